@@ -19,17 +19,24 @@ package account
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -115,18 +122,54 @@ var Create action.GTSAction = func(ctx context.Context) error {
 	return err
 }
 
-// List returns all existing local accounts.
+// List returns all existing local accounts,
+// filtered according to the provided flags.
 var List action.GTSAction = func(ctx context.Context) error {
 	state, err := initState(ctx)
 	if err != nil {
 		return err
 	}
 
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	return list(ctx, state, os.Stdout)
+}
+
+func list(ctx context.Context, state *state.State, out io.Writer) error {
+	role := config.GetAdminAccountRole()
+	if role != "" {
+		if err := validateRole(role); err != nil {
+			return err
+		}
+	}
+	pending := config.GetAdminAccountListPending()
+	disabled := config.GetAdminAccountListDisabled()
+
 	users, err := state.DB.GetAllUsers(ctx)
 	if err != nil {
 		return err
 	}
 
+	// Filter users in place.
+	filtered := users[:0]
+	for _, u := range users {
+		switch {
+		case role != "" && userRole(u) != role:
+			continue
+		case pending && *u.Approved:
+			continue
+		case disabled && !*u.Disabled:
+			continue
+		}
+		filtered = append(filtered, u)
+	}
+	users = filtered
+
 	fmtBool := func(b *bool) string {
 		if b == nil {
 			return "unknown"
@@ -144,10 +187,10 @@ var List action.GTSAction = func(ctx context.Context) error {
 		return "yes"
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
-	fmt.Fprintln(w, "user\taccount\tapproved\tadmin\tmoderator\tsuspended\tconfirmed")
+	w := tabwriter.NewWriter(out, 0, 0, 1, ' ', 0)
+	fmt.Fprintln(w, "user\taccount\tapproved\tadmin\tmoderator\tdisabled\tsuspended\tconfirmed")
 	for _, u := range users {
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", u.Account.Username, u.AccountID, fmtBool(u.Approved), fmtBool(u.Admin), fmtBool(u.Moderator), fmtDate(u.Account.SuspendedAt), fmtDate(u.ConfirmedAt))
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", u.Account.Username, u.AccountID, fmtBool(u.Approved), fmtBool(u.Admin), fmtBool(u.Moderator), fmtBool(u.Disabled), fmtDate(u.Account.SuspendedAt), fmtDate(u.ConfirmedAt))
 	}
 	return w.Flush()
 }
@@ -191,6 +234,102 @@ var Confirm action.GTSAction = func(ctx context.Context) error {
 	)
 }
 
+// Approve sets a pending user to Approved,
+// without confirming their email address.
+var Approve action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	return approve(ctx, state)
+}
+
+func approve(ctx context.Context, state *state.State) error {
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	if *user.Approved {
+		return fmt.Errorf("account %s is already approved", username)
+	}
+
+	user.Approved = func() *bool { a := true; return &a }()
+	return state.DB.UpdateUser(
+		ctx, user,
+		"approved",
+	)
+}
+
+// Reject removes a pending user, and their
+// account, without ever having approved them.
+var Reject action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	return reject(ctx, state)
+}
+
+func reject(ctx context.Context, state *state.State) error {
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	if *user.Approved {
+		return fmt.Errorf("account %s is already approved; disable it instead", username)
+	}
+
+	// The account was never approved, so it can't have
+	// posted or federated anything; just remove it.
+	if err := state.DB.DeleteUserByID(ctx, user.ID); err != nil {
+		return fmt.Errorf("error deleting user: %w", err)
+	}
+
+	if err := state.DB.DeleteAccount(ctx, account.ID); err != nil {
+		return fmt.Errorf("error deleting account: %w", err)
+	}
+
+	return nil
+}
+
 // Promote sets admin + moderator flags on a user to true.
 var Promote action.GTSAction = func(ctx context.Context) error {
 	state, err := initState(ctx)
@@ -301,6 +440,224 @@ var Disable action.GTSAction = func(ctx context.Context) error {
 	)
 }
 
+// Role sets admin + moderator flags on a user
+// according to the given role.
+var Role action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	return setRole(ctx, state)
+}
+
+func setRole(ctx context.Context, state *state.State) error {
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	role := config.GetAdminAccountRole()
+	if err := validateRole(role); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	admin := role == roleAdmin
+	moderator := role == roleAdmin || role == roleModerator
+	user.Admin = &admin
+	user.Moderator = &moderator
+	return state.DB.UpdateUser(
+		ctx, user,
+		"admin", "moderator",
+	)
+}
+
+// Enable sets Disabled to false on a user.
+var Enable action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	user.Disabled = func() *bool { d := false; return &d }()
+	return state.DB.UpdateUser(
+		ctx, user,
+		"disabled",
+	)
+}
+
+// ResetPassword replaces the password of target account
+// with a new random one, and revokes all of the account's
+// access tokens, so that the user has to sign in again
+// with the new password.
+//
+// The new password is printed to stderr rather than stdout,
+// so that it doesn't end up in logs or files that stdout
+// may be redirected to when scripting admin commands.
+var ResetPassword action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	return resetPassword(ctx, state, os.Stderr)
+}
+
+func resetPassword(ctx context.Context, state *state.State, out io.Writer) error {
+	username := config.GetAdminAccountUsername()
+	if err := validate.Username(username); err != nil {
+		return err
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		return err
+	}
+
+	user, err := state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Errorf("error generating password: %w", err)
+	}
+	password := base64.RawURLEncoding.EncodeToString(b)
+
+	encryptedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("error hashing password: %s", err)
+	}
+
+	user.EncryptedPassword = string(encryptedPassword)
+	if err := state.DB.UpdateUser(
+		ctx, user,
+		"encrypted_password",
+	); err != nil {
+		return err
+	}
+
+	tokens := []*gtsmodel.Token{}
+	if err := state.DB.GetWhere(ctx, []db.Where{{Key: "user_id", Value: user.ID}}, &tokens); err != nil {
+		return fmt.Errorf("error getting tokens: %w", err)
+	}
+
+	for _, t := range tokens {
+		if err := state.DB.DeleteByID(ctx, t.ID, t); err != nil {
+			return fmt.Errorf("error deleting token: %w", err)
+		}
+	}
+
+	fmt.Fprintf(out, "new password for %s: %s\n", username, password)
+	return nil
+}
+
+// Purge removes all cached media (including avatar and
+// header) of the target remote account from storage,
+// and marks the account to be refetched next time
+// it's needed. Statuses of the account are kept.
+var Purge action.GTSAction = func(ctx context.Context) error {
+	state, err := initState(ctx)
+	if err != nil {
+		return err
+	}
+
+	//nolint:contextcheck
+	storage, err := gtsstorage.AutoConfig()
+	if err != nil {
+		return fmt.Errorf("error creating storage backend: %w", err)
+	}
+	state.Storage = storage
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := storage.Close(); err != nil {
+			log.Error(ctx, err)
+		}
+		if err := stopState(state); err != nil {
+			log.Error(ctx, err)
+		}
+	}()
+
+	return purge(ctx, state, os.Stdout)
+}
+
+func purge(ctx context.Context, state *state.State, out io.Writer) error {
+	username := config.GetAdminAccountUsername()
+	domain := config.GetAdminAccountDomain()
+	if domain == "" || domain == config.GetHost() || domain == config.GetAccountDomain() {
+		return errors.New("only remote accounts can be purged")
+	}
+
+	account, err := state.DB.GetAccountByUsernameDomain(ctx, username, domain)
+	if err != nil {
+		return err
+	}
+
+	//nolint:contextcheck
+	uncached, err := cleaner.New(state).Media().UncacheAccount(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	// Zero fetched at, so that the account
+	// is dereferenced again on next access.
+	account.FetchedAt = time.Time{}
+	if err := state.DB.UpdateAccount(ctx, account, "fetched_at"); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "purged %d cached media of %s@%s\n", uncached, username, domain)
+	return nil
+}
+
 // Password sets the password of target account.
 var Password action.GTSAction = func(ctx context.Context) error {
 	state, err := initState(ctx)
@@ -346,3 +703,33 @@ var Password action.GTSAction = func(ctx context.Context) error {
 		"encrypted_password",
 	)
 }
+
+// Roles that can be set on,
+// or used to list, accounts.
+const (
+	roleAdmin     = "admin"
+	roleModerator = "moderator"
+	roleUser      = "user"
+)
+
+func validateRole(role string) error {
+	switch role {
+	case roleAdmin, roleModerator, roleUser:
+		return nil
+	default:
+		return fmt.Errorf("role must be one of admin, moderator or user, provided value was %s", role)
+	}
+}
+
+// userRole returns the role that
+// the given user currently has.
+func userRole(user *gtsmodel.User) string {
+	switch {
+	case *user.Admin:
+		return roleAdmin
+	case *user.Moderator:
+		return roleModerator
+	default:
+		return roleUser
+	}
+}
@@ -0,0 +1,278 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type AccountTestSuite struct {
+	suite.Suite
+	db      db.DB
+	storage *storage.Driver
+	state   state.State
+
+	testAccounts map[string]*gtsmodel.Account
+	testUsers    map[string]*gtsmodel.User
+}
+
+func (suite *AccountTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testUsers = testrig.NewTestUsers()
+}
+
+func (suite *AccountTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+	testrig.StartWorkers(&suite.state)
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	suite.state.DB = suite.db
+	suite.storage = testrig.NewInMemoryStorage()
+	suite.state.Storage = suite.storage
+
+	testrig.StandardDBSetup(suite.db, suite.testAccounts)
+	testrig.StandardStorageSetup(suite.storage, "../../../../../testrig/media")
+}
+
+func (suite *AccountTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StandardStorageTeardown(suite.storage)
+	testrig.StopWorkers(&suite.state)
+}
+
+// listUsernames runs list with the current
+// config, and returns the listed usernames.
+func (suite *AccountTestSuite) listUsernames() []string {
+	out := &bytes.Buffer{}
+	if err := list(context.Background(), &suite.state, out); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	suite.True(strings.HasPrefix(lines[0], "user "))
+
+	usernames := make([]string, 0, len(lines)-1)
+	for _, line := range lines[1:] {
+		usernames = append(usernames, strings.Fields(line)[0])
+	}
+	return usernames
+}
+
+func (suite *AccountTestSuite) getUser(username string) *gtsmodel.User {
+	ctx := context.Background()
+
+	account, err := suite.db.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	user, err := suite.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return user
+}
+
+func (suite *AccountTestSuite) TestList() {
+	suite.ElementsMatch(
+		[]string{"weed_lord420", "admin", "the_mighty_zork", "1happyturtle"},
+		suite.listUsernames(),
+	)
+}
+
+func (suite *AccountTestSuite) TestListRole() {
+	config.SetAdminAccountRole(roleAdmin)
+	suite.Equal([]string{"admin"}, suite.listUsernames())
+
+	config.SetAdminAccountRole(roleModerator)
+	suite.Empty(suite.listUsernames())
+
+	config.SetAdminAccountRole(roleUser)
+	suite.ElementsMatch(
+		[]string{"weed_lord420", "the_mighty_zork", "1happyturtle"},
+		suite.listUsernames(),
+	)
+
+	config.SetAdminAccountRole("superuser")
+	err := list(context.Background(), &suite.state, &bytes.Buffer{})
+	suite.ErrorContains(err, "role must be one of admin, moderator or user")
+}
+
+func (suite *AccountTestSuite) TestListPending() {
+	config.SetAdminAccountListPending(true)
+	suite.Equal([]string{"weed_lord420"}, suite.listUsernames())
+}
+
+func (suite *AccountTestSuite) TestListDisabled() {
+	config.SetAdminAccountListDisabled(true)
+	suite.Empty(suite.listUsernames())
+
+	user := suite.getUser("the_mighty_zork")
+	user.Disabled = util.Ptr(true)
+	if err := suite.db.UpdateUser(context.Background(), user, "disabled"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal([]string{"the_mighty_zork"}, suite.listUsernames())
+}
+
+func (suite *AccountTestSuite) TestApprove() {
+	config.SetAdminAccountUsername("weed_lord420")
+
+	suite.NoError(approve(context.Background(), &suite.state))
+	suite.True(*suite.getUser("weed_lord420").Approved)
+
+	// Can't approve twice.
+	err := approve(context.Background(), &suite.state)
+	suite.ErrorContains(err, "account weed_lord420 is already approved")
+}
+
+func (suite *AccountTestSuite) TestReject() {
+	ctx := context.Background()
+	config.SetAdminAccountUsername("weed_lord420")
+
+	suite.NoError(reject(ctx, &suite.state))
+
+	_, err := suite.db.GetAccountByUsernameDomain(ctx, "weed_lord420", "")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetUserByID(ctx, suite.testUsers["unconfirmed_account"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *AccountTestSuite) TestRejectApproved() {
+	config.SetAdminAccountUsername("the_mighty_zork")
+
+	err := reject(context.Background(), &suite.state)
+	suite.ErrorContains(err, "account the_mighty_zork is already approved")
+
+	// Zork should still be there.
+	suite.True(*suite.getUser("the_mighty_zork").Approved)
+}
+
+func (suite *AccountTestSuite) TestSetRole() {
+	ctx := context.Background()
+	config.SetAdminAccountUsername("the_mighty_zork")
+
+	for _, test := range []struct {
+		role      string
+		admin     bool
+		moderator bool
+	}{
+		{roleModerator, false, true},
+		{roleAdmin, true, true},
+		{roleUser, false, false},
+	} {
+		config.SetAdminAccountRole(test.role)
+		suite.NoError(setRole(ctx, &suite.state))
+
+		user := suite.getUser("the_mighty_zork")
+		suite.Equal(test.admin, *user.Admin, test.role)
+		suite.Equal(test.moderator, *user.Moderator, test.role)
+		suite.Equal(test.role, userRole(user))
+	}
+
+	config.SetAdminAccountRole("")
+	suite.Error(setRole(ctx, &suite.state))
+}
+
+func (suite *AccountTestSuite) TestResetPassword() {
+	ctx := context.Background()
+	config.SetAdminAccountUsername("the_mighty_zork")
+
+	out := &bytes.Buffer{}
+	suite.NoError(resetPassword(ctx, &suite.state, out))
+
+	match := regexp.MustCompile(`^new password for the_mighty_zork: (\S+)\n$`).FindStringSubmatch(out.String())
+	if len(match) != 2 {
+		suite.FailNow("unexpected output: " + out.String())
+	}
+
+	user := suite.getUser("the_mighty_zork")
+	suite.NoError(bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(match[1])))
+
+	// All of zork's tokens should be gone.
+	tokens := []*gtsmodel.Token{}
+	err := suite.db.GetWhere(ctx, []db.Where{{Key: "user_id", Value: user.ID}}, &tokens)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(tokens)
+}
+
+func (suite *AccountTestSuite) TestPurge() {
+	ctx := context.Background()
+	account := suite.testAccounts["remote_account_1"]
+	config.SetAdminAccountUsername(account.Username)
+	config.SetAdminAccountDomain(account.Domain)
+
+	cached, err := suite.db.GetCachedAttachmentsByAccountID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(cached)
+
+	out := &bytes.Buffer{}
+	suite.NoError(purge(ctx, &suite.state, out))
+	suite.Contains(out.String(), "cached media of "+account.Username+"@"+account.Domain)
+
+	cached, err = suite.db.GetCachedAttachmentsByAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(cached)
+
+	// Account should be marked for refetching.
+	purged, err := suite.db.GetAccountByID(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(purged.FetchedAt.IsZero())
+}
+
+func (suite *AccountTestSuite) TestPurgeLocal() {
+	config.SetAdminAccountUsername("the_mighty_zork")
+
+	for _, domain := range []string{"", config.GetHost()} {
+		config.SetAdminAccountDomain(domain)
+		err := purge(context.Background(), &suite.state, &bytes.Buffer{})
+		suite.EqualError(err, "only remote accounts can be purged")
+	}
+}
+
+func TestAccountTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountTestSuite))
+}
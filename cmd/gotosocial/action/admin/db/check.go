@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// Check scans the database for referential integrity
+// violations, optionally repairing them, and prints a report.
+var Check action.GTSAction = func(ctx context.Context) error {
+	var state state.State
+	state.Caches.Init()
+	state.Caches.Start()
+	state.Workers.Start()
+
+	dbConn, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("error creating dbConn: %w", err)
+	}
+	state.DB = dbConn
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := state.DB.Close(); err != nil {
+			log.Error(ctx, err)
+		}
+		state.Workers.Stop()
+		state.Caches.Stop()
+	}()
+
+	if !config.GetAdminDBCheckRepair() {
+		log.Info(ctx, "db check DRY RUN")
+		ctx = gtscontext.SetDryRun(ctx)
+	}
+
+	return check(ctx, &state, os.Stdout)
+}
+
+func check(ctx context.Context, state *state.State, out io.Writer) error {
+	//nolint:contextcheck
+	cleaner := cleaner.New(state)
+
+	report, err := cleaner.Integrity().CheckStatuses(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "statuses checked\t%d\n", report.Checked)
+	fmt.Fprintf(w, "missing author account\t%d\n", report.MissingAuthor)
+	fmt.Fprintf(w, "missing boosted status\t%d\n", report.MissingBoostOf)
+	fmt.Fprintf(w, "missing attachments\t%d\n", report.MissingAttachments)
+	fmt.Fprintf(w, "missing mentions\t%d\n", report.MissingMentions)
+	fmt.Fprintf(w, "missing tags\t%d\n", report.MissingTags)
+	fmt.Fprintf(w, "missing emojis\t%d\n", report.MissingEmojis)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	switch {
+	case report.Violations() == 0:
+		fmt.Fprintln(out, "no integrity violations found")
+	case gtscontext.DryRun(ctx):
+		fmt.Fprintf(out, "found %d integrity violations; run again with --repair to repair them\n", report.Violations())
+	default:
+		fmt.Fprintf(out, "repaired %d integrity violations\n", report.Violations())
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+)
+
+// Preflight reports the database migrations and backfills
+// still to run, without running them, and what they involve.
+var Preflight action.GTSAction = func(ctx context.Context) error {
+	report, err := bundb.Preflight(ctx)
+	if err != nil {
+		return fmt.Errorf("error running preflight checks: %w", err)
+	}
+
+	return preflight(report, os.Stdout)
+}
+
+func preflight(report *bundb.PreflightReport, out io.Writer) error {
+	if len(report.Migrations) == 0 {
+		fmt.Fprintln(out, "no pending migrations")
+	} else {
+		w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "MIGRATION\tCHANGE\tTABLES (ESTIMATED ROWS)\tLOCKS")
+		for _, m := range report.Migrations {
+			tables := make([]string, 0, len(m.Tables))
+			for _, table := range m.Tables {
+				tables = append(tables, fmt.Sprintf("%s (%d)", table.Name, table.Rows))
+			}
+			if len(tables) == 0 {
+				tables = append(tables, "unknown")
+			}
+			fmt.Fprintf(w, "%s_%s\t%s\t%s\t%s\n", m.Name, m.Comment, m.Change, strings.Join(tables, ", "), m.Lock)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if len(report.Backfills) == 0 {
+		fmt.Fprintln(out, "no pending backfills")
+	} else {
+		fmt.Fprintf(out, "pending backfills, run in the background after startup: %s\n", strings.Join(report.Backfills, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
+	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// RecountStats recounts the stored follower, following
+// and status counts of accounts, and prints a summary.
+var RecountStats action.GTSAction = func(ctx context.Context) error {
+	var state state.State
+	state.Caches.Init()
+	state.Caches.Start()
+	state.Workers.Start()
+
+	dbConn, err := bundb.NewBunDBService(ctx, &state)
+	if err != nil {
+		return fmt.Errorf("error creating dbConn: %w", err)
+	}
+	state.DB = dbConn
+
+	defer func() {
+		// Ensure state gets stopped on return.
+		if err := state.DB.Close(); err != nil {
+			log.Error(ctx, err)
+		}
+		state.Workers.Stop()
+		state.Caches.Stop()
+	}()
+
+	return recountStats(ctx, &state, os.Stdout)
+}
+
+func recountStats(ctx context.Context, state *state.State, out io.Writer) error {
+	//nolint:contextcheck
+	cleaner := cleaner.New(state)
+
+	recounted, fixed, err := cleaner.Integrity().RecountAccountStats(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "recounted stats of %d accounts, %d of which were wrong\n", recounted, fixed)
+	return nil
+}
@@ -24,10 +24,12 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"syscall"
 	"time"
 
-	"codeberg.org/gruf/go-sched"
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action"
 	"github.com/superseriousbusiness/gotosocial/internal/api"
@@ -40,6 +42,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 	"go.uber.org/automaxprocs/maxprocs"
 
+	"github.com/superseriousbusiness/gotosocial/internal/challenge"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
@@ -47,14 +50,18 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
 	"github.com/superseriousbusiness/gotosocial/internal/gotosocial"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/redis"
 	"github.com/superseriousbusiness/gotosocial/internal/router"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
@@ -98,6 +105,13 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error creating instance instance: %s", err)
 	}
 
+	// Prime caches with commonly needed data. This is
+	// only an optimization, so don't fail startup if
+	// it goes wrong; caches will just load lazily.
+	if err := bundb.PrimeCaches(ctx, &state); err != nil {
+		log.Warnf(ctx, "error priming caches: %v", err)
+	}
+
 	// Open the storage backend
 	storage, err := gtsstorage.AutoConfig()
 	if err != nil {
@@ -113,18 +127,61 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		BlockRanges:           config.MustParseIPPrefixes(config.GetHTTPClientBlockIPs()),
 		Timeout:               config.GetHTTPClientTimeout(),
 		TLSInsecureSkipVerify: config.GetHTTPClientTLSInsecureSkipVerify(),
+		MaxOpenConnsPerHost:   config.GetHTTPClientMaxOpenConnsPerHost(),
+		MaxIdleConnsPerHost:   config.GetHTTPClientMaxIdleConnsPerHost(),
+		IdleConnTimeout:       config.GetHTTPClientIdleConnTimeout(),
+		DisableHTTP2:          config.GetHTTPClientDisableHTTP2(),
+		Proxy:                 config.MustParseProxyURL(config.GetHTTPClientProxy()),
+		OnionProxy:            config.MustParseProxyURL(config.GetHTTPClientOnionProxy()),
 	})
 
+	// Connect to redis, if configured.
+	if url := config.GetRedisURL(); url != "" {
+		redisClient, err := redis.New(url)
+		if err != nil {
+			return fmt.Errorf("error creating redis client: %w", err)
+		}
+		defer redisClient.Close()
+
+		if config.GetRedisCacheNotify() {
+			notifier := redis.StartCacheNotifier(redisClient, &state.Caches)
+			defer notifier.Stop()
+		}
+
+		for _, name := range config.GetRedisQueues() {
+			queue, err := redis.NewQueue(redisClient, name)
+			if err != nil {
+				return fmt.Errorf("error creating redis queue %s: %w", name, err)
+			}
+
+			switch name {
+			case config.RedisQueueClientAPI:
+				state.Workers.ClientAPIQueue = queue
+			case config.RedisQueueFediAPI:
+				state.Workers.FediAPIQueue = queue
+			}
+		}
+	}
+
 	// Initialize workers.
 	state.Workers.Start()
 	defer state.Workers.Stop()
 
-	// Add a task to the scheduler to sweep caches.
+	// Add a job to sweep caches.
 	// Frequency = 1 * minute
 	// Threshold = 80% capacity
-	sweep := func(time.Time) { state.Caches.Sweep(80) }
-	job := sched.NewJob(sweep).Every(time.Minute)
-	_ = state.Workers.Scheduler.Schedule(job)
+	state.Jobs.Register(jobs.CacheSweep, "@every 1m", func(context.Context, time.Time) error {
+		state.Caches.Sweep(80)
+		return nil
+	})
+
+	// Add a job running batches of any
+	// backfills scheduled by migrations,
+	// finishing before the next run is due.
+	state.Jobs.Register(jobs.Backfill, "@every 1m", func(ctx context.Context, now time.Time) error {
+		_, err := state.DB.RunBackfills(ctx, now.Add(50*time.Second))
+		return err
+	})
 
 	// Build handlers used in later initializations.
 	mediaManager := media.NewManager(&state)
@@ -135,21 +192,52 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	transportController := transport.NewController(&state, federatingDB, &federation.Clock{}, client)
 	federator := federation.NewFederator(&state, federatingDB, transportController, typeConverter, mediaManager)
 
+	// Add a job refreshing stale remote
+	// accounts followed by local accounts,
+	// and their recent statuses.
+	state.Jobs.Register(jobs.StaleRefresh, "@every 10m", federator.RefreshStale)
+
+	// Load language bundles for translating
+	// server-rendered web pages and emails.
+	i18n.Load(filepath.Join(config.GetWebAssetBaseDir(), "i18n"))
+
 	// Decide whether to create a noop email
 	// sender (won't send emails) or a real one.
-	var emailSender email.Sender
-	if smtpHost := config.GetSMTPHost(); smtpHost != "" {
-		// Host is defined; create a proper sender.
-		emailSender, err = email.NewSender()
-		if err != nil {
-			return fmt.Errorf("error creating email sender: %s", err)
+	// This is decided again whenever the smtp
+	// settings are changed by a config reload.
+	emailSender, err := email.NewReloadableSender(func() (email.Sender, error) {
+		if config.GetSMTPHost() != "" || config.GetSMTPTransport() == config.SMTPTransportHTTP {
+			// Host or mail API is defined; create a proper sender.
+			sender, err := email.NewSender()
+			if err != nil {
+				return nil, fmt.Errorf("error creating email sender: %w", err)
+			}
+			return sender, nil
 		}
-	} else {
-		// No host is defined; create a noop sender.
-		emailSender, err = email.NewNoopSender(nil)
+
+		// Nothing is defined; create a noop sender.
+		sender, err := email.NewNoopSender(nil)
 		if err != nil {
-			return fmt.Errorf("error creating noop email sender: %s", err)
+			return nil, fmt.Errorf("error creating noop email sender: %w", err)
 		}
+		return sender, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Set up the challenge that new
+	// sign-ups must pass, if any.
+	state.Challenge, err = challenge.New()
+	if err != nil {
+		return fmt.Errorf("error creating sign-up challenge: %s", err)
+	}
+
+	// Set up the backend that statuses
+	// are translated with, if any.
+	state.Translator, err = translate.New()
+	if err != nil {
+		return fmt.Errorf("error creating translator: %s", err)
 	}
 
 	// Initialize timelines.
@@ -173,9 +261,44 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error starting list timeline: %s", err)
 	}
 
+	// Add a job to prune timelines
+	// that haven't been used lately.
+	state.Jobs.Register(jobs.TimelinePrune, "@hourly", func(_ context.Context, now time.Time) error {
+		state.Timelines.Home.PruneStale(now)
+		state.Timelines.List.PruneStale(now)
+		return nil
+	})
+
 	// Create the processor using all the other services we've created so far.
 	processor := processing.NewProcessor(typeConverter, federator, oauthServer, mediaManager, &state, emailSender)
 
+	// If enabled, pass streamed events to (and from)
+	// other processes sharing this database, so they
+	// reach streams no matter which process serves them.
+	if config.GetDbPostgresStreamNotify() {
+		if config.GetDbType() != "postgres" {
+			log.Warnf(ctx, "%s is only supported for postgres, ignoring", config.DbPostgresStreamNotifyFlag())
+		} else {
+			streamNotifier, err := bundb.StartStreamNotifier(processor.Stream().Deliver)
+			if err != nil {
+				return fmt.Errorf("error starting stream notifier: %w", err)
+			}
+			defer streamNotifier.Stop()
+			processor.Stream().SetRelay(streamNotifier)
+		}
+	}
+
+	// Start processing any messages held in persistent
+	// queues, including those left over from a previous run.
+	if q := state.Workers.ClientAPIQueue; q != nil {
+		q.Start(4*runtime.GOMAXPROCS(0), processor.Workers().ProcessQueuedClientAPI)
+		defer q.Stop()
+	}
+	if q := state.Workers.FediAPIQueue; q != nil {
+		q.Start(4*runtime.GOMAXPROCS(0), processor.Workers().ProcessQueuedFediAPI)
+		defer q.Stop()
+	}
+
 	// Set state client / federator asynchronous worker enqueue functions
 	state.Workers.EnqueueClientAPI = processor.Workers().EnqueueClientAPI
 	state.Workers.EnqueueFediAPI = processor.Workers().EnqueueFediAPI
@@ -184,6 +307,37 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	state.Workers.ProcessFromClientAPI = processor.Workers().ProcessFromClientAPI
 	state.Workers.ProcessFromFediAPI = processor.Workers().ProcessFromFediAPI
 
+	// Schedule countdowns for any upcoming
+	// maintenance set by announcements.
+	if err := processor.Admin().MaintenanceScheduleAll(ctx); err != nil {
+		return fmt.Errorf("error scheduling maintenance: %w", err)
+	}
+
+	// Add a job checking saved
+	// searches for new matches.
+	processor.Search().SavedSearchRegisterJob()
+
+	// Add a job sending digests of
+	// notifications by email.
+	processor.Workers().EmailDigestRegisterJob()
+
+	// Add a job recording nightly
+	// instance statistics.
+	processor.Admin().StatisticsRegisterJob()
+
+	// Add a job making daily highlights
+	// of statuses from followed accounts.
+	processor.Workers().HighlightsRegisterJob()
+
+	// Add a job syncing faves / boosts
+	// counts of remote statuses.
+	processor.Status().CountsRegisterJob()
+
+	// Start all the registered jobs.
+	if err := state.Jobs.Start(state.DB, &state.Workers.Scheduler); err != nil {
+		return fmt.Errorf("error starting jobs: %w", err)
+	}
+
 	/*
 		HTTP router initialization
 	*/
@@ -203,6 +357,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		// note: hooks adding ctx fields must be ABOVE
 		// the logger, otherwise won't be accessible.
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.IPBlock(state.DB.MatchIPBlock, processor.InstanceGetV1),
 		middleware.UserAgent(),
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
@@ -231,6 +386,11 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	// Add CSP to middlewares.
 	middlewares = append(middlewares, middleware.ContentSecurityPolicy(cspExtraURIs...))
 
+	// Advertise onion service address, if we have one.
+	if config.GetOnionHost() != "" {
+		middlewares = append(middlewares, middleware.OnionLocation())
+	}
+
 	// attach global middlewares which are used for every request
 	router.AttachGlobalMiddleware(middlewares...)
 
@@ -272,9 +432,49 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	// rate limiting
 	rlLimit := config.GetAdvancedRateLimitRequests()
 	rlExceptions := config.GetAdvancedRateLimitExceptions()
-	clLimit := middleware.RateLimit(rlLimit, rlExceptions)  // client api
-	s2sLimit := middleware.RateLimit(rlLimit, rlExceptions) // server-to-server (AP)
-	fsLimit := middleware.RateLimit(rlLimit, rlExceptions)  // fileserver / web templates
+	rateLimits := []*middleware.ReloadableRateLimit{
+		middleware.NewReloadableRateLimit(rlLimit, rlExceptions), // client api
+		middleware.NewReloadableRateLimit(rlLimit, rlExceptions), // server-to-server (AP)
+		middleware.NewReloadableRateLimit(rlLimit, rlExceptions), // fileserver / web templates
+	}
+	clLimit := rateLimits[0].Handler()
+	s2sLimit := rateLimits[1].Handler()
+	fsLimit := rateLimits[2].Handler()
+
+	// Apply settings which are otherwise only
+	// read on startup when the config is reloaded.
+	config.OnReload(func(changed []string) {
+		for _, name := range changed {
+			switch {
+			case name == config.LogLevelFlag():
+				if err := log.ParseLevel(config.GetLogLevel()); err != nil {
+					log.Errorf(ctx, "error applying reloaded log level: %v", err)
+				}
+
+			case name == config.LogLevelsFlag():
+				if err := log.ParseSubsystemLevels(config.GetLogLevels()); err != nil {
+					log.Errorf(ctx, "error applying reloaded log levels: %v", err)
+				}
+
+			case name == config.AdvancedRateLimitRequestsFlag(),
+				name == config.AdvancedRateLimitExceptionsFlag():
+				for _, rl := range rateLimits {
+					if err := rl.Reload(
+						config.GetAdvancedRateLimitRequests(),
+						config.GetAdvancedRateLimitExceptions(),
+					); err != nil {
+						log.Errorf(ctx, "error applying reloaded rate limits: %v", err)
+						break
+					}
+				}
+
+			case strings.HasPrefix(name, "smtp-"):
+				if err := emailSender.Reload(); err != nil {
+					log.Errorf(ctx, "error applying reloaded smtp settings: %v", err)
+				}
+			}
+		}
+	})
 
 	// throttling
 	cpuMultiplier := config.GetAdvancedThrottlingMultiplier()
@@ -286,14 +486,18 @@ var Start action.GTSAction = func(ctx context.Context) error {
 
 	gzip := middleware.Gzip() // applied to all except fileserver
 
+	// reject writes during maintenance, but let admins through to end it early
+	maintenance := middleware.Maintenance(processor.Admin().MaintenanceActive, processor.InstanceGetV1, "/api/v1/admin")
+
 	// these should be routed in order;
 	// apply throttling *after* rate limiting
 	authModule.Route(router, clLimit, clThrottle, gzip)
-	clientModule.Route(router, clLimit, clThrottle, gzip)
+	clientModule.Route(router, clLimit, clThrottle, gzip, maintenance)
+	clientModule.RouteStreaming(router, clLimit)
 	fileserverModule.Route(router, fsLimit, fsThrottle)
 	wellKnownModule.Route(router, gzip, s2sLimit, s2sThrottle)
 	nodeInfoModule.Route(router, s2sLimit, s2sThrottle, gzip)
-	activityPubModule.Route(router, s2sLimit, s2sThrottle, gzip)
+	activityPubModule.Route(router, s2sLimit, s2sThrottle, gzip, maintenance)
 	activityPubModule.RoutePublicKey(router, s2sLimit, pkThrottle, gzip)
 	webModule.Route(router, fsLimit, fsThrottle, gzip)
 
@@ -306,10 +510,21 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error starting gotosocial service: %s", err)
 	}
 
-	// catch shutdown signals from the operating system
+	// catch shutdown signals from the operating system,
+	// reloading config and tls certificates on SIGHUP
 	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
 	sig := <-sigs // block until signal received
+	for sig == syscall.SIGHUP {
+		log.Info(ctx, "received SIGHUP, reloading config and tls certificates")
+		if _, err := config.ReloadFile(); err != nil {
+			log.Errorf(ctx, "error reloading config: %v", err)
+		}
+		if err := router.ReloadTLS(); err != nil {
+			log.Errorf(ctx, "error reloading tls certificates: %v", err)
+		}
+		sig = <-sigs
+	}
 	log.Infof(ctx, "received signal %s, shutting down", sig)
 
 	// close down all running services in order
@@ -33,8 +33,10 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/api"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gotosocial"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
@@ -70,6 +72,11 @@ var Start action.GTSAction = func(ctx context.Context) error {
 
 	testrig.StandardDBSetup(state.DB, nil)
 
+	// Prime caches as the server would.
+	if err := bundb.PrimeCaches(ctx, &state); err != nil {
+		log.Warnf(ctx, "error priming caches: %v", err)
+	}
+
 	if os.Getenv("GTS_STORAGE_BACKEND") == "s3" {
 		var err error
 		state.Storage, err = storage.NewS3Storage()
@@ -96,6 +103,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	mediaManager := testrig.NewTestMediaManager(&state)
 	federator := testrig.NewTestFederator(&state, transportController, mediaManager)
 
+	i18n.Load("./web/assets/i18n/")
 	emailSender := testrig.NewEmailSender("./web/template/", nil)
 	typeConverter := typeutils.NewConverter(&state)
 	filter := visibility.NewFilter(&state)
@@ -136,6 +144,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	}
 	middlewares = append(middlewares, []gin.HandlerFunc{
 		middleware.Logger(config.GetLogClientIP()),
+		middleware.IPBlock(state.DB.MatchIPBlock, processor.InstanceGetV1),
 		middleware.UserAgent(),
 		middleware.CORS(),
 		middleware.ExtraHeaders(),
@@ -204,6 +213,7 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	// these should be routed in order
 	authModule.Route(router)
 	clientModule.Route(router)
+	clientModule.RouteStreaming(router)
 	fileserverModule.Route(router)
 	wellKnownModule.Route(router)
 	nodeInfoModule.Route(router)
@@ -20,6 +20,7 @@ package main
 import (
 	"github.com/spf13/cobra"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/account"
+	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/db"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/media/prune"
 	"github.com/superseriousbusiness/gotosocial/cmd/gotosocial/action/admin/trans"
@@ -38,7 +39,7 @@ func adminCommands() *cobra.Command {
 
 	adminAccountCmd := &cobra.Command{
 		Use:   "account",
-		Short: "admin commands related to local (this instance) accounts",
+		Short: "admin commands related to accounts",
 	}
 	config.AddAdminAccount(adminAccountCmd)
 
@@ -57,7 +58,7 @@ func adminCommands() *cobra.Command {
 
 	adminAccountListCmd := &cobra.Command{
 		Use:   "list",
-		Short: "list all existing local accounts",
+		Short: "list existing local accounts, optionally filtered by role, pending approval, or disabled",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return preRun(preRunArgs{cmd: cmd})
 		},
@@ -65,6 +66,7 @@ func adminCommands() *cobra.Command {
 			return run(cmd.Context(), account.List)
 		},
 	}
+	config.AddAdminAccountList(adminAccountListCmd)
 	adminAccountCmd.AddCommand(adminAccountListCmd)
 
 	adminAccountConfirmCmd := &cobra.Command{
@@ -80,6 +82,32 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccount(adminAccountConfirmCmd)
 	adminAccountCmd.AddCommand(adminAccountConfirmCmd)
 
+	adminAccountApproveCmd := &cobra.Command{
+		Use:   "approve",
+		Short: "approve a local account that is pending approval, without confirming its email address",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Approve)
+		},
+	}
+	config.AddAdminAccount(adminAccountApproveCmd)
+	adminAccountCmd.AddCommand(adminAccountApproveCmd)
+
+	adminAccountRejectCmd := &cobra.Command{
+		Use:   "reject",
+		Short: "reject a local account that is pending approval, removing it",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Reject)
+		},
+	}
+	config.AddAdminAccount(adminAccountRejectCmd)
+	adminAccountCmd.AddCommand(adminAccountRejectCmd)
+
 	adminAccountPromoteCmd := &cobra.Command{
 		Use:   "promote",
 		Short: "promote a local account to admin",
@@ -106,6 +134,20 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccount(adminAccountDemoteCmd)
 	adminAccountCmd.AddCommand(adminAccountDemoteCmd)
 
+	adminAccountRoleCmd := &cobra.Command{
+		Use:   "role",
+		Short: "set the role of a local account to admin, moderator or user",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Role)
+		},
+	}
+	config.AddAdminAccount(adminAccountRoleCmd)
+	config.AddAdminAccountRole(adminAccountRoleCmd)
+	adminAccountCmd.AddCommand(adminAccountRoleCmd)
+
 	adminAccountDisableCmd := &cobra.Command{
 		Use:   "disable",
 		Short: "prevent a local account from signing in or posting etc, but don't delete anything",
@@ -119,6 +161,19 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccount(adminAccountDisableCmd)
 	adminAccountCmd.AddCommand(adminAccountDisableCmd)
 
+	adminAccountEnableCmd := &cobra.Command{
+		Use:   "enable",
+		Short: "allow a previously disabled local account to sign in and post again",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Enable)
+		},
+	}
+	config.AddAdminAccount(adminAccountEnableCmd)
+	adminAccountCmd.AddCommand(adminAccountEnableCmd)
+
 	adminAccountPasswordCmd := &cobra.Command{
 		Use:   "password",
 		Short: "set a new password for the given local account",
@@ -133,6 +188,32 @@ func adminCommands() *cobra.Command {
 	config.AddAdminAccountPassword(adminAccountPasswordCmd)
 	adminAccountCmd.AddCommand(adminAccountPasswordCmd)
 
+	adminAccountResetPasswordCmd := &cobra.Command{
+		Use:   "reset-password",
+		Short: "replace the password of a local account with a new random one, and sign it out everywhere",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.ResetPassword)
+		},
+	}
+	config.AddAdminAccount(adminAccountResetPasswordCmd)
+	adminAccountCmd.AddCommand(adminAccountResetPasswordCmd)
+
+	adminAccountPurgeCmd := &cobra.Command{
+		Use:   "purge",
+		Short: "remove a remote account's cached media from storage, and have it refetched next time it's needed",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), account.Purge)
+		},
+	}
+	config.AddAdminAccountPurge(adminAccountPurgeCmd)
+	adminAccountCmd.AddCommand(adminAccountPurgeCmd)
+
 	adminCmd.AddCommand(adminAccountCmd)
 
 	/*
@@ -165,6 +246,54 @@ func adminCommands() *cobra.Command {
 	config.AddAdminTrans(adminImportCmd)
 	adminCmd.AddCommand(adminImportCmd)
 
+	/*
+		ADMIN DB COMMANDS
+	*/
+
+	adminDBCmd := &cobra.Command{
+		Use:   "db",
+		Short: "admin commands related to the database",
+	}
+
+	adminDBCheckCmd := &cobra.Command{
+		Use:   "check",
+		Short: "check the database for integrity violations, such as statuses referring to missing accounts or media",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), db.Check)
+		},
+	}
+	config.AddAdminDBCheck(adminDBCheckCmd)
+	adminDBCmd.AddCommand(adminDBCheckCmd)
+
+	adminDBRecountStatsCmd := &cobra.Command{
+		Use:   "recount-stats",
+		Short: "recount the stored follower, following and status counts of accounts, fixing any which have drifted",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), db.RecountStats)
+		},
+	}
+	adminDBCmd.AddCommand(adminDBRecountStatsCmd)
+
+	adminDBPreflightCmd := &cobra.Command{
+		Use:   "preflight",
+		Short: "report pending database migrations, the sizes of the tables they change and the locks they take, and pending background backfills, without running any of them",
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return preRun(preRunArgs{cmd: cmd})
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(cmd.Context(), db.Preflight)
+		},
+	}
+	adminDBCmd.AddCommand(adminDBPreflightCmd)
+
+	adminCmd.AddCommand(adminDBCmd)
+
 	/*
 		ADMIN MEDIA COMMANDS
 	*/
@@ -69,6 +69,16 @@ func run(ctx context.Context, action action.GTSAction) error {
 		return fmt.Errorf("error parsing log level: %w", err)
 	}
 
+	// Set any per-subsystem log level overrides
+	if err := log.ParseSubsystemLevels(config.GetLogLevels()); err != nil {
+		return fmt.Errorf("error parsing log levels: %w", err)
+	}
+
+	// Set the log output format
+	if err := log.ParseFormat(config.GetLogFormat()); err != nil {
+		return fmt.Errorf("error parsing log format: %w", err)
+	}
+
 	if config.GetSyslogEnabled() {
 		// Enable logging to syslog
 		if err := log.EnableSyslog(
@@ -22,6 +22,7 @@ import (
 	"os"
 	godebug "runtime/debug"
 	"strings"
+	_ "time/tzdata" // user time zones, even where the system has no tz database
 
 	"codeberg.org/gruf/go-debug"
 	"github.com/spf13/cobra"
@@ -77,6 +77,13 @@ const (
 	// See https://www.w3.org/TR/activitystreams-vocabulary/#microsyntaxes
 	// and https://www.w3.org/TR/activitystreams-vocabulary/#dfn-tag
 	TagHashtag = "Hashtag"
+
+	// EmojiReact is not in the AS spec, but is used by Pleroma
+	// and Akkoma to federate emoji reactions to a post. It is
+	// shaped exactly like a Like activity with content set.
+	//
+	// See https://docs.akkoma.dev/stable/development/ap_extensions/#emojireact
+	ActivityEmojiReact = "EmojiReact"
 )
 
 // isActivity returns whether AS type name is of an Activity (NOT IntransitiveActivity).
@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+import (
+	"net/url"
+)
+
+// alsoKnownAsKey is the JSON key of the alsoKnownAs
+// property on actors. It's not part of the vocabulary
+// supported by our activitystreams library, so it has
+// to be handled as an "unknown" property instead.
+const alsoKnownAsKey = "alsoKnownAs"
+
+// ExtractAlsoKnownAsURIs extracts the alsoKnownAs
+// URIs of an actor, if set. Values that don't parse
+// as absolute URIs are skipped.
+//
+// The property may be given as a single IRI, an array of
+// IRIs, or (rarely) an array of objects with an "id".
+func ExtractAlsoKnownAsURIs(i WithUnknownProperties) []*url.URL {
+	raw, ok := i.GetUnknownProperties()[alsoKnownAsKey]
+	if !ok {
+		return nil
+	}
+
+	var values []interface{}
+	switch v := raw.(type) {
+	case []interface{}:
+		values = v
+	default:
+		values = []interface{}{v}
+	}
+
+	uris := make([]*url.URL, 0, len(values))
+	for _, value := range values {
+		var uriStr string
+
+		switch v := value.(type) {
+		case string:
+			uriStr = v
+		case map[string]interface{}:
+			uriStr, _ = v["id"].(string)
+		}
+
+		if uriStr == "" {
+			continue
+		}
+
+		uri, err := url.Parse(uriStr)
+		if err != nil || !uri.IsAbs() {
+			continue
+		}
+
+		uris = append(uris, uri)
+	}
+
+	return uris
+}
+
+// SetAlsoKnownAsURIs sets the alsoKnownAs property
+// of an actor to the given URIs, always as an array.
+// If uris is empty, the property is removed entirely.
+func SetAlsoKnownAsURIs(i WithUnknownProperties, uris []string) {
+	props := i.GetUnknownProperties()
+
+	if len(uris) == 0 {
+		delete(props, alsoKnownAsKey)
+		return
+	}
+
+	values := make([]interface{}, len(uris))
+	for i, uri := range uris {
+		values[i] = uri
+	}
+
+	props[alsoKnownAsKey] = values
+}
@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+import (
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// ExtractAnnounceObjectURI extracts the URI of the
+// object (ie., the boosted status) of an Announce.
+//
+// Groups (eg., Lemmy communities and Friendica forums)
+// distribute the posts of their members by Announcing
+// the Create activity of each post, rather than the
+// post itself. In this case, the URI of the object of
+// the embedded Create is returned.
+func ExtractAnnounceObjectURI(announceable Announceable) (*url.URL, error) {
+	objectProp := announceable.GetActivityStreamsObject()
+	if objectProp == nil {
+		return nil, gtserror.New("object property was nil")
+	}
+
+	for iter := objectProp.Begin(); iter != objectProp.End(); iter = iter.Next() {
+		if create, ok := iter.GetType().(vocab.ActivityStreamsCreate); ok {
+			// Embedded Create, use
+			// the object's URI instead.
+			return ExtractObjectURI(create)
+		}
+
+		id, err := pub.ToId(iter)
+		if err == nil {
+			// Found one we can use.
+			return id, nil
+		}
+	}
+
+	return nil, gtserror.New("no iri found for object prop")
+}
+
+// IsAnnouncedActivity returns whether the object of the
+// given Announce is an embedded activity other than Create.
+//
+// As well as posts, groups also Announce other activities
+// of their members to group followers, like Likes, Updates
+// and Deletes. These are not boosts, and we can't trust
+// their contents without fetching them from the origin,
+// so they should be ignored rather than treated as boosts.
+func IsAnnouncedActivity(announceable Announceable) bool {
+	objectProp := announceable.GetActivityStreamsObject()
+	if objectProp == nil {
+		return false
+	}
+
+	for iter := objectProp.Begin(); iter != objectProp.End(); iter = iter.Next() {
+		t := iter.GetType()
+		if t == nil {
+			continue
+		}
+
+		typeName := t.GetTypeName()
+		if typeName != ActivityCreate && isActivity(typeName) {
+			return true
+		}
+	}
+
+	return false
+}
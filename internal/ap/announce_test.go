@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type AnnounceTestSuite struct {
+	APTestSuite
+}
+
+func (suite *AnnounceTestSuite) announceable(in string) ap.Announceable {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(in), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	announceable, ok := t.(ap.Announceable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	return announceable
+}
+
+func (suite *AnnounceTestSuite) TestAnnounceStatusURI() {
+	announce := suite.announceable(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/users/someone/statuses/1/activity",
+  "type": "Announce",
+  "actor": "https://example.org/users/someone",
+  "object": "https://example.org/users/someone_else/statuses/2"
+}`)
+
+	uri, err := ap.ExtractAnnounceObjectURI(announce)
+	suite.NoError(err)
+	suite.Equal("https://example.org/users/someone_else/statuses/2", uri.String())
+	suite.False(ap.IsAnnouncedActivity(announce))
+}
+
+func (suite *AnnounceTestSuite) TestAnnounceGroupCreate() {
+	announce := suite.announceable(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://lemmy.example.org/activities/announce/create/1",
+  "type": "Announce",
+  "actor": "https://lemmy.example.org/c/gotosocial",
+  "to": [
+    "https://www.w3.org/ns/activitystreams#Public"
+  ],
+  "cc": [
+    "https://lemmy.example.org/c/gotosocial/followers"
+  ],
+  "object": {
+    "id": "https://lemmy.example.org/activities/create/1",
+    "type": "Create",
+    "actor": "https://lemmy.example.org/u/someone",
+    "object": {
+      "id": "https://lemmy.example.org/post/1",
+      "type": "Page",
+      "attributedTo": "https://lemmy.example.org/u/someone",
+      "name": "Hello, community!"
+    }
+  }
+}`)
+
+	uri, err := ap.ExtractAnnounceObjectURI(announce)
+	suite.NoError(err)
+	suite.Equal("https://lemmy.example.org/post/1", uri.String())
+	suite.False(ap.IsAnnouncedActivity(announce))
+}
+
+func (suite *AnnounceTestSuite) TestAnnounceGroupLike() {
+	announce := suite.announceable(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://lemmy.example.org/activities/announce/like/1",
+  "type": "Announce",
+  "actor": "https://lemmy.example.org/c/gotosocial",
+  "object": {
+    "id": "https://lemmy.example.org/activities/like/1",
+    "type": "Like",
+    "actor": "https://lemmy.example.org/u/someone",
+    "object": "https://lemmy.example.org/post/1"
+  }
+}`)
+
+	suite.True(ap.IsAnnouncedActivity(announce))
+}
+
+func TestAnnounceTestSuite(t *testing.T) {
+	suite.Run(t, &AnnounceTestSuite{})
+}
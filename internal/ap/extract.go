@@ -252,6 +252,59 @@ func ExtractPublished(i WithPublished) (time.Time, error) {
 	return t, nil
 }
 
+// ExtractStartTime extracts the startTime from the given
+// WithStartTime, or a zero time if it's not set or not a
+// date time.
+func ExtractStartTime(i WithStartTime) time.Time {
+	startTimeProp := i.GetActivityStreamsStartTime()
+	if startTimeProp == nil || !startTimeProp.IsXMLSchemaDateTime() {
+		return time.Time{}
+	}
+	return startTimeProp.Get()
+}
+
+// ExtractEndTime extracts the endTime from the given
+// WithEndTime, or a zero time if it's not set or not a
+// date time.
+func ExtractEndTime(i WithEndTime) time.Time {
+	endTimeProp := i.GetActivityStreamsEndTime()
+	if endTimeProp == nil || !endTimeProp.IsXMLSchemaDateTime() {
+		return time.Time{}
+	}
+	return endTimeProp.Get()
+}
+
+// ExtractLocation extracts the name of the first location
+// object (usually a Place) which has a name, from the given
+// WithLocation. Coordinates and other location details are
+// deliberately not extracted, only the human-readable name.
+func ExtractLocation(i WithLocation) string {
+	locationProp := i.GetActivityStreamsLocation()
+	if locationProp == nil {
+		return ""
+	}
+
+	for iter := locationProp.Begin(); iter != locationProp.End(); iter = iter.Next() {
+		// Location may be just an IRI,
+		// which we don't dereference.
+		t := iter.GetType()
+		if t == nil {
+			continue
+		}
+
+		withName, ok := t.(WithName)
+		if !ok {
+			continue
+		}
+
+		if name := strings.TrimSpace(ExtractName(withName)); name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
+
 // ExtractIconURI extracts the first URI it can find from
 // the given WithIcon which links to a supported image file.
 // Input will look something like this:
@@ -1003,6 +1056,67 @@ func ExtractSharedInbox(withEndpoints WithEndpoints) *url.URL {
 	return nil
 }
 
+// ExtractTotalItems extracts the totalItems property of the given
+// collection (or collection page), returning false if it's not set.
+func ExtractTotalItems(i WithTotalItems) (int, bool) {
+	totalItemsProp := i.GetActivityStreamsTotalItems()
+	if totalItemsProp == nil || !totalItemsProp.IsXMLSchemaNonNegativeInteger() {
+		return 0, false
+	}
+
+	return totalItemsProp.Get(), true
+}
+
+// ExtractLikesTotal extracts the total number of likes of the given
+// item, from the totalItems of its likes collection if embedded. If
+// instead the collection is only referenced, its IRI is returned so
+// that it can be dereferenced for its total.
+func ExtractLikesTotal(i WithLikes) (int, *url.URL, bool) {
+	likesProp := i.GetActivityStreamsLikes()
+	if likesProp == nil {
+		return 0, nil, false
+	}
+
+	return extractCollectionTotal(likesProp.GetType(), likesProp.GetIRI())
+}
+
+// ExtractSharesTotal extracts the total number of shares of the given
+// item, from the totalItems of its shares collection if embedded. If
+// instead the collection is only referenced, its IRI is returned so
+// that it can be dereferenced for its total.
+func ExtractSharesTotal(i WithShares) (int, *url.URL, bool) {
+	sharesProp := i.GetActivityStreamsShares()
+	if sharesProp == nil {
+		return 0, nil, false
+	}
+
+	return extractCollectionTotal(sharesProp.GetType(), sharesProp.GetIRI())
+}
+
+// extractCollectionTotal extracts the totalItems of the given embedded
+// collection type, falling back to returning the IRI of the collection.
+func extractCollectionTotal(t vocab.Type, iri *url.URL) (int, *url.URL, bool) {
+	if t == nil {
+		// Only referenced
+		// by IRI (or unset).
+		return 0, iri, false
+	}
+
+	if withTotalItems, ok := t.(WithTotalItems); ok {
+		if total, ok := ExtractTotalItems(withTotalItems); ok {
+			return total, nil, true
+		}
+	}
+
+	// Embedded without a total,
+	// try the collection's own ID.
+	if idProp := t.GetJSONLDId(); idProp != nil && idProp.IsIRI() {
+		return 0, idProp.GetIRI(), false
+	}
+
+	return 0, nil, false
+}
+
 // IterateOneOf will attempt to extract oneOf property from given interface, and passes each iterated item to function.
 func IterateOneOf(withOneOf WithOneOf, foreach func(vocab.ActivityStreamsOneOfPropertyIterator)) {
 	if foreach == nil {
@@ -0,0 +1,116 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type ExtractCountsTestSuite struct {
+	APTestSuite
+}
+
+func (suite *ExtractCountsTestSuite) TestExtractLikesSharesTotal() {
+	for _, test := range []struct {
+		counts      string
+		likes       int
+		likesOK     bool
+		likesIRI    string
+		shares      int
+		sharesOK    bool
+		sharesIRI   string
+		description string
+	}{
+		{
+			description: "no collections",
+		},
+		{
+			description: "embedded collections with totals",
+			counts: `"likes": {
+    "id": "https://example.org/notes/1/likes",
+    "type": "Collection",
+    "totalItems": 12
+  },
+  "shares": {
+    "id": "https://example.org/notes/1/shares",
+    "type": "OrderedCollection",
+    "totalItems": 3
+  },`,
+			likes:    12,
+			likesOK:  true,
+			shares:   3,
+			sharesOK: true,
+		},
+		{
+			description: "collections only by IRI",
+			counts: `"likes": "https://example.org/notes/1/likes",
+  "shares": "https://example.org/notes/1/shares",`,
+			likesIRI:  "https://example.org/notes/1/likes",
+			sharesIRI: "https://example.org/notes/1/shares",
+		},
+		{
+			description: "embedded collection without total",
+			counts: `"likes": {
+    "id": "https://example.org/notes/1/likes",
+    "type": "Collection"
+  },`,
+			likesIRI: "https://example.org/notes/1/likes",
+		},
+	} {
+		b := []byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/notes/1",
+  "type": "Note",
+  ` + test.counts + `
+  "content": "hello"
+}`)
+
+		statusable, err := ap.ResolveStatusable(context.Background(), b)
+		if !suite.NoError(err, test.description) {
+			continue
+		}
+
+		likes, likesIRI, ok := ap.ExtractLikesTotal(statusable.(ap.WithLikes))
+		suite.Equal(test.likesOK, ok, test.description)
+		suite.Equal(test.likes, likes, test.description)
+		suite.Equal(test.likesIRI, iriString(likesIRI), test.description)
+
+		shares, sharesIRI, ok := ap.ExtractSharesTotal(statusable.(ap.WithShares))
+		suite.Equal(test.sharesOK, ok, test.description)
+		suite.Equal(test.shares, shares, test.description)
+		suite.Equal(test.sharesIRI, iriString(sharesIRI), test.description)
+	}
+}
+
+// iriString returns the string of
+// given IRI, or empty string if nil.
+func iriString(iri *url.URL) string {
+	if iri == nil {
+		return ""
+	}
+	return iri.String()
+}
+
+func TestExtractCountsTestSuite(t *testing.T) {
+	suite.Run(t, &ExtractCountsTestSuite{})
+}
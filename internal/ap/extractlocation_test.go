@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type ExtractLocationTestSuite struct {
+	APTestSuite
+}
+
+func (suite *ExtractLocationTestSuite) TestExtractLocation() {
+	for _, test := range []struct {
+		location string
+		expected string
+	}{
+		{
+			// No location.
+			location: ``,
+			expected: "",
+		},
+		{
+			// Just an IRI, not dereferenced.
+			location: `"location": "https://example.org/places/1",`,
+			expected: "",
+		},
+		{
+			// Place with coordinates,
+			// only the name is kept.
+			location: `"location": {
+    "type": "Place",
+    "name": " Amsterdam Centraal ",
+    "latitude": 52.3791,
+    "longitude": 4.9003
+  },`,
+			expected: "Amsterdam Centraal",
+		},
+		{
+			// First named location wins.
+			location: `"location": [
+    {"type": "Place"},
+    {"type": "Place", "name": "Utrecht"}
+  ],`,
+			expected: "Utrecht",
+		},
+	} {
+		b := []byte(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/notes/1",
+  "type": "Note",
+  ` + test.location + `
+  "content": "hello"
+}`)
+
+		statusable, err := ap.ResolveStatusable(context.Background(), b)
+		if !suite.NoError(err) {
+			continue
+		}
+
+		suite.Equal(test.expected, ap.ExtractLocation(statusable))
+	}
+}
+
+func TestExtractLocationTestSuite(t *testing.T) {
+	suite.Run(t, &ExtractLocationTestSuite{})
+}
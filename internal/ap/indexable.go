@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+// indexableKey is the JSON key of the indexable
+// property on actors, used by Mastodon to indicate
+// whether an account's posts may be found by search.
+// Like alsoKnownAs, it's not part of the vocabulary
+// supported by our activitystreams library.
+const indexableKey = "indexable"
+
+// ExtractIndexable extracts the indexable boolean of an
+// actor. The second return value is false if the property
+// wasn't set, or wasn't a boolean, in which case callers
+// should not assume either way.
+func ExtractIndexable(i WithUnknownProperties) (bool, bool) {
+	raw, ok := i.GetUnknownProperties()[indexableKey]
+	if !ok {
+		return false, false
+	}
+
+	indexable, ok := raw.(bool)
+	return indexable, ok
+}
+
+// SetIndexable sets the indexable property of an actor.
+func SetIndexable(i WithUnknownProperties, indexable bool) {
+	i.GetUnknownProperties()[indexableKey] = indexable
+}
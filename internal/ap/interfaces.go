@@ -88,6 +88,20 @@ func ToPollable(t vocab.Type) (Pollable, bool) {
 	return pollable, true
 }
 
+// IsEventable returns whether AS vocab type name is acceptable as Eventable.
+func IsEventable(typeName string) bool {
+	return typeName == ObjectEvent
+}
+
+// ToEventable safely tries to cast vocab.Type as Eventable, also checking for expected AS type names.
+func ToEventable(t vocab.Type) (Eventable, bool) {
+	eventable, ok := t.(Eventable)
+	if !ok || !IsEventable(t.GetTypeName()) {
+		return nil, false
+	}
+	return eventable, true
+}
+
 // Accountable represents the minimum activitypub interface for representing an 'account'.
 // (see: IsAccountable() for types implementing this, though you MUST make sure to check
 // the typeName as this bare interface may be implementable by non-Accountable types).
@@ -111,6 +125,7 @@ type Accountable interface {
 	WithManuallyApprovesFollowers
 	WithEndpoints
 	WithTag
+	WithUnknownProperties
 }
 
 // Statusable represents the minimum activitypub interface for representing a 'status'.
@@ -133,6 +148,8 @@ type Statusable interface {
 	WithAttachment
 	WithTag
 	WithReplies
+	WithLocation
+	WithUnknownProperties
 }
 
 // Pollable represents the minimum activitypub interface for representing a 'poll' (it's a subset of a status).
@@ -149,6 +166,17 @@ type Pollable interface {
 	Statusable
 }
 
+// Eventable represents the minimum activitypub interface for representing an 'event' (it's a subset of a status).
+// (see: IsEventable() for types implementing this, though you MUST make sure to check
+// the typeName as this bare interface may be implementable by non-Eventable types).
+type Eventable interface {
+	WithStartTime
+	WithEndTime
+
+	// base-interface
+	Statusable
+}
+
 // PollOptionable represents the minimum activitypub interface for representing a poll 'option'.
 // (see: IsPollOptionable() for types implementing this).
 type PollOptionable interface {
@@ -207,6 +235,16 @@ type Likeable interface {
 	WithObject
 }
 
+// Reactable represents the minimum interface for an activitystreams 'like'
+// activity carrying an emoji reaction in its content, as sent by Misskey,
+// or for a Pleroma / Akkoma 'EmojiReact' activity normalized to a 'like'.
+type Reactable interface {
+	Likeable
+
+	WithContent
+	WithTag
+}
+
 // Blockable represents the minimum interface for an activitystreams 'block' activity.
 type Blockable interface {
 	WithJSONLDId
@@ -431,6 +469,24 @@ type WithReplies interface {
 	SetActivityStreamsReplies(vocab.ActivityStreamsRepliesProperty)
 }
 
+// WithLikes represents an activity with ActivityStreamsLikesProperty
+type WithLikes interface {
+	GetActivityStreamsLikes() vocab.ActivityStreamsLikesProperty
+	SetActivityStreamsLikes(vocab.ActivityStreamsLikesProperty)
+}
+
+// WithShares represents an activity with ActivityStreamsSharesProperty
+type WithShares interface {
+	GetActivityStreamsShares() vocab.ActivityStreamsSharesProperty
+	SetActivityStreamsShares(vocab.ActivityStreamsSharesProperty)
+}
+
+// WithTotalItems represents an activity with ActivityStreamsTotalItemsProperty
+type WithTotalItems interface {
+	GetActivityStreamsTotalItems() vocab.ActivityStreamsTotalItemsProperty
+	SetActivityStreamsTotalItems(vocab.ActivityStreamsTotalItemsProperty)
+}
+
 // WithMediaType represents an activity with ActivityStreamsMediaTypeProperty
 type WithMediaType interface {
 	GetActivityStreamsMediaType() vocab.ActivityStreamsMediaTypeProperty
@@ -513,6 +569,18 @@ type WithAnyOf interface {
 	SetActivityStreamsAnyOf(vocab.ActivityStreamsAnyOfProperty)
 }
 
+// WithLocation represents an activity with the location property.
+type WithLocation interface {
+	GetActivityStreamsLocation() vocab.ActivityStreamsLocationProperty
+	SetActivityStreamsLocation(vocab.ActivityStreamsLocationProperty)
+}
+
+// WithStartTime represents an activity with the startTime property.
+type WithStartTime interface {
+	GetActivityStreamsStartTime() vocab.ActivityStreamsStartTimeProperty
+	SetActivityStreamsStartTime(vocab.ActivityStreamsStartTimeProperty)
+}
+
 // WithEndTime represents an activity with the endTime property.
 type WithEndTime interface {
 	GetActivityStreamsEndTime() vocab.ActivityStreamsEndTimeProperty
@@ -530,3 +598,9 @@ type WithVotersCount interface {
 	GetTootVotersCount() vocab.TootVotersCountProperty
 	SetTootVotersCount(vocab.TootVotersCountProperty)
 }
+
+// WithUnknownProperties represents a type with properties that
+// aren't (yet) part of the vocabulary, eg., alsoKnownAs.
+type WithUnknownProperties interface {
+	GetUnknownProperties() map[string]interface{}
+}
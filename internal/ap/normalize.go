@@ -84,6 +84,24 @@ func NormalizeIncomingActivity(activity pub.Activity, rawJSON map[string]interfa
 	}
 }
 
+// NormalizeIncomingEmojiReact rewrites the type of an 'EmojiReact'
+// activity in the given raw json object map to 'Like', so that it
+// can be parsed by go-fed; the content and tag of the reaction are
+// left intact. EmojiReact activities nested as the object of an
+// 'Undo' are rewritten in the same way.
+func NormalizeIncomingEmojiReact(rawJSON map[string]interface{}) {
+	if typ, ok := rawJSON["type"].(string); ok && typ == ActivityEmojiReact {
+		rawJSON["type"] = ActivityLike
+		return
+	}
+
+	if object, ok := rawJSON["object"].(map[string]interface{}); ok {
+		if typ, ok := object["type"].(string); ok && typ == ActivityEmojiReact {
+			object["type"] = ActivityLike
+		}
+	}
+}
+
 // NormalizeIncomingContent replaces the Content of the given item
 // with the sanitized version of the raw 'content' value from the
 // raw json object map.
@@ -110,7 +128,11 @@ func NormalizeIncomingContent(item WithContent, rawJSON map[string]interface{})
 	//
 	// TODO: sanitize differently based on mediaType.
 	// https://www.w3.org/TR/activitystreams-vocabulary/#dfn-mediatype
-	content = text.SanitizeToHTML(content)
+	//
+	// Convert any Misskey (MFM) function markup
+	// before sanitizing, so the result is sanitized.
+	content = text.ConvertMFM(content)
+	content = text.SanitizeRemoteHTML(content)
 	content = text.MinifyHTML(content)
 
 	// Set normalized content property from the raw string;
@@ -201,7 +223,7 @@ func NormalizeIncomingSummary(item WithSummary, rawJSON map[string]interface{})
 
 	// Summary should be HTML encoded:
 	// https://www.w3.org/TR/activitystreams-vocabulary/#dfn-summary
-	summary = text.SanitizeToHTML(summary)
+	summary = text.SanitizeRemoteHTML(summary)
 	summary = text.MinifyHTML(summary)
 
 	// Set normalized summary property from the raw string; this
@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap
+
+import (
+	"net/url"
+)
+
+// quoteURIKeys are the JSON keys used by various
+// implementations to indicate the status that a
+// status quotes, in order of preference. None of
+// them are part of the vocabulary supported by our
+// activitystreams library, so they have to be
+// handled as "unknown" properties instead.
+//
+//   - quoteUri: Fedibird, Misskey.
+//   - quoteUrl / quoteURL: Akkoma, Pleroma, Misskey.
+//   - _misskey_quote: Misskey.
+var quoteURIKeys = []string{
+	"quoteUri",
+	"quoteUrl",
+	"quoteURL",
+	"_misskey_quote",
+}
+
+// ExtractQuoteURI extracts the URI of the status
+// quoted by the given status, if set. If none of the
+// known quote properties are set to an absolute URI,
+// nil will be returned.
+func ExtractQuoteURI(i WithUnknownProperties) *url.URL {
+	props := i.GetUnknownProperties()
+
+	for _, key := range quoteURIKeys {
+		uriStr, ok := props[key].(string)
+		if !ok || uriStr == "" {
+			continue
+		}
+
+		uri, err := url.Parse(uriStr)
+		if err != nil || !uri.IsAbs() {
+			continue
+		}
+
+		return uri
+	}
+
+	return nil
+}
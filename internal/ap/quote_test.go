@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ap_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+)
+
+type QuoteTestSuite struct {
+	APTestSuite
+}
+
+func (suite *QuoteTestSuite) TestExtractQuoteURI() {
+	for _, test := range []struct {
+		props map[string]interface{}
+		uri   string
+	}{
+		{
+			props: map[string]interface{}{},
+			uri:   "",
+		},
+		{
+			props: map[string]interface{}{"_misskey_quote": "https://example.org/notes/1"},
+			uri:   "https://example.org/notes/1",
+		},
+		{
+			props: map[string]interface{}{"quoteUrl": "https://example.org/objects/2"},
+			uri:   "https://example.org/objects/2",
+		},
+		{
+			props: map[string]interface{}{
+				"quoteUri":       "not a uri",
+				"_misskey_quote": "https://example.org/notes/3",
+			},
+			uri: "https://example.org/notes/3",
+		},
+	} {
+		note := streams.NewActivityStreamsNote()
+		for k, v := range test.props {
+			note.GetUnknownProperties()[k] = v
+		}
+
+		uri := ap.ExtractQuoteURI(note)
+		if test.uri == "" {
+			suite.Nil(uri)
+			continue
+		}
+
+		if suite.NotNil(uri) {
+			suite.Equal(test.uri, uri.String())
+		}
+	}
+}
+
+func TestQuoteTestSuite(t *testing.T) {
+	suite.Run(t, &QuoteTestSuite{})
+}
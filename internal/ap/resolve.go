@@ -71,6 +71,10 @@ func ResolveIncomingActivity(r *http.Request) (pub.Activity, gtserror.WithCode)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	// Rewrite any EmojiReact types to Like,
+	// as go-fed doesn't know how to parse them.
+	NormalizeIncomingEmojiReact(raw)
+
 	// Resolve "raw" JSON to vocab.Type.
 	t, err := streams.ToType(r.Context(), raw)
 	if err != nil {
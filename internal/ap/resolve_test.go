@@ -19,6 +19,9 @@ package ap_test
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -47,6 +50,27 @@ func (suite *ResolveTestSuite) TestResolveDocumentAsAccountable() {
 	suite.Nil(accountable)
 }
 
+func (suite *ResolveTestSuite) TestResolveIncomingEmojiReact() {
+	const body = `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "https://example.org/activities/01HD9YQ6ZJ8KXM2V5S0T3W7B4C",
+  "type": "EmojiReact",
+  "actor": "https://example.org/users/someone",
+  "object": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+  "content": "🐢"
+}`
+
+	r := httptest.NewRequest(http.MethodPost, "http://localhost:8080/users/the_mighty_zork/inbox", strings.NewReader(body))
+
+	activity, errWithCode := ap.ResolveIncomingActivity(r)
+	suite.NoError(errWithCode)
+
+	like, ok := activity.(ap.Reactable)
+	suite.True(ok)
+	suite.Equal(ap.ActivityLike, like.GetTypeName())
+	suite.Equal("🐢", ap.ExtractContent(like))
+}
+
 func TestResolveTestSuite(t *testing.T) {
 	suite.Run(t, &ResolveTestSuite{})
 }
@@ -38,6 +38,7 @@ import (
 //   - OrderedCollection: 'orderedItems' property will always be made into an array.
 //   - Any Accountable type: 'attachment' property will always be made into an array.
 //   - Update: any Accountable 'object's set on an update will be custom serialized as above.
+//   - Like: any 'content' set on the like (ie., an emoji reaction) will also be set as '_misskey_reaction'.
 func Serialize(t vocab.Type) (m map[string]interface{}, e error) {
 	switch t.GetTypeName() {
 	case ObjectOrderedCollection:
@@ -46,6 +47,8 @@ func Serialize(t vocab.Type) (m map[string]interface{}, e error) {
 		return serializeAccountable(t, true)
 	case ActivityUpdate:
 		return serializeWithObject(t)
+	case ActivityLike:
+		return serializeLike(t)
 	default:
 		// No custom serializer necessary.
 		return streams.Serialize(t)
@@ -132,6 +135,27 @@ func serializeAccountable(accountable vocab.Type, includeContext bool) (map[stri
 	return data, nil
 }
 
+// serializeLike is a custom serializer for an ActivityStreamsLike.
+// If the like has content set (ie., it's an emoji reaction), then
+// the content is also set as '_misskey_reaction', since Misskey
+// looks for this property instead of 'content' on incoming likes.
+func serializeLike(like vocab.Type) (map[string]interface{}, error) {
+	data, err := streams.Serialize(like)
+	if err != nil {
+		return nil, err
+	}
+
+	content, ok := data["content"].(string)
+	if !ok || content == "" {
+		// No reaction, nothing to add.
+		return data, nil
+	}
+
+	data["_misskey_reaction"] = content
+
+	return data, nil
+}
+
 func serializeWithObject(t vocab.Type) (map[string]interface{}, error) {
 	withObject, ok := t.(WithObject)
 	if !ok {
@@ -47,10 +47,14 @@ const (
 	OutboxPath = BasePath + "/" + uris.OutboxPath
 	// FollowersPath is for serving GET request's to a user's followers list, with the given username key.
 	FollowersPath = BasePath + "/" + uris.FollowersPath
+	// FollowersSyncPath is for serving GET requests to a user's partial followers collection, for FEP-8fcf followers synchronization.
+	FollowersSyncPath = BasePath + "/" + uris.FollowersSyncPath
 	// FollowingPath is for serving GET request's to a user's following list, with the given username key.
 	FollowingPath = BasePath + "/" + uris.FollowingPath
 	// FeaturedCollectionPath is for serving GET requests to a user's list of featured (pinned) statuses.
 	FeaturedCollectionPath = BasePath + "/" + uris.CollectionsPath + "/" + uris.FeaturedPath
+	// FeaturedTagsCollectionPath is for serving GET requests to a user's list of featured hashtags.
+	FeaturedTagsCollectionPath = BasePath + "/" + uris.CollectionsPath + "/" + uris.TagsPath
 	// StatusPath is for serving GET requests to a particular status by a user, with the given username key and status ID
 	StatusPath = BasePath + "/" + uris.StatusesPath + "/:" + StatusIDKey
 	// StatusRepliesPath is for serving the replies collection of a status.
@@ -71,8 +75,10 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.UsersGETHandler)
 	attachHandler(http.MethodPost, InboxPath, m.InboxPOSTHandler)
 	attachHandler(http.MethodGet, FollowersPath, m.FollowersGETHandler)
+	attachHandler(http.MethodGet, FollowersSyncPath, m.FollowersSyncGETHandler)
 	attachHandler(http.MethodGet, FollowingPath, m.FollowingGETHandler)
 	attachHandler(http.MethodGet, FeaturedCollectionPath, m.FeaturedCollectionGETHandler)
+	attachHandler(http.MethodGet, FeaturedTagsCollectionPath, m.FeaturedTagsCollectionGETHandler)
 	attachHandler(http.MethodGet, StatusPath, m.StatusGETHandler)
 	attachHandler(http.MethodGet, StatusRepliesPath, m.StatusRepliesGETHandler)
 	attachHandler(http.MethodGet, OutboxPath, m.OutboxGETHandler)
@@ -148,6 +148,7 @@ func (m *Module) AuthorizeGETHandler(c *gin.Context) {
 	// about the app that's trying to authorize, and the scope of the request.
 	// They can then approve it if it looks OK to them, which will POST to the AuthorizePOSTHandler
 	c.HTML(http.StatusOK, "authorize.tmpl", gin.H{
+		"i18n":       apiutil.TemplateLocalizer(c, user.Locale),
 		"appname":    app.Name,
 		"appwebsite": app.Website,
 		"redirect":   redirect,
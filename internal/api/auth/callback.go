@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"net/netip"
 	"strings"
 
 	"github.com/gin-contrib/sessions"
@@ -144,6 +145,7 @@ func (m *Module) CallbackGETHandler(c *gin.Context) {
 			return
 		}
 		c.HTML(http.StatusOK, "finalize.tmpl", gin.H{
+			"i18n":              apiutil.TemplateLocalizer(c),
 			"instance":          instance,
 			"name":              claims.Name,
 			"preferredUsername": claims.PreferredUsername,
@@ -178,6 +180,7 @@ func (m *Module) FinalizePOSTHandler(c *gin.Context) {
 			return
 		}
 		c.HTML(http.StatusOK, "finalize.tmpl", gin.H{
+			"i18n":              apiutil.TemplateLocalizer(c),
 			"instance":          instance,
 			"name":              form.Name,
 			"preferredUsername": form.Username,
@@ -272,6 +275,34 @@ func (m *Module) fetchUserForClaims(ctx context.Context, claims *oidc.Claims, ip
 }
 
 func (m *Module) createUserFromOIDC(ctx context.Context, claims *oidc.Claims, extraInfo *extraInfo, ip net.IP, appID string) (*gtsmodel.User, gtserror.WithCode) {
+	// Check if sign-ups from this IP are blocked.
+	if addr, ok := netip.AddrFromSlice(ip); ok {
+		block, err := m.db.MatchIPBlock(ctx, addr.Unmap())
+		if err != nil {
+			err := gtserror.Newf("db error matching ip blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if block != nil {
+			const text = "sign-ups from your IP address are not allowed"
+			err := fmt.Errorf("ip %s matched ip block %s", addr, block.ID)
+			return nil, gtserror.NewErrorForbidden(err, text)
+		}
+	}
+
+	// Check if the claimed email address is at a blocked domain.
+	_, domain, _ := strings.Cut(claims.Email, "@")
+	blocked, err := m.db.IsEmailDomainBlocked(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("db error checking email domain block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		err := fmt.Errorf("sign-ups using email addresses at %s are not allowed", domain)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
 	// Check if the claimed email address is available for use.
 	emailAvailable, err := m.db.IsEmailAvailable(ctx, claims.Email)
 	if err != nil {
@@ -102,6 +102,7 @@ func (m *Module) OobHandler(c *gin.Context) {
 	m.clearSession(s)
 
 	c.HTML(http.StatusOK, "oob.tmpl", gin.H{
+		"i18n":     apiutil.TemplateLocalizer(c, user.Locale),
 		"instance": instance,
 		"user":     acct.Username,
 		"oobToken": oobToken,
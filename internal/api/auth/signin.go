@@ -57,6 +57,7 @@ func (m *Module) SignInGETHandler(c *gin.Context) {
 
 		// no idp provider, use our own funky little sign in page
 		c.HTML(http.StatusOK, "sign-in.tmpl", gin.H{
+			"i18n":     apiutil.TemplateLocalizer(c),
 			"instance": instance,
 		})
 		return
@@ -19,9 +19,11 @@ package auth
 
 import (
 	"net/http"
+	"net/netip"
 	"net/url"
 
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 
@@ -35,6 +37,11 @@ type tokenRequestForm struct {
 	ClientID     *string `form:"client_id" json:"client_id" xml:"client_id"`
 	ClientSecret *string `form:"client_secret" json:"client_secret" xml:"client_secret"`
 	Scope        *string `form:"scope" json:"scope" xml:"scope"`
+
+	// Response token from the sign-up challenge widget, only used
+	// for client_credentials grants when accounts-challenge-app-tokens
+	// is set, since those tokens are needed to sign up through the API.
+	ChallengeResponse *string `form:"challenge_response" json:"challenge_response" xml:"challenge_response"`
 }
 
 // TokenPOSTHandler should be served as a POST at https://example.org/oauth/token
@@ -102,6 +109,26 @@ func (m *Module) TokenPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if grantType == "client_credentials" && config.GetAccountsChallengeAppTokens() {
+		var challengeResponse string
+		if form.ChallengeResponse != nil {
+			challengeResponse = *form.ChallengeResponse
+		}
+
+		// Ignore parse error, the challenge
+		// provider is simply not told the IP.
+		remoteIP, _ := netip.ParseAddr(c.ClientIP())
+
+		if errWithCode := m.processor.OAuthVerifyChallenge(
+			c.Request.Context(),
+			challengeResponse,
+			remoteIP.Unmap(),
+		); errWithCode != nil {
+			apiutil.OAuthErrorHandler(c, errWithCode)
+			return
+		}
+	}
+
 	token, errWithCode := m.processor.OAuthHandleTokenRequest(c.Request)
 	if errWithCode != nil {
 		apiutil.OAuthErrorHandler(c, errWithCode)
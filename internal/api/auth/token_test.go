@@ -22,11 +22,13 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"net/netip"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -209,6 +211,70 @@ func (suite *TokenTestSuite) TestRetrieveAuthorizationCodeWrongGrantType() {
 	suite.Equal(`{"error":"invalid_request","error_description":"Bad Request: a code was provided in the token request form, but grant_type was not set to authorization_code"}`, string(b))
 }
 
+// fakeChallenge passes responses equal to "passed".
+type fakeChallenge struct{}
+
+func (fakeChallenge) Provider() string { return "fake" }
+
+func (fakeChallenge) SiteKey() string { return "fake-site-key" }
+
+func (fakeChallenge) Verify(_ context.Context, response string, _ netip.Addr) (bool, error) {
+	return response == "passed", nil
+}
+
+func (suite *TokenTestSuite) TestRetrieveClientCredentialsChallenge() {
+	suite.state.Challenge = fakeChallenge{}
+	config.SetAccountsChallengeAppTokens(true)
+	defer func() {
+		suite.state.Challenge = nil
+		config.SetAccountsChallengeAppTokens(false)
+	}()
+
+	testClient := suite.testClients["local_account_1"]
+
+	requestToken := func(challengeResponse string) (int, string) {
+		requestBody, w, err := testrig.CreateMultipartFormData(
+			"", "",
+			map[string]string{
+				"grant_type":         "client_credentials",
+				"client_id":          testClient.ID,
+				"client_secret":      testClient.Secret,
+				"redirect_uri":       "http://localhost:8080",
+				"challenge_response": challengeResponse,
+			})
+		if err != nil {
+			panic(err)
+		}
+		bodyBytes := requestBody.Bytes()
+
+		ctx, recorder := suite.newContext(http.MethodPost, "oauth/token", bodyBytes, w.FormDataContentType())
+		ctx.Request.Header.Set("accept", "application/json")
+
+		suite.authModule.TokenPOSTHandler(ctx)
+
+		result := recorder.Result()
+		defer result.Body.Close()
+
+		b, err := ioutil.ReadAll(result.Body)
+		suite.NoError(err)
+		return recorder.Code, string(b)
+	}
+
+	// No challenge response.
+	code, body := requestToken("")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"invalid_request","error_description":"Bad Request: challenge_response must be set"}`, body)
+
+	// Challenge failed.
+	code, body = requestToken("failed")
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"invalid_request","error_description":"Bad Request: sign-up challenge was not passed, please try again"}`, body)
+
+	// Challenge passed.
+	code, _ = requestToken("passed")
+	suite.Equal(http.StatusOK, code)
+}
+
 func TestTokenTestSuite(t *testing.T) {
 	suite.Run(t, &TokenTestSuite{})
 }
@@ -23,21 +23,27 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/announcements"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/apps"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/blocks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/bookmarks"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/customemojis"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/drafts"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/favourites"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/featuredtags"
 	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/firstinteractions"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/followrequests"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/instance"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/lists"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/markers"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/media"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/mutes"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/notifications"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/oembed"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/preferences"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/reports"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/savedsearches"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/search"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/streaming"
@@ -53,28 +59,34 @@ type Client struct {
 	processor *processing.Processor
 	db        db.DB
 
-	accounts       *accounts.Module       // api/v1/accounts
-	admin          *admin.Module          // api/v1/admin
-	apps           *apps.Module           // api/v1/apps
-	blocks         *blocks.Module         // api/v1/blocks
-	bookmarks      *bookmarks.Module      // api/v1/bookmarks
-	customEmojis   *customemojis.Module   // api/v1/custom_emojis
-	favourites     *favourites.Module     // api/v1/favourites
-	featuredTags   *featuredtags.Module   // api/v1/featured_tags
-	filters        *filter.Module         // api/v1/filters
-	followRequests *followrequests.Module // api/v1/follow_requests
-	instance       *instance.Module       // api/v1/instance
-	lists          *lists.Module          // api/v1/lists
-	markers        *markers.Module        // api/v1/markers
-	media          *media.Module          // api/v1/media, api/v2/media
-	notifications  *notifications.Module  // api/v1/notifications
-	preferences    *preferences.Module    // api/v1/preferences
-	reports        *reports.Module        // api/v1/reports
-	search         *search.Module         // api/v1/search, api/v2/search
-	statuses       *statuses.Module       // api/v1/statuses
-	streaming      *streaming.Module      // api/v1/streaming
-	timelines      *timelines.Module      // api/v1/timelines
-	user           *user.Module           // api/v1/user
+	accounts          *accounts.Module          // api/v1/accounts
+	admin             *admin.Module             // api/v1/admin
+	announcements     *announcements.Module     // api/v1/announcements
+	apps              *apps.Module              // api/v1/apps
+	blocks            *blocks.Module            // api/v1/blocks
+	bookmarks         *bookmarks.Module         // api/v1/bookmarks
+	customEmojis      *customemojis.Module      // api/v1/custom_emojis
+	drafts            *drafts.Module            // api/v1/drafts
+	favourites        *favourites.Module        // api/v1/favourites
+	featuredTags      *featuredtags.Module      // api/v1/featured_tags
+	filters           *filter.Module            // api/v1/filters
+	firstInteractions *firstinteractions.Module // api/v1/first_interactions
+	followRequests    *followrequests.Module    // api/v1/follow_requests
+	instance          *instance.Module          // api/v1/instance
+	lists             *lists.Module             // api/v1/lists
+	markers           *markers.Module           // api/v1/markers
+	media             *media.Module             // api/v1/media, api/v2/media
+	mutes             *mutes.Module             // api/v1/mutes
+	notifications     *notifications.Module     // api/v1/notifications
+	oEmbed            *oembed.Module            // api/oembed
+	preferences       *preferences.Module       // api/v1/preferences
+	reports           *reports.Module           // api/v1/reports
+	savedSearches     *savedsearches.Module     // api/v1/saved_searches
+	search            *search.Module            // api/v1/search, api/v2/search
+	statuses          *statuses.Module          // api/v1/statuses
+	streaming         *streaming.Module         // api/v1/streaming
+	timelines         *timelines.Module         // api/v1/timelines
+	user              *user.Module              // api/v1/user
 }
 
 func (c *Client) Route(r router.Router, m ...gin.HandlerFunc) {
@@ -96,21 +108,27 @@ func (c *Client) Route(r router.Router, m ...gin.HandlerFunc) {
 	h := apiGroup.Handle
 	c.accounts.Route(h)
 	c.admin.Route(h)
+	c.announcements.Route(h)
 	c.apps.Route(h)
 	c.blocks.Route(h)
 	c.bookmarks.Route(h)
 	c.customEmojis.Route(h)
+	c.drafts.Route(h)
 	c.favourites.Route(h)
 	c.featuredTags.Route(h)
 	c.filters.Route(h)
+	c.firstInteractions.Route(h)
 	c.followRequests.Route(h)
 	c.instance.Route(h)
 	c.lists.Route(h)
 	c.markers.Route(h)
 	c.media.Route(h)
+	c.mutes.Route(h)
 	c.notifications.Route(h)
+	c.oEmbed.Route(h)
 	c.preferences.Route(h)
 	c.reports.Route(h)
+	c.savedSearches.Route(h)
 	c.search.Route(h)
 	c.statuses.Route(h)
 	c.streaming.Route(h)
@@ -118,32 +136,55 @@ func (c *Client) Route(r router.Router, m ...gin.HandlerFunc) {
 	c.user.Route(h)
 }
 
+// RouteStreaming attaches the server-sent events streaming endpoints, which
+// require different middleware from other client api endpoints, since they
+// hold their request open for as long as the stream: no throttling or gzip.
+func (c *Client) RouteStreaming(r router.Router, m ...gin.HandlerFunc) {
+	apiGroup := r.AttachGroup("api")
+
+	apiGroup.Use(m...)
+	apiGroup.Use(
+		middleware.TokenCheck(c.db, c.processor.OAuthValidateBearerToken),
+		middleware.CacheControl(middleware.CacheControlConfig{
+			Directives: []string{"no-store"},
+		}),
+	)
+
+	c.streaming.RouteSSE(apiGroup.Handle)
+}
+
 func NewClient(db db.DB, p *processing.Processor) *Client {
 	return &Client{
 		processor: p,
 		db:        db,
 
-		accounts:       accounts.New(p),
-		admin:          admin.New(p),
-		apps:           apps.New(p),
-		blocks:         blocks.New(p),
-		bookmarks:      bookmarks.New(p),
-		customEmojis:   customemojis.New(p),
-		favourites:     favourites.New(p),
-		featuredTags:   featuredtags.New(p),
-		filters:        filter.New(p),
-		followRequests: followrequests.New(p),
-		instance:       instance.New(p),
-		lists:          lists.New(p),
-		markers:        markers.New(p),
-		media:          media.New(p),
-		notifications:  notifications.New(p),
-		preferences:    preferences.New(p),
-		reports:        reports.New(p),
-		search:         search.New(p),
-		statuses:       statuses.New(p),
-		streaming:      streaming.New(p, time.Second*30, 4096),
-		timelines:      timelines.New(p),
-		user:           user.New(p),
+		accounts:          accounts.New(p),
+		admin:             admin.New(p),
+		announcements:     announcements.New(p),
+		apps:              apps.New(p),
+		blocks:            blocks.New(p),
+		bookmarks:         bookmarks.New(p),
+		customEmojis:      customemojis.New(p),
+		drafts:            drafts.New(p),
+		favourites:        favourites.New(p),
+		featuredTags:      featuredtags.New(p),
+		filters:           filter.New(p),
+		firstInteractions: firstinteractions.New(p),
+		followRequests:    followrequests.New(p),
+		instance:          instance.New(p),
+		lists:             lists.New(p),
+		markers:           markers.New(p),
+		media:             media.New(p),
+		mutes:             mutes.New(p),
+		notifications:     notifications.New(p),
+		oEmbed:            oembed.New(p),
+		preferences:       preferences.New(p),
+		reports:           reports.New(p),
+		savedSearches:     savedsearches.New(p),
+		search:            search.New(p),
+		statuses:          statuses.New(p),
+		streaming:         streaming.New(p, time.Second*30, 4096),
+		timelines:         timelines.New(p),
+		user:              user.New(p),
 	}
 }
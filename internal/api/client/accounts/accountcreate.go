@@ -63,10 +63,14 @@ import (
 //			description: bad request
 //		'401':
 //			description: unauthorized
+//		'403':
+//			description: forbidden (sign-ups from the IP address are blocked, or the sign-up challenge was not passed)
 //		'404':
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable (sign-ups using the email domain are blocked)
 //		'500':
 //			description: internal server error
 func (m *Module) AccountCreatePOSTHandler(c *gin.Context) {
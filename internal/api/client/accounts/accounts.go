@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -38,19 +39,27 @@ const (
 	IDKey          = "id"
 	BasePathWithID = BasePath + "/:" + IDKey
 
+	AliasPath         = BasePath + "/alias"
 	BlockPath         = BasePathWithID + "/block"
 	DeletePath        = BasePath + "/delete"
+	FeaturedTagsPath  = BasePathWithID + "/featured_tags"
 	FollowersPath     = BasePathWithID + "/followers"
 	FollowingPath     = BasePathWithID + "/following"
 	FollowPath        = BasePathWithID + "/follow"
+	ImportProfilePath = BasePath + "/import_profile"
+	InteractionsPath  = BasePath + "/interaction_circle"
 	ListsPath         = BasePathWithID + "/lists"
 	LookupPath        = BasePath + "/lookup"
+	MutePath          = BasePathWithID + "/mute"
 	NotePath          = BasePathWithID + "/note"
+	RefreshPath       = BasePathWithID + "/refresh"
 	RelationshipsPath = BasePath + "/relationships"
 	SearchPath        = BasePath + "/search"
 	StatusesPath      = BasePathWithID + "/statuses"
+	ThemesPath        = BasePath + "/themes"
 	UnblockPath       = BasePathWithID + "/unblock"
 	UnfollowPath      = BasePathWithID + "/unfollow"
+	UnmutePath        = BasePathWithID + "/unmute"
 	UpdatePath        = BasePath + "/update_credentials"
 	VerifyPath        = BasePath + "/verify_credentials"
 )
@@ -70,7 +79,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, BasePath, m.AccountCreatePOSTHandler)
 
 	// get account
-	attachHandler(http.MethodGet, BasePathWithID, m.AccountGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, middleware.ETag(), m.AccountGETHandler)
 
 	// delete account
 	attachHandler(http.MethodPost, DeletePath, m.AccountDeletePOSTHandler)
@@ -99,13 +108,38 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, BlockPath, m.AccountBlockPOSTHandler)
 	attachHandler(http.MethodPost, UnblockPath, m.AccountUnblockPOSTHandler)
 
+	// mute or unmute account
+	attachHandler(http.MethodPost, MutePath, m.AccountMutePOSTHandler)
+	attachHandler(http.MethodPost, UnmutePath, m.AccountUnmutePOSTHandler)
+
 	// account lists
 	attachHandler(http.MethodGet, ListsPath, m.AccountListsGETHandler)
 
+	// account featured tags
+	attachHandler(http.MethodGet, FeaturedTagsPath, m.AccountFeaturedTagsGETHandler)
+
 	// account note
 	attachHandler(http.MethodPost, NotePath, m.AccountNotePOSTHandler)
 
+	// force refresh of remote account
+	attachHandler(http.MethodPost, RefreshPath, m.AccountRefreshPOSTHandler)
+
+	// manage account aliases
+	attachHandler(http.MethodGet, AliasPath, m.AccountAliasesGETHandler)
+	attachHandler(http.MethodPost, AliasPath, m.AccountAliasPOSTHandler)
+	attachHandler(http.MethodDelete, AliasPath, m.AccountAliasDELETEHandler)
+
+	// import profile from aliased remote account
+	attachHandler(http.MethodGet, ImportProfilePath, m.AccountImportProfileGETHandler)
+	attachHandler(http.MethodPost, ImportProfilePath, m.AccountImportProfilePOSTHandler)
+
+	// get accounts which most interacted with requester
+	attachHandler(http.MethodGet, InteractionsPath, m.AccountInteractionCircleGETHandler)
+
 	// search for accounts
 	attachHandler(http.MethodGet, SearchPath, m.AccountSearchGETHandler)
-	attachHandler(http.MethodGet, LookupPath, m.AccountLookupGETHandler)
+	attachHandler(http.MethodGet, LookupPath, middleware.ETag(), m.AccountLookupGETHandler)
+
+	// see theme gallery
+	attachHandler(http.MethodGet, ThemesPath, m.AccountThemesGETHandler)
 }
@@ -56,6 +56,16 @@ import (
 //		description: Account should be made discoverable and shown in the profile directory (if enabled).
 //		type: boolean
 //	-
+//		name: indexable
+//		in: formData
+//		description: Account's posts and profile may be found by search, including on other instances.
+//		type: boolean
+//	-
+//		name: noindex
+//		in: formData
+//		description: Ask search engines not to index your profile and status web pages.
+//		type: boolean
+//	-
 //		name: bot
 //		in: formData
 //		description: Account is flagged as a bot.
@@ -108,6 +118,52 @@ import (
 //		description: Default content type to use for authored statuses (text/plain or text/markdown).
 //		type: string
 //	-
+//		name: source[locale]
+//		in: formData
+//		description: >-
+//			Preferred locale for web pages and emails from this instance, as a BCP 47 language tag.
+//			Empty string to clear the preference, and use the languages preferred by the browser instead.
+//		type: string
+//	-
+//		name: source[email_notifications]
+//		in: formData
+//		description: >-
+//			Whether to email follows and mentions to the user of this account.
+//			`none` to not email them, `immediate` to email each one as it happens,
+//			or `daily` to email a daily digest of them.
+//		type: string
+//		enum:
+//			- none
+//			- immediate
+//			- daily
+//	-
+//		name: source[review_first_interactions]
+//		in: formData
+//		description: >-
+//			Hold mentions from remote accounts that you've never interacted with
+//			for review, instead of notifying you of them straight away.
+//		type: boolean
+//	-
+//		name: source[time_zone]
+//		in: formData
+//		description: >-
+//			IANA name of the time zone the user of this account is in, eg., `Europe/Amsterdam`.
+//			Used to decide when daily highlights are made. Empty string to clear the
+//			preference, and use UTC instead.
+//		type: string
+//	-
+//		name: source[highlights_digest]
+//		in: formData
+//		description: >-
+//			Whether to make daily highlights of the posts from followed accounts that got
+//			the most interactions. `none` to not make them, `daily` to make them once a day,
+//			to be fetched from /api/v1/timelines/highlights, or `email` to also email them.
+//		type: string
+//		enum:
+//			- none
+//			- daily
+//			- email
+//	-
 //		name: custom_css
 //		in: formData
 //		description: >-
@@ -115,6 +171,13 @@ import (
 //			String must be no more than 5,000 characters (~5kb).
 //		type: string
 //	-
+//		name: theme
+//		in: formData
+//		description: >-
+//			File name of the theme to use when rendering this account's profile or statuses.
+//			See /api/v1/accounts/themes for available themes. Empty string to use no theme.
+//		type: string
+//	-
 //		name: enable_rss
 //		in: formData
 //		description: Enable RSS feed for this account's Public posts at `/[username]/feed.rss`
@@ -122,7 +185,10 @@ import (
 //	-
 //		name: fields_attributes
 //		in: formData
-//		description: Profile fields to be added to this account's profile
+//		description: >-
+//			Profile fields to be added to this account's profile. Each field has a `name` and a `value`,
+//			and optionally `rel_me`: if true, links in the value of the field are marked rel="me", so
+//			that the sites they link to can verify that this profile links back to them.
 //		type: array
 //		items:
 //			type: object
@@ -243,6 +309,8 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 
 	if form == nil ||
 		(form.Discoverable == nil &&
+			form.Indexable == nil &&
+			form.NoIndex == nil &&
 			form.Bot == nil &&
 			form.DisplayName == nil &&
 			form.Note == nil &&
@@ -253,8 +321,14 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 			form.Source.Sensitive == nil &&
 			form.Source.Language == nil &&
 			form.Source.StatusContentType == nil &&
+			form.Source.Locale == nil &&
+			form.Source.EmailNotifications == nil &&
+			form.Source.ReviewFirstInteractions == nil &&
+			form.Source.TimeZone == nil &&
+			form.Source.HighlightsDigest == nil &&
 			form.FieldsAttributes == nil &&
 			form.CustomCSS == nil &&
+			form.Theme == nil &&
 			form.EnableRSS == nil) {
 		return nil, errors.New("empty form submitted")
 	}
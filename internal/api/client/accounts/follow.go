@@ -88,6 +88,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'429':
+//			description: too many requests, toggled on and off again too quickly
 //		'500':
 //			description: internal server error
 func (m *Module) AccountFollowPOSTHandler(c *gin.Context) {
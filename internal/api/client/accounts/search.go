@@ -83,6 +83,16 @@ import (
 //			will enhance the search by also searching within account notes, not just in usernames and display names.
 //		default: false
 //		in: query
+//	-
+//		name: interacted
+//		type: boolean
+//		description: >-
+//			Show only accounts that the requesting account has recently interacted with, by mentioning them,
+//			being mentioned by them, or faving their statuses. If `following` is also `true`, accounts that the
+//			requesting account follows are shown too. Remote accounts are never resolved when this is `true`, which
+//			makes it suitable for quickly autocompleting mentions while composing a status.
+//		default: false
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -148,6 +158,12 @@ func (m *Module) AccountSearchGETHandler(c *gin.Context) {
 		return
 	}
 
+	interacted, errWithCode := apiutil.ParseSearchInteracted(c.Query(apiutil.SearchInteractedKey), false)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
 	results, errWithCode := m.processor.Search().Accounts(
 		c.Request.Context(),
 		authed.Account,
@@ -156,6 +172,7 @@ func (m *Module) AccountSearchGETHandler(c *gin.Context) {
 		offset,
 		resolve,
 		following,
+		interacted,
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -50,6 +50,7 @@ func (suite *AccountSearchTestSuite) getSearch(
 	query string,
 	resolve *bool,
 	following *bool,
+	interacted *bool,
 	expectedHTTPStatus int,
 	expectedBody string,
 ) ([]*apimodel.Account, error) {
@@ -79,6 +80,10 @@ func (suite *AccountSearchTestSuite) getSearch(
 		queryParts = append(queryParts, apiutil.SearchFollowingKey+"="+strconv.FormatBool(*following))
 	}
 
+	if interacted != nil {
+		queryParts = append(queryParts, apiutil.SearchInteractedKey+"="+strconv.FormatBool(*interacted))
+	}
+
 	requestURL.RawQuery = strings.Join(queryParts, "&")
 	ctx.Request = httptest.NewRequest(http.MethodGet, requestURL.String(), nil)
 	ctx.Set(oauth.SessionAuthorizedAccount, requestingAccount)
@@ -132,6 +137,7 @@ func (suite *AccountSearchTestSuite) TestSearchZorkOK() {
 		resolve            *bool = nil
 		query                    = "zork"
 		following          *bool = nil
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -145,6 +151,7 @@ func (suite *AccountSearchTestSuite) TestSearchZorkOK() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -168,6 +175,7 @@ func (suite *AccountSearchTestSuite) TestSearchZorkExactOK() {
 		resolve            *bool = nil
 		query                    = "@the_mighty_zork"
 		following          *bool = nil
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -181,6 +189,7 @@ func (suite *AccountSearchTestSuite) TestSearchZorkExactOK() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -204,6 +213,7 @@ func (suite *AccountSearchTestSuite) TestSearchZorkWithDomainOK() {
 		resolve            *bool = nil
 		query                    = "@the_mighty_zork@localhost:8080"
 		following          *bool = nil
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -217,6 +227,7 @@ func (suite *AccountSearchTestSuite) TestSearchZorkWithDomainOK() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -240,6 +251,7 @@ func (suite *AccountSearchTestSuite) TestSearchFossSatanNotFollowing() {
 		resolve            *bool = nil
 		query                    = "foss_satan"
 		following          *bool = func() *bool { i := false; return &i }()
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -253,6 +265,7 @@ func (suite *AccountSearchTestSuite) TestSearchFossSatanNotFollowing() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -276,6 +289,7 @@ func (suite *AccountSearchTestSuite) TestSearchFossSatanFollowing() {
 		resolve            *bool = nil
 		query                    = "foss_satan"
 		following          *bool = func() *bool { i := true; return &i }()
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -289,6 +303,7 @@ func (suite *AccountSearchTestSuite) TestSearchFossSatanFollowing() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -312,6 +327,7 @@ func (suite *AccountSearchTestSuite) TestSearchBonkersQuery() {
 		resolve            *bool = nil
 		query                    = "aaaaa@aaaaaaaaa@aaaaa **** this won't@ return anything!@!!"
 		following          *bool = nil
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -325,6 +341,7 @@ func (suite *AccountSearchTestSuite) TestSearchBonkersQuery() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -348,6 +365,7 @@ func (suite *AccountSearchTestSuite) TestSearchAFollowing() {
 		resolve            *bool = nil
 		query                    = "a"
 		following          *bool = nil
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -361,6 +379,7 @@ func (suite *AccountSearchTestSuite) TestSearchAFollowing() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -369,16 +388,18 @@ func (suite *AccountSearchTestSuite) TestSearchAFollowing() {
 		suite.FailNow(err.Error())
 	}
 
-	if l := len(accounts); l != 5 {
-		suite.FailNow("", "expected length %d got %d", 5, l)
+	// Some_User matches on their bio, as
+	// notes are searched for all accounts.
+	if l := len(accounts); l != 6 {
+		suite.FailNow("", "expected length %d got %d", 6, l)
 	}
 
-	usernames := make([]string, 0, 5)
+	usernames := make([]string, 0, 6)
 	for _, account := range accounts {
 		usernames = append(usernames, account.Username)
 	}
 
-	suite.EqualValues([]string{"her_fuckin_maj", "foss_satan", "1happyturtle", "the_mighty_zork", "admin"}, usernames)
+	suite.EqualValues([]string{"her_fuckin_maj", "Some_User", "foss_satan", "1happyturtle", "the_mighty_zork", "admin"}, usernames)
 }
 
 func (suite *AccountSearchTestSuite) TestSearchANotFollowing() {
@@ -391,6 +412,7 @@ func (suite *AccountSearchTestSuite) TestSearchANotFollowing() {
 		resolve            *bool = nil
 		query                    = "a"
 		following          *bool = func() *bool { i := true; return &i }()
+		interacted         *bool = nil
 		expectedHTTPStatus       = http.StatusOK
 		expectedBody             = ""
 	)
@@ -404,6 +426,7 @@ func (suite *AccountSearchTestSuite) TestSearchANotFollowing() {
 		query,
 		resolve,
 		following,
+		interacted,
 		expectedHTTPStatus,
 		expectedBody,
 	)
@@ -424,6 +447,51 @@ func (suite *AccountSearchTestSuite) TestSearchANotFollowing() {
 	suite.EqualValues([]string{"1happyturtle", "admin"}, usernames)
 }
 
+func (suite *AccountSearchTestSuite) TestSearchAInteracted() {
+	var (
+		requestingAccount        = suite.testAccounts["local_account_1"]
+		token                    = suite.testTokens["local_account_1"]
+		user                     = suite.testUsers["local_account_1"]
+		limit              *int  = nil
+		offset             *int  = nil
+		resolve            *bool = func() *bool { i := true; return &i }()
+		query                    = "a"
+		following          *bool = nil
+		interacted         *bool = func() *bool { i := true; return &i }()
+		expectedHTTPStatus       = http.StatusOK
+		expectedBody             = ""
+	)
+
+	accounts, err := suite.getSearch(
+		requestingAccount,
+		token,
+		user,
+		limit,
+		offset,
+		query,
+		resolve,
+		following,
+		interacted,
+		expectedHTTPStatus,
+		expectedBody,
+	)
+
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if l := len(accounts); l != 3 {
+		suite.FailNow("", "expected length %d got %d", 3, l)
+	}
+
+	usernames := make([]string, 0, 3)
+	for _, account := range accounts {
+		usernames = append(usernames, account.Username)
+	}
+
+	suite.EqualValues([]string{"foss_satan", "1happyturtle", "admin"}, usernames)
+}
+
 func TestAccountSearchTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountSearchTestSuite))
 }
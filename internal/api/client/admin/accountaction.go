@@ -53,7 +53,9 @@ import (
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken, currently only supports `suspend`.
+//		description: >-
+//			Type of action to be taken: `suspend`, or `unlimit` to lift the temporary
+//			limit put on a local account because of a burst of activity.
 //		type: string
 //		required: true
 //	-
@@ -21,33 +21,75 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
-	BasePath                = "/v1/admin"
-	EmojiPath               = BasePath + "/custom_emojis"
-	EmojiPathWithID         = EmojiPath + "/:" + IDKey
-	EmojiCategoriesPath     = EmojiPath + "/categories"
-	DomainBlocksPath        = BasePath + "/domain_blocks"
-	DomainBlocksPathWithID  = DomainBlocksPath + "/:" + IDKey
-	DomainAllowsPath        = BasePath + "/domain_allows"
-	DomainAllowsPathWithID  = DomainAllowsPath + "/:" + IDKey
-	DomainKeysExpirePath    = BasePath + "/domain_keys_expire"
-	AccountsPath            = BasePath + "/accounts"
-	AccountsPathWithID      = AccountsPath + "/:" + IDKey
-	AccountsActionPath      = AccountsPathWithID + "/action"
-	MediaCleanupPath        = BasePath + "/media_cleanup"
-	MediaRefetchPath        = BasePath + "/media_refetch"
-	ReportsPath             = BasePath + "/reports"
-	ReportsPathWithID       = ReportsPath + "/:" + IDKey
-	ReportsResolvePath      = ReportsPathWithID + "/resolve"
-	EmailPath               = BasePath + "/email"
-	EmailTestPath           = EmailPath + "/test"
-	InstanceRulesPath       = BasePath + "/instance/rules"
-	InstanceRulesPathWithID = InstanceRulesPath + "/:" + IDKey
+	BasePath                    = "/v1/admin"
+	EmojiPath                   = BasePath + "/custom_emojis"
+	EmojiPathWithID             = EmojiPath + "/:" + IDKey
+	EmojiCategoriesPath         = EmojiPath + "/categories"
+	DomainBlocksPath            = BasePath + "/domain_blocks"
+	DomainBlocksPathWithID      = DomainBlocksPath + "/:" + IDKey
+	DomainAllowsPath            = BasePath + "/domain_allows"
+	DomainAllowsPathWithID      = DomainAllowsPath + "/:" + IDKey
+	DomainKeysExpirePath        = BasePath + "/domain_keys_expire"
+	DomainInteropPath           = BasePath + "/domain_interop"
+	DomainInteropPathWithDomain = DomainInteropPath + "/:" + DomainKey
+	AccountsPath                = BasePath + "/accounts"
+	AccountsPathWithID          = AccountsPath + "/:" + IDKey
+	AccountsActionPath          = AccountsPathWithID + "/action"
+	AccountsMediaQuotaPath      = AccountsPathWithID + "/media_quota"
+	MediaCleanupPath            = BasePath + "/media_cleanup"
+	MediaRefetchPath            = BasePath + "/media_refetch"
+	ReportsPath                 = BasePath + "/reports"
+	ReportsPathWithID           = ReportsPath + "/:" + IDKey
+	ReportsResolvePath          = ReportsPathWithID + "/resolve"
+	EmailPath                   = BasePath + "/email"
+	EmailTestPath               = EmailPath + "/test"
+	InstanceRulesPath           = BasePath + "/instance/rules"
+	InstanceRulesPathWithID     = InstanceRulesPath + "/:" + IDKey
+	InstancePagesPath           = BasePath + "/instance/pages"
+	InstancePagesPathWithID     = InstancePagesPath + "/:" + IDKey
+	AnnouncementsPath           = BasePath + "/announcements"
+	AnnouncementsPathWithID     = AnnouncementsPath + "/:" + IDKey
+	TagBansPath                 = BasePath + "/tag_bans"
+	TagBansPathWithName         = TagBansPath + "/:" + NameKey
+	IngestRulesPath             = BasePath + "/ingest_rules"
+	IngestRulesPathWithID       = IngestRulesPath + "/:" + IDKey
+	IngestRulesTestPath         = IngestRulesPath + "/test"
+	StatisticsPath              = BasePath + "/statistics"
+	IPBlocksPath                = BasePath + "/ip_blocks"
+	IPBlocksPathWithID          = IPBlocksPath + "/:" + IDKey
+	EmailDomainBlocksPath       = BasePath + "/email_domain_blocks"
+	EmailDomainBlocksPathWithID = EmailDomainBlocksPath + "/:" + IDKey
+	QuarantinePath              = BasePath + "/quarantine"
+	QuarantinePathWithID        = QuarantinePath + "/:" + IDKey
+	QuarantineApprovePath       = QuarantinePathWithID + "/approve"
+	QuarantineRejectPath        = QuarantinePathWithID + "/reject"
+	DomainQuarantinesPath       = BasePath + "/domain_quarantines"
+	DomainQuarantinesPathWithID = DomainQuarantinesPath + "/:" + IDKey
+	DomainQuarantineApprovePath = DomainQuarantinesPathWithID + "/approve"
+	DomainStatsPath             = BasePath + "/domain_stats"
+	DomainStatsPathWithDomain   = DomainStatsPath + "/:" + DomainKey
+	ConfigReloadPath            = BasePath + "/config/reload"
+	LogLevelsPath               = BasePath + "/log_levels"
+	JobsPath                    = BasePath + "/jobs"
+	JobsPathWithName            = JobsPath + "/:" + NameKey
+	JobsRunPath                 = JobsPathWithName + "/run"
+	JobsPausePath               = JobsPathWithName + "/pause"
+	JobsUnpausePath             = JobsPathWithName + "/unpause"
+	DebugCachesPath             = BasePath + "/debug/caches"
+	DebugCachesInvalidatePath   = DebugCachesPath + "/invalidate"
+	DebugDereferencesPath       = BasePath + "/debug/dereferences"
+	DebugPprofPath              = BasePath + "/debug/pprof/*" + ProfileKey
+	DebugVarsPath               = BasePath + "/debug/vars"
 
 	IDKey                 = "id"
+	DomainKey             = "domain"
+	NameKey               = "name"
+	ProfileKey            = "profile"
 	FilterQueryKey        = "filter"
 	MaxShortcodeDomainKey = "max_shortcode_domain"
 	MinShortcodeDomainKey = "min_shortcode_domain"
@@ -94,9 +136,15 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 
 	// domain maintenance stuff
 	attachHandler(http.MethodPost, DomainKeysExpirePath, m.DomainKeysExpirePOSTHandler)
+	attachHandler(http.MethodGet, DomainInteropPath, m.DomainInteropsGETHandler)
+	attachHandler(http.MethodGet, DomainInteropPathWithDomain, m.DomainInteropGETHandler)
+	attachHandler(http.MethodDelete, DomainInteropPathWithDomain, m.DomainInteropDELETEHandler)
 
 	// accounts stuff
+	attachHandler(http.MethodGet, AccountsPathWithID, m.AccountGETHandler)
 	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
+	attachHandler(http.MethodPost, AccountsMediaQuotaPath, m.AccountMediaQuotaPOSTHandler)
+	attachHandler(http.MethodDelete, AccountsMediaQuotaPath, m.AccountMediaQuotaDELETEHandler)
 
 	// media stuff
 	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
@@ -116,4 +164,75 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, InstanceRulesPath, m.RulePOSTHandler)
 	attachHandler(http.MethodPatch, InstanceRulesPathWithID, m.RulePATCHHandler)
 	attachHandler(http.MethodDelete, InstanceRulesPathWithID, m.RuleDELETEHandler)
+
+	// instance page stuff
+	attachHandler(http.MethodGet, InstancePagesPath, m.PagesGETHandler)
+	attachHandler(http.MethodGet, InstancePagesPathWithID, m.PageGETHandler)
+	attachHandler(http.MethodPost, InstancePagesPath, m.PagePOSTHandler)
+	attachHandler(http.MethodPatch, InstancePagesPathWithID, m.PagePATCHHandler)
+	attachHandler(http.MethodDelete, InstancePagesPathWithID, m.PageDELETEHandler)
+
+	// announcements stuff
+	attachHandler(http.MethodGet, AnnouncementsPath, m.AnnouncementsGETHandler)
+	attachHandler(http.MethodPost, AnnouncementsPath, m.AnnouncementPOSTHandler)
+	attachHandler(http.MethodDelete, AnnouncementsPathWithID, m.AnnouncementDELETEHandler)
+
+	// tag ban stuff
+	attachHandler(http.MethodGet, TagBansPath, m.TagBansGETHandler)
+	attachHandler(http.MethodPost, TagBansPath, m.TagBanPOSTHandler)
+	attachHandler(http.MethodDelete, TagBansPathWithName, m.TagBanDELETEHandler)
+
+	// ingest rule stuff
+	attachHandler(http.MethodGet, IngestRulesPath, m.IngestRulesGETHandler)
+	attachHandler(http.MethodPost, IngestRulesPath, m.IngestRulePOSTHandler)
+	attachHandler(http.MethodPost, IngestRulesTestPath, m.IngestRuleTestPOSTHandler)
+	attachHandler(http.MethodGet, IngestRulesPathWithID, m.IngestRuleGETHandler)
+	attachHandler(http.MethodDelete, IngestRulesPathWithID, m.IngestRuleDELETEHandler)
+
+	// spam quarantine stuff
+	attachHandler(http.MethodGet, QuarantinePath, m.QuarantineGETHandler)
+	attachHandler(http.MethodPost, QuarantineApprovePath, m.QuarantineApprovePOSTHandler)
+	attachHandler(http.MethodPost, QuarantineRejectPath, m.QuarantineRejectPOSTHandler)
+	attachHandler(http.MethodGet, DomainQuarantinesPath, m.DomainQuarantinesGETHandler)
+	attachHandler(http.MethodPost, DomainQuarantineApprovePath, m.DomainQuarantineApprovePOSTHandler)
+
+	// domain stats stuff
+	attachHandler(http.MethodGet, DomainStatsPath, m.DomainStatsGETHandler)
+	attachHandler(http.MethodGet, DomainStatsPathWithDomain, m.DomainStatGETHandler)
+
+	// statistics stuff
+	attachHandler(http.MethodGet, StatisticsPath, m.StatisticsGETHandler)
+
+	// ip block stuff
+	attachHandler(http.MethodGet, IPBlocksPath, m.IPBlocksGETHandler)
+	attachHandler(http.MethodPost, IPBlocksPath, m.IPBlockPOSTHandler)
+	attachHandler(http.MethodGet, IPBlocksPathWithID, m.IPBlockGETHandler)
+	attachHandler(http.MethodDelete, IPBlocksPathWithID, m.IPBlockDELETEHandler)
+
+	// email domain block stuff
+	attachHandler(http.MethodGet, EmailDomainBlocksPath, m.EmailDomainBlocksGETHandler)
+	attachHandler(http.MethodPost, EmailDomainBlocksPath, m.EmailDomainBlockPOSTHandler)
+	attachHandler(http.MethodGet, EmailDomainBlocksPathWithID, m.EmailDomainBlockGETHandler)
+	attachHandler(http.MethodDelete, EmailDomainBlocksPathWithID, m.EmailDomainBlockDELETEHandler)
+
+	// config stuff
+	attachHandler(http.MethodPost, ConfigReloadPath, m.ConfigReloadPOSTHandler)
+	attachHandler(http.MethodGet, LogLevelsPath, m.LogLevelsGETHandler)
+	attachHandler(http.MethodPut, LogLevelsPath, m.LogLevelsPUTHandler)
+
+	// jobs stuff
+	attachHandler(http.MethodGet, JobsPath, m.JobsGETHandler)
+	attachHandler(http.MethodGet, JobsPathWithName, m.JobGETHandler)
+	attachHandler(http.MethodPost, JobsRunPath, m.JobRunPOSTHandler)
+	attachHandler(http.MethodPost, JobsPausePath, m.JobPausePOSTHandler)
+	attachHandler(http.MethodPost, JobsUnpausePath, m.JobUnpausePOSTHandler)
+
+	// debug stuff
+	attachHandler(http.MethodGet, DebugCachesPath, m.DebugCachesGETHandler)
+	attachHandler(http.MethodPost, DebugCachesInvalidatePath, m.DebugCacheInvalidatePOSTHandler)
+	attachHandler(http.MethodGet, DebugDereferencesPath, m.DebugDereferencesGETHandler)
+	if config.GetPprofEnabled() {
+		attachHandler(http.MethodGet, DebugPprofPath, m.DebugPprofGETHandler)
+		attachHandler(http.MethodGet, DebugVarsPath, m.DebugVarsGETHandler)
+	}
 }
@@ -72,6 +72,22 @@ import (
 //			given name doesn't exist yet, it will be created.
 //		type: string
 //		required: false
+//	-
+//		name: license
+//		in: formData
+//		description: >-
+//			License under which the emoji image may be used, eg., `CC BY 4.0`.
+//			100 characters or less. Shown on the about page of the instance.
+//		type: string
+//		required: false
+//	-
+//		name: attribution
+//		in: formData
+//		description: >-
+//			Credit for the author(s) of the emoji image, as required by its license.
+//			500 characters or less. Shown on the about page of the instance.
+//		type: string
+//		required: false
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -148,5 +164,13 @@ func validateCreateEmoji(form *apimodel.EmojiCreateRequest) error {
 		return err
 	}
 
-	return validate.EmojiCategory(form.CategoryName)
+	if err := validate.EmojiCategory(form.CategoryName); err != nil {
+		return err
+	}
+
+	if err := validate.EmojiLicense(form.License); err != nil {
+		return err
+	}
+
+	return validate.EmojiAttribution(form.Attribution)
 }
@@ -44,7 +44,7 @@ import (
 // be unique among emojis already present on this instance. A category MAY be provided, and the copied emoji will then
 // be put into the provided category.
 //
-// `modify`: modify a LOCAL emoji. You can provide a new image for the emoji and/or update the category.
+// `modify`: modify a LOCAL emoji. You can provide a new image for the emoji and/or update the category, license and attribution.
 //
 // Local emojis cannot be deleted using this endpoint. To delete a local emoji, check DELETE /api/v1/admin/custom_emojis/{id} instead.
 //
@@ -97,6 +97,20 @@ import (
 //			Category in which to place the emoji. 64 characters or less.
 //			If a category with the given name doesn't exist yet, it will be created.
 //		type: string
+//	-
+//		name: license
+//		in: formData
+//		description: >-
+//			License under which the emoji image may be used, eg., `CC BY 4.0`. 100 characters or less.
+//			Provide an empty string to remove the license. Works for LOCAL emojis only.
+//		type: string
+//	-
+//		name: attribution
+//		in: formData
+//		description: >-
+//			Credit for the author(s) of the emoji image, as required by its license. 500 characters or less.
+//			Provide an empty string to remove the attribution. Works for LOCAL emojis only.
+//		type: string
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -191,11 +205,13 @@ func validateUpdateEmoji(form *apimodel.EmojiUpdateRequest) error {
 
 		form.Type = apimodel.EmojiUpdateCopy
 	case string(apimodel.EmojiUpdateModify):
-		// need either image or category name for modify
+		// need image, category name, license or attribution for modify
 		hasImage := form.Image != nil && form.Image.Size != 0
 		hasCategoryName := form.CategoryName != nil
-		if !hasImage && !hasCategoryName {
-			return errors.New("emoji action type was 'modify' but no image or category name was provided")
+		hasLicense := form.License != nil
+		hasAttribution := form.Attribution != nil
+		if !hasImage && !hasCategoryName && !hasLicense && !hasAttribution {
+			return errors.New("emoji action type was 'modify' but no image, category name, license or attribution was provided")
 		}
 
 		if hasImage {
@@ -211,6 +227,18 @@ func validateUpdateEmoji(form *apimodel.EmojiUpdateRequest) error {
 			}
 		}
 
+		if hasLicense {
+			if err := validate.EmojiLicense(*form.License); err != nil {
+				return err
+			}
+		}
+
+		if hasAttribution {
+			if err := validate.EmojiAttribution(*form.Attribution); err != nil {
+				return err
+			}
+		}
+
 		form.Type = apimodel.EmojiUpdateModify
 	default:
 		return errors.New("emoji action type must be one of 'disable', 'copy', 'modify'")
@@ -405,7 +405,54 @@ func (suite *EmojiUpdateTestSuite) TestEmojiUpdateModifyNoParams() {
 	b, err := ioutil.ReadAll(result.Body)
 	suite.NoError(err)
 
-	suite.Equal(`{"error":"Bad Request: emoji action type was 'modify' but no image or category name was provided"}`, string(b))
+	suite.Equal(`{"error":"Bad Request: emoji action type was 'modify' but no image, category name, license or attribution was provided"}`, string(b))
+}
+
+func (suite *EmojiUpdateTestSuite) TestEmojiUpdateModifyLicense() {
+	testEmoji := &gtsmodel.Emoji{}
+	*testEmoji = *suite.testEmojis["rainbow"]
+
+	// set up the request
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"type":        "modify",
+			"license":     "CC BY 4.0",
+			"attribution": "Rainbow by Some Artist",
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, bodyBytes, admin.EmojiPathWithID, w.FormDataContentType())
+	ctx.AddParam(admin.IDKey, testEmoji.ID)
+
+	// call the handler
+	suite.adminModule.EmojiPATCHHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	adminEmoji := &apimodel.AdminEmoji{}
+	if err := json.Unmarshal(b, adminEmoji); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("CC BY 4.0", adminEmoji.License)
+	suite.Equal("Rainbow by Some Artist", adminEmoji.Attribution)
+	suite.Equal("reactions", adminEmoji.Category)
+
+	// emoji in the db should be updated too
+	dbEmoji, err := suite.db.GetEmojiByID(context.Background(), testEmoji.ID)
+	suite.NoError(err)
+	suite.Equal("CC BY 4.0", dbEmoji.License)
+	suite.Equal("Rainbow by Some Artist", dbEmoji.Attribution)
+	suite.Equal(testEmoji.ImagePath, dbEmoji.ImagePath)
 }
 
 func (suite *EmojiUpdateTestSuite) TestEmojiUpdateCopyLocalToLocal() {
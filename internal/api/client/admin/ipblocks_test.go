@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type IPBlocksTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *IPBlocksTestSuite) TestIPBlockCreateListDelete() {
+	// Create a block.
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(`{"ip":"192.0.2.0/24","severity":"no_access","comment":"scrapers"}`), admin.IPBlocksPath, "application/json")
+
+	suite.adminModule.IPBlockPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	block := &apimodel.AdminIPBlock{}
+	if err := json.NewDecoder(recorder.Body).Decode(block); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("192.0.2.0/24", block.IP)
+	suite.Equal("no_access", block.Severity)
+	suite.Equal("scrapers", block.Comment)
+	suite.Nil(block.ExpiresAt)
+
+	// Block should be listed.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.IPBlocksPath, "")
+
+	suite.adminModule.IPBlocksGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	blocks := []*apimodel.AdminIPBlock{}
+	if err := json.NewDecoder(recorder.Body).Decode(&blocks); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(blocks, 1)
+	suite.Equal(block.ID, blocks[0].ID)
+
+	// Delete the block.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, admin.IPBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, block.ID)
+
+	suite.adminModule.IPBlockDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	// Block should be gone.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.IPBlocksPathWithID, "")
+	ctx.AddParam(admin.IDKey, block.ID)
+
+	suite.adminModule.IPBlockGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *IPBlocksTestSuite) TestIPBlockCreateInvalid() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(`{"ip":"not an ip","severity":"no_access"}`), admin.IPBlocksPath, "application/json")
+
+	suite.adminModule.IPBlockPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+}
+
+func TestIPBlocksTestSuite(t *testing.T) {
+	suite.Run(t, new(IPBlocksTestSuite))
+}
@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+)
+
+type PagesTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *PagesTestSuite) createPage(body string, expectedHTTPStatus int) (*apimodel.InstancePage, string) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), admin.InstancePagesPath, "application/json")
+
+	suite.adminModule.PagePOSTHandler(ctx)
+	suite.Equal(expectedHTTPStatus, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if recorder.Code != http.StatusOK {
+		return nil, string(b)
+	}
+
+	page := &apimodel.InstancePage{}
+	if err := json.Unmarshal(b, page); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return page, ""
+}
+
+func (suite *PagesTestSuite) TestPageCreateUpdateDelete() {
+	page, _ := suite.createPage(`{
+  "slug": "privacy",
+  "title": "Privacy Policy",
+  "text": "We **don't** sell your data.",
+  "nav_order": 2
+}`, http.StatusOK)
+	suite.NotEmpty(page.ID)
+	suite.Equal("privacy", page.Slug)
+	suite.Equal("<p>We <strong>don't</strong> sell your data.</p>", page.Content)
+	suite.Equal(2, page.NavOrder)
+	suite.True(page.ShowInNav)
+
+	// Update just the text + nav visibility.
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPatch, []byte(`{"text":"Nothing to see here.","show_in_nav":false}`), admin.InstancePagesPathWithID, "application/json")
+	ctx.AddParam(admin.IDKey, page.ID)
+
+	suite.adminModule.PagePATCHHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	updated := &apimodel.InstancePage{}
+	if err := json.NewDecoder(recorder.Body).Decode(updated); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("Privacy Policy", updated.Title)
+	suite.Equal("Nothing to see here.", updated.Text)
+	suite.Equal("<p>Nothing to see here.</p>", updated.Content)
+	suite.False(updated.ShowInNav)
+
+	// Page should be listed.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodGet, nil, admin.InstancePagesPath, "")
+
+	suite.adminModule.PagesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	pages := []*apimodel.InstancePage{}
+	if err := json.NewDecoder(recorder.Body).Decode(&pages); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(pages, 1)
+	suite.Equal(page.ID, pages[0].ID)
+
+	// Delete the page.
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodDelete, nil, admin.InstancePagesPathWithID, "")
+	ctx.AddParam(admin.IDKey, page.ID)
+
+	suite.adminModule.PageDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	_, err := suite.db.GetInstancePageByID(context.Background(), page.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *PagesTestSuite) TestPageCreateInvalidSlug() {
+	_, errString := suite.createPage(`{"slug":"Not A Slug","title":"Nope"}`, http.StatusBadRequest)
+	suite.Equal(`{"error":"Bad Request: page slug Not A Slug was invalid: must contain only lowercase letters, numbers, and hyphens"}`, errString)
+}
+
+func (suite *PagesTestSuite) TestPageCreateDuplicateSlug() {
+	suite.createPage(`{"slug":"code-of-conduct","title":"Code of Conduct"}`, http.StatusOK)
+
+	_, errString := suite.createPage(`{"slug":"code-of-conduct","title":"Another Code of Conduct"}`, http.StatusConflict)
+	suite.Equal(`{"error":"Conflict: a page with slug code-of-conduct already exists"}`, errString)
+}
+
+func (suite *PagesTestSuite) TestPageGetNotFound() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.InstancePagesPathWithID, "")
+	ctx.AddParam(admin.IDKey, "01GF8VRXX1R00X7XH8973Z29R1")
+
+	suite.adminModule.PageGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestPagesTestSuite(t *testing.T) {
+	suite.Run(t, &PagesTestSuite{})
+}
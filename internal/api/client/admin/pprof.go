@@ -0,0 +1,144 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/router"
+)
+
+// DebugPprofGETHandler swagger:operation GET /api/v1/admin/debug/pprof/{profile} debugPprofGet
+//
+// Capture a Go runtime profile from this instance, in the format served by net/http/pprof.
+//
+// Only available if pprof-enabled is set to true in the instance config.
+//
+// The returned profile can be inspected with `go tool pprof`.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/octet-stream
+//	- text/html
+//	- text/plain
+//
+//	parameters:
+//	-
+//		name: profile
+//		type: string
+//		description: >-
+//			Name of the profile to capture, eg., `heap`, `goroutine`,
+//			`allocs`, `block`, `mutex`, `profile` (CPU) or `trace`.
+//			Leave empty for an HTML index of available profiles.
+//		in: path
+//		required: true
+//	-
+//		name: seconds
+//		type: integer
+//		description: Duration in seconds to capture `profile` and `trace` profiles for.
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: The requested profile.
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+func (m *Module) DebugPprofGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Catch-all param includes the leading slash.
+	name := strings.TrimPrefix(c.Param(ProfileKey), "/")
+	router.ServePprof(c.Writer, c.Request, name)
+}
+
+// DebugVarsGETHandler swagger:operation GET /api/v1/admin/debug/vars debugVarsGet
+//
+// View Go runtime stats for this instance, as published by expvar.
+//
+// Only available if pprof-enabled is set to true in the instance config.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	produces:
+//	- application/json
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin
+//
+//	responses:
+//		'200':
+//			description: >-
+//				Runtime stats, including `cmdline` and `memstats`
+//				(see https://pkg.go.dev/runtime#MemStats).
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+func (m *Module) DebugVarsGETHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	router.ServeExpvar(c.Writer, c.Request)
+}
@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type PprofTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *PprofTestSuite) TestDebugVars() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.DebugVarsPath, "")
+
+	suite.adminModule.DebugVarsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	vars := make(map[string]json.RawMessage)
+	if err := json.NewDecoder(recorder.Body).Decode(&vars); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Contains(vars, "memstats")
+	suite.Contains(vars, "cmdline")
+}
+
+func (suite *PprofTestSuite) TestDebugPprofGoroutine() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/admin/debug/pprof/goroutine?debug=1", "")
+	ctx.Params = gin.Params{{Key: admin.ProfileKey, Value: "/goroutine"}}
+
+	suite.adminModule.DebugPprofGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.True(strings.HasPrefix(recorder.Body.String(), "goroutine profile:"))
+}
+
+func (suite *PprofTestSuite) TestDebugPprofNotAdmin() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "api/v1/admin/debug/pprof/heap", "")
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Params = gin.Params{{Key: admin.ProfileKey, Value: "/heap"}}
+
+	suite.adminModule.DebugPprofGETHandler(ctx)
+	suite.Equal(http.StatusForbidden, recorder.Code)
+}
+
+func TestPprofTestSuite(t *testing.T) {
+	suite.Run(t, new(PprofTestSuite))
+}
@@ -170,7 +170,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "display_name": "big gerald",
         "locked": false,
         "discoverable": true,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2021-09-26T10:52:36.000Z",
         "note": "i post about like, i dunno, stuff, or whatever!!!!",
         "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -211,7 +214,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "display_name": "happy little turtle :3",
         "locked": true,
         "discoverable": false,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2022-06-04T13:12:00.000Z",
         "note": "\u003cp\u003ei post about things that concern me\u003c/p\u003e",
         "url": "http://localhost:8080/@1happyturtle",
@@ -267,7 +273,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "display_name": "",
         "locked": false,
         "discoverable": true,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2022-05-17T13:10:59.000Z",
         "note": "",
         "url": "http://localhost:8080/@admin",
@@ -313,7 +322,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "display_name": "",
         "locked": false,
         "discoverable": true,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2022-05-17T13:10:59.000Z",
         "note": "",
         "url": "http://localhost:8080/@admin",
@@ -372,7 +384,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "display_name": "happy little turtle :3",
         "locked": true,
         "discoverable": false,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2022-06-04T13:12:00.000Z",
         "note": "\u003cp\u003ei post about things that concern me\u003c/p\u003e",
         "url": "http://localhost:8080/@1happyturtle",
@@ -428,7 +443,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
         "display_name": "big gerald",
         "locked": false,
         "discoverable": true,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2021-09-26T10:52:36.000Z",
         "note": "i post about like, i dunno, stuff, or whatever!!!!",
         "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -475,7 +493,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
           "display_name": "big gerald",
           "locked": false,
           "discoverable": true,
+          "indexable": false,
+          "noindex": false,
           "bot": false,
+          "group": false,
           "created_at": "2021-09-26T10:52:36.000Z",
           "note": "i post about like, i dunno, stuff, or whatever!!!!",
           "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -518,6 +539,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetAll() {
               }
             },
             "description": "tweet from thoughts of dog: i drank. all the water. in my bowl. earlier. but just now. i returned. to the same bowl. and it was. full again.. the bowl. is haunted",
+            "sensitive": false,
             "blurhash": "LARysgM_IU_3~pD%M_Rj_39FIAt6"
           }
         ],
@@ -593,7 +615,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
         "display_name": "happy little turtle :3",
         "locked": true,
         "discoverable": false,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2022-06-04T13:12:00.000Z",
         "note": "\u003cp\u003ei post about things that concern me\u003c/p\u003e",
         "url": "http://localhost:8080/@1happyturtle",
@@ -649,7 +674,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
         "display_name": "big gerald",
         "locked": false,
         "discoverable": true,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2021-09-26T10:52:36.000Z",
         "note": "i post about like, i dunno, stuff, or whatever!!!!",
         "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -696,7 +724,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
           "display_name": "big gerald",
           "locked": false,
           "discoverable": true,
+          "indexable": false,
+          "noindex": false,
           "bot": false,
+          "group": false,
           "created_at": "2021-09-26T10:52:36.000Z",
           "note": "i post about like, i dunno, stuff, or whatever!!!!",
           "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -739,6 +770,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetCreatedByAccount() {
               }
             },
             "description": "tweet from thoughts of dog: i drank. all the water. in my bowl. earlier. but just now. i returned. to the same bowl. and it was. full again.. the bowl. is haunted",
+            "sensitive": false,
             "blurhash": "LARysgM_IU_3~pD%M_Rj_39FIAt6"
           }
         ],
@@ -814,7 +846,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
         "display_name": "happy little turtle :3",
         "locked": true,
         "discoverable": false,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2022-06-04T13:12:00.000Z",
         "note": "\u003cp\u003ei post about things that concern me\u003c/p\u003e",
         "url": "http://localhost:8080/@1happyturtle",
@@ -870,7 +905,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
         "display_name": "big gerald",
         "locked": false,
         "discoverable": true,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2021-09-26T10:52:36.000Z",
         "note": "i post about like, i dunno, stuff, or whatever!!!!",
         "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -917,7 +955,10 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
           "display_name": "big gerald",
           "locked": false,
           "discoverable": true,
+          "indexable": false,
+          "noindex": false,
           "bot": false,
+          "group": false,
           "created_at": "2021-09-26T10:52:36.000Z",
           "note": "i post about like, i dunno, stuff, or whatever!!!!",
           "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -960,6 +1001,7 @@ func (suite *ReportsGetTestSuite) TestReportsGetTargetAccount() {
               }
             },
             "description": "tweet from thoughts of dog: i drank. all the water. in my bowl. earlier. but just now. i returned. to the same bowl. and it was. full again.. the bowl. is haunted",
+            "sensitive": false,
             "blurhash": "LARysgM_IU_3~pD%M_Rj_39FIAt6"
           }
         ],
@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type StatisticsGetTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *StatisticsGetTestSuite) getStatistics(query string, expectedHTTPStatus int) []*apimodel.AdminStatistic {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.StatisticsPath+query, "")
+
+	suite.adminModule.StatisticsGETHandler(ctx)
+	suite.Equal(expectedHTTPStatus, recorder.Code)
+
+	if recorder.Code != http.StatusOK {
+		return nil
+	}
+
+	stats := []*apimodel.AdminStatistic{}
+	if err := json.NewDecoder(recorder.Body).Decode(&stats); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return stats
+}
+
+func (suite *StatisticsGetTestSuite) TestStatisticsGet() {
+	// Nothing recorded yet.
+	stats := suite.getStatistics("", http.StatusOK)
+	suite.Empty(stats)
+
+	// Record statistics for the last couple of days.
+	now := time.Now()
+	for _, t := range []time.Time{now.Add(-24 * time.Hour), now} {
+		if err := suite.processor.Admin().StatisticsRecord(context.Background(), t); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	stats = suite.getStatistics("?days=7", http.StatusOK)
+	if suite.Len(stats, 2) {
+		suite.Equal(now.UTC().Add(-48*time.Hour).Format(time.DateOnly), stats[0].Date)
+		suite.Equal(now.UTC().Add(-24*time.Hour).Format(time.DateOnly), stats[1].Date)
+	}
+
+	// Only yesterday.
+	stats = suite.getStatistics("?days=1", http.StatusOK)
+	suite.Len(stats, 1)
+}
+
+func (suite *StatisticsGetTestSuite) TestStatisticsGetBadDays() {
+	suite.getStatistics("?days=lots", http.StatusBadRequest)
+}
+
+func TestStatisticsGetTestSuite(t *testing.T) {
+	suite.Run(t, new(StatisticsGetTestSuite))
+}
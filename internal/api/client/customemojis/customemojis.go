@@ -21,12 +21,17 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
 const (
 	// BasePath is the base path for serving custom emojis, minus the 'api' prefix
 	BasePath = "/v1/custom_emojis"
+	// ShortcodeKey is the key for the shortcode of one custom emoji.
+	ShortcodeKey = "shortcode"
+	// BasePathWithShortcode is the path for serving one custom emoji.
+	BasePathWithShortcode = BasePath + "/:" + ShortcodeKey
 )
 
 type Module struct {
@@ -40,5 +45,6 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, BasePath, m.CustomEmojisGETHandler)
+	attachHandler(http.MethodGet, BasePath, middleware.ETag(), m.CustomEmojisGETHandler)
+	attachHandler(http.MethodGet, BasePathWithShortcode, middleware.ETag(), m.CustomEmojiGETHandler)
 }
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package drafts_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/drafts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DraftCreateTestSuite struct {
+	DraftsStandardTestSuite
+}
+
+// draftRequest calls the given handler as local_account_1,
+// with the given method, draft ID and form, and returns the
+// response code and body.
+func (suite *DraftCreateTestSuite) draftRequest(
+	handler gin.HandlerFunc,
+	method string,
+	draftID string,
+	form url.Values,
+) (int, []byte) {
+	return suite.draftRequestBody(handler, method, draftID, "application/x-www-form-urlencoded", form.Encode())
+}
+
+// draftRequestBody is like draftRequest,
+// but with the given raw body + content type.
+func (suite *DraftCreateTestSuite) draftRequestBody(
+	handler gin.HandlerFunc,
+	method string,
+	draftID string,
+	contentType string,
+	body string,
+) (int, []byte) {
+	var (
+		recorder = httptest.NewRecorder()
+		ctx, _   = testrig.CreateGinTestContext(recorder, nil)
+	)
+
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	requestPath := config.GetProtocol() + "://" + config.GetHost() + "/api" + drafts.BasePath
+	if draftID != "" {
+		ctx.AddParam(drafts.IDKey, draftID)
+		requestPath += "/" + draftID
+	}
+
+	request := httptest.NewRequest(method, requestPath, strings.NewReader(body))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", contentType)
+	ctx.Request = request
+
+	handler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+func (suite *DraftCreateTestSuite) TestDraftLifecycle() {
+	// Create a draft with an attachment.
+	code, b := suite.draftRequest(suite.draftsModule.DraftCreatePOSTHandler, http.MethodPost, "", url.Values{
+		"status":       {"this is a draft"},
+		"spoiler_text": {"spoiler"},
+		"visibility":   {"private"},
+		"media_ids[]":  {suite.testAttachments["local_account_1_unattached_1"].ID},
+	})
+	suite.Equal(http.StatusOK, code, string(b))
+
+	draft := &apimodel.Draft{}
+	if err := json.Unmarshal(b, draft); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(draft.ID)
+	suite.Equal("this is a draft", draft.Status)
+	suite.Equal("spoiler", draft.SpoilerText)
+	suite.Equal(apimodel.VisibilityPrivate, draft.Visibility)
+	suite.Len(draft.MediaAttachments, 1)
+	suite.Nil(draft.Poll)
+
+	// Replace it with a poll draft.
+	code, b = suite.draftRequestBody(suite.draftsModule.DraftUpdatePUTHandler, http.MethodPut, draft.ID, "application/json", `{
+  "status": "which is best?",
+  "poll": {
+    "options": ["this", "that"],
+    "expires_in": 3600,
+    "multiple": true
+  },
+  "content_type": "text/markdown",
+  "sensitive": true,
+  "language": "en",
+  "in_reply_to_id": "`+suite.testStatuses["admin_account_status_1"].ID+`"
+}`)
+	suite.Equal(http.StatusOK, code, string(b))
+
+	updated := &apimodel.Draft{}
+	if err := json.Unmarshal(b, updated); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(draft.ID, updated.ID)
+	suite.Equal("which is best?", updated.Status)
+	suite.Empty(updated.MediaAttachments)
+	suite.True(updated.Sensitive)
+	suite.Equal(apimodel.StatusContentTypeMarkdown, updated.ContentType)
+	if suite.NotNil(updated.Poll) {
+		suite.Equal([]string{"this", "that"}, updated.Poll.Options)
+		suite.Equal(3600, updated.Poll.ExpiresIn)
+		suite.True(updated.Poll.Multiple)
+	}
+
+	// List all drafts.
+	code, b = suite.draftRequest(suite.draftsModule.DraftsGETHandler, http.MethodGet, "", nil)
+	suite.Equal(http.StatusOK, code, string(b))
+
+	all := []*apimodel.Draft{}
+	if err := json.Unmarshal(b, &all); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(all, 1) {
+		suite.Equal(draft.ID, all[0].ID)
+	}
+
+	// Delete it again.
+	code, b = suite.draftRequest(suite.draftsModule.DraftDELETEHandler, http.MethodDelete, draft.ID, nil)
+	suite.Equal(http.StatusOK, code, string(b))
+
+	code, _ = suite.draftRequest(suite.draftsModule.DraftGETHandler, http.MethodGet, draft.ID, nil)
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *DraftCreateTestSuite) TestDraftCreateEmpty() {
+	code, b := suite.draftRequest(suite.draftsModule.DraftCreatePOSTHandler, http.MethodPost, "", url.Values{})
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: no status, spoiler text, media, or poll provided"}`, string(b))
+}
+
+func (suite *DraftCreateTestSuite) TestDraftCreateOthersMedia() {
+	code, b := suite.draftRequest(suite.draftsModule.DraftCreatePOSTHandler, http.MethodPost, "", url.Values{
+		"status":      {"nicked this"},
+		"media_ids[]": {suite.testAttachments["admin_account_status_1_attachment_1"].ID},
+	})
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Contains(string(b), "does not belong to account")
+}
+
+func TestDraftCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(DraftCreateTestSuite))
+}
@@ -0,0 +1,127 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package drafts
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+const (
+	IDKey = "id"
+	// BasePath is the base path for serving the drafts API, minus the 'api' prefix
+	BasePath       = "/v1/drafts"
+	BasePathWithID = BasePath + "/:" + IDKey
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// create / get / update / delete drafts
+	attachHandler(http.MethodPost, BasePath, m.DraftCreatePOSTHandler)
+	attachHandler(http.MethodGet, BasePath, m.DraftsGETHandler)
+	attachHandler(http.MethodGet, BasePathWithID, m.DraftGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, m.DraftUpdatePUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.DraftDELETEHandler)
+}
+
+// validateNormalizeDraft checks the given draft form
+// against the same limits used when posting statuses,
+// so that a saved draft can later be posted as-is.
+func validateNormalizeDraft(form *apimodel.DraftRequest) error {
+	hasStatus := form.Status != ""
+	hasMedia := len(form.MediaIDs) != 0
+	hasPoll := form.Poll != nil
+
+	if !hasStatus && !hasMedia && !hasPoll && form.SpoilerText == "" {
+		return errors.New("no status, spoiler text, media, or poll provided")
+	}
+
+	if hasMedia && hasPoll {
+		return errors.New("can't draft media + poll in same status")
+	}
+
+	maxChars := config.GetStatusesMaxChars()
+	maxMediaFiles := config.GetStatusesMediaMaxFiles()
+	maxPollOptions := config.GetStatusesPollMaxOptions()
+	maxPollChars := config.GetStatusesPollOptionMaxChars()
+	maxCwChars := config.GetStatusesCWMaxChars()
+
+	if length := len([]rune(form.Status)); length > maxChars {
+		return fmt.Errorf("status too long, %d characters provided but limit is %d", length, maxChars)
+	}
+
+	if len(form.MediaIDs) > maxMediaFiles {
+		return fmt.Errorf("too many media files attached to draft, %d attached but limit is %d", len(form.MediaIDs), maxMediaFiles)
+	}
+
+	if form.Poll != nil {
+		if len(form.Poll.Options) == 0 {
+			return errors.New("poll with no options")
+		}
+		if len(form.Poll.Options) > maxPollOptions {
+			return fmt.Errorf("too many poll options provided, %d provided but limit is %d", len(form.Poll.Options), maxPollOptions)
+		}
+		for _, p := range form.Poll.Options {
+			if length := len([]rune(p)); length > maxPollChars {
+				return fmt.Errorf("poll option too long, %d characters provided but limit is %d", length, maxPollChars)
+			}
+		}
+	}
+
+	if length := len([]rune(form.SpoilerText)); length > maxCwChars {
+		return fmt.Errorf("content-warning/spoilertext too long, %d characters provided but limit is %d", length, maxCwChars)
+	}
+
+	if form.Visibility != "" {
+		if err := validate.Privacy(string(form.Visibility)); err != nil {
+			return err
+		}
+	}
+
+	if form.ContentType != "" {
+		if err := validate.StatusContentType(string(form.ContentType)); err != nil {
+			return err
+		}
+	}
+
+	if form.Language != "" {
+		language, err := validate.Language(form.Language)
+		if err != nil {
+			return err
+		}
+		form.Language = language
+	}
+
+	return nil
+}
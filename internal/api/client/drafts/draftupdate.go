@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package drafts
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// DraftUpdatePUTHandler swagger:operation PUT /api/v1/drafts/{id} draftUpdate
+//
+// Update an existing status draft.
+//
+// The draft is replaced entirely with the provided parameters.
+//
+//	---
+//	tags:
+//	- drafts
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		type: string
+//		description: ID of the draft
+//		in: path
+//		required: true
+//	-
+//		name: status
+//		type: string
+//		description: Text content of the draft.
+//		in: formData
+//	-
+//		name: media_ids[]
+//		type: array
+//		items:
+//			type: string
+//		description: Array of media attachment IDs to attach to the draft.
+//		in: formData
+//	-
+//		name: poll[options][]
+//		type: array
+//		items:
+//			type: string
+//		description: Possible answers of a poll to post with the draft.
+//		in: formData
+//	-
+//		name: poll[expires_in]
+//		type: integer
+//		description: Duration in seconds that the poll should be open for.
+//		in: formData
+//	-
+//		name: poll[multiple]
+//		type: boolean
+//		description: Allow multiple choices on the poll.
+//		in: formData
+//	-
+//		name: poll[hide_totals]
+//		type: boolean
+//		description: Hide vote counts until the poll ends.
+//		in: formData
+//	-
+//		name: in_reply_to_id
+//		type: string
+//		description: ID of the status being replied to, if draft is a reply.
+//		in: formData
+//	-
+//		name: sensitive
+//		type: boolean
+//		description: Draft and attached media should be marked as sensitive.
+//		in: formData
+//	-
+//		name: spoiler_text
+//		type: string
+//		description: Text to be shown as a warning or subject before the actual content.
+//		in: formData
+//	-
+//		name: visibility
+//		type: string
+//		description: Visibility to post the draft with.
+//		in: formData
+//	-
+//		name: language
+//		type: string
+//		description: ISO 639 language code for the draft.
+//		in: formData
+//	-
+//		name: content_type
+//		type: string
+//		description: Content type to use when posting the draft.
+//		in: formData
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:statuses
+//
+//	responses:
+//		'200':
+//			description: "The updated draft."
+//			schema:
+//				"$ref": "#/definitions/draft"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) DraftUpdatePUTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	targetDraftID := c.Param(IDKey)
+	if targetDraftID == "" {
+		err := errors.New("no draft id specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.DraftRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if err := validateNormalizeDraft(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	apiDraft, errWithCode := m.processor.Draft().Update(c.Request.Context(), authed.Account, targetDraftID, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, apiDraft)
+}
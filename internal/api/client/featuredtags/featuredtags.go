@@ -25,7 +25,10 @@ import (
 )
 
 const (
-	BasePath = "/v1/featured_tags"
+	IDKey = "id"
+	// BasePath is the base path for serving the featured tags API, minus the 'api' prefix
+	BasePath       = "/v1/featured_tags"
+	BasePathWithID = BasePath + "/:" + IDKey
 )
 
 type Module struct {
@@ -39,5 +42,8 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// create / get / delete featured tags
+	attachHandler(http.MethodPost, BasePath, m.FeaturedTagCreatePOSTHandler)
 	attachHandler(http.MethodGet, BasePath, m.FeaturedTagsGETHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.FeaturedTagDELETEHandler)
 }
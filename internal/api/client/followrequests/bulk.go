@@ -0,0 +1,178 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package followrequests
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// FollowRequestAuthorizeBulkPOSTHandler swagger:operation POST /api/v1/follow_requests/authorize authorizeFollowRequests
+//
+// Accept/authorize multiple follow requests at once.
+//
+// Provide either a list of account IDs whose follow requests should be accepted, or
+// `all=true` to accept your oldest pending follow requests, up to 200 per call.
+// Account IDs without a pending follow request are skipped.
+//
+//	---
+//	tags:
+//	- follow_requests
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: account_ids[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of accounts requesting to follow you. Max 200.
+//		in: formData
+//	-
+//		name: all
+//		type: boolean
+//		description: Accept all pending follow requests, oldest first, up to 200.
+//		default: false
+//		in: formData
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:follows
+//
+//	responses:
+//		'200':
+//			name: account relationships
+//			description: Your relationship to each account whose follow request was accepted.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/accountRelationship"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) FollowRequestAuthorizeBulkPOSTHandler(c *gin.Context) {
+	m.followRequestBulkPOSTHandler(c, m.processor.Account().FollowRequestsAcceptBulk)
+}
+
+// FollowRequestRejectBulkPOSTHandler swagger:operation POST /api/v1/follow_requests/reject rejectFollowRequests
+//
+// Reject multiple follow requests at once.
+//
+// Provide either a list of account IDs whose follow requests should be rejected, or
+// `all=true` to reject your oldest pending follow requests, up to 200 per call.
+// Account IDs without a pending follow request are skipped.
+//
+//	---
+//	tags:
+//	- follow_requests
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: account_ids[]
+//		type: array
+//		items:
+//			type: string
+//		description: IDs of accounts requesting to follow you. Max 200.
+//		in: formData
+//	-
+//		name: all
+//		type: boolean
+//		description: Reject all pending follow requests, oldest first, up to 200.
+//		default: false
+//		in: formData
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:follows
+//
+//	responses:
+//		'200':
+//			name: account relationships
+//			description: Your relationship to each account whose follow request was rejected.
+//			schema:
+//				type: array
+//				items:
+//					"$ref": "#/definitions/accountRelationship"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) FollowRequestRejectBulkPOSTHandler(c *gin.Context) {
+	m.followRequestBulkPOSTHandler(c, m.processor.Account().FollowRequestsRejectBulk)
+}
+
+func (m *Module) followRequestBulkPOSTHandler(
+	c *gin.Context,
+	handle func(context.Context, *gtsmodel.Account, []string, bool) ([]*apimodel.Relationship, gtserror.WithCode),
+) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	form := &apimodel.FollowRequestBulkRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	relationships, errWithCode := handle(c.Request.Context(), authed.Account, form.AccountIDs, form.All)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.JSON(http.StatusOK, relationships)
+}
@@ -0,0 +1,175 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package followrequests_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type BulkTestSuite struct {
+	FollowRequestStandardTestSuite
+}
+
+func (suite *BulkTestSuite) putFollowRequests(requesters ...*gtsmodel.Account) {
+	targetAccount := suite.testAccounts["local_account_1"]
+
+	for i, requestingAccount := range requesters {
+		id := fmt.Sprintf("01HDAQ8Y6V6KDN3YFH3QEXG0Z%d", i)
+		fr := &gtsmodel.FollowRequest{
+			ID:              id,
+			CreatedAt:       time.Now(),
+			UpdatedAt:       time.Now(),
+			URI:             fmt.Sprintf("%s/follow/%s", requestingAccount.URI, id),
+			AccountID:       requestingAccount.ID,
+			TargetAccountID: targetAccount.ID,
+		}
+
+		if err := suite.db.Put(context.Background(), fr); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+}
+
+func (suite *BulkTestSuite) bulk(path string, body string, reject bool) (int, []*apimodel.Relationship) {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(body), path, "application/x-www-form-urlencoded")
+
+	if reject {
+		suite.followRequestModule.FollowRequestRejectBulkPOSTHandler(ctx)
+	} else {
+		suite.followRequestModule.FollowRequestAuthorizeBulkPOSTHandler(ctx)
+	}
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if recorder.Code != http.StatusOK {
+		return recorder.Code, nil
+	}
+
+	var relationships []*apimodel.Relationship
+	if err := json.Unmarshal(b, &relationships); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, relationships
+}
+
+func (suite *BulkTestSuite) TestAuthorizeAll() {
+	suite.putFollowRequests(
+		suite.testAccounts["remote_account_1"],
+		suite.testAccounts["remote_account_2"],
+	)
+
+	code, relationships := suite.bulk("/api/v1/follow_requests/authorize", "all=true", false)
+	suite.Equal(http.StatusOK, code)
+	suite.Len(relationships, 2)
+
+	for _, relationship := range relationships {
+		suite.True(relationship.FollowedBy)
+		suite.False(relationship.Requested)
+	}
+
+	// Nothing left pending.
+	count, err := suite.db.CountAccountFollowRequests(context.Background(), suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
+func (suite *BulkTestSuite) TestRejectByID() {
+	remoteAccount1 := suite.testAccounts["remote_account_1"]
+	remoteAccount2 := suite.testAccounts["remote_account_2"]
+	suite.putFollowRequests(remoteAccount1, remoteAccount2)
+
+	// Include an account which has no follow request, it should be skipped.
+	body := "account_ids[]=" + remoteAccount1.ID + "&account_ids[]=" + suite.testAccounts["admin_account"].ID
+	code, relationships := suite.bulk("/api/v1/follow_requests/reject", body, true)
+	suite.Equal(http.StatusOK, code)
+	if suite.Len(relationships, 1) {
+		suite.Equal(remoteAccount1.ID, relationships[0].ID)
+		suite.False(relationships[0].FollowedBy)
+	}
+
+	// Other follow request still pending.
+	count, err := suite.db.CountAccountFollowRequests(context.Background(), suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.Equal(1, count)
+}
+
+func (suite *BulkTestSuite) TestBulkNoIDs() {
+	code, _ := suite.bulk("/api/v1/follow_requests/authorize", "", false)
+	suite.Equal(http.StatusBadRequest, code)
+}
+
+func (suite *BulkTestSuite) TestGetExtended() {
+	remoteAccount2 := suite.testAccounts["remote_account_2"]
+	suite.putFollowRequests(remoteAccount2)
+
+	// local_account_1 follows admin, so make admin follow
+	// the requester to give them one mutual follower.
+	if err := suite.db.Put(context.Background(), &gtsmodel.Follow{
+		ID:              "01HDAQWJ5J0B6Y0R5A9M2S8Z7T",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		URI:             "http://localhost:8080/users/admin/follow/01HDAQWJ5J0B6Y0R5A9M2S8Z7T",
+		AccountID:       suite.testAccounts["admin_account"].ID,
+		TargetAccountID: remoteAccount2.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, "/api/v1/follow_requests/extended", "")
+	suite.followRequestModule.FollowRequestExtendedGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	var followRequests []*apimodel.FollowRequest
+	if err := json.NewDecoder(result.Body).Decode(&followRequests); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(followRequests, 1) {
+		suite.Equal("01HDAQ8Y6V6KDN3YFH3QEXG0Z0", followRequests[0].ID)
+		suite.Equal(remoteAccount2.ID, followRequests[0].Account.ID)
+		suite.Equal(remoteAccount2.Note, followRequests[0].Account.Note)
+		suite.Equal(1, followRequests[0].MutualFollowersCount)
+	}
+}
+
+func TestBulkTestSuite(t *testing.T) {
+	suite.Run(t, &BulkTestSuite{})
+}
@@ -36,6 +36,12 @@ const (
 	AuthorizePath = BasePathWithID + "/authorize"
 	// RejectPath is used for rejecting follow requests
 	RejectPath = BasePathWithID + "/reject"
+	// ExtendedPath is used for getting follow requests with extra context
+	ExtendedPath = BasePath + "/extended"
+	// AuthorizeBulkPath is used for authorizing multiple follow requests
+	AuthorizeBulkPath = BasePath + "/authorize"
+	// RejectBulkPath is used for rejecting multiple follow requests
+	RejectBulkPath = BasePath + "/reject"
 )
 
 type Module struct {
@@ -52,4 +58,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.FollowRequestGETHandler)
 	attachHandler(http.MethodPost, AuthorizePath, m.FollowRequestAuthorizePOSTHandler)
 	attachHandler(http.MethodPost, RejectPath, m.FollowRequestRejectPOSTHandler)
+	attachHandler(http.MethodGet, ExtendedPath, m.FollowRequestExtendedGETHandler)
+	attachHandler(http.MethodPost, AuthorizeBulkPath, m.FollowRequestAuthorizeBulkPOSTHandler)
+	attachHandler(http.MethodPost, RejectBulkPath, m.FollowRequestRejectBulkPOSTHandler)
 }
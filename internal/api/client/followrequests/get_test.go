@@ -89,7 +89,10 @@ func (suite *GetTestSuite) TestGet() {
     "display_name": "some user",
     "locked": true,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2020-08-10T12:13:28.000Z",
     "note": "i'm a real son of a gun",
     "url": "http://example.org/@Some_User",
@@ -21,6 +21,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
 )
 
@@ -29,6 +30,9 @@ const (
 	InstanceInformationPathV2 = "/v2/instance"
 	InstancePeersPath         = InstanceInformationPathV1 + "/peers"
 	InstanceRulesPath         = InstanceInformationPathV1 + "/rules"
+	InstancePrivacyPolicyPath = InstanceInformationPathV1 + "/privacy_policy"
+	InstanceChooserPath       = InstanceInformationPathV1 + "/chooser"
+	InstanceTranslationPath   = InstanceInformationPathV1 + "/translation_languages"
 	PeersFilterKey            = "filter" // PeersFilterKey is used to provide filters to /api/v1/instance/peers
 )
 
@@ -43,11 +47,14 @@ func New(processor *processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
-	attachHandler(http.MethodGet, InstanceInformationPathV1, m.InstanceInformationGETHandlerV1)
-	attachHandler(http.MethodGet, InstanceInformationPathV2, m.InstanceInformationGETHandlerV2)
+	attachHandler(http.MethodGet, InstanceInformationPathV1, middleware.ETag(), m.InstanceInformationGETHandlerV1)
+	attachHandler(http.MethodGet, InstanceInformationPathV2, middleware.ETag(), m.InstanceInformationGETHandlerV2)
 
 	attachHandler(http.MethodPatch, InstanceInformationPathV1, m.InstanceUpdatePATCHHandler)
 	attachHandler(http.MethodGet, InstancePeersPath, m.InstancePeersGETHandler)
 
 	attachHandler(http.MethodGet, InstanceRulesPath, m.InstanceRulesGETHandler)
+	attachHandler(http.MethodGet, InstancePrivacyPolicyPath, m.InstancePrivacyPolicyGETHandler)
+	attachHandler(http.MethodGet, InstanceChooserPath, m.InstanceChooserGETHandler)
+	attachHandler(http.MethodGet, InstanceTranslationPath, m.InstanceTranslationLanguagesGETHandler)
 }
@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package instance_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/instance"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type InstanceChooserGetTestSuite struct {
+	InstanceStandardTestSuite
+}
+
+func (suite *InstanceChooserGetTestSuite) TestInstanceChooserGet() {
+	config.SetInstanceLanguages([]string{"nl", "en-GB"})
+	config.SetInstanceCategories([]string{"tech", "music"})
+
+	recorder := httptest.NewRecorder()
+	baseURI := fmt.Sprintf("%s://%s", config.GetProtocol(), config.GetHost())
+	requestURI := fmt.Sprintf("%s/api%s", baseURI, instance.InstanceChooserPath)
+	ctx := suite.newContext(recorder, http.MethodGet, requestURI, nil, "", false)
+
+	suite.instanceModule.InstanceChooserGETHandler(ctx)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`{
+  "domain": "localhost:8080",
+  "title": "GoToSocial Testrig Instance",
+  "short_description": "\u003cp\u003eThis is the GoToSocial testrig. It doesn't federate or anything.\u003c/p\u003e\u003cp\u003eWhen the testrig is shut down, all data on it will be deleted.\u003c/p\u003e\u003cp\u003eDon't use this in production!\u003c/p\u003e",
+  "thumbnail": "http://localhost:8080/assets/logo.png",
+  "version": "0.0.0-testrig",
+  "languages": [
+    "nl",
+    "en-GB"
+  ],
+  "categories": [
+    "tech",
+    "music"
+  ],
+  "registrations": {
+    "open": true,
+    "approval_required": true,
+    "reason_required": true,
+    "invite_required": false
+  },
+  "rules": [
+    {
+      "id": "01GP3AWY4CRDVRNZKW0TEAMB51",
+      "text": "Be gay"
+    },
+    {
+      "id": "01GP3DFY9XQ1TJMZT5BGAZPXX3",
+      "text": "Do crime"
+    }
+  ],
+  "usage": {
+    "users": 4,
+    "active_month": 0
+  }
+}`, dst.String())
+}
+
+func TestInstanceChooserGetTestSuite(t *testing.T) {
+	suite.Run(t, &InstanceChooserGetTestSuite{})
+}
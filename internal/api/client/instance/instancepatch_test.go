@@ -91,6 +91,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -108,7 +109,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -119,7 +121,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "emojis": {
       "emoji_size_limit": 51200
@@ -141,7 +144,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -205,6 +211,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -222,7 +229,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -233,7 +241,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "emojis": {
       "emoji_size_limit": 51200
@@ -255,7 +264,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -319,6 +331,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -336,7 +349,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -347,7 +361,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "emojis": {
       "emoji_size_limit": 51200
@@ -369,7 +384,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -484,6 +502,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -501,7 +520,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -512,7 +532,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "emojis": {
       "emoji_size_limit": 51200
@@ -534,7 +555,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -620,6 +644,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -637,7 +662,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -648,7 +674,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "emojis": {
       "emoji_size_limit": 51200
@@ -672,7 +699,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -771,6 +801,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -788,7 +819,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -799,7 +831,8 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "emojis": {
       "emoji_size_limit": 51200
@@ -821,7 +854,10 @@ func (suite *InstancePatchTestSuite) TestInstancePatch9() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
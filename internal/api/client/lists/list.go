@@ -30,6 +30,9 @@ const (
 	BasePath       = "/v1/lists"
 	BasePathWithID = BasePath + "/:" + IDKey
 	AccountsPath   = BasePathWithID + "/accounts"
+	RuleIDKey      = "rule_id"
+	RulesPath      = BasePathWithID + "/rules"
+	RulePathWithID = RulesPath + "/:" + RuleIDKey
 	MaxIDKey       = "max_id"
 	LimitKey       = "limit"
 	SinceIDKey     = "since_id"
@@ -58,4 +61,9 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, AccountsPath, m.ListAccountsGETHandler)
 	attachHandler(http.MethodPost, AccountsPath, m.ListAccountsPOSTHandler)
 	attachHandler(http.MethodDelete, AccountsPath, m.ListAccountsDELETEHandler)
+
+	// get / add / remove list rules
+	attachHandler(http.MethodGet, RulesPath, m.ListRulesGETHandler)
+	attachHandler(http.MethodPost, RulesPath, m.ListRulePOSTHandler)
+	attachHandler(http.MethodDelete, RulePathWithID, m.ListRuleDELETEHandler)
 }
@@ -69,11 +69,27 @@ import (
 //		type: string
 //		default: "0,0"
 //	-
+//		name: sensitive
+//		in: formData
+//		description: >-
+//			Mark this media attachment individually as sensitive, so that it's hidden
+//			behind a warning even if the status it's attached to is not marked as sensitive.
+//		type: boolean
+//		default: false
+//	-
 //		name: file
 //		in: formData
 //		description: The media attachment to upload.
 //		type: file
 //		required: true
+//	-
+//		name: Idempotency-Key
+//		type: string
+//		in: header
+//		description: >-
+//			Key identifying this request, to avoid creating duplicates when retrying it.
+//			If the request is retried with the same key within an hour, the attachment
+//			created by the first request is returned.
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -88,6 +104,8 @@ import (
 //			description: bad request
 //		'401':
 //			description: unauthorized
+//		'409':
+//			description: request with this Idempotency-Key is still being processed
 //		'422':
 //			description: unprocessable
 //		'500':
@@ -124,7 +142,13 @@ func (m *Module) MediaCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiAttachment, errWithCode := m.processor.Media().Create(c.Request.Context(), authed.Account, form)
+	idempotencyKey, errWithCode := apiutil.IdempotencyKey(c)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiAttachment, errWithCode := m.processor.Media().Create(c.Request.Context(), authed.Account, form, idempotencyKey)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
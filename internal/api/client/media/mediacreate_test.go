@@ -29,6 +29,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"codeberg.org/gruf/go-bytesize"
 	"github.com/stretchr/testify/suite"
 	mediamodule "github.com/superseriousbusiness/gotosocial/internal/api/client/media"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -45,6 +46,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -388,6 +390,224 @@ func (suite *MediaCreateTestSuite) TestMediaCreateTooShortDescription() {
 	suite.EqualValues(http.StatusOK, recorder.Code)
 }
 
+func (suite *MediaCreateTestSuite) TestMediaCreateQuotaExceeded() {
+	account := suite.testAccounts["local_account_1"]
+
+	// set the quota to just above what's already used
+	used, err := suite.db.GetAccountAttachmentsSize(context.Background(), account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	config.SetMediaLocalQuota(bytesize.Size(used + 1024))
+	defer config.SetMediaLocalQuota(0)
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+
+	// create the request
+	buf, w, err := testrig.CreateMultipartFormData("file", "../../../../testrig/media/test-jpeg.jpg", map[string]string{
+		"description": "this is a test image -- a cool background from somewhere",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPost, "http://localhost:8080/api/v1/media", bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+
+	// do the actual request
+	suite.mediaModule.MediaCreatePOSTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusUnprocessableEntity, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	suite.Equal(`{"error":"Unprocessable Entity: upload of 269739 bytes would exceed remaining media quota of 1024 bytes"}`, string(b))
+}
+
+func (suite *MediaCreateTestSuite) TestMediaCreateQuotaOverrideExceeded() {
+	account := suite.testAccounts["local_account_1"]
+
+	// no instance quota, but override the quota of
+	// this user to just above what's already used
+	used, err := suite.db.GetAccountAttachmentsSize(context.Background(), account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+	user.MediaQuota = util.Ptr(used + 1024)
+	if err := suite.db.UpdateUser(context.Background(), user, "media_quota"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+
+	// create the request
+	buf, w, err := testrig.CreateMultipartFormData("file", "../../../../testrig/media/test-jpeg.jpg", map[string]string{
+		"description": "this is a test image -- a cool background from somewhere",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPost, "http://localhost:8080/api/v1/media", bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+
+	// do the actual request
+	suite.mediaModule.MediaCreatePOSTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusUnprocessableEntity, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	suite.Equal(`{"error":"Unprocessable Entity: upload of 269739 bytes would exceed remaining media quota of 1024 bytes"}`, string(b))
+}
+
+func (suite *MediaCreateTestSuite) TestMediaCreateInfected() {
+	account := suite.testAccounts["local_account_1"]
+
+	// use a scan command which
+	// reports every file infected
+	config.SetMediaScanCommand("false")
+	defer config.SetMediaScanCommand("")
+
+	mediaManager := testrig.NewTestMediaManager(&suite.state)
+	processor := testrig.NewTestProcessor(&suite.state, suite.federator, suite.emailSender, mediaManager)
+	mediaModule := mediamodule.New(processor)
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+
+	// create the request
+	buf, w, err := testrig.CreateMultipartFormData("file", "../../../../testrig/media/test-jpeg.jpg", map[string]string{
+		"description": "this is a test image -- a cool background from somewhere",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPost, "http://localhost:8080/api/v1/media", bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+
+	// do the actual request
+	mediaModule.MediaCreatePOSTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusUnprocessableEntity, recorder.Code)
+
+	// a report should have been opened against the uploader
+	instanceAccount, err := suite.db.GetInstanceAccount(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reports, err := suite.db.GetReports(context.Background(), nil, instanceAccount.ID, account.ID, "", "", "", 10)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reports, 1)
+	suite.Equal("Media scanner found unknown in uploaded media, which was rejected.", reports[0].Comment)
+	suite.False(*reports[0].Forwarded)
+}
+
+func (suite *MediaCreateTestSuite) TestMediaUploadCheck() {
+	account := suite.testAccounts["local_account_1"]
+
+	used, err := suite.db.GetAccountAttachmentsSize(context.Background(), account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	config.SetMediaLocalQuota(bytesize.Size(used + 1024))
+	defer config.SetMediaLocalQuota(0)
+
+	for _, test := range []struct {
+		query    string
+		accepted *bool
+		reason   string
+	}{
+		{
+			// No size given, just limits.
+			query: "",
+		},
+		{
+			query:    "size=512&type=image/png",
+			accepted: util.Ptr(true),
+		},
+		{
+			query:    "size=2048&type=image/png",
+			accepted: util.Ptr(false),
+			reason:   "upload of 2048 bytes would exceed remaining media quota of 1024 bytes",
+		},
+		{
+			query:    "size=512&type=application/pdf",
+			accepted: util.Ptr(false),
+			reason:   "mime type application/pdf is not supported",
+		},
+	} {
+		t := suite.testTokens["local_account_1"]
+		oauthToken := oauth.DBTokenToToken(t)
+		recorder := httptest.NewRecorder()
+		ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+		ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+		ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+		ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+		ctx.Set(oauth.SessionAuthorizedAccount, account)
+		ctx.Request = httptest.NewRequest(http.MethodGet, "http://localhost:8080/api/v1/media/upload_check?"+test.query, nil)
+		ctx.Request.Header.Set("accept", "application/json")
+		ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+
+		suite.mediaModule.MediaUploadCheckGETHandler(ctx)
+		suite.EqualValues(http.StatusOK, recorder.Code)
+
+		check := &apimodel.MediaUploadCheck{}
+		if err := json.NewDecoder(recorder.Body).Decode(check); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		suite.Equal(used+1024, *check.QuotaLimit)
+		suite.Equal(used, check.QuotaUsed)
+		suite.EqualValues(1024, *check.QuotaRemaining)
+		suite.False(check.AsyncProcessing)
+		suite.Equal(test.accepted, check.Accepted)
+		suite.Equal(test.reason, check.Reason)
+	}
+}
+
 func TestMediaCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(MediaCreateTestSuite))
 }
@@ -36,6 +36,9 @@ import (
 //
 // You must own the media attachment, and the attachment must not yet be attached to a status.
 //
+// Images can also be rotated and / or cropped, in which case the attachment will be
+// reprocessed from its stored original, and a new thumbnail and blurhash generated.
+//
 // The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
 // The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
 //
@@ -77,6 +80,32 @@ import (
 //		type: string
 //		allowEmptyValue: true
 //		default: "0,0"
+//	-
+//		name: sensitive
+//		in: formData
+//		description: >-
+//			Mark this media attachment individually as sensitive, so that it's hidden
+//			behind a warning even if the status it's attached to is not marked as sensitive.
+//		type: boolean
+//	-
+//		name: rotate
+//		in: formData
+//		description: >-
+//			Rotate the image clockwise by this many degrees.
+//			Must be one of 0, 90, 180, or 270.
+//			Only JPEG, PNG, and WebP images can be rotated.
+//		type: integer
+//		default: 0
+//	-
+//		name: crop
+//		in: formData
+//		description: >-
+//			Crop the image (after any rotation) to the given rectangle.
+//			If present, it should be in the form of four comma-separated integers,
+//			giving the x and y coordinates of the top-left corner, then the width and height, in pixels.
+//			For example: `10,20,640,480`.
+//			Only JPEG, PNG, and WebP images can be cropped.
+//		type: string
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -95,6 +124,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable - attachment cannot be edited
 //		'500':
 //			description: internal server error
 func (m *Module) MediaPUTHandler(c *gin.Context) {
@@ -154,8 +185,16 @@ func validateUpdateMedia(form *apimodel.AttachmentUpdateRequest) error {
 		}
 	}
 
-	if form.Focus == nil && form.Description == nil {
-		return errors.New("focus and description were both nil, there's nothing to update")
+	if form.Rotate != nil {
+		switch *form.Rotate {
+		case 0, 90, 180, 270:
+		default:
+			return fmt.Errorf("rotate must be one of 0, 90, 180, or 270, but provided rotate was %d", *form.Rotate)
+		}
+	}
+
+	if form.Focus == nil && form.Description == nil && form.Sensitive == nil && form.Rotate == nil && form.Crop == nil {
+		return errors.New("focus, description, sensitive, rotate, and crop were all nil, there's nothing to update")
 	}
 
 	return nil
@@ -19,6 +19,7 @@ package media_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -193,6 +194,180 @@ func (suite *MediaUpdateTestSuite) TestUpdateImage() {
 	suite.NotEmpty(toUpdate.Thumbnail.URL, attachmentReply.PreviewURL)
 }
 
+func (suite *MediaUpdateTestSuite) TestUpdateImageSensitive() {
+	toUpdate := suite.testAttachments["local_account_1_unattached_1"]
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+
+	// create the request, updating only the sensitive flag
+	buf, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"id":        toUpdate.ID,
+		"sensitive": "true",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("http://localhost:8080/api/v1/media/%s", toUpdate.ID), bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+	ctx.AddParam(mediamodule.IDKey, toUpdate.ID)
+
+	// do the actual request
+	suite.mediaModule.MediaPUTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	attachmentReply := &apimodel.Attachment{}
+	err = json.Unmarshal(recorder.Body.Bytes(), attachmentReply)
+	suite.NoError(err)
+
+	// the reply should be sensitive, with description untouched
+	suite.True(attachmentReply.Sensitive)
+	suite.Equal(toUpdate.Description, *attachmentReply.Description)
+
+	// the change should be stored in the db
+	dbAttachment, err := suite.db.GetAttachmentByID(context.Background(), toUpdate.ID)
+	suite.NoError(err)
+	suite.True(dbAttachment.IsSensitive())
+}
+
+func (suite *MediaUpdateTestSuite) TestUpdateImageRotateCrop() {
+	toUpdate := suite.testAttachments["local_account_1_unattached_1"]
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+
+	// create the request, rotating the 800x450 image
+	// to 450x800, and then cropping it to 400x300
+	buf, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"id":     toUpdate.ID,
+		"rotate": "90",
+		"crop":   "25,100,400,300",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("http://localhost:8080/api/v1/media/%s", toUpdate.ID), bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+	ctx.AddParam(mediamodule.IDKey, toUpdate.ID)
+
+	// do the actual request
+	suite.mediaModule.MediaPUTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	attachmentReply := &apimodel.Attachment{}
+	err = json.Unmarshal(recorder.Body.Bytes(), attachmentReply)
+	suite.NoError(err)
+
+	// the reply should have the new dimensions,
+	// with a new blurhash and description untouched
+	suite.Equal(400, attachmentReply.Meta.Original.Width)
+	suite.Equal(300, attachmentReply.Meta.Original.Height)
+	suite.NotEqual(toUpdate.Blurhash, attachmentReply.Blurhash)
+	suite.Equal(toUpdate.Description, *attachmentReply.Description)
+	suite.Equal(toUpdate.URL, *attachmentReply.URL)
+
+	// the change should be stored in the db
+	dbAttachment, err := suite.db.GetAttachmentByID(context.Background(), toUpdate.ID)
+	suite.NoError(err)
+	suite.Equal(400, dbAttachment.FileMeta.Original.Width)
+	suite.Equal(300, dbAttachment.FileMeta.Original.Height)
+	suite.Equal(gtsmodel.ProcessingStatusProcessed, dbAttachment.Processing)
+}
+
+func (suite *MediaUpdateTestSuite) TestUpdateImageCropOutOfBounds() {
+	toUpdate := suite.testAttachments["local_account_1_unattached_1"]
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+
+	// create the request, cropping the 800x450
+	// image to a rectangle outside its bounds
+	buf, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"id":   toUpdate.ID,
+		"crop": "500,0,400,300",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("http://localhost:8080/api/v1/media/%s", toUpdate.ID), bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+	ctx.AddParam(mediamodule.IDKey, toUpdate.ID)
+
+	// do the actual request
+	suite.mediaModule.MediaPUTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+	suite.Equal(`{"error":"Bad Request: crop 500,0,400,300 is out of bounds for 800x450 image"}`, recorder.Body.String())
+}
+
+func (suite *MediaUpdateTestSuite) TestUpdateImageRotateAttached() {
+	toUpdate := suite.testAttachments["local_account_1_status_4_attachment_1"]
+
+	// set up the context for the request
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+
+	// create the request, rotating an attachment
+	// that's already attached to a status
+	buf, w, err := testrig.CreateMultipartFormData("", "", map[string]string{
+		"id":     toUpdate.ID,
+		"rotate": "180",
+	})
+	if err != nil {
+		panic(err)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("http://localhost:8080/api/v1/media/%s", toUpdate.ID), bytes.NewReader(buf.Bytes())) // the endpoint we're hitting
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam(apiutil.APIVersionKey, apiutil.APIv1)
+	ctx.AddParam(mediamodule.IDKey, toUpdate.ID)
+
+	// do the actual request
+	suite.mediaModule.MediaPUTHandler(ctx)
+
+	// check response
+	suite.EqualValues(http.StatusUnprocessableEntity, recorder.Code)
+	suite.Equal(`{"error":"Unprocessable Entity: attachment is already attached to a status and can no longer be edited"}`, recorder.Body.String())
+}
+
 func (suite *MediaUpdateTestSuite) TestUpdateImageShortDescription() {
 	// set the min description length
 	config.SetMediaDescriptionMinChars(50)
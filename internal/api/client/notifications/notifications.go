@@ -31,8 +31,13 @@ const (
 	BasePath = "/v1/notifications"
 	// BasePathWithID is just the base path with the ID key in it.
 	// Use this anywhere you need to know the ID of the notification being queried.
-	BasePathWithID    = BasePath + "/:" + IDKey
-	BasePathWithClear = BasePath + "/clear"
+	BasePathWithID      = BasePath + "/:" + IDKey
+	BasePathWithClear   = BasePath + "/clear"
+	BasePathWithDismiss = BasePath + "/dismiss"
+	// BasePathWithUnreadCount is the path for counting unread notifications.
+	BasePathWithUnreadCount = BasePath + "/unread_count"
+	// BasePathV2 is the base path for serving grouped notifications, minus the 'api' prefix.
+	BasePathV2 = "/v2/notifications"
 
 	// ExcludeTypes is an array specifying notification types to exclude
 	ExcludeTypesKey = "exclude_types[]"
@@ -40,6 +45,11 @@ const (
 	LimitKey        = "limit"
 	SinceIDKey      = "since_id"
 	MinIDKey        = "min_id"
+
+	// GroupedTypesKey is an array specifying notification types to group
+	GroupedTypesKey = "grouped_types[]"
+	// TypesKey is an array specifying notification types to include
+	TypesKey = "types[]"
 )
 
 type Module struct {
@@ -56,4 +66,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, BasePath, m.NotificationsGETHandler)
 	attachHandler(http.MethodGet, BasePathWithID, m.NotificationGETHandler)
 	attachHandler(http.MethodPost, BasePathWithClear, m.NotificationsClearPOSTHandler)
+	attachHandler(http.MethodPost, BasePathWithDismiss, m.NotificationsDismissPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithUnreadCount, m.NotificationsUnreadCountGETHandler)
+	attachHandler(http.MethodGet, BasePathV2, m.NotificationGroupsGETHandler)
 }
@@ -119,7 +119,10 @@ func (suite *ReportGetTestSuite) TestGetReport1() {
     "display_name": "big gerald",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2021-09-26T10:52:36.000Z",
     "note": "i post about like, i dunno, stuff, or whatever!!!!",
     "url": "http://fossbros-anonymous.io/@foss_satan",
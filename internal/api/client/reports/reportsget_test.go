@@ -144,7 +144,10 @@ func (suite *ReportsGetTestSuite) TestGetReports() {
       "display_name": "big gerald",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2021-09-26T10:52:36.000Z",
       "note": "i post about like, i dunno, stuff, or whatever!!!!",
       "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -234,7 +237,10 @@ func (suite *ReportsGetTestSuite) TestGetReports4() {
       "display_name": "big gerald",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2021-09-26T10:52:36.000Z",
       "note": "i post about like, i dunno, stuff, or whatever!!!!",
       "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -308,7 +314,10 @@ func (suite *ReportsGetTestSuite) TestGetReports6() {
       "display_name": "big gerald",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2021-09-26T10:52:36.000Z",
       "note": "i post about like, i dunno, stuff, or whatever!!!!",
       "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -366,7 +375,10 @@ func (suite *ReportsGetTestSuite) TestGetReports7() {
       "display_name": "big gerald",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2021-09-26T10:52:36.000Z",
       "note": "i post about like, i dunno, stuff, or whatever!!!!",
       "url": "http://fossbros-anonymous.io/@foss_satan",
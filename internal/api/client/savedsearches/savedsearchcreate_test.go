@@ -0,0 +1,214 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package savedsearches_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/savedsearches"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SavedSearchCreateTestSuite struct {
+	SavedSearchesStandardTestSuite
+}
+
+// savedSearchRequest calls the given handler as local_account_1,
+// with the given method, saved search ID and form, and returns
+// the response code and body.
+func (suite *SavedSearchCreateTestSuite) savedSearchRequest(
+	handler gin.HandlerFunc,
+	method string,
+	savedSearchID string,
+	form url.Values,
+) (int, []byte) {
+	var (
+		recorder = httptest.NewRecorder()
+		ctx, _   = testrig.CreateGinTestContext(recorder, nil)
+	)
+
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	requestPath := config.GetProtocol() + "://" + config.GetHost() + "/api" + savedsearches.BasePath
+	if savedSearchID != "" {
+		ctx.AddParam(savedsearches.IDKey, savedSearchID)
+		requestPath += "/" + savedSearchID
+	}
+
+	request := httptest.NewRequest(method, requestPath, strings.NewReader(form.Encode()))
+	request.Header.Set("accept", "application/json")
+	request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx.Request = request
+
+	handler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return recorder.Code, b
+}
+
+func (suite *SavedSearchCreateTestSuite) TestSavedSearchLifecycle() {
+	// Save a search.
+	code, b := suite.savedSearchRequest(suite.savedSearchesModule.SavedSearchCreatePOSTHandler, http.MethodPost, "", url.Values{
+		"title":  {" my pics "},
+		"query":  {"from:me has:media"},
+		"notify": {"true"},
+	})
+	suite.Equal(http.StatusOK, code, string(b))
+
+	savedSearch := &apimodel.SavedSearch{}
+	if err := json.Unmarshal(b, savedSearch); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(savedSearch.ID)
+	suite.Equal("my pics", savedSearch.Title)
+	suite.Equal("from:me has:media", savedSearch.Query)
+	suite.True(savedSearch.Notify)
+
+	// List all saved searches.
+	code, b = suite.savedSearchRequest(suite.savedSearchesModule.SavedSearchesGETHandler, http.MethodGet, "", nil)
+	suite.Equal(http.StatusOK, code, string(b))
+
+	all := []*apimodel.SavedSearch{}
+	if err := json.Unmarshal(b, &all); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(all, 1) {
+		suite.Equal(savedSearch.ID, all[0].ID)
+	}
+
+	// Delete it again.
+	code, b = suite.savedSearchRequest(suite.savedSearchesModule.SavedSearchDELETEHandler, http.MethodDelete, savedSearch.ID, nil)
+	suite.Equal(http.StatusOK, code, string(b))
+
+	code, _ = suite.savedSearchRequest(suite.savedSearchesModule.SavedSearchDELETEHandler, http.MethodDelete, savedSearch.ID, nil)
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *SavedSearchCreateTestSuite) TestSavedSearchCreateBadOperator() {
+	code, b := suite.savedSearchRequest(suite.savedSearchesModule.SavedSearchCreatePOSTHandler, http.MethodPost, "", url.Values{
+		"title": {"polls"},
+		"query": {"has:poll"},
+	})
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: has: operator value poll not recognized, valid options are ['media']"}`, string(b))
+}
+
+func (suite *SavedSearchCreateTestSuite) TestSavedSearchCreateNoQuery() {
+	code, b := suite.savedSearchRequest(suite.savedSearchesModule.SavedSearchCreatePOSTHandler, http.MethodPost, "", url.Values{
+		"title": {"nothing"},
+		"query": {"   "},
+	})
+	suite.Equal(http.StatusBadRequest, code)
+	suite.Equal(`{"error":"Bad Request: no query provided"}`, string(b))
+}
+
+func (suite *SavedSearchCreateTestSuite) TestSavedSearchNotify() {
+	var (
+		ctx     = context.Background()
+		account = suite.testAccounts["local_account_1"]
+	)
+
+	// Save a search for replies from admin.
+	code, b := suite.savedSearchRequest(suite.savedSearchesModule.SavedSearchCreatePOSTHandler, http.MethodPost, "", url.Values{
+		"title":  {"admin says"},
+		"query":  {"from:@admin"},
+		"notify": {"true"},
+	})
+	suite.Equal(http.StatusOK, code, string(b))
+
+	apiSavedSearch := &apimodel.SavedSearch{}
+	if err := json.Unmarshal(b, apiSavedSearch); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Nothing new has been posted since the
+	// search was saved, so no notifications.
+	if err := suite.processor.Search().SavedSearchesCheck(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(suite.savedSearchNotifications(account.ID))
+
+	// Pretend the search was saved
+	// before any statuses existed.
+	savedSearch, err := suite.db.GetSavedSearchByID(ctx, apiSavedSearch.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	savedSearch.LastCheckedID = id.Lowest
+	if err := suite.db.UpdateSavedSearch(ctx, savedSearch, "last_checked_id"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.processor.Search().SavedSearchesCheck(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	notifs := suite.savedSearchNotifications(account.ID)
+	if suite.Len(notifs, 1) {
+		suite.Equal(suite.testAccounts["admin_account"].ID, notifs[0].OriginAccountID)
+	}
+
+	// Checking again shouldn't notify twice.
+	if err := suite.processor.Search().SavedSearchesCheck(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(suite.savedSearchNotifications(account.ID), 1)
+}
+
+func (suite *SavedSearchCreateTestSuite) savedSearchNotifications(accountID string) []*gtsmodel.Notification {
+	notifs, err := suite.db.GetAccountNotifications(context.Background(), accountID, "", "", "", 0, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	var savedSearchNotifs []*gtsmodel.Notification
+	for _, notif := range notifs {
+		if notif.NotificationType == gtsmodel.NotificationSavedSearch {
+			savedSearchNotifs = append(savedSearchNotifs, notif)
+		}
+	}
+	return savedSearchNotifs
+}
+
+func TestSavedSearchCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(SavedSearchCreateTestSuite))
+}
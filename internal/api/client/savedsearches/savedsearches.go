@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package savedsearches
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	IDKey = "id"
+	// BasePath is the base path for serving the saved searches API, minus the 'api' prefix
+	BasePath       = "/v1/saved_searches"
+	BasePathWithID = BasePath + "/:" + IDKey
+
+	maxTitleChars = 100
+	maxQueryChars = 500
+)
+
+type Module struct {
+	processor *processing.Processor
+}
+
+func New(processor *processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// create / get / delete saved searches
+	attachHandler(http.MethodPost, BasePath, m.SavedSearchCreatePOSTHandler)
+	attachHandler(http.MethodGet, BasePath, m.SavedSearchesGETHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.SavedSearchDELETEHandler)
+}
+
+// validateNormalizeSavedSearch trims the given saved
+// search form, and checks that it's within limits.
+func validateNormalizeSavedSearch(form *apimodel.SavedSearchCreateRequest) error {
+	form.Title = strings.TrimSpace(form.Title)
+	form.Query = strings.TrimSpace(form.Query)
+
+	if form.Title == "" {
+		return errors.New("no title provided")
+	}
+
+	if length := len([]rune(form.Title)); length > maxTitleChars {
+		return fmt.Errorf("title too long, %d characters provided but limit is %d", length, maxTitleChars)
+	}
+
+	if form.Query == "" {
+		return errors.New("no query provided")
+	}
+
+	if length := len([]rune(form.Query)); length > maxQueryChars {
+		return fmt.Errorf("query too long, %d characters provided but limit is %d", length, maxQueryChars)
+	}
+
+	return nil
+}
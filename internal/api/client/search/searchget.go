@@ -99,6 +99,12 @@ import (
 //			- `https://example.org/some/arbitrary/url` -- search for an account OR a status with the given URL. Will only ever return 1 result at most.
 //			- `#[hashtag_name]` -- search for a hashtag with the given hashtag name, or starting with the given hashtag name. Case insensitive. Can return multiple results.
 //			- any arbitrary string -- search for accounts or statuses containing the given string. Can return multiple results.
+//			Status searches can be narrowed down with the following operators, which can be combined with each other and with arbitrary text:
+//			- `from:me`, `from:@[username]`, `from:@[username]@[domain]` -- only statuses created by the given account.
+//			- `before:YYYY-MM-DD` -- only statuses created before the given date (UTC).
+//			- `after:YYYY-MM-DD` -- only statuses created after the given date (UTC).
+//			- `has:media` -- only statuses with media attachments.
+//			If the query contains operators, only statuses will be returned.
 //		in: query
 //		required: true
 //	-
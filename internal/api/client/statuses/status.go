@@ -27,6 +27,8 @@ import (
 const (
 	// IDKey is for status UUIDs
 	IDKey = "id"
+	// EmojiKey is for emoji reactions
+	EmojiKey = "emoji"
 	// BasePath is the base path for serving the statuses API, minus the 'api' prefix
 	BasePath = "/v1/statuses"
 	// BasePathWithID is just the base path with the ID key in it.
@@ -61,9 +63,19 @@ const (
 	PinPath = BasePathWithID + "/pin"
 	// UnpinPath is for undoing a pin and returning a status to the ever-swirling drain of time and entropy
 	UnpinPath = BasePathWithID + "/unpin"
+	// PinsOrderPath is for reordering the pinned statuses of the requesting account
+	PinsOrderPath = BasePath + "/pins/order"
+
+	// ReactionsPath is for seeing who's reacted to a given status with which emoji
+	ReactionsPath = BasePathWithID + "/reactions"
+	// ReactionPath is for adding or removing an emoji reaction to/from a given status
+	ReactionPath = ReactionsPath + "/:" + EmojiKey
 
 	// ContextPath is used for fetching context of posts
 	ContextPath = BasePathWithID + "/context"
+
+	// TranslatePath is for translating a status into another language
+	TranslatePath = BasePathWithID + "/translate"
 )
 
 type Module struct {
@@ -90,6 +102,7 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	// pin stuff
 	attachHandler(http.MethodPost, PinPath, m.StatusPinPOSTHandler)
 	attachHandler(http.MethodPost, UnpinPath, m.StatusUnpinPOSTHandler)
+	attachHandler(http.MethodPost, PinsOrderPath, m.StatusPinsOrderPOSTHandler)
 
 	// reblog stuff
 	attachHandler(http.MethodPost, ReblogPath, m.StatusBoostPOSTHandler)
@@ -98,6 +111,14 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPost, BookmarkPath, m.StatusBookmarkPOSTHandler)
 	attachHandler(http.MethodPost, UnbookmarkPath, m.StatusUnbookmarkPOSTHandler)
 
+	// reaction stuff
+	attachHandler(http.MethodPut, ReactionPath, m.StatusReactionPUTHandler)
+	attachHandler(http.MethodDelete, ReactionPath, m.StatusReactionDELETEHandler)
+	attachHandler(http.MethodGet, ReactionsPath, m.StatusReactionsGETHandler)
+
 	// context / status thread
 	attachHandler(http.MethodGet, ContextPath, m.StatusContextGETHandler)
+
+	// translation
+	attachHandler(http.MethodPost, TranslatePath, m.StatusTranslatePOSTHandler)
 }
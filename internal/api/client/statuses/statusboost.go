@@ -19,9 +19,11 @@ package statuses
 
 import (
 	"errors"
+	"io"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
@@ -34,10 +36,18 @@ import (
 // If the target status is rebloggable/boostable, it will be shared with your followers.
 // This is equivalent to an ActivityPub 'Announce' activity.
 //
+// The visibility of the boost can optionally be set using the `visibility` parameter.
+// This may be narrower than, but never wider than, the visibility of the target status.
+// Followers-only statuses can only be boosted by their author, to their followers.
+//
 //	---
 //	tags:
 //	- statuses
 //
+//	consumes:
+//	- application/json
+//	- application/x-www-form-urlencoded
+//
 //	produces:
 //	- application/json
 //
@@ -48,6 +58,13 @@ import (
 //		description: Target status ID.
 //		in: path
 //		required: true
+//	-
+//		name: visibility
+//		type: string
+//		description: >-
+//			Visibility of the boost. One of `public`, `unlisted`, or `private`.
+//			Defaults to the visibility of the target status.
+//		in: formData
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -69,6 +86,10 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable entity
+//		'429':
+//			description: too many requests, toggled on and off again too quickly
 //		'500':
 //			description: internal server error
 func (m *Module) StatusBoostPOSTHandler(c *gin.Context) {
@@ -90,7 +111,21 @@ func (m *Module) StatusBoostPOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiStatus, errWithCode := m.processor.Status().BoostCreate(c.Request.Context(), authed.Account, authed.Application, targetStatusID)
+	form := &apimodel.StatusBoostRequest{}
+	if err := c.ShouldBind(form); err != nil && !errors.Is(err, io.EOF) {
+		// Tolerate empty body (io.EOF),
+		// since visibility is optional.
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	apiStatus, errWithCode := m.processor.Status().BoostCreate(
+		c.Request.Context(),
+		authed.Account,
+		authed.Application,
+		targetStatusID,
+		form.Visibility,
+	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
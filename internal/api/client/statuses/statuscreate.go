@@ -31,6 +31,10 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
+// maxLocationChars is the maximum length
+// of a free-text status location, in runes.
+const maxLocationChars = 100
+
 // StatusCreatePOSTHandler swagger:operation POST /api/v1/statuses statusCreate
 //
 // Create a new status.
@@ -38,6 +42,9 @@ import (
 // The parameters can also be given in the body of the request, as JSON, if the content-type is set to 'application/json'.
 // The parameters can also be given in the body of the request, as XML, if the content-type is set to 'application/xml'.
 //
+// If the request is retried with the same Idempotency-Key header within an hour, the status created
+// by the first request is returned instead of creating a duplicate.
+//
 //	---
 //	tags:
 //	- statuses
@@ -50,6 +57,13 @@ import (
 //	produces:
 //	- application/json
 //
+//	parameters:
+//	-
+//		name: Idempotency-Key
+//		type: string
+//		in: header
+//		description: Key identifying this request, to avoid creating duplicates when retrying it.
+//
 //	security:
 //	- OAuth2 Bearer:
 //		- write:statuses
@@ -69,6 +83,10 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'409':
+//			description: request with this Idempotency-Key is still being processed
+//		'422':
+//			description: Idempotency-Key was already used for a different request
 //		'500':
 //			description: internal server error
 func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
@@ -103,7 +121,13 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
-	apiStatus, errWithCode := m.processor.Status().Create(c.Request.Context(), authed.Account, authed.Application, form)
+	idempotencyKey, errWithCode := apiutil.IdempotencyKey(c)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	apiStatus, errWithCode := m.processor.Status().Create(c.Request.Context(), authed.Account, authed.Application, form, idempotencyKey)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -163,6 +187,12 @@ func validateNormalizeCreateStatus(form *apimodel.AdvancedStatusCreateForm) erro
 		}
 	}
 
+	if form.Location != "" {
+		if length := len([]rune(form.Location)); length > maxLocationChars {
+			return fmt.Errorf("location too long, %d characters provided but limit is %d", length, maxLocationChars)
+		}
+	}
+
 	if form.Language != "" {
 		language, err := validate.Language(form.Language)
 		if err != nil {
@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusReactionTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusReactionTestSuite) reactionContext(method string, path string, targetStatus *gtsmodel.Status, emoji string) (*gin.Context, *httptest.ResponseRecorder) {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+
+	path = strings.Replace(path, ":"+statuses.IDKey, targetStatus.ID, 1)
+	path = strings.Replace(path, ":"+statuses.EmojiKey, url.PathEscape(emoji), 1)
+	ctx.Request = httptest.NewRequest(method, fmt.Sprintf("http://localhost:8080/api%s", path), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatus.ID,
+		},
+		gin.Param{
+			Key:   statuses.EmojiKey,
+			Value: emoji,
+		},
+	}
+
+	return ctx, recorder
+}
+
+func (suite *StatusReactionTestSuite) react(targetStatus *gtsmodel.Status, emoji string) *apimodel.Status {
+	ctx, recorder := suite.reactionContext(http.MethodPut, statuses.ReactionPath, targetStatus, emoji)
+	suite.statusModule.StatusReactionPUTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiStatus := &apimodel.Status{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return apiStatus
+}
+
+func (suite *StatusReactionTestSuite) TestReactAndUnreact() {
+	targetStatus := suite.testStatuses["admin_account_status_2"]
+
+	suite.react(targetStatus, "🐢")
+	apiStatus := suite.react(targetStatus, ":rainbow:")
+
+	// Reacting twice with the same emoji is a no-op.
+	apiStatus2 := suite.react(targetStatus, ":rainbow:")
+	suite.Equal(apiStatus.EmojiReactions, apiStatus2.EmojiReactions)
+
+	if !suite.Len(apiStatus.EmojiReactions, 2) {
+		suite.FailNow("")
+	}
+	suite.Equal("🐢", apiStatus.EmojiReactions[0].Name)
+	suite.Equal(1, apiStatus.EmojiReactions[0].Count)
+	suite.True(apiStatus.EmojiReactions[0].Me)
+	suite.Empty(apiStatus.EmojiReactions[0].URL)
+	suite.Equal(":rainbow:", apiStatus.EmojiReactions[1].Name)
+	suite.Equal(testrig.NewTestEmojis()["rainbow"].ImageURL, apiStatus.EmojiReactions[1].URL)
+
+	// Check the list of reactions + accounts.
+	ctx, recorder := suite.reactionContext(http.MethodGet, statuses.ReactionsPath, targetStatus, "")
+	suite.statusModule.StatusReactionsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiReactions := []apimodel.StatusReaction{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &apiReactions); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(apiReactions, 2)
+	for _, apiReaction := range apiReactions {
+		if suite.Len(apiReaction.Accounts, 1) {
+			suite.Equal(suite.testAccounts["local_account_1"].ID, apiReaction.Accounts[0].ID)
+		}
+	}
+
+	// Remove one of the reactions.
+	ctx, recorder = suite.reactionContext(http.MethodDelete, statuses.ReactionPath, targetStatus, "🐢")
+	suite.statusModule.StatusReactionDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiStatus = &apimodel.Status{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), apiStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(apiStatus.EmojiReactions, 1) {
+		suite.Equal(":rainbow:", apiStatus.EmojiReactions[0].Name)
+	}
+}
+
+func (suite *StatusReactionTestSuite) TestReactInvalid() {
+	targetStatus := suite.testStatuses["admin_account_status_2"]
+
+	for emoji, expect := range map[string]string{
+		"hello":              `{"error":"Bad Request: emoji reaction hello did not pass validation, must be a unicode emoji or custom emoji shortcode"}`,
+		":does_not_exist:":   `{"error":"Unprocessable Entity: custom emoji :does_not_exist: not found"}`,
+		":blob cat with sp:": `{"error":"Bad Request: emoji reaction :blob cat with sp: did not pass validation, custom emoji must be a valid shortcode wrapped in colons"}`,
+	} {
+		ctx, recorder := suite.reactionContext(http.MethodPut, statuses.ReactionPath, targetStatus, emoji)
+		suite.statusModule.StatusReactionPUTHandler(ctx)
+
+		b, err := io.ReadAll(recorder.Body)
+		suite.NoError(err)
+		suite.Equal(expect, string(b))
+	}
+}
+
+func (suite *StatusReactionTestSuite) TestReactDisabled() {
+	config.SetInstanceEmojiReactions(false)
+	defer config.SetInstanceEmojiReactions(true)
+
+	targetStatus := suite.testStatuses["admin_account_status_2"]
+
+	ctx, recorder := suite.reactionContext(http.MethodPut, statuses.ReactionPath, targetStatus, "🐢")
+	suite.statusModule.StatusReactionPUTHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+	suite.Equal(`{"error":"Not Found"}`, recorder.Body.String())
+}
+
+func TestStatusReactionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusReactionTestSuite))
+}
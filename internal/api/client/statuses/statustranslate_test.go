@@ -0,0 +1,190 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package statuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// fakeTranslator "translates" texts by
+// prefixing them with the target language.
+type fakeTranslator struct {
+	calls int
+}
+
+func (f *fakeTranslator) Provider() string {
+	return "Fake"
+}
+
+func (f *fakeTranslator) Translate(_ context.Context, texts []string, source string, target string) ([]string, string, error) {
+	f.calls++
+
+	translated := make([]string, len(texts))
+	for i, text := range texts {
+		translated[i] = "[" + target + "] " + text
+	}
+
+	if source == "" {
+		source = "en"
+	}
+
+	return translated, source, nil
+}
+
+func (f *fakeTranslator) Languages(_ context.Context) (map[string][]string, error) {
+	return map[string][]string{
+		"en": {"de", "fr"},
+		"de": {"en", "fr"},
+	}, nil
+}
+
+type StatusTranslateTestSuite struct {
+	StatusStandardTestSuite
+	translator *fakeTranslator
+}
+
+func (suite *StatusTranslateTestSuite) SetupTest() {
+	suite.StatusStandardTestSuite.SetupTest()
+	suite.translator = &fakeTranslator{}
+	suite.state.Translator = suite.translator
+}
+
+func (suite *StatusTranslateTestSuite) TearDownTest() {
+	suite.state.Translator = nil
+	suite.StatusStandardTestSuite.TearDownTest()
+}
+
+func (suite *StatusTranslateTestSuite) translate(
+	targetStatusID string,
+	lang string,
+	expectedHTTPStatus int,
+	expectedBody string,
+) *apimodel.Translation {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	form := url.Values{}
+	if lang != "" {
+		form.Set("lang", lang)
+	}
+	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api/"+statuses.BasePath+"/"+targetStatusID+"/translate", strings.NewReader(form.Encode()))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx.AddParam(statuses.IDKey, targetStatusID)
+
+	// trigger the handler
+	suite.statusModule.StatusTranslatePOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+	if expectedBody != "" {
+		suite.Equal(expectedBody, string(b))
+		return nil
+	}
+
+	translation := &apimodel.Translation{}
+	if err := json.Unmarshal(b, translation); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return translation
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslate() {
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	translation := suite.translate(targetStatus.ID, "fr", http.StatusOK, "")
+	suite.Equal(&apimodel.Translation{
+		Content:                "[fr] 🐢 hi everyone i post about turtles 🐢",
+		SpoilerText:            "[fr] introduction post",
+		DetectedSourceLanguage: "en",
+		Language:               "fr",
+		Provider:               "Fake",
+	}, translation)
+
+	// Translating again should
+	// be served from the cache.
+	suite.translate(targetStatus.ID, "fr", http.StatusOK, "")
+	suite.Equal(1, suite.translator.calls)
+
+	// But not into another language.
+	suite.translate(targetStatus.ID, "DE", http.StatusOK, "")
+	suite.Equal(2, suite.translator.calls)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateIntoUserLocale() {
+	// The status is in English already,
+	// as is the locale of the user.
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	suite.translate(targetStatus.ID, "", http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status is already in en"}`)
+	suite.Zero(suite.translator.calls)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateUnsupportedLanguage() {
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	suite.translate(targetStatus.ID, "ja", http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: status cannot be translated into ja"}`)
+	suite.Zero(suite.translator.calls)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateFollowersOnly() {
+	targetStatus := suite.testStatuses["local_account_1_status_5"]
+
+	suite.translate(targetStatus.ID, "fr", http.StatusForbidden, `{"error":"Forbidden: only public or unlisted statuses can be translated"}`)
+	suite.Zero(suite.translator.calls)
+}
+
+func (suite *StatusTranslateTestSuite) TestTranslateDisabled() {
+	suite.state.Translator = nil
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	suite.translate(targetStatus.ID, "fr", http.StatusNotFound, `{"error":"Not Found"}`)
+}
+
+func TestStatusTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusTranslateTestSuite))
+}
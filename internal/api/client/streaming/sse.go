@@ -0,0 +1,272 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package streaming
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"codeberg.org/gruf/go-kv"
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	streampkg "github.com/superseriousbusiness/gotosocial/internal/stream"
+)
+
+// sseStreamTypes contains the stream types that
+// can be requested over server-sent events, by
+// path segments relative to the streaming basepath.
+var sseStreamTypes = map[string]struct{}{
+	streampkg.TimelineHome:          {},
+	streampkg.TimelineNotifications: {},
+	streampkg.TimelinePublic:        {},
+	streampkg.TimelineLocal:         {},
+	"hashtag":                       {},
+	"hashtag:local":                 {},
+	streampkg.TimelineList:          {},
+	streampkg.TimelineDirect:        {},
+}
+
+// StreamSSEGETHandler swagger:operation GET /api/v1/streaming/{stream} streamSSEGet
+//
+// Initiate a server-sent events connection for live streaming of statuses and notifications.
+//
+// This is an alternative to the websockets streaming API, for clients and reverse proxies that
+// don't support websockets well. The same events are streamed, but only for the requested stream.
+//
+// Each event is sent with `event` set to the event type, `data` set to its payload, and `id` set
+// to the event ID where it can be replayed. To replay missed events when reconnecting, pass the
+// last received event ID in the `Last-Event-ID` header, which browsers do by themselves, or as `since_id`.
+//
+// A `:thump` comment is sent every 30 seconds to keep the connection alive.
+//
+//	---
+//	tags:
+//	- streaming
+//
+//	produces:
+//	- text/event-stream
+//
+//	parameters:
+//	-
+//		name: stream
+//		type: string
+//		description: |-
+//			Type of stream to request.
+//
+//			Options are:
+//
+//			`user`: receive updates for the account's home timeline.
+//			`user/notification`: receive notifications only.
+//			`public`: receive updates for the public timeline.
+//			`public/local`: receive updates for the local timeline.
+//			`hashtag`: receive updates for a given hashtag.
+//			`hashtag/local`: receive local updates for a given hashtag.
+//			`list`: receive updates for a certain list of accounts.
+//			`direct`: receive updates for direct messages.
+//		in: path
+//		required: true
+//	-
+//		name: access_token
+//		type: string
+//		description: Access token for the requesting account, if not given in the Authorization header.
+//		in: query
+//	-
+//		name: list
+//		type: string
+//		description: |-
+//			ID of the list to subscribe to.
+//			Only used if stream type is 'list'.
+//		in: query
+//	-
+//		name: tag
+//		type: string
+//		description: |-
+//			Name of the tag to subscribe to.
+//			Only used if stream type is 'hashtag' or 'hashtag/local'.
+//		in: query
+//	-
+//		name: since_id
+//		type: string
+//		description: ID of the last event received before the connection dropped, to replay missed events.
+//		in: query
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- read:streaming
+//
+//	responses:
+//		'200':
+//			description: A stream of server-sent events.
+//		'401':
+//			description: unauthorized
+//		'404':
+//			description: unknown stream type
+//		'429':
+//			description: too many open streams for this account
+func (m *Module) StreamSSEGETHandler(c *gin.Context) {
+	account, errWithCode := m.authorize(c)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Sub types are given as an extra path
+	// segment, eg., `user/notification`.
+	streamType := c.Param(StreamParamKey)
+	if sub := c.Param(StreamSubParamKey); sub != "" {
+		streamType += ":" + sub
+	}
+
+	if _, ok := sseStreamTypes[streamType]; !ok {
+		const text = "unknown stream type"
+		errWithCode := gtserror.NewErrorNotFound(errors.New(text), text)
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Browsers send the last received event ID
+	// in this header when reconnecting by themselves.
+	sinceID := c.Query(StreamSinceIDKey)
+	if sinceID == "" {
+		sinceID = c.GetHeader(LastEventIDHeader)
+	}
+
+	stream, errWithCode := m.processor.Stream().Open(
+		c.Request.Context(),
+		account,
+		withListOrTag(c, streamType),
+		sinceID,
+	)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Unlike websockets, the handler serves the stream
+	// for as long as it's open. Close processor channel
+	// when it returns so the processor knows not to send
+	// any more messages to this stream.
+	defer close(stream.Hangup)
+
+	l := log.
+		WithContext(c.Request.Context()).
+		WithFields(kv.Fields{
+			{"username", account.Username},
+			{"streamID", stream.ID},
+		}...)
+
+	c.Header("Content-Type", "text/event-stream")
+	// Stop nginx from buffering events.
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	l.Info("opened server-sent events connection")
+	m.writeToSSEConn(c.Request.Context(), account.Username, c.Writer, stream)
+	l.Info("closed server-sent events connection")
+}
+
+// writeToSSEConn receives messages coming from the processor via
+// the given stream, and writes them as server-sent events into the
+// given writer. This function also handles sending heartbeat comments
+// to keep the connection alive when no other activity occurs.
+//
+// This is a blocking function; will return only on write error or
+// if the given context is canceled.
+func (m *Module) writeToSSEConn(
+	ctx context.Context,
+	username string,
+	w gin.ResponseWriter,
+	stream *streampkg.Stream,
+) {
+	l := log.
+		WithContext(ctx).
+		WithFields(kv.Fields{
+			{"username", username},
+			{"streamID", stream.ID},
+		}...)
+
+	// Create ticker to send heartbeats.
+	heartbeat := time.NewTicker(m.dTicker)
+	defer heartbeat.Stop()
+
+	for {
+		var err error
+
+		select {
+		case <-ctx.Done():
+			// Client left.
+			return
+
+		case msg := <-stream.Messages:
+			// Received a new message from the processor.
+			l.Tracef("writing server-sent event: %+v", msg)
+			err = writeSSEMessage(w, msg)
+
+			// Reset heartbeat on successful send, since
+			// we know the connection is still there.
+			heartbeat.Reset(m.dTicker)
+
+		case <-heartbeat.C:
+			// Time to send a keep-alive comment.
+			l.Trace("writing heartbeat comment")
+			_, err = io.WriteString(w, ":thump\n\n")
+		}
+
+		if err != nil {
+			l.Debugf("error writing server-sent event: %v", err)
+			return
+		}
+
+		w.Flush()
+	}
+}
+
+// writeSSEMessage writes the given message to
+// the given writer in the server-sent events format.
+func writeSSEMessage(w io.Writer, msg *streampkg.Message) error {
+	var b strings.Builder
+
+	if msg.ID != "" {
+		b.WriteString("id: " + msg.ID + "\n")
+	}
+
+	b.WriteString("event: " + msg.Event + "\n")
+
+	// Each line of the payload needs its own field;
+	// JSON payloads don't contain any newlines, but
+	// better safe than sorry.
+	for _, line := range strings.Split(msg.Payload, "\n") {
+		b.WriteString("data: " + line + "\n")
+	}
+
+	b.WriteString("\n")
+
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return fmt.Errorf("error writing event: %w", err)
+	}
+
+	return nil
+}
@@ -96,6 +96,16 @@ import (
 //			Name of the tag to subscribe to.
 //			Only used if stream type is 'hashtag' or 'hashtag:local'.
 //		in: query
+//	-
+//		name: since_id
+//		type: string
+//		description: |-
+//			ID of the last event received before the connection dropped.
+//			Missed `update`, `notification` and `delete` events streamed after it,
+//			within the last 10 minutes, will be replayed first, unless they should
+//			no longer be shown (status deleted, author since muted or blocked,
+//			notification dismissed, etc).
+//		in: query
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -106,6 +116,11 @@ import (
 //			schema:
 //				type: object
 //				properties:
+//					id:
+//						description: |-
+//							ID of the event, to pass as `since_id` when reconnecting.
+//							Only set for `update`, `notification` and `delete` events.
+//						type: string
 //					stream:
 //						type: array
 //						items:
@@ -149,51 +164,14 @@ import (
 //		'400':
 //			description: bad request
 func (m *Module) StreamGETHandler(c *gin.Context) {
-	var (
-		account     *gtsmodel.Account
-		errWithCode gtserror.WithCode
-	)
-
-	// Try query param access token.
-	token := c.Query(AccessTokenQueryKey)
-	if token == "" {
-		// Try fallback HTTP header provided token.
-		token = c.GetHeader(AccessTokenHeader)
-	}
-
-	if token != "" {
-		// Token was provided, use it to authorize stream.
-		account, errWithCode = m.processor.Stream().Authorize(c.Request.Context(), token)
-	} else {
-		// No explicit token was provided:
-		// try regular oauth as a last resort.
-		account, errWithCode = func() (*gtsmodel.Account, gtserror.WithCode) {
-			authed, err := oauth.Authed(c, true, true, true, true)
-			if err != nil {
-				return nil, gtserror.NewErrorUnauthorized(err, err.Error())
-			}
-
-			return authed.Account, nil
-		}()
-	}
-
+	account, errWithCode := m.authorize(c)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
 	}
 
 	// Get the initial requested stream type, if there is one.
-	streamType := c.Query(StreamQueryKey)
-
-	// By appending other query params to the streamType, we
-	// can allow streaming for specific list IDs or hashtags.
-	// The streamType in this case will end up looking like
-	// `hashtag:example` or `list:01H3YF48G8B7KTPQFS8D2QBVG8`.
-	if list := c.Query(StreamListKey); list != "" {
-		streamType += ":" + list
-	} else if tag := c.Query(StreamTagKey); tag != "" {
-		streamType += ":" + tag
-	}
+	streamType := withListOrTag(c, c.Query(StreamQueryKey))
 
 	// Open a stream with the processor; this lets processor
 	// functions pass messages into a channel, which we can
@@ -202,6 +180,7 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 		c.Request.Context(),
 		account,
 		streamType,
+		c.Query(StreamSinceIDKey),
 	)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
@@ -238,6 +217,58 @@ func (m *Module) StreamGETHandler(c *gin.Context) {
 	go m.handleWSConn(account.Username, wsConn, stream)
 }
 
+// authorize returns the account of the given streaming request,
+// authorized either by the token it contains or regular oauth.
+func (m *Module) authorize(c *gin.Context) (*gtsmodel.Account, gtserror.WithCode) {
+	var (
+		account     *gtsmodel.Account
+		errWithCode gtserror.WithCode
+	)
+
+	// Try query param access token.
+	token := c.Query(AccessTokenQueryKey)
+	if token == "" {
+		// Try fallback HTTP header provided token.
+		token = c.GetHeader(AccessTokenHeader)
+	}
+
+	if token != "" {
+		// Token was provided, use it to authorize stream.
+		account, errWithCode = m.processor.Stream().Authorize(c.Request.Context(), token)
+	} else {
+		// No explicit token was provided:
+		// try regular oauth as a last resort.
+		account, errWithCode = func() (*gtsmodel.Account, gtserror.WithCode) {
+			authed, err := oauth.Authed(c, true, true, true, true)
+			if err != nil {
+				return nil, gtserror.NewErrorUnauthorized(err, err.Error())
+			}
+
+			return authed.Account, nil
+		}()
+	}
+
+	return account, errWithCode
+}
+
+// withListOrTag appends the list ID or tag name requested with
+// the given streaming request, if any, to the given stream type.
+//
+// This allows streaming for specific list IDs or hashtags.
+// The streamType in this case will end up looking like
+// `hashtag:example` or `list:01H3YF48G8B7KTPQFS8D2QBVG8`.
+func withListOrTag(c *gin.Context, streamType string) string {
+	if list := c.Query(StreamListKey); list != "" {
+		return streamType + ":" + list
+	}
+
+	if tag := c.Query(StreamTagKey); tag != "" {
+		return streamType + ":" + tag
+	}
+
+	return streamType
+}
+
 // handleWSConn handles a two-way websocket streaming connection.
 // It will both read messages from the connection, and push messages
 // into the connection. If any errors are encountered while reading
@@ -31,10 +31,20 @@ const (
 	StreamQueryKey      = "stream"                 // type of stream being requested
 	StreamListKey       = "list"                   // id of list being requested
 	StreamTagKey        = "tag"                    // name of tag being requested
+	StreamSinceIDKey    = "since_id"               // id of last event received, to replay missed events
 	AccessTokenQueryKey = "access_token"           // oauth access token
 	AccessTokenHeader   = "Sec-Websocket-Protocol" //nolint:gosec
 )
 
+// Server-sent events streaming.
+const (
+	StreamParamKey     = "stream"                                      // type of stream being requested
+	StreamSubParamKey  = "sub"                                         // sub type of stream being requested, eg., `notification` for `user`
+	BasePathWithStream = BasePath + "/:" + StreamParamKey              // path for streaming a type
+	BasePathWithSub    = BasePathWithStream + "/:" + StreamSubParamKey // path for streaming a sub type
+	LastEventIDHeader  = "Last-Event-ID"                               // id of last event received, to replay missed events
+)
+
 type Module struct {
 	processor *processing.Processor
 	dTicker   time.Duration
@@ -61,3 +71,11 @@ func New(processor *processing.Processor, dTicker time.Duration, wsBuf int) *Mod
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, BasePath, m.StreamGETHandler)
 }
+
+// RouteSSE attaches the server-sent events streaming handlers. These are
+// kept separate from Route, as they hold their request open for as long
+// as the stream, so shouldn't be behind throttling or gzip middleware.
+func (m *Module) RouteSSE(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePathWithStream, m.StreamSSEGETHandler)
+	attachHandler(http.MethodGet, BasePathWithSub, m.StreamSSEGETHandler)
+}
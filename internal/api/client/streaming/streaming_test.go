@@ -19,6 +19,7 @@ package streaming_test
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -31,6 +32,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/streaming"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
@@ -240,6 +242,68 @@ func (suite *StreamingTestSuite) TestSecurityHeader() {
 	suite.NoError(err)
 }
 
+func (suite *StreamingTestSuite) TestServerSentEvents() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	account := suite.testAccounts["local_account_1"]
+
+	// Send heartbeats often enough to see one.
+	streamingModule := streaming.New(suite.processor, 50*time.Millisecond, 4096)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	reqCtx, cancel := context.WithCancel(context.Background())
+	ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:8080/api%s/user/notification?access_token=%s", streaming.BasePath, oauthToken.Access), nil).WithContext(reqCtx)
+	ctx.Params = gin.Params{
+		{Key: streaming.StreamParamKey, Value: "user"},
+		{Key: streaming.StreamSubParamKey, Value: "notification"},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		streamingModule.StreamSSEGETHandler(ctx)
+		close(done)
+	}()
+
+	// Wait for the stream to open.
+	time.Sleep(100 * time.Millisecond)
+
+	err := suite.processor.Stream().Notify(&apimodel.Notification{ID: "01F8Q0ANPTWW10DAKTX7BRPBJP", Type: "favourite"}, account)
+	suite.NoError(err)
+
+	// Wait for the event and a
+	// heartbeat, then hang up.
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	<-done
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("text/event-stream", recorder.Header().Get("Content-Type"))
+
+	body := recorder.Body.String()
+	suite.Regexp(`id: [0-9A-Z]{26}\nevent: notification\ndata: \{"id":"01F8Q0ANPTWW10DAKTX7BRPBJP","type":"favourite",.*\}\n\n`, body)
+	suite.Contains(body, ":thump\n\n")
+}
+
+func (suite *StreamingTestSuite) TestServerSentEventsUnknownStream() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Request = httptest.NewRequest(http.MethodGet, fmt.Sprintf("http://localhost:8080/api%s/user/everything?access_token=%s", streaming.BasePath, oauthToken.Access), nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Params = gin.Params{
+		{Key: streaming.StreamParamKey, Value: "user"},
+		{Key: streaming.StreamSubParamKey, Value: "everything"},
+	}
+
+	suite.streamingModule.StreamSSEGETHandler(ctx)
+
+	suite.Equal(http.StatusNotFound, recorder.Code)
+	suite.Equal(`{"error":"Not Found"}`, recorder.Body.String())
+}
+
 func TestStreamingTestSuite(t *testing.T) {
 	suite.Run(t, new(StreamingTestSuite))
 }
@@ -26,11 +26,12 @@ import (
 )
 
 const (
-	BasePath       = "/v1/timelines"
-	HomeTimeline   = BasePath + "/home"
-	PublicTimeline = BasePath + "/public"
-	ListTimeline   = BasePath + "/list/:" + apiutil.IDKey
-	TagTimeline    = BasePath + "/tag/:" + apiutil.TagNameKey
+	BasePath           = "/v1/timelines"
+	HomeTimeline       = BasePath + "/home"
+	PublicTimeline     = BasePath + "/public"
+	ListTimeline       = BasePath + "/list/:" + apiutil.IDKey
+	TagTimeline        = BasePath + "/tag/:" + apiutil.TagNameKey
+	HighlightsTimeline = BasePath + "/highlights"
 )
 
 type Module struct {
@@ -48,4 +49,5 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
 	attachHandler(http.MethodGet, ListTimeline, m.ListTimelineGETHandler)
 	attachHandler(http.MethodGet, TagTimeline, m.TagTimelineGETHandler)
+	attachHandler(http.MethodGet, HighlightsTimeline, m.HighlightsTimelineGETHandler)
 }
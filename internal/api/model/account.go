@@ -45,8 +45,16 @@ type Account struct {
 	Locked bool `json:"locked"`
 	// Account has opted into discovery features.
 	Discoverable bool `json:"discoverable"`
+	// Account has opted into having its posts and profile found by search.
+	Indexable bool `json:"indexable"`
+	// Account's profile and status web pages ask search engines not to index them.
+	// Only ever true for local accounts.
+	NoIndex bool `json:"noindex"`
 	// Account identifies as a bot.
 	Bot bool `json:"bot"`
+	// Account is a group actor, such as a Lemmy community or Friendica forum,
+	// which distributes posts from its members to its followers by boosting them.
+	Group bool `json:"group"`
 	// When the account was created (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	CreatedAt string `json:"created_at"`
@@ -91,6 +99,9 @@ type Account struct {
 	Source *Source `json:"source,omitempty"`
 	// CustomCSS to include when rendering this account's profile or statuses.
 	CustomCSS string `json:"custom_css,omitempty"`
+	// File name of the instance theme used when rendering this account's profile or statuses.
+	// example: midnight.css
+	Theme string `json:"theme,omitempty"`
 	// Account has enabled RSS feed.
 	EnableRSS bool `json:"enable_rss,omitempty"`
 	// Role of the account on this instance.
@@ -129,6 +140,9 @@ type AccountCreateRequest struct {
 	// example: en
 	// Required: true
 	Locale string `form:"locale" json:"locale" xml:"locale" binding:"required"`
+	// Response token from the sign-up challenge widget, if the instance uses one.
+	// swagger:parameters
+	ChallengeResponse string `form:"challenge_response" json:"challenge_response" xml:"challenge_response"`
 	// The IP of the sign up request, will not be parsed from the form.
 	// swagger:parameters
 	// swagger:ignore
@@ -141,6 +155,10 @@ type AccountCreateRequest struct {
 type UpdateCredentialsRequest struct {
 	// Account should be made discoverable and shown in the profile directory (if enabled).
 	Discoverable *bool `form:"discoverable" json:"discoverable"`
+	// Account's posts and profile may be found by search.
+	Indexable *bool `form:"indexable" json:"indexable"`
+	// Ask search engines not to index the account's profile and status web pages.
+	NoIndex *bool `form:"noindex" json:"noindex"`
 	// Account is flagged as a bot.
 	Bot *bool `form:"bot" json:"bot"`
 	// The display name to use for the account.
@@ -161,6 +179,9 @@ type UpdateCredentialsRequest struct {
 	JSONFieldsAttributes *map[string]UpdateField `form:"-" json:"fields_attributes"`
 	// Custom CSS to be included when rendering this account's profile or statuses.
 	CustomCSS *string `form:"custom_css" json:"custom_css"`
+	// File name of the instance theme to use when rendering this account's profile or statuses.
+	// Empty string to use no theme.
+	Theme *string `form:"theme" json:"theme"`
 	// Enable RSS feed of public toots for this account at /@[username]/feed.rss
 	EnableRSS *bool `form:"enable_rss" json:"enable_rss"`
 }
@@ -177,6 +198,18 @@ type UpdateSource struct {
 	Language *string `form:"language" json:"language"`
 	// Default format for authored statuses (text/plain or text/markdown).
 	StatusContentType *string `form:"status_content_type" json:"status_content_type"`
+	// Preferred locale for web pages and emails from this instance (BCP 47).
+	// Empty string to clear the preference.
+	Locale *string `form:"locale" json:"locale"`
+	// Whether to email follows and mentions: none, immediate, or daily.
+	EmailNotifications *string `form:"email_notifications" json:"email_notifications"`
+	// Hold mentions from remote accounts never interacted with for review.
+	ReviewFirstInteractions *bool `form:"review_first_interactions" json:"review_first_interactions"`
+	// IANA name of the time zone the user is in, eg., Europe/Amsterdam.
+	// Empty string to clear the preference.
+	TimeZone *string `form:"time_zone" json:"time_zone"`
+	// Whether to make daily highlights of posts from followed accounts: none, daily, or email.
+	HighlightsDigest *string `form:"highlights_digest" json:"highlights_digest"`
 }
 
 // UpdateField is to be used specifically in an UpdateCredentialsRequest.
@@ -191,6 +224,8 @@ type UpdateField struct {
 	Name *string `form:"name" json:"name"`
 	// Value of the field
 	Value *string `form:"value" json:"value"`
+	// Mark links in the value of the field as rel="me".
+	RelMe *bool `form:"rel_me" json:"rel_me"`
 }
 
 // AccountFollowRequest models a request to follow an account.
@@ -205,6 +240,20 @@ type AccountFollowRequest struct {
 	Notify *bool `form:"notify" json:"notify" xml:"notify"`
 }
 
+// AccountMuteRequest models a request to mute an account.
+//
+// swagger:ignore
+type AccountMuteRequest struct {
+	// Mute notifications from this account too.
+	Notifications *bool `form:"notifications" json:"notifications" xml:"notifications"`
+	// Hide statuses authored by this account.
+	// GoToSocial extension; defaults to true.
+	Statuses *bool `form:"statuses" json:"statuses" xml:"statuses"`
+	// Hide boosts made by this account.
+	// GoToSocial extension; defaults to true.
+	Boosts *bool `form:"boosts" json:"boosts" xml:"boosts"`
+}
+
 // AccountDeleteRequest models a request to delete an account.
 //
 // swagger:ignore
@@ -239,3 +288,42 @@ type AccountNoteRequest struct {
 	// Comment to use for the note text.
 	Comment string `form:"comment" json:"comment" xml:"comment"`
 }
+
+// AccountAlias models one alias (alsoKnownAs entry)
+// of the requesting account.
+//
+// swagger:model accountAlias
+type AccountAlias struct {
+	// ActivityPub URI of the aliased account.
+	// example: https://example.org/users/some_user
+	URI string `json:"uri"`
+	// The aliased account, if it could be resolved.
+	Account *Account `json:"account,omitempty"`
+}
+
+// AccountAliasRequest models a request to add or remove an account alias.
+//
+// swagger:ignore
+type AccountAliasRequest struct {
+	// ActivityPub URI of the account to add or remove as an alias.
+	URI string `form:"uri" json:"uri" xml:"uri"`
+}
+
+// ImportProfileRequest models a request to import parts of the
+// profile of an aliased account on another instance.
+//
+// swagger:ignore
+type ImportProfileRequest struct {
+	// ActivityPub URI of the aliased account to import from.
+	URI string `form:"uri" json:"uri" xml:"uri"`
+	// Import the display name of the aliased account.
+	DisplayName bool `form:"display_name" json:"display_name" xml:"display_name"`
+	// Import the bio (note) of the aliased account.
+	Note bool `form:"note" json:"note" xml:"note"`
+	// Import the profile fields of the aliased account.
+	Fields bool `form:"fields" json:"fields" xml:"fields"`
+	// Import the avatar of the aliased account.
+	Avatar bool `form:"avatar" json:"avatar" xml:"avatar"`
+	// Import the header of the aliased account.
+	Header bool `form:"header" json:"header" xml:"header"`
+}
@@ -66,12 +66,46 @@ type AdminAccountInfo struct {
 	Silenced bool `json:"silenced"`
 	// Whether the account is currently suspended.
 	Suspended bool `json:"suspended"`
+	// If the account is currently limited because of a burst of activity,
+	// the time when the limit expires (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LimitedUntil string `json:"limited_until,omitempty"`
 	// User-level information about the account.
 	Account *Account `json:"account"`
 	// The ID of the application that created this account.
 	CreatedByApplicationID string `json:"created_by_application_id,omitempty"`
 	// The ID of the account that invited this user
 	InvitedByAccountID string `json:"invited_by_account_id,omitempty"`
+	// Media storage usage and quota of the account.
+	// Only set for local accounts when viewing a single account.
+	MediaQuota *AdminAccountMediaQuota `json:"media_quota,omitempty"`
+}
+
+// AdminAccountMediaQuota models the media
+// storage usage and quota of a local account.
+//
+// swagger:model adminAccountMediaQuota
+type AdminAccountMediaQuota struct {
+	// Total size in bytes of media attachments stored by the account.
+	// example: 1048576
+	Used int64 `json:"used"`
+	// Max total size in bytes of media attachments the account may store.
+	// Null if there is no limit.
+	// example: 1073741824
+	Limit *int64 `json:"limit"`
+	// Whether the limit has been set for this account by an admin,
+	// rather than being the default limit of the instance.
+	Override bool `json:"override"`
+}
+
+// AdminAccountMediaQuotaRequest models a request
+// to set the media quota of a local account.
+//
+// swagger:ignore
+type AdminAccountMediaQuotaRequest struct {
+	// Max total size in bytes of media attachments
+	// the account may store. 0 means no limit.
+	Quota *int64 `form:"quota" json:"quota" xml:"quota"`
 }
 
 // AdminReport models the admin view of a report.
@@ -163,6 +197,198 @@ type AdminEmoji struct {
 	URI string `json:"uri"`
 }
 
+// AdminTag models the admin view of a hashtag.
+//
+// swagger:model adminTag
+type AdminTag struct {
+	Tag
+	// The ID of the tag.
+	// example: 01F8MHA1A2NF9MJ3WCCQ3K8BSZ
+	ID string `json:"id"`
+	// Whether this tag can be used in new posts on this instance.
+	// False if the tag has been banned.
+	// example: false
+	Useable bool `json:"useable"`
+	// Whether posts using this tag can be listed on this instance, eg., on the tag timeline.
+	// False if the tag has been banned.
+	// example: false
+	Listable bool `json:"listable"`
+	// Time when the tag was last updated.
+	// example: 2023-11-07T09:21:26.419Z
+	UpdatedAt string `json:"updated_at"`
+	// ID of the admin action unlisting existing public posts that use this tag.
+	// Only set in response to a ban request with unlist_existing set to true.
+	// example: 01H9QG6TZ9W5P0402VFRVM17TH
+	UnlistActionID string `json:"unlist_action_id,omitempty"`
+}
+
+// AdminTagBanRequest models a request to ban a hashtag.
+//
+// swagger:ignore
+type AdminTagBanRequest struct {
+	// Name of the tag to ban, with or without leading '#'.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Also change existing public posts that use the tag to unlisted.
+	UnlistExisting bool `form:"unlist_existing" json:"unlist_existing" xml:"unlist_existing"`
+}
+
+// AdminIngestRule models a keyword or regular expression
+// which is checked against statuses coming in over federation.
+//
+// swagger:model adminIngestRule
+type AdminIngestRule struct {
+	// The ID of the rule.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// Keyword or regular expression to check statuses against.
+	// example: buy cheap followers
+	Pattern string `json:"pattern"`
+	// Whether the pattern is a regular expression.
+	// If false, it's a keyword, matched case-insensitively.
+	// example: false
+	Regex bool `json:"regex"`
+	// What to do with statuses that match this rule: `drop` or `unlist`.
+	// example: drop
+	Action string `json:"action"`
+	// Private comment on this rule, viewable to admins.
+	// example: spam wave, november 2023
+	Comment string `json:"comment"`
+	// ID of the account that created this rule.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+	// Time at which this rule was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Number of incoming statuses that have matched this rule.
+	// example: 12
+	Hits int `json:"hits"`
+	// Time at which an incoming status last matched this rule (ISO 8601 Datetime).
+	// Null if it has never matched.
+	// example: 2021-07-30T09:20:25+00:00
+	LastHitAt *string `json:"last_hit_at"`
+}
+
+// AdminIngestRuleRequest models a request to create an ingest rule.
+//
+// swagger:ignore
+type AdminIngestRuleRequest struct {
+	// Keyword or regular expression to check statuses against.
+	Pattern string `form:"pattern" json:"pattern" xml:"pattern"`
+	// Whether the pattern is a regular expression.
+	Regex bool `form:"regex" json:"regex" xml:"regex"`
+	// What to do with statuses that match: drop or unlist.
+	Action string `form:"action" json:"action" xml:"action"`
+	// Private comment on the rule.
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+}
+
+// AdminIngestRuleTestRequest models a request to
+// check some text against ingest rules.
+//
+// swagger:ignore
+type AdminIngestRuleTestRequest struct {
+	// Text to check.
+	Text string `form:"text" json:"text" xml:"text"`
+	// Keyword or regular expression to check the text against.
+	// If not set, the text is checked against all stored rules.
+	Pattern string `form:"pattern" json:"pattern" xml:"pattern"`
+	// Whether the pattern is a regular expression.
+	Regex bool `form:"regex" json:"regex" xml:"regex"`
+}
+
+// AdminIngestRuleTestResult models the result
+// of checking some text against ingest rules.
+//
+// swagger:model adminIngestRuleTestResult
+type AdminIngestRuleTestResult struct {
+	// Whether the text matched any rule.
+	// example: true
+	Matched bool `json:"matched"`
+	// What would be done with a status containing this text: `drop` or `unlist`.
+	// Empty if the text didn't match any rule.
+	// example: drop
+	Action string `json:"action,omitempty"`
+	// Rules that matched the text. When testing a
+	// pattern that isn't stored, its ID will be empty.
+	Rules []*AdminIngestRule `json:"rules"`
+}
+
+// AdminIPBlock models a block on an IP address or range.
+//
+// swagger:model adminIPBlock
+type AdminIPBlock struct {
+	// The ID of the block.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// Blocked IP range in CIDR notation.
+	// example: 192.0.2.0/24
+	IP string `json:"ip"`
+	// What is blocked for the range: `sign_up_block` or `no_access`.
+	// example: sign_up_block
+	Severity string `json:"severity"`
+	// Private comment on this block, viewable to admins.
+	// example: sign-up spam, november 2023
+	Comment string `json:"comment"`
+	// ID of the account that created this block.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+	// Time at which this block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which this block stops applying (ISO 8601 Datetime).
+	// Null if it never expires.
+	// example: 2021-07-30T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+}
+
+// AdminIPBlockRequest models a request to create an IP block.
+//
+// swagger:ignore
+type AdminIPBlockRequest struct {
+	// IP address or range in CIDR notation.
+	IP string `form:"ip" json:"ip" xml:"ip"`
+	// What to block: sign_up_block or no_access.
+	Severity string `form:"severity" json:"severity" xml:"severity"`
+	// Private comment on the block.
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+	// Number of seconds from now that the block should expire.
+	// 0 means never.
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// AdminEmailDomainBlock models a block on
+// signing up with email addresses at a domain.
+//
+// swagger:model adminEmailDomainBlock
+type AdminEmailDomainBlock struct {
+	// The ID of the block.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// Blocked email domain. Subdomains are blocked too.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Private comment on this block, viewable to admins.
+	// example: throwaway addresses
+	Comment string `json:"comment"`
+	// ID of the account that created this block.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+	// Time at which this block was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+}
+
+// AdminEmailDomainBlockRequest models a
+// request to create an email domain block.
+//
+// swagger:ignore
+type AdminEmailDomainBlockRequest struct {
+	// Email domain to block.
+	Domain string `form:"domain" json:"domain" xml:"domain"`
+	// Private comment on the block.
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+}
+
 // AdminActionRequest models a request
 // for an admin action to be performed.
 //
@@ -210,3 +436,244 @@ type AdminInstanceRule struct {
 	UpdatedAt string `json:"updated_at"` // when was item last updated
 	Text      string `json:"text"`       // text content of the rule
 }
+
+// AdminStatistic models usage statistics
+// for this instance over one day.
+//
+// swagger:model adminStatistic
+type AdminStatistic struct {
+	// Day covered by these statistics (UTC), ISO 8601 date.
+	// example: 2023-11-10
+	Date string `json:"date"`
+	// Number of local accounts that signed in or posted during the day.
+	// example: 12
+	ActiveUsers int `json:"active_users"`
+	// Number of local users that signed up during the day.
+	// example: 2
+	NewRegistrations int `json:"new_registrations"`
+	// Number of statuses posted by local accounts during the day.
+	// example: 48
+	StatusesCreated int `json:"statuses_created"`
+	// Number of statuses from remote accounts created during the day.
+	// example: 1520
+	StatusesReceived int `json:"statuses_received"`
+	// Number of remote accounts first seen during the day.
+	// example: 37
+	AccountsDiscovered int `json:"accounts_discovered"`
+	// Number of instances federated with, as of the end of the day.
+	// example: 840
+	KnownInstances int `json:"known_instances"`
+	// Total size in bytes of media attachments stored, as of the end of the day.
+	// example: 1073741824
+	MediaStorageBytes int64 `json:"media_storage_bytes"`
+}
+
+// AdminQuarantinedStatus models a status which came in over
+// federation, but which is held back from timelines and
+// notifications by the spam filter until reviewed by a moderator.
+//
+// swagger:model adminQuarantinedStatus
+type AdminQuarantinedStatus struct {
+	// The ID of the quarantine entry.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// Time at which the status was quarantined (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Spam score given to the status.
+	// example: 70
+	Score int `json:"score"`
+	// Reasons which contributed to the score.
+	// example: ["4 links","account first seen less than a day ago"]
+	Reasons []string `json:"reasons"`
+	// The held status.
+	Status *Status `json:"status"`
+}
+
+// AdminDomainQuarantine models a domain which delivered to this
+// instance for the first time, and whose statuses are held in the
+// quarantine queue until the domain is approved by a moderator.
+//
+// swagger:model adminDomainQuarantine
+type AdminDomainQuarantine struct {
+	// The ID of the domain quarantine.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// The quarantined domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Time at which the domain was quarantined (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the domain was approved (ISO 8601 Datetime), if it has been.
+	// example: 2021-07-30T09:20:25+00:00
+	ApprovedAt string `json:"approved_at,omitempty"`
+	// Number of held statuses from this domain that passed the spam filter.
+	// example: 3
+	CleanCount int `json:"clean_count"`
+}
+
+// AdminConfigReload models the result of
+// re-reading the instance configuration file.
+//
+// swagger:model adminConfigReload
+type AdminConfigReload struct {
+	// Names of settings which were changed, and are now in effect.
+	// example: ["log-level","smtp-host"]
+	Changed []string `json:"changed"`
+	// Names of settings which were changed in the configuration
+	// file, but which only take effect after a restart.
+	// example: ["host"]
+	RequiresRestart []string `json:"requires_restart"`
+}
+
+// AdminLogLevels models the log levels currently in effect.
+//
+// swagger:model adminLogLevels
+type AdminLogLevels struct {
+	// Default log level, used for subsystems without an override.
+	// example: info
+	Level string `json:"level"`
+	// Log level overrides in the form subsystem=level, where subsystem
+	// is the path of a package under internal/. An override applies
+	// to that package and all packages beneath it.
+	// example: ["federation/dereferencing=debug"]
+	Subsystems []string `json:"subsystems"`
+}
+
+// AdminLogLevelsRequest models a request to change log levels at runtime.
+//
+// swagger:ignore
+type AdminLogLevelsRequest struct {
+	// Default log level. Unchanged if not set.
+	Level *string `form:"level" json:"level" xml:"level"`
+	// Log level overrides in the form subsystem=level, replacing
+	// any currently set. Unchanged if not set, an empty array
+	// clears all overrides.
+	Subsystems *[]string `form:"subsystems[]" json:"subsystems" xml:"subsystems"`
+}
+
+// AdminJob models one of the instance's scheduled
+// background jobs, and the outcome of its last run.
+//
+// swagger:model adminJob
+type AdminJob struct {
+	// Name of the job.
+	// example: media-clean
+	Name string `json:"name"`
+	// Schedule the job runs on: a cron expression
+	// (evaluated in UTC), or a shorthand such as
+	// "@hourly" or "@every 30m".
+	// example: @midnight
+	Schedule string `json:"schedule"`
+	// Scheduled runs of the job are skipped while it's paused.
+	Paused bool `json:"paused"`
+	// The job is running now.
+	Running bool `json:"running"`
+	// When the job is next scheduled to run (ISO 8601 Datetime).
+	// Omitted if the job isn't scheduled.
+	// example: 2021-07-31T00:00:00.000Z
+	NextRunAt string `json:"next_run_at,omitempty"`
+	// When the last run of the job started (ISO 8601 Datetime).
+	// Omitted if the job hasn't run yet.
+	// example: 2021-07-30T00:00:00.000Z
+	LastRunAt string `json:"last_run_at,omitempty"`
+	// Outcome of the last run of the job.
+	// Omitted if the job hasn't run yet.
+	// enum:
+	//	- running
+	//	- succeeded
+	//	- failed
+	// example: succeeded
+	LastRunStatus string `json:"last_run_status,omitempty"`
+	// Error returned by the last attempt of the
+	// last run of the job, if it failed.
+	// example: db error getting users: context deadline exceeded
+	LastRunError string `json:"last_run_error,omitempty"`
+	// How long the last run of the job
+	// took in milliseconds, including retries.
+	// example: 5230
+	LastRunDuration int64 `json:"last_run_duration"`
+	// Number of attempts made during the last run
+	// of the job, more than 1 if it was retried.
+	// example: 1
+	LastRunAttempts int `json:"last_run_attempts"`
+}
+
+// AdminCacheStats models statistics
+// about one of the instance's caches.
+//
+// swagger:model adminCacheStats
+type AdminCacheStats struct {
+	// Name of the cache.
+	// example: Account
+	Name string `json:"name"`
+	// Current number of entries in the cache.
+	// Null if not known for this cache.
+	// example: 1024
+	Size *int `json:"size"`
+	// Maximum number of entries in the cache.
+	// Null if this cache is not size bounded.
+	// example: 20000
+	Capacity *int `json:"capacity"`
+	// Number of lookups served from the cache
+	// since startup. Null if not counted.
+	// example: 512000
+	Hits *uint64 `json:"hits"`
+	// Number of lookups which had to fall through
+	// to the database since startup. Null if not counted.
+	// example: 4096
+	Misses *uint64 `json:"misses"`
+	// Lookups under which individual entries can be invalidated.
+	// Empty if the cache can only be cleared as a whole.
+	// example: ["ID","URI","Username.Domain"]
+	Lookups []string `json:"lookups"`
+	// Hits and misses broken down by kind of lookup, for caches
+	// which count them so, eg., by visibility type for the
+	// Visibility cache. Omitted for other caches.
+	Breakdown map[string]AdminCacheCounts `json:"breakdown,omitempty"`
+}
+
+// AdminCacheCounts models counts of
+// lookups of one kind in a cache.
+//
+// swagger:model adminCacheCounts
+type AdminCacheCounts struct {
+	// Number of lookups served from the cache since startup.
+	// example: 512000
+	Hits uint64 `json:"hits"`
+	// Number of lookups which had to fall through since startup.
+	// example: 4096
+	Misses uint64 `json:"misses"`
+}
+
+// AdminCacheInvalidateRequest models a request to
+// invalidate entries from, or clear, a cache.
+//
+// swagger:ignore
+type AdminCacheInvalidateRequest struct {
+	// Name of the cache.
+	Cache string `form:"cache" json:"cache" xml:"cache"`
+	// Lookup to invalidate entries under.
+	Lookup string `form:"lookup" json:"lookup" xml:"lookup"`
+	// Keys of the entries to invalidate. If
+	// empty, the whole cache is cleared.
+	Keys []string `form:"keys[]" json:"keys" xml:"keys"`
+}
+
+// AdminDereferencesInFlight models a snapshot of
+// the dereferences currently in progress.
+//
+// swagger:model adminDereferencesInFlight
+type AdminDereferencesInFlight struct {
+	// Usernames of local accounts mapped to URIs of
+	// remote accounts they're currently handshaking with.
+	// example: {"admin":["https://example.org/users/someone"]}
+	Handshakes map[string][]string `json:"handshakes"`
+	// Remote URLs of avatars being dereferenced.
+	Avatars []string `json:"avatars"`
+	// Remote URLs of headers being dereferenced.
+	Headers []string `json:"headers"`
+	// shortcode@domain of emojis being dereferenced.
+	Emojis []string `json:"emojis"`
+}
@@ -59,4 +59,52 @@ type Announcement struct {
 	Emojis []Emoji `json:"emoji"`
 	// Reactions to this announcement.
 	Reactions []AnnouncementReaction `json:"reactions"`
+	// When the instance will enter read-only maintenance mode because
+	// of this announcement (ISO 8601 Datetime). Maintenance mode lasts
+	// until ends_at. If no maintenance is scheduled, this will be omitted.
+	// example: 2021-07-30T09:20:25+00:00
+	MaintenanceAt string `json:"maintenance_at,omitempty"`
+}
+
+// AnnouncementCreateRequest models a request to create an announcement, made through the admin API.
+//
+// swagger:ignore
+type AnnouncementCreateRequest struct {
+	// Text of the announcement, plaintext.
+	Text string `form:"text" json:"text" xml:"text"`
+	// When the announcement should begin to be displayed (ISO 8601 Datetime).
+	StartsAt string `form:"starts_at" json:"starts_at" xml:"starts_at"`
+	// When the announcement should stop being displayed (ISO 8601 Datetime).
+	EndsAt string `form:"ends_at" json:"ends_at" xml:"ends_at"`
+	// Starts at and ends at are days rather than exact times.
+	AllDay bool `form:"all_day" json:"all_day" xml:"all_day"`
+	// Make the announcement visible to users.
+	Published bool `form:"published" json:"published" xml:"published"`
+	// When the instance should enter read-only maintenance mode (ISO 8601 Datetime).
+	// Maintenance mode lasts until ends_at, or until the announcement is deleted.
+	MaintenanceAt string `form:"maintenance_at" json:"maintenance_at" xml:"maintenance_at"`
+}
+
+// Maintenance models the state of a scheduled instance maintenance window.
+// It is sent to clients as the payload of 'maintenance' streaming events,
+// counting down to the start of maintenance, and when maintenance ends.
+//
+// swagger:model maintenance
+type Maintenance struct {
+	// ID of the announcement which scheduled this maintenance.
+	// example: 01FC30T7X4TNCZK0TH90QYF3M4
+	AnnouncementID string `json:"announcement_id"`
+	// When maintenance mode starts (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	StartsAt string `json:"starts_at"`
+	// When maintenance mode ends (ISO 8601 Datetime).
+	// If there is no scheduled end time, this will be omitted.
+	// example: 2021-07-30T10:20:25+00:00
+	EndsAt string `json:"ends_at,omitempty"`
+	// Seconds remaining until maintenance mode starts.
+	// Zero if maintenance mode is active or has ended.
+	// example: 300
+	SecondsRemaining int64 `json:"seconds_remaining"`
+	// Instance is currently in read-only maintenance mode.
+	Active bool `json:"active"`
 }
@@ -33,6 +33,10 @@ type AttachmentRequest struct {
 	// If present, it should be in the form of two comma-separated floats between -1 and 1.
 	// example: -0.5,0.565
 	Focus string `form:"focus"`
+	// Mark this media file individually as sensitive. Optional.
+	// Clients should hide sensitive media behind a warning, even
+	// if the status it's attached to is not marked as sensitive.
+	Sensitive bool `form:"sensitive"`
 }
 
 // AttachmentUpdateRequest models an update request for an attachment.
@@ -47,6 +51,14 @@ type AttachmentUpdateRequest struct {
 	// If present, it should be in the form of two comma-separated floats between -1 and 1.
 	// allowEmptyValue: true
 	Focus *string `form:"focus" json:"focus" xml:"focus"`
+	// Mark this media file individually as sensitive.
+	Sensitive *bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
+	// Degrees to rotate the image clockwise by: 0, 90, 180 or 270.
+	Rotate *int `form:"rotate" json:"rotate" xml:"rotate"`
+	// Rectangle to crop the image to after rotating.
+	// If present, it should be in the form of four comma-separated
+	// integers in pixels: "x,y,width,height".
+	Crop *string `form:"crop" json:"crop" xml:"crop"`
 }
 
 // Attachment models a media attachment.
@@ -87,6 +99,9 @@ type Attachment struct {
 	// Alt text that describes what is in the media attachment.
 	// example: This is a picture of a kitten.
 	Description *string `json:"description"`
+	// This attachment has individually been marked as sensitive, and should be hidden
+	// behind a warning, even if the status it's attached to is not marked as sensitive.
+	Sensitive bool `json:"sensitive"`
 	// A hash computed by the BlurHash algorithm, for generating colorful preview thumbnails when media has not been downloaded yet.
 	// See https://github.com/woltapp/blurhash
 	Blurhash string `json:"blurhash,omitempty"`
@@ -26,3 +26,23 @@ type Context struct {
 	// Children in the thread.
 	Descendants []Status `json:"descendants"`
 }
+
+// WebThreadContext models one page of the context
+// of a status, as shown in the web view of a thread.
+//
+// swagger:ignore
+type WebThreadContext struct {
+	Context
+	// Amount of earlier posts in the thread left out
+	// of Ancestors, between the first post of the
+	// thread and the latest earlier posts.
+	HiddenAncestors int `json:"hidden_ancestors"`
+	// Current page of Descendants, starting at 1.
+	Page int `json:"page"`
+	// Previous page of Descendants, 0 if none.
+	PrevPage int `json:"prev_page"`
+	// Next page of Descendants, 0 if none.
+	NextPage int `json:"next_page"`
+	// Total amount of Descendants across all pages.
+	TotalDescendants int `json:"total_descendants"`
+}
@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// DomainInterop represents the ways in which a remote domain deviates from the
+// federation protocols (its quirks), as learned automatically from failed
+// interactions with it. Learned quirks are worked around when federating.
+//
+// swagger:model domainInterop
+type DomainInterop struct {
+	// The hostname of the domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Domain verifies signatures of GET requests over the request path only, excluding any query.
+	// example: false
+	SignGETWithoutQuery bool `json:"sign_get_without_query"`
+	// Domain rejects our usual Accept header when dereferencing, and requires 'application/activity+json'.
+	// example: false
+	AcceptActivityJSON bool `json:"accept_activity_json"`
+	// Domain rejects deliveries with an 'application/ld+json' Content-Type, and requires 'application/activity+json'.
+	// example: true
+	DeliverActivityJSON bool `json:"deliver_activity_json"`
+	// Domain serves ActivityPub objects with a non-ActivityPub Content-Type. No workaround is needed for this.
+	// example: false
+	LaxContentType bool `json:"lax_content_type"`
+	// Signature algorithm last used by the domain to sign requests to this instance,
+	// if not the most common one. This algorithm is tried first when verifying.
+	// example: rsa-sha512
+	SignatureAlgorithm string `json:"signature_algorithm,omitempty"`
+	// Description of the failure which most recently exposed a quirk of this domain.
+	// example: delivery to https://example.org/inbox rejected Content-Type: application/ld+json
+	LastQuirk string `json:"last_quirk,omitempty"`
+	// Time at which the most recent quirk was learned (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LastQuirkAt string `json:"last_quirk_at,omitempty"`
+	// Time at which the first quirk of this domain was learned (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+}
@@ -0,0 +1,66 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// DomainStats represents federation statistics of a
+// remote domain, as counted from contacts with it.
+// These help admins to spot dead or misbehaving domains.
+//
+// swagger:model domainStats
+type DomainStats struct {
+	// The hostname of the domain.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Number of activities successfully delivered to the domain.
+	// example: 120
+	DeliverySuccesses int64 `json:"delivery_successes"`
+	// Number of activities that could not be delivered to the domain.
+	// example: 3
+	DeliveryFailures int64 `json:"delivery_failures"`
+	// Fraction of deliveries to the domain that failed, between 0 and 1.
+	// example: 0.024
+	DeliveryFailureRate float64 `json:"delivery_failure_rate"`
+	// Number of successful dereferences of resources from the domain.
+	// example: 58
+	DereferenceSuccesses int64 `json:"dereference_successes"`
+	// Number of failed dereferences of resources from the domain.
+	// example: 0
+	DereferenceFailures int64 `json:"dereference_failures"`
+	// Fraction of dereferences from the domain that failed, between 0 and 1.
+	// example: 0
+	DereferenceFailureRate float64 `json:"dereference_failure_rate"`
+	// Time of the most recent successful contact with the domain (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LastSuccessAt string `json:"last_success_at,omitempty"`
+	// Time of the most recent failed contact with the domain (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	LastFailureAt string `json:"last_failure_at,omitempty"`
+	// Description of the most recent failed contact with the domain.
+	// example: POST request to https://example.org/inbox failed: status="503 Service Unavailable"
+	LastFailure string `json:"last_failure,omitempty"`
+	// Number of deliveries to the domain which failed since the last successful one.
+	// example: 3
+	ConsecutiveDeliveryFailures int64 `json:"consecutive_delivery_failures"`
+	// Time at which deliveries to the domain were suspended because it seems dead (ISO 8601 Datetime).
+	// Deliveries resume once the domain contacts this instance again.
+	// example: 2021-07-30T09:20:25+00:00
+	DeliveriesSuspendedAt string `json:"deliveries_suspended_at,omitempty"`
+	// Time at which the domain was first contacted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+}
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Draft represents an unposted status
+// stored server-side for later use.
+//
+// swagger:model draft
+type Draft struct {
+	// The ID of the draft.
+	// example: 01FC0SKA48HNSVR6YKZCQGS2V8
+	ID string `json:"id"`
+	// When the draft was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// When the draft was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// Text of the draft, as provided by the client.
+	// example: hello world, this isn't posted yet!
+	Status string `json:"status"`
+	// Subject, summary, or content warning for the draft.
+	// example: warning nsfw
+	SpoilerText string `json:"spoiler_text"`
+	// Media attached to the draft.
+	MediaAttachments []*Attachment `json:"media_attachments"`
+	// Poll to post with the draft, if set.
+	Poll *DraftPoll `json:"poll"`
+	// ID of the status being replied to, if draft is a reply.
+	// example: 01FF25D5Q0DH7CHD57CTRS6WK0
+	InReplyToID *string `json:"in_reply_to_id"`
+	// Draft and attached media should be marked as sensitive.
+	Sensitive bool `json:"sensitive"`
+	// Visibility to post the draft with.
+	// Key/value not set if not specified.
+	// example: unlisted
+	Visibility Visibility `json:"visibility,omitempty"`
+	// ISO 639 language code for the draft.
+	// Key/value not set if not specified.
+	// example: en
+	Language string `json:"language,omitempty"`
+	// Content type to use when posting the draft.
+	// Key/value not set if not specified.
+	// example: text/markdown
+	ContentType StatusContentType `json:"content_type,omitempty"`
+}
+
+// DraftPoll represents the poll settings of a draft.
+//
+// swagger:model draftPoll
+type DraftPoll struct {
+	// Possible answers for the poll.
+	Options []string `json:"options"`
+	// Duration the poll should be open, in seconds.
+	// example: 86400
+	ExpiresIn int `json:"expires_in"`
+	// Allow multiple choices on the poll.
+	Multiple bool `json:"multiple"`
+	// Hide vote counts until the poll ends.
+	HideTotals bool `json:"hide_totals"`
+}
+
+// DraftRequest models draft creation / update parameters.
+//
+// swagger:ignore
+type DraftRequest struct {
+	// Text content of the draft.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Array of Attachment ids to be attached as media.
+	MediaIDs []string `form:"media_ids[]" json:"media_ids" xml:"media_ids"`
+	// Poll to include with this draft.
+	Poll *PollRequest `form:"poll" json:"poll" xml:"poll"`
+	// ID of the status being replied to, if draft is a reply.
+	InReplyToID string `form:"in_reply_to_id" json:"in_reply_to_id" xml:"in_reply_to_id"`
+	// Draft and attached media should be marked as sensitive.
+	Sensitive bool `form:"sensitive" json:"sensitive" xml:"sensitive"`
+	// Text to be shown as a warning or subject before the actual content.
+	SpoilerText string `form:"spoiler_text" json:"spoiler_text" xml:"spoiler_text"`
+	// Visibility to post the draft with.
+	Visibility Visibility `form:"visibility" json:"visibility" xml:"visibility"`
+	// ISO 639 language code for this draft.
+	Language string `form:"language" json:"language" xml:"language"`
+	// Content type to use when posting this draft.
+	ContentType StatusContentType `form:"content_type" json:"content_type" xml:"content_type"`
+}
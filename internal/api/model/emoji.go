@@ -38,6 +38,12 @@ type Emoji struct {
 	// Used for sorting custom emoji in the picker.
 	// example: blobcats
 	Category string `json:"category,omitempty"`
+	// License under which the emoji image may be used, if set by the admin.
+	// example: CC BY 4.0
+	License string `json:"license,omitempty"`
+	// Credit for the author(s) of the emoji image, if set by the admin.
+	// example: Blobcats by Volpeon, https://volpeon.ink/emojis/blobcat/
+	Attribution string `json:"attribution,omitempty"`
 }
 
 // EmojiCreateRequest represents a request to create a custom emoji made through the admin API.
@@ -52,6 +58,12 @@ type EmojiCreateRequest struct {
 	// Category in which to place the new emoji. Will be uncategorized by default.
 	// CategoryName length should not exceed 64 characters.
 	CategoryName string `form:"category"`
+	// License under which the emoji image may be used, eg., `CC BY 4.0`.
+	// License length should not exceed 100 characters.
+	License string `form:"license"`
+	// Credit for the author(s) of the emoji image.
+	// Attribution length should not exceed 500 characters.
+	Attribution string `form:"attribution"`
 }
 
 // EmojiUpdateRequest represents a request to update a custom emoji, made through the admin API.
@@ -68,6 +80,10 @@ type EmojiUpdateRequest struct {
 	Image *multipart.FileHeader `form:"image"`
 	// Category in which to place the emoji.
 	CategoryName *string `form:"category"`
+	// License under which the emoji image may be used.
+	License *string `form:"license"`
+	// Credit for the author(s) of the emoji image.
+	Attribution *string `form:"attribution"`
 }
 
 // EmojiUpdateType models an admin update action to take on a custom emoji.
@@ -18,15 +18,32 @@
 package model
 
 // FeaturedTag represents a hashtag that is featured on a profile.
+//
+// swagger:model featuredTag
 type FeaturedTag struct {
 	// The internal ID of the featured tag in the database.
+	// example: 01FC0SKA48HNSVR6YKZCQGS2V8
 	ID string `json:"id"`
-	// The name of the hashtag being featured.
+	// The name of the hashtag being featured, without the `#`.
+	// example: sloths
 	Name string `json:"name"`
-	// A link to all statuses by a user that contain this hashtag.
+	// A link to the hashtag's page on this instance.
+	// example: https://example.org/tags/sloths
 	URL string `json:"url"`
-	// The number of authored statuses containing this hashtag.
+	// The number of public and unlisted statuses by the
+	// featuring account which contain this hashtag.
+	// example: 12
 	StatusesCount int `json:"statuses_count"`
-	// The timestamp of the last authored status containing this hashtag. (ISO 8601 Datetime)
-	LastStatusAt string `json:"last_status_at"`
+	// The timestamp of the last public or unlisted status by the featuring
+	// account containing this hashtag (ISO 8601 Datetime), or null if none.
+	// example: 2021-07-30T09:20:25+00:00
+	LastStatusAt *string `json:"last_status_at"`
+}
+
+// FeaturedTagCreateRequest models featured tag creation parameters.
+//
+// swagger:ignore
+type FeaturedTagCreateRequest struct {
+	// Name of the hashtag to feature, with or without the `#`.
+	Name string `form:"name" json:"name" xml:"name"`
 }
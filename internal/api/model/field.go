@@ -30,4 +30,8 @@ type Field struct {
 	// If this field has been verified, when did this occur? (ISO 8601 Datetime).
 	// example: 2021-07-30T09:20:25+00:00
 	VerifiedAt *string `json:"verified_at"`
+	// Links in the value of this field are marked rel="me", so that the
+	// sites they link to can verify that this profile links back to them.
+	// example: true
+	RelMe bool `json:"rel_me,omitempty"`
 }
@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// FirstInteraction represents the first interaction of a remote account
+// with the requesting account: statuses from the remote account that
+// mention the requesting account, held for review.
+//
+// swagger:model firstInteraction
+type FirstInteraction struct {
+	// The ID of the first interaction.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	ID string `json:"id"`
+	// When the first status was received (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The remote account that mentioned the requesting account.
+	Account *Account `json:"account"`
+	// Statuses held for review, oldest first.
+	Statuses []*Status `json:"statuses"`
+}
+
+// FirstInteractionReportRequest models parameters
+// for reporting a first interaction.
+//
+// swagger:ignore
+type FirstInteractionReportRequest struct {
+	// The reason for the report. Default maximum of 1000 characters.
+	Comment string `form:"comment" json:"comment" xml:"comment"`
+	// Should the report be forwarded to the remote admin?
+	Forward bool `form:"forward" json:"forward" xml:"forward"`
+}
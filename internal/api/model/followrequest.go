@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// FollowRequest represents a pending request to follow
+// the requesting account, with additional context about
+// the requester to help decide whether to accept it.
+//
+// swagger:model followRequest
+type FollowRequest struct {
+	// The ID of the follow request.
+	// example: 01FBW9XGEP7G6K88VY4S9MPE1R
+	ID string `json:"id"`
+	// When the follow request was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// The account requesting to follow. The account's
+	// note field contains the requester's bio.
+	Account *Account `json:"account"`
+	// Number of accounts you follow which also follow the requester.
+	// example: 3
+	MutualFollowersCount int `json:"mutual_followers_count"`
+}
+
+// FollowRequestBulkRequest models a request to
+// authorize or reject multiple follow requests.
+//
+// swagger:ignore
+type FollowRequestBulkRequest struct {
+	// IDs of the follow requesting accounts.
+	AccountIDs []string `form:"account_ids[]" json:"account_ids" xml:"account_ids"`
+	// Act on all pending follow requests, up to the bulk limit.
+	All bool `form:"all" json:"all" xml:"all"`
+}
@@ -57,6 +57,10 @@ type InstanceConfigurationAccounts struct {
 	// The maximum number of profile fields allowed for each account.
 	// Currently not configurable, so this is hardcoded to 6. (https://github.com/superseriousbusiness/gotosocial/issues/1876)
 	MaxProfileFields int `json:"max_profile_fields"`
+	// The maximum number of statuses each account can pin to their profile.
+	//
+	// example: 10
+	MaxPinnedStatuses int `json:"max_pinned_statuses"`
 }
 
 // InstanceConfigurationStatuses models instance status config parameters.
@@ -75,6 +79,10 @@ type InstanceConfigurationStatuses struct {
 	//
 	// example: 25
 	CharactersReservedPerURL int `json:"characters_reserved_per_url"`
+	// Maximum allowed length of a content warning on this instance, in characters.
+	//
+	// example: 500
+	MaxContentWarningCharacters int `json:"max_content_warning_characters"`
 	// List of mime types that it's possible to use for statuses on this instance.
 	//
 	// example: ["text/plain","text/markdown"]
@@ -115,6 +123,10 @@ type InstanceConfigurationMediaAttachments struct {
 	//
 	// example: 16777216
 	VideoMatrixLimit int `json:"video_matrix_limit"`
+	// Max allowed length of a media description, in characters.
+	//
+	// example: 1500
+	DescriptionLimit int `json:"description_limit"`
 }
 
 // InstanceConfigurationPolls models instance poll config parameters.
@@ -0,0 +1,82 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// InstanceChooser models everything that apps which help
+// people choose an instance to sign up on need to know
+// about this instance, in one document.
+//
+// swagger:model instanceChooser
+type InstanceChooser struct {
+	// The domain of the instance.
+	// example: gts.example.org
+	Domain string `json:"domain"`
+	// The title of the instance.
+	// example: GoToSocial Example Instance
+	Title string `json:"title"`
+	// A short description of the instance.
+	//
+	// Should be HTML formatted, but might be plaintext.
+	ShortDescription string `json:"short_description"`
+	// The URL of an image used to represent this instance.
+	// example: https://example.org/assets/logo.png
+	Thumbnail string `json:"thumbnail"`
+	// The version of GoToSocial installed on the instance.
+	// example: 0.1.1 cb85f65
+	Version string `json:"version"`
+	// Primary languages of the instance + moderators/admins, most preferred first.
+	// example: ["en"]
+	Languages []string `json:"languages"`
+	// Topics or categories that best describe the instance, most relevant first.
+	// example: ["tech","music"]
+	Categories []string `json:"categories"`
+	// How people can sign up on the instance.
+	Registrations InstanceChooserRegistrations `json:"registrations"`
+	// Rules of the instance, which people agree to when signing up.
+	Rules []InstanceRule `json:"rules"`
+	// Basic anonymous usage data for this instance.
+	Usage InstanceChooserUsage `json:"usage"`
+}
+
+// InstanceChooserRegistrations models
+// how people can sign up on an instance.
+//
+// swagger:model instanceChooserRegistrations
+type InstanceChooserRegistrations struct {
+	// Whether anyone may submit a sign-up request.
+	Open bool `json:"open"`
+	// Whether sign-ups must be approved by an admin or moderator before the new account can be used.
+	ApprovalRequired bool `json:"approval_required"`
+	// Whether a reason must be given when submitting a sign-up request.
+	ReasonRequired bool `json:"reason_required"`
+	// Whether an invite is needed to sign up, ie., sign-ups aren't open.
+	InviteRequired bool `json:"invite_required"`
+}
+
+// InstanceChooserUsage models basic
+// anonymous usage data for an instance.
+//
+// swagger:model instanceChooserUsage
+type InstanceChooserUsage struct {
+	// The total number of local accounts.
+	// example: 10
+	Users int `json:"users"`
+	// The number of local accounts which have posted in the past 4 weeks.
+	// example: 2
+	ActiveMonth int `json:"active_month"`
+}
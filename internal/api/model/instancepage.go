@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// InstancePage represents a static page of the instance, like
+// the about page or privacy policy, written by an admin.
+//
+// swagger:model instancePage
+type InstancePage struct {
+	// The ID of the page.
+	// example: 01FC0SKA48HNSVR6YKZCQGS2V8
+	ID string `json:"id"`
+	// When the page was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// When the page was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// Url-safe name of the page. The page is served at /pages/{slug}.
+	// Slug `about` is shown on the about page in place of the instance
+	// description, and slug `privacy` is used as the privacy policy.
+	// example: privacy
+	Slug string `json:"slug"`
+	// Title of the page.
+	// example: Privacy Policy
+	Title string `json:"title"`
+	// Markdown text of the page, as provided by the admin.
+	// example: We don't sell your data.
+	Text string `json:"text"`
+	// Html content of the page, rendered from text.
+	// example: <p>We don't sell your data.</p>
+	Content string `json:"content"`
+	// Position of the page in navigation entries, lowest first.
+	// example: 1
+	NavOrder int `json:"nav_order"`
+	// Show the page in navigation entries.
+	ShowInNav bool `json:"show_in_nav"`
+}
+
+// InstancePageCreateRequest models instance page creation parameters.
+//
+// swagger:ignore
+type InstancePageCreateRequest struct {
+	// Url-safe name of the page.
+	Slug string `form:"slug" json:"slug" xml:"slug"`
+	// Title of the page.
+	Title string `form:"title" json:"title" xml:"title"`
+	// Markdown text of the page.
+	Text string `form:"text" json:"text" xml:"text"`
+	// Position of the page in navigation entries.
+	NavOrder int `form:"nav_order" json:"nav_order" xml:"nav_order"`
+	// Show the page in navigation entries.
+	ShowInNav *bool `form:"show_in_nav" json:"show_in_nav" xml:"show_in_nav"`
+}
+
+// InstancePageUpdateRequest models instance page update parameters.
+// Only provided fields will be updated.
+//
+// swagger:ignore
+type InstancePageUpdateRequest struct {
+	// Url-safe name of the page.
+	Slug *string `form:"slug" json:"slug" xml:"slug"`
+	// Title of the page.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// Markdown text of the page.
+	Text *string `form:"text" json:"text" xml:"text"`
+	// Position of the page in navigation entries.
+	NavOrder *int `form:"nav_order" json:"nav_order" xml:"nav_order"`
+	// Show the page in navigation entries.
+	ShowInNav *bool `form:"show_in_nav" json:"show_in_nav" xml:"show_in_nav"`
+}
+
+// PrivacyPolicy represents the privacy policy of the instance.
+//
+// swagger:model privacyPolicy
+type PrivacyPolicy struct {
+	// When the privacy policy was last updated (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	UpdatedAt string `json:"updated_at"`
+	// Html content of the privacy policy.
+	// example: <p>We don't sell your data.</p>
+	Content string `json:"content"`
+}
@@ -79,9 +79,8 @@ type InstanceV2Usage struct {
 //
 // swagger:model instanceV2Users
 type InstanceV2Users struct {
-	// The number of active users in the past 4 weeks.
-	// Currently not implemented: will always be 0.
-	// example: 0
+	// The number of local accounts which have posted in the past 4 weeks.
+	// example: 2
 	ActiveMonth int `json:"active_month"`
 }
 
@@ -135,7 +134,6 @@ type InstanceV2URLs struct {
 // swagger:model instanceV2ConfigurationTranslation
 type InstanceV2ConfigurationTranslation struct {
 	// Whether the Translations API is available on this instance.
-	// Not implemented so this value is always false.
 	Enabled bool `json:"enabled"`
 }
 
@@ -173,6 +171,23 @@ type InstanceV2Registrations struct {
 	// Value will be null if no message is set.
 	// example: <p>Registrations are currently closed on example.org because of spam bots!</p>
 	Message *string `json:"message"`
+	// The challenge that must be passed to register.
+	// Value will be null if no challenge is required.
+	Challenge *InstanceV2RegistrationsChallenge `json:"challenge"`
+}
+
+// Information about the CAPTCHA-style challenge that must be passed
+// to register. Clients should render the provider's widget using the
+// site key, and send the token it gives back as challenge_response.
+//
+// swagger:model instanceV2RegistrationsChallenge
+type InstanceV2RegistrationsChallenge struct {
+	// Provider of the challenge.
+	// example: hcaptcha
+	Provider string `json:"provider"`
+	// Public key used to render the provider's widget.
+	// example: 10000000-ffff-ffff-ffff-000000000001
+	SiteKey string `json:"site_key"`
 }
 
 // Hints related to contacting a representative of the instance.
@@ -0,0 +1,40 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// InteractionCircleEntry represents an account which
+// interacted with the requesting account's statuses,
+// and how often, over the requested period.
+//
+// swagger:model interactionCircleEntry
+type InteractionCircleEntry struct {
+	// The account which interacted with the requesting account.
+	Account *Account `json:"account"`
+	// Number of the requesting account's statuses faved by this account.
+	// example: 12
+	Favourites int `json:"favourites"`
+	// Number of the requesting account's statuses boosted by this account.
+	// example: 3
+	Reblogs int `json:"reblogs"`
+	// Number of replies to the requesting account posted by this account.
+	// example: 5
+	Replies int `json:"replies"`
+	// Total number of interactions by this account.
+	// example: 20
+	Total int `json:"total"`
+}
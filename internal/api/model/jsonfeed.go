@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// JSONFeed models a JSON Feed 1.1 document.
+// See https://www.jsonfeed.org/version/1.1/
+//
+// swagger:ignore
+type JSONFeed struct {
+	// URL of the version of the format the feed uses.
+	Version string `json:"version"`
+	// Name of the feed.
+	Title string `json:"title"`
+	// URL of the resource that the feed describes.
+	HomePageURL string `json:"home_page_url,omitempty"`
+	// URL of the feed itself.
+	FeedURL string `json:"feed_url,omitempty"`
+	// Description of the feed.
+	Description string `json:"description,omitempty"`
+	// URL of the next (older) page of the feed, if there is one.
+	NextURL string `json:"next_url,omitempty"`
+	// URL of an image for the feed, suitable for use in a timeline.
+	Icon string `json:"icon,omitempty"`
+	// URL of an image for the feed, suitable for use in a source list.
+	Favicon string `json:"favicon,omitempty"`
+	// Authors of the feed.
+	Authors []JSONFeedAuthor `json:"authors,omitempty"`
+	// Primary language of the feed, as a BCP 47 language tag.
+	Language string `json:"language,omitempty"`
+	// Items in the feed, newest first.
+	Items []*JSONFeedItem `json:"items"`
+}
+
+// JSONFeedAuthor models the author of a JSON Feed or JSON Feed item.
+//
+// swagger:ignore
+type JSONFeedAuthor struct {
+	// Name of the author.
+	Name string `json:"name,omitempty"`
+	// URL of a site owned by the author.
+	URL string `json:"url,omitempty"`
+	// URL of an image of the author.
+	Avatar string `json:"avatar,omitempty"`
+}
+
+// JSONFeedItem models one item in a JSON Feed.
+//
+// swagger:ignore
+type JSONFeedItem struct {
+	// Unique, permanent ID of the item.
+	ID string `json:"id"`
+	// URL of the resource described by the item.
+	URL string `json:"url,omitempty"`
+	// HTML content of the item.
+	ContentHTML string `json:"content_html"`
+	// Plain text summary of the item.
+	Summary string `json:"summary,omitempty"`
+	// Date the item was published (RFC 3339).
+	DatePublished string `json:"date_published,omitempty"`
+	// Date the item was last modified (RFC 3339).
+	DateModified string `json:"date_modified,omitempty"`
+	// Authors of the item.
+	Authors []JSONFeedAuthor `json:"authors,omitempty"`
+	// Tags of the item, without leading '#'.
+	Tags []string `json:"tags,omitempty"`
+	// Language of the item, as a BCP 47 language tag.
+	Language string `json:"language,omitempty"`
+	// Media attached to the item.
+	Attachments []JSONFeedAttachment `json:"attachments,omitempty"`
+}
+
+// JSONFeedAttachment models a media enclosure of a JSON Feed item.
+//
+// swagger:ignore
+type JSONFeedAttachment struct {
+	// URL of the attachment.
+	URL string `json:"url"`
+	// MIME type of the attachment.
+	MIMEType string `json:"mime_type"`
+	// Description of the attachment.
+	Title string `json:"title,omitempty"`
+	// Size of the attachment in bytes.
+	SizeInBytes int `json:"size_in_bytes,omitempty"`
+}
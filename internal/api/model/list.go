@@ -71,3 +71,28 @@ type ListUpdateRequest struct {
 type ListAccountsChangeRequest struct {
 	AccountIDs []string `form:"account_ids[]" json:"account_ids" xml:"account_ids"`
 }
+
+// ListRule represents a rule by which statuses are automatically added
+// to a list timeline, in addition to statuses from the list's accounts.
+//
+// Exactly one of tag or account will be set.
+//
+// swagger:model listRule
+type ListRule struct {
+	// The ID of the list rule.
+	ID string `json:"id"`
+	// Statuses using this tag are added to the list.
+	Tag *Tag `json:"tag,omitempty"`
+	// Statuses authored by this account are added to the list.
+	Account *Account `json:"account,omitempty"`
+}
+
+// ListRuleCreateRequest models list rule creation parameters.
+//
+// swagger:ignore
+type ListRuleCreateRequest struct {
+	// Name of the tag to match, with or without leading '#'.
+	Tag string `form:"tag" json:"tag" xml:"tag"`
+	// ID of the account to match.
+	AccountID string `form:"account_id" json:"account_id" xml:"account_id"`
+}
@@ -33,6 +33,9 @@ type TimelineMarker struct {
 	UpdatedAt string `json:"updated_at"`
 	// Used for locking to prevent write conflicts.
 	Version int `json:"version"`
+	// Number of notifications newer than the marker, up to a
+	// maximum of 1000. Only set on the notifications marker.
+	UnreadCount *int `json:"unread_count,omitempty"`
 }
 
 // MarkerName is the name of one of the timelines we can store markers for.
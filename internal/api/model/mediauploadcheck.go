@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// MediaUploadCheckRequest models optional parameters for
+// checking whether an upload would be accepted before
+// actually sending it.
+//
+// swagger:ignore
+type MediaUploadCheckRequest struct {
+	// Size in bytes of the file the client intends to upload.
+	Size int64 `form:"size"`
+	// MIME type of the file the client intends to upload.
+	Type string `form:"type"`
+}
+
+// MediaUploadCheck models information that clients can
+// use to determine whether a media upload will be accepted
+// by the instance, before actually uploading the file.
+//
+// swagger:model mediaUploadCheck
+type MediaUploadCheck struct {
+	// Max total size in bytes of media the requesting account may store.
+	// Null if there is no limit.
+	//
+	// example: 1073741824
+	QuotaLimit *int64 `json:"quota_limit"`
+	// Total size in bytes of media currently stored by the requesting account.
+	//
+	// example: 52428800
+	QuotaUsed int64 `json:"quota_used"`
+	// Remaining bytes that the requesting account may upload.
+	// Null if there is no limit.
+	//
+	// example: 1021313024
+	QuotaRemaining *int64 `json:"quota_remaining"`
+	// List of mime types that it's possible to upload to this instance.
+	//
+	// example: ["image/jpeg","image/gif"]
+	SupportedMimeTypes []string `json:"supported_mime_types"`
+	// Max allowed image size in bytes.
+	//
+	// example: 2097152
+	ImageSizeLimit int `json:"image_size_limit"`
+	// Max allowed image size in pixels as height*width.
+	//
+	// example: 16777216
+	ImageMatrixLimit int `json:"image_matrix_limit"`
+	// Max allowed video size in bytes.
+	//
+	// example: 10485760
+	VideoSizeLimit int `json:"video_size_limit"`
+	// Max allowed video size in pixels as height*width.
+	//
+	// example: 16777216
+	VideoMatrixLimit int `json:"video_matrix_limit"`
+	// Max allowed length in characters of media descriptions.
+	//
+	// example: 5000
+	DescriptionLimit int `json:"description_limit"`
+	// Min required length in characters of media descriptions.
+	//
+	// example: 0
+	DescriptionMinimum int `json:"description_minimum"`
+	// Whether uploads are processed asynchronously after the
+	// upload request returns. If true, clients should poll the
+	// attachment until its url is set before attaching it to a
+	// status. GoToSocial processes uploads before responding,
+	// so this is always false.
+	AsyncProcessing bool `json:"async_processing"`
+	// Whether an upload with the size and type given in the
+	// request would be accepted. Null if no size was given.
+	Accepted *bool `json:"accepted"`
+	// If accepted is false, the reason the upload would be rejected.
+	//
+	// example: upload of 524288000 bytes would exceed remaining media quota of 1048576 bytes
+	Reason string `json:"reason,omitempty"`
+}
@@ -33,6 +33,7 @@ type Notification struct {
 	// 	favourite = Someone favourited one of your statuses
 	// 	poll = A poll you have voted in or created has ended
 	// 	status = Someone you enabled notifications for has posted a status
+	// 	saved_search = A new status matches one of your saved searches
 	Type string `json:"type"`
 	// The timestamp of the notification (ISO 8601 Datetime)
 	CreatedAt string `json:"created_at"`
@@ -45,6 +46,75 @@ type Notification struct {
 	Status *Status `json:"status,omitempty"`
 }
 
+// GroupedNotificationsResults models a page of grouped notifications,
+// along with the accounts and statuses that they refer to. Each account
+// and status is included only once, however many groups refer to it.
+//
+// swagger:model groupedNotificationsResults
+type GroupedNotificationsResults struct {
+	// Accounts referred to by notification groups.
+	Accounts []*Account `json:"accounts"`
+	// Statuses referred to by notification groups.
+	Statuses []*Status `json:"statuses"`
+	// Notification groups, newest first.
+	NotificationGroups []*NotificationGroup `json:"notification_groups"`
+	// Link header to page through
+	// the results. Not serialized.
+	LinkHeader string `json:"-"`
+}
+
+// NotificationGroup represents notifications of the same type about
+// the same status, collapsed into one group. Notifications of a type
+// that isn't grouped are each represented by a group of their own.
+//
+// swagger:model notificationGroup
+type NotificationGroup struct {
+	// Key identifying the group. Groups with the same key,
+	// on different pages of results, may be merged together.
+	GroupKey string `json:"group_key"`
+	// Total number of notifications in the group.
+	NotificationsCount int `json:"notifications_count"`
+	// The type of the notifications in the group.
+	Type string `json:"type"`
+	// ID of the most recent notification in the group.
+	MostRecentNotificationID string `json:"most_recent_notification_id"`
+	// ID of the oldest notification in the group on this page.
+	PageMinID string `json:"page_min_id"`
+	// ID of the newest notification in the group on this page.
+	PageMaxID string `json:"page_max_id"`
+	// Timestamp of the newest notification in the group on this page (ISO 8601 Datetime).
+	LatestPageNotificationAt string `json:"latest_page_notification_at"`
+	// IDs of the accounts that caused the most recent notifications in the group, newest first.
+	// Accounts are found in the accounts of the grouped notifications results.
+	SampleAccountIDs []string `json:"sample_account_ids"`
+	// ID of the status that the notifications are about, if any. The
+	// status is found in the statuses of the grouped notifications results.
+	StatusID string `json:"status_id,omitempty"`
+}
+
+// NotificationsUnreadCount represents the number of notifications
+// newer than the notifications marker of the requesting account.
+//
+// swagger:model notificationsUnreadCount
+type NotificationsUnreadCount struct {
+	// Number of unread notifications, up to the requested limit.
+	Count int `json:"count"`
+}
+
+// NotificationsDismissRequest models a request to
+// dismiss notifications in bulk. Only notifications
+// matching each of the provided filters are dismissed.
+//
+// swagger:ignore
+type NotificationsDismissRequest struct {
+	// Dismiss notifications of these types.
+	Types []string `form:"types[]" json:"types" xml:"types"`
+	// Dismiss notifications originating from this account ID.
+	AccountID string `form:"account_id" json:"account_id" xml:"account_id"`
+	// Dismiss notifications created before this ISO 8601 Datetime.
+	OlderThan string `form:"older_than" json:"older_than" xml:"older_than"`
+}
+
 /*
 	The below functions are added onto the apimodel notification so that it satisfies
 	the Timelineable interface in internal/timeline.
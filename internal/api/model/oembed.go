@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// OEmbed models an oEmbed response for a status or profile on this
+// instance, which other sites can use to embed or unfurl links to it.
+//
+// See https://oembed.com/
+//
+// swagger:model oEmbed
+type OEmbed struct {
+	// The oEmbed version number. Always "1.0".
+	// example: 1.0
+	Version string `json:"version"`
+	// The resource type: "rich" for statuses, "link" for profiles.
+	// example: rich
+	Type string `json:"type"`
+	// A text title describing the resource.
+	// example: Post by @some_user@example.org
+	Title string `json:"title,omitempty"`
+	// The name of the author of the resource.
+	// example: some_user
+	AuthorName string `json:"author_name,omitempty"`
+	// A URL for the author of the resource.
+	// example: https://example.org/@some_user
+	AuthorURL string `json:"author_url,omitempty"`
+	// The name of the resource provider; the title of this instance.
+	// example: GoToSocial Example Instance
+	ProviderName string `json:"provider_name"`
+	// The URL of the resource provider; the URL of this instance.
+	// example: https://example.org
+	ProviderURL string `json:"provider_url"`
+	// Suggested cache lifetime for this resource, in seconds.
+	// example: 86400
+	CacheAge int `json:"cache_age"`
+	// HTML required to embed the resource. Only set for type "rich".
+	// example: <iframe src="https://example.org/@some_user/statuses/01F8MH75CBF9JFX4ZAD54N0W0R/embed"></iframe>
+	HTML string `json:"html,omitempty"`
+	// Width in pixels required to display the HTML. Only set for type "rich".
+	// example: 400
+	Width int `json:"width,omitempty"`
+	// Height in pixels required to display the HTML. Only set for type "rich".
+	// example: 300
+	Height int `json:"height,omitempty"`
+	// A URL to a thumbnail image representing the resource.
+	// example: https://example.org/fileserver/01JDPX2NVH3G2JMYRSWB5DXEJ3/attachment/small/01JDPX2T3EJK28DPW5A69MZR23.jpg
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// Width of the thumbnail in pixels.
+	// example: 400
+	ThumbnailWidth int `json:"thumbnail_width,omitempty"`
+	// Height of the thumbnail in pixels.
+	// example: 300
+	ThumbnailHeight int `json:"thumbnail_height,omitempty"`
+}
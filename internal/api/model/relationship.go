@@ -48,4 +48,7 @@ type Relationship struct {
 	Endorsed bool `json:"endorsed"`
 	// Your note on this account.
 	Note string `json:"note"`
+	// You are a member of this group account; following a group is how you join it.
+	// Only set if this account is a group, as indicated by its "group" field.
+	Member *bool `json:"member,omitempty"`
 }
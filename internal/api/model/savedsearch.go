@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// SavedSearch represents a named status search
+// saved by an account for later reuse.
+//
+// swagger:model savedSearch
+type SavedSearch struct {
+	// The ID of the saved search.
+	// example: 01FC0SKA48HNSVR6YKZCQGS2V8
+	ID string `json:"id"`
+	// When the saved search was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Name given to the saved search.
+	// example: sloth pics
+	Title string `json:"title"`
+	// Search query, as it would be given to the search API.
+	// example: sloth has:media
+	Query string `json:"query"`
+	// Notify the owner when new statuses match the query.
+	Notify bool `json:"notify"`
+}
+
+// SavedSearchCreateRequest models saved search creation parameters.
+//
+// swagger:ignore
+type SavedSearchCreateRequest struct {
+	// Name to give the saved search.
+	Title string `form:"title" json:"title" xml:"title"`
+	// Search query to save.
+	Query string `form:"query" json:"query" xml:"query"`
+	// Notify the owner when new statuses match the query.
+	Notify bool `form:"notify" json:"notify" xml:"notify"`
+}
@@ -32,6 +32,27 @@ type Source struct {
 	Language string `json:"language"`
 	// The default posting content type for new statuses.
 	StatusContentType string `json:"status_content_type"`
+	// Preferred locale for web pages and emails from this instance,
+	// as a BCP 47 language tag. Empty if no locale has been chosen.
+	Locale string `json:"locale"`
+	// Whether follows and mentions are emailed to the user of the account.
+	//	none = Don't email notifications
+	//	immediate = Email each notification as it happens
+	//	daily = Email a daily digest of notifications
+	EmailNotifications string `json:"email_notifications"`
+	// Whether mentions from remote accounts that the user of the
+	// account has never interacted with are held for review.
+	ReviewFirstInteractions bool `json:"review_first_interactions"`
+	// IANA name of the time zone the user of the account is in,
+	// eg., Europe/Amsterdam. Empty if no time zone has been chosen,
+	// in which case UTC is used.
+	TimeZone string `json:"time_zone"`
+	// Whether daily highlights of the statuses from followed
+	// accounts that got the most interactions are made.
+	//	none = Don't make highlights
+	//	daily = Make highlights once a day
+	//	email = Make highlights once a day, and email them
+	HighlightsDigest string `json:"highlights_digest"`
 	// Profile bio.
 	Note string `json:"note"`
 	// Metadata about the account.
@@ -98,6 +98,33 @@ type Status struct {
 	// so the user may redraft from the source text without the client having to reverse-engineer
 	// the original text from the HTML content.
 	Text string `json:"text,omitempty"`
+	// Summary of emoji reactions to this status, grouped by emoji.
+	// Only set when emoji reactions are enabled on this instance.
+	EmojiReactions []StatusReaction `json:"emoji_reactions,omitempty"`
+	// ID of the status quoted by this status, if known to this instance.
+	// example: 01FBVD42CQ3ZEEVMW180SBX03B
+	QuoteID *string `json:"quote_id,omitempty"`
+	// ActivityPub URI of the status quoted by this status, if any.
+	// example: https://example.org/notes/9k2xk1t2c9
+	QuoteURL string `json:"quote_url,omitempty"`
+	// Free-text name of the place this status was posted from, if any.
+	// example: Amsterdam Centraal
+	Location string `json:"location,omitempty"`
+	// Start and end times of the event represented by this status, if it's an event.
+	Event *StatusEvent `json:"event,omitempty"`
+}
+
+// StatusEvent models the start and end times of an event status.
+//
+// swagger:model statusEvent
+type StatusEvent struct {
+	// Time the event starts (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	StartTime string `json:"start_time"`
+	// Time the event ends (ISO 8601 Datetime), if known.
+	// example: 2021-07-30T11:20:25+00:00
+	// nullable: true
+	EndTime *string `json:"end_time"`
 }
 
 /*
@@ -181,6 +208,29 @@ type StatusCreateRequest struct {
 	// Content type to use when parsing this status.
 	// in: formData
 	ContentType StatusContentType `form:"content_type" json:"content_type" xml:"content_type"`
+	// Free-text name of the place this status is being posted from.
+	// Federated as an ActivityStreams Place with no coordinates.
+	// in: formData
+	Location string `form:"location" json:"location" xml:"location"`
+}
+
+// StatusBoostRequest models optional parameters
+// for boosting/reblogging a status.
+//
+// swagger:ignore
+type StatusBoostRequest struct {
+	// Visibility of the boost. Must be one of public, unlisted,
+	// or private, and may not be wider than the visibility of
+	// the boosted status. Defaults to visibility of boosted status.
+	Visibility Visibility `form:"visibility" json:"visibility" xml:"visibility"`
+}
+
+// PinsOrderRequest models a request to reorder pinned statuses.
+//
+// swagger:ignore
+type PinsOrderRequest struct {
+	// IDs of all pinned statuses of the requesting account, in the desired order.
+	StatusIDs []string `form:"status_ids[]" json:"status_ids" xml:"status_ids"`
 }
 
 // Visibility models the visibility of a status.
@@ -0,0 +1,42 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// StatusReaction models a summary of emoji reactions
+// to a status, grouped by the emoji used to react.
+//
+// swagger:model statusReaction
+type StatusReaction struct {
+	// The emoji used to react: either a unicode emoji,
+	// or a custom emoji shortcode wrapped in colons.
+	// example: :blobcat:
+	Name string `json:"name"`
+	// Number of accounts that reacted to the status with this emoji.
+	Count int `json:"count"`
+	// Whether the requesting account reacted to the status with this emoji.
+	Me bool `json:"me"`
+	// Web URL of the custom emoji image, if a custom emoji was used.
+	// example: https://example.org/fileserver/01BPSX2MKCRVMD4YN4D71G9CP5/emoji/original/01AY3XX9FKPVKVA3QRB0QEKWNB.png
+	URL string `json:"url,omitempty"`
+	// Web URL of a static version of the custom emoji image, if a custom emoji was used.
+	// example: https://example.org/fileserver/01BPSX2MKCRVMD4YN4D71G9CP5/emoji/static/01AY3XX9FKPVKVA3QRB0QEKWNB.png
+	StaticURL string `json:"static_url,omitempty"`
+	// Accounts that reacted to the status with this emoji.
+	// Only set when viewing the reactions to a single status.
+	Accounts []*Account `json:"accounts,omitempty"`
+}
@@ -0,0 +1,34 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Theme represents one theme from the
+// instance's server-side theme gallery.
+//
+// swagger:model theme
+type Theme struct {
+	// User-facing title of this theme.
+	// example: Midnight
+	Title string `json:"title"`
+	// User-facing description of this theme.
+	// example: Dark blue background with light text.
+	Description string `json:"description"`
+	// File name of this theme, used to select it.
+	// example: midnight.css
+	FileName string `json:"file_name"`
+}
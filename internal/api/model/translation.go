@@ -0,0 +1,52 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package model
+
+// Translation represents a status
+// translated into another language.
+//
+// swagger:model translation
+type Translation struct {
+	// Translated content of the status, as HTML.
+	// example: <p>Hello, world!</p>
+	Content string `json:"content"`
+	// Translated content warning of the status, if it has one.
+	SpoilerText string `json:"spoiler_text"`
+	// Language of the status (ISO 639), as given to or
+	// detected by the translation backend.
+	// example: de
+	DetectedSourceLanguage string `json:"detected_source_language"`
+	// Language the status was translated into.
+	// example: en
+	Language string `json:"language"`
+	// Name of the translation backend
+	// that translated the status.
+	// example: DeepL.com
+	Provider string `json:"provider"`
+}
+
+// StatusTranslateRequest models optional
+// parameters for translating a status.
+//
+// swagger:ignore
+type StatusTranslateRequest struct {
+	// ISO 639 language code to translate the status into.
+	// Defaults to the locale of the user, or the main
+	// language of the instance if they have none set.
+	Lang string `form:"lang" json:"lang" xml:"lang"`
+}
@@ -50,13 +50,14 @@ func NotFoundHandler(c *gin.Context, instanceGet func(ctx context.Context) (*api
 		}
 
 		c.HTML(http.StatusNotFound, "404.tmpl", gin.H{
+			"i18n":      TemplateLocalizer(c),
 			"instance":  instance,
 			"requestID": gtscontext.RequestID(ctx),
 		})
 	default:
-		c.JSON(http.StatusNotFound, gin.H{
+		c.JSON(http.StatusNotFound, errorBody(c, gin.H{
 			"error": http.StatusText(http.StatusNotFound),
-		})
+		}))
 	}
 }
 
@@ -73,14 +74,27 @@ func genericErrorHandler(c *gin.Context, instanceGet func(ctx context.Context) (
 		}
 
 		c.HTML(errWithCode.Code(), "error.tmpl", gin.H{
+			"i18n":      TemplateLocalizer(c),
 			"instance":  instance,
 			"code":      errWithCode.Code(),
 			"error":     errWithCode.Safe(),
 			"requestID": gtscontext.RequestID(ctx),
 		})
 	default:
-		c.JSON(errWithCode.Code(), gin.H{"error": errWithCode.Safe()})
+		c.JSON(errWithCode.Code(), errorBody(c, gin.H{
+			"error": errWithCode.Safe(),
+		}))
+	}
+}
+
+// errorBody adds the ID of the current request to the given
+// JSON error response body, if set, so that callers can give
+// it to the instance admin to find the error in the logs.
+func errorBody(c *gin.Context, body gin.H) gin.H {
+	if id := gtscontext.RequestID(c.Request.Context()); id != "" {
+		body["request_id"] = id
 	}
+	return body
 }
 
 // ErrorHandler takes the provided gin context and errWithCode
@@ -110,8 +124,12 @@ func ErrorHandler(c *gin.Context, errWithCode gtserror.WithCode, instanceGet fun
 	}
 
 	// Set the error on the gin context so that it can be logged
-	// in the gin logger middleware (internal/middleware/logger.go).
-	c.Error(errWithCode) //nolint:errcheck
+	// in the gin logger middleware (internal/middleware/logger.go),
+	// tagged with the request ID which is returned to the caller.
+	c.Error(gtserror.WithRequestID( //nolint:errcheck
+		errWithCode,
+		gtscontext.RequestID(c.Request.Context()),
+	))
 
 	// Discover if we're allowed to serve a nice html error page,
 	// or if we should just use a json. Normally we would want to
@@ -155,8 +173,8 @@ func OAuthErrorHandler(c *gin.Context, errWithCode gtserror.WithCode) {
 		l.Debug("handling OAuth error")
 	}
 
-	c.JSON(statusCode, gin.H{
+	c.JSON(statusCode, errorBody(c, gin.H{
 		"error":             errWithCode.Error(),
 		"error_description": errWithCode.Safe(),
-	})
+	}))
 }
@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+const (
+	// IdempotencyKeyHeader can be set by clients on requests creating
+	// something, so that retrying a request with the same key returns
+	// what the first request created, instead of creating a duplicate.
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	maxIdempotencyKeyLength = 256
+)
+
+// IdempotencyKey returns the Idempotency-Key header of the given
+// request, or an empty string if it's not set, or an error if
+// it's too long.
+func IdempotencyKey(c *gin.Context) (string, gtserror.WithCode) {
+	key := c.GetHeader(IdempotencyKeyHeader)
+	if len(key) > maxIdempotencyKeyLength {
+		err := fmt.Errorf("%s header too long, %d characters provided but limit is %d", IdempotencyKeyHeader, len(key), maxIdempotencyKeyLength)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return key, nil
+}
@@ -25,6 +25,8 @@ const (
 	AppXML            MIME = `application/xml`
 	AppXMLXRD         MIME = `application/xrd+xml`
 	AppRSSXML         MIME = `application/rss+xml`
+	AppAtomXML        MIME = `application/atom+xml`
+	AppFeedJSON       MIME = `application/feed+json` // https://www.jsonfeed.org/version/1.1/
 	AppActivityJSON   MIME = `application/activity+json`
 	AppActivityLDJSON MIME = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
 	AppJRDJSON        MIME = `application/jrd+json` // https://www.rfc-editor.org/rfc/rfc7033#section-10.2
@@ -33,4 +35,6 @@ const (
 	TextXML           MIME = `text/xml`
 	TextHTML          MIME = `text/html`
 	TextCSS           MIME = `text/css`
+	TextVCard         MIME = `text/vcard`
+	ImageSVG          MIME = `image/svg+xml`
 )
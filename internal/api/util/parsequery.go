@@ -45,6 +45,7 @@ const (
 
 	SearchExcludeUnreviewedKey = "exclude_unreviewed"
 	SearchFollowingKey         = "following"
+	SearchInteractedKey        = "interacted"
 	SearchLookupKey            = "acct"
 	SearchOffsetKey            = "offset"
 	SearchQueryKey             = "q"
@@ -57,13 +58,34 @@ const (
 
 	/* Web endpoint keys */
 
-	WebUsernameKey = "username"
-	WebStatusIDKey = "status"
+	WebUsernameKey   = "username"
+	WebStatusIDKey   = "status"
+	WebThreadPageKey = "page"
+	WebLinkURLKey    = "url"
+
+	/* oEmbed keys */
+
+	OEmbedURLKey       = "url"
+	OEmbedFormatKey    = "format"
+	OEmbedMaxWidthKey  = "maxwidth"
+	OEmbedMaxHeightKey = "maxheight"
 
 	/* Domain permission keys */
 
 	DomainPermissionExportKey = "export"
 	DomainPermissionImportKey = "import"
+
+	/* Domain stats keys */
+
+	DomainStatsFailingKey = "failing"
+
+	/* Interaction circle keys */
+
+	InteractionCircleDaysKey = "days"
+
+	/* Admin statistics keys */
+
+	StatisticsDaysKey = "days"
 )
 
 // parseError returns gtserror.WithCode set to 400 Bad Request, to indicate
@@ -113,6 +135,10 @@ func ParseSearchFollowing(value string, defaultValue bool) (bool, gtserror.WithC
 	return parseBool(value, defaultValue, SearchFollowingKey)
 }
 
+func ParseSearchInteracted(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, SearchInteractedKey)
+}
+
 func ParseSearchOffset(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
 	return parseInt(value, defaultValue, max, min, SearchOffsetKey)
 }
@@ -129,6 +155,30 @@ func ParseDomainPermissionImport(value string, defaultValue bool) (bool, gtserro
 	return parseBool(value, defaultValue, DomainPermissionImportKey)
 }
 
+func ParseDomainStatsFailing(value string, defaultValue bool) (bool, gtserror.WithCode) {
+	return parseBool(value, defaultValue, DomainStatsFailingKey)
+}
+
+func ParseWebThreadPage(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, WebThreadPageKey)
+}
+
+func ParseInteractionCircleDays(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, InteractionCircleDaysKey)
+}
+
+func ParseStatisticsDays(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, StatisticsDaysKey)
+}
+
+func ParseOEmbedMaxWidth(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, OEmbedMaxWidthKey)
+}
+
+func ParseOEmbedMaxHeight(value string, defaultValue int, max, min int) (int, gtserror.WithCode) {
+	return parseInt(value, defaultValue, max, min, OEmbedMaxHeightKey)
+}
+
 /*
 	Parse functions for *REQUIRED* parameters.
 */
@@ -193,6 +243,16 @@ func ParseTagName(value string) (string, gtserror.WithCode) {
 	return value, nil
 }
 
+func ParseOEmbedURL(value string) (string, gtserror.WithCode) {
+	key := OEmbedURLKey
+
+	if value == "" {
+		return "", requiredError(key)
+	}
+
+	return value, nil
+}
+
 func ParseWebUsername(value string) (string, gtserror.WithCode) {
 	key := WebUsernameKey
 
@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+)
+
+// TemplateLocalizer returns the localizer to use
+// when rendering an html template in response to
+// the given request. Strings are translated into the
+// first of the given locales that can be served, eg.,
+// the locale chosen by the logged-in user, or else into
+// the languages accepted by the requester, following
+// the fallback chain described on i18n.New.
+//
+// Templates expect to find the localizer under key
+// "i18n" in the data passed to them.
+func TemplateLocalizer(c *gin.Context, locales ...string) *i18n.Localizer {
+	langs := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		if locale != "" {
+			langs = append(langs, locale)
+		}
+	}
+
+	langs = append(langs, i18n.ParseAcceptLanguage(c.GetHeader("Accept-Language"))...)
+	return i18n.New(langs...)
+}
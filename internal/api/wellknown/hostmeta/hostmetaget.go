@@ -50,7 +50,7 @@ func (m *Module) HostMetaGETHandler(c *gin.Context) {
 		return
 	}
 
-	hostMeta := m.processor.Fedi().HostMetaGet()
+	hostMeta := m.processor.Fedi().HostMetaGet(c.Request.Host)
 
 	// this setup with a separate buffer we encode into is used because
 	// xml.Marshal does not emit xml.Header by itself
@@ -49,7 +49,7 @@ func (m *Module) NodeInfoWellKnownGETHandler(c *gin.Context) {
 		return
 	}
 
-	resp, errWithCode := m.processor.Fedi().NodeInfoRelGet(c.Request.Context())
+	resp, errWithCode := m.processor.Fedi().NodeInfoRelGet(c.Request.Context(), c.Request.Host)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 		return
@@ -27,6 +27,7 @@ import (
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -75,7 +76,7 @@ func (m *Module) WebfingerGETRequest(c *gin.Context) {
 		return
 	}
 
-	if requestedHost != config.GetHost() && requestedHost != config.GetAccountDomain() {
+	if !uris.IsLocalHost(requestedHost) && requestedHost != config.GetAccountDomain() {
 		err := fmt.Errorf("requested host %s does not belong to this instance", requestedHost)
 		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
 		return
@@ -262,6 +262,37 @@ func (suite *WebfingerGetTestSuite) TestFingerUserWithoutAcct() {
 }`, resp)
 }
 
+func (suite *WebfingerGetTestSuite) TestFingerUserByOnionHost() {
+	config.SetOnionHost("abcdefghijklmnop.onion")
+	defer config.SetOnionHost("")
+
+	// Fingering by the onion service address
+	// should give the same, canonical result.
+	targetAccount := suite.testAccounts["local_account_1"]
+	requestPath := fmt.Sprintf("/%s?resource=acct:%s@%s", webfinger.WebfingerBasePath, targetAccount.Username, config.GetOnionHost())
+
+	resp := suite.finger(requestPath)
+	suite.Equal(`{
+  "subject": "acct:the_mighty_zork@localhost:8080",
+  "aliases": [
+    "http://localhost:8080/users/the_mighty_zork",
+    "http://localhost:8080/@the_mighty_zork"
+  ],
+  "links": [
+    {
+      "rel": "http://webfinger.net/rel/profile-page",
+      "type": "text/html",
+      "href": "http://localhost:8080/@the_mighty_zork"
+    },
+    {
+      "rel": "self",
+      "type": "application/activity+json",
+      "href": "http://localhost:8080/users/the_mighty_zork"
+    }
+  ]
+}`, resp)
+}
+
 func TestWebfingerGetTestSuite(t *testing.T) {
 	suite.Run(t, new(WebfingerGetTestSuite))
 }
@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bloom
+
+import "math"
+
+// Filter is a bloom filter over strings, useful for cheaply
+// pre-screening set membership before falling back to a more
+// expensive (e.g. database) check. A negative result from
+// .MayContain() is always correct, whereas a positive result
+// is a false positive with roughly the rate passed to New().
+//
+// Filters are not safe for concurrent writes, and are
+// intended to be built once and then only read from.
+type Filter struct {
+	bits []uint64 // bitset
+	k    uint64   // no. hashes
+}
+
+// New returns a new Filter sized to hold n
+// entries with a false positive rate of p.
+func New(n int, p float64) *Filter {
+	if n < 1 {
+		n = 1
+	}
+
+	// Optimal no. bits: m = -n*ln(p) / ln(2)^2
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+
+	// Optimal no. hashes: k = (m/n) * ln(2)
+	k := math.Round(m / float64(n) * math.Ln2)
+	if k < 1 {
+		k = 1
+	}
+
+	return &Filter{
+		bits: make([]uint64, (uint64(m)+63)/64),
+		k:    uint64(k),
+	}
+}
+
+// Add adds the given string to the filter.
+func (f *Filter) Add(s string) {
+	h1, h2 := hash(s)
+	m := uint64(len(f.bits)) * 64
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % m
+		f.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// MayContain returns whether the given string may have been
+// added to the filter. If false, it definitely was not.
+func (f *Filter) MayContain(s string) bool {
+	h1, h2 := hash(s)
+	m := uint64(len(f.bits)) * 64
+	for i := uint64(0); i < f.k; i++ {
+		bit := (h1 + i*h2) % m
+		if f.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the size of the filter's bitset in bytes.
+func (f *Filter) Size() int {
+	return len(f.bits) * 8
+}
+
+// hash returns two 64-bit hashes of s for use in double
+// hashing, the first being an inlined (allocation-free)
+// FNV-1a, the second a remixing of that first hash.
+func hash(s string) (uint64, uint64) {
+	const (
+		offset64 = 14695981039346656037
+		prime64  = 1099511628211
+	)
+
+	h1 := uint64(offset64)
+	for i := 0; i < len(s); i++ {
+		h1 ^= uint64(s[i])
+		h1 *= prime64
+	}
+
+	// Remix with a splitmix64 finalizer,
+	// ensuring odd so never a zero step.
+	h2 := h1 ^ (h1 >> 30)
+	h2 *= 0xbf58476d1ce4e5b9
+	h2 ^= h2 >> 27
+	h2 *= 0x94d049bb133111eb
+	h2 ^= h2 >> 31
+
+	return h1, h2 | 1
+}
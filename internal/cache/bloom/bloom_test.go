@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bloom_test
+
+import (
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache/bloom"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func TestFilter(t *testing.T) {
+	const n = 1000
+
+	added := make([]string, n)
+	for i := range added {
+		added[i] = id.NewULID()
+	}
+
+	f := bloom.New(n, 0.01)
+	for _, s := range added {
+		f.Add(s)
+	}
+
+	// Every added entry must be reported.
+	for _, s := range added {
+		if !f.MayContain(s) {
+			t.Fatalf("filter should contain: %s", s)
+		}
+	}
+
+	// Entries never added should mostly not be,
+	// allowing some slack on the false positive rate.
+	var falsePositives int
+	for i := 0; i < n; i++ {
+		if f.MayContain(id.NewULID()) {
+			falsePositives++
+		}
+	}
+
+	if falsePositives > n/20 {
+		t.Errorf("too many false positives: %d/%d", falsePositives, n)
+	}
+}
+
+func TestFilterEmpty(t *testing.T) {
+	f := bloom.New(0, 0.01)
+
+	if f.MayContain(id.NewULID()) {
+		t.Error("empty filter should not contain anything")
+	}
+}
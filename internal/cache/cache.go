@@ -35,6 +35,10 @@ type Caches struct {
 	// (used by the visibility filter).
 	Visibility VisibilityCache
 
+	// notifier propagates invalidations
+	// to other processes, if configured.
+	notifier notifier
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -53,6 +57,13 @@ func (c *Caches) Init() {
 	c.setuphooks()
 }
 
+// Clear will clear all caches of database-backed items,
+// including the visibility cache which depends on them.
+func (c *Caches) Clear() {
+	c.GTS.Clear()
+	c.Visibility.Clear()
+}
+
 // Start will start both the GTS and AP cache collections.
 func (c *Caches) Start() {
 	log.Infof(nil, "start: %p", c)
@@ -75,151 +86,326 @@ func (c *Caches) Stop() {
 // as an invalidation indicates a database INSERT / UPDATE / DELETE.
 // NOTE THEY ARE ONLY CALLED WHEN THE ITEM IS IN THE CACHE, SO FOR
 // HOOKS TO BE CALLED ON DELETE YOU MUST FIRST POPULATE IT IN THE CACHE.
+//
+// Each hook also passes the invalidation on to any configured
+// Notifier (see notify.go), along with the keys needed to repeat
+// the same dependent invalidations in another process.
 func (c *Caches) setuphooks() {
 	c.GTS.Account().SetInvalidateCallback(func(account *gtsmodel.Account) {
-		// Invalidate account ID cached visibility.
-		c.Visibility.Invalidate("ItemID", account.ID)
-		c.Visibility.Invalidate("RequesterID", account.ID)
-
-		// Invalidate this account's
-		// following / follower lists.
-		// (see FollowIDs() comment for details).
-		c.GTS.FollowIDs().InvalidateAll(
-			">"+account.ID,
-			"l>"+account.ID,
-			"<"+account.ID,
-			"l<"+account.ID,
-		)
-
-		// Invalidate this account's
-		// follow requesting / request lists.
-		// (see FollowRequestIDs() comment for details).
-		c.GTS.FollowRequestIDs().InvalidateAll(
-			">"+account.ID,
-			"<"+account.ID,
-		)
-
-		// Invalidate this account's block lists.
-		c.GTS.BlockIDs().Invalidate(account.ID)
+		c.invalidateAccountDeps(account.ID)
+		c.notify(cacheAccount, account.ID)
+	})
+
+	c.GTS.AccountNote().SetInvalidateCallback(func(note *gtsmodel.AccountNote) {
+		c.notify(cacheAccountNote, note.ID)
+	})
+
+	c.GTS.Application().SetInvalidateCallback(func(app *gtsmodel.Application) {
+		c.notify(cacheApplication, app.ID)
 	})
 
 	c.GTS.Block().SetInvalidateCallback(func(block *gtsmodel.Block) {
-		// Invalidate block origin account ID cached visibility.
-		c.Visibility.Invalidate("ItemID", block.AccountID)
-		c.Visibility.Invalidate("RequesterID", block.AccountID)
+		c.invalidateBlockDeps(block.AccountID, block.TargetAccountID)
+		c.notify(cacheBlock, block.ID, block.AccountID, block.TargetAccountID)
+	})
+
+	c.GTS.DomainAllow().SetClearCallback(c.invalidateDomainDeps)
 
-		// Invalidate block target account ID cached visibility.
-		c.Visibility.Invalidate("ItemID", block.TargetAccountID)
-		c.Visibility.Invalidate("RequesterID", block.TargetAccountID)
+	c.GTS.DomainBlock().SetClearCallback(c.invalidateDomainDeps)
+
+	c.GTS.DomainInterop().SetInvalidateCallback(func(interop *gtsmodel.DomainInterop) {
+		c.notify(cacheDomainInterop, interop.ID)
+	})
 
-		// Invalidate source account's block lists.
-		c.GTS.BlockIDs().Invalidate(block.AccountID)
+	c.GTS.Emoji().SetInvalidateCallback(func(emoji *gtsmodel.Emoji) {
+		c.notify(cacheEmoji, emoji.ID)
 	})
 
 	c.GTS.EmojiCategory().SetInvalidateCallback(func(category *gtsmodel.EmojiCategory) {
-		// Invalidate any emoji in this category.
-		c.GTS.Emoji().Invalidate("CategoryID", category.ID)
+		c.invalidateEmojiCategoryDeps(category.ID)
+		c.notify(cacheEmojiCategory, category.ID)
 	})
 
 	c.GTS.Follow().SetInvalidateCallback(func(follow *gtsmodel.Follow) {
-		// Invalidate follow request with this same ID.
-		c.GTS.FollowRequest().Invalidate("ID", follow.ID)
-
-		// Invalidate any related list entries.
-		c.GTS.ListEntry().Invalidate("FollowID", follow.ID)
-
-		// Invalidate follow origin account ID cached visibility.
-		c.Visibility.Invalidate("ItemID", follow.AccountID)
-		c.Visibility.Invalidate("RequesterID", follow.AccountID)
-
-		// Invalidate follow target account ID cached visibility.
-		c.Visibility.Invalidate("ItemID", follow.TargetAccountID)
-		c.Visibility.Invalidate("RequesterID", follow.TargetAccountID)
-
-		// Invalidate source account's following
-		// lists, and destination's follwer lists.
-		// (see FollowIDs() comment for details).
-		c.GTS.FollowIDs().InvalidateAll(
-			">"+follow.AccountID,
-			"l>"+follow.AccountID,
-			"<"+follow.AccountID,
-			"l<"+follow.AccountID,
-			"<"+follow.TargetAccountID,
-			"l<"+follow.TargetAccountID,
-			">"+follow.TargetAccountID,
-			"l>"+follow.TargetAccountID,
-		)
+		c.invalidateFollowDeps(follow.ID, follow.AccountID, follow.TargetAccountID)
+		c.notify(cacheFollow, follow.ID, follow.AccountID, follow.TargetAccountID)
 	})
 
 	c.GTS.FollowRequest().SetInvalidateCallback(func(followReq *gtsmodel.FollowRequest) {
-		// Invalidate follow with this same ID.
-		c.GTS.Follow().Invalidate("ID", followReq.ID)
+		c.invalidateFollowRequestDeps(followReq.ID, followReq.AccountID, followReq.TargetAccountID)
+		c.notify(cacheFollowRequest, followReq.ID, followReq.AccountID, followReq.TargetAccountID)
+	})
 
-		// Invalidate source account's followreq
-		// lists, and destinations follow req lists.
-		// (see FollowRequestIDs() comment for details).
-		c.GTS.FollowRequestIDs().InvalidateAll(
-			">"+followReq.AccountID,
-			"<"+followReq.AccountID,
-			">"+followReq.TargetAccountID,
-			"<"+followReq.TargetAccountID,
-		)
+	c.GTS.Instance().SetInvalidateCallback(func(instance *gtsmodel.Instance) {
+		c.notify(cacheInstance, instance.ID)
 	})
 
 	c.GTS.List().SetInvalidateCallback(func(list *gtsmodel.List) {
-		// Invalidate all cached entries of this list.
-		c.GTS.ListEntry().Invalidate("ListID", list.ID)
+		c.invalidateListDeps(list.ID)
+		c.notify(cacheList, list.ID)
+	})
+
+	c.GTS.ListEntry().SetInvalidateCallback(func(entry *gtsmodel.ListEntry) {
+		c.notify(cacheListEntry, entry.ID)
+	})
+
+	c.GTS.Marker().SetInvalidateCallback(func(marker *gtsmodel.Marker) {
+		c.notify(cacheMarker, marker.AccountID, string(marker.Name))
 	})
 
 	c.GTS.Media().SetInvalidateCallback(func(media *gtsmodel.MediaAttachment) {
+		var accountID string
 		if *media.Avatar || *media.Header {
-			// Invalidate cache of attaching account.
-			c.GTS.Account().Invalidate("ID", media.AccountID)
+			accountID = media.AccountID
 		}
 
-		if media.StatusID != "" {
-			// Invalidate cache of attaching status.
-			c.GTS.Status().Invalidate("ID", media.StatusID)
-		}
+		c.invalidateMediaDeps(accountID, media.StatusID)
+		c.notify(cacheMedia, media.ID, accountID, media.StatusID)
 	})
 
-	c.GTS.Status().SetInvalidateCallback(func(status *gtsmodel.Status) {
-		// Invalidate status ID cached visibility.
-		c.Visibility.Invalidate("ItemID", status.ID)
-
-		for _, id := range status.AttachmentIDs {
-			// Invalidate each media by the IDs we're aware of.
-			// This must be done as the status table is aware of
-			// the media IDs in use before the media table is
-			// aware of the status ID they are linked to.
-			//
-			// c.GTS.Media().Invalidate("StatusID") will not work.
-			c.GTS.Media().Invalidate("ID", id)
-		}
+	c.GTS.Mention().SetInvalidateCallback(func(mention *gtsmodel.Mention) {
+		c.notify(cacheMention, mention.ID)
+	})
 
-		if status.BoostOfID != "" {
-			// Invalidate boost ID list of the original status.
-			c.GTS.BoostOfIDs().Invalidate(status.BoostOfID)
-		}
+	c.GTS.Notification().SetInvalidateCallback(func(notif *gtsmodel.Notification) {
+		c.notify(cacheNotification, notif.ID)
+	})
 
-		if status.InReplyToID != "" {
-			// Invalidate in reply to ID list of original status.
-			c.GTS.InReplyToIDs().Invalidate(status.InReplyToID)
-		}
+	c.GTS.Report().SetInvalidateCallback(func(report *gtsmodel.Report) {
+		c.notify(cacheReport, report.ID)
+	})
+
+	c.GTS.Status().SetInvalidateCallback(func(status *gtsmodel.Status) {
+		c.invalidateStatusDeps(status.ID, status.BoostOfID, status.InReplyToID, status.AttachmentIDs)
+
+		keys := make([]string, 0, 3+len(status.AttachmentIDs))
+		keys = append(keys, status.ID, status.BoostOfID, status.InReplyToID)
+		keys = append(keys, status.AttachmentIDs...)
+		c.notify(cacheStatus, keys...)
 	})
 
 	c.GTS.StatusFave().SetInvalidateCallback(func(fave *gtsmodel.StatusFave) {
-		// Invalidate status fave ID list for this status.
-		c.GTS.StatusFaveIDs().Invalidate(fave.StatusID)
+		c.invalidateStatusFaveDeps(fave.StatusID)
+		c.notify(cacheStatusFave, fave.ID, fave.StatusID)
+	})
+
+	c.GTS.StatusReaction().SetInvalidateCallback(func(reaction *gtsmodel.StatusReaction) {
+		c.invalidateStatusReactionDeps(reaction.StatusID)
+		c.notify(cacheStatusReaction, reaction.ID, reaction.StatusID)
+	})
+
+	c.GTS.Tag().SetInvalidateCallback(func(tag *gtsmodel.Tag) {
+		c.notify(cacheTag, tag.ID)
+	})
+
+	c.GTS.Tombstone().SetInvalidateCallback(func(tombstone *gtsmodel.Tombstone) {
+		c.notify(cacheTombstone, tombstone.ID)
 	})
 
 	c.GTS.User().SetInvalidateCallback(func(user *gtsmodel.User) {
-		// Invalidate local account ID cached visibility.
-		c.Visibility.Invalidate("ItemID", user.AccountID)
-		c.Visibility.Invalidate("RequesterID", user.AccountID)
+		c.invalidateUserDeps(user.AccountID)
+		c.notify(cacheUser, user.ID, user.AccountID)
+	})
+
+	c.GTS.UserMute().SetInvalidateCallback(func(mute *gtsmodel.UserMute) {
+		c.invalidateUserMuteDeps(mute.AccountID)
+		c.notify(cacheUserMute, mute.ID, mute.AccountID)
 	})
 }
 
+// invalidateAccountDeps invalidates caches dependent on account with ID.
+func (c *Caches) invalidateAccountDeps(accountID string) {
+	// Invalidate account ID cached visibility.
+	c.Visibility.Invalidate("ItemID", accountID)
+	c.Visibility.Invalidate("RequesterID", accountID)
+
+	// Invalidate this account's
+	// following / follower lists.
+	// (see FollowIDs() comment for details).
+	c.GTS.FollowIDs().InvalidateAll(
+		">"+accountID,
+		"l>"+accountID,
+		"<"+accountID,
+		"l<"+accountID,
+	)
+
+	// Invalidate this account's
+	// follow requesting / request lists.
+	// (see FollowRequestIDs() comment for details).
+	c.GTS.FollowRequestIDs().InvalidateAll(
+		">"+accountID,
+		"<"+accountID,
+	)
+
+	// Invalidate this account's block lists.
+	c.GTS.BlockIDs().Invalidate(accountID)
+	c.GTS.BlockFilter().Invalidate(accountID)
+
+	// Invalidate this account's mute filter.
+	c.GTS.MuteFilter().Invalidate(accountID)
+}
+
+// invalidateBlockDeps invalidates caches dependent on a block between accounts.
+func (c *Caches) invalidateBlockDeps(accountID, targetAccountID string) {
+	// Invalidate block origin account ID cached visibility.
+	c.Visibility.Invalidate("ItemID", accountID)
+	c.Visibility.Invalidate("RequesterID", accountID)
+
+	// Invalidate block target account ID cached visibility.
+	c.Visibility.Invalidate("ItemID", targetAccountID)
+	c.Visibility.Invalidate("RequesterID", targetAccountID)
+
+	// Invalidate source account's block lists.
+	c.GTS.BlockIDs().Invalidate(accountID)
+
+	// Invalidate both accounts' block filters,
+	// as these cover blocks in either direction.
+	c.GTS.BlockFilter().InvalidateAll(
+		accountID,
+		targetAccountID,
+	)
+}
+
+// invalidateDomainDeps invalidates caches dependent on domain allows / blocks.
+func (c *Caches) invalidateDomainDeps() {
+	// Domain allows / blocks affect the visibility
+	// of all accounts on a domain, and their statuses,
+	// which can't be picked out of the visibility cache
+	// by domain, so just clear all cached visibility
+	// (this must empty the cache, not just trim it).
+	c.Visibility.Clear()
+}
+
+// invalidateEmojiCategoryDeps invalidates caches dependent on emoji category with ID.
+func (c *Caches) invalidateEmojiCategoryDeps(categoryID string) {
+	// Invalidate any emoji in this category.
+	c.GTS.Emoji().Invalidate("CategoryID", categoryID)
+}
+
+// invalidateFollowDeps invalidates caches dependent on a follow between accounts.
+func (c *Caches) invalidateFollowDeps(followID, accountID, targetAccountID string) {
+	// Invalidate follow request with this same ID.
+	c.GTS.FollowRequest().Invalidate("ID", followID)
+
+	// Invalidate any related list entries.
+	c.GTS.ListEntry().Invalidate("FollowID", followID)
+
+	// Invalidate follow origin account ID cached visibility.
+	c.Visibility.Invalidate("ItemID", accountID)
+	c.Visibility.Invalidate("RequesterID", accountID)
+
+	// Invalidate follow target account ID cached visibility.
+	c.Visibility.Invalidate("ItemID", targetAccountID)
+	c.Visibility.Invalidate("RequesterID", targetAccountID)
+
+	// Invalidate source account's following
+	// lists, and destination's follwer lists.
+	// (see FollowIDs() comment for details).
+	c.GTS.FollowIDs().InvalidateAll(
+		">"+accountID,
+		"l>"+accountID,
+		"<"+accountID,
+		"l<"+accountID,
+		"<"+targetAccountID,
+		"l<"+targetAccountID,
+		">"+targetAccountID,
+		"l>"+targetAccountID,
+	)
+}
+
+// invalidateFollowRequestDeps invalidates caches dependent on a follow request between accounts.
+func (c *Caches) invalidateFollowRequestDeps(followReqID, accountID, targetAccountID string) {
+	// Invalidate follow with this same ID.
+	c.GTS.Follow().Invalidate("ID", followReqID)
+
+	// Invalidate source account's followreq
+	// lists, and destinations follow req lists.
+	// (see FollowRequestIDs() comment for details).
+	c.GTS.FollowRequestIDs().InvalidateAll(
+		">"+accountID,
+		"<"+accountID,
+		">"+targetAccountID,
+		"<"+targetAccountID,
+	)
+}
+
+// invalidateListDeps invalidates caches dependent on list with ID.
+func (c *Caches) invalidateListDeps(listID string) {
+	// Invalidate all cached entries of this list.
+	c.GTS.ListEntry().Invalidate("ListID", listID)
+}
+
+// invalidateMediaDeps invalidates caches dependent on a media attachment. The
+// account ID should only be set if the media is used as an avatar / header.
+func (c *Caches) invalidateMediaDeps(accountID, statusID string) {
+	if accountID != "" {
+		// Invalidate cache of attaching account.
+		c.GTS.Account().Invalidate("ID", accountID)
+	}
+
+	if statusID != "" {
+		// Invalidate cache of attaching status.
+		c.GTS.Status().Invalidate("ID", statusID)
+	}
+}
+
+// invalidateStatusDeps invalidates caches dependent on a status.
+func (c *Caches) invalidateStatusDeps(statusID, boostOfID, inReplyToID string, attachmentIDs []string) {
+	// Invalidate status ID cached visibility,
+	// and that of any boosts of this status.
+	c.Visibility.Invalidate("ItemID", statusID)
+	c.Visibility.Invalidate("BoostOfID", statusID)
+
+	for _, id := range attachmentIDs {
+		// Invalidate each media by the IDs we're aware of.
+		// This must be done as the status table is aware of
+		// the media IDs in use before the media table is
+		// aware of the status ID they are linked to.
+		//
+		// c.GTS.Media().Invalidate("StatusID") will not work.
+		c.GTS.Media().Invalidate("ID", id)
+	}
+
+	if boostOfID != "" {
+		// Invalidate boost ID list of the original status.
+		c.GTS.BoostOfIDs().Invalidate(boostOfID)
+	}
+
+	if inReplyToID != "" {
+		// Invalidate in reply to ID list of original status.
+		c.GTS.InReplyToIDs().Invalidate(inReplyToID)
+	}
+}
+
+// invalidateStatusFaveDeps invalidates caches dependent on a fave of status with ID.
+func (c *Caches) invalidateStatusFaveDeps(statusID string) {
+	// Invalidate status fave ID list for this status.
+	c.GTS.StatusFaveIDs().Invalidate(statusID)
+}
+
+// invalidateStatusReactionDeps invalidates caches dependent on a reaction to status with ID.
+func (c *Caches) invalidateStatusReactionDeps(statusID string) {
+	// Invalidate status reaction ID list for this status.
+	c.GTS.StatusReactionIDs().Invalidate(statusID)
+}
+
+// invalidateUserDeps invalidates caches dependent on user with account ID.
+func (c *Caches) invalidateUserDeps(accountID string) {
+	// Invalidate local account ID cached visibility.
+	c.Visibility.Invalidate("ItemID", accountID)
+	c.Visibility.Invalidate("RequesterID", accountID)
+}
+
+// invalidateUserMuteDeps invalidates caches dependent on a mute by account with ID.
+func (c *Caches) invalidateUserMuteDeps(accountID string) {
+	// Invalidate muting account's cached visibility,
+	// as mutes affect which statuses are timelineable.
+	c.Visibility.Invalidate("RequesterID", accountID)
+
+	// Invalidate muting account's mute filter.
+	c.GTS.MuteFilter().Invalidate(accountID)
+}
+
 // Sweep will sweep all the available caches to ensure none
 // are above threshold percent full to their total capacity.
 //
@@ -231,6 +417,8 @@ func (c *Caches) Sweep(threshold float64) {
 	c.GTS.AccountNote().Trim(threshold)
 	c.GTS.Block().Trim(threshold)
 	c.GTS.BlockIDs().Trim(threshold)
+	c.GTS.BlockFilter().Trim(threshold)
+	c.GTS.DomainInterop().Trim(threshold)
 	c.GTS.Emoji().Trim(threshold)
 	c.GTS.EmojiCategory().Trim(threshold)
 	c.GTS.Follow().Trim(threshold)
@@ -243,12 +431,15 @@ func (c *Caches) Sweep(threshold float64) {
 	c.GTS.Marker().Trim(threshold)
 	c.GTS.Media().Trim(threshold)
 	c.GTS.Mention().Trim(threshold)
+	c.GTS.MuteFilter().Trim(threshold)
 	c.GTS.Notification().Trim(threshold)
 	c.GTS.Report().Trim(threshold)
 	c.GTS.Status().Trim(threshold)
 	c.GTS.StatusFave().Trim(threshold)
+	c.GTS.StatusReaction().Trim(threshold)
 	c.GTS.Tag().Trim(threshold)
 	c.GTS.Tombstone().Trim(threshold)
 	c.GTS.User().Trim(threshold)
+	c.GTS.UserMute().Trim(threshold)
 	c.Visibility.Trim(threshold)
 }
@@ -40,6 +40,9 @@ type Cache struct {
 	// atomically updated ptr value to the
 	// current domain cache radix trie.
 	rootptr unsafe.Pointer
+
+	// optional hook called on .Clear().
+	clear func()
 }
 
 // Matches checks whether domain matches an entry in the cache.
@@ -83,6 +86,16 @@ func (c *Cache) Matches(domain string, load func() ([]string, error)) (bool, err
 // triggering a reload on next call to .Matches().
 func (c *Cache) Clear() {
 	atomic.StorePointer(&c.rootptr, nil)
+	if c.clear != nil {
+		c.clear()
+	}
+}
+
+// SetClearCallback sets a function to be called whenever the
+// cache is cleared, e.g. to clear caches dependent on it.
+// NOTE: not thread-safe, must only be called during setup.
+func (c *Cache) SetClearCallback(hook func()) {
+	c.clear = hook
 }
 
 // String returns a string representation of stored domains in cache.
@@ -83,3 +83,25 @@ func TestCache(t *testing.T) {
 		t.Errorf("matches did not return expected error: %v", err)
 	}
 }
+
+func TestCacheClearCallback(t *testing.T) {
+	c := new(domain.Cache)
+
+	var cleared int
+	c.SetClearCallback(func() { cleared++ })
+
+	if _, err := c.Matches("google.com", func() ([]string, error) {
+		return []string{"google.com"}, nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Clearing the cache should
+	// call the registered hook.
+	c.Clear()
+	c.Clear()
+
+	if cleared != 2 {
+		t.Errorf("expected clear callback to be called 2 times, got %d", cleared)
+	}
+}
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"sync/atomic"
+
+	"codeberg.org/gruf/go-cache/v3/simple"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/bloom"
+)
+
+// filterFalsePositiveRate is the target false
+// positive rate of FilterCache's bloom filters.
+const filterFalsePositiveRate = 0.01
+
+// FilterCache wraps a simple.Cache to provide simple loader-callback
+// functions for building + caching bloom filters of IDs, with which
+// membership can be cheaply pre-screened before hitting the database.
+type FilterCache struct {
+	*simple.Cache[string, *bloom.Filter]
+	counter
+
+	// gen is incremented on every invalidation, so
+	// that Load can tell whether one raced with it.
+	gen atomic.Uint64
+}
+
+// Load will attempt to load an existing filter from the cache for the given key, else calling the provided load function and caching a filter built from the result.
+func (c *FilterCache) Load(key string, load func() ([]string, error)) (*bloom.Filter, error) {
+	// Look for bloom filter in cache under this key.
+	filter, ok := c.Get(key)
+	c.count(ok)
+
+	if !ok {
+		// Note generation before loading.
+		gen := c.gen.Load()
+
+		// Not cached, load!
+		ids, err := load()
+		if err != nil {
+			return nil, err
+		}
+
+		// Build filter from loaded IDs.
+		filter = bloom.New(len(ids),
+			filterFalsePositiveRate,
+		)
+		for _, id := range ids {
+			filter.Add(id)
+		}
+
+		// Store the filter.
+		c.Set(key, filter)
+
+		if c.gen.Load() != gen {
+			// Something was invalidated while loading, so the
+			// loaded IDs may already be stale. Drop the filter
+			// again (checked after Set, to not miss any race).
+			c.Cache.Invalidate(key)
+		}
+	}
+
+	// Filters are never modified
+	// once built, safe to share.
+	return filter, nil
+}
+
+// Invalidate removes the filter under the given key from the cache.
+func (c *FilterCache) Invalidate(key string) bool {
+	c.gen.Add(1)
+	return c.Cache.Invalidate(key)
+}
+
+// InvalidateAll removes the filters under the given keys from the cache.
+func (c *FilterCache) InvalidateAll(keys ...string) bool {
+	c.gen.Add(1)
+	return c.Cache.InvalidateAll(keys...)
+}
+
+// Clear removes all items from the cache, see ResultCache{}.Clear().
+func (c *FilterCache) Clear() {
+	c.gen.Add(1)
+	c.Trim(0)
+}
@@ -23,45 +23,72 @@ import (
 	"codeberg.org/gruf/go-cache/v3/result"
 	"codeberg.org/gruf/go-cache/v3/simple"
 	"codeberg.org/gruf/go-cache/v3/ttl"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/bloom"
 	"github.com/superseriousbusiness/gotosocial/internal/cache/domain"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ingest"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ipblock"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 type GTSCaches struct {
-	account          *result.Cache[*gtsmodel.Account]
-	accountNote      *result.Cache[*gtsmodel.AccountNote]
-	application      *result.Cache[*gtsmodel.Application]
-	block            *result.Cache[*gtsmodel.Block]
-	blockIDs         *SliceCache[string]
-	boostOfIDs       *SliceCache[string]
-	domainAllow      *domain.Cache
-	domainBlock      *domain.Cache
-	emoji            *result.Cache[*gtsmodel.Emoji]
-	emojiCategory    *result.Cache[*gtsmodel.EmojiCategory]
-	follow           *result.Cache[*gtsmodel.Follow]
-	followIDs        *SliceCache[string]
-	followRequest    *result.Cache[*gtsmodel.FollowRequest]
-	followRequestIDs *SliceCache[string]
-	instance         *result.Cache[*gtsmodel.Instance]
-	inReplyToIDs     *SliceCache[string]
-	list             *result.Cache[*gtsmodel.List]
-	listEntry        *result.Cache[*gtsmodel.ListEntry]
-	marker           *result.Cache[*gtsmodel.Marker]
-	media            *result.Cache[*gtsmodel.MediaAttachment]
-	mention          *result.Cache[*gtsmodel.Mention]
-	notification     *result.Cache[*gtsmodel.Notification]
-	report           *result.Cache[*gtsmodel.Report]
-	status           *result.Cache[*gtsmodel.Status]
-	statusFave       *result.Cache[*gtsmodel.StatusFave]
-	statusFaveIDs    *SliceCache[string]
-	tag              *result.Cache[*gtsmodel.Tag]
-	tombstone        *result.Cache[*gtsmodel.Tombstone]
-	user             *result.Cache[*gtsmodel.User]
+	account           *ResultCache[*gtsmodel.Account]
+	accountNote       *ResultCache[*gtsmodel.AccountNote]
+	application       *ResultCache[*gtsmodel.Application]
+	block             *ResultCache[*gtsmodel.Block]
+	blockIDs          *SliceCache[string]
+	blockFilter       *FilterCache
+	boostOfIDs        *SliceCache[string]
+	domainAllow       *domain.Cache
+	domainBlock       *domain.Cache
+	domainInterop     *ResultCache[*gtsmodel.DomainInterop]
+	emailDomainBlock  *domain.Cache
+	emoji             *ResultCache[*gtsmodel.Emoji]
+	emojiCategory     *ResultCache[*gtsmodel.EmojiCategory]
+	follow            *ResultCache[*gtsmodel.Follow]
+	followIDs         *SliceCache[string]
+	followRequest     *ResultCache[*gtsmodel.FollowRequest]
+	followRequestIDs  *SliceCache[string]
+	ingestRule        *ingest.Cache
+	ipBlock           *ipblock.Cache
+	instance          *ResultCache[*gtsmodel.Instance]
+	inReplyToIDs      *SliceCache[string]
+	list              *ResultCache[*gtsmodel.List]
+	listEntry         *ResultCache[*gtsmodel.ListEntry]
+	marker            *ResultCache[*gtsmodel.Marker]
+	media             *ResultCache[*gtsmodel.MediaAttachment]
+	mention           *ResultCache[*gtsmodel.Mention]
+	muteFilter        *FilterCache
+	notification      *ResultCache[*gtsmodel.Notification]
+	report            *ResultCache[*gtsmodel.Report]
+	status            *ResultCache[*gtsmodel.Status]
+	statusFave        *ResultCache[*gtsmodel.StatusFave]
+	statusFaveIDs     *SliceCache[string]
+	statusReaction    *ResultCache[*gtsmodel.StatusReaction]
+	statusReactionIDs *SliceCache[string]
+	tag               *ResultCache[*gtsmodel.Tag]
+	tombstone         *ResultCache[*gtsmodel.Tombstone]
+	user              *ResultCache[*gtsmodel.User]
+	userMute          *ResultCache[*gtsmodel.UserMute]
 
 	// TODO: move out of GTS caches since unrelated to DB.
 	webfinger *ttl.Cache[string, string] // TTL=24hr, sweep=5min
+
+	webfingerResults *ttl.Cache[string, WebfingerResult] // TTL=configurable, sweep=1min
+	webfingerMisses  *ttl.Cache[string, string]          // TTL=configurable, sweep=1min
+
+	idempotentRequests *ttl.Cache[string, IdempotentRequest] // TTL=1hr, sweep=1min
+
+	instanceCounts *ttl.Cache[string, int] // TTL=5min, sweep=1min
+
+	interactionToggles *ttl.Cache[string, time.Time] // TTL=1hr, sweep=1min
+
+	accountRefreshes *ttl.Cache[string, time.Time] // TTL=1hr, sweep=1min
+
+	spamContentHashes *ttl.Cache[string, string] // TTL=24hr, sweep=5min
+
+	translations *ttl.Cache[string, Translation] // TTL=configurable, sweep=1min
 }
 
 // Init will initialize all the gtsmodel caches in this collection.
@@ -69,34 +96,51 @@ type GTSCaches struct {
 func (c *GTSCaches) Init() {
 	c.initAccount()
 	c.initAccountNote()
+	c.initAccountRefreshes()
 	c.initApplication()
 	c.initBlock()
 	c.initBlockIDs()
+	c.initBlockFilter()
 	c.initBoostOfIDs()
 	c.initDomainAllow()
 	c.initDomainBlock()
+	c.initDomainInterop()
+	c.initEmailDomainBlock()
 	c.initEmoji()
 	c.initEmojiCategory()
 	c.initFollow()
 	c.initFollowIDs()
 	c.initFollowRequest()
 	c.initFollowRequestIDs()
+	c.initIdempotentRequests()
+	c.initIngestRule()
+	c.initIPBlock()
 	c.initInReplyToIDs()
 	c.initInstance()
+	c.initInstanceCounts()
+	c.initInteractionToggles()
 	c.initList()
 	c.initListEntry()
 	c.initMarker()
 	c.initMedia()
 	c.initMention()
+	c.initMuteFilter()
 	c.initNotification()
 	c.initReport()
+	c.initSpamContentHashes()
+	c.initTranslations()
 	c.initStatus()
 	c.initStatusFave()
 	c.initTag()
 	c.initStatusFaveIDs()
+	c.initStatusReaction()
+	c.initStatusReactionIDs()
 	c.initTombstone()
 	c.initUser()
+	c.initUserMute()
 	c.initWebfinger()
+	c.initWebfingerResults()
+	c.initWebfingerMisses()
 }
 
 // Start will attempt to start all of the gtsmodel caches, or panic.
@@ -104,30 +148,110 @@ func (c *GTSCaches) Start() {
 	tryUntil("starting *gtsmodel.Webfinger cache", 5, func() bool {
 		return c.webfinger.Start(5 * time.Minute)
 	})
+	tryUntil("starting webfinger results cache", 5, func() bool {
+		return c.webfingerResults.Start(time.Minute)
+	})
+	tryUntil("starting webfinger misses cache", 5, func() bool {
+		return c.webfingerMisses.Start(time.Minute)
+	})
+	tryUntil("starting idempotent requests cache", 5, func() bool {
+		return c.idempotentRequests.Start(time.Minute)
+	})
+	tryUntil("starting instance counts cache", 5, func() bool {
+		return c.instanceCounts.Start(time.Minute)
+	})
+	tryUntil("starting interaction toggles cache", 5, func() bool {
+		return c.interactionToggles.Start(time.Minute)
+	})
+	tryUntil("starting account refreshes cache", 5, func() bool {
+		return c.accountRefreshes.Start(time.Minute)
+	})
+	tryUntil("starting spam content hashes cache", 5, func() bool {
+		return c.spamContentHashes.Start(5 * time.Minute)
+	})
+	tryUntil("starting translations cache", 5, func() bool {
+		return c.translations.Start(time.Minute)
+	})
 }
 
 // Stop will attempt to stop all of the gtsmodel caches, or panic.
 func (c *GTSCaches) Stop() {
 	tryUntil("stopping *gtsmodel.Webfinger cache", 5, c.webfinger.Stop)
+	tryUntil("stopping webfinger results cache", 5, c.webfingerResults.Stop)
+	tryUntil("stopping webfinger misses cache", 5, c.webfingerMisses.Stop)
+	tryUntil("stopping idempotent requests cache", 5, c.idempotentRequests.Stop)
+	tryUntil("stopping instance counts cache", 5, c.instanceCounts.Stop)
+	tryUntil("stopping interaction toggles cache", 5, c.interactionToggles.Stop)
+	tryUntil("stopping account refreshes cache", 5, c.accountRefreshes.Stop)
+	tryUntil("stopping spam content hashes cache", 5, c.spamContentHashes.Stop)
+	tryUntil("stopping translations cache", 5, c.translations.Stop)
+}
+
+// Clear will clear all of the database-backed gtsmodel caches,
+// for when they may have missed invalidations. Caches of purely
+// process-local state, like webfinger URLs, idempotent requests,
+// interaction toggles, account refreshes, spam content hashes and
+// translations, are left alone. When adding a new database-backed
+// cache, make sure to also clear it here.
+func (c *GTSCaches) Clear() {
+	c.account.Clear()
+	c.accountNote.Clear()
+	c.application.Clear()
+	c.block.Clear()
+	c.blockIDs.Clear()
+	c.blockFilter.Clear()
+	c.boostOfIDs.Clear()
+	c.domainAllow.Clear()
+	c.domainBlock.Clear()
+	c.domainInterop.Clear()
+	c.emailDomainBlock.Clear()
+	c.emoji.Clear()
+	c.emojiCategory.Clear()
+	c.follow.Clear()
+	c.followIDs.Clear()
+	c.followRequest.Clear()
+	c.followRequestIDs.Clear()
+	c.ingestRule.Clear()
+	c.ipBlock.Clear()
+	c.instance.Clear()
+	c.inReplyToIDs.Clear()
+	c.list.Clear()
+	c.listEntry.Clear()
+	c.marker.Clear()
+	c.media.Clear()
+	c.mention.Clear()
+	c.muteFilter.Clear()
+	c.notification.Clear()
+	c.report.Clear()
+	c.status.Clear()
+	c.statusFave.Clear()
+	c.statusFaveIDs.Clear()
+	c.statusReaction.Clear()
+	c.statusReactionIDs.Clear()
+	c.tag.Clear()
+	c.tombstone.Clear()
+	c.user.Clear()
+	c.userMute.Clear()
+	c.instanceCounts.Clear()
 }
 
 // Account provides access to the gtsmodel Account database cache.
-func (c *GTSCaches) Account() *result.Cache[*gtsmodel.Account] {
+func (c *GTSCaches) Account() *ResultCache[*gtsmodel.Account] {
 	return c.account
 }
 
 // AccountNote provides access to the gtsmodel Note database cache.
-func (c *GTSCaches) AccountNote() *result.Cache[*gtsmodel.AccountNote] {
+func (c *GTSCaches) AccountNote() *ResultCache[*gtsmodel.AccountNote] {
 	return c.accountNote
 }
 
 // Application provides access to the gtsmodel Application database cache.
-func (c *GTSCaches) Application() *result.Cache[*gtsmodel.Application] {
+func (c *GTSCaches) Application() *ResultCache[*gtsmodel.Application] {
 	return c.application
 }
 
 // Block provides access to the gtsmodel Block (account) database cache.
-func (c *GTSCaches) Block() *result.Cache[*gtsmodel.Block] {
+func (c *GTSCaches) Block() *ResultCache[*gtsmodel.Block] {
 	return c.block
 }
 
@@ -136,6 +260,12 @@ func (c *GTSCaches) BlockIDs() *SliceCache[string] {
 	return c.blockIDs
 }
 
+// BlockFilter provides access to the bloom filters of account IDs
+// with a block in either direction between them and the keyed account.
+func (c *GTSCaches) BlockFilter() *FilterCache {
+	return c.blockFilter
+}
+
 // BoostOfIDs provides access to the boost of IDs list database cache.
 func (c *GTSCaches) BoostOfIDs() *SliceCache[string] {
 	return c.boostOfIDs
@@ -151,18 +281,28 @@ func (c *GTSCaches) DomainBlock() *domain.Cache {
 	return c.domainBlock
 }
 
+// EmailDomainBlock provides access to the email domain block database cache.
+func (c *GTSCaches) EmailDomainBlock() *domain.Cache {
+	return c.emailDomainBlock
+}
+
+// DomainInterop provides access to the gtsmodel DomainInterop database cache.
+func (c *GTSCaches) DomainInterop() *ResultCache[*gtsmodel.DomainInterop] {
+	return c.domainInterop
+}
+
 // Emoji provides access to the gtsmodel Emoji database cache.
-func (c *GTSCaches) Emoji() *result.Cache[*gtsmodel.Emoji] {
+func (c *GTSCaches) Emoji() *ResultCache[*gtsmodel.Emoji] {
 	return c.emoji
 }
 
 // EmojiCategory provides access to the gtsmodel EmojiCategory database cache.
-func (c *GTSCaches) EmojiCategory() *result.Cache[*gtsmodel.EmojiCategory] {
+func (c *GTSCaches) EmojiCategory() *ResultCache[*gtsmodel.EmojiCategory] {
 	return c.emojiCategory
 }
 
 // Follow provides access to the gtsmodel Follow database cache.
-func (c *GTSCaches) Follow() *result.Cache[*gtsmodel.Follow] {
+func (c *GTSCaches) Follow() *ResultCache[*gtsmodel.Follow] {
 	return c.follow
 }
 
@@ -177,7 +317,7 @@ func (c *GTSCaches) FollowIDs() *SliceCache[string] {
 }
 
 // FollowRequest provides access to the gtsmodel FollowRequest database cache.
-func (c *GTSCaches) FollowRequest() *result.Cache[*gtsmodel.FollowRequest] {
+func (c *GTSCaches) FollowRequest() *ResultCache[*gtsmodel.FollowRequest] {
 	return c.followRequest
 }
 
@@ -189,8 +329,18 @@ func (c *GTSCaches) FollowRequestIDs() *SliceCache[string] {
 	return c.followRequestIDs
 }
 
+// IngestRule provides access to the ingest rule database cache.
+func (c *GTSCaches) IngestRule() *ingest.Cache {
+	return c.ingestRule
+}
+
+// IPBlock provides access to the ip block database cache.
+func (c *GTSCaches) IPBlock() *ipblock.Cache {
+	return c.ipBlock
+}
+
 // Instance provides access to the gtsmodel Instance database cache.
-func (c *GTSCaches) Instance() *result.Cache[*gtsmodel.Instance] {
+func (c *GTSCaches) Instance() *ResultCache[*gtsmodel.Instance] {
 	return c.instance
 }
 
@@ -200,52 +350,58 @@ func (c *GTSCaches) InReplyToIDs() *SliceCache[string] {
 }
 
 // List provides access to the gtsmodel List database cache.
-func (c *GTSCaches) List() *result.Cache[*gtsmodel.List] {
+func (c *GTSCaches) List() *ResultCache[*gtsmodel.List] {
 	return c.list
 }
 
 // ListEntry provides access to the gtsmodel ListEntry database cache.
-func (c *GTSCaches) ListEntry() *result.Cache[*gtsmodel.ListEntry] {
+func (c *GTSCaches) ListEntry() *ResultCache[*gtsmodel.ListEntry] {
 	return c.listEntry
 }
 
 // Marker provides access to the gtsmodel Marker database cache.
-func (c *GTSCaches) Marker() *result.Cache[*gtsmodel.Marker] {
+func (c *GTSCaches) Marker() *ResultCache[*gtsmodel.Marker] {
 	return c.marker
 }
 
 // Media provides access to the gtsmodel Media database cache.
-func (c *GTSCaches) Media() *result.Cache[*gtsmodel.MediaAttachment] {
+func (c *GTSCaches) Media() *ResultCache[*gtsmodel.MediaAttachment] {
 	return c.media
 }
 
 // Mention provides access to the gtsmodel Mention database cache.
-func (c *GTSCaches) Mention() *result.Cache[*gtsmodel.Mention] {
+func (c *GTSCaches) Mention() *ResultCache[*gtsmodel.Mention] {
 	return c.mention
 }
 
+// MuteFilter provides access to the bloom
+// filters of account IDs muted by the keyed account.
+func (c *GTSCaches) MuteFilter() *FilterCache {
+	return c.muteFilter
+}
+
 // Notification provides access to the gtsmodel Notification database cache.
-func (c *GTSCaches) Notification() *result.Cache[*gtsmodel.Notification] {
+func (c *GTSCaches) Notification() *ResultCache[*gtsmodel.Notification] {
 	return c.notification
 }
 
 // Report provides access to the gtsmodel Report database cache.
-func (c *GTSCaches) Report() *result.Cache[*gtsmodel.Report] {
+func (c *GTSCaches) Report() *ResultCache[*gtsmodel.Report] {
 	return c.report
 }
 
 // Status provides access to the gtsmodel Status database cache.
-func (c *GTSCaches) Status() *result.Cache[*gtsmodel.Status] {
+func (c *GTSCaches) Status() *ResultCache[*gtsmodel.Status] {
 	return c.status
 }
 
 // StatusFave provides access to the gtsmodel StatusFave database cache.
-func (c *GTSCaches) StatusFave() *result.Cache[*gtsmodel.StatusFave] {
+func (c *GTSCaches) StatusFave() *ResultCache[*gtsmodel.StatusFave] {
 	return c.statusFave
 }
 
 // Tag provides access to the gtsmodel Tag database cache.
-func (c *GTSCaches) Tag() *result.Cache[*gtsmodel.Tag] {
+func (c *GTSCaches) Tag() *ResultCache[*gtsmodel.Tag] {
 	return c.tag
 }
 
@@ -254,21 +410,112 @@ func (c *GTSCaches) StatusFaveIDs() *SliceCache[string] {
 	return c.statusFaveIDs
 }
 
+// StatusReaction provides access to the gtsmodel StatusReaction database cache.
+func (c *GTSCaches) StatusReaction() *ResultCache[*gtsmodel.StatusReaction] {
+	return c.statusReaction
+}
+
+// StatusReactionIDs provides access to the status reaction IDs list database cache.
+func (c *GTSCaches) StatusReactionIDs() *SliceCache[string] {
+	return c.statusReactionIDs
+}
+
 // Tombstone provides access to the gtsmodel Tombstone database cache.
-func (c *GTSCaches) Tombstone() *result.Cache[*gtsmodel.Tombstone] {
+func (c *GTSCaches) Tombstone() *ResultCache[*gtsmodel.Tombstone] {
 	return c.tombstone
 }
 
 // User provides access to the gtsmodel User database cache.
-func (c *GTSCaches) User() *result.Cache[*gtsmodel.User] {
+func (c *GTSCaches) User() *ResultCache[*gtsmodel.User] {
 	return c.user
 }
 
+// UserMute provides access to the gtsmodel UserMute database cache.
+func (c *GTSCaches) UserMute() *ResultCache[*gtsmodel.UserMute] {
+	return c.userMute
+}
+
+// IdempotentRequest is a request made by an
+// account with an Idempotency-Key header.
+type IdempotentRequest struct {
+	Fingerprint string // hash of the request form, to spot a key reused for a different request.
+	ID          string // ID of whatever the request created, empty while it's still being processed.
+}
+
+// IdempotentRequests provides access to the cache of recent
+// requests made with an Idempotency-Key header, keyed by
+// kind of request, account ID and Idempotency-Key.
+func (c *GTSCaches) IdempotentRequests() *ttl.Cache[string, IdempotentRequest] {
+	return c.idempotentRequests
+}
+
+// InstanceCounts provides access to the cache of
+// (expensive to calculate) instance usage counts.
+func (c *GTSCaches) InstanceCounts() *ttl.Cache[string, int] {
+	return c.instanceCounts
+}
+
+// InteractionToggles provides access to the cache of
+// times at which local accounts last toggled a boost
+// or follow, used to enforce the interaction cooldown.
+func (c *GTSCaches) InteractionToggles() *ttl.Cache[string, time.Time] {
+	return c.interactionToggles
+}
+
+// AccountRefreshes provides access to the cache of
+// times at which local accounts last forced a refresh
+// of a remote account, used to rate-limit refreshes.
+func (c *GTSCaches) AccountRefreshes() *ttl.Cache[string, time.Time] {
+	return c.accountRefreshes
+}
+
+// SpamContentHashes provides access to the cache of
+// hashes of recently received status content, mapped
+// to the URI of the first status seen with that content,
+// used by the spam filter to spot duplicates.
+func (c *GTSCaches) SpamContentHashes() *ttl.Cache[string, string] {
+	return c.spamContentHashes
+}
+
+// Translation is a status translated
+// into another language by a translator.
+type Translation struct {
+	Content        string // translated content of the status.
+	ContentWarning string // translated content warning of the status.
+	SourceLanguage string // language of the status, as given to or detected by the translator.
+	Provider       string // name of the translator.
+}
+
+// Translations provides access to the cache of status translations,
+// keyed by status ID, status update time and target language.
+func (c *GTSCaches) Translations() *ttl.Cache[string, Translation] {
+	return c.translations
+}
+
 // Webfinger provides access to the webfinger URL cache.
 func (c *GTSCaches) Webfinger() *ttl.Cache[string, string] {
 	return c.webfinger
 }
 
+// WebfingerResult is the result of a
+// successful webfinger lookup of an account.
+type WebfingerResult struct {
+	AccountDomain string // domain of the account, from the webfinger subject.
+	AccountURI    string // ActivityPub URI of the account, from the self link.
+}
+
+// WebfingerResults provides access to the cache of successful webfinger
+// lookups of accounts, keyed by the looked up 'username@domain'.
+func (c *GTSCaches) WebfingerResults() *ttl.Cache[string, WebfingerResult] {
+	return c.webfingerResults
+}
+
+// WebfingerMisses provides access to the cache of failed webfinger lookups
+// of accounts, keyed by the looked up 'username@domain', to the error.
+func (c *GTSCaches) WebfingerMisses() *ttl.Cache[string, string] {
+	return c.webfingerMisses
+}
+
 func (c *GTSCaches) initAccount() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -278,7 +525,7 @@ func (c *GTSCaches) initAccount() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.account = result.New([]result.Lookup{
+	c.account = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "URI"},
 		{Name: "URL"},
@@ -306,7 +553,7 @@ func (c *GTSCaches) initAccountNote() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.accountNote = result.New([]result.Lookup{
+	c.accountNote = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "AccountID.TargetAccountID"},
 	}, func(n1 *gtsmodel.AccountNote) *gtsmodel.AccountNote {
@@ -327,7 +574,7 @@ func (c *GTSCaches) initApplication() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.application = result.New([]result.Lookup{
+	c.application = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "ClientID"},
 	}, func(a1 *gtsmodel.Application) *gtsmodel.Application {
@@ -348,7 +595,7 @@ func (c *GTSCaches) initBlock() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.block = result.New([]result.Lookup{
+	c.block = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "URI"},
 		{Name: "AccountID.TargetAccountID"},
@@ -377,6 +624,20 @@ func (c *GTSCaches) initBlockIDs() {
 	)}
 }
 
+func (c *GTSCaches) initBlockFilter() {
+	// Calculate maximum cache size.
+	cap := calculateFilterCacheMax(
+		config.GetCacheBlockFilterMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.blockFilter = &FilterCache{Cache: simple.New[string, *bloom.Filter](
+		0,
+		cap,
+	)}
+}
+
 func (c *GTSCaches) initBoostOfIDs() {
 	// Calculate maximum cache size.
 	cap := calculateSliceCacheMax(
@@ -399,6 +660,31 @@ func (c *GTSCaches) initDomainBlock() {
 	c.domainBlock = new(domain.Cache)
 }
 
+func (c *GTSCaches) initEmailDomainBlock() {
+	c.emailDomainBlock = new(domain.Cache)
+}
+
+func (c *GTSCaches) initDomainInterop() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofDomainInterop(), // model in-mem size.
+		config.GetCacheDomainInteropMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.domainInterop = newResultCache([]result.Lookup{
+		{Name: "ID"},
+		{Name: "Domain"},
+	}, func(i1 *gtsmodel.DomainInterop) *gtsmodel.DomainInterop {
+		i2 := new(gtsmodel.DomainInterop)
+		*i2 = *i1
+		return i2
+	}, cap)
+
+	c.domainInterop.IgnoreErrors(ignoreErrors)
+}
+
 func (c *GTSCaches) initEmoji() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -408,7 +694,7 @@ func (c *GTSCaches) initEmoji() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.emoji = result.New([]result.Lookup{
+	c.emoji = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "URI"},
 		{Name: "Shortcode.Domain", AllowZero: true /* domain can be zero i.e. "" */},
@@ -432,7 +718,7 @@ func (c *GTSCaches) initEmojiCategory() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.emojiCategory = result.New([]result.Lookup{
+	c.emojiCategory = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "Name"},
 	}, func(c1 *gtsmodel.EmojiCategory) *gtsmodel.EmojiCategory {
@@ -453,7 +739,7 @@ func (c *GTSCaches) initFollow() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.follow = result.New([]result.Lookup{
+	c.follow = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "URI"},
 		{Name: "AccountID.TargetAccountID"},
@@ -491,7 +777,7 @@ func (c *GTSCaches) initFollowRequest() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.followRequest = result.New([]result.Lookup{
+	c.followRequest = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "URI"},
 		{Name: "AccountID.TargetAccountID"},
@@ -534,6 +820,14 @@ func (c *GTSCaches) initInReplyToIDs() {
 	)}
 }
 
+func (c *GTSCaches) initIngestRule() {
+	c.ingestRule = new(ingest.Cache)
+}
+
+func (c *GTSCaches) initIPBlock() {
+	c.ipBlock = new(ipblock.Cache)
+}
+
 func (c *GTSCaches) initInstance() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -543,7 +837,7 @@ func (c *GTSCaches) initInstance() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.instance = result.New([]result.Lookup{
+	c.instance = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "Domain"},
 	}, func(i1 *gtsmodel.Instance) *gtsmodel.Instance {
@@ -564,7 +858,7 @@ func (c *GTSCaches) initList() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.list = result.New([]result.Lookup{
+	c.list = newResultCache([]result.Lookup{
 		{Name: "ID"},
 	}, func(l1 *gtsmodel.List) *gtsmodel.List {
 		l2 := new(gtsmodel.List)
@@ -584,7 +878,7 @@ func (c *GTSCaches) initListEntry() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.listEntry = result.New([]result.Lookup{
+	c.listEntry = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "ListID", Multi: true},
 		{Name: "FollowID", Multi: true},
@@ -606,7 +900,7 @@ func (c *GTSCaches) initMarker() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.marker = result.New([]result.Lookup{
+	c.marker = newResultCache([]result.Lookup{
 		{Name: "AccountID.Name"},
 	}, func(m1 *gtsmodel.Marker) *gtsmodel.Marker {
 		m2 := new(gtsmodel.Marker)
@@ -626,7 +920,7 @@ func (c *GTSCaches) initMedia() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.media = result.New([]result.Lookup{
+	c.media = newResultCache([]result.Lookup{
 		{Name: "ID"},
 	}, func(m1 *gtsmodel.MediaAttachment) *gtsmodel.MediaAttachment {
 		m2 := new(gtsmodel.MediaAttachment)
@@ -646,7 +940,7 @@ func (c *GTSCaches) initMention() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.mention = result.New([]result.Lookup{
+	c.mention = newResultCache([]result.Lookup{
 		{Name: "ID"},
 	}, func(m1 *gtsmodel.Mention) *gtsmodel.Mention {
 		m2 := new(gtsmodel.Mention)
@@ -657,6 +951,20 @@ func (c *GTSCaches) initMention() {
 	c.mention.IgnoreErrors(ignoreErrors)
 }
 
+func (c *GTSCaches) initMuteFilter() {
+	// Calculate maximum cache size.
+	cap := calculateFilterCacheMax(
+		config.GetCacheMuteFilterMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.muteFilter = &FilterCache{Cache: simple.New[string, *bloom.Filter](
+		0,
+		cap,
+	)}
+}
+
 func (c *GTSCaches) initNotification() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -666,7 +974,7 @@ func (c *GTSCaches) initNotification() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.notification = result.New([]result.Lookup{
+	c.notification = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "NotificationType.TargetAccountID.OriginAccountID.StatusID"},
 	}, func(n1 *gtsmodel.Notification) *gtsmodel.Notification {
@@ -687,7 +995,7 @@ func (c *GTSCaches) initReport() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.report = result.New([]result.Lookup{
+	c.report = newResultCache([]result.Lookup{
 		{Name: "ID"},
 	}, func(r1 *gtsmodel.Report) *gtsmodel.Report {
 		r2 := new(gtsmodel.Report)
@@ -707,7 +1015,7 @@ func (c *GTSCaches) initStatus() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.status = result.New([]result.Lookup{
+	c.status = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "URI"},
 		{Name: "URL"},
@@ -730,7 +1038,7 @@ func (c *GTSCaches) initStatusFave() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.statusFave = result.New([]result.Lookup{
+	c.statusFave = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "AccountID.StatusID"},
 		{Name: "StatusID", Multi: true},
@@ -757,6 +1065,43 @@ func (c *GTSCaches) initStatusFaveIDs() {
 	)}
 }
 
+func (c *GTSCaches) initStatusReaction() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofStatusReaction(), // model in-mem size.
+		config.GetCacheStatusReactionMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.statusReaction = newResultCache([]result.Lookup{
+		{Name: "ID"},
+		{Name: "URI"},
+		{Name: "AccountID.StatusID.Content"},
+		{Name: "StatusID", Multi: true},
+	}, func(r1 *gtsmodel.StatusReaction) *gtsmodel.StatusReaction {
+		r2 := new(gtsmodel.StatusReaction)
+		*r2 = *r1
+		return r2
+	}, cap)
+
+	c.statusReaction.IgnoreErrors(ignoreErrors)
+}
+
+func (c *GTSCaches) initStatusReactionIDs() {
+	// Calculate maximum cache size.
+	cap := calculateSliceCacheMax(
+		config.GetCacheStatusReactionIDsMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.statusReactionIDs = &SliceCache[string]{Cache: simple.New[string, []string](
+		0,
+		cap,
+	)}
+}
+
 func (c *GTSCaches) initTag() {
 	// Calculate maximum cache size.
 	cap := calculateResultCacheMax(
@@ -766,7 +1111,7 @@ func (c *GTSCaches) initTag() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.tag = result.New([]result.Lookup{
+	c.tag = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "Name"},
 	}, func(m1 *gtsmodel.Tag) *gtsmodel.Tag {
@@ -787,7 +1132,7 @@ func (c *GTSCaches) initTombstone() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.tombstone = result.New([]result.Lookup{
+	c.tombstone = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "URI"},
 	}, func(t1 *gtsmodel.Tombstone) *gtsmodel.Tombstone {
@@ -808,7 +1153,7 @@ func (c *GTSCaches) initUser() {
 
 	log.Infof(nil, "cache size = %d", cap)
 
-	c.user = result.New([]result.Lookup{
+	c.user = newResultCache([]result.Lookup{
 		{Name: "ID"},
 		{Name: "AccountID"},
 		{Name: "Email"},
@@ -823,6 +1168,101 @@ func (c *GTSCaches) initUser() {
 	c.user.IgnoreErrors(ignoreErrors)
 }
 
+func (c *GTSCaches) initUserMute() {
+	// Calculate maximum cache size.
+	cap := calculateResultCacheMax(
+		sizeofUserMute(), // model in-mem size.
+		config.GetCacheUserMuteMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.userMute = newResultCache([]result.Lookup{
+		{Name: "ID"},
+		{Name: "AccountID.TargetAccountID"},
+		{Name: "AccountID", Multi: true},
+		{Name: "TargetAccountID", Multi: true},
+	}, func(m1 *gtsmodel.UserMute) *gtsmodel.UserMute {
+		m2 := new(gtsmodel.UserMute)
+		*m2 = *m1
+		return m2
+	}, cap)
+
+	c.userMute.IgnoreErrors(ignoreErrors)
+}
+
+func (c *GTSCaches) initInstanceCounts() {
+	// Only a handful of counts are ever stored
+	// per instance domain, so use a small fixed
+	// capacity instead of calculating from config.
+	//
+	// Counts are expensive, and only need to be
+	// roughly up to date, so keep them for a while
+	// rather than counting every time the instance
+	// is requested.
+	c.instanceCounts = ttl.New[string, int](
+		0,
+		1000,
+		5*time.Minute,
+	)
+}
+
+func (c *GTSCaches) initIdempotentRequests() {
+	// Entries are tiny, and clients only retry
+	// for a short while, so use a fixed capacity
+	// and an hour's TTL; oldest entries get evicted.
+	c.idempotentRequests = ttl.New[string, IdempotentRequest](
+		0,
+		10000,
+		time.Hour,
+	)
+}
+
+func (c *GTSCaches) initInteractionToggles() {
+	// Entries only need to outlive the configured
+	// cooldown, which is meant to be short, so cap
+	// them at an hour and use a fixed capacity.
+	c.interactionToggles = ttl.New[string, time.Time](
+		0,
+		10000,
+		time.Hour,
+	)
+}
+
+func (c *GTSCaches) initAccountRefreshes() {
+	// Entries only need to outlive the configured
+	// refresh cooldown, so like interaction toggles
+	// cap them at an hour and use a fixed capacity.
+	c.accountRefreshes = ttl.New[string, time.Time](
+		0,
+		10000,
+		time.Hour,
+	)
+}
+
+func (c *GTSCaches) initSpamContentHashes() {
+	// Entries are tiny and only needed for
+	// the duplicate content window, so use a
+	// fixed capacity instead of calculating
+	// from config; oldest entries get evicted.
+	c.spamContentHashes = ttl.New[string, string](
+		0,
+		10000,
+		24*time.Hour,
+	)
+}
+
+func (c *GTSCaches) initTranslations() {
+	// Translations may each be a few KiB,
+	// so use a modest fixed capacity; oldest
+	// entries get evicted.
+	c.translations = ttl.New[string, Translation](
+		0,
+		1000,
+		config.GetCacheTranslationTTL(),
+	)
+}
+
 func (c *GTSCaches) initWebfinger() {
 	// Calculate maximum cache size.
 	cap := calculateCacheMax(
@@ -838,3 +1278,35 @@ func (c *GTSCaches) initWebfinger() {
 		24*time.Hour,
 	)
 }
+
+func (c *GTSCaches) initWebfingerResults() {
+	// Calculate maximum cache size.
+	cap := calculateCacheMax(
+		sizeofURIStr, sizeofURIStr*2,
+		config.GetCacheWebfingerMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.webfingerResults = ttl.New[string, WebfingerResult](
+		0,
+		cap,
+		config.GetCacheWebfingerTTL(),
+	)
+}
+
+func (c *GTSCaches) initWebfingerMisses() {
+	// Calculate maximum cache size.
+	cap := calculateCacheMax(
+		sizeofURIStr, sizeofURIStr,
+		config.GetCacheWebfingerMemRatio(),
+	)
+
+	log.Infof(nil, "cache size = %d", cap)
+
+	c.webfingerMisses = ttl.New[string, string](
+		0,
+		cap,
+		config.GetCacheWebfingerNegativeTTL(),
+	)
+}
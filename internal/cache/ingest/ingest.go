@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ingest
+
+import (
+	"fmt"
+	"regexp"
+	"sync/atomic"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Cache provides a means of caching compiled ingest rules
+// in memory, to avoid loading and compiling them from the
+// database for every incoming status.
+//
+// The in-memory rule list is kept up-to-date by means of a
+// passed loader function during every call to .Matches(). In
+// the case of a nil internal rule list, the loader function is
+// called to hydrate the cache with the latest list of rules.
+//
+// The .Clear() function can be used to invalidate the cache,
+// e.g. when a rule is added / deleted from the database.
+type Cache struct {
+	// atomically updated ptr value
+	// to the current compiled rules.
+	ptr atomic.Pointer[[]compiled]
+}
+
+// compiled is an ingest rule
+// with its compiled regexp.
+type compiled struct {
+	rule   *gtsmodel.IngestRule
+	regexp *regexp.Regexp
+}
+
+// Matches returns the ingest rules in the cache that match
+// the given text. If the cache is not currently loaded, then
+// the provided load function is used to hydrate it.
+func (c *Cache) Matches(text string, load func() ([]*gtsmodel.IngestRule, error)) ([]*gtsmodel.IngestRule, error) {
+	// Load the current rules ptr value.
+	ptr := c.ptr.Load()
+
+	if ptr == nil {
+		// Cache is not hydrated.
+		//
+		// Load rules from callback.
+		rules, err := load()
+		if err != nil {
+			return nil, fmt.Errorf("error reloading cache: %w", err)
+		}
+
+		compiledRules := make([]compiled, 0, len(rules))
+		for _, rule := range rules {
+			r, err := Compile(rule)
+			if err != nil {
+				// Rules are checked when they're
+				// created, so this shouldn't happen.
+				log.Warnf(nil, "skipping ingest rule %s: %v", rule.ID, err)
+				continue
+			}
+			compiledRules = append(compiledRules, compiled{rule, r})
+		}
+
+		// Store the new rules ptr.
+		ptr = &compiledRules
+		c.ptr.Store(ptr)
+	}
+
+	var matches []*gtsmodel.IngestRule
+	for _, r := range *ptr {
+		if r.regexp.MatchString(text) {
+			matches = append(matches, r.rule)
+		}
+	}
+
+	return matches, nil
+}
+
+// Clear will drop the currently loaded rules,
+// triggering a reload on next call to .Matches().
+func (c *Cache) Clear() {
+	c.ptr.Store(nil)
+}
+
+// Compile compiles the pattern of the given ingest rule:
+// as-is if it's a regular expression, or else as a keyword
+// which matches case-insensitively anywhere in the text.
+func Compile(rule *gtsmodel.IngestRule) (*regexp.Regexp, error) {
+	if rule.Regex != nil && *rule.Regex {
+		return regexp.Compile(rule.Pattern)
+	}
+	return regexp.Compile("(?i)" + regexp.QuoteMeta(rule.Pattern))
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ingest_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ingest"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func TestCache(t *testing.T) {
+	c := new(ingest.Cache)
+
+	cachedRules := []*gtsmodel.IngestRule{
+		{ID: "keyword", Pattern: "cheap followers", Regex: util.Ptr(false)},
+		{ID: "regex", Pattern: `https?://spam\.example\.(com|net)`, Regex: util.Ptr(true)},
+		{ID: "invalid", Pattern: "spam(", Regex: util.Ptr(true)},
+	}
+
+	loader := func() ([]*gtsmodel.IngestRule, error) {
+		t.Log("load: returning cached rules")
+		return cachedRules, nil
+	}
+
+	// Check a list of texts that should match.
+	for _, text := range []string{
+		"buy cheap followers",
+		"Buy CHEAP FOLLOWERS now!",
+		"see https://spam.example.com",
+		"see http://spam.example.net",
+	} {
+		t.Logf("checking text matches: %s", text)
+		if rules, _ := c.Matches(text, loader); len(rules) != 1 {
+			t.Errorf("text should be matched by one rule: %s", text)
+		}
+	}
+
+	// Check a list of texts that shouldn't match.
+	for _, text := range []string{
+		"followers are cheap",
+		"cheap  followers",
+		"see https://spam.example.org",
+		"spam(",
+	} {
+		t.Logf("checking text isn't matched: %s", text)
+		if rules, _ := c.Matches(text, loader); len(rules) != 0 {
+			t.Errorf("text should not be matched: %s", text)
+		}
+	}
+
+	// Clear the cache
+	t.Logf("%+v\n", c)
+	c.Clear()
+	t.Logf("%+v\n", c)
+
+	knownErr := errors.New("known error")
+
+	// Check that reload is actually performed and returns our error
+	if _, err := c.Matches("", func() ([]*gtsmodel.IngestRule, error) {
+		t.Log("load: returning known error")
+		return nil, knownErr
+	}); !errors.Is(err, knownErr) {
+		t.Errorf("matches did not return expected error: %v", err)
+	}
+}
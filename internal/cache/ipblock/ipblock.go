@@ -0,0 +1,140 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ipblock
+
+import (
+	"fmt"
+	"net/netip"
+	"sync/atomic"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Cache provides a means of caching parsed IP blocks
+// in memory, to avoid loading and parsing them from
+// the database for every incoming request.
+//
+// The in-memory block list is kept up-to-date by means of a
+// passed loader function during every call to .Match(). In
+// the case of a nil internal block list, the loader function
+// is called to hydrate the cache with the latest list of blocks.
+//
+// The .Clear() function can be used to invalidate the cache,
+// e.g. when a block is added / deleted from the database.
+type Cache struct {
+	// atomically updated ptr value
+	// to the current parsed blocks.
+	ptr atomic.Pointer[[]parsed]
+}
+
+// parsed is an IP block
+// with its parsed prefix.
+type parsed struct {
+	block  *gtsmodel.IPBlock
+	prefix netip.Prefix
+}
+
+// Match returns the most severe unexpired IP block in
+// the cache that contains the given address, or nil if
+// there's none. If the cache is not currently loaded,
+// then the provided load function is used to hydrate it.
+func (c *Cache) Match(addr netip.Addr, load func() ([]*gtsmodel.IPBlock, error)) (*gtsmodel.IPBlock, error) {
+	// Load the current blocks ptr value.
+	ptr := c.ptr.Load()
+
+	if ptr == nil {
+		// Cache is not hydrated.
+		//
+		// Load blocks from callback.
+		blocks, err := load()
+		if err != nil {
+			return nil, fmt.Errorf("error reloading cache: %w", err)
+		}
+
+		parsedBlocks := make([]parsed, 0, len(blocks))
+		for _, block := range blocks {
+			prefix, err := Parse(block.IP)
+			if err != nil {
+				// Blocks are checked when they're
+				// created, so this shouldn't happen.
+				log.Warnf(nil, "skipping ip block %s: %v", block.ID, err)
+				continue
+			}
+			parsedBlocks = append(parsedBlocks, parsed{block, prefix})
+		}
+
+		// Store the new blocks ptr.
+		ptr = &parsedBlocks
+		c.ptr.Store(ptr)
+	}
+
+	// Addresses may come in as
+	// IPv4-mapped IPv6, so unmap.
+	addr = addr.Unmap()
+	now := time.Now()
+
+	var match *gtsmodel.IPBlock
+	for _, b := range *ptr {
+		if !b.prefix.Contains(addr) || b.block.Expired(now) {
+			continue
+		}
+
+		if b.block.Severity == gtsmodel.IPBlockNoAccess {
+			// Can't get more severe.
+			return b.block, nil
+		}
+
+		match = b.block
+	}
+
+	return match, nil
+}
+
+// Clear will drop the currently loaded blocks,
+// triggering a reload on next call to .Match().
+func (c *Cache) Clear() {
+	c.ptr.Store(nil)
+}
+
+// Parse parses the given IP address or CIDR range into
+// a masked prefix. Single addresses are treated as a
+// range containing only that address.
+func Parse(ip string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(ip); err == nil {
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(ip)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+
+	if prefix.Addr().Is4In6() {
+		// Store IPv4-mapped ranges as plain IPv4.
+		bits := prefix.Bits() - 96
+		if bits < 0 {
+			return netip.Prefix{}, fmt.Errorf("%s is not a valid ip range", ip)
+		}
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), bits)
+	}
+
+	return prefix.Masked(), nil
+}
@@ -0,0 +1,119 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package ipblock_test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ipblock"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func TestCache(t *testing.T) {
+	c := new(ipblock.Cache)
+
+	cachedBlocks := []*gtsmodel.IPBlock{
+		{ID: "signup", IP: "192.0.2.0/24", Severity: gtsmodel.IPBlockSignUpBlock},
+		{ID: "noaccess", IP: "192.0.2.128/25", Severity: gtsmodel.IPBlockNoAccess},
+		{ID: "v6", IP: "2001:db8::/32", Severity: gtsmodel.IPBlockSignUpBlock},
+		{ID: "expired", IP: "198.51.100.7", Severity: gtsmodel.IPBlockNoAccess, ExpiresAt: time.Now().Add(-time.Hour)},
+		{ID: "invalid", IP: "not an ip", Severity: gtsmodel.IPBlockNoAccess},
+	}
+
+	loader := func() ([]*gtsmodel.IPBlock, error) {
+		t.Log("load: returning cached blocks")
+		return cachedBlocks, nil
+	}
+
+	// Check a list of addresses that should match.
+	for addr, blockID := range map[string]string{
+		"192.0.2.1":          "signup",
+		"::ffff:192.0.2.1":   "signup",
+		"192.0.2.200":        "noaccess",
+		"2001:db8::1":        "v6",
+		"2001:db8:ffff::abc": "v6",
+	} {
+		t.Logf("checking address matches: %s", addr)
+		block, err := c.Match(netip.MustParseAddr(addr), loader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if block == nil || block.ID != blockID {
+			t.Errorf("address %s should be matched by block %s, got %+v", addr, blockID, block)
+		}
+	}
+
+	// Check a list of addresses that shouldn't match.
+	for _, addr := range []string{
+		"192.0.3.1",
+		"198.51.100.7",
+		"2001:db9::1",
+	} {
+		t.Logf("checking address isn't matched: %s", addr)
+		if block, _ := c.Match(netip.MustParseAddr(addr), loader); block != nil {
+			t.Errorf("address should not be matched: %s", addr)
+		}
+	}
+
+	// Clear the cache
+	c.Clear()
+
+	knownErr := errors.New("known error")
+
+	// Check that reload is actually performed and returns our error
+	if _, err := c.Match(netip.MustParseAddr("192.0.2.1"), func() ([]*gtsmodel.IPBlock, error) {
+		t.Log("load: returning known error")
+		return nil, knownErr
+	}); !errors.Is(err, knownErr) {
+		t.Errorf("matching did not return expected error: %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	for in, out := range map[string]string{
+		"192.0.2.1":            "192.0.2.1/32",
+		"192.0.2.1/24":         "192.0.2.0/24",
+		"::ffff:192.0.2.1":     "192.0.2.1/32",
+		"::ffff:192.0.2.0/120": "192.0.2.0/24",
+		"2001:db8::1":          "2001:db8::1/128",
+		"2001:db8::1/32":       "2001:db8::/32",
+	} {
+		prefix, err := ipblock.Parse(in)
+		if err != nil {
+			t.Errorf("error parsing %s: %v", in, err)
+			continue
+		}
+		if prefix.String() != out {
+			t.Errorf("expected %s to parse as %s, got %s", in, out, prefix)
+		}
+	}
+
+	for _, in := range []string{
+		"",
+		"192.0.2",
+		"192.0.2.1/33",
+		"::ffff:192.0.2.0/90",
+	} {
+		if _, err := ipblock.Parse(in); err == nil {
+			t.Errorf("expected error parsing %s", in)
+		}
+	}
+}
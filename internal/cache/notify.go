@@ -0,0 +1,271 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"sync"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Names of caches for which invalidations
+// may be propagated to / from other processes.
+const (
+	cacheAccount          = "Account"
+	cacheAccountNote      = "AccountNote"
+	cacheApplication      = "Application"
+	cacheBlock            = "Block"
+	cacheDomainAllow      = "DomainAllow"
+	cacheDomainBlock      = "DomainBlock"
+	cacheDomainInterop    = "DomainInterop"
+	cacheEmailDomainBlock = "EmailDomainBlock"
+	cacheEmoji            = "Emoji"
+	cacheEmojiCategory    = "EmojiCategory"
+	cacheFollow           = "Follow"
+	cacheFollowRequest    = "FollowRequest"
+	cacheIngestRule       = "IngestRule"
+	cacheInstance         = "Instance"
+	cacheIPBlock          = "IPBlock"
+	cacheList             = "List"
+	cacheListEntry        = "ListEntry"
+	cacheMarker           = "Marker"
+	cacheMedia            = "Media"
+	cacheMention          = "Mention"
+	cacheNotification     = "Notification"
+	cacheReport           = "Report"
+	cacheStatus           = "Status"
+	cacheStatusFave       = "StatusFave"
+	cacheStatusReaction   = "StatusReaction"
+	cacheTag              = "Tag"
+	cacheTombstone        = "Tombstone"
+	cacheUser             = "User"
+	cacheUserMute         = "UserMute"
+)
+
+// InvalidateEvent describes a single cache invalidation,
+// to be propagated between processes sharing a database.
+//
+// Keys are positional and depend on the named cache, the
+// first key generally being the ID of the invalidated item,
+// followed by any keys required to invalidate dependent caches.
+type InvalidateEvent struct {
+	Cache string   `json:"c"`
+	Keys  []string `json:"k,omitempty"`
+}
+
+// Notifier is implemented by types able to
+// propagate cache invalidations to other processes,
+// e.g. via Postgres LISTEN / NOTIFY. Notify must
+// not block, as it is called from within cache hooks.
+type Notifier interface {
+	Notify(event InvalidateEvent)
+}
+
+// notifier wraps a Notifier with
+// the state needed to avoid echoing
+// invalidations received from another
+// process straight back out again.
+type notifier struct {
+	n        Notifier
+	handling map[string]int
+	mu       sync.Mutex
+}
+
+// SetNotifier sets the Notifier to pass cache invalidations to.
+// Passing nil disables propagation of cache invalidations.
+func (c *Caches) SetNotifier(n Notifier) {
+	c.notifier.mu.Lock()
+	c.notifier.n = n
+	c.notifier.mu.Unlock()
+}
+
+// NotifyDomainAllow notifies other processes that the
+// domain allow cache has been cleared, see NotifyDomainBlock.
+func (c *Caches) NotifyDomainAllow() {
+	c.notify(cacheDomainAllow)
+}
+
+// NotifyDomainBlock notifies other processes that the
+// domain block cache has been cleared. This is needed
+// as the domain caches do not support invalidate hooks.
+func (c *Caches) NotifyDomainBlock() {
+	c.notify(cacheDomainBlock)
+}
+
+// NotifyEmailDomainBlock notifies other processes that the email
+// domain block cache has been cleared, see NotifyDomainBlock.
+func (c *Caches) NotifyEmailDomainBlock() {
+	c.notify(cacheEmailDomainBlock)
+}
+
+// NotifyIngestRule notifies other processes that the
+// ingest rule cache has been cleared, see NotifyDomainBlock.
+func (c *Caches) NotifyIngestRule() {
+	c.notify(cacheIngestRule)
+}
+
+// NotifyIPBlock notifies other processes that the
+// ip block cache has been cleared, see NotifyDomainBlock.
+func (c *Caches) NotifyIPBlock() {
+	c.notify(cacheIPBlock)
+}
+
+// notify passes an invalidate event for given cache name and keys
+// on to the configured Notifier, if any, unless the event is the
+// result of handling the same invalidation from another process.
+func (c *Caches) notify(cache string, keys ...string) {
+	c.notifier.mu.Lock()
+	n := c.notifier.n
+	_, handling := c.notifier.handling[handlingKey(cache, keys)]
+	c.notifier.mu.Unlock()
+
+	if n == nil || handling {
+		return
+	}
+
+	n.Notify(InvalidateEvent{
+		Cache: cache,
+		Keys:  keys,
+	})
+}
+
+// HandleInvalidate handles an invalidate event received
+// from another process, invalidating the same items (and
+// their dependents) from our local caches. Returns false
+// if the event was not recognised.
+func (c *Caches) HandleInvalidate(event InvalidateEvent) bool {
+	hkey := handlingKey(event.Cache, event.Keys)
+
+	// Mark this event as being handled, so the
+	// resulting invalidate hooks don't re-notify.
+	c.notifier.mu.Lock()
+	if c.notifier.handling == nil {
+		c.notifier.handling = make(map[string]int)
+	}
+	c.notifier.handling[hkey]++
+	c.notifier.mu.Unlock()
+
+	defer func() {
+		c.notifier.mu.Lock()
+		if c.notifier.handling[hkey]--; c.notifier.handling[hkey] <= 0 {
+			delete(c.notifier.handling, hkey)
+		}
+		c.notifier.mu.Unlock()
+	}()
+
+	// key returns positional key
+	// at idx, or empty string.
+	keys := event.Keys
+	key := func(idx int) string {
+		if idx < len(keys) {
+			return keys[idx]
+		}
+		return ""
+	}
+
+	switch event.Cache {
+	case cacheAccount:
+		c.GTS.Account().Invalidate("ID", key(0))
+		c.invalidateAccountDeps(key(0))
+	case cacheAccountNote:
+		c.GTS.AccountNote().Invalidate("ID", key(0))
+	case cacheApplication:
+		c.GTS.Application().Invalidate("ID", key(0))
+	case cacheBlock:
+		c.GTS.Block().Invalidate("ID", key(0))
+		c.invalidateBlockDeps(key(1), key(2))
+	case cacheDomainAllow:
+		c.GTS.DomainAllow().Clear()
+	case cacheDomainBlock:
+		c.GTS.DomainBlock().Clear()
+	case cacheDomainInterop:
+		c.GTS.DomainInterop().Invalidate("ID", key(0))
+	case cacheEmailDomainBlock:
+		c.GTS.EmailDomainBlock().Clear()
+	case cacheEmoji:
+		c.GTS.Emoji().Invalidate("ID", key(0))
+	case cacheEmojiCategory:
+		c.GTS.EmojiCategory().Invalidate("ID", key(0))
+		c.invalidateEmojiCategoryDeps(key(0))
+	case cacheFollow:
+		c.GTS.Follow().Invalidate("ID", key(0))
+		c.invalidateFollowDeps(key(0), key(1), key(2))
+	case cacheFollowRequest:
+		c.GTS.FollowRequest().Invalidate("ID", key(0))
+		c.invalidateFollowRequestDeps(key(0), key(1), key(2))
+	case cacheIngestRule:
+		c.GTS.IngestRule().Clear()
+	case cacheInstance:
+		c.GTS.Instance().Invalidate("ID", key(0))
+	case cacheIPBlock:
+		c.GTS.IPBlock().Clear()
+	case cacheList:
+		c.GTS.List().Invalidate("ID", key(0))
+		c.invalidateListDeps(key(0))
+	case cacheListEntry:
+		c.GTS.ListEntry().Invalidate("ID", key(0))
+	case cacheMarker:
+		c.GTS.Marker().Invalidate("AccountID.Name", key(0), gtsmodel.MarkerName(key(1)))
+	case cacheMedia:
+		c.GTS.Media().Invalidate("ID", key(0))
+		c.invalidateMediaDeps(key(1), key(2))
+	case cacheMention:
+		c.GTS.Mention().Invalidate("ID", key(0))
+	case cacheNotification:
+		c.GTS.Notification().Invalidate("ID", key(0))
+	case cacheReport:
+		c.GTS.Report().Invalidate("ID", key(0))
+	case cacheStatus:
+		var attachmentIDs []string
+		if len(keys) > 3 {
+			attachmentIDs = keys[3:]
+		}
+		c.GTS.Status().Invalidate("ID", key(0))
+		c.invalidateStatusDeps(key(0), key(1), key(2), attachmentIDs)
+	case cacheStatusFave:
+		c.GTS.StatusFave().Invalidate("ID", key(0))
+		c.invalidateStatusFaveDeps(key(1))
+	case cacheStatusReaction:
+		c.GTS.StatusReaction().Invalidate("ID", key(0))
+		c.invalidateStatusReactionDeps(key(1))
+	case cacheTag:
+		c.GTS.Tag().Invalidate("ID", key(0))
+	case cacheTombstone:
+		c.GTS.Tombstone().Invalidate("ID", key(0))
+	case cacheUser:
+		c.GTS.User().Invalidate("ID", key(0))
+		c.invalidateUserDeps(key(1))
+	case cacheUserMute:
+		c.GTS.UserMute().Invalidate("ID", key(0))
+		c.invalidateUserMuteDeps(key(1))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// handlingKey generates a key for an invalidate event from the
+// cache name and first (i.e. primary) key only, as the dependent
+// keys of a locally cached copy of the item may differ.
+func handlingKey(cache string, keys []string) string {
+	if len(keys) == 0 {
+		return cache
+	}
+	return cache + "." + keys[0]
+}
@@ -25,6 +25,7 @@ import (
 	"codeberg.org/gruf/go-cache/v3/simple"
 	"github.com/DmitriyVTitov/size"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/bloom"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -87,6 +88,11 @@ func calculateSliceCacheMax(ratio float64) int {
 	return calculateCacheMax(sizeofIDStr, sizeofIDSlice, ratio)
 }
 
+// calculateFilterCacheMax calculates the maximum capacity for a filter cache with given individual ratio.
+func calculateFilterCacheMax(ratio float64) int {
+	return calculateCacheMax(sizeofIDStr, sizeofFilter(), ratio)
+}
+
 // calculateResultCacheMax calculates the maximum cache capacity for a result
 // cache's individual ratio number, and the size of the struct model in memory.
 func calculateResultCacheMax(structSz uintptr, ratio float64) int {
@@ -174,7 +180,9 @@ func totalOfRatios() float64 {
 		config.GetCacheApplicationMemRatio() +
 		config.GetCacheBlockMemRatio() +
 		config.GetCacheBlockIDsMemRatio() +
+		config.GetCacheBlockFilterMemRatio() +
 		config.GetCacheBoostOfIDsMemRatio() +
+		config.GetCacheDomainInteropMemRatio() +
 		config.GetCacheEmojiMemRatio() +
 		config.GetCacheEmojiCategoryMemRatio() +
 		config.GetCacheFollowMemRatio() +
@@ -188,14 +196,18 @@ func totalOfRatios() float64 {
 		config.GetCacheMarkerMemRatio() +
 		config.GetCacheMediaMemRatio() +
 		config.GetCacheMentionMemRatio() +
+		config.GetCacheMuteFilterMemRatio() +
 		config.GetCacheNotificationMemRatio() +
 		config.GetCacheReportMemRatio() +
 		config.GetCacheStatusMemRatio() +
 		config.GetCacheStatusFaveMemRatio() +
 		config.GetCacheStatusFaveIDsMemRatio() +
+		config.GetCacheStatusReactionMemRatio() +
+		config.GetCacheStatusReactionIDsMemRatio() +
 		config.GetCacheTagMemRatio() +
 		config.GetCacheTombstoneMemRatio() +
 		config.GetCacheUserMemRatio() +
+		config.GetCacheUserMuteMemRatio() +
 		config.GetCacheWebfingerMemRatio() +
 		config.GetCacheVisibilityMemRatio()
 }
@@ -273,6 +285,22 @@ func sizeofBlock() uintptr {
 	}))
 }
 
+func sizeofDomainInterop() uintptr {
+	return uintptr(size.Of(&gtsmodel.DomainInterop{
+		ID:                  exampleID,
+		CreatedAt:           exampleTime,
+		UpdatedAt:           exampleTime,
+		Domain:              exampleURI,
+		SignGETWithoutQuery: func() *bool { ok := false; return &ok }(),
+		AcceptActivityJSON:  func() *bool { ok := false; return &ok }(),
+		DeliverActivityJSON: func() *bool { ok := false; return &ok }(),
+		LaxContentType:      func() *bool { ok := false; return &ok }(),
+		SignatureAlgorithm:  exampleUsername,
+		LastQuirk:           exampleTextSmall,
+		LastQuirkAt:         exampleTime,
+	}))
+}
+
 func sizeofEmoji() uintptr {
 	return uintptr(size.Of(&gtsmodel.Emoji{
 		ID:                     exampleID,
@@ -500,6 +528,19 @@ func sizeofStatusFave() uintptr {
 	}))
 }
 
+func sizeofStatusReaction() uintptr {
+	return uintptr(size.Of(&gtsmodel.StatusReaction{
+		ID:              exampleID,
+		CreatedAt:       exampleTime,
+		AccountID:       exampleID,
+		TargetAccountID: exampleID,
+		StatusID:        exampleID,
+		Content:         ":blobcat:",
+		EmojiID:         exampleID,
+		URI:             exampleURI,
+	}))
+}
+
 func sizeofTag() uintptr {
 	return uintptr(size.Of(&gtsmodel.Tag{
 		ID:        exampleID,
@@ -521,10 +562,17 @@ func sizeofTombstone() uintptr {
 	}))
 }
 
+func sizeofFilter() uintptr {
+	// Estimate using a filter of 250 IDs, as for sizeofIDSlice.
+	filter := bloom.New(250, filterFalsePositiveRate)
+	return unsafe.Sizeof(*filter) + uintptr(filter.Size())
+}
+
 func sizeofVisibility() uintptr {
 	return uintptr(size.Of(&CachedVisibility{
 		ItemID:      exampleID,
 		RequesterID: exampleID,
+		BoostOfID:   exampleID,
 		Type:        VisibilityTypeAccount,
 		Value:       false,
 	}))
@@ -559,3 +607,16 @@ func sizeofUser() uintptr {
 		ExternalID:             exampleID,
 	}))
 }
+
+func sizeofUserMute() uintptr {
+	return uintptr(size.Of(&gtsmodel.UserMute{
+		ID:              exampleID,
+		CreatedAt:       exampleTime,
+		UpdatedAt:       exampleTime,
+		AccountID:       exampleID,
+		TargetAccountID: exampleID,
+		Statuses:        func() *bool { ok := true; return &ok }(),
+		Boosts:          func() *bool { ok := true; return &ok }(),
+		Notifications:   func() *bool { ok := true; return &ok }(),
+	}))
+}
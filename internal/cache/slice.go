@@ -26,12 +26,14 @@ import (
 // functions for fetching + caching slices of objects (e.g. IDs).
 type SliceCache[T any] struct {
 	*simple.Cache[string, []T]
+	counter
 }
 
 // Load will attempt to load an existing slice from the cache for the given key, else calling the provided load function and caching the result.
 func (c *SliceCache[T]) Load(key string, load func() ([]T, error)) ([]T, error) {
 	// Look for follow IDs list in cache under this key.
 	data, ok := c.Get(key)
+	c.count(ok)
 
 	if !ok {
 		var err error
@@ -49,3 +51,6 @@ func (c *SliceCache[T]) Load(key string, load func() ([]T, error)) ([]T, error)
 	// Return data clone for safety.
 	return slices.Clone(data), nil
 }
+
+// Clear removes all items from the cache, see ResultCache{}.Clear().
+func (c *SliceCache[T]) Clear() { c.Trim(0) }
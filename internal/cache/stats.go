@@ -0,0 +1,358 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"codeberg.org/gruf/go-cache/v3/result"
+	"codeberg.org/gruf/go-cache/v3/ttl"
+	"golang.org/x/exp/slices"
+)
+
+// CacheStats contains statistics
+// about a single cache, for debugging.
+type CacheStats struct {
+	// Name of the cache.
+	Name string
+
+	// Len is the current number of cached
+	// entries, or -1 if not known for this cache.
+	Len int
+
+	// Cap is the maximum number of cached entries,
+	// or -1 if this cache is not size bounded.
+	Cap int
+
+	// Counted indicates whether Hits and
+	// Misses are counted for this cache.
+	Counted bool
+
+	// Hits and Misses count lookups
+	// served from the cache, and lookups
+	// which had to fall through to load.
+	Hits   uint64
+	Misses uint64
+
+	// Breakdown of Hits and Misses by kind
+	// of lookup, for caches counting them
+	// so, e.g. by visibility type for the
+	// visibility cache. Nil otherwise.
+	Breakdown map[string]CacheCounts
+
+	// Lookups are the names of the lookups
+	// under which individual entries can be
+	// invalidated, e.g. "ID" or "Username.Domain".
+	// Empty if the cache can only be cleared.
+	Lookups []string
+}
+
+// CacheCounts counts cache lookup hits + misses.
+type CacheCounts struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// counter keeps count of
+// cache lookup hits + misses.
+type counter struct {
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// count increments hits or misses.
+func (c *counter) count(hit bool) {
+	if hit {
+		c.hits.Add(1)
+	} else {
+		c.misses.Add(1)
+	}
+}
+
+// ResultCache wraps a result.Cache to count lookup
+// hits and misses, and to keep track of its capacity
+// and lookups, for the admin cache debug endpoints.
+type ResultCache[T any] struct {
+	*result.Cache[T]
+	counter
+	lookups []string
+	cap     int
+}
+
+// newResultCache returns a new ResultCache wrapping
+// result.New() called with the given arguments.
+func newResultCache[T any](lookups []result.Lookup, copy func(T) T, cap int) *ResultCache[T] {
+	names := make([]string, len(lookups))
+	for i, lookup := range lookups {
+		names[i] = lookup.Name
+	}
+	return &ResultCache[T]{
+		Cache:   result.New(lookups, copy, cap),
+		lookups: names,
+		cap:     cap,
+	}
+}
+
+// Load: see result.Cache{}.Load(). Lookups which
+// call load are counted as misses, others as hits.
+func (c *ResultCache[T]) Load(lookup string, load func() (T, error), keyParts ...any) (T, error) {
+	hit := true
+	v, err := c.Cache.Load(lookup, func() (T, error) {
+		hit = false
+		return load()
+	}, keyParts...)
+	c.count(hit)
+	return v, err
+}
+
+// Clear removes all items from the cache. Note that
+// result.Cache{}.Clear() only trims the cache down
+// to its capacity, which usually removes nothing.
+func (c *ResultCache[T]) Clear() { c.Trim(0) }
+
+// debugCache provides uniform access
+// to differing cache types for debugging.
+type debugCache struct {
+	stats      func() CacheStats
+	invalidate func(lookup string, keys []string) error
+	clear      func()
+}
+
+// debugResult returns a debugCache for a ResultCache.
+func debugResult[T any](c *ResultCache[T]) debugCache {
+	return debugCache{
+		stats: func() CacheStats {
+			return CacheStats{
+				Len:     -1,
+				Cap:     c.cap,
+				Counted: true,
+				Hits:    c.hits.Load(),
+				Misses:  c.misses.Load(),
+				Lookups: slices.Clone(c.lookups),
+			}
+		},
+		invalidate: func(lookup string, keys []string) error {
+			if !slices.Contains(c.lookups, lookup) {
+				return fmt.Errorf("unknown lookup %q, expected one of %v", lookup, c.lookups)
+			}
+
+			// Each part of the lookup name
+			// requires a corresponding key.
+			if parts := strings.Count(lookup, ".") + 1; len(keys) != parts {
+				return fmt.Errorf("lookup %q requires %d keys, got %d", lookup, parts, len(keys))
+			}
+
+			keyParts := make([]any, len(keys))
+			for i, key := range keys {
+				keyParts[i] = key
+			}
+
+			c.Invalidate(lookup, keyParts...)
+			return nil
+		},
+		clear: c.Clear,
+	}
+}
+
+// debugVisibility returns a debugCache for the VisibilityCache,
+// including its hits and misses broken down by visibility type.
+func debugVisibility(c *VisibilityCache) debugCache {
+	debug := debugResult(c.ResultCache)
+	stats := debug.stats
+	debug.stats = func() CacheStats {
+		s := stats()
+		s.Breakdown = make(map[string]CacheCounts, len(c.counters))
+		for vtype, counter := range c.counters {
+			s.Breakdown[vtype.String()] = CacheCounts{
+				Hits:   counter.hits.Load(),
+				Misses: counter.misses.Load(),
+			}
+		}
+		return s
+	}
+	return debug
+}
+
+// debugSlice returns a debugCache for a SliceCache.
+func debugSlice[T any](c *SliceCache[T]) debugCache {
+	return debugCache{
+		stats: func() CacheStats {
+			return CacheStats{
+				Len:     c.Len(),
+				Cap:     c.Cap(),
+				Counted: true,
+				Hits:    c.hits.Load(),
+				Misses:  c.misses.Load(),
+				Lookups: []string{"Key"},
+			}
+		},
+		invalidate: func(_ string, keys []string) error {
+			c.InvalidateAll(keys...)
+			return nil
+		},
+		clear: c.Clear,
+	}
+}
+
+// debugFilter returns a debugCache for a FilterCache.
+func debugFilter(c *FilterCache) debugCache {
+	return debugCache{
+		stats: func() CacheStats {
+			return CacheStats{
+				Len:     c.Len(),
+				Cap:     c.Cap(),
+				Counted: true,
+				Hits:    c.hits.Load(),
+				Misses:  c.misses.Load(),
+				Lookups: []string{"Key"},
+			}
+		},
+		invalidate: func(_ string, keys []string) error {
+			c.InvalidateAll(keys...)
+			return nil
+		},
+		clear: c.Clear,
+	}
+}
+
+// debugTTL returns a debugCache for a ttl.Cache.
+func debugTTL[V any](c *ttl.Cache[string, V]) debugCache {
+	return debugCache{
+		stats: func() CacheStats {
+			return CacheStats{
+				Len:     c.Len(),
+				Cap:     c.Cap(),
+				Lookups: []string{"Key"},
+			}
+		},
+		invalidate: func(_ string, keys []string) error {
+			c.InvalidateAll(keys...)
+			return nil
+		},
+		clear: c.Clear,
+	}
+}
+
+// debugClearOnly returns a debugCache for a cache
+// which only supports clearing, e.g. domain.Cache.
+func debugClearOnly(clear func()) debugCache {
+	return debugCache{
+		stats: func() CacheStats {
+			return CacheStats{Len: -1, Cap: -1}
+		},
+		invalidate: func(string, []string) error {
+			return fmt.Errorf("cache only supports being cleared")
+		},
+		clear: clear,
+	}
+}
+
+// debugCaches returns the caches
+// available for debugging by name.
+func (c *Caches) debugCaches() map[string]debugCache {
+	return map[string]debugCache{
+		cacheAccount:          debugResult(c.GTS.account),
+		cacheAccountNote:      debugResult(c.GTS.accountNote),
+		"AccountRefreshes":    debugTTL(c.GTS.accountRefreshes),
+		cacheApplication:      debugResult(c.GTS.application),
+		cacheBlock:            debugResult(c.GTS.block),
+		"BlockFilter":         debugFilter(c.GTS.blockFilter),
+		"BlockIDs":            debugSlice(c.GTS.blockIDs),
+		"BoostOfIDs":          debugSlice(c.GTS.boostOfIDs),
+		cacheDomainAllow:      debugClearOnly(c.GTS.domainAllow.Clear),
+		cacheDomainBlock:      debugClearOnly(c.GTS.domainBlock.Clear),
+		cacheDomainInterop:    debugResult(c.GTS.domainInterop),
+		cacheEmailDomainBlock: debugClearOnly(c.GTS.emailDomainBlock.Clear),
+		cacheEmoji:            debugResult(c.GTS.emoji),
+		cacheEmojiCategory:    debugResult(c.GTS.emojiCategory),
+		cacheFollow:           debugResult(c.GTS.follow),
+		"FollowIDs":           debugSlice(c.GTS.followIDs),
+		cacheFollowRequest:    debugResult(c.GTS.followRequest),
+		"FollowRequestIDs":    debugSlice(c.GTS.followRequestIDs),
+		"IdempotentRequests":  debugTTL(c.GTS.idempotentRequests),
+		cacheIngestRule:       debugClearOnly(c.GTS.ingestRule.Clear),
+		cacheInstance:         debugResult(c.GTS.instance),
+		"InstanceCounts":      debugTTL(c.GTS.instanceCounts),
+		"InReplyToIDs":        debugSlice(c.GTS.inReplyToIDs),
+		"InteractionToggles":  debugTTL(c.GTS.interactionToggles),
+		cacheIPBlock:          debugClearOnly(c.GTS.ipBlock.Clear),
+		cacheList:             debugResult(c.GTS.list),
+		cacheListEntry:        debugResult(c.GTS.listEntry),
+		cacheMarker:           debugResult(c.GTS.marker),
+		cacheMedia:            debugResult(c.GTS.media),
+		cacheMention:          debugResult(c.GTS.mention),
+		"MuteFilter":          debugFilter(c.GTS.muteFilter),
+		cacheNotification:     debugResult(c.GTS.notification),
+		cacheReport:           debugResult(c.GTS.report),
+		"SpamContentHashes":   debugTTL(c.GTS.spamContentHashes),
+		cacheStatus:           debugResult(c.GTS.status),
+		cacheStatusFave:       debugResult(c.GTS.statusFave),
+		"StatusFaveIDs":       debugSlice(c.GTS.statusFaveIDs),
+		cacheStatusReaction:   debugResult(c.GTS.statusReaction),
+		"StatusReactionIDs":   debugSlice(c.GTS.statusReactionIDs),
+		cacheTag:              debugResult(c.GTS.tag),
+		cacheTombstone:        debugResult(c.GTS.tombstone),
+		"Translations":        debugTTL(c.GTS.translations),
+		cacheUser:             debugResult(c.GTS.user),
+		cacheUserMute:         debugResult(c.GTS.userMute),
+		"Visibility":          debugVisibility(&c.Visibility),
+		"Webfinger":           debugTTL(c.GTS.webfinger),
+		"WebfingerMisses":     debugTTL(c.GTS.webfingerMisses),
+		"WebfingerResults":    debugTTL(c.GTS.webfingerResults),
+	}
+}
+
+// Stats returns statistics for all caches, sorted by name.
+func (c *Caches) Stats() []CacheStats {
+	caches := c.debugCaches()
+
+	stats := make([]CacheStats, 0, len(caches))
+	for name, cache := range caches {
+		s := cache.stats()
+		s.Name = name
+		stats = append(stats, s)
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Name < stats[j].Name
+	})
+
+	return stats
+}
+
+// Invalidate invalidates the entries with given keys under lookup
+// in the named cache (see Stats() for names and lookups), or clears
+// the whole cache if no keys are given. Invalidating entries from
+// database-backed caches also invalidates any dependent caches, and
+// propagates the invalidation to other processes, as for a db update.
+func (c *Caches) Invalidate(name string, lookup string, keys []string) error {
+	cache, ok := c.debugCaches()[name]
+	if !ok {
+		return fmt.Errorf("unknown cache %q", name)
+	}
+
+	if len(keys) == 0 {
+		cache.clear()
+		return nil
+	}
+
+	return cache.invalidate(lookup, keys)
+}
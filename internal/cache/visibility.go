@@ -24,7 +24,11 @@ import (
 )
 
 type VisibilityCache struct {
-	*result.Cache[*CachedVisibility]
+	*ResultCache[*CachedVisibility]
+
+	// counters count hits and misses
+	// of lookups by visibility type.
+	counters map[VisibilityType]*counter
 }
 
 // Init will initialize the visibility cache in this collection.
@@ -38,9 +42,10 @@ func (c *VisibilityCache) Init() {
 
 	log.Infof(nil, "Visibility cache size = %d", cap)
 
-	c.Cache = result.New([]result.Lookup{
+	c.ResultCache = newResultCache([]result.Lookup{
 		{Name: "ItemID", Multi: true},
 		{Name: "RequesterID", Multi: true},
+		{Name: "BoostOfID", Multi: true},
 		{Name: "Type.RequesterID.ItemID"},
 	}, func(v1 *CachedVisibility) *CachedVisibility {
 		v2 := new(CachedVisibility)
@@ -48,7 +53,32 @@ func (c *VisibilityCache) Init() {
 		return v2
 	}, cap)
 
-	c.Cache.IgnoreErrors(ignoreErrors)
+	c.ResultCache.IgnoreErrors(ignoreErrors)
+
+	c.counters = make(map[VisibilityType]*counter, len(visibilityTypes))
+	for _, vtype := range visibilityTypes {
+		c.counters[vtype] = new(counter)
+	}
+}
+
+// Load: see ResultCache{}.Load(). Lookups by
+// "Type.RequesterID.ItemID" are also counted
+// as hits or misses by visibility type.
+func (c *VisibilityCache) Load(lookup string, load func() (*CachedVisibility, error), keyParts ...any) (*CachedVisibility, error) {
+	hit := true
+	v, err := c.ResultCache.Load(lookup, func() (*CachedVisibility, error) {
+		hit = false
+		return load()
+	}, keyParts...)
+
+	if len(keyParts) > 0 {
+		vtype, _ := keyParts[0].(VisibilityType)
+		if counter := c.counters[vtype]; counter != nil {
+			counter.count(hit)
+		}
+	}
+
+	return v, err
 }
 
 // Start will attempt to start the visibility cache, or panic.
@@ -72,6 +102,29 @@ const (
 	VisibilityTypePublic  = VisibilityType('p')
 )
 
+// visibilityTypes are all the visibility lookup types.
+var visibilityTypes = []VisibilityType{
+	VisibilityTypeAccount,
+	VisibilityTypeStatus,
+	VisibilityTypeHome,
+	VisibilityTypePublic,
+}
+
+func (t VisibilityType) String() string {
+	switch t {
+	case VisibilityTypeAccount:
+		return "account"
+	case VisibilityTypeStatus:
+		return "status"
+	case VisibilityTypeHome:
+		return "home"
+	case VisibilityTypePublic:
+		return "public"
+	default:
+		return "unknown"
+	}
+}
+
 // CachedVisibility represents a cached visibility lookup value.
 type CachedVisibility struct {
 	// ItemID is the ID of the item in question (status / account).
@@ -80,6 +133,11 @@ type CachedVisibility struct {
 	// RequesterID is the ID of the requesting account for this visibility lookup.
 	RequesterID string
 
+	// BoostOfID is the ID of the status boosted by the item in
+	// question, if it's a boost, since a boost's visibility depends
+	// on that of the boosted status.
+	BoostOfID string
+
 	// Type is the visibility lookup type.
 	Type VisibilityType
 
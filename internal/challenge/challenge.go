@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package challenge provides the hook through which new
+// sign-ups can be made to pass a CAPTCHA-style challenge.
+package challenge
+
+import (
+	"context"
+	"net/netip"
+)
+
+// Challenge is a CAPTCHA-style check which people signing
+// up must pass before their account is created. The sign-up
+// form renders a widget from the challenge provider, which
+// hands back a response token once the person has passed
+// the challenge; that token is then submitted along with
+// the form, and checked with Verify.
+type Challenge interface {
+	// Provider returns the name of the challenge
+	// provider, eg., "hcaptcha", so that clients
+	// know which widget to render.
+	Provider() string
+
+	// SiteKey returns the public key used by
+	// clients to render the provider's widget.
+	SiteKey() string
+
+	// Verify checks the response token submitted with a sign-up
+	// from the given remote IP address, returning false if the
+	// challenge was not passed. An error is returned only if
+	// the token could not be checked at all.
+	Verify(ctx context.Context, response string, remoteIP netip.Addr) (bool, error)
+}
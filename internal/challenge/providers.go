@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package challenge
+
+import (
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+const (
+	hCaptchaSiteVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	turnstileSiteVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+)
+
+// NewHCaptcha returns a Challenge which checks
+// response tokens from the hCaptcha widget.
+func NewHCaptcha(siteKey string, secretKey string) Challenge {
+	return newSiteVerify(
+		config.AccountsChallengeProviderHCaptcha,
+		hCaptchaSiteVerifyURL,
+		siteKey,
+		secretKey,
+		true,
+	)
+}
+
+// NewTurnstile returns a Challenge which checks response
+// tokens from the Cloudflare Turnstile widget.
+func NewTurnstile(siteKey string, secretKey string) Challenge {
+	return newSiteVerify(
+		config.AccountsChallengeProviderTurnstile,
+		turnstileSiteVerifyURL,
+		siteKey,
+		secretKey,
+		false,
+	)
+}
+
+// New returns the Challenge set up in the instance
+// configuration, or nil if sign-ups don't have to
+// pass a challenge.
+func New() (Challenge, error) {
+	var (
+		siteKey   = config.GetAccountsChallengeSiteKey()
+		secretKey = config.GetAccountsChallengeSecretKey()
+	)
+
+	switch provider := config.GetAccountsChallengeProvider(); provider {
+	case "":
+		return nil, nil
+	case config.AccountsChallengeProviderHCaptcha:
+		return NewHCaptcha(siteKey, secretKey), nil
+	case config.AccountsChallengeProviderTurnstile:
+		return NewTurnstile(siteKey, secretKey), nil
+	default:
+		return nil, fmt.Errorf("challenge provider %s not recognized", provider)
+	}
+}
@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// siteVerify is a Challenge which checks response tokens
+// by POSTing them to the provider's siteverify endpoint.
+// hCaptcha and Turnstile both implement this endpoint in
+// the same way, which was in turn copied from reCAPTCHA.
+type siteVerify struct {
+	provider  string
+	url       string
+	siteKey   string
+	secretKey string
+
+	// sendSiteKey is whether the site key is sent
+	// along with the response token, so that the
+	// provider checks it was issued for this site.
+	sendSiteKey bool
+
+	client *http.Client
+}
+
+// siteVerifyResponse is the JSON
+// body returned by siteverify.
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+func newSiteVerify(provider string, url string, siteKey string, secretKey string, sendSiteKey bool) *siteVerify {
+	return &siteVerify{
+		provider:    provider,
+		url:         url,
+		siteKey:     siteKey,
+		secretKey:   secretKey,
+		sendSiteKey: sendSiteKey,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *siteVerify) Provider() string {
+	return s.provider
+}
+
+func (s *siteVerify) SiteKey() string {
+	return s.siteKey
+}
+
+func (s *siteVerify) Verify(ctx context.Context, response string, remoteIP netip.Addr) (bool, error) {
+	form := url.Values{}
+	form.Set("secret", s.secretKey)
+	form.Set("response", response)
+	if remoteIP.IsValid() {
+		form.Set("remoteip", remoteIP.String())
+	}
+	if s.sendSiteKey {
+		form.Set("sitekey", s.siteKey)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := s.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("%s siteverify responded %s", s.provider, rsp.Status)
+	}
+
+	var result siteVerifyResponse
+	if err := json.NewDecoder(io.LimitReader(rsp.Body, 64*1024)).Decode(&result); err != nil {
+		return false, fmt.Errorf("error decoding %s siteverify response: %w", s.provider, err)
+	}
+
+	if result.Success {
+		return true, nil
+	}
+
+	// A failed check is only the fault of the person
+	// signing up if their response token was bad; other
+	// errors mean the instance is misconfigured.
+	for _, code := range result.ErrorCodes {
+		switch code {
+		case "missing-input-response",
+			"invalid-input-response",
+			"invalid-or-already-seen-response",
+			"timeout-or-duplicate":
+			continue
+		default:
+			return false, fmt.Errorf("%s siteverify returned error code %s", s.provider, code)
+		}
+	}
+
+	return false, nil
+}
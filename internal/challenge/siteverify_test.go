@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package challenge
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type SiteVerifyTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+
+	// last form posted to the server.
+	form map[string]string
+}
+
+func (suite *SiteVerifyTestSuite) SetupTest() {
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		suite.form = make(map[string]string)
+		for k := range r.PostForm {
+			suite.form[k] = r.PostForm.Get(k)
+		}
+
+		rsp := siteVerifyResponse{}
+		switch {
+		case r.PostForm.Get("secret") != "secret-key":
+			rsp.ErrorCodes = []string{"invalid-input-secret"}
+		case r.PostForm.Get("response") == "passed":
+			rsp.Success = true
+		default:
+			rsp.ErrorCodes = []string{"invalid-input-response"}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(rsp)
+	}))
+}
+
+func (suite *SiteVerifyTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *SiteVerifyTestSuite) TestVerifyHCaptcha() {
+	s := newSiteVerify("hcaptcha", suite.server.URL, "site-key", "secret-key", true)
+
+	passed, err := s.Verify(context.Background(), "passed", netip.MustParseAddr("192.0.2.1"))
+	suite.NoError(err)
+	suite.True(passed)
+	suite.Equal(map[string]string{
+		"secret":   "secret-key",
+		"response": "passed",
+		"remoteip": "192.0.2.1",
+		"sitekey":  "site-key",
+	}, suite.form)
+}
+
+func (suite *SiteVerifyTestSuite) TestVerifyTurnstileFailed() {
+	s := newSiteVerify("turnstile", suite.server.URL, "site-key", "secret-key", false)
+
+	passed, err := s.Verify(context.Background(), "failed", netip.Addr{})
+	suite.NoError(err)
+	suite.False(passed)
+
+	// No remote IP or site key sent.
+	suite.Equal(map[string]string{
+		"secret":   "secret-key",
+		"response": "failed",
+	}, suite.form)
+}
+
+func (suite *SiteVerifyTestSuite) TestVerifyBadSecret() {
+	s := newSiteVerify("turnstile", suite.server.URL, "site-key", "wrong-key", false)
+
+	passed, err := s.Verify(context.Background(), "passed", netip.Addr{})
+	suite.EqualError(err, "turnstile siteverify returned error code invalid-input-secret")
+	suite.False(passed)
+}
+
+func TestSiteVerifyTestSuite(t *testing.T) {
+	suite.Run(t, new(SiteVerifyTestSuite))
+}
@@ -22,12 +22,11 @@ import (
 	"errors"
 	"time"
 
-	"codeberg.org/gruf/go-runners"
-	"codeberg.org/gruf/go-sched"
 	"codeberg.org/gruf/go-store/v2/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 )
@@ -37,17 +36,19 @@ const (
 )
 
 type Cleaner struct {
-	state *state.State
-	emoji Emoji
-	media Media
+	state     *state.State
+	emoji     Emoji
+	integrity Integrity
+	media     Media
 }
 
 func New(state *state.State) *Cleaner {
 	c := new(Cleaner)
 	c.state = state
 	c.emoji.Cleaner = c
+	c.integrity.Cleaner = c
 	c.media.Cleaner = c
-	scheduleJobs(c)
+	registerJobs(c)
 	return c
 }
 
@@ -56,6 +57,11 @@ func (c *Cleaner) Emoji() *Emoji {
 	return &c.emoji
 }
 
+// Integrity returns the database integrity set of cleaner utilities.
+func (c *Cleaner) Integrity() *Integrity {
+	return &c.integrity
+}
+
 // Media returns the media set of cleaner utilities.
 func (c *Cleaner) Media() *Media {
 	return &c.media
@@ -109,31 +115,21 @@ func (c *Cleaner) removeFiles(ctx context.Context, files ...string) (int, error)
 	return diff, nil
 }
 
-func scheduleJobs(c *Cleaner) {
-	const day = time.Hour * 24
-
-	// Calculate closest midnight.
-	now := time.Now()
-	midnight := now.Round(day)
-
-	if midnight.Before(now) {
-		// since <= 11:59am rounds down.
-		midnight = midnight.Add(day)
-	}
-
-	// Get ctx associated with scheduler run state.
-	done := c.state.Workers.Scheduler.Done()
-	doneCtx := runners.CancelCtx(done)
-
-	// TODO: we'll need to do some thinking to make these
-	// jobs restartable if we want to implement reloads in
-	// the future that make call to Workers.Stop() -> Workers.Start().
-
-	// Schedule the cleaning tasks to execute every day at midnight.
-	c.state.Workers.Scheduler.Schedule(sched.NewJob(func(start time.Time) {
-		log.Info(nil, "starting media clean")
-		c.Media().All(doneCtx, config.GetMediaRemoteCacheDays())
-		c.Emoji().All(doneCtx, config.GetMediaRemoteCacheDays())
-		log.Infof(nil, "finished media clean after %s", time.Since(start))
-	}).EveryAt(midnight, day))
+// registerJobs registers the daily media and emoji
+// cleaning jobs, and the weekly account stats recount.
+func registerJobs(c *Cleaner) {
+	c.state.Jobs.Register(jobs.MediaClean, "@midnight", func(ctx context.Context, _ time.Time) error {
+		c.Media().All(ctx, config.GetMediaRemoteCacheDays())
+		return nil
+	})
+
+	c.state.Jobs.Register(jobs.EmojiClean, "@midnight", func(ctx context.Context, _ time.Time) error {
+		c.Emoji().All(ctx, config.GetMediaRemoteCacheDays())
+		return nil
+	})
+
+	c.state.Jobs.Register(jobs.AccountStatsRecount, "@weekly", func(ctx context.Context, _ time.Time) error {
+		_, _, err := c.Integrity().RecountAccountStats(ctx)
+		return err
+	})
 }
@@ -0,0 +1,312 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// Integrity encompasses a set of database
+// integrity check / repair utilities.
+type Integrity struct {
+	*Cleaner
+}
+
+// IntegrityReport contains the counts of integrity
+// violations found during an Integrity check.
+type IntegrityReport struct {
+	// Checked is the number of statuses checked.
+	Checked int
+
+	// MissingAuthor is the number of statuses whose author account is missing.
+	MissingAuthor int
+
+	// MissingBoostOf is the number of boosts whose boosted status is missing.
+	MissingBoostOf int
+
+	// MissingAttachments is the number of attachment IDs without a media attachment.
+	MissingAttachments int
+
+	// MissingMentions is the number of mention IDs without a mention.
+	MissingMentions int
+
+	// MissingTags is the number of tag IDs without a tag.
+	MissingTags int
+
+	// MissingEmojis is the number of emoji IDs without an emoji.
+	MissingEmojis int
+}
+
+// Violations returns the total number of integrity violations in the report.
+func (r *IntegrityReport) Violations() int {
+	return r.MissingAuthor +
+		r.MissingBoostOf +
+		r.MissingAttachments +
+		r.MissingMentions +
+		r.MissingTags +
+		r.MissingEmojis
+}
+
+// LogCheckStatuses performs Integrity.CheckStatuses(...), logging the start and outcome.
+func (i *Integrity) LogCheckStatuses(ctx context.Context) {
+	log.Info(ctx, "start")
+	if report, err := i.CheckStatuses(ctx); err != nil {
+		log.Error(ctx, err)
+	} else {
+		log.Infof(ctx, "checked: %d violations: %d", report.Checked, report.Violations())
+	}
+}
+
+// CheckStatuses scans all statuses in the database for references that the database
+// schema itself cannot enforce: missing author accounts, missing boosted statuses, and
+// attachment / mention / tag / emoji IDs without a corresponding row. Context will be
+// checked for `gtscontext.DryRun()` in order to actually repair violations: statuses with
+// a missing author or boosted status are deleted, and dangling IDs are dropped from the status.
+func (i *Integrity) CheckStatuses(ctx context.Context) (IntegrityReport, error) {
+	var (
+		report IntegrityReport
+		maxID  string
+	)
+
+	// Fetch statuses barebones so that broken
+	// references don't prevent us loading them.
+	bareCtx := gtscontext.SetBarebones(ctx)
+
+	for {
+		// Fetch the next batch of statuses from database.
+		statuses, err := i.state.DB.GetStatuses(bareCtx, maxID, selectLimit)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return report, gtserror.Newf("error getting statuses: %w", err)
+		}
+
+		if len(statuses) == 0 {
+			// reached end.
+			break
+		}
+
+		// Use last ID as the next 'maxID' value.
+		maxID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			// Check this status for integrity violations.
+			if err := i.checkStatus(ctx, status, &report); err != nil {
+				return report, err
+			}
+		}
+	}
+
+	return report, nil
+}
+
+func (i *Integrity) checkStatus(ctx context.Context, status *gtsmodel.Status, report *IntegrityReport) error {
+	bareCtx := gtscontext.SetBarebones(ctx)
+	report.Checked++
+
+	// Check the status author exists.
+	missing, err := isMissing(bareCtx, status.AccountID, i.state.DB.GetAccountByID)
+	if err != nil {
+		return gtserror.Newf("error checking author of status %s: %w", status.ID, err)
+	} else if missing {
+		log.Warnf(ctx, "status %s: missing author account %s", status.ID, status.AccountID)
+		report.MissingAuthor++
+		return i.deleteStatus(ctx, status)
+	}
+
+	if status.BoostOfID != "" {
+		// Check the boosted status exists.
+		missing, err := isMissing(bareCtx, status.BoostOfID, i.state.DB.GetStatusByID)
+		if err != nil {
+			return gtserror.Newf("error checking boost of status %s: %w", status.ID, err)
+		} else if missing {
+			log.Warnf(ctx, "status %s: missing boosted status %s", status.ID, status.BoostOfID)
+			report.MissingBoostOf++
+			return i.deleteStatus(ctx, status)
+		}
+	}
+
+	// Populate the status sub-models. Any errors here
+	// are checked below for each type of sub-model,
+	// so that we can tell missing rows from db errors.
+	if err := i.state.DB.PopulateStatus(bareCtx, status); err != nil {
+		log.Debugf(ctx, "error populating status %s: %v", status.ID, err)
+	}
+
+	var columns []string
+
+	if !status.AttachmentsPopulated() {
+		ids, n, err := dropMissing(bareCtx, status.AttachmentIDs, i.state.DB.GetAttachmentByID)
+		if err != nil {
+			return gtserror.Newf("error checking attachments of status %s: %w", status.ID, err)
+		} else if n > 0 {
+			log.Warnf(ctx, "status %s: %d missing attachment(s)", status.ID, n)
+			report.MissingAttachments += n
+			status.AttachmentIDs = ids
+			columns = append(columns, "attachments")
+		}
+	}
+
+	if !status.MentionsPopulated() {
+		ids, n, err := dropMissing(bareCtx, status.MentionIDs, i.state.DB.GetMention)
+		if err != nil {
+			return gtserror.Newf("error checking mentions of status %s: %w", status.ID, err)
+		} else if n > 0 {
+			log.Warnf(ctx, "status %s: %d missing mention(s)", status.ID, n)
+			report.MissingMentions += n
+			status.MentionIDs = ids
+			columns = append(columns, "mentions")
+		}
+	}
+
+	if !status.TagsPopulated() {
+		ids, n, err := dropMissing(bareCtx, status.TagIDs, i.state.DB.GetTag)
+		if err != nil {
+			return gtserror.Newf("error checking tags of status %s: %w", status.ID, err)
+		} else if n > 0 {
+			log.Warnf(ctx, "status %s: %d missing tag(s)", status.ID, n)
+			report.MissingTags += n
+			status.TagIDs = ids
+			columns = append(columns, "tags")
+		}
+	}
+
+	if !status.EmojisPopulated() {
+		ids, n, err := dropMissing(bareCtx, status.EmojiIDs, i.state.DB.GetEmojiByID)
+		if err != nil {
+			return gtserror.Newf("error checking emojis of status %s: %w", status.ID, err)
+		} else if n > 0 {
+			log.Warnf(ctx, "status %s: %d missing emoji(s)", status.ID, n)
+			report.MissingEmojis += n
+			status.EmojiIDs = ids
+			columns = append(columns, "emojis")
+		}
+	}
+
+	if len(columns) == 0 || gtscontext.DryRun(ctx) {
+		// Nothing to repair,
+		// or this is a dry run.
+		return nil
+	}
+
+	// Update the status with dangling IDs dropped.
+	if err := i.state.DB.UpdateStatus(ctx, status, columns...); err != nil {
+		return gtserror.Newf("error updating status %s: %w", status.ID, err)
+	}
+
+	return nil
+}
+
+// deleteStatus deletes the given status, unless this is a dry run.
+func (i *Integrity) deleteStatus(ctx context.Context, status *gtsmodel.Status) error {
+	if gtscontext.DryRun(ctx) {
+		// Dry run, do nothing.
+		return nil
+	}
+
+	if err := i.state.DB.DeleteStatusByID(ctx, status.ID); err != nil {
+		return gtserror.Newf("error deleting status %s: %w", status.ID, err)
+	}
+
+	return nil
+}
+
+// isMissing returns whether the model with given ID is missing from the database,
+// i.e. get returns db.ErrNoEntries. Any other error is returned to the caller.
+func isMissing[T any](ctx context.Context, id string, get func(context.Context, string) (T, error)) (bool, error) {
+	_, err := get(ctx, id)
+	if errors.Is(err, db.ErrNoEntries) {
+		return true, nil
+	}
+	return false, err
+}
+
+// dropMissing returns the given IDs with those missing from the database removed, and the number removed.
+func dropMissing[T any](ctx context.Context, ids []string, get func(context.Context, string) (T, error)) ([]string, int, error) {
+	kept := make([]string, 0, len(ids))
+	for _, id := range ids {
+		missing, err := isMissing(ctx, id, get)
+		if err != nil {
+			return nil, 0, err
+		} else if !missing {
+			kept = append(kept, id)
+		}
+	}
+	return kept, len(ids) - len(kept), nil
+}
+
+// RecountAccountStats recounts the stored follower, following and status counts
+// of every account that has them from scratch, in case they've drifted from the
+// truth, eg., after a crash. Returns the number of accounts recounted, and how
+// many of those had stored counts which were wrong.
+func (i *Integrity) RecountAccountStats(ctx context.Context) (int, int, error) {
+	var (
+		recounted int
+		fixed     int
+		maxID     string
+	)
+
+	for {
+		// Fetch the next batch of account IDs from database.
+		accountIDs, err := i.state.DB.GetAccountStatsIDs(ctx, maxID, selectLimit)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return recounted, fixed, gtserror.Newf("error getting account stats ids: %w", err)
+		}
+
+		if len(accountIDs) == 0 {
+			// reached end.
+			break
+		}
+
+		// Use last ID as the next 'maxID' value.
+		maxID = accountIDs[len(accountIDs)-1]
+
+		for _, accountID := range accountIDs {
+			old, err := i.state.DB.GetAccountStats(ctx, accountID)
+			if err != nil {
+				return recounted, fixed, gtserror.Newf("error getting stats of account %s: %w", accountID, err)
+			}
+
+			stats, err := i.state.DB.RegenerateAccountStats(ctx, accountID)
+			if err != nil {
+				return recounted, fixed, gtserror.Newf("error recounting stats of account %s: %w", accountID, err)
+			}
+
+			recounted++
+
+			if old.FollowersCount != stats.FollowersCount ||
+				old.FollowingCount != stats.FollowingCount ||
+				old.StatusesCount != stats.StatusesCount {
+				log.Warnf(ctx, "account %s: fixed stats (followers %d->%d, following %d->%d, statuses %d->%d)",
+					accountID,
+					old.FollowersCount, stats.FollowersCount,
+					old.FollowingCount, stats.FollowingCount,
+					old.StatusesCount, stats.StatusesCount,
+				)
+				fixed++
+			}
+		}
+	}
+
+	return recounted, fixed, nil
+}
@@ -0,0 +1,148 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cleaner_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+func (suite *CleanerTestSuite) TestIntegrityCheckStatusesClean() {
+	ctx := context.Background()
+
+	report, err := suite.cleaner.Integrity().CheckStatuses(ctx)
+	suite.NoError(err)
+	suite.NotZero(report.Checked)
+	suite.Zero(report.Violations())
+}
+
+func (suite *CleanerTestSuite) TestIntegrityCheckStatuses() {
+	ctx := context.Background()
+	suite.putBrokenStatuses(ctx)
+
+	report, err := suite.cleaner.Integrity().CheckStatuses(ctx)
+	suite.NoError(err)
+	suite.Equal(1, report.MissingAuthor)
+	suite.Equal(1, report.MissingBoostOf)
+	suite.Equal(1, report.MissingAttachments)
+	suite.Equal(1, report.MissingMentions)
+	suite.Equal(1, report.MissingTags)
+	suite.Equal(1, report.MissingEmojis)
+	suite.Equal(6, report.Violations())
+
+	// Statuses with missing author / boost target should be gone.
+	for _, id := range []string{"01HBBWR2N8XKQ1T0W6B6RSJ1YN", "01HBBWR2N8XKQ1T0W6B6RSJ1YP"} {
+		_, err := suite.state.DB.GetStatusByID(ctx, id)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+
+	// Dangling IDs should have been dropped, leaving the valid ones.
+	status, err := suite.state.DB.GetStatusByID(ctx, "01HBBWR2N8XKQ1T0W6B6RSJ1YQ")
+	suite.NoError(err)
+	suite.Equal([]string{"01F8MH6NEM8D7527KZAECTCR76"}, status.AttachmentIDs)
+	suite.Empty(status.MentionIDs)
+	suite.Empty(status.TagIDs)
+	suite.Empty(status.EmojiIDs)
+
+	// A second check should find nothing left to repair.
+	report, err = suite.cleaner.Integrity().CheckStatuses(ctx)
+	suite.NoError(err)
+	suite.Zero(report.Violations())
+}
+
+func (suite *CleanerTestSuite) TestIntegrityCheckStatusesDryRun() {
+	ctx := context.Background()
+	suite.putBrokenStatuses(ctx)
+
+	report, err := suite.cleaner.Integrity().CheckStatuses(gtscontext.SetDryRun(ctx))
+	suite.NoError(err)
+	suite.Equal(6, report.Violations())
+
+	// Nothing should have been changed.
+	bareCtx := gtscontext.SetBarebones(ctx)
+	for _, id := range []string{"01HBBWR2N8XKQ1T0W6B6RSJ1YN", "01HBBWR2N8XKQ1T0W6B6RSJ1YP"} {
+		_, err := suite.state.DB.GetStatusByID(bareCtx, id)
+		suite.NoError(err)
+	}
+
+	status, err := suite.state.DB.GetStatusByID(bareCtx, "01HBBWR2N8XKQ1T0W6B6RSJ1YQ")
+	suite.NoError(err)
+	suite.Len(status.AttachmentIDs, 2)
+	suite.Len(status.MentionIDs, 1)
+	suite.Len(status.TagIDs, 1)
+	suite.Len(status.EmojiIDs, 1)
+
+	// And checking again should give the same result.
+	report, err = suite.cleaner.Integrity().CheckStatuses(gtscontext.SetDryRun(ctx))
+	suite.NoError(err)
+	suite.Equal(6, report.Violations())
+}
+
+// putBrokenStatuses stores statuses with one of each kind of integrity violation.
+func (suite *CleanerTestSuite) putBrokenStatuses(ctx context.Context) {
+	statuses := testrig.NewTestStatuses()
+	attachments := testrig.NewTestAttachments()
+
+	// Status whose author account doesn't exist.
+	noAuthor := new(gtsmodel.Status)
+	*noAuthor = *statuses["local_account_1_status_1"]
+	noAuthor.ID = "01HBBWR2N8XKQ1T0W6B6RSJ1YN"
+	noAuthor.URI = "http://localhost:8080/users/ghost/statuses/" + noAuthor.ID
+	noAuthor.URL = ""
+	noAuthor.AccountID = "01HBBWSJ2WB7J9E4Y3HNM8N1QS"
+	noAuthor.Account = nil
+	noAuthor.AttachmentIDs = nil
+	noAuthor.MentionIDs = nil
+	noAuthor.TagIDs = nil
+	noAuthor.EmojiIDs = nil
+
+	// Boost of a status that doesn't exist.
+	noBoostOf := new(gtsmodel.Status)
+	*noBoostOf = *statuses["admin_account_status_1"]
+	noBoostOf.ID = "01HBBWR2N8XKQ1T0W6B6RSJ1YP"
+	noBoostOf.URI = "http://localhost:8080/users/admin/statuses/" + noBoostOf.ID
+	noBoostOf.URL = ""
+	noBoostOf.BoostOfID = "01HBBWT8PSN0VC1BW8HC0GWMB4"
+	noBoostOf.BoostOfAccountID = statuses["admin_account_status_1"].AccountID
+	noBoostOf.AttachmentIDs = nil
+	noBoostOf.MentionIDs = nil
+	noBoostOf.TagIDs = nil
+	noBoostOf.EmojiIDs = nil
+
+	// Status with one dangling ID of each sub-model type.
+	dangling := new(gtsmodel.Status)
+	*dangling = *statuses["admin_account_status_1"]
+	dangling.ID = "01HBBWR2N8XKQ1T0W6B6RSJ1YQ"
+	dangling.URI = "http://localhost:8080/users/admin/statuses/" + dangling.ID
+	dangling.URL = ""
+	dangling.AttachmentIDs = []string{attachments["admin_account_status_1_attachment_1"].ID, "01HBBWVBNC1Y4KVVP0YMEAFPPN"}
+	dangling.MentionIDs = []string{"01HBBWVQ0Y7HDEZJXYH9P3Q4GV"}
+	dangling.TagIDs = []string{"01HBBWW2XS5YH3M1NK6R3KEFJG"}
+	dangling.EmojiIDs = []string{"01HBBWWD2Q8Q7RZ7N9X6QKBNCA"}
+
+	for _, status := range []*gtsmodel.Status{noAuthor, noBoostOf, dangling} {
+		if err := suite.state.DB.PutStatus(ctx, status); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+			suite.FailNow(err.Error())
+		}
+	}
+}
@@ -209,6 +209,26 @@ func (m *Media) UncacheRemote(ctx context.Context, olderThan time.Time) (int, er
 	return total, nil
 }
 
+// UncacheAccount will uncache all cached remote media attachments (including avatar
+// and header) belonging to the given account, regardless of age. Uncached media will
+// be recached as normal the next time it's needed.
+// Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
+func (m *Media) UncacheAccount(ctx context.Context, accountID string) (int, error) {
+	attachments, err := m.state.DB.GetCachedAttachmentsByAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return 0, gtserror.Newf("error getting account attachments: %w", err)
+	}
+
+	for i, media := range attachments {
+		log.Debugf(ctx, "uncaching account media: %s", media.ID)
+		if err := m.uncache(ctx, media); err != nil {
+			return i, err
+		}
+	}
+
+	return len(attachments), nil
+}
+
 // FixCacheStatus will check all media for up-to-date cache status (i.e. in storage driver).
 // Media marked as cached, with any required files missing, will be automatically uncached.
 // Context will be checked for `gtscontext.DryRun()` in order to actually perform the action.
@@ -342,6 +362,17 @@ func (m *Media) pruneUnused(ctx context.Context, media *gtsmodel.MediaAttachment
 		}
 	}
 
+	if account != nil && media.StatusID == "" {
+		// Check whether media is attached to any of the account's drafts.
+		inDraft, err := m.isInDraft(ctx, account.ID, media.ID)
+		if err != nil {
+			return false, err
+		} else if inDraft {
+			l.Debug("skipping as attached to draft")
+			return false, nil
+		}
+	}
+
 	// Check whether we have the required status for media.
 	status, missing, err := m.getRelatedStatus(ctx, media)
 	if err != nil {
@@ -494,6 +525,23 @@ func (m *Media) getOwningAccount(ctx context.Context, media *gtsmodel.MediaAttac
 	return account, false, nil
 }
 
+func (m *Media) isInDraft(ctx context.Context, accountID string, mediaID string) (bool, error) {
+	drafts, err := m.state.DB.GetDraftsForAccountID(gtscontext.SetBarebones(ctx), accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error fetching drafts for account %s: %w", accountID, err)
+	}
+
+	for _, draft := range drafts {
+		for _, id := range draft.AttachmentIDs {
+			if id == mediaID {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
 func (m *Media) getRelatedStatus(ctx context.Context, media *gtsmodel.MediaAttachment) (*gtsmodel.Status, bool, error) {
 	if media.StatusID == "" {
 		// no related status.
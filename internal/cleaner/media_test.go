@@ -338,6 +338,29 @@ func (suite *MediaTestSuite) TestUncacheRemoteDry() {
 	suite.True(*uncachedAttachment.Cached)
 }
 
+func (suite *MediaTestSuite) TestUncacheAccount() {
+	ctx := context.Background()
+
+	testStatusAttachment := suite.testAttachments["remote_account_1_status_1_attachment_1"]
+	suite.True(*testStatusAttachment.Cached)
+
+	testHeader := suite.testAttachments["remote_account_3_header"]
+	suite.True(*testHeader.Cached)
+
+	totalUncached, err := suite.cleaner.Media().UncacheAccount(ctx, testHeader.AccountID)
+	suite.NoError(err)
+	suite.Equal(1, totalUncached)
+
+	uncachedAttachment, err := suite.db.GetAttachmentByID(ctx, testHeader.ID)
+	suite.NoError(err)
+	suite.False(*uncachedAttachment.Cached)
+
+	// Other accounts' media is left alone.
+	cachedAttachment, err := suite.db.GetAttachmentByID(ctx, testStatusAttachment.ID)
+	suite.NoError(err)
+	suite.True(*cachedAttachment.Cached)
+}
+
 func (suite *MediaTestSuite) TestUncacheRemoteTwice() {
 	ctx := context.Background()
 	after := time.Now().Add(-24 * time.Hour)
@@ -45,6 +45,8 @@ func fieldtag(field, tag string) string {
 // `go run ./internal/config/gen/ -out ./internal/config/helpers.gen.go`
 type Configuration struct {
 	LogLevel           string   `name:"log-level" usage:"Log level to run at: [trace, debug, info, warn, fatal]"`
+	LogLevels          []string `name:"log-levels" usage:"Per-subsystem log level overrides, in the form subsystem=level, eg., federation/dereferencing=debug"`
+	LogFormat          string   `name:"log-format" usage:"Log output format: [logfmt, json]"`
 	LogTimestampFormat string   `name:"log-timestamp-format" usage:"Format to use for the log timestamp, as supported by Go's time.Layout"`
 	LogDbQueries       bool     `name:"log-db-queries" usage:"Log database queries verbosely when log-level is trace or debug"`
 	LogClientIP        bool     `name:"log-client-ip" usage:"Include the client IP in logs"`
@@ -53,6 +55,7 @@ type Configuration struct {
 	ConfigPath         string   `name:"config-path" usage:"Path to a file containing gotosocial configuration. Values set in this file will be overwritten by values set as env vars or arguments"`
 	Host               string   `name:"host" usage:"Hostname to use for the server (eg., example.org, gotosocial.whatever.com). DO NOT change this on a server that's already run!"`
 	AccountDomain      string   `name:"account-domain" usage:"Domain to use in account names (eg., example.org, whatever.com). If not set, will default to the setting for host. DO NOT change this on a server that's already run!"`
+	OnionHost          string   `name:"onion-host" usage:"Tor onion service address this instance is also served on (eg., abcdefghijklmnop.onion). Requests to this host are treated as requests to the instance itself."`
 	Protocol           string   `name:"protocol" usage:"Protocol to use for the REST api of the server (only use http if you are debugging or behind a reverse proxy!)"`
 	BindAddress        string   `name:"bind-address" usage:"Bind address to use for the GoToSocial server (eg., 0.0.0.0, 172.138.0.9, [::], localhost). For ipv6, enclose the address in square brackets, eg [2001:db8::fed1]. Default binds to all interfaces."`
 	Port               int      `name:"port" usage:"Port to use for GoToSocial. Change this to 443 if you're running the binary directly on the host machine."`
@@ -72,17 +75,41 @@ type Configuration struct {
 	DbSqliteSynchronous      string        `name:"db-sqlite-synchronous" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_synchronous"`
 	DbSqliteCacheSize        bytesize.Size `name:"db-sqlite-cache-size" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_cache_size"`
 	DbSqliteBusyTimeout      time.Duration `name:"db-sqlite-busy-timeout" usage:"Sqlite only: see https://www.sqlite.org/pragma.html#pragma_busy_timeout"`
+	DbPostgresCacheNotify    bool          `name:"db-postgres-cache-notify" usage:"Postgres only: propagate cache invalidations between GoToSocial processes sharing the database using LISTEN / NOTIFY."`
+	DbPostgresStreamNotify   bool          `name:"db-postgres-stream-notify" usage:"Postgres only: pass streaming API events between GoToSocial processes sharing the database using LISTEN / NOTIFY."`
+	DbPostgresAdvisoryLocks  bool          `name:"db-postgres-advisory-locks" usage:"Postgres only: use advisory locks to avoid GoToSocial processes sharing the database dereferencing the same remote account or status at once."`
 
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
+	WebThreadPageSize  int    `name:"web-thread-page-size" usage:"Amount of replies to show per page in the web view of a thread. Earlier posts of longer threads are collapsed too."`
+	WebThemeColor      string `name:"web-theme-color" usage:"CSS color used by browsers to tint the UI around the web frontend, and by the installable web app."`
+	WebBackgroundColor string `name:"web-background-color" usage:"CSS color used as background of the installable web app while it's loading."`
 
-	InstanceFederationMode         string `name:"instance-federation-mode" usage:"Set instance federation mode."`
-	InstanceExposePeers            bool   `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeSuspended        bool   `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
-	InstanceExposeSuspendedWeb     bool   `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
-	InstanceExposePublicTimeline   bool   `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceDeliverToSharedInboxes bool   `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
-	InstanceInjectMastodonVersion  bool   `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
+	WebLinkInterstitial               bool     `name:"web-link-interstitial" usage:"Send visitors clicking external links in statuses and profiles on the web view through a page showing the real destination domain."`
+	WebLinkInterstitialTrustedDomains []string `name:"web-link-interstitial-trusted-domains" usage:"Domains (including their subdomains) that links lead to directly, without the interstitial page."`
+
+	InstanceFederationMode         string        `name:"instance-federation-mode" usage:"Set instance federation mode."`
+	InstanceExposePeers            bool          `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstanceExposeSuspended        bool          `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
+	InstanceExposeSuspendedWeb     bool          `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
+	InstanceExposePublicTimeline   bool          `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceDeliverToSharedInboxes bool          `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceInjectMastodonVersion  bool          `name:"instance-inject-mastodon-version" usage:"This injects a Mastodon compatible version in /api/v1/instance to help Mastodon clients that use that version for feature detection"`
+	InstanceExposeLocalTimelineRSS bool          `name:"instance-expose-local-timeline-rss" usage:"Expose RSS and Atom feeds of public posts by local accounts at /timelines/local/feed.rss and /timelines/local/feed.atom"`
+	InstanceExposeTagRSS           bool          `name:"instance-expose-tag-rss" usage:"Expose RSS and Atom feeds of public posts using a hashtag at /tags/:tag_name/feed.rss and /tags/:tag_name/feed.atom"`
+	InstanceNoIndexDefault         bool          `name:"instance-noindex-default" usage:"Ask search engines not to index the profile and status web pages of local accounts which haven't chosen for themselves."`
+	InstanceEmojiReactions         bool          `name:"instance-emoji-reactions" usage:"Enable emoji reactions on statuses, as used by Pleroma, Akkoma, Misskey and similar software"`
+	InstanceInteractionCircle      bool          `name:"instance-interaction-circle" usage:"Allow users to query /api/v1/accounts/interaction_circle for the accounts which most interacted with their own statuses"`
+	InstanceLanguages              []string      `name:"instance-languages" usage:"BCP 47 language tags of the main languages of this instance, most preferred first. Web pages and emails fall back to these languages when no translation is available in the language of the reader."`
+	InstanceCategories             []string      `name:"instance-categories" usage:"Topics or categories that best describe this instance, eg., 'tech' or 'art', most relevant first. Shown to apps that help people choose an instance."`
+	InstanceNewDomainQuarantine    bool          `name:"instance-new-domain-quarantine" usage:"Hold statuses from domains that deliver to this instance for the first time in the quarantine queue, until a moderator approves the domain."`
+	InstanceNewDomainAutoApprove   int           `name:"instance-new-domain-auto-approve" usage:"Automatically approve a quarantined new domain once this many of its statuses have passed the spam filter. 0 means only approve domains manually."`
+	InstanceDeadDeliveryFailures   int           `name:"instance-dead-delivery-failures" usage:"Suspend deliveries to a remote domain after this many consecutive delivery failures, spread over at least instance-dead-delivery-window. Deliveries resume once the domain contacts this instance again. 0 means never suspend deliveries."`
+	InstanceDeadDeliveryWindow     time.Duration `name:"instance-dead-delivery-window" usage:"Minimum time between the first and last of the consecutive delivery failures that mark a remote domain as dead."`
+	InstanceOptimisticIngestion    bool          `name:"instance-optimistic-ingestion" usage:"Store and show newly seen remote statuses straight away, fetching their mentioned accounts, attachments and emojis from remote servers in the background."`
+	InstanceRemoteAccountFreshness time.Duration `name:"instance-remote-account-freshness" usage:"How long after fetching a remote account it's considered up to date. Stale accounts are fetched again when next used, and stale accounts followed by local accounts are refreshed in the background."`
+	InstanceRemoteStatusFreshness  time.Duration `name:"instance-remote-status-freshness" usage:"How long after fetching a remote status it's considered up to date. Stale statuses are fetched again when next used, and recent stale statuses by accounts followed by local accounts are refreshed in the background."`
+	InstanceRemoteRefreshPerDomain int           `name:"instance-remote-refresh-per-domain" usage:"Maximum number of stale remote accounts and statuses of one domain refreshed in the background at once."`
 
 	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
 	AccountsApprovalRequired bool `name:"accounts-approval-required" usage:"Do account signups require approval by an admin or moderator before user can log in? If false, new registrations will be automatically approved."`
@@ -90,13 +117,34 @@ type Configuration struct {
 	AccountsAllowCustomCSS   bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
 	AccountsCustomCSSLength  int  `name:"accounts-custom-css-length" usage:"Maximum permitted length (characters) of custom CSS for accounts."`
 
+	AccountsActivityLimitWindow                  time.Duration `name:"accounts-activity-limit-window" usage:"Window of time over which follows and direct messages sent by each local account are counted, to detect bursts of activity."`
+	AccountsActivityLimitDuration                time.Duration `name:"accounts-activity-limit-duration" usage:"How long a local account is limited for after going over one of its activity limits."`
+	AccountsActivityLimitUserFollows             int           `name:"accounts-activity-limit-user-follows" usage:"Number of follows that a user can send within the activity limit window. 0 means no limit."`
+	AccountsActivityLimitUserDirectMessages      int           `name:"accounts-activity-limit-user-direct-messages" usage:"Number of direct messages that a user can send within the activity limit window. 0 means no limit."`
+	AccountsActivityLimitModeratorFollows        int           `name:"accounts-activity-limit-moderator-follows" usage:"Number of follows that a moderator can send within the activity limit window. 0 means no limit."`
+	AccountsActivityLimitModeratorDirectMessages int           `name:"accounts-activity-limit-moderator-direct-messages" usage:"Number of direct messages that a moderator can send within the activity limit window. 0 means no limit."`
+	AccountsActivityLimitAdminFollows            int           `name:"accounts-activity-limit-admin-follows" usage:"Number of follows that an admin can send within the activity limit window. 0 means no limit."`
+	AccountsActivityLimitAdminDirectMessages     int           `name:"accounts-activity-limit-admin-direct-messages" usage:"Number of direct messages that an admin can send within the activity limit window. 0 means no limit."`
+	AccountsInteractionCooldown                  time.Duration `name:"accounts-interaction-cooldown" usage:"Minimum time between toggling a boost of the same status, or a follow of the same account, on and off again. 0 means no cooldown."`
+	AccountsRefreshCooldown                      time.Duration `name:"accounts-refresh-cooldown" usage:"Minimum time between forced refreshes of remote accounts requested by the same user. 0 means no cooldown."`
+	AccountsChallengeProvider                    string        `name:"accounts-challenge-provider" usage:"Challenge that new sign-ups must pass to reduce bot sign-ups: 'hcaptcha', 'turnstile', or empty for none."`
+	AccountsChallengeSiteKey                     string        `name:"accounts-challenge-site-key" usage:"Public site key given by the challenge provider, used by clients to render the challenge widget."`
+	AccountsChallengeSecretKey                   string        `name:"accounts-challenge-secret-key" usage:"Secret key given by the challenge provider, used to check challenge responses."`
+	AccountsChallengeAppTokens                   bool          `name:"accounts-challenge-app-tokens" usage:"Also require the challenge to be passed when apps request a client credentials token, which is needed to sign up through the API."`
+
 	MediaImageMaxSize        bytesize.Size `name:"media-image-max-size" usage:"Max size of accepted images in bytes"`
 	MediaVideoMaxSize        bytesize.Size `name:"media-video-max-size" usage:"Max size of accepted videos in bytes"`
+	MediaImageMaxDimension   int           `name:"media-image-max-dimension" usage:"Max width or height in pixels of stored images. Larger images are downscaled to fit. If set to 0, images are never downscaled."`
+	MediaImageMaxPixels      int           `name:"media-image-max-pixels" usage:"Max number of pixels (width * height) of images that will be decoded. Larger images are rejected, to protect against decompression bombs. If set to 0, there is no limit."`
 	MediaDescriptionMinChars int           `name:"media-description-min-chars" usage:"Min required chars for an image description"`
 	MediaDescriptionMaxChars int           `name:"media-description-max-chars" usage:"Max permitted chars for an image description"`
 	MediaRemoteCacheDays     int           `name:"media-remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
 	MediaEmojiLocalMaxSize   bytesize.Size `name:"media-emoji-local-max-size" usage:"Max size in bytes of emojis uploaded to this instance via the admin API."`
 	MediaEmojiRemoteMaxSize  bytesize.Size `name:"media-emoji-remote-max-size" usage:"Max size in bytes of emojis to download from other instances."`
+	MediaLocalQuota          bytesize.Size `name:"media-local-quota" usage:"Max total size in bytes of media attachments each local account may store. If set to 0, there is no limit."`
+	MediaScanClamAVAddress   string        `name:"media-scan-clamav-address" usage:"Address of a clamd daemon to scan media attachments with, eg tcp://127.0.0.1:3310 or unix:///run/clamav/clamd.ctl. If empty, clamd scanning is disabled."`
+	MediaScanCommand         string        `name:"media-scan-command" usage:"Command to scan media attachments with, which receives the file on stdin and exits 0 if clean or 1 if infected. Only used if media-scan-clamav-address is empty."`
+	MediaScanTimeout         time.Duration `name:"media-scan-timeout" usage:"Timeout for scanning a single media attachment."`
 
 	StorageBackend       string `name:"storage-backend" usage:"Storage backend to use for media attachments"`
 	StorageLocalBasePath string `name:"storage-local-base-path" usage:"Full path to an already-created directory where gts should store/retrieve media files. Subfolders will be created within this dir."`
@@ -112,11 +160,22 @@ type Configuration struct {
 	StatusesPollMaxOptions     int `name:"statuses-poll-max-options" usage:"Max amount of options permitted on a poll"`
 	StatusesPollOptionMaxChars int `name:"statuses-poll-option-max-chars" usage:"Max amount of characters for a poll option"`
 	StatusesMediaMaxFiles      int `name:"statuses-media-max-files" usage:"Maximum number of media files/attachments per status"`
+	StatusesMaxPinned          int `name:"statuses-max-pinned" usage:"Maximum number of statuses an account can pin to their profile"`
+
+	StatusesSpamFilterEnabled         bool `name:"statuses-spam-filter-enabled" usage:"EXPERIMENTAL: Score statuses coming in over federation from accounts that nobody on this instance follows, and hold or drop those that look like spam."`
+	StatusesSpamFilterQuarantineScore int  `name:"statuses-spam-filter-quarantine-score" usage:"Spam score at or above which an incoming status is held in a queue for review by a moderator. 0 means never hold."`
+	StatusesSpamFilterDropScore       int  `name:"statuses-spam-filter-drop-score" usage:"Spam score at or above which an incoming status is dropped. 0 means never drop."`
+
+	StatusesTranslationBackend string `name:"statuses-translation-backend" usage:"Backend used to translate statuses when users ask for it: 'libretranslate', 'deepl', or empty to disable translation."`
+	StatusesTranslationURL     string `name:"statuses-translation-url" usage:"Base URL of the translation backend's API. Must be set for libretranslate. For deepl, defaults to the free or pro API depending on the API key."`
+	StatusesTranslationAPIKey  string `name:"statuses-translation-api-key" usage:"API key to authenticate with the translation backend."`
 
 	LetsEncryptEnabled      bool   `name:"letsencrypt-enabled" usage:"Enable letsencrypt TLS certs for this server. If set to true, then cert dir also needs to be set (or take the default)."`
 	LetsEncryptPort         int    `name:"letsencrypt-port" usage:"Port to listen on for letsencrypt certificate challenges. Must not be the same as the GtS webserver/API port."`
 	LetsEncryptCertDir      string `name:"letsencrypt-cert-dir" usage:"Directory to store acquired letsencrypt certificates."`
 	LetsEncryptEmailAddress string `name:"letsencrypt-email-address" usage:"Email address to use when requesting letsencrypt certs. Will receive updates on cert expiry etc."`
+	LetsEncryptChallenge    string `name:"letsencrypt-challenge" usage:"ACME challenge type to use when requesting letsencrypt certs: 'http-01' or 'dns-01'."`
+	LetsEncryptDNSCommand   string `name:"letsencrypt-dns-command" usage:"Path to an executable used to create and remove DNS TXT records for dns-01 challenges. Called as '<command> present|cleanup <fqdn> <value>'."`
 
 	TLSCertificateChain string `name:"tls-certificate-chain" usage:"Filesystem path to the certificate chain including any intermediate CAs and the TLS public key"`
 	TLSCertificateKey   string `name:"tls-certificate-key" usage:"Filesystem path to the TLS private key"`
@@ -136,17 +195,33 @@ type Configuration struct {
 	TracingEndpoint          string `name:"tracing-endpoint" usage:"Endpoint of your trace collector. Eg., 'localhost:4317' for gRPC, 'localhost:4318' for http"`
 	TracingInsecureTransport bool   `name:"tracing-insecure-transport" usage:"Disable TLS for the gRPC or HTTP transport protocol"`
 
+	PprofEnabled     bool   `name:"pprof-enabled" usage:"Serve net/http/pprof profiles and expvar runtime stats to admins at /api/v1/admin/debug/pprof/ and /api/v1/admin/debug/vars"`
+	PprofBindAddress string `name:"pprof-bind-address" usage:"If set (and pprof-enabled is true), also serve pprof profiles and expvar runtime stats WITHOUT authentication at /debug/pprof/ and /debug/vars on this address. Eg., 'localhost:6060'"`
+
 	SMTPHost               string `name:"smtp-host" usage:"Host of the smtp server. Eg., 'smtp.eu.mailgun.org'"`
 	SMTPPort               int    `name:"smtp-port" usage:"Port of the smtp server. Eg., 587"`
 	SMTPUsername           string `name:"smtp-username" usage:"Username to authenticate with the smtp server as. Eg., 'postmaster@mail.example.org'"`
 	SMTPPassword           string `name:"smtp-password" usage:"Password to pass to the smtp server."`
 	SMTPFrom               string `name:"smtp-from" usage:"Address to use as the 'from' field of the email. Eg., 'gotosocial@example.org'"`
 	SMTPDiscloseRecipients bool   `name:"smtp-disclose-recipients" usage:"If true, email notifications sent to multiple recipients will be To'd to every recipient at once. If false, recipients will not be disclosed"`
+	SMTPTransport          string `name:"smtp-transport" usage:"How to deliver emails: 'smtp' to send them to smtp-host, or 'http' to POST them to smtp-http-url"`
+	SMTPHTTPURL            string `name:"smtp-http-url" usage:"URL of an HTTP mail API to POST emails to when smtp-transport is 'http'. Eg., 'https://relay.example.org/send'"`
+	SMTPHTTPToken          string `name:"smtp-http-token" usage:"Bearer token to send in the Authorization header of requests to smtp-http-url."`
+	SMTPDKIMDomain         string `name:"smtp-dkim-domain" usage:"Domain to sign outgoing emails for with DKIM. Eg., 'example.org'. If not set, emails will not be DKIM signed"`
+	SMTPDKIMSelector       string `name:"smtp-dkim-selector" usage:"DKIM selector under which the public key is published in DNS. Eg., 'gotosocial'"`
+	SMTPDKIMPrivateKeyPath string `name:"smtp-dkim-private-key-path" usage:"Path to a PEM-encoded RSA or Ed25519 private key to DKIM sign emails with."`
 
 	SyslogEnabled  bool   `name:"syslog-enabled" usage:"Enable the syslog logging hook. Logs will be mirrored to the configured destination."`
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
+	RedisURL         string   `name:"redis-url" usage:"URL of a redis server to use, eg., 'redis://localhost:6379/0'. Leave empty to not use redis."`
+	RedisCacheNotify bool     `name:"redis-cache-notify" usage:"Propagate cache invalidations between GoToSocial processes sharing the redis server using pub/sub."`
+	RedisQueues      []string `name:"redis-queues" usage:"Worker queues to hold in redis instead of memory, so they survive restarts. Options: client-api, fedi-api."`
+
+	JobsSchedules  []string `name:"jobs-schedules" usage:"Override the default schedules of background jobs, as name=schedule pairs, eg., 'media-clean=0 3 * * *'."`
+	JobsMaxRetries int      `name:"jobs-max-retries" usage:"Number of times to retry a failed run of a background job, waiting 1 minute before the first retry and doubling the wait for each further retry."`
+
 	AdvancedCookiesSamesite      string        `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
 	AdvancedRateLimitRequests    int           `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
 	AdvancedRateLimitExceptions  []string      `name:"advanced-rate-limit-exceptions" usage:"Slice of CIDRs to exclude from rate limit restrictions."`
@@ -154,6 +229,10 @@ type Configuration struct {
 	AdvancedThrottlingRetryAfter time.Duration `name:"advanced-throttling-retry-after" usage:"Retry-After duration response to send for throttled requests."`
 	AdvancedSenderMultiplier     int           `name:"advanced-sender-multiplier" usage:"Multiplier to use per cpu for batching outgoing fedi messages. 0 or less turns batching off (not recommended)."`
 	AdvancedCSPExtraURIs         []string      `name:"advanced-csp-extra-uris" usage:"Additional URIs to allow when building content-security-policy for media + images."`
+	AdvancedSanitizerPolicy      string        `name:"advanced-sanitizer-policy" usage:"Base HTML sanitization policy for remote content: 'standard' or 'minimal'."`
+	AdvancedSanitizerAllowTags   []string      `name:"advanced-sanitizer-allow-tags" usage:"Extra HTML elements to allow through in remote content, on top of the base sanitizer policy."`
+	AdvancedSanitizerAllowAttrs  []string      `name:"advanced-sanitizer-allow-attrs" usage:"Extra HTML attributes to allow through in remote content, in the form 'element.attribute'."`
+	AdvancedSanitizerLinkRel     []string      `name:"advanced-sanitizer-link-rel" usage:"Values of the rel attribute to set on links to other sites in statuses and profiles."`
 
 	// HTTPClient configuration vars.
 	HTTPClient HTTPClientConfiguration `name:"http-client"`
@@ -165,10 +244,15 @@ type Configuration struct {
 	AdminAccountUsername     string `name:"username" usage:"the username to create/delete/etc"`
 	AdminAccountEmail        string `name:"email" usage:"the email address of this account"`
 	AdminAccountPassword     string `name:"password" usage:"the password to set for this account"`
+	AdminAccountDomain       string `name:"domain" usage:"the domain of the remote account to purge"`
+	AdminAccountRole         string `name:"role" usage:"the role to set for this account, or to list accounts by: admin, moderator or user"`
+	AdminAccountListPending  bool   `name:"pending" usage:"list only accounts awaiting approval"`
+	AdminAccountListDisabled bool   `name:"disabled" usage:"list only disabled accounts"`
 	AdminTransPath           string `name:"path" usage:"the path of the file to import from/export to"`
 	AdminMediaPruneDryRun    bool   `name:"dry-run" usage:"perform a dry run and only log number of items eligible for pruning"`
 	AdminMediaListLocalOnly  bool   `name:"local-only" usage:"list only local attachments/emojis; if specified then remote-only cannot also be true"`
 	AdminMediaListRemoteOnly bool   `name:"remote-only" usage:"list only remote attachments/emojis; if specified then local-only cannot also be true"`
+	AdminDBCheckRepair       bool   `name:"repair" usage:"repair integrity violations found in the database, rather than only reporting them"`
 
 	RequestIDHeader string `name:"request-id-header" usage:"Header to extract the Request ID from. Eg.,'X-Request-Id'."`
 }
@@ -178,39 +262,55 @@ type HTTPClientConfiguration struct {
 	BlockIPs              []string      `name:"block-ips"`
 	Timeout               time.Duration `name:"timeout"`
 	TLSInsecureSkipVerify bool          `name:"tls-insecure-skip-verify"`
+	MaxOpenConnsPerHost   int           `name:"max-open-conns-per-host"`
+	MaxIdleConnsPerHost   int           `name:"max-idle-conns-per-host"`
+	IdleConnTimeout       time.Duration `name:"idle-conn-timeout"`
+	DisableHTTP2          bool          `name:"disable-http2"`
+	Proxy                 string        `name:"proxy"`
+	OnionProxy            string        `name:"onion-proxy"`
 }
 
 type CacheConfiguration struct {
-	MemoryTarget             bytesize.Size `name:"memory-target"`
-	AccountMemRatio          float64       `name:"account-mem-ratio"`
-	AccountNoteMemRatio      float64       `name:"account-note-mem-ratio"`
-	ApplicationMemRatio      float64       `name:"application-mem-ratio"`
-	BlockMemRatio            float64       `name:"block-mem-ratio"`
-	BlockIDsMemRatio         float64       `name:"block-mem-ratio"`
-	BoostOfIDsMemRatio       float64       `name:"boost-of-ids-mem-ratio"`
-	EmojiMemRatio            float64       `name:"emoji-mem-ratio"`
-	EmojiCategoryMemRatio    float64       `name:"emoji-category-mem-ratio"`
-	FollowMemRatio           float64       `name:"follow-mem-ratio"`
-	FollowIDsMemRatio        float64       `name:"follow-ids-mem-ratio"`
-	FollowRequestMemRatio    float64       `name:"follow-request-mem-ratio"`
-	FollowRequestIDsMemRatio float64       `name:"follow-request-ids-mem-ratio"`
-	InReplyToIDsMemRatio     float64       `name:"in-reply-to-ids-mem-ratio"`
-	InstanceMemRatio         float64       `name:"instance-mem-ratio"`
-	ListMemRatio             float64       `name:"list-mem-ratio"`
-	ListEntryMemRatio        float64       `name:"list-entry-mem-ratio"`
-	MarkerMemRatio           float64       `name:"marker-mem-ratio"`
-	MediaMemRatio            float64       `name:"media-mem-ratio"`
-	MentionMemRatio          float64       `name:"mention-mem-ratio"`
-	NotificationMemRatio     float64       `name:"notification-mem-ratio"`
-	ReportMemRatio           float64       `name:"report-mem-ratio"`
-	StatusMemRatio           float64       `name:"status-mem-ratio"`
-	StatusFaveMemRatio       float64       `name:"status-fave-mem-ratio"`
-	StatusFaveIDsMemRatio    float64       `name:"status-fave-ids-mem-ratio"`
-	TagMemRatio              float64       `name:"tag-mem-ratio"`
-	TombstoneMemRatio        float64       `name:"tombstone-mem-ratio"`
-	UserMemRatio             float64       `name:"user-mem-ratio"`
-	WebfingerMemRatio        float64       `name:"webfinger-mem-ratio"`
-	VisibilityMemRatio       float64       `name:"visibility-mem-ratio"`
+	MemoryTarget              bytesize.Size `name:"memory-target"`
+	AccountMemRatio           float64       `name:"account-mem-ratio"`
+	AccountNoteMemRatio       float64       `name:"account-note-mem-ratio"`
+	ApplicationMemRatio       float64       `name:"application-mem-ratio"`
+	BlockMemRatio             float64       `name:"block-mem-ratio"`
+	BlockIDsMemRatio          float64       `name:"block-mem-ratio"`
+	BlockFilterMemRatio       float64       `name:"block-filter-mem-ratio"`
+	BoostOfIDsMemRatio        float64       `name:"boost-of-ids-mem-ratio"`
+	DomainInteropMemRatio     float64       `name:"domain-interop-mem-ratio"`
+	EmojiMemRatio             float64       `name:"emoji-mem-ratio"`
+	EmojiCategoryMemRatio     float64       `name:"emoji-category-mem-ratio"`
+	FollowMemRatio            float64       `name:"follow-mem-ratio"`
+	FollowIDsMemRatio         float64       `name:"follow-ids-mem-ratio"`
+	FollowRequestMemRatio     float64       `name:"follow-request-mem-ratio"`
+	FollowRequestIDsMemRatio  float64       `name:"follow-request-ids-mem-ratio"`
+	InReplyToIDsMemRatio      float64       `name:"in-reply-to-ids-mem-ratio"`
+	InstanceMemRatio          float64       `name:"instance-mem-ratio"`
+	ListMemRatio              float64       `name:"list-mem-ratio"`
+	ListEntryMemRatio         float64       `name:"list-entry-mem-ratio"`
+	MarkerMemRatio            float64       `name:"marker-mem-ratio"`
+	MediaMemRatio             float64       `name:"media-mem-ratio"`
+	MentionMemRatio           float64       `name:"mention-mem-ratio"`
+	MuteFilterMemRatio        float64       `name:"mute-filter-mem-ratio"`
+	NotificationMemRatio      float64       `name:"notification-mem-ratio"`
+	PrimeLocal                bool          `name:"prime-local"`
+	ReportMemRatio            float64       `name:"report-mem-ratio"`
+	StatusMemRatio            float64       `name:"status-mem-ratio"`
+	StatusFaveMemRatio        float64       `name:"status-fave-mem-ratio"`
+	StatusFaveIDsMemRatio     float64       `name:"status-fave-ids-mem-ratio"`
+	StatusReactionMemRatio    float64       `name:"status-reaction-mem-ratio"`
+	StatusReactionIDsMemRatio float64       `name:"status-reaction-ids-mem-ratio"`
+	TagMemRatio               float64       `name:"tag-mem-ratio"`
+	TombstoneMemRatio         float64       `name:"tombstone-mem-ratio"`
+	TranslationTTL            time.Duration `name:"translation-ttl"`
+	UserMemRatio              float64       `name:"user-mem-ratio"`
+	UserMuteMemRatio          float64       `name:"user-mute-mem-ratio"`
+	WebfingerMemRatio         float64       `name:"webfinger-mem-ratio"`
+	WebfingerTTL              time.Duration `name:"webfinger-ttl"`
+	WebfingerNegativeTTL      time.Duration `name:"webfinger-negative-ttl"`
+	VisibilityMemRatio        float64       `name:"visibility-mem-ratio"`
 }
 
 // MarshalMap will marshal current Configuration into a map structure (useful for JSON/TOML/YAML).
@@ -24,3 +24,45 @@ const (
 	InstanceFederationModeAllowlist = "allowlist"
 	InstanceFederationModeDefault   = InstanceFederationModeBlocklist
 )
+
+// SMTP transport determines how this
+// instance delivers assembled emails.
+const (
+	SMTPTransportSMTP = "smtp"
+	SMTPTransportHTTP = "http"
+)
+
+// Accounts challenge provider determines which
+// CAPTCHA-style challenge new sign-ups must pass.
+const (
+	AccountsChallengeProviderHCaptcha  = "hcaptcha"
+	AccountsChallengeProviderTurnstile = "turnstile"
+)
+
+// Statuses translation backend determines which
+// service statuses are sent to for translation.
+const (
+	StatusesTranslationBackendLibreTranslate = "libretranslate"
+	StatusesTranslationBackendDeepL          = "deepl"
+)
+
+// LetsEncrypt challenge determines how this instance
+// proves control of its host when obtaining certs.
+const (
+	LetsEncryptChallengeHTTP01 = "http-01"
+	LetsEncryptChallengeDNS01  = "dns-01"
+)
+
+// Log format determines how log entries are
+// formatted when written to stdout and syslog.
+const (
+	LogFormatLogfmt = "logfmt"
+	LogFormatJSON   = "json"
+)
+
+// Redis queues are the worker
+// queues which may be held in redis.
+const (
+	RedisQueueClientAPI = "client-api"
+	RedisQueueFediAPI   = "fedi-api"
+)
@@ -28,6 +28,8 @@ import (
 // if you use this, you will still need to set Host, and, if desired, ConfigPath.
 var Defaults = Configuration{
 	LogLevel:           "info",
+	LogLevels:          []string{},
+	LogFormat:          LogFormatLogfmt,
 	LogTimestampFormat: "02/01/2006 15:04:05.000",
 	LogDbQueries:       false,
 	ApplicationName:    "gotosocial",
@@ -35,6 +37,7 @@ var Defaults = Configuration{
 	ConfigPath:         "",
 	Host:               "",
 	AccountDomain:      "",
+	OnionHost:          "",
 	Protocol:           "https",
 	BindAddress:        "0.0.0.0",
 	Port:               8080,
@@ -53,15 +56,39 @@ var Defaults = Configuration{
 	DbSqliteSynchronous:      "NORMAL",
 	DbSqliteCacheSize:        8 * bytesize.MiB,
 	DbSqliteBusyTimeout:      time.Minute * 30,
+	DbPostgresCacheNotify:    false,
+	DbPostgresStreamNotify:   false,
+	DbPostgresAdvisoryLocks:  false,
 
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
+	WebThreadPageSize:  40,
+	WebThemeColor:      "#fd6a00",
+	WebBackgroundColor: "#2a2b2f",
+
+	WebLinkInterstitial:               false,
+	WebLinkInterstitialTrustedDomains: []string{},
 
 	InstanceFederationMode:         InstanceFederationModeDefault,
 	InstanceExposePeers:            false,
 	InstanceExposeSuspended:        false,
 	InstanceExposeSuspendedWeb:     false,
+	InstanceExposeLocalTimelineRSS: false,
+	InstanceExposeTagRSS:           false,
+	InstanceNoIndexDefault:         false,
+	InstanceEmojiReactions:         false,
+	InstanceInteractionCircle:      false,
+	InstanceLanguages:              []string{},
+	InstanceCategories:             []string{},
 	InstanceDeliverToSharedInboxes: true,
+	InstanceNewDomainQuarantine:    false,
+	InstanceNewDomainAutoApprove:   0,
+	InstanceDeadDeliveryFailures:   20,
+	InstanceDeadDeliveryWindow:     7 * 24 * time.Hour,
+	InstanceOptimisticIngestion:    false,
+	InstanceRemoteAccountFreshness: 6 * time.Hour,
+	InstanceRemoteStatusFreshness:  2 * time.Hour,
+	InstanceRemoteRefreshPerDomain: 2,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
@@ -69,13 +96,34 @@ var Defaults = Configuration{
 	AccountsAllowCustomCSS:   false,
 	AccountsCustomCSSLength:  10000,
 
+	AccountsActivityLimitWindow:                  time.Hour,
+	AccountsActivityLimitDuration:                24 * time.Hour,
+	AccountsActivityLimitUserFollows:             100,
+	AccountsActivityLimitUserDirectMessages:      30,
+	AccountsActivityLimitModeratorFollows:        200,
+	AccountsActivityLimitModeratorDirectMessages: 100,
+	AccountsActivityLimitAdminFollows:            0,
+	AccountsActivityLimitAdminDirectMessages:     0,
+	AccountsInteractionCooldown:                  10 * time.Second,
+	AccountsRefreshCooldown:                      time.Minute,
+	AccountsChallengeProvider:                    "",
+	AccountsChallengeSiteKey:                     "",
+	AccountsChallengeSecretKey:                   "",
+	AccountsChallengeAppTokens:                   false,
+
 	MediaImageMaxSize:        10 * bytesize.MiB,
 	MediaVideoMaxSize:        40 * bytesize.MiB,
+	MediaImageMaxDimension:   4096,
+	MediaImageMaxPixels:      8192 * 8192,
 	MediaDescriptionMinChars: 0,
 	MediaDescriptionMaxChars: 500,
 	MediaRemoteCacheDays:     7,
 	MediaEmojiLocalMaxSize:   50 * bytesize.KiB,
 	MediaEmojiRemoteMaxSize:  100 * bytesize.KiB,
+	MediaLocalQuota:          0,
+	MediaScanClamAVAddress:   "",
+	MediaScanCommand:         "",
+	MediaScanTimeout:         30 * time.Second,
 
 	StorageBackend:       "local",
 	StorageLocalBasePath: "/gotosocial/storage",
@@ -87,11 +135,22 @@ var Defaults = Configuration{
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesMaxPinned:          10,
+
+	StatusesSpamFilterEnabled:         false,
+	StatusesSpamFilterQuarantineScore: 50,
+	StatusesSpamFilterDropScore:       100,
+
+	StatusesTranslationBackend: "",
+	StatusesTranslationURL:     "",
+	StatusesTranslationAPIKey:  "",
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         80,
 	LetsEncryptCertDir:      "/gotosocial/storage/certs",
 	LetsEncryptEmailAddress: "",
+	LetsEncryptChallenge:    LetsEncryptChallengeHTTP01,
+	LetsEncryptDNSCommand:   "",
 
 	TLSCertificateChain: "",
 	TLSCertificateKey:   "",
@@ -111,16 +170,32 @@ var Defaults = Configuration{
 	SMTPPassword:           "",
 	SMTPFrom:               "",
 	SMTPDiscloseRecipients: false,
+	SMTPTransport:          SMTPTransportSMTP,
+	SMTPHTTPURL:            "",
+	SMTPHTTPToken:          "",
+	SMTPDKIMDomain:         "",
+	SMTPDKIMSelector:       "",
+	SMTPDKIMPrivateKeyPath: "",
 
 	TracingEnabled:           false,
 	TracingTransport:         "grpc",
 	TracingEndpoint:          "",
 	TracingInsecureTransport: false,
 
+	PprofEnabled:     false,
+	PprofBindAddress: "",
+
 	SyslogEnabled:  false,
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	RedisURL:         "",
+	RedisCacheNotify: false,
+	RedisQueues:      []string{},
+
+	JobsSchedules:  []string{},
+	JobsMaxRetries: 2,
+
 	AdvancedCookiesSamesite:      "lax",
 	AdvancedRateLimitRequests:    300, // 1 per second per 5 minutes
 	AdvancedRateLimitExceptions:  []string{},
@@ -128,6 +203,10 @@ var Defaults = Configuration{
 	AdvancedThrottlingRetryAfter: time.Second * 30,
 	AdvancedSenderMultiplier:     2, // 2 senders per CPU
 	AdvancedCSPExtraURIs:         []string{},
+	AdvancedSanitizerPolicy:      "standard",
+	AdvancedSanitizerAllowTags:   []string{},
+	AdvancedSanitizerAllowAttrs:  []string{},
+	AdvancedSanitizerLinkRel:     []string{"nofollow", "noreferrer", "noopener"},
 
 	Cache: CacheConfiguration{
 		// Rough memory target that the total
@@ -149,35 +228,45 @@ var Defaults = Configuration{
 		// when TODO items in the size.go source
 		// file have been addressed, these should
 		// be able to make some more sense :D
-		AccountMemRatio:          5,
-		AccountNoteMemRatio:      1,
-		ApplicationMemRatio:      0.1,
-		BlockMemRatio:            2,
-		BlockIDsMemRatio:         3,
-		BoostOfIDsMemRatio:       3,
-		EmojiMemRatio:            3,
-		EmojiCategoryMemRatio:    0.1,
-		FollowMemRatio:           2,
-		FollowIDsMemRatio:        4,
-		FollowRequestMemRatio:    2,
-		FollowRequestIDsMemRatio: 2,
-		InReplyToIDsMemRatio:     3,
-		InstanceMemRatio:         1,
-		ListMemRatio:             1,
-		ListEntryMemRatio:        2,
-		MarkerMemRatio:           0.5,
-		MediaMemRatio:            4,
-		MentionMemRatio:          2,
-		NotificationMemRatio:     2,
-		ReportMemRatio:           1,
-		StatusMemRatio:           5,
-		StatusFaveMemRatio:       2,
-		StatusFaveIDsMemRatio:    3,
-		TagMemRatio:              2,
-		TombstoneMemRatio:        0.5,
-		UserMemRatio:             0.25,
-		WebfingerMemRatio:        0.1,
-		VisibilityMemRatio:       2,
+		AccountMemRatio:           5,
+		AccountNoteMemRatio:       1,
+		ApplicationMemRatio:       0.1,
+		BlockMemRatio:             2,
+		BlockIDsMemRatio:          3,
+		BlockFilterMemRatio:       0.5,
+		BoostOfIDsMemRatio:        3,
+		DomainInteropMemRatio:     0.5,
+		EmojiMemRatio:             3,
+		EmojiCategoryMemRatio:     0.1,
+		FollowMemRatio:            2,
+		FollowIDsMemRatio:         4,
+		FollowRequestMemRatio:     2,
+		FollowRequestIDsMemRatio:  2,
+		InReplyToIDsMemRatio:      3,
+		InstanceMemRatio:          1,
+		ListMemRatio:              1,
+		ListEntryMemRatio:         2,
+		MarkerMemRatio:            0.5,
+		MediaMemRatio:             4,
+		MentionMemRatio:           2,
+		MuteFilterMemRatio:        0.5,
+		NotificationMemRatio:      2,
+		PrimeLocal:                false,
+		ReportMemRatio:            1,
+		StatusMemRatio:            5,
+		StatusFaveMemRatio:        2,
+		StatusFaveIDsMemRatio:     3,
+		StatusReactionMemRatio:    1,
+		StatusReactionIDsMemRatio: 1,
+		TagMemRatio:               2,
+		TombstoneMemRatio:         0.5,
+		TranslationTTL:            24 * time.Hour,
+		UserMemRatio:              0.25,
+		UserMuteMemRatio:          1,
+		WebfingerMemRatio:         0.1,
+		WebfingerTTL:              time.Hour,
+		WebfingerNegativeTTL:      10 * time.Minute,
+		VisibilityMemRatio:        2,
 	},
 
 	HTTPClient: HTTPClientConfiguration{
@@ -185,6 +274,12 @@ var Defaults = Configuration{
 		BlockIPs:              make([]string, 0),
 		Timeout:               10 * time.Second,
 		TLSInsecureSkipVerify: false,
+		MaxOpenConnsPerHost:   0,
+		MaxIdleConnsPerHost:   0,
+		IdleConnTimeout:       90 * time.Second,
+		DisableHTTP2:          false,
+		Proxy:                 "",
+		OnionProxy:            "",
 	},
 
 	AdminMediaPruneDryRun: true,
@@ -36,8 +36,11 @@ func (s *ConfigState) AddGlobalFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(LandingPageUserFlag(), cfg.LandingPageUser, fieldtag("LandingPageUser", "usage"))
 		cmd.PersistentFlags().String(HostFlag(), cfg.Host, fieldtag("Host", "usage"))
 		cmd.PersistentFlags().String(AccountDomainFlag(), cfg.AccountDomain, fieldtag("AccountDomain", "usage"))
+		cmd.PersistentFlags().String(OnionHostFlag(), cfg.OnionHost, fieldtag("OnionHost", "usage"))
 		cmd.PersistentFlags().String(ProtocolFlag(), cfg.Protocol, fieldtag("Protocol", "usage"))
 		cmd.PersistentFlags().String(LogLevelFlag(), cfg.LogLevel, fieldtag("LogLevel", "usage"))
+		cmd.PersistentFlags().StringSlice(LogLevelsFlag(), cfg.LogLevels, fieldtag("LogLevels", "usage"))
+		cmd.PersistentFlags().String(LogFormatFlag(), cfg.LogFormat, fieldtag("LogFormat", "usage"))
 		cmd.PersistentFlags().String(LogTimestampFormatFlag(), cfg.LogTimestampFormat, fieldtag("LogTimestampFormat", "usage"))
 		cmd.PersistentFlags().Bool(LogDbQueriesFlag(), cfg.LogDbQueries, fieldtag("LogDbQueries", "usage"))
 		cmd.PersistentFlags().String(ConfigPathFlag(), cfg.ConfigPath, fieldtag("ConfigPath", "usage"))
@@ -56,12 +59,21 @@ func (s *ConfigState) AddGlobalFlags(cmd *cobra.Command) {
 		cmd.PersistentFlags().String(DbSqliteSynchronousFlag(), cfg.DbSqliteSynchronous, fieldtag("DbSqliteSynchronous", "usage"))
 		cmd.PersistentFlags().Uint64(DbSqliteCacheSizeFlag(), uint64(cfg.DbSqliteCacheSize), fieldtag("DbSqliteCacheSize", "usage"))
 		cmd.PersistentFlags().Duration(DbSqliteBusyTimeoutFlag(), cfg.DbSqliteBusyTimeout, fieldtag("DbSqliteBusyTimeout", "usage"))
+		cmd.PersistentFlags().Bool(DbPostgresCacheNotifyFlag(), cfg.DbPostgresCacheNotify, fieldtag("DbPostgresCacheNotify", "usage"))
+		cmd.PersistentFlags().Bool(DbPostgresStreamNotifyFlag(), cfg.DbPostgresStreamNotify, fieldtag("DbPostgresStreamNotify", "usage"))
+		cmd.PersistentFlags().Bool(DbPostgresAdvisoryLocksFlag(), cfg.DbPostgresAdvisoryLocks, fieldtag("DbPostgresAdvisoryLocks", "usage"))
 
 		// HTTPClient
 		cmd.PersistentFlags().StringSlice(HTTPClientAllowIPsFlag(), cfg.HTTPClient.AllowIPs, "no usage string")
 		cmd.PersistentFlags().StringSlice(HTTPClientBlockIPsFlag(), cfg.HTTPClient.BlockIPs, "no usage string")
 		cmd.PersistentFlags().Duration(HTTPClientTimeoutFlag(), cfg.HTTPClient.Timeout, "no usage string")
 		cmd.PersistentFlags().Bool(HTTPClientTLSInsecureSkipVerifyFlag(), cfg.HTTPClient.TLSInsecureSkipVerify, "no usage string")
+		cmd.PersistentFlags().Int(HTTPClientMaxOpenConnsPerHostFlag(), cfg.HTTPClient.MaxOpenConnsPerHost, "no usage string")
+		cmd.PersistentFlags().Int(HTTPClientMaxIdleConnsPerHostFlag(), cfg.HTTPClient.MaxIdleConnsPerHost, "no usage string")
+		cmd.PersistentFlags().Duration(HTTPClientIdleConnTimeoutFlag(), cfg.HTTPClient.IdleConnTimeout, "no usage string")
+		cmd.PersistentFlags().Bool(HTTPClientDisableHTTP2Flag(), cfg.HTTPClient.DisableHTTP2, "no usage string")
+		cmd.PersistentFlags().String(HTTPClientProxyFlag(), cfg.HTTPClient.Proxy, "no usage string")
+		cmd.PersistentFlags().String(HTTPClientOnionProxyFlag(), cfg.HTTPClient.OnionProxy, "no usage string")
 	})
 }
 
@@ -81,6 +93,11 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		// Template
 		cmd.Flags().String(WebTemplateBaseDirFlag(), cfg.WebTemplateBaseDir, fieldtag("WebTemplateBaseDir", "usage"))
 		cmd.Flags().String(WebAssetBaseDirFlag(), cfg.WebAssetBaseDir, fieldtag("WebAssetBaseDir", "usage"))
+		cmd.Flags().Int(WebThreadPageSizeFlag(), cfg.WebThreadPageSize, fieldtag("WebThreadPageSize", "usage"))
+		cmd.Flags().String(WebThemeColorFlag(), cfg.WebThemeColor, fieldtag("WebThemeColor", "usage"))
+		cmd.Flags().String(WebBackgroundColorFlag(), cfg.WebBackgroundColor, fieldtag("WebBackgroundColor", "usage"))
+		cmd.Flags().Bool(WebLinkInterstitialFlag(), cfg.WebLinkInterstitial, fieldtag("WebLinkInterstitial", "usage"))
+		cmd.Flags().StringSlice(WebLinkInterstitialTrustedDomainsFlag(), cfg.WebLinkInterstitialTrustedDomains, fieldtag("WebLinkInterstitialTrustedDomains", "usage"))
 
 		// Instance
 		cmd.Flags().String(InstanceFederationModeFlag(), cfg.InstanceFederationMode, fieldtag("InstanceFederationMode", "usage"))
@@ -88,21 +105,56 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
+		cmd.Flags().Bool(InstanceExposeLocalTimelineRSSFlag(), cfg.InstanceExposeLocalTimelineRSS, fieldtag("InstanceExposeLocalTimelineRSS", "usage"))
+		cmd.Flags().Bool(InstanceExposeTagRSSFlag(), cfg.InstanceExposeTagRSS, fieldtag("InstanceExposeTagRSS", "usage"))
+		cmd.Flags().Bool(InstanceNoIndexDefaultFlag(), cfg.InstanceNoIndexDefault, fieldtag("InstanceNoIndexDefault", "usage"))
+		cmd.Flags().Bool(InstanceEmojiReactionsFlag(), cfg.InstanceEmojiReactions, fieldtag("InstanceEmojiReactions", "usage"))
+		cmd.Flags().Bool(InstanceInteractionCircleFlag(), cfg.InstanceInteractionCircle, fieldtag("InstanceInteractionCircle", "usage"))
+		cmd.Flags().StringSlice(InstanceLanguagesFlag(), cfg.InstanceLanguages, fieldtag("InstanceLanguages", "usage"))
+		cmd.Flags().StringSlice(InstanceCategoriesFlag(), cfg.InstanceCategories, fieldtag("InstanceCategories", "usage"))
+		cmd.Flags().Bool(InstanceNewDomainQuarantineFlag(), cfg.InstanceNewDomainQuarantine, fieldtag("InstanceNewDomainQuarantine", "usage"))
+		cmd.Flags().Int(InstanceNewDomainAutoApproveFlag(), cfg.InstanceNewDomainAutoApprove, fieldtag("InstanceNewDomainAutoApprove", "usage"))
+		cmd.Flags().Int(InstanceDeadDeliveryFailuresFlag(), cfg.InstanceDeadDeliveryFailures, fieldtag("InstanceDeadDeliveryFailures", "usage"))
+		cmd.Flags().Duration(InstanceDeadDeliveryWindowFlag(), cfg.InstanceDeadDeliveryWindow, fieldtag("InstanceDeadDeliveryWindow", "usage"))
+		cmd.Flags().Bool(InstanceOptimisticIngestionFlag(), cfg.InstanceOptimisticIngestion, fieldtag("InstanceOptimisticIngestion", "usage"))
+		cmd.Flags().Duration(InstanceRemoteAccountFreshnessFlag(), cfg.InstanceRemoteAccountFreshness, fieldtag("InstanceRemoteAccountFreshness", "usage"))
+		cmd.Flags().Duration(InstanceRemoteStatusFreshnessFlag(), cfg.InstanceRemoteStatusFreshness, fieldtag("InstanceRemoteStatusFreshness", "usage"))
+		cmd.Flags().Int(InstanceRemoteRefreshPerDomainFlag(), cfg.InstanceRemoteRefreshPerDomain, fieldtag("InstanceRemoteRefreshPerDomain", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsApprovalRequiredFlag(), cfg.AccountsApprovalRequired, fieldtag("AccountsApprovalRequired", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Duration(AccountsActivityLimitWindowFlag(), cfg.AccountsActivityLimitWindow, fieldtag("AccountsActivityLimitWindow", "usage"))
+		cmd.Flags().Duration(AccountsActivityLimitDurationFlag(), cfg.AccountsActivityLimitDuration, fieldtag("AccountsActivityLimitDuration", "usage"))
+		cmd.Flags().Int(AccountsActivityLimitUserFollowsFlag(), cfg.AccountsActivityLimitUserFollows, fieldtag("AccountsActivityLimitUserFollows", "usage"))
+		cmd.Flags().Int(AccountsActivityLimitUserDirectMessagesFlag(), cfg.AccountsActivityLimitUserDirectMessages, fieldtag("AccountsActivityLimitUserDirectMessages", "usage"))
+		cmd.Flags().Int(AccountsActivityLimitModeratorFollowsFlag(), cfg.AccountsActivityLimitModeratorFollows, fieldtag("AccountsActivityLimitModeratorFollows", "usage"))
+		cmd.Flags().Int(AccountsActivityLimitModeratorDirectMessagesFlag(), cfg.AccountsActivityLimitModeratorDirectMessages, fieldtag("AccountsActivityLimitModeratorDirectMessages", "usage"))
+		cmd.Flags().Int(AccountsActivityLimitAdminFollowsFlag(), cfg.AccountsActivityLimitAdminFollows, fieldtag("AccountsActivityLimitAdminFollows", "usage"))
+		cmd.Flags().Int(AccountsActivityLimitAdminDirectMessagesFlag(), cfg.AccountsActivityLimitAdminDirectMessages, fieldtag("AccountsActivityLimitAdminDirectMessages", "usage"))
+		cmd.Flags().Duration(AccountsInteractionCooldownFlag(), cfg.AccountsInteractionCooldown, fieldtag("AccountsInteractionCooldown", "usage"))
+		cmd.Flags().Duration(AccountsRefreshCooldownFlag(), cfg.AccountsRefreshCooldown, fieldtag("AccountsRefreshCooldown", "usage"))
+		cmd.Flags().String(AccountsChallengeProviderFlag(), cfg.AccountsChallengeProvider, fieldtag("AccountsChallengeProvider", "usage"))
+		cmd.Flags().String(AccountsChallengeSiteKeyFlag(), cfg.AccountsChallengeSiteKey, fieldtag("AccountsChallengeSiteKey", "usage"))
+		cmd.Flags().String(AccountsChallengeSecretKeyFlag(), cfg.AccountsChallengeSecretKey, fieldtag("AccountsChallengeSecretKey", "usage"))
+		cmd.Flags().Bool(AccountsChallengeAppTokensFlag(), cfg.AccountsChallengeAppTokens, fieldtag("AccountsChallengeAppTokens", "usage"))
 
 		// Media
 		cmd.Flags().Uint64(MediaImageMaxSizeFlag(), uint64(cfg.MediaImageMaxSize), fieldtag("MediaImageMaxSize", "usage"))
 		cmd.Flags().Uint64(MediaVideoMaxSizeFlag(), uint64(cfg.MediaVideoMaxSize), fieldtag("MediaVideoMaxSize", "usage"))
+		cmd.Flags().Int(MediaImageMaxDimensionFlag(), cfg.MediaImageMaxDimension, fieldtag("MediaImageMaxDimension", "usage"))
+		cmd.Flags().Int(MediaImageMaxPixelsFlag(), cfg.MediaImageMaxPixels, fieldtag("MediaImageMaxPixels", "usage"))
 		cmd.Flags().Int(MediaDescriptionMinCharsFlag(), cfg.MediaDescriptionMinChars, fieldtag("MediaDescriptionMinChars", "usage"))
 		cmd.Flags().Int(MediaDescriptionMaxCharsFlag(), cfg.MediaDescriptionMaxChars, fieldtag("MediaDescriptionMaxChars", "usage"))
 		cmd.Flags().Int(MediaRemoteCacheDaysFlag(), cfg.MediaRemoteCacheDays, fieldtag("MediaRemoteCacheDays", "usage"))
 		cmd.Flags().Uint64(MediaEmojiLocalMaxSizeFlag(), uint64(cfg.MediaEmojiLocalMaxSize), fieldtag("MediaEmojiLocalMaxSize", "usage"))
 		cmd.Flags().Uint64(MediaEmojiRemoteMaxSizeFlag(), uint64(cfg.MediaEmojiRemoteMaxSize), fieldtag("MediaEmojiRemoteMaxSize", "usage"))
+		cmd.Flags().Uint64(MediaLocalQuotaFlag(), uint64(cfg.MediaLocalQuota), fieldtag("MediaLocalQuota", "usage"))
+		cmd.Flags().String(MediaScanClamAVAddressFlag(), cfg.MediaScanClamAVAddress, fieldtag("MediaScanClamAVAddress", "usage"))
+		cmd.Flags().String(MediaScanCommandFlag(), cfg.MediaScanCommand, fieldtag("MediaScanCommand", "usage"))
+		cmd.Flags().Duration(MediaScanTimeoutFlag(), cfg.MediaScanTimeout, fieldtag("MediaScanTimeout", "usage"))
 
 		// Storage
 		cmd.Flags().String(StorageBackendFlag(), cfg.StorageBackend, fieldtag("StorageBackend", "usage"))
@@ -114,12 +166,21 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Int(StatusesPollMaxOptionsFlag(), cfg.StatusesPollMaxOptions, fieldtag("StatusesPollMaxOptions", "usage"))
 		cmd.Flags().Int(StatusesPollOptionMaxCharsFlag(), cfg.StatusesPollOptionMaxChars, fieldtag("StatusesPollOptionMaxChars", "usage"))
 		cmd.Flags().Int(StatusesMediaMaxFilesFlag(), cfg.StatusesMediaMaxFiles, fieldtag("StatusesMediaMaxFiles", "usage"))
+		cmd.Flags().Int(StatusesMaxPinnedFlag(), cfg.StatusesMaxPinned, fieldtag("StatusesMaxPinned", "usage"))
+		cmd.Flags().Bool(StatusesSpamFilterEnabledFlag(), cfg.StatusesSpamFilterEnabled, fieldtag("StatusesSpamFilterEnabled", "usage"))
+		cmd.Flags().Int(StatusesSpamFilterQuarantineScoreFlag(), cfg.StatusesSpamFilterQuarantineScore, fieldtag("StatusesSpamFilterQuarantineScore", "usage"))
+		cmd.Flags().Int(StatusesSpamFilterDropScoreFlag(), cfg.StatusesSpamFilterDropScore, fieldtag("StatusesSpamFilterDropScore", "usage"))
+		cmd.Flags().String(StatusesTranslationBackendFlag(), cfg.StatusesTranslationBackend, fieldtag("StatusesTranslationBackend", "usage"))
+		cmd.Flags().String(StatusesTranslationURLFlag(), cfg.StatusesTranslationURL, fieldtag("StatusesTranslationURL", "usage"))
+		cmd.Flags().String(StatusesTranslationAPIKeyFlag(), cfg.StatusesTranslationAPIKey, fieldtag("StatusesTranslationAPIKey", "usage"))
 
 		// LetsEncrypt
 		cmd.Flags().Bool(LetsEncryptEnabledFlag(), cfg.LetsEncryptEnabled, fieldtag("LetsEncryptEnabled", "usage"))
 		cmd.Flags().Int(LetsEncryptPortFlag(), cfg.LetsEncryptPort, fieldtag("LetsEncryptPort", "usage"))
 		cmd.Flags().String(LetsEncryptCertDirFlag(), cfg.LetsEncryptCertDir, fieldtag("LetsEncryptCertDir", "usage"))
 		cmd.Flags().String(LetsEncryptEmailAddressFlag(), cfg.LetsEncryptEmailAddress, fieldtag("LetsEncryptEmailAddress", "usage"))
+		cmd.Flags().String(LetsEncryptChallengeFlag(), cfg.LetsEncryptChallenge, fieldtag("LetsEncryptChallenge", "usage"))
+		cmd.Flags().String(LetsEncryptDNSCommandFlag(), cfg.LetsEncryptDNSCommand, fieldtag("LetsEncryptDNSCommand", "usage"))
 
 		// Manual TLS
 		cmd.Flags().String(TLSCertificateChainFlag(), cfg.TLSCertificateChain, fieldtag("TLSCertificateChain", "usage"))
@@ -141,12 +202,27 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SMTPPasswordFlag(), cfg.SMTPPassword, fieldtag("SMTPPassword", "usage"))
 		cmd.Flags().String(SMTPFromFlag(), cfg.SMTPFrom, fieldtag("SMTPFrom", "usage"))
 		cmd.Flags().Bool(SMTPDiscloseRecipientsFlag(), cfg.SMTPDiscloseRecipients, fieldtag("SMTPDiscloseRecipients", "usage"))
+		cmd.Flags().String(SMTPTransportFlag(), cfg.SMTPTransport, fieldtag("SMTPTransport", "usage"))
+		cmd.Flags().String(SMTPHTTPURLFlag(), cfg.SMTPHTTPURL, fieldtag("SMTPHTTPURL", "usage"))
+		cmd.Flags().String(SMTPHTTPTokenFlag(), cfg.SMTPHTTPToken, fieldtag("SMTPHTTPToken", "usage"))
+		cmd.Flags().String(SMTPDKIMDomainFlag(), cfg.SMTPDKIMDomain, fieldtag("SMTPDKIMDomain", "usage"))
+		cmd.Flags().String(SMTPDKIMSelectorFlag(), cfg.SMTPDKIMSelector, fieldtag("SMTPDKIMSelector", "usage"))
+		cmd.Flags().String(SMTPDKIMPrivateKeyPathFlag(), cfg.SMTPDKIMPrivateKeyPath, fieldtag("SMTPDKIMPrivateKeyPath", "usage"))
 
 		// Syslog
 		cmd.Flags().Bool(SyslogEnabledFlag(), cfg.SyslogEnabled, fieldtag("SyslogEnabled", "usage"))
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
 		cmd.Flags().String(SyslogAddressFlag(), cfg.SyslogAddress, fieldtag("SyslogAddress", "usage"))
 
+		// Redis
+		cmd.Flags().String(RedisURLFlag(), cfg.RedisURL, fieldtag("RedisURL", "usage"))
+		cmd.Flags().Bool(RedisCacheNotifyFlag(), cfg.RedisCacheNotify, fieldtag("RedisCacheNotify", "usage"))
+		cmd.Flags().StringSlice(RedisQueuesFlag(), cfg.RedisQueues, fieldtag("RedisQueues", "usage"))
+
+		// Jobs
+		cmd.Flags().StringSlice(JobsSchedulesFlag(), cfg.JobsSchedules, fieldtag("JobsSchedules", "usage"))
+		cmd.Flags().Int(JobsMaxRetriesFlag(), cfg.JobsMaxRetries, fieldtag("JobsMaxRetries", "usage"))
+
 		// Advanced flags
 		cmd.Flags().String(AdvancedCookiesSamesiteFlag(), cfg.AdvancedCookiesSamesite, fieldtag("AdvancedCookiesSamesite", "usage"))
 		cmd.Flags().Int(AdvancedRateLimitRequestsFlag(), cfg.AdvancedRateLimitRequests, fieldtag("AdvancedRateLimitRequests", "usage"))
@@ -155,8 +231,14 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Duration(AdvancedThrottlingRetryAfterFlag(), cfg.AdvancedThrottlingRetryAfter, fieldtag("AdvancedThrottlingRetryAfter", "usage"))
 		cmd.Flags().Int(AdvancedSenderMultiplierFlag(), cfg.AdvancedSenderMultiplier, fieldtag("AdvancedSenderMultiplier", "usage"))
 		cmd.Flags().StringSlice(AdvancedCSPExtraURIsFlag(), cfg.AdvancedCSPExtraURIs, fieldtag("AdvancedCSPExtraURIs", "usage"))
+		cmd.Flags().String(AdvancedSanitizerPolicyFlag(), cfg.AdvancedSanitizerPolicy, fieldtag("AdvancedSanitizerPolicy", "usage"))
+		cmd.Flags().StringSlice(AdvancedSanitizerAllowTagsFlag(), cfg.AdvancedSanitizerAllowTags, fieldtag("AdvancedSanitizerAllowTags", "usage"))
+		cmd.Flags().StringSlice(AdvancedSanitizerAllowAttrsFlag(), cfg.AdvancedSanitizerAllowAttrs, fieldtag("AdvancedSanitizerAllowAttrs", "usage"))
+		cmd.Flags().StringSlice(AdvancedSanitizerLinkRelFlag(), cfg.AdvancedSanitizerLinkRel, fieldtag("AdvancedSanitizerLinkRel", "usage"))
 
 		cmd.Flags().String(RequestIDHeaderFlag(), cfg.RequestIDHeader, fieldtag("RequestIDHeader", "usage"))
+		cmd.Flags().Bool(PprofEnabledFlag(), cfg.PprofEnabled, fieldtag("PprofEnabled", "usage"))
+		cmd.Flags().String(PprofBindAddressFlag(), cfg.PprofBindAddress, fieldtag("PprofBindAddress", "usage"))
 	})
 }
 
@@ -180,6 +262,43 @@ func AddAdminAccountPassword(cmd *cobra.Command) {
 	}
 }
 
+// AddAdminAccountList attaches flags pertaining to admin account list filters.
+func AddAdminAccountList(cmd *cobra.Command) {
+	role := AdminAccountRoleFlag()
+	roleUsage := fieldtag("AdminAccountRole", "usage")
+	cmd.Flags().String(role, "", roleUsage)
+
+	pending := AdminAccountListPendingFlag()
+	pendingUsage := fieldtag("AdminAccountListPending", "usage")
+	cmd.Flags().Bool(pending, false, pendingUsage)
+
+	disabled := AdminAccountListDisabledFlag()
+	disabledUsage := fieldtag("AdminAccountListDisabled", "usage")
+	cmd.Flags().Bool(disabled, false, disabledUsage)
+}
+
+// AddAdminAccountRole attaches flags pertaining to setting an account's role.
+func AddAdminAccountRole(cmd *cobra.Command) {
+	name := AdminAccountRoleFlag()
+	usage := fieldtag("AdminAccountRole", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+}
+
+// AddAdminAccountPurge attaches flags pertaining to purging a remote account.
+func AddAdminAccountPurge(cmd *cobra.Command) {
+	AddAdminAccount(cmd)
+
+	name := AdminAccountDomainFlag()
+	usage := fieldtag("AdminAccountDomain", "usage")
+	cmd.Flags().String(name, "", usage) // REQUIRED
+	if err := cmd.MarkFlagRequired(name); err != nil {
+		panic(err)
+	}
+}
+
 // AddAdminAccountCreate attaches flags pertaining to admin account creation.
 func AddAdminAccountCreate(cmd *cobra.Command) {
 	// Requires both account and password
@@ -221,3 +340,10 @@ func AddAdminMediaPrune(cmd *cobra.Command) {
 	usage := fieldtag("AdminMediaPruneDryRun", "usage")
 	cmd.Flags().Bool(name, true, usage)
 }
+
+// AddAdminDBCheck attaches flags pertaining to database integrity check commands.
+func AddAdminDBCheck(cmd *cobra.Command) {
+	name := AdminDBCheckRepairFlag()
+	usage := fieldtag("AdminDBCheckRepair", "usage")
+	cmd.Flags().Bool(name, false, usage)
+}
@@ -49,6 +49,56 @@ func GetLogLevel() string { return global.GetLogLevel() }
 // SetLogLevel safely sets the value for global configuration 'LogLevel' field
 func SetLogLevel(v string) { global.SetLogLevel(v) }
 
+// GetLogLevels safely fetches the Configuration value for state's 'LogLevels' field
+func (st *ConfigState) GetLogLevels() (v []string) {
+	st.mutex.RLock()
+	v = st.config.LogLevels
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLogLevels safely sets the Configuration value for state's 'LogLevels' field
+func (st *ConfigState) SetLogLevels(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LogLevels = v
+	st.reloadToViper()
+}
+
+// LogLevelsFlag returns the flag name for the 'LogLevels' field
+func LogLevelsFlag() string { return "log-levels" }
+
+// GetLogLevels safely fetches the value for global configuration 'LogLevels' field
+func GetLogLevels() []string { return global.GetLogLevels() }
+
+// SetLogLevels safely sets the value for global configuration 'LogLevels' field
+func SetLogLevels(v []string) { global.SetLogLevels(v) }
+
+// GetLogFormat safely fetches the Configuration value for state's 'LogFormat' field
+func (st *ConfigState) GetLogFormat() (v string) {
+	st.mutex.RLock()
+	v = st.config.LogFormat
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLogFormat safely sets the Configuration value for state's 'LogFormat' field
+func (st *ConfigState) SetLogFormat(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LogFormat = v
+	st.reloadToViper()
+}
+
+// LogFormatFlag returns the flag name for the 'LogFormat' field
+func LogFormatFlag() string { return "log-format" }
+
+// GetLogFormat safely fetches the value for global configuration 'LogFormat' field
+func GetLogFormat() string { return global.GetLogFormat() }
+
+// SetLogFormat safely sets the value for global configuration 'LogFormat' field
+func SetLogFormat(v string) { global.SetLogFormat(v) }
+
 // GetLogTimestampFormat safely fetches the Configuration value for state's 'LogTimestampFormat' field
 func (st *ConfigState) GetLogTimestampFormat() (v string) {
 	st.mutex.RLock()
@@ -249,6 +299,31 @@ func GetAccountDomain() string { return global.GetAccountDomain() }
 // SetAccountDomain safely sets the value for global configuration 'AccountDomain' field
 func SetAccountDomain(v string) { global.SetAccountDomain(v) }
 
+// GetOnionHost safely fetches the Configuration value for state's 'OnionHost' field
+func (st *ConfigState) GetOnionHost() (v string) {
+	st.mutex.RLock()
+	v = st.config.OnionHost
+	st.mutex.RUnlock()
+	return
+}
+
+// SetOnionHost safely sets the Configuration value for state's 'OnionHost' field
+func (st *ConfigState) SetOnionHost(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.OnionHost = v
+	st.reloadToViper()
+}
+
+// OnionHostFlag returns the flag name for the 'OnionHost' field
+func OnionHostFlag() string { return "onion-host" }
+
+// GetOnionHost safely fetches the value for global configuration 'OnionHost' field
+func GetOnionHost() string { return global.GetOnionHost() }
+
+// SetOnionHost safely sets the value for global configuration 'OnionHost' field
+func SetOnionHost(v string) { global.SetOnionHost(v) }
+
 // GetProtocol safely fetches the Configuration value for state's 'Protocol' field
 func (st *ConfigState) GetProtocol() (v string) {
 	st.mutex.RLock()
@@ -699,6 +774,81 @@ func GetDbSqliteBusyTimeout() time.Duration { return global.GetDbSqliteBusyTimeo
 // SetDbSqliteBusyTimeout safely sets the value for global configuration 'DbSqliteBusyTimeout' field
 func SetDbSqliteBusyTimeout(v time.Duration) { global.SetDbSqliteBusyTimeout(v) }
 
+// GetDbPostgresCacheNotify safely fetches the Configuration value for state's 'DbPostgresCacheNotify' field
+func (st *ConfigState) GetDbPostgresCacheNotify() (v bool) {
+	st.mutex.RLock()
+	v = st.config.DbPostgresCacheNotify
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbPostgresCacheNotify safely sets the Configuration value for state's 'DbPostgresCacheNotify' field
+func (st *ConfigState) SetDbPostgresCacheNotify(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbPostgresCacheNotify = v
+	st.reloadToViper()
+}
+
+// DbPostgresCacheNotifyFlag returns the flag name for the 'DbPostgresCacheNotify' field
+func DbPostgresCacheNotifyFlag() string { return "db-postgres-cache-notify" }
+
+// GetDbPostgresCacheNotify safely fetches the value for global configuration 'DbPostgresCacheNotify' field
+func GetDbPostgresCacheNotify() bool { return global.GetDbPostgresCacheNotify() }
+
+// SetDbPostgresCacheNotify safely sets the value for global configuration 'DbPostgresCacheNotify' field
+func SetDbPostgresCacheNotify(v bool) { global.SetDbPostgresCacheNotify(v) }
+
+// GetDbPostgresStreamNotify safely fetches the Configuration value for state's 'DbPostgresStreamNotify' field
+func (st *ConfigState) GetDbPostgresStreamNotify() (v bool) {
+	st.mutex.RLock()
+	v = st.config.DbPostgresStreamNotify
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbPostgresStreamNotify safely sets the Configuration value for state's 'DbPostgresStreamNotify' field
+func (st *ConfigState) SetDbPostgresStreamNotify(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbPostgresStreamNotify = v
+	st.reloadToViper()
+}
+
+// DbPostgresStreamNotifyFlag returns the flag name for the 'DbPostgresStreamNotify' field
+func DbPostgresStreamNotifyFlag() string { return "db-postgres-stream-notify" }
+
+// GetDbPostgresStreamNotify safely fetches the value for global configuration 'DbPostgresStreamNotify' field
+func GetDbPostgresStreamNotify() bool { return global.GetDbPostgresStreamNotify() }
+
+// SetDbPostgresStreamNotify safely sets the value for global configuration 'DbPostgresStreamNotify' field
+func SetDbPostgresStreamNotify(v bool) { global.SetDbPostgresStreamNotify(v) }
+
+// GetDbPostgresAdvisoryLocks safely fetches the Configuration value for state's 'DbPostgresAdvisoryLocks' field
+func (st *ConfigState) GetDbPostgresAdvisoryLocks() (v bool) {
+	st.mutex.RLock()
+	v = st.config.DbPostgresAdvisoryLocks
+	st.mutex.RUnlock()
+	return
+}
+
+// SetDbPostgresAdvisoryLocks safely sets the Configuration value for state's 'DbPostgresAdvisoryLocks' field
+func (st *ConfigState) SetDbPostgresAdvisoryLocks(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.DbPostgresAdvisoryLocks = v
+	st.reloadToViper()
+}
+
+// DbPostgresAdvisoryLocksFlag returns the flag name for the 'DbPostgresAdvisoryLocks' field
+func DbPostgresAdvisoryLocksFlag() string { return "db-postgres-advisory-locks" }
+
+// GetDbPostgresAdvisoryLocks safely fetches the value for global configuration 'DbPostgresAdvisoryLocks' field
+func GetDbPostgresAdvisoryLocks() bool { return global.GetDbPostgresAdvisoryLocks() }
+
+// SetDbPostgresAdvisoryLocks safely sets the value for global configuration 'DbPostgresAdvisoryLocks' field
+func SetDbPostgresAdvisoryLocks(v bool) { global.SetDbPostgresAdvisoryLocks(v) }
+
 // GetWebTemplateBaseDir safely fetches the Configuration value for state's 'WebTemplateBaseDir' field
 func (st *ConfigState) GetWebTemplateBaseDir() (v string) {
 	st.mutex.RLock()
@@ -749,6 +899,133 @@ func GetWebAssetBaseDir() string { return global.GetWebAssetBaseDir() }
 // SetWebAssetBaseDir safely sets the value for global configuration 'WebAssetBaseDir' field
 func SetWebAssetBaseDir(v string) { global.SetWebAssetBaseDir(v) }
 
+// GetWebThreadPageSize safely fetches the Configuration value for state's 'WebThreadPageSize' field
+func (st *ConfigState) GetWebThreadPageSize() (v int) {
+	st.mutex.RLock()
+	v = st.config.WebThreadPageSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetWebThreadPageSize safely sets the Configuration value for state's 'WebThreadPageSize' field
+func (st *ConfigState) SetWebThreadPageSize(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebThreadPageSize = v
+	st.reloadToViper()
+}
+
+// WebThreadPageSizeFlag returns the flag name for the 'WebThreadPageSize' field
+func WebThreadPageSizeFlag() string { return "web-thread-page-size" }
+
+// GetWebThreadPageSize safely fetches the value for global configuration 'WebThreadPageSize' field
+func GetWebThreadPageSize() int { return global.GetWebThreadPageSize() }
+
+// SetWebThreadPageSize safely sets the value for global configuration 'WebThreadPageSize' field
+func SetWebThreadPageSize(v int) { global.SetWebThreadPageSize(v) }
+
+// GetWebThemeColor safely fetches the Configuration value for state's 'WebThemeColor' field
+func (st *ConfigState) GetWebThemeColor() (v string) {
+	st.mutex.RLock()
+	v = st.config.WebThemeColor
+	st.mutex.RUnlock()
+	return
+}
+
+// SetWebThemeColor safely sets the Configuration value for state's 'WebThemeColor' field
+func (st *ConfigState) SetWebThemeColor(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebThemeColor = v
+	st.reloadToViper()
+}
+
+// WebThemeColorFlag returns the flag name for the 'WebThemeColor' field
+func WebThemeColorFlag() string { return "web-theme-color" }
+
+// GetWebThemeColor safely fetches the value for global configuration 'WebThemeColor' field
+func GetWebThemeColor() string { return global.GetWebThemeColor() }
+
+// SetWebThemeColor safely sets the value for global configuration 'WebThemeColor' field
+func SetWebThemeColor(v string) { global.SetWebThemeColor(v) }
+
+// GetWebBackgroundColor safely fetches the Configuration value for state's 'WebBackgroundColor' field
+func (st *ConfigState) GetWebBackgroundColor() (v string) {
+	st.mutex.RLock()
+	v = st.config.WebBackgroundColor
+	st.mutex.RUnlock()
+	return
+}
+
+// SetWebBackgroundColor safely sets the Configuration value for state's 'WebBackgroundColor' field
+func (st *ConfigState) SetWebBackgroundColor(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebBackgroundColor = v
+	st.reloadToViper()
+}
+
+// WebBackgroundColorFlag returns the flag name for the 'WebBackgroundColor' field
+func WebBackgroundColorFlag() string { return "web-background-color" }
+
+// GetWebBackgroundColor safely fetches the value for global configuration 'WebBackgroundColor' field
+func GetWebBackgroundColor() string { return global.GetWebBackgroundColor() }
+
+// SetWebBackgroundColor safely sets the value for global configuration 'WebBackgroundColor' field
+func SetWebBackgroundColor(v string) { global.SetWebBackgroundColor(v) }
+
+// GetWebLinkInterstitial safely fetches the Configuration value for state's 'WebLinkInterstitial' field
+func (st *ConfigState) GetWebLinkInterstitial() (v bool) {
+	st.mutex.RLock()
+	v = st.config.WebLinkInterstitial
+	st.mutex.RUnlock()
+	return
+}
+
+// SetWebLinkInterstitial safely sets the Configuration value for state's 'WebLinkInterstitial' field
+func (st *ConfigState) SetWebLinkInterstitial(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebLinkInterstitial = v
+	st.reloadToViper()
+}
+
+// WebLinkInterstitialFlag returns the flag name for the 'WebLinkInterstitial' field
+func WebLinkInterstitialFlag() string { return "web-link-interstitial" }
+
+// GetWebLinkInterstitial safely fetches the value for global configuration 'WebLinkInterstitial' field
+func GetWebLinkInterstitial() bool { return global.GetWebLinkInterstitial() }
+
+// SetWebLinkInterstitial safely sets the value for global configuration 'WebLinkInterstitial' field
+func SetWebLinkInterstitial(v bool) { global.SetWebLinkInterstitial(v) }
+
+// GetWebLinkInterstitialTrustedDomains safely fetches the Configuration value for state's 'WebLinkInterstitialTrustedDomains' field
+func (st *ConfigState) GetWebLinkInterstitialTrustedDomains() (v []string) {
+	st.mutex.RLock()
+	v = st.config.WebLinkInterstitialTrustedDomains
+	st.mutex.RUnlock()
+	return
+}
+
+// SetWebLinkInterstitialTrustedDomains safely sets the Configuration value for state's 'WebLinkInterstitialTrustedDomains' field
+func (st *ConfigState) SetWebLinkInterstitialTrustedDomains(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.WebLinkInterstitialTrustedDomains = v
+	st.reloadToViper()
+}
+
+// WebLinkInterstitialTrustedDomainsFlag returns the flag name for the 'WebLinkInterstitialTrustedDomains' field
+func WebLinkInterstitialTrustedDomainsFlag() string { return "web-link-interstitial-trusted-domains" }
+
+// GetWebLinkInterstitialTrustedDomains safely fetches the value for global configuration 'WebLinkInterstitialTrustedDomains' field
+func GetWebLinkInterstitialTrustedDomains() []string {
+	return global.GetWebLinkInterstitialTrustedDomains()
+}
+
+// SetWebLinkInterstitialTrustedDomains safely sets the value for global configuration 'WebLinkInterstitialTrustedDomains' field
+func SetWebLinkInterstitialTrustedDomains(v []string) { global.SetWebLinkInterstitialTrustedDomains(v) }
+
 // GetInstanceFederationMode safely fetches the Configuration value for state's 'InstanceFederationMode' field
 func (st *ConfigState) GetInstanceFederationMode() (v string) {
 	st.mutex.RLock()
@@ -924,305 +1201,1210 @@ func GetInstanceInjectMastodonVersion() bool { return global.GetInstanceInjectMa
 // SetInstanceInjectMastodonVersion safely sets the value for global configuration 'InstanceInjectMastodonVersion' field
 func SetInstanceInjectMastodonVersion(v bool) { global.SetInstanceInjectMastodonVersion(v) }
 
-// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
+// GetInstanceExposeLocalTimelineRSS safely fetches the Configuration value for state's 'InstanceExposeLocalTimelineRSS' field
+func (st *ConfigState) GetInstanceExposeLocalTimelineRSS() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsRegistrationOpen
+	v = st.config.InstanceExposeLocalTimelineRSS
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
-func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
+// SetInstanceExposeLocalTimelineRSS safely sets the Configuration value for state's 'InstanceExposeLocalTimelineRSS' field
+func (st *ConfigState) SetInstanceExposeLocalTimelineRSS(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsRegistrationOpen = v
+	st.config.InstanceExposeLocalTimelineRSS = v
 	st.reloadToViper()
 }
 
-// AccountsRegistrationOpenFlag returns the flag name for the 'AccountsRegistrationOpen' field
-func AccountsRegistrationOpenFlag() string { return "accounts-registration-open" }
+// InstanceExposeLocalTimelineRSSFlag returns the flag name for the 'InstanceExposeLocalTimelineRSS' field
+func InstanceExposeLocalTimelineRSSFlag() string { return "instance-expose-local-timeline-rss" }
 
-// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
-func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
+// GetInstanceExposeLocalTimelineRSS safely fetches the value for global configuration 'InstanceExposeLocalTimelineRSS' field
+func GetInstanceExposeLocalTimelineRSS() bool { return global.GetInstanceExposeLocalTimelineRSS() }
 
-// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
-func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
+// SetInstanceExposeLocalTimelineRSS safely sets the value for global configuration 'InstanceExposeLocalTimelineRSS' field
+func SetInstanceExposeLocalTimelineRSS(v bool) { global.SetInstanceExposeLocalTimelineRSS(v) }
 
-// GetAccountsApprovalRequired safely fetches the Configuration value for state's 'AccountsApprovalRequired' field
-func (st *ConfigState) GetAccountsApprovalRequired() (v bool) {
+// GetInstanceExposeTagRSS safely fetches the Configuration value for state's 'InstanceExposeTagRSS' field
+func (st *ConfigState) GetInstanceExposeTagRSS() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsApprovalRequired
+	v = st.config.InstanceExposeTagRSS
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsApprovalRequired safely sets the Configuration value for state's 'AccountsApprovalRequired' field
-func (st *ConfigState) SetAccountsApprovalRequired(v bool) {
+// SetInstanceExposeTagRSS safely sets the Configuration value for state's 'InstanceExposeTagRSS' field
+func (st *ConfigState) SetInstanceExposeTagRSS(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsApprovalRequired = v
+	st.config.InstanceExposeTagRSS = v
 	st.reloadToViper()
 }
 
-// AccountsApprovalRequiredFlag returns the flag name for the 'AccountsApprovalRequired' field
-func AccountsApprovalRequiredFlag() string { return "accounts-approval-required" }
+// InstanceExposeTagRSSFlag returns the flag name for the 'InstanceExposeTagRSS' field
+func InstanceExposeTagRSSFlag() string { return "instance-expose-tag-rss" }
 
-// GetAccountsApprovalRequired safely fetches the value for global configuration 'AccountsApprovalRequired' field
-func GetAccountsApprovalRequired() bool { return global.GetAccountsApprovalRequired() }
+// GetInstanceExposeTagRSS safely fetches the value for global configuration 'InstanceExposeTagRSS' field
+func GetInstanceExposeTagRSS() bool { return global.GetInstanceExposeTagRSS() }
 
-// SetAccountsApprovalRequired safely sets the value for global configuration 'AccountsApprovalRequired' field
-func SetAccountsApprovalRequired(v bool) { global.SetAccountsApprovalRequired(v) }
+// SetInstanceExposeTagRSS safely sets the value for global configuration 'InstanceExposeTagRSS' field
+func SetInstanceExposeTagRSS(v bool) { global.SetInstanceExposeTagRSS(v) }
 
-// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
+// GetInstanceNoIndexDefault safely fetches the Configuration value for state's 'InstanceNoIndexDefault' field
+func (st *ConfigState) GetInstanceNoIndexDefault() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsReasonRequired
+	v = st.config.InstanceNoIndexDefault
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
-func (st *ConfigState) SetAccountsReasonRequired(v bool) {
+// SetInstanceNoIndexDefault safely sets the Configuration value for state's 'InstanceNoIndexDefault' field
+func (st *ConfigState) SetInstanceNoIndexDefault(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsReasonRequired = v
+	st.config.InstanceNoIndexDefault = v
 	st.reloadToViper()
 }
 
-// AccountsReasonRequiredFlag returns the flag name for the 'AccountsReasonRequired' field
-func AccountsReasonRequiredFlag() string { return "accounts-reason-required" }
+// InstanceNoIndexDefaultFlag returns the flag name for the 'InstanceNoIndexDefault' field
+func InstanceNoIndexDefaultFlag() string { return "instance-noindex-default" }
 
-// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
-func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
+// GetInstanceNoIndexDefault safely fetches the value for global configuration 'InstanceNoIndexDefault' field
+func GetInstanceNoIndexDefault() bool { return global.GetInstanceNoIndexDefault() }
 
-// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
-func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
+// SetInstanceNoIndexDefault safely sets the value for global configuration 'InstanceNoIndexDefault' field
+func SetInstanceNoIndexDefault(v bool) { global.SetInstanceNoIndexDefault(v) }
 
-// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
+// GetInstanceEmojiReactions safely fetches the Configuration value for state's 'InstanceEmojiReactions' field
+func (st *ConfigState) GetInstanceEmojiReactions() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsAllowCustomCSS
+	v = st.config.InstanceEmojiReactions
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
-func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
+// SetInstanceEmojiReactions safely sets the Configuration value for state's 'InstanceEmojiReactions' field
+func (st *ConfigState) SetInstanceEmojiReactions(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsAllowCustomCSS = v
+	st.config.InstanceEmojiReactions = v
 	st.reloadToViper()
 }
 
-// AccountsAllowCustomCSSFlag returns the flag name for the 'AccountsAllowCustomCSS' field
-func AccountsAllowCustomCSSFlag() string { return "accounts-allow-custom-css" }
+// InstanceEmojiReactionsFlag returns the flag name for the 'InstanceEmojiReactions' field
+func InstanceEmojiReactionsFlag() string { return "instance-emoji-reactions" }
 
-// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
-func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
+// GetInstanceEmojiReactions safely fetches the value for global configuration 'InstanceEmojiReactions' field
+func GetInstanceEmojiReactions() bool { return global.GetInstanceEmojiReactions() }
 
-// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
-func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
+// SetInstanceEmojiReactions safely sets the value for global configuration 'InstanceEmojiReactions' field
+func SetInstanceEmojiReactions(v bool) { global.SetInstanceEmojiReactions(v) }
 
-// GetAccountsCustomCSSLength safely fetches the Configuration value for state's 'AccountsCustomCSSLength' field
-func (st *ConfigState) GetAccountsCustomCSSLength() (v int) {
+// GetInstanceInteractionCircle safely fetches the Configuration value for state's 'InstanceInteractionCircle' field
+func (st *ConfigState) GetInstanceInteractionCircle() (v bool) {
 	st.mutex.RLock()
-	v = st.config.AccountsCustomCSSLength
+	v = st.config.InstanceInteractionCircle
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAccountsCustomCSSLength safely sets the Configuration value for state's 'AccountsCustomCSSLength' field
-func (st *ConfigState) SetAccountsCustomCSSLength(v int) {
+// SetInstanceInteractionCircle safely sets the Configuration value for state's 'InstanceInteractionCircle' field
+func (st *ConfigState) SetInstanceInteractionCircle(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AccountsCustomCSSLength = v
+	st.config.InstanceInteractionCircle = v
 	st.reloadToViper()
 }
 
-// AccountsCustomCSSLengthFlag returns the flag name for the 'AccountsCustomCSSLength' field
-func AccountsCustomCSSLengthFlag() string { return "accounts-custom-css-length" }
+// InstanceInteractionCircleFlag returns the flag name for the 'InstanceInteractionCircle' field
+func InstanceInteractionCircleFlag() string { return "instance-interaction-circle" }
 
-// GetAccountsCustomCSSLength safely fetches the value for global configuration 'AccountsCustomCSSLength' field
-func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength() }
+// GetInstanceInteractionCircle safely fetches the value for global configuration 'InstanceInteractionCircle' field
+func GetInstanceInteractionCircle() bool { return global.GetInstanceInteractionCircle() }
 
-// SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
-func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
+// SetInstanceInteractionCircle safely sets the value for global configuration 'InstanceInteractionCircle' field
+func SetInstanceInteractionCircle(v bool) { global.SetInstanceInteractionCircle(v) }
 
-// GetMediaImageMaxSize safely fetches the Configuration value for state's 'MediaImageMaxSize' field
-func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
+// GetInstanceLanguages safely fetches the Configuration value for state's 'InstanceLanguages' field
+func (st *ConfigState) GetInstanceLanguages() (v []string) {
 	st.mutex.RLock()
-	v = st.config.MediaImageMaxSize
+	v = st.config.InstanceLanguages
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaImageMaxSize safely sets the Configuration value for state's 'MediaImageMaxSize' field
-func (st *ConfigState) SetMediaImageMaxSize(v bytesize.Size) {
+// SetInstanceLanguages safely sets the Configuration value for state's 'InstanceLanguages' field
+func (st *ConfigState) SetInstanceLanguages(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaImageMaxSize = v
+	st.config.InstanceLanguages = v
 	st.reloadToViper()
 }
 
-// MediaImageMaxSizeFlag returns the flag name for the 'MediaImageMaxSize' field
-func MediaImageMaxSizeFlag() string { return "media-image-max-size" }
+// InstanceLanguagesFlag returns the flag name for the 'InstanceLanguages' field
+func InstanceLanguagesFlag() string { return "instance-languages" }
 
-// GetMediaImageMaxSize safely fetches the value for global configuration 'MediaImageMaxSize' field
-func GetMediaImageMaxSize() bytesize.Size { return global.GetMediaImageMaxSize() }
+// GetInstanceLanguages safely fetches the value for global configuration 'InstanceLanguages' field
+func GetInstanceLanguages() []string { return global.GetInstanceLanguages() }
 
-// SetMediaImageMaxSize safely sets the value for global configuration 'MediaImageMaxSize' field
-func SetMediaImageMaxSize(v bytesize.Size) { global.SetMediaImageMaxSize(v) }
+// SetInstanceLanguages safely sets the value for global configuration 'InstanceLanguages' field
+func SetInstanceLanguages(v []string) { global.SetInstanceLanguages(v) }
 
-// GetMediaVideoMaxSize safely fetches the Configuration value for state's 'MediaVideoMaxSize' field
-func (st *ConfigState) GetMediaVideoMaxSize() (v bytesize.Size) {
+// GetInstanceCategories safely fetches the Configuration value for state's 'InstanceCategories' field
+func (st *ConfigState) GetInstanceCategories() (v []string) {
 	st.mutex.RLock()
-	v = st.config.MediaVideoMaxSize
+	v = st.config.InstanceCategories
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaVideoMaxSize safely sets the Configuration value for state's 'MediaVideoMaxSize' field
-func (st *ConfigState) SetMediaVideoMaxSize(v bytesize.Size) {
+// SetInstanceCategories safely sets the Configuration value for state's 'InstanceCategories' field
+func (st *ConfigState) SetInstanceCategories(v []string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaVideoMaxSize = v
+	st.config.InstanceCategories = v
 	st.reloadToViper()
 }
 
-// MediaVideoMaxSizeFlag returns the flag name for the 'MediaVideoMaxSize' field
-func MediaVideoMaxSizeFlag() string { return "media-video-max-size" }
+// InstanceCategoriesFlag returns the flag name for the 'InstanceCategories' field
+func InstanceCategoriesFlag() string { return "instance-categories" }
 
-// GetMediaVideoMaxSize safely fetches the value for global configuration 'MediaVideoMaxSize' field
-func GetMediaVideoMaxSize() bytesize.Size { return global.GetMediaVideoMaxSize() }
+// GetInstanceCategories safely fetches the value for global configuration 'InstanceCategories' field
+func GetInstanceCategories() []string { return global.GetInstanceCategories() }
 
-// SetMediaVideoMaxSize safely sets the value for global configuration 'MediaVideoMaxSize' field
-func SetMediaVideoMaxSize(v bytesize.Size) { global.SetMediaVideoMaxSize(v) }
+// SetInstanceCategories safely sets the value for global configuration 'InstanceCategories' field
+func SetInstanceCategories(v []string) { global.SetInstanceCategories(v) }
 
-// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
-func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+// GetInstanceNewDomainQuarantine safely fetches the Configuration value for state's 'InstanceNewDomainQuarantine' field
+func (st *ConfigState) GetInstanceNewDomainQuarantine() (v bool) {
 	st.mutex.RLock()
-	v = st.config.MediaDescriptionMinChars
+	v = st.config.InstanceNewDomainQuarantine
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'MediaDescriptionMinChars' field
-func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+// SetInstanceNewDomainQuarantine safely sets the Configuration value for state's 'InstanceNewDomainQuarantine' field
+func (st *ConfigState) SetInstanceNewDomainQuarantine(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaDescriptionMinChars = v
+	st.config.InstanceNewDomainQuarantine = v
 	st.reloadToViper()
 }
 
-// MediaDescriptionMinCharsFlag returns the flag name for the 'MediaDescriptionMinChars' field
-func MediaDescriptionMinCharsFlag() string { return "media-description-min-chars" }
+// InstanceNewDomainQuarantineFlag returns the flag name for the 'InstanceNewDomainQuarantine' field
+func InstanceNewDomainQuarantineFlag() string { return "instance-new-domain-quarantine" }
 
-// GetMediaDescriptionMinChars safely fetches the value for global configuration 'MediaDescriptionMinChars' field
-func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+// GetInstanceNewDomainQuarantine safely fetches the value for global configuration 'InstanceNewDomainQuarantine' field
+func GetInstanceNewDomainQuarantine() bool { return global.GetInstanceNewDomainQuarantine() }
 
-// SetMediaDescriptionMinChars safely sets the value for global configuration 'MediaDescriptionMinChars' field
-func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+// SetInstanceNewDomainQuarantine safely sets the value for global configuration 'InstanceNewDomainQuarantine' field
+func SetInstanceNewDomainQuarantine(v bool) { global.SetInstanceNewDomainQuarantine(v) }
 
-// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'MediaDescriptionMaxChars' field
-func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+// GetInstanceNewDomainAutoApprove safely fetches the Configuration value for state's 'InstanceNewDomainAutoApprove' field
+func (st *ConfigState) GetInstanceNewDomainAutoApprove() (v int) {
 	st.mutex.RLock()
-	v = st.config.MediaDescriptionMaxChars
+	v = st.config.InstanceNewDomainAutoApprove
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'MediaDescriptionMaxChars' field
-func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+// SetInstanceNewDomainAutoApprove safely sets the Configuration value for state's 'InstanceNewDomainAutoApprove' field
+func (st *ConfigState) SetInstanceNewDomainAutoApprove(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaDescriptionMaxChars = v
+	st.config.InstanceNewDomainAutoApprove = v
 	st.reloadToViper()
 }
 
-// MediaDescriptionMaxCharsFlag returns the flag name for the 'MediaDescriptionMaxChars' field
-func MediaDescriptionMaxCharsFlag() string { return "media-description-max-chars" }
+// InstanceNewDomainAutoApproveFlag returns the flag name for the 'InstanceNewDomainAutoApprove' field
+func InstanceNewDomainAutoApproveFlag() string { return "instance-new-domain-auto-approve" }
 
-// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'MediaDescriptionMaxChars' field
-func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+// GetInstanceNewDomainAutoApprove safely fetches the value for global configuration 'InstanceNewDomainAutoApprove' field
+func GetInstanceNewDomainAutoApprove() int { return global.GetInstanceNewDomainAutoApprove() }
 
-// SetMediaDescriptionMaxChars safely sets the value for global configuration 'MediaDescriptionMaxChars' field
-func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+// SetInstanceNewDomainAutoApprove safely sets the value for global configuration 'InstanceNewDomainAutoApprove' field
+func SetInstanceNewDomainAutoApprove(v int) { global.SetInstanceNewDomainAutoApprove(v) }
 
-// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'MediaRemoteCacheDays' field
-func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+// GetInstanceDeadDeliveryFailures safely fetches the Configuration value for state's 'InstanceDeadDeliveryFailures' field
+func (st *ConfigState) GetInstanceDeadDeliveryFailures() (v int) {
 	st.mutex.RLock()
-	v = st.config.MediaRemoteCacheDays
+	v = st.config.InstanceDeadDeliveryFailures
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'MediaRemoteCacheDays' field
+// SetInstanceDeadDeliveryFailures safely sets the Configuration value for state's 'InstanceDeadDeliveryFailures' field
+func (st *ConfigState) SetInstanceDeadDeliveryFailures(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceDeadDeliveryFailures = v
+	st.reloadToViper()
+}
+
+// InstanceDeadDeliveryFailuresFlag returns the flag name for the 'InstanceDeadDeliveryFailures' field
+func InstanceDeadDeliveryFailuresFlag() string { return "instance-dead-delivery-failures" }
+
+// GetInstanceDeadDeliveryFailures safely fetches the value for global configuration 'InstanceDeadDeliveryFailures' field
+func GetInstanceDeadDeliveryFailures() int { return global.GetInstanceDeadDeliveryFailures() }
+
+// SetInstanceDeadDeliveryFailures safely sets the value for global configuration 'InstanceDeadDeliveryFailures' field
+func SetInstanceDeadDeliveryFailures(v int) { global.SetInstanceDeadDeliveryFailures(v) }
+
+// GetInstanceDeadDeliveryWindow safely fetches the Configuration value for state's 'InstanceDeadDeliveryWindow' field
+func (st *ConfigState) GetInstanceDeadDeliveryWindow() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceDeadDeliveryWindow
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceDeadDeliveryWindow safely sets the Configuration value for state's 'InstanceDeadDeliveryWindow' field
+func (st *ConfigState) SetInstanceDeadDeliveryWindow(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceDeadDeliveryWindow = v
+	st.reloadToViper()
+}
+
+// InstanceDeadDeliveryWindowFlag returns the flag name for the 'InstanceDeadDeliveryWindow' field
+func InstanceDeadDeliveryWindowFlag() string { return "instance-dead-delivery-window" }
+
+// GetInstanceDeadDeliveryWindow safely fetches the value for global configuration 'InstanceDeadDeliveryWindow' field
+func GetInstanceDeadDeliveryWindow() time.Duration { return global.GetInstanceDeadDeliveryWindow() }
+
+// SetInstanceDeadDeliveryWindow safely sets the value for global configuration 'InstanceDeadDeliveryWindow' field
+func SetInstanceDeadDeliveryWindow(v time.Duration) { global.SetInstanceDeadDeliveryWindow(v) }
+
+// GetInstanceOptimisticIngestion safely fetches the Configuration value for state's 'InstanceOptimisticIngestion' field
+func (st *ConfigState) GetInstanceOptimisticIngestion() (v bool) {
+	st.mutex.RLock()
+	v = st.config.InstanceOptimisticIngestion
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceOptimisticIngestion safely sets the Configuration value for state's 'InstanceOptimisticIngestion' field
+func (st *ConfigState) SetInstanceOptimisticIngestion(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceOptimisticIngestion = v
+	st.reloadToViper()
+}
+
+// InstanceOptimisticIngestionFlag returns the flag name for the 'InstanceOptimisticIngestion' field
+func InstanceOptimisticIngestionFlag() string { return "instance-optimistic-ingestion" }
+
+// GetInstanceOptimisticIngestion safely fetches the value for global configuration 'InstanceOptimisticIngestion' field
+func GetInstanceOptimisticIngestion() bool { return global.GetInstanceOptimisticIngestion() }
+
+// SetInstanceOptimisticIngestion safely sets the value for global configuration 'InstanceOptimisticIngestion' field
+func SetInstanceOptimisticIngestion(v bool) { global.SetInstanceOptimisticIngestion(v) }
+
+// GetInstanceRemoteAccountFreshness safely fetches the Configuration value for state's 'InstanceRemoteAccountFreshness' field
+func (st *ConfigState) GetInstanceRemoteAccountFreshness() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceRemoteAccountFreshness
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceRemoteAccountFreshness safely sets the Configuration value for state's 'InstanceRemoteAccountFreshness' field
+func (st *ConfigState) SetInstanceRemoteAccountFreshness(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceRemoteAccountFreshness = v
+	st.reloadToViper()
+}
+
+// InstanceRemoteAccountFreshnessFlag returns the flag name for the 'InstanceRemoteAccountFreshness' field
+func InstanceRemoteAccountFreshnessFlag() string { return "instance-remote-account-freshness" }
+
+// GetInstanceRemoteAccountFreshness safely fetches the value for global configuration 'InstanceRemoteAccountFreshness' field
+func GetInstanceRemoteAccountFreshness() time.Duration {
+	return global.GetInstanceRemoteAccountFreshness()
+}
+
+// SetInstanceRemoteAccountFreshness safely sets the value for global configuration 'InstanceRemoteAccountFreshness' field
+func SetInstanceRemoteAccountFreshness(v time.Duration) { global.SetInstanceRemoteAccountFreshness(v) }
+
+// GetInstanceRemoteStatusFreshness safely fetches the Configuration value for state's 'InstanceRemoteStatusFreshness' field
+func (st *ConfigState) GetInstanceRemoteStatusFreshness() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.InstanceRemoteStatusFreshness
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceRemoteStatusFreshness safely sets the Configuration value for state's 'InstanceRemoteStatusFreshness' field
+func (st *ConfigState) SetInstanceRemoteStatusFreshness(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceRemoteStatusFreshness = v
+	st.reloadToViper()
+}
+
+// InstanceRemoteStatusFreshnessFlag returns the flag name for the 'InstanceRemoteStatusFreshness' field
+func InstanceRemoteStatusFreshnessFlag() string { return "instance-remote-status-freshness" }
+
+// GetInstanceRemoteStatusFreshness safely fetches the value for global configuration 'InstanceRemoteStatusFreshness' field
+func GetInstanceRemoteStatusFreshness() time.Duration {
+	return global.GetInstanceRemoteStatusFreshness()
+}
+
+// SetInstanceRemoteStatusFreshness safely sets the value for global configuration 'InstanceRemoteStatusFreshness' field
+func SetInstanceRemoteStatusFreshness(v time.Duration) { global.SetInstanceRemoteStatusFreshness(v) }
+
+// GetInstanceRemoteRefreshPerDomain safely fetches the Configuration value for state's 'InstanceRemoteRefreshPerDomain' field
+func (st *ConfigState) GetInstanceRemoteRefreshPerDomain() (v int) {
+	st.mutex.RLock()
+	v = st.config.InstanceRemoteRefreshPerDomain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetInstanceRemoteRefreshPerDomain safely sets the Configuration value for state's 'InstanceRemoteRefreshPerDomain' field
+func (st *ConfigState) SetInstanceRemoteRefreshPerDomain(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceRemoteRefreshPerDomain = v
+	st.reloadToViper()
+}
+
+// InstanceRemoteRefreshPerDomainFlag returns the flag name for the 'InstanceRemoteRefreshPerDomain' field
+func InstanceRemoteRefreshPerDomainFlag() string { return "instance-remote-refresh-per-domain" }
+
+// GetInstanceRemoteRefreshPerDomain safely fetches the value for global configuration 'InstanceRemoteRefreshPerDomain' field
+func GetInstanceRemoteRefreshPerDomain() int { return global.GetInstanceRemoteRefreshPerDomain() }
+
+// SetInstanceRemoteRefreshPerDomain safely sets the value for global configuration 'InstanceRemoteRefreshPerDomain' field
+func SetInstanceRemoteRefreshPerDomain(v int) { global.SetInstanceRemoteRefreshPerDomain(v) }
+
+// GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsRegistrationOpen
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsRegistrationOpen safely sets the Configuration value for state's 'AccountsRegistrationOpen' field
+func (st *ConfigState) SetAccountsRegistrationOpen(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsRegistrationOpen = v
+	st.reloadToViper()
+}
+
+// AccountsRegistrationOpenFlag returns the flag name for the 'AccountsRegistrationOpen' field
+func AccountsRegistrationOpenFlag() string { return "accounts-registration-open" }
+
+// GetAccountsRegistrationOpen safely fetches the value for global configuration 'AccountsRegistrationOpen' field
+func GetAccountsRegistrationOpen() bool { return global.GetAccountsRegistrationOpen() }
+
+// SetAccountsRegistrationOpen safely sets the value for global configuration 'AccountsRegistrationOpen' field
+func SetAccountsRegistrationOpen(v bool) { global.SetAccountsRegistrationOpen(v) }
+
+// GetAccountsApprovalRequired safely fetches the Configuration value for state's 'AccountsApprovalRequired' field
+func (st *ConfigState) GetAccountsApprovalRequired() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsApprovalRequired
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsApprovalRequired safely sets the Configuration value for state's 'AccountsApprovalRequired' field
+func (st *ConfigState) SetAccountsApprovalRequired(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsApprovalRequired = v
+	st.reloadToViper()
+}
+
+// AccountsApprovalRequiredFlag returns the flag name for the 'AccountsApprovalRequired' field
+func AccountsApprovalRequiredFlag() string { return "accounts-approval-required" }
+
+// GetAccountsApprovalRequired safely fetches the value for global configuration 'AccountsApprovalRequired' field
+func GetAccountsApprovalRequired() bool { return global.GetAccountsApprovalRequired() }
+
+// SetAccountsApprovalRequired safely sets the value for global configuration 'AccountsApprovalRequired' field
+func SetAccountsApprovalRequired(v bool) { global.SetAccountsApprovalRequired(v) }
+
+// GetAccountsReasonRequired safely fetches the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) GetAccountsReasonRequired() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsReasonRequired
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsReasonRequired safely sets the Configuration value for state's 'AccountsReasonRequired' field
+func (st *ConfigState) SetAccountsReasonRequired(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsReasonRequired = v
+	st.reloadToViper()
+}
+
+// AccountsReasonRequiredFlag returns the flag name for the 'AccountsReasonRequired' field
+func AccountsReasonRequiredFlag() string { return "accounts-reason-required" }
+
+// GetAccountsReasonRequired safely fetches the value for global configuration 'AccountsReasonRequired' field
+func GetAccountsReasonRequired() bool { return global.GetAccountsReasonRequired() }
+
+// SetAccountsReasonRequired safely sets the value for global configuration 'AccountsReasonRequired' field
+func SetAccountsReasonRequired(v bool) { global.SetAccountsReasonRequired(v) }
+
+// GetAccountsAllowCustomCSS safely fetches the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) GetAccountsAllowCustomCSS() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsAllowCustomCSS
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsAllowCustomCSS safely sets the Configuration value for state's 'AccountsAllowCustomCSS' field
+func (st *ConfigState) SetAccountsAllowCustomCSS(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsAllowCustomCSS = v
+	st.reloadToViper()
+}
+
+// AccountsAllowCustomCSSFlag returns the flag name for the 'AccountsAllowCustomCSS' field
+func AccountsAllowCustomCSSFlag() string { return "accounts-allow-custom-css" }
+
+// GetAccountsAllowCustomCSS safely fetches the value for global configuration 'AccountsAllowCustomCSS' field
+func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS() }
+
+// SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
+func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
+
+// GetAccountsCustomCSSLength safely fetches the Configuration value for state's 'AccountsCustomCSSLength' field
+func (st *ConfigState) GetAccountsCustomCSSLength() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsCustomCSSLength
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsCustomCSSLength safely sets the Configuration value for state's 'AccountsCustomCSSLength' field
+func (st *ConfigState) SetAccountsCustomCSSLength(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsCustomCSSLength = v
+	st.reloadToViper()
+}
+
+// AccountsCustomCSSLengthFlag returns the flag name for the 'AccountsCustomCSSLength' field
+func AccountsCustomCSSLengthFlag() string { return "accounts-custom-css-length" }
+
+// GetAccountsCustomCSSLength safely fetches the value for global configuration 'AccountsCustomCSSLength' field
+func GetAccountsCustomCSSLength() int { return global.GetAccountsCustomCSSLength() }
+
+// SetAccountsCustomCSSLength safely sets the value for global configuration 'AccountsCustomCSSLength' field
+func SetAccountsCustomCSSLength(v int) { global.SetAccountsCustomCSSLength(v) }
+
+// GetAccountsActivityLimitWindow safely fetches the Configuration value for state's 'AccountsActivityLimitWindow' field
+func (st *ConfigState) GetAccountsActivityLimitWindow() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitWindow
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitWindow safely sets the Configuration value for state's 'AccountsActivityLimitWindow' field
+func (st *ConfigState) SetAccountsActivityLimitWindow(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitWindow = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitWindowFlag returns the flag name for the 'AccountsActivityLimitWindow' field
+func AccountsActivityLimitWindowFlag() string { return "accounts-activity-limit-window" }
+
+// GetAccountsActivityLimitWindow safely fetches the value for global configuration 'AccountsActivityLimitWindow' field
+func GetAccountsActivityLimitWindow() time.Duration { return global.GetAccountsActivityLimitWindow() }
+
+// SetAccountsActivityLimitWindow safely sets the value for global configuration 'AccountsActivityLimitWindow' field
+func SetAccountsActivityLimitWindow(v time.Duration) { global.SetAccountsActivityLimitWindow(v) }
+
+// GetAccountsActivityLimitDuration safely fetches the Configuration value for state's 'AccountsActivityLimitDuration' field
+func (st *ConfigState) GetAccountsActivityLimitDuration() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitDuration
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitDuration safely sets the Configuration value for state's 'AccountsActivityLimitDuration' field
+func (st *ConfigState) SetAccountsActivityLimitDuration(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitDuration = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitDurationFlag returns the flag name for the 'AccountsActivityLimitDuration' field
+func AccountsActivityLimitDurationFlag() string { return "accounts-activity-limit-duration" }
+
+// GetAccountsActivityLimitDuration safely fetches the value for global configuration 'AccountsActivityLimitDuration' field
+func GetAccountsActivityLimitDuration() time.Duration {
+	return global.GetAccountsActivityLimitDuration()
+}
+
+// SetAccountsActivityLimitDuration safely sets the value for global configuration 'AccountsActivityLimitDuration' field
+func SetAccountsActivityLimitDuration(v time.Duration) { global.SetAccountsActivityLimitDuration(v) }
+
+// GetAccountsActivityLimitUserFollows safely fetches the Configuration value for state's 'AccountsActivityLimitUserFollows' field
+func (st *ConfigState) GetAccountsActivityLimitUserFollows() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitUserFollows
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitUserFollows safely sets the Configuration value for state's 'AccountsActivityLimitUserFollows' field
+func (st *ConfigState) SetAccountsActivityLimitUserFollows(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitUserFollows = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitUserFollowsFlag returns the flag name for the 'AccountsActivityLimitUserFollows' field
+func AccountsActivityLimitUserFollowsFlag() string { return "accounts-activity-limit-user-follows" }
+
+// GetAccountsActivityLimitUserFollows safely fetches the value for global configuration 'AccountsActivityLimitUserFollows' field
+func GetAccountsActivityLimitUserFollows() int { return global.GetAccountsActivityLimitUserFollows() }
+
+// SetAccountsActivityLimitUserFollows safely sets the value for global configuration 'AccountsActivityLimitUserFollows' field
+func SetAccountsActivityLimitUserFollows(v int) { global.SetAccountsActivityLimitUserFollows(v) }
+
+// GetAccountsActivityLimitUserDirectMessages safely fetches the Configuration value for state's 'AccountsActivityLimitUserDirectMessages' field
+func (st *ConfigState) GetAccountsActivityLimitUserDirectMessages() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitUserDirectMessages
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitUserDirectMessages safely sets the Configuration value for state's 'AccountsActivityLimitUserDirectMessages' field
+func (st *ConfigState) SetAccountsActivityLimitUserDirectMessages(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitUserDirectMessages = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitUserDirectMessagesFlag returns the flag name for the 'AccountsActivityLimitUserDirectMessages' field
+func AccountsActivityLimitUserDirectMessagesFlag() string {
+	return "accounts-activity-limit-user-direct-messages"
+}
+
+// GetAccountsActivityLimitUserDirectMessages safely fetches the value for global configuration 'AccountsActivityLimitUserDirectMessages' field
+func GetAccountsActivityLimitUserDirectMessages() int {
+	return global.GetAccountsActivityLimitUserDirectMessages()
+}
+
+// SetAccountsActivityLimitUserDirectMessages safely sets the value for global configuration 'AccountsActivityLimitUserDirectMessages' field
+func SetAccountsActivityLimitUserDirectMessages(v int) {
+	global.SetAccountsActivityLimitUserDirectMessages(v)
+}
+
+// GetAccountsActivityLimitModeratorFollows safely fetches the Configuration value for state's 'AccountsActivityLimitModeratorFollows' field
+func (st *ConfigState) GetAccountsActivityLimitModeratorFollows() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitModeratorFollows
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitModeratorFollows safely sets the Configuration value for state's 'AccountsActivityLimitModeratorFollows' field
+func (st *ConfigState) SetAccountsActivityLimitModeratorFollows(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitModeratorFollows = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitModeratorFollowsFlag returns the flag name for the 'AccountsActivityLimitModeratorFollows' field
+func AccountsActivityLimitModeratorFollowsFlag() string {
+	return "accounts-activity-limit-moderator-follows"
+}
+
+// GetAccountsActivityLimitModeratorFollows safely fetches the value for global configuration 'AccountsActivityLimitModeratorFollows' field
+func GetAccountsActivityLimitModeratorFollows() int {
+	return global.GetAccountsActivityLimitModeratorFollows()
+}
+
+// SetAccountsActivityLimitModeratorFollows safely sets the value for global configuration 'AccountsActivityLimitModeratorFollows' field
+func SetAccountsActivityLimitModeratorFollows(v int) {
+	global.SetAccountsActivityLimitModeratorFollows(v)
+}
+
+// GetAccountsActivityLimitModeratorDirectMessages safely fetches the Configuration value for state's 'AccountsActivityLimitModeratorDirectMessages' field
+func (st *ConfigState) GetAccountsActivityLimitModeratorDirectMessages() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitModeratorDirectMessages
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitModeratorDirectMessages safely sets the Configuration value for state's 'AccountsActivityLimitModeratorDirectMessages' field
+func (st *ConfigState) SetAccountsActivityLimitModeratorDirectMessages(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitModeratorDirectMessages = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitModeratorDirectMessagesFlag returns the flag name for the 'AccountsActivityLimitModeratorDirectMessages' field
+func AccountsActivityLimitModeratorDirectMessagesFlag() string {
+	return "accounts-activity-limit-moderator-direct-messages"
+}
+
+// GetAccountsActivityLimitModeratorDirectMessages safely fetches the value for global configuration 'AccountsActivityLimitModeratorDirectMessages' field
+func GetAccountsActivityLimitModeratorDirectMessages() int {
+	return global.GetAccountsActivityLimitModeratorDirectMessages()
+}
+
+// SetAccountsActivityLimitModeratorDirectMessages safely sets the value for global configuration 'AccountsActivityLimitModeratorDirectMessages' field
+func SetAccountsActivityLimitModeratorDirectMessages(v int) {
+	global.SetAccountsActivityLimitModeratorDirectMessages(v)
+}
+
+// GetAccountsActivityLimitAdminFollows safely fetches the Configuration value for state's 'AccountsActivityLimitAdminFollows' field
+func (st *ConfigState) GetAccountsActivityLimitAdminFollows() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitAdminFollows
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitAdminFollows safely sets the Configuration value for state's 'AccountsActivityLimitAdminFollows' field
+func (st *ConfigState) SetAccountsActivityLimitAdminFollows(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitAdminFollows = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitAdminFollowsFlag returns the flag name for the 'AccountsActivityLimitAdminFollows' field
+func AccountsActivityLimitAdminFollowsFlag() string { return "accounts-activity-limit-admin-follows" }
+
+// GetAccountsActivityLimitAdminFollows safely fetches the value for global configuration 'AccountsActivityLimitAdminFollows' field
+func GetAccountsActivityLimitAdminFollows() int { return global.GetAccountsActivityLimitAdminFollows() }
+
+// SetAccountsActivityLimitAdminFollows safely sets the value for global configuration 'AccountsActivityLimitAdminFollows' field
+func SetAccountsActivityLimitAdminFollows(v int) { global.SetAccountsActivityLimitAdminFollows(v) }
+
+// GetAccountsActivityLimitAdminDirectMessages safely fetches the Configuration value for state's 'AccountsActivityLimitAdminDirectMessages' field
+func (st *ConfigState) GetAccountsActivityLimitAdminDirectMessages() (v int) {
+	st.mutex.RLock()
+	v = st.config.AccountsActivityLimitAdminDirectMessages
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsActivityLimitAdminDirectMessages safely sets the Configuration value for state's 'AccountsActivityLimitAdminDirectMessages' field
+func (st *ConfigState) SetAccountsActivityLimitAdminDirectMessages(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsActivityLimitAdminDirectMessages = v
+	st.reloadToViper()
+}
+
+// AccountsActivityLimitAdminDirectMessagesFlag returns the flag name for the 'AccountsActivityLimitAdminDirectMessages' field
+func AccountsActivityLimitAdminDirectMessagesFlag() string {
+	return "accounts-activity-limit-admin-direct-messages"
+}
+
+// GetAccountsActivityLimitAdminDirectMessages safely fetches the value for global configuration 'AccountsActivityLimitAdminDirectMessages' field
+func GetAccountsActivityLimitAdminDirectMessages() int {
+	return global.GetAccountsActivityLimitAdminDirectMessages()
+}
+
+// SetAccountsActivityLimitAdminDirectMessages safely sets the value for global configuration 'AccountsActivityLimitAdminDirectMessages' field
+func SetAccountsActivityLimitAdminDirectMessages(v int) {
+	global.SetAccountsActivityLimitAdminDirectMessages(v)
+}
+
+// GetAccountsInteractionCooldown safely fetches the Configuration value for state's 'AccountsInteractionCooldown' field
+func (st *ConfigState) GetAccountsInteractionCooldown() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AccountsInteractionCooldown
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsInteractionCooldown safely sets the Configuration value for state's 'AccountsInteractionCooldown' field
+func (st *ConfigState) SetAccountsInteractionCooldown(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsInteractionCooldown = v
+	st.reloadToViper()
+}
+
+// AccountsInteractionCooldownFlag returns the flag name for the 'AccountsInteractionCooldown' field
+func AccountsInteractionCooldownFlag() string { return "accounts-interaction-cooldown" }
+
+// GetAccountsInteractionCooldown safely fetches the value for global configuration 'AccountsInteractionCooldown' field
+func GetAccountsInteractionCooldown() time.Duration { return global.GetAccountsInteractionCooldown() }
+
+// SetAccountsInteractionCooldown safely sets the value for global configuration 'AccountsInteractionCooldown' field
+func SetAccountsInteractionCooldown(v time.Duration) { global.SetAccountsInteractionCooldown(v) }
+
+// GetAccountsRefreshCooldown safely fetches the Configuration value for state's 'AccountsRefreshCooldown' field
+func (st *ConfigState) GetAccountsRefreshCooldown() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.AccountsRefreshCooldown
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsRefreshCooldown safely sets the Configuration value for state's 'AccountsRefreshCooldown' field
+func (st *ConfigState) SetAccountsRefreshCooldown(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsRefreshCooldown = v
+	st.reloadToViper()
+}
+
+// AccountsRefreshCooldownFlag returns the flag name for the 'AccountsRefreshCooldown' field
+func AccountsRefreshCooldownFlag() string { return "accounts-refresh-cooldown" }
+
+// GetAccountsRefreshCooldown safely fetches the value for global configuration 'AccountsRefreshCooldown' field
+func GetAccountsRefreshCooldown() time.Duration { return global.GetAccountsRefreshCooldown() }
+
+// SetAccountsRefreshCooldown safely sets the value for global configuration 'AccountsRefreshCooldown' field
+func SetAccountsRefreshCooldown(v time.Duration) { global.SetAccountsRefreshCooldown(v) }
+
+// GetAccountsChallengeProvider safely fetches the Configuration value for state's 'AccountsChallengeProvider' field
+func (st *ConfigState) GetAccountsChallengeProvider() (v string) {
+	st.mutex.RLock()
+	v = st.config.AccountsChallengeProvider
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsChallengeProvider safely sets the Configuration value for state's 'AccountsChallengeProvider' field
+func (st *ConfigState) SetAccountsChallengeProvider(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsChallengeProvider = v
+	st.reloadToViper()
+}
+
+// AccountsChallengeProviderFlag returns the flag name for the 'AccountsChallengeProvider' field
+func AccountsChallengeProviderFlag() string { return "accounts-challenge-provider" }
+
+// GetAccountsChallengeProvider safely fetches the value for global configuration 'AccountsChallengeProvider' field
+func GetAccountsChallengeProvider() string { return global.GetAccountsChallengeProvider() }
+
+// SetAccountsChallengeProvider safely sets the value for global configuration 'AccountsChallengeProvider' field
+func SetAccountsChallengeProvider(v string) { global.SetAccountsChallengeProvider(v) }
+
+// GetAccountsChallengeSiteKey safely fetches the Configuration value for state's 'AccountsChallengeSiteKey' field
+func (st *ConfigState) GetAccountsChallengeSiteKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.AccountsChallengeSiteKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsChallengeSiteKey safely sets the Configuration value for state's 'AccountsChallengeSiteKey' field
+func (st *ConfigState) SetAccountsChallengeSiteKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsChallengeSiteKey = v
+	st.reloadToViper()
+}
+
+// AccountsChallengeSiteKeyFlag returns the flag name for the 'AccountsChallengeSiteKey' field
+func AccountsChallengeSiteKeyFlag() string { return "accounts-challenge-site-key" }
+
+// GetAccountsChallengeSiteKey safely fetches the value for global configuration 'AccountsChallengeSiteKey' field
+func GetAccountsChallengeSiteKey() string { return global.GetAccountsChallengeSiteKey() }
+
+// SetAccountsChallengeSiteKey safely sets the value for global configuration 'AccountsChallengeSiteKey' field
+func SetAccountsChallengeSiteKey(v string) { global.SetAccountsChallengeSiteKey(v) }
+
+// GetAccountsChallengeSecretKey safely fetches the Configuration value for state's 'AccountsChallengeSecretKey' field
+func (st *ConfigState) GetAccountsChallengeSecretKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.AccountsChallengeSecretKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsChallengeSecretKey safely sets the Configuration value for state's 'AccountsChallengeSecretKey' field
+func (st *ConfigState) SetAccountsChallengeSecretKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsChallengeSecretKey = v
+	st.reloadToViper()
+}
+
+// AccountsChallengeSecretKeyFlag returns the flag name for the 'AccountsChallengeSecretKey' field
+func AccountsChallengeSecretKeyFlag() string { return "accounts-challenge-secret-key" }
+
+// GetAccountsChallengeSecretKey safely fetches the value for global configuration 'AccountsChallengeSecretKey' field
+func GetAccountsChallengeSecretKey() string { return global.GetAccountsChallengeSecretKey() }
+
+// SetAccountsChallengeSecretKey safely sets the value for global configuration 'AccountsChallengeSecretKey' field
+func SetAccountsChallengeSecretKey(v string) { global.SetAccountsChallengeSecretKey(v) }
+
+// GetAccountsChallengeAppTokens safely fetches the Configuration value for state's 'AccountsChallengeAppTokens' field
+func (st *ConfigState) GetAccountsChallengeAppTokens() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AccountsChallengeAppTokens
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAccountsChallengeAppTokens safely sets the Configuration value for state's 'AccountsChallengeAppTokens' field
+func (st *ConfigState) SetAccountsChallengeAppTokens(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsChallengeAppTokens = v
+	st.reloadToViper()
+}
+
+// AccountsChallengeAppTokensFlag returns the flag name for the 'AccountsChallengeAppTokens' field
+func AccountsChallengeAppTokensFlag() string { return "accounts-challenge-app-tokens" }
+
+// GetAccountsChallengeAppTokens safely fetches the value for global configuration 'AccountsChallengeAppTokens' field
+func GetAccountsChallengeAppTokens() bool { return global.GetAccountsChallengeAppTokens() }
+
+// SetAccountsChallengeAppTokens safely sets the value for global configuration 'AccountsChallengeAppTokens' field
+func SetAccountsChallengeAppTokens(v bool) { global.SetAccountsChallengeAppTokens(v) }
+
+// GetMediaImageMaxSize safely fetches the Configuration value for state's 'MediaImageMaxSize' field
+func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaImageMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaImageMaxSize safely sets the Configuration value for state's 'MediaImageMaxSize' field
+func (st *ConfigState) SetMediaImageMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaImageMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaImageMaxSizeFlag returns the flag name for the 'MediaImageMaxSize' field
+func MediaImageMaxSizeFlag() string { return "media-image-max-size" }
+
+// GetMediaImageMaxSize safely fetches the value for global configuration 'MediaImageMaxSize' field
+func GetMediaImageMaxSize() bytesize.Size { return global.GetMediaImageMaxSize() }
+
+// SetMediaImageMaxSize safely sets the value for global configuration 'MediaImageMaxSize' field
+func SetMediaImageMaxSize(v bytesize.Size) { global.SetMediaImageMaxSize(v) }
+
+// GetMediaVideoMaxSize safely fetches the Configuration value for state's 'MediaVideoMaxSize' field
+func (st *ConfigState) GetMediaVideoMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaVideoMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaVideoMaxSize safely sets the Configuration value for state's 'MediaVideoMaxSize' field
+func (st *ConfigState) SetMediaVideoMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaVideoMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaVideoMaxSizeFlag returns the flag name for the 'MediaVideoMaxSize' field
+func MediaVideoMaxSizeFlag() string { return "media-video-max-size" }
+
+// GetMediaVideoMaxSize safely fetches the value for global configuration 'MediaVideoMaxSize' field
+func GetMediaVideoMaxSize() bytesize.Size { return global.GetMediaVideoMaxSize() }
+
+// SetMediaVideoMaxSize safely sets the value for global configuration 'MediaVideoMaxSize' field
+func SetMediaVideoMaxSize(v bytesize.Size) { global.SetMediaVideoMaxSize(v) }
+
+// GetMediaImageMaxDimension safely fetches the Configuration value for state's 'MediaImageMaxDimension' field
+func (st *ConfigState) GetMediaImageMaxDimension() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaImageMaxDimension
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaImageMaxDimension safely sets the Configuration value for state's 'MediaImageMaxDimension' field
+func (st *ConfigState) SetMediaImageMaxDimension(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaImageMaxDimension = v
+	st.reloadToViper()
+}
+
+// MediaImageMaxDimensionFlag returns the flag name for the 'MediaImageMaxDimension' field
+func MediaImageMaxDimensionFlag() string { return "media-image-max-dimension" }
+
+// GetMediaImageMaxDimension safely fetches the value for global configuration 'MediaImageMaxDimension' field
+func GetMediaImageMaxDimension() int { return global.GetMediaImageMaxDimension() }
+
+// SetMediaImageMaxDimension safely sets the value for global configuration 'MediaImageMaxDimension' field
+func SetMediaImageMaxDimension(v int) { global.SetMediaImageMaxDimension(v) }
+
+// GetMediaImageMaxPixels safely fetches the Configuration value for state's 'MediaImageMaxPixels' field
+func (st *ConfigState) GetMediaImageMaxPixels() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaImageMaxPixels
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaImageMaxPixels safely sets the Configuration value for state's 'MediaImageMaxPixels' field
+func (st *ConfigState) SetMediaImageMaxPixels(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaImageMaxPixels = v
+	st.reloadToViper()
+}
+
+// MediaImageMaxPixelsFlag returns the flag name for the 'MediaImageMaxPixels' field
+func MediaImageMaxPixelsFlag() string { return "media-image-max-pixels" }
+
+// GetMediaImageMaxPixels safely fetches the value for global configuration 'MediaImageMaxPixels' field
+func GetMediaImageMaxPixels() int { return global.GetMediaImageMaxPixels() }
+
+// SetMediaImageMaxPixels safely sets the value for global configuration 'MediaImageMaxPixels' field
+func SetMediaImageMaxPixels(v int) { global.SetMediaImageMaxPixels(v) }
+
+// GetMediaDescriptionMinChars safely fetches the Configuration value for state's 'MediaDescriptionMinChars' field
+func (st *ConfigState) GetMediaDescriptionMinChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaDescriptionMinChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescriptionMinChars safely sets the Configuration value for state's 'MediaDescriptionMinChars' field
+func (st *ConfigState) SetMediaDescriptionMinChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaDescriptionMinChars = v
+	st.reloadToViper()
+}
+
+// MediaDescriptionMinCharsFlag returns the flag name for the 'MediaDescriptionMinChars' field
+func MediaDescriptionMinCharsFlag() string { return "media-description-min-chars" }
+
+// GetMediaDescriptionMinChars safely fetches the value for global configuration 'MediaDescriptionMinChars' field
+func GetMediaDescriptionMinChars() int { return global.GetMediaDescriptionMinChars() }
+
+// SetMediaDescriptionMinChars safely sets the value for global configuration 'MediaDescriptionMinChars' field
+func SetMediaDescriptionMinChars(v int) { global.SetMediaDescriptionMinChars(v) }
+
+// GetMediaDescriptionMaxChars safely fetches the Configuration value for state's 'MediaDescriptionMaxChars' field
+func (st *ConfigState) GetMediaDescriptionMaxChars() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaDescriptionMaxChars
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaDescriptionMaxChars safely sets the Configuration value for state's 'MediaDescriptionMaxChars' field
+func (st *ConfigState) SetMediaDescriptionMaxChars(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaDescriptionMaxChars = v
+	st.reloadToViper()
+}
+
+// MediaDescriptionMaxCharsFlag returns the flag name for the 'MediaDescriptionMaxChars' field
+func MediaDescriptionMaxCharsFlag() string { return "media-description-max-chars" }
+
+// GetMediaDescriptionMaxChars safely fetches the value for global configuration 'MediaDescriptionMaxChars' field
+func GetMediaDescriptionMaxChars() int { return global.GetMediaDescriptionMaxChars() }
+
+// SetMediaDescriptionMaxChars safely sets the value for global configuration 'MediaDescriptionMaxChars' field
+func SetMediaDescriptionMaxChars(v int) { global.SetMediaDescriptionMaxChars(v) }
+
+// GetMediaRemoteCacheDays safely fetches the Configuration value for state's 'MediaRemoteCacheDays' field
+func (st *ConfigState) GetMediaRemoteCacheDays() (v int) {
+	st.mutex.RLock()
+	v = st.config.MediaRemoteCacheDays
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaRemoteCacheDays safely sets the Configuration value for state's 'MediaRemoteCacheDays' field
 func (st *ConfigState) SetMediaRemoteCacheDays(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaRemoteCacheDays = v
+	st.config.MediaRemoteCacheDays = v
+	st.reloadToViper()
+}
+
+// MediaRemoteCacheDaysFlag returns the flag name for the 'MediaRemoteCacheDays' field
+func MediaRemoteCacheDaysFlag() string { return "media-remote-cache-days" }
+
+// GetMediaRemoteCacheDays safely fetches the value for global configuration 'MediaRemoteCacheDays' field
+func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+
+// SetMediaRemoteCacheDays safely sets the value for global configuration 'MediaRemoteCacheDays' field
+func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+
+// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'MediaEmojiLocalMaxSize' field
+func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaEmojiLocalMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'MediaEmojiLocalMaxSize' field
+func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaEmojiLocalMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaEmojiLocalMaxSizeFlag returns the flag name for the 'MediaEmojiLocalMaxSize' field
+func MediaEmojiLocalMaxSizeFlag() string { return "media-emoji-local-max-size" }
+
+// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'MediaEmojiLocalMaxSize' field
+func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+
+// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'MediaEmojiLocalMaxSize' field
+func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+
+// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
+func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaEmojiRemoteMaxSize
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
+func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaEmojiRemoteMaxSize = v
+	st.reloadToViper()
+}
+
+// MediaEmojiRemoteMaxSizeFlag returns the flag name for the 'MediaEmojiRemoteMaxSize' field
+func MediaEmojiRemoteMaxSizeFlag() string { return "media-emoji-remote-max-size" }
+
+// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'MediaEmojiRemoteMaxSize' field
+func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+
+// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'MediaEmojiRemoteMaxSize' field
+func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+
+// GetMediaLocalQuota safely fetches the Configuration value for state's 'MediaLocalQuota' field
+func (st *ConfigState) GetMediaLocalQuota() (v bytesize.Size) {
+	st.mutex.RLock()
+	v = st.config.MediaLocalQuota
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaLocalQuota safely sets the Configuration value for state's 'MediaLocalQuota' field
+func (st *ConfigState) SetMediaLocalQuota(v bytesize.Size) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaLocalQuota = v
+	st.reloadToViper()
+}
+
+// MediaLocalQuotaFlag returns the flag name for the 'MediaLocalQuota' field
+func MediaLocalQuotaFlag() string { return "media-local-quota" }
+
+// GetMediaLocalQuota safely fetches the value for global configuration 'MediaLocalQuota' field
+func GetMediaLocalQuota() bytesize.Size { return global.GetMediaLocalQuota() }
+
+// SetMediaLocalQuota safely sets the value for global configuration 'MediaLocalQuota' field
+func SetMediaLocalQuota(v bytesize.Size) { global.SetMediaLocalQuota(v) }
+
+// GetMediaScanClamAVAddress safely fetches the Configuration value for state's 'MediaScanClamAVAddress' field
+func (st *ConfigState) GetMediaScanClamAVAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.MediaScanClamAVAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetMediaScanClamAVAddress safely sets the Configuration value for state's 'MediaScanClamAVAddress' field
+func (st *ConfigState) SetMediaScanClamAVAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MediaScanClamAVAddress = v
 	st.reloadToViper()
 }
 
-// MediaRemoteCacheDaysFlag returns the flag name for the 'MediaRemoteCacheDays' field
-func MediaRemoteCacheDaysFlag() string { return "media-remote-cache-days" }
+// MediaScanClamAVAddressFlag returns the flag name for the 'MediaScanClamAVAddress' field
+func MediaScanClamAVAddressFlag() string { return "media-scan-clamav-address" }
 
-// GetMediaRemoteCacheDays safely fetches the value for global configuration 'MediaRemoteCacheDays' field
-func GetMediaRemoteCacheDays() int { return global.GetMediaRemoteCacheDays() }
+// GetMediaScanClamAVAddress safely fetches the value for global configuration 'MediaScanClamAVAddress' field
+func GetMediaScanClamAVAddress() string { return global.GetMediaScanClamAVAddress() }
 
-// SetMediaRemoteCacheDays safely sets the value for global configuration 'MediaRemoteCacheDays' field
-func SetMediaRemoteCacheDays(v int) { global.SetMediaRemoteCacheDays(v) }
+// SetMediaScanClamAVAddress safely sets the value for global configuration 'MediaScanClamAVAddress' field
+func SetMediaScanClamAVAddress(v string) { global.SetMediaScanClamAVAddress(v) }
 
-// GetMediaEmojiLocalMaxSize safely fetches the Configuration value for state's 'MediaEmojiLocalMaxSize' field
-func (st *ConfigState) GetMediaEmojiLocalMaxSize() (v bytesize.Size) {
+// GetMediaScanCommand safely fetches the Configuration value for state's 'MediaScanCommand' field
+func (st *ConfigState) GetMediaScanCommand() (v string) {
 	st.mutex.RLock()
-	v = st.config.MediaEmojiLocalMaxSize
+	v = st.config.MediaScanCommand
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiLocalMaxSize safely sets the Configuration value for state's 'MediaEmojiLocalMaxSize' field
-func (st *ConfigState) SetMediaEmojiLocalMaxSize(v bytesize.Size) {
+// SetMediaScanCommand safely sets the Configuration value for state's 'MediaScanCommand' field
+func (st *ConfigState) SetMediaScanCommand(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaEmojiLocalMaxSize = v
+	st.config.MediaScanCommand = v
 	st.reloadToViper()
 }
 
-// MediaEmojiLocalMaxSizeFlag returns the flag name for the 'MediaEmojiLocalMaxSize' field
-func MediaEmojiLocalMaxSizeFlag() string { return "media-emoji-local-max-size" }
+// MediaScanCommandFlag returns the flag name for the 'MediaScanCommand' field
+func MediaScanCommandFlag() string { return "media-scan-command" }
 
-// GetMediaEmojiLocalMaxSize safely fetches the value for global configuration 'MediaEmojiLocalMaxSize' field
-func GetMediaEmojiLocalMaxSize() bytesize.Size { return global.GetMediaEmojiLocalMaxSize() }
+// GetMediaScanCommand safely fetches the value for global configuration 'MediaScanCommand' field
+func GetMediaScanCommand() string { return global.GetMediaScanCommand() }
 
-// SetMediaEmojiLocalMaxSize safely sets the value for global configuration 'MediaEmojiLocalMaxSize' field
-func SetMediaEmojiLocalMaxSize(v bytesize.Size) { global.SetMediaEmojiLocalMaxSize(v) }
+// SetMediaScanCommand safely sets the value for global configuration 'MediaScanCommand' field
+func SetMediaScanCommand(v string) { global.SetMediaScanCommand(v) }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
-func (st *ConfigState) GetMediaEmojiRemoteMaxSize() (v bytesize.Size) {
+// GetMediaScanTimeout safely fetches the Configuration value for state's 'MediaScanTimeout' field
+func (st *ConfigState) GetMediaScanTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.MediaEmojiRemoteMaxSize
+	v = st.config.MediaScanTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetMediaEmojiRemoteMaxSize safely sets the Configuration value for state's 'MediaEmojiRemoteMaxSize' field
-func (st *ConfigState) SetMediaEmojiRemoteMaxSize(v bytesize.Size) {
+// SetMediaScanTimeout safely sets the Configuration value for state's 'MediaScanTimeout' field
+func (st *ConfigState) SetMediaScanTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.MediaEmojiRemoteMaxSize = v
+	st.config.MediaScanTimeout = v
 	st.reloadToViper()
 }
 
-// MediaEmojiRemoteMaxSizeFlag returns the flag name for the 'MediaEmojiRemoteMaxSize' field
-func MediaEmojiRemoteMaxSizeFlag() string { return "media-emoji-remote-max-size" }
+// MediaScanTimeoutFlag returns the flag name for the 'MediaScanTimeout' field
+func MediaScanTimeoutFlag() string { return "media-scan-timeout" }
 
-// GetMediaEmojiRemoteMaxSize safely fetches the value for global configuration 'MediaEmojiRemoteMaxSize' field
-func GetMediaEmojiRemoteMaxSize() bytesize.Size { return global.GetMediaEmojiRemoteMaxSize() }
+// GetMediaScanTimeout safely fetches the value for global configuration 'MediaScanTimeout' field
+func GetMediaScanTimeout() time.Duration { return global.GetMediaScanTimeout() }
 
-// SetMediaEmojiRemoteMaxSize safely sets the value for global configuration 'MediaEmojiRemoteMaxSize' field
-func SetMediaEmojiRemoteMaxSize(v bytesize.Size) { global.SetMediaEmojiRemoteMaxSize(v) }
+// SetMediaScanTimeout safely sets the value for global configuration 'MediaScanTimeout' field
+func SetMediaScanTimeout(v time.Duration) { global.SetMediaScanTimeout(v) }
 
 // GetStorageBackend safely fetches the Configuration value for state's 'StorageBackend' field
 func (st *ConfigState) GetStorageBackend() (v string) {
@@ -1549,6 +2731,181 @@ func GetStatusesMediaMaxFiles() int { return global.GetStatusesMediaMaxFiles() }
 // SetStatusesMediaMaxFiles safely sets the value for global configuration 'StatusesMediaMaxFiles' field
 func SetStatusesMediaMaxFiles(v int) { global.SetStatusesMediaMaxFiles(v) }
 
+// GetStatusesMaxPinned safely fetches the Configuration value for state's 'StatusesMaxPinned' field
+func (st *ConfigState) GetStatusesMaxPinned() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesMaxPinned
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesMaxPinned safely sets the Configuration value for state's 'StatusesMaxPinned' field
+func (st *ConfigState) SetStatusesMaxPinned(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesMaxPinned = v
+	st.reloadToViper()
+}
+
+// StatusesMaxPinnedFlag returns the flag name for the 'StatusesMaxPinned' field
+func StatusesMaxPinnedFlag() string { return "statuses-max-pinned" }
+
+// GetStatusesMaxPinned safely fetches the value for global configuration 'StatusesMaxPinned' field
+func GetStatusesMaxPinned() int { return global.GetStatusesMaxPinned() }
+
+// SetStatusesMaxPinned safely sets the value for global configuration 'StatusesMaxPinned' field
+func SetStatusesMaxPinned(v int) { global.SetStatusesMaxPinned(v) }
+
+// GetStatusesSpamFilterEnabled safely fetches the Configuration value for state's 'StatusesSpamFilterEnabled' field
+func (st *ConfigState) GetStatusesSpamFilterEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.StatusesSpamFilterEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesSpamFilterEnabled safely sets the Configuration value for state's 'StatusesSpamFilterEnabled' field
+func (st *ConfigState) SetStatusesSpamFilterEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesSpamFilterEnabled = v
+	st.reloadToViper()
+}
+
+// StatusesSpamFilterEnabledFlag returns the flag name for the 'StatusesSpamFilterEnabled' field
+func StatusesSpamFilterEnabledFlag() string { return "statuses-spam-filter-enabled" }
+
+// GetStatusesSpamFilterEnabled safely fetches the value for global configuration 'StatusesSpamFilterEnabled' field
+func GetStatusesSpamFilterEnabled() bool { return global.GetStatusesSpamFilterEnabled() }
+
+// SetStatusesSpamFilterEnabled safely sets the value for global configuration 'StatusesSpamFilterEnabled' field
+func SetStatusesSpamFilterEnabled(v bool) { global.SetStatusesSpamFilterEnabled(v) }
+
+// GetStatusesSpamFilterQuarantineScore safely fetches the Configuration value for state's 'StatusesSpamFilterQuarantineScore' field
+func (st *ConfigState) GetStatusesSpamFilterQuarantineScore() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesSpamFilterQuarantineScore
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesSpamFilterQuarantineScore safely sets the Configuration value for state's 'StatusesSpamFilterQuarantineScore' field
+func (st *ConfigState) SetStatusesSpamFilterQuarantineScore(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesSpamFilterQuarantineScore = v
+	st.reloadToViper()
+}
+
+// StatusesSpamFilterQuarantineScoreFlag returns the flag name for the 'StatusesSpamFilterQuarantineScore' field
+func StatusesSpamFilterQuarantineScoreFlag() string { return "statuses-spam-filter-quarantine-score" }
+
+// GetStatusesSpamFilterQuarantineScore safely fetches the value for global configuration 'StatusesSpamFilterQuarantineScore' field
+func GetStatusesSpamFilterQuarantineScore() int { return global.GetStatusesSpamFilterQuarantineScore() }
+
+// SetStatusesSpamFilterQuarantineScore safely sets the value for global configuration 'StatusesSpamFilterQuarantineScore' field
+func SetStatusesSpamFilterQuarantineScore(v int) { global.SetStatusesSpamFilterQuarantineScore(v) }
+
+// GetStatusesSpamFilterDropScore safely fetches the Configuration value for state's 'StatusesSpamFilterDropScore' field
+func (st *ConfigState) GetStatusesSpamFilterDropScore() (v int) {
+	st.mutex.RLock()
+	v = st.config.StatusesSpamFilterDropScore
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesSpamFilterDropScore safely sets the Configuration value for state's 'StatusesSpamFilterDropScore' field
+func (st *ConfigState) SetStatusesSpamFilterDropScore(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesSpamFilterDropScore = v
+	st.reloadToViper()
+}
+
+// StatusesSpamFilterDropScoreFlag returns the flag name for the 'StatusesSpamFilterDropScore' field
+func StatusesSpamFilterDropScoreFlag() string { return "statuses-spam-filter-drop-score" }
+
+// GetStatusesSpamFilterDropScore safely fetches the value for global configuration 'StatusesSpamFilterDropScore' field
+func GetStatusesSpamFilterDropScore() int { return global.GetStatusesSpamFilterDropScore() }
+
+// SetStatusesSpamFilterDropScore safely sets the value for global configuration 'StatusesSpamFilterDropScore' field
+func SetStatusesSpamFilterDropScore(v int) { global.SetStatusesSpamFilterDropScore(v) }
+
+// GetStatusesTranslationBackend safely fetches the Configuration value for state's 'StatusesTranslationBackend' field
+func (st *ConfigState) GetStatusesTranslationBackend() (v string) {
+	st.mutex.RLock()
+	v = st.config.StatusesTranslationBackend
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesTranslationBackend safely sets the Configuration value for state's 'StatusesTranslationBackend' field
+func (st *ConfigState) SetStatusesTranslationBackend(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesTranslationBackend = v
+	st.reloadToViper()
+}
+
+// StatusesTranslationBackendFlag returns the flag name for the 'StatusesTranslationBackend' field
+func StatusesTranslationBackendFlag() string { return "statuses-translation-backend" }
+
+// GetStatusesTranslationBackend safely fetches the value for global configuration 'StatusesTranslationBackend' field
+func GetStatusesTranslationBackend() string { return global.GetStatusesTranslationBackend() }
+
+// SetStatusesTranslationBackend safely sets the value for global configuration 'StatusesTranslationBackend' field
+func SetStatusesTranslationBackend(v string) { global.SetStatusesTranslationBackend(v) }
+
+// GetStatusesTranslationURL safely fetches the Configuration value for state's 'StatusesTranslationURL' field
+func (st *ConfigState) GetStatusesTranslationURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.StatusesTranslationURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesTranslationURL safely sets the Configuration value for state's 'StatusesTranslationURL' field
+func (st *ConfigState) SetStatusesTranslationURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesTranslationURL = v
+	st.reloadToViper()
+}
+
+// StatusesTranslationURLFlag returns the flag name for the 'StatusesTranslationURL' field
+func StatusesTranslationURLFlag() string { return "statuses-translation-url" }
+
+// GetStatusesTranslationURL safely fetches the value for global configuration 'StatusesTranslationURL' field
+func GetStatusesTranslationURL() string { return global.GetStatusesTranslationURL() }
+
+// SetStatusesTranslationURL safely sets the value for global configuration 'StatusesTranslationURL' field
+func SetStatusesTranslationURL(v string) { global.SetStatusesTranslationURL(v) }
+
+// GetStatusesTranslationAPIKey safely fetches the Configuration value for state's 'StatusesTranslationAPIKey' field
+func (st *ConfigState) GetStatusesTranslationAPIKey() (v string) {
+	st.mutex.RLock()
+	v = st.config.StatusesTranslationAPIKey
+	st.mutex.RUnlock()
+	return
+}
+
+// SetStatusesTranslationAPIKey safely sets the Configuration value for state's 'StatusesTranslationAPIKey' field
+func (st *ConfigState) SetStatusesTranslationAPIKey(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.StatusesTranslationAPIKey = v
+	st.reloadToViper()
+}
+
+// StatusesTranslationAPIKeyFlag returns the flag name for the 'StatusesTranslationAPIKey' field
+func StatusesTranslationAPIKeyFlag() string { return "statuses-translation-api-key" }
+
+// GetStatusesTranslationAPIKey safely fetches the value for global configuration 'StatusesTranslationAPIKey' field
+func GetStatusesTranslationAPIKey() string { return global.GetStatusesTranslationAPIKey() }
+
+// SetStatusesTranslationAPIKey safely sets the value for global configuration 'StatusesTranslationAPIKey' field
+func SetStatusesTranslationAPIKey(v string) { global.SetStatusesTranslationAPIKey(v) }
+
 // GetLetsEncryptEnabled safely fetches the Configuration value for state's 'LetsEncryptEnabled' field
 func (st *ConfigState) GetLetsEncryptEnabled() (v bool) {
 	st.mutex.RLock()
@@ -1649,6 +3006,56 @@ func GetLetsEncryptEmailAddress() string { return global.GetLetsEncryptEmailAddr
 // SetLetsEncryptEmailAddress safely sets the value for global configuration 'LetsEncryptEmailAddress' field
 func SetLetsEncryptEmailAddress(v string) { global.SetLetsEncryptEmailAddress(v) }
 
+// GetLetsEncryptChallenge safely fetches the Configuration value for state's 'LetsEncryptChallenge' field
+func (st *ConfigState) GetLetsEncryptChallenge() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptChallenge
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptChallenge safely sets the Configuration value for state's 'LetsEncryptChallenge' field
+func (st *ConfigState) SetLetsEncryptChallenge(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptChallenge = v
+	st.reloadToViper()
+}
+
+// LetsEncryptChallengeFlag returns the flag name for the 'LetsEncryptChallenge' field
+func LetsEncryptChallengeFlag() string { return "letsencrypt-challenge" }
+
+// GetLetsEncryptChallenge safely fetches the value for global configuration 'LetsEncryptChallenge' field
+func GetLetsEncryptChallenge() string { return global.GetLetsEncryptChallenge() }
+
+// SetLetsEncryptChallenge safely sets the value for global configuration 'LetsEncryptChallenge' field
+func SetLetsEncryptChallenge(v string) { global.SetLetsEncryptChallenge(v) }
+
+// GetLetsEncryptDNSCommand safely fetches the Configuration value for state's 'LetsEncryptDNSCommand' field
+func (st *ConfigState) GetLetsEncryptDNSCommand() (v string) {
+	st.mutex.RLock()
+	v = st.config.LetsEncryptDNSCommand
+	st.mutex.RUnlock()
+	return
+}
+
+// SetLetsEncryptDNSCommand safely sets the Configuration value for state's 'LetsEncryptDNSCommand' field
+func (st *ConfigState) SetLetsEncryptDNSCommand(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.LetsEncryptDNSCommand = v
+	st.reloadToViper()
+}
+
+// LetsEncryptDNSCommandFlag returns the flag name for the 'LetsEncryptDNSCommand' field
+func LetsEncryptDNSCommandFlag() string { return "letsencrypt-dns-command" }
+
+// GetLetsEncryptDNSCommand safely fetches the value for global configuration 'LetsEncryptDNSCommand' field
+func GetLetsEncryptDNSCommand() string { return global.GetLetsEncryptDNSCommand() }
+
+// SetLetsEncryptDNSCommand safely sets the value for global configuration 'LetsEncryptDNSCommand' field
+func SetLetsEncryptDNSCommand(v string) { global.SetLetsEncryptDNSCommand(v) }
+
 // GetTLSCertificateChain safely fetches the Configuration value for state's 'TLSCertificateChain' field
 func (st *ConfigState) GetTLSCertificateChain() (v string) {
 	st.mutex.RLock()
@@ -2024,6 +3431,56 @@ func GetTracingInsecureTransport() bool { return global.GetTracingInsecureTransp
 // SetTracingInsecureTransport safely sets the value for global configuration 'TracingInsecureTransport' field
 func SetTracingInsecureTransport(v bool) { global.SetTracingInsecureTransport(v) }
 
+// GetPprofEnabled safely fetches the Configuration value for state's 'PprofEnabled' field
+func (st *ConfigState) GetPprofEnabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.PprofEnabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetPprofEnabled safely sets the Configuration value for state's 'PprofEnabled' field
+func (st *ConfigState) SetPprofEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.PprofEnabled = v
+	st.reloadToViper()
+}
+
+// PprofEnabledFlag returns the flag name for the 'PprofEnabled' field
+func PprofEnabledFlag() string { return "pprof-enabled" }
+
+// GetPprofEnabled safely fetches the value for global configuration 'PprofEnabled' field
+func GetPprofEnabled() bool { return global.GetPprofEnabled() }
+
+// SetPprofEnabled safely sets the value for global configuration 'PprofEnabled' field
+func SetPprofEnabled(v bool) { global.SetPprofEnabled(v) }
+
+// GetPprofBindAddress safely fetches the Configuration value for state's 'PprofBindAddress' field
+func (st *ConfigState) GetPprofBindAddress() (v string) {
+	st.mutex.RLock()
+	v = st.config.PprofBindAddress
+	st.mutex.RUnlock()
+	return
+}
+
+// SetPprofBindAddress safely sets the Configuration value for state's 'PprofBindAddress' field
+func (st *ConfigState) SetPprofBindAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.PprofBindAddress = v
+	st.reloadToViper()
+}
+
+// PprofBindAddressFlag returns the flag name for the 'PprofBindAddress' field
+func PprofBindAddressFlag() string { return "pprof-bind-address" }
+
+// GetPprofBindAddress safely fetches the value for global configuration 'PprofBindAddress' field
+func GetPprofBindAddress() string { return global.GetPprofBindAddress() }
+
+// SetPprofBindAddress safely sets the value for global configuration 'PprofBindAddress' field
+func SetPprofBindAddress(v string) { global.SetPprofBindAddress(v) }
+
 // GetSMTPHost safely fetches the Configuration value for state's 'SMTPHost' field
 func (st *ConfigState) GetSMTPHost() (v string) {
 	st.mutex.RLock()
@@ -2171,8 +3628,158 @@ func SMTPDiscloseRecipientsFlag() string { return "smtp-disclose-recipients" }
 // GetSMTPDiscloseRecipients safely fetches the value for global configuration 'SMTPDiscloseRecipients' field
 func GetSMTPDiscloseRecipients() bool { return global.GetSMTPDiscloseRecipients() }
 
-// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
-func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
+// SetSMTPDiscloseRecipients safely sets the value for global configuration 'SMTPDiscloseRecipients' field
+func SetSMTPDiscloseRecipients(v bool) { global.SetSMTPDiscloseRecipients(v) }
+
+// GetSMTPTransport safely fetches the Configuration value for state's 'SMTPTransport' field
+func (st *ConfigState) GetSMTPTransport() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPTransport
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPTransport safely sets the Configuration value for state's 'SMTPTransport' field
+func (st *ConfigState) SetSMTPTransport(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPTransport = v
+	st.reloadToViper()
+}
+
+// SMTPTransportFlag returns the flag name for the 'SMTPTransport' field
+func SMTPTransportFlag() string { return "smtp-transport" }
+
+// GetSMTPTransport safely fetches the value for global configuration 'SMTPTransport' field
+func GetSMTPTransport() string { return global.GetSMTPTransport() }
+
+// SetSMTPTransport safely sets the value for global configuration 'SMTPTransport' field
+func SetSMTPTransport(v string) { global.SetSMTPTransport(v) }
+
+// GetSMTPHTTPURL safely fetches the Configuration value for state's 'SMTPHTTPURL' field
+func (st *ConfigState) GetSMTPHTTPURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPHTTPURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPHTTPURL safely sets the Configuration value for state's 'SMTPHTTPURL' field
+func (st *ConfigState) SetSMTPHTTPURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPHTTPURL = v
+	st.reloadToViper()
+}
+
+// SMTPHTTPURLFlag returns the flag name for the 'SMTPHTTPURL' field
+func SMTPHTTPURLFlag() string { return "smtp-http-url" }
+
+// GetSMTPHTTPURL safely fetches the value for global configuration 'SMTPHTTPURL' field
+func GetSMTPHTTPURL() string { return global.GetSMTPHTTPURL() }
+
+// SetSMTPHTTPURL safely sets the value for global configuration 'SMTPHTTPURL' field
+func SetSMTPHTTPURL(v string) { global.SetSMTPHTTPURL(v) }
+
+// GetSMTPHTTPToken safely fetches the Configuration value for state's 'SMTPHTTPToken' field
+func (st *ConfigState) GetSMTPHTTPToken() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPHTTPToken
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPHTTPToken safely sets the Configuration value for state's 'SMTPHTTPToken' field
+func (st *ConfigState) SetSMTPHTTPToken(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPHTTPToken = v
+	st.reloadToViper()
+}
+
+// SMTPHTTPTokenFlag returns the flag name for the 'SMTPHTTPToken' field
+func SMTPHTTPTokenFlag() string { return "smtp-http-token" }
+
+// GetSMTPHTTPToken safely fetches the value for global configuration 'SMTPHTTPToken' field
+func GetSMTPHTTPToken() string { return global.GetSMTPHTTPToken() }
+
+// SetSMTPHTTPToken safely sets the value for global configuration 'SMTPHTTPToken' field
+func SetSMTPHTTPToken(v string) { global.SetSMTPHTTPToken(v) }
+
+// GetSMTPDKIMDomain safely fetches the Configuration value for state's 'SMTPDKIMDomain' field
+func (st *ConfigState) GetSMTPDKIMDomain() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPDKIMDomain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPDKIMDomain safely sets the Configuration value for state's 'SMTPDKIMDomain' field
+func (st *ConfigState) SetSMTPDKIMDomain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPDKIMDomain = v
+	st.reloadToViper()
+}
+
+// SMTPDKIMDomainFlag returns the flag name for the 'SMTPDKIMDomain' field
+func SMTPDKIMDomainFlag() string { return "smtp-dkim-domain" }
+
+// GetSMTPDKIMDomain safely fetches the value for global configuration 'SMTPDKIMDomain' field
+func GetSMTPDKIMDomain() string { return global.GetSMTPDKIMDomain() }
+
+// SetSMTPDKIMDomain safely sets the value for global configuration 'SMTPDKIMDomain' field
+func SetSMTPDKIMDomain(v string) { global.SetSMTPDKIMDomain(v) }
+
+// GetSMTPDKIMSelector safely fetches the Configuration value for state's 'SMTPDKIMSelector' field
+func (st *ConfigState) GetSMTPDKIMSelector() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPDKIMSelector
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPDKIMSelector safely sets the Configuration value for state's 'SMTPDKIMSelector' field
+func (st *ConfigState) SetSMTPDKIMSelector(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPDKIMSelector = v
+	st.reloadToViper()
+}
+
+// SMTPDKIMSelectorFlag returns the flag name for the 'SMTPDKIMSelector' field
+func SMTPDKIMSelectorFlag() string { return "smtp-dkim-selector" }
+
+// GetSMTPDKIMSelector safely fetches the value for global configuration 'SMTPDKIMSelector' field
+func GetSMTPDKIMSelector() string { return global.GetSMTPDKIMSelector() }
+
+// SetSMTPDKIMSelector safely sets the value for global configuration 'SMTPDKIMSelector' field
+func SetSMTPDKIMSelector(v string) { global.SetSMTPDKIMSelector(v) }
+
+// GetSMTPDKIMPrivateKeyPath safely fetches the Configuration value for state's 'SMTPDKIMPrivateKeyPath' field
+func (st *ConfigState) GetSMTPDKIMPrivateKeyPath() (v string) {
+	st.mutex.RLock()
+	v = st.config.SMTPDKIMPrivateKeyPath
+	st.mutex.RUnlock()
+	return
+}
+
+// SetSMTPDKIMPrivateKeyPath safely sets the Configuration value for state's 'SMTPDKIMPrivateKeyPath' field
+func (st *ConfigState) SetSMTPDKIMPrivateKeyPath(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.SMTPDKIMPrivateKeyPath = v
+	st.reloadToViper()
+}
+
+// SMTPDKIMPrivateKeyPathFlag returns the flag name for the 'SMTPDKIMPrivateKeyPath' field
+func SMTPDKIMPrivateKeyPathFlag() string { return "smtp-dkim-private-key-path" }
+
+// GetSMTPDKIMPrivateKeyPath safely fetches the value for global configuration 'SMTPDKIMPrivateKeyPath' field
+func GetSMTPDKIMPrivateKeyPath() string { return global.GetSMTPDKIMPrivateKeyPath() }
+
+// SetSMTPDKIMPrivateKeyPath safely sets the value for global configuration 'SMTPDKIMPrivateKeyPath' field
+func SetSMTPDKIMPrivateKeyPath(v string) { global.SetSMTPDKIMPrivateKeyPath(v) }
 
 // GetSyslogEnabled safely fetches the Configuration value for state's 'SyslogEnabled' field
 func (st *ConfigState) GetSyslogEnabled() (v bool) {
@@ -2249,6 +3856,131 @@ func GetSyslogAddress() string { return global.GetSyslogAddress() }
 // SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
 func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
 
+// GetRedisURL safely fetches the Configuration value for state's 'RedisURL' field
+func (st *ConfigState) GetRedisURL() (v string) {
+	st.mutex.RLock()
+	v = st.config.RedisURL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetRedisURL safely sets the Configuration value for state's 'RedisURL' field
+func (st *ConfigState) SetRedisURL(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.RedisURL = v
+	st.reloadToViper()
+}
+
+// RedisURLFlag returns the flag name for the 'RedisURL' field
+func RedisURLFlag() string { return "redis-url" }
+
+// GetRedisURL safely fetches the value for global configuration 'RedisURL' field
+func GetRedisURL() string { return global.GetRedisURL() }
+
+// SetRedisURL safely sets the value for global configuration 'RedisURL' field
+func SetRedisURL(v string) { global.SetRedisURL(v) }
+
+// GetRedisCacheNotify safely fetches the Configuration value for state's 'RedisCacheNotify' field
+func (st *ConfigState) GetRedisCacheNotify() (v bool) {
+	st.mutex.RLock()
+	v = st.config.RedisCacheNotify
+	st.mutex.RUnlock()
+	return
+}
+
+// SetRedisCacheNotify safely sets the Configuration value for state's 'RedisCacheNotify' field
+func (st *ConfigState) SetRedisCacheNotify(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.RedisCacheNotify = v
+	st.reloadToViper()
+}
+
+// RedisCacheNotifyFlag returns the flag name for the 'RedisCacheNotify' field
+func RedisCacheNotifyFlag() string { return "redis-cache-notify" }
+
+// GetRedisCacheNotify safely fetches the value for global configuration 'RedisCacheNotify' field
+func GetRedisCacheNotify() bool { return global.GetRedisCacheNotify() }
+
+// SetRedisCacheNotify safely sets the value for global configuration 'RedisCacheNotify' field
+func SetRedisCacheNotify(v bool) { global.SetRedisCacheNotify(v) }
+
+// GetRedisQueues safely fetches the Configuration value for state's 'RedisQueues' field
+func (st *ConfigState) GetRedisQueues() (v []string) {
+	st.mutex.RLock()
+	v = st.config.RedisQueues
+	st.mutex.RUnlock()
+	return
+}
+
+// SetRedisQueues safely sets the Configuration value for state's 'RedisQueues' field
+func (st *ConfigState) SetRedisQueues(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.RedisQueues = v
+	st.reloadToViper()
+}
+
+// RedisQueuesFlag returns the flag name for the 'RedisQueues' field
+func RedisQueuesFlag() string { return "redis-queues" }
+
+// GetRedisQueues safely fetches the value for global configuration 'RedisQueues' field
+func GetRedisQueues() []string { return global.GetRedisQueues() }
+
+// SetRedisQueues safely sets the value for global configuration 'RedisQueues' field
+func SetRedisQueues(v []string) { global.SetRedisQueues(v) }
+
+// GetJobsSchedules safely fetches the Configuration value for state's 'JobsSchedules' field
+func (st *ConfigState) GetJobsSchedules() (v []string) {
+	st.mutex.RLock()
+	v = st.config.JobsSchedules
+	st.mutex.RUnlock()
+	return
+}
+
+// SetJobsSchedules safely sets the Configuration value for state's 'JobsSchedules' field
+func (st *ConfigState) SetJobsSchedules(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.JobsSchedules = v
+	st.reloadToViper()
+}
+
+// JobsSchedulesFlag returns the flag name for the 'JobsSchedules' field
+func JobsSchedulesFlag() string { return "jobs-schedules" }
+
+// GetJobsSchedules safely fetches the value for global configuration 'JobsSchedules' field
+func GetJobsSchedules() []string { return global.GetJobsSchedules() }
+
+// SetJobsSchedules safely sets the value for global configuration 'JobsSchedules' field
+func SetJobsSchedules(v []string) { global.SetJobsSchedules(v) }
+
+// GetJobsMaxRetries safely fetches the Configuration value for state's 'JobsMaxRetries' field
+func (st *ConfigState) GetJobsMaxRetries() (v int) {
+	st.mutex.RLock()
+	v = st.config.JobsMaxRetries
+	st.mutex.RUnlock()
+	return
+}
+
+// SetJobsMaxRetries safely sets the Configuration value for state's 'JobsMaxRetries' field
+func (st *ConfigState) SetJobsMaxRetries(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.JobsMaxRetries = v
+	st.reloadToViper()
+}
+
+// JobsMaxRetriesFlag returns the flag name for the 'JobsMaxRetries' field
+func JobsMaxRetriesFlag() string { return "jobs-max-retries" }
+
+// GetJobsMaxRetries safely fetches the value for global configuration 'JobsMaxRetries' field
+func GetJobsMaxRetries() int { return global.GetJobsMaxRetries() }
+
+// SetJobsMaxRetries safely sets the value for global configuration 'JobsMaxRetries' field
+func SetJobsMaxRetries(v int) { global.SetJobsMaxRetries(v) }
+
 // GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
 func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
 	st.mutex.RLock()
@@ -2399,130 +4131,380 @@ func GetAdvancedSenderMultiplier() int { return global.GetAdvancedSenderMultipli
 // SetAdvancedSenderMultiplier safely sets the value for global configuration 'AdvancedSenderMultiplier' field
 func SetAdvancedSenderMultiplier(v int) { global.SetAdvancedSenderMultiplier(v) }
 
-// GetAdvancedCSPExtraURIs safely fetches the Configuration value for state's 'AdvancedCSPExtraURIs' field
-func (st *ConfigState) GetAdvancedCSPExtraURIs() (v []string) {
+// GetAdvancedCSPExtraURIs safely fetches the Configuration value for state's 'AdvancedCSPExtraURIs' field
+func (st *ConfigState) GetAdvancedCSPExtraURIs() (v []string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedCSPExtraURIs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedCSPExtraURIs safely sets the Configuration value for state's 'AdvancedCSPExtraURIs' field
+func (st *ConfigState) SetAdvancedCSPExtraURIs(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedCSPExtraURIs = v
+	st.reloadToViper()
+}
+
+// AdvancedCSPExtraURIsFlag returns the flag name for the 'AdvancedCSPExtraURIs' field
+func AdvancedCSPExtraURIsFlag() string { return "advanced-csp-extra-uris" }
+
+// GetAdvancedCSPExtraURIs safely fetches the value for global configuration 'AdvancedCSPExtraURIs' field
+func GetAdvancedCSPExtraURIs() []string { return global.GetAdvancedCSPExtraURIs() }
+
+// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'AdvancedCSPExtraURIs' field
+func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
+
+// GetAdvancedSanitizerPolicy safely fetches the Configuration value for state's 'AdvancedSanitizerPolicy' field
+func (st *ConfigState) GetAdvancedSanitizerPolicy() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedSanitizerPolicy
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedSanitizerPolicy safely sets the Configuration value for state's 'AdvancedSanitizerPolicy' field
+func (st *ConfigState) SetAdvancedSanitizerPolicy(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedSanitizerPolicy = v
+	st.reloadToViper()
+}
+
+// AdvancedSanitizerPolicyFlag returns the flag name for the 'AdvancedSanitizerPolicy' field
+func AdvancedSanitizerPolicyFlag() string { return "advanced-sanitizer-policy" }
+
+// GetAdvancedSanitizerPolicy safely fetches the value for global configuration 'AdvancedSanitizerPolicy' field
+func GetAdvancedSanitizerPolicy() string { return global.GetAdvancedSanitizerPolicy() }
+
+// SetAdvancedSanitizerPolicy safely sets the value for global configuration 'AdvancedSanitizerPolicy' field
+func SetAdvancedSanitizerPolicy(v string) { global.SetAdvancedSanitizerPolicy(v) }
+
+// GetAdvancedSanitizerAllowTags safely fetches the Configuration value for state's 'AdvancedSanitizerAllowTags' field
+func (st *ConfigState) GetAdvancedSanitizerAllowTags() (v []string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedSanitizerAllowTags
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedSanitizerAllowTags safely sets the Configuration value for state's 'AdvancedSanitizerAllowTags' field
+func (st *ConfigState) SetAdvancedSanitizerAllowTags(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedSanitizerAllowTags = v
+	st.reloadToViper()
+}
+
+// AdvancedSanitizerAllowTagsFlag returns the flag name for the 'AdvancedSanitizerAllowTags' field
+func AdvancedSanitizerAllowTagsFlag() string { return "advanced-sanitizer-allow-tags" }
+
+// GetAdvancedSanitizerAllowTags safely fetches the value for global configuration 'AdvancedSanitizerAllowTags' field
+func GetAdvancedSanitizerAllowTags() []string { return global.GetAdvancedSanitizerAllowTags() }
+
+// SetAdvancedSanitizerAllowTags safely sets the value for global configuration 'AdvancedSanitizerAllowTags' field
+func SetAdvancedSanitizerAllowTags(v []string) { global.SetAdvancedSanitizerAllowTags(v) }
+
+// GetAdvancedSanitizerAllowAttrs safely fetches the Configuration value for state's 'AdvancedSanitizerAllowAttrs' field
+func (st *ConfigState) GetAdvancedSanitizerAllowAttrs() (v []string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedSanitizerAllowAttrs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedSanitizerAllowAttrs safely sets the Configuration value for state's 'AdvancedSanitizerAllowAttrs' field
+func (st *ConfigState) SetAdvancedSanitizerAllowAttrs(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedSanitizerAllowAttrs = v
+	st.reloadToViper()
+}
+
+// AdvancedSanitizerAllowAttrsFlag returns the flag name for the 'AdvancedSanitizerAllowAttrs' field
+func AdvancedSanitizerAllowAttrsFlag() string { return "advanced-sanitizer-allow-attrs" }
+
+// GetAdvancedSanitizerAllowAttrs safely fetches the value for global configuration 'AdvancedSanitizerAllowAttrs' field
+func GetAdvancedSanitizerAllowAttrs() []string { return global.GetAdvancedSanitizerAllowAttrs() }
+
+// SetAdvancedSanitizerAllowAttrs safely sets the value for global configuration 'AdvancedSanitizerAllowAttrs' field
+func SetAdvancedSanitizerAllowAttrs(v []string) { global.SetAdvancedSanitizerAllowAttrs(v) }
+
+// GetAdvancedSanitizerLinkRel safely fetches the Configuration value for state's 'AdvancedSanitizerLinkRel' field
+func (st *ConfigState) GetAdvancedSanitizerLinkRel() (v []string) {
+	st.mutex.RLock()
+	v = st.config.AdvancedSanitizerLinkRel
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdvancedSanitizerLinkRel safely sets the Configuration value for state's 'AdvancedSanitizerLinkRel' field
+func (st *ConfigState) SetAdvancedSanitizerLinkRel(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdvancedSanitizerLinkRel = v
+	st.reloadToViper()
+}
+
+// AdvancedSanitizerLinkRelFlag returns the flag name for the 'AdvancedSanitizerLinkRel' field
+func AdvancedSanitizerLinkRelFlag() string { return "advanced-sanitizer-link-rel" }
+
+// GetAdvancedSanitizerLinkRel safely fetches the value for global configuration 'AdvancedSanitizerLinkRel' field
+func GetAdvancedSanitizerLinkRel() []string { return global.GetAdvancedSanitizerLinkRel() }
+
+// SetAdvancedSanitizerLinkRel safely sets the value for global configuration 'AdvancedSanitizerLinkRel' field
+func SetAdvancedSanitizerLinkRel(v []string) { global.SetAdvancedSanitizerLinkRel(v) }
+
+// GetHTTPClientAllowIPs safely fetches the Configuration value for state's 'HTTPClient.AllowIPs' field
+func (st *ConfigState) GetHTTPClientAllowIPs() (v []string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.AllowIPs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientAllowIPs safely sets the Configuration value for state's 'HTTPClient.AllowIPs' field
+func (st *ConfigState) SetHTTPClientAllowIPs(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.AllowIPs = v
+	st.reloadToViper()
+}
+
+// HTTPClientAllowIPsFlag returns the flag name for the 'HTTPClient.AllowIPs' field
+func HTTPClientAllowIPsFlag() string { return "httpclient-allow-ips" }
+
+// GetHTTPClientAllowIPs safely fetches the value for global configuration 'HTTPClient.AllowIPs' field
+func GetHTTPClientAllowIPs() []string { return global.GetHTTPClientAllowIPs() }
+
+// SetHTTPClientAllowIPs safely sets the value for global configuration 'HTTPClient.AllowIPs' field
+func SetHTTPClientAllowIPs(v []string) { global.SetHTTPClientAllowIPs(v) }
+
+// GetHTTPClientBlockIPs safely fetches the Configuration value for state's 'HTTPClient.BlockIPs' field
+func (st *ConfigState) GetHTTPClientBlockIPs() (v []string) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.BlockIPs
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientBlockIPs safely sets the Configuration value for state's 'HTTPClient.BlockIPs' field
+func (st *ConfigState) SetHTTPClientBlockIPs(v []string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.BlockIPs = v
+	st.reloadToViper()
+}
+
+// HTTPClientBlockIPsFlag returns the flag name for the 'HTTPClient.BlockIPs' field
+func HTTPClientBlockIPsFlag() string { return "httpclient-block-ips" }
+
+// GetHTTPClientBlockIPs safely fetches the value for global configuration 'HTTPClient.BlockIPs' field
+func GetHTTPClientBlockIPs() []string { return global.GetHTTPClientBlockIPs() }
+
+// SetHTTPClientBlockIPs safely sets the value for global configuration 'HTTPClient.BlockIPs' field
+func SetHTTPClientBlockIPs(v []string) { global.SetHTTPClientBlockIPs(v) }
+
+// GetHTTPClientTimeout safely fetches the Configuration value for state's 'HTTPClient.Timeout' field
+func (st *ConfigState) GetHTTPClientTimeout() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.Timeout
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientTimeout safely sets the Configuration value for state's 'HTTPClient.Timeout' field
+func (st *ConfigState) SetHTTPClientTimeout(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.Timeout = v
+	st.reloadToViper()
+}
+
+// HTTPClientTimeoutFlag returns the flag name for the 'HTTPClient.Timeout' field
+func HTTPClientTimeoutFlag() string { return "httpclient-timeout" }
+
+// GetHTTPClientTimeout safely fetches the value for global configuration 'HTTPClient.Timeout' field
+func GetHTTPClientTimeout() time.Duration { return global.GetHTTPClientTimeout() }
+
+// SetHTTPClientTimeout safely sets the value for global configuration 'HTTPClient.Timeout' field
+func SetHTTPClientTimeout(v time.Duration) { global.SetHTTPClientTimeout(v) }
+
+// GetHTTPClientTLSInsecureSkipVerify safely fetches the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
+func (st *ConfigState) GetHTTPClientTLSInsecureSkipVerify() (v bool) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.TLSInsecureSkipVerify
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientTLSInsecureSkipVerify safely sets the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
+func (st *ConfigState) SetHTTPClientTLSInsecureSkipVerify(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.TLSInsecureSkipVerify = v
+	st.reloadToViper()
+}
+
+// HTTPClientTLSInsecureSkipVerifyFlag returns the flag name for the 'HTTPClient.TLSInsecureSkipVerify' field
+func HTTPClientTLSInsecureSkipVerifyFlag() string { return "httpclient-tls-insecure-skip-verify" }
+
+// GetHTTPClientTLSInsecureSkipVerify safely fetches the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
+func GetHTTPClientTLSInsecureSkipVerify() bool { return global.GetHTTPClientTLSInsecureSkipVerify() }
+
+// SetHTTPClientTLSInsecureSkipVerify safely sets the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
+func SetHTTPClientTLSInsecureSkipVerify(v bool) { global.SetHTTPClientTLSInsecureSkipVerify(v) }
+
+// GetHTTPClientMaxOpenConnsPerHost safely fetches the Configuration value for state's 'HTTPClient.MaxOpenConnsPerHost' field
+func (st *ConfigState) GetHTTPClientMaxOpenConnsPerHost() (v int) {
+	st.mutex.RLock()
+	v = st.config.HTTPClient.MaxOpenConnsPerHost
+	st.mutex.RUnlock()
+	return
+}
+
+// SetHTTPClientMaxOpenConnsPerHost safely sets the Configuration value for state's 'HTTPClient.MaxOpenConnsPerHost' field
+func (st *ConfigState) SetHTTPClientMaxOpenConnsPerHost(v int) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.HTTPClient.MaxOpenConnsPerHost = v
+	st.reloadToViper()
+}
+
+// HTTPClientMaxOpenConnsPerHostFlag returns the flag name for the 'HTTPClient.MaxOpenConnsPerHost' field
+func HTTPClientMaxOpenConnsPerHostFlag() string { return "httpclient-max-open-conns-per-host" }
+
+// GetHTTPClientMaxOpenConnsPerHost safely fetches the value for global configuration 'HTTPClient.MaxOpenConnsPerHost' field
+func GetHTTPClientMaxOpenConnsPerHost() int { return global.GetHTTPClientMaxOpenConnsPerHost() }
+
+// SetHTTPClientMaxOpenConnsPerHost safely sets the value for global configuration 'HTTPClient.MaxOpenConnsPerHost' field
+func SetHTTPClientMaxOpenConnsPerHost(v int) { global.SetHTTPClientMaxOpenConnsPerHost(v) }
+
+// GetHTTPClientMaxIdleConnsPerHost safely fetches the Configuration value for state's 'HTTPClient.MaxIdleConnsPerHost' field
+func (st *ConfigState) GetHTTPClientMaxIdleConnsPerHost() (v int) {
 	st.mutex.RLock()
-	v = st.config.AdvancedCSPExtraURIs
+	v = st.config.HTTPClient.MaxIdleConnsPerHost
 	st.mutex.RUnlock()
 	return
 }
 
-// SetAdvancedCSPExtraURIs safely sets the Configuration value for state's 'AdvancedCSPExtraURIs' field
-func (st *ConfigState) SetAdvancedCSPExtraURIs(v []string) {
+// SetHTTPClientMaxIdleConnsPerHost safely sets the Configuration value for state's 'HTTPClient.MaxIdleConnsPerHost' field
+func (st *ConfigState) SetHTTPClientMaxIdleConnsPerHost(v int) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.AdvancedCSPExtraURIs = v
+	st.config.HTTPClient.MaxIdleConnsPerHost = v
 	st.reloadToViper()
 }
 
-// AdvancedCSPExtraURIsFlag returns the flag name for the 'AdvancedCSPExtraURIs' field
-func AdvancedCSPExtraURIsFlag() string { return "advanced-csp-extra-uris" }
+// HTTPClientMaxIdleConnsPerHostFlag returns the flag name for the 'HTTPClient.MaxIdleConnsPerHost' field
+func HTTPClientMaxIdleConnsPerHostFlag() string { return "httpclient-max-idle-conns-per-host" }
 
-// GetAdvancedCSPExtraURIs safely fetches the value for global configuration 'AdvancedCSPExtraURIs' field
-func GetAdvancedCSPExtraURIs() []string { return global.GetAdvancedCSPExtraURIs() }
+// GetHTTPClientMaxIdleConnsPerHost safely fetches the value for global configuration 'HTTPClient.MaxIdleConnsPerHost' field
+func GetHTTPClientMaxIdleConnsPerHost() int { return global.GetHTTPClientMaxIdleConnsPerHost() }
 
-// SetAdvancedCSPExtraURIs safely sets the value for global configuration 'AdvancedCSPExtraURIs' field
-func SetAdvancedCSPExtraURIs(v []string) { global.SetAdvancedCSPExtraURIs(v) }
+// SetHTTPClientMaxIdleConnsPerHost safely sets the value for global configuration 'HTTPClient.MaxIdleConnsPerHost' field
+func SetHTTPClientMaxIdleConnsPerHost(v int) { global.SetHTTPClientMaxIdleConnsPerHost(v) }
 
-// GetHTTPClientAllowIPs safely fetches the Configuration value for state's 'HTTPClient.AllowIPs' field
-func (st *ConfigState) GetHTTPClientAllowIPs() (v []string) {
+// GetHTTPClientIdleConnTimeout safely fetches the Configuration value for state's 'HTTPClient.IdleConnTimeout' field
+func (st *ConfigState) GetHTTPClientIdleConnTimeout() (v time.Duration) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.AllowIPs
+	v = st.config.HTTPClient.IdleConnTimeout
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientAllowIPs safely sets the Configuration value for state's 'HTTPClient.AllowIPs' field
-func (st *ConfigState) SetHTTPClientAllowIPs(v []string) {
+// SetHTTPClientIdleConnTimeout safely sets the Configuration value for state's 'HTTPClient.IdleConnTimeout' field
+func (st *ConfigState) SetHTTPClientIdleConnTimeout(v time.Duration) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.AllowIPs = v
+	st.config.HTTPClient.IdleConnTimeout = v
 	st.reloadToViper()
 }
 
-// HTTPClientAllowIPsFlag returns the flag name for the 'HTTPClient.AllowIPs' field
-func HTTPClientAllowIPsFlag() string { return "httpclient-allow-ips" }
+// HTTPClientIdleConnTimeoutFlag returns the flag name for the 'HTTPClient.IdleConnTimeout' field
+func HTTPClientIdleConnTimeoutFlag() string { return "httpclient-idle-conn-timeout" }
 
-// GetHTTPClientAllowIPs safely fetches the value for global configuration 'HTTPClient.AllowIPs' field
-func GetHTTPClientAllowIPs() []string { return global.GetHTTPClientAllowIPs() }
+// GetHTTPClientIdleConnTimeout safely fetches the value for global configuration 'HTTPClient.IdleConnTimeout' field
+func GetHTTPClientIdleConnTimeout() time.Duration { return global.GetHTTPClientIdleConnTimeout() }
 
-// SetHTTPClientAllowIPs safely sets the value for global configuration 'HTTPClient.AllowIPs' field
-func SetHTTPClientAllowIPs(v []string) { global.SetHTTPClientAllowIPs(v) }
+// SetHTTPClientIdleConnTimeout safely sets the value for global configuration 'HTTPClient.IdleConnTimeout' field
+func SetHTTPClientIdleConnTimeout(v time.Duration) { global.SetHTTPClientIdleConnTimeout(v) }
 
-// GetHTTPClientBlockIPs safely fetches the Configuration value for state's 'HTTPClient.BlockIPs' field
-func (st *ConfigState) GetHTTPClientBlockIPs() (v []string) {
+// GetHTTPClientDisableHTTP2 safely fetches the Configuration value for state's 'HTTPClient.DisableHTTP2' field
+func (st *ConfigState) GetHTTPClientDisableHTTP2() (v bool) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.BlockIPs
+	v = st.config.HTTPClient.DisableHTTP2
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientBlockIPs safely sets the Configuration value for state's 'HTTPClient.BlockIPs' field
-func (st *ConfigState) SetHTTPClientBlockIPs(v []string) {
+// SetHTTPClientDisableHTTP2 safely sets the Configuration value for state's 'HTTPClient.DisableHTTP2' field
+func (st *ConfigState) SetHTTPClientDisableHTTP2(v bool) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.BlockIPs = v
+	st.config.HTTPClient.DisableHTTP2 = v
 	st.reloadToViper()
 }
 
-// HTTPClientBlockIPsFlag returns the flag name for the 'HTTPClient.BlockIPs' field
-func HTTPClientBlockIPsFlag() string { return "httpclient-block-ips" }
+// HTTPClientDisableHTTP2Flag returns the flag name for the 'HTTPClient.DisableHTTP2' field
+func HTTPClientDisableHTTP2Flag() string { return "httpclient-disable-http2" }
 
-// GetHTTPClientBlockIPs safely fetches the value for global configuration 'HTTPClient.BlockIPs' field
-func GetHTTPClientBlockIPs() []string { return global.GetHTTPClientBlockIPs() }
+// GetHTTPClientDisableHTTP2 safely fetches the value for global configuration 'HTTPClient.DisableHTTP2' field
+func GetHTTPClientDisableHTTP2() bool { return global.GetHTTPClientDisableHTTP2() }
 
-// SetHTTPClientBlockIPs safely sets the value for global configuration 'HTTPClient.BlockIPs' field
-func SetHTTPClientBlockIPs(v []string) { global.SetHTTPClientBlockIPs(v) }
+// SetHTTPClientDisableHTTP2 safely sets the value for global configuration 'HTTPClient.DisableHTTP2' field
+func SetHTTPClientDisableHTTP2(v bool) { global.SetHTTPClientDisableHTTP2(v) }
 
-// GetHTTPClientTimeout safely fetches the Configuration value for state's 'HTTPClient.Timeout' field
-func (st *ConfigState) GetHTTPClientTimeout() (v time.Duration) {
+// GetHTTPClientProxy safely fetches the Configuration value for state's 'HTTPClient.Proxy' field
+func (st *ConfigState) GetHTTPClientProxy() (v string) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.Timeout
+	v = st.config.HTTPClient.Proxy
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientTimeout safely sets the Configuration value for state's 'HTTPClient.Timeout' field
-func (st *ConfigState) SetHTTPClientTimeout(v time.Duration) {
+// SetHTTPClientProxy safely sets the Configuration value for state's 'HTTPClient.Proxy' field
+func (st *ConfigState) SetHTTPClientProxy(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.Timeout = v
+	st.config.HTTPClient.Proxy = v
 	st.reloadToViper()
 }
 
-// HTTPClientTimeoutFlag returns the flag name for the 'HTTPClient.Timeout' field
-func HTTPClientTimeoutFlag() string { return "httpclient-timeout" }
+// HTTPClientProxyFlag returns the flag name for the 'HTTPClient.Proxy' field
+func HTTPClientProxyFlag() string { return "httpclient-proxy" }
 
-// GetHTTPClientTimeout safely fetches the value for global configuration 'HTTPClient.Timeout' field
-func GetHTTPClientTimeout() time.Duration { return global.GetHTTPClientTimeout() }
+// GetHTTPClientProxy safely fetches the value for global configuration 'HTTPClient.Proxy' field
+func GetHTTPClientProxy() string { return global.GetHTTPClientProxy() }
 
-// SetHTTPClientTimeout safely sets the value for global configuration 'HTTPClient.Timeout' field
-func SetHTTPClientTimeout(v time.Duration) { global.SetHTTPClientTimeout(v) }
+// SetHTTPClientProxy safely sets the value for global configuration 'HTTPClient.Proxy' field
+func SetHTTPClientProxy(v string) { global.SetHTTPClientProxy(v) }
 
-// GetHTTPClientTLSInsecureSkipVerify safely fetches the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
-func (st *ConfigState) GetHTTPClientTLSInsecureSkipVerify() (v bool) {
+// GetHTTPClientOnionProxy safely fetches the Configuration value for state's 'HTTPClient.OnionProxy' field
+func (st *ConfigState) GetHTTPClientOnionProxy() (v string) {
 	st.mutex.RLock()
-	v = st.config.HTTPClient.TLSInsecureSkipVerify
+	v = st.config.HTTPClient.OnionProxy
 	st.mutex.RUnlock()
 	return
 }
 
-// SetHTTPClientTLSInsecureSkipVerify safely sets the Configuration value for state's 'HTTPClient.TLSInsecureSkipVerify' field
-func (st *ConfigState) SetHTTPClientTLSInsecureSkipVerify(v bool) {
+// SetHTTPClientOnionProxy safely sets the Configuration value for state's 'HTTPClient.OnionProxy' field
+func (st *ConfigState) SetHTTPClientOnionProxy(v string) {
 	st.mutex.Lock()
 	defer st.mutex.Unlock()
-	st.config.HTTPClient.TLSInsecureSkipVerify = v
+	st.config.HTTPClient.OnionProxy = v
 	st.reloadToViper()
 }
 
-// HTTPClientTLSInsecureSkipVerifyFlag returns the flag name for the 'HTTPClient.TLSInsecureSkipVerify' field
-func HTTPClientTLSInsecureSkipVerifyFlag() string { return "httpclient-tls-insecure-skip-verify" }
+// HTTPClientOnionProxyFlag returns the flag name for the 'HTTPClient.OnionProxy' field
+func HTTPClientOnionProxyFlag() string { return "httpclient-onion-proxy" }
 
-// GetHTTPClientTLSInsecureSkipVerify safely fetches the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
-func GetHTTPClientTLSInsecureSkipVerify() bool { return global.GetHTTPClientTLSInsecureSkipVerify() }
+// GetHTTPClientOnionProxy safely fetches the value for global configuration 'HTTPClient.OnionProxy' field
+func GetHTTPClientOnionProxy() string { return global.GetHTTPClientOnionProxy() }
 
-// SetHTTPClientTLSInsecureSkipVerify safely sets the value for global configuration 'HTTPClient.TLSInsecureSkipVerify' field
-func SetHTTPClientTLSInsecureSkipVerify(v bool) { global.SetHTTPClientTLSInsecureSkipVerify(v) }
+// SetHTTPClientOnionProxy safely sets the value for global configuration 'HTTPClient.OnionProxy' field
+func SetHTTPClientOnionProxy(v string) { global.SetHTTPClientOnionProxy(v) }
 
 // GetCacheMemoryTarget safely fetches the Configuration value for state's 'Cache.MemoryTarget' field
 func (st *ConfigState) GetCacheMemoryTarget() (v bytesize.Size) {
@@ -2674,6 +4656,31 @@ func GetCacheBlockIDsMemRatio() float64 { return global.GetCacheBlockIDsMemRatio
 // SetCacheBlockIDsMemRatio safely sets the value for global configuration 'Cache.BlockIDsMemRatio' field
 func SetCacheBlockIDsMemRatio(v float64) { global.SetCacheBlockIDsMemRatio(v) }
 
+// GetCacheBlockFilterMemRatio safely fetches the Configuration value for state's 'Cache.BlockFilterMemRatio' field
+func (st *ConfigState) GetCacheBlockFilterMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.BlockFilterMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheBlockFilterMemRatio safely sets the Configuration value for state's 'Cache.BlockFilterMemRatio' field
+func (st *ConfigState) SetCacheBlockFilterMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.BlockFilterMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheBlockFilterMemRatioFlag returns the flag name for the 'Cache.BlockFilterMemRatio' field
+func CacheBlockFilterMemRatioFlag() string { return "cache-block-filter-mem-ratio" }
+
+// GetCacheBlockFilterMemRatio safely fetches the value for global configuration 'Cache.BlockFilterMemRatio' field
+func GetCacheBlockFilterMemRatio() float64 { return global.GetCacheBlockFilterMemRatio() }
+
+// SetCacheBlockFilterMemRatio safely sets the value for global configuration 'Cache.BlockFilterMemRatio' field
+func SetCacheBlockFilterMemRatio(v float64) { global.SetCacheBlockFilterMemRatio(v) }
+
 // GetCacheBoostOfIDsMemRatio safely fetches the Configuration value for state's 'Cache.BoostOfIDsMemRatio' field
 func (st *ConfigState) GetCacheBoostOfIDsMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -2699,6 +4706,31 @@ func GetCacheBoostOfIDsMemRatio() float64 { return global.GetCacheBoostOfIDsMemR
 // SetCacheBoostOfIDsMemRatio safely sets the value for global configuration 'Cache.BoostOfIDsMemRatio' field
 func SetCacheBoostOfIDsMemRatio(v float64) { global.SetCacheBoostOfIDsMemRatio(v) }
 
+// GetCacheDomainInteropMemRatio safely fetches the Configuration value for state's 'Cache.DomainInteropMemRatio' field
+func (st *ConfigState) GetCacheDomainInteropMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.DomainInteropMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheDomainInteropMemRatio safely sets the Configuration value for state's 'Cache.DomainInteropMemRatio' field
+func (st *ConfigState) SetCacheDomainInteropMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.DomainInteropMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheDomainInteropMemRatioFlag returns the flag name for the 'Cache.DomainInteropMemRatio' field
+func CacheDomainInteropMemRatioFlag() string { return "cache-domain-interop-mem-ratio" }
+
+// GetCacheDomainInteropMemRatio safely fetches the value for global configuration 'Cache.DomainInteropMemRatio' field
+func GetCacheDomainInteropMemRatio() float64 { return global.GetCacheDomainInteropMemRatio() }
+
+// SetCacheDomainInteropMemRatio safely sets the value for global configuration 'Cache.DomainInteropMemRatio' field
+func SetCacheDomainInteropMemRatio(v float64) { global.SetCacheDomainInteropMemRatio(v) }
+
 // GetCacheEmojiMemRatio safely fetches the Configuration value for state's 'Cache.EmojiMemRatio' field
 func (st *ConfigState) GetCacheEmojiMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3024,6 +5056,31 @@ func GetCacheMentionMemRatio() float64 { return global.GetCacheMentionMemRatio()
 // SetCacheMentionMemRatio safely sets the value for global configuration 'Cache.MentionMemRatio' field
 func SetCacheMentionMemRatio(v float64) { global.SetCacheMentionMemRatio(v) }
 
+// GetCacheMuteFilterMemRatio safely fetches the Configuration value for state's 'Cache.MuteFilterMemRatio' field
+func (st *ConfigState) GetCacheMuteFilterMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.MuteFilterMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheMuteFilterMemRatio safely sets the Configuration value for state's 'Cache.MuteFilterMemRatio' field
+func (st *ConfigState) SetCacheMuteFilterMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.MuteFilterMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheMuteFilterMemRatioFlag returns the flag name for the 'Cache.MuteFilterMemRatio' field
+func CacheMuteFilterMemRatioFlag() string { return "cache-mute-filter-mem-ratio" }
+
+// GetCacheMuteFilterMemRatio safely fetches the value for global configuration 'Cache.MuteFilterMemRatio' field
+func GetCacheMuteFilterMemRatio() float64 { return global.GetCacheMuteFilterMemRatio() }
+
+// SetCacheMuteFilterMemRatio safely sets the value for global configuration 'Cache.MuteFilterMemRatio' field
+func SetCacheMuteFilterMemRatio(v float64) { global.SetCacheMuteFilterMemRatio(v) }
+
 // GetCacheNotificationMemRatio safely fetches the Configuration value for state's 'Cache.NotificationMemRatio' field
 func (st *ConfigState) GetCacheNotificationMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3049,6 +5106,31 @@ func GetCacheNotificationMemRatio() float64 { return global.GetCacheNotification
 // SetCacheNotificationMemRatio safely sets the value for global configuration 'Cache.NotificationMemRatio' field
 func SetCacheNotificationMemRatio(v float64) { global.SetCacheNotificationMemRatio(v) }
 
+// GetCachePrimeLocal safely fetches the Configuration value for state's 'Cache.PrimeLocal' field
+func (st *ConfigState) GetCachePrimeLocal() (v bool) {
+	st.mutex.RLock()
+	v = st.config.Cache.PrimeLocal
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCachePrimeLocal safely sets the Configuration value for state's 'Cache.PrimeLocal' field
+func (st *ConfigState) SetCachePrimeLocal(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.PrimeLocal = v
+	st.reloadToViper()
+}
+
+// CachePrimeLocalFlag returns the flag name for the 'Cache.PrimeLocal' field
+func CachePrimeLocalFlag() string { return "cache-prime-local" }
+
+// GetCachePrimeLocal safely fetches the value for global configuration 'Cache.PrimeLocal' field
+func GetCachePrimeLocal() bool { return global.GetCachePrimeLocal() }
+
+// SetCachePrimeLocal safely sets the value for global configuration 'Cache.PrimeLocal' field
+func SetCachePrimeLocal(v bool) { global.SetCachePrimeLocal(v) }
+
 // GetCacheReportMemRatio safely fetches the Configuration value for state's 'Cache.ReportMemRatio' field
 func (st *ConfigState) GetCacheReportMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3149,6 +5231,56 @@ func GetCacheStatusFaveIDsMemRatio() float64 { return global.GetCacheStatusFaveI
 // SetCacheStatusFaveIDsMemRatio safely sets the value for global configuration 'Cache.StatusFaveIDsMemRatio' field
 func SetCacheStatusFaveIDsMemRatio(v float64) { global.SetCacheStatusFaveIDsMemRatio(v) }
 
+// GetCacheStatusReactionMemRatio safely fetches the Configuration value for state's 'Cache.StatusReactionMemRatio' field
+func (st *ConfigState) GetCacheStatusReactionMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusReactionMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusReactionMemRatio safely sets the Configuration value for state's 'Cache.StatusReactionMemRatio' field
+func (st *ConfigState) SetCacheStatusReactionMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusReactionMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheStatusReactionMemRatioFlag returns the flag name for the 'Cache.StatusReactionMemRatio' field
+func CacheStatusReactionMemRatioFlag() string { return "cache-status-reaction-mem-ratio" }
+
+// GetCacheStatusReactionMemRatio safely fetches the value for global configuration 'Cache.StatusReactionMemRatio' field
+func GetCacheStatusReactionMemRatio() float64 { return global.GetCacheStatusReactionMemRatio() }
+
+// SetCacheStatusReactionMemRatio safely sets the value for global configuration 'Cache.StatusReactionMemRatio' field
+func SetCacheStatusReactionMemRatio(v float64) { global.SetCacheStatusReactionMemRatio(v) }
+
+// GetCacheStatusReactionIDsMemRatio safely fetches the Configuration value for state's 'Cache.StatusReactionIDsMemRatio' field
+func (st *ConfigState) GetCacheStatusReactionIDsMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.StatusReactionIDsMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheStatusReactionIDsMemRatio safely sets the Configuration value for state's 'Cache.StatusReactionIDsMemRatio' field
+func (st *ConfigState) SetCacheStatusReactionIDsMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.StatusReactionIDsMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheStatusReactionIDsMemRatioFlag returns the flag name for the 'Cache.StatusReactionIDsMemRatio' field
+func CacheStatusReactionIDsMemRatioFlag() string { return "cache-status-reaction-ids-mem-ratio" }
+
+// GetCacheStatusReactionIDsMemRatio safely fetches the value for global configuration 'Cache.StatusReactionIDsMemRatio' field
+func GetCacheStatusReactionIDsMemRatio() float64 { return global.GetCacheStatusReactionIDsMemRatio() }
+
+// SetCacheStatusReactionIDsMemRatio safely sets the value for global configuration 'Cache.StatusReactionIDsMemRatio' field
+func SetCacheStatusReactionIDsMemRatio(v float64) { global.SetCacheStatusReactionIDsMemRatio(v) }
+
 // GetCacheTagMemRatio safely fetches the Configuration value for state's 'Cache.TagMemRatio' field
 func (st *ConfigState) GetCacheTagMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3199,6 +5331,31 @@ func GetCacheTombstoneMemRatio() float64 { return global.GetCacheTombstoneMemRat
 // SetCacheTombstoneMemRatio safely sets the value for global configuration 'Cache.TombstoneMemRatio' field
 func SetCacheTombstoneMemRatio(v float64) { global.SetCacheTombstoneMemRatio(v) }
 
+// GetCacheTranslationTTL safely fetches the Configuration value for state's 'Cache.TranslationTTL' field
+func (st *ConfigState) GetCacheTranslationTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.TranslationTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheTranslationTTL safely sets the Configuration value for state's 'Cache.TranslationTTL' field
+func (st *ConfigState) SetCacheTranslationTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.TranslationTTL = v
+	st.reloadToViper()
+}
+
+// CacheTranslationTTLFlag returns the flag name for the 'Cache.TranslationTTL' field
+func CacheTranslationTTLFlag() string { return "cache-translation-ttl" }
+
+// GetCacheTranslationTTL safely fetches the value for global configuration 'Cache.TranslationTTL' field
+func GetCacheTranslationTTL() time.Duration { return global.GetCacheTranslationTTL() }
+
+// SetCacheTranslationTTL safely sets the value for global configuration 'Cache.TranslationTTL' field
+func SetCacheTranslationTTL(v time.Duration) { global.SetCacheTranslationTTL(v) }
+
 // GetCacheUserMemRatio safely fetches the Configuration value for state's 'Cache.UserMemRatio' field
 func (st *ConfigState) GetCacheUserMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3224,6 +5381,31 @@ func GetCacheUserMemRatio() float64 { return global.GetCacheUserMemRatio() }
 // SetCacheUserMemRatio safely sets the value for global configuration 'Cache.UserMemRatio' field
 func SetCacheUserMemRatio(v float64) { global.SetCacheUserMemRatio(v) }
 
+// GetCacheUserMuteMemRatio safely fetches the Configuration value for state's 'Cache.UserMuteMemRatio' field
+func (st *ConfigState) GetCacheUserMuteMemRatio() (v float64) {
+	st.mutex.RLock()
+	v = st.config.Cache.UserMuteMemRatio
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheUserMuteMemRatio safely sets the Configuration value for state's 'Cache.UserMuteMemRatio' field
+func (st *ConfigState) SetCacheUserMuteMemRatio(v float64) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.UserMuteMemRatio = v
+	st.reloadToViper()
+}
+
+// CacheUserMuteMemRatioFlag returns the flag name for the 'Cache.UserMuteMemRatio' field
+func CacheUserMuteMemRatioFlag() string { return "cache-user-mute-mem-ratio" }
+
+// GetCacheUserMuteMemRatio safely fetches the value for global configuration 'Cache.UserMuteMemRatio' field
+func GetCacheUserMuteMemRatio() float64 { return global.GetCacheUserMuteMemRatio() }
+
+// SetCacheUserMuteMemRatio safely sets the value for global configuration 'Cache.UserMuteMemRatio' field
+func SetCacheUserMuteMemRatio(v float64) { global.SetCacheUserMuteMemRatio(v) }
+
 // GetCacheWebfingerMemRatio safely fetches the Configuration value for state's 'Cache.WebfingerMemRatio' field
 func (st *ConfigState) GetCacheWebfingerMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3249,6 +5431,56 @@ func GetCacheWebfingerMemRatio() float64 { return global.GetCacheWebfingerMemRat
 // SetCacheWebfingerMemRatio safely sets the value for global configuration 'Cache.WebfingerMemRatio' field
 func SetCacheWebfingerMemRatio(v float64) { global.SetCacheWebfingerMemRatio(v) }
 
+// GetCacheWebfingerTTL safely fetches the Configuration value for state's 'Cache.WebfingerTTL' field
+func (st *ConfigState) GetCacheWebfingerTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.WebfingerTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheWebfingerTTL safely sets the Configuration value for state's 'Cache.WebfingerTTL' field
+func (st *ConfigState) SetCacheWebfingerTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.WebfingerTTL = v
+	st.reloadToViper()
+}
+
+// CacheWebfingerTTLFlag returns the flag name for the 'Cache.WebfingerTTL' field
+func CacheWebfingerTTLFlag() string { return "cache-webfinger-ttl" }
+
+// GetCacheWebfingerTTL safely fetches the value for global configuration 'Cache.WebfingerTTL' field
+func GetCacheWebfingerTTL() time.Duration { return global.GetCacheWebfingerTTL() }
+
+// SetCacheWebfingerTTL safely sets the value for global configuration 'Cache.WebfingerTTL' field
+func SetCacheWebfingerTTL(v time.Duration) { global.SetCacheWebfingerTTL(v) }
+
+// GetCacheWebfingerNegativeTTL safely fetches the Configuration value for state's 'Cache.WebfingerNegativeTTL' field
+func (st *ConfigState) GetCacheWebfingerNegativeTTL() (v time.Duration) {
+	st.mutex.RLock()
+	v = st.config.Cache.WebfingerNegativeTTL
+	st.mutex.RUnlock()
+	return
+}
+
+// SetCacheWebfingerNegativeTTL safely sets the Configuration value for state's 'Cache.WebfingerNegativeTTL' field
+func (st *ConfigState) SetCacheWebfingerNegativeTTL(v time.Duration) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.Cache.WebfingerNegativeTTL = v
+	st.reloadToViper()
+}
+
+// CacheWebfingerNegativeTTLFlag returns the flag name for the 'Cache.WebfingerNegativeTTL' field
+func CacheWebfingerNegativeTTLFlag() string { return "cache-webfinger-negative-ttl" }
+
+// GetCacheWebfingerNegativeTTL safely fetches the value for global configuration 'Cache.WebfingerNegativeTTL' field
+func GetCacheWebfingerNegativeTTL() time.Duration { return global.GetCacheWebfingerNegativeTTL() }
+
+// SetCacheWebfingerNegativeTTL safely sets the value for global configuration 'Cache.WebfingerNegativeTTL' field
+func SetCacheWebfingerNegativeTTL(v time.Duration) { global.SetCacheWebfingerNegativeTTL(v) }
+
 // GetCacheVisibilityMemRatio safely fetches the Configuration value for state's 'Cache.VisibilityMemRatio' field
 func (st *ConfigState) GetCacheVisibilityMemRatio() (v float64) {
 	st.mutex.RLock()
@@ -3349,6 +5581,106 @@ func GetAdminAccountPassword() string { return global.GetAdminAccountPassword()
 // SetAdminAccountPassword safely sets the value for global configuration 'AdminAccountPassword' field
 func SetAdminAccountPassword(v string) { global.SetAdminAccountPassword(v) }
 
+// GetAdminAccountDomain safely fetches the Configuration value for state's 'AdminAccountDomain' field
+func (st *ConfigState) GetAdminAccountDomain() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountDomain
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountDomain safely sets the Configuration value for state's 'AdminAccountDomain' field
+func (st *ConfigState) SetAdminAccountDomain(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountDomain = v
+	st.reloadToViper()
+}
+
+// AdminAccountDomainFlag returns the flag name for the 'AdminAccountDomain' field
+func AdminAccountDomainFlag() string { return "domain" }
+
+// GetAdminAccountDomain safely fetches the value for global configuration 'AdminAccountDomain' field
+func GetAdminAccountDomain() string { return global.GetAdminAccountDomain() }
+
+// SetAdminAccountDomain safely sets the value for global configuration 'AdminAccountDomain' field
+func SetAdminAccountDomain(v string) { global.SetAdminAccountDomain(v) }
+
+// GetAdminAccountRole safely fetches the Configuration value for state's 'AdminAccountRole' field
+func (st *ConfigState) GetAdminAccountRole() (v string) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountRole
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountRole safely sets the Configuration value for state's 'AdminAccountRole' field
+func (st *ConfigState) SetAdminAccountRole(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountRole = v
+	st.reloadToViper()
+}
+
+// AdminAccountRoleFlag returns the flag name for the 'AdminAccountRole' field
+func AdminAccountRoleFlag() string { return "role" }
+
+// GetAdminAccountRole safely fetches the value for global configuration 'AdminAccountRole' field
+func GetAdminAccountRole() string { return global.GetAdminAccountRole() }
+
+// SetAdminAccountRole safely sets the value for global configuration 'AdminAccountRole' field
+func SetAdminAccountRole(v string) { global.SetAdminAccountRole(v) }
+
+// GetAdminAccountListPending safely fetches the Configuration value for state's 'AdminAccountListPending' field
+func (st *ConfigState) GetAdminAccountListPending() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountListPending
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountListPending safely sets the Configuration value for state's 'AdminAccountListPending' field
+func (st *ConfigState) SetAdminAccountListPending(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountListPending = v
+	st.reloadToViper()
+}
+
+// AdminAccountListPendingFlag returns the flag name for the 'AdminAccountListPending' field
+func AdminAccountListPendingFlag() string { return "pending" }
+
+// GetAdminAccountListPending safely fetches the value for global configuration 'AdminAccountListPending' field
+func GetAdminAccountListPending() bool { return global.GetAdminAccountListPending() }
+
+// SetAdminAccountListPending safely sets the value for global configuration 'AdminAccountListPending' field
+func SetAdminAccountListPending(v bool) { global.SetAdminAccountListPending(v) }
+
+// GetAdminAccountListDisabled safely fetches the Configuration value for state's 'AdminAccountListDisabled' field
+func (st *ConfigState) GetAdminAccountListDisabled() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminAccountListDisabled
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminAccountListDisabled safely sets the Configuration value for state's 'AdminAccountListDisabled' field
+func (st *ConfigState) SetAdminAccountListDisabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminAccountListDisabled = v
+	st.reloadToViper()
+}
+
+// AdminAccountListDisabledFlag returns the flag name for the 'AdminAccountListDisabled' field
+func AdminAccountListDisabledFlag() string { return "disabled" }
+
+// GetAdminAccountListDisabled safely fetches the value for global configuration 'AdminAccountListDisabled' field
+func GetAdminAccountListDisabled() bool { return global.GetAdminAccountListDisabled() }
+
+// SetAdminAccountListDisabled safely sets the value for global configuration 'AdminAccountListDisabled' field
+func SetAdminAccountListDisabled(v bool) { global.SetAdminAccountListDisabled(v) }
+
 // GetAdminTransPath safely fetches the Configuration value for state's 'AdminTransPath' field
 func (st *ConfigState) GetAdminTransPath() (v string) {
 	st.mutex.RLock()
@@ -3449,6 +5781,31 @@ func GetAdminMediaListRemoteOnly() bool { return global.GetAdminMediaListRemoteO
 // SetAdminMediaListRemoteOnly safely sets the value for global configuration 'AdminMediaListRemoteOnly' field
 func SetAdminMediaListRemoteOnly(v bool) { global.SetAdminMediaListRemoteOnly(v) }
 
+// GetAdminDBCheckRepair safely fetches the Configuration value for state's 'AdminDBCheckRepair' field
+func (st *ConfigState) GetAdminDBCheckRepair() (v bool) {
+	st.mutex.RLock()
+	v = st.config.AdminDBCheckRepair
+	st.mutex.RUnlock()
+	return
+}
+
+// SetAdminDBCheckRepair safely sets the Configuration value for state's 'AdminDBCheckRepair' field
+func (st *ConfigState) SetAdminDBCheckRepair(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AdminDBCheckRepair = v
+	st.reloadToViper()
+}
+
+// AdminDBCheckRepairFlag returns the flag name for the 'AdminDBCheckRepair' field
+func AdminDBCheckRepairFlag() string { return "repair" }
+
+// GetAdminDBCheckRepair safely fetches the value for global configuration 'AdminDBCheckRepair' field
+func GetAdminDBCheckRepair() bool { return global.GetAdminDBCheckRepair() }
+
+// SetAdminDBCheckRepair safely sets the value for global configuration 'AdminDBCheckRepair' field
+func SetAdminDBCheckRepair(v bool) { global.SetAdminDBCheckRepair(v) }
+
 // GetRequestIDHeader safely fetches the Configuration value for state's 'RequestIDHeader' field
 func (st *ConfigState) GetRequestIDHeader() (v string) {
 	st.mutex.RLock()
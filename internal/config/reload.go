@@ -0,0 +1,197 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/spf13/viper"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// reloadable contains the names of settings which can be changed
+// in the config file and applied without restarting, and name
+// prefixes (ending in '-') for whole groups of such settings.
+var reloadable = []string{
+	"log-level",
+	"log-levels",
+	"advanced-rate-limit-requests",
+	"advanced-rate-limit-exceptions",
+	"media-",
+	"smtp-",
+}
+
+// IsReloadable returns whether the setting with the given
+// name can be changed at runtime by ReloadFile, as opposed
+// to requiring a restart to take effect.
+func IsReloadable(name string) bool {
+	for _, r := range reloadable {
+		if name == r || (strings.HasSuffix(r, "-") &&
+			strings.HasPrefix(name, r)) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReloadResult describes the outcome of re-reading the config file.
+type ReloadResult struct {
+	// Changed contains the names of reloadable
+	// settings whose values were changed, and
+	// which now hold their new values.
+	Changed []string
+
+	// RequiresRestart contains the names of
+	// settings whose values were changed in
+	// the file but were NOT applied, as the
+	// instance must be restarted to apply them.
+	RequiresRestart []string
+}
+
+// ReloadFile re-reads the configuration file, applying changed values
+// of reloadable settings (see IsReloadable) to the ConfigState. Other
+// settings keep their current value. Settings which are overridden by
+// an environment variable or command line flag are skipped, as the file
+// has no effect on them.
+//
+// Callers are responsible for applying changed settings to anything
+// that only reads them on startup, see also OnReload.
+func (st *ConfigState) ReloadFile() (*ReloadResult, error) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+
+	path := st.config.ConfigPath
+	if path == "" {
+		return nil, errors.New("no configuration file set")
+	}
+
+	// Read file into a fresh viper instance, so
+	// we only see (and only apply) what it sets.
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+
+	// Decode file values over a copy of the
+	// current configuration, without zeroing,
+	// so unset values are left as they were.
+	next := st.config
+	if err := v.Unmarshal(&next, func(c *mapstructure.DecoderConfig) {
+		c.TagName = "name"
+		c.DecodeHook = mapstructure.ComposeDecodeHookFunc(
+			mapstructure.TextUnmarshallerHookFunc(),
+			c.DecodeHook,
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	var (
+		res  = new(ReloadResult)
+		curr = reflect.ValueOf(&st.config).Elem()
+		newv = reflect.ValueOf(&next).Elem()
+		t    = curr.Type()
+	)
+
+	for i := 0; i < t.NumField(); i++ {
+		name := t.Field(i).Tag.Get("name")
+		if name == "" || !v.IsSet(name) {
+			// Not in file.
+			continue
+		}
+
+		envKey := "GTS_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+		if _, ok := os.LookupEnv(envKey); ok || st.flagChanged(name) {
+			// Env + flags take precedence.
+			continue
+		}
+
+		if reflect.DeepEqual(
+			curr.Field(i).Interface(),
+			newv.Field(i).Interface(),
+		) {
+			// Unchanged.
+			continue
+		}
+
+		if !IsReloadable(name) {
+			res.RequiresRestart = append(res.RequiresRestart, name)
+			continue
+		}
+
+		curr.Field(i).Set(newv.Field(i))
+		res.Changed = append(res.Changed, name)
+	}
+
+	if len(res.Changed) > 0 {
+		st.reloadToViper()
+	}
+
+	return res, nil
+}
+
+var (
+	reloadHooks []func(changed []string)
+	reloadMutex sync.Mutex
+)
+
+// OnReload registers a function to be called after ReloadFile
+// successfully changes one or more settings of the global config,
+// with the names of the changed settings. This should be used to
+// apply settings which are otherwise only read on startup.
+func OnReload(fn func(changed []string)) {
+	reloadMutex.Lock()
+	reloadHooks = append(reloadHooks, fn)
+	reloadMutex.Unlock()
+}
+
+// ReloadFile re-reads the global configuration file, applying any
+// changes to reloadable settings, and logging which were changed
+// and which will only be applied after a restart. See ConfigState.ReloadFile().
+func ReloadFile() (*ReloadResult, error) {
+	// Serialize reloads so that
+	// hooks run in file order.
+	reloadMutex.Lock()
+	defer reloadMutex.Unlock()
+
+	res, err := global.ReloadFile()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Changed) > 0 {
+		log.Infof(nil, "config reloaded, changed settings: %s", strings.Join(res.Changed, ", "))
+		for _, fn := range reloadHooks {
+			fn(res.Changed)
+		}
+	} else {
+		log.Info(nil, "config reloaded, no reloadable settings changed")
+	}
+
+	if len(res.RequiresRestart) > 0 {
+		log.Warnf(nil, "config reloaded, changed settings which require a restart to take effect: %s", strings.Join(res.RequiresRestart, ", "))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+func TestReloadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	write := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("host: example.org\nlog-level: info\nsmtp-host: smtp.example.org\n")
+
+	state := config.NewState()
+	state.SetConfigPath(path)
+	if err := state.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "example.org", state.GetHost())
+	assert.Equal(t, "info", state.GetLogLevel())
+
+	// Change a reloadable setting, a setting
+	// which requires restart, and add one
+	// which was previously left as default.
+	write("host: example.com\nlog-level: debug\nsmtp-host: smtp.example.org\nmedia-description-max-chars: 2000\n")
+
+	res, err := state.ReloadFile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.ElementsMatch(t, []string{"log-level", "media-description-max-chars"}, res.Changed)
+	assert.Equal(t, []string{"host"}, res.RequiresRestart)
+
+	// Reloadable settings are applied,
+	// others keep their running values.
+	assert.Equal(t, "debug", state.GetLogLevel())
+	assert.Equal(t, 2000, state.GetMediaDescriptionMaxChars())
+	assert.Equal(t, "example.org", state.GetHost())
+	assert.Equal(t, "smtp.example.org", state.GetSMTPHost())
+}
+
+func TestIsReloadable(t *testing.T) {
+	assert.True(t, config.IsReloadable("log-level"))
+	assert.True(t, config.IsReloadable("smtp-password"))
+	assert.True(t, config.IsReloadable("media-local-quota"))
+	assert.True(t, config.IsReloadable("advanced-rate-limit-requests"))
+	assert.False(t, config.IsReloadable("host"))
+	assert.False(t, config.IsReloadable("advanced-throttling-multiplier"))
+	assert.False(t, config.IsReloadable("log-timestamp-format"))
+}
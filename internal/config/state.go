@@ -23,6 +23,7 @@ import (
 
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
@@ -32,6 +33,7 @@ import (
 type ConfigState struct { //nolint
 	viper  *viper.Viper
 	config Configuration
+	flags  []*pflag.FlagSet
 	mutex  sync.RWMutex
 }
 
@@ -96,10 +98,22 @@ func (st *ConfigState) LoadEarlyFlags(cmd *cobra.Command) (err error) {
 func (st *ConfigState) BindFlags(cmd *cobra.Command) (err error) {
 	st.Viper(func(v *viper.Viper) {
 		err = v.BindPFlags(cmd.Flags())
+		st.flags = append(st.flags, cmd.Flags())
 	})
 	return
 }
 
+// flagChanged returns whether the flag with given name was
+// explicitly set on the command line of any bound command.
+func (st *ConfigState) flagChanged(name string) bool {
+	for _, flags := range st.flags {
+		if f := flags.Lookup(name); f != nil && f.Changed {
+			return true
+		}
+	}
+	return false
+}
+
 // Reload will reload the Configuration values from ConfigState's viper instance, and from file if set.
 func (st *ConfigState) Reload() (err error) {
 	st.Viper(func(v *viper.Viper) {
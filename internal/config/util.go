@@ -19,6 +19,7 @@ package config
 
 import (
 	"net/netip"
+	"net/url"
 
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
@@ -37,3 +38,28 @@ func MustParseIPPrefixes(in []string) []netip.Prefix {
 
 	return prefs
 }
+
+// MustParseProxyURL parses the given proxy URL, returning nil if it's
+// empty, or panicking if it's not a valid http, https or socks5 URL.
+func MustParseProxyURL(in string) *url.URL {
+	if in == "" {
+		return nil
+	}
+
+	u, err := url.Parse(in)
+	if err != nil {
+		log.Panicf(nil, "error parsing proxy url from %q: %v", in, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		log.Panicf(nil, "unsupported proxy url scheme %q, should be http, https or socks5", u.Scheme)
+	}
+
+	if u.Host == "" {
+		log.Panicf(nil, "proxy url %q has no host", in)
+	}
+
+	return u
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/text/language"
 )
 
 // Validate validates global config settings which don't have defaults, to make sure they are set sensibly.
@@ -72,11 +73,26 @@ func Validate() error {
 		errs = append(errs, fmt.Errorf("%s must be set to either blocklist or allowlist, provided value was %s", InstanceFederationModeFlag(), federationMode))
 	}
 
+	for _, lang := range GetInstanceLanguages() {
+		if _, err := language.Parse(lang); err != nil {
+			errs = append(errs, fmt.Errorf("%s contained %s, which is not a valid BCP 47 language tag: %w", InstanceLanguagesFlag(), lang, err))
+		}
+	}
+
 	webAssetsBaseDir := GetWebAssetBaseDir()
 	if webAssetsBaseDir == "" {
 		errs = append(errs, fmt.Errorf("%s must be set", WebAssetBaseDirFlag()))
 	}
 
+	for _, rel := range GetAdvancedSanitizerLinkRel() {
+		switch rel {
+		case "nofollow", "noreferrer", "noopener", "ugc", "external":
+			// no problem
+		default:
+			errs = append(errs, fmt.Errorf("%s contained %s, but only nofollow, noreferrer, noopener, ugc and external are allowed", AdvancedSanitizerLinkRelFlag(), rel))
+		}
+	}
+
 	tlsChain := GetTLSCertificateChain()
 	tlsKey := GetTLSCertificateKey()
 	tlsChainFlag := TLSCertificateChainFlag()
@@ -90,6 +106,90 @@ func Validate() error {
 		errs = append(errs, fmt.Errorf("%s and %s need to both be set or unset", tlsChainFlag, tlsKeyFlag))
 	}
 
+	// letsencrypt challenge type
+	if GetLetsEncryptEnabled() {
+		switch challenge := GetLetsEncryptChallenge(); challenge {
+		case LetsEncryptChallengeHTTP01:
+			// no problem
+			break
+		case LetsEncryptChallengeDNS01:
+			if GetLetsEncryptDNSCommand() == "" {
+				errs = append(errs, fmt.Errorf("%s must be set when %s is %s", LetsEncryptDNSCommandFlag(), LetsEncryptChallengeFlag(), challenge))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("%s must be set to either %s or %s, provided value was %s", LetsEncryptChallengeFlag(), LetsEncryptChallengeHTTP01, LetsEncryptChallengeDNS01, challenge))
+		}
+	}
+
+	// smtp transport
+	switch transport := GetSMTPTransport(); transport {
+	case SMTPTransportSMTP:
+		// no problem
+		break
+	case SMTPTransportHTTP:
+		if GetSMTPHTTPURL() == "" {
+			errs = append(errs, fmt.Errorf("%s must be set when %s is %s", SMTPHTTPURLFlag(), SMTPTransportFlag(), transport))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%s must be set to either smtp or http, provided value was %s", SMTPTransportFlag(), transport))
+	}
+
+	// sign-up challenge
+	switch provider := GetAccountsChallengeProvider(); provider {
+	case "":
+		// no problem
+		break
+	case AccountsChallengeProviderHCaptcha, AccountsChallengeProviderTurnstile:
+		if GetAccountsChallengeSiteKey() == "" || GetAccountsChallengeSecretKey() == "" {
+			errs = append(errs, fmt.Errorf("%s and %s must be set when %s is %s", AccountsChallengeSiteKeyFlag(), AccountsChallengeSecretKeyFlag(), AccountsChallengeProviderFlag(), provider))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%s must be set to either hcaptcha, turnstile, or left empty, provided value was %s", AccountsChallengeProviderFlag(), provider))
+	}
+
+	// status translation
+	switch backend := GetStatusesTranslationBackend(); backend {
+	case "":
+		// no problem
+		break
+	case StatusesTranslationBackendLibreTranslate:
+		if GetStatusesTranslationURL() == "" {
+			errs = append(errs, fmt.Errorf("%s must be set when %s is %s", StatusesTranslationURLFlag(), StatusesTranslationBackendFlag(), backend))
+		}
+	case StatusesTranslationBackendDeepL:
+		if GetStatusesTranslationAPIKey() == "" {
+			errs = append(errs, fmt.Errorf("%s must be set when %s is %s", StatusesTranslationAPIKeyFlag(), StatusesTranslationBackendFlag(), backend))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%s must be set to either libretranslate, deepl, or left empty, provided value was %s", StatusesTranslationBackendFlag(), backend))
+	}
+
+	dkimDomain := GetSMTPDKIMDomain()
+	dkimSelector := GetSMTPDKIMSelector()
+	dkimKeyPath := GetSMTPDKIMPrivateKeyPath()
+	if (dkimDomain != "" || dkimSelector != "" || dkimKeyPath != "") &&
+		(dkimDomain == "" || dkimSelector == "" || dkimKeyPath == "") {
+		errs = append(errs, fmt.Errorf("%s, %s and %s need to all be set or unset", SMTPDKIMDomainFlag(), SMTPDKIMSelectorFlag(), SMTPDKIMPrivateKeyPathFlag()))
+	}
+
+	// redis
+	for _, queue := range GetRedisQueues() {
+		switch queue {
+		case RedisQueueClientAPI, RedisQueueFediAPI:
+		default:
+			errs = append(errs, fmt.Errorf("%s must contain only %s or %s, provided value was %s", RedisQueuesFlag(), RedisQueueClientAPI, RedisQueueFediAPI, queue))
+		}
+	}
+
+	if GetRedisURL() == "" && (GetRedisCacheNotify() || len(GetRedisQueues()) > 0) {
+		errs = append(errs, fmt.Errorf("%s must be set when %s or %s are set", RedisURLFlag(), RedisCacheNotifyFlag(), RedisQueuesFlag()))
+	}
+
+	// jobs
+	if GetJobsMaxRetries() < 0 {
+		errs = append(errs, fmt.Errorf("%s must be 0 or greater, provided value was %d", JobsMaxRetriesFlag(), GetJobsMaxRetries()))
+	}
+
 	if len(errs) > 0 {
 		errStrings := []string{}
 		for _, err := range errs {
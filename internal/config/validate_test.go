@@ -140,6 +140,63 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set; protocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigChallengeNoKeys() {
+	testrig.InitTestConfig()
+
+	config.SetAccountsChallengeProvider("hcaptcha")
+	config.SetAccountsChallengeSiteKey("10000000-ffff-ffff-ffff-000000000001")
+
+	err := config.Validate()
+	suite.EqualError(err, "accounts-challenge-site-key and accounts-challenge-secret-key must be set when accounts-challenge-provider is hcaptcha")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadChallengeProvider() {
+	testrig.InitTestConfig()
+
+	config.SetAccountsChallengeProvider("recaptcha")
+
+	err := config.Validate()
+	suite.EqualError(err, "accounts-challenge-provider must be set to either hcaptcha, turnstile, or left empty, provided value was recaptcha")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigLibreTranslateNoURL() {
+	testrig.InitTestConfig()
+
+	config.SetStatusesTranslationBackend("libretranslate")
+
+	err := config.Validate()
+	suite.EqualError(err, "statuses-translation-url must be set when statuses-translation-backend is libretranslate")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadTranslationBackend() {
+	testrig.InitTestConfig()
+
+	config.SetStatusesTranslationBackend("google")
+
+	err := config.Validate()
+	suite.EqualError(err, "statuses-translation-backend must be set to either libretranslate, deepl, or left empty, provided value was google")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigLetsEncryptDNSNoCommand() {
+	testrig.InitTestConfig()
+
+	config.SetLetsEncryptEnabled(true)
+	config.SetLetsEncryptChallenge("dns-01")
+
+	err := config.Validate()
+	suite.EqualError(err, "letsencrypt-dns-command must be set when letsencrypt-challenge is dns-01")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadLetsEncryptChallenge() {
+	testrig.InitTestConfig()
+
+	config.SetLetsEncryptEnabled(true)
+	config.SetLetsEncryptChallenge("tls-alpn-01")
+
+	err := config.Validate()
+	suite.EqualError(err, "letsencrypt-challenge must be set to either http-01 or dns-01, provided value was tls-alpn-01")
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
@@ -67,9 +67,6 @@ type Account interface {
 	// account as suspended instead, rather than deleting from the db entirely.
 	DeleteAccount(ctx context.Context, id string) error
 
-	// GetAccountCustomCSSByUsername returns the custom css of an account on this instance with the given username.
-	GetAccountCustomCSSByUsername(ctx context.Context, username string) (string, error)
-
 	// GetAccountFaves fetches faves/likes created by the target accountID.
 	GetAccountFaves(ctx context.Context, accountID string) ([]*gtsmodel.StatusFave, error)
 
@@ -79,9 +76,18 @@ type Account interface {
 	// GetAccountStatusesCount is a shortcut for the common action of counting statuses produced by accountID.
 	CountAccountStatuses(ctx context.Context, accountID string) (int, error)
 
+	// CountAccountStatusesSince returns the number of statuses with the given
+	// visibility produced by accountID which were created since the given time.
+	CountAccountStatusesSince(ctx context.Context, accountID string, visibility gtsmodel.Visibility, since time.Time) (int, error)
+
 	// CountAccountPinned returns the total number of pinned statuses owned by account with the given id.
 	CountAccountPinned(ctx context.Context, accountID string) (int, error)
 
+	// GetAccountInteractionCounts returns counts of faves, boosts and replies received on statuses
+	// of the account with the given id since the given time, grouped by the interacting account.
+	// Interactions by the account itself are not included. Results are not sorted.
+	GetAccountInteractionCounts(ctx context.Context, accountID string, since time.Time) ([]*gtsmodel.InteractionCount, error)
+
 	// GetAccountStatuses is a shortcut for getting the most recent statuses. accountID is optional, if not provided
 	// then all statuses will be returned. If limit is set to 0, the size of the returned slice will not be limited. This can
 	// be very memory intensive so you probably shouldn't do this!
@@ -92,7 +98,8 @@ type Account interface {
 	// GetAccountPinnedStatuses returns ONLY statuses owned by the give accountID for which a corresponding StatusPin
 	// exists in the database. Statuses which are not pinned will not be returned by this function.
 	//
-	// Statuses will be returned in the order in which they were pinned, from latest pinned to oldest pinned (descending).
+	// Statuses will be returned in their pin order (ascending), with pins that haven't been ordered first,
+	// in the order in which they were pinned, from latest pinned to oldest pinned (descending).
 	//
 	// In the case of no statuses, this function will return db.ErrNoEntries.
 	GetAccountPinnedStatuses(ctx context.Context, accountID string) ([]*gtsmodel.Status, error)
@@ -111,6 +118,10 @@ type Account interface {
 	// The returned time will be zero if account has never posted anything.
 	GetAccountLastPosted(ctx context.Context, accountID string, webOnly bool) (time.Time, error)
 
+	// GetStaleFollowedAccounts fetches up to limit remote accounts followed by local accounts,
+	// which were last fetched before fetchedBefore (or never), least recently fetched first.
+	GetStaleFollowedAccounts(ctx context.Context, fetchedBefore time.Time, limit int) ([]*gtsmodel.Account, error)
+
 	// SetAccountHeaderOrAvatar sets the header or avatar for the given accountID to the given media attachment.
 	SetAccountHeaderOrAvatar(ctx context.Context, mediaAttachment *gtsmodel.MediaAttachment, accountID string) error
 
@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AccountStats interface {
+	// GetAccountStats gets the follower, following and status counts of the given
+	// account. If they've not been stored yet, they're counted and stored first.
+	GetAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error)
+
+	// GetAccountStatsIDs gets up to limit IDs of accounts which have stored
+	// stats, in descending order, starting from (but not including) maxID.
+	GetAccountStatsIDs(ctx context.Context, maxID string, limit int) ([]string, error)
+
+	// RegenerateAccountStats recounts the follower, following and status
+	// counts of the given account from scratch, storing the results.
+	RegenerateAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error)
+
+	// DeleteAccountStats deletes stored stats of the given account.
+	DeleteAccountStats(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,37 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Backfill interface {
+	// GetBackfills gets the stored progress of all
+	// backfills which have been started, sorted by name.
+	GetBackfills(ctx context.Context) ([]*gtsmodel.Backfill, error)
+
+	// RunBackfills runs batches of the unfinished backfills scheduled by
+	// migrations, storing progress after each batch, until either they're
+	// all finished or the until time has passed. Returns whether all the
+	// backfills are finished.
+	RunBackfills(ctx context.Context, until time.Time) (bool, error)
+}
@@ -385,6 +385,15 @@ func (a *accountDB) DeleteAccount(ctx context.Context, id string) error {
 			return err
 		}
 
+		// clear out any stored stats
+		if _, err := tx.
+			NewDelete().
+			Table("account_stats").
+			Where("? = ?", bun.Ident("account_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// delete the account
 		_, err := tx.
 			NewDelete().
@@ -455,15 +464,6 @@ func (a *accountDB) SetAccountHeaderOrAvatar(ctx context.Context, mediaAttachmen
 	return nil
 }
 
-func (a *accountDB) GetAccountCustomCSSByUsername(ctx context.Context, username string) (string, error) {
-	account, err := a.GetAccountByUsernameDomain(ctx, username, "")
-	if err != nil {
-		return "", err
-	}
-
-	return account.CustomCSS, nil
-}
-
 func (a *accountDB) GetAccountsUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Account, error) {
 	var accountIDs []string
 
@@ -481,6 +481,30 @@ func (a *accountDB) GetAccountsUsingEmoji(ctx context.Context, emojiID string) (
 	return a.GetAccountsByIDs(ctx, accountIDs)
 }
 
+func (a *accountDB) GetStaleFollowedAccounts(ctx context.Context, fetchedBefore time.Time, limit int) ([]*gtsmodel.Account, error) {
+	var accountIDs []string
+
+	if err := a.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NOT NULL", bun.Ident("account.domain")).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("account.fetched_at")).
+				WhereOr("? < ?", bun.Ident("account.fetched_at"), fetchedBefore)
+		}).
+		Where("? IN (?)", bun.Ident("account.id"), newSelectLocallyFollowedIDs(a.db)).
+		Order("account.fetched_at ASC").
+		Limit(limit).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert account IDs into account objects.
+	return a.GetAccountsByIDs(ctx, accountIDs)
+}
+
 func (a *accountDB) GetAccountFaves(ctx context.Context, accountID string) ([]*gtsmodel.StatusFave, error) {
 	faves := new([]*gtsmodel.StatusFave)
 
@@ -503,6 +527,16 @@ func (a *accountDB) CountAccountStatuses(ctx context.Context, accountID string)
 		Count(ctx)
 }
 
+func (a *accountDB) CountAccountStatusesSince(ctx context.Context, accountID string, visibility gtsmodel.Visibility, since time.Time) (int, error) {
+	return a.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Where("? = ?", bun.Ident("status.account_id"), accountID).
+		Where("? = ?", bun.Ident("status.visibility"), visibility).
+		Where("? >= ?", bun.Ident("status.created_at"), since).
+		Count(ctx)
+}
+
 func (a *accountDB) CountAccountPinned(ctx context.Context, accountID string) (int, error) {
 	return a.db.
 		NewSelect().
@@ -512,6 +546,74 @@ func (a *accountDB) CountAccountPinned(ctx context.Context, accountID string) (i
 		Count(ctx)
 }
 
+func (a *accountDB) GetAccountInteractionCounts(ctx context.Context, accountID string, since time.Time) ([]*gtsmodel.InteractionCount, error) {
+	var (
+		counts  = make(map[string]*gtsmodel.InteractionCount)
+		results []*gtsmodel.InteractionCount
+	)
+
+	// getCount returns the interaction count
+	// for the given interacting account ID.
+	getCount := func(id string) *gtsmodel.InteractionCount {
+		count, ok := counts[id]
+		if !ok {
+			count = &gtsmodel.InteractionCount{AccountID: id}
+			counts[id] = count
+			results = append(results, count)
+		}
+		return count
+	}
+
+	// countGrouped counts rows of the given table where
+	// targetColumn is accountID, grouped by the account
+	// that created the row, and passes results to set.
+	countGrouped := func(table string, targetColumn string, set func(*gtsmodel.InteractionCount, int)) error {
+		var rows []struct {
+			AccountID string `bun:"account_id"`
+			Count     int    `bun:"count"`
+		}
+
+		if err := a.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident(table), bun.Ident("t")).
+			Column("t.account_id").
+			ColumnExpr("COUNT(*) AS ?", bun.Ident("count")).
+			Where("? = ?", bun.Ident("t."+targetColumn), accountID).
+			Where("? != ?", bun.Ident("t.account_id"), accountID).
+			Where("? > ?", bun.Ident("t.created_at"), since).
+			Group("t.account_id").
+			Scan(ctx, &rows); err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			set(getCount(row.AccountID), row.Count)
+		}
+
+		return nil
+	}
+
+	if err := countGrouped("status_faves", "target_account_id", func(c *gtsmodel.InteractionCount, n int) {
+		c.Favourites = n
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := countGrouped("statuses", "boost_of_account_id", func(c *gtsmodel.InteractionCount, n int) {
+		c.Reblogs = n
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := countGrouped("statuses", "in_reply_to_account_id", func(c *gtsmodel.InteractionCount, n int) {
+		c.Replies = n
+	}); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 func (a *accountDB) GetAccountStatuses(ctx context.Context, accountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, mediaOnly bool, publicOnly bool) ([]*gtsmodel.Status, error) {
 	// Ensure reasonable
 	if limit < 0 {
@@ -625,7 +727,7 @@ func (a *accountDB) GetAccountPinnedStatuses(ctx context.Context, accountID stri
 		Column("status.id").
 		Where("? = ?", bun.Ident("status.account_id"), accountID).
 		Where("? IS NOT NULL", bun.Ident("status.pinned_at")).
-		Order("status.pinned_at DESC")
+		Order("status.pin_order ASC", "status.pinned_at DESC")
 
 	if err := q.Scan(ctx, &statusIDs); err != nil {
 		return nil, err
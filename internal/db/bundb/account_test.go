@@ -32,6 +32,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/uptrace/bun"
 )
 
@@ -375,6 +376,76 @@ func (suite *AccountTestSuite) TestCountAccountPinnedNothingPinned() {
 	suite.Equal(pinned, 0) // This account has nothing pinned.
 }
 
+func (suite *AccountTestSuite) TestCountAccountStatusesSince() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_2"]
+
+	var direct int
+	for _, status := range suite.testStatuses {
+		if status.AccountID == account.ID && status.Visibility == gtsmodel.VisibilityDirect {
+			direct++
+		}
+	}
+
+	count, err := suite.db.CountAccountStatusesSince(ctx, account.ID, gtsmodel.VisibilityDirect, time.Time{})
+	suite.NoError(err)
+	suite.Equal(direct, count)
+
+	// Test statuses were all created a while ago.
+	count, err = suite.db.CountAccountStatusesSince(ctx, account.ID, gtsmodel.VisibilityDirect, time.Now().Add(-time.Hour))
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
+func (suite *AccountTestSuite) TestGetStaleFollowedAccounts() {
+	var (
+		ctx    = context.Background()
+		now    = time.Now()
+		local  = suite.testAccounts["local_account_1"]
+		remote = suite.testAccounts["remote_account_1"]
+	)
+
+	staleIDs := func() []string {
+		accounts, err := suite.db.GetStaleFollowedAccounts(ctx, now, 20)
+		suite.NoError(err)
+
+		ids := make([]string, 0, len(accounts))
+		for _, account := range accounts {
+			suite.False(account.IsLocal())
+			ids = append(ids, account.ID)
+		}
+		return ids
+	}
+
+	// Not followed by any local account.
+	suite.NotContains(staleIDs(), remote.ID)
+
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01HG4R0W1ZQ8X6N2M5K7J3P9TB",
+		AccountID:       local.ID,
+		TargetAccountID: remote.ID,
+		ShowReblogs:     util.Ptr(true),
+		URI:             "http://localhost:8080/users/the_mighty_zork/follow/01HG4R0W1ZQ8X6N2M5K7J3P9TB",
+		Notify:          util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Now followed, and stale.
+	suite.Contains(staleIDs(), remote.ID)
+
+	// Mark as just fetched.
+	account := new(gtsmodel.Account)
+	*account = *remote
+	account.FetchedAt = now.Add(time.Minute)
+	if err := suite.db.UpdateAccount(ctx, account, "fetched_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// No longer stale.
+	suite.NotContains(staleIDs(), remote.ID)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
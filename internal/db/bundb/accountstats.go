@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type accountStatsDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (a *accountStatsDB) GetAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error) {
+	var stats gtsmodel.AccountStats
+
+	err := a.db.
+		NewSelect().
+		Model(&stats).
+		Where("? = ?", bun.Ident("account_stats.account_id"), accountID).
+		Scan(ctx)
+	if err == nil {
+		// Counts are only ever adjusted relative to
+		// their current value, so in the unlikely event
+		// of one drifting below zero, don't show that.
+		stats.FollowersCount = max(stats.FollowersCount, 0)
+		stats.FollowingCount = max(stats.FollowingCount, 0)
+		stats.StatusesCount = max(stats.StatusesCount, 0)
+		return &stats, nil
+	}
+
+	if !errors.Is(err, db.ErrNoEntries) {
+		return nil, err
+	}
+
+	// No stats stored for
+	// this account yet, so
+	// count them up now.
+	return a.RegenerateAccountStats(ctx, accountID)
+}
+
+func (a *accountStatsDB) GetAccountStatsIDs(ctx context.Context, maxID string, limit int) ([]string, error) {
+	var accountIDs []string
+
+	q := a.db.
+		NewSelect().
+		Table("account_stats").
+		Column("account_id").
+		Order("account_id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account_id"), maxID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+func (a *accountStatsDB) RegenerateAccountStats(ctx context.Context, accountID string) (*gtsmodel.AccountStats, error) {
+	stats := &gtsmodel.AccountStats{
+		AccountID:     accountID,
+		RegeneratedAt: time.Now(),
+	}
+
+	if err := a.db.RunInTx(ctx, func(tx Tx) error {
+		var err error
+
+		// Count accounts following this account.
+		stats.FollowersCount, err = tx.
+			NewSelect().
+			Table("follows").
+			Where("? = ?", bun.Ident("target_account_id"), accountID).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Count accounts this account follows.
+		stats.FollowingCount, err = tx.
+			NewSelect().
+			Table("follows").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Count statuses by this account.
+		stats.StatusesCount, err = tx.
+			NewSelect().
+			Table("statuses").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Count(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Store the new counts, replacing any existing.
+		_, err = tx.
+			NewInsert().
+			Model(stats).
+			On("CONFLICT (?) DO UPDATE", bun.Ident("account_id")).
+			Set("? = ?", bun.Ident("regenerated_at"), stats.RegeneratedAt).
+			Set("? = ?", bun.Ident("followers_count"), stats.FollowersCount).
+			Set("? = ?", bun.Ident("following_count"), stats.FollowingCount).
+			Set("? = ?", bun.Ident("statuses_count"), stats.StatusesCount).
+			Exec(ctx)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func (a *accountStatsDB) DeleteAccountStats(ctx context.Context, accountID string) error {
+	_, err := a.db.
+		NewDelete().
+		Table("account_stats").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
+
+// adjustAccountStats adds delta to the given counter column of the stored
+// stats of the given account, as part of transaction tx. If the account
+// has no stored stats yet this does nothing, as they'll be counted from
+// scratch (including whatever tx is changing) when they're first needed.
+func adjustAccountStats(ctx context.Context, tx Tx, accountID string, column string, delta int) error {
+	_, err := tx.
+		NewUpdate().
+		Table("account_stats").
+		Set("? = ? + ?", bun.Ident(column), bun.Ident(column), delta).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,152 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type AccountStatsTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// requireStats checks the stored stats of the given
+// account match the counts worked out from scratch.
+func (suite *AccountStatsTestSuite) requireStats(ctx context.Context, accountID string) *gtsmodel.AccountStats {
+	stats, err := suite.db.GetAccountStats(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	followers, err := suite.db.CountAccountFollowers(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	following, err := suite.db.CountAccountFollows(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	statuses, err := suite.db.CountAccountStatuses(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(accountID, stats.AccountID)
+	suite.Equal(followers, stats.FollowersCount)
+	suite.Equal(following, stats.FollowingCount)
+	suite.Equal(statuses, stats.StatusesCount)
+	return stats
+}
+
+func (suite *AccountStatsTestSuite) TestFollowStats() {
+	ctx := context.Background()
+	follow := suite.testFollows["local_account_1_admin_account"]
+
+	// Counted from scratch on first get.
+	origin := suite.requireStats(ctx, follow.AccountID)
+	target := suite.requireStats(ctx, follow.TargetAccountID)
+
+	if err := suite.db.DeleteFollowByID(ctx, follow.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(origin.FollowingCount-1, suite.requireStats(ctx, follow.AccountID).FollowingCount)
+	suite.Equal(target.FollowersCount-1, suite.requireStats(ctx, follow.TargetAccountID).FollowersCount)
+
+	// Deleting again doesn't uncount it twice.
+	if err := suite.db.DeleteFollowByID(ctx, follow.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(origin.FollowingCount-1, suite.requireStats(ctx, follow.AccountID).FollowingCount)
+
+	newFollow := &gtsmodel.Follow{}
+	*newFollow = *follow
+	newFollow.ID = id.NewULID()
+	newFollow.URI = follow.URI + "/" + newFollow.ID
+	if err := suite.db.PutFollow(ctx, newFollow); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(origin.FollowingCount, suite.requireStats(ctx, follow.AccountID).FollowingCount)
+	suite.Equal(target.FollowersCount, suite.requireStats(ctx, follow.TargetAccountID).FollowersCount)
+}
+
+func (suite *AccountStatsTestSuite) TestStatusStats() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+	before := suite.requireStats(ctx, testStatus.AccountID)
+
+	status := &gtsmodel.Status{}
+	*status = *testStatus
+	status.ID = id.NewULID()
+	status.URI = status.URI + "/" + status.ID
+	status.CreatedAt = time.Now()
+	if err := suite.db.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(before.StatusesCount+1, suite.requireStats(ctx, status.AccountID).StatusesCount)
+
+	if err := suite.db.DeleteStatusByID(ctx, status.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(before.StatusesCount, suite.requireStats(ctx, status.AccountID).StatusesCount)
+}
+
+func (suite *AccountStatsTestSuite) TestRegenerateAccountStats() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+	accountID := testStatus.AccountID
+	stats := suite.requireStats(ctx, accountID)
+
+	// Make the stored counts drift by inserting
+	// a status without going through PutStatus.
+	status := &gtsmodel.Status{}
+	*status = *testStatus
+	status.ID = id.NewULID()
+	status.URI = status.URI + "/" + status.ID
+	if err := suite.db.Put(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	drifted, err := suite.db.GetAccountStats(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(stats.StatusesCount, drifted.StatusesCount)
+
+	ids, err := suite.db.GetAccountStatsIDs(ctx, "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Contains(ids, accountID)
+
+	regenerated, err := suite.db.RegenerateAccountStats(ctx, accountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(stats.StatusesCount+1, regenerated.StatusesCount)
+	suite.requireStats(ctx, accountID)
+}
+
+func TestAccountStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountStatsTestSuite))
+}
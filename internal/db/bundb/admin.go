@@ -68,12 +68,7 @@ func (a *adminDB) IsEmailAvailable(ctx context.Context, email string) (bool, err
 	domain := strings.Split(m.Address, "@")[1] // domain will always be the second part after @
 
 	// check if the email domain is blocked
-	emailDomainBlockedQ := a.db.
-		NewSelect().
-		TableExpr("? AS ?", bun.Ident("email_domain_blocks"), bun.Ident("email_domain_block")).
-		Column("email_domain_block.id").
-		Where("? = ?", bun.Ident("email_domain_block.domain"), domain)
-	emailDomainBlocked, err := a.db.Exists(ctx, emailDomainBlockedQ)
+	emailDomainBlocked, err := a.state.DB.IsEmailDomainBlocked(ctx, domain)
 	if err != nil {
 		return false, err
 	}
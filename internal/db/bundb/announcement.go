@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type announcementDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (a *announcementDB) GetAnnouncementByID(ctx context.Context, id string) (*gtsmodel.Announcement, error) {
+	var announcement gtsmodel.Announcement
+
+	q := a.db.
+		NewSelect().
+		Model(&announcement).
+		Where("? = ?", bun.Ident("announcement.id"), id)
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &announcement, nil
+}
+
+func (a *announcementDB) GetAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	announcements := make([]*gtsmodel.Announcement, 0)
+
+	q := a.db.
+		NewSelect().
+		Model(&announcements).
+		Order("announcement.id DESC")
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) GetMaintenanceAnnouncements(ctx context.Context) ([]*gtsmodel.Announcement, error) {
+	announcements := make([]*gtsmodel.Announcement, 0)
+
+	q := a.db.
+		NewSelect().
+		Model(&announcements).
+		Where("? = ?", bun.Ident("announcement.published"), true).
+		Where("? IS NOT NULL", bun.Ident("announcement.maintenance_at")).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("announcement.ends_at")).
+				WhereOr("? > ?", bun.Ident("announcement.ends_at"), time.Now())
+		}).
+		Order("announcement.id DESC")
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return announcements, nil
+}
+
+func (a *announcementDB) PutAnnouncement(ctx context.Context, announcement *gtsmodel.Announcement) error {
+	_, err := a.db.
+		NewInsert().
+		Model(announcement).
+		Exec(ctx)
+	return err
+}
+
+func (a *announcementDB) DeleteAnnouncementByID(ctx context.Context, id string) error {
+	_, err := a.db.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("announcements"), bun.Ident("announcement")).
+		Where("? = ?", bun.Ident("announcement.id"), id).
+		Exec(ctx)
+	return err
+}
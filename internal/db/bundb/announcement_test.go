@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type AnnouncementTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *AnnouncementTestSuite) putAnnouncement(maintenanceAt time.Time, endsAt time.Time, published bool) *gtsmodel.Announcement {
+	a := &gtsmodel.Announcement{
+		ID:            id.NewULID(),
+		Content:       "<p>scheduled maintenance</p>",
+		Text:          "scheduled maintenance",
+		EndsAt:        endsAt,
+		AllDay:        util.Ptr(false),
+		Published:     util.Ptr(published),
+		MaintenanceAt: maintenanceAt,
+	}
+
+	if err := suite.state.DB.PutAnnouncement(context.Background(), a); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return a
+}
+
+func (suite *AnnouncementTestSuite) TestPutGetDeleteAnnouncement() {
+	ctx := context.Background()
+	a := suite.putAnnouncement(time.Time{}, time.Time{}, true)
+
+	dbAnnouncement, err := suite.state.DB.GetAnnouncementByID(ctx, a.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(a.Text, dbAnnouncement.Text)
+	suite.True(dbAnnouncement.MaintenanceAt.IsZero())
+
+	announcements, err := suite.state.DB.GetAnnouncements(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(announcements, 1)
+
+	if err := suite.state.DB.DeleteAnnouncementByID(ctx, a.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetAnnouncementByID(ctx, a.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *AnnouncementTestSuite) TestGetMaintenanceAnnouncements() {
+	var (
+		ctx = context.Background()
+		now = time.Now()
+	)
+
+	// Upcoming, open-ended maintenance window.
+	upcoming := suite.putAnnouncement(now.Add(time.Hour), time.Time{}, true)
+
+	// Maintenance window that has already ended.
+	suite.putAnnouncement(now.Add(-2*time.Hour), now.Add(-time.Hour), true)
+
+	// Unpublished maintenance window.
+	suite.putAnnouncement(now.Add(time.Hour), time.Time{}, false)
+
+	// Plain announcement with no maintenance.
+	suite.putAnnouncement(time.Time{}, time.Time{}, true)
+
+	announcements, err := suite.state.DB.GetMaintenanceAnnouncements(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if suite.Len(announcements, 1) {
+		suite.Equal(upcoming.ID, announcements[0].ID)
+	}
+}
+
+func TestAnnouncementTestSuite(t *testing.T) {
+	suite.Run(t, new(AnnouncementTestSuite))
+}
@@ -0,0 +1,131 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type backfillDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (b *backfillDB) GetBackfills(ctx context.Context) ([]*gtsmodel.Backfill, error) {
+	var backfills []*gtsmodel.Backfill
+
+	if err := b.db.
+		NewSelect().
+		Model(&backfills).
+		Order("backfill.name ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(backfills) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return backfills, nil
+}
+
+func (b *backfillDB) RunBackfills(ctx context.Context, until time.Time) (bool, error) {
+	for _, backfill := range migrations.Backfills() {
+		progress, err := b.getOrPutBackfill(ctx, backfill.Name)
+		if err != nil {
+			return false, err
+		}
+
+		for !progress.Finished() {
+			if time.Now().After(until) {
+				// Out of time, carry
+				// on from here next run.
+				return false, nil
+			}
+
+			cursor, err := backfill.Batch(ctx, b.db.bun, progress.Cursor)
+			if err != nil {
+				return false, gtserror.Newf("error running batch of backfill %s: %w", backfill.Name, err)
+			}
+
+			progress.Cursor = cursor
+			progress.UpdatedAt = time.Now()
+			if cursor == "" {
+				progress.FinishedAt = progress.UpdatedAt
+			}
+
+			// Store progress so a restart resumes from here.
+			if _, err := b.db.
+				NewUpdate().
+				Model(progress).
+				Where("? = ?", bun.Ident("backfill.name"), progress.Name).
+				Column("cursor", "updated_at", "finished_at").
+				Exec(ctx); err != nil {
+				return false, gtserror.Newf("error storing progress of backfill %s: %w", backfill.Name, err)
+			}
+
+			if progress.Finished() {
+				log.Infof(ctx, "finished backfill %s", backfill.Name)
+			}
+		}
+	}
+
+	return true, nil
+}
+
+// getOrPutBackfill gets the stored progress of the
+// backfill with the given name, storing new progress
+// from the start if the backfill hasn't been started.
+func (b *backfillDB) getOrPutBackfill(ctx context.Context, name string) (*gtsmodel.Backfill, error) {
+	var backfill gtsmodel.Backfill
+
+	err := b.db.
+		NewSelect().
+		Model(&backfill).
+		Where("? = ?", bun.Ident("backfill.name"), name).
+		Scan(ctx)
+	if err == nil {
+		return &backfill, nil
+	}
+
+	if !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting backfill %s: %w", name, err)
+	}
+
+	log.Infof(ctx, "starting backfill %s", name)
+	backfill = gtsmodel.Backfill{Name: name}
+
+	if _, err := b.db.
+		NewInsert().
+		Model(&backfill).
+		Exec(ctx); err != nil {
+		return nil, gtserror.Newf("error putting backfill %s: %w", name, err)
+	}
+
+	return &backfill, nil
+}
@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type BackfillTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *BackfillTestSuite) TestRunBackfills() {
+	ctx := context.Background()
+
+	// Store stats for one account
+	// before the backfill runs.
+	account := suite.testAccounts["local_account_1"]
+	stored := &gtsmodel.AccountStats{
+		AccountID:      account.ID,
+		RegeneratedAt:  time.Now(),
+		FollowersCount: 1234,
+	}
+	if err := suite.db.Put(ctx, stored); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Out of time already, so the
+	// backfill is started but no
+	// batches are run.
+	finished, err := suite.db.RunBackfills(ctx, time.Now().Add(-time.Minute))
+	suite.NoError(err)
+	suite.False(finished)
+
+	backfills, err := suite.db.GetBackfills(ctx)
+	suite.NoError(err)
+	suite.Len(backfills, 1)
+	suite.Equal("account-stats", backfills[0].Name)
+	suite.False(backfills[0].Finished())
+
+	// Now run it through to the end.
+	finished, err = suite.db.RunBackfills(ctx, time.Now().Add(time.Minute))
+	suite.NoError(err)
+	suite.True(finished)
+
+	backfills, err = suite.db.GetBackfills(ctx)
+	suite.NoError(err)
+	suite.True(backfills[0].Finished())
+	suite.Empty(backfills[0].Cursor)
+
+	// Every account should have stats now.
+	statsIDs, err := suite.db.GetAccountStatsIDs(ctx, "", 0)
+	suite.NoError(err)
+	for _, account := range suite.testAccounts {
+		suite.Contains(statsIDs, account.ID)
+	}
+
+	// Stats stored before the backfill
+	// shouldn't have been replaced.
+	stats, err := suite.db.GetAccountStats(ctx, account.ID)
+	suite.NoError(err)
+	suite.Equal(1234, stats.FollowersCount)
+
+	// Running again is a no-op.
+	finished, err = suite.db.RunBackfills(ctx, time.Now().Add(time.Minute))
+	suite.NoError(err)
+	suite.True(finished)
+}
+
+func TestBackfillTestSuite(t *testing.T) {
+	suite.Run(t, new(BackfillTestSuite))
+}
@@ -28,7 +28,8 @@ import (
 )
 
 type basicDB struct {
-	db *DB
+	db       *DB
+	notifier *cacheNotifier // may be nil
 }
 
 func (b *basicDB) Put(ctx context.Context, i interface{}) error {
@@ -164,5 +165,8 @@ func (b *basicDB) IsHealthy(ctx context.Context) error {
 
 func (b *basicDB) Close() error {
 	log.Info(nil, "closing db connection")
+	if b.notifier != nil {
+		b.notifier.Stop()
+	}
 	return b.db.Close()
 }
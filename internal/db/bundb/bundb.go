@@ -59,25 +59,45 @@ var registerTables = []interface{}{
 // DBService satisfies the DB interface
 type DBService struct {
 	db.Account
+	db.AccountStats
 	db.Admin
+	db.Announcement
 	db.Application
+	db.Backfill
 	db.Basic
 	db.Domain
+	db.DomainQuarantine
+	db.DomainStats
+	db.Draft
+	db.EmailDomainBlock
 	db.Emoji
+	db.FeaturedTag
+	db.FirstInteraction
+	db.Highlights
+	db.IngestRule
 	db.Instance
+	db.InstancePage
+	db.InstanceStatistic
+	db.Interop
+	db.IPBlock
+	db.Job
 	db.List
+	db.Locks
 	db.Marker
 	db.Media
 	db.Mention
 	db.Notification
+	db.QuarantinedStatus
 	db.Relationship
 	db.Report
 	db.Rule
+	db.SavedSearch
 	db.Search
 	db.Session
 	db.Status
 	db.StatusBookmark
 	db.StatusFave
+	db.StatusReaction
 	db.Tag
 	db.Timeline
 	db.User
@@ -115,35 +135,11 @@ func doMigration(ctx context.Context, db *bun.DB) error {
 // NewBunDBService returns a bunDB derived from the provided config, which implements the go-fed DB interface.
 // Under the hood, it uses https://github.com/uptrace/bun to create and maintain a database connection.
 func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
-	var db *DB
-	var err error
 	t := strings.ToLower(config.GetDbType())
 
-	switch t {
-	case "postgres":
-		db, err = pgConn(ctx)
-		if err != nil {
-			return nil, err
-		}
-	case "sqlite":
-		db, err = sqliteConn(ctx)
-		if err != nil {
-			return nil, err
-		}
-	default:
-		return nil, fmt.Errorf("database type %s not supported for bundb", t)
-	}
-
-	// Add database query hooks.
-	db.AddQueryHook(queryHook{})
-	if config.GetTracingEnabled() {
-		db.AddQueryHook(tracing.InstrumentBun())
-	}
-
-	// table registration is needed for many-to-many, see:
-	// https://bun.uptrace.dev/orm/many-to-many-relation/
-	for _, t := range registerTables {
-		db.RegisterModel(t)
+	db, err := connect(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	// perform any pending database migrations: this includes
@@ -153,38 +149,129 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		return nil, fmt.Errorf("db migration error: %s", err)
 	}
 
+	// If enabled, start propagating cache invalidations
+	// to (and from) other processes sharing this database.
+	var notifier *cacheNotifier
+	if config.GetDbPostgresCacheNotify() {
+		if t != "postgres" {
+			log.Warnf(ctx, "%s is only supported for postgres, ignoring", config.DbPostgresCacheNotifyFlag())
+		} else {
+			notifier, err = startCacheNotifier(state)
+			if err != nil {
+				return nil, fmt.Errorf("error starting cache notifier: %w", err)
+			}
+		}
+	}
+
+	// If enabled, take postgres advisory locks
+	// so that locks are shared with other processes.
+	advisory := config.GetDbPostgresAdvisoryLocks()
+	if advisory && t != "postgres" {
+		log.Warnf(ctx, "%s is only supported for postgres, ignoring", config.DbPostgresAdvisoryLocksFlag())
+		advisory = false
+	}
+
 	ps := &DBService{
 		Account: &accountDB{
 			db:    db,
 			state: state,
 		},
+		AccountStats: &accountStatsDB{
+			db:    db,
+			state: state,
+		},
 		Admin: &adminDB{
 			db:    db,
 			state: state,
 		},
+		Announcement: &announcementDB{
+			db:    db,
+			state: state,
+		},
 		Application: &applicationDB{
 			db:    db,
 			state: state,
 		},
+		Backfill: &backfillDB{
+			db:    db,
+			state: state,
+		},
 		Basic: &basicDB{
-			db: db,
+			db:       db,
+			notifier: notifier,
 		},
 		Domain: &domainDB{
 			db:    db,
 			state: state,
 		},
+		DomainQuarantine: &domainQuarantineDB{
+			db:    db,
+			state: state,
+		},
+		DomainStats: &domainStatsDB{
+			db:    db,
+			state: state,
+		},
+		Draft: &draftDB{
+			db:    db,
+			state: state,
+		},
+		EmailDomainBlock: &emailDomainBlockDB{
+			db:    db,
+			state: state,
+		},
 		Emoji: &emojiDB{
 			db:    db,
 			state: state,
 		},
+		FeaturedTag: &featuredTagDB{
+			db:    db,
+			state: state,
+		},
+		FirstInteraction: &firstInteractionDB{
+			db:    db,
+			state: state,
+		},
+		Highlights: &highlightsDB{
+			db:    db,
+			state: state,
+		},
+		IngestRule: &ingestRuleDB{
+			db:    db,
+			state: state,
+		},
 		Instance: &instanceDB{
 			db:    db,
 			state: state,
 		},
+		InstancePage: &instancePageDB{
+			db:    db,
+			state: state,
+		},
+		InstanceStatistic: &instanceStatisticDB{
+			db:    db,
+			state: state,
+		},
+		Interop: &interopDB{
+			db:    db,
+			state: state,
+		},
+		IPBlock: &ipBlockDB{
+			db:    db,
+			state: state,
+		},
+		Job: &jobDB{
+			db:    db,
+			state: state,
+		},
 		List: &listDB{
 			db:    db,
 			state: state,
 		},
+		Locks: &locksDB{
+			db:       db,
+			advisory: advisory,
+		},
 		Marker: &markerDB{
 			db:    db,
 			state: state,
@@ -201,6 +288,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		QuarantinedStatus: &quarantinedStatusDB{
+			db:    db,
+			state: state,
+		},
 		Relationship: &relationshipDB{
 			db:    db,
 			state: state,
@@ -213,6 +304,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		SavedSearch: &savedSearchDB{
+			db:    db,
+			state: state,
+		},
 		Search: &searchDB{
 			db:    db,
 			state: state,
@@ -232,6 +327,10 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			db:    db,
 			state: state,
 		},
+		StatusReaction: &statusReactionDB{
+			db:    db,
+			state: state,
+		},
 		Tag: &tagDB{
 			conn:  db,
 			state: state,
@@ -255,6 +354,42 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 	return ps, nil
 }
 
+// connect opens a connection to the configured
+// database, ready for use but not yet migrated.
+func connect(ctx context.Context) (*DB, error) {
+	var db *DB
+	var err error
+
+	switch t := strings.ToLower(config.GetDbType()); t {
+	case "postgres":
+		db, err = pgConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+	case "sqlite":
+		db, err = sqliteConn(ctx)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("database type %s not supported for bundb", t)
+	}
+
+	// Add database query hooks.
+	db.AddQueryHook(queryHook{})
+	if config.GetTracingEnabled() {
+		db.AddQueryHook(tracing.InstrumentBun())
+	}
+
+	// table registration is needed for many-to-many, see:
+	// https://bun.uptrace.dev/orm/many-to-many-relation/
+	for _, t := range registerTables {
+		db.RegisterModel(t)
+	}
+
+	return db, nil
+}
+
 func pgConn(ctx context.Context) (*DB, error) {
 	opts, err := deriveBunDBPGOptions() //nolint:contextcheck
 	if err != nil {
@@ -0,0 +1,366 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// cacheNotifyChannel is the postgres NOTIFY channel
+	// on which cache invalidations are sent / received.
+	cacheNotifyChannel = "gts_cache_invalidate"
+
+	// cacheNotifyMaxPayload is the max payload size in bytes we
+	// send per NOTIFY, kept under the postgres default of 8000.
+	cacheNotifyMaxPayload = 7000
+
+	// cacheNotifyMaxPending is the max number of invalidate events
+	// we'll queue while unable to send, after which the queue is
+	// dropped and other processes are told to clear all caches.
+	cacheNotifyMaxPending = 100_000
+
+	// cacheNotifyRetry is the max backoff between attempts to
+	// (re)connect to the database for sending / listening.
+	cacheNotifyRetry = time.Minute
+)
+
+// cacheNotifyPayload is the JSON payload
+// sent on the cache notify channel.
+type cacheNotifyPayload struct {
+	// Node is the unique ID of the sending process,
+	// used to ignore our own notifications.
+	Node string `json:"n"`
+
+	// Events contains the batched invalidate events.
+	Events []cache.InvalidateEvent `json:"e,omitempty"`
+
+	// Overflow indicates the sender dropped some events,
+	// and so receivers should clear all their caches.
+	Overflow bool `json:"o,omitempty"`
+}
+
+// cacheNotifier implements cache.Notifier by propagating invalidations
+// to other GoToSocial processes using postgres LISTEN / NOTIFY, and
+// handles invalidations received from other processes in turn.
+type cacheNotifier struct {
+	state  *state.State
+	node   string
+	cfg    *pgx.ConnConfig
+	cancel context.CancelFunc
+
+	pending  []cache.InvalidateEvent
+	overflow bool
+	clearing bool
+	signal   chan struct{}
+	mu       sync.Mutex
+}
+
+// startCacheNotifier starts listening for, and sending, cache invalidations
+// on a dedicated postgres connection, registering itself with state caches.
+func startCacheNotifier(state *state.State) (*cacheNotifier, error) {
+	cfg, err := deriveBunDBPGOptions() //nolint:contextcheck
+	if err != nil {
+		return nil, gtserror.Newf("could not create postgres options: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	n := &cacheNotifier{
+		state:  state,
+		node:   id.NewULID(),
+		cfg:    cfg,
+		cancel: cancel,
+		signal: make(chan struct{}, 1),
+	}
+
+	go n.listen(ctx)
+	go n.send(ctx)
+
+	state.Caches.SetNotifier(n)
+	log.Infof(ctx, "propagating cache invalidations on postgres channel %s", cacheNotifyChannel)
+	return n, nil
+}
+
+// Stop will stop the cache notifier, unregistering it from state caches.
+func (n *cacheNotifier) Stop() {
+	n.state.Caches.SetNotifier(nil)
+	n.cancel()
+}
+
+// Notify implements cache.Notifier{}, queueing
+// the event to be sent on the next send loop.
+func (n *cacheNotifier) Notify(event cache.InvalidateEvent) {
+	n.mu.Lock()
+	if n.clearing {
+		// Invalidations caused by clearing
+		// our own caches are not propagated.
+		n.mu.Unlock()
+		return
+	} else if len(n.pending) >= cacheNotifyMaxPending {
+		// Too many queued, drop them and just
+		// tell other processes to clear caches.
+		n.pending = nil
+		n.overflow = true
+	} else if !n.overflow {
+		n.pending = append(n.pending, event)
+	}
+	n.mu.Unlock()
+
+	select {
+	case n.signal <- struct{}{}:
+	default:
+	}
+}
+
+// send loops, sending pending invalidate
+// events until the context is cancelled.
+func (n *cacheNotifier) send(ctx context.Context) {
+	var conn *pgx.Conn
+	var backoff time.Duration
+
+	defer func() {
+		if conn != nil {
+			_ = conn.Close(context.Background())
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-n.signal:
+		}
+
+		for {
+			if conn == nil {
+				var err error
+				conn, err = pgx.ConnectConfig(ctx, n.cfg)
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+
+					backoff = util.NextBackoff(backoff, cacheNotifyRetry)
+					log.Errorf(ctx, "error connecting to send cache invalidations, retrying in %s: %v", backoff, err)
+
+					if !util.SleepCtx(ctx, backoff) {
+						return
+					}
+					continue
+				}
+			}
+
+			backoff = 0
+
+			// Take the currently pending events.
+			n.mu.Lock()
+			events, overflow := n.pending, n.overflow
+			n.pending, n.overflow = nil, false
+			n.mu.Unlock()
+
+			if len(events) == 0 && !overflow {
+				break
+			}
+
+			if err := n.sendEvents(ctx, conn, events, overflow); err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+
+				log.Errorf(ctx, "error sending cache invalidations: %v", err)
+
+				// Requeue what we failed to send as an
+				// overflow, as we don't know what got sent.
+				n.mu.Lock()
+				n.pending, n.overflow = nil, true
+				n.mu.Unlock()
+
+				_ = conn.Close(context.Background())
+				conn = nil
+			}
+		}
+	}
+}
+
+// sendEvents sends given events on the cache notify
+// channel, batched into as few payloads as possible.
+func (n *cacheNotifier) sendEvents(
+	ctx context.Context,
+	conn *pgx.Conn,
+	events []cache.InvalidateEvent,
+	overflow bool,
+) error {
+	if overflow {
+		// Other processes will clear
+		// everything, no need for events.
+		return n.sendPayload(ctx, conn, cacheNotifyPayload{
+			Node:     n.node,
+			Overflow: true,
+		})
+	}
+
+	payload := cacheNotifyPayload{Node: n.node}
+	size := 0
+
+	for _, event := range events {
+		// Rough size estimate of this encoded event.
+		esize := len(event.Cache) + 16
+		for _, key := range event.Keys {
+			esize += len(key) + 3
+		}
+
+		if size+esize > cacheNotifyMaxPayload && len(payload.Events) > 0 {
+			// Payload full, send this batch.
+			if err := n.sendPayload(ctx, conn, payload); err != nil {
+				return err
+			}
+
+			payload.Events = payload.Events[:0]
+			size = 0
+		}
+
+		payload.Events = append(payload.Events, event)
+		size += esize
+	}
+
+	if len(payload.Events) == 0 {
+		return nil
+	}
+
+	return n.sendPayload(ctx, conn, payload)
+}
+
+// sendPayload encodes and sends the payload on the cache notify channel.
+func (n *cacheNotifier) sendPayload(ctx context.Context, conn *pgx.Conn, payload cacheNotifyPayload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return gtserror.Newf("error encoding payload: %w", err)
+	}
+
+	if _, err := conn.Exec(ctx, "SELECT pg_notify($1, $2)", cacheNotifyChannel, string(b)); err != nil {
+		return gtserror.Newf("error notifying: %w", err)
+	}
+
+	return nil
+}
+
+// listen loops, handling invalidations received
+// from other processes until context is cancelled.
+func (n *cacheNotifier) listen(ctx context.Context) {
+	var backoff time.Duration
+
+	for {
+		err := n.listenConn(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		backoff = util.NextBackoff(backoff, cacheNotifyRetry)
+		log.Errorf(ctx, "error listening for cache invalidations, retrying in %s: %v", backoff, err)
+
+		// We may have missed invalidations while
+		// disconnected, so clear all our caches.
+		n.clearAll()
+
+		if !util.SleepCtx(ctx, backoff) {
+			return
+		}
+	}
+}
+
+// listenConn opens a new connection and listens for
+// invalidations on it until an error is encountered.
+func (n *cacheNotifier) listenConn(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, n.cfg)
+	if err != nil {
+		return gtserror.Newf("error connecting: %w", err)
+	}
+
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+cacheNotifyChannel); err != nil {
+		return gtserror.Newf("error listening: %w", err)
+	}
+
+	for {
+		notif, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return gtserror.Newf("error waiting for notification: %w", err)
+		}
+
+		var payload cacheNotifyPayload
+		if err := json.Unmarshal([]byte(notif.Payload), &payload); err != nil {
+			log.Warnf(ctx, "invalid cache invalidation payload: %v", err)
+			continue
+		}
+
+		n.handle(ctx, payload)
+	}
+}
+
+// handle handles a payload received on the cache notify channel.
+func (n *cacheNotifier) handle(ctx context.Context, payload cacheNotifyPayload) {
+	if payload.Node == n.node {
+		// Sent by us.
+		return
+	}
+
+	if payload.Overflow {
+		n.clearAll()
+		return
+	}
+
+	for _, event := range payload.Events {
+		if !n.state.Caches.HandleInvalidate(event) {
+			log.Warnf(ctx, "unknown cache in invalidation: %s", event.Cache)
+		}
+	}
+}
+
+// clearAll clears all local caches which may be invalidated
+// by other processes, used when we may have missed events.
+//
+// Invalidations during the clear are not propagated, so
+// other processes don't also clear all of their caches.
+// This does mean a local write racing with the clear
+// may go unannounced, but this should be very rare.
+func (n *cacheNotifier) clearAll() {
+	n.mu.Lock()
+	n.clearing = true
+	n.mu.Unlock()
+
+	defer func() {
+		n.mu.Lock()
+		n.clearing = false
+		n.mu.Unlock()
+	}()
+
+	n.state.Caches.Clear()
+}
@@ -0,0 +1,227 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type CacheNotifyTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// recordingNotifier is a cache.Notifier
+// which just records received events.
+type recordingNotifier struct {
+	events []cache.InvalidateEvent
+	mu     sync.Mutex
+}
+
+func (n *recordingNotifier) Notify(event cache.InvalidateEvent) {
+	n.mu.Lock()
+	n.events = append(n.events, event)
+	n.mu.Unlock()
+}
+
+func (n *recordingNotifier) has(cacheName string, key string) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for _, event := range n.events {
+		if event.Cache != cacheName {
+			continue
+		}
+		if key == "" || (len(event.Keys) > 0 && event.Keys[0] == key) {
+			return true
+		}
+	}
+	return false
+}
+
+func (suite *CacheNotifyTestSuite) setNotifier() *recordingNotifier {
+	notifier := &recordingNotifier{}
+	suite.state.Caches.SetNotifier(notifier)
+	suite.T().Cleanup(func() { suite.state.Caches.SetNotifier(nil) })
+	return notifier
+}
+
+func (suite *CacheNotifyTestSuite) TestNotifyOnUpdate() {
+	ctx := context.Background()
+	notifier := suite.setNotifier()
+
+	account, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	account.Note = "updated note"
+	if err := suite.db.UpdateAccount(ctx, account, "note"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(notifier.has("Account", account.ID))
+}
+
+func (suite *CacheNotifyTestSuite) TestNotifyDomainBlock() {
+	ctx := context.Background()
+	notifier := suite.setNotifier()
+
+	if err := suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01HD9PJAN7Q1SQB0ME6AJ5C7TN",
+		Domain:             "some.naughty.domain",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.True(notifier.has("DomainBlock", ""))
+}
+
+func (suite *CacheNotifyTestSuite) TestNotifyIPBlock() {
+	ctx := context.Background()
+	notifier := suite.setNotifier()
+
+	block := &gtsmodel.IPBlock{
+		ID:                 "01HF1B6ZB4V4ZP7G5C3N3S0S0M",
+		IP:                 "192.0.2.0/24",
+		Severity:           gtsmodel.IPBlockSignUpBlock,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	if err := suite.db.PutIPBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(notifier.has("IPBlock", ""))
+
+	notifier.events = nil
+	if err := suite.db.DeleteIPBlockByID(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(notifier.has("IPBlock", ""))
+}
+
+func (suite *CacheNotifyTestSuite) TestNotifyEmailDomainBlock() {
+	ctx := context.Background()
+	notifier := suite.setNotifier()
+
+	block := &gtsmodel.EmailDomainBlock{
+		ID:                 "01HF1B7QXR3M5AVB0E5YF3M3T1",
+		Domain:             "spam.example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	if err := suite.db.PutEmailDomainBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(notifier.has("EmailDomainBlock", ""))
+
+	notifier.events = nil
+	if err := suite.db.DeleteEmailDomainBlockByID(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(notifier.has("EmailDomainBlock", ""))
+}
+
+func (suite *CacheNotifyTestSuite) TestNotifyIngestRule() {
+	ctx := context.Background()
+	notifier := suite.setNotifier()
+
+	rule := &gtsmodel.IngestRule{
+		ID:                 "01HF1BDX3G2Y3W8Q2V1Z7M7K5R",
+		Pattern:            "cheap followers",
+		Action:             gtsmodel.IngestRuleActionDrop,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	if err := suite.db.PutIngestRule(ctx, rule); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(notifier.has("IngestRule", ""))
+
+	notifier.events = nil
+	if err := suite.db.DeleteIngestRuleByID(ctx, rule.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(notifier.has("IngestRule", ""))
+}
+
+func (suite *CacheNotifyTestSuite) TestHandleInvalidate() {
+	ctx := context.Background()
+	accountID := suite.testAccounts["local_account_1"].ID
+
+	// Ensure account is in the cache.
+	if _, err := suite.db.GetAccountByID(ctx, accountID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(suite.state.Caches.GTS.Account().Has("ID", accountID))
+
+	notifier := suite.setNotifier()
+
+	// Handle an invalidation as if received from another process.
+	ok := suite.state.Caches.HandleInvalidate(cache.InvalidateEvent{
+		Cache: "Account",
+		Keys:  []string{accountID},
+	})
+	suite.True(ok)
+
+	// Account should no longer be cached,
+	// and the invalidation not sent back out.
+	suite.False(suite.state.Caches.GTS.Account().Has("ID", accountID))
+	suite.False(notifier.has("Account", accountID))
+
+	// Unknown caches should be reported.
+	suite.False(suite.state.Caches.HandleInvalidate(cache.InvalidateEvent{
+		Cache: "NotACache",
+	}))
+}
+
+func (suite *CacheNotifyTestSuite) TestClearAll() {
+	ctx := context.Background()
+
+	// Ensure some items from caches added
+	// after the notifier are cached.
+	mute := &gtsmodel.UserMute{
+		ID:              "01HF0M5ZKYBS6S8PTWRCSB5A3X",
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		TargetAccountID: suite.testAccounts["remote_account_1"].ID,
+	}
+	if err := suite.db.PutMute(ctx, mute); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if _, err := suite.db.GetMuteByID(ctx, mute.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(suite.state.Caches.GTS.UserMute().Has("ID", mute.ID))
+
+	accountID := suite.testAccounts["local_account_1"].ID
+	if _, err := suite.db.GetAccountByID(ctx, accountID); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(suite.state.Caches.GTS.Account().Has("ID", accountID))
+
+	suite.state.Caches.Clear()
+
+	suite.False(suite.state.Caches.GTS.UserMute().Has("ID", mute.ID))
+	suite.False(suite.state.Caches.GTS.Account().Has("ID", accountID))
+}
+
+func TestCacheNotifyTestSuite(t *testing.T) {
+	suite.Run(t, new(CacheNotifyTestSuite))
+}
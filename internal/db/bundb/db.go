@@ -88,6 +88,10 @@ func (db *DB) PingContext(ctx context.Context) error { return db.bun.PingContext
 // Close is a direct call-through to bun.DB.Close().
 func (db *DB) Close() error { return db.bun.Close() }
 
+// Conn is a direct call-through to sql.DB.Conn(), returning a single
+// connection from the pool. This must be closed to return it to the pool.
+func (db *DB) Conn(ctx context.Context) (*sql.Conn, error) { return db.raw.db.Conn(ctx) }
+
 // ExecContext wraps bun.DB.ExecContext() with retry-busy timeout and our own error processing.
 func (db *DB) ExecContext(ctx context.Context, query string, args ...any) (result sql.Result, err error) {
 	bundb := db.bun // use underlying *bun.DB interface for their query formatting
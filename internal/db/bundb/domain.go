@@ -52,6 +52,7 @@ func (d *domainDB) CreateDomainAllow(ctx context.Context, allow *gtsmodel.Domain
 
 	// Clear the domain allow cache (for later reload)
 	d.state.Caches.GTS.DomainAllow().Clear()
+	d.state.Caches.NotifyDomainAllow()
 
 	return nil
 }
@@ -127,6 +128,7 @@ func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) error {
 
 	// Clear the domain allow cache (for later reload)
 	d.state.Caches.GTS.DomainAllow().Clear()
+	d.state.Caches.NotifyDomainAllow()
 
 	return nil
 }
@@ -148,6 +150,7 @@ func (d *domainDB) CreateDomainBlock(ctx context.Context, block *gtsmodel.Domain
 
 	// Clear the domain block cache (for later reload)
 	d.state.Caches.GTS.DomainBlock().Clear()
+	d.state.Caches.NotifyDomainBlock()
 
 	return nil
 }
@@ -223,6 +226,7 @@ func (d *domainDB) DeleteDomainBlock(ctx context.Context, domain string) error {
 
 	// Clear the domain block cache (for later reload)
 	d.state.Caches.GTS.DomainBlock().Clear()
+	d.state.Caches.NotifyDomainBlock()
 
 	return nil
 }
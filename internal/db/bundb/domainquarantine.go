@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type domainQuarantineDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (d *domainQuarantineDB) GetDomainQuarantineByID(ctx context.Context, id string) (*gtsmodel.DomainQuarantine, error) {
+	return d.getDomainQuarantine(ctx, "id", id)
+}
+
+func (d *domainQuarantineDB) GetDomainQuarantine(ctx context.Context, domain string) (*gtsmodel.DomainQuarantine, error) {
+	// Normalize the domain as punycode.
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getDomainQuarantine(ctx, "domain", domain)
+}
+
+func (d *domainQuarantineDB) getDomainQuarantine(ctx context.Context, column string, value string) (*gtsmodel.DomainQuarantine, error) {
+	var quarantine gtsmodel.DomainQuarantine
+
+	if err := d.db.
+		NewSelect().
+		Model(&quarantine).
+		Where("? = ?", bun.Ident("domain_quarantine."+column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &quarantine, nil
+}
+
+func (d *domainQuarantineDB) GetPendingDomainQuarantines(ctx context.Context, page *paging.Page) ([]*gtsmodel.DomainQuarantine, error) {
+	var ids []string
+
+	// Like the status quarantine queue, this is
+	// only ever looked at by moderators, so there's
+	// no need to cache it; select IDs as needed.
+	if err := d.db.NewSelect().
+		TableExpr("?", bun.Ident("domain_quarantines")).
+		ColumnExpr("?", bun.Ident("id")).
+		Where("? IS NULL", bun.Ident("approved_at")).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	// Selected IDs are in descending
+	// order, which may not be the order
+	// that was requested by the page.
+	if page.GetOrder().Ascending() {
+		ids = paging.Reverse(ids)
+	}
+
+	// Page the resulting IDs.
+	ids = page.Page(ids)
+
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	quarantines := make([]*gtsmodel.DomainQuarantine, 0, len(ids))
+	for _, id := range ids {
+		quarantine, err := d.GetDomainQuarantineByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		quarantines = append(quarantines, quarantine)
+	}
+
+	return quarantines, nil
+}
+
+func (d *domainQuarantineDB) DomainHasStatuses(ctx context.Context, domain string, excludeStatusID string) (bool, error) {
+	// Don't use the cached instance status
+	// count here, as it may be out of date.
+	return d.db.Exists(ctx, d.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("status.account_id")).
+		Where("? = ?", bun.Ident("account.domain"), domain).
+		Where("? != ?", bun.Ident("status.id"), excludeStatusID),
+	)
+}
+
+func (d *domainQuarantineDB) PutDomainQuarantine(ctx context.Context, quarantine *gtsmodel.DomainQuarantine) error {
+	var err error
+
+	// Normalize the domain as punycode.
+	quarantine.Domain, err = util.Punify(quarantine.Domain)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.
+		NewInsert().
+		Model(quarantine).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainQuarantineDB) UpdateDomainQuarantine(ctx context.Context, quarantine *gtsmodel.DomainQuarantine, columns ...string) error {
+	quarantine.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.db.
+		NewUpdate().
+		Model(quarantine).
+		Column(columns...).
+		Where("? = ?", bun.Ident("domain_quarantine.id"), quarantine.ID).
+		Exec(ctx)
+	return err
+}
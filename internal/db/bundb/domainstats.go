@@ -0,0 +1,289 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type domainStatsDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (d *domainStatsDB) GetDomainStats(ctx context.Context, domain string) (*gtsmodel.DomainStats, error) {
+	// Normalize the domain as punycode.
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.getDomainStats(ctx, "domain", domain)
+}
+
+func (d *domainStatsDB) getDomainStats(ctx context.Context, column string, value string) (*gtsmodel.DomainStats, error) {
+	var stats gtsmodel.DomainStats
+
+	if err := d.db.
+		NewSelect().
+		Model(&stats).
+		Where("? = ?", bun.Ident("domain_stats."+column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (d *domainStatsDB) GetAllDomainStats(ctx context.Context, page *paging.Page, failing bool) ([]*gtsmodel.DomainStats, error) {
+	var ids []string
+
+	// Stats are only ever looked at
+	// by admins, so there's no need to
+	// cache them; select IDs as needed.
+	q := d.db.NewSelect().
+		TableExpr("?", bun.Ident("domain_stats")).
+		ColumnExpr("?", bun.Ident("id")).
+		OrderExpr("? DESC", bun.Ident("id"))
+
+	if failing {
+		// Only domains whose most
+		// recent contact failed.
+		q = q.
+			Where("? IS NOT NULL", bun.Ident("last_failure_at")).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where("? IS NULL", bun.Ident("last_success_at")).
+					WhereOr("? > ?", bun.Ident("last_failure_at"), bun.Ident("last_success_at"))
+			})
+	}
+
+	if err := q.Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	// Selected IDs are in descending
+	// order, which may not be the order
+	// that was requested by the page.
+	if page.GetOrder().Ascending() {
+		ids = paging.Reverse(ids)
+	}
+
+	// Page the resulting IDs.
+	ids = page.Page(ids)
+
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	statss := make([]*gtsmodel.DomainStats, 0, len(ids))
+	for _, id := range ids {
+		stats, err := d.getDomainStats(ctx, "id", id)
+		if err != nil {
+			return nil, err
+		}
+		statss = append(statss, stats)
+	}
+
+	return statss, nil
+}
+
+func (d *domainStatsDB) RecordDomainContact(ctx context.Context, domain string, contact gtsmodel.DomainContact, failure string) error {
+	// Normalize the domain as punycode.
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return err
+	}
+
+	// Try to increment existing stats first, as
+	// that's by far the most common case. This is
+	// done in SQL so concurrent contacts all count.
+	updated, err := d.incrementDomainStats(ctx, domain, contact, failure)
+	if err != nil || updated {
+		return err
+	}
+
+	// No stats for this
+	// domain yet, create them.
+	now := time.Now()
+	stats := &gtsmodel.DomainStats{
+		ID:     id.NewULID(),
+		Domain: domain,
+	}
+
+	if failure == "" {
+		stats.LastSuccessAt = now
+		switch contact {
+		case gtsmodel.DomainContactDelivery:
+			stats.DeliverySuccesses = 1
+		case gtsmodel.DomainContactDereference:
+			stats.DereferenceSuccesses = 1
+		}
+	} else {
+		stats.LastFailureAt = now
+		stats.LastFailure = failure
+		switch contact {
+		case gtsmodel.DomainContactDelivery:
+			stats.DeliveryFailures = 1
+			stats.ConsecutiveDeliveryFailures = 1
+			stats.DeliveryFailingSince = now
+		case gtsmodel.DomainContactDereference:
+			stats.DereferenceFailures = 1
+		}
+	}
+
+	_, err = d.db.NewInsert().Model(stats).Exec(ctx)
+	if !errors.Is(err, db.ErrAlreadyExists) {
+		return err
+	}
+
+	// Lost a race with a concurrent
+	// insert, increment theirs instead.
+	_, err = d.incrementDomainStats(ctx, domain, contact, failure)
+	return err
+}
+
+// incrementDomainStats increments the relevant counter of existing stats for
+// the given domain, returning false if there are no stats for the domain yet.
+func (d *domainStatsDB) incrementDomainStats(ctx context.Context, domain string, contact gtsmodel.DomainContact, failure string) (bool, error) {
+	now := time.Now()
+
+	q := d.db.
+		NewUpdate().
+		Table("domain_stats").
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Where("? = ?", bun.Ident("domain"), domain)
+
+	if failure == "" {
+		counter := string(contact) + "_successes"
+		q = q.
+			Set("? = ? + 1", bun.Ident(counter), bun.Ident(counter)).
+			Set("? = ?", bun.Ident("last_success_at"), now)
+
+		if contact == gtsmodel.DomainContactDelivery {
+			// Successful delivery ends
+			// any run of failed ones.
+			q = q.
+				Set("? = 0", bun.Ident("consecutive_delivery_failures")).
+				Set("? = NULL", bun.Ident("delivery_failing_since"))
+		}
+	} else {
+		counter := string(contact) + "_failures"
+		q = q.
+			Set("? = ? + 1", bun.Ident(counter), bun.Ident(counter)).
+			Set("? = ?", bun.Ident("last_failure_at"), now).
+			Set("? = ?", bun.Ident("last_failure"), failure)
+
+		if contact == gtsmodel.DomainContactDelivery {
+			// Extend the run of failed deliveries,
+			// noting when it started if this is new.
+			q = q.
+				Set("? = ? + 1", bun.Ident("consecutive_delivery_failures"), bun.Ident("consecutive_delivery_failures")).
+				Set("? = COALESCE(?, ?)", bun.Ident("delivery_failing_since"), bun.Ident("delivery_failing_since"), now)
+		}
+	}
+
+	res, err := q.Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (d *domainStatsDB) SuspendDomainDeliveries(ctx context.Context, domain string) error {
+	// Normalize the domain as punycode.
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, err = d.db.
+		NewUpdate().
+		Table("domain_stats").
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Set("? = ?", bun.Ident("deliveries_suspended_at"), now).
+		Where("? = ?", bun.Ident("domain"), domain).
+		Where("? IS NULL", bun.Ident("deliveries_suspended_at")).
+		Exec(ctx)
+	return err
+}
+
+func (d *domainStatsDB) ResumeDomainDeliveries(ctx context.Context, domain string) (bool, error) {
+	// Normalize the domain as punycode.
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// This is called on every incoming
+	// request from the domain, so only
+	// update stats if actually suspended.
+	res, err := d.db.
+		NewUpdate().
+		Table("domain_stats").
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Set("? = NULL", bun.Ident("deliveries_suspended_at")).
+		Set("? = 0", bun.Ident("consecutive_delivery_failures")).
+		Set("? = NULL", bun.Ident("delivery_failing_since")).
+		Where("? = ?", bun.Ident("domain"), domain).
+		Where("? IS NOT NULL", bun.Ident("deliveries_suspended_at")).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (d *domainStatsDB) AreDomainDeliveriesSuspended(ctx context.Context, domain string) (bool, error) {
+	// Normalize the domain as punycode.
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	q := d.db.
+		NewSelect().
+		TableExpr("?", bun.Ident("domain_stats")).
+		Where("? = ?", bun.Ident("domain"), domain).
+		Where("? IS NOT NULL", bun.Ident("deliveries_suspended_at"))
+
+	return d.db.Exists(ctx, q)
+}
@@ -0,0 +1,175 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DomainStatsTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *DomainStatsTestSuite) TestRecordDomainContact() {
+	ctx := context.Background()
+
+	// No stats to begin with.
+	_, err := suite.state.DB.GetDomainStats(ctx, "ëxample.org")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	for _, contact := range []struct {
+		contact gtsmodel.DomainContact
+		failure string
+	}{
+		{gtsmodel.DomainContactDelivery, ""},
+		{gtsmodel.DomainContactDelivery, ""},
+		{gtsmodel.DomainContactDelivery, "connection refused"},
+		{gtsmodel.DomainContactDereference, ""},
+	} {
+		if err := suite.state.DB.RecordDomainContact(ctx, "ëxample.org", contact.contact, contact.failure); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	stats, err := suite.state.DB.GetDomainStats(ctx, "xn--xample-ova.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("xn--xample-ova.org", stats.Domain)
+	suite.EqualValues(2, stats.DeliverySuccesses)
+	suite.EqualValues(1, stats.DeliveryFailures)
+	suite.EqualValues(1, stats.DereferenceSuccesses)
+	suite.EqualValues(0, stats.DereferenceFailures)
+	suite.Equal("connection refused", stats.LastFailure)
+	suite.False(stats.LastSuccessAt.IsZero())
+	suite.False(stats.LastFailureAt.IsZero())
+
+	// Most recent contact succeeded,
+	// so the domain isn't failing.
+	failing, err := suite.state.DB.GetAllDomainStats(ctx, &paging.Page{}, true)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(failing)
+}
+
+func (suite *DomainStatsTestSuite) TestGetFailingDomainStats() {
+	ctx := context.Background()
+
+	for domain, failure := range map[string]string{
+		"alive.example.org": "",
+		"dead.example.org":  "no such host",
+	} {
+		if err := suite.state.DB.RecordDomainContact(ctx, domain, gtsmodel.DomainContactDelivery, failure); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	all, err := suite.state.DB.GetAllDomainStats(ctx, &paging.Page{}, false)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(all, 2)
+
+	failing, err := suite.state.DB.GetAllDomainStats(ctx, &paging.Page{}, true)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(failing, 1) {
+		suite.Equal("dead.example.org", failing[0].Domain)
+		suite.Equal("no such host", failing[0].LastFailure)
+	}
+}
+
+func (suite *DomainStatsTestSuite) TestSuspendResumeDomainDeliveries() {
+	ctx := context.Background()
+	domain := "dead.example.org"
+
+	for i := 0; i < 3; i++ {
+		if err := suite.state.DB.RecordDomainContact(ctx, domain, gtsmodel.DomainContactDelivery, "no such host"); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	stats, err := suite.state.DB.GetDomainStats(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.EqualValues(3, stats.ConsecutiveDeliveryFailures)
+	suite.False(stats.DeliveryFailingSince.IsZero())
+	suite.False(stats.DeliveriesSuspended())
+
+	if err := suite.state.DB.SuspendDomainDeliveries(ctx, domain); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suspended, err := suite.state.DB.AreDomainDeliveriesSuspended(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(suspended)
+
+	// Resuming should reset the run of failures.
+	resumed, err := suite.state.DB.ResumeDomainDeliveries(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(resumed)
+
+	stats, err = suite.state.DB.GetDomainStats(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.EqualValues(0, stats.ConsecutiveDeliveryFailures)
+	suite.True(stats.DeliveryFailingSince.IsZero())
+	suite.False(stats.DeliveriesSuspended())
+
+	// Nothing to resume now.
+	resumed, err = suite.state.DB.ResumeDomainDeliveries(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(resumed)
+}
+
+func (suite *DomainStatsTestSuite) TestDeliverySuccessEndsFailures() {
+	ctx := context.Background()
+	domain := "flaky.example.org"
+
+	for _, failure := range []string{"connection refused", "connection refused", ""} {
+		if err := suite.state.DB.RecordDomainContact(ctx, domain, gtsmodel.DomainContactDelivery, failure); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	stats, err := suite.state.DB.GetDomainStats(ctx, domain)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.EqualValues(2, stats.DeliveryFailures)
+	suite.EqualValues(0, stats.ConsecutiveDeliveryFailures)
+	suite.True(stats.DeliveryFailingSince.IsZero())
+}
+
+func TestDomainStatsTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainStatsTestSuite))
+}
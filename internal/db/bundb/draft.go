@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type draftDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (d *draftDB) GetDraftByID(ctx context.Context, id string) (*gtsmodel.Draft, error) {
+	var draft gtsmodel.Draft
+
+	if err := d.db.
+		NewSelect().
+		Model(&draft).
+		Where("? = ?", bun.Ident("draft.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &draft, nil
+	}
+
+	// Further populate the draft fields where applicable.
+	if err := d.PopulateDraft(ctx, &draft); err != nil {
+		return nil, err
+	}
+
+	return &draft, nil
+}
+
+func (d *draftDB) GetDraftsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Draft, error) {
+	// Fetch draft IDs for account.
+	var draftIDs []string
+	if err := d.db.
+		NewSelect().
+		Table("drafts").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Order("id DESC").
+		Scan(ctx, &draftIDs); err != nil {
+		return nil, err
+	}
+
+	if len(draftIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each draft using its ID to ensure population.
+	drafts := make([]*gtsmodel.Draft, 0, len(draftIDs))
+	for _, id := range draftIDs {
+		draft, err := d.GetDraftByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		drafts = append(drafts, draft)
+	}
+
+	return drafts, nil
+}
+
+func (d *draftDB) CountDraftsForAccountID(ctx context.Context, accountID string) (int, error) {
+	return d.db.
+		NewSelect().
+		Table("drafts").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Count(ctx)
+}
+
+func (d *draftDB) PopulateDraft(ctx context.Context, draft *gtsmodel.Draft) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if draft.Account == nil {
+		// Draft account is not set, fetch from the database.
+		draft.Account, err = d.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			draft.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating draft account: %w", err)
+		}
+	}
+
+	if !draft.AttachmentsPopulated() {
+		// Draft attachments are out-of-date with IDs, repopulate.
+		draft.Attachments, err = d.state.DB.GetAttachmentsByIDs(
+			ctx, // these are already barebones
+			draft.AttachmentIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating draft attachments: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (d *draftDB) PutDraft(ctx context.Context, draft *gtsmodel.Draft) error {
+	_, err := d.db.
+		NewInsert().
+		Model(draft).
+		Exec(ctx)
+	return err
+}
+
+func (d *draftDB) UpdateDraft(ctx context.Context, draft *gtsmodel.Draft, columns ...string) error {
+	draft.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.db.
+		NewUpdate().
+		Model(draft).
+		Where("? = ?", bun.Ident("draft.id"), draft.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (d *draftDB) DeleteDraftByID(ctx context.Context, id string) error {
+	_, err := d.db.
+		NewDelete().
+		Table("drafts").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
+
+func (d *draftDB) DeleteDraftsForAccountID(ctx context.Context, accountID string) error {
+	_, err := d.db.
+		NewDelete().
+		Table("drafts").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
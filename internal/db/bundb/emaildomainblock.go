@@ -0,0 +1,174 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type emailDomainBlockDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (e *emailDomainBlockDB) GetEmailDomainBlockByID(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, error) {
+	var block gtsmodel.EmailDomainBlock
+
+	if err := e.db.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("email_domain_block.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &block, nil
+	}
+
+	// Further populate the email domain block fields where applicable.
+	if err := e.PopulateEmailDomainBlock(ctx, &block); err != nil {
+		return nil, err
+	}
+
+	return &block, nil
+}
+
+func (e *emailDomainBlockDB) GetEmailDomainBlocks(ctx context.Context) ([]*gtsmodel.EmailDomainBlock, error) {
+	// Fetch all email domain block IDs.
+	var blockIDs []string
+	if err := e.db.
+		NewSelect().
+		Table("email_domain_blocks").
+		Column("id").
+		Order("id ASC").
+		Scan(ctx, &blockIDs); err != nil {
+		return nil, err
+	}
+
+	if len(blockIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each block using its ID to ensure population.
+	blocks := make([]*gtsmodel.EmailDomainBlock, 0, len(blockIDs))
+	for _, id := range blockIDs {
+		block, err := e.GetEmailDomainBlockByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+func (e *emailDomainBlockDB) IsEmailDomainBlocked(ctx context.Context, domain string) (bool, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return false, err
+	}
+
+	if domain == "" {
+		return false, nil
+	}
+
+	// Check the cache for a domain block (hydrating the cache with callback if necessary)
+	return e.state.Caches.GTS.EmailDomainBlock().Matches(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all blocked email domains from DB
+		q := e.db.NewSelect().
+			Table("email_domain_blocks").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, err
+		}
+
+		return domains, nil
+	})
+}
+
+func (e *emailDomainBlockDB) PopulateEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) error {
+	if block.CreatedByAccount != nil {
+		// Nothing to do.
+		return nil
+	}
+
+	// Email domain block creator is not set, fetch from the database.
+	account, err := e.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		block.CreatedByAccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error populating email domain block created by account: %w", err)
+	}
+	block.CreatedByAccount = account
+
+	return nil
+}
+
+func (e *emailDomainBlockDB) PutEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) error {
+	// Normalize the domain as punycode
+	var err error
+	block.Domain, err = util.Punify(block.Domain)
+	if err != nil {
+		return err
+	}
+
+	if _, err := e.db.
+		NewInsert().
+		Model(block).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the email domain block cache (for later reload).
+	e.state.Caches.GTS.EmailDomainBlock().Clear()
+	e.state.Caches.NotifyEmailDomainBlock()
+
+	return nil
+}
+
+func (e *emailDomainBlockDB) DeleteEmailDomainBlockByID(ctx context.Context, id string) error {
+	_, err := e.db.
+		NewDelete().
+		Table("email_domain_blocks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Clear the email domain block cache (for later reload).
+	e.state.Caches.GTS.EmailDomainBlock().Clear()
+	e.state.Caches.NotifyEmailDomainBlock()
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type EmailDomainBlockTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *EmailDomainBlockTestSuite) TestPutGetDeleteEmailDomainBlock() {
+	ctx := context.Background()
+
+	// No blocks to begin with.
+	_, err := suite.state.DB.GetEmailDomainBlocks(ctx)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	block := &gtsmodel.EmailDomainBlock{
+		ID:                 id.NewULID(),
+		Domain:             "ëxample.org",
+		Comment:            "throwaway addresses",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	if err := suite.state.DB.PutEmailDomainBlock(ctx, block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	dbBlock, err := suite.state.DB.GetEmailDomainBlockByID(ctx, block.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("xn--xample-ova.org", dbBlock.Domain)
+	suite.Equal("throwaway addresses", dbBlock.Comment)
+	suite.NotNil(dbBlock.CreatedByAccount)
+
+	for domain, blocked := range map[string]bool{
+		"ëxample.org":              true,
+		"xn--xample-ova.org":       true,
+		"mail.xn--xample-ova.org":  true,
+		"example.org":              false,
+		"notxn--xample-ova.org":    false,
+		"xn--xample-ova.org.other": false,
+	} {
+		isBlocked, err := suite.state.DB.IsEmailDomainBlocked(ctx, domain)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal(blocked, isBlocked, domain)
+	}
+
+	if err := suite.state.DB.DeleteEmailDomainBlockByID(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetEmailDomainBlockByID(ctx, block.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	// Deleting the block should stop it matching.
+	isBlocked, err := suite.state.DB.IsEmailDomainBlocked(ctx, "ëxample.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(isBlocked)
+}
+
+func TestEmailDomainBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(EmailDomainBlockTestSuite))
+}
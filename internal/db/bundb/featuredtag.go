@@ -0,0 +1,202 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type featuredTagDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (f *featuredTagDB) GetFeaturedTagByID(ctx context.Context, id string) (*gtsmodel.FeaturedTag, error) {
+	var featuredTag gtsmodel.FeaturedTag
+
+	if err := f.db.
+		NewSelect().
+		Model(&featuredTag).
+		Where("? = ?", bun.Ident("featured_tag.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &featuredTag, nil
+	}
+
+	// Further populate the featured tag fields where applicable.
+	if err := f.PopulateFeaturedTag(ctx, &featuredTag); err != nil {
+		return nil, err
+	}
+
+	return &featuredTag, nil
+}
+
+func (f *featuredTagDB) GetFeaturedTagsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, error) {
+	// Fetch featured tag IDs for account.
+	var featuredTagIDs []string
+	if err := f.db.
+		NewSelect().
+		Table("featured_tags").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Order("id ASC").
+		Scan(ctx, &featuredTagIDs); err != nil {
+		return nil, err
+	}
+
+	if len(featuredTagIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each featured tag using its ID to ensure population.
+	featuredTags := make([]*gtsmodel.FeaturedTag, 0, len(featuredTagIDs))
+	for _, id := range featuredTagIDs {
+		featuredTag, err := f.GetFeaturedTagByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		featuredTags = append(featuredTags, featuredTag)
+	}
+
+	return featuredTags, nil
+}
+
+func (f *featuredTagDB) CountFeaturedTagsForAccountID(ctx context.Context, accountID string) (int, error) {
+	return f.db.
+		NewSelect().
+		Table("featured_tags").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Count(ctx)
+}
+
+func (f *featuredTagDB) GetFeaturedTagUsage(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) (int, time.Time, error) {
+	// Statuses of the featuring account that use the tag,
+	// and which are visible to anyone looking at its profile.
+	q := func() *bun.SelectQuery {
+		return f.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+			Join(
+				"INNER JOIN ? AS ? ON ? = ?",
+				bun.Ident("statuses"), bun.Ident("status"),
+				bun.Ident("status.id"), bun.Ident("status_to_tag.status_id"),
+			).
+			Where("? = ?", bun.Ident("status_to_tag.tag_id"), featuredTag.TagID).
+			Where("? = ?", bun.Ident("status.account_id"), featuredTag.AccountID).
+			Where("? IN (?)", bun.Ident("status.visibility"), bun.In([]gtsmodel.Visibility{
+				gtsmodel.VisibilityPublic,
+				gtsmodel.VisibilityUnlocked,
+			}))
+	}
+
+	count, err := q().Count(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+
+	if count == 0 {
+		// Never used.
+		return 0, time.Time{}, nil
+	}
+
+	// Status IDs are ULIDs, so the
+	// highest is the most recent.
+	var lastStatusAt time.Time
+	if err := q().
+		Column("status.created_at").
+		Order("status.id DESC").
+		Limit(1).
+		Scan(ctx, &lastStatusAt); err != nil {
+		return 0, time.Time{}, err
+	}
+
+	return count, lastStatusAt, nil
+}
+
+func (f *featuredTagDB) PopulateFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if featuredTag.Account == nil {
+		// Featured tag account is not set, fetch from the database.
+		featuredTag.Account, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			featuredTag.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating featured tag account: %w", err)
+		}
+	}
+
+	if featuredTag.Tag == nil {
+		// Featured tag tag is not set, fetch from the database.
+		featuredTag.Tag, err = f.state.DB.GetTag(ctx, featuredTag.TagID)
+		if err != nil {
+			errs.Appendf("error populating featured tag tag: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (f *featuredTagDB) PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error {
+	_, err := f.db.
+		NewInsert().
+		Model(featuredTag).
+		Exec(ctx)
+	return err
+}
+
+func (f *featuredTagDB) DeleteFeaturedTagByID(ctx context.Context, id string) error {
+	_, err := f.db.
+		NewDelete().
+		Table("featured_tags").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
+
+func (f *featuredTagDB) DeleteFeaturedTagsForAccountID(ctx context.Context, accountID string) error {
+	_, err := f.db.
+		NewDelete().
+		Table("featured_tags").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type firstInteractionDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (f *firstInteractionDB) GetFirstInteractionByID(ctx context.Context, id string) (*gtsmodel.FirstInteraction, error) {
+	return f.getFirstInteraction(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("? = ?", bun.Ident("first_interaction.id"), id)
+	})
+}
+
+func (f *firstInteractionDB) GetFirstInteraction(ctx context.Context, accountID string, originAccountID string) (*gtsmodel.FirstInteraction, error) {
+	return f.getFirstInteraction(ctx, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? = ?", bun.Ident("first_interaction.account_id"), accountID).
+			Where("? = ?", bun.Ident("first_interaction.origin_account_id"), originAccountID)
+	})
+}
+
+func (f *firstInteractionDB) getFirstInteraction(ctx context.Context, where func(*bun.SelectQuery) *bun.SelectQuery) (*gtsmodel.FirstInteraction, error) {
+	var firstInteraction gtsmodel.FirstInteraction
+
+	q := f.db.
+		NewSelect().
+		Model(&firstInteraction)
+
+	if err := where(q).Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &firstInteraction, nil
+	}
+
+	// Further populate the first interaction fields where applicable.
+	if err := f.PopulateFirstInteraction(ctx, &firstInteraction); err != nil {
+		return nil, err
+	}
+
+	return &firstInteraction, nil
+}
+
+func (f *firstInteractionDB) GetPendingFirstInteractions(ctx context.Context, accountID string) ([]*gtsmodel.FirstInteraction, error) {
+	// Fetch IDs of all pending first interactions.
+	var ids []string
+	if err := f.db.
+		NewSelect().
+		Table("first_interactions").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Where("? IS NULL", bun.Ident("approved_at")).
+		Order("id DESC").
+		Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each first interaction using its ID to ensure population.
+	firstInteractions := make([]*gtsmodel.FirstInteraction, 0, len(ids))
+	for _, id := range ids {
+		firstInteraction, err := f.GetFirstInteractionByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		firstInteractions = append(firstInteractions, firstInteraction)
+	}
+
+	return firstInteractions, nil
+}
+
+func (f *firstInteractionDB) PopulateFirstInteraction(ctx context.Context, firstInteraction *gtsmodel.FirstInteraction) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(3)
+	)
+
+	if firstInteraction.Account == nil {
+		// Account is not set, fetch from the database.
+		firstInteraction.Account, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			firstInteraction.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating first interaction account: %w", err)
+		}
+	}
+
+	if firstInteraction.OriginAccount == nil {
+		// Origin account is not set, fetch from the database.
+		firstInteraction.OriginAccount, err = f.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			firstInteraction.OriginAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating first interaction origin account: %w", err)
+		}
+	}
+
+	if len(firstInteraction.Statuses) != len(firstInteraction.StatusIDs) {
+		// Statuses are not set, fetch from the database. Held
+		// statuses may since have been deleted by their author,
+		// in which case they're just left out.
+		firstInteraction.Statuses, err = f.state.DB.GetStatusesByIDs(
+			ctx,
+			firstInteraction.StatusIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating first interaction statuses: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (f *firstInteractionDB) PutFirstInteraction(ctx context.Context, firstInteraction *gtsmodel.FirstInteraction) error {
+	_, err := f.db.
+		NewInsert().
+		Model(firstInteraction).
+		Exec(ctx)
+	return err
+}
+
+func (f *firstInteractionDB) UpdateFirstInteraction(ctx context.Context, firstInteraction *gtsmodel.FirstInteraction, columns ...string) error {
+	firstInteraction.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column,
+		// ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := f.db.
+		NewUpdate().
+		Model(firstInteraction).
+		Column(columns...).
+		Where("? = ?", bun.Ident("first_interaction.id"), firstInteraction.ID).
+		Exec(ctx)
+	return err
+}
+
+func (f *firstInteractionDB) DeleteFirstInteractionByID(ctx context.Context, id string) error {
+	_, err := f.db.
+		NewDelete().
+		Table("first_interactions").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
+
+func (f *firstInteractionDB) DeleteAccountFirstInteractions(ctx context.Context, accountID string) error {
+	_, err := f.db.
+		NewDelete().
+		Table("first_interactions").
+		WhereOr("? = ?", bun.Ident("account_id"), accountID).
+		WhereOr("? = ?", bun.Ident("origin_account_id"), accountID).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
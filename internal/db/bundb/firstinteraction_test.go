@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type FirstInteractionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FirstInteractionTestSuite) TestPutGetUpdateDeleteFirstInteraction() {
+	var (
+		ctx           = context.Background()
+		account       = suite.testAccounts["local_account_1"]
+		originAccount = suite.testAccounts["remote_account_1"]
+		status        = suite.testStatuses["remote_account_1_status_1"]
+	)
+
+	// Nothing pending to begin with.
+	_, err := suite.state.DB.GetPendingFirstInteractions(ctx, account.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	firstInteraction := &gtsmodel.FirstInteraction{
+		ID:              id.NewULID(),
+		AccountID:       account.ID,
+		OriginAccountID: originAccount.ID,
+		StatusIDs:       []string{status.ID},
+	}
+	if err := suite.state.DB.PutFirstInteraction(ctx, firstInteraction); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only one per pair of accounts.
+	err = suite.state.DB.PutFirstInteraction(ctx, &gtsmodel.FirstInteraction{
+		ID:              id.NewULID(),
+		AccountID:       account.ID,
+		OriginAccountID: originAccount.ID,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	dbFirstInteraction, err := suite.state.DB.GetFirstInteraction(ctx, account.ID, originAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(firstInteraction.ID, dbFirstInteraction.ID)
+	suite.Equal(originAccount.ID, dbFirstInteraction.OriginAccount.ID)
+	if suite.Len(dbFirstInteraction.Statuses, 1) {
+		suite.Equal(status.ID, dbFirstInteraction.Statuses[0].ID)
+	}
+
+	firstInteractions, err := suite.state.DB.GetPendingFirstInteractions(ctx, account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(firstInteractions, 1)
+
+	// Approved first interactions aren't pending.
+	dbFirstInteraction.ApprovedAt = time.Now()
+	if err := suite.state.DB.UpdateFirstInteraction(ctx, dbFirstInteraction, "approved_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetPendingFirstInteractions(ctx, account.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	if err := suite.state.DB.DeleteAccountFirstInteractions(ctx, account.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetFirstInteractionByID(ctx, firstInteraction.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestFirstInteractionTestSuite(t *testing.T) {
+	suite.Run(t, new(FirstInteractionTestSuite))
+}
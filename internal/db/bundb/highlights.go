@@ -0,0 +1,124 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type highlightsDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (h *highlightsDB) GetHighlightsByAccountID(ctx context.Context, accountID string) (*gtsmodel.Highlights, error) {
+	var highlights gtsmodel.Highlights
+
+	if err := h.db.
+		NewSelect().
+		Model(&highlights).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &highlights, nil
+	}
+
+	// Further populate the highlights fields where applicable.
+	if err := h.PopulateHighlights(ctx, &highlights); err != nil {
+		return nil, err
+	}
+
+	return &highlights, nil
+}
+
+func (h *highlightsDB) PopulateHighlights(ctx context.Context, highlights *gtsmodel.Highlights) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if highlights.Account == nil {
+		// Account is not set, fetch from the database.
+		highlights.Account, err = h.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			highlights.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating highlights account: %w", err)
+		}
+	}
+
+	if len(highlights.Statuses) != len(highlights.StatusIDs) {
+		// Statuses are not set, fetch from the database.
+		// Highlighted statuses may since have been deleted
+		// by their author, in which case they're left out.
+		highlights.Statuses, err = h.state.DB.GetStatusesByIDs(
+			ctx,
+			highlights.StatusIDs,
+		)
+		if err != nil {
+			errs.Appendf("error populating highlights statuses: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (h *highlightsDB) PutHighlights(ctx context.Context, highlights *gtsmodel.Highlights) error {
+	return h.db.RunInTx(ctx, func(tx Tx) error {
+		// Only the most recent highlights
+		// are kept, so clear any old ones.
+		if _, err := tx.
+			NewDelete().
+			Table("highlights").
+			Where("? = ?", bun.Ident("account_id"), highlights.AccountID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewInsert().
+			Model(highlights).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (h *highlightsDB) DeleteHighlightsByAccountID(ctx context.Context, accountID string) error {
+	_, err := h.db.
+		NewDelete().
+		Table("highlights").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
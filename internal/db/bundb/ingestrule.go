@@ -0,0 +1,166 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type ingestRuleDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (i *ingestRuleDB) GetIngestRuleByID(ctx context.Context, id string) (*gtsmodel.IngestRule, error) {
+	var rule gtsmodel.IngestRule
+
+	if err := i.db.
+		NewSelect().
+		Model(&rule).
+		Where("? = ?", bun.Ident("ingest_rule.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &rule, nil
+	}
+
+	// Further populate the ingest rule fields where applicable.
+	if err := i.PopulateIngestRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (i *ingestRuleDB) GetIngestRules(ctx context.Context) ([]*gtsmodel.IngestRule, error) {
+	// Fetch all ingest rule IDs.
+	var ruleIDs []string
+	if err := i.db.
+		NewSelect().
+		Table("ingest_rules").
+		Column("id").
+		Order("id ASC").
+		Scan(ctx, &ruleIDs); err != nil {
+		return nil, err
+	}
+
+	if len(ruleIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each rule using its ID to ensure population.
+	rules := make([]*gtsmodel.IngestRule, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		rule, err := i.GetIngestRuleByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (i *ingestRuleDB) MatchIngestRules(ctx context.Context, text string) ([]*gtsmodel.IngestRule, error) {
+	return i.state.Caches.GTS.IngestRule().Matches(text, func() ([]*gtsmodel.IngestRule, error) {
+		rules, err := i.GetIngestRules(gtscontext.SetBarebones(ctx))
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+		return rules, nil
+	})
+}
+
+func (i *ingestRuleDB) PopulateIngestRule(ctx context.Context, rule *gtsmodel.IngestRule) error {
+	if rule.CreatedByAccount != nil {
+		// Nothing to do.
+		return nil
+	}
+
+	// Ingest rule creator is not set, fetch from the database.
+	account, err := i.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		rule.CreatedByAccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error populating ingest rule created by account: %w", err)
+	}
+	rule.CreatedByAccount = account
+
+	return nil
+}
+
+func (i *ingestRuleDB) PutIngestRule(ctx context.Context, rule *gtsmodel.IngestRule) error {
+	if _, err := i.db.
+		NewInsert().
+		Model(rule).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the ingest rule cache (for later reload).
+	i.state.Caches.GTS.IngestRule().Clear()
+	i.state.Caches.NotifyIngestRule()
+
+	return nil
+}
+
+func (i *ingestRuleDB) IncrementIngestRuleHits(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	_, err := i.db.
+		NewUpdate().
+		Table("ingest_rules").
+		Set("? = ? + 1", bun.Ident("hits"), bun.Ident("hits")).
+		Set("? = ?", bun.Ident("last_hit_at"), time.Now()).
+		Where("? IN (?)", bun.Ident("id"), bun.In(ids)).
+		Exec(ctx)
+	return err
+}
+
+func (i *ingestRuleDB) DeleteIngestRuleByID(ctx context.Context, id string) error {
+	_, err := i.db.
+		NewDelete().
+		Table("ingest_rules").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Clear the ingest rule cache (for later reload).
+	i.state.Caches.GTS.IngestRule().Clear()
+	i.state.Caches.NotifyIngestRule()
+
+	return nil
+}
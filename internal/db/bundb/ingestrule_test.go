@@ -0,0 +1,146 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type IngestRuleTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *IngestRuleTestSuite) putIngestRule(pattern string, regex bool, action gtsmodel.IngestRuleAction) *gtsmodel.IngestRule {
+	rule := &gtsmodel.IngestRule{
+		ID:                 id.NewULID(),
+		Pattern:            pattern,
+		Regex:              util.Ptr(regex),
+		Action:             action,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.state.DB.PutIngestRule(context.Background(), rule); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return rule
+}
+
+func (suite *IngestRuleTestSuite) TestPutGetDeleteIngestRule() {
+	ctx := context.Background()
+
+	// No rules to begin with.
+	_, err := suite.state.DB.GetIngestRules(ctx)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	rule := suite.putIngestRule("cheap followers", false, gtsmodel.IngestRuleActionDrop)
+
+	dbRule, err := suite.state.DB.GetIngestRuleByID(ctx, rule.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("cheap followers", dbRule.Pattern)
+	suite.False(*dbRule.Regex)
+	suite.Equal(gtsmodel.IngestRuleActionDrop, dbRule.Action)
+	suite.NotNil(dbRule.CreatedByAccount)
+	suite.Zero(dbRule.Hits)
+	suite.Zero(dbRule.LastHitAt)
+
+	rules, err := suite.state.DB.GetIngestRules(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(rules, 1)
+
+	if err := suite.state.DB.DeleteIngestRuleByID(ctx, rule.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetIngestRuleByID(ctx, rule.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *IngestRuleTestSuite) TestMatchIngestRules() {
+	ctx := context.Background()
+
+	keyword := suite.putIngestRule("cheap followers", false, gtsmodel.IngestRuleActionDrop)
+	regex := suite.putIngestRule(`https?://spam\.example\.(com|net)`, true, gtsmodel.IngestRuleActionUnlist)
+
+	for _, test := range []struct {
+		text    string
+		matches []string
+	}{
+		{"Buy CHEAP FOLLOWERS now!", []string{keyword.ID}},
+		{"see http://spam.example.net", []string{regex.ID}},
+		{"cheap followers at https://spam.example.com", []string{keyword.ID, regex.ID}},
+		{"see https://spam.example.org, followers are cheap", nil},
+	} {
+		rules, err := suite.state.DB.MatchIngestRules(ctx, test.text)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		ids := make([]string, 0, len(rules))
+		for _, rule := range rules {
+			ids = append(ids, rule.ID)
+		}
+		suite.ElementsMatch(test.matches, ids, test.text)
+	}
+
+	// Deleting a rule should stop it matching.
+	if err := suite.state.DB.DeleteIngestRuleByID(ctx, keyword.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	rules, err := suite.state.DB.MatchIngestRules(ctx, "Buy CHEAP FOLLOWERS now!")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(rules)
+}
+
+func (suite *IngestRuleTestSuite) TestIncrementIngestRuleHits() {
+	ctx := context.Background()
+
+	rule := suite.putIngestRule("cheap followers", false, gtsmodel.IngestRuleActionDrop)
+
+	for i := 0; i < 2; i++ {
+		if err := suite.state.DB.IncrementIngestRuleHits(ctx, []string{rule.ID}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	dbRule, err := suite.state.DB.GetIngestRuleByID(ctx, rule.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(2, dbRule.Hits)
+	suite.NotZero(dbRule.LastHitAt)
+}
+
+func TestIngestRuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IngestRuleTestSuite))
+}
@@ -38,72 +38,115 @@ type instanceDB struct {
 	state *state.State
 }
 
-func (i *instanceDB) CountInstanceUsers(ctx context.Context, domain string) (int, error) {
-	q := i.db.
-		NewSelect().
-		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
-		Column("account.id").
-		Where("? != ?", bun.Ident("account.username"), domain).
-		Where("? IS NULL", bun.Ident("account.suspended_at"))
-
-	if domain == config.GetHost() || domain == config.GetAccountDomain() {
-		// If the domain is *this* domain, just
-		// count where the domain field is null.
-		q = q.Where("? IS NULL", bun.Ident("account.domain"))
-	} else {
-		q = q.Where("? = ?", bun.Ident("account.domain"), domain)
+// cachedCount returns the cached count under the given
+// key for the given domain if set, else it calls count
+// and stores the result in the instance counts cache.
+func (i *instanceDB) cachedCount(key string, domain string, count func() (int, error)) (int, error) {
+	key = key + "." + domain
+
+	if n, ok := i.state.Caches.GTS.InstanceCounts().Get(key); ok {
+		return n, nil
 	}
 
-	count, err := q.Count(ctx)
+	n, err := count()
 	if err != nil {
 		return 0, err
 	}
-	return count, nil
+
+	i.state.Caches.GTS.InstanceCounts().Set(key, n)
+	return n, nil
+}
+
+func isThisDomain(domain string) bool {
+	return domain == config.GetHost() || domain == config.GetAccountDomain()
+}
+
+func (i *instanceDB) CountInstanceUsers(ctx context.Context, domain string) (int, error) {
+	return i.cachedCount("users", domain, func() (int, error) {
+		q := i.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+			Column("account.id").
+			Where("? != ?", bun.Ident("account.username"), domain).
+			Where("? IS NULL", bun.Ident("account.suspended_at"))
+
+		if isThisDomain(domain) {
+			// If the domain is *this* domain, just
+			// count where the domain field is null.
+			q = q.Where("? IS NULL", bun.Ident("account.domain"))
+		} else {
+			q = q.Where("? = ?", bun.Ident("account.domain"), domain)
+		}
+
+		return q.Count(ctx)
+	})
+}
+
+func (i *instanceDB) CountInstanceActiveUsers(ctx context.Context, domain string) (int, error) {
+	return i.cachedCount("active_users", domain, func() (int, error) {
+		// Count distinct authors of statuses
+		// created within the past 4 weeks.
+		since := time.Now().Add(-4 * 7 * 24 * time.Hour)
+
+		q := i.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			ColumnExpr("DISTINCT ?", bun.Ident("status.account_id")).
+			Where("? > ?", bun.Ident("status.created_at"), since)
+
+		if isThisDomain(domain) {
+			// if the domain is *this* domain, just count where local is true
+			q = q.Where("? = ?", bun.Ident("status.local"), true)
+		} else {
+			// join on the domain of the account
+			q = q.
+				Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("status.account_id")).
+				Where("? = ?", bun.Ident("account.domain"), domain)
+		}
+
+		return i.db.
+			NewSelect().
+			TableExpr("(?) AS ?", q, bun.Ident("active")).
+			Count(ctx)
+	})
 }
 
 func (i *instanceDB) CountInstanceStatuses(ctx context.Context, domain string) (int, error) {
-	q := i.db.
-		NewSelect().
-		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status"))
-
-	if domain == config.GetHost() || domain == config.GetAccountDomain() {
-		// if the domain is *this* domain, just count where local is true
-		q = q.Where("? = ?", bun.Ident("status.local"), true)
-	} else {
-		// join on the domain of the account
-		q = q.
-			Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("status.account_id")).
-			Where("? = ?", bun.Ident("account.domain"), domain)
-	}
+	return i.cachedCount("statuses", domain, func() (int, error) {
+		q := i.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status"))
+
+		if isThisDomain(domain) {
+			// if the domain is *this* domain, just count where local is true
+			q = q.Where("? = ?", bun.Ident("status.local"), true)
+		} else {
+			// join on the domain of the account
+			q = q.
+				Join("JOIN ? AS ? ON ? = ?", bun.Ident("accounts"), bun.Ident("account"), bun.Ident("account.id"), bun.Ident("status.account_id")).
+				Where("? = ?", bun.Ident("account.domain"), domain)
+		}
 
-	count, err := q.Count(ctx)
-	if err != nil {
-		return 0, err
-	}
-	return count, nil
+		return q.Count(ctx)
+	})
 }
 
 func (i *instanceDB) CountInstanceDomains(ctx context.Context, domain string) (int, error) {
-	q := i.db.
-		NewSelect().
-		TableExpr("? AS ?", bun.Ident("instances"), bun.Ident("instance"))
-
-	if domain == config.GetHost() {
-		// if the domain is *this* domain, just count other instances it knows about
-		// exclude domains that are blocked
-		q = q.
-			Where("? != ?", bun.Ident("instance.domain"), domain).
-			Where("? IS NULL", bun.Ident("instance.suspended_at"))
-	} else {
+	if domain != config.GetHost() {
 		// TODO: implement federated domain counting properly for remote domains
 		return 0, nil
 	}
 
-	count, err := q.Count(ctx)
-	if err != nil {
-		return 0, err
-	}
-	return count, nil
+	return i.cachedCount("domains", domain, func() (int, error) {
+		// if the domain is *this* domain, just count other instances it knows about
+		// exclude domains that are blocked
+		return i.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("instances"), bun.Ident("instance")).
+			Where("? != ?", bun.Ident("instance.domain"), domain).
+			Where("? IS NULL", bun.Ident("instance.suspended_at")).
+			Count(ctx)
+	})
 }
 
 func (i *instanceDB) GetInstance(ctx context.Context, domain string) (*gtsmodel.Instance, error) {
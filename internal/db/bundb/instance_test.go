@@ -20,11 +20,13 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -44,6 +46,39 @@ func (suite *InstanceTestSuite) TestCountInstanceUsersRemote() {
 	suite.Equal(1, count)
 }
 
+func (suite *InstanceTestSuite) TestCountInstanceActiveUsers() {
+	ctx := context.Background()
+
+	// All testrig statuses are old.
+	count, err := suite.db.CountInstanceActiveUsers(ctx, config.GetHost())
+	suite.NoError(err)
+	suite.Equal(0, count)
+
+	// Post two new statuses from one account.
+	for i := 0; i < 2; i++ {
+		status := &gtsmodel.Status{}
+		*status = *suite.testStatuses["local_account_1_status_1"]
+		status.ID = id.NewULID()
+		status.URI = status.URI + "/" + status.ID
+		status.CreatedAt = time.Now()
+		if err := suite.db.PutStatus(ctx, status); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Count is cached, so this should still be 0.
+	count, err = suite.db.CountInstanceActiveUsers(ctx, config.GetHost())
+	suite.NoError(err)
+	suite.Equal(0, count)
+
+	// Clear the cache; the
+	// account is now active.
+	suite.state.Caches.GTS.InstanceCounts().Clear()
+	count, err = suite.db.CountInstanceActiveUsers(ctx, config.GetHost())
+	suite.NoError(err)
+	suite.Equal(1, count)
+}
+
 func (suite *InstanceTestSuite) TestCountInstanceStatuses() {
 	count, err := suite.db.CountInstanceStatuses(context.Background(), config.GetHost())
 	suite.NoError(err)
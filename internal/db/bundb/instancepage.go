@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type instancePageDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (i *instancePageDB) GetInstancePageByID(ctx context.Context, id string) (*gtsmodel.InstancePage, error) {
+	return i.getInstancePage(ctx, "id", id)
+}
+
+func (i *instancePageDB) GetInstancePageBySlug(ctx context.Context, slug string) (*gtsmodel.InstancePage, error) {
+	return i.getInstancePage(ctx, "slug", slug)
+}
+
+func (i *instancePageDB) getInstancePage(ctx context.Context, column string, value string) (*gtsmodel.InstancePage, error) {
+	var page gtsmodel.InstancePage
+
+	if err := i.db.
+		NewSelect().
+		Model(&page).
+		Where("? = ?", bun.Ident("instance_page."+column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+func (i *instancePageDB) GetInstancePages(ctx context.Context) ([]*gtsmodel.InstancePage, error) {
+	var pages []*gtsmodel.InstancePage
+
+	if err := i.db.
+		NewSelect().
+		Model(&pages).
+		Order("instance_page.nav_order ASC", "instance_page.title ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(pages) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return pages, nil
+}
+
+func (i *instancePageDB) PutInstancePage(ctx context.Context, page *gtsmodel.InstancePage) error {
+	_, err := i.db.
+		NewInsert().
+		Model(page).
+		Exec(ctx)
+	return err
+}
+
+func (i *instancePageDB) UpdateInstancePage(ctx context.Context, page *gtsmodel.InstancePage, columns ...string) error {
+	page.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.db.
+		NewUpdate().
+		Model(page).
+		Where("? = ?", bun.Ident("instance_page.id"), page.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (i *instancePageDB) DeleteInstancePageByID(ctx context.Context, id string) error {
+	_, err := i.db.
+		NewDelete().
+		Table("instance_pages").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type instanceStatisticDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (i *instanceStatisticDB) GetInstanceStatisticByDay(ctx context.Context, day time.Time) (*gtsmodel.InstanceStatistic, error) {
+	var stat gtsmodel.InstanceStatistic
+
+	if err := i.db.
+		NewSelect().
+		Model(&stat).
+		Where("? = ?", bun.Ident("instance_statistic.day"), day).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &stat, nil
+}
+
+func (i *instanceStatisticDB) GetInstanceStatistics(ctx context.Context, since time.Time) ([]*gtsmodel.InstanceStatistic, error) {
+	var stats []*gtsmodel.InstanceStatistic
+
+	if err := i.db.
+		NewSelect().
+		Model(&stats).
+		Where("? >= ?", bun.Ident("instance_statistic.day"), since).
+		Order("instance_statistic.day ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(stats) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return stats, nil
+}
+
+func (i *instanceStatisticDB) CalculateInstanceStatistic(ctx context.Context, day time.Time) (*gtsmodel.InstanceStatistic, error) {
+	var (
+		end  = day.Add(24 * time.Hour)
+		stat = &gtsmodel.InstanceStatistic{Day: day}
+		err  error
+	)
+
+	// Local accounts that posted during the day.
+	posted := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.account_id").
+		Where("? = ?", bun.Ident("status.local"), true).
+		Where("? >= ?", bun.Ident("status.created_at"), day).
+		Where("? < ?", bun.Ident("status.created_at"), end)
+
+	// Local accounts that signed in during the day.
+	signedIn := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Column("user.account_id").
+		Where("? >= ?", bun.Ident("user.current_sign_in_at"), day).
+		Where("? < ?", bun.Ident("user.current_sign_in_at"), end)
+
+	if stat.ActiveUsers, err = i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Where("? IN (?)", bun.Ident("account.id"), posted).
+		WhereOr("? IN (?)", bun.Ident("account.id"), signedIn).
+		Count(ctx); err != nil {
+		return nil, err
+	}
+
+	if stat.NewRegistrations, err = i.countCreatedDuring("users", "user", day, end).
+		Count(ctx); err != nil {
+		return nil, err
+	}
+
+	if stat.StatusesCreated, err = i.countCreatedDuring("statuses", "status", day, end).
+		Where("? = ?", bun.Ident("status.local"), true).
+		Count(ctx); err != nil {
+		return nil, err
+	}
+
+	if stat.StatusesReceived, err = i.countCreatedDuring("statuses", "status", day, end).
+		Where("? = ?", bun.Ident("status.local"), false).
+		Count(ctx); err != nil {
+		return nil, err
+	}
+
+	if stat.AccountsDiscovered, err = i.countCreatedDuring("accounts", "account", day, end).
+		Where("? IS NOT NULL", bun.Ident("account.domain")).
+		Count(ctx); err != nil {
+		return nil, err
+	}
+
+	if stat.KnownInstances, err = i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("instances"), bun.Ident("instance")).
+		Where("? != ?", bun.Ident("instance.domain"), config.GetHost()).
+		Where("? IS NULL", bun.Ident("instance.suspended_at")).
+		Count(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+		ColumnExpr("COALESCE(SUM(? + ?), 0)",
+			bun.Ident("media_attachment.file_file_size"),
+			bun.Ident("media_attachment.thumbnail_file_size"),
+		).
+		Where("? = ?", bun.Ident("media_attachment.cached"), true).
+		Scan(ctx, &stat.MediaStorageBytes); err != nil {
+		return nil, err
+	}
+
+	return stat, nil
+}
+
+// countCreatedDuring returns a select query on the given
+// table, filtered to rows created between start and end.
+func (i *instanceStatisticDB) countCreatedDuring(
+	table string,
+	alias string,
+	start time.Time,
+	end time.Time,
+) *bun.SelectQuery {
+	return i.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident(table), bun.Ident(alias)).
+		Where("? >= ?", bun.Ident(alias+".created_at"), start).
+		Where("? < ?", bun.Ident(alias+".created_at"), end)
+}
+
+func (i *instanceStatisticDB) PutInstanceStatistic(ctx context.Context, stat *gtsmodel.InstanceStatistic) error {
+	_, err := i.db.
+		NewInsert().
+		Model(stat).
+		Exec(ctx)
+	return err
+}
+
+func (i *instanceStatisticDB) UpdateInstanceStatistic(ctx context.Context, stat *gtsmodel.InstanceStatistic, columns ...string) error {
+	stat.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.db.
+		NewUpdate().
+		Model(stat).
+		Where("? = ?", bun.Ident("instance_statistic.id"), stat.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
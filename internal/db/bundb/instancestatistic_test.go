@@ -0,0 +1,132 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type InstanceStatisticTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *InstanceStatisticTestSuite) TestCalculateInstanceStatistic() {
+	var (
+		ctx   = context.Background()
+		today = time.Now().UTC().Truncate(24 * time.Hour)
+	)
+
+	// All testrig statuses are old.
+	stat, err := suite.db.CalculateInstanceStatistic(ctx, today)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(0, stat.ActiveUsers)
+	suite.Equal(0, stat.StatusesCreated)
+
+	// Post two new statuses from one account.
+	for i := 0; i < 2; i++ {
+		status := &gtsmodel.Status{}
+		*status = *suite.testStatuses["local_account_1_status_1"]
+		status.ID = id.NewULID()
+		status.URI = status.URI + "/" + status.ID
+		status.CreatedAt = time.Now()
+		if err := suite.db.PutStatus(ctx, status); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Sign in with another account.
+	user := suite.testUsers["local_account_2"]
+	user.CurrentSignInAt = time.Now()
+	if err := suite.db.UpdateUser(ctx, user, "current_sign_in_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	stat, err = suite.db.CalculateInstanceStatistic(ctx, today)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(today, stat.Day)
+	suite.Equal(2, stat.ActiveUsers)
+	suite.Equal(2, stat.StatusesCreated)
+	suite.NotZero(stat.MediaStorageBytes)
+
+	knownInstances, err := suite.db.CountInstanceDomains(ctx, config.GetHost())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(knownInstances, stat.KnownInstances)
+
+	// Nothing happened yesterday.
+	stat, err = suite.db.CalculateInstanceStatistic(ctx, today.Add(-24*time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(0, stat.ActiveUsers)
+	suite.Equal(0, stat.StatusesCreated)
+}
+
+func (suite *InstanceStatisticTestSuite) TestPutGetInstanceStatistics() {
+	var (
+		ctx   = context.Background()
+		today = time.Now().UTC().Truncate(24 * time.Hour)
+	)
+
+	_, err := suite.db.GetInstanceStatistics(ctx, today.Add(-7*24*time.Hour))
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	for i := 1; i <= 3; i++ {
+		if err := suite.db.PutInstanceStatistic(ctx, &gtsmodel.InstanceStatistic{
+			ID:          id.NewULID(),
+			Day:         today.Add(-time.Duration(i) * 24 * time.Hour),
+			ActiveUsers: i,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// Only want the last two days.
+	stats, err := suite.db.GetInstanceStatistics(ctx, today.Add(-2*24*time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if suite.Len(stats, 2) {
+		// Oldest first.
+		suite.Equal(2, stats[0].ActiveUsers)
+		suite.Equal(1, stats[1].ActiveUsers)
+	}
+
+	stat, err := suite.db.GetInstanceStatisticByDay(ctx, today.Add(-3*24*time.Hour))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(3, stat.ActiveUsers)
+}
+
+func TestInstanceStatisticTestSuite(t *testing.T) {
+	suite.Run(t, new(InstanceStatisticTestSuite))
+}
@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/uptrace/bun"
+)
+
+type interopDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (i *interopDB) GetDomainInterop(ctx context.Context, domain string) (*gtsmodel.DomainInterop, error) {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.state.Caches.GTS.DomainInterop().Load("Domain", func() (*gtsmodel.DomainInterop, error) {
+		var interop gtsmodel.DomainInterop
+
+		q := i.db.
+			NewSelect().
+			Model(&interop).
+			Where("? = ?", bun.Ident("domain_interop.domain"), domain)
+
+		if err := q.Scan(ctx); err != nil {
+			return nil, err
+		}
+
+		return &interop, nil
+	}, domain)
+}
+
+func (i *interopDB) GetDomainInterops(ctx context.Context) ([]*gtsmodel.DomainInterop, error) {
+	var domains []string
+
+	if err := i.db.
+		NewSelect().
+		Table("domain_interops").
+		Column("domain").
+		Order("domain ASC").
+		Scan(ctx, &domains); err != nil {
+		return nil, err
+	}
+
+	interops := make([]*gtsmodel.DomainInterop, 0, len(domains))
+	for _, domain := range domains {
+		interop, err := i.GetDomainInterop(ctx, domain)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Deleted since select.
+				continue
+			}
+			return nil, err
+		}
+		interops = append(interops, interop)
+	}
+
+	return interops, nil
+}
+
+func (i *interopDB) PutDomainInterop(ctx context.Context, interop *gtsmodel.DomainInterop) error {
+	var err error
+
+	// Normalize the domain as punycode
+	interop.Domain, err = util.Punify(interop.Domain)
+	if err != nil {
+		return err
+	}
+
+	return i.state.Caches.GTS.DomainInterop().Store(interop, func() error {
+		_, err := i.db.
+			NewInsert().
+			Model(interop).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (i *interopDB) UpdateDomainInterop(ctx context.Context, interop *gtsmodel.DomainInterop, columns ...string) error {
+	interop.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return i.state.Caches.GTS.DomainInterop().Store(interop, func() error {
+		_, err := i.db.
+			NewUpdate().
+			Model(interop).
+			Where("? = ?", bun.Ident("domain_interop.id"), interop.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (i *interopDB) DeleteDomainInterop(ctx context.Context, domain string) error {
+	// Normalize the domain as punycode
+	domain, err := util.Punify(domain)
+	if err != nil {
+		return err
+	}
+
+	defer i.state.Caches.GTS.DomainInterop().Invalidate("Domain", domain)
+
+	// Delete interop profile from DB.
+	_, err = i.db.NewDelete().
+		TableExpr("? AS ?", bun.Ident("domain_interops"), bun.Ident("domain_interop")).
+		Where("? = ?", bun.Ident("domain_interop.domain"), domain).
+		Exec(ctx)
+	return err
+}
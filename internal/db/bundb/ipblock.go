@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type ipBlockDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (i *ipBlockDB) GetIPBlockByID(ctx context.Context, id string) (*gtsmodel.IPBlock, error) {
+	var block gtsmodel.IPBlock
+
+	if err := i.db.
+		NewSelect().
+		Model(&block).
+		Where("? = ?", bun.Ident("ip_block.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &block, nil
+	}
+
+	// Further populate the ip block fields where applicable.
+	if err := i.PopulateIPBlock(ctx, &block); err != nil {
+		return nil, err
+	}
+
+	return &block, nil
+}
+
+func (i *ipBlockDB) GetIPBlocks(ctx context.Context) ([]*gtsmodel.IPBlock, error) {
+	// Fetch all ip block IDs.
+	var blockIDs []string
+	if err := i.db.
+		NewSelect().
+		Table("ip_blocks").
+		Column("id").
+		Order("id ASC").
+		Scan(ctx, &blockIDs); err != nil {
+		return nil, err
+	}
+
+	if len(blockIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each block using its ID to ensure population.
+	blocks := make([]*gtsmodel.IPBlock, 0, len(blockIDs))
+	for _, id := range blockIDs {
+		block, err := i.GetIPBlockByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+func (i *ipBlockDB) MatchIPBlock(ctx context.Context, addr netip.Addr) (*gtsmodel.IPBlock, error) {
+	return i.state.Caches.GTS.IPBlock().Match(addr, func() ([]*gtsmodel.IPBlock, error) {
+		blocks, err := i.GetIPBlocks(gtscontext.SetBarebones(ctx))
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+		return blocks, nil
+	})
+}
+
+func (i *ipBlockDB) PopulateIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error {
+	if block.CreatedByAccount != nil {
+		// Nothing to do.
+		return nil
+	}
+
+	// IP block creator is not set, fetch from the database.
+	account, err := i.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		block.CreatedByAccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error populating ip block created by account: %w", err)
+	}
+	block.CreatedByAccount = account
+
+	return nil
+}
+
+func (i *ipBlockDB) PutIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error {
+	if _, err := i.db.
+		NewInsert().
+		Model(block).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Clear the ip block cache (for later reload).
+	i.state.Caches.GTS.IPBlock().Clear()
+	i.state.Caches.NotifyIPBlock()
+
+	return nil
+}
+
+func (i *ipBlockDB) DeleteIPBlockByID(ctx context.Context, id string) error {
+	_, err := i.db.
+		NewDelete().
+		Table("ip_blocks").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Clear the ip block cache (for later reload).
+	i.state.Caches.GTS.IPBlock().Clear()
+	i.state.Caches.NotifyIPBlock()
+
+	return nil
+}
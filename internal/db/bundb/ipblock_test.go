@@ -0,0 +1,128 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type IPBlockTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *IPBlockTestSuite) putIPBlock(ip string, severity gtsmodel.IPBlockSeverity, expiresAt time.Time) *gtsmodel.IPBlock {
+	block := &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		IP:                 ip,
+		Severity:           severity,
+		ExpiresAt:          expiresAt,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+
+	if err := suite.state.DB.PutIPBlock(context.Background(), block); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return block
+}
+
+func (suite *IPBlockTestSuite) TestPutGetDeleteIPBlock() {
+	ctx := context.Background()
+
+	// No blocks to begin with.
+	_, err := suite.state.DB.GetIPBlocks(ctx)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	block := suite.putIPBlock("192.0.2.0/24", gtsmodel.IPBlockSignUpBlock, time.Time{})
+
+	dbBlock, err := suite.state.DB.GetIPBlockByID(ctx, block.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("192.0.2.0/24", dbBlock.IP)
+	suite.Equal(gtsmodel.IPBlockSignUpBlock, dbBlock.Severity)
+	suite.NotNil(dbBlock.CreatedByAccount)
+	suite.Zero(dbBlock.ExpiresAt)
+
+	blocks, err := suite.state.DB.GetIPBlocks(ctx)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(blocks, 1)
+
+	if err := suite.state.DB.DeleteIPBlockByID(ctx, block.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.state.DB.GetIPBlockByID(ctx, block.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *IPBlockTestSuite) TestMatchIPBlock() {
+	ctx := context.Background()
+
+	signUp := suite.putIPBlock("192.0.2.0/24", gtsmodel.IPBlockSignUpBlock, time.Time{})
+	noAccess := suite.putIPBlock("192.0.2.128/25", gtsmodel.IPBlockNoAccess, time.Time{})
+	suite.putIPBlock("2001:db8::/32", gtsmodel.IPBlockNoAccess, time.Now().Add(-time.Hour))
+
+	for _, test := range []struct {
+		addr  string
+		match string
+	}{
+		{"192.0.2.1", signUp.ID},
+		{"192.0.2.200", noAccess.ID},
+		{"::ffff:192.0.2.200", noAccess.ID},
+		{"198.51.100.1", ""},
+		{"2001:db8::1", ""}, // expired
+	} {
+		block, err := suite.state.DB.MatchIPBlock(ctx, netip.MustParseAddr(test.addr))
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		var matchID string
+		if block != nil {
+			matchID = block.ID
+		}
+		suite.Equal(test.match, matchID, test.addr)
+	}
+
+	// Deleting a block should stop it matching.
+	if err := suite.state.DB.DeleteIPBlockByID(ctx, noAccess.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	block, err := suite.state.DB.MatchIPBlock(ctx, netip.MustParseAddr("192.0.2.200"))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(signUp.ID, block.ID)
+}
+
+func TestIPBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(IPBlockTestSuite))
+}
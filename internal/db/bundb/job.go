@@ -0,0 +1,89 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type jobDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (j *jobDB) GetJobs(ctx context.Context) ([]*gtsmodel.Job, error) {
+	var jobs []*gtsmodel.Job
+
+	if err := j.db.
+		NewSelect().
+		Model(&jobs).
+		Order("job.name ASC").
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(jobs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return jobs, nil
+}
+
+func (j *jobDB) GetJobByName(ctx context.Context, name string) (*gtsmodel.Job, error) {
+	var job gtsmodel.Job
+
+	if err := j.db.
+		NewSelect().
+		Model(&job).
+		Where("? = ?", bun.Ident("job.name"), name).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (j *jobDB) PutJob(ctx context.Context, job *gtsmodel.Job) error {
+	_, err := j.db.
+		NewInsert().
+		Model(job).
+		Exec(ctx)
+	return err
+}
+
+func (j *jobDB) UpdateJob(ctx context.Context, job *gtsmodel.Job, columns ...string) error {
+	job.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := j.db.
+		NewUpdate().
+		Model(job).
+		Where("? = ?", bun.Ident("job.id"), job.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
@@ -215,6 +215,14 @@ func (l *listDB) DeleteListByID(ctx context.Context, id string) error {
 			return err
 		}
 
+		// Delete all rules attached to list.
+		if _, err := tx.NewDelete().
+			Table("list_rules").
+			Where("? = ?", bun.Ident("list_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// Delete the list itself.
 		_, err := tx.NewDelete().
 			Table("lists").
@@ -515,6 +523,152 @@ func (l *listDB) ListIncludesAccount(ctx context.Context, listID string, account
 	return exists, err
 }
 
+/*
+	LIST RULE functions
+*/
+
+func (l *listDB) GetListRuleByID(ctx context.Context, id string) (*gtsmodel.ListRule, error) {
+	listRule := new(gtsmodel.ListRule)
+
+	if err := l.db.
+		NewSelect().
+		Model(listRule).
+		Where("? = ?", bun.Ident("list_rule.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return listRule, nil
+	}
+
+	if err := l.state.DB.PopulateListRule(ctx, listRule); err != nil {
+		return nil, err
+	}
+
+	return listRule, nil
+}
+
+func (l *listDB) GetListRules(ctx context.Context, listID string) ([]*gtsmodel.ListRule, error) {
+	var ruleIDs []string
+
+	if err := l.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_rules"), bun.Ident("list_rule")).
+		Column("list_rule.id").
+		Where("? = ?", bun.Ident("list_rule.list_id"), listID).
+		Order("list_rule.id ASC").
+		Scan(ctx, &ruleIDs); err != nil {
+		return nil, err
+	}
+
+	if len(ruleIDs) == 0 {
+		return nil, nil
+	}
+
+	rules := make([]*gtsmodel.ListRule, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		rule, err := l.GetListRuleByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error fetching list rule %q: %v", id, err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (l *listDB) GetListRulesForTargets(ctx context.Context, accountID string, tagIDs []string) ([]*gtsmodel.ListRule, error) {
+	var rules []*gtsmodel.ListRule
+
+	// Select all rules matching either the account
+	// ID or one of the tag IDs in one go; both of
+	// these columns are indexed so this is cheap.
+	q := l.db.
+		NewSelect().
+		Model(&rules).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.Where("? = ?", bun.Ident("list_rule.target_account_id"), accountID)
+			if len(tagIDs) != 0 {
+				q = q.WhereOr("? IN (?)", bun.Ident("list_rule.tag_id"), bun.In(tagIDs))
+			}
+			return q
+		}).
+		Order("list_rule.id ASC")
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (l *listDB) PopulateListRule(ctx context.Context, listRule *gtsmodel.ListRule) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if listRule.TagID != "" && listRule.Tag == nil {
+		// Rule tag is not set, fetch from the database.
+		listRule.Tag, err = l.state.DB.GetTag(ctx, listRule.TagID)
+		if err != nil {
+			errs.Appendf("error populating list rule tag: %w", err)
+		}
+	}
+
+	if listRule.TargetAccountID != "" && listRule.TargetAccount == nil {
+		// Rule target account is not set, fetch from the database.
+		listRule.TargetAccount, err = l.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			listRule.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating list rule target account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (l *listDB) PutListRule(ctx context.Context, listRule *gtsmodel.ListRule) error {
+	defer func() {
+		// Invalidate the timeline for the list this rule belongs to.
+		if err := l.state.Timelines.List.RemoveTimeline(ctx, listRule.ListID); err != nil {
+			log.Errorf(ctx, "error invalidating list timeline: %q", err)
+		}
+	}()
+
+	_, err := l.db.NewInsert().Model(listRule).Exec(ctx)
+	return err
+}
+
+func (l *listDB) DeleteListRule(ctx context.Context, id string) error {
+	rule, err := l.GetListRuleByID(gtscontext.SetBarebones(ctx), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Already gone.
+			return nil
+		}
+		return err
+	}
+
+	defer func() {
+		// Invalidate the timeline for the list this rule belonged to.
+		if err := l.state.Timelines.List.RemoveTimeline(ctx, rule.ListID); err != nil {
+			log.Errorf(ctx, "error invalidating list timeline: %q", err)
+		}
+	}()
+
+	_, err = l.db.NewDelete().
+		Table("list_rules").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
 // collate will collect the values of type T from an expected slice of length 'len',
 // passing the expected index to each call of 'get' and deduplicating the end result.
 func collate[T comparable](get func(int) T, len int) []T {
@@ -331,6 +331,79 @@ func (suite *ListTestSuite) TestListIncludesAccount() {
 	}
 }
 
+func (suite *ListTestSuite) TestListRules() {
+	var (
+		ctx         = context.Background()
+		testList, _ = suite.testStructs()
+		remote      = suite.testAccounts["remote_account_1"]
+		tag         = suite.testTags["welcome"]
+	)
+
+	accountRule := &gtsmodel.ListRule{
+		ID:              "01HD8ZQ0Y6W3V9GQ8B1YS1V0K1",
+		ListID:          testList.ID,
+		TargetAccountID: remote.ID,
+	}
+	if err := suite.db.PutListRule(ctx, accountRule); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tagRule := &gtsmodel.ListRule{
+		ID:     "01HD8ZQ0Y6W3V9GQ8B1YS1V0K2",
+		ListID: testList.ID,
+		TagID:  tag.ID,
+	}
+	if err := suite.db.PutListRule(ctx, tagRule); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Both rules should come back populated.
+	rules, err := suite.db.GetListRules(ctx, testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(rules, 2)
+	suite.Equal(accountRule.ID, rules[0].ID)
+	suite.Equal(remote.ID, rules[0].TargetAccount.ID)
+	suite.Equal(tagRule.ID, rules[1].ID)
+	suite.Equal(tag.Name, rules[1].Tag.Name)
+
+	// Match by account.
+	rules, err = suite.db.GetListRulesForTargets(ctx, remote.ID, nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(rules, 1)
+	suite.Equal(accountRule.ID, rules[0].ID)
+
+	// Match by tag.
+	rules, err = suite.db.GetListRulesForTargets(ctx, suite.testAccounts["admin_account"].ID, []string{tag.ID})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(rules, 1)
+	suite.Equal(tagRule.ID, rules[0].ID)
+
+	// Delete one rule.
+	if err := suite.db.DeleteListRule(ctx, accountRule.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetListRuleByID(ctx, accountRule.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Deleting the list should delete remaining rules.
+	if err := suite.db.DeleteListByID(ctx, testList.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	rules, err = suite.db.GetListRules(ctx, testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(rules)
+}
+
 func TestListTestSuite(t *testing.T) {
 	suite.Run(t, new(ListTestSuite))
 }
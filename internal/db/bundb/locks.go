@@ -0,0 +1,131 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"database/sql/driver"
+	"sync"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+type locksDB struct {
+	db *DB
+
+	// advisory indicates whether to also take
+	// postgres advisory locks, shared between
+	// all processes using the same database.
+	advisory bool
+
+	// local contains locks held within this
+	// process, always taken first so that we
+	// only ever use one database connection
+	// waiting on the same advisory lock.
+	local map[string]chan struct{}
+	mu    sync.Mutex
+}
+
+func (l *locksDB) Lock(ctx context.Context, key string) (func(), error) {
+	unlockLocal, err := l.lockLocal(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !l.advisory {
+		return unlockLocal, nil
+	}
+
+	unlockAdvisory, err := l.lockAdvisory(ctx, key)
+	if err != nil {
+		unlockLocal()
+		return nil, err
+	}
+
+	return func() {
+		unlockAdvisory()
+		unlockLocal()
+	}, nil
+}
+
+// lockLocal acquires the in-process lock with given key,
+// waiting until it is released or the context is cancelled.
+func (l *locksDB) lockLocal(ctx context.Context, key string) (func(), error) {
+	for {
+		l.mu.Lock()
+		wait, ok := l.local[key]
+		if !ok {
+			// Not held, take it.
+			done := make(chan struct{})
+			if l.local == nil {
+				l.local = make(map[string]chan struct{})
+			}
+			l.local[key] = done
+			l.mu.Unlock()
+
+			return func() {
+				l.mu.Lock()
+				delete(l.local, key)
+				l.mu.Unlock()
+				close(done)
+			}, nil
+		}
+		l.mu.Unlock()
+
+		// Wait for the current
+		// holder to release it.
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// lockAdvisory acquires the postgres advisory lock for given key,
+// waiting until it is released or the context is cancelled. The
+// lock is tied to the session, so a connection is held until unlock.
+func (l *locksDB) lockAdvisory(ctx context.Context, key string) (func(), error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, gtserror.Newf("error getting connection: %w", err)
+	}
+
+	if _, err := conn.ExecContext(ctx,
+		"SELECT pg_advisory_lock(hashtextextended($1, 0))", key,
+	); err != nil {
+		_ = conn.Close()
+		return nil, gtserror.Newf("error acquiring advisory lock %s: %w", key, err)
+	}
+
+	return func() {
+		// Use a fresh context, the lock must be released
+		// even if the one used to acquire it has expired.
+		if _, err := conn.ExecContext(context.Background(),
+			"SELECT pg_advisory_unlock(hashtextextended($1, 0))", key,
+		); err != nil {
+			// Closing the connection below won't release
+			// the lock if it's returned to the pool, so
+			// make sure that it is discarded instead.
+			log.Errorf(nil, "error releasing advisory lock %s: %v", key, err)
+			_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		}
+		_ = conn.Close()
+	}, nil
+}
@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LocksTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *LocksTestSuite) TestLockWaits() {
+	ctx := context.Background()
+
+	unlock, err := suite.db.Lock(ctx, "some key")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// A different key can be taken meanwhile.
+	unlockOther, err := suite.db.Lock(ctx, "some other key")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	unlockOther()
+
+	locked := make(chan func())
+	go func() {
+		unlock, err := suite.db.Lock(ctx, "some key")
+		if err != nil {
+			suite.Fail(err.Error())
+		}
+		locked <- unlock
+	}()
+
+	// Still held, so the second lock waits.
+	select {
+	case <-locked:
+		suite.FailNow("lock taken while already held")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Release it, second lock is taken.
+	unlock()
+
+	select {
+	case unlock := <-locked:
+		unlock()
+	case <-time.After(5 * time.Second):
+		suite.FailNow("lock not taken after release")
+	}
+}
+
+func (suite *LocksTestSuite) TestLockContextCancelled() {
+	unlock, err := suite.db.Lock(context.Background(), "some key")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	defer unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err = suite.db.Lock(ctx, "some key")
+	suite.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestLocksTestSuite(t *testing.T) {
+	suite.Run(t, new(LocksTestSuite))
+}
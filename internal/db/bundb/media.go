@@ -269,3 +269,39 @@ func (m *mediaDB) GetCachedAttachmentsOlderThan(ctx context.Context, olderThan t
 
 	return m.GetAttachmentsByIDs(ctx, attachmentIDs)
 }
+
+func (m *mediaDB) GetCachedAttachmentsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.MediaAttachment, error) {
+	attachmentIDs := []string{}
+
+	if err := m.db.
+		NewSelect().
+		Table("media_attachments").
+		Column("id").
+		Where("cached = true").
+		Where("remote_url IS NOT NULL").
+		Where("account_id = ?", accountID).
+		Order("created_at DESC").
+		Scan(ctx, &attachmentIDs); err != nil {
+		return nil, err
+	}
+
+	return m.GetAttachmentsByIDs(ctx, attachmentIDs)
+}
+
+func (m *mediaDB) GetAccountAttachmentsSize(ctx context.Context, accountID string) (int64, error) {
+	var size int64
+
+	if err := m.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+		ColumnExpr("COALESCE(SUM(? + ?), 0)",
+			bun.Ident("media_attachment.file_file_size"),
+			bun.Ident("media_attachment.thumbnail_file_size"),
+		).
+		Where("? = ?", bun.Ident("media_attachment.account_id"), accountID).
+		Scan(ctx, &size); err != nil {
+		return 0, err
+	}
+
+	return size, nil
+}
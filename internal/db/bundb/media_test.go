@@ -50,6 +50,21 @@ func (suite *MediaTestSuite) TestGetCachedAttachmentsOlderThan() {
 	suite.Len(attachments, 2)
 }
 
+func (suite *MediaTestSuite) TestGetCachedAttachmentsByAccountID() {
+	ctx := context.Background()
+
+	testHeader := suite.testAttachments["remote_account_3_header"]
+	attachments, err := suite.db.GetCachedAttachmentsByAccountID(ctx, testHeader.AccountID)
+	suite.NoError(err)
+	suite.Len(attachments, 1)
+	suite.Equal(testHeader.ID, attachments[0].ID)
+
+	// Local account media is never included.
+	attachments, err = suite.db.GetCachedAttachmentsByAccountID(ctx, suite.testAccounts["admin_account"].ID)
+	suite.NoError(err)
+	suite.Empty(attachments)
+}
+
 func TestMediaTestSuite(t *testing.T) {
 	suite.Run(t, new(MediaTestSuite))
 }
@@ -65,7 +65,9 @@ func init() {
 				"note",
 				"note_raw",
 				"memorial",
-				"also_known_as",
+				// "also_known_as" was never populated, and
+				// has since been replaced by "also_known_as_uris",
+				// so don't attempt to copy it over here.
 				"moved_to_account_id",
 				"bot",
 				"reason",
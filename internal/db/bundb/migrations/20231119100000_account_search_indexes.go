@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		if db.Dialect().Name() != dialect.PG {
+			// Trigram indexes are
+			// a Postgres-only thing.
+			return nil
+		}
+
+		// Enabling the extension needs extra privileges
+		// on some setups, which the database user may not
+		// have. Searching works fine without the indexes
+		// (just slower), so don't fail the migration.
+		//
+		// Done outside of the transaction below, as a
+		// failed statement aborts the whole transaction.
+		if _, err := db.ExecContext(ctx, "CREATE EXTENSION IF NOT EXISTS pg_trgm"); err != nil {
+			log.Warnf(ctx, "couldn't enable pg_trgm extension, account search will be slower: %v", err)
+			return nil
+		}
+
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Index the lowercased text columns that accounts
+			// are searched by, so that account search LIKE
+			// queries don't need to scan the whole table.
+			log.Info(ctx, "creating account search indexes, please wait and don't interrupt it (this may take a while)")
+			for index, column := range map[string]string{
+				"accounts_username_trgm_idx":     "username",
+				"accounts_display_name_trgm_idx": "display_name",
+				"accounts_note_trgm_idx":         "note",
+			} {
+				if _, err := tx.
+					NewCreateIndex().
+					Table("accounts").
+					Index(index).
+					Using("GIN").
+					ColumnExpr("LOWER(?) gin_trgm_ops", bun.Ident(column)).
+					IfNotExists().
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
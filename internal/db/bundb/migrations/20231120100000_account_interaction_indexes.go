@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Index mentions and faves by account and ID, so that
+			// the most recent interactions of an account can be
+			// selected quickly when searching for accounts to
+			// autocomplete in a mention.
+			log.Info(ctx, "creating account interaction indexes, please wait and don't interrupt it (this may take a while)")
+			for _, index := range []struct {
+				model   any
+				name    string
+				columns []string
+			}{
+				{
+					model:   &gtsmodel.Mention{},
+					name:    "mentions_origin_account_id_id_idx",
+					columns: []string{"origin_account_id", "id"},
+				},
+				{
+					model:   &gtsmodel.Mention{},
+					name:    "mentions_target_account_id_id_idx",
+					columns: []string{"target_account_id", "id"},
+				},
+				{
+					model:   &gtsmodel.StatusFave{},
+					name:    "status_faves_account_id_id_idx",
+					columns: []string{"account_id", "id"},
+				},
+			} {
+				if _, err := tx.
+					NewCreateIndex().
+					Model(index.model).
+					Index(index.name).
+					Column(index.columns...).
+					IfNotExists().
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+
+	Describe(Info{
+		Change: ChangeIndex,
+		Tables: []string{"mentions", "status_faves"},
+	})
+}
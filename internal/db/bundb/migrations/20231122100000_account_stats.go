@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package migrations
+
+import (
+	"context"
+	"time"
+
+	gtsmodel "github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			_, err := tx.
+				NewCreateTable().
+				Model(&gtsmodel.AccountStats{}).
+				IfNotExists().
+				Exec(ctx)
+			return err
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+
+	Describe(Info{
+		Change: ChangeCreate,
+		Tables: []string{"account_stats"},
+	})
+
+	// Store stats of existing accounts in the background,
+	// rather than counting them all here, which could take
+	// a long time on large databases. Until then, missing
+	// stats are counted lazily when they're first needed.
+	RegisterBackfill(Backfill{
+		Name:  "account-stats",
+		Batch: backfillAccountStats,
+	})
+}
+
+// backfillAccountStats stores stats of the
+// next batch of accounts after cursor, by ID.
+func backfillAccountStats(ctx context.Context, db bun.IDB, cursor string) (string, error) {
+	var accountIDs []string
+
+	q := db.
+		NewSelect().
+		Table("accounts").
+		Column("id").
+		Order("id ASC").
+		Limit(100)
+
+	if cursor != "" {
+		q = q.Where("? > ?", bun.Ident("id"), cursor)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return "", err
+	}
+
+	if len(accountIDs) == 0 {
+		// All done.
+		return "", nil
+	}
+
+	for _, accountID := range accountIDs {
+		stats := &gtsmodel.AccountStats{
+			AccountID:     accountID,
+			RegeneratedAt: time.Now(),
+		}
+
+		for _, count := range []struct {
+			dst    *int
+			table  string
+			column string
+		}{
+			{&stats.FollowersCount, "follows", "target_account_id"},
+			{&stats.FollowingCount, "follows", "account_id"},
+			{&stats.StatusesCount, "statuses", "account_id"},
+		} {
+			n, err := db.
+				NewSelect().
+				Table(count.table).
+				Where("? = ?", bun.Ident(count.column), accountID).
+				Count(ctx)
+			if err != nil {
+				return "", err
+			}
+			*count.dst = n
+		}
+
+		// Don't replace stats stored since the
+		// migration, as they've been kept up to
+		// date with changes made since then.
+		if _, err := db.
+			NewInsert().
+			Model(stats).
+			On("CONFLICT (?) DO NOTHING", bun.Ident("account_id")).
+			Exec(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	return accountIDs[len(accountIDs)-1], nil
+}
@@ -18,8 +18,123 @@
 package migrations
 
 import (
+	"context"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+
+	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/migrate"
 )
 
 // Migrations provides migration logic for bun
 var Migrations = migrate.NewMigrations()
+
+// Change is the kind of change a migration makes to
+// existing tables, from which the database locks it
+// needs, and how long for, can be worked out.
+type Change int
+
+const (
+	ChangeUnknown Change = iota // Not described; could be anything.
+	ChangeCreate                // Only creates new tables.
+	ChangeIndex                 // Creates indexes on existing tables.
+	ChangeAlter                 // Alters existing tables without rewriting them, eg., adding a nullable column.
+	ChangeRewrite               // Rewrites every row of existing tables.
+)
+
+func (c Change) String() string {
+	switch c {
+	case ChangeCreate:
+		return "create"
+	case ChangeIndex:
+		return "index"
+	case ChangeAlter:
+		return "alter"
+	case ChangeRewrite:
+		return "rewrite"
+	default:
+		return "unknown"
+	}
+}
+
+// Info describes the impact of running
+// a migration, for pre-flight checks.
+type Info struct {
+	// Change made by the migration.
+	Change Change
+
+	// Tables changed by the migration.
+	Tables []string
+}
+
+// infos are the Infos of described
+// migrations, keyed by migration name.
+var infos = make(map[string]Info)
+
+// nameRE matches the name of a migration
+// from its file name, as bun does.
+var nameRE = regexp.MustCompile(`^(\d{1,14})_`)
+
+// Describe describes the impact of running the migration
+// registered in the calling file, for pre-flight checks.
+// It should be called alongside Migrations.Register().
+func Describe(info Info) {
+	_, file, _, _ := runtime.Caller(1)
+	match := nameRE.FindStringSubmatch(filepath.Base(file))
+	if match == nil {
+		panic("migrations: unsupported migration file name: " + file)
+	}
+	infos[match[1]] = info
+}
+
+// Describing returns the Info of the migration
+// with the given name, if it's been described.
+func Describing(name string) (Info, bool) {
+	info, ok := infos[name]
+	return info, ok
+}
+
+// Backfill is a long running fill of data scheduled by a migration,
+// run in batches as a background job once the instance has started,
+// rather than holding up startup until it's done. Its progress is
+// stored after each batch, so it resumes where it left off after a
+// restart.
+type Backfill struct {
+	// Name of the backfill,
+	// by which its progress
+	// is stored.
+	Name string
+
+	// Batch fills the next batch of data after cursor,
+	// an empty cursor meaning the start, and returns the
+	// cursor to continue from, or empty once finished.
+	Batch func(ctx context.Context, db bun.IDB, cursor string) (string, error)
+}
+
+// backfills are the registered
+// backfills, keyed by name.
+var backfills = make(map[string]Backfill)
+
+// RegisterBackfill registers a backfill to be run
+// in the background, usually from the init() of
+// the migration which makes it necessary.
+func RegisterBackfill(backfill Backfill) {
+	if _, ok := backfills[backfill.Name]; ok {
+		panic("migrations: backfill already registered: " + backfill.Name)
+	}
+	backfills[backfill.Name] = backfill
+}
+
+// Backfills returns all registered backfills, sorted by name.
+func Backfills() []Backfill {
+	sorted := make([]Backfill, 0, len(backfills))
+	for _, backfill := range backfills {
+		sorted = append(sorted, backfill)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
@@ -19,7 +19,9 @@ package bundb
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -28,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/schema"
 )
 
 type notificationDB struct {
@@ -172,6 +175,252 @@ func (n *notificationDB) GetAccountNotifications(
 	return notifs, nil
 }
 
+func (n *notificationDB) GetAccountNotificationGroups(
+	ctx context.Context,
+	accountID string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+	excludeTypes []string,
+	groupedTypes []string,
+	samples int,
+) ([]*gtsmodel.NotificationGroup, error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	var (
+		rows = make([]struct {
+			MostRecentID       string                    `bun:"most_recent_id"`
+			OldestID           string                    `bun:"oldest_id"`
+			NotificationsCount int                       `bun:"notifications_count"`
+			NotificationType   gtsmodel.NotificationType `bun:"notification_type"`
+			StatusID           string                    `bun:"status_id"`
+		}, 0, limit)
+		frontToBack = true
+	)
+
+	// Notifications of a type that isn't grouped
+	// are each put in a group of their own by ID.
+	groupKey := schema.SafeQuery("?", []interface{}{bun.Ident("notification.id")})
+	if len(groupedTypes) != 0 {
+		groupKey = schema.SafeQuery("CASE WHEN ? IN (?) THEN '' ELSE ? END", []interface{}{
+			bun.Ident("notification.notification_type"),
+			bun.In(groupedTypes),
+			bun.Ident("notification.id"),
+		})
+	}
+
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		// Reblog notifications pertain to the boost wrapper
+		// status, so join it to find the boosted status.
+		Join(
+			"LEFT JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("notification.status_id"),
+		).
+		ColumnExpr("MAX(?) AS ?", bun.Ident("notification.id"), bun.Ident("most_recent_id")).
+		ColumnExpr("MIN(?) AS ?", bun.Ident("notification.id"), bun.Ident("oldest_id")).
+		ColumnExpr("COUNT(*) AS ?", bun.Ident("notifications_count")).
+		ColumnExpr("? AS ?", bun.Ident("notification.notification_type"), bun.Ident("notification_type")).
+		ColumnExpr("? AS ?", notificationGroupStatusID(), bun.Ident("status_id")).
+		GroupExpr("?", bun.Ident("notification.notification_type")).
+		GroupExpr("?", notificationGroupStatusID()).
+		GroupExpr("?", groupKey)
+
+	if maxID == "" {
+		maxID = id.Highest
+	}
+
+	// Return only notifs LOWER (ie., older) than maxID.
+	q = q.Where("? < ?", bun.Ident("notification.id"), maxID)
+
+	if sinceID != "" {
+		// Return only notifs HIGHER (ie., newer) than sinceID.
+		q = q.Where("? > ?", bun.Ident("notification.id"), sinceID)
+	}
+
+	if minID != "" {
+		// Return only notifs HIGHER (ie., newer) than minID.
+		q = q.Where("? > ?", bun.Ident("notification.id"), minID)
+
+		frontToBack = false // page up
+	}
+
+	for _, excludeType := range excludeTypes {
+		// Filter out unwanted notif types.
+		q = q.Where("? != ?", bun.Ident("notification.notification_type"), excludeType)
+	}
+
+	// Return only notifs for this account.
+	q = q.Where("? = ?", bun.Ident("notification.target_account_id"), accountID)
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if frontToBack {
+		// Page down.
+		q = q.OrderExpr("? DESC", bun.Ident("most_recent_id"))
+	} else {
+		// Page up.
+		q = q.OrderExpr("? ASC", bun.Ident("most_recent_id"))
+	}
+
+	if err := q.Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	// If we're paging up, we still want groups
+	// to be sorted by ID desc, so reverse rows slice.
+	// https://zchee.github.io/golang-wiki/SliceTricks/#reversing
+	if !frontToBack {
+		for l, r := 0, len(rows)-1; l < r; l, r = l+1, r-1 {
+			rows[l], rows[r] = rows[r], rows[l]
+		}
+	}
+
+	groups := make([]*gtsmodel.NotificationGroup, 0, len(rows))
+	for _, row := range rows {
+		// Attempt fetch of most recent notif from DB.
+		notif, err := n.GetNotificationByID(ctx, row.MostRecentID)
+		if err != nil {
+			log.Errorf(ctx, "error fetching notification %q: %v", row.MostRecentID, err)
+			continue
+		}
+
+		group := &gtsmodel.NotificationGroup{
+			NotificationType:         row.NotificationType,
+			StatusID:                 row.StatusID,
+			MostRecentNotificationID: row.MostRecentID,
+			OldestNotificationID:     row.OldestID,
+			NotificationsCount:       row.NotificationsCount,
+			SampleAccountIDs:         []string{notif.OriginAccountID},
+			MostRecentNotification:   notif,
+		}
+
+		if group.NotificationsCount > 1 && samples > 1 {
+			// Select accounts that caused
+			// the most recent notifs in group.
+			group.SampleAccountIDs, err = n.getNotificationGroupSamples(ctx, accountID, group, samples)
+			if err != nil {
+				log.Errorf(ctx, "error fetching samples of notification group %q: %v", row.MostRecentID, err)
+				continue
+			}
+		}
+
+		// Append notification group
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// getNotificationGroupSamples selects the origin account IDs of up to
+// samples most recent notifications in the given notification group.
+func (n *notificationDB) getNotificationGroupSamples(
+	ctx context.Context,
+	accountID string,
+	group *gtsmodel.NotificationGroup,
+	samples int,
+) ([]string, error) {
+	accountIDs := make([]string, 0, samples)
+
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Join(
+			"LEFT JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"), bun.Ident("status"),
+			bun.Ident("status.id"), bun.Ident("notification.status_id"),
+		).
+		Column("notification.origin_account_id").
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID).
+		Where("? = ?", bun.Ident("notification.notification_type"), group.NotificationType).
+		Where("? >= ?", bun.Ident("notification.id"), group.OldestNotificationID).
+		Where("? <= ?", bun.Ident("notification.id"), group.MostRecentNotificationID).
+		Order("notification.id DESC").
+		Limit(samples)
+
+	if group.StatusID == "" {
+		q = q.Where("? IS NULL", notificationGroupStatusID())
+	} else {
+		q = q.Where("? = ?", notificationGroupStatusID(), group.StatusID)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, err
+	}
+
+	return accountIDs, nil
+}
+
+// notificationGroupStatusID returns an expression selecting the ID
+// of the status that a notification pertains to, for grouping: that
+// of the boosted status for reblogs, otherwise just the status ID.
+func notificationGroupStatusID() schema.QueryWithArgs {
+	return schema.SafeQuery("COALESCE(?, ?)", []interface{}{
+		bun.Ident("status.boost_of_id"),
+		bun.Ident("notification.status_id"),
+	})
+}
+
+func (n *notificationDB) CountAccountNotifications(
+	ctx context.Context,
+	accountID string,
+	sinceID string,
+	limit int,
+	types []string,
+	excludeTypes []string,
+) (int, error) {
+	// Select only IDs of the notifs to
+	// count, so the count can be limited.
+	q := n.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("notifications"), bun.Ident("notification")).
+		Column("notification.id").
+		Where("? = ?", bun.Ident("notification.target_account_id"), accountID)
+
+	if sinceID != "" {
+		// Return only notifs HIGHER (ie., newer) than sinceID.
+		q = q.Where("? > ?", bun.Ident("notification.id"), sinceID)
+	}
+
+	if len(types) != 0 {
+		// Return only notifs of wanted types.
+		q = q.Where("? IN (?)", bun.Ident("notification.notification_type"), bun.In(types))
+	}
+
+	for _, excludeType := range excludeTypes {
+		// Filter out unwanted notif types.
+		q = q.Where("? != ?", bun.Ident("notification.notification_type"), excludeType)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	var count int
+	if err := n.db.
+		NewSelect().
+		TableExpr("(?) AS ?", q, bun.Ident("notification")).
+		ColumnExpr("COUNT(*)").
+		Scan(ctx, &count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (n *notificationDB) PutNotification(ctx context.Context, notif *gtsmodel.Notification) error {
 	return n.state.Caches.GTS.Notification().Store(notif, func() error {
 		_, err := n.db.NewInsert().Model(notif).Exec(ctx)
@@ -255,6 +504,55 @@ func (n *notificationDB) DeleteNotifications(ctx context.Context, types []string
 	return err
 }
 
+func (n *notificationDB) DismissNotifications(
+	ctx context.Context,
+	targetAccountID string,
+	types []string,
+	originAccountID string,
+	olderThan time.Time,
+) (int, error) {
+	if targetAccountID == "" {
+		return 0, errors.New("DismissNotifications: targetAccountID must be set")
+	}
+
+	var notifIDs []string
+
+	// Prepare DELETE query returning
+	// the IDs of deleted notifications.
+	q := n.db.
+		NewDelete().
+		Table("notifications").
+		Where("? = ?", bun.Ident("target_account_id"), targetAccountID).
+		Returning("id")
+
+	if len(types) > 0 {
+		q = q.Where("? IN (?)", bun.Ident("notification_type"), bun.In(types))
+	}
+
+	if originAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("origin_account_id"), originAccountID)
+	}
+
+	if !olderThan.IsZero() {
+		q = q.Where("? < ?", bun.Ident("created_at"), olderThan)
+	}
+
+	if _, err := q.Exec(ctx, &notifIDs); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Not an issue, only due
+			// to us doing a RETURNING.
+			err = nil
+		}
+		return 0, err
+	}
+
+	for _, id := range notifIDs {
+		n.state.Caches.GTS.Notification().Invalidate("ID", id)
+	}
+
+	return len(notifIDs), nil
+}
+
 func (n *notificationDB) DeleteNotificationsForStatus(ctx context.Context, statusID string) error {
 	var notifIDs []string
 
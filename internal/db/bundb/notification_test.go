@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
 func (suite *NotificationTestSuite) spamNotifs() {
@@ -206,6 +207,168 @@ func (suite *NotificationTestSuite) TestDeleteNotificationsPertainingToStatusID(
 	}
 }
 
+func (suite *NotificationTestSuite) TestDismissNotificationsByTypeAndOrigin() {
+	targetAccount := suite.testAccounts["local_account_1"]
+	originAccount := suite.testAccounts["admin_account"]
+
+	// No follow notifs from admin to dismiss.
+	dismissed, err := suite.db.DismissNotifications(context.Background(), targetAccount.ID, []string{string(gtsmodel.NotificationFollow)}, originAccount.ID, time.Time{})
+	suite.NoError(err)
+	suite.Zero(dismissed)
+
+	// One fave notif from admin to dismiss.
+	dismissed, err = suite.db.DismissNotifications(context.Background(), targetAccount.ID, []string{string(gtsmodel.NotificationFave)}, originAccount.ID, time.Time{})
+	suite.NoError(err)
+	suite.Equal(1, dismissed)
+
+	notif, err := suite.db.GetNotificationByID(context.Background(), "01F8Q0ANPTWW10DAKTX7BRPBJP")
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(notif)
+}
+
+func (suite *NotificationTestSuite) TestDismissNotificationsOlderThan() {
+	suite.spamNotifs()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Only the testrig notif is older than this.
+	olderThan := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	dismissed, err := suite.db.DismissNotifications(context.Background(), testAccount.ID, nil, "", olderThan)
+	suite.NoError(err)
+	suite.Equal(1, dismissed)
+
+	notifications, err := suite.db.GetAccountNotifications(context.Background(), testAccount.ID, id.Highest, id.Lowest, "", 20, nil)
+	suite.NoError(err)
+	suite.Len(notifications, 20)
+	for _, n := range notifications {
+		suite.True(n.CreatedAt.After(olderThan))
+	}
+}
+
+func (suite *NotificationTestSuite) TestGetAccountNotificationGroups() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		testStatus  = suite.testStatuses["local_account_1_status_1"]
+		existingFav = testrig.NewTestNotifications()["local_account_1_like"]
+	)
+
+	// Fave the status already faved by admin_account
+	// twice more, then have admin_account boost it too.
+	// IDs are fixed, as ordering of ULIDs generated
+	// within the same millisecond is random.
+	notifs := []*gtsmodel.Notification{
+		{
+			ID:               "01HGKA1TY1X9V7K0B1J2T0GQ1A",
+			NotificationType: gtsmodel.NotificationFave,
+			TargetAccountID:  testAccount.ID,
+			OriginAccountID:  suite.testAccounts["local_account_2"].ID,
+			StatusID:         testStatus.ID,
+		},
+		{
+			ID:               "01HGKA1TY1X9V7K0B1J2T0GQ1B",
+			NotificationType: gtsmodel.NotificationFave,
+			TargetAccountID:  testAccount.ID,
+			OriginAccountID:  suite.testAccounts["remote_account_1"].ID,
+			StatusID:         testStatus.ID,
+		},
+		{
+			ID:               "01HGKA1TY1X9V7K0B1J2T0GQ1C",
+			NotificationType: gtsmodel.NotificationReblog,
+			TargetAccountID:  testAccount.ID,
+			OriginAccountID:  suite.testAccounts["admin_account"].ID,
+			StatusID:         suite.testStatuses["admin_account_status_4"].ID,
+		},
+	}
+	for _, notif := range notifs {
+		if err := suite.db.PutNotification(ctx, notif); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	groups, err := suite.db.GetAccountNotificationGroups(ctx, testAccount.ID, "", "", "", 20, nil, []string{"favourite", "reblog"}, 2)
+	suite.NoError(err)
+	suite.Len(groups, 2)
+
+	// The boost is grouped by the boosted status.
+	suite.Equal(gtsmodel.NotificationReblog, groups[0].NotificationType)
+	suite.Equal(testStatus.ID, groups[0].StatusID)
+	suite.Equal(1, groups[0].NotificationsCount)
+	suite.Equal(notifs[2].ID, groups[0].MostRecentNotificationID)
+	suite.Equal([]string{suite.testAccounts["admin_account"].ID}, groups[0].SampleAccountIDs)
+
+	// The faves are all collapsed together,
+	// with the two most recent faves sampled.
+	suite.Equal(gtsmodel.NotificationFave, groups[1].NotificationType)
+	suite.Equal(testStatus.ID, groups[1].StatusID)
+	suite.Equal(3, groups[1].NotificationsCount)
+	suite.Equal(notifs[1].ID, groups[1].MostRecentNotificationID)
+	suite.Equal(existingFav.ID, groups[1].OldestNotificationID)
+	suite.Equal([]string{
+		suite.testAccounts["remote_account_1"].ID,
+		suite.testAccounts["local_account_2"].ID,
+	}, groups[1].SampleAccountIDs)
+	suite.Equal(notifs[1].ID, groups[1].MostRecentNotification.ID)
+
+	// Paging down from the boost should return only the faves.
+	groups, err = suite.db.GetAccountNotificationGroups(ctx, testAccount.ID, notifs[2].ID, "", "", 20, nil, []string{"favourite", "reblog"}, 2)
+	suite.NoError(err)
+	suite.Len(groups, 1)
+	suite.Equal(3, groups[0].NotificationsCount)
+
+	// Without grouped types, each notification is its own group.
+	groups, err = suite.db.GetAccountNotificationGroups(ctx, testAccount.ID, "", "", "", 20, nil, nil, 2)
+	suite.NoError(err)
+	suite.Len(groups, 4)
+	for _, group := range groups {
+		suite.Equal(1, group.NotificationsCount)
+		suite.Equal(group.MostRecentNotificationID, group.OldestNotificationID)
+	}
+}
+
+func (suite *NotificationTestSuite) TestCountAccountNotifications() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		existingFav = testrig.NewTestNotifications()["local_account_1_like"]
+	)
+
+	// Add a mention notif newer than the existing fave.
+	if err := suite.db.PutNotification(ctx, &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationMention,
+		TargetAccountID:  testAccount.ID,
+		OriginAccountID:  suite.testAccounts["admin_account"].ID,
+		StatusID:         suite.testStatuses["admin_account_status_1"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	count, err := suite.db.CountAccountNotifications(ctx, testAccount.ID, "", 0, nil, nil)
+	suite.NoError(err)
+	suite.Equal(2, count)
+
+	// Count is limited.
+	count, err = suite.db.CountAccountNotifications(ctx, testAccount.ID, "", 1, nil, nil)
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	// Only newer than the fave.
+	count, err = suite.db.CountAccountNotifications(ctx, testAccount.ID, existingFav.ID, 0, nil, nil)
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	// Only of given types.
+	count, err = suite.db.CountAccountNotifications(ctx, testAccount.ID, "", 0, []string{"favourite", "reblog"}, nil)
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	// Excluding types.
+	count, err = suite.db.CountAccountNotifications(ctx, testAccount.ID, "", 0, nil, []string{"favourite", "mention"})
+	suite.NoError(err)
+	suite.Equal(0, count)
+}
+
 func TestNotificationTestSuite(t *testing.T) {
 	suite.Run(t, new(NotificationTestSuite))
 }
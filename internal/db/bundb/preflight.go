@@ -0,0 +1,231 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/migrate"
+)
+
+// PreflightReport describes the database migrations
+// and backfills still to run, and what they involve.
+type PreflightReport struct {
+	// Migrations not yet
+	// run, in order.
+	Migrations []PendingMigration
+
+	// Names of backfills
+	// not yet finished.
+	Backfills []string
+}
+
+// PendingMigration describes a migration not yet run.
+type PendingMigration struct {
+	// Name (ie., timestamp) and
+	// comment of the migration.
+	Name    string
+	Comment string
+
+	// Kind of change
+	// the migration makes.
+	Change migrations.Change
+
+	// Tables changed by the migration,
+	// and estimates of their sizes.
+	Tables []TableSize
+
+	// Locks taken by the migration.
+	Lock string
+}
+
+// TableSize is the estimated number of rows in a table.
+type TableSize struct {
+	Name string
+	Rows int64
+}
+
+// Preflight connects to the configured database without
+// migrating it, and reports the pending migrations, with
+// estimates of the sizes of the tables they change and
+// the locks they need, and the unfinished backfills.
+//
+// The only change made to the database is to create the
+// tables tracking migrations, if they don't exist yet.
+func Preflight(ctx context.Context) (*PreflightReport, error) {
+	conn, err := connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Errorf(ctx, "error closing db connection: %v", err)
+		}
+	}()
+
+	migrator := migrate.NewMigrator(conn.bun, migrations.Migrations)
+	if err := migrator.Init(ctx); err != nil {
+		return nil, gtserror.Newf("error initializing migrator: %w", err)
+	}
+
+	ms, err := migrator.MigrationsWithStatus(ctx)
+	if err != nil {
+		return nil, gtserror.Newf("error getting migrations: %w", err)
+	}
+
+	var report PreflightReport
+
+	for _, m := range ms.Unapplied() {
+		pending := PendingMigration{
+			Name:    m.Name,
+			Comment: m.Comment,
+		}
+
+		if info, ok := migrations.Describing(m.Name); ok {
+			pending.Change = info.Change
+			for _, table := range info.Tables {
+				rows, err := tableRows(ctx, conn, table)
+				if err != nil {
+					return nil, gtserror.Newf("error estimating size of table %s: %w", table, err)
+				}
+				pending.Tables = append(pending.Tables, TableSize{table, rows})
+			}
+		}
+
+		pending.Lock = lockFor(conn, pending.Change, pending.Tables)
+		report.Migrations = append(report.Migrations, pending)
+	}
+
+	// Check progress of backfills, if the
+	// table tracking them exists yet.
+	finished := make(map[string]bool)
+	exists, err := tableExists(ctx, conn, "backfills")
+	if err != nil {
+		return nil, gtserror.Newf("error checking for backfills table: %w", err)
+	}
+
+	if exists {
+		var backfills []*gtsmodel.Backfill
+		if err := conn.
+			NewSelect().
+			Model(&backfills).
+			Scan(ctx); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("error getting backfills: %w", err)
+		}
+
+		for _, backfill := range backfills {
+			finished[backfill.Name] = backfill.Finished()
+		}
+	}
+
+	for _, backfill := range migrations.Backfills() {
+		if !finished[backfill.Name] {
+			report.Backfills = append(report.Backfills, backfill.Name)
+		}
+	}
+
+	return &report, nil
+}
+
+// tableExists returns whether the given table exists.
+func tableExists(ctx context.Context, conn *DB, table string) (bool, error) {
+	var q string
+	if conn.Dialect().Name() == dialect.PG {
+		q = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = ?"
+	} else {
+		q = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?"
+	}
+
+	var n int
+	if err := conn.NewRaw(q, table).Scan(ctx, &n); err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// tableRows returns an estimate of the number of rows in the given
+// table, or zero if it doesn't exist yet. Postgres' own estimate is
+// used if there is one, as counting rows of large tables is slow.
+func tableRows(ctx context.Context, conn *DB, table string) (int64, error) {
+	exists, err := tableExists(ctx, conn, table)
+	if err != nil || !exists {
+		return 0, err
+	}
+
+	if conn.Dialect().Name() == dialect.PG {
+		var estimate int64
+		if err := conn.NewRaw(
+			"SELECT reltuples::bigint FROM pg_class WHERE oid = to_regclass(?)",
+			table,
+		).Scan(ctx, &estimate); err != nil {
+			return 0, err
+		}
+
+		// Negative if the table
+		// was never analyzed.
+		if estimate >= 0 {
+			return estimate, nil
+		}
+	}
+
+	rows, err := conn.
+		NewSelect().
+		Table(table).
+		Count(ctx)
+	return int64(rows), err
+}
+
+// lockFor describes the locks taken by a
+// migration making the given change to tables.
+func lockFor(conn *DB, change migrations.Change, tables []TableSize) string {
+	if change == migrations.ChangeUnknown {
+		return "unknown"
+	}
+
+	if conn.Dialect().Name() == dialect.SQLite {
+		// SQLite only has the one write lock.
+		return "database write lock"
+	}
+
+	names := make([]string, 0, len(tables))
+	for _, table := range tables {
+		names = append(names, table.Name)
+	}
+	on := strings.Join(names, ", ")
+
+	switch change {
+	case migrations.ChangeCreate:
+		return "none on existing tables"
+	case migrations.ChangeIndex:
+		return "SHARE on " + on + ", blocking writes while indexes build"
+	case migrations.ChangeAlter:
+		return "ACCESS EXCLUSIVE on " + on + ", briefly"
+	default:
+		return "ACCESS EXCLUSIVE on " + on + ", while rewriting"
+	}
+}
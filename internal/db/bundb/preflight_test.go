@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PreflightTestSuite struct {
+	suite.Suite
+}
+
+func (suite *PreflightTestSuite) TestPreflightEmptyDB() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	if config.GetDbType() != "sqlite" || config.GetDbAddress() != ":memory:" {
+		suite.T().Skip("needs a new, empty database")
+	}
+
+	// Each in-memory connection
+	// gets a new, empty database.
+	report, err := bundb.Preflight(context.Background())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Every migration should be
+	// pending, including this one.
+	var found bool
+	for _, m := range report.Migrations {
+		if m.Name != "20231123100000" {
+			continue
+		}
+		found = true
+		suite.Equal("backfills", m.Comment)
+		suite.Equal(migrations.ChangeCreate, m.Change)
+		suite.Equal([]bundb.TableSize{{Name: "backfills", Rows: 0}}, m.Tables)
+		suite.Equal("database write lock", m.Lock)
+	}
+	suite.True(found)
+
+	suite.Equal([]string{"account-stats"}, report.Backfills)
+}
+
+func TestPreflightTestSuite(t *testing.T) {
+	suite.Run(t, new(PreflightTestSuite))
+}
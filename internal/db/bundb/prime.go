@@ -0,0 +1,230 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// primeDomain is a placeholder domain used to trigger
+// loading of domain lists into the caches; the result
+// of matching it is discarded. The .invalid TLD is
+// reserved, so this will never be a real domain.
+const primeDomain = "cache-prime.invalid"
+
+// primeTask is a single step of priming the caches.
+type primeTask struct {
+	// name of the task, for logging
+	// and for referencing in after.
+	name string
+
+	// names of tasks that must be
+	// finished before this one starts.
+	after []string
+
+	// prime loads data into the caches.
+	prime func(ctx context.Context, state *state.State) error
+}
+
+// primeTasks are cheap to run, and needed by most
+// requests, so they're always run at startup.
+var primeTasks = []primeTask{
+	{
+		name: "domain allows / blocks",
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.IsDomainBlocked(ctx, primeDomain)
+			return err
+		},
+	},
+	{
+		name: "email domain blocks",
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.IsEmailDomainBlocked(ctx, primeDomain)
+			return err
+		},
+	},
+	{
+		name: "ip blocks",
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.MatchIPBlock(ctx, netip.IPv4Unspecified())
+			return err
+		},
+	},
+	{
+		name: "ingest rules",
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.MatchIngestRules(ctx, "")
+			return err
+		},
+	},
+	{
+		name: "instance account",
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.GetInstanceAccount(ctx, "")
+			return err
+		},
+	},
+	{
+		name: "instance",
+
+		// The instance model is
+		// populated with its account.
+		after: []string{"instance account"},
+
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.GetInstance(ctx, config.GetHost())
+			return err
+		},
+	},
+}
+
+// primeLocalTasks load all local accounts (via their
+// users) and custom emojis, which can be slow for large
+// databases, so they're only run if enabled by config.
+var primeLocalTasks = []primeTask{
+	{
+		name: "emoji categories",
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.GetEmojiCategories(ctx)
+			return err
+		},
+	},
+	{
+		name: "emojis",
+
+		// Emojis are populated
+		// with their category.
+		after: []string{"emoji categories"},
+
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.GetUseableEmojis(ctx)
+			return err
+		},
+	},
+	{
+		name: "users",
+
+		// Users are populated with their
+		// account, which in turn is
+		// populated with its emojis.
+		after: []string{"emojis"},
+
+		prime: func(ctx context.Context, state *state.State) error {
+			_, err := state.DB.GetAllUsers(ctx)
+			return err
+		},
+	},
+}
+
+// PrimeCaches loads commonly needed data into the caches at startup,
+// so that the first requests served don't each have to wait for it,
+// running the steps that don't depend on each other concurrently.
+//
+// If cache-prime-local is enabled, all local accounts and custom emojis
+// are also loaded, in the background so as not to hold up startup.
+// Otherwise these are loaded lazily, as and when they're needed.
+func PrimeCaches(ctx context.Context, state *state.State) error {
+	if err := runPrimeTasks(ctx, state, primeTasks); err != nil {
+		return err
+	}
+
+	if !config.GetCachePrimeLocal() {
+		return nil
+	}
+
+	go func() {
+		if err := runPrimeTasks(ctx, state, primeLocalTasks); err != nil {
+			log.Errorf(ctx, "error priming local caches: %v", err)
+		}
+	}()
+
+	return nil
+}
+
+// runPrimeTasks runs the given tasks concurrently, each
+// starting once all the tasks it's after have finished,
+// and returns the combined errors of any that failed.
+//
+// A task failing doesn't stop tasks after it from being
+// run, as priming is only an optimization; at worst they
+// will load some data the failed task would have loaded.
+func runPrimeTasks(ctx context.Context, state *state.State, tasks []primeTask) error {
+	// Channels closed when each task is finished.
+	done := make(map[string]chan struct{}, len(tasks))
+	for _, task := range tasks {
+		done[task.name] = make(chan struct{})
+	}
+
+	// Check dependencies up front, as waiting
+	// on an unknown task would block forever.
+	for _, task := range tasks {
+		for _, name := range task.after {
+			if _, ok := done[name]; !ok {
+				return gtserror.Newf("task %s is after unknown task %s", task.name, name)
+			}
+		}
+	}
+
+	var (
+		wg     sync.WaitGroup
+		errs   gtserror.MultiError
+		errsMu sync.Mutex
+	)
+
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(task primeTask) {
+			defer wg.Done()
+			defer close(done[task.name])
+
+			// Wait for prerequisites.
+			for _, name := range task.after {
+				select {
+				case <-done[name]:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			start := time.Now()
+
+			err := task.prime(ctx, state)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				errsMu.Lock()
+				errs.Appendf("error priming %s: %w", task.name, err)
+				errsMu.Unlock()
+				return
+			}
+
+			log.Debugf(ctx, "primed %s in %s", task.name, time.Since(start))
+		}(task)
+	}
+
+	wg.Wait()
+	return errs.Combine()
+}
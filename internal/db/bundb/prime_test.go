@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PrimeTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+// usersCached returns whether all the
+// test users are in the user cache.
+func (suite *PrimeTestSuite) usersCached() bool {
+	for _, user := range suite.testUsers {
+		if !suite.state.Caches.GTS.User().Has("ID", user.ID) {
+			return false
+		}
+	}
+	return true
+}
+
+func (suite *PrimeTestSuite) TestPrimeCaches() {
+	ctx := context.Background()
+
+	// Start from empty caches.
+	suite.state.Caches.Clear()
+
+	err := bundb.PrimeCaches(ctx, &suite.state)
+	suite.NoError(err)
+
+	// Instance should now be cached.
+	suite.True(suite.state.Caches.GTS.Instance().Has("Domain", config.GetHost()))
+
+	// Local users are only
+	// primed if enabled.
+	suite.False(suite.usersCached())
+}
+
+func (suite *PrimeTestSuite) TestPrimeCachesLocal() {
+	ctx := context.Background()
+	config.SetCachePrimeLocal(true)
+
+	// Start from empty caches.
+	suite.state.Caches.Clear()
+
+	err := bundb.PrimeCaches(ctx, &suite.state)
+	suite.NoError(err)
+
+	// Local users are primed
+	// in the background.
+	if !testrig.WaitFor(suite.usersCached) {
+		suite.FailNow("timed out waiting for users to be cached")
+	}
+}
+
+func TestPrimeTestSuite(t *testing.T) {
+	suite.Run(t, new(PrimeTestSuite))
+}
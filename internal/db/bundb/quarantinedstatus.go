@@ -0,0 +1,232 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package bundb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type quarantinedStatusDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (q *quarantinedStatusDB) GetQuarantinedStatusByID(ctx context.Context, id string) (*gtsmodel.QuarantinedStatus, error) {
+	return q.getQuarantinedStatus(ctx, "id", id)
+}
+
+func (q *quarantinedStatusDB) GetQuarantinedStatusByStatusID(ctx context.Context, statusID string) (*gtsmodel.QuarantinedStatus, error) {
+	return q.getQuarantinedStatus(ctx, "status_id", statusID)
+}
+
+func (q *quarantinedStatusDB) getQuarantinedStatus(ctx context.Context, column string, value string) (*gtsmodel.QuarantinedStatus, error) {
+	var quarantined gtsmodel.QuarantinedStatus
+
+	if err := q.db.
+		NewSelect().
+		Model(&quarantined).
+		Where("? = ?", bun.Ident("quarantined_status."+column), value).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &quarantined, nil
+	}
+
+	// Further populate the quarantined status fields where applicable.
+	if err := q.PopulateQuarantinedStatus(ctx, &quarantined); err != nil {
+		return nil, err
+	}
+
+	return &quarantined, nil
+}
+
+func (q *quarantinedStatusDB) IsStatusQuarantined(ctx context.Context, statusID string) (bool, error) {
+	return q.db.Exists(ctx, q.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("quarantined_statuses"), bun.Ident("quarantined_status")).
+		Column("quarantined_status.id").
+		Where("? = ?", bun.Ident("quarantined_status.status_id"), statusID),
+	)
+}
+
+func (q *quarantinedStatusDB) GetQuarantinedStatuses(ctx context.Context, page *paging.Page) ([]*gtsmodel.QuarantinedStatus, error) {
+	var ids []string
+
+	// The review queue is only ever looked
+	// at by moderators, so there's no need
+	// to cache it; select IDs as needed.
+	if err := q.db.NewSelect().
+		TableExpr("?", bun.Ident("quarantined_statuses")).
+		ColumnExpr("?", bun.Ident("id")).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	// Selected IDs are in descending
+	// order, which may not be the order
+	// that was requested by the page.
+	if page.GetOrder().Ascending() {
+		ids = paging.Reverse(ids)
+	}
+
+	// Page the resulting IDs.
+	ids = page.Page(ids)
+
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each entry using its ID to ensure population.
+	quarantined := make([]*gtsmodel.QuarantinedStatus, 0, len(ids))
+	for _, id := range ids {
+		entry, err := q.GetQuarantinedStatusByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		quarantined = append(quarantined, entry)
+	}
+
+	return quarantined, nil
+}
+
+func (q *quarantinedStatusDB) GetQuarantinedStatusesByDomain(ctx context.Context, domain string) ([]*gtsmodel.QuarantinedStatus, error) {
+	var ids []string
+
+	if err := q.db.NewSelect().
+		TableExpr("?", bun.Ident("quarantined_statuses")).
+		ColumnExpr("?", bun.Ident("id")).
+		Where("? = ?", bun.Ident("domain"), domain).
+		OrderExpr("? ASC", bun.Ident("id")).
+		Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+
+	// Select each entry using its ID to ensure population.
+	quarantined := make([]*gtsmodel.QuarantinedStatus, 0, len(ids))
+	for _, id := range ids {
+		entry, err := q.GetQuarantinedStatusByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		quarantined = append(quarantined, entry)
+	}
+
+	return quarantined, nil
+}
+
+func (q *quarantinedStatusDB) PopulateQuarantinedStatus(ctx context.Context, quarantined *gtsmodel.QuarantinedStatus) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if quarantined.Status == nil {
+		// Held status is not set, fetch from the database.
+		quarantined.Status, err = q.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			quarantined.StatusID,
+		)
+		if err != nil {
+			errs.Appendf("error populating quarantined status: %w", err)
+		}
+	}
+
+	if quarantined.Account == nil {
+		// Held status author is not set, fetch from the database.
+		quarantined.Account, err = q.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			quarantined.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating quarantined status account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (q *quarantinedStatusDB) PutQuarantinedStatus(ctx context.Context, quarantined *gtsmodel.QuarantinedStatus) error {
+	if _, err := q.db.
+		NewInsert().
+		Model(quarantined).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// Status visibility changed.
+	q.invalidateStatus(quarantined.StatusID)
+
+	return nil
+}
+
+func (q *quarantinedStatusDB) DeleteQuarantinedStatusByID(ctx context.Context, id string) error {
+	return q.deleteQuarantinedStatus(ctx, "id", id)
+}
+
+func (q *quarantinedStatusDB) DeleteQuarantinedStatusByStatusID(ctx context.Context, statusID string) error {
+	return q.deleteQuarantinedStatus(ctx, "status_id", statusID)
+}
+
+func (q *quarantinedStatusDB) deleteQuarantinedStatus(ctx context.Context, column string, value string) error {
+	var statusID string
+
+	// Perform DELETE on quarantine entry,
+	// returning the status ID it was for.
+	if _, err := q.db.
+		NewDelete().
+		Table("quarantined_statuses").
+		Where("? = ?", bun.Ident(column), value).
+		Returning("status_id").
+		Exec(ctx, &statusID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, db.ErrNoEntries) {
+			// Not an issue, only due
+			// to us doing a RETURNING.
+			err = nil
+		}
+		return err
+	}
+
+	if statusID != "" {
+		// Status visibility changed.
+		q.invalidateStatus(statusID)
+	}
+
+	return nil
+}
+
+// invalidateStatus invalidates the cached status with the
+// given ID, and its cached visibility, after the status has
+// been put in or taken out of quarantine. Invalidating the
+// status also passes the invalidation on to other processes.
+func (q *quarantinedStatusDB) invalidateStatus(statusID string) {
+	q.state.Caches.GTS.Status().Invalidate("ID", statusID)
+	q.state.Caches.Visibility.Invalidate("ItemID", statusID)
+}
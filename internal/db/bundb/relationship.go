@@ -20,6 +20,7 @@ package bundb
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -99,6 +100,20 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 		rel.Note = note.Comment
 	}
 
+	// check if the requesting account has muted the target account
+	mute, err := r.GetMute(
+		gtscontext.SetBarebones(ctx),
+		requestingAccount,
+		targetAccount,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error fetching mute: %w", err)
+	}
+	if mute != nil {
+		rel.Muting = *mute.Statuses || *mute.Boosts
+		rel.MutingNotifications = *mute.Notifications
+	}
+
 	return &rel, nil
 }
 
@@ -193,6 +208,40 @@ func (r *relationshipDB) CountAccountBlocks(ctx context.Context, accountID strin
 	return len(blockIDs), err
 }
 
+func (r *relationshipDB) CountAccountFollowsSince(ctx context.Context, accountID string, since time.Time) (int, error) {
+	var total int
+
+	// A follow request is deleted when it's accepted,
+	// so count both tables to count each follow once.
+	for _, table := range []string{"follows", "follow_requests"} {
+		count, err := r.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident(table), bun.Ident("follow")).
+			Where("? = ?", bun.Ident("follow.account_id"), accountID).
+			Where("? >= ?", bun.Ident("follow.created_at"), since).
+			Count(ctx)
+		if err != nil {
+			return 0, err
+		}
+		total += count
+	}
+
+	return total, nil
+}
+
+func (r *relationshipDB) CountMutualFollowers(ctx context.Context, accountID string, targetAccountID string) (int, error) {
+	return r.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("follows"), bun.Ident("follower"),
+			bun.Ident("follower.account_id"), bun.Ident("follow.target_account_id"),
+		).
+		Where("? = ?", bun.Ident("follow.account_id"), accountID).
+		Where("? = ?", bun.Ident("follower.target_account_id"), targetAccountID).
+		Count(ctx)
+}
+
 func (r *relationshipDB) getAccountFollowIDs(ctx context.Context, accountID string, page *paging.Page) ([]string, error) {
 	return loadPagedIDs(r.state.Caches.GTS.FollowIDs(), ">"+accountID, page, func() ([]string, error) {
 		var followIDs []string
@@ -363,6 +412,18 @@ func newSelectLocalFollowers(db *DB, accountID string) *bun.SelectQuery {
 		OrderExpr("? DESC", bun.Ident("id"))
 }
 
+// newSelectLocallyFollowedIDs returns a new select query for the IDs of all accounts followed by local accounts.
+func newSelectLocallyFollowedIDs(db *DB) *bun.SelectQuery {
+	return db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Column("follow.target_account_id").
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("follower"),
+			bun.Ident("follower.id"), bun.Ident("follow.account_id"),
+		).
+		Where("? IS NULL", bun.Ident("follower.domain"))
+}
+
 // newSelectBlocks returns a new select query for all rows in the blocks table with account_id = accountID.
 func newSelectBlocks(db *DB, accountID string) *bun.SelectQuery {
 	return db.NewSelect().
@@ -30,6 +30,19 @@ import (
 )
 
 func (r *relationshipDB) IsBlocked(ctx context.Context, sourceAccountID string, targetAccountID string) (bool, error) {
+	// Pre-screen against the source account's block filter,
+	// only falling through to the database on a possible hit.
+	filter, err := r.state.Caches.GTS.BlockFilter().Load(sourceAccountID, func() ([]string, error) {
+		return r.getAccountBlockRelationIDs(ctx, sourceAccountID)
+	})
+	if err != nil {
+		return false, gtserror.Newf("error loading block filter: %w", err)
+	}
+
+	if !filter.MayContain(targetAccountID) {
+		return false, nil
+	}
+
 	block, err := r.GetBlock(
 		gtscontext.SetBarebones(ctx),
 		sourceAccountID,
@@ -272,3 +285,31 @@ func (r *relationshipDB) DeleteAccountBlocks(ctx context.Context, accountID stri
 		Exec(ctx)
 	return err
 }
+
+// getAccountBlockRelationIDs returns the IDs of all accounts
+// blocked by, or blocking, the account with given ID.
+func (r *relationshipDB) getAccountBlockRelationIDs(ctx context.Context, accountID string) ([]string, error) {
+	var blockedIDs []string
+
+	// Select IDs of accounts blocked by account.
+	if err := r.db.NewSelect().
+		TableExpr("?", bun.Ident("blocks")).
+		ColumnExpr("?", bun.Ident("target_account_id")).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx, &blockedIDs); err != nil {
+		return nil, err
+	}
+
+	var blockingIDs []string
+
+	// Select IDs of accounts blocking account.
+	if err := r.db.NewSelect().
+		TableExpr("?", bun.Ident("blocks")).
+		ColumnExpr("?", bun.Ident("account_id")).
+		Where("? = ?", bun.Ident("target_account_id"), accountID).
+		Scan(ctx, &blockingIDs); err != nil {
+		return nil, err
+	}
+
+	return append(blockedIDs, blockingIDs...), nil
+}
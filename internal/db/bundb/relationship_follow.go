@@ -190,8 +190,14 @@ func (r *relationshipDB) PopulateFollow(ctx context.Context, follow *gtsmodel.Fo
 
 func (r *relationshipDB) PutFollow(ctx context.Context, follow *gtsmodel.Follow) error {
 	return r.state.Caches.GTS.Follow().Store(follow, func() error {
-		_, err := r.db.NewInsert().Model(follow).Exec(ctx)
-		return err
+		return r.db.RunInTx(ctx, func(tx Tx) error {
+			if _, err := tx.NewInsert().Model(follow).Exec(ctx); err != nil {
+				return err
+			}
+
+			// Count the new follow in both accounts' stats.
+			return adjustFollowStats(ctx, tx, follow, +1)
+		})
 	})
 }
 
@@ -215,17 +221,31 @@ func (r *relationshipDB) UpdateFollow(ctx context.Context, follow *gtsmodel.Foll
 	})
 }
 
-func (r *relationshipDB) deleteFollow(ctx context.Context, id string) error {
-	// Delete the follow itself using the given ID.
-	if _, err := r.db.NewDelete().
-		Table("follows").
-		Where("? = ?", bun.Ident("id"), id).
-		Exec(ctx); err != nil {
+func (r *relationshipDB) deleteFollow(ctx context.Context, follow *gtsmodel.Follow) error {
+	if err := r.db.RunInTx(ctx, func(tx Tx) error {
+		// Delete the follow itself using its ID.
+		res, err := tx.NewDelete().
+			Table("follows").
+			Where("? = ?", bun.Ident("id"), follow.ID).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		if n, _ := res.RowsAffected(); n == 0 {
+			// Already deleted
+			// by someone else.
+			return nil
+		}
+
+		// Uncount the follow from both accounts' stats.
+		return adjustFollowStats(ctx, tx, follow, -1)
+	}); err != nil {
 		return err
 	}
 
 	// Delete every list entry that used this followID.
-	if err := r.state.DB.DeleteListEntriesForFollowID(ctx, id); err != nil {
+	if err := r.state.DB.DeleteListEntriesForFollowID(ctx, follow.ID); err != nil {
 		return fmt.Errorf("deleteFollow: error deleting list entries: %w", err)
 	}
 
@@ -253,7 +273,7 @@ func (r *relationshipDB) DeleteFollow(ctx context.Context, sourceAccountID strin
 	defer r.state.Caches.GTS.Follow().Invalidate("AccountID.TargetAccountID", sourceAccountID, targetAccountID)
 
 	// Finally delete follow from DB.
-	return r.deleteFollow(ctx, follow.ID)
+	return r.deleteFollow(ctx, follow)
 }
 
 func (r *relationshipDB) DeleteFollowByID(ctx context.Context, id string) error {
@@ -273,7 +293,7 @@ func (r *relationshipDB) DeleteFollowByID(ctx context.Context, id string) error
 	defer r.state.Caches.GTS.Follow().Invalidate("ID", id)
 
 	// Finally delete follow from DB.
-	return r.deleteFollow(ctx, follow.ID)
+	return r.deleteFollow(ctx, follow)
 }
 
 func (r *relationshipDB) DeleteFollowByURI(ctx context.Context, uri string) error {
@@ -293,7 +313,7 @@ func (r *relationshipDB) DeleteFollowByURI(ctx context.Context, uri string) erro
 	defer r.state.Caches.GTS.Follow().Invalidate("URI", uri)
 
 	// Finally delete follow from DB.
-	return r.deleteFollow(ctx, follow.ID)
+	return r.deleteFollow(ctx, follow)
 }
 
 func (r *relationshipDB) DeleteAccountFollows(ctx context.Context, accountID string) error {
@@ -325,12 +345,16 @@ func (r *relationshipDB) DeleteAccountFollows(ctx context.Context, accountID str
 	// related caches correctly (e.g. visibility).
 	for _, id := range followIDs {
 		follow, err := r.GetFollowByID(ctx, id)
-		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Already gone.
+				continue
+			}
 			return err
 		}
 
 		// Delete each follow from DB.
-		if err := r.deleteFollow(ctx, follow.ID); err != nil &&
+		if err := r.deleteFollow(ctx, follow); err != nil &&
 			!errors.Is(err, db.ErrNoEntries) {
 			return err
 		}
@@ -338,3 +362,13 @@ func (r *relationshipDB) DeleteAccountFollows(ctx context.Context, accountID str
 
 	return nil
 }
+
+// adjustFollowStats adds delta to the following count of the
+// origin account of the given follow, and to the followers
+// count of its target account, as part of transaction tx.
+func adjustFollowStats(ctx context.Context, tx Tx, follow *gtsmodel.Follow, delta int) error {
+	if err := adjustAccountStats(ctx, tx, follow.AccountID, "following_count", delta); err != nil {
+		return err
+	}
+	return adjustAccountStats(ctx, tx, follow.TargetAccountID, "followers_count", delta)
+}
@@ -213,14 +213,33 @@ func (r *relationshipDB) AcceptFollowRequest(ctx context.Context, sourceAccountI
 	}
 
 	if err := r.state.Caches.GTS.Follow().Store(follow, func() error {
-		// If the follow already exists, just
-		// replace the URI with the new one.
-		_, err := r.db.
-			NewInsert().
-			Model(follow).
-			On("CONFLICT (?,?) DO UPDATE set ? = ?", bun.Ident("account_id"), bun.Ident("target_account_id"), bun.Ident("uri"), follow.URI).
-			Exec(ctx)
-		return err
+		return r.db.RunInTx(ctx, func(tx Tx) error {
+			// If the follow already exists, just
+			// replace the URI with the new one.
+			res, err := tx.
+				NewUpdate().
+				Table("follows").
+				Set("? = ?", bun.Ident("uri"), follow.URI).
+				Where("? = ?", bun.Ident("account_id"), sourceAccountID).
+				Where("? = ?", bun.Ident("target_account_id"), targetAccountID).
+				Exec(ctx)
+			if err != nil {
+				return err
+			}
+
+			if n, _ := res.RowsAffected(); n > 0 {
+				// Existing follow, it's
+				// already been counted.
+				return nil
+			}
+
+			if _, err := tx.NewInsert().Model(follow).Exec(ctx); err != nil {
+				return err
+			}
+
+			// Count the new follow in both accounts' stats.
+			return adjustFollowStats(ctx, tx, follow, +1)
+		})
 	}); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,289 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/uptrace/bun"
+)
+
+func (r *relationshipDB) GetMuteByID(ctx context.Context, id string) (*gtsmodel.UserMute, error) {
+	return r.getMute(
+		ctx,
+		"ID",
+		func(mute *gtsmodel.UserMute) error {
+			return r.db.NewSelect().Model(mute).
+				Where("? = ?", bun.Ident("user_mute.id"), id).
+				Scan(ctx)
+		},
+		id,
+	)
+}
+
+func (r *relationshipDB) GetMute(ctx context.Context, sourceAccountID string, targetAccountID string) (*gtsmodel.UserMute, error) {
+	// Pre-screen against the source account's mute filter,
+	// only falling through to the database on a possible hit.
+	filter, err := r.state.Caches.GTS.MuteFilter().Load(sourceAccountID, func() ([]string, error) {
+		return r.getAccountMuteTargetIDs(ctx, sourceAccountID)
+	})
+	if err != nil {
+		return nil, gtserror.Newf("error loading mute filter: %w", err)
+	}
+
+	if !filter.MayContain(targetAccountID) {
+		return nil, db.ErrNoEntries
+	}
+
+	return r.getMute(
+		ctx,
+		"AccountID.TargetAccountID",
+		func(mute *gtsmodel.UserMute) error {
+			return r.db.NewSelect().Model(mute).
+				Where("? = ?", bun.Ident("user_mute.account_id"), sourceAccountID).
+				Where("? = ?", bun.Ident("user_mute.target_account_id"), targetAccountID).
+				Scan(ctx)
+		},
+		sourceAccountID,
+		targetAccountID,
+	)
+}
+
+func (r *relationshipDB) GetMutesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.UserMute, error) {
+	// Preallocate slice of expected length.
+	mutes := make([]*gtsmodel.UserMute, 0, len(ids))
+
+	for _, id := range ids {
+		// Fetch mute model for this ID.
+		mute, err := r.GetMuteByID(ctx, id)
+		if err != nil {
+			log.Errorf(ctx, "error getting mute %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice.
+		mutes = append(mutes, mute)
+	}
+
+	return mutes, nil
+}
+
+func (r *relationshipDB) getMute(ctx context.Context, lookup string, dbQuery func(*gtsmodel.UserMute) error, keyParts ...any) (*gtsmodel.UserMute, error) {
+	// Fetch mute from cache with loader callback
+	mute, err := r.state.Caches.GTS.UserMute().Load(lookup, func() (*gtsmodel.UserMute, error) {
+		var mute gtsmodel.UserMute
+
+		// Not cached! Perform database query
+		if err := dbQuery(&mute); err != nil {
+			return nil, err
+		}
+
+		return &mute, nil
+	}, keyParts...)
+	if err != nil {
+		// already processed
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// Only a barebones model was requested.
+		return mute, nil
+	}
+
+	if err := r.state.DB.PopulateMute(ctx, mute); err != nil {
+		return nil, err
+	}
+
+	return mute, nil
+}
+
+func (r *relationshipDB) PopulateMute(ctx context.Context, mute *gtsmodel.UserMute) error {
+	var (
+		err  error
+		errs = gtserror.NewMultiError(2)
+	)
+
+	if mute.Account == nil {
+		// Mute origin account is not set, fetch from database.
+		mute.Account, err = r.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			mute.AccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating mute account: %w", err)
+		}
+	}
+
+	if mute.TargetAccount == nil {
+		// Mute target account is not set, fetch from database.
+		mute.TargetAccount, err = r.state.DB.GetAccountByID(
+			gtscontext.SetBarebones(ctx),
+			mute.TargetAccountID,
+		)
+		if err != nil {
+			errs.Appendf("error populating mute target account: %w", err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+func (r *relationshipDB) PutMute(ctx context.Context, mute *gtsmodel.UserMute) error {
+	return r.state.Caches.GTS.UserMute().Store(mute, func() error {
+		_, err := r.db.NewInsert().Model(mute).Exec(ctx)
+		return err
+	})
+}
+
+func (r *relationshipDB) UpdateMute(ctx context.Context, mute *gtsmodel.UserMute, columns ...string) error {
+	mute.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	return r.state.Caches.GTS.UserMute().Store(mute, func() error {
+		_, err := r.db.NewUpdate().
+			Model(mute).
+			Where("? = ?", bun.Ident("user_mute.id"), mute.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *relationshipDB) DeleteMuteByID(ctx context.Context, id string) error {
+	// Load mute into cache before attempting a delete,
+	// as we need it cached in order to trigger the invalidate
+	// callback. This in turn invalidates others.
+	_, err := r.GetMuteByID(gtscontext.SetBarebones(ctx), id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// not an issue.
+			err = nil
+		}
+		return err
+	}
+
+	// Drop this now-cached mute on return after delete.
+	defer r.state.Caches.GTS.UserMute().Invalidate("ID", id)
+
+	// Finally delete mute from DB.
+	_, err = r.db.NewDelete().
+		Table("user_mutes").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return err
+}
+
+func (r *relationshipDB) DeleteAccountMutes(ctx context.Context, accountID string) error {
+	var muteIDs []string
+
+	// Get full list of IDs.
+	if err := r.db.NewSelect().
+		Column("id").
+		Table("user_mutes").
+		WhereOr("? = ? OR ? = ?",
+			bun.Ident("account_id"),
+			accountID,
+			bun.Ident("target_account_id"),
+			accountID,
+		).
+		Scan(ctx, &muteIDs); err != nil {
+		return err
+	}
+
+	if len(muteIDs) == 0 {
+		// Nothing
+		// to delete.
+		return nil
+	}
+
+	defer func() {
+		// Invalidate all account's incoming / outoing mutes on return.
+		r.state.Caches.GTS.UserMute().Invalidate("AccountID", accountID)
+		r.state.Caches.GTS.UserMute().Invalidate("TargetAccountID", accountID)
+	}()
+
+	// Load all mutes into cache, this *really* isn't great
+	// but it is the only way we can ensure we invalidate all
+	// related caches correctly (e.g. visibility).
+	for _, id := range muteIDs {
+		_, err := r.GetMuteByID(gtscontext.SetBarebones(ctx), id)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+	}
+
+	// Finally delete all from DB.
+	_, err := r.db.NewDelete().
+		Table("user_mutes").
+		Where("? IN (?)", bun.Ident("id"), bun.In(muteIDs)).
+		Exec(ctx)
+	return err
+}
+
+func (r *relationshipDB) GetAccountMutes(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.UserMute, error) {
+	var muteIDs []string
+
+	// Mutes are only ever visible to the muting
+	// account, so rather than caching a list of IDs
+	// for each account we select them as needed.
+	if err := r.db.NewSelect().
+		TableExpr("?", bun.Ident("user_mutes")).
+		ColumnExpr("?", bun.Ident("id")).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		OrderExpr("? DESC", bun.Ident("id")).
+		Scan(ctx, &muteIDs); err != nil {
+		return nil, err
+	}
+
+	// Selected IDs are in descending
+	// order, which may not be the order
+	// that was requested by the page.
+	if page.GetOrder().Ascending() {
+		muteIDs = paging.Reverse(muteIDs)
+	}
+
+	// Page the resulting IDs.
+	muteIDs = page.Page(muteIDs)
+
+	return r.GetMutesByIDs(ctx, muteIDs)
+}
+
+// getAccountMuteTargetIDs returns the IDs of all
+// accounts muted by the account with given ID.
+func (r *relationshipDB) getAccountMuteTargetIDs(ctx context.Context, accountID string) ([]string, error) {
+	var targetIDs []string
+	if err := r.db.NewSelect().
+		TableExpr("?", bun.Ident("user_mutes")).
+		ColumnExpr("?", bun.Ident("target_account_id")).
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Scan(ctx, &targetIDs); err != nil {
+		return nil, err
+	}
+	return targetIDs, nil
+}
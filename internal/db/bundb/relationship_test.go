@@ -510,6 +510,106 @@ func (suite *RelationshipTestSuite) TestDeleteAccountBlocks() {
 	suite.Nil(block)
 }
 
+func (suite *RelationshipTestSuite) TestPutUpdateDeleteMute() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	account2 := suite.testAccounts["local_account_2"].ID
+
+	// put a boosts-only mute in first
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01HF90CBZ3AXG7FY1V4Z1TTNDN",
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Statuses:        util.Ptr(false),
+		Boosts:          util.Ptr(true),
+		Notifications:   util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// make sure the mute is in the db
+	mute, err := suite.db.GetMute(ctx, account1, account2)
+	suite.NoError(err)
+	suite.NotNil(mute)
+	suite.Equal("01HF90CBZ3AXG7FY1V4Z1TTNDN", mute.ID)
+	suite.False(*mute.Statuses)
+	suite.True(*mute.Boosts)
+	suite.False(*mute.Notifications)
+
+	// relationship should reflect the mute
+	relationship, err := suite.db.GetRelationship(ctx, account1, account2)
+	suite.NoError(err)
+	suite.True(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+
+	// update to a notifications-only mute
+	mute.Boosts = util.Ptr(false)
+	mute.Notifications = util.Ptr(true)
+	if err := suite.db.UpdateMute(ctx, mute, "boosts", "notifications"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	relationship, err = suite.db.GetRelationship(ctx, account1, account2)
+	suite.NoError(err)
+	suite.False(relationship.Muting)
+	suite.True(relationship.MutingNotifications)
+
+	// mute should be listed for account1
+	mutes, err := suite.db.GetAccountMutes(ctx, account1, nil)
+	suite.NoError(err)
+	suite.Len(mutes, 1)
+	suite.Equal(account2, mutes[0].TargetAccount.ID)
+
+	// delete the mutes by targetAccountID
+	err = suite.db.DeleteAccountMutes(ctx, account2)
+	suite.NoError(err)
+
+	// mute should be gone
+	mute, err = suite.db.GetMute(ctx, account1, account2)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(mute)
+}
+
+func (suite *RelationshipTestSuite) TestGetMuteFilterInvalidated() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	account2 := suite.testAccounts["local_account_2"].ID
+
+	// no mute yet, this also
+	// caches account1's mute filter
+	mute, err := suite.db.GetMute(ctx, account1, account2)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(mute)
+
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01HG0C3ZQ5XW7A2B8D6N4P1RJE",
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Statuses:        util.Ptr(true),
+		Boosts:          util.Ptr(true),
+		Notifications:   util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// putting the mute should have invalidated
+	// the cached filter, so it's now found
+	mute, err = suite.db.GetMute(ctx, account1, account2)
+	suite.NoError(err)
+	suite.NotNil(mute)
+
+	if err := suite.db.DeleteMuteByID(ctx, mute.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// and gone again after delete
+	mute, err = suite.db.GetMute(ctx, account1, account2)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(mute)
+}
+
 func (suite *RelationshipTestSuite) TestGetRelationship() {
 	requestingAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
@@ -959,6 +1059,35 @@ func (suite *RelationshipTestSuite) TestPutNote() {
 	suite.Equal("bar", note.Comment)
 }
 
+func (suite *RelationshipTestSuite) TestCountAccountFollowsSince() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_2"]
+
+	// Test follows were all created a while ago.
+	count, err := suite.db.CountAccountFollowsSince(ctx, account.ID, time.Time{})
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	since := time.Now().Add(-time.Hour)
+	count, err = suite.db.CountAccountFollowsSince(ctx, account.ID, since)
+	suite.NoError(err)
+	suite.Zero(count)
+
+	// Follow requests count too.
+	if err := suite.db.PutFollowRequest(ctx, &gtsmodel.FollowRequest{
+		ID:              id.NewULID(),
+		URI:             "http://localhost:8080/weeeeeeeeeeeeeeeee",
+		AccountID:       account.ID,
+		TargetAccountID: suite.testAccounts["remote_account_1"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	count, err = suite.db.CountAccountFollowsSince(ctx, account.ID, since)
+	suite.NoError(err)
+	suite.Equal(1, count)
+}
+
 func TestRelationshipTestSuite(t *testing.T) {
 	suite.Run(t, new(RelationshipTestSuite))
 }
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type savedSearchDB struct {
+	db    *DB
+	state *state.State
+}
+
+func (s *savedSearchDB) GetSavedSearchByID(ctx context.Context, id string) (*gtsmodel.SavedSearch, error) {
+	var savedSearch gtsmodel.SavedSearch
+
+	if err := s.db.
+		NewSelect().
+		Model(&savedSearch).
+		Where("? = ?", bun.Ident("saved_search.id"), id).
+		Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	if gtscontext.Barebones(ctx) {
+		// no need to fully populate.
+		return &savedSearch, nil
+	}
+
+	// Further populate the saved search fields where applicable.
+	if err := s.PopulateSavedSearch(ctx, &savedSearch); err != nil {
+		return nil, err
+	}
+
+	return &savedSearch, nil
+}
+
+func (s *savedSearchDB) GetSavedSearchesForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.SavedSearch, error) {
+	// Fetch saved search IDs for account.
+	var savedSearchIDs []string
+	if err := s.db.
+		NewSelect().
+		Table("saved_searches").
+		Column("id").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Order("id DESC").
+		Scan(ctx, &savedSearchIDs); err != nil {
+		return nil, err
+	}
+
+	return s.getSavedSearchesByIDs(ctx, savedSearchIDs)
+}
+
+func (s *savedSearchDB) GetSavedSearchesToNotify(ctx context.Context) ([]*gtsmodel.SavedSearch, error) {
+	// Fetch IDs of saved searches with notify set.
+	var savedSearchIDs []string
+	if err := s.db.
+		NewSelect().
+		Table("saved_searches").
+		Column("id").
+		Where("? = ?", bun.Ident("notify"), true).
+		Order("id ASC").
+		Scan(ctx, &savedSearchIDs); err != nil {
+		return nil, err
+	}
+
+	return s.getSavedSearchesByIDs(ctx, savedSearchIDs)
+}
+
+func (s *savedSearchDB) getSavedSearchesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.SavedSearch, error) {
+	if len(ids) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Select each saved search using its ID to ensure population.
+	savedSearches := make([]*gtsmodel.SavedSearch, 0, len(ids))
+	for _, id := range ids {
+		savedSearch, err := s.GetSavedSearchByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		savedSearches = append(savedSearches, savedSearch)
+	}
+
+	return savedSearches, nil
+}
+
+func (s *savedSearchDB) CountSavedSearchesForAccountID(ctx context.Context, accountID string) (int, error) {
+	return s.db.
+		NewSelect().
+		Table("saved_searches").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Count(ctx)
+}
+
+func (s *savedSearchDB) PopulateSavedSearch(ctx context.Context, savedSearch *gtsmodel.SavedSearch) error {
+	if savedSearch.Account != nil {
+		// Nothing to do.
+		return nil
+	}
+
+	// Saved search account is not set, fetch from the database.
+	account, err := s.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		savedSearch.AccountID,
+	)
+	if err != nil {
+		return gtserror.Newf("error populating saved search account: %w", err)
+	}
+	savedSearch.Account = account
+
+	return nil
+}
+
+func (s *savedSearchDB) PutSavedSearch(ctx context.Context, savedSearch *gtsmodel.SavedSearch) error {
+	_, err := s.db.
+		NewInsert().
+		Model(savedSearch).
+		Exec(ctx)
+	return err
+}
+
+func (s *savedSearchDB) UpdateSavedSearch(ctx context.Context, savedSearch *gtsmodel.SavedSearch, columns ...string) error {
+	savedSearch.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := s.db.
+		NewUpdate().
+		Model(savedSearch).
+		Where("? = ?", bun.Ident("saved_search.id"), savedSearch.ID).
+		Column(columns...).
+		Exec(ctx)
+	return err
+}
+
+func (s *savedSearchDB) DeleteSavedSearchByID(ctx context.Context, id string) error {
+	_, err := s.db.
+		NewDelete().
+		Table("saved_searches").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
+
+func (s *savedSearchDB) DeleteSavedSearchesForAccountID(ctx context.Context, accountID string) error {
+	_, err := s.db.
+		NewDelete().
+		Table("saved_searches").
+		Where("? = ?", bun.Ident("account_id"), accountID).
+		Exec(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+	return nil
+}
@@ -20,17 +20,21 @@ package bundb
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/oklog/ulid"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/schema"
 )
 
 // todo: currently we pass an 'offset' parameter into functions owned by this struct,
-// which is ignored.
+// which is ignored (except by ranked account searches, which have no IDs to page by).
 //
 // The idea of 'offset' is to allow callers to page through results without supplying
 // maxID or minID params; they simply use the offset as more or less a 'page number'.
@@ -67,8 +71,12 @@ type searchDB struct {
 //	WHERE (("account"."domain" IS NULL) OR ("account"."domain" != "account"."username"))
 //	AND ("account"."id" < 'ZZZZZZZZZZZZZZZZZZZZZZZZZZ')
 //	AND ("account"."id" IN (SELECT "target_account_id" FROM "follows" WHERE ("account_id" = '016T5Q3SQKBT337DAKVSKNXXW1')))
-//	AND ((SELECT LOWER("account"."username" || COALESCE("account"."display_name", '') || COALESCE("account"."note", '')) AS "account_text") LIKE '%turtle%' ESCAPE '\')
-//	ORDER BY "account"."id" DESC LIMIT 10
+//	AND ((LOWER("account"."username") LIKE '%turtle%' ESCAPE '\') OR (LOWER("account"."display_name") LIKE '%turtle%' ESCAPE '\') OR (LOWER("account"."note") LIKE '%turtle%' ESCAPE '\'))
+//	ORDER BY (CASE WHEN LOWER("account"."username") = 'turtle' THEN 16 [...] END) DESC, "account"."id" DESC LIMIT 10
+//
+// When neither maxID nor minID are given, results are ranked by relevance
+// to the query (see relevance()), and offset is used to page through them.
+// Otherwise, results are paged through by ID, and offset is ignored.
 func (s *searchDB) SearchForAccounts(
 	ctx context.Context,
 	accountID string,
@@ -77,6 +85,7 @@ func (s *searchDB) SearchForAccounts(
 	minID string,
 	limit int,
 	following bool,
+	interacted bool,
 	offset int,
 ) ([]*gtsmodel.Account, error) {
 	// Ensure reasonable
@@ -84,10 +93,15 @@ func (s *searchDB) SearchForAccounts(
 		limit = 0
 	}
 
+	if offset < 0 {
+		offset = 0
+	}
+
 	// Make educated guess for slice size
 	var (
 		accountIDs  = make([]string, 0, limit)
 		frontToBack = true
+		ranked      = (maxID == "" && minID == "")
 	)
 
 	q := s.db.
@@ -119,31 +133,75 @@ func (s *searchDB) SearchForAccounts(
 		frontToBack = false
 	}
 
-	if following {
+	// Respect remote accounts that opted out of being
+	// found by search, unless accountID follows them
+	// anyway. Accounts that don't say are included.
+	q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("? IS NULL", bun.Ident("account.domain")).
+			WhereOr("? IS NULL", bun.Ident("account.indexable")).
+			WhereOr("? = ?", bun.Ident("account.indexable"), true).
+			WhereOr("? IN (?)", bun.Ident("account.id"), s.followedAccounts(accountID))
+	})
+
+	switch {
+	case following && interacted:
+		// Select only from accounts followed by
+		// accountID, or recently interacted with.
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.Where("? IN (?)", bun.Ident("account.id"), s.followedAccounts(accountID))
+			return s.whereInteracted(q, accountID)
+		})
+
+	case following:
 		// Select only from accounts followed by accountID.
 		q = q.Where(
 			"? IN (?)",
 			bun.Ident("account.id"),
 			s.followedAccounts(accountID),
 		)
-	}
 
-	// Select account text as subquery.
-	accountTextSubq := s.accountText(following)
+	case interacted:
+		// Select only from accounts recently
+		// interacted with by accountID.
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return s.whereInteracted(q, accountID)
+		})
+	}
 
-	// Search using LIKE for matches of query
-	// string within accountText subquery.
-	q = whereLike(q, accountTextSubq, query)
+	// Search using LIKE for matches of query string
+	// within account username, display name or note.
+	// Columns are matched separately, rather than as
+	// one concatenation, so that Postgres can use the
+	// trigram index of each of them where available.
+	query = strings.ToLower(query)
+	contains := `%` + likeEscaper.Replace(query) + `%`
+	q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.
+			Where("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.username"), contains, `\`).
+			WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.display_name"), contains, `\`).
+			WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.note"), contains, `\`)
+	})
 
 	if limit > 0 {
 		// Limit amount of accounts returned.
 		q = q.Limit(limit)
 	}
 
-	if frontToBack {
+	switch {
+	case ranked:
+		// Most relevant first. There are no IDs
+		// to page by, so page using offset instead.
+		q = q.
+			OrderExpr("? DESC", s.relevance(accountID, query)).
+			Order("account.id DESC").
+			Offset(offset)
+
+	case frontToBack:
 		// Page down.
 		q = q.Order("account.id DESC")
-	} else {
+
+	default:
 		// Page up.
 		q = q.Order("account.id ASC")
 	}
@@ -191,61 +249,100 @@ func (s *searchDB) followedAccounts(accountID string) *bun.SelectQuery {
 		Where("? = ?", bun.Ident("follow.account_id"), accountID)
 }
 
-// statusText returns a subquery that selects a concatenation
-// of account username and display name as "account_text". If
-// `following` is true, then account note will also be included
-// in the concatenation.
-func (s *searchDB) accountText(following bool) *bun.SelectQuery {
-	var (
-		accountText = s.db.NewSelect()
-		query       string
-		args        []interface{}
-	)
-
-	if following {
-		// If querying for accounts we follow,
-		// include note in text search params.
-		args = []interface{}{
-			bun.Ident("account.username"),
-			bun.Ident("account.display_name"), "",
-			bun.Ident("account.note"), "",
-			bun.Ident("account_text"),
-		}
-	} else {
-		// If querying for accounts we're not following,
-		// don't include note in text search params.
-		args = []interface{}{
-			bun.Ident("account.username"),
-			bun.Ident("account.display_name"), "",
-			bun.Ident("account_text"),
-		}
-	}
-
-	// SQLite and Postgres use different syntaxes for
-	// concatenation, and we also need to use a
-	// different number of placeholders depending on
-	// following/not following. COALESCE calls ensure
-	// that we're not trying to concatenate null values.
-	d := s.db.Dialect().Name()
-	switch {
-
-	case d == dialect.SQLite && following:
-		query = "LOWER(? || COALESCE(?, ?) || COALESCE(?, ?)) AS ?"
-
-	case d == dialect.SQLite && !following:
-		query = "LOWER(? || COALESCE(?, ?)) AS ?"
+// recentInteractions is the number of most recent mentions
+// and faves, in each direction, that are considered when
+// looking for accounts that an account has interacted with.
+const recentInteractions = 200
+
+// whereInteracted ORs onto the given query conditions that
+// select only accounts that accountID recently interacted
+// with: by mentioning them, being mentioned by them, or by
+// faving their statuses. Each subquery is covered by an
+// index on (account ID column, id), see the migration
+// 20231120100000_account_interaction_indexes.
+func (s *searchDB) whereInteracted(q *bun.SelectQuery, accountID string) *bun.SelectQuery {
+	// Accounts recently mentioned by accountID.
+	mentioned := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+		Column("mention.target_account_id").
+		Where("? = ?", bun.Ident("mention.origin_account_id"), accountID).
+		Order("mention.id DESC").
+		Limit(recentInteractions)
+
+	// Accounts that recently mentioned accountID.
+	mentionedBy := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+		Column("mention.origin_account_id").
+		Where("? = ?", bun.Ident("mention.target_account_id"), accountID).
+		Order("mention.id DESC").
+		Limit(recentInteractions)
+
+	// Accounts whose statuses accountID recently faved.
+	faved := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		Column("status_fave.target_account_id").
+		Where("? = ?", bun.Ident("status_fave.account_id"), accountID).
+		Order("status_fave.id DESC").
+		Limit(recentInteractions)
+
+	return q.
+		WhereOr("? IN (?)", bun.Ident("account.id"), mentioned).
+		WhereOr("? IN (?)", bun.Ident("account.id"), mentionedBy).
+		WhereOr("? IN (?)", bun.Ident("account.id"), faved)
+}
 
-	case d == dialect.PG && following:
-		query = "LOWER(CONCAT(?, COALESCE(?, ?), COALESCE(?, ?))) AS ?"
+// relevance returns an expression scoring the relevance of an account
+// to the given (lowercase) query, when searched for by accountID.
+//
+// Matches in the username weigh more than in the display name, which
+// weigh more than in the note, and matches at the start weigh more than
+// elsewhere. Accounts followed by accountID, or that accountID has
+// interacted with by mentioning them or faving their statuses, are
+// boosted, as they're more likely to be what accountID is looking for.
+func (s *searchDB) relevance(accountID string, query string) schema.QueryWithArgs {
+	var (
+		prefix   = likeEscaper.Replace(query) + `%`
+		contains = `%` + prefix
 
-	case d == dialect.PG && !following:
-		query = "LOWER(CONCAT(?, COALESCE(?, ?))) AS ?"
+		username    = bun.Ident("account.username")
+		displayName = bun.Ident("account.display_name")
+		note        = bun.Ident("account.note")
+	)
 
-	default:
-		panic("db conn was neither pg not sqlite")
-	}
+	// Select accounts mentioned by accountID.
+	mentioned := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("mentions"), bun.Ident("mention")).
+		ColumnExpr("1").
+		Where("? = ?", bun.Ident("mention.origin_account_id"), accountID).
+		Where("? = ?", bun.Ident("mention.target_account_id"), bun.Ident("account.id"))
 
-	return accountText.ColumnExpr(query, args...)
+	// Select accounts whose statuses accountID faved.
+	faved := s.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		ColumnExpr("1").
+		Where("? = ?", bun.Ident("status_fave.account_id"), accountID).
+		Where("? = ?", bun.Ident("status_fave.target_account_id"), bun.Ident("account.id"))
+
+	const score = "(" +
+		"CASE WHEN LOWER(?) = ? THEN 16 WHEN LOWER(?) LIKE ? ESCAPE ? THEN 8 WHEN LOWER(?) LIKE ? ESCAPE ? THEN 4 ELSE 0 END + " +
+		"CASE WHEN LOWER(?) LIKE ? ESCAPE ? THEN 4 WHEN LOWER(?) LIKE ? ESCAPE ? THEN 2 ELSE 0 END + " +
+		"CASE WHEN LOWER(?) LIKE ? ESCAPE ? THEN 1 ELSE 0 END + " +
+		"CASE WHEN ? IN (?) THEN 8 ELSE 0 END + " +
+		"CASE WHEN EXISTS (?) OR EXISTS (?) THEN 4 ELSE 0 END" +
+		")"
+
+	return schema.SafeQuery(score, []interface{}{
+		username, query, username, prefix, `\`, username, contains, `\`,
+		displayName, prefix, `\`, displayName, contains, `\`,
+		note, contains, `\`,
+		bun.Ident("account.id"), s.followedAccounts(accountID),
+		mentioned, faved,
+	})
 }
 
 // Query example (SQLite):
@@ -257,10 +354,18 @@ func (s *searchDB) accountText(following bool) *bun.SelectQuery {
 //	AND ("status"."id" < 'ZZZZZZZZZZZZZZZZZZZZZZZZZZ')
 //	AND ((SELECT LOWER("status"."content" || COALESCE("status"."content_warning", '')) AS "status_text") LIKE '%hello%' ESCAPE '\')
 //	ORDER BY "status"."id" DESC LIMIT 10
+//
+// With operators (from:, before:, after:, has:media) the following may also be added:
+//
+//	AND ("status"."account_id" = '01F8MH1H7YV1Z7D2C8K2730QBF')
+//	AND ("status"."id" < '01GQ4K2TE00000000000000000')
+//	AND ("status"."id" > '01GQ4K2TE0ZZZZZZZZZZZZZZZZ')
+//	AND (EXISTS (SELECT 1 FROM "media_attachments" AS "media_attachment" WHERE ("media_attachment"."status_id" = "status"."id")))
 func (s *searchDB) SearchForStatuses(
 	ctx context.Context,
 	accountID string,
 	query string,
+	operators db.StatusSearchOperators,
 	maxID string,
 	minID string,
 	limit int,
@@ -306,12 +411,39 @@ func (s *searchDB) SearchForStatuses(
 		frontToBack = false
 	}
 
-	// Select status text as subquery.
-	statusTextSubq := s.statusText()
+	// Apply any search operators. Time bounds are
+	// applied to status IDs rather than created_at,
+	// since IDs are ULIDs generated from creation
+	// time and are the primary key, so indexed.
+	if operators.FromAccountID != "" {
+		q = q.Where("? = ?", bun.Ident("status.account_id"), operators.FromAccountID)
+	}
 
-	// Search using LIKE for matches of query
-	// string within statusText subquery.
-	q = whereLike(q, statusTextSubq, query)
+	if !operators.Before.IsZero() {
+		q = q.Where("? < ?", bun.Ident("status.id"), ulidTimePrefix(operators.Before)+lowestEntropy)
+	}
+
+	if !operators.After.IsZero() {
+		q = q.Where("? > ?", bun.Ident("status.id"), ulidTimePrefix(operators.After)+highestEntropy)
+	}
+
+	if operators.HasMedia {
+		q = q.Where("EXISTS (?)", s.db.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+			ColumnExpr("1").
+			Where("? = ?", bun.Ident("media_attachment.status_id"), bun.Ident("status.id")),
+		)
+	}
+
+	if query != "" {
+		// Select status text as subquery.
+		statusTextSubq := s.statusText()
+
+		// Search using LIKE for matches of query
+		// string within statusText subquery.
+		q = whereLike(q, statusTextSubq, query)
+	}
 
 	if limit > 0 {
 		// Limit amount of statuses returned.
@@ -359,6 +491,19 @@ func (s *searchDB) SearchForStatuses(
 	return statuses, nil
 }
 
+const (
+	lowestEntropy  = "0000000000000000"
+	highestEntropy = "ZZZZZZZZZZZZZZZZ"
+)
+
+// ulidTimePrefix returns the 10 character timestamp
+// part of a ULID generated at the given time. Adding
+// lowestEntropy or highestEntropy to it gives the
+// lowest or highest ULID possible at that time.
+func ulidTimePrefix(t time.Time) string {
+	return ulid.MustNew(ulid.Timestamp(t), nil).String()[:10]
+}
+
 // statusText returns a subquery that selects a concatenation
 // of status content and content warning as "status_text".
 func (s *searchDB) statusText() *bun.SelectQuery {
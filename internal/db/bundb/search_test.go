@@ -20,9 +20,12 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type SearchTestSuite struct {
@@ -32,7 +35,7 @@ type SearchTestSuite struct {
 func (suite *SearchTestSuite) TestSearchAccountsTurtleAny() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "turtle", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "turtle", "", "", 10, false, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -40,7 +43,7 @@ func (suite *SearchTestSuite) TestSearchAccountsTurtleAny() {
 func (suite *SearchTestSuite) TestSearchAccountsTurtleFollowing() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "turtle", "", "", 10, true, 0)
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "turtle", "", "", 10, true, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -48,7 +51,7 @@ func (suite *SearchTestSuite) TestSearchAccountsTurtleFollowing() {
 func (suite *SearchTestSuite) TestSearchAccountsPostFollowing() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "post", "", "", 10, true, 0)
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "post", "", "", 10, true, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
@@ -56,27 +59,168 @@ func (suite *SearchTestSuite) TestSearchAccountsPostFollowing() {
 func (suite *SearchTestSuite) TestSearchAccountsPostAny() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "post", "", "", 10, false, 0)
-	suite.NoError(err, db.ErrNoEntries)
-	suite.Empty(accounts)
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "post", "", "", 10, false, false, 0)
+	suite.NoError(err)
+	suite.Len(accounts, 2)
+}
+
+func (suite *SearchTestSuite) TestSearchAccountsRanked() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Both accounts followed by local_account_1
+	// should be ranked above the rest.
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "a", "", "", 2, false, false, 0)
+	suite.NoError(err)
+	suite.Len(accounts, 2)
+	suite.ElementsMatch(
+		[]string{
+			suite.testAccounts["admin_account"].ID,
+			suite.testAccounts["local_account_2"].ID,
+		},
+		[]string{accounts[0].ID, accounts[1].ID},
+	)
+
+	// Offset should skip past them.
+	accounts, err = suite.db.SearchForAccounts(context.Background(), testAccount.ID, "a", "", "", 10, false, false, 2)
+	suite.NoError(err)
+	suite.NotEmpty(accounts)
+	for _, account := range accounts {
+		suite.NotEqual(suite.testAccounts["admin_account"].ID, account.ID)
+		suite.NotEqual(suite.testAccounts["local_account_2"].ID, account.ID)
+	}
 }
 
 func (suite *SearchTestSuite) TestSearchAccountsFossAny() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "foss", "", "", 10, false, 0)
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "foss", "", "", 10, false, false, 0)
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+}
+
+func (suite *SearchTestSuite) TestSearchAccountsFossInteracted() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Not followed by local_account_1...
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "foss", "", "", 10, true, false, 0)
+	suite.NoError(err)
+	suite.Empty(accounts)
+
+	// ...but mentioned by them.
+	accounts, err = suite.db.SearchForAccounts(context.Background(), testAccount.ID, "foss", "", "", 10, false, true, 0)
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+
+	// Either is enough when both are set.
+	accounts, err = suite.db.SearchForAccounts(context.Background(), testAccount.ID, "foss", "", "", 10, true, true, 0)
+	suite.NoError(err)
+	suite.Len(accounts, 1)
+}
+
+func (suite *SearchTestSuite) TestSearchAccountsNotIndexable() {
+	var (
+		ctx         = context.Background()
+		testAccount = suite.testAccounts["local_account_1"]
+		foss        = new(gtsmodel.Account)
+	)
+
+	// Remote account opts out of search.
+	*foss = *suite.testAccounts["remote_account_1"]
+	foss.Indexable = util.Ptr(false)
+	if err := suite.db.UpdateAccount(ctx, foss, "indexable"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	accounts, err := suite.db.SearchForAccounts(ctx, testAccount.ID, "foss", "", "", 10, false, false, 0)
+	suite.NoError(err)
+	suite.Empty(accounts)
+
+	// Opting back in makes it searchable again.
+	foss.Indexable = util.Ptr(true)
+	if err := suite.db.UpdateAccount(ctx, foss, "indexable"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	accounts, err = suite.db.SearchForAccounts(ctx, testAccount.ID, "foss", "", "", 10, false, false, 0)
 	suite.NoError(err)
 	suite.Len(accounts, 1)
 }
 
+func (suite *SearchTestSuite) TestSearchAccountsInteracted() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// local_account_1 mentioned remote_account_1, was mentioned
+	// by admin_account, and faved statuses of local_account_2.
+	accounts, err := suite.db.SearchForAccounts(context.Background(), testAccount.ID, "a", "", "", 10, false, true, 0)
+	suite.NoError(err)
+
+	accountIDs := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		accountIDs = append(accountIDs, account.ID)
+	}
+
+	suite.ElementsMatch(
+		[]string{
+			suite.testAccounts["admin_account"].ID,
+			suite.testAccounts["local_account_2"].ID,
+			suite.testAccounts["remote_account_1"].ID,
+		},
+		accountIDs,
+	)
+}
+
 func (suite *SearchTestSuite) TestSearchStatuses() {
 	testAccount := suite.testAccounts["local_account_1"]
 
-	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hello", "", "", 10, 0)
+	statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, "hello", db.StatusSearchOperators{}, "", "", 10, 0)
 	suite.NoError(err)
 	suite.Len(statuses, 1)
 }
 
+func (suite *SearchTestSuite) TestSearchStatusesOperators() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	for _, test := range []struct {
+		query     string
+		operators db.StatusSearchOperators
+		expected  int
+	}{
+		{
+			// No text, only statuses from this account.
+			operators: db.StatusSearchOperators{FromAccountID: testAccount.ID},
+			expected:  5,
+		},
+		{
+			// No text, only statuses from someone else.
+			operators: db.StatusSearchOperators{FromAccountID: suite.testAccounts["admin_account"].ID},
+			expected:  1,
+		},
+		{
+			// Only statuses with media.
+			operators: db.StatusSearchOperators{HasMedia: true},
+			expected:  1,
+		},
+		{
+			// Text + before + after.
+			query: "hello",
+			operators: db.StatusSearchOperators{
+				After:  time.Date(2021, 6, 20, 0, 0, 0, 0, time.UTC),
+				Before: time.Date(2021, 6, 21, 0, 0, 0, 0, time.UTC),
+			},
+			expected: 1,
+		},
+		{
+			// Nothing before the fediverse.
+			operators: db.StatusSearchOperators{Before: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+			expected:  0,
+		},
+	} {
+		statuses, err := suite.db.SearchForStatuses(context.Background(), testAccount.ID, test.query, test.operators, "", "", 20, 0)
+		suite.NoError(err)
+		suite.Len(statuses, test.expected, "%+v", test.operators)
+	}
+}
+
 func (suite *SearchTestSuite) TestSearchTags() {
 	// Search with full tag string.
 	tags, err := suite.db.SearchForTags(context.Background(), "welcome", "", "", 10, 0)
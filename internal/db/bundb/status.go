@@ -66,6 +66,29 @@ func (s *statusDB) GetStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmo
 	return statuses, nil
 }
 
+func (s *statusDB) GetStatuses(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Status, error) {
+	statusIDs := make([]string, 0, limit)
+
+	q := s.db.NewSelect().
+		Table("statuses").
+		Column("id").
+		Order("id DESC")
+
+	if maxID != "" {
+		q = q.Where("id < ?", maxID)
+	}
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (s *statusDB) GetStatusByURI(ctx context.Context, uri string) (*gtsmodel.Status, error) {
 	return s.getStatus(
 		ctx,
@@ -325,8 +348,12 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) error
 			}
 
 			// Finally, insert the status
-			_, err := tx.NewInsert().Model(status).Exec(ctx)
-			return err
+			if _, err := tx.NewInsert().Model(status).Exec(ctx); err != nil {
+				return err
+			}
+
+			// Count the new status in its author's stats.
+			return adjustAccountStats(ctx, tx, status.AccountID, "statuses_count", +1)
 		})
 	})
 }
@@ -406,7 +433,7 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 	// Load status into cache before attempting a delete,
 	// as we need it cached in order to trigger the invalidate
 	// callback. This in turn invalidates others.
-	_, err := s.GetStatusByID(
+	status, err := s.GetStatusByID(
 		gtscontext.SetBarebones(ctx),
 		id,
 	)
@@ -440,18 +467,91 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) error {
 		}
 
 		// delete the status itself
-		if _, err := tx.
+		res, err := tx.
 			NewDelete().
 			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 			Where("? = ?", bun.Ident("status.id"), id).
-			Exec(ctx); err != nil {
+			Exec(ctx)
+		if err != nil {
 			return err
 		}
 
-		return nil
+		if n, _ := res.RowsAffected(); n == 0 || status == nil {
+			// Nothing was deleted, or we
+			// don't know whose it was.
+			return nil
+		}
+
+		// Uncount the status from its author's stats.
+		return adjustAccountStats(ctx, tx, status.AccountID, "statuses_count", -1)
 	})
 }
 
+func (s *statusDB) GetStatusesForCountsRefresh(ctx context.Context, fetchedBefore time.Time, createdAfter time.Time, limit int) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	// Subquery selecting IDs of statuses faved by local accounts.
+	localFaves := s.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_faves"), bun.Ident("status_fave")).
+		Column("status_fave.status_id").
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("accounts"), bun.Ident("account"),
+			bun.Ident("account.id"), bun.Ident("status_fave.account_id"),
+		).
+		Where("? IS NULL", bun.Ident("account.domain"))
+
+	// Subquery selecting IDs of statuses boosted by local accounts.
+	localBoosts := s.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("boost")).
+		Column("boost.boost_of_id").
+		Where("? = ?", bun.Ident("boost.local"), true).
+		Where("? IS NOT NULL", bun.Ident("boost.boost_of_id"))
+
+	if err := s.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.local"), false).
+		Where("? > ?", bun.Ident("status.created_at"), createdAfter).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("status.counts_fetched_at")).
+				WhereOr("? < ?", bun.Ident("status.counts_fetched_at"), fetchedBefore)
+		}).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? IN (?)", bun.Ident("status.id"), localFaves).
+				WhereOr("? IN (?)", bun.Ident("status.id"), localBoosts)
+		}).
+		Order("status.id DESC").
+		Limit(limit).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
+func (s *statusDB) GetStaleFollowedStatuses(ctx context.Context, fetchedBefore time.Time, createdAfter time.Time, limit int) ([]*gtsmodel.Status, error) {
+	var statusIDs []string
+
+	if err := s.db.NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		Column("status.id").
+		Where("? = ?", bun.Ident("status.local"), false).
+		Where("? > ?", bun.Ident("status.created_at"), createdAfter).
+		Where("? < ?", bun.Ident("status.fetched_at"), fetchedBefore).
+		Where("? IN (?)", bun.Ident("status.account_id"), newSelectLocallyFollowedIDs(s.db)).
+		Order("status.id DESC").
+		Limit(limit).
+		Scan(ctx, &statusIDs); err != nil {
+		return nil, err
+	}
+
+	// Convert status IDs into status objects.
+	return s.GetStatusesByIDs(ctx, statusIDs)
+}
+
 func (s *statusDB) GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error) {
 	var statusIDs []string
 
@@ -212,6 +212,57 @@ func (suite *StatusTestSuite) TestUpdateStatus() {
 	suite.True(updated.PinnedAt.IsZero())
 }
 
+func (suite *StatusTestSuite) TestGetStatusesForCountsRefresh() {
+	var (
+		ctx          = context.Background()
+		now          = time.Now()
+		faver        = suite.testAccounts["local_account_1"]
+		remoteStatus = suite.testStatuses["remote_account_1_status_1"]
+	)
+
+	refreshIDs := func() []string {
+		statuses, err := suite.db.GetStatusesForCountsRefresh(ctx, now, time.Time{}, 20)
+		suite.NoError(err)
+
+		ids := make([]string, 0, len(statuses))
+		for _, status := range statuses {
+			suite.False(*status.Local)
+			ids = append(ids, status.ID)
+		}
+		return ids
+	}
+
+	// Remote status isn't due, no local
+	// account has faved or boosted it.
+	suite.NotContains(refreshIDs(), remoteStatus.ID)
+
+	// Fave it from a local account.
+	if err := suite.db.PutStatusFave(ctx, &gtsmodel.StatusFave{
+		ID:              "01HG2A5B8ZK6N3W4Q7R9T1V2XY",
+		AccountID:       faver.ID,
+		TargetAccountID: remoteStatus.AccountID,
+		StatusID:        remoteStatus.ID,
+		URI:             "http://localhost:8080/users/the_mighty_zork/liked/01HG2A5B8ZK6N3W4Q7R9T1V2XY",
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Now it's due for a counts refresh.
+	suite.Contains(refreshIDs(), remoteStatus.ID)
+
+	// Mark its counts as just fetched.
+	status := new(gtsmodel.Status)
+	*status = *remoteStatus
+	status.RemoteFavesCount = 5
+	status.CountsFetchedAt = now.Add(time.Minute)
+	if err := suite.db.UpdateStatus(ctx, status, "remote_faves_count", "counts_fetched_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// No longer due.
+	suite.NotContains(refreshIDs(), remoteStatus.ID)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusReactionTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *StatusReactionTestSuite) TestPutGetDeleteStatusReaction() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	testEmoji := suite.testEmojis["rainbow"]
+
+	for _, reaction := range []*gtsmodel.StatusReaction{
+		{
+			ID:              "01HDJ6B2A1QKX3V9M0E8T7C4WN",
+			AccountID:       testAccount.ID,
+			TargetAccountID: testStatus.AccountID,
+			StatusID:        testStatus.ID,
+			Content:         "🐢",
+			URI:             "http://localhost:8080/users/the_mighty_zork/liked/01HDJ6B2A1QKX3V9M0E8T7C4WN",
+		},
+		{
+			ID:              "01HDJ6B9R5W8Y2N4J7P0F3H6KD",
+			AccountID:       testAccount.ID,
+			TargetAccountID: testStatus.AccountID,
+			StatusID:        testStatus.ID,
+			Content:         ":rainbow:",
+			EmojiID:         testEmoji.ID,
+			URI:             "http://localhost:8080/users/the_mighty_zork/liked/01HDJ6B9R5W8Y2N4J7P0F3H6KD",
+		},
+	} {
+		if err := suite.db.PutStatusReaction(ctx, reaction); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	// The same account can't react twice with the same emoji.
+	err := suite.db.PutStatusReaction(ctx, &gtsmodel.StatusReaction{
+		ID:              "01HDJ6C4G7T1B5X8Q2Z9L3M6RS",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		StatusID:        testStatus.ID,
+		Content:         "🐢",
+		URI:             "http://localhost:8080/users/the_mighty_zork/liked/01HDJ6C4G7T1B5X8Q2Z9L3M6RS",
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+
+	reactions, err := suite.db.GetStatusReactions(ctx, testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reactions, 2)
+	for _, reaction := range reactions {
+		suite.NotNil(reaction.Account)
+		suite.NotNil(reaction.TargetAccount)
+		suite.NotNil(reaction.Status)
+	}
+	suite.Nil(reactions[0].Emoji)
+	suite.NotNil(reactions[1].Emoji)
+
+	reaction, err := suite.db.GetStatusReaction(ctx, testAccount.ID, testStatus.ID, ":rainbow:")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("01HDJ6B9R5W8Y2N4J7P0F3H6KD", reaction.ID)
+
+	if err := suite.db.DeleteStatusReactionsForStatus(ctx, testStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	reactions, err = suite.db.GetStatusReactions(ctx, testStatus.ID)
+	suite.NoError(err)
+	suite.Empty(reactions)
+
+	_, err = suite.db.GetStatusReaction(ctx, testAccount.ID, testStatus.ID, "🐢")
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestStatusReactionTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusReactionTestSuite))
+}
@@ -0,0 +1,356 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package bundb
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// streamNotifyChannel is the postgres NOTIFY channel
+	// on which streamed events are sent / received.
+	streamNotifyChannel = "gts_stream"
+
+	// streamNotifyChunkSize is the max number of bytes of an encoded
+	// event sent per NOTIFY. Events are frequently larger than the
+	// postgres payload limit of 8000 bytes, so are split into chunks,
+	// which once base64 encoded fit comfortably within the limit.
+	streamNotifyChunkSize = 5000
+
+	// streamNotifyMaxPending is the max number of events we'll
+	// queue while unable to send, after which they're dropped.
+	streamNotifyMaxPending = 10_000
+)
+
+// streamNotifyMessage is the JSON encoded
+// message, split over one or more payloads.
+type streamNotifyMessage struct {
+	AccountID string        `json:"a,omitempty"`
+	Event     *stream.Event `json:"e"`
+}
+
+// streamNotifyPayload is the JSON payload
+// sent on the stream notify channel.
+type streamNotifyPayload struct {
+	// Node is the unique ID of the sending process,
+	// used to ignore our own notifications.
+	Node string `json:"n"`
+
+	// Index of this chunk of the
+	// message, and the total count.
+	Index int `json:"i"`
+	Total int `json:"t"`
+
+	// Data is the base64 encoded chunk of the message.
+	Data string `json:"d"`
+}
+
+// StreamNotifier implements stream.Relay by passing streamed events on
+// to other GoToSocial processes using postgres LISTEN / NOTIFY, and
+// delivers events received from other processes to local streams.
+type StreamNotifier struct {
+	deliver func(accountID string, e *stream.Event)
+	node    string
+	cfg     *pgx.ConnConfig
+	cancel  context.CancelFunc
+
+	// partial contains chunks of
+	// messages received so far, by
+	// the node ID of the sender.
+	partial map[string][]string
+
+	pending []streamNotifyMessage
+	dropped int
+	signal  chan struct{}
+	mu      sync.Mutex
+}
+
+// StartStreamNotifier starts listening for, and sending, streamed events on
+// dedicated postgres connections, passing received events to deliver.
+func StartStreamNotifier(deliver func(accountID string, e *stream.Event)) (*StreamNotifier, error) {
+	cfg, err := deriveBunDBPGOptions() //nolint:contextcheck
+	if err != nil {
+		return nil, gtserror.Newf("could not create postgres options: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	n := &StreamNotifier{
+		deliver: deliver,
+		node:    id.NewULID(),
+		cfg:     cfg,
+		cancel:  cancel,
+		partial: make(map[string][]string),
+		signal:  make(chan struct{}, 1),
+	}
+
+	go n.listen(ctx)
+	go n.send(ctx)
+
+	log.Infof(ctx, "relaying streamed events on postgres channel %s", streamNotifyChannel)
+	return n, nil
+}
+
+// Stop will stop the stream notifier.
+func (n *StreamNotifier) Stop() {
+	n.cancel()
+}
+
+// Relay implements stream.Relay{}, queueing
+// the event to be sent on the next send loop.
+func (n *StreamNotifier) Relay(accountID string, e *stream.Event) {
+	n.mu.Lock()
+	if len(n.pending) >= streamNotifyMaxPending {
+		// Too many queued, drop this one.
+		n.dropped++
+	} else {
+		n.pending = append(n.pending, streamNotifyMessage{
+			AccountID: accountID,
+			Event:     e,
+		})
+	}
+	n.mu.Unlock()
+
+	select {
+	case n.signal <- struct{}{}:
+	default:
+	}
+}
+
+// send loops, sending pending streamed
+// events until the context is cancelled.
+func (n *StreamNotifier) send(ctx context.Context) {
+	var conn *pgx.Conn
+	var backoff time.Duration
+
+	defer func() {
+		if conn != nil {
+			_ = conn.Close(context.Background())
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-n.signal:
+		}
+
+		for {
+			if conn == nil {
+				var err error
+				conn, err = pgx.ConnectConfig(ctx, n.cfg)
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+
+					backoff = util.NextBackoff(backoff, cacheNotifyRetry)
+					log.Errorf(ctx, "error connecting to send streamed events, retrying in %s: %v", backoff, err)
+
+					if !util.SleepCtx(ctx, backoff) {
+						return
+					}
+					continue
+				}
+			}
+
+			backoff = 0
+
+			// Take the currently pending events.
+			n.mu.Lock()
+			msgs, dropped := n.pending, n.dropped
+			n.pending, n.dropped = nil, 0
+			n.mu.Unlock()
+
+			if dropped > 0 {
+				log.Warnf(ctx, "dropped %d streamed events while unable to send", dropped)
+			}
+
+			if len(msgs) == 0 {
+				break
+			}
+
+			for i, msg := range msgs {
+				if err := n.sendMessage(ctx, conn, msg); err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+
+					// Streamed events are only of use when they're
+					// timely, so unlike cache invalidations we don't
+					// retry, just drop what remains and reconnect.
+					log.Errorf(ctx, "error sending streamed events, dropped %d: %v", len(msgs)-i, err)
+
+					_ = conn.Close(context.Background())
+					conn = nil
+					break
+				}
+			}
+		}
+	}
+}
+
+// sendMessage encodes and sends the message on the stream notify
+// channel, split into as many chunks as needed. Chunks are sent
+// in order on a single connection, and so are received in order.
+func (n *StreamNotifier) sendMessage(ctx context.Context, conn *pgx.Conn, msg streamNotifyMessage) error {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		return gtserror.Newf("error encoding message: %w", err)
+	}
+
+	total := (len(b) + streamNotifyChunkSize - 1) / streamNotifyChunkSize
+
+	for i := 0; i < total; i++ {
+		chunk := b[i*streamNotifyChunkSize:]
+		if len(chunk) > streamNotifyChunkSize {
+			chunk = chunk[:streamNotifyChunkSize]
+		}
+
+		p, err := json.Marshal(streamNotifyPayload{
+			Node:  n.node,
+			Index: i,
+			Total: total,
+			Data:  base64.StdEncoding.EncodeToString(chunk),
+		})
+		if err != nil {
+			return gtserror.Newf("error encoding payload: %w", err)
+		}
+
+		if _, err := conn.Exec(ctx, "SELECT pg_notify($1, $2)", streamNotifyChannel, string(p)); err != nil {
+			return gtserror.Newf("error notifying: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// listen loops, delivering streamed events received
+// from other processes until context is cancelled.
+func (n *StreamNotifier) listen(ctx context.Context) {
+	var backoff time.Duration
+
+	for {
+		err := n.listenConn(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+
+		backoff = util.NextBackoff(backoff, cacheNotifyRetry)
+		log.Errorf(ctx, "error listening for streamed events, retrying in %s: %v", backoff, err)
+
+		// Drop partially received messages,
+		// we may have missed their remainder.
+		clear(n.partial)
+
+		if !util.SleepCtx(ctx, backoff) {
+			return
+		}
+	}
+}
+
+// listenConn opens a new connection and listens for
+// streamed events on it until an error is encountered.
+func (n *StreamNotifier) listenConn(ctx context.Context) error {
+	conn, err := pgx.ConnectConfig(ctx, n.cfg)
+	if err != nil {
+		return gtserror.Newf("error connecting: %w", err)
+	}
+
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+streamNotifyChannel); err != nil {
+		return gtserror.Newf("error listening: %w", err)
+	}
+
+	for {
+		notif, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return gtserror.Newf("error waiting for notification: %w", err)
+		}
+
+		var payload streamNotifyPayload
+		if err := json.Unmarshal([]byte(notif.Payload), &payload); err != nil {
+			log.Warnf(ctx, "invalid streamed event payload: %v", err)
+			continue
+		}
+
+		n.handle(ctx, payload)
+	}
+}
+
+// handle handles a payload received on the stream notify channel,
+// delivering the message once all of its chunks have been received.
+// Only called from the listen loop, so needs no locking.
+func (n *StreamNotifier) handle(ctx context.Context, payload streamNotifyPayload) {
+	if payload.Node == n.node {
+		// Sent by us.
+		return
+	}
+
+	chunks := n.partial[payload.Node]
+	if payload.Index != len(chunks) {
+		// Out of sequence, we must have missed
+		// a chunk, drop the partial message. A
+		// new message starts again at index 0.
+		chunks = nil
+		if payload.Index != 0 {
+			delete(n.partial, payload.Node)
+			return
+		}
+	}
+
+	chunks = append(chunks, payload.Data)
+	if len(chunks) < payload.Total {
+		// Wait for more.
+		n.partial[payload.Node] = chunks
+		return
+	}
+
+	delete(n.partial, payload.Node)
+
+	var b []byte
+	for _, chunk := range chunks {
+		data, err := base64.StdEncoding.DecodeString(chunk)
+		if err != nil {
+			log.Warnf(ctx, "invalid streamed event chunk: %v", err)
+			return
+		}
+		b = append(b, data...)
+	}
+
+	var msg streamNotifyMessage
+	if err := json.Unmarshal(b, &msg); err != nil || msg.Event == nil {
+		log.Warnf(ctx, "invalid streamed event message: %v", err)
+		return
+	}
+
+	n.deliver(msg.AccountID, msg.Event)
+}
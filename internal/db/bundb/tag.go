@@ -20,7 +20,9 @@ package bundb
 import (
 	"context"
 	"strings"
+	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
@@ -117,3 +119,40 @@ func (m *tagDB) PutTag(ctx context.Context, tag *gtsmodel.Tag) error {
 
 	return nil
 }
+
+func (m *tagDB) UpdateTag(ctx context.Context, tag *gtsmodel.Tag, columns ...string) error {
+	tag.UpdatedAt = time.Now()
+	if len(columns) != 0 {
+		columns = append(columns, "updated_at")
+	}
+
+	return m.state.Caches.GTS.Tag().Store(tag, func() error {
+		_, err := m.conn.
+			NewUpdate().
+			Model(tag).
+			Where("? = ?", bun.Ident("tag.id"), tag.ID).
+			Column(columns...).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (m *tagDB) GetBannedTags(ctx context.Context) ([]*gtsmodel.Tag, error) {
+	var tagIDs []string
+
+	if err := m.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("tags"), bun.Ident("tag")).
+		Column("tag.id").
+		Where("? = ?", bun.Ident("tag.useable"), false).
+		Order("tag.name ASC").
+		Scan(ctx, &tagIDs); err != nil {
+		return nil, err
+	}
+
+	if len(tagIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	return m.GetTags(ctx, tagIDs)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type TagTestSuite struct {
@@ -86,6 +87,37 @@ func (suite *TagTestSuite) TestPutTag() {
 	}
 }
 
+func (suite *TagTestSuite) TestBanTag() {
+	ctx := context.Background()
+
+	// Nothing banned to begin with.
+	_, err := suite.db.GetBannedTags(ctx)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	testTag := new(gtsmodel.Tag)
+	*testTag = *suite.testTags["welcome"]
+	testTag.Useable = util.Ptr(false)
+	testTag.Listable = util.Ptr(false)
+
+	err = suite.db.UpdateTag(ctx, testTag, "useable", "listable")
+	suite.NoError(err)
+
+	// Updated tag should now
+	// be returned as banned.
+	bannedTags, err := suite.db.GetBannedTags(ctx)
+	suite.NoError(err)
+	suite.Len(bannedTags, 1)
+	suite.Equal(testTag.ID, bannedTags[0].ID)
+	suite.False(*bannedTags[0].Useable)
+	suite.False(*bannedTags[0].Listable)
+
+	// Should also be updated
+	// when getting by name.
+	dbTag, err := suite.db.GetTagByName(ctx, testTag.Name)
+	suite.NoError(err)
+	suite.False(*dbTag.Useable)
+}
+
 func TestTagTestSuite(t *testing.T) {
 	suite.Run(t, new(TagTestSuite))
 }
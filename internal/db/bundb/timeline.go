@@ -190,6 +190,8 @@ func (t *timelineDB) GetPublicTimeline(ctx context.Context, maxID string, sinceI
 		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
 		// Ignore boosts.
 		Where("? IS NULL", bun.Ident("status.boost_of_id")).
+		// Ignore statuses held for review.
+		Where("NOT EXISTS (?)", t.quarantinedStatusQ("status.id")).
 		// Select only IDs from table
 		Column("status.id")
 
@@ -378,14 +380,52 @@ func (t *timelineDB) GetListTimeline(
 		Column("follow.target_account_id").
 		Where("? IN (?)", bun.Ident("follow.id"), bun.In(followIDs))
 
-	// Select only status IDs created
-	// by one of the followed accounts.
+	// Fetch any rules attached to this list, used to
+	// pull in statuses by tag or author in addition
+	// to the statuses of the list's followed accounts.
+	listRules, err := t.state.DB.GetListRules(
+		gtscontext.SetBarebones(ctx),
+		listID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error getting rules for list %s: %w", listID, err)
+	}
+
+	var ruleAccountIDs, ruleTagIDs []string
+	for _, listRule := range listRules {
+		if listRule.TargetAccountID != "" {
+			ruleAccountIDs = append(ruleAccountIDs, listRule.TargetAccountID)
+		}
+		if listRule.TagID != "" {
+			ruleTagIDs = append(ruleTagIDs, listRule.TagID)
+		}
+	}
+
+	// Select only status IDs created by one of the
+	// followed accounts, or matching one of the rules.
 	q := t.db.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
 		// Select only IDs from table
 		Column("status.id").
-		Where("? IN (?)", bun.Ident("status.account_id"), subQ)
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.Where("? IN (?)", bun.Ident("status.account_id"), subQ)
+
+			if len(ruleAccountIDs) != 0 {
+				q = q.WhereOr("? IN (?)", bun.Ident("status.account_id"), bun.In(ruleAccountIDs))
+			}
+
+			if len(ruleTagIDs) != 0 {
+				tagQ := t.db.
+					NewSelect().
+					TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+					Column("status_to_tag.status_id").
+					Where("? IN (?)", bun.Ident("status_to_tag.tag_id"), bun.In(ruleTagIDs))
+				q = q.WhereOr("? IN (?)", bun.Ident("status.id"), tagQ)
+			}
+
+			return q
+		})
 
 	if maxID == "" || maxID >= id.Highest {
 		const future = 24 * time.Hour
@@ -493,7 +533,9 @@ func (t *timelineDB) GetTagTimeline(
 		// Public only.
 		Where("? = ?", bun.Ident("status.visibility"), gtsmodel.VisibilityPublic).
 		// This tag only.
-		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID)
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID).
+		// Ignore statuses held for review.
+		Where("NOT EXISTS (?)", t.quarantinedStatusQ("status.id"))
 
 	if maxID == "" || maxID >= id.Highest {
 		const future = 24 * time.Hour
@@ -568,3 +610,14 @@ func (t *timelineDB) GetTagTimeline(
 
 	return statuses, nil
 }
+
+// quarantinedStatusQ returns a subquery which selects the
+// quarantine entry of the status whose ID is in the given column,
+// for keeping statuses held by the spam filter off timelines.
+func (t *timelineDB) quarantinedStatusQ(statusIDColumn string) *bun.SelectQuery {
+	return t.db.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("quarantined_statuses"), bun.Ident("quarantined_status")).
+		Column("quarantined_status.id").
+		Where("? = ?", bun.Ident("quarantined_status.status_id"), bun.Ident(statusIDColumn))
+}
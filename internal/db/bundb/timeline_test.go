@@ -305,6 +305,41 @@ func (suite *TimelineTestSuite) TestGetListTimelineMinIDPagingUp() {
 	suite.Equal("01F8MHCP5P2NWYQ416SBA0XSEV", s[len(s)-1].ID)
 }
 
+func (suite *TimelineTestSuite) TestGetListTimelineWithRules() {
+	var (
+		ctx    = context.Background()
+		list   = suite.testLists["local_account_1_list_1"]
+		remote = suite.testAccounts["remote_account_1"]
+	)
+
+	// Add a rule to pull in statuses from
+	// an account that isn't in the list.
+	if err := suite.db.PutListRule(ctx, &gtsmodel.ListRule{
+		ID:              "01HD8ZQ0Y6W3V9GQ8B1YS1V0K3",
+		ListID:          list.ID,
+		TargetAccountID: remote.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	s, err := suite.db.GetListTimeline(ctx, list.ID, "", "", "", 20)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Should now contain more than the 11
+	// statuses of the list's followed accounts.
+	suite.Greater(len(s), 11)
+
+	var found bool
+	for _, status := range s {
+		if status.ID == suite.testStatuses["remote_account_1_status_1"].ID {
+			found = true
+		}
+	}
+	suite.True(found)
+}
+
 func (suite *TimelineTestSuite) TestGetTagTimelineNoParams() {
 	var (
 		ctx = context.Background()
@@ -157,6 +157,42 @@ func (u *userDB) GetAllUsers(ctx context.Context) ([]*gtsmodel.User, error) {
 	return u.GetUsersByIDs(ctx, userIDs)
 }
 
+func (u *userDB) GetUsersByEmailNotifications(ctx context.Context, emailNotifications gtsmodel.EmailNotifications) ([]*gtsmodel.User, error) {
+	var userIDs []string
+
+	// Scan IDs of users with this
+	// email notification setting.
+	if err := u.db.NewSelect().
+		Table("users").
+		Column("id").
+		Where("? = ?", bun.Ident("email_notifications"), emailNotifications).
+		Order("id ASC").
+		Scan(ctx, &userIDs); err != nil {
+		return nil, err
+	}
+
+	// Transform user IDs into user slice.
+	return u.GetUsersByIDs(ctx, userIDs)
+}
+
+func (u *userDB) GetUsersWithHighlightsDigest(ctx context.Context) ([]*gtsmodel.User, error) {
+	var userIDs []string
+
+	// Scan IDs of users with
+	// any highlights setting.
+	if err := u.db.NewSelect().
+		Table("users").
+		Column("id").
+		Where("? IS NOT NULL", bun.Ident("highlights_digest")).
+		Order("id ASC").
+		Scan(ctx, &userIDs); err != nil {
+		return nil, err
+	}
+
+	// Transform user IDs into user slice.
+	return u.GetUsersByIDs(ctx, userIDs)
+}
+
 func (u *userDB) PutUser(ctx context.Context, user *gtsmodel.User) error {
 	return u.state.Caches.GTS.User().Store(user, func() error {
 		_, err := u.db.
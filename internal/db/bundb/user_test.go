@@ -53,6 +53,52 @@ func (suite *UserTestSuite) TestGetUserByAccountID() {
 	suite.NotNil(user)
 }
 
+func (suite *UserTestSuite) TestGetUsersByEmailNotifications() {
+	ctx := context.Background()
+
+	// No test users have email notifications set.
+	users, err := suite.db.GetUsersByEmailNotifications(ctx, gtsmodel.EmailNotificationsDaily)
+	suite.NoError(err)
+	suite.Empty(users)
+
+	testUser := new(gtsmodel.User)
+	*testUser = *suite.testUsers["local_account_1"]
+	testUser.EmailNotifications = gtsmodel.EmailNotificationsDaily
+	if err := suite.db.UpdateUser(ctx, testUser, "email_notifications"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	users, err = suite.db.GetUsersByEmailNotifications(ctx, gtsmodel.EmailNotificationsDaily)
+	suite.NoError(err)
+	suite.Len(users, 1)
+	suite.Equal(testUser.ID, users[0].ID)
+
+	users, err = suite.db.GetUsersByEmailNotifications(ctx, gtsmodel.EmailNotificationsImmediate)
+	suite.NoError(err)
+	suite.Empty(users)
+}
+
+func (suite *UserTestSuite) TestGetUsersWithHighlightsDigest() {
+	ctx := context.Background()
+
+	// No test users have highlights turned on.
+	users, err := suite.db.GetUsersWithHighlightsDigest(ctx)
+	suite.NoError(err)
+	suite.Empty(users)
+
+	testUser := new(gtsmodel.User)
+	*testUser = *suite.testUsers["local_account_1"]
+	testUser.HighlightsDigest = gtsmodel.HighlightsDigestDaily
+	if err := suite.db.UpdateUser(ctx, testUser, "highlights_digest"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	users, err = suite.db.GetUsersWithHighlightsDigest(ctx)
+	suite.NoError(err)
+	suite.Len(users, 1)
+	suite.Equal(testUser.ID, users[0].ID)
+}
+
 func (suite *UserTestSuite) TestUpdateUserSelectedColumns() {
 	testUser := suite.testUsers["local_account_1"]
 
@@ -25,25 +25,45 @@ const (
 // DB provides methods for interacting with an underlying database or other storage mechanism.
 type DB interface {
 	Account
+	AccountStats
 	Admin
+	Announcement
 	Application
+	Backfill
 	Basic
 	Domain
+	DomainQuarantine
+	DomainStats
+	Draft
+	EmailDomainBlock
 	Emoji
+	FeaturedTag
+	FirstInteraction
+	Highlights
+	IngestRule
 	Instance
+	InstancePage
+	InstanceStatistic
+	Interop
+	IPBlock
+	Job
 	List
+	Locks
 	Marker
 	Media
 	Mention
 	Notification
+	QuarantinedStatus
 	Relationship
 	Report
 	Rule
+	SavedSearch
 	Search
 	Session
 	Status
 	StatusBookmark
 	StatusFave
+	StatusReaction
 	Tag
 	Timeline
 	User
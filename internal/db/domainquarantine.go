@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DomainQuarantine interface {
+	// GetDomainQuarantineByID gets one domain quarantine with the given id.
+	GetDomainQuarantineByID(ctx context.Context, id string) (*gtsmodel.DomainQuarantine, error)
+
+	// GetDomainQuarantine gets the domain quarantine for the given domain.
+	GetDomainQuarantine(ctx context.Context, domain string) (*gtsmodel.DomainQuarantine, error)
+
+	// GetPendingDomainQuarantines gets a page of domain quarantines awaiting approval.
+	GetPendingDomainQuarantines(ctx context.Context, page *paging.Page) ([]*gtsmodel.DomainQuarantine, error)
+
+	// DomainHasStatuses returns whether any statuses from accounts on the
+	// given domain are stored, ignoring the status with ID excludeStatusID.
+	DomainHasStatuses(ctx context.Context, domain string, excludeStatusID string) (bool, error)
+
+	// PutDomainQuarantine puts a new domain quarantine in the database.
+	PutDomainQuarantine(ctx context.Context, quarantine *gtsmodel.DomainQuarantine) error
+
+	// UpdateDomainQuarantine updates the given columns of one domain quarantine.
+	UpdateDomainQuarantine(ctx context.Context, quarantine *gtsmodel.DomainQuarantine, columns ...string) error
+}
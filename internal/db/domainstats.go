@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DomainStats interface {
+	// GetDomainStats gets the federation stats for the given domain.
+	GetDomainStats(ctx context.Context, domain string) (*gtsmodel.DomainStats, error)
+
+	// GetAllDomainStats gets a page of federation stats for all domains. If failing
+	// is true, only domains whose most recent contact failed are returned.
+	GetAllDomainStats(ctx context.Context, page *paging.Page, failing bool) ([]*gtsmodel.DomainStats, error)
+
+	// RecordDomainContact records the outcome of an outgoing contact of the given type
+	// with the given domain, creating stats for the domain if necessary. An empty
+	// failure means the contact succeeded, else it should describe what went wrong.
+	RecordDomainContact(ctx context.Context, domain string, contact gtsmodel.DomainContact, failure string) error
+
+	// SuspendDomainDeliveries marks deliveries to the given domain as suspended.
+	SuspendDomainDeliveries(ctx context.Context, domain string) error
+
+	// ResumeDomainDeliveries resumes suspended deliveries to the given domain, resetting
+	// its count of consecutive delivery failures. Returns whether deliveries were suspended.
+	ResumeDomainDeliveries(ctx context.Context, domain string) (bool, error)
+
+	// AreDomainDeliveriesSuspended returns whether deliveries to the given domain are suspended.
+	AreDomainDeliveriesSuspended(ctx context.Context, domain string) (bool, error)
+}
@@ -0,0 +1,51 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Draft interface {
+	// GetDraftByID gets one draft with the given id.
+	GetDraftByID(ctx context.Context, id string) (*gtsmodel.Draft, error)
+
+	// GetDraftsForAccountID gets all drafts owned by the given accountID, newest first.
+	GetDraftsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Draft, error)
+
+	// CountDraftsForAccountID returns the number of drafts owned by the given accountID.
+	CountDraftsForAccountID(ctx context.Context, accountID string) (int, error)
+
+	// PopulateDraft ensures that the draft's struct fields are populated.
+	PopulateDraft(ctx context.Context, draft *gtsmodel.Draft) error
+
+	// PutDraft puts a new draft in the database.
+	PutDraft(ctx context.Context, draft *gtsmodel.Draft) error
+
+	// UpdateDraft updates the given draft.
+	// Columns is optional, if not specified all will be updated.
+	UpdateDraft(ctx context.Context, draft *gtsmodel.Draft, columns ...string) error
+
+	// DeleteDraftByID deletes one draft with the given ID.
+	DeleteDraftByID(ctx context.Context, id string) error
+
+	// DeleteDraftsForAccountID deletes all drafts owned by the given accountID.
+	DeleteDraftsForAccountID(ctx context.Context, accountID string) error
+}
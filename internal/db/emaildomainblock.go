@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type EmailDomainBlock interface {
+	// GetEmailDomainBlockByID gets one email domain block with the given id.
+	GetEmailDomainBlockByID(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, error)
+
+	// GetEmailDomainBlocks gets all email domain blocks, oldest first.
+	GetEmailDomainBlocks(ctx context.Context) ([]*gtsmodel.EmailDomainBlock, error)
+
+	// IsEmailDomainBlocked checks whether addresses at the given email
+	// domain (or a subdomain of it) are blocked from signing up.
+	IsEmailDomainBlocked(ctx context.Context, domain string) (bool, error)
+
+	// PopulateEmailDomainBlock ensures that the email domain block's struct fields are populated.
+	PopulateEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) error
+
+	// PutEmailDomainBlock puts a new email domain block in the database.
+	PutEmailDomainBlock(ctx context.Context, block *gtsmodel.EmailDomainBlock) error
+
+	// DeleteEmailDomainBlockByID deletes one email domain block with the given ID.
+	DeleteEmailDomainBlockByID(ctx context.Context, id string) error
+}
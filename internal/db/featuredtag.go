@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type FeaturedTag interface {
+	// GetFeaturedTagByID gets one featured tag with the given id.
+	GetFeaturedTagByID(ctx context.Context, id string) (*gtsmodel.FeaturedTag, error)
+
+	// GetFeaturedTagsForAccountID gets all tags featured by the given accountID, in the order they were featured.
+	GetFeaturedTagsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FeaturedTag, error)
+
+	// CountFeaturedTagsForAccountID returns the number of tags featured by the given accountID.
+	CountFeaturedTagsForAccountID(ctx context.Context, accountID string) (int, error)
+
+	// GetFeaturedTagUsage returns the number of public and unlisted statuses
+	// by the featuring account which use the featured tag, and the time at
+	// which the most recent one was created, or a zero time if there are none.
+	GetFeaturedTagUsage(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) (int, time.Time, error)
+
+	// PopulateFeaturedTag ensures that the featured tag's struct fields are populated.
+	PopulateFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error
+
+	// PutFeaturedTag puts a new featured tag in the database.
+	PutFeaturedTag(ctx context.Context, featuredTag *gtsmodel.FeaturedTag) error
+
+	// DeleteFeaturedTagByID deletes one featured tag with the given ID.
+	DeleteFeaturedTagByID(ctx context.Context, id string) error
+
+	// DeleteFeaturedTagsForAccountID deletes all tags featured by the given accountID.
+	DeleteFeaturedTagsForAccountID(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type FirstInteraction interface {
+	// GetFirstInteractionByID gets one first interaction with the given id.
+	GetFirstInteractionByID(ctx context.Context, id string) (*gtsmodel.FirstInteraction, error)
+
+	// GetFirstInteraction gets the first interaction of
+	// originAccountID with accountID, if there is one.
+	GetFirstInteraction(ctx context.Context, accountID string, originAccountID string) (*gtsmodel.FirstInteraction, error)
+
+	// GetPendingFirstInteractions gets all first interactions with
+	// the given account that are pending review, newest first.
+	GetPendingFirstInteractions(ctx context.Context, accountID string) ([]*gtsmodel.FirstInteraction, error)
+
+	// PopulateFirstInteraction ensures that the first interaction's struct fields are populated.
+	PopulateFirstInteraction(ctx context.Context, firstInteraction *gtsmodel.FirstInteraction) error
+
+	// PutFirstInteraction puts a new first interaction in the database.
+	PutFirstInteraction(ctx context.Context, firstInteraction *gtsmodel.FirstInteraction) error
+
+	// UpdateFirstInteraction updates one first interaction by ID.
+	UpdateFirstInteraction(ctx context.Context, firstInteraction *gtsmodel.FirstInteraction, columns ...string) error
+
+	// DeleteFirstInteractionByID deletes one first interaction with the given ID.
+	DeleteFirstInteractionByID(ctx context.Context, id string) error
+
+	// DeleteAccountFirstInteractions deletes all first
+	// interactions with, or originating from, the given account.
+	DeleteAccountFirstInteractions(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Highlights interface {
+	// GetHighlightsByAccountID gets the most recent
+	// highlights made for the given account.
+	GetHighlightsByAccountID(ctx context.Context, accountID string) (*gtsmodel.Highlights, error)
+
+	// PopulateHighlights ensures that the highlights' struct fields are populated.
+	PopulateHighlights(ctx context.Context, highlights *gtsmodel.Highlights) error
+
+	// PutHighlights puts new highlights in the database,
+	// replacing any previous highlights of the same account.
+	PutHighlights(ctx context.Context, highlights *gtsmodel.Highlights) error
+
+	// DeleteHighlightsByAccountID deletes the
+	// highlights made for the given account.
+	DeleteHighlightsByAccountID(ctx context.Context, accountID string) error
+}
@@ -0,0 +1,48 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type IngestRule interface {
+	// GetIngestRuleByID gets one ingest rule with the given id.
+	GetIngestRuleByID(ctx context.Context, id string) (*gtsmodel.IngestRule, error)
+
+	// GetIngestRules gets all ingest rules, oldest first.
+	GetIngestRules(ctx context.Context) ([]*gtsmodel.IngestRule, error)
+
+	// MatchIngestRules returns all ingest rules which match the given text.
+	MatchIngestRules(ctx context.Context, text string) ([]*gtsmodel.IngestRule, error)
+
+	// PopulateIngestRule ensures that the ingest rule's struct fields are populated.
+	PopulateIngestRule(ctx context.Context, rule *gtsmodel.IngestRule) error
+
+	// PutIngestRule puts a new ingest rule in the database.
+	PutIngestRule(ctx context.Context, rule *gtsmodel.IngestRule) error
+
+	// IncrementIngestRuleHits adds one to the hit counter of each ingest
+	// rule with the given ids, and sets the time they were last hit to now.
+	IncrementIngestRuleHits(ctx context.Context, ids []string) error
+
+	// DeleteIngestRuleByID deletes one ingest rule with the given ID.
+	DeleteIngestRuleByID(ctx context.Context, id string) error
+}
@@ -28,6 +28,9 @@ type Instance interface {
 	// CountInstanceUsers returns the number of known accounts registered with the given domain.
 	CountInstanceUsers(ctx context.Context, domain string) (int, error)
 
+	// CountInstanceActiveUsers returns the number of accounts registered with the given domain which have posted in the past 4 weeks.
+	CountInstanceActiveUsers(ctx context.Context, domain string) (int, error)
+
 	// CountInstanceStatuses returns the number of known statuses posted from the given domain.
 	CountInstanceStatuses(ctx context.Context, domain string) (int, error)
 
@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// InstancePage handles getting/creation/deletion/updating of static instance pages.
+type InstancePage interface {
+	// GetInstancePageByID gets one instance page by its db id.
+	GetInstancePageByID(ctx context.Context, id string) (*gtsmodel.InstancePage, error)
+
+	// GetInstancePageBySlug gets one instance page by its slug.
+	GetInstancePageBySlug(ctx context.Context, slug string) (*gtsmodel.InstancePage, error)
+
+	// GetInstancePages gets all instance pages, in navigation order.
+	GetInstancePages(ctx context.Context) ([]*gtsmodel.InstancePage, error)
+
+	// PutInstancePage puts the given instance page in the database.
+	PutInstancePage(ctx context.Context, page *gtsmodel.InstancePage) error
+
+	// UpdateInstancePage updates the given instance page.
+	// Columns is optional, if not specified all will be updated.
+	UpdateInstancePage(ctx context.Context, page *gtsmodel.InstancePage, columns ...string) error
+
+	// DeleteInstancePageByID deletes one instance page with the given ID.
+	DeleteInstancePageByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InstanceStatistic interface {
+	// GetInstanceStatisticByDay gets the instance statistics
+	// for the day starting at the given midnight UTC, if recorded.
+	GetInstanceStatisticByDay(ctx context.Context, day time.Time) (*gtsmodel.InstanceStatistic, error)
+
+	// GetInstanceStatistics gets recorded instance statistics
+	// for days starting at or after since, oldest day first.
+	GetInstanceStatistics(ctx context.Context, since time.Time) ([]*gtsmodel.InstanceStatistic, error)
+
+	// CalculateInstanceStatistic calculates, but does not store,
+	// instance statistics for the day starting at the given
+	// midnight UTC. Totals such as stored media size are
+	// calculated as of the time this function is called.
+	CalculateInstanceStatistic(ctx context.Context, day time.Time) (*gtsmodel.InstanceStatistic, error)
+
+	// PutInstanceStatistic puts new instance statistics in the database.
+	PutInstanceStatistic(ctx context.Context, stat *gtsmodel.InstanceStatistic) error
+
+	// UpdateInstanceStatistic updates the given instance statistics.
+	UpdateInstanceStatistic(ctx context.Context, stat *gtsmodel.InstanceStatistic, columns ...string) error
+}
@@ -0,0 +1,44 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Interop contains functions for getting / storing the
+// federation interop profiles learned for remote domains.
+type Interop interface {
+	// GetDomainInterop returns the interop profile for the given domain, if it exists.
+	GetDomainInterop(ctx context.Context, domain string) (*gtsmodel.DomainInterop, error)
+
+	// GetDomainInterops returns all interop profiles, ordered by domain.
+	GetDomainInterops(ctx context.Context) ([]*gtsmodel.DomainInterop, error)
+
+	// PutDomainInterop puts the given interop profile into the database.
+	PutDomainInterop(ctx context.Context, interop *gtsmodel.DomainInterop) error
+
+	// UpdateDomainInterop updates the given interop profile.
+	// Columns is optional, if not specified all will be updated.
+	UpdateDomainInterop(ctx context.Context, interop *gtsmodel.DomainInterop, columns ...string) error
+
+	// DeleteDomainInterop deletes the interop profile for the given domain, if it exists.
+	DeleteDomainInterop(ctx context.Context, domain string) error
+}
@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type IPBlock interface {
+	// GetIPBlockByID gets one ip block with the given id.
+	GetIPBlockByID(ctx context.Context, id string) (*gtsmodel.IPBlock, error)
+
+	// GetIPBlocks gets all ip blocks, oldest first.
+	GetIPBlocks(ctx context.Context) ([]*gtsmodel.IPBlock, error)
+
+	// MatchIPBlock returns the most severe unexpired ip
+	// block containing the given address, or nil if none.
+	MatchIPBlock(ctx context.Context, addr netip.Addr) (*gtsmodel.IPBlock, error)
+
+	// PopulateIPBlock ensures that the ip block's struct fields are populated.
+	PopulateIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error
+
+	// PutIPBlock puts a new ip block in the database.
+	PutIPBlock(ctx context.Context, block *gtsmodel.IPBlock) error
+
+	// DeleteIPBlockByID deletes one ip block with the given ID.
+	DeleteIPBlockByID(ctx context.Context, id string) error
+}
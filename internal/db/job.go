@@ -0,0 +1,38 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type Job interface {
+	// GetJobs gets the stored state of all scheduled jobs, sorted by name.
+	GetJobs(ctx context.Context) ([]*gtsmodel.Job, error)
+
+	// GetJobByName gets the stored state of the job with the given name.
+	GetJobByName(ctx context.Context, name string) (*gtsmodel.Job, error)
+
+	// PutJob puts the state of a new job in the database.
+	PutJob(ctx context.Context, job *gtsmodel.Job) error
+
+	// UpdateJob updates the given job state.
+	UpdateJob(ctx context.Context, job *gtsmodel.Job, columns ...string) error
+}
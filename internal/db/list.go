@@ -67,4 +67,24 @@ type List interface {
 
 	// ListIncludesAccount returns true if the given listID includes the given accountID.
 	ListIncludesAccount(ctx context.Context, listID string, accountID string) (bool, error)
+
+	// GetListRuleByID gets one list rule with the given ID.
+	GetListRuleByID(ctx context.Context, id string) (*gtsmodel.ListRule, error)
+
+	// GetListRules gets all list rules belonging to the given listID.
+	GetListRules(ctx context.Context, listID string) ([]*gtsmodel.ListRule, error)
+
+	// GetListRulesForTargets gets all list rules (from any list) which match
+	// either the given accountID, or any of the given tagIDs. Returned rules
+	// are barebones, ie., their Tag and TargetAccount are not populated.
+	GetListRulesForTargets(ctx context.Context, accountID string, tagIDs []string) ([]*gtsmodel.ListRule, error)
+
+	// PopulateListRule ensures that the listRule's struct fields are populated.
+	PopulateListRule(ctx context.Context, listRule *gtsmodel.ListRule) error
+
+	// PutListRule puts a new list rule in the database.
+	PutListRule(ctx context.Context, listRule *gtsmodel.ListRule) error
+
+	// DeleteListRule deletes one list rule with the given id.
+	DeleteListRule(ctx context.Context, id string) error
 }
@@ -0,0 +1,31 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import "context"
+
+// Locks provides named locks which, where supported by the
+// database (i.e. postgres with advisory locks enabled), are
+// held across all GoToSocial processes sharing the database.
+// Otherwise the locks are only held within this process.
+type Locks interface {
+	// Lock acquires the lock with given key, waiting until it is
+	// available or the context is cancelled. On success, the returned
+	// function must be called to release the lock once done.
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
@@ -47,7 +47,16 @@ type Media interface {
 	// GetRemoteAttachments fetches media attachments with a non-empty domain, up to a given max ID, and at most limit.
 	GetRemoteAttachments(ctx context.Context, maxID string, limit int) ([]*gtsmodel.MediaAttachment, error)
 
+	// GetAccountAttachmentsSize returns the total size in bytes of all media
+	// attachments (including avatars and headers) stored for the given account,
+	// counting both the original file and its thumbnail.
+	GetAccountAttachmentsSize(ctx context.Context, accountID string) (int64, error)
+
 	// GetCachedAttachmentsOlderThan gets limit n remote attachments (including avatars and headers) older than
 	// the given time. These will be returned in order of attachment.created_at descending (i.e. newest to oldest).
 	GetCachedAttachmentsOlderThan(ctx context.Context, olderThan time.Time, limit int) ([]*gtsmodel.MediaAttachment, error)
+
+	// GetCachedAttachmentsByAccountID gets all cached remote attachments
+	// (including avatars and headers) belonging to the given account.
+	GetCachedAttachmentsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.MediaAttachment, error)
 }
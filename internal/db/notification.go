@@ -19,6 +19,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -30,6 +31,19 @@ type Notification interface {
 	// Returned notifications will be ordered ID descending (ie., highest/newest to lowest/oldest).
 	GetAccountNotifications(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int, excludeTypes []string) ([]*gtsmodel.Notification, error)
 
+	// GetAccountNotificationGroups returns notifications that pertain to the given accountID, with notifications
+	// of one of the given groupedTypes collapsed into one group per notification type and status. Each group
+	// holds the IDs of up to samples accounts that caused its most recent notifications. Paging parameters
+	// apply to the IDs of notifications, rather than of groups.
+	//
+	// Returned groups will be ordered by their most recent notification ID descending.
+	GetAccountNotificationGroups(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int, excludeTypes []string, groupedTypes []string, samples int) ([]*gtsmodel.NotificationGroup, error)
+
+	// CountAccountNotifications counts notifications that pertain to the given accountID and are newer
+	// than sinceID (if set), of one of the given types (if set) and not of one of excludeTypes. Counting
+	// stops at limit (if set), as an exact count of many notifications is rarely useful and costly.
+	CountAccountNotifications(ctx context.Context, accountID string, sinceID string, limit int, types []string, excludeTypes []string) (int, error)
+
 	// GetNotification returns one notification according to its id.
 	GetNotificationByID(ctx context.Context, id string) (*gtsmodel.Notification, error)
 
@@ -59,6 +73,16 @@ type Notification interface {
 	// At least one parameter must not be an empty string.
 	DeleteNotifications(ctx context.Context, types []string, targetAccountID string, originAccountID string) error
 
+	// DismissNotifications deletes, in one statement, all notifications
+	// targeting targetAccountID that match each of the given filters:
+	//
+	//   - types: notification is of one of the given types, if set.
+	//   - originAccountID: notification originates from this account, if set.
+	//   - olderThan: notification was created before this time, if set.
+	//
+	// The amount of dismissed notifications is returned.
+	DismissNotifications(ctx context.Context, targetAccountID string, types []string, originAccountID string, olderThan time.Time) (int, error)
+
 	// DeleteNotificationsForStatus deletes all notifications that relate to
 	// the given statusID. This function is useful when a status has been deleted,
 	// and so notifications relating to that status must also be deleted.
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type QuarantinedStatus interface {
+	// GetQuarantinedStatusByID gets one quarantined status with the given id.
+	GetQuarantinedStatusByID(ctx context.Context, id string) (*gtsmodel.QuarantinedStatus, error)
+
+	// GetQuarantinedStatusByStatusID gets the quarantine entry for the status with the given id.
+	GetQuarantinedStatusByStatusID(ctx context.Context, statusID string) (*gtsmodel.QuarantinedStatus, error)
+
+	// IsStatusQuarantined returns whether the status with
+	// the given id is currently held by the spam filter.
+	IsStatusQuarantined(ctx context.Context, statusID string) (bool, error)
+
+	// GetQuarantinedStatuses gets a page of quarantined statuses awaiting review.
+	GetQuarantinedStatuses(ctx context.Context, page *paging.Page) ([]*gtsmodel.QuarantinedStatus, error)
+
+	// GetQuarantinedStatusesByDomain gets all statuses held because
+	// their domain, as given, is awaiting approval. See DomainQuarantine.
+	GetQuarantinedStatusesByDomain(ctx context.Context, domain string) ([]*gtsmodel.QuarantinedStatus, error)
+
+	// PopulateQuarantinedStatus ensures that the quarantined status' struct fields are populated.
+	PopulateQuarantinedStatus(ctx context.Context, quarantined *gtsmodel.QuarantinedStatus) error
+
+	// PutQuarantinedStatus puts a new quarantined status in the database.
+	PutQuarantinedStatus(ctx context.Context, quarantined *gtsmodel.QuarantinedStatus) error
+
+	// DeleteQuarantinedStatusByID deletes one quarantined status with the given id.
+	// Only the quarantine entry is deleted, not the status itself.
+	DeleteQuarantinedStatusByID(ctx context.Context, id string) error
+
+	// DeleteQuarantinedStatusByStatusID deletes the quarantine
+	// entry for the status with the given id, if there is one.
+	DeleteQuarantinedStatusByStatusID(ctx context.Context, statusID string) error
+}
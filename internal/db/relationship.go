@@ -19,6 +19,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
@@ -179,9 +180,42 @@ type Relationship interface {
 	// CountAccountBlocks ...
 	CountAccountBlocks(ctx context.Context, accountID string) (int, error)
 
+	// CountAccountFollowsSince returns the number of follows and follow requests originating from the given account which were created since the given time.
+	CountAccountFollowsSince(ctx context.Context, accountID string, since time.Time) (int, error)
+
+	// CountMutualFollowers returns the number of accounts followed by the given accountID which also follow targetAccountID.
+	CountMutualFollowers(ctx context.Context, accountID string, targetAccountID string) (int, error)
+
 	// GetNote gets a private note from a source account on a target account, if it exists.
 	GetNote(ctx context.Context, sourceAccountID string, targetAccountID string) (*gtsmodel.AccountNote, error)
 
 	// PutNote creates or updates a private note.
 	PutNote(ctx context.Context, note *gtsmodel.AccountNote) error
+
+	// GetMuteByID fetches mute with given ID from the database.
+	GetMuteByID(ctx context.Context, id string) (*gtsmodel.UserMute, error)
+
+	// GetMute returns the mute from account1 targeting account2, if it exists, or an error if it doesn't.
+	GetMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, error)
+
+	// GetMutesByIDs fetches all mutes with given IDs from the database.
+	GetMutesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.UserMute, error)
+
+	// PopulateMute populates the struct pointers on the given mute.
+	PopulateMute(ctx context.Context, mute *gtsmodel.UserMute) error
+
+	// PutMute attempts to place the given account mute in the database.
+	PutMute(ctx context.Context, mute *gtsmodel.UserMute) error
+
+	// UpdateMute updates one mute by ID, optionally only the given columns.
+	UpdateMute(ctx context.Context, mute *gtsmodel.UserMute, columns ...string) error
+
+	// DeleteMuteByID removes mute with given ID from the database.
+	DeleteMuteByID(ctx context.Context, id string) error
+
+	// DeleteAccountMutes will delete all database mutes to / from the given account ID.
+	DeleteAccountMutes(ctx context.Context, accountID string) error
+
+	// GetAccountMutes returns all mutes originating from the given account, with given optional paging parameters.
+	GetAccountMutes(ctx context.Context, accountID string, page *paging.Page) ([]*gtsmodel.UserMute, error)
 }
@@ -0,0 +1,54 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type SavedSearch interface {
+	// GetSavedSearchByID gets one saved search with the given id.
+	GetSavedSearchByID(ctx context.Context, id string) (*gtsmodel.SavedSearch, error)
+
+	// GetSavedSearchesForAccountID gets all saved searches owned by the given accountID, newest first.
+	GetSavedSearchesForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.SavedSearch, error)
+
+	// GetSavedSearchesToNotify gets all saved searches which have notifications enabled, from any account.
+	GetSavedSearchesToNotify(ctx context.Context) ([]*gtsmodel.SavedSearch, error)
+
+	// CountSavedSearchesForAccountID returns the number of saved searches owned by the given accountID.
+	CountSavedSearchesForAccountID(ctx context.Context, accountID string) (int, error)
+
+	// PopulateSavedSearch ensures that the saved search's struct fields are populated.
+	PopulateSavedSearch(ctx context.Context, savedSearch *gtsmodel.SavedSearch) error
+
+	// PutSavedSearch puts a new saved search in the database.
+	PutSavedSearch(ctx context.Context, savedSearch *gtsmodel.SavedSearch) error
+
+	// UpdateSavedSearch updates the given saved search.
+	// Columns is optional, if not specified all will be updated.
+	UpdateSavedSearch(ctx context.Context, savedSearch *gtsmodel.SavedSearch, columns ...string) error
+
+	// DeleteSavedSearchByID deletes one saved search with the given ID.
+	DeleteSavedSearchByID(ctx context.Context, id string) error
+
+	// DeleteSavedSearchesForAccountID deletes all saved searches owned by the given accountID.
+	DeleteSavedSearchesForAccountID(ctx context.Context, accountID string) error
+}
@@ -19,17 +19,40 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type Search interface {
-	// SearchForAccounts uses the given query text to search for accounts that accountID follows.
-	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, following bool, offset int) ([]*gtsmodel.Account, error)
+	// SearchForAccounts uses the given query text to search for accounts by username, display name
+	// or note, optionally only those that accountID follows and/or has recently interacted with (if
+	// both are set, accounts matching either are returned). Without maxID or minID, results are
+	// ranked by relevance to the query and to accountID, and paged through using offset instead.
+	SearchForAccounts(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, following bool, interacted bool, offset int) ([]*gtsmodel.Account, error)
 
-	// SearchForStatuses uses the given query text to search for statuses created by accountID, or in reply to accountID.
-	SearchForStatuses(ctx context.Context, accountID string, query string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, error)
+	// SearchForStatuses uses the given query text to search for statuses created by accountID, or in reply to accountID,
+	// further narrowed down by the given operators. Query text may be empty if at least one operator is set.
+	SearchForStatuses(ctx context.Context, accountID string, query string, operators StatusSearchOperators, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Status, error)
 
 	// SearchForTags searches for tags that start with the given query text (case insensitive).
 	SearchForTags(ctx context.Context, query string, maxID string, minID string, limit int, offset int) ([]*gtsmodel.Tag, error)
 }
+
+// StatusSearchOperators narrows down a status search.
+// Zero values mean the operator is not applied.
+type StatusSearchOperators struct {
+	// Only statuses created by this account ID.
+	FromAccountID string
+	// Only statuses created before this time.
+	Before time.Time
+	// Only statuses created after this time.
+	After time.Time
+	// Only statuses with media attachments.
+	HasMedia bool
+}
+
+// IsZero returns true if no operators are set.
+func (o StatusSearchOperators) IsZero() bool {
+	return o == StatusSearchOperators{}
+}
@@ -19,6 +19,7 @@ package db
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -52,6 +53,18 @@ type Status interface {
 	// GetStatuses gets a slice of statuses corresponding to the given status IDs.
 	GetStatusesByIDs(ctx context.Context, ids []string) ([]*gtsmodel.Status, error)
 
+	// GetStatuses fetches a page of statuses with IDs lower than maxID, in descending ID order. Used for iterating over all statuses.
+	GetStatuses(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Status, error)
+
+	// GetStatusesForCountsRefresh fetches up to limit remote statuses created after createdAfter, faved or boosted by local
+	// accounts, whose remote faves / boosts counts haven't been fetched since fetchedBefore (or ever), newest first.
+	GetStatusesForCountsRefresh(ctx context.Context, fetchedBefore time.Time, createdAfter time.Time, limit int) ([]*gtsmodel.Status, error)
+
+	// GetStaleFollowedStatuses fetches up to limit remote statuses created after createdAfter, by accounts followed by
+	// local accounts, which were last fetched before fetchedBefore, newest first. Statuses that were never fetched, ie.,
+	// which were delivered to this instance, are left out, as their updates are delivered too.
+	GetStaleFollowedStatuses(ctx context.Context, fetchedBefore time.Time, createdAfter time.Time, limit int) ([]*gtsmodel.Status, error)
+
 	// GetStatusesUsingEmoji fetches all status models using emoji with given ID stored in their 'emojis' column.
 	GetStatusesUsingEmoji(ctx context.Context, emojiID string) ([]*gtsmodel.Status, error)
 
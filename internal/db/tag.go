@@ -36,4 +36,12 @@ type Tag interface {
 
 	// GetTags gets multiple tags.
 	GetTags(ctx context.Context, ids []string) ([]*gtsmodel.Tag, error)
+
+	// UpdateTag updates the given tag in the database,
+	// updating only the given columns, or all if none given.
+	UpdateTag(ctx context.Context, tag *gtsmodel.Tag, columns ...string) error
+
+	// GetBannedTags gets all tags which have been banned
+	// on this instance, ie., which are not useable.
+	GetBannedTags(ctx context.Context) ([]*gtsmodel.Tag, error)
 }
@@ -37,6 +37,10 @@ type User interface {
 	GetUserByExternalID(ctx context.Context, id string) (*gtsmodel.User, error)
 	// GetUserByConfirmationToken returns one user by its confirmation token, or an error if something goes wrong.
 	GetUserByConfirmationToken(ctx context.Context, confirmationToken string) (*gtsmodel.User, error)
+	// GetUsersByEmailNotifications returns all users with the given email notifications setting.
+	GetUsersByEmailNotifications(ctx context.Context, emailNotifications gtsmodel.EmailNotifications) ([]*gtsmodel.User, error)
+	// GetUsersWithHighlightsDigest returns all users who've turned on highlights.
+	GetUsersWithHighlightsDigest(ctx context.Context) ([]*gtsmodel.User, error)
 	// PutUser will attempt to place user in the database
 	PutUser(ctx context.Context, user *gtsmodel.User) error
 	// UpdateUser updates one user by its primary key, updating either only the specified columns, or all of them.
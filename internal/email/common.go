@@ -21,7 +21,6 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
-	"net/smtp"
 	"os"
 	"path/filepath"
 	"strings"
@@ -29,20 +28,28 @@ import (
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 )
 
-func (s *sender) sendTemplate(template string, subject string, data any, toAddresses ...string) error {
-	buf := &bytes.Buffer{}
-	if err := s.template.ExecuteTemplate(buf, template, data); err != nil {
+func (s *sender) sendTemplate(template string, subject string, locale string, data any, toAddresses ...string) error {
+	subject, body, err := executeTemplate(s.template, template, subject, locale, data)
+	if err != nil {
 		return err
 	}
 
-	msg, err := assembleMessage(subject, buf.String(), s.from, toAddresses...)
+	msg, err := assembleMessage(subject, body, s.from, toAddresses...)
 	if err != nil {
 		return err
 	}
 
-	if err := smtp.SendMail(s.hostAddress, s.auth, s.from, toAddresses, msg); err != nil {
+	if s.dkim != nil {
+		msg, err = s.dkim.Sign(msg)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := s.transport.Send(s.from, toAddresses, msg); err != nil {
 		return gtserror.SetType(err, gtserror.TypeSMTP)
 	}
 
@@ -59,7 +66,50 @@ func loadTemplates(templateBaseDir string) (*template.Template, error) {
 	}
 
 	// look for all templates that start with 'email_'
-	return template.ParseGlob(filepath.Join(templateBaseDir, "email_*"))
+	return template.New("").
+		Funcs(funcs(nil)).
+		ParseGlob(filepath.Join(templateBaseDir, "email_*"))
+}
+
+// funcs returns the functions available
+// to email templates, translating strings
+// with the given localizer.
+func funcs(localizer *i18n.Localizer) template.FuncMap {
+	return template.FuncMap{
+		"t": localizer.T,
+		"n": localizer.N,
+	}
+}
+
+// executeTemplate executes the named email template with
+// the given data, translating strings into the given locale,
+// or into the instance languages if the locale is empty or
+// has no translation. It returns the translated subject and
+// the body of the email.
+func executeTemplate(
+	t *template.Template,
+	name string,
+	subject string,
+	locale string,
+	data any,
+) (string, string, error) {
+	localizer := i18n.New(locale)
+
+	// Clone the parsed templates, so we
+	// can bind them to this localizer
+	// without affecting concurrent sends.
+	t, err := t.Clone()
+	if err != nil {
+		return "", "", err
+	}
+	t.Funcs(funcs(localizer))
+
+	buf := &bytes.Buffer{}
+	if err := t.ExecuteTemplate(buf, name, data); err != nil {
+		return "", "", err
+	}
+
+	return localizer.T(subject), buf.String(), nil
 }
 
 // assembleMessage assembles a valid email message following:
@@ -33,8 +33,10 @@ type ConfirmData struct {
 	// Link to present to the receiver to click on and do the confirmation.
 	// Should be a full link with protocol eg., https://example.org/confirm_email?token=some-long-token
 	ConfirmLink string
+	// Preferred locale of the receiver, if any.
+	Locale string
 }
 
 func (s *sender) SendConfirmEmail(toAddress string, data ConfirmData) error {
-	return s.sendTemplate(confirmTemplate, confirmSubject, data, toAddress)
+	return s.sendTemplate(confirmTemplate, confirmSubject, data.Locale, data, toAddress)
 }
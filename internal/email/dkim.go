@@ -0,0 +1,253 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// dkimHeaders are the names of headers to sign,
+// if present. From is always present, and required.
+var dkimHeaders = []string{"from", "to", "subject", "date", "message-id"}
+
+// dkimSigner signs outgoing emails following:
+//   - https://datatracker.ietf.org/doc/html/rfc6376
+//   - https://datatracker.ietf.org/doc/html/rfc8463
+//
+// using relaxed/relaxed canonicalization.
+type dkimSigner struct {
+	domain   string
+	selector string
+	key      crypto.Signer
+}
+
+// newDKIMSigner returns a new dkimSigner for the given
+// domain and selector, using the PEM-encoded RSA or Ed25519
+// private key at keyPath.
+func newDKIMSigner(domain string, selector string, keyPath string) (*dkimSigner, error) {
+	b, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading dkim private key: %w", err)
+	}
+
+	key, err := parseDKIMKey(b)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing dkim private key %s: %w", keyPath, err)
+	}
+
+	return &dkimSigner{
+		domain:   domain,
+		selector: selector,
+		key:      key,
+	}, nil
+}
+
+// parseDKIMKey parses a PEM-encoded PKCS #1
+// RSA key, or PKCS #8 RSA or Ed25519 key.
+func parseDKIMKey(b []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+
+		switch key := key.(type) {
+		case *rsa.PrivateKey:
+			return key, nil
+		case ed25519.PrivateKey:
+			return key, nil
+		default:
+			return nil, fmt.Errorf("unsupported key type %T", key)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %s", block.Type)
+	}
+}
+
+// algorithm returns the DKIM
+// a= tag value for the key.
+func (d *dkimSigner) algorithm() string {
+	if _, ok := d.key.(ed25519.PrivateKey); ok {
+		return "ed25519-sha256"
+	}
+	return "rsa-sha256"
+}
+
+// Sign returns msg with a DKIM-Signature header prepended.
+func (d *dkimSigner) Sign(msg []byte) ([]byte, error) {
+	header, body, ok := bytes.Cut(msg, []byte("\r\n\r\n"))
+	if !ok {
+		return nil, errors.New("message has no header / body separator")
+	}
+
+	// Pick out the headers we're going to sign, in order.
+	fields := parseHeaderFields(header)
+	signed := make([]string, 0, len(dkimHeaders))
+	hashed := &bytes.Buffer{}
+	for _, name := range dkimHeaders {
+		field, ok := fields[name]
+		if !ok {
+			continue
+		}
+		signed = append(signed, name)
+		hashed.WriteString(relaxedHeader(field))
+	}
+
+	if len(signed) == 0 || signed[0] != "from" {
+		return nil, errors.New("message has no From header")
+	}
+
+	bodyHash := sha256.Sum256(relaxedBody(body))
+
+	sig := "DKIM-Signature: v=1; a=" + d.algorithm() + "; c=relaxed/relaxed;\r\n" +
+		" d=" + d.domain + "; s=" + d.selector + ";\r\n" +
+		" t=" + strconv.FormatInt(time.Now().Unix(), 10) + "; h=" + strings.Join(signed, ":") + ";\r\n" +
+		" bh=" + base64.StdEncoding.EncodeToString(bodyHash[:]) + ";\r\n" +
+		" b="
+
+	// The signature header itself is hashed last,
+	// with an empty b= value and no trailing CRLF.
+	hashed.WriteString(strings.TrimSuffix(relaxedHeader(sig), "\r\n"))
+	digest := sha256.Sum256(hashed.Bytes())
+
+	var (
+		b   []byte
+		err error
+	)
+	switch key := d.key.(type) {
+	case ed25519.PrivateKey:
+		// Ed25519 signs the hash itself, see RFC 8463.
+		b = ed25519.Sign(key, digest[:])
+	default:
+		b, err = d.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error signing message: %w", err)
+	}
+
+	out := make([]byte, 0, len(sig)+512+len(msg))
+	out = append(out, sig...)
+	out = append(out, base64.StdEncoding.EncodeToString(b)...)
+	out = append(out, "\r\n"...)
+	out = append(out, msg...)
+	return out, nil
+}
+
+// parseHeaderFields parses the given header block into
+// complete (possibly folded) fields keyed by lowercase
+// field name. Later fields replace earlier ones, since
+// DKIM signs header instances from the bottom up.
+func parseHeaderFields(header []byte) map[string]string {
+	fields := make(map[string]string)
+
+	var current string
+	flush := func() {
+		if current == "" {
+			return
+		}
+		name, _, _ := strings.Cut(current, ":")
+		fields[strings.ToLower(strings.TrimSpace(name))] = current
+	}
+
+	for _, line := range strings.Split(string(header), "\r\n") {
+		if strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") {
+			// Continuation of a folded field.
+			current += "\r\n" + line
+			continue
+		}
+		flush()
+		current = line
+	}
+	flush()
+
+	return fields
+}
+
+// relaxedHeader canonicalizes one header
+// field with the "relaxed" algorithm.
+func relaxedHeader(field string) string {
+	name, value, _ := strings.Cut(field, ":")
+
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	// Unfold, then compress whitespace.
+	value = strings.ReplaceAll(value, "\r\n", "")
+	value = strings.Join(strings.FieldsFunc(value, isWSP), " ")
+
+	return name + ":" + value + "\r\n"
+}
+
+// relaxedBody canonicalizes a message
+// body with the "relaxed" algorithm.
+func relaxedBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+
+	// Compress whitespace within lines,
+	// and drop it from the end of lines.
+	for i, line := range lines {
+		trimmed := strings.TrimRightFunc(line, isWSP)
+		if trimmed == "" {
+			lines[i] = ""
+			continue
+		}
+
+		fields := strings.FieldsFunc(trimmed, isWSP)
+		if isWSP(rune(trimmed[0])) {
+			lines[i] = " " + strings.Join(fields, " ")
+		} else {
+			lines[i] = strings.Join(fields, " ")
+		}
+	}
+
+	// Ignore all empty lines at the end.
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
+
+func isWSP(r rune) bool {
+	return r == ' ' || r == '\t'
+}
@@ -0,0 +1,150 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DKIMTestSuite struct {
+	suite.Suite
+}
+
+const dkimTestMessage = "To: user@example.org\r\nFrom: test@example.org\r\nSubject: Hello   there\r\n\r\nHi!  \r\n\r\n  Bye.\r\n\r\n"
+
+// verify checks the DKIM-Signature at the top of msg
+// against the given public key, returning the tags.
+func (suite *DKIMTestSuite) verify(msg []byte, verify func(digest []byte, sig []byte) bool) map[string]string {
+	header, body, ok := strings.Cut(string(msg), "\r\n\r\n")
+	suite.True(ok)
+
+	fields := parseHeaderFields([]byte(header))
+	sigField, ok := fields["dkim-signature"]
+	suite.True(ok)
+
+	tags := make(map[string]string)
+	for _, tag := range strings.Split(relaxedHeader(sigField)[len("dkim-signature:"):], ";") {
+		k, v, _ := strings.Cut(strings.TrimSpace(tag), "=")
+		tags[k] = strings.TrimSpace(v)
+	}
+
+	bodyHash := sha256.Sum256(relaxedBody([]byte(body)))
+	suite.Equal(base64.StdEncoding.EncodeToString(bodyHash[:]), tags["bh"])
+
+	hashed := ""
+	for _, name := range strings.Split(tags["h"], ":") {
+		hashed += relaxedHeader(fields[name])
+	}
+	unsigned := relaxedHeader(sigField)
+	unsigned = strings.TrimSuffix(unsigned[:strings.LastIndex(unsigned, "b=")+2], "\r\n")
+	digest := sha256.Sum256([]byte(hashed + unsigned))
+
+	sig, err := base64.StdEncoding.DecodeString(tags["b"])
+	suite.NoError(err)
+	suite.True(verify(digest[:], sig))
+
+	return tags
+}
+
+func (suite *DKIMTestSuite) TestRelaxedCanonicalization() {
+	// Example from RFC 6376 section 3.4.5.
+	suite.Equal("a:X\r\n", relaxedHeader("A: X"))
+	suite.Equal("b:Y Z\r\n", relaxedHeader("B : Y\t\r\n\tZ  "))
+	suite.Equal(" C\r\nD E\r\n", string(relaxedBody([]byte(" C \r\nD \t E\r\n\r\n\r\n"))))
+	suite.Empty(relaxedBody([]byte("\r\n\r\n")))
+}
+
+func (suite *DKIMTestSuite) TestSignRSA() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+
+	d := &dkimSigner{domain: "example.org", selector: "gotosocial", key: key}
+	signed, err := d.Sign([]byte(dkimTestMessage))
+	suite.NoError(err)
+	suite.True(strings.HasSuffix(string(signed), dkimTestMessage))
+
+	tags := suite.verify(signed, func(digest []byte, sig []byte) bool {
+		return rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest, sig) == nil
+	})
+	suite.Equal("rsa-sha256", tags["a"])
+	suite.Equal("relaxed/relaxed", tags["c"])
+	suite.Equal("example.org", tags["d"])
+	suite.Equal("gotosocial", tags["s"])
+	suite.Equal("from:to:subject", tags["h"])
+}
+
+func (suite *DKIMTestSuite) TestSignEd25519() {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+
+	d := &dkimSigner{domain: "example.org", selector: "gotosocial", key: key}
+	signed, err := d.Sign([]byte(dkimTestMessage))
+	suite.NoError(err)
+
+	tags := suite.verify(signed, func(digest []byte, sig []byte) bool {
+		return ed25519.Verify(pub, digest, sig)
+	})
+	suite.Equal("ed25519-sha256", tags["a"])
+}
+
+func (suite *DKIMTestSuite) TestSignNoFrom() {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+
+	d := &dkimSigner{domain: "example.org", selector: "gotosocial", key: key}
+	_, err = d.Sign([]byte("To: user@example.org\r\n\r\nHi!\r\n"))
+	suite.EqualError(err, "message has no From header")
+}
+
+func (suite *DKIMTestSuite) TestParseDKIMKey() {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	suite.NoError(err)
+	pkcs1 := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(rsaKey)})
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	suite.NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(edKey)
+	suite.NoError(err)
+	pkcs8 := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	key, err := parseDKIMKey(pkcs1)
+	suite.NoError(err)
+	suite.IsType(&rsa.PrivateKey{}, key)
+
+	key, err = parseDKIMKey(pkcs8)
+	suite.NoError(err)
+	suite.IsType(ed25519.PrivateKey{}, key)
+
+	_, err = parseDKIMKey([]byte("not a key"))
+	suite.EqualError(err, "no PEM data found")
+}
+
+func TestDKIMTestSuite(t *testing.T) {
+	suite.Run(t, new(DKIMTestSuite))
+}
@@ -185,6 +185,115 @@ func (suite *EmailTestSuite) TestTemplateReportClosedLocalAccountNoComment() {
 	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Report Closed\r\n\r\nHello !\r\n\r\nYou recently reported the account @1happyturtle to the moderator(s) of Test Instance (https://example.org).\r\n\r\nThe report you submitted has now been closed.\r\n\r\nThe moderator who closed the report did not leave a comment.\r\n\r\n", suite.sentEmails["user@example.org"])
 }
 
+func (suite *EmailTestSuite) TestTemplateNotificationMention() {
+	notificationData := email.NotificationData{
+		Username:     "the_mighty_zork",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		SettingsURL:  "https://example.org/settings/user/settings",
+		Notifications: []email.Notification{
+			{
+				Type:       email.NotificationMention,
+				Account:    "@foss_satan@fossbros-anonymous.io",
+				AccountURL: "http://fossbros-anonymous.io/@foss_satan",
+				StatusURL:  "http://fossbros-anonymous.io/@foss_satan/01FVW7JHQFSFK166WWKR8CBA6M",
+				StatusText: "hey @the_mighty_zork, how are you?",
+			},
+		},
+	}
+
+	if err := suite.sender.SendNotificationEmail("user@example.org", notificationData); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial New Mention\r\n\r\nHello the_mighty_zork!\r\n\r\n@foss_satan@fossbros-anonymous.io mentioned you on Test Instance:\r\n\r\nhey @the_mighty_zork, how are you?\r\n\r\nTo see the post, paste the following in your browser's address bar:\r\n\r\nhttp://fossbros-anonymous.io/@foss_satan/01FVW7JHQFSFK166WWKR8CBA6M\r\n\r\nYou are receiving this mail because you turned on email notifications for your account on https://example.org. To change which emails you receive, visit https://example.org/settings/user/settings\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *EmailTestSuite) TestTemplateNotificationFollow() {
+	notificationData := email.NotificationData{
+		Username:     "the_mighty_zork",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		SettingsURL:  "https://example.org/settings/user/settings",
+		Notifications: []email.Notification{
+			{
+				Type:       email.NotificationFollow,
+				Account:    "@admin",
+				AccountURL: "https://example.org/@admin",
+			},
+		},
+	}
+
+	if err := suite.sender.SendNotificationEmail("user@example.org", notificationData); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial New Follower\r\n\r\nHello the_mighty_zork!\r\n\r\n@admin followed you on Test Instance.\r\n\r\nTo see their profile, paste the following in your browser's address bar:\r\n\r\nhttps://example.org/@admin\r\n\r\nYou are receiving this mail because you turned on email notifications for your account on https://example.org. To change which emails you receive, visit https://example.org/settings/user/settings\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *EmailTestSuite) TestTemplateDigest() {
+	notificationData := email.NotificationData{
+		Username:     "the_mighty_zork",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		SettingsURL:  "https://example.org/settings/user/settings",
+		Notifications: []email.Notification{
+			{
+				Type:       email.NotificationMention,
+				Account:    "@foss_satan@fossbros-anonymous.io",
+				AccountURL: "http://fossbros-anonymous.io/@foss_satan",
+				StatusURL:  "http://fossbros-anonymous.io/@foss_satan/01FVW7JHQFSFK166WWKR8CBA6M",
+				StatusText: "hey @the_mighty_zork, how are you?",
+			},
+			{
+				Type:       email.NotificationFollowRequest,
+				Account:    "@1happyturtle",
+				AccountURL: "https://example.org/@1happyturtle",
+			},
+			{
+				Type:       email.NotificationFollow,
+				Account:    "@admin",
+				AccountURL: "https://example.org/@admin",
+			},
+		},
+	}
+
+	if err := suite.sender.SendDigestEmail("user@example.org", notificationData); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Notifications Digest\r\n\r\nHello the_mighty_zork!\r\n\r\nYou have 3 new notifications on Test Instance since your last digest:\r\n\r\n- @foss_satan@fossbros-anonymous.io mentioned you: hey @the_mighty_zork, how are you?\r\n  http://fossbros-anonymous.io/@foss_satan/01FVW7JHQFSFK166WWKR8CBA6M\r\n- @1happyturtle requested to follow you.\r\n- @admin followed you.\r\n\r\nYou are receiving this mail because you turned on daily email digests for your account on https://example.org. To change which emails you receive, visit https://example.org/settings/user/settings\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *EmailTestSuite) TestTemplateHighlights() {
+	highlightsData := email.HighlightsData{
+		Username:     "the_mighty_zork",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		SettingsURL:  "https://example.org/settings/user/settings",
+		Statuses: []email.HighlightedStatus{
+			{
+				Account:      "@foss_satan@fossbros-anonymous.io",
+				StatusURL:    "http://fossbros-anonymous.io/@foss_satan/01FVW7JHQFSFK166WWKR8CBA6M",
+				StatusText:   "dark souls status bot: \"thoughts of dog\"",
+				Interactions: 12,
+			},
+			{
+				Account:      "@admin",
+				StatusURL:    "https://example.org/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+				StatusText:   "hello world! #welcome ! first post on the instance :rainbow: !",
+				Interactions: 1,
+			},
+		},
+	}
+
+	if err := suite.sender.SendHighlightsEmail("user@example.org", highlightsData); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nFrom: test@example.org\r\nSubject: GoToSocial Highlights\r\n\r\nHello the_mighty_zork!\r\n\r\nHere are the posts from accounts you follow that got the most interactions on Test Instance in the last day:\r\n\r\n- @foss_satan@fossbros-anonymous.io: dark souls status bot: \"thoughts of dog\"\r\n  12 interactions - http://fossbros-anonymous.io/@foss_satan/01FVW7JHQFSFK166WWKR8CBA6M\r\n- @admin: hello world! #welcome ! first post on the instance :rainbow: !\r\n  1 interaction - https://example.org/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R\r\n\r\nYou are receiving this mail because you turned on emailed highlights for your account on https://example.org. To change which emails you receive, visit https://example.org/settings/user/settings\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
 func TestEmailTestSuite(t *testing.T) {
 	suite.Run(t, new(EmailTestSuite))
 }
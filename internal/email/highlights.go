@@ -0,0 +1,60 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+const (
+	highlightsTemplate = "email_highlights.tmpl"
+	highlightsSubject  = "GoToSocial Highlights"
+)
+
+type HighlightedStatus struct {
+	// Name of the account that posted the status,
+	// in the form @username for local accounts, or
+	// @username@domain for remote accounts.
+	Account string
+	// URL of the status.
+	StatusURL string
+	// Plain text content of the status, or its
+	// content warning if it has one, possibly
+	// truncated.
+	StatusText string
+	// Number of favourites, boosts
+	// and replies the status got.
+	Interactions int
+}
+
+type HighlightsData struct {
+	// Username to be addressed.
+	Username string
+	// URL of the instance to present to the receiver.
+	InstanceURL string
+	// Name of the instance to present to the receiver.
+	InstanceName string
+	// URL of the settings panel page where the
+	// receiver can change their email preferences.
+	SettingsURL string
+	// Highlighted statuses to tell the
+	// receiver about, most interacted first.
+	Statuses []HighlightedStatus
+	// Preferred locale of the receiver, if any.
+	Locale string
+}
+
+func (s *sender) SendHighlightsEmail(toAddress string, data HighlightsData) error {
+	return s.sendTemplate(highlightsTemplate, highlightsSubject, data.Locale, data, toAddress)
+}
@@ -18,7 +18,6 @@
 package email
 
 import (
-	"bytes"
 	"text/template"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -49,32 +48,44 @@ type noopSender struct {
 }
 
 func (s *noopSender) SendConfirmEmail(toAddress string, data ConfirmData) error {
-	return s.sendTemplate(confirmTemplate, confirmSubject, data, toAddress)
+	return s.sendTemplate(confirmTemplate, confirmSubject, data.Locale, data, toAddress)
 }
 
 func (s *noopSender) SendResetEmail(toAddress string, data ResetData) error {
-	return s.sendTemplate(resetTemplate, resetSubject, data, toAddress)
+	return s.sendTemplate(resetTemplate, resetSubject, data.Locale, data, toAddress)
 }
 
 func (s *noopSender) SendTestEmail(toAddress string, data TestData) error {
-	return s.sendTemplate(testTemplate, testSubject, data, toAddress)
+	return s.sendTemplate(testTemplate, testSubject, "", data, toAddress)
 }
 
 func (s *noopSender) SendNewReportEmail(toAddresses []string, data NewReportData) error {
-	return s.sendTemplate(newReportTemplate, newReportSubject, data, toAddresses...)
+	return s.sendTemplate(newReportTemplate, newReportSubject, "", data, toAddresses...)
 }
 
 func (s *noopSender) SendReportClosedEmail(toAddress string, data ReportClosedData) error {
-	return s.sendTemplate(reportClosedTemplate, reportClosedSubject, data, toAddress)
+	return s.sendTemplate(reportClosedTemplate, reportClosedSubject, data.Locale, data, toAddress)
 }
 
-func (s *noopSender) sendTemplate(template string, subject string, data any, toAddresses ...string) error {
-	buf := &bytes.Buffer{}
-	if err := s.template.ExecuteTemplate(buf, template, data); err != nil {
+func (s *noopSender) SendNotificationEmail(toAddress string, data NotificationData) error {
+	return s.sendTemplate(notificationTemplate, notificationSubject(data), data.Locale, data, toAddress)
+}
+
+func (s *noopSender) SendDigestEmail(toAddress string, data NotificationData) error {
+	return s.sendTemplate(digestTemplate, digestSubject, data.Locale, data, toAddress)
+}
+
+func (s *noopSender) SendHighlightsEmail(toAddress string, data HighlightsData) error {
+	return s.sendTemplate(highlightsTemplate, highlightsSubject, data.Locale, data, toAddress)
+}
+
+func (s *noopSender) sendTemplate(template string, subject string, locale string, data any, toAddresses ...string) error {
+	subject, body, err := executeTemplate(s.template, template, subject, locale, data)
+	if err != nil {
 		return err
 	}
 
-	msg, err := assembleMessage(subject, buf.String(), "test@example.org", toAddresses...)
+	msg, err := assembleMessage(subject, body, "test@example.org", toAddresses...)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,96 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+const (
+	notificationTemplate = "email_notification.tmpl"
+	followSubject        = "GoToSocial New Follower"
+	followRequestSubject = "GoToSocial New Follow Request"
+	mentionSubject       = "GoToSocial New Mention"
+	digestTemplate       = "email_digest.tmpl"
+	digestSubject        = "GoToSocial Notifications Digest"
+)
+
+// Notification types that can be emailed.
+const (
+	NotificationFollow        = "follow"
+	NotificationFollowRequest = "follow_request"
+	NotificationMention       = "mention"
+)
+
+type Notification struct {
+	// Type of the notification; one of
+	// follow, follow_request, or mention.
+	Type string
+	// Name of the account that triggered the notification,
+	// in the form @username for local accounts, or
+	// @username@domain for remote accounts.
+	Account string
+	// URL of the profile of the account
+	// that triggered the notification.
+	AccountURL string
+	// URL of the mentioning status.
+	// Empty string if not a mention.
+	StatusURL string
+	// Plain text content of the mentioning
+	// status, possibly truncated. Empty
+	// string if not a mention.
+	StatusText string
+}
+
+type NotificationData struct {
+	// Username to be addressed.
+	Username string
+	// URL of the instance to present to the receiver.
+	InstanceURL string
+	// Name of the instance to present to the receiver.
+	InstanceName string
+	// URL of the settings panel page where the
+	// receiver can change their email preferences.
+	SettingsURL string
+	// Notifications to tell the receiver about,
+	// newest first. One for immediate emails.
+	Notifications []Notification
+	// Preferred locale of the receiver, if any.
+	Locale string
+}
+
+func (s *sender) SendNotificationEmail(toAddress string, data NotificationData) error {
+	return s.sendTemplate(notificationTemplate, notificationSubject(data), data.Locale, data, toAddress)
+}
+
+func (s *sender) SendDigestEmail(toAddress string, data NotificationData) error {
+	return s.sendTemplate(digestTemplate, digestSubject, data.Locale, data, toAddress)
+}
+
+// notificationSubject returns the subject
+// line for an immediate notification email.
+func notificationSubject(data NotificationData) string {
+	if len(data.Notifications) == 0 {
+		return digestSubject
+	}
+
+	switch data.Notifications[0].Type {
+	case NotificationFollow:
+		return followSubject
+	case NotificationFollowRequest:
+		return followRequestSubject
+	default:
+		return mentionSubject
+	}
+}
@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import "sync/atomic"
+
+// ReloadableSender is a Sender which delegates to
+// another Sender that can be rebuilt at runtime, eg.,
+// after smtp settings were changed by a config reload.
+type ReloadableSender struct {
+	sender atomic.Pointer[Sender]
+	build  func() (Sender, error)
+}
+
+// NewReloadableSender returns a new ReloadableSender
+// delegating to the Sender returned by build, which
+// will be called again on each call to Reload().
+func NewReloadableSender(build func() (Sender, error)) (*ReloadableSender, error) {
+	r := &ReloadableSender{build: build}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload rebuilds the underlying Sender. If this
+// fails, the previous Sender is kept in place.
+func (r *ReloadableSender) Reload() error {
+	sender, err := r.build()
+	if err != nil {
+		return err
+	}
+	r.sender.Store(&sender)
+	return nil
+}
+
+func (r *ReloadableSender) get() Sender {
+	return *r.sender.Load()
+}
+
+func (r *ReloadableSender) SendConfirmEmail(toAddress string, data ConfirmData) error {
+	return r.get().SendConfirmEmail(toAddress, data)
+}
+
+func (r *ReloadableSender) SendResetEmail(toAddress string, data ResetData) error {
+	return r.get().SendResetEmail(toAddress, data)
+}
+
+func (r *ReloadableSender) SendTestEmail(toAddress string, data TestData) error {
+	return r.get().SendTestEmail(toAddress, data)
+}
+
+func (r *ReloadableSender) SendNewReportEmail(toAddresses []string, data NewReportData) error {
+	return r.get().SendNewReportEmail(toAddresses, data)
+}
+
+func (r *ReloadableSender) SendReportClosedEmail(toAddress string, data ReportClosedData) error {
+	return r.get().SendReportClosedEmail(toAddress, data)
+}
+
+func (r *ReloadableSender) SendNotificationEmail(toAddress string, data NotificationData) error {
+	return r.get().SendNotificationEmail(toAddress, data)
+}
+
+func (r *ReloadableSender) SendDigestEmail(toAddress string, data NotificationData) error {
+	return r.get().SendDigestEmail(toAddress, data)
+}
+
+func (r *ReloadableSender) SendHighlightsEmail(toAddress string, data HighlightsData) error {
+	return r.get().SendHighlightsEmail(toAddress, data)
+}
@@ -40,7 +40,7 @@ type NewReportData struct {
 }
 
 func (s *sender) SendNewReportEmail(toAddresses []string, data NewReportData) error {
-	return s.sendTemplate(newReportTemplate, newReportSubject, data, toAddresses...)
+	return s.sendTemplate(newReportTemplate, newReportSubject, "", data, toAddresses...)
 }
 
 type ReportClosedData struct {
@@ -57,8 +57,10 @@ type ReportClosedData struct {
 	ReportTargetDomain string
 	// Comment left by the admin who closed the report.
 	ActionTakenComment string
+	// Preferred locale of the receiver, if any.
+	Locale string
 }
 
 func (s *sender) SendReportClosedEmail(toAddress string, data ReportClosedData) error {
-	return s.sendTemplate(reportClosedTemplate, reportClosedSubject, data, toAddress)
+	return s.sendTemplate(reportClosedTemplate, reportClosedSubject, data.Locale, data, toAddress)
 }
@@ -33,8 +33,10 @@ type ResetData struct {
 	// Link to present to the receiver to click on and begin the reset process.
 	// Should be a full link with protocol eg., https://example.org/reset_password?token=some-reset-password-token
 	ResetLink string
+	// Preferred locale of the receiver, if any.
+	Locale string
 }
 
 func (s *sender) SendResetEmail(toAddress string, data ResetData) error {
-	return s.sendTemplate(resetTemplate, resetSubject, data, toAddress)
+	return s.sendTemplate(resetTemplate, resetSubject, data.Locale, data, toAddress)
 }
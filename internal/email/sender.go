@@ -46,6 +46,18 @@ type Sender interface {
 	// SendReportClosedEmail sends an email notification to the given address, letting them
 	// know that a report that they created has been closed / resolved by an admin.
 	SendReportClosedEmail(toAddress string, data ReportClosedData) error
+
+	// SendNotificationEmail sends an email to the given address, letting them
+	// know about the one notification (follow, follow request, or mention) in data.
+	SendNotificationEmail(toAddress string, data NotificationData) error
+
+	// SendDigestEmail sends an email to the given address, summarizing
+	// the notifications they received since their last digest.
+	SendDigestEmail(toAddress string, data NotificationData) error
+
+	// SendHighlightsEmail sends an email to the given address, telling them
+	// about the statuses from accounts they follow that got the most interactions.
+	SendHighlightsEmail(toAddress string, data HighlightsData) error
 }
 
 // NewSender returns a new email Sender interface with the given configuration, or an error if something goes wrong.
@@ -56,23 +68,47 @@ func NewSender() (Sender, error) {
 		return nil, err
 	}
 
-	username := config.GetSMTPUsername()
-	password := config.GetSMTPPassword()
-	host := config.GetSMTPHost()
-	port := config.GetSMTPPort()
-	from := config.GetSMTPFrom()
+	var transport Transport
+	switch config.GetSMTPTransport() {
+	case config.SMTPTransportHTTP:
+		transport = newHTTPTransport(
+			config.GetSMTPHTTPURL(),
+			config.GetSMTPHTTPToken(),
+		)
+	default:
+		username := config.GetSMTPUsername()
+		password := config.GetSMTPPassword()
+		host := config.GetSMTPHost()
+		port := config.GetSMTPPort()
+		transport = &smtpTransport{
+			hostAddress: fmt.Sprintf("%s:%d", host, port),
+			auth:        smtp.PlainAuth("", username, password, host),
+		}
+	}
+
+	var dkim *dkimSigner
+	if domain := config.GetSMTPDKIMDomain(); domain != "" {
+		dkim, err = newDKIMSigner(
+			domain,
+			config.GetSMTPDKIMSelector(),
+			config.GetSMTPDKIMPrivateKeyPath(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return &sender{
-		hostAddress: fmt.Sprintf("%s:%d", host, port),
-		from:        from,
-		auth:        smtp.PlainAuth("", username, password, host),
-		template:    t,
+		from:      config.GetSMTPFrom(),
+		transport: transport,
+		dkim:      dkim,
+		template:  t,
 	}, nil
 }
 
 type sender struct {
-	hostAddress string
-	from        string
-	auth        smtp.Auth
-	template    *template.Template
+	from      string
+	transport Transport
+	dkim      *dkimSigner // nil if not signing
+	template  *template.Template
 }
@@ -32,5 +32,5 @@ type TestData struct {
 }
 
 func (s *sender) SendTestEmail(toAddress string, data TestData) error {
-	return s.sendTemplate(testTemplate, testSubject, data, toAddress)
+	return s.sendTemplate(testTemplate, testSubject, "", data, toAddress)
 }
@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/smtp"
+	"time"
+)
+
+// Transport delivers assembled email messages.
+type Transport interface {
+	// Send delivers msg, from the given from
+	// address, to the given recipient addresses.
+	Send(from string, to []string, msg []byte) error
+}
+
+// smtpTransport delivers emails by
+// connecting to an smtp server.
+type smtpTransport struct {
+	hostAddress string
+	auth        smtp.Auth
+}
+
+func (t *smtpTransport) Send(from string, to []string, msg []byte) error {
+	return smtp.SendMail(t.hostAddress, t.auth, from, to, msg)
+}
+
+// httpTransport delivers emails by POSTing them
+// as JSON to an HTTP mail API, eg., a relay webhook.
+type httpTransport struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// httpMessage is the JSON body
+// POSTed by httpTransport.
+type httpMessage struct {
+	// From address of the email.
+	From string `json:"from"`
+	// To addresses of the email.
+	To []string `json:"to"`
+	// Message is the complete email, headers
+	// and body, as it would be sent over smtp.
+	Message string `json:"message"`
+}
+
+func newHTTPTransport(url string, token string) *httpTransport {
+	return &httpTransport{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (t *httpTransport) Send(from string, to []string, msg []byte) error {
+	b, err := json.Marshal(httpMessage{
+		From:    from,
+		To:      to,
+		Message: string(msg),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+
+	rsp, err := t.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode < 200 || rsp.StatusCode > 299 {
+		// Include (the start of) the response
+		// body, as it likely explains the problem.
+		body, _ := io.ReadAll(io.LimitReader(rsp.Body, 512))
+		return fmt.Errorf("mail API responded %s: %s", rsp.Status, bytes.TrimSpace(body))
+	}
+
+	return nil
+}
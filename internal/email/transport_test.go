@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package email
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type TransportTestSuite struct {
+	suite.Suite
+}
+
+func (suite *TransportTestSuite) TestHTTPTransport() {
+	var (
+		got  httpMessage
+		auth string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth = r.Header.Get("Authorization")
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	t := newHTTPTransport(server.URL, "some-token")
+	err := t.Send("test@example.org", []string{"user@example.org"}, []byte("Subject: Hi\r\n\r\nHi!\r\n"))
+	suite.NoError(err)
+	suite.Equal("Bearer some-token", auth)
+	suite.Equal(httpMessage{
+		From:    "test@example.org",
+		To:      []string{"user@example.org"},
+		Message: "Subject: Hi\r\n\r\nHi!\r\n",
+	}, got)
+}
+
+func (suite *TransportTestSuite) TestHTTPTransportError() {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "quota exceeded", http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	t := newHTTPTransport(server.URL, "")
+	err := t.Send("test@example.org", []string{"user@example.org"}, []byte("Subject: Hi\r\n\r\nHi!\r\n"))
+	suite.EqualError(err, "mail API responded 429 Too Many Requests: quota exceeded")
+}
+
+func TestTransportTestSuite(t *testing.T) {
+	suite.Run(t, new(TransportTestSuite))
+}
@@ -33,10 +33,12 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/interop"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 var (
@@ -137,6 +139,12 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 		return nil, errWithCode
 	}
 
+	// Local actors may be referred to by our onion
+	// service address, but their keys are always
+	// stored under the clearnet host, so look for
+	// them there instead.
+	pubKeyID = uris.Canonicalize(pubKeyID)
+
 	// At this point we know the request was signed,
 	// so now we need to validate the signature.
 
@@ -178,6 +186,14 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 	// Try to authenticate using permitted algorithms in
 	// order of most -> least common, checking each defined
 	// pubKey for this Actor. Return OK as soon as one passes.
+	//
+	// If the remote domain has previously been seen signing
+	// with a less common algorithm, that is tried first.
+	algos := signingAlgorithms
+	if !local {
+		algos = preferAlgorithm(algos, interop.Get(ctx, f.state, pubKeyID.Host))
+	}
+
 	for _, pubKey := range [2]*rsa.PublicKey{
 		pubKeyAuth.FetchedPubKey,
 		pubKeyAuth.CachedPubKey,
@@ -186,12 +202,25 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 			continue
 		}
 
-		for _, algo := range signingAlgorithms {
+		for _, algo := range algos {
 			l.Tracef("trying %s", algo)
 
 			err := verifier.Verify(pubKey, algo)
 			if err == nil {
 				l.Tracef("authentication PASSED with %s", algo)
+
+				if !local && algo != algos[0] {
+					// Remember less common algorithm
+					// in use by domain for next time.
+					interop.LearnSignatureAlgorithm(ctx, f.state, pubKeyID.Host, string(algo))
+				}
+
+				if !local {
+					// Domain is evidently alive, so resume
+					// deliveries if they were suspended.
+					f.resumeDeliveries(ctx, pubKeyID.Host)
+				}
+
 				return pubKeyAuth, nil
 			}
 
@@ -208,6 +237,50 @@ func (f *Federator) AuthenticateFederatedRequest(ctx context.Context, requestedU
 	return nil, gtserror.NewErrorUnauthorized(err, err.Error())
 }
 
+// resumeDeliveries resumes deliveries to the given
+// domain, if they were suspended as it seemed dead.
+func (f *Federator) resumeDeliveries(ctx context.Context, domain string) {
+	resumed, err := f.state.DB.ResumeDomainDeliveries(ctx, domain)
+	if err != nil {
+		log.Errorf(ctx, "error resuming deliveries to %s: %v", domain, err)
+		return
+	}
+
+	if resumed {
+		log.Infof(ctx, "resumed deliveries to %s after contact from it", domain)
+	}
+}
+
+// preferAlgorithm returns the given signing algorithms, with the
+// algorithm learned for a domain in its interop profile (if any)
+// moved to the front, such that it will be tried first.
+func preferAlgorithm(algos []httpsig.Algorithm, profile *gtsmodel.DomainInterop) []httpsig.Algorithm {
+	if profile == nil || profile.SignatureAlgorithm == "" ||
+		string(algos[0]) == profile.SignatureAlgorithm {
+		return algos
+	}
+
+	preferred := make([]httpsig.Algorithm, 0, len(algos))
+	for _, algo := range algos {
+		if string(algo) == profile.SignatureAlgorithm {
+			preferred = append(preferred, algo)
+		}
+	}
+
+	if len(preferred) == 0 {
+		// Not a permitted algorithm.
+		return algos
+	}
+
+	for _, algo := range algos {
+		if string(algo) != profile.SignatureAlgorithm {
+			preferred = append(preferred, algo)
+		}
+	}
+
+	return preferred
+}
+
 // derefPubKeyDBOnly tries to dereference the given
 // pubKey using only entries already in the database.
 //
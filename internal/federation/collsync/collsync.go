@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package collsync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// HeaderName is the HTTP header used to carry collection
+// synchronization information on inbox deliveries, as per
+// https://codeberg.org/fediverse/fep/src/branch/main/fep/8fcf/fep-8fcf.md
+const HeaderName = "Collection-Synchronization"
+
+// Digest computes the FEP-8fcf digest of the given collection
+// item URIs: the hex-encoded XOR of the SHA256 sum of each URI.
+// The digest is independent of the ordering of the URIs, and
+// an empty slice of URIs results in a digest of all zeroes.
+func Digest(uris []string) string {
+	var digest [sha256.Size]byte
+	for _, uri := range uris {
+		sum := sha256.Sum256([]byte(uri))
+		for i := range digest {
+			digest[i] ^= sum[i]
+		}
+	}
+	return hex.EncodeToString(digest[:])
+}
+
+// Header represents the value of a FEP-8fcf
+// Collection-Synchronization HTTP header.
+type Header struct {
+	// CollectionID is the ID of the collection
+	// being synchronized, e.g. a followers URI.
+	CollectionID string
+
+	// URL is the location of the partial collection,
+	// containing only items on the receiving host.
+	URL string
+
+	// Digest is the digest of the partial collection.
+	Digest string
+}
+
+// String returns the header value
+// in the format defined by FEP-8fcf.
+func (h Header) String() string {
+	return `collectionId=` + strconv.Quote(h.CollectionID) +
+		`, url=` + strconv.Quote(h.URL) +
+		`, digest=` + strconv.Quote(h.Digest)
+}
+
+// ParseHeader parses the given Collection-Synchronization header value,
+// returning an error if it is malformed or missing any required parameter.
+func ParseHeader(value string) (Header, error) {
+	var h Header
+
+	for value = strings.TrimSpace(value); value != ""; {
+		// Split the next key from "key=value".
+		key, rest, ok := strings.Cut(value, "=")
+		if !ok {
+			return h, errors.New("malformed header parameter")
+		}
+		key = strings.TrimSpace(key)
+
+		// Parse the quoted parameter value.
+		val, err := strconv.QuotedPrefix(strings.TrimSpace(rest))
+		if err != nil {
+			return h, errors.New("malformed header parameter value for " + key)
+		}
+		rest = strings.TrimSpace(rest)[len(val):]
+		val, _ = strconv.Unquote(val)
+
+		switch key {
+		case "collectionId":
+			h.CollectionID = val
+		case "url":
+			h.URL = val
+		case "digest":
+			h.Digest = strings.ToLower(val)
+		}
+
+		// Move on to the next parameter, if any.
+		rest = strings.TrimSpace(rest)
+		if rest != "" && rest[0] != ',' {
+			return h, errors.New("malformed header parameter separator")
+		}
+		value = strings.TrimSpace(strings.TrimPrefix(rest, ","))
+	}
+
+	if h.CollectionID == "" || h.URL == "" || h.Digest == "" {
+		return h, errors.New("header missing required parameter")
+	}
+
+	return h, nil
+}
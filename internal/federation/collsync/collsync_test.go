@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package collsync_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/federation/collsync"
+)
+
+func TestDigest(t *testing.T) {
+	zero := strings.Repeat("0", 64)
+
+	if d := collsync.Digest(nil); d != zero {
+		t.Fatalf("expected zero digest for empty collection, got %s", d)
+	}
+
+	a := collsync.Digest([]string{
+		"https://example.org/users/alice",
+		"https://example.org/users/bob",
+	})
+	b := collsync.Digest([]string{
+		"https://example.org/users/bob",
+		"https://example.org/users/alice",
+	})
+	if a != b {
+		t.Fatalf("expected order-independent digest, got %s and %s", a, b)
+	}
+
+	c := collsync.Digest([]string{
+		"https://example.org/users/alice",
+	})
+	if a == c {
+		t.Fatal("expected differing digests for differing collections")
+	}
+
+	if d := collsync.Digest([]string{
+		"https://example.org/users/alice",
+		"https://example.org/users/alice",
+	}); d != zero {
+		t.Fatalf("expected duplicate items to cancel out, got %s", d)
+	}
+}
+
+func TestHeaderRoundTrip(t *testing.T) {
+	h := collsync.Header{
+		CollectionID: "https://example.org/users/alice/followers",
+		URL:          "https://example.org/users/alice/followers_synchronization",
+		Digest:       collsync.Digest([]string{"https://remote.example/users/bob"}),
+	}
+
+	parsed, err := collsync.ParseHeader(h.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed != h {
+		t.Fatalf("expected %+v, got %+v", h, parsed)
+	}
+}
+
+func TestParseHeader(t *testing.T) {
+	for _, test := range []struct {
+		value string
+		valid bool
+	}{
+		{
+			value: `collectionId="https://example.org/users/alice/followers", url="https://example.org/users/alice/followers_synchronization", digest="B08AB6251C7E8B48E8A45A04A2D7D4E8FB2A5CD9D0B5D8B1BCD01C0F0BC8D5F0"`,
+			valid: true,
+		},
+		{
+			value: `digest="abc",url="https://example.org/sync",collectionId="https://example.org/followers"`,
+			valid: true,
+		},
+		{
+			value: `collectionId="https://example.org/users/alice/followers", url="https://example.org/sync"`,
+			valid: false,
+		},
+		{
+			value: `collectionId=https://example.org/users/alice/followers`,
+			valid: false,
+		},
+		{
+			value: `collectionId="https://example.org/followers" url="https://example.org/sync", digest="abc"`,
+			valid: false,
+		},
+		{
+			value: ``,
+			valid: false,
+		},
+	} {
+		_, err := collsync.ParseHeader(test.value)
+		if test.valid && err != nil {
+			t.Errorf("expected %q to parse, got error: %v", test.value, err)
+		} else if !test.valid && err == nil {
+			t.Errorf("expected %q not to parse", test.value)
+		}
+	}
+}
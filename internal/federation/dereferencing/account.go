@@ -53,7 +53,8 @@ func accountUpToDate(account *gtsmodel.Account) bool {
 	}
 
 	// If this account was updated recently (last interval), we return as-is.
-	if next := account.FetchedAt.Add(6 * time.Hour); time.Now().Before(next) {
+	freshness := config.GetInstanceRemoteAccountFreshness()
+	if next := account.FetchedAt.Add(freshness); time.Now().Before(next) {
 		return true
 	}
 
@@ -87,31 +88,24 @@ func (d *Dereferencer) GetAccountByURI(ctx context.Context, requestUser string,
 
 // getAccountByURI is a package internal form of .GetAccountByURI() that doesn't bother dereferencing featured posts on update.
 func (d *Dereferencer) getAccountByURI(ctx context.Context, requestUser string, uri *url.URL) (*gtsmodel.Account, ap.Accountable, error) {
-	var (
-		account *gtsmodel.Account
-		uriStr  = uri.String()
-		err     error
-	)
+	uriStr := uri.String()
 
 	// Search the database for existing account with URI.
-	account, err = d.state.DB.GetAccountByURI(
-		// request a barebones object, it may be in the
-		// db but with related models not yet dereferenced.
-		gtscontext.SetBarebones(ctx),
-		uriStr,
-	)
+	account, err := d.getAccountFromDB(ctx, uriStr)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		return nil, nil, gtserror.Newf("error checking database for account %s by uri: %w", uriStr, err)
+		return nil, nil, err
 	}
 
-	if account == nil {
-		// Else, search the database for existing by URL.
-		account, err = d.state.DB.GetAccountByURL(
-			gtscontext.SetBarebones(ctx),
-			uriStr,
-		)
+	if account == nil || !accountUpToDate(account) {
+		// The account needs dereferencing, so first acquire the lock for
+		// it in case this is already in progress elsewhere, then check
+		// the database again as it may have just been dereferenced.
+		unlock := d.lockDeref(ctx, "account", uriStr)
+		defer unlock()
+
+		account, err = d.getAccountFromDB(ctx, uriStr)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
-			return nil, nil, gtserror.Newf("error checking database for account %s by url: %w", uriStr, err)
+			return nil, nil, err
 		}
 	}
 
@@ -121,6 +115,12 @@ func (d *Dereferencer) getAccountByURI(ctx context.Context, requestUser string,
 			return nil, nil, gtserror.SetUnretrievable(err) // this will be db.ErrNoEntries
 		}
 
+		if movedTo := d.movedTo(ctx, uriStr); movedTo != nil {
+			// This account is known to have permanently
+			// moved, search again using the latest URI.
+			return d.getAccountByURI(ctx, requestUser, movedTo)
+		}
+
 		// Create and pass-through a new bare-bones model for dereferencing.
 		return d.enrichAccount(ctx, requestUser, uri, &gtsmodel.Account{
 			ID:     id.NewULID(),
@@ -159,6 +159,35 @@ func (d *Dereferencer) getAccountByURI(ctx context.Context, requestUser string,
 	return latest, apubAcc, nil
 }
 
+// getAccountFromDB searches the database for a barebones account model with
+// given URI, falling back to searching by URL. Returns db.ErrNoEntries
+// if no account was found.
+func (d *Dereferencer) getAccountFromDB(ctx context.Context, uriStr string) (*gtsmodel.Account, error) {
+	// Search the database for existing account with URI.
+	account, err := d.state.DB.GetAccountByURI(
+		// request a barebones object, it may be in the
+		// db but with related models not yet dereferenced.
+		gtscontext.SetBarebones(ctx),
+		uriStr,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error checking database for account %s by uri: %w", uriStr, err)
+	}
+
+	if account == nil {
+		// Else, search the database for existing by URL.
+		account, err = d.state.DB.GetAccountByURL(
+			gtscontext.SetBarebones(ctx),
+			uriStr,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("error checking database for account %s by url: %w", uriStr, err)
+		}
+	}
+
+	return account, err
+}
+
 // GetAccountByUsernameDomain will attempt to fetch an accounts by its username@domain, first checking the database. In the case of a newly-met remote model,
 // or a remote model whose last_fetched date is beyond a certain interval, the account will be dereferenced. In the case of dereferencing, some low-priority
 // account information may be enqueued for asynchronous fetching, e.g. featured account statuses (pins). An ActivityPub object indicates the account was dereferenced.
@@ -363,9 +392,13 @@ func (d *Dereferencer) enrichAccount(ctx context.Context, requestUser string, ur
 	d.startHandshake(requestUser, uri)
 	defer d.stopHandshake(requestUser, uri)
 
+	// Set if account was found to
+	// have permanently moved URI.
+	var movedTo *url.URL
+
 	if apubAcc == nil {
 		// Dereference latest version of the account.
-		b, err := tsport.Dereference(ctx, uri)
+		b, moved, err := tsport.DereferenceMoved(ctx, uri)
 		if err != nil {
 			err := gtserror.Newf("error deferencing %s: %w", uri, err)
 			return nil, nil, gtserror.SetUnretrievable(err)
@@ -376,6 +409,17 @@ func (d *Dereferencer) enrichAccount(ctx context.Context, requestUser string, ur
 		if err != nil {
 			return nil, nil, gtserror.Newf("error resolving accountable from data for account %s: %w", uri, err)
 		}
+
+		if moved != nil {
+			// The account was permanently moved, ensure the
+			// returned representation is at its new location,
+			// as we'll be taking its ID as the new account URI.
+			if err := checkMovedID(apubAcc, moved); err != nil {
+				return nil, nil, gtserror.Newf("error checking moved account %s: %w", uri, err)
+			}
+
+			movedTo = moved
+		}
 	}
 
 	// Convert the dereferenced AP account object to our GTS model.
@@ -476,6 +520,14 @@ func (d *Dereferencer) enrichAccount(ctx context.Context, requestUser string, ur
 		}
 	}
 
+	if movedTo != nil {
+		// Mark old account URI as moved, to
+		// save remote lookups in the future.
+		if err := d.handleMoved(ctx, uri, movedTo); err != nil {
+			log.Errorf(ctx, "error marking account %s as moved: %v", uri, err)
+		}
+	}
+
 	return latestAcc, apubAcc, nil
 }
 
@@ -490,6 +542,10 @@ func (d *Dereferencer) fetchRemoteAccountAvatar(ctx context.Context, tsport tran
 	// be changed if and when we have the new media loaded into storage.
 	latestAcc.AvatarMediaAttachmentID = existing.AvatarMediaAttachmentID
 
+	// Cache validators of the existing attachment, if any,
+	// used to only refetch the media when it's changed.
+	var validators transport.MediaValidators
+
 	// If we had a media attachment ID already, and the URL
 	// of the attachment hasn't changed from existing -> latest,
 	// then we may be able to just keep our existing attachment
@@ -503,11 +559,20 @@ func (d *Dereferencer) fetchRemoteAccountAvatar(ctx context.Context, tsport tran
 			return gtserror.Newf("error getting attachment %s: %w", existing.AvatarMediaAttachmentID, err)
 		}
 
-		// Ensure attachment has correct properties.
-		if media != nil && media.RemoteURL == latestAcc.AvatarRemoteURL {
-			// We already have the most up-to-date
-			// media attachment, keep using it.
-			return nil
+		// Ensure attachment has correct properties, taking into
+		// account the media may have since permanently moved.
+		if media != nil && media.RemoteURL == d.movedToStr(ctx, latestAcc.AvatarRemoteURL) {
+			if !*media.Cached || (media.RemoteETag == "" && media.RemoteLastModified == "") {
+				// We already have the most up-to-date
+				// media attachment, keep using it.
+				return nil
+			}
+
+			// We can cheaply check if the media itself has
+			// changed at the same URL, using a conditional
+			// request with the validators we stored for it.
+			validators.ETag = media.RemoteETag
+			validators.LastModified = media.RemoteLastModified
 		}
 	}
 
@@ -520,49 +585,58 @@ func (d *Dereferencer) fetchRemoteAccountAvatar(ctx context.Context, tsport tran
 		return gtserror.Newf("error parsing url %s: %w", latestAcc.AvatarRemoteURL, err)
 	}
 
-	// Acquire lock for derefs map.
-	unlock := d.derefAvatarsMu.Lock()
-	defer unlock()
-
-	// Look for an existing dereference in progress.
-	processing, ok := d.derefAvatars[latestAcc.AvatarRemoteURL]
+	// Fetch directly from the latest
+	// URI if the media has since moved.
+	if movedTo := d.movedTo(ctx, latestAcc.AvatarRemoteURL); movedTo != nil {
+		avatarURI = movedTo
+	}
 
-	if !ok {
-		var err error
+	// Set if media was found to
+	// have permanently moved URI.
+	var movedTo *url.URL
 
+	// Look for an existing dereference in progress, else start a new one.
+	processing, done, err := d.derefAvatars.LoadOrStore(latestAcc.AvatarRemoteURL, func() (*media.ProcessingMedia, error) {
 		// Set the media data function to dereference avatar from URI.
 		data := func(ctx context.Context) (io.ReadCloser, int64, error) {
-			return tsport.DereferenceMedia(ctx, avatarURI)
+			rc, sz, moved, err := tsport.DereferenceMediaConditional(ctx, avatarURI, &validators)
+			movedTo = moved
+			return rc, sz, err
 		}
 
 		// Create new media processing request from the media manager instance.
-		processing, err = d.mediaManager.PreProcessMedia(ctx, data, latestAcc.ID, &media.AdditionalMediaInfo{
+		return d.mediaManager.PreProcessMedia(ctx, data, latestAcc.ID, &media.AdditionalMediaInfo{
 			Avatar:    func() *bool { v := true; return &v }(),
 			RemoteURL: &latestAcc.AvatarRemoteURL,
 		})
-		if err != nil {
-			return gtserror.Newf("error preprocessing media for attachment %s: %w", latestAcc.AvatarRemoteURL, err)
-		}
-
-		// Store media in map to mark as processing.
-		d.derefAvatars[latestAcc.AvatarRemoteURL] = processing
-
-		defer func() {
-			// On exit safely remove media from map.
-			unlock := d.derefAvatarsMu.Lock()
-			delete(d.derefAvatars, latestAcc.AvatarRemoteURL)
-			unlock()
-		}()
+	})
+	if err != nil {
+		return gtserror.Newf("error preprocessing media for attachment %s: %w", latestAcc.AvatarRemoteURL, err)
 	}
 
-	// Unlock map.
-	unlock()
+	// On exit mark dereference as done.
+	defer done()
 
 	// Start media attachment loading (blocking call).
-	if _, err := processing.LoadAttachment(ctx); err != nil {
+	attachment, err := processing.LoadAttachment(ctx)
+	if err != nil {
+		if errors.Is(err, transport.ErrNotModified) {
+			// Media hasn't changed since we last
+			// fetched it, keep existing attachment.
+			return nil
+		}
 		return gtserror.Newf("error loading attachment %s: %w", latestAcc.AvatarRemoteURL, err)
 	}
 
+	// Store validators of the fetched
+	// media for conditional refetches.
+	d.storeMediaValidators(ctx, attachment, validators)
+
+	if movedTo != nil {
+		// Update attachment to use the new media location.
+		d.handleMovedMedia(ctx, attachment, avatarURI, movedTo)
+	}
+
 	// Set the newly loaded avatar media attachment ID.
 	latestAcc.AvatarMediaAttachmentID = processing.AttachmentID()
 
@@ -580,6 +654,10 @@ func (d *Dereferencer) fetchRemoteAccountHeader(ctx context.Context, tsport tran
 	// be changed if and when we have the new media loaded into storage.
 	latestAcc.HeaderMediaAttachmentID = existing.HeaderMediaAttachmentID
 
+	// Cache validators of the existing attachment, if any,
+	// used to only refetch the media when it's changed.
+	var validators transport.MediaValidators
+
 	// If we had a media attachment ID already, and the URL
 	// of the attachment hasn't changed from existing -> latest,
 	// then we may be able to just keep our existing attachment
@@ -593,11 +671,20 @@ func (d *Dereferencer) fetchRemoteAccountHeader(ctx context.Context, tsport tran
 			return gtserror.Newf("error getting attachment %s: %w", existing.HeaderMediaAttachmentID, err)
 		}
 
-		// Ensure attachment has correct properties.
-		if media != nil && media.RemoteURL == latestAcc.HeaderRemoteURL {
-			// We already have the most up-to-date
-			// media attachment, keep using it.
-			return nil
+		// Ensure attachment has correct properties, taking into
+		// account the media may have since permanently moved.
+		if media != nil && media.RemoteURL == d.movedToStr(ctx, latestAcc.HeaderRemoteURL) {
+			if !*media.Cached || (media.RemoteETag == "" && media.RemoteLastModified == "") {
+				// We already have the most up-to-date
+				// media attachment, keep using it.
+				return nil
+			}
+
+			// We can cheaply check if the media itself has
+			// changed at the same URL, using a conditional
+			// request with the validators we stored for it.
+			validators.ETag = media.RemoteETag
+			validators.LastModified = media.RemoteLastModified
 		}
 	}
 
@@ -610,55 +697,80 @@ func (d *Dereferencer) fetchRemoteAccountHeader(ctx context.Context, tsport tran
 		return gtserror.Newf("error parsing url %s: %w", latestAcc.HeaderRemoteURL, err)
 	}
 
-	// Acquire lock for derefs map.
-	unlock := d.derefHeadersMu.Lock()
-	defer unlock()
-
-	// Look for an existing dereference in progress.
-	processing, ok := d.derefHeaders[latestAcc.HeaderRemoteURL]
+	// Fetch directly from the latest
+	// URI if the media has since moved.
+	if movedTo := d.movedTo(ctx, latestAcc.HeaderRemoteURL); movedTo != nil {
+		headerURI = movedTo
+	}
 
-	if !ok {
-		var err error
+	// Set if media was found to
+	// have permanently moved URI.
+	var movedTo *url.URL
 
-		// Set the media data function to dereference avatar from URI.
+	// Look for an existing dereference in progress, else start a new one.
+	processing, done, err := d.derefHeaders.LoadOrStore(latestAcc.HeaderRemoteURL, func() (*media.ProcessingMedia, error) {
+		// Set the media data function to dereference header from URI.
 		data := func(ctx context.Context) (io.ReadCloser, int64, error) {
-			return tsport.DereferenceMedia(ctx, headerURI)
+			rc, sz, moved, err := tsport.DereferenceMediaConditional(ctx, headerURI, &validators)
+			movedTo = moved
+			return rc, sz, err
 		}
 
 		// Create new media processing request from the media manager instance.
-		processing, err = d.mediaManager.PreProcessMedia(ctx, data, latestAcc.ID, &media.AdditionalMediaInfo{
+		return d.mediaManager.PreProcessMedia(ctx, data, latestAcc.ID, &media.AdditionalMediaInfo{
 			Header:    func() *bool { v := true; return &v }(),
 			RemoteURL: &latestAcc.HeaderRemoteURL,
 		})
-		if err != nil {
-			return gtserror.Newf("error preprocessing media for attachment %s: %w", latestAcc.HeaderRemoteURL, err)
-		}
-
-		// Store media in map to mark as processing.
-		d.derefHeaders[latestAcc.HeaderRemoteURL] = processing
-
-		defer func() {
-			// On exit safely remove media from map.
-			unlock := d.derefHeadersMu.Lock()
-			delete(d.derefHeaders, latestAcc.HeaderRemoteURL)
-			unlock()
-		}()
+	})
+	if err != nil {
+		return gtserror.Newf("error preprocessing media for attachment %s: %w", latestAcc.HeaderRemoteURL, err)
 	}
 
-	// Unlock map.
-	unlock()
+	// On exit mark dereference as done.
+	defer done()
 
 	// Start media attachment loading (blocking call).
-	if _, err := processing.LoadAttachment(ctx); err != nil {
+	attachment, err := processing.LoadAttachment(ctx)
+	if err != nil {
+		if errors.Is(err, transport.ErrNotModified) {
+			// Media hasn't changed since we last
+			// fetched it, keep existing attachment.
+			return nil
+		}
 		return gtserror.Newf("error loading attachment %s: %w", latestAcc.HeaderRemoteURL, err)
 	}
 
+	// Store validators of the fetched
+	// media for conditional refetches.
+	d.storeMediaValidators(ctx, attachment, validators)
+
+	if movedTo != nil {
+		// Update attachment to use the new media location.
+		d.handleMovedMedia(ctx, attachment, headerURI, movedTo)
+	}
+
 	// Set the newly loaded avatar media attachment ID.
 	latestAcc.HeaderMediaAttachmentID = processing.AttachmentID()
 
 	return nil
 }
 
+// storeMediaValidators stores the given cache validators, returned
+// by a remote server when the attachment was fetched, on the given
+// attachment, so that it can later be refetched conditionally.
+func (d *Dereferencer) storeMediaValidators(ctx context.Context, attachment *gtsmodel.MediaAttachment, validators transport.MediaValidators) {
+	if validators.IsZero() {
+		// Nothing to store.
+		return
+	}
+
+	attachment.RemoteETag = validators.ETag
+	attachment.RemoteLastModified = validators.LastModified
+	if err := d.state.DB.UpdateAttachment(ctx, attachment, "remote_etag", "remote_last_modified"); err != nil {
+		log.Errorf(ctx, "error updating attachment %s cache validators: %v", attachment.ID, err)
+	}
+}
+
 func (d *Dereferencer) fetchRemoteAccountEmojis(ctx context.Context, targetAccount *gtsmodel.Account, requestingUsername string) (bool, error) {
 	maybeEmojis := targetAccount.Emojis
 	maybeEmojiIDs := targetAccount.EmojiIDs
@@ -860,6 +972,9 @@ func (d *Dereferencer) dereferenceAccountFeatured(ctx context.Context, requestUs
 		// we still know it was *meant* to be pinned.
 		statusURIs = append(statusURIs, statusURI)
 
+		// Keep pins in the order of the collection.
+		pinOrder := len(statusURIs)
+
 		status, _, err := d.getStatusByURI(ctx, requestUser, statusURI)
 		if err != nil {
 			// We couldn't get the status, bummer. Just log + move on, we can try later.
@@ -867,8 +982,9 @@ func (d *Dereferencer) dereferenceAccountFeatured(ctx context.Context, requestUs
 			continue
 		}
 
-		// If the status was already pinned, we don't need to do anything.
-		if !status.PinnedAt.IsZero() {
+		// If the status was already pinned in
+		// this position, we don't need to do anything.
+		if !status.PinnedAt.IsZero() && status.PinOrder == pinOrder {
 			continue
 		}
 
@@ -886,8 +1002,11 @@ func (d *Dereferencer) dereferenceAccountFeatured(ctx context.Context, requestUs
 
 		// All conditions are met for this status to
 		// be pinned, so we can finally update it.
-		status.PinnedAt = time.Now()
-		if err := d.state.DB.UpdateStatus(ctx, status, "pinned_at"); err != nil {
+		if status.PinnedAt.IsZero() {
+			status.PinnedAt = time.Now()
+		}
+		status.PinOrder = pinOrder
+		if err := d.state.DB.UpdateStatus(ctx, status, "pinned_at", "pin_order"); err != nil {
 			log.Errorf(ctx, "error updating status in featured collection %s: %v", status.URI, err)
 			continue
 		}
@@ -908,7 +1027,8 @@ outerLoop:
 		// Status was pinned before, but is not included
 		// in most recent pinned uris, so unpin it now.
 		status.PinnedAt = time.Time{}
-		if err := d.state.DB.UpdateStatus(ctx, status, "pinned_at"); err != nil {
+		status.PinOrder = 0
+		if err := d.state.DB.UpdateStatus(ctx, status, "pinned_at", "pin_order"); err != nil {
 			log.Errorf(ctx, "error unpinning status %s: %v", status.URI, err)
 			continue
 		}
@@ -81,6 +81,47 @@ func (suite *AccountTestSuite) TestDereferenceService() {
 	suite.Equal(service.ID, dbService.ID)
 	suite.Equal(ap.ActorService, dbService.ActorType)
 	suite.Equal("example.org", dbService.Domain)
+
+	// The service was webfingered at its host, which is an
+	// alias of the canonical account on example.org. Both
+	// lookups should have been confirmed and cached.
+	for _, key := range []string{
+		"rgh@owncast.example.org",
+		"rgh@example.org",
+	} {
+		res, ok := suite.state.Caches.GTS.WebfingerResults().Get(key)
+		if suite.True(ok, key) {
+			suite.Equal("example.org", res.AccountDomain)
+			suite.Equal("https://owncast.example.org/federation/user/rgh", res.AccountURI)
+		}
+	}
+}
+
+func (suite *AccountTestSuite) TestDereferenceUnknownAccountCachesWebfingerMiss() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	fetchedAccount, _, err := suite.dereferencer.GetAccountByUsernameDomain(
+		context.Background(),
+		fetchingAccount.Username,
+		"nobody",
+		"unknown-instance.com",
+	)
+	suite.Error(err)
+	suite.Nil(fetchedAccount)
+
+	// The failed lookup should be remembered.
+	_, ok := suite.state.Caches.GTS.WebfingerMisses().Get("nobody@unknown-instance.com")
+	suite.True(ok)
+
+	// And trying again should fail the same way,
+	// but without another webfinger request.
+	_, _, err = suite.dereferencer.GetAccountByUsernameDomain(
+		context.Background(),
+		fetchingAccount.Username,
+		"nobody",
+		"unknown-instance.com",
+	)
+	suite.ErrorContains(err, "recently failed")
 }
 
 /*
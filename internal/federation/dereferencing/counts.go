@@ -0,0 +1,143 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+)
+
+// RefreshStatusCounts refreshes the remote totals of faves and boosts
+// of the given (remote) status, from the totalItems of its likes and
+// shares collections, dereferencing these if not embedded in the status.
+// The status' counts are marked as fetched even where no totals could
+// be found, so that they're not refetched before they're next due.
+func (d *Dereferencer) RefreshStatusCounts(ctx context.Context, requestUser string, status *gtsmodel.Status) error {
+	if *status.Local {
+		// Local status counts
+		// are always up-to-date.
+		return nil
+	}
+
+	uri, err := url.Parse(status.URI)
+	if err != nil {
+		return gtserror.Newf("invalid status uri %q: %w", status.URI, err)
+	}
+
+	if blocked, err := d.state.DB.IsDomainBlocked(ctx, uri.Host); err != nil {
+		return gtserror.Newf("error checking blocked domain: %w", err)
+	} else if blocked {
+		return gtserror.Newf("%s is blocked", uri.Host)
+	}
+
+	tsport, err := d.transportController.NewTransportForUsername(ctx, requestUser)
+	if err != nil {
+		return gtserror.Newf("couldn't create transport: %w", err)
+	}
+
+	b, err := tsport.Dereference(ctx, uri)
+	if err != nil {
+		return gtserror.Newf("error dereferencing %s: %w", uri, err)
+	}
+
+	statusable, err := ap.ResolveStatusable(ctx, b)
+	if err != nil {
+		return gtserror.Newf("error resolving statusable %s: %w", uri, err)
+	}
+
+	if withLikes, ok := statusable.(ap.WithLikes); ok {
+		total, iri, ok := ap.ExtractLikesTotal(withLikes)
+		if !ok && iri != nil {
+			total, ok = d.dereferenceCollectionTotal(ctx, tsport, uri, iri)
+		}
+		if ok {
+			status.RemoteFavesCount = total
+		}
+	}
+
+	if withShares, ok := statusable.(ap.WithShares); ok {
+		total, iri, ok := ap.ExtractSharesTotal(withShares)
+		if !ok && iri != nil {
+			total, ok = d.dereferenceCollectionTotal(ctx, tsport, uri, iri)
+		}
+		if ok {
+			status.RemoteBoostsCount = total
+		}
+	}
+
+	status.CountsFetchedAt = time.Now()
+	if err := d.state.DB.UpdateStatus(ctx, status,
+		"remote_faves_count",
+		"remote_boosts_count",
+		"counts_fetched_at",
+	); err != nil {
+		return gtserror.Newf("error updating status counts: %w", err)
+	}
+
+	return nil
+}
+
+// dereferenceCollectionTotal dereferences the collection at given IRI,
+// which must be on the same host as the status it belongs to, returning
+// its totalItems. Returns false if it couldn't be fetched or had no total.
+func (d *Dereferencer) dereferenceCollectionTotal(
+	ctx context.Context,
+	tsport transport.Transport,
+	statusURI *url.URL,
+	iri *url.URL,
+) (int, bool) {
+	if iri.Host != statusURI.Host {
+		log.Debugf(ctx, "collection %s not on host of status %s", iri, statusURI)
+		return 0, false
+	}
+
+	b, err := tsport.Dereference(ctx, iri)
+	if err != nil {
+		log.Debugf(ctx, "error dereferencing collection %s: %v", iri, err)
+		return 0, false
+	}
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(b, &m); err != nil {
+		log.Debugf(ctx, "error unmarshalling collection %s: %v", iri, err)
+		return 0, false
+	}
+
+	t, err := streams.ToType(ctx, m)
+	if err != nil {
+		log.Debugf(ctx, "error resolving collection %s: %v", iri, err)
+		return 0, false
+	}
+
+	withTotalItems, ok := t.(ap.WithTotalItems)
+	if !ok {
+		log.Debugf(ctx, "collection %s type %T has no totalItems", iri, t)
+		return 0, false
+	}
+
+	return ap.ExtractTotalItems(withTotalItems)
+}
@@ -21,7 +21,6 @@ import (
 	"net/url"
 	"sync"
 
-	"codeberg.org/gruf/go-mutexes"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
@@ -35,12 +34,9 @@ type Dereferencer struct {
 	converter           *typeutils.Converter
 	transportController transport.Controller
 	mediaManager        *media.Manager
-	derefAvatars        map[string]*media.ProcessingMedia
-	derefAvatarsMu      mutexes.Mutex
-	derefHeaders        map[string]*media.ProcessingMedia
-	derefHeadersMu      mutexes.Mutex
-	derefEmojis         map[string]*media.ProcessingEmoji
-	derefEmojisMu       mutexes.Mutex
+	derefAvatars        *derefs[*media.ProcessingMedia] // avatar dereferences in progress, by remote URL
+	derefHeaders        *derefs[*media.ProcessingMedia] // header dereferences in progress, by remote URL
+	derefEmojis         *derefs[*media.ProcessingEmoji] // emoji dereferences in progress, by shortcode@domain
 	handshakes          map[string][]*url.URL
 	handshakesMu        sync.Mutex // mutex to lock/unlock when checking or updating the handshakes map
 }
@@ -57,15 +53,9 @@ func NewDereferencer(
 		converter:           converter,
 		transportController: transportController,
 		mediaManager:        mediaManager,
-		derefAvatars:        make(map[string]*media.ProcessingMedia),
-		derefHeaders:        make(map[string]*media.ProcessingMedia),
-		derefEmojis:         make(map[string]*media.ProcessingEmoji),
+		derefAvatars:        newDerefs[*media.ProcessingMedia](),
+		derefHeaders:        newDerefs[*media.ProcessingMedia](),
+		derefEmojis:         newDerefs[*media.ProcessingEmoji](),
 		handshakes:          make(map[string][]*url.URL),
-
-		// use wrapped mutexes to allow safely deferring unlock
-		// even when more granular locks are required (only unlocks once).
-		derefAvatarsMu: mutexes.WithSafety(mutexes.New()),
-		derefHeadersMu: mutexes.WithSafety(mutexes.New()),
-		derefEmojisMu:  mutexes.WithSafety(mutexes.New()),
 	}
 }
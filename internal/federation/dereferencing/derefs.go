@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"codeberg.org/gruf/go-cache/v3/ttl"
+)
+
+const (
+	// derefsTTL is how long an entry for a media dereference
+	// in progress is kept for if it's never marked as done,
+	// eg., because the routine waiting on it is stuck.
+	derefsTTL = 10 * time.Minute
+
+	// derefsCap is the maximum number of media dereferences
+	// in progress tracked at once, beyond which the oldest
+	// entries are evicted.
+	derefsCap = 1000
+)
+
+// derefs tracks media dereferences in progress by key (eg., remote URL),
+// so that concurrent requests for the same media share one dereference.
+// Entries are removed by the done callback returned with them, but also
+// expire after derefsTTL, and are evicted oldest first beyond derefsCap,
+// so that abandoned entries can't pile up over time on busy instances.
+type derefs[T comparable] struct {
+	cache *ttl.Cache[string, T]
+	mutex sync.Mutex // protects check-then-store in LoadOrStore
+}
+
+// newDerefs returns a new, empty derefs.
+func newDerefs[T comparable]() *derefs[T] {
+	return &derefs[T]{cache: ttl.New[string, T](0, derefsCap, derefsTTL)}
+}
+
+// LoadOrStore returns the dereference in progress with the given key,
+// else stores and returns a new one made by create. The returned done
+// function removes the entry, and should be called once the caller is
+// finished waiting on the dereference, whether it succeeded or not.
+func (d *derefs[T]) LoadOrStore(key string, create func() (T, error)) (T, func(), error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	// Drop any abandoned entries
+	// that have expired by now.
+	d.cache.Sweep(time.Time{})
+
+	v, ok := peek(d.cache, key)
+	if !ok {
+		var err error
+
+		// None in progress, start one.
+		v, err = create()
+		if err != nil {
+			return v, nil, err
+		}
+
+		d.cache.Set(key, v)
+	}
+
+	return v, func() { d.remove(key, v) }, nil
+}
+
+// remove removes the entry with the given key, if it's
+// still v rather than a newer dereference stored since.
+func (d *derefs[T]) remove(key string, v T) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if cur, ok := peek(d.cache, key); ok && cur == v {
+		d.cache.Invalidate(key)
+	}
+}
+
+// Keys returns the sorted keys of
+// the dereferences in progress.
+func (d *derefs[T]) Keys() []string {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	// Don't report expired entries.
+	d.cache.Sweep(time.Time{})
+
+	d.cache.Lock()
+	keys := make([]string, 0, d.cache.Cache.Len())
+	d.cache.Cache.Range(0, d.cache.Cache.Len(), func(_ int, key string, _ *ttl.Entry[string, T]) {
+		keys = append(keys, key)
+	})
+	d.cache.Unlock()
+
+	sort.Strings(keys)
+	return keys
+}
+
+// Len returns the number of
+// dereferences in progress.
+func (d *derefs[T]) Len() int {
+	return d.cache.Len()
+}
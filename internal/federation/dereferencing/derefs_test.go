@@ -0,0 +1,115 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DerefsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *DerefsTestSuite) TestLoadOrStoreShared() {
+	d := newDerefs[*int]()
+
+	created := 0
+	create := func() (*int, error) {
+		created++
+		return &created, nil
+	}
+
+	v1, done1, err := d.LoadOrStore("https://example.org/avatar.png", create)
+	suite.NoError(err)
+
+	// Second caller should share the first's entry.
+	v2, done2, err := d.LoadOrStore("https://example.org/avatar.png", create)
+	suite.NoError(err)
+	suite.Same(v1, v2)
+	suite.Equal(1, created)
+	suite.Equal([]string{"https://example.org/avatar.png"}, d.Keys())
+
+	// Entry should be gone once done,
+	// and calling done again is harmless.
+	done1()
+	done2()
+	suite.Zero(d.Len())
+}
+
+func (suite *DerefsTestSuite) TestLoadOrStoreError() {
+	d := newDerefs[*int]()
+
+	_, _, err := d.LoadOrStore("https://example.org/avatar.png", func() (*int, error) {
+		return nil, errors.New("oopsie")
+	})
+	suite.EqualError(err, "oopsie")
+	suite.Zero(d.Len())
+}
+
+func (suite *DerefsTestSuite) TestDoneStale() {
+	d := newDerefs[*int]()
+
+	v1, done1, err := d.LoadOrStore("key", func() (*int, error) { return new(int), nil })
+	suite.NoError(err)
+	done1()
+
+	v2, done2, err := d.LoadOrStore("key", func() (*int, error) { return new(int), nil })
+	suite.NoError(err)
+	suite.NotSame(v1, v2)
+
+	// A stale done callback shouldn't
+	// remove the newer entry.
+	done1()
+	suite.Equal([]string{"key"}, d.Keys())
+
+	done2()
+	suite.Zero(d.Len())
+}
+
+func (suite *DerefsTestSuite) TestAbandonedExpire() {
+	d := newDerefs[*int]()
+	d.cache.SetTTL(time.Millisecond, false)
+
+	// Never call done.
+	_, _, err := d.LoadOrStore("key", func() (*int, error) { return new(int), nil })
+	suite.NoError(err)
+
+	time.Sleep(5 * time.Millisecond)
+	suite.Empty(d.Keys())
+	suite.Zero(d.Len())
+}
+
+func (suite *DerefsTestSuite) TestCapacity() {
+	d := newDerefs[*int]()
+
+	for i := 0; i < derefsCap+10; i++ {
+		_, _, err := d.LoadOrStore(strconv.Itoa(i), func() (*int, error) { return new(int), nil })
+		suite.NoError(err)
+	}
+
+	suite.Equal(derefsCap, d.Len())
+}
+
+func TestDerefsTestSuite(t *testing.T) {
+	suite.Run(t, &DerefsTestSuite{})
+}
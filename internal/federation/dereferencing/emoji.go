@@ -31,21 +31,10 @@ import (
 )
 
 func (d *Dereferencer) GetRemoteEmoji(ctx context.Context, requestingUsername string, remoteURL string, shortcode string, domain string, id string, emojiURI string, ai *media.AdditionalEmojiInfo, refresh bool) (*media.ProcessingEmoji, error) {
-	var (
-		shortcodeDomain = shortcode + "@" + domain
-		processingEmoji *media.ProcessingEmoji
-	)
-
-	// Acquire lock for derefs map.
-	unlock := d.derefEmojisMu.Lock()
-	defer unlock()
-
-	// first check if we're already processing this emoji
-	if alreadyProcessing, ok := d.derefEmojis[shortcodeDomain]; ok {
-		// we're already on it, no worries
-		processingEmoji = alreadyProcessing
-	} else {
-		// not processing it yet, let's start
+	shortcodeDomain := shortcode + "@" + domain
+
+	// check if we're already processing this emoji, else start
+	processingEmoji, done, err := d.derefEmojis.LoadOrStore(shortcodeDomain, func() (*media.ProcessingEmoji, error) {
 		t, err := d.transportController.NewTransportForUsername(ctx, requestingUsername)
 		if err != nil {
 			return nil, fmt.Errorf("GetRemoteEmoji: error creating transport to fetch emoji %s: %s", shortcodeDomain, err)
@@ -57,7 +46,8 @@ func (d *Dereferencer) GetRemoteEmoji(ctx context.Context, requestingUsername st
 		}
 
 		dataFunc := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
-			return t.DereferenceMedia(innerCtx, derefURI)
+			rc, sz, _, err := t.DereferenceMedia(innerCtx, derefURI)
+			return rc, sz, err
 		}
 
 		newProcessing, err := d.mediaManager.PreProcessEmoji(ctx, dataFunc, shortcode, id, emojiURI, ai, refresh)
@@ -65,20 +55,14 @@ func (d *Dereferencer) GetRemoteEmoji(ctx context.Context, requestingUsername st
 			return nil, fmt.Errorf("GetRemoteEmoji: error processing emoji %s: %s", shortcodeDomain, err)
 		}
 
-		// store it in our map to indicate it's in process
-		d.derefEmojis[shortcodeDomain] = newProcessing
-		processingEmoji = newProcessing
+		return newProcessing, nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Unlock map.
-	unlock()
-
-	defer func() {
-		// On exit safely remove emoji from map.
-		unlock := d.derefEmojisMu.Lock()
-		delete(d.derefEmojis, shortcodeDomain)
-		unlock()
-	}()
+	// On exit mark emoji as done.
+	defer done()
 
 	// Start emoji attachment loading (blocking call).
 	if _, err := processingEmoji.LoadEmoji(ctx); err != nil {
@@ -25,12 +25,99 @@ import (
 	"net/url"
 	"strings"
 
+	"codeberg.org/gruf/go-cache/v3/ttl"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// fingerRemoteAccount webfingers the given account, returning the domain of the account (which
+// may differ from targetHost) and its ActivityPub URI. Results of recent lookups, successful or
+// not, are cached, so that eg. repeatedly resolving mentions of an account doesn't hammer its server.
 func (d *Dereferencer) fingerRemoteAccount(ctx context.Context, transport transport.Transport, targetUsername string, targetHost string) (accountDomain string, accountURI *url.URL, err error) {
+	key := targetUsername + "@" + targetHost
+
+	// Check for a recent successful lookup.
+	if res, ok := peek(d.state.Caches.GTS.WebfingerResults(), key); ok {
+		accountURI, err = url.Parse(res.AccountURI)
+		if err != nil {
+			return "", nil, fmt.Errorf("fingerRemoteAccount: error parsing cached account uri: %w", err)
+		}
+		return res.AccountDomain, accountURI, nil
+	}
+
+	// Check for a recent failed lookup.
+	if msg, ok := peek(d.state.Caches.GTS.WebfingerMisses(), key); ok {
+		return "", nil, fmt.Errorf("fingerRemoteAccount: fingering @%s recently failed: %s", key, msg)
+	}
+
+	accountDomain, accountURI, err = d.fingerAndConfirm(ctx, transport, targetUsername, targetHost)
+	if err != nil {
+		if ctx.Err() == nil {
+			// Only remember failures which
+			// weren't down to us giving up.
+			d.state.Caches.GTS.WebfingerMisses().Set(key, err.Error())
+		}
+		return "", nil, err
+	}
+
+	d.state.Caches.GTS.WebfingerResults().Set(key, cache.WebfingerResult{
+		AccountDomain: accountDomain,
+		AccountURI:    accountURI.String(),
+	})
+
+	return accountDomain, accountURI, nil
+}
+
+// fingerAndConfirm webfingers the given account. If the webfinger subject is on a
+// different domain than targetHost, ie. the looked up account is an alias of one on
+// a canonical domain, the canonical domain is webfingered too, to confirm that the
+// account is really theirs. This stops domains from claiming accounts on others.
+func (d *Dereferencer) fingerAndConfirm(ctx context.Context, transport transport.Transport, targetUsername string, targetHost string) (string, *url.URL, error) {
+	subjectUsername, accountDomain, accountURI, err := d.finger(ctx, transport, targetUsername, targetHost)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if strings.EqualFold(accountDomain, targetHost) {
+		// Not an alias.
+		return accountDomain, accountURI, nil
+	}
+
+	key := subjectUsername + "@" + accountDomain
+
+	// The canonical account may well have been looked up recently.
+	res, ok := peek(d.state.Caches.GTS.WebfingerResults(), key)
+	if !ok {
+		canonicalUsername, canonicalDomain, canonicalURI, err := d.finger(ctx, transport, subjectUsername, accountDomain)
+		if err != nil {
+			return "", nil, fmt.Errorf("fingerRemoteAccount: error confirming @%s@%s is an alias of @%s: %w", targetUsername, targetHost, key, err)
+		}
+
+		if canonicalUsername+"@"+canonicalDomain != key {
+			// Only follow one alias, else
+			// this could go on for ever.
+			return "", nil, fmt.Errorf("fingerRemoteAccount: @%s is itself an alias of @%s@%s", key, canonicalUsername, canonicalDomain)
+		}
+
+		res = cache.WebfingerResult{
+			AccountDomain: canonicalDomain,
+			AccountURI:    canonicalURI.String(),
+		}
+		d.state.Caches.GTS.WebfingerResults().Set(key, res)
+	}
+
+	if res.AccountURI != accountURI.String() {
+		return "", nil, fmt.Errorf("fingerRemoteAccount: @%s@%s claims to be @%s, but that is %s, not %s", targetUsername, targetHost, key, res.AccountURI, accountURI)
+	}
+
+	return accountDomain, accountURI, nil
+}
+
+// finger webfingers the given account, returning the username and
+// domain of the webfinger subject, and the ActivityPub URI of the account.
+func (d *Dereferencer) finger(ctx context.Context, transport transport.Transport, targetUsername string, targetHost string) (subjectUsername string, subjectDomain string, accountURI *url.URL, err error) {
 	b, err := transport.Finger(ctx, targetUsername, targetHost)
 	if err != nil {
 		err = fmt.Errorf("fingerRemoteAccount: error fingering @%s@%s: %s", targetUsername, targetHost, err)
@@ -53,9 +140,10 @@ func (d *Dereferencer) fingerRemoteAccount(ctx context.Context, transport transp
 		return
 	}
 
-	_, accountDomain, err = util.ExtractWebfingerParts(resp.Subject)
+	subjectUsername, subjectDomain, err = util.ExtractWebfingerParts(resp.Subject)
 	if err != nil {
 		err = fmt.Errorf("fingerRemoteAccount: error extracting webfinger subject parts: %s", err)
+		return
 	}
 
 	// look through the links for the first one that matches what we need
@@ -69,5 +157,21 @@ func (d *Dereferencer) fingerRemoteAccount(ctx context.Context, transport transp
 		}
 	}
 
-	return "", nil, errors.New("fingerRemoteAccount: no match found in webfinger response")
+	return "", "", nil, errors.New("fingerRemoteAccount: no match found in webfinger response")
+}
+
+// peek gets the value for key from the given cache, without
+// extending its expiry like ttl.Cache{}.Get() does. Cached
+// webfinger results should be refreshed once in a while.
+func peek[V any](c *ttl.Cache[string, V], key string) (V, bool) {
+	c.Lock()
+	entry, ok := c.Cache.Get(key)
+	c.Unlock()
+
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return entry.Value, true
 }
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+// InFlight is a snapshot of the dereferences
+// in progress at a given moment, for debugging.
+type InFlight struct {
+	// Handshakes maps usernames of local accounts to URIs of
+	// remote accounts they're currently handshaking with.
+	Handshakes map[string][]string
+
+	// Avatars, Headers and Emojis contain the remote
+	// URLs (or shortcode@domain for emojis) of media
+	// currently being dereferenced.
+	Avatars []string
+	Headers []string
+	Emojis  []string
+}
+
+// InFlight returns a snapshot of the handshakes and
+// media dereferences currently in progress. This is
+// only intended for debugging stuck dereferences.
+func (d *Dereferencer) InFlight() InFlight {
+	var inflight InFlight
+
+	d.handshakesMu.Lock()
+	inflight.Handshakes = make(map[string][]string, len(d.handshakes))
+	for username, remoteIDs := range d.handshakes {
+		ids := make([]string, 0, len(remoteIDs))
+		for _, id := range remoteIDs {
+			ids = append(ids, id.String())
+		}
+		inflight.Handshakes[username] = ids
+	}
+	d.handshakesMu.Unlock()
+
+	inflight.Avatars = d.derefAvatars.Keys()
+	inflight.Headers = d.derefHeaders.Keys()
+	inflight.Emojis = d.derefEmojis.Keys()
+
+	return inflight
+}
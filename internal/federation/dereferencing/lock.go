@@ -0,0 +1,55 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// derefLockTimeout is the max time we wait for another
+// dereference of the same remote item to finish, after
+// which we just go ahead and dereference it ourselves.
+//
+// This also breaks deadlocks between processes, eg., when
+// a remote instance fetches one of our accounts from another
+// process while we're dereferencing its instance account, and
+// that process in turn tries to dereference it to verify the
+// request signature.
+const derefLockTimeout = 15 * time.Second
+
+// lockDeref acquires the lock for dereferencing the remote item of given
+// kind with given URI, shared with other processes where the database
+// supports it, so that the same item isn't dereferenced more than once
+// at a time. The returned function releases the lock, and must be called.
+func (d *Dereferencer) lockDeref(ctx context.Context, kind string, uri string) func() {
+	lockCtx, cancel := context.WithTimeout(ctx, derefLockTimeout)
+	defer cancel()
+
+	unlock, err := d.state.DB.Lock(lockCtx, "deref "+kind+" "+uri)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Warnf(ctx, "couldn't lock %s %s, dereferencing anyway: %v", kind, uri, err)
+		}
+		return func() {}
+	}
+
+	return unlock
+}
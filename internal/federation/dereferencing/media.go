@@ -42,7 +42,8 @@ func (d *Dereferencer) GetRemoteMedia(ctx context.Context, requestingUsername st
 	}
 
 	dataFunc := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
-		return t.DereferenceMedia(innerCtx, derefURI)
+		rc, sz, _, err := t.DereferenceMedia(innerCtx, derefURI)
+		return rc, sz, err
 	}
 
 	processingMedia, err := d.mediaManager.ProcessMedia(ctx, dataFunc, accountID, ai)
@@ -0,0 +1,187 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// maxMovedHops is the maximum number of moved
+// tombstones that will be followed when resolving
+// the latest URI of a permanently moved object.
+const maxMovedHops = 5
+
+// movedTo checks the database for tombstones marking the object (or media) at
+// the given URI as having permanently moved, returning the latest URI it moved
+// to, following any chain of moves. Returns nil if the object has not moved.
+func (d *Dereferencer) movedTo(ctx context.Context, uri string) *url.URL {
+	var latest string
+
+	for i := 0; i < maxMovedHops; i++ {
+		tombstone, err := d.state.DB.GetTombstoneByURI(ctx, uri)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "error getting tombstone %s: %v", uri, err)
+			}
+			break
+		}
+
+		if !tombstone.Moved() {
+			// Object was deleted
+			// rather than moved.
+			break
+		}
+
+		uri = tombstone.MovedToURI
+		latest = uri
+	}
+
+	if latest == "" {
+		// Never moved.
+		return nil
+	}
+
+	movedTo, err := url.Parse(latest)
+	if err != nil {
+		log.Errorf(ctx, "invalid moved to uri %s: %v", latest, err)
+		return nil
+	}
+
+	return movedTo
+}
+
+// movedToStr is a convenience wrapper around movedTo, returning
+// the latest URI of the object at the given URI as a string, or
+// the given URI as-is if the object has not permanently moved.
+func (d *Dereferencer) movedToStr(ctx context.Context, uri string) string {
+	if movedTo := d.movedTo(ctx, uri); movedTo != nil {
+		return movedTo.String()
+	}
+	return uri
+}
+
+// handleMoved stores a tombstone marking the object (or media) at URI
+// 'from' as having permanently moved to URI 'to', such that future
+// lookups of 'from' can be resolved without another remote round trip.
+//
+// As the object was successfully dereferenced at 'to', any existing
+// tombstone for 'to' is stale and will be removed, which also prevents
+// cycles from forming between previously moved objects.
+func (d *Dereferencer) handleMoved(ctx context.Context, from, to *url.URL) error {
+	fromStr, toStr := from.String(), to.String()
+	if fromStr == toStr {
+		return nil
+	}
+
+	// Remove any stale tombstone for new location.
+	if err := d.deleteTombstone(ctx, toStr); err != nil {
+		return err
+	}
+
+	existing, err := d.state.DB.GetTombstoneByURI(ctx, fromStr)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting tombstone %s: %w", fromStr, err)
+	}
+
+	if existing != nil {
+		if existing.MovedToURI == toStr {
+			// Already up-to-date.
+			return nil
+		}
+
+		// Replace outdated tombstone.
+		if err := d.state.DB.DeleteTombstone(ctx, existing.ID); err != nil {
+			return gtserror.Newf("error deleting tombstone %s: %w", fromStr, err)
+		}
+	}
+
+	if err := d.state.DB.PutTombstone(ctx, &gtsmodel.Tombstone{
+		ID:         id.NewULID(),
+		Domain:     from.Host,
+		URI:        fromStr,
+		MovedToURI: toStr,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return gtserror.Newf("error putting tombstone %s: %w", fromStr, err)
+	}
+
+	return nil
+}
+
+// deleteTombstone deletes the moved tombstone
+// with given URI from the database, if any.
+func (d *Dereferencer) deleteTombstone(ctx context.Context, uri string) error {
+	tombstone, err := d.state.DB.GetTombstoneByURI(ctx, uri)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil
+		}
+		return gtserror.Newf("error getting tombstone %s: %w", uri, err)
+	}
+
+	if !tombstone.Moved() {
+		// Leave tombstones of
+		// deleted objects alone.
+		return nil
+	}
+
+	if err := d.state.DB.DeleteTombstone(ctx, tombstone.ID); err != nil {
+		return gtserror.Newf("error deleting tombstone %s: %w", uri, err)
+	}
+
+	return nil
+}
+
+// handleMovedMedia updates the remote URL of given attachment to
+// the location its media was found to have permanently moved to,
+// and marks the old location as moved. Errors are only logged.
+func (d *Dereferencer) handleMovedMedia(ctx context.Context, attachment *gtsmodel.MediaAttachment, from, to *url.URL) {
+	attachment.RemoteURL = to.String()
+	if err := d.state.DB.UpdateAttachment(ctx, attachment, "remote_url"); err != nil {
+		log.Errorf(ctx, "error updating attachment %s remote url: %v", attachment.ID, err)
+	}
+
+	if err := d.handleMoved(ctx, from, to); err != nil {
+		log.Errorf(ctx, "error marking media %s as moved: %v", from, err)
+	}
+}
+
+// checkMovedID checks that the ID of an ActivityPub representation
+// dereferenced by following permanent redirects is on the same host
+// as the location it moved to. This ensures a remote cannot use a
+// redirect to have us accept an object on behalf of another host.
+func checkMovedID(t interface{ GetJSONLDId() vocab.JSONLDIdProperty }, movedTo *url.URL) error {
+	idProp := t.GetJSONLDId()
+	if idProp == nil || !idProp.IsIRI() {
+		return gtserror.New("no id property found, or id was not an iri")
+	}
+
+	if id := idProp.GetIRI(); id.Host != movedTo.Host {
+		return gtserror.Newf("id %s does not match moved to host %s", id, movedTo.Host)
+	}
+
+	return nil
+}
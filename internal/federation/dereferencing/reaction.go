@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// DereferenceReactionEmoji ensures that the custom emoji used for the given
+// status reaction (if any) is stored in the database, dereferencing it from
+// the remote instance if necessary, and sets the EmojiID of the reaction.
+//
+// Reactions using one of our own custom emojis are matched to the local emoji.
+func (d *Dereferencer) DereferenceReactionEmoji(ctx context.Context, reaction *gtsmodel.StatusReaction, requestingUsername string) error {
+	if reaction.Emoji == nil {
+		// Unicode emoji,
+		// nothing to do.
+		return nil
+	}
+
+	if reaction.Emoji.Domain == config.GetHost() ||
+		reaction.Emoji.Domain == config.GetAccountDomain() {
+		// Reaction with one of our local emojis.
+		emoji, err := d.state.DB.GetEmojiByShortcodeDomain(ctx, reaction.Emoji.Shortcode, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.Newf("db error getting local emoji %s: %w", reaction.Emoji.Shortcode, err)
+		}
+
+		reaction.Emoji = emoji
+		if emoji != nil {
+			reaction.EmojiID = emoji.ID
+		}
+
+		return nil
+	}
+
+	emojis, err := d.populateEmojis(ctx, []*gtsmodel.Emoji{reaction.Emoji}, requestingUsername)
+	if err != nil {
+		return gtserror.Newf("error populating emoji: %w", err)
+	}
+
+	if len(emojis) == 0 {
+		// Couldn't get the emoji, but we can
+		// still store the reaction by shortcode.
+		reaction.Emoji = nil
+		return nil
+	}
+
+	reaction.Emoji = emojis[0]
+	reaction.EmojiID = emojis[0].ID
+
+	return nil
+}
@@ -0,0 +1,171 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package dereferencing
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// staleRefreshBatch is the maximum number of stale accounts,
+	// and of stale statuses, refreshed by one RefreshStale() run.
+	staleRefreshBatch = 100
+
+	// staleRefreshJitter is the window over which the refreshes
+	// of one run are randomly spread, to avoid sending bursts
+	// of requests to remote servers.
+	staleRefreshJitter = 5 * time.Minute
+
+	// staleRefreshPeriod is how long after being created
+	// a remote status is still refreshed in the background.
+	staleRefreshPeriod = 24 * time.Hour
+)
+
+// RefreshStale refreshes a batch of stale remote accounts followed by local
+// accounts, and of recent stale remote statuses by these accounts, so that
+// they don't only get refreshed once a user happens to fetch them. Refreshes
+// start at random times within staleRefreshJitter, with no more than the
+// configured number per domain at once. It returns once all are done.
+func (d *Dereferencer) RefreshStale(ctx context.Context, now time.Time) error {
+	accounts, err := d.state.DB.GetStaleFollowedAccounts(ctx,
+		now.Add(-config.GetInstanceRemoteAccountFreshness()),
+		staleRefreshBatch,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting stale accounts: %w", err)
+	}
+
+	statuses, err := d.state.DB.GetStaleFollowedStatuses(ctx,
+		now.Add(-config.GetInstanceRemoteStatusFreshness()),
+		now.Add(-staleRefreshPeriod),
+		staleRefreshBatch,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting stale statuses: %w", err)
+	}
+
+	var (
+		wg     sync.WaitGroup
+		limits = newDomainLimits(config.GetInstanceRemoteRefreshPerDomain())
+	)
+
+	// refresh runs the given refresh func in the background
+	// after a random jitter, once the domain has a free slot.
+	refresh := func(domain string, fn func(context.Context) error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			jitter := time.Duration(rand.Int63n(int64(staleRefreshJitter))) //nolint:gosec
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(jitter):
+			}
+
+			release, ok := limits.acquire(ctx, domain)
+			if !ok {
+				return
+			}
+			defer release()
+
+			if err := fn(ctx); err != nil {
+				log.Debugf(ctx, "error refreshing stale %s item: %v", domain, err)
+			}
+		}()
+	}
+
+	for _, account := range accounts {
+		if accountUpToDate(account) {
+			// e.g. an instance account.
+			continue
+		}
+
+		account := account
+		refresh(account.Domain, func(ctx context.Context) error {
+			_, _, err := d.RefreshAccount(ctx, "", account, nil, false)
+			return err
+		})
+	}
+
+	for _, status := range statuses {
+		uri, err := url.Parse(status.URI)
+		if err != nil {
+			log.Warnf(ctx, "invalid status uri %q: %v", status.URI, err)
+			continue
+		}
+
+		status := status
+		refresh(uri.Host, func(ctx context.Context) error {
+			_, _, err := d.RefreshStatus(ctx, "", status, nil, false)
+			return err
+		})
+	}
+
+	wg.Wait()
+	return nil
+}
+
+// domainLimits caps the number of
+// concurrent operations per domain.
+type domainLimits struct {
+	max   int
+	mutex sync.Mutex
+	slots map[string]chan struct{}
+}
+
+// newDomainLimits returns domainLimits allowing
+// max operations per domain at once (minimum 1).
+func newDomainLimits(max int) *domainLimits {
+	if max < 1 {
+		max = 1
+	}
+	return &domainLimits{
+		max:   max,
+		slots: make(map[string]chan struct{}),
+	}
+}
+
+// acquire blocks until a slot for the given domain is free,
+// returning a func to release it, or false if ctx is done.
+func (l *domainLimits) acquire(ctx context.Context, domain string) (func(), bool) {
+	l.mutex.Lock()
+	slots, ok := l.slots[domain]
+	if !ok {
+		slots = make(chan struct{}, l.max)
+		l.slots[domain] = slots
+	}
+	l.mutex.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, false
+	case slots <- struct{}{}:
+		return func() { <-slots }, true
+	}
+}
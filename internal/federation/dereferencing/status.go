@@ -20,13 +20,19 @@ package dereferencing
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"mime"
 	"net/url"
+	"path"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/ingest"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -36,6 +42,11 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 )
 
+// maxMentionDerefs is the maximum number of accounts mentioned
+// by a status which will be dereferenced at once, so that a
+// status mentioning many accounts doesn't hammer remotes.
+const maxMentionDerefs = 4
+
 // statusUpToDate returns whether the given status model is both updateable
 // (i.e. remote status) and whether it needs an update based on `fetched_at`.
 func statusUpToDate(status *gtsmodel.Status) bool {
@@ -45,7 +56,8 @@ func statusUpToDate(status *gtsmodel.Status) bool {
 	}
 
 	// If this status was updated recently (last interval), we return as-is.
-	if next := status.FetchedAt.Add(2 * time.Hour); time.Now().Before(next) {
+	freshness := config.GetInstanceRemoteStatusFreshness()
+	if next := status.FetchedAt.Add(freshness); time.Now().Before(next) {
 		return true
 	}
 
@@ -77,31 +89,24 @@ func (d *Dereferencer) GetStatusByURI(ctx context.Context, requestUser string, u
 
 // getStatusByURI is a package internal form of .GetStatusByURI() that doesn't bother dereferencing the whole thread on update.
 func (d *Dereferencer) getStatusByURI(ctx context.Context, requestUser string, uri *url.URL) (*gtsmodel.Status, ap.Statusable, error) {
-	var (
-		status *gtsmodel.Status
-		uriStr = uri.String()
-		err    error
-	)
+	uriStr := uri.String()
 
 	// Search the database for existing status with URI.
-	status, err = d.state.DB.GetStatusByURI(
-		// request a barebones object, it may be in the
-		// db but with related models not yet dereferenced.
-		gtscontext.SetBarebones(ctx),
-		uriStr,
-	)
+	status, err := d.getStatusFromDB(ctx, uriStr)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		return nil, nil, gtserror.Newf("error checking database for status %s by uri: %w", uriStr, err)
+		return nil, nil, err
 	}
 
-	if status == nil {
-		// Else, search the database for existing by URL.
-		status, err = d.state.DB.GetStatusByURL(
-			gtscontext.SetBarebones(ctx),
-			uriStr,
-		)
+	if status == nil || !statusUpToDate(status) {
+		// The status needs dereferencing, so first acquire the lock for
+		// it in case this is already in progress elsewhere, then check
+		// the database again as it may have just been dereferenced.
+		unlock := d.lockDeref(ctx, "status", uriStr)
+		defer unlock()
+
+		status, err = d.getStatusFromDB(ctx, uriStr)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
-			return nil, nil, gtserror.Newf("error checking database for status %s by url: %w", uriStr, err)
+			return nil, nil, err
 		}
 	}
 
@@ -111,6 +116,12 @@ func (d *Dereferencer) getStatusByURI(ctx context.Context, requestUser string, u
 			return nil, nil, gtserror.SetUnretrievable(err) // this will be db.ErrNoEntries
 		}
 
+		if movedTo := d.movedTo(ctx, uriStr); movedTo != nil {
+			// This status is known to have permanently
+			// moved, search again using the latest URI.
+			return d.getStatusByURI(ctx, requestUser, movedTo)
+		}
+
 		// Create and pass-through a new bare-bones model for deref.
 		return d.enrichStatus(ctx, requestUser, uri, &gtsmodel.Status{
 			Local: func() *bool { var false bool; return &false }(),
@@ -148,6 +159,35 @@ func (d *Dereferencer) getStatusByURI(ctx context.Context, requestUser string, u
 	return latest, apubStatus, nil
 }
 
+// getStatusFromDB searches the database for a barebones status model with
+// given URI, falling back to searching by URL. Returns db.ErrNoEntries
+// if no status was found.
+func (d *Dereferencer) getStatusFromDB(ctx context.Context, uriStr string) (*gtsmodel.Status, error) {
+	// Search the database for existing status with URI.
+	status, err := d.state.DB.GetStatusByURI(
+		// request a barebones object, it may be in the
+		// db but with related models not yet dereferenced.
+		gtscontext.SetBarebones(ctx),
+		uriStr,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error checking database for status %s by uri: %w", uriStr, err)
+	}
+
+	if status == nil {
+		// Else, search the database for existing by URL.
+		status, err = d.state.DB.GetStatusByURL(
+			gtscontext.SetBarebones(ctx),
+			uriStr,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.Newf("error checking database for status %s by url: %w", uriStr, err)
+		}
+	}
+
+	return status, err
+}
+
 // RefreshStatus updates the given status if remote and last_fetched is beyond fetch interval, or if force is set. An updated status model is returned,
 // but in the case of dereferencing, some low-priority status information may be enqueued for asynchronous fetching, e.g. dereferencing the remainder of the
 // status thread. An ActivityPub object indicates the status was dereferenced (i.e. updated).
@@ -234,9 +274,13 @@ func (d *Dereferencer) enrichStatus(
 		return nil, nil, gtserror.SetUnretrievable(err)
 	}
 
+	// Set if status was found to
+	// have permanently moved URI.
+	var movedTo *url.URL
+
 	if apubStatus == nil {
 		// Dereference latest version of the status.
-		b, err := tsport.Dereference(ctx, uri)
+		b, moved, err := tsport.DereferenceMoved(ctx, uri)
 		if err != nil {
 			err := gtserror.Newf("error deferencing %s: %w", uri, err)
 			return nil, nil, gtserror.SetUnretrievable(err)
@@ -247,6 +291,17 @@ func (d *Dereferencer) enrichStatus(
 		if err != nil {
 			return nil, nil, gtserror.Newf("error resolving statusable from data for account %s: %w", uri, err)
 		}
+
+		if moved != nil {
+			// The status was permanently moved, ensure the
+			// returned representation is at its new location,
+			// as we'll be taking its ID as the new status URI.
+			if err := checkMovedID(apubStatus, moved); err != nil {
+				return nil, nil, gtserror.Newf("error checking moved status %s: %w", uri, err)
+			}
+
+			movedTo = moved
+		}
 	}
 
 	// Get the attributed-to account in order to fetch profile.
@@ -287,9 +342,30 @@ func (d *Dereferencer) enrichStatus(
 	// Carry-over values and set fetch time.
 	latestStatus.FetchedAt = time.Now()
 	latestStatus.Local = status.Local
+	latestStatus.PinnedAt = status.PinnedAt
+	latestStatus.PinOrder = status.PinOrder
+
+	if latestStatus.CountsFetchedAt.IsZero() {
+		// No faves / boosts totals were embedded,
+		// keep any previously fetched for status.
+		latestStatus.RemoteFavesCount = status.RemoteFavesCount
+		latestStatus.RemoteBoostsCount = status.RemoteBoostsCount
+		latestStatus.CountsFetchedAt = status.CountsFetchedAt
+	}
+
+	// Check the status against this instance's ingest rules,
+	// before doing any more work to fetch its mentions etc.
+	if err := ingest.ApplyRules(ctx, d.state, latestStatus, status.CreatedAt.IsZero()); err != nil {
+		return nil, nil, err
+	}
+
+	// If this is a new status, and optimistic ingestion is enabled,
+	// store it straight away with only what we needn't fetch from
+	// remotes, and enrich it with the rest in the background.
+	optimistic := status.CreatedAt.IsZero() && config.GetInstanceOptimisticIngestion()
 
 	// Ensure the status' mentions are populated, and pass in existing to check for changes.
-	if err := d.fetchStatusMentions(ctx, requestUser, status, latestStatus); err != nil {
+	if err := d.fetchStatusMentions(ctx, requestUser, status, latestStatus, optimistic); err != nil {
 		return nil, nil, gtserror.Newf("error populating mentions for status %s: %w", uri, err)
 	}
 
@@ -298,14 +374,24 @@ func (d *Dereferencer) enrichStatus(
 		return nil, nil, gtserror.Newf("error populating tags for status %s: %w", uri, err)
 	}
 
-	// Ensure the status' media attachments are populated, passing in existing to check for changes.
-	if err := d.fetchStatusAttachments(ctx, tsport, status, latestStatus); err != nil {
-		return nil, nil, gtserror.Newf("error populating attachments for status %s: %w", uri, err)
-	}
+	if optimistic {
+		// Store placeholders for the status' media attachments, to be fetched later.
+		if err := d.placeholderStatusAttachments(ctx, latestStatus); err != nil {
+			return nil, nil, gtserror.Newf("error populating placeholder attachments for status %s: %w", uri, err)
+		}
 
-	// Ensure the status' emoji attachments are populated, (changes are expected / okay).
-	if err := d.fetchStatusEmojis(ctx, requestUser, latestStatus); err != nil {
-		return nil, nil, gtserror.Newf("error populating emojis for status %s: %w", uri, err)
+		// Populate only the status' emojis which we already know about.
+		d.placeholderStatusEmojis(ctx, latestStatus)
+	} else {
+		// Ensure the status' media attachments are populated, passing in existing to check for changes.
+		if err := d.fetchStatusAttachments(ctx, tsport, status, latestStatus); err != nil {
+			return nil, nil, gtserror.Newf("error populating attachments for status %s: %w", uri, err)
+		}
+
+		// Ensure the status' emoji attachments are populated, (changes are expected / okay).
+		if err := d.fetchStatusEmojis(ctx, requestUser, latestStatus); err != nil {
+			return nil, nil, gtserror.Newf("error populating emojis for status %s: %w", uri, err)
+		}
 	}
 
 	if status.CreatedAt.IsZero() {
@@ -331,13 +417,70 @@ func (d *Dereferencer) enrichStatus(
 		}
 	}
 
+	if movedTo != nil {
+		// Mark old status URI as moved, to
+		// save remote lookups in the future.
+		if err := d.handleMoved(ctx, uri, movedTo); err != nil {
+			log.Errorf(ctx, "error marking status %s as moved: %v", uri, err)
+		}
+	}
+
+	if optimistic {
+		// Enqueue fetching everything left out above.
+		statusID := latestStatus.ID
+		d.state.Workers.Federator.MustEnqueueCtx(ctx, func(ctx context.Context) {
+			d.enrichStatusDeferred(ctx, requestUser, uri, statusID, apubStatus)
+		})
+	}
+
 	return latestStatus, apubStatus, nil
 }
 
-func (d *Dereferencer) fetchStatusMentions(ctx context.Context, requestUser string, existing, status *gtsmodel.Status) error {
+// enrichStatusDeferred finishes enriching a status which was stored
+// optimistically by enrichStatus, fetching the mentioned accounts, media
+// attachments and emojis which were left out in order to store it sooner.
+func (d *Dereferencer) enrichStatusDeferred(ctx context.Context, requestUser string, uri *url.URL, statusID string, apubStatus ap.Statusable) {
+	// Acquire the lock for the status, in case
+	// it's being dereferenced elsewhere already.
+	unlock := d.lockDeref(ctx, "status", uri.String())
+	defer unlock()
+
+	// Get the optimistically stored status,
+	// with its placeholder mentions etc.
+	status, err := d.state.DB.GetStatusByID(ctx, statusID)
+	if err != nil {
+		log.Errorf(ctx, "error getting status %s: %v", uri, err)
+		return
+	}
+
+	// As the status is no longer new, this
+	// fetches everything from remotes as usual.
+	if _, _, err := d.enrichStatus(ctx, requestUser, uri, status, apubStatus); err != nil {
+		log.Errorf(ctx, "error enriching status %s: %v", uri, err)
+		return
+	}
+
+	// The status may have been prepared for
+	// timelines already; ensure they're updated.
+	if err := d.state.Timelines.Home.UnprepareItemFromAllTimelines(ctx, statusID); err != nil {
+		log.Errorf(ctx, "error unpreparing status %s from home timelines: %v", uri, err)
+	}
+	if err := d.state.Timelines.List.UnprepareItemFromAllTimelines(ctx, statusID); err != nil {
+		log.Errorf(ctx, "error unpreparing status %s from list timelines: %v", uri, err)
+	}
+}
+
+// fetchStatusMentions populates the status' mentions, reusing those of the
+// existing status where possible. If optimistic is set, only mentions of
+// accounts already in the database are populated, with no dereferencing.
+func (d *Dereferencer) fetchStatusMentions(ctx context.Context, requestUser string, existing, status *gtsmodel.Status, optimistic bool) error {
 	// Allocate new slice to take the yet-to-be created mention IDs.
 	status.MentionIDs = make([]string, len(status.Mentions))
 
+	// URIs of mentioned accounts we need
+	// to dereference, by their string form.
+	accountURIs := make(map[string]*url.URL, len(status.Mentions))
+
 	for i := range status.Mentions {
 		mention := status.Mentions[i]
 
@@ -356,16 +499,40 @@ func (d *Dereferencer) fetchStatusMentions(ctx context.Context, requestUser stri
 			continue
 		}
 
+		accountURIs[mention.TargetAccountURI] = accountURI
+	}
+
+	var accounts map[string]*gtsmodel.Account
+	if optimistic {
+		// Only use accounts we know about already.
+		accounts = d.getKnownAccounts(ctx, accountURIs)
+	} else {
+		// Dereference all the mentioned accounts together,
+		// rather than waiting on each remote in turn.
+		accounts = d.getMentionedAccounts(ctx, requestUser, accountURIs)
+	}
+
+	for i := range status.Mentions {
+		if status.MentionIDs[i] != "" {
+			// Existing mention.
+			continue
+		}
+
+		mention := status.Mentions[i]
+
 		// Ensure we have the account of the mention target dereferenced.
-		mention.TargetAccount, _, err = d.getAccountByURI(ctx, requestUser, accountURI)
-		if err != nil {
-			log.Errorf(ctx, "failed to dereference account %s: %v", accountURI, err)
+		var ok bool
+		mention.TargetAccount, ok = accounts[mention.TargetAccountURI]
+		if !ok {
+			// Invalid URI or deref
+			// failed, logged above.
 			continue
 		}
 
 		// Generate new ID according to status creation.
 		// TODO: update this to use "edited_at" when we add
 		//       support for edited status revision history.
+		var err error
 		mention.ID, err = id.NewULIDFromTime(status.CreatedAt)
 		if err != nil {
 			log.Errorf(ctx, "invalid created at date: %v", err)
@@ -410,6 +577,113 @@ func (d *Dereferencer) fetchStatusMentions(ctx context.Context, requestUser stri
 	return nil
 }
 
+// getMentionedAccounts dereferences the accounts at the given URIs
+// concurrently, with at most maxMentionDerefs in flight at once,
+// returning those successfully dereferenced under the same keys.
+// Failures are logged together, and don't affect the other accounts.
+func (d *Dereferencer) getMentionedAccounts(ctx context.Context, requestUser string, accountURIs map[string]*url.URL) map[string]*gtsmodel.Account {
+	var (
+		// accounts contains the successfully
+		// dereferenced accounts, by key.
+		accounts = make(map[string]*gtsmodel.Account, len(accountURIs))
+
+		// keys contains the keys of the
+		// URIs yet to be dereferenced.
+		keys = make([]string, 0, len(accountURIs))
+
+		// errs accumulates errors received
+		// during attempted dereferences.
+		errs gtserror.MultiError
+
+		// wait blocks until all
+		// routines have returned.
+		wait sync.WaitGroup
+
+		// mutex protects 'keys', 'accounts'
+		// and 'errs' for concurrent access.
+		mutex sync.Mutex
+	)
+
+	for key := range accountURIs {
+		keys = append(keys, key)
+	}
+
+	// Don't start more routines than
+	// there are accounts to dereference.
+	routines := min(len(keys), maxMentionDerefs)
+	wait.Add(routines)
+
+	for i := 0; i < routines; i++ {
+		go func() {
+			// Mark returned.
+			defer wait.Done()
+
+			for {
+				// Acquire lock.
+				mutex.Lock()
+
+				if len(keys) == 0 {
+					// Reached end.
+					mutex.Unlock()
+					return
+				}
+
+				// Pop next key.
+				i := len(keys) - 1
+				key := keys[i]
+				keys = keys[:i]
+
+				// Done with lock.
+				mutex.Unlock()
+
+				// Dereference the account at URI.
+				uri := accountURIs[key]
+				account, _, err := d.getAccountByURI(ctx, requestUser, uri)
+
+				mutex.Lock()
+				if err != nil {
+					errs.Appendf("error dereferencing account %s: %w", uri, err)
+				} else {
+					accounts[key] = account
+				}
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	// Wait for finish.
+	wait.Wait()
+
+	if err := errs.Combine(); err != nil {
+		log.Errorf(ctx, "error(s) dereferencing mentioned accounts: %v", err)
+	}
+
+	return accounts
+}
+
+// getKnownAccounts returns those of the accounts at the given URIs which are
+// already in the database, under the same keys, without dereferencing any.
+func (d *Dereferencer) getKnownAccounts(ctx context.Context, accountURIs map[string]*url.URL) map[string]*gtsmodel.Account {
+	accounts := make(map[string]*gtsmodel.Account, len(accountURIs))
+
+	for key, uri := range accountURIs {
+		account, err := d.state.DB.GetAccountByURI(
+			gtscontext.SetBarebones(ctx),
+			uri.String(),
+		)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "error checking database for account %s: %v", uri, err)
+			}
+			continue
+		}
+
+		accounts[key] = account
+	}
+
+	return accounts
+}
+
 func (d *Dereferencer) fetchStatusTags(ctx context.Context, status *gtsmodel.Status) error {
 	// Allocate new slice to take the yet-to-be determined tag IDs.
 	status.TagIDs = make([]string, len(status.Tags))
@@ -467,30 +741,53 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 	for i := range status.Attachments {
 		placeholder := status.Attachments[i]
 
+		// Resolve the latest location of the media,
+		// in case it's since been permanently moved.
+		latestURL := d.movedToStr(ctx, placeholder.RemoteURL)
+
 		// Look for existing media attachment with remoet URL first.
-		existing, ok := existing.GetAttachmentByRemoteURL(placeholder.RemoteURL)
+		existing, ok := existing.GetAttachmentByRemoteURL(latestURL)
 		if ok && existing.ID != "" && *existing.Cached {
 			status.Attachments[i] = existing
 			status.AttachmentIDs[i] = existing.ID
 			continue
 		}
 
+		// Whether there's an existing attachment which isn't cached, eg.,
+		// an optimistic placeholder, or one uncached by the media cleaner.
+		uncached := ok && existing.ID != ""
+
 		// Ensure a valid media attachment remote URL.
-		remoteURL, err := url.Parse(placeholder.RemoteURL)
+		remoteURL, err := url.Parse(latestURL)
 		if err != nil {
-			log.Errorf(ctx, "invalid remote media url %q: %v", placeholder.RemoteURL, err)
+			log.Errorf(ctx, "invalid remote media url %q: %v", latestURL, err)
 			continue
 		}
 
-		// Start pre-processing remote media at remote URL.
-		processing, err := d.mediaManager.PreProcessMedia(ctx, func(ctx context.Context) (io.ReadCloser, int64, error) {
-			return tsport.DereferenceMedia(ctx, remoteURL)
-		}, status.AccountID, &media.AdditionalMediaInfo{
-			StatusID:    &status.ID,
-			RemoteURL:   &placeholder.RemoteURL,
-			Description: &placeholder.Description,
-			Blurhash:    &placeholder.Blurhash,
-		})
+		// Set if media was found to
+		// have permanently moved URI.
+		var movedTo *url.URL
+
+		// Data function to fetch the media from remote URL.
+		data := func(ctx context.Context) (io.ReadCloser, int64, error) {
+			rc, sz, moved, err := tsport.DereferenceMedia(ctx, remoteURL)
+			movedTo = moved
+			return rc, sz, err
+		}
+
+		var processing *media.ProcessingMedia
+		if uncached {
+			// Recache the existing attachment, rather than making another.
+			processing, err = d.mediaManager.PreProcessMediaRecache(ctx, data, existing.ID)
+		} else {
+			// Start pre-processing remote media at remote URL.
+			processing, err = d.mediaManager.PreProcessMedia(ctx, data, status.AccountID, &media.AdditionalMediaInfo{
+				StatusID:    &status.ID,
+				RemoteURL:   &placeholder.RemoteURL,
+				Description: &placeholder.Description,
+				Blurhash:    &placeholder.Blurhash,
+			})
+		}
 		if err != nil {
 			log.Errorf(ctx, "error processing attachment: %v", err)
 			continue
@@ -503,6 +800,11 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 			continue
 		}
 
+		if movedTo != nil {
+			// Update attachment to use the new media location.
+			d.handleMovedMedia(ctx, media, remoteURL, movedTo)
+		}
+
 		// Set the *new* attachment and ID.
 		status.Attachments[i] = media
 		status.AttachmentIDs[i] = media.ID
@@ -524,6 +826,114 @@ func (d *Dereferencer) fetchStatusAttachments(ctx context.Context, tsport transp
 	return nil
 }
 
+// placeholderStatusAttachments stores placeholders for each of the status'
+// media attachments, which are shown as links to the remote media until
+// they're fetched by fetchStatusAttachments, without fetching any now.
+func (d *Dereferencer) placeholderStatusAttachments(ctx context.Context, status *gtsmodel.Status) error {
+	status.AttachmentIDs = make([]string, len(status.Attachments))
+
+	for i, placeholder := range status.Attachments {
+		var (
+			now       = time.Now()
+			avatar    = false
+			header    = false
+			cached    = false
+			sensitive = false
+			mediaID   = id.NewULID()
+		)
+
+		// Storage paths and content types can't be left empty, so guess
+		// them from the remote URL. They're only used once the media has
+		// been fetched, at which point they're recalculated anyway.
+		ext, contentType := placeholderMediaType(placeholder.RemoteURL)
+
+		attachment := &gtsmodel.MediaAttachment{
+			ID:          mediaID,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			StatusID:    status.ID,
+			RemoteURL:   placeholder.RemoteURL,
+			Type:        gtsmodel.FileTypeUnknown,
+			AccountID:   status.AccountID,
+			Description: placeholder.Description,
+			Blurhash:    placeholder.Blurhash,
+			Processing:  gtsmodel.ProcessingStatusReceived,
+			File: gtsmodel.File{
+				Path: fmt.Sprintf(
+					"%s/%s/%s/%s.%s",
+					status.AccountID,
+					media.TypeAttachment,
+					media.SizeOriginal,
+					mediaID,
+					ext,
+				),
+				ContentType: contentType,
+				UpdatedAt:   now,
+			},
+			Thumbnail: gtsmodel.Thumbnail{
+				Path: fmt.Sprintf(
+					"%s/%s/%s/%s.jpg",
+					status.AccountID,
+					media.TypeAttachment,
+					media.SizeSmall,
+					mediaID,
+				),
+				ContentType: "image/jpeg",
+				UpdatedAt:   now,
+			},
+			Avatar:    &avatar,
+			Header:    &header,
+			Cached:    &cached,
+			Sensitive: &sensitive,
+		}
+
+		if err := d.state.DB.PutAttachment(ctx, attachment); err != nil {
+			return gtserror.Newf("error putting attachment in database: %w", err)
+		}
+
+		status.Attachments[i] = attachment
+		status.AttachmentIDs[i] = attachment.ID
+	}
+
+	return nil
+}
+
+// placeholderMediaType guesses the file extension and
+// content type of the media at the given remote URL,
+// falling back to a generic binary type if unknown.
+func placeholderMediaType(remoteURL string) (string, string) {
+	if u, err := url.Parse(remoteURL); err == nil {
+		ext := strings.ToLower(path.Ext(u.Path))
+		if contentType := mime.TypeByExtension(ext); ext != "" && contentType != "" {
+			return strings.TrimPrefix(ext, "."), contentType
+		}
+	}
+	return "bin", "application/octet-stream"
+}
+
+// placeholderStatusEmojis populates only those of the status' emojis which
+// are already in the database, leaving the rest to fetchStatusEmojis.
+func (d *Dereferencer) placeholderStatusEmojis(ctx context.Context, status *gtsmodel.Status) {
+	emojis := make([]*gtsmodel.Emoji, 0, len(status.Emojis))
+	emojiIDs := make([]string, 0, len(status.Emojis))
+
+	for _, e := range status.Emojis {
+		emoji, err := d.state.DB.GetEmojiByShortcodeDomain(ctx, e.Shortcode, e.Domain)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "error checking database for emoji %s@%s: %v", e.Shortcode, e.Domain, err)
+			}
+			continue
+		}
+
+		emojis = append(emojis, emoji)
+		emojiIDs = append(emojiIDs, emoji.ID)
+	}
+
+	status.Emojis = emojis
+	status.EmojiIDs = emojiIDs
+}
+
 func (d *Dereferencer) fetchStatusEmojis(ctx context.Context, requestUser string, status *gtsmodel.Status) error {
 	// Fetch the full-fleshed-out emoji objects for our status.
 	emojis, err := d.populateEmojis(ctx, status.Emojis, requestUser)
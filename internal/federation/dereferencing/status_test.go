@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -123,6 +124,32 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithMention() {
 	suite.False(*m.Silent)
 }
 
+func (suite *StatusTestSuite) TestDereferenceStatusWithMentions() {
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	statusURL := testrig.URLMustParse("https://unknown-instance.com/users/brand_new_person/statuses/01HF0AXW0S6NZTJV1SJ0KC3RBE")
+	status, _, err := suite.dereferencer.GetStatusByURI(context.Background(), fetchingAccount.Username, statusURL)
+	suite.NoError(err)
+	suite.NotNil(status)
+
+	// The mentioned account which couldn't be
+	// dereferenced is dropped, the others kept.
+	suite.Len(status.Mentions, 2)
+	suite.Len(status.MentionIDs, 2)
+	suite.Equal(fetchingAccount.ID, status.Mentions[0].TargetAccountID)
+	suite.Equal(suite.testAccounts["admin_account"].ID, status.Mentions[1].TargetAccountID)
+
+	for i, mention := range status.Mentions {
+		suite.Equal(status.MentionIDs[i], mention.ID)
+
+		// Mentions should be in the database.
+		dbMention, err := suite.db.GetMention(context.Background(), mention.ID)
+		suite.NoError(err)
+		suite.Equal(status.ID, dbMention.StatusID)
+		suite.Equal(mention.TargetAccountID, dbMention.TargetAccountID)
+	}
+}
+
 func (suite *StatusTestSuite) TestDereferenceStatusWithTag() {
 	fetchingAccount := suite.testAccounts["local_account_1"]
 
@@ -218,6 +245,42 @@ func (suite *StatusTestSuite) TestDereferenceStatusWithImageAndNoContent() {
 	suite.NoError(err)
 }
 
+func (suite *StatusTestSuite) TestDereferenceStatusOptimistic() {
+	config.SetInstanceOptimisticIngestion(true)
+	defer config.SetInstanceOptimisticIngestion(false)
+	fetchingAccount := suite.testAccounts["local_account_1"]
+
+	statusURL := testrig.URLMustParse("https://turnip.farm/users/turniplover6969/statuses/70c53e54-3146-42d5-a630-83c8b6c7c042")
+	status, _, err := suite.dereferencer.GetStatusByURI(context.Background(), fetchingAccount.Username, statusURL)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Status is stored straight away
+	// with a placeholder attachment.
+	if !suite.Len(status.Attachments, 1) {
+		suite.FailNow("")
+	}
+	placeholder := status.Attachments[0]
+	suite.Equal("https://turnip.farm/attachments/f17843c7-015e-4251-9b5a-91389c49ee57.jpg", placeholder.RemoteURL)
+	suite.Equal(gtsmodel.FileTypeUnknown, placeholder.Type)
+	suite.False(*placeholder.Cached)
+
+	// The placeholder attachment should
+	// be fetched in the background.
+	if !testrig.WaitFor(func() bool {
+		attachment, err := suite.db.GetAttachmentByID(context.Background(), placeholder.ID)
+		return err == nil && *attachment.Cached
+	}) {
+		suite.FailNow("timed out waiting for attachment to be fetched")
+	}
+
+	dbStatus, err := suite.db.GetStatusByURI(context.Background(), status.URI)
+	suite.NoError(err)
+	suite.Equal([]string{placeholder.ID}, dbStatus.AttachmentIDs)
+	suite.Equal(gtsmodel.FileTypeImage, dbStatus.Attachments[0].Type)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
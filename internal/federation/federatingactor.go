@@ -33,6 +33,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 // IsASMediaType will return whether the given content-type string
@@ -191,7 +192,7 @@ func (f *federatingActor) PostInboxScheme(ctx context.Context, w http.ResponseWr
 		*u = *r.URL
 		u.Host = r.Host
 		u.Scheme = scheme
-		return u
+		return uris.Canonicalize(u)
 	}()
 
 	// At this point we have everything we need, and have verified that
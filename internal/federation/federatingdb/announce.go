@@ -44,6 +44,13 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 		return nil // Already processed.
 	}
 
+	if ap.IsAnnouncedActivity(announce) {
+		// Not a boost, but a group forwarding
+		// some other activity. Ignore it for now.
+		log.Debug(ctx, "ignoring announce of non-Create activity")
+		return nil
+	}
+
 	boost, isNew, err := f.converter.ASAnnounceToStatus(ctx, announce)
 	if err != nil {
 		return gtserror.Newf("error converting announce to boost: %w", err)
@@ -26,12 +26,15 @@ import (
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/ingest"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 // Create adds a new entry to the database which must be able to be
@@ -255,6 +258,18 @@ func (f *federatingDB) createStatusable(
 		return nil
 	}
 
+	// Check the new status against this instance's
+	// ingest rules before we store anything for it.
+	if err := ingest.ApplyRules(ctx, f.state, status, true); err != nil {
+		if gtserror.Dropped(err) {
+			// Dropped statuses are silently
+			// ignored, just like unaccepted ones.
+			log.Debug(ctx, err)
+			return nil
+		}
+		return err
+	}
+
 	// ID the new status based on the time it was created.
 	status.ID, err = id.NewULIDFromTime(status.CreatedAt)
 	if err != nil {
@@ -348,6 +363,12 @@ func (f *federatingDB) activityLike(ctx context.Context, asType vocab.Type, rece
 		return errors.New("activityLike: could not convert type to like")
 	}
 
+	if isReaction(like) {
+		// Like with emoji content set,
+		// handle it as a reaction instead.
+		return f.activityReaction(ctx, like, receivingAccount)
+	}
+
 	fave, err := f.converter.ASLikeToFave(ctx, like)
 	if err != nil {
 		return fmt.Errorf("activityLike: could not convert Like to fave: %w", err)
@@ -375,6 +396,38 @@ func (f *federatingDB) activityLike(ctx context.Context, asType vocab.Type, rece
 	return nil
 }
 
+// isReaction returns whether the given like should be handled as
+// an emoji reaction rather than as a fave, ie., emoji reactions are
+// enabled on this instance and the like has valid reaction content.
+func isReaction(like ap.Reactable) bool {
+	if !config.GetInstanceEmojiReactions() {
+		return false
+	}
+
+	content := ap.ExtractContent(like)
+	return content != "" && validate.StatusReaction(content) == nil
+}
+
+func (f *federatingDB) activityReaction(ctx context.Context, like vocab.ActivityStreamsLike, receivingAccount *gtsmodel.Account) error {
+	reaction, err := f.converter.ASLikeToStatusReaction(ctx, like)
+	if err != nil {
+		return fmt.Errorf("activityReaction: could not convert Like to reaction: %w", err)
+	}
+
+	reaction.ID = id.NewULID()
+
+	// Reaction is stored by the worker, as any
+	// custom emoji must be dereferenced first.
+	f.state.Workers.EnqueueFediAPI(ctx, messages.FromFediAPI{
+		APObjectType:     ap.ActivityEmojiReact,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         reaction,
+		ReceivingAccount: receivingAccount,
+	})
+
+	return nil
+}
+
 /*
 	FLAG HANDLERS
 */
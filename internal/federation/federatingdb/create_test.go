@@ -25,7 +25,9 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type CreateTestSuite struct {
@@ -61,6 +63,49 @@ func (suite *CreateTestSuite) TestCreateNote() {
 	suite.NoError(err)
 }
 
+func (suite *CreateTestSuite) TestCreateNoteDroppedByIngestRule() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	rule := &gtsmodel.IngestRule{
+		ID:                 "01HEKJ6FQ1VV1BF2Z3MGGG4HSA",
+		Pattern:            "NEW PRIVATE NOTE",
+		Regex:              util.Ptr(false),
+		Action:             gtsmodel.IngestRuleActionDrop,
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}
+	if err := suite.db.PutIngestRule(context.Background(), rule); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	create := suite.testActivities["dm_for_zork"].Activity
+	noteURI := create.GetActivityStreamsObject().At(0).GetActivityStreamsNote().GetJSONLDId().Get()
+
+	// Dropping is silent.
+	err := suite.federatingDB.Create(ctx, create)
+	suite.NoError(err)
+
+	// Nothing should be heading to the processor.
+	select {
+	case msg := <-suite.fromFederator:
+		suite.FailNow("unexpected message", "%+v", msg)
+	default:
+	}
+
+	// Status should not be in the database.
+	_, err = suite.db.GetStatusByURI(context.Background(), noteURI.String())
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// The rule should have been hit.
+	dbRule, err := suite.db.GetIngestRuleByID(context.Background(), rule.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(1, dbRule.Hits)
+}
+
 func (suite *CreateTestSuite) TestCreateNoteForward() {
 	receivingAccount := suite.testAccounts["local_account_1"]
 	requestingAccount := suite.testAccounts["remote_account_1"]
@@ -128,6 +173,49 @@ func (suite *CreateTestSuite) TestCreateFlag1() {
 	}
 }
 
+func (suite *CreateTestSuite) TestCreateLikeWithReaction() {
+	reactedAccount := suite.testAccounts["local_account_1"]
+	reactingAccount := suite.testAccounts["remote_account_1"]
+	reactedStatus := suite.testStatuses["local_account_1_status_1"]
+
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "` + reactingAccount.URI + `",
+  "content": "🐢",
+  "_misskey_reaction": "🐢",
+  "id": "http://fossbros-anonymous.io/likes/8f1c7a7e-0c1a-4b8e-9d4b-2c0a9b4e1f33",
+  "object": "` + reactedStatus.URI + `",
+  "type": "Like"
+}`
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := createTestContext(reactedAccount, reactingAccount)
+	if err := suite.federatingDB.Create(ctx, t); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// should be a reaction heading to the processor now, not a fave
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ActivityEmojiReact, msg.APObjectType)
+	suite.Equal(ap.ActivityCreate, msg.APActivityType)
+
+	reaction := msg.GTSModel.(*gtsmodel.StatusReaction)
+	suite.Equal("🐢", reaction.Content)
+	suite.Equal(reactingAccount.ID, reaction.AccountID)
+	suite.Equal(reactedAccount.ID, reaction.TargetAccountID)
+	suite.Equal(reactedStatus.ID, reaction.StatusID)
+	suite.Nil(reaction.Emoji)
+}
+
 func TestCreateTestSuite(t *testing.T) {
 	suite.Run(t, &CreateTestSuite{})
 }
@@ -42,6 +42,10 @@ func (f *federatingDB) Owns(ctx context.Context, id *url.URL) (bool, error) {
 		}...)
 	l.Debug("entering Owns")
 
+	// IRIs on our onion service address
+	// are stored under the clearnet host.
+	id = uris.Canonicalize(id)
+
 	// if the id host isn't this instance host, we don't own this IRI
 	if host := config.GetHost(); id.Host != host {
 		l.Tracef("we DO NOT own activity because the host is %s not %s", id.Host, host)
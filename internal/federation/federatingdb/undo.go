@@ -140,6 +140,12 @@ func (f *federatingDB) undoLike(
 		return nil
 	}
 
+	if isReaction(Like) {
+		// Like with emoji content set,
+		// undo it as a reaction instead.
+		return f.undoReaction(ctx, receivingAccount, Like)
+	}
+
 	fave, err := f.converter.ASLikeToFave(ctx, Like)
 	if err != nil {
 		return fmt.Errorf("undoLike: error converting ActivityStreams Like to fave: %w", err)
@@ -176,6 +182,49 @@ func (f *federatingDB) undoLike(
 	return nil
 }
 
+func (f *federatingDB) undoReaction(
+	ctx context.Context,
+	receivingAccount *gtsmodel.Account,
+	like vocab.ActivityStreamsLike,
+) error {
+	reaction, err := f.converter.ASLikeToStatusReaction(ctx, like)
+	if err != nil {
+		return fmt.Errorf("undoReaction: error converting ActivityStreams Like to reaction: %w", err)
+	}
+
+	// Ensure addressee is reaction target.
+	if reaction.TargetAccountID != receivingAccount.ID {
+		// Ignore this Activity.
+		return nil
+	}
+
+	// As with faves, select using account, target
+	// status, and content rather than the URI.
+	reaction, err = f.state.DB.GetStatusReaction(
+		gtscontext.SetBarebones(ctx),
+		reaction.AccountID,
+		reaction.StatusID,
+		reaction.Content,
+	)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// We didn't have this reaction
+			// for this combo anyway, ignore.
+			return nil
+		}
+		// Real error.
+		return fmt.Errorf("undoReaction: db error getting reaction: %w", err)
+	}
+
+	// Delete the status reaction.
+	if err := f.state.DB.DeleteStatusReactionByID(ctx, reaction.ID); err != nil {
+		return fmt.Errorf("undoReaction: db error deleting reaction %s: %w", reaction.ID, err)
+	}
+
+	log.Debug(ctx, "EmojiReact undone")
+	return nil
+}
+
 func (f *federatingDB) undoBlock(
 	ctx context.Context,
 	receivingAccount *gtsmodel.Account,
@@ -288,6 +288,10 @@ func (f *Federator) AuthenticatePostInbox(ctx context.Context, w http.ResponseWr
 		return nil, false, err
 	}
 
+	// Check for any FEP-8fcf followers synchronization
+	// info sent along with the delivery, acting on it.
+	f.syncFollowers(ctx, r, receivingAccount, requestingAccount)
+
 	// We have everything we need now, set the requesting
 	// and receiving accounts on the context for later use.
 	ctx = gtscontext.SetRequestingAccount(ctx, requestingAccount)
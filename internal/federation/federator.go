@@ -34,6 +34,7 @@ var _ interface {
 } = &Federator{}
 
 type Federator struct {
+	state               *state.State
 	db                  db.DB
 	federatingDB        federatingdb.DB
 	clock               pub.Clock
@@ -54,6 +55,7 @@ func NewFederator(
 ) *Federator {
 	clock := &Clock{}
 	f := &Federator{
+		state:               state,
 		db:                  state.DB,
 		federatingDB:        federatingDB,
 		clock:               clock,
@@ -0,0 +1,305 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package federation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/collsync"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// syncFollowers checks the FEP-8fcf Collection-Synchronization header
+// on an inbox POST request from requestingAccount, if set, comparing the
+// digest it contains with the digest of local accounts following the
+// requesting account. On mismatch, the partial followers collection
+// is fetched from the remote and local follows are reconciled with it.
+func (f *Federator) syncFollowers(
+	ctx context.Context,
+	r *http.Request,
+	receivingAccount *gtsmodel.Account,
+	requestingAccount *gtsmodel.Account,
+) {
+	value := r.Header.Get(collsync.HeaderName)
+	if value == "" {
+		// Nothing to do.
+		return
+	}
+
+	hdr, err := collsync.ParseHeader(value)
+	if err != nil {
+		log.Debugf(ctx, "invalid %s header: %v", collsync.HeaderName, err)
+		return
+	}
+
+	if hdr.CollectionID != requestingAccount.FollowersURI {
+		// We only support synchronizing the
+		// followers collection of the sender.
+		log.Debugf(ctx, "ignoring sync of unsupported collection %s", hdr.CollectionID)
+		return
+	}
+
+	// Ensure the partial collection is hosted
+	// alongside the requesting account, so we
+	// only ever trust the authoritative source.
+	syncURL, err := url.Parse(hdr.URL)
+	if err != nil {
+		log.Debugf(ctx, "invalid sync url %s: %v", hdr.URL, err)
+		return
+	}
+
+	accountURI, err := url.Parse(requestingAccount.URI)
+	if err != nil {
+		log.Errorf(ctx, "invalid account uri %s: %v", requestingAccount.URI, err)
+		return
+	}
+
+	if syncURL.Host != accountURI.Host {
+		log.Debugf(ctx, "ignoring sync url %s on different host to account %s", syncURL, accountURI)
+		return
+	}
+
+	// Get local accounts following the requester.
+	follows, err := f.state.DB.GetAccountLocalFollowers(ctx, requestingAccount.ID)
+	if err != nil {
+		log.Errorf(ctx, "error getting local followers of %s: %v", requestingAccount.URI, err)
+		return
+	}
+
+	if hdr.Digest == collsync.Digest(followerURIs(follows)) {
+		// Collections are in sync.
+		return
+	}
+
+	log.Infof(ctx, "followers of %s out of sync, reconciling", requestingAccount.URI)
+
+	// Reconcile asynchronously so the
+	// inbox delivery isn't held up by it.
+	f.state.Workers.Federator.MustEnqueueCtx(ctx, func(ctx context.Context) {
+		if err := f.reconcileFollowers(ctx,
+			receivingAccount.Username,
+			requestingAccount,
+			syncURL,
+		); err != nil {
+			log.Errorf(ctx, "error reconciling followers of %s: %v", requestingAccount.URI, err)
+		}
+	})
+}
+
+// reconcileFollowers fetches the partial followers collection of remote
+// account at syncURL, using a transport for the local account with given
+// username. Local follows of the remote account not present in the partial
+// collection are removed, and local accounts present in the collection which
+// do not follow the remote account have an Undo Follow sent on their behalf.
+func (f *Federator) reconcileFollowers(
+	ctx context.Context,
+	username string,
+	account *gtsmodel.Account,
+	syncURL *url.URL,
+) error {
+	tsport, err := f.transportController.NewTransportForUsername(ctx, username)
+	if err != nil {
+		return gtserror.Newf("error getting transport for %s: %w", username, err)
+	}
+
+	b, err := tsport.Dereference(ctx, syncURL)
+	if err != nil {
+		return gtserror.Newf("error dereferencing %s: %w", syncURL, err)
+	}
+
+	var collection struct {
+		Items        []string `json:"items"`
+		OrderedItems []string `json:"orderedItems"`
+	}
+
+	if err := json.Unmarshal(b, &collection); err != nil {
+		return gtserror.Newf("error unmarshaling %s: %w", syncURL, err)
+	}
+
+	// Gather the set of follower
+	// URIs that the remote knows.
+	listed := make(map[string]struct{})
+	for _, uri := range append(collection.Items, collection.OrderedItems...) {
+		listed[uri] = struct{}{}
+	}
+
+	// Refetch current local follows, as
+	// these may have changed in the meantime.
+	follows, err := f.state.DB.GetAccountLocalFollowers(ctx, account.ID)
+	if err != nil {
+		return gtserror.Newf("error getting local followers: %w", err)
+	}
+
+	following := make(map[string]struct{}, len(follows))
+	for _, follow := range follows {
+		if follow.Account == nil {
+			continue
+		}
+
+		following[follow.Account.URI] = struct{}{}
+
+		if _, ok := listed[follow.Account.URI]; ok {
+			// In sync.
+			continue
+		}
+
+		// The remote has no record of this follow,
+		// so drop it locally to match remote state.
+		log.Infof(ctx, "removing follow of %s by %s unknown to remote", account.URI, follow.Account.URI)
+		if err := f.state.DB.DeleteFollowByID(ctx, follow.ID); err != nil {
+			log.Errorf(ctx, "error deleting follow %s: %v", follow.ID, err)
+		}
+	}
+
+	for uri := range listed {
+		if _, ok := following[uri]; ok {
+			// In sync.
+			continue
+		}
+
+		if err := f.undoUnknownFollow(ctx, uri, account); err != nil {
+			log.Errorf(ctx, "error undoing follow of %s by %s: %v", account.URI, uri, err)
+		}
+	}
+
+	return nil
+}
+
+// undoUnknownFollow sends an Undo Follow to given remote target account,
+// on behalf of the local account with URI, for a follow that the remote
+// believes to exist but we have no record of.
+func (f *Federator) undoUnknownFollow(
+	ctx context.Context,
+	uri string,
+	targetAccount *gtsmodel.Account,
+) error {
+	iri, err := url.Parse(uri)
+	if err != nil {
+		return gtserror.Newf("error parsing %s: %w", uri, err)
+	}
+
+	if iri.Host != config.GetHost() {
+		// Only act on behalf of our own accounts.
+		return nil
+	}
+
+	account, err := f.state.DB.GetAccountByURI(gtscontext.SetBarebones(ctx), uri)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Unknown account, nothing to undo.
+			return nil
+		}
+		return gtserror.Newf("error getting account %s: %w", uri, err)
+	}
+
+	// Check whether an outstanding follow
+	// request exists, in which case the
+	// remote is ahead of us; leave it be.
+	requested, err := f.state.DB.IsFollowRequested(ctx, account.ID, targetAccount.ID)
+	if err != nil {
+		return gtserror.Newf("error checking follow request: %w", err)
+	}
+
+	if requested {
+		return nil
+	}
+
+	log.Infof(ctx, "undoing follow of %s by %s unknown to us", targetAccount.URI, uri)
+
+	// Recreate a Follow from account to target,
+	// since we don't know the original Follow URI.
+	followID := id.NewULID()
+	follow := &gtsmodel.Follow{
+		ID:              followID,
+		URI:             uris.GenerateURIForFollow(account.Username, followID),
+		AccountID:       account.ID,
+		Account:         account,
+		TargetAccountID: targetAccount.ID,
+		TargetAccount:   targetAccount,
+	}
+
+	asFollow, err := f.converter.FollowToAS(ctx, follow)
+	if err != nil {
+		return gtserror.Newf("error converting follow to AS: %w", err)
+	}
+
+	// Wrap the Follow in an Undo.
+	undo := streams.NewActivityStreamsUndo()
+	undo.SetActivityStreamsActor(asFollow.GetActivityStreamsActor())
+
+	undoObject := streams.NewActivityStreamsObjectProperty()
+	undoObject.AppendActivityStreamsFollow(asFollow)
+	undo.SetActivityStreamsObject(undoObject)
+
+	targetIRI, err := url.Parse(targetAccount.URI)
+	if err != nil {
+		return gtserror.Newf("error parsing %s: %w", targetAccount.URI, err)
+	}
+
+	undoTo := streams.NewActivityStreamsToProperty()
+	undoTo.AppendIRI(targetIRI)
+	undo.SetActivityStreamsTo(undoTo)
+
+	data, err := ap.Serialize(undo)
+	if err != nil {
+		return gtserror.Newf("error serializing undo: %w", err)
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		return gtserror.Newf("error marshaling undo: %w", err)
+	}
+
+	inboxIRI, err := url.Parse(targetAccount.InboxURI)
+	if err != nil {
+		return gtserror.Newf("error parsing inbox %s: %w", targetAccount.InboxURI, err)
+	}
+
+	tsport, err := f.transportController.NewTransportForUsername(ctx, account.Username)
+	if err != nil {
+		return gtserror.Newf("error getting transport for %s: %w", account.Username, err)
+	}
+
+	return tsport.Deliver(ctx, b, inboxIRI)
+}
+
+// followerURIs returns the account URIs of the
+// followers (origin accounts) of the given follows.
+func followerURIs(follows []*gtsmodel.Follow) []string {
+	accURIs := make([]string, 0, len(follows))
+	for _, follow := range follows {
+		if follow.Account == nil {
+			continue
+		}
+		accURIs = append(accURIs, follow.Account.URI)
+	}
+	return accURIs
+}
@@ -19,15 +19,22 @@ package federation
 
 import (
 	"context"
+	"errors"
 	"net/url"
 
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 )
 
 // CheckGone checks if a tombstone exists in the database for AP Actor or Object with the given uri.
+// Tombstones marking an Actor or Object as having moved to a new URI are not considered gone.
 func (f *Federator) CheckGone(ctx context.Context, uri *url.URL) (bool, error) {
-	return f.db.TombstoneExistsWithURI(ctx, uri.String())
+	tombstone, err := f.db.GetTombstoneByURI(ctx, uri.String())
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, err
+	}
+	return tombstone != nil && !tombstone.Moved(), nil
 }
 
 // HandleGone puts a tombstone in the database, which marks an AP Actor or Object with the given uri as gone.
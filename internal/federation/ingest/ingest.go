@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package ingest applies the ingest rules set on this
+// instance to statuses coming in over federation.
+package ingest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// ApplyRules checks the given incoming status against the ingest
+// rules set on this instance. If a matching rule says to drop the status,
+// and it's new, an error flagged as dropped is returned. Otherwise, if a
+// matching rule says to unlist the status, and it's public, its visibility
+// is set to unlisted.
+//
+// Statuses we already have are never dropped,
+// and only hits from new statuses are counted,
+// so refreshing a status doesn't count twice.
+func ApplyRules(ctx context.Context, state *state.State, status *gtsmodel.Status, isNew bool) error {
+	rules, err := state.DB.MatchIngestRules(ctx, Text(status))
+	if err != nil {
+		return gtserror.Newf("error matching ingest rules: %w", err)
+	}
+
+	if len(rules) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	if isNew {
+		ids := make([]string, len(rules))
+		for i, rule := range rules {
+			ids[i] = rule.ID
+		}
+
+		if err := state.DB.IncrementIngestRuleHits(ctx, ids); err != nil {
+			log.Errorf(ctx, "error counting ingest rule hits: %v", err)
+		}
+	}
+
+	for _, rule := range rules {
+		switch rule.Action {
+		case gtsmodel.IngestRuleActionDrop:
+			if isNew {
+				err := gtserror.Newf("status %s dropped by ingest rule %s", status.URI, rule.ID)
+				return gtserror.SetDropped(err)
+			}
+
+		case gtsmodel.IngestRuleActionUnlist:
+			if status.Visibility == gtsmodel.VisibilityPublic {
+				status.Visibility = gtsmodel.VisibilityUnlocked
+			}
+		}
+	}
+
+	return nil
+}
+
+// Text returns the text of the given status
+// to check against ingest rules: its content warning,
+// its content as both plain text and HTML (so that link
+// URLs can be matched too), and its media descriptions.
+func Text(status *gtsmodel.Status) string {
+	parts := []string{
+		status.ContentWarning,
+		text.SanitizeToPlaintext(status.Content),
+		status.Content,
+	}
+
+	for _, attachment := range status.Attachments {
+		parts = append(parts, attachment.Description)
+	}
+
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,176 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package interop handles learning and storing the ways in which remote
+// domains deviate from the federation protocols as we implement them,
+// such that workarounds can be applied to future interactions.
+package interop
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Get returns the interop profile learned for the given domain,
+// or nil if nothing has been learned. Database errors are logged,
+// as profiles are only ever used to improve interoperability.
+func Get(ctx context.Context, state *state.State, domain string) *gtsmodel.DomainInterop {
+	interop, err := state.DB.GetDomainInterop(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error getting interop profile for %s: %v", domain, err)
+	}
+	return interop
+}
+
+// Quirk identifies a learnable quirk flag of domain interop profiles.
+type Quirk struct {
+	column string
+	field  func(*gtsmodel.DomainInterop) **bool
+}
+
+var (
+	// SignGETWithoutQuery: see gtsmodel.DomainInterop{}.SignGETWithoutQuery.
+	SignGETWithoutQuery = Quirk{"sign_get_without_query", func(i *gtsmodel.DomainInterop) **bool { return &i.SignGETWithoutQuery }}
+
+	// AcceptActivityJSON: see gtsmodel.DomainInterop{}.AcceptActivityJSON.
+	AcceptActivityJSON = Quirk{"accept_activity_json", func(i *gtsmodel.DomainInterop) **bool { return &i.AcceptActivityJSON }}
+
+	// DeliverActivityJSON: see gtsmodel.DomainInterop{}.DeliverActivityJSON.
+	DeliverActivityJSON = Quirk{"deliver_activity_json", func(i *gtsmodel.DomainInterop) **bool { return &i.DeliverActivityJSON }}
+
+	// LaxContentType: see gtsmodel.DomainInterop{}.LaxContentType.
+	LaxContentType = Quirk{"lax_content_type", func(i *gtsmodel.DomainInterop) **bool { return &i.LaxContentType }}
+)
+
+// In returns whether this quirk is set on given
+// profile. The profile may be nil, ie. unknown.
+func (q Quirk) In(interop *gtsmodel.DomainInterop) bool {
+	if interop == nil {
+		return false
+	}
+	flag := *q.field(interop)
+	return flag != nil && *flag
+}
+
+// LearnQuirk records the given quirk of the domain in its interop profile,
+// creating the profile if necessary. Reason should describe the failure
+// which exposed the quirk. Errors are logged rather than returned.
+func LearnQuirk(ctx context.Context, state *state.State, domain string, quirk Quirk, reason string) {
+	learn(ctx, state, domain, reason, func(interop *gtsmodel.DomainInterop) []string {
+		if quirk.In(interop) {
+			// Already known.
+			return nil
+		}
+		*quirk.field(interop) = util.Ptr(true)
+		return []string{quirk.column}
+	})
+}
+
+// LearnSignatureAlgorithm records the signature algorithm the domain was last
+// seen signing requests with, in its interop profile. This should only be
+// called when that is not the first algorithm we would try verifying with.
+func LearnSignatureAlgorithm(ctx context.Context, state *state.State, domain string, algo string) {
+	reason := "signs requests using " + algo
+	learn(ctx, state, domain, reason, func(interop *gtsmodel.DomainInterop) []string {
+		if interop.SignatureAlgorithm == algo {
+			// Already known.
+			return nil
+		}
+		interop.SignatureAlgorithm = algo
+		return []string{"signature_algorithm"}
+	})
+}
+
+// learn records a newly learned quirk of the given domain in its interop
+// profile, creating the profile if necessary. The given function should set
+// the quirk on the profile, returning the updated columns, or none if the quirk
+// was already known. Reason describes the failure which exposed the quirk.
+func learn(
+	ctx context.Context,
+	state *state.State,
+	domain string,
+	reason string,
+	set func(*gtsmodel.DomainInterop) []string,
+) {
+	if err := doLearn(ctx, state, domain, reason, set); err != nil {
+		log.Errorf(ctx, "error learning interop quirk for %s: %v", domain, err)
+	}
+}
+
+func doLearn(
+	ctx context.Context,
+	state *state.State,
+	domain string,
+	reason string,
+	set func(*gtsmodel.DomainInterop) []string,
+) error {
+	interop, err := state.DB.GetDomainInterop(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	if interop == nil {
+		// First quirk for this domain, create
+		// a new profile with the quirk set.
+		interop = &gtsmodel.DomainInterop{
+			ID:                  id.NewULID(),
+			Domain:              domain,
+			SignGETWithoutQuery: util.Ptr(false),
+			AcceptActivityJSON:  util.Ptr(false),
+			DeliverActivityJSON: util.Ptr(false),
+			LaxContentType:      util.Ptr(false),
+			LastQuirk:           reason,
+			LastQuirkAt:         time.Now(),
+		}
+		set(interop)
+
+		log.Infof(ctx, "learned interop quirk for %s: %s", domain, reason)
+
+		err := state.DB.PutDomainInterop(ctx, interop)
+		if !errors.Is(err, db.ErrAlreadyExists) {
+			return err
+		}
+
+		// Lost a race with a concurrent
+		// learner, update theirs instead.
+		interop, err = state.DB.GetDomainInterop(ctx, domain)
+		if err != nil {
+			return err
+		}
+	}
+
+	columns := set(interop)
+	if len(columns) == 0 {
+		// Already known.
+		return nil
+	}
+
+	log.Infof(ctx, "learned interop quirk for %s: %s", domain, reason)
+
+	interop.LastQuirk = reason
+	interop.LastQuirkAt = time.Now()
+	columns = append(columns, "last_quirk", "last_quirk_at")
+	return state.DB.UpdateDomainInterop(ctx, interop, columns...)
+}
@@ -0,0 +1,187 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+// Package spam scores statuses coming in over federation
+// from unknown accounts, so that statuses which look like
+// spam can be held for review by a moderator, or dropped.
+package spam
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	// Mentions beyond this many each add to the score.
+	freeMentions    = 2
+	scorePerMention = 10
+
+	// Each link adds to the score, and links in
+	// short statuses add extra on top of that.
+	scorePerLink     = 10
+	wordsPerLink     = 10
+	scoreLinkDensity = 20
+
+	// Accounts first seen recently add to the score.
+	scoreNewAccountDay  = 30
+	scoreNewAccountWeek = 15
+
+	// Text seen recently in a different
+	// status adds to the score; very short
+	// text is ignored as it repeats anyway.
+	scoreDuplicate     = 40
+	minDuplicateLength = 20
+)
+
+// linkRegex matches the opening tag of links in
+// status content. Mentions and hashtags are also
+// links, but they're marked with a "mention" class.
+var linkRegex = regexp.MustCompile(`(?i)<a\s[^>]*>`)
+
+// Result is the outcome of scoring a status.
+type Result struct {
+	// Score given to the status; higher is spammier.
+	Score int
+
+	// Reasons which contributed to the score.
+	Reasons []string
+}
+
+// Drop returns true if the score is
+// at or above the configured drop score.
+func (r Result) Drop() bool {
+	threshold := config.GetStatusesSpamFilterDropScore()
+	return threshold > 0 && r.Score >= threshold
+}
+
+// Quarantine returns true if the score is at or
+// above the configured quarantine score. Check
+// Drop first, since a status that should be
+// dropped will usually also be quarantined.
+func (r Result) Quarantine() bool {
+	threshold := config.GetStatusesSpamFilterQuarantineScore()
+	return threshold > 0 && r.Score >= threshold
+}
+
+func (r *Result) add(score int, reason string) {
+	r.Score += score
+	r.Reasons = append(r.Reasons, reason)
+}
+
+// Check scores the given incoming status, which must have
+// its account populated. Only statuses from remote accounts
+// that nobody on this instance follows are scored; for anything
+// else, or if the spam filter is disabled, a zero result is returned.
+func Check(ctx context.Context, state *state.State, status *gtsmodel.Status) (Result, error) {
+	var result Result
+
+	if !config.GetStatusesSpamFilterEnabled() {
+		// Nothing to do.
+		return result, nil
+	}
+
+	if status.Account == nil || status.Account.IsLocal() {
+		// Only check remote statuses.
+		return result, nil
+	}
+
+	followers, err := state.DB.CountAccountLocalFollowers(ctx, status.AccountID)
+	if err != nil {
+		return result, gtserror.Newf("error counting local followers: %w", err)
+	}
+
+	if followers > 0 {
+		// Someone here knows
+		// this account, skip.
+		return result, nil
+	}
+
+	plain := text.SanitizeToPlaintext(status.Content)
+
+	if mentions := len(status.MentionIDs); mentions > freeMentions {
+		result.add(
+			(mentions-freeMentions)*scorePerMention,
+			strconv.Itoa(mentions)+" mentions",
+		)
+	}
+
+	if links := countLinks(status.Content); links > 0 {
+		result.add(links*scorePerLink, strconv.Itoa(links)+" links")
+
+		if words := len(strings.Fields(plain)); words < links*wordsPerLink {
+			result.add(scoreLinkDensity, "high link density")
+		}
+	}
+
+	switch age := time.Since(status.Account.CreatedAt); {
+	case age < 24*time.Hour:
+		result.add(scoreNewAccountDay, "account first seen less than a day ago")
+	case age < 7*24*time.Hour:
+		result.add(scoreNewAccountWeek, "account first seen less than a week ago")
+	}
+
+	if isDuplicate(state, status.URI, plain) {
+		result.add(scoreDuplicate, "duplicate of recently received content")
+	}
+
+	return result, nil
+}
+
+// countLinks returns the number of links in
+// the given html that aren't mentions or hashtags.
+func countLinks(html string) int {
+	var count int
+	for _, tag := range linkRegex.FindAllString(html, -1) {
+		if !strings.Contains(tag, "mention") {
+			count++
+		}
+	}
+	return count
+}
+
+// isDuplicate returns true if the given plain text
+// was recently seen in a status other than the one
+// with the given URI, and records it if not seen yet.
+func isDuplicate(state *state.State, uri string, plain string) bool {
+	// Normalize case and whitespace so
+	// trivial variations still match.
+	normal := strings.Join(strings.Fields(strings.ToLower(plain)), " ")
+	if len(normal) < minDuplicateLength {
+		return false
+	}
+
+	sum := sha256.Sum256([]byte(normal))
+	hash := hex.EncodeToString(sum[:])
+
+	cache := state.Caches.GTS.SpamContentHashes()
+	if firstURI, ok := cache.Get(hash); ok {
+		return firstURI != uri
+	}
+
+	cache.Set(hash, uri)
+	return false
+}
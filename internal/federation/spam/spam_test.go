@@ -0,0 +1,179 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package spam_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/spam"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type SpamTestSuite struct {
+	suite.Suite
+	db    db.DB
+	state state.State
+
+	testAccounts map[string]*gtsmodel.Account
+}
+
+func (suite *SpamTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+}
+
+func (suite *SpamTestSuite) SetupTest() {
+	suite.state.Caches.Init()
+
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB(&suite.state)
+	testrig.StandardDBSetup(suite.db, nil)
+
+	config.SetStatusesSpamFilterEnabled(true)
+}
+
+func (suite *SpamTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+// newStatus returns a new, unstored status
+// authored by the given account, with the
+// given content and number of mentions.
+func (suite *SpamTestSuite) newStatus(account *gtsmodel.Account, content string, mentions int) *gtsmodel.Status {
+	statusID := id.NewULID()
+	status := &gtsmodel.Status{
+		ID:        statusID,
+		URI:       account.URI + "/statuses/" + statusID,
+		Content:   content,
+		AccountID: account.ID,
+		Account:   account,
+	}
+
+	for i := 0; i < mentions; i++ {
+		status.MentionIDs = append(status.MentionIDs, id.NewULID())
+	}
+
+	return status
+}
+
+func (suite *SpamTestSuite) TestCheckDisabled() {
+	config.SetStatusesSpamFilterEnabled(false)
+
+	account := suite.testAccounts["remote_account_1"]
+	status := suite.newStatus(account, `<p><a href="https://spam.example.org">buy now</a></p>`, 5)
+
+	result, err := spam.Check(context.Background(), &suite.state, status)
+	suite.NoError(err)
+	suite.Zero(result.Score)
+	suite.Empty(result.Reasons)
+}
+
+func (suite *SpamTestSuite) TestCheckLocal() {
+	account := suite.testAccounts["local_account_1"]
+	status := suite.newStatus(account, `<p><a href="https://spam.example.org">buy now</a></p>`, 5)
+
+	result, err := spam.Check(context.Background(), &suite.state, status)
+	suite.NoError(err)
+	suite.Zero(result.Score)
+}
+
+func (suite *SpamTestSuite) TestCheckFollowed() {
+	ctx := context.Background()
+	account := suite.testAccounts["remote_account_1"]
+
+	// Have someone here follow the remote account.
+	if err := suite.db.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              id.NewULID(),
+		URI:             "http://localhost:8080/users/the_mighty_zork/follow/" + id.NewULID(),
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		TargetAccountID: account.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status := suite.newStatus(account, `<p><a href="https://spam.example.org">buy now</a></p>`, 5)
+
+	result, err := spam.Check(ctx, &suite.state, status)
+	suite.NoError(err)
+	suite.Zero(result.Score)
+}
+
+func (suite *SpamTestSuite) TestCheckSpammy() {
+	// Copy the account so we can
+	// make it look newly discovered.
+	account := new(gtsmodel.Account)
+	*account = *suite.testAccounts["remote_account_1"]
+	account.CreatedAt = time.Now().Add(-time.Hour)
+
+	// 4 mentions, of which 2 are free: 20
+	// 3 links in 6 words: 30, plus 20 for density
+	// account first seen an hour ago: 30
+	status := suite.newStatus(account, `<p><span class="h-card"><a href="https://example.org/@someone" class="u-url mention">@<span>someone</span></a></span> cheap deals <a href="https://spam.example.org/1">here</a> <a href="https://spam.example.org/2">here</a> <a href="https://spam.example.org/3">here</a></p>`, 4)
+
+	result, err := spam.Check(context.Background(), &suite.state, status)
+	suite.NoError(err)
+	suite.Equal(100, result.Score)
+	suite.Equal([]string{
+		"4 mentions",
+		"3 links",
+		"high link density",
+		"account first seen less than a day ago",
+	}, result.Reasons)
+	suite.True(result.Drop())
+	suite.True(result.Quarantine())
+}
+
+func (suite *SpamTestSuite) TestCheckDuplicate() {
+	ctx := context.Background()
+	account := suite.testAccounts["remote_account_1"]
+	content := "<p>Hello, have you heard about our amazing new product?</p>"
+
+	// First time seen, not a duplicate.
+	status1 := suite.newStatus(account, content, 0)
+	result, err := spam.Check(ctx, &suite.state, status1)
+	suite.NoError(err)
+	suite.Zero(result.Score)
+
+	// Checking the same status again
+	// doesn't count as a duplicate.
+	result, err = spam.Check(ctx, &suite.state, status1)
+	suite.NoError(err)
+	suite.Zero(result.Score)
+
+	// Same text with different case and
+	// spacing in another status is a duplicate.
+	status2 := suite.newStatus(account, "<p>hello,   have you heard about our AMAZING new product?</p>", 0)
+	result, err = spam.Check(ctx, &suite.state, status2)
+	suite.NoError(err)
+	suite.Equal(40, result.Score)
+	suite.Equal([]string{"duplicate of recently received content"}, result.Reasons)
+	suite.False(result.Drop())
+	suite.False(result.Quarantine())
+}
+
+func TestSpamTestSuite(t *testing.T) {
+	suite.Run(t, new(SpamTestSuite))
+}
@@ -35,6 +35,8 @@ const (
 	errorTypeKey
 	unrtrvableKey
 	wrongTypeKey
+	droppedKey
+	requestIDKey
 
 	// Types returnable from Type(...).
 	TypeSMTP ErrorType = "smtp" // smtp (mail)
@@ -71,6 +73,22 @@ func SetWrongType(err error) error {
 	return errors.WithValue(err, wrongTypeKey, struct{}{})
 }
 
+// Dropped checks error for a stored "dropped" flag. Dropped
+// indicates that an incoming resource (status, etc) was
+// deliberately discarded by a rule set on this instance,
+// rather than failing to be processed, so there's no
+// need to report it as an error.
+func Dropped(err error) bool {
+	_, ok := errors.Value(err, droppedKey).(struct{})
+	return ok
+}
+
+// SetDropped will wrap the given error to store a "dropped" flag,
+// returning wrapped error. See "Dropped" for example use-cases.
+func SetDropped(err error) error {
+	return errors.WithValue(err, droppedKey, struct{}{})
+}
+
 // StatusCode checks error for a stored status code value. For example
 // an error from an outgoing HTTP request may be stored, or an API handler
 // expected response status code may be stored.
@@ -111,3 +129,20 @@ func Type(err error) ErrorType {
 func SetType(err error, errType ErrorType) error {
 	return errors.WithValue(err, errorTypeKey, errType)
 }
+
+// RequestID checks error for a stored request ID value, ie., the
+// ID of the API or federation request during which it occurred.
+func RequestID(err error) string {
+	id, _ := errors.Value(err, requestIDKey).(string)
+	return id
+}
+
+// WithRequestID will wrap the given error to store provided request
+// ID, returning wrapped error. If the ID is empty, err is returned
+// as-is. See RequestID() for example use-cases.
+func WithRequestID(err error, id string) error {
+	if id == "" {
+		return err
+	}
+	return errors.WithValue(err, requestIDKey, id)
+}
@@ -52,17 +52,19 @@ type Account struct {
 	Note                    string           `bun:""`                               // A note that this account has on their profile (ie., the account's bio/description of themselves)
 	NoteRaw                 string           `bun:""`                               // The raw contents of .Note without conversion to HTML, only available when requester = target
 	Memorial                *bool            `bun:",default:false"`                 // Is this a memorial account, ie., has the user passed away?
-	AlsoKnownAs             string           `bun:"type:CHAR(26),nullzero"`         // This account is associated with x account id (TODO: migrate to be AlsoKnownAsID)
+	AlsoKnownAsURIs         []string         `bun:"also_known_as_uris,array"`       // This account is associated with these account URIs (aliases), eg., as part of account migration.
 	MovedToAccountID        string           `bun:"type:CHAR(26),nullzero"`         // This account has moved this account id in the database
 	Bot                     *bool            `bun:",default:false"`                 // Does this account identify itself as a bot?
 	Reason                  string           `bun:""`                               // What reason was given for signing up when this account was created?
 	Locked                  *bool            `bun:",default:true"`                  // Does this account need an approval for new followers?
 	Discoverable            *bool            `bun:",default:false"`                 // Should this account be shown in the instance's profile directory?
+	Indexable               *bool            `bun:""`                               // May this account's statuses (and profile) be indexed for search? Nil if a remote account doesn't say.
 	Privacy                 Visibility       `bun:",nullzero"`                      // Default post privacy for this account
 	Sensitive               *bool            `bun:",default:false"`                 // Set posts from this account to sensitive by default?
 	Language                string           `bun:",nullzero,notnull,default:'en'"` // What language does this account post in?
 	StatusContentType       string           `bun:",nullzero"`                      // What is the default format for statuses posted by this account (only for local accounts).
 	CustomCSS               string           `bun:",nullzero"`                      // Custom CSS that should be displayed for this Account's profile and statuses.
+	Theme                   string           `bun:",nullzero"`                      // File name of the instance theme to use for this Account's profile and statuses.
 	URI                     string           `bun:",nullzero,notnull,unique"`       // ActivityPub URI for this account.
 	URL                     string           `bun:",nullzero,unique"`               // Web URL for this account's profile
 	InboxURI                string           `bun:",nullzero,unique"`               // Address of this account's ActivityPub inbox, for sending activity to
@@ -72,7 +74,7 @@ type Account struct {
 	FollowersURI            string           `bun:",nullzero,unique"`               // URI for getting the followers list of this account
 	FeaturedCollectionURI   string           `bun:",nullzero,unique"`               // URL for getting the featured collection list of this account
 	ActorType               string           `bun:",nullzero,notnull"`              // What type of activitypub actor is this account?
-	PrivateKey              *rsa.PrivateKey  `bun:""`                               // Privatekey for signing activitypub requests, will only be defined for local accounts
+	PrivateKey              *rsa.PrivateKey  `bun:"" json:"-"`                      // Privatekey for signing activitypub requests, will only be defined for local accounts
 	PublicKey               *rsa.PublicKey   `bun:",notnull"`                       // Publickey for authorizing signed activitypub requests, will be defined for both local and remote accounts
 	PublicKeyURI            string           `bun:",nullzero,notnull,unique"`       // Web-reachable location of this account's public key
 	PublicKeyExpiresAt      time.Time        `bun:"type:timestamptz,nullzero"`      // PublicKey will expire/has expired at given time, and should be fetched again as appropriate. Only ever set for remote accounts.
@@ -82,6 +84,7 @@ type Account struct {
 	HideCollections         *bool            `bun:",default:false"`                 // Hide this account's collections
 	SuspensionOrigin        string           `bun:"type:CHAR(26),nullzero"`         // id of the database entry that caused this account to become suspended -- can be an account ID or a domain block ID
 	EnableRSS               *bool            `bun:",default:false"`                 // enable RSS feed subscription for this account's public posts at [URL]/feed
+	NoIndex                 *bool            `bun:""`                               // ask search engines not to index this account's profile and status web pages; nil means use the instance default
 }
 
 // IsLocal returns whether account is a local user account.
@@ -109,6 +112,13 @@ func (a *Account) IsInstance() bool {
 		a.Username == "instance.actor" // <- misskey
 }
 
+// IsGroup returns whether account is a group actor, eg.,
+// a Lemmy community or Friendica forum, which distributes
+// posts from members to its followers by Announcing them.
+func (a *Account) IsGroup() bool {
+	return a.ActorType == "Group"
+}
+
 // EmojisPopulated returns whether emojis are populated according to current EmojiIDs.
 func (a *Account) EmojisPopulated() bool {
 	if len(a.EmojiIDs) != len(a.Emojis) {
@@ -156,6 +166,7 @@ type Field struct {
 	Name       string    // Name of this field.
 	Value      string    // Value of this field.
 	VerifiedAt time.Time `bun:",nullzero"` // This field was verified at (optional).
+	RelMe      bool      // Links in the value of this field get rel="me" (local accounts only).
 }
 
 // Relationship describes a requester's relationship with another account.
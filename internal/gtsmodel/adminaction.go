@@ -33,6 +33,7 @@ const (
 	AdminActionCategoryUnknown AdminActionCategory = iota
 	AdminActionCategoryAccount
 	AdminActionCategoryDomain
+	AdminActionCategoryTag
 )
 
 func (c AdminActionCategory) String() string {
@@ -41,6 +42,8 @@ func (c AdminActionCategory) String() string {
 		return "account"
 	case AdminActionCategoryDomain:
 		return "domain"
+	case AdminActionCategoryTag:
+		return "tag"
 	default:
 		return "unknown" //nolint:goconst
 	}
@@ -52,6 +55,8 @@ func NewAdminActionCategory(in string) AdminActionCategory {
 		return AdminActionCategoryAccount
 	case "domain":
 		return AdminActionCategoryDomain
+	case "tag":
+		return AdminActionCategoryTag
 	default:
 		return AdminActionCategoryUnknown
 	}
@@ -73,6 +78,8 @@ const (
 	AdminActionSuspend
 	AdminActionUnsuspend
 	AdminActionExpireKeys
+	AdminActionUnlist
+	AdminActionUnlimit
 )
 
 func (t AdminActionType) String() string {
@@ -91,6 +98,10 @@ func (t AdminActionType) String() string {
 		return "unsuspend"
 	case AdminActionExpireKeys:
 		return "expire-keys"
+	case AdminActionUnlist:
+		return "unlist"
+	case AdminActionUnlimit:
+		return "unlimit"
 	default:
 		return "unknown"
 	}
@@ -112,6 +123,10 @@ func NewAdminActionType(in string) AdminActionType {
 		return AdminActionUnsuspend
 	case "expire-keys":
 		return AdminActionExpireKeys
+	case "unlist":
+		return AdminActionUnlist
+	case "unlimit":
+		return AdminActionUnlimit
 	default:
 		return AdminActionUnknown
 	}
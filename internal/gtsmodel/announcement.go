@@ -0,0 +1,63 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Announcement models an instance-wide announcement set by an admin.
+type Announcement struct {
+	ID            string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt     time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Content       string    `bun:",nullzero"`                                                   // html content of the announcement
+	Text          string    `bun:",nullzero"`                                                   // original plaintext of the announcement
+	StartsAt      time.Time `bun:"type:timestamptz,nullzero"`                                   // when the announcement should start being shown, zero = immediately
+	EndsAt        time.Time `bun:"type:timestamptz,nullzero"`                                   // when the announcement should stop being shown, zero = never
+	AllDay        *bool     `bun:",nullzero,notnull,default:false"`                             // starts + ends at are days rather than exact times
+	Published     *bool     `bun:",nullzero,notnull,default:false"`                             // is this announcement visible to non-admin users
+	MaintenanceAt time.Time `bun:"type:timestamptz,nullzero"`                                   // if set, instance enters read-only maintenance mode at this time until EndsAt
+}
+
+// Active returns whether the announcement
+// should be shown to users at the given time.
+func (a *Announcement) Active(now time.Time) bool {
+	if !*a.Published {
+		return false
+	}
+
+	if !a.StartsAt.IsZero() && now.Before(a.StartsAt) {
+		return false
+	}
+
+	return a.EndsAt.IsZero() || now.Before(a.EndsAt)
+}
+
+// InMaintenance returns whether the announcement
+// places the instance in maintenance mode at the
+// given time.
+func (a *Announcement) InMaintenance(now time.Time) bool {
+	if a.MaintenanceAt.IsZero() || !*a.Published {
+		return false
+	}
+
+	if now.Before(a.MaintenanceAt) {
+		return false
+	}
+
+	return a.EndsAt.IsZero() || now.Before(a.EndsAt)
+}
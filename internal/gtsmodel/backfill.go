@@ -0,0 +1,36 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Backfill is the stored progress of a long running
+// fill of data scheduled by a database migration, which
+// is run in batches in the background after startup.
+type Backfill struct {
+	Name       string    `bun:",pk,nullzero,notnull,unique"`                                 // Name of the backfill, eg., "account-stats".
+	CreatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Cursor     string    `bun:",nullzero"`                                                   // Position reached by the last batch, to resume from.
+	FinishedAt time.Time `bun:"type:timestamptz,nullzero"`                                   // When the backfill finished, zero if it's still to do.
+}
+
+// Finished returns whether the backfill has finished.
+func (b *Backfill) Finished() bool {
+	return !b.FinishedAt.IsZero()
+}
@@ -0,0 +1,38 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainInterop is a profile of the ways in which a remote domain deviates
+// from the federation protocols as we implement them (its quirks), learned
+// automatically from failed interactions with that domain. Learned quirks
+// are used to apply workarounds to future interactions with the domain.
+type DomainInterop struct {
+	ID                  string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain              string    `bun:",nullzero,notnull,unique"`                                    // domain this profile applies to. Eg. 'whatever.com'
+	SignGETWithoutQuery *bool     `bun:",nullzero,notnull,default:false"`                             // domain verifies signatures of GET requests over the request path only, excluding any query.
+	AcceptActivityJSON  *bool     `bun:",nullzero,notnull,default:false"`                             // domain rejects our usual Accept header when dereferencing, and requires 'application/activity+json'.
+	DeliverActivityJSON *bool     `bun:",nullzero,notnull,default:false"`                             // domain rejects deliveries with an 'application/ld+json' Content-Type, and requires 'application/activity+json'.
+	LaxContentType      *bool     `bun:",nullzero,notnull,default:false"`                             // domain serves ActivityPub objects with a non-ActivityPub Content-Type (no workaround needed, we accept these).
+	SignatureAlgorithm  string    `bun:",nullzero"`                                                   // signature algorithm last used by domain to sign requests to us, when not our first choice; tried first.
+	LastQuirk           string    `bun:",nullzero"`                                                   // description of the failure which most recently taught us a quirk of this domain.
+	LastQuirkAt         time.Time `bun:"type:timestamptz,nullzero"`                                   // when LastQuirk was learned.
+}
@@ -0,0 +1,39 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainQuarantine tracks a domain which delivered to this instance for
+// the first time while new domain quarantine was enabled. Until the domain
+// is approved, statuses from it are held in the quarantine queue.
+type DomainQuarantine struct {
+	ID                  string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt           time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain              string    `bun:",nullzero,notnull,unique"`                                    // Domain being quarantined, eg., example.org.
+	ApprovedAt          time.Time `bun:"type:timestamptz,nullzero"`                                   // When was this domain approved? Zero if still pending.
+	ApprovedByAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // ID of the moderator who approved this domain, if not auto-approved.
+	CleanCount          int       `bun:",notnull,default:0"`                                          // Number of held statuses from this domain that passed the spam filter.
+}
+
+// Approved returns whether this domain has been approved,
+// and statuses from it should no longer be held.
+func (d *DomainQuarantine) Approved() bool {
+	return !d.ApprovedAt.IsZero()
+}
@@ -0,0 +1,53 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// DomainStats holds counters of outgoing federation traffic with a remote
+// domain, used to spot remote instances which are dead or misbehaving.
+type DomainStats struct {
+	ID                          string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt                   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt                   time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain                      string    `bun:",nullzero,notnull,unique"`                                    // domain these stats apply to. Eg. 'whatever.com'
+	DeliverySuccesses           int64     `bun:",notnull,default:0"`                                          // deliveries to the domain which were accepted with a 2xx response.
+	DeliveryFailures            int64     `bun:",notnull,default:0"`                                          // deliveries to the domain which failed to connect, or were rejected.
+	DereferenceSuccesses        int64     `bun:",notnull,default:0"`                                          // dereferences from the domain which got a response, even if eg. 404 Not Found.
+	DereferenceFailures         int64     `bun:",notnull,default:0"`                                          // dereferences from the domain which failed to connect, or got a 5xx response.
+	LastSuccessAt               time.Time `bun:"type:timestamptz,nullzero"`                                   // when was the domain last contacted successfully.
+	LastFailureAt               time.Time `bun:"type:timestamptz,nullzero"`                                   // when did contacting the domain last fail.
+	LastFailure                 string    `bun:",nullzero"`                                                   // description of the most recent failure contacting the domain.
+	ConsecutiveDeliveryFailures int64     `bun:",notnull,default:0"`                                          // deliveries to the domain which failed since the last successful delivery.
+	DeliveryFailingSince        time.Time `bun:"type:timestamptz,nullzero"`                                   // when did the first of the consecutive delivery failures happen.
+	DeliveriesSuspendedAt       time.Time `bun:"type:timestamptz,nullzero"`                                   // when were deliveries to the domain suspended because it seems dead.
+}
+
+// DeliveriesSuspended returns whether deliveries
+// to the domain are suspended because it seems dead.
+func (s *DomainStats) DeliveriesSuspended() bool {
+	return !s.DeliveriesSuspendedAt.IsZero()
+}
+
+// DomainContact identifies a type of outgoing contact with a remote domain.
+type DomainContact string
+
+const (
+	DomainContactDelivery    DomainContact = "delivery"    // delivery of an activity to the domain.
+	DomainContactDereference DomainContact = "dereference" // dereference of a resource from the domain.
+)
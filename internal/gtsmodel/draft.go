@@ -0,0 +1,64 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Draft represents an unposted status stored server-side
+// for an account, so that it can be picked up again later,
+// potentially from a different client or device.
+type Draft struct {
+	ID             string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt      time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt      time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID      string             `bun:"type:CHAR(26),nullzero,notnull"`                              // Account that created/owns the draft.
+	Account        *Account           `bun:"-"`                                                           // Account corresponding to accountID.
+	Text           string             `bun:""`                                                            // Original text of the draft, as provided by the client.
+	ContentWarning string             `bun:",nullzero"`                                                   // Content warning / spoiler text for the draft.
+	AttachmentIDs  []string           `bun:"attachments,array"`                                           // Database IDs of any media attachments associated with this draft.
+	Attachments    []*MediaAttachment `bun:"-"`                                                           // Attachments corresponding to attachmentIDs.
+	InReplyToID    string             `bun:"type:CHAR(26),nullzero"`                                      // ID of the status this draft replies to, if any.
+	Visibility     Visibility         `bun:",nullzero"`                                                   // Visibility to post the draft with.
+	Sensitive      *bool              `bun:",nullzero,notnull,default:false"`                             // Mark the draft as sensitive?
+	Language       string             `bun:",nullzero"`                                                   // Language the draft is written in.
+	ContentType    string             `bun:",nullzero"`                                                   // Content type (text/plain, text/markdown) to parse the draft text with.
+	PollOptions    []string           `bun:"poll_options,array"`                                          // Options of a poll to post with the draft, if any.
+	PollExpiresIn  int                `bun:",nullzero"`                                                   // Duration in seconds the poll should be open for.
+	PollMultiple   *bool              `bun:",nullzero,notnull,default:false"`                             // Allow multiple choices on the poll.
+	PollHideTotals *bool              `bun:",nullzero,notnull,default:false"`                             // Hide vote counts until the poll ends.
+}
+
+// AttachmentsPopulated returns whether media attachments
+// are populated according to current AttachmentIDs.
+func (d *Draft) AttachmentsPopulated() bool {
+	if len(d.AttachmentIDs) != len(d.Attachments) {
+		// this is the quickest indicator.
+		return false
+	}
+	for i, id := range d.AttachmentIDs {
+		if d.Attachments[i].ID != id {
+			return false
+		}
+	}
+	return true
+}
+
+// HasPoll returns whether the draft has poll options set.
+func (d *Draft) HasPoll() bool {
+	return len(d.PollOptions) != 0
+}
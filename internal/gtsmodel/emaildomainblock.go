@@ -19,12 +19,14 @@ package gtsmodel
 
 import "time"
 
-// EmailDomainBlock represents a domain that the server should automatically reject sign-up requests from.
+// EmailDomainBlock is a domain (and its subdomains)
+// from which email addresses may not be used to sign up.
 type EmailDomainBlock struct {
 	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
 	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
 	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Domain             string    `bun:",nullzero,notnull"`                                           // Email domain to block. Eg. 'gmail.com' or 'hotmail.com'
-	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this block
-	CreatedByAccount   *Account  `bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	Domain             string    `bun:",nullzero,notnull"`                                           // Email domain to block, in punycode. Eg. 'gmail.com' or 'hotmail.com'.
+	Comment            string    `bun:",nullzero"`                                                   // Private comment on this block, viewable to admins.
+	CreatedByAccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this block.
+	CreatedByAccount   *Account  `bun:"-"`                                                           // Account corresponding to createdByAccountID.
 }
@@ -43,4 +43,6 @@ type Emoji struct {
 	Category               *EmojiCategory `bun:"rel:belongs-to"`                                              // In which emoji category is this emoji visible?
 	CategoryID             string         `bun:"type:CHAR(26),nullzero"`                                      // ID of the category this emoji belongs to.
 	Cached                 *bool          `bun:",nullzero,notnull,default:false"`
+	License                string         `bun:",nullzero"` // License under which the emoji image may be used, eg., 'CC BY 4.0'. Only set for local emojis.
+	Attribution            string         `bun:",nullzero"` // Credit for the author(s) of the emoji image, as required by its license. Only set for local emojis.
 }
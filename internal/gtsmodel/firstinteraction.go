@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// FirstInteraction is created when a remote account that a local account
+// has never interacted with mentions the local account for the first time,
+// if the local account wants to review such first interactions. While it's
+// pending, the local account isn't notified of statuses from the remote
+// account that mention it; they're held until the local account approves
+// the remote account, or reports it.
+type FirstInteraction struct {
+	ID              string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                   // id of this item in the database
+	CreatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                // when was item created
+	UpdatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                // when was item last updated
+	AccountID       string    `bun:"type:CHAR(26),unique:first_interactions_account_id_origin_account_id_uniq,nullzero,notnull"` // ID of the local account that was mentioned.
+	Account         *Account  `bun:"-"`                                                                                          // Account corresponding to accountID.
+	OriginAccountID string    `bun:"type:CHAR(26),unique:first_interactions_account_id_origin_account_id_uniq,nullzero,notnull"` // ID of the remote account that did the mentioning.
+	OriginAccount   *Account  `bun:"-"`                                                                                          // Account corresponding to originAccountID.
+	StatusIDs       []string  `bun:"statuses,array"`                                                                             // IDs of held statuses from the origin account that mention the account, oldest first.
+	Statuses        []*Status `bun:"-"`                                                                                          // Statuses corresponding to statusIDs.
+	ApprovedAt      time.Time `bun:"type:timestamptz,nullzero"`                                                                  // When did the account approve the origin account? Zero while pending review.
+}
+
+// Pending returns true if the first
+// interaction has yet to be approved.
+func (f *FirstInteraction) Pending() bool {
+	return f.ApprovedAt.IsZero()
+}
@@ -0,0 +1,47 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// IngestRule is a keyword or regular expression which is checked
+// against statuses coming in over federation. Statuses that match
+// the rule are either dropped, or stored as unlisted, according to
+// the rule's action.
+type IngestRule struct {
+	ID                 string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Pattern            string           `bun:",nullzero,notnull"`                                           // Keyword or regular expression to check statuses against.
+	Regex              *bool            `bun:",nullzero,notnull,default:false"`                             // Is Pattern a regular expression? If not, it's a keyword, matched case-insensitively.
+	Action             IngestRuleAction `bun:",nullzero,notnull"`                                           // What to do with statuses that match this rule.
+	Comment            string           `bun:",nullzero"`                                                   // Private comment on this rule, viewable to admins.
+	CreatedByAccountID string           `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this rule.
+	CreatedByAccount   *Account         `bun:"-"`                                                           // Account corresponding to createdByAccountID.
+	Hits               int              `bun:",notnull,default:0"`                                          // Number of incoming statuses that have matched this rule.
+	LastHitAt          time.Time        `bun:"type:timestamptz,nullzero"`                                   // When did an incoming status last match this rule?
+}
+
+// IngestRuleAction describes what is done
+// with a status that matches an ingest rule.
+type IngestRuleAction string
+
+const (
+	IngestRuleActionDrop   IngestRuleAction = "drop"   // Don't store the status at all.
+	IngestRuleActionUnlist IngestRuleAction = "unlist" // Store the status, but keep it off public timelines.
+)
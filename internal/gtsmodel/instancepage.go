@@ -0,0 +1,41 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// InstancePage represents a static page of the instance, like the about
+// page or privacy policy, written by an admin in markdown and rendered
+// by the web frontend.
+type InstancePage struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Slug      string    `bun:",nullzero,notnull,unique"`                                    // Url-safe name of the page, used in its path.
+	Title     string    `bun:",nullzero,notnull"`                                           // Title of the page.
+	Text      string    `bun:""`                                                            // Markdown text of the page, as provided by the admin.
+	Content   string    `bun:""`                                                            // Html content of the page, rendered from Text.
+	NavOrder  int       `bun:",notnull,default:0"`                                          // Position of the page in navigation entries, lowest first.
+	ShowInNav *bool     `bun:",nullzero,notnull,default:true"`                              // Show the page in navigation entries?
+}
+
+// Slugs of instance pages with special meaning.
+const (
+	InstancePageAbout   = "about"   // InstancePageAbout -- shown on the about page in place of the instance description.
+	InstancePagePrivacy = "privacy" // InstancePagePrivacy -- privacy policy of the instance.
+)
@@ -0,0 +1,37 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// InstanceStatistic is a snapshot of usage statistics
+// for this instance over one day, recorded nightly to
+// build up time series for the admin dashboard.
+type InstanceStatistic struct {
+	ID                 string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Day                time.Time `bun:"type:timestamptz,nullzero,notnull,unique"`                    // Start (midnight UTC) of the day covered by these statistics.
+	ActiveUsers        int       `bun:",notnull"`                                                    // Number of local accounts that signed in or posted during the day.
+	NewRegistrations   int       `bun:",notnull"`                                                    // Number of local users that signed up during the day.
+	StatusesCreated    int       `bun:",notnull"`                                                    // Number of statuses posted by local accounts during the day.
+	StatusesReceived   int       `bun:",notnull"`                                                    // Number of statuses from remote accounts created during the day.
+	AccountsDiscovered int       `bun:",notnull"`                                                    // Number of remote accounts first seen during the day.
+	KnownInstances     int       `bun:",notnull"`                                                    // Number of instances federated with, as of the end of the day.
+	MediaStorageBytes  int64     `bun:",notnull"`                                                    // Total size in bytes of media attachments stored, as of the end of the day.
+}
@@ -0,0 +1,33 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+// InteractionCount models the number of times one
+// account interacted with statuses of another account
+// over a period of time. It is not stored in the database.
+type InteractionCount struct {
+	AccountID  string // ID of the interacting account.
+	Favourites int    // Number of statuses faved by the interacting account.
+	Reblogs    int    // Number of statuses boosted by the interacting account.
+	Replies    int    // Number of replies posted by the interacting account.
+}
+
+// Total returns the total number of interactions.
+func (i *InteractionCount) Total() int {
+	return i.Favourites + i.Reblogs + i.Replies
+}
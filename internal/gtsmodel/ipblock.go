@@ -0,0 +1,49 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// IPBlock is an IP address or range of IP addresses
+// from which sign-ups, or all access, are blocked.
+type IPBlock struct {
+	ID                 string          `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time       `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	IP                 string          `bun:",nullzero,notnull,unique"`                                    // Blocked IP range in CIDR notation, eg., 192.0.2.0/24. Single addresses are stored as /32 or /128.
+	Severity           IPBlockSeverity `bun:",nullzero,notnull"`                                           // What is blocked for the IP range.
+	Comment            string          `bun:",nullzero"`                                                   // Private comment on this block, viewable to admins.
+	ExpiresAt          time.Time       `bun:"type:timestamptz,nullzero"`                                   // When does this block stop applying? Zero means never.
+	CreatedByAccountID string          `bun:"type:CHAR(26),nullzero,notnull"`                              // Account ID of the creator of this block.
+	CreatedByAccount   *Account        `bun:"-"`                                                           // Account corresponding to createdByAccountID.
+}
+
+// Expired returns true if the IP
+// block has expired as of now.
+func (b *IPBlock) Expired(now time.Time) bool {
+	return !b.ExpiresAt.IsZero() && !now.Before(b.ExpiresAt)
+}
+
+// IPBlockSeverity describes what
+// is blocked for an IP range.
+type IPBlockSeverity string
+
+const (
+	IPBlockSignUpBlock IPBlockSeverity = "sign_up_block" // Block sign-ups from the range.
+	IPBlockNoAccess    IPBlockSeverity = "no_access"     // Block all requests from the range.
+)
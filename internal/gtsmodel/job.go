@@ -0,0 +1,46 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// Job is the stored state of one of the instance's
+// scheduled background jobs, eg., media cleanup,
+// recording whether the job's been paused by an
+// admin, and the outcome of its last run.
+type Job struct {
+	ID              string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Name            string    `bun:",nullzero,notnull,unique"`                                    // Name of the job, eg., "media-clean".
+	Paused          *bool     `bun:",nullzero,notnull,default:false"`                             // Skip scheduled runs of this job until unpaused.
+	LastRunAt       time.Time `bun:"type:timestamptz,nullzero"`                                   // When the last run of this job started.
+	LastRunDuration int64     `bun:",notnull,default:0"`                                          // How long the last run took, in milliseconds, including retries.
+	LastRunAttempts int       `bun:",notnull,default:0"`                                          // Number of attempts made during the last run.
+	LastRunStatus   JobStatus `bun:",nullzero"`                                                   // Outcome of the last run.
+	LastRunError    string    `bun:",nullzero"`                                                   // Error returned by the last attempt, if it failed.
+}
+
+// JobStatus is the outcome of a run of a Job.
+type JobStatus string
+
+const (
+	JobStatusRunning   JobStatus = "running"   // Job is running now.
+	JobStatusSucceeded JobStatus = "succeeded" // Job ran successfully.
+	JobStatusFailed    JobStatus = "failed"    // Job failed, even after retrying.
+)
@@ -49,3 +49,18 @@ const (
 	RepliesPolicyList     RepliesPolicy = "list"     // Show replies to members of the list only.
 	RepliesPolicyNone     RepliesPolicy = "none"     // Don't show replies.
 )
+
+// ListRule refers to a single rule by which statuses are automatically
+// surfaced in a list timeline, in addition to the list's follow entries.
+//
+// Exactly one of TagID or TargetAccountID should be set.
+type ListRule struct {
+	ID              string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt       time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	ListID          string    `bun:"type:CHAR(26),notnull,nullzero"`                              // ID of the list that this rule belongs to.
+	TagID           string    `bun:"type:CHAR(26),nullzero"`                                      // ID of the tag which statuses should use in order to match this rule.
+	Tag             *Tag      `bun:"-"`                                                           // Tag corresponding to tagID.
+	TargetAccountID string    `bun:"type:CHAR(26),nullzero"`                                      // ID of the account which statuses should be authored by in order to match this rule.
+	TargetAccount   *Account  `bun:"-"`                                                           // Account corresponding to targetAccountID.
+}
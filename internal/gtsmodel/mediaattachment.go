@@ -24,24 +24,33 @@ import (
 // MediaAttachment represents a user-uploaded media attachment: an image/video/audio/gif that is
 // somewhere in storage and that can be retrieved and served by the router.
 type MediaAttachment struct {
-	ID                string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt         time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt         time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	StatusID          string           `bun:"type:CHAR(26),nullzero"`                                      // ID of the status to which this is attached
-	URL               string           `bun:",nullzero"`                                                   // Where can the attachment be retrieved on *this* server
-	RemoteURL         string           `bun:",nullzero"`                                                   // Where can the attachment be retrieved on a remote server (empty for local media)
-	Type              FileType         `bun:",nullzero,notnull"`                                           // Type of file (image/gifv/audio/video)
-	FileMeta          FileMeta         `bun:",embed:,nullzero,notnull"`                                    // Metadata about the file
-	AccountID         string           `bun:"type:CHAR(26),nullzero,notnull"`                              // To which account does this attachment belong
-	Description       string           `bun:""`                                                            // Description of the attachment (for screenreaders)
-	ScheduledStatusID string           `bun:"type:CHAR(26),nullzero"`                                      // To which scheduled status does this attachment belong
-	Blurhash          string           `bun:",nullzero"`                                                   // What is the generated blurhash of this attachment
-	Processing        ProcessingStatus `bun:",notnull,default:2"`                                          // What is the processing status of this attachment
-	File              File             `bun:",embed:file_,notnull,nullzero"`                               // metadata for the whole file
-	Thumbnail         Thumbnail        `bun:",embed:thumbnail_,notnull,nullzero"`                          // small image thumbnail derived from a larger image, video, or audio file.
-	Avatar            *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as an avatar?
-	Header            *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as a header?
-	Cached            *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment currently cached by our instance?
+	ID                 string           `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt          time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time        `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	StatusID           string           `bun:"type:CHAR(26),nullzero"`                                      // ID of the status to which this is attached
+	URL                string           `bun:",nullzero"`                                                   // Where can the attachment be retrieved on *this* server
+	RemoteURL          string           `bun:",nullzero"`                                                   // Where can the attachment be retrieved on a remote server (empty for local media)
+	RemoteETag         string           `bun:"remote_etag,nullzero"`                                        // ETag returned by the remote server with the attachment, for conditional refetches
+	RemoteLastModified string           `bun:",nullzero"`                                                   // Last-Modified returned by the remote server with the attachment, for conditional refetches
+	Type               FileType         `bun:",nullzero,notnull"`                                           // Type of file (image/gifv/audio/video)
+	FileMeta           FileMeta         `bun:",embed:,nullzero,notnull"`                                    // Metadata about the file
+	AccountID          string           `bun:"type:CHAR(26),nullzero,notnull"`                              // To which account does this attachment belong
+	Description        string           `bun:""`                                                            // Description of the attachment (for screenreaders)
+	ScheduledStatusID  string           `bun:"type:CHAR(26),nullzero"`                                      // To which scheduled status does this attachment belong
+	Blurhash           string           `bun:",nullzero"`                                                   // What is the generated blurhash of this attachment
+	Processing         ProcessingStatus `bun:",notnull,default:2"`                                          // What is the processing status of this attachment
+	File               File             `bun:",embed:file_,notnull,nullzero"`                               // metadata for the whole file
+	Thumbnail          Thumbnail        `bun:",embed:thumbnail_,notnull,nullzero"`                          // small image thumbnail derived from a larger image, video, or audio file.
+	Avatar             *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as an avatar?
+	Header             *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment being used as a header?
+	Cached             *bool            `bun:",nullzero,notnull,default:false"`                             // Is this attachment currently cached by our instance?
+	Sensitive          *bool            `bun:",nullzero,notnull,default:false"`                             // Has this attachment individually been marked as sensitive, regardless of the status it's attached to?
+}
+
+// IsSensitive returns whether this attachment has
+// individually been marked as sensitive.
+func (m *MediaAttachment) IsSensitive() bool {
+	return m.Sensitive != nil && *m.Sensitive
 }
 
 // File refers to the metadata for the whole file
@@ -34,6 +34,20 @@ type Notification struct {
 	Read             *bool            `bun:",nullzero,notnull,default:false"`                             // Notification has been seen/read
 }
 
+// NotificationGroup models notifications of one type about the same
+// status, collapsed into one group when selected from the database.
+// It isn't stored itself. Notifications of a type that isn't grouped
+// are selected as a group of one notification each.
+type NotificationGroup struct {
+	NotificationType         NotificationType // Type of all notifications in the group
+	StatusID                 string           // ID of the status that the notifications pertain to, if any (for reblogs, the boosted status)
+	MostRecentNotificationID string           // ID of the newest notification in the group
+	OldestNotificationID     string           // ID of the oldest notification in the group
+	NotificationsCount       int              // Number of notifications in the group
+	SampleAccountIDs         []string         // IDs of the accounts that caused the most recent notifications in the group, newest first
+	MostRecentNotification   *Notification    // Newest notification in the group
+}
+
 // NotificationType describes the reason/type of this notification.
 type NotificationType string
 
@@ -46,4 +60,5 @@ const (
 	NotificationFave          NotificationType = "favourite"      // NotificationFave -- someone faved/liked one of your statuses
 	NotificationPoll          NotificationType = "poll"           // NotificationPoll -- a poll you voted in or created has ended
 	NotificationStatus        NotificationType = "status"         // NotificationStatus -- someone you enabled notifications for has posted a status.
+	NotificationSavedSearch   NotificationType = "saved_search"   // NotificationSavedSearch -- a new status matches one of your saved searches.
 )
@@ -0,0 +1,35 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package gtsmodel
+
+import "time"
+
+// QuarantinedStatus is a status which came in over federation,
+// but which was held back from timelines and notifications by
+// the spam filter until a moderator approves or rejects it.
+type QuarantinedStatus struct {
+	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	StatusID  string    `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // ID of the held status.
+	Status    *Status   `bun:"-"`                                                           // Status corresponding to StatusID.
+	AccountID string    `bun:"type:CHAR(26),nullzero,notnull"`                              // ID of the account that authored the held status.
+	Account   *Account  `bun:"-"`                                                           // Account corresponding to AccountID.
+	Score     int       `bun:",notnull,default:0"`                                          // Spam score given to the status.
+	Reasons   []string  `bun:"reasons,array"`                                               // Human-readable reasons which contributed to the score.
+	Domain    string    `bun:",nullzero"`                                                   // Set if held because its domain awaits approval, see DomainQuarantine.
+}
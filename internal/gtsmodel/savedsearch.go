@@ -0,0 +1,35 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package gtsmodel
+
+import "time"
+
+// SavedSearch represents a named status search saved by an
+// account, which can optionally notify the account when new
+// statuses start matching it.
+type SavedSearch struct {
+	ID            string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt     time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID     string    `bun:"type:CHAR(26),nullzero,notnull"`                              // Account that created/owns the saved search.
+	Account       *Account  `bun:"-"`                                                           // Account corresponding to accountID.
+	Title         string    `bun:",nullzero,notnull"`                                           // Name given to the saved search by its owner.
+	Query         string    `bun:",nullzero,notnull"`                                           // Search query, as it would be given to the search API.
+	Notify        *bool     `bun:",nullzero,notnull,default:false"`                             // Notify the owner when new statuses match the query?
+	LastCheckedID string    `bun:"type:CHAR(26),nullzero"`                                      // ID of the newest status checked for matches; only newer statuses will trigger a notification.
+}
@@ -30,6 +30,7 @@ type Status struct {
 	UpdatedAt                time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
 	FetchedAt                time.Time          `bun:"type:timestamptz,nullzero"`                                   // when was item (remote) last fetched.
 	PinnedAt                 time.Time          `bun:"type:timestamptz,nullzero"`                                   // Status was pinned by owning account at this time.
+	PinOrder                 int                `bun:",notnull"`                                                    // Position of this pinned status among the account's pins, lowest first; 0 for pins not (yet) ordered, shown first, newest first.
 	URI                      string             `bun:",unique,nullzero,notnull"`                                    // activitypub URI of this status
 	URL                      string             `bun:",nullzero"`                                                   // web url for viewing this status
 	Content                  string             `bun:""`                                                            // content of this status; likely html-formatted but not guaranteed
@@ -54,6 +55,11 @@ type Status struct {
 	BoostOfAccountID         string             `bun:"type:CHAR(26),nullzero"`                                      // id of the account that owns the boosted status
 	BoostOf                  *Status            `bun:"-"`                                                           // status that corresponds to boostOfID
 	BoostOfAccount           *Account           `bun:"rel:belongs-to"`                                              // account that corresponds to boostOfAccountID
+	EventStartAt             time.Time          `bun:"type:timestamptz,nullzero"`                                   // start time of the event represented by this status, if it's an Event
+	EventEndAt               time.Time          `bun:"type:timestamptz,nullzero"`                                   // end time of the event represented by this status, if it's an Event and has one
+	QuoteOfID                string             `bun:"type:CHAR(26),nullzero"`                                      // id of the status this status quotes, if known
+	QuoteOfURI               string             `bun:",nullzero"`                                                   // activitypub uri of the status this status quotes
+	Location                 string             `bun:",nullzero"`                                                   // name of the place this status was posted from, if any
 	ContentWarning           string             `bun:",nullzero"`                                                   // cw string for this status
 	Visibility               Visibility         `bun:",nullzero,notnull"`                                           // visibility entry for this status
 	Sensitive                *bool              `bun:",nullzero,notnull,default:false"`                             // mark the status as sensitive?
@@ -66,6 +72,9 @@ type Status struct {
 	Boostable                *bool              `bun:",notnull"`                                                    // This status can be boosted/reblogged
 	Replyable                *bool              `bun:",notnull"`                                                    // This status can be replied to
 	Likeable                 *bool              `bun:",notnull"`                                                    // This status can be liked/faved
+	RemoteFavesCount         int                `bun:",nullzero"`                                                   // (remote) total no. faves of this status as per its likes collection, if known
+	RemoteBoostsCount        int                `bun:",nullzero"`                                                   // (remote) total no. boosts of this status as per its shares collection, if known
+	CountsFetchedAt          time.Time          `bun:"type:timestamptz,nullzero"`                                   // when were remote faves / boosts counts last fetched
 }
 
 // GetID implements timeline.Timelineable{}.
@@ -28,10 +28,20 @@ import (
 // Tombstone represents either a remote fediverse account, object, activity etc which has been deleted.
 // It's useful in cases where a remote account has been deleted, and we don't want to keep trying to process
 // subsequent activities from that account, or deletes which target it.
+//
+// A tombstone with MovedToURI set instead marks an Object/Actor (or media) which has permanently moved,
+// ie., the remote responded to a fetch of URI with an HTTP 301 / 308 redirect to MovedToURI.
 type Tombstone struct {
-	ID        string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Domain    string    `bun:",nullzero,notnull"`                                           // Domain of the Object/Actor.
-	URI       string    `bun:",nullzero,notnull,unique"`                                    // ActivityPub URI for this Object/Actor.
+	ID         string    `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt  time.Time `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Domain     string    `bun:",nullzero,notnull"`                                           // Domain of the Object/Actor.
+	URI        string    `bun:",nullzero,notnull,unique"`                                    // ActivityPub URI for this Object/Actor.
+	MovedToURI string    `bun:",nullzero"`                                                   // URI this Object/Actor permanently moved to, if not gone.
+}
+
+// Moved returns true if this tombstone marks a permanently
+// moved Object/Actor, rather than a deleted one.
+func (t *Tombstone) Moved() bool {
+	return t.MovedToURI != ""
 }
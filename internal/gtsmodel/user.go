@@ -25,39 +25,86 @@ import (
 // User represents an actual human user of gotosocial. Note, this is a LOCAL gotosocial user, not a remote account.
 // To cross reference this local user with their account (which can be local or remote), use the AccountID field.
 type User struct {
-	ID                     string       `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
-	CreatedAt              time.Time    `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt              time.Time    `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	Email                  string       `bun:",nullzero,unique"`                                            // confirmed email address for this user, this should be unique -- only one email address registered per instance, multiple users per email are not supported
-	AccountID              string       `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // The id of the local gtsmodel.Account entry for this user.
-	Account                *Account     `bun:"rel:belongs-to"`                                              // Pointer to the account of this user that corresponds to AccountID.
-	EncryptedPassword      string       `bun:",nullzero,notnull"`                                           // The encrypted password of this user, generated using https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword. A salt is included so we're safe against 🌈 tables.
-	SignUpIP               net.IP       `bun:",nullzero"`                                                   // From what IP was this user created?
-	CurrentSignInAt        time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did the user sign in with their current session.
-	CurrentSignInIP        net.IP       `bun:",nullzero"`                                                   // What's the most recent IP of this user
-	LastSignInAt           time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did this user last sign in?
-	LastSignInIP           net.IP       `bun:",nullzero"`                                                   // What's the previous IP of this user?
-	SignInCount            int          `bun:",notnull,default:0"`                                          // How many times has this user signed in?
-	InviteID               string       `bun:"type:CHAR(26),nullzero"`                                      // id of the user who invited this user (who let this joker in?)
-	ChosenLanguages        []string     `bun:",nullzero"`                                                   // What languages does this user want to see?
-	FilteredLanguages      []string     `bun:",nullzero"`                                                   // What languages does this user not want to see?
-	Locale                 string       `bun:",nullzero"`                                                   // In what timezone/locale is this user located?
-	CreatedByApplicationID string       `bun:"type:CHAR(26),nullzero"`                                      // Which application id created this user? See gtsmodel.Application
-	CreatedByApplication   *Application `bun:"rel:belongs-to"`                                              // Pointer to the application corresponding to createdbyapplicationID.
-	LastEmailedAt          time.Time    `bun:"type:timestamptz,nullzero"`                                   // When was this user last contacted by email.
-	ConfirmationToken      string       `bun:",nullzero"`                                                   // What confirmation token did we send this user/what are we expecting back?
-	ConfirmationSentAt     time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did we send email confirmation to this user?
-	ConfirmedAt            time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did the user confirm their email address
-	UnconfirmedEmail       string       `bun:",nullzero"`                                                   // Email address that hasn't yet been confirmed
-	Moderator              *bool        `bun:",nullzero,notnull,default:false"`                             // Is this user a moderator?
-	Admin                  *bool        `bun:",nullzero,notnull,default:false"`                             // Is this user an admin?
-	Disabled               *bool        `bun:",nullzero,notnull,default:false"`                             // Is this user disabled from posting?
-	Approved               *bool        `bun:",nullzero,notnull,default:false"`                             // Has this user been approved by a moderator?
-	ResetPasswordToken     string       `bun:",nullzero"`                                                   // The generated token that the user can use to reset their password
-	ResetPasswordSentAt    time.Time    `bun:"type:timestamptz,nullzero"`                                   // When did we email the user their reset-password email?
-	ExternalID             string       `bun:",nullzero,unique"`                                            // If the login for the user is managed externally (e.g OIDC), we need to keep a stable reference to the external object (e.g OIDC sub claim)
+	ID                      string             `bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt               time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt               time.Time          `bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Email                   string             `bun:",nullzero,unique"`                                            // confirmed email address for this user, this should be unique -- only one email address registered per instance, multiple users per email are not supported
+	AccountID               string             `bun:"type:CHAR(26),nullzero,notnull,unique"`                       // The id of the local gtsmodel.Account entry for this user.
+	Account                 *Account           `bun:"rel:belongs-to"`                                              // Pointer to the account of this user that corresponds to AccountID.
+	EncryptedPassword       string             `bun:",nullzero,notnull"`                                           // The encrypted password of this user, generated using https://pkg.go.dev/golang.org/x/crypto/bcrypt#GenerateFromPassword. A salt is included so we're safe against 🌈 tables.
+	SignUpIP                net.IP             `bun:",nullzero"`                                                   // From what IP was this user created?
+	CurrentSignInAt         time.Time          `bun:"type:timestamptz,nullzero"`                                   // When did the user sign in with their current session.
+	CurrentSignInIP         net.IP             `bun:",nullzero"`                                                   // What's the most recent IP of this user
+	LastSignInAt            time.Time          `bun:"type:timestamptz,nullzero"`                                   // When did this user last sign in?
+	LastSignInIP            net.IP             `bun:",nullzero"`                                                   // What's the previous IP of this user?
+	SignInCount             int                `bun:",notnull,default:0"`                                          // How many times has this user signed in?
+	InviteID                string             `bun:"type:CHAR(26),nullzero"`                                      // id of the user who invited this user (who let this joker in?)
+	ChosenLanguages         []string           `bun:",nullzero"`                                                   // What languages does this user want to see?
+	FilteredLanguages       []string           `bun:",nullzero"`                                                   // What languages does this user not want to see?
+	Locale                  string             `bun:",nullzero"`                                                   // In what timezone/locale is this user located?
+	CreatedByApplicationID  string             `bun:"type:CHAR(26),nullzero"`                                      // Which application id created this user? See gtsmodel.Application
+	CreatedByApplication    *Application       `bun:"rel:belongs-to"`                                              // Pointer to the application corresponding to createdbyapplicationID.
+	LastEmailedAt           time.Time          `bun:"type:timestamptz,nullzero"`                                   // When was this user last contacted by email.
+	ConfirmationToken       string             `bun:",nullzero"`                                                   // What confirmation token did we send this user/what are we expecting back?
+	ConfirmationSentAt      time.Time          `bun:"type:timestamptz,nullzero"`                                   // When did we send email confirmation to this user?
+	ConfirmedAt             time.Time          `bun:"type:timestamptz,nullzero"`                                   // When did the user confirm their email address
+	UnconfirmedEmail        string             `bun:",nullzero"`                                                   // Email address that hasn't yet been confirmed
+	Moderator               *bool              `bun:",nullzero,notnull,default:false"`                             // Is this user a moderator?
+	Admin                   *bool              `bun:",nullzero,notnull,default:false"`                             // Is this user an admin?
+	Disabled                *bool              `bun:",nullzero,notnull,default:false"`                             // Is this user disabled from posting?
+	Approved                *bool              `bun:",nullzero,notnull,default:false"`                             // Has this user been approved by a moderator?
+	ResetPasswordToken      string             `bun:",nullzero"`                                                   // The generated token that the user can use to reset their password
+	ResetPasswordSentAt     time.Time          `bun:"type:timestamptz,nullzero"`                                   // When did we email the user their reset-password email?
+	ExternalID              string             `bun:",nullzero,unique"`                                            // If the login for the user is managed externally (e.g OIDC), we need to keep a stable reference to the external object (e.g OIDC sub claim)
+	EmailNotifications      EmailNotifications `bun:",nullzero"`                                                   // Should follows and mentions be emailed to this user, and if so, how often?
+	DigestSentAt            time.Time          `bun:"type:timestamptz,nullzero"`                                   // When did we last send this user a digest of their notifications?
+	LimitedUntil            time.Time          `bun:"type:timestamptz,nullzero"`                                   // Until when is this user prevented from following or direct messaging anyone, because of a burst of activity?
+	ReviewFirstInteractions *bool              `bun:",nullzero,notnull,default:false"`                             // Should mentions from remote accounts this user has never interacted with be held for review?
+	MediaQuota              *int64             `bun:",nullzero"`                                                   // Max total size in bytes of media attachments this user may store, overriding the instance default; 0 means no limit. Nil means use the instance default.
+	TimeZone                string             `bun:",nullzero"`                                                   // IANA name of the time zone this user is in, eg., Europe/Amsterdam. Empty means UTC.
+	HighlightsDigest        HighlightsDigest   `bun:",nullzero"`                                                   // Should daily highlights from followed accounts be made for this user, and if so, should they be emailed?
+	HighlightsDigestAt      time.Time          `bun:"type:timestamptz,nullzero"`                                   // When did we last make highlights for this user?
 }
 
+// Location returns the time zone that the user
+// is in, or UTC if they haven't chosen one.
+func (u *User) Location() *time.Location {
+	if u.TimeZone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(u.TimeZone)
+	if err != nil {
+		// Time zone was valid when
+		// chosen, but isn't known
+		// here; fall back to UTC.
+		return time.UTC
+	}
+
+	return loc
+}
+
+// EmailNotifications describes whether, and how often,
+// follow and mention notifications are emailed to a user.
+type EmailNotifications string
+
+const (
+	EmailNotificationsNone      EmailNotifications = ""          // Don't email notifications.
+	EmailNotificationsImmediate EmailNotifications = "immediate" // Send one email per notification, as it happens.
+	EmailNotificationsDaily     EmailNotifications = "daily"     // Send one digest email per day.
+)
+
+// HighlightsDigest describes whether daily highlights of
+// statuses from followed accounts are made for a user,
+// and whether they're emailed to them.
+type HighlightsDigest string
+
+const (
+	HighlightsDigestNone  HighlightsDigest = ""      // Don't make highlights.
+	HighlightsDigestDaily HighlightsDigest = "daily" // Make highlights once a day, to be fetched via the API.
+	HighlightsDigestEmail HighlightsDigest = "email" // Make highlights once a day, and email them too.
+)
+
 // NewSignup models parameters for the creation
 // of a new user + account on this instance.
 //
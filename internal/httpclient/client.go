@@ -26,6 +26,7 @@ import (
 	"net"
 	"net/http"
 	"net/netip"
+	"net/url"
 	"runtime"
 	"strconv"
 	"strings"
@@ -40,6 +41,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/net/http2"
 )
 
 var (
@@ -54,6 +56,9 @@ var (
 
 	// ErrBodyTooLarge is returned when a received response body is above predefined limit (default 40MB).
 	ErrBodyTooLarge = errors.New("body size too large")
+
+	// ErrNoOnionProxy is returned if a request to a .onion host is attempted with no onion proxy configured.
+	ErrNoOnionProxy = errors.New("no onion proxy configured")
 )
 
 // Config provides configuration details for setting up a new
@@ -61,12 +66,29 @@ var (
 // configuration values passed to initialized http.Transport{}
 // and http.Client{}, along with httpclient.Client{} specific.
 type Config struct {
-	// MaxOpenConnsPerHost limits the max number of open connections to a host.
+	// MaxOpenConnsPerHost limits the max number of requests in flight to a host.
 	MaxOpenConnsPerHost int
 
 	// MaxIdleConns: see http.Transport{}.MaxIdleConns.
 	MaxIdleConns int
 
+	// MaxIdleConnsPerHost: see http.Transport{}.MaxIdleConnsPerHost.
+	MaxIdleConnsPerHost int
+
+	// IdleConnTimeout: see http.Transport{}.IdleConnTimeout.
+	IdleConnTimeout time.Duration
+
+	// DisableHTTP2 disables HTTP/2, using HTTP/1.1 only.
+	DisableHTTP2 bool
+
+	// Proxy is the proxy to send requests through, if
+	// set, else proxy settings from the environment are used.
+	Proxy *url.URL
+
+	// OnionProxy is the SOCKS5 proxy to send requests to
+	// .onion hosts through. Without it they are refused.
+	OnionProxy *url.URL
+
 	// ReadBufferSize: see http.Transport{}.ReadBufferSize.
 	ReadBufferSize int
 
@@ -104,12 +126,16 @@ type Config struct {
 //   - protection from server side request forgery (SSRF) by only dialing
 //     out to known public IP prefixes, configurable with allows/blocks
 //   - retry-backoff logic for error temporary HTTP error responses
+//   - limiting the number of requests in flight to each host
+//   - routing requests through proxies, and .onion hosts through Tor
 //   - optional request signing
 //   - request logging
 type Client struct {
 	client   http.Client
 	badHosts cache.TTLCache[string, struct{}]
+	limits   hostLimits
 	bodyMax  int64
+	onion    bool
 }
 
 // New returns a new instance of Client initialized using configuration.
@@ -133,20 +159,71 @@ func New(cfg Config) *Client {
 		cfg.MaxIdleConns = cfg.MaxOpenConnsPerHost * 10
 	}
 
+	if cfg.MaxIdleConnsPerHost <= 0 {
+		// By default allow reusing as many
+		// connections as may be open at once,
+		// rather than http.Transport{}'s 2.
+		cfg.MaxIdleConnsPerHost = cfg.MaxOpenConnsPerHost
+	}
+
+	if cfg.IdleConnTimeout <= 0 {
+		cfg.IdleConnTimeout = 90 * time.Second
+	}
+
 	if cfg.MaxBodySize <= 0 {
 		// By default set this to a reasonable 40MB.
 		cfg.MaxBodySize = int64(40 * bytesize.MiB)
 	}
 
+	// Proxies are typically on a local or private network,
+	// so dial them with a copy of the dialer made before
+	// protecting it with the sanitizer; they're trusted.
+	proxyDialer := *d
+	proxyAddrs := make(map[string]struct{})
+	for _, u := range []*url.URL{cfg.Proxy, cfg.OnionProxy} {
+		if u != nil {
+			proxyAddrs[proxyAddr(u)] = struct{}{}
+		}
+	}
+
 	// Protect dialer with IP range sanitizer.
 	d.Control = (&Sanitizer{
 		Allow: cfg.AllowRanges,
 		Block: cfg.BlockRanges,
 	}).Sanitize
 
+	dialContext := func(ctx context.Context, network string, addr string) (net.Conn, error) {
+		if _, ok := proxyAddrs[addr]; ok {
+			return proxyDialer.DialContext(ctx, network, addr)
+		}
+		return d.DialContext(ctx, network, addr)
+	}
+
+	// Pick the proxy to use for each request: .onion
+	// hosts through the onion proxy, if configured,
+	// everything else through the configured proxy,
+	// falling back to proxies from the environment.
+	proxy := http.ProxyFromEnvironment
+	if cfg.Proxy != nil {
+		proxy = http.ProxyURL(cfg.Proxy)
+	}
+
+	if cfg.OnionProxy != nil {
+		clearnetProxy := proxy
+		proxy = func(r *http.Request) (*url.URL, error) {
+			if isOnion(r.URL.Hostname()) {
+				return cfg.OnionProxy, nil
+			}
+			return clearnetProxy(r)
+		}
+	}
+
 	// Prepare client fields.
 	c.client.Timeout = cfg.Timeout
+	c.client.CheckRedirect = checkRedirect
+	c.limits.max = cfg.MaxOpenConnsPerHost
 	c.bodyMax = cfg.MaxBodySize
+	c.onion = (cfg.OnionProxy != nil)
 
 	// Prepare TLS config for transport.
 	tlsClientConfig := &tls.Config{
@@ -162,14 +239,14 @@ func New(cfg Config) *Client {
 		)
 	}
 
-	// Set underlying HTTP client roundtripper.
-	c.client.Transport = &http.Transport{
-		Proxy:                 http.ProxyFromEnvironment,
-		ForceAttemptHTTP2:     true,
-		DialContext:           d.DialContext,
+	// Prepare underlying HTTP client roundtripper.
+	transport := &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           dialContext,
 		TLSClientConfig:       tlsClientConfig,
 		MaxIdleConns:          cfg.MaxIdleConns,
-		IdleConnTimeout:       90 * time.Second,
+		MaxIdleConnsPerHost:   cfg.MaxIdleConnsPerHost,
+		IdleConnTimeout:       cfg.IdleConnTimeout,
 		TLSHandshakeTimeout:   10 * time.Second,
 		ExpectContinueTimeout: 1 * time.Second,
 		ReadBufferSize:        cfg.ReadBufferSize,
@@ -177,6 +254,25 @@ func New(cfg Config) *Client {
 		DisableCompression:    cfg.DisableCompression,
 	}
 
+	if cfg.DisableHTTP2 {
+		// A non-nil, empty map disables HTTP/2.
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	} else {
+		// Configure HTTP/2 ourselves, rather than through
+		// ForceAttemptHTTP2, to be able to health check
+		// connections which go quiet, so requests aren't
+		// stuck on dead connections until they time out.
+		t2, err := http2.ConfigureTransports(transport)
+		if err != nil {
+			log.Panicf(nil, "error configuring http2 transport: %v", err)
+		}
+		t2.ReadIdleTimeout = 30 * time.Second
+		t2.PingTimeout = 15 * time.Second
+	}
+
+	// Set underlying HTTP client roundtripper.
+	c.client.Transport = transport
+
 	// Initiate outgoing bad hosts lookup cache.
 	c.badHosts = cache.NewTTL[string, struct{}](0, 1000, 0)
 	c.badHosts.SetTTL(time.Hour, false)
@@ -212,6 +308,16 @@ func (c *Client) DoSigned(r *http.Request, sign SignFunc) (rsp *http.Response, e
 	// Get request hostname.
 	host := r.URL.Hostname()
 
+	if isOnion(host) && !c.onion {
+		// Don't leak the request to DNS
+		// or a proxy that can't route it.
+		return nil, ErrNoOnionProxy
+	}
+
+	// Set sign func on request, used
+	// to re-sign redirected requests.
+	r = withSignFunc(r, sign)
+
 	// Check whether request should fast fail.
 	fastFail := gtscontext.IsFastfail(r.Context())
 	if !fastFail {
@@ -241,9 +347,7 @@ func (c *Client) DoSigned(r *http.Request, sign SignFunc) (rsp *http.Response, e
 
 		// Reset signing header fields
 		now := time.Now().UTC()
-		r.Header.Set("Date", now.Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
-		r.Header.Del("Signature")
-		r.Header.Del("Digest")
+		resetSigningHeaders(r, now)
 
 		// Rewind body reader and content-length if set.
 		if rc, ok := r.Body.(*byteutil.ReadNopCloser); ok {
@@ -256,10 +360,19 @@ func (c *Client) DoSigned(r *http.Request, sign SignFunc) (rsp *http.Response, e
 			return nil, err
 		}
 
+		// Wait for a free slot for this host,
+		// released on error, or once response
+		// body is closed by the caller.
+		var release func()
+		release, err = c.limits.acquire(r.Context(), host)
+		if err != nil {
+			return nil, err
+		}
+
 		l.Info("performing request")
 
 		// Perform the request.
-		rsp, err = c.do(r)
+		rsp, err = c.do(r, release)
 		if err == nil { //nolint:gocritic
 
 			// TooManyRequest means we need to slow
@@ -344,11 +457,13 @@ func (c *Client) DoSigned(r *http.Request, sign SignFunc) (rsp *http.Response, e
 	return
 }
 
-// do wraps http.Client{}.Do() to provide safely limited response bodies.
-func (c *Client) do(req *http.Request) (*http.Response, error) {
+// do wraps http.Client{}.Do() to provide safely limited response bodies,
+// calling release on error, or after the response body is closed.
+func (c *Client) do(req *http.Request, release func()) (*http.Response, error) {
 	// Perform the HTTP request.
 	rsp, err := c.client.Do(req)
 	if err != nil {
+		release()
 		return nil, err
 	}
 
@@ -371,6 +486,9 @@ func (c *Client) do(req *http.Request) (*http.Response, error) {
 		_, _ = discard.ReadFrom(rbody)
 	})
 
+	// Wrap closer to free up slot for host AFTER close.
+	cbody = iotools.CloserCallback(cbody, release)
+
 	// Wrap body with limit.
 	rsp.Body = &struct {
 		io.Reader
@@ -392,3 +510,26 @@ var discard = io.Discard.(interface { //nolint
 	io.StringWriter
 	io.ReaderFrom
 })
+
+// isOnion returns whether the given
+// host is a Tor hidden service.
+func isOnion(host string) bool {
+	return strings.HasSuffix(strings.TrimSuffix(host, "."), ".onion")
+}
+
+// proxyAddr returns the address to dial the given
+// proxy URL at, in the form http.Transport{} uses.
+func proxyAddr(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "https":
+			port = "443"
+		case "socks5":
+			port = "1080"
+		default:
+			port = "80"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
@@ -19,12 +19,15 @@ package httpclient_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/netip"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 )
@@ -143,3 +146,158 @@ func TestHTTPClientPrivateIP(t *testing.T) {
 		}
 	}
 }
+
+func TestHTTPClientSignedRedirect(t *testing.T) {
+	client := httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+	})
+
+	// Set test handler permanently redirecting
+	// old -> new, and checking request signatures.
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Signature") != "signed:"+r.URL.Path {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if r.URL.Path == "/old" {
+			http.Redirect(rw, r, "/new", http.StatusMovedPermanently)
+			return
+		}
+
+		_, _ = rw.Write([]byte("moved"))
+	})
+
+	// Start the test server
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	// Create the test HTTP request
+	req, _ := http.NewRequest("GET", srv.URL+"/old", nil)
+
+	// Perform the signed test request
+	rsp, err := client.DoSigned(req, func(r *http.Request) error {
+		r.Header.Set("Signature", "signed:"+r.URL.Path)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("error performing client request: %v", err)
+	}
+	defer rsp.Body.Close()
+
+	// Redirected request should have been re-signed.
+	if rsp.StatusCode != http.StatusOK {
+		t.Fatalf("unexpected response status: %s", rsp.Status)
+	}
+
+	if rsp.Request.URL.Path != "/new" {
+		t.Errorf("unexpected final request path: %s", rsp.Request.URL.Path)
+	}
+}
+
+func TestHTTPClientMaxOpenConnsPerHost(t *testing.T) {
+	client := httpclient.New(httpclient.Config{
+		MaxOpenConnsPerHost: 1,
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by server)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+	})
+
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte("hello world!"))
+	})
+
+	// Start the test server
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	// First request takes the only slot
+	// for this host until body is closed.
+	req, _ := http.NewRequest("GET", srv.URL, nil)
+	rsp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("error performing client request: %v", err)
+	}
+
+	// Second request has to wait for it.
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, "GET", srv.URL, nil)
+	if _, err := client.Do(req); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected request to time out waiting for slot, got: %v", err)
+	}
+
+	// Once the first is done, requests go ahead.
+	_ = rsp.Body.Close()
+	req, _ = http.NewRequest("GET", srv.URL, nil)
+	rsp, err = client.Do(req)
+	if err != nil {
+		t.Fatalf("error performing client request: %v", err)
+	}
+	_ = rsp.Body.Close()
+}
+
+func TestHTTPClientProxy(t *testing.T) {
+	// Set test handler acting as a (plain http) proxy.
+	handler := http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		_, _ = rw.Write([]byte("proxied to " + r.URL.Host))
+	})
+
+	// Start the test proxy server
+	srv := httptest.NewServer(handler)
+	defer srv.Close()
+
+	proxy, _ := url.Parse(srv.URL)
+
+	for _, test := range []struct {
+		cfg    httpclient.Config
+		url    string
+		expect string
+		err    error
+	}{
+		{
+			// Without onion proxy, onions are refused.
+			cfg: httpclient.Config{},
+			url: "http://gts7dpcbbzmbuxszn5ygxbwjt3hhuhsdnctsw2ev5c6sktbuemu6ytqd.onion/",
+			err: httpclient.ErrNoOnionProxy,
+		},
+		{
+			// Onions go through onion proxy; note that
+			// the proxy is on loopback, which would
+			// otherwise be refused by the sanitizer.
+			cfg:    httpclient.Config{OnionProxy: proxy},
+			url:    "http://gts7dpcbbzmbuxszn5ygxbwjt3hhuhsdnctsw2ev5c6sktbuemu6ytqd.onion/",
+			expect: "proxied to gts7dpcbbzmbuxszn5ygxbwjt3hhuhsdnctsw2ev5c6sktbuemu6ytqd.onion",
+		},
+		{
+			// Everything goes through proxy.
+			cfg:    httpclient.Config{Proxy: proxy},
+			url:    "http://example.org/",
+			expect: "proxied to example.org",
+		},
+	} {
+		client := httpclient.New(test.cfg)
+
+		req, _ := http.NewRequest("GET", test.url, nil)
+		rsp, err := client.Do(req)
+		if !errors.Is(err, test.err) {
+			t.Fatalf("unexpected error performing client request: %v", err)
+		} else if err != nil {
+			continue // expected error
+		}
+
+		body, err := io.ReadAll(rsp.Body)
+		_ = rsp.Body.Close()
+		if err != nil {
+			t.Fatalf("error reading response body: %v", err)
+		}
+
+		if string(body) != test.expect {
+			t.Errorf("response body did not match expected: expect=%q actual=%q", test.expect, string(body))
+		}
+	}
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package httpclient
+
+import (
+	"context"
+	"sync"
+)
+
+// hostLimits limits the number of requests in flight to each host,
+// so that requests to one busy host can't take up every outgoing
+// connection, while requests to other hosts go ahead unhindered.
+type hostLimits struct {
+	max   int
+	hosts map[string]*hostLimit
+	mutex sync.Mutex
+}
+
+// hostLimit is the request
+// semaphore for one host.
+type hostLimit struct {
+	slots chan struct{}
+	refs  int // no. requests holding or waiting for a slot.
+}
+
+// acquire waits for a free request slot for the given host, returning
+// a function to release it again, which is safe to call more than once.
+// An error is returned if the context is canceled while waiting.
+func (l *hostLimits) acquire(ctx context.Context, host string) (func(), error) {
+	l.mutex.Lock()
+	if l.hosts == nil {
+		l.hosts = make(map[string]*hostLimit)
+	}
+	limit, ok := l.hosts[host]
+	if !ok {
+		limit = &hostLimit{slots: make(chan struct{}, l.max)}
+		l.hosts[host] = limit
+	}
+	limit.refs++
+	l.mutex.Unlock()
+
+	select {
+	case limit.slots <- struct{}{}:
+	case <-ctx.Done():
+		l.unref(host, limit)
+		return nil, ctx.Err()
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-limit.slots
+			l.unref(host, limit)
+		})
+	}, nil
+}
+
+// unref drops a reference to the given host's limit,
+// dropping the limit itself once it's unused, so
+// as not to keep one around for every host ever.
+func (l *hostLimits) unref(host string, limit *hostLimit) {
+	l.mutex.Lock()
+	limit.refs--
+	if limit.refs == 0 {
+		delete(l.hosts, host)
+	}
+	l.mutex.Unlock()
+}
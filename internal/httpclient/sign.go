@@ -17,11 +17,67 @@
 
 package httpclient
 
-import "net/http"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
 
 // SignFunc is a function signature that provides request signing.
 type SignFunc func(r *http.Request) error
 
+// signFuncKey is the context key
+// under which request SignFunc is stored.
+type signFuncKey struct{}
+
+// withSignFunc returns request with sign func set on its context,
+// so that any redirected requests can be signed in the same way.
+func withSignFunc(r *http.Request, sign SignFunc) *http.Request {
+	ctx := context.WithValue(r.Context(), signFuncKey{}, sign)
+	return r.WithContext(ctx)
+}
+
+// resetSigningHeaders resets the header fields used in
+// request signing, ready for the request to be (re)signed.
+func resetSigningHeaders(r *http.Request, now time.Time) {
+	r.Header.Set("Date", now.Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
+	r.Header.Del("Signature")
+	r.Header.Del("Digest")
+}
+
+// checkRedirect is used as the http.Client{}.CheckRedirect function.
+// As redirected requests keep the headers of the original, any request
+// signature would no longer be valid for the new request target, so
+// redirected requests are instead re-signed using the original SignFunc.
+func checkRedirect(r *http.Request, via []*http.Request) error {
+	if len(via) >= 10 {
+		// Same as http.Client{} default,
+		// this error string is checked for.
+		return errors.New("stopped after 10 redirects")
+	}
+
+	// Validate redirected request
+	// as with the original request.
+	if err := ValidateRequest(r); err != nil {
+		return err
+	}
+
+	sign, _ := r.Context().Value(signFuncKey{}).(SignFunc)
+	if sign == nil {
+		return nil
+	}
+
+	if r.Header.Get("Host") != "" {
+		// Explicitly set host header was
+		// copied from original, update it.
+		r.Header.Set("Host", r.URL.Host)
+	}
+
+	resetSigningHeaders(r, time.Now().UTC())
+	return sign(r)
+}
+
 type SigningClient interface {
 	Do(r *http.Request) (*http.Response, error)
 	DoSigned(r *http.Request, sign SignFunc) (*http.Response, error)
@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package i18n translates the strings in
+// server-rendered web pages and emails.
+//
+// Templates are written in English, and the English strings
+// in them double as keys into language bundles, which map them
+// to their translation. Strings that aren't in any suitable
+// bundle are left in English.
+package i18n
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/text/language"
+)
+
+// bundle maps English source strings
+// to their translation in one language.
+type bundle map[string]string
+
+// bundles holds the loaded language
+// bundles, keyed by language tag.
+var bundles atomic.Pointer[map[string]bundle]
+
+// Load loads all language bundles in the given
+// directory, replacing any bundles loaded before.
+//
+// A language bundle is a JSON file named after the BCP 47
+// tag of the language it translates into, eg., de.json or
+// pt-BR.json, containing an object which maps English source
+// strings, as they appear in the templates, to their translation.
+//
+// Bundles that can't be read or parsed are skipped with a
+// warning; a missing directory just means no translations.
+func Load(dir string) {
+	loaded := make(map[string]bundle)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warnf(nil, "error reading language bundles dir %s: %v", dir, err)
+		}
+		bundles.Store(&loaded)
+		return
+	}
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if entry.IsDir() || filepath.Ext(fileName) != ".json" {
+			continue
+		}
+
+		tag, err := language.Parse(strings.TrimSuffix(fileName, ".json"))
+		if err != nil {
+			log.Warnf(nil, "language bundle %s is not named after a valid language tag: %v", fileName, err)
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(dir, fileName))
+		if err != nil {
+			log.Warnf(nil, "error reading language bundle %s: %v", fileName, err)
+			continue
+		}
+
+		var translations bundle
+		if err := json.Unmarshal(b, &translations); err != nil {
+			log.Warnf(nil, "error parsing language bundle %s: %v", fileName, err)
+			continue
+		}
+
+		loaded[tag.String()] = translations
+	}
+
+	bundles.Store(&loaded)
+}
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package i18n_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// shippedBundles is the dir of the
+// language bundles shipped with GtS.
+const shippedBundles = "../../web/assets/i18n"
+
+// formatVerb matches fmt verbs, with or
+// without an explicit argument index.
+var formatVerb = regexp.MustCompile(`%(\[\d+\])?[a-z]`)
+
+type BundleTestSuite struct {
+	suite.Suite
+}
+
+func (suite *BundleTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	i18n.Load(shippedBundles)
+}
+
+func (suite *BundleTestSuite) TestLoadShipped() {
+	l := i18n.New("de-DE")
+	suite.Equal("de", l.Lang())
+	suite.Equal("Beiträge", l.T("Posts"))
+	suite.Equal("Hallo zork!", l.T("Hi %s!", "zork"))
+	suite.Equal("5 Antworten", l.N("%d reply", "%d replies", 5, 5))
+}
+
+func (suite *BundleTestSuite) TestShippedBundlesValid() {
+	entries, err := os.ReadDir(shippedBundles)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotEmpty(entries)
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+		lang := strings.TrimSuffix(fileName, ".json")
+
+		// Every shipped bundle should load.
+		suite.Equal(lang, i18n.New(lang).Lang(), fileName)
+
+		b, err := os.ReadFile(filepath.Join(shippedBundles, fileName))
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		var translations map[string]string
+		if err := json.Unmarshal(b, &translations); err != nil {
+			suite.FailNow(err.Error(), fileName)
+		}
+
+		// Translations must take the same
+		// args as the strings they translate.
+		for source, translation := range translations {
+			suite.NotEmpty(translation, "%s: %s", fileName, source)
+			suite.Len(
+				formatVerb.FindAllString(translation, -1),
+				len(formatVerb.FindAllString(source, -1)),
+				"%s: %s", fileName, source,
+			)
+		}
+	}
+}
+
+func TestBundleTestSuite(t *testing.T) {
+	suite.Run(t, new(BundleTestSuite))
+}
@@ -0,0 +1,199 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package i18n
+
+import (
+	"fmt"
+	"html/template"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"golang.org/x/text/language"
+)
+
+// sourceLanguage is the language of the
+// source strings in the templates.
+const sourceLanguage = "en"
+
+// Localizer translates strings into the most
+// preferred language of a reader that can be
+// served, falling back to other languages
+// string by string where translations are missing.
+//
+// A nil Localizer is valid, and returns
+// the source strings untranslated.
+type Localizer struct {
+	lang  string
+	chain []bundle
+}
+
+// New returns a Localizer for the given BCP 47
+// language tags, most preferred first, eg., the
+// locale chosen by a user, or the languages from
+// an Accept-Language header.
+//
+// Translations are looked up along a fallback chain:
+// each of the given languages in turn, directly followed
+// by its base language (eg., pt after pt-BR), then the
+// configured instance languages. The chain ends at the
+// first English language, or when it runs out, after
+// which the English source string is used as-is.
+func New(langs ...string) *Localizer {
+	l := &Localizer{lang: sourceLanguage}
+
+	loaded := bundles.Load()
+	if loaded == nil || len(*loaded) == 0 {
+		// Nothing to translate with.
+		return l
+	}
+
+	var (
+		seen  = make(map[string]bool)
+		tried = make([]string, 0, len(langs))
+	)
+
+	// add adds the bundle for tag to the
+	// chain, if there is one, and it's
+	// not on the chain already.
+	add := func(tag string) {
+		if seen[tag] {
+			return
+		}
+		seen[tag] = true
+
+		b, ok := (*loaded)[tag]
+		if !ok {
+			return
+		}
+
+		if len(l.chain) == 0 {
+			l.lang = tag
+		}
+		l.chain = append(l.chain, b)
+	}
+
+	tried = append(tried, langs...)
+	tried = append(tried, config.GetInstanceLanguages()...)
+
+	for _, lang := range tried {
+		tag, err := language.Parse(lang)
+		if err != nil {
+			// Ignore junk.
+			continue
+		}
+
+		add(tag.String())
+
+		base, _ := tag.Base()
+		add(base.String())
+
+		if base.String() == sourceLanguage {
+			// Reader is happy with English,
+			// so there's no point in falling
+			// back to other languages after this.
+			break
+		}
+	}
+
+	return l
+}
+
+// Lang returns the language tag of the most preferred
+// language that has a translation, for use in eg., the
+// lang attribute of a web page. If no translation is
+// available at all, it returns the source language.
+func (l *Localizer) Lang() string {
+	if l == nil {
+		return sourceLanguage
+	}
+	return l.lang
+}
+
+// T translates the given source string. If any args are
+// given, the translation is used as a format string for
+// them, as by fmt.Sprintf. Translations can use explicit
+// argument indexes like %[2]s to change argument order.
+func (l *Localizer) T(s string, args ...any) string {
+	if l != nil {
+		for _, b := range l.chain {
+			if t := b[s]; t != "" {
+				s = t
+				break
+			}
+		}
+	}
+
+	if len(args) == 0 {
+		return s
+	}
+
+	return fmt.Sprintf(s, args...)
+}
+
+// N translates singular if n is 1, or plural otherwise,
+// formatting any given args into it, as by T. Usually,
+// n will also be the first of args.
+func (l *Localizer) N(singular string, plural string, n int, args ...any) string {
+	if n == 1 {
+		return l.T(singular, args...)
+	}
+	return l.T(plural, args...)
+}
+
+// HTML translates the given source string, which may
+// contain HTML markup, as by T. String args are escaped
+// before formatting them into the translation, unless
+// they are already of type template.HTML.
+func (l *Localizer) HTML(s string, args ...any) template.HTML {
+	escaped := make([]any, len(args))
+	for i, arg := range args {
+		switch arg := arg.(type) {
+		case template.HTML:
+			escaped[i] = string(arg)
+		case string:
+			escaped[i] = template.HTMLEscapeString(arg)
+		default:
+			escaped[i] = arg
+		}
+	}
+
+	/* #nosec G203 */
+	// (translations are trusted, args are escaped above)
+	return template.HTML(l.T(s, escaped...))
+}
+
+// ParseAcceptLanguage returns the language tags in
+// the given Accept-Language header value, most
+// preferred first. It returns nil if the header
+// is empty or can't be parsed.
+func ParseAcceptLanguage(header string) []string {
+	if header == "" {
+		return nil
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+
+	langs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		langs = append(langs, tag.String())
+	}
+
+	return langs
+}
@@ -0,0 +1,156 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package i18n_test
+
+import (
+	"html/template"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type LocalizerTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LocalizerTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	dir := suite.T().TempDir()
+	for fileName, content := range map[string]string{
+		"de.json":    `{"Hello %s!": "Hallo %s!", "Posts": "Beiträge", "%d reply": "%d Antwort", "%d replies": "%d Antworten"}`,
+		"de-AT.json": `{"Posts": "Postings"}`,
+		"nl.json":    `{"Hello %s!": "Hoi %s!", "Posts": "Berichten", "Followers": "Volgers"}`,
+		"en-GB.json": `{"Favourites": "Favourites, innit"}`,
+		"junk.json":  `{"Posts": "Junk"}`,
+		"fr.json":    `not json`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, fileName), []byte(content), 0o600); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	i18n.Load(dir)
+}
+
+func (suite *LocalizerTestSuite) TestTranslate() {
+	l := i18n.New("de")
+	suite.Equal("de", l.Lang())
+	suite.Equal("Hallo zork!", l.T("Hello %s!", "zork"))
+	suite.Equal("Beiträge", l.T("Posts"))
+}
+
+func (suite *LocalizerTestSuite) TestFallbackToSource() {
+	l := i18n.New("de")
+	suite.Equal("Followers", l.T("Followers"))
+	suite.Equal("100% untranslated", l.T("100% untranslated"))
+}
+
+func (suite *LocalizerTestSuite) TestFallbackToBaseLanguage() {
+	l := i18n.New("de-AT")
+	suite.Equal("de-AT", l.Lang())
+	suite.Equal("Postings", l.T("Posts"))
+	suite.Equal("Hallo zork!", l.T("Hello %s!", "zork"))
+
+	l = i18n.New("de-CH")
+	suite.Equal("de", l.Lang())
+	suite.Equal("Beiträge", l.T("Posts"))
+}
+
+func (suite *LocalizerTestSuite) TestFallbackChain() {
+	l := i18n.New("sv", "de", "nl")
+	suite.Equal("de", l.Lang())
+	suite.Equal("Beiträge", l.T("Posts"))
+	suite.Equal("Volgers", l.T("Followers"))
+}
+
+func (suite *LocalizerTestSuite) TestFallbackStopsAtEnglish() {
+	l := i18n.New("en-GB", "nl")
+	suite.Equal("en-GB", l.Lang())
+	suite.Equal("Favourites, innit", l.T("Favourites"))
+	suite.Equal("Posts", l.T("Posts"))
+
+	l = i18n.New("en", "nl")
+	suite.Equal("en", l.Lang())
+	suite.Equal("Posts", l.T("Posts"))
+}
+
+func (suite *LocalizerTestSuite) TestFallbackToInstanceLanguages() {
+	config.SetInstanceLanguages([]string{"nl", "en"})
+
+	l := i18n.New("sv")
+	suite.Equal("nl", l.Lang())
+	suite.Equal("Berichten", l.T("Posts"))
+
+	l = i18n.New()
+	suite.Equal("nl", l.Lang())
+	suite.Equal("Berichten", l.T("Posts"))
+
+	l = i18n.New("de")
+	suite.Equal("Beiträge", l.T("Posts"))
+	suite.Equal("Volgers", l.T("Followers"))
+}
+
+func (suite *LocalizerTestSuite) TestJunkIgnored() {
+	l := i18n.New("not a language", "fr")
+	suite.Equal("en", l.Lang())
+	suite.Equal("Posts", l.T("Posts"))
+}
+
+func (suite *LocalizerTestSuite) TestPlural() {
+	l := i18n.New("de")
+	suite.Equal("1 Antwort", l.N("%d reply", "%d replies", 1, 1))
+	suite.Equal("5 Antworten", l.N("%d reply", "%d replies", 5, 5))
+}
+
+func (suite *LocalizerTestSuite) TestHTML() {
+	l := i18n.New("de")
+	suite.Equal(
+		template.HTML(`Hallo <b>zork</b>!`),
+		l.HTML("Hello %s!", template.HTML("<b>zork</b>")),
+	)
+	suite.Equal(
+		template.HTML(`Hallo &lt;b&gt;zork&lt;/b&gt;!`),
+		l.HTML("Hello %s!", "<b>zork</b>"),
+	)
+}
+
+func (suite *LocalizerTestSuite) TestNil() {
+	var l *i18n.Localizer
+	suite.Equal("en", l.Lang())
+	suite.Equal("Hello zork!", l.T("Hello %s!", "zork"))
+}
+
+func (suite *LocalizerTestSuite) TestParseAcceptLanguage() {
+	suite.Equal(
+		[]string{"nl", "de-AT", "en"},
+		i18n.ParseAcceptLanguage("en;q=0.5, de-AT;q=0.8, nl"),
+	)
+	suite.Nil(i18n.ParseAcceptLanguage(""))
+	suite.Nil(i18n.ParseAcceptLanguage("; q=what"))
+}
+
+func TestLocalizerTestSuite(t *testing.T) {
+	suite.Run(t, new(LocalizerTestSuite))
+}
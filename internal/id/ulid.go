@@ -19,7 +19,9 @@ package id
 
 import (
 	"crypto/rand"
+	"io"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/oklog/ulid"
@@ -45,6 +47,41 @@ func NewULID() string {
 	return ulid.String()
 }
 
+// monotonic holds the state of NewMonotonicULID.
+var monotonic struct {
+	entropy io.Reader
+	ms      uint64
+	mu      sync.Mutex
+}
+
+// NewMonotonicULID returns a new ULID string using the current time, which
+// is guaranteed to sort after any ULID previously returned by this function
+// in this process, even within the same millisecond. Use this where ULIDs
+// must be strictly increasing, e.g. for ordering events; ordinary ULIDs in
+// the same millisecond sort randomly.
+func NewMonotonicULID() string {
+	monotonic.mu.Lock()
+	defer monotonic.mu.Unlock()
+
+	if monotonic.entropy == nil {
+		monotonic.entropy = ulid.Monotonic(rand.Reader, 0)
+	}
+
+	// Never go backwards, even
+	// if the system clock does.
+	ms := ulid.Timestamp(time.Now())
+	if ms < monotonic.ms {
+		ms = monotonic.ms
+	}
+	monotonic.ms = ms
+
+	ulid, err := ulid.New(ms, monotonic.entropy)
+	if err != nil {
+		panic(err)
+	}
+	return ulid.String()
+}
+
 // NewULIDFromTime returns a new ULID string using the given time, or an error if something goes wrong.
 func NewULIDFromTime(t time.Time) (string, error) {
 	newUlid, err := ulid.New(ulid.Timestamp(t), rand.Reader)
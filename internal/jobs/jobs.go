@@ -0,0 +1,457 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"codeberg.org/gruf/go-runners"
+	"codeberg.org/gruf/go-sched"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// Names of the background jobs run by GoToSocial.
+const (
+	AccountStatsRecount = "account-stats-recount"
+	Backfill            = "backfill"
+	CacheSweep          = "cache-sweep"
+	EmailDigests        = "email-digests"
+	EmojiClean          = "emoji-clean"
+	Highlights          = "highlights"
+	InstanceStatistics  = "instance-statistics"
+	MediaClean          = "media-clean"
+	SavedSearches       = "saved-searches"
+	StaleRefresh        = "stale-refresh"
+	StatusCounts        = "status-counts"
+	TimelinePrune       = "timeline-prune"
+)
+
+// retryDelay is how long to wait before the first retry of a
+// failed job run, doubling for each further retry of that run.
+const retryDelay = time.Minute
+
+var (
+	// ErrUnknownJob is returned when
+	// no job with given name is registered.
+	ErrUnknownJob = errors.New("unknown job")
+
+	// ErrJobRunning is returned when trying
+	// to trigger a job which is already running.
+	ErrJobRunning = errors.New("job already running")
+
+	// ErrNotStarted is returned when trying
+	// to trigger a job before Start() is called.
+	ErrNotStarted = errors.New("jobs not started")
+)
+
+// Func is the function run by a job, passed the time the run
+// was scheduled for. Returning an error marks the run as failed,
+// and it'll be retried up to the configured number of times.
+type Func func(ctx context.Context, now time.Time) error
+
+// Jobs is the registry of scheduled background jobs. Jobs are registered
+// with a default schedule during initialization, which may be overridden
+// in config, then scheduled together on Start(). Each job's state, ie.,
+// whether it's paused and how its last run went, is stored in the database.
+type Jobs struct {
+	jobs  map[string]*job
+	db    db.Job
+	ctx   context.Context
+	mutex sync.Mutex
+}
+
+// job is a single registered job.
+type job struct {
+	name     string
+	schedule Schedule
+	fn       Func
+
+	// set on Start().
+	sched *sched.Job
+
+	paused  atomic.Bool
+	running atomic.Bool
+}
+
+// Info describes a registered job, and its stored state.
+type Info struct {
+	// Name of the job.
+	Name string
+
+	// Schedule the job runs on.
+	Schedule string
+
+	// Next time the job is scheduled to run,
+	// zero if jobs haven't been started.
+	Next time.Time
+
+	// Running is whether the job is running now.
+	Running bool
+
+	// Job is the stored state of the job.
+	Job *gtsmodel.Job
+}
+
+// Register registers a job with the given name, to be run on the given default
+// schedule (see ParseSchedule), which may be overridden by config. Registering
+// a job with the same name as an existing job replaces it. Register panics
+// if the schedule is invalid, and must be called before Start().
+func (j *Jobs) Register(name string, schedule string, fn Func) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.jobs == nil {
+		j.jobs = make(map[string]*job)
+	}
+
+	j.jobs[name] = &job{
+		name:     name,
+		schedule: MustParseSchedule(schedule),
+		fn:       fn,
+	}
+}
+
+// Start applies any schedules set in config, loads (or creates) the stored
+// state of each registered job, and schedules them with the given scheduler,
+// which must be running. Jobs are run with a context that's cancelled when
+// the scheduler is stopped.
+func (j *Jobs) Start(database db.Job, scheduler *sched.Scheduler) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.ctx != nil {
+		return errors.New("jobs already started")
+	}
+
+	// Parse schedules set in config, in the form "name=schedule".
+	for _, setting := range config.GetJobsSchedules() {
+		name, spec, ok := strings.Cut(setting, "=")
+		if !ok {
+			return fmt.Errorf("invalid %s entry %q, expected name=schedule", config.JobsSchedulesFlag(), setting)
+		}
+
+		job, ok := j.jobs[strings.TrimSpace(name)]
+		if !ok {
+			return fmt.Errorf("invalid %s entry %q: %w", config.JobsSchedulesFlag(), setting, ErrUnknownJob)
+		}
+
+		schedule, err := ParseSchedule(spec)
+		if err != nil {
+			return fmt.Errorf("invalid %s entry %q: %w", config.JobsSchedulesFlag(), setting, err)
+		}
+
+		job.schedule = schedule
+	}
+
+	ctx := runners.CancelCtx(scheduler.Done())
+
+	for _, job := range j.jobs {
+		state, err := getOrCreate(ctx, database, job.name)
+		if err != nil {
+			return err
+		}
+
+		if state.LastRunStatus == gtsmodel.JobStatusRunning {
+			// We must've been stopped mid-run
+			// last time, without recording it.
+			state.LastRunStatus = gtsmodel.JobStatusFailed
+			state.LastRunError = "interrupted by shutdown"
+			if err := database.UpdateJob(ctx, state,
+				"last_run_status",
+				"last_run_error",
+			); err != nil {
+				return gtserror.Newf("db error updating job %s: %w", job.name, err)
+			}
+		}
+
+		job.paused.Store(*state.Paused)
+	}
+
+	j.db = database
+	j.ctx = ctx
+
+	for _, job := range j.jobs {
+		job := job // rescope
+		job.sched = sched.NewJob(func(now time.Time) {
+			if job.paused.Load() {
+				log.Debugf(ctx, "skipping paused job %s", job.name)
+				return
+			}
+			j.run(job, now)
+		}).With(job.schedule)
+		scheduler.Schedule(job.sched)
+	}
+
+	return nil
+}
+
+// List returns info on all registered jobs, sorted by name.
+func (j *Jobs) List(ctx context.Context) ([]Info, error) {
+	j.mutex.Lock()
+	names := make([]string, 0, len(j.jobs))
+	for name := range j.jobs {
+		names = append(names, name)
+	}
+	j.mutex.Unlock()
+
+	slices.Sort(names)
+
+	infos := make([]Info, 0, len(names))
+	for _, name := range names {
+		info, err := j.Get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// Get returns info on the job with the given name.
+func (j *Jobs) Get(ctx context.Context, name string) (Info, error) {
+	job, database, err := j.get(name)
+	if err != nil {
+		return Info{}, err
+	}
+
+	info := Info{
+		Name:     job.name,
+		Schedule: job.schedule.String(),
+		Running:  job.running.Load(),
+	}
+
+	if database == nil {
+		// Not started, so no
+		// stored state to get.
+		info.Job = &gtsmodel.Job{
+			Name:   job.name,
+			Paused: util.Ptr(false),
+		}
+		return info, nil
+	}
+
+	info.Next = job.sched.Next()
+
+	info.Job, err = database.GetJobByName(ctx, job.name)
+	if err != nil {
+		return Info{}, gtserror.Newf("db error getting job %s: %w", job.name, err)
+	}
+
+	return info, nil
+}
+
+// Trigger runs the job with the given name in the
+// background now, regardless of its schedule, and
+// whether it's paused. The job mustn't be running.
+func (j *Jobs) Trigger(name string) error {
+	job, database, err := j.get(name)
+	if err != nil {
+		return err
+	}
+
+	if database == nil {
+		return ErrNotStarted
+	}
+
+	if !job.running.CompareAndSwap(false, true) {
+		return ErrJobRunning
+	}
+
+	go j.runClaimed(job, time.Now())
+	return nil
+}
+
+// SetPaused pauses or unpauses the job with the given name. Paused jobs
+// skip their scheduled runs, though they can still be triggered manually,
+// and any run in progress when the job is paused is left to finish.
+func (j *Jobs) SetPaused(ctx context.Context, name string, paused bool) error {
+	job, database, err := j.get(name)
+	if err != nil {
+		return err
+	}
+
+	if database == nil {
+		return ErrNotStarted
+	}
+
+	state, err := database.GetJobByName(ctx, job.name)
+	if err != nil {
+		return gtserror.Newf("db error getting job %s: %w", job.name, err)
+	}
+
+	state.Paused = &paused
+	if err := database.UpdateJob(ctx, state, "paused"); err != nil {
+		return gtserror.Newf("db error updating job %s: %w", job.name, err)
+	}
+
+	job.paused.Store(paused)
+	return nil
+}
+
+// get returns the job with the given name,
+// and the database if jobs have been started.
+func (j *Jobs) get(name string) (*job, db.Job, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	job, ok := j.jobs[name]
+	if !ok {
+		return nil, nil, ErrUnknownJob
+	}
+
+	return job, j.db, nil
+}
+
+// run runs the job, unless it's already running,
+// so that runs of a job never overlap.
+func (j *Jobs) run(job *job, now time.Time) {
+	if !job.running.CompareAndSwap(false, true) {
+		log.Warnf(j.ctx, "skipping run of job %s, previous run still in progress", job.name)
+		return
+	}
+	j.runClaimed(job, now)
+}
+
+// runClaimed runs the job, once its running flag has been set,
+// retrying it on failure up to the configured number of times,
+// recording its outcome in the database.
+func (j *Jobs) runClaimed(job *job, now time.Time) {
+	defer job.running.Store(false)
+
+	ctx := j.ctx
+	l := log.WithContext(ctx).WithField("job", job.name)
+
+	state, err := j.db.GetJobByName(ctx, job.name)
+	if err != nil {
+		l.Errorf("db error getting job: %v", err)
+		return
+	}
+
+	start := time.Now()
+	state.LastRunAt = start
+	state.LastRunStatus = gtsmodel.JobStatusRunning
+	state.LastRunAttempts = 0
+	state.LastRunError = ""
+	if err := j.db.UpdateJob(ctx, state,
+		"last_run_at",
+		"last_run_status",
+		"last_run_attempts",
+		"last_run_error",
+	); err != nil {
+		l.Errorf("db error updating job: %v", err)
+	}
+
+	l.Info("starting run")
+
+	delay := retryDelay
+	maxAttempts := 1 + max(0, config.GetJobsMaxRetries())
+
+	for {
+		state.LastRunAttempts++
+
+		if err = call(ctx, job.fn, now); err == nil {
+			break
+		}
+
+		if state.LastRunAttempts >= maxAttempts || ctx.Err() != nil {
+			break
+		}
+
+		l.Warnf("attempt %d failed, retrying in %s: %v", state.LastRunAttempts, delay, err)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+	}
+
+	state.LastRunDuration = time.Since(start).Milliseconds()
+	if err != nil {
+		state.LastRunStatus = gtsmodel.JobStatusFailed
+		state.LastRunError = err.Error()
+		l.Errorf("run failed after %d attempt(s): %v", state.LastRunAttempts, err)
+	} else {
+		state.LastRunStatus = gtsmodel.JobStatusSucceeded
+		l.Infof("finished run after %s", time.Since(start))
+	}
+
+	// Record the outcome even if we're shutting down.
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	if err := j.db.UpdateJob(ctx, state,
+		"last_run_duration",
+		"last_run_attempts",
+		"last_run_status",
+		"last_run_error",
+	); err != nil {
+		l.Errorf("db error updating job: %v", err)
+	}
+}
+
+// call calls the job function,
+// turning any panic into an error.
+func call(ctx context.Context, fn Func, now time.Time) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx, now)
+}
+
+// getOrCreate gets the stored state of the job with
+// the given name, creating it if it doesn't exist yet.
+func getOrCreate(ctx context.Context, database db.Job, name string) (*gtsmodel.Job, error) {
+	state, err := database.GetJobByName(ctx, name)
+	if err == nil {
+		return state, nil
+	}
+
+	if !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("db error getting job %s: %w", name, err)
+	}
+
+	state = &gtsmodel.Job{
+		ID:     id.NewULID(),
+		Name:   name,
+		Paused: util.Ptr(false),
+	}
+
+	if err := database.PutJob(ctx, state); err != nil {
+		return nil, gtserror.Newf("db error putting job %s: %w", name, err)
+	}
+
+	return state, nil
+}
@@ -0,0 +1,230 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package jobs_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"codeberg.org/gruf/go-sched"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+)
+
+// memJobDB is an in-memory db.Job.
+type memJobDB struct {
+	jobs  map[string]gtsmodel.Job
+	mutex sync.Mutex
+}
+
+func (m *memJobDB) GetJobs(ctx context.Context) ([]*gtsmodel.Job, error) {
+	panic("not used")
+}
+
+func (m *memJobDB) GetJobByName(ctx context.Context, name string) (*gtsmodel.Job, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	job, ok := m.jobs[name]
+	if !ok {
+		return nil, db.ErrNoEntries
+	}
+	return &job, nil
+}
+
+func (m *memJobDB) PutJob(ctx context.Context, job *gtsmodel.Job) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.jobs[job.Name] = *job
+	return nil
+}
+
+func (m *memJobDB) UpdateJob(ctx context.Context, job *gtsmodel.Job, columns ...string) error {
+	return m.PutJob(ctx, job)
+}
+
+type JobsTestSuite struct {
+	suite.Suite
+
+	db        *memJobDB
+	scheduler *sched.Scheduler
+	jobs      *jobs.Jobs
+}
+
+func (suite *JobsTestSuite) SetupTest() {
+	config.SetJobsSchedules(nil)
+	config.SetJobsMaxRetries(0)
+
+	suite.db = &memJobDB{jobs: make(map[string]gtsmodel.Job)}
+	suite.scheduler = new(sched.Scheduler)
+	suite.scheduler.Start(nil)
+	suite.jobs = new(jobs.Jobs)
+}
+
+func (suite *JobsTestSuite) TearDownTest() {
+	suite.scheduler.Stop()
+}
+
+// waitRun triggers the job, and waits for the run to finish.
+func (suite *JobsTestSuite) waitRun(name string) jobs.Info {
+	suite.NoError(suite.jobs.Trigger(name))
+
+	var info jobs.Info
+	suite.Eventually(func() bool {
+		var err error
+		info, err = suite.jobs.Get(context.Background(), name)
+		suite.NoError(err)
+		return !info.Running
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return info
+}
+
+func (suite *JobsTestSuite) TestRunSucceeded() {
+	ran := make(chan time.Time, 1)
+	suite.jobs.Register(jobs.MediaClean, "@midnight", func(_ context.Context, now time.Time) error {
+		ran <- now
+		return nil
+	})
+	suite.NoError(suite.jobs.Start(suite.db, suite.scheduler))
+
+	info := suite.waitRun(jobs.MediaClean)
+	suite.Len(ran, 1)
+	suite.Equal("@midnight", info.Schedule)
+	suite.False(info.Next.IsZero())
+	suite.Equal(gtsmodel.JobStatusSucceeded, info.Job.LastRunStatus)
+	suite.Equal(1, info.Job.LastRunAttempts)
+	suite.Empty(info.Job.LastRunError)
+	suite.False(info.Job.LastRunAt.IsZero())
+}
+
+func (suite *JobsTestSuite) TestRunFailed() {
+	suite.jobs.Register(jobs.MediaClean, "@midnight", func(context.Context, time.Time) error {
+		return errors.New("storage unavailable")
+	})
+	suite.jobs.Register(jobs.EmojiClean, "@midnight", func(context.Context, time.Time) error {
+		panic("oh no")
+	})
+	suite.NoError(suite.jobs.Start(suite.db, suite.scheduler))
+
+	info := suite.waitRun(jobs.MediaClean)
+	suite.Equal(gtsmodel.JobStatusFailed, info.Job.LastRunStatus)
+	suite.Equal("storage unavailable", info.Job.LastRunError)
+	suite.Equal(1, info.Job.LastRunAttempts)
+
+	info = suite.waitRun(jobs.EmojiClean)
+	suite.Equal(gtsmodel.JobStatusFailed, info.Job.LastRunStatus)
+	suite.Equal("panic: oh no", info.Job.LastRunError)
+}
+
+func (suite *JobsTestSuite) TestTriggerRunning() {
+	release := make(chan struct{})
+	suite.jobs.Register(jobs.MediaClean, "@midnight", func(context.Context, time.Time) error {
+		<-release
+		return nil
+	})
+	suite.NoError(suite.jobs.Start(suite.db, suite.scheduler))
+
+	suite.NoError(suite.jobs.Trigger(jobs.MediaClean))
+	suite.ErrorIs(suite.jobs.Trigger(jobs.MediaClean), jobs.ErrJobRunning)
+
+	info, err := suite.jobs.Get(context.Background(), jobs.MediaClean)
+	suite.NoError(err)
+	suite.True(info.Running)
+
+	close(release)
+}
+
+func (suite *JobsTestSuite) TestPause() {
+	suite.jobs.Register(jobs.MediaClean, "@midnight", func(context.Context, time.Time) error {
+		return nil
+	})
+	suite.NoError(suite.jobs.Start(suite.db, suite.scheduler))
+
+	suite.NoError(suite.jobs.SetPaused(context.Background(), jobs.MediaClean, true))
+
+	info, err := suite.jobs.Get(context.Background(), jobs.MediaClean)
+	suite.NoError(err)
+	suite.True(*info.Job.Paused)
+
+	// Paused state is stored, so
+	// it's kept across restarts.
+	restarted := new(jobs.Jobs)
+	restarted.Register(jobs.MediaClean, "@midnight", func(context.Context, time.Time) error {
+		return nil
+	})
+	suite.NoError(restarted.Start(suite.db, suite.scheduler))
+
+	info, err = restarted.Get(context.Background(), jobs.MediaClean)
+	suite.NoError(err)
+	suite.True(*info.Job.Paused)
+}
+
+func (suite *JobsTestSuite) TestUnknownJob() {
+	suite.NoError(suite.jobs.Start(suite.db, suite.scheduler))
+
+	_, err := suite.jobs.Get(context.Background(), "nope")
+	suite.ErrorIs(err, jobs.ErrUnknownJob)
+	suite.ErrorIs(suite.jobs.Trigger("nope"), jobs.ErrUnknownJob)
+	suite.ErrorIs(suite.jobs.SetPaused(context.Background(), "nope", true), jobs.ErrUnknownJob)
+}
+
+func (suite *JobsTestSuite) TestConfigSchedules() {
+	noop := func(context.Context, time.Time) error { return nil }
+	suite.jobs.Register(jobs.MediaClean, "@midnight", noop)
+	suite.jobs.Register(jobs.TimelinePrune, "@hourly", noop)
+
+	config.SetJobsSchedules([]string{"media-clean=0 3 * * *"})
+	suite.NoError(suite.jobs.Start(suite.db, suite.scheduler))
+
+	infos, err := suite.jobs.List(context.Background())
+	suite.NoError(err)
+	suite.Len(infos, 2)
+	suite.Equal(jobs.MediaClean, infos[0].Name)
+	suite.Equal("0 3 * * *", infos[0].Schedule)
+	suite.Equal(3, infos[0].Next.Hour())
+	suite.Equal(jobs.TimelinePrune, infos[1].Name)
+	suite.Equal("@hourly", infos[1].Schedule)
+}
+
+func (suite *JobsTestSuite) TestConfigSchedulesInvalid() {
+	for _, setting := range []string{
+		"media-clean",
+		"media-clean=sometimes",
+		"not-a-job=@daily",
+	} {
+		j := new(jobs.Jobs)
+		j.Register(jobs.MediaClean, "@midnight", func(context.Context, time.Time) error {
+			return nil
+		})
+
+		config.SetJobsSchedules([]string{setting})
+		suite.Error(j.Start(suite.db, suite.scheduler), setting)
+	}
+}
+
+func TestJobsTestSuite(t *testing.T) {
+	suite.Run(t, new(JobsTestSuite))
+}
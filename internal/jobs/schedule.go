@@ -0,0 +1,302 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a recurring schedule on which to run
+// a job, parsed from a cron-like specification.
+//
+// Schedule implements sched.Timing.
+type Schedule interface {
+	// Next returns the next time after
+	// now at which the job should run.
+	Next(now time.Time) time.Time
+
+	// String returns the specification
+	// this schedule was parsed from.
+	String() string
+}
+
+// shorthands are the supported "@..." shorthand
+// specifications, and the cron expressions they
+// stand for.
+var shorthands = map[string]string{
+	"@hourly":   "0 * * * *",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@weekly":   "0 0 * * 0",
+	"@monthly":  "0 0 1 * *",
+}
+
+// ParseSchedule parses a schedule specification, which is either:
+//
+//   - a standard five field cron expression, "minute hour day-of-month month day-of-week",
+//     where each field is a comma separated list of "*", values or ranges of values ("1-5"),
+//     optionally followed by a step ("*/15", "0-30/10"); evaluated in UTC.
+//   - one of the shorthands "@hourly", "@daily" (or "@midnight"), "@weekly" or "@monthly".
+//   - "@every <duration>", eg., "@every 30m", to run at a fixed interval after startup.
+func ParseSchedule(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if d, ok := strings.CutPrefix(spec, "@every "); ok {
+		period, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+		}
+		if period < time.Second {
+			return nil, fmt.Errorf("invalid schedule %q: interval must be at least 1s", spec)
+		}
+		return every{spec: spec, period: period}, nil
+	}
+
+	expr := spec
+	if strings.HasPrefix(spec, "@") {
+		var ok bool
+		if expr, ok = shorthands[spec]; !ok {
+			return nil, fmt.Errorf("invalid schedule %q: unknown shorthand", spec)
+		}
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields, got %d", spec, len(fields))
+	}
+
+	c := cron{spec: spec}
+
+	for i, f := range []struct {
+		name string
+		dst  *uint64
+		min  int
+		max  int
+	}{
+		{"minute", &c.minute, 0, 59},
+		{"hour", &c.hour, 0, 23},
+		{"day of month", &c.dom, 1, 31},
+		{"month", &c.month, 1, 12},
+		{"day of week", &c.dow, 0, 7},
+	} {
+		set, err := parseField(fields[i], f.min, f.max)
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %s: %w", spec, f.name, err)
+		}
+		*f.dst = set
+	}
+
+	// Sunday is both 0 and 7.
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+		c.dow &^= 1 << 7
+	}
+
+	// As in cron, if both day fields are restricted,
+	// a day matches if *either* of them matches.
+	c.domStar = strings.HasPrefix(fields[2], "*")
+	c.dowStar = strings.HasPrefix(fields[4], "*")
+
+	// Catch things like "0 0 30 2 *", which
+	// would otherwise run once straight away.
+	if c.Next(time.Now()).IsZero() {
+		return nil, fmt.Errorf("invalid schedule %q: never matches", spec)
+	}
+
+	return c, nil
+}
+
+// MustParseSchedule is like ParseSchedule,
+// but panics if the specification is invalid.
+func MustParseSchedule(spec string) Schedule {
+	s, err := ParseSchedule(spec)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// parseField parses one field of a cron expression
+// into a bitset of the values it matches.
+func parseField(field string, min int, max int) (uint64, error) {
+	var set uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %q", stepStr)
+			}
+		}
+
+		var (
+			lo  = min
+			hi  = max
+			err error
+		)
+
+		switch {
+		case rng == "*":
+			// Full range.
+
+		case strings.Contains(rng, "-"):
+			loStr, hiStr, _ := strings.Cut(rng, "-")
+			if lo, err = parseValue(loStr, min, max); err != nil {
+				return 0, err
+			}
+			if hi, err = parseValue(hiStr, min, max); err != nil {
+				return 0, err
+			}
+			if lo > hi {
+				return 0, fmt.Errorf("invalid range %q", rng)
+			}
+
+		default:
+			if lo, err = parseValue(rng, min, max); err != nil {
+				return 0, err
+			}
+			if !hasStep {
+				// Single value.
+				hi = lo
+			}
+		}
+
+		for v := lo; v <= hi; v += step {
+			set |= 1 << v
+		}
+	}
+
+	if set == 0 {
+		return 0, errors.New("matches nothing")
+	}
+
+	return set, nil
+}
+
+// parseValue parses a single value in a
+// cron field, checking it's within range.
+func parseValue(s string, min int, max int) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, min, max)
+	}
+	return v, nil
+}
+
+// every is a Schedule running at a fixed interval.
+type every struct {
+	spec   string
+	period time.Duration
+}
+
+func (e every) Next(now time.Time) time.Time {
+	return now.Add(e.period)
+}
+
+func (e every) String() string {
+	return e.spec
+}
+
+// cron is a Schedule parsed from a cron expression, with
+// each field stored as a bitset of the values it matches.
+type cron struct {
+	spec    string
+	minute  uint64
+	hour    uint64
+	dom     uint64
+	month   uint64
+	dow     uint64
+	domStar bool
+	dowStar bool
+}
+
+func (c cron) Next(now time.Time) time.Time {
+	// Start at the beginning of the next minute.
+	t := now.UTC().Truncate(time.Minute).Add(time.Minute)
+
+	// Any matching time must occur within the next few
+	// years (a leap day on a particular weekday can take
+	// a while to come around); give up if we pass that.
+	limit := t.AddDate(30, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			// Skip to the start of the next month.
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if !c.dayMatches(t) {
+			// Skip to the start of the next day.
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			// Skip to the start of the next hour.
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			// Skip to the next matching minute this
+			// hour, or the start of the next hour.
+			rest := c.minute >> uint(t.Minute())
+			if rest == 0 {
+				t = t.Truncate(time.Hour).Add(time.Hour)
+			} else {
+				t = t.Add(time.Duration(bits.TrailingZeros64(rest)) * time.Minute)
+			}
+			continue
+		}
+
+		return t
+	}
+
+	// Never matches.
+	return time.Time{}
+}
+
+// dayMatches returns whether the day of t
+// matches the day of month / week fields.
+func (c cron) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+
+	if c.domStar || c.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+func (c cron) String() string {
+	return c.spec
+}
@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package jobs_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+)
+
+func TestScheduleNext(t *testing.T) {
+	// Friday 15 March 2024, 10:07:30 UTC.
+	now := time.Date(2024, time.March, 15, 10, 7, 30, 0, time.UTC)
+
+	for _, test := range []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2024, time.March, 15, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, time.March, 15, 10, 15, 0, 0, time.UTC)},
+		{"0,30 * * * *", time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, time.March, 15, 11, 0, 0, 0, time.UTC)},
+		{"@midnight", time.Date(2024, time.March, 16, 0, 0, 0, 0, time.UTC)},
+		{"10 0 * * *", time.Date(2024, time.March, 16, 0, 10, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2024, time.March, 15, 13, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, time.March, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 1-5", time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 12 1 * 1", time.Date(2024, time.March, 18, 12, 0, 0, 0, time.UTC)}, // day of month OR day of week
+		{"@every 90m", now.Add(90 * time.Minute)},
+	} {
+		s, err := jobs.ParseSchedule(test.spec)
+		require.NoError(t, err, test.spec)
+		assert.Equal(t, test.next, s.Next(now), test.spec)
+		assert.Equal(t, test.spec, s.String())
+	}
+}
+
+func TestScheduleNextTimeZone(t *testing.T) {
+	s, err := jobs.ParseSchedule("@midnight")
+	require.NoError(t, err)
+
+	// 23:30 on the 15th in UTC+2 is 21:30 UTC,
+	// so next midnight UTC is 02:00 on the 16th.
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Date(2024, time.March, 15, 23, 30, 0, 0, zone)
+	assert.True(t, s.Next(now).Equal(time.Date(2024, time.March, 16, 2, 0, 0, 0, zone)))
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+		"0 0 30 2 *",
+		"@yearly",
+		"@every",
+		"@every 10ms",
+		"@every soon",
+	} {
+		_, err := jobs.ParseSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
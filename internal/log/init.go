@@ -27,25 +27,83 @@ import (
 
 // ParseLevel will parse the log level from given string and set to appropriate level.
 func ParseLevel(str string) error {
+	lvl, err := LevelFromString(str)
+	if err != nil {
+		return err
+	}
+	SetLevel(lvl)
+	return nil
+}
+
+// LevelFromString parses the log level from given string,
+// without setting it. An empty string is treated as "info".
+func LevelFromString(str string) (level.LEVEL, error) {
 	switch strings.ToLower(str) {
 	case "trace":
-		SetLevel(level.TRACE)
+		return level.TRACE, nil
 	case "debug":
-		SetLevel(level.DEBUG)
+		return level.DEBUG, nil
 	case "", "info":
-		SetLevel(level.INFO)
+		return level.INFO, nil
 	case "warn":
-		SetLevel(level.WARN)
+		return level.WARN, nil
 	case "error":
-		SetLevel(level.ERROR)
+		return level.ERROR, nil
 	case "fatal":
-		SetLevel(level.FATAL)
+		return level.FATAL, nil
+	default:
+		return 0, fmt.Errorf("unknown log level: %q", str)
+	}
+}
+
+// LevelString returns the lowercase string
+// form of lvl, as accepted by LevelFromString.
+func LevelString(lvl level.LEVEL) string {
+	return strings.ToLower(lvlstrs[lvl])
+}
+
+// ParseFormat will parse the log output format from given string and set it.
+func ParseFormat(str string) error {
+	switch strings.ToLower(str) {
+	case "", "logfmt":
+		SetFormat(FormatLogfmt)
+	case "json":
+		SetFormat(FormatJSON)
 	default:
-		return fmt.Errorf("unknown log level: %q", str)
+		return fmt.Errorf("unknown log format: %q", str)
 	}
 	return nil
 }
 
+// ParseSubsystemLevels will parse subsystem log level overrides, each
+// in the form "subsystem=level", from given strings and set them. Any
+// previously set overrides are replaced; an empty slice clears them.
+func ParseSubsystemLevels(strs []string) error {
+	levels := make(map[string]level.LEVEL, len(strs))
+
+	for _, str := range strs {
+		subsystem, lvlstr, ok := strings.Cut(str, "=")
+		if !ok {
+			return fmt.Errorf("invalid subsystem log level %q: expected subsystem=level", str)
+		}
+
+		subsystem = strings.Trim(strings.TrimSpace(subsystem), "/")
+		if subsystem == "" {
+			return fmt.Errorf("invalid subsystem log level %q: empty subsystem", str)
+		}
+
+		lvl, err := LevelFromString(strings.TrimSpace(lvlstr))
+		if err != nil {
+			return fmt.Errorf("invalid subsystem log level %q: %w", str, err)
+		}
+
+		levels[subsystem] = lvl
+	}
+
+	SetSubsystemLevels(levels)
+	return nil
+}
+
 // EnableSyslog will enabling logging to the syslog at given address.
 func EnableSyslog(proto, addr string) error {
 	// Dial a connection to the syslog daemon
@@ -0,0 +1,139 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"codeberg.org/gruf/go-byteutil"
+	"codeberg.org/gruf/go-kv"
+)
+
+// appendJSON appends a log entry formatted as a single JSON object
+// to buf, with given caller, level (omitted if empty), fields and msg.
+func appendJSON(buf *byteutil.Buffer, caller string, lvl string, fields []kv.Field, msg string) {
+	buf.B = append(buf.B, '{')
+
+	if timelayout != "" {
+		// Append formatted timestamp according to `timelayout`
+		buf.B = append(buf.B, `"timestamp":"`...)
+		buf.B = time.Now().AppendFormat(buf.B, timelayout)
+		buf.B = append(buf.B, `",`...)
+	}
+
+	buf.B = append(buf.B, `"func":`...)
+	buf.B = appendJSONString(buf.B, caller)
+
+	if lvl != "" {
+		buf.B = append(buf.B, `,"level":`...)
+		buf.B = appendJSONString(buf.B, lvl)
+	}
+
+	for _, field := range fields {
+		buf.B = append(buf.B, ',')
+		buf.B = appendJSONString(buf.B, field.K)
+		buf.B = append(buf.B, ':')
+		buf.B = appendJSONValue(buf.B, field.V)
+	}
+
+	buf.B = append(buf.B, `,"msg":`...)
+	buf.B = appendJSONString(buf.B, msg)
+	buf.B = append(buf.B, '}')
+}
+
+// appendJSONValue appends v as a JSON value, keeping booleans
+// and numbers as-is and formatting anything else as a string.
+func appendJSONValue(b []byte, v interface{}) []byte {
+	switch v := v.(type) {
+	case nil:
+		return append(b, "null"...)
+	case bool:
+		return strconv.AppendBool(b, v)
+	case int:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int8:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int16:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int32:
+		return strconv.AppendInt(b, int64(v), 10)
+	case int64:
+		return strconv.AppendInt(b, v, 10)
+	case uint:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint8:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint16:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint32:
+		return strconv.AppendUint(b, uint64(v), 10)
+	case uint64:
+		return strconv.AppendUint(b, v, 10)
+	case string:
+		return appendJSONString(b, v)
+	case error:
+		return appendJSONString(b, v.Error())
+	case fmt.Stringer:
+		return appendJSONString(b, v.String())
+	default:
+		return appendJSONString(b, fmt.Sprint(v))
+	}
+}
+
+// appendJSONString appends s as a quoted and escaped JSON string.
+func appendJSONString(b []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+
+	b = append(b, '"')
+	for i := 0; i < len(s); {
+		c := s[i]
+
+		if c < utf8.RuneSelf {
+			switch {
+			case c == '"' || c == '\\':
+				b = append(b, '\\', c)
+			case c == '\n':
+				b = append(b, '\\', 'n')
+			case c == '\r':
+				b = append(b, '\\', 'r')
+			case c == '\t':
+				b = append(b, '\\', 't')
+			case c < 0x20:
+				b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xf])
+			default:
+				b = append(b, c)
+			}
+			i++
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			// Replace invalid UTF-8.
+			b = append(b, `�`...)
+		} else {
+			b = append(b, s[i:i+size]...)
+		}
+		i += size
+	}
+
+	return append(b, '"')
+}
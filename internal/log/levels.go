@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package log
+
+import (
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"codeberg.org/gruf/go-logger/v2/level"
+)
+
+// sublvls holds the currently set subsystem level overrides, nil if none.
+var sublvls atomic.Pointer[subsystemLevels]
+
+// subsystemLevels is an immutable set of subsystem
+// log level overrides, with a lookup cache of calling
+// function PCs to the override that applies to them.
+type subsystemLevels struct {
+	levels map[string]level.LEVEL
+	max    level.LEVEL
+	cache  sync.Map // map[uintptr]int, -1 if no override applies
+}
+
+// SetSubsystemLevels sets log level overrides for subsystems, where a
+// subsystem is a package path relative to the internal/ directory, e.g.
+// "federation/dereferencing". An override applies to the package itself
+// and any packages beneath it, with the most specific override winning.
+// Passing an empty map clears all overrides.
+func SetSubsystemLevels(levels map[string]level.LEVEL) {
+	if len(levels) == 0 {
+		sublvls.Store(nil)
+		return
+	}
+
+	subs := &subsystemLevels{
+		levels: make(map[string]level.LEVEL, len(levels)),
+	}
+
+	for subsystem, lvl := range levels {
+		subs.levels[subsystem] = lvl
+		if lvl > subs.max {
+			subs.max = lvl
+		}
+	}
+
+	sublvls.Store(subs)
+}
+
+// SubsystemLevels returns a copy of the currently set subsystem level overrides.
+func SubsystemLevels() map[string]level.LEVEL {
+	subs := sublvls.Load()
+	if subs == nil {
+		return map[string]level.LEVEL{}
+	}
+
+	levels := make(map[string]level.LEVEL, len(subs.levels))
+	for subsystem, lvl := range subs.levels {
+		levels[subsystem] = lvl
+	}
+
+	return levels
+}
+
+// enabled returns whether logging at lvl is enabled for the
+// function at given stack depth, taking subsystem overrides
+// into account. Depth is as for runtime.Callers, from the caller.
+func enabled(depth int, lvl level.LEVEL) bool {
+	def := DefaultLevel()
+
+	subs := sublvls.Load()
+	if subs == nil {
+		// Fast path, no overrides.
+		return lvl <= def
+	}
+
+	if lvl > def && lvl > subs.max {
+		// Not enabled anywhere.
+		return false
+	}
+
+	// Look up calling function PC.
+	var pcs [1]uintptr
+	if runtime.Callers(depth, pcs[:]) < 1 {
+		return lvl <= def
+	}
+
+	if override := subs.lookup(pcs[0]); override >= 0 {
+		return lvl <= level.LEVEL(override)
+	}
+
+	return lvl <= def
+}
+
+// lookup returns the level override applying to the function
+// at given PC, or -1 if none applies. Results are cached per PC.
+func (subs *subsystemLevels) lookup(pc uintptr) int {
+	if v, ok := subs.cache.Load(pc); ok {
+		return v.(int)
+	}
+
+	override := -1
+
+	// Find the longest subsystem
+	// matching the caller package.
+	pkg := subsystem(pc)
+	match := ""
+	for subsystem, lvl := range subs.levels {
+		if len(subsystem) <= len(match) {
+			continue
+		}
+		if pkg == subsystem || strings.HasPrefix(pkg, subsystem+"/") {
+			match = subsystem
+			override = int(lvl)
+		}
+	}
+
+	subs.cache.Store(pc, override)
+	return override
+}
+
+// subsystem returns the package path relative to
+// the internal/ directory of the function at PC,
+// or the full package path if not an internal package.
+func subsystem(pc uintptr) string {
+	fn := runtime.FuncForPC(pc)
+	if fn == nil {
+		return ""
+	}
+
+	// Function names take the form of
+	// "path/to/pkg.(*Type).Method", so
+	// the package ends at the first dot
+	// following the last slash.
+	name := fn.Name()
+	slash := strings.LastIndexByte(name, '/')
+	if dot := strings.IndexByte(name[slash+1:], '.'); dot >= 0 {
+		name = name[:slash+1+dot]
+	}
+
+	if i := strings.LastIndex(name, "/internal/"); i >= 0 {
+		name = name[i+len("/internal/"):]
+	}
+
+	return name
+}
@@ -23,6 +23,7 @@ import (
 	"log/syslog"
 	"os"
 	"strings"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -30,9 +31,25 @@ import (
 	"codeberg.org/gruf/go-logger/v2/level"
 )
 
+// Format is a log output format.
+type Format uint32
+
+const (
+	// FormatLogfmt outputs space-separated
+	// key=value pairs, one entry per line.
+	FormatLogfmt Format = iota
+
+	// FormatJSON outputs one JSON
+	// object per line (ndjson).
+	FormatJSON
+)
+
 var (
-	// loglvl is the currently set logging level.
-	loglvl level.LEVEL
+	// loglvl is the currently set default logging level.
+	loglvl atomic.Uint32
+
+	// logfmt is the currently set output format.
+	logfmt atomic.Uint32
 
 	// lvlstrs is the lookup table of log levels to strings.
 	lvlstrs = level.Default()
@@ -44,6 +61,11 @@ var (
 	// the full field and required quoting
 	timefmt = `timestamp="02/01/2006 15:04:05.000" `
 
+	// timelayout is the raw logging time format
+	// used, for output formats which quote it
+	// themselves. Empty if timestamps disabled.
+	timelayout = "02/01/2006 15:04:05.000"
+
 	// ctxhooks allows modifying log content based on context.
 	ctxhooks []func(context.Context, []kv.Field) []kv.Field
 )
@@ -53,14 +75,32 @@ func Hook(hook func(ctx context.Context, kvs []kv.Field) []kv.Field) {
 	ctxhooks = append(ctxhooks, hook)
 }
 
-// Level returns the currently set log level.
+// Level returns the most verbose log level enabled for any
+// subsystem, ie., the default level or the highest subsystem
+// level override. Use this to check whether it's worth doing
+// work to prepare a log entry at a given level.
 func Level() level.LEVEL {
-	return loglvl
+	lvl := DefaultLevel()
+	if subs := sublvls.Load(); subs != nil && subs.max > lvl {
+		lvl = subs.max
+	}
+	return lvl
 }
 
-// SetLevel sets the max logging level.
+// DefaultLevel returns the currently set default log
+// level, used for subsystems without a level override.
+func DefaultLevel() level.LEVEL {
+	return level.LEVEL(loglvl.Load())
+}
+
+// SetLevel sets the default max logging level.
 func SetLevel(lvl level.LEVEL) {
-	loglvl = lvl
+	loglvl.Store(uint32(lvl))
+}
+
+// SetFormat sets the log output format.
+func SetFormat(format Format) {
+	logfmt.Store(uint32(format))
 }
 
 // TimeFormat returns the currently-set timestamp format.
@@ -70,6 +110,7 @@ func TimeFormat() string {
 
 // SetTimeFormat sets the timestamp format to the given string.
 func SetTimeFormat(format string) {
+	timelayout = format
 	if format == "" {
 		timefmt = format
 		return
@@ -178,22 +219,27 @@ func printf(depth int, fields []kv.Field, s string, a ...interface{}) {
 	// Acquire buffer
 	buf := getBuf()
 
-	// Append formatted timestamp according to `timefmt`
-	buf.B = time.Now().AppendFormat(buf.B, timefmt)
-
-	// Append formatted caller func
-	buf.B = append(buf.B, `func=`...)
-	buf.B = append(buf.B, Caller(depth+1)...)
-	buf.B = append(buf.B, ' ')
+	if Format(logfmt.Load()) == FormatJSON {
+		// Append entry as JSON object, with no level
+		appendJSON(buf, Caller(depth+1), "", fields, fmt.Sprintf(s, a...))
+	} else {
+		// Append formatted timestamp according to `timefmt`
+		buf.B = time.Now().AppendFormat(buf.B, timefmt)
 
-	if len(fields) > 0 {
-		// Append formatted fields
-		kv.Fields(fields).AppendFormat(buf, false)
+		// Append formatted caller func
+		buf.B = append(buf.B, `func=`...)
+		buf.B = append(buf.B, Caller(depth+1)...)
 		buf.B = append(buf.B, ' ')
-	}
 
-	// Append formatted args
-	fmt.Fprintf(buf, s, a...)
+		if len(fields) > 0 {
+			// Append formatted fields
+			kv.Fields(fields).AppendFormat(buf, false)
+			buf.B = append(buf.B, ' ')
+		}
+
+		// Append formatted args
+		fmt.Fprintf(buf, s, a...)
+	}
 
 	if buf.B[len(buf.B)-1] != '\n' {
 		// Append a final newline
@@ -213,8 +259,9 @@ func printf(depth int, fields []kv.Field, s string, a ...interface{}) {
 func logf(ctx context.Context, depth int, lvl level.LEVEL, fields []kv.Field, s string, a ...interface{}) {
 	var out *os.File
 
-	// Check if enabled.
-	if lvl > Level() {
+	// Check if enabled, for
+	// the calling subsystem.
+	if !enabled(depth+1, lvl) {
 		return
 	}
 
@@ -229,19 +276,6 @@ func logf(ctx context.Context, depth int, lvl level.LEVEL, fields []kv.Field, s
 	// Acquire buffer
 	buf := getBuf()
 
-	// Append formatted timestamp according to `timefmt`
-	buf.B = time.Now().AppendFormat(buf.B, timefmt)
-
-	// Append formatted caller func
-	buf.B = append(buf.B, `func=`...)
-	buf.B = append(buf.B, Caller(depth+1)...)
-	buf.B = append(buf.B, ' ')
-
-	// Append formatted level string
-	buf.B = append(buf.B, `level=`...)
-	buf.B = append(buf.B, lvlstrs[lvl]...)
-	buf.B = append(buf.B, ' ')
-
 	if ctx != nil {
 		// Pass context through hooks.
 		for _, hook := range ctxhooks {
@@ -249,10 +283,28 @@ func logf(ctx context.Context, depth int, lvl level.LEVEL, fields []kv.Field, s
 		}
 	}
 
-	// Append formatted fields with msg
-	kv.Fields(append(fields, kv.Field{
-		K: "msg", V: fmt.Sprintf(s, a...),
-	})).AppendFormat(buf, false)
+	if Format(logfmt.Load()) == FormatJSON {
+		// Append entry as JSON object
+		appendJSON(buf, Caller(depth+1), lvlstrs[lvl], fields, fmt.Sprintf(s, a...))
+	} else {
+		// Append formatted timestamp according to `timefmt`
+		buf.B = time.Now().AppendFormat(buf.B, timefmt)
+
+		// Append formatted caller func
+		buf.B = append(buf.B, `func=`...)
+		buf.B = append(buf.B, Caller(depth+1)...)
+		buf.B = append(buf.B, ' ')
+
+		// Append formatted level string
+		buf.B = append(buf.B, `level=`...)
+		buf.B = append(buf.B, lvlstrs[lvl]...)
+		buf.B = append(buf.B, ' ')
+
+		// Append formatted fields with msg
+		kv.Fields(append(fields, kv.Field{
+			K: "msg", V: fmt.Sprintf(s, a...),
+		})).AppendFormat(buf, false)
+	}
 
 	if buf.B[len(buf.B)-1] != '\n' {
 		// Append a final newline
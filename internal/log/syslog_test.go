@@ -18,12 +18,15 @@
 package log_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"path"
 	"regexp"
+	"strings"
 	"testing"
 
+	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -87,6 +90,66 @@ func (suite *SyslogTestSuite) TestSyslogDisableTimestamp() {
 	suite.Regexp(regexp.MustCompile(`func=.* level=INFO msg="this is a test of the emergency broadcast system!"`), entry["content"])
 }
 
+func (suite *SyslogTestSuite) TestSyslogJSON() {
+	log.SetFormat(log.FormatJSON)
+	defer log.SetFormat(log.FormatLogfmt)
+
+	log.WithField("count", 3).Info("this is a \"test\" of the emergency broadcast system!")
+
+	entry := <-suite.syslogChannel
+
+	// Skip any syslog header before the JSON object.
+	content := entry["content"].(string)
+	if i := strings.IndexByte(content, '{'); i > 0 {
+		content = content[i:]
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(content), &fields); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(fields["timestamp"])
+	suite.Equal("log_test.(*SyslogTestSuite).TestSyslogJSON", fields["func"])
+	suite.Equal("INFO", fields["level"])
+	suite.Equal(float64(3), fields["count"])
+	suite.Equal("this is a \"test\" of the emergency broadcast system!", fields["msg"])
+}
+
+func (suite *SyslogTestSuite) TestSyslogSubsystemLevels() {
+	// Only log errors from this package,
+	// and debug from an unrelated one.
+	if err := log.ParseSubsystemLevels([]string{
+		"log_test=error",
+		"federation=debug",
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+	defer log.SetSubsystemLevels(nil)
+
+	// Level should reflect most verbose override.
+	suite.Equal(level.DEBUG, log.Level())
+	suite.Equal(level.INFO, log.DefaultLevel())
+
+	// Only the error should make it through.
+	log.Debug(nil, "this is a debug message")
+	log.Info(nil, "this is an info message")
+	log.Error(nil, "this is an error message")
+
+	entry := <-suite.syslogChannel
+	suite.Regexp(regexp.MustCompile(`level=ERROR msg="this is an error message"`), entry["content"])
+}
+
+func (suite *SyslogTestSuite) TestParseSubsystemLevelsInvalid() {
+	for _, strs := range [][]string{
+		{"federation"},
+		{"=debug"},
+		{"federation=loud"},
+	} {
+		suite.Error(log.ParseSubsystemLevels(strs))
+	}
+}
+
 func (suite *SyslogTestSuite) TestSyslogLongMessage() {
 	log.Warn(nil, longMessage)
 
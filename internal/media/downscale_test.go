@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+)
+
+type DownscaleTestSuite struct {
+	MediaStandardTestSuite
+}
+
+func (suite *DownscaleTestSuite) process(path string) (*gtsmodel.MediaAttachment, error) {
+	ctx := context.Background()
+
+	data := func(_ context.Context) (io.ReadCloser, int64, error) {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			panic(err)
+		}
+		return io.NopCloser(bytes.NewBuffer(b)), int64(len(b)), nil
+	}
+
+	processingMedia, err := suite.manager.ProcessMedia(ctx, data, "01FS1X72SK9ZPW0J1QQ68BD264", nil)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return processingMedia.LoadAttachment(ctx)
+}
+
+func (suite *DownscaleTestSuite) TestDownscaleJpeg() {
+	config.SetMediaImageMaxDimension(960)
+
+	attachment, err := suite.process("./test/test-jpeg.jpg")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// 1920x1080 should be fit within 960x960.
+	suite.Equal(960, attachment.FileMeta.Original.Width)
+	suite.Equal(540, attachment.FileMeta.Original.Height)
+	suite.Equal("image/jpeg", attachment.File.ContentType)
+	suite.Equal(".jpg", attachment.File.Path[len(attachment.File.Path)-4:])
+
+	// The stored original should be the downscaled image.
+	b, err := suite.storage.Get(context.Background(), attachment.File.Path)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(b, attachment.File.FileSize)
+	suite.Less(attachment.File.FileSize, 269739)
+}
+
+func (suite *DownscaleTestSuite) TestNoDownscale() {
+	config.SetMediaImageMaxDimension(0)
+
+	attachment, err := suite.process("./test/test-jpeg.jpg")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(1920, attachment.FileMeta.Original.Width)
+	suite.Equal(1080, attachment.FileMeta.Original.Height)
+	suite.Equal(269739, attachment.File.FileSize)
+}
+
+func (suite *DownscaleTestSuite) TestTooManyPixels() {
+	config.SetMediaImageMaxPixels(1920*1080 - 1)
+
+	_, err := suite.process("./test/test-jpeg.jpg")
+	suite.True(errors.Is(err, media.ErrImageTooLarge), "expected ErrImageTooLarge, got %v", err)
+}
+
+func TestDownscaleTestSuite(t *testing.T) {
+	suite.Run(t, &DownscaleTestSuite{})
+}
@@ -0,0 +1,146 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/jpeg"
+	"io"
+
+	"github.com/disintegration/imaging"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// ErrUneditable is returned by PreProcessMediaEdit
+// for attachments whose type doesn't support editing.
+var ErrUneditable = errors.New("media type cannot be edited")
+
+// ImageEdit describes edits to be made to the original
+// of an image attachment. Rotation is applied first,
+// then any crop, relative to the rotated image.
+type ImageEdit struct {
+	// Degrees to rotate the image by, clockwise.
+	// Must be one of 0, 90, 180, 270.
+	Rotate int
+	// Rectangle (in pixels) to crop the image to,
+	// or nil to not crop. Must be within bounds of
+	// the (rotated) image, else error is returned.
+	Crop *image.Rectangle
+}
+
+// PreProcessMediaEdit applies the given edit to the stored original
+// of an existing image attachment, and then reprocesses the attachment
+// from the edited original, regenerating its thumbnail and metadata.
+//
+// Only JPEG, PNG and WebP images can be edited. JPEGs remain JPEGs,
+// other images will be re-encoded as PNG, since we can't encode WebP.
+//
+// Note: like PreProcessMediaRecache, this will NOT queue the media
+// to be asynchronously processed. The caller should remove the old
+// original from storage if its path changed after processing.
+func (m *Manager) PreProcessMediaEdit(ctx context.Context, attachment *gtsmodel.MediaAttachment, edit ImageEdit) (*ProcessingMedia, error) {
+	switch attachment.File.ContentType {
+	case mimeImageJpeg, mimeImagePng, mimeImageWebp:
+	default:
+		return nil, ErrUneditable
+	}
+
+	switch edit.Rotate {
+	case 0, 90, 180, 270:
+	default:
+		return nil, gtserror.Newf("invalid rotation %d", edit.Rotate)
+	}
+
+	// Fetch and decode the stored original into
+	// memory now, so that any invalid edit can
+	// be returned before we start processing.
+	rc, err := m.state.Storage.GetStream(ctx, attachment.File.Path)
+	if err != nil {
+		return nil, gtserror.Newf("error loading file from storage: %w", err)
+	}
+
+	var r io.Reader = rc
+	if attachment.File.ContentType == mimeImagePng {
+		r = &pngAncillaryChunkStripper{Reader: rc}
+	}
+
+	img, err := decodeImage(r, imaging.AutoOrientation(true))
+	_ = rc.Close()
+	if err != nil {
+		return nil, gtserror.Newf("error decoding image: %w", err)
+	}
+
+	// Rotate the image. Note the imaging
+	// library rotates counter-clockwise.
+	switch edit.Rotate {
+	case 90:
+		img.image = imaging.Rotate270(img.image)
+	case 180:
+		img.image = imaging.Rotate180(img.image)
+	case 270:
+		img.image = imaging.Rotate90(img.image)
+	}
+
+	if edit.Crop != nil {
+		bounds := img.image.Bounds()
+		crop := edit.Crop.Add(bounds.Min)
+
+		if crop.Empty() || !crop.In(bounds) {
+			return nil, gtserror.Newf(
+				"crop %s out of bounds for %dx%d image",
+				edit.Crop, img.Width(), img.Height(),
+			)
+		}
+
+		img.image = imaging.Crop(img.image, crop)
+	}
+
+	// Encode the edited image to be
+	// passed on as new original data.
+	var enc io.Reader
+	if attachment.File.ContentType == mimeImageJpeg {
+		enc = img.ToJPEG(&jpeg.Options{
+			Quality: 90, // keep as much detail as we can.
+		})
+	} else {
+		enc = img.ToPNG()
+	}
+
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(enc); err != nil {
+		return nil, gtserror.Newf("error encoding image: %w", err)
+	}
+
+	data := func(context.Context) (io.ReadCloser, int64, error) {
+		b := buf.Bytes()
+		return io.NopCloser(bytes.NewReader(b)), int64(len(b)), nil
+	}
+
+	processingMedia := &ProcessingMedia{
+		media:   attachment,
+		dataFn:  data,
+		recache: true, // existing attachment, only update
+		mgr:     m,
+	}
+
+	return processingMedia, nil
+}
@@ -19,6 +19,9 @@ package media
 
 import (
 	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
 	"image"
 	"image/color"
 	"image/draw"
@@ -29,12 +32,17 @@ import (
 
 	"github.com/buckket/go-blurhash"
 	"github.com/disintegration/imaging"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/iotools"
 
 	// import to init webp encode/decoding.
 	_ "golang.org/x/image/webp"
 )
 
+// ErrImageTooLarge is returned when decoding an image whose
+// dimensions exceed the configured media-image-max-pixels.
+var ErrImageTooLarge = errors.New("image dimensions too large")
+
 var (
 	// pngEncoder provides our global PNG encoding with
 	// specified compression level, and memory pooled buffers.
@@ -72,7 +80,22 @@ func blankImage(width int, height int) *gtsImage {
 
 // decodeImage will decode image from reader stream and return image wrapped in our own gtsImage{} type.
 func decodeImage(r io.Reader, opts ...imaging.DecodeOption) (*gtsImage, error) {
-	img, err := imaging.Decode(r, opts...)
+	// Check the image dimensions from its header before decoding
+	// the image itself, to avoid decompression bombs where a small
+	// file decodes into an image too large to fit in memory.
+	var hdr bytes.Buffer
+	cfg, _, err := image.DecodeConfig(io.TeeReader(r, &hdr))
+	if err != nil {
+		return nil, err
+	}
+
+	if max := config.GetMediaImageMaxPixels(); max > 0 &&
+		cfg.Width*cfg.Height > max {
+		return nil, fmt.Errorf("%w: %dx%d", ErrImageTooLarge, cfg.Width, cfg.Height)
+	}
+
+	// Recombine already read header bytes with the rest.
+	img, err := imaging.Decode(io.MultiReader(&hdr, r), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,7 @@ import (
 
 	"codeberg.org/gruf/go-iotools"
 	"codeberg.org/gruf/go-store/v2/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -42,18 +43,46 @@ var SupportedMIMETypes = []string{
 	mimeVideoMp4,
 }
 
+// VideoMatrixLimit is the max size in pixels (width * height)
+// of uploaded videos advertised to clients. GtS doesn't enforce
+// this, but for compatibility we give Mastodon's value.
+const VideoMatrixLimit = 16777216 // 4096x4096px
+
+// ImageMatrixLimit returns the max size in pixels (width * height)
+// of stored images advertised to clients. Larger images are
+// downscaled to fit media-image-max-dimension, so this is that
+// squared, else media-image-max-pixels if downscaling is disabled.
+func ImageMatrixLimit() int {
+	if max := config.GetMediaImageMaxDimension(); max > 0 {
+		return max * max
+	}
+	return config.GetMediaImageMaxPixels()
+}
+
 var SupportedEmojiMIMETypes = []string{
 	mimeImageGif,
 	mimeImagePng,
 }
 
 type Manager struct {
-	state *state.State
+	state   *state.State
+	scanner Scanner
 }
 
-// NewManager returns a media manager with given state.
+// NewManager returns a media manager with given state,
+// using the media scanner configured (if any).
 func NewManager(state *state.State) *Manager {
 	m := &Manager{state: state}
+
+	scanner, err := NewScanner()
+	if err != nil {
+		// Fail closed, rejecting
+		// all media until fixed.
+		log.Errorf(nil, "error configuring media scanner, all media will be rejected: %v", err)
+		scanner = &errScanner{err: err}
+	}
+	m.scanner = scanner
+
 	return m
 }
 
@@ -77,6 +106,7 @@ func (m *Manager) PreProcessMedia(ctx context.Context, data DataFunc, accountID
 	avatar := false
 	header := false
 	cached := false
+	sensitive := false
 	now := time.Now()
 
 	// populate initial fields on the media attachment -- some of these will be overwritten as we proceed
@@ -99,6 +129,7 @@ func (m *Manager) PreProcessMedia(ctx context.Context, data DataFunc, accountID
 		Avatar:            &avatar,
 		Header:            &header,
 		Cached:            &cached,
+		Sensitive:         &sensitive,
 	}
 
 	// check if we have additional info to add to the attachment,
@@ -143,6 +174,10 @@ func (m *Manager) PreProcessMedia(ctx context.Context, data DataFunc, accountID
 		if ai.FocusY != nil {
 			attachment.FileMeta.Focus.Y = *ai.FocusY
 		}
+
+		if ai.Sensitive != nil {
+			attachment.Sensitive = ai.Sensitive
+		}
 	}
 
 	processingMedia := &ProcessingMedia{
@@ -322,6 +357,14 @@ func (m *Manager) PreProcessEmoji(ctx context.Context, data DataFunc, shortcode
 		if ai.CategoryID != nil {
 			emoji.CategoryID = *ai.CategoryID
 		}
+
+		if ai.License != nil {
+			emoji.License = *ai.License
+		}
+
+		if ai.Attribution != nil {
+			emoji.Attribution = *ai.Attribution
+		}
 	}
 
 	processingEmoji := &ProcessingEmoji{
@@ -23,6 +23,8 @@ import (
 	"fmt"
 	"image/jpeg"
 	"io"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"codeberg.org/gruf/go-errors/v2"
@@ -30,6 +32,7 @@ import (
 	"github.com/disintegration/imaging"
 	"github.com/h2non/filetype"
 	terminator "github.com/superseriousbusiness/exif-terminator"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -115,6 +118,12 @@ func (p *ProcessingMedia) load(ctx context.Context) (*gtsmodel.MediaAttachment,
 			return err
 		}
 
+		// Scan stored media for viruses, if
+		// enabled, before processing it further.
+		if err = p.scan(ctx); err != nil {
+			return err
+		}
+
 		// Finish processing by reloading media into
 		// memory to get dimension and generate a thumb.
 		if err = p.finish(ctx); err != nil {
@@ -290,6 +299,12 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		return gtserror.Newf("error closing file: %w", err)
 	}
 
+	// Downscale oversized images to fit
+	// within configured max dimensions.
+	if err := p.downscale(ctx, fullImg); err != nil {
+		return err
+	}
+
 	// Set full-size dimensions in attachment info.
 	p.media.FileMeta.Original.Width = int(fullImg.Width())
 	p.media.FileMeta.Original.Height = int(fullImg.Height())
@@ -369,3 +384,71 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 
 	return nil
 }
+
+// downscale resizes the given full-size image to fit within the configured
+// media-image-max-dimension if it's larger, replacing the stored original
+// with the downscaled image. JPEGs remain JPEGs, PNG and WebP images are
+// stored as PNG, since we can't encode WebP. Other types are left as-is.
+func (p *ProcessingMedia) downscale(ctx context.Context, img *gtsImage) error {
+	switch p.media.File.ContentType {
+	case mimeImageJpeg, mimeImagePng, mimeImageWebp:
+	default:
+		// GIFs are skipped so as not to lose
+		// animation, and videos are as they are.
+		return nil
+	}
+
+	max := config.GetMediaImageMaxDimension()
+	if max <= 0 || (int(img.Width()) <= max && int(img.Height()) <= max) {
+		// Nothing to do.
+		return nil
+	}
+
+	log.Debugf(ctx, "downscaling %dx%d image %s", img.Width(), img.Height(), p.media.ID)
+
+	// Resize to fit within max dimensions, keeping aspect ratio.
+	img.image = imaging.Fit(img.image, max, max, imaging.Lanczos)
+
+	var (
+		enc  io.Reader
+		path = p.media.File.Path
+		ext  = strings.TrimPrefix(filepath.Ext(path), ".")
+	)
+
+	if p.media.File.ContentType == mimeImageJpeg {
+		enc = img.ToJPEG(&jpeg.Options{
+			Quality: 90, // keep as much detail as we can.
+		})
+	} else {
+		enc = img.ToPNG()
+		ext = "png"
+		path = strings.TrimSuffix(path, filepath.Ext(path)) + ".png"
+	}
+
+	// Remove the oversized original from storage.
+	if err := p.mgr.state.Storage.Delete(ctx, p.media.File.Path); err != nil {
+		return gtserror.Newf("error removing media from storage: %w", err)
+	}
+
+	// Write the downscaled image to storage in its place.
+	sz, err := p.mgr.state.Storage.PutStream(ctx, path, enc)
+	if err != nil {
+		return gtserror.Newf("error writing media to storage: %w", err)
+	}
+
+	// Update attachment details for new original.
+	p.media.File.Path = path
+	p.media.File.FileSize = int(sz)
+	if ext == "png" {
+		p.media.File.ContentType = mimeImagePng
+	}
+	p.media.URL = uris.GenerateURIForAttachment(
+		p.media.AccountID,
+		string(TypeAttachment),
+		string(SizeOriginal),
+		p.media.ID,
+		ext,
+	)
+
+	return nil
+}
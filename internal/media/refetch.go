@@ -30,7 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
-type DereferenceMedia func(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, error)
+type DereferenceMedia func(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, *url.URL, error)
 
 // RefetchEmojis iterates through remote emojis (for the given domain, or all if domain is empty string).
 //
@@ -110,7 +110,8 @@ func (m *Manager) RefetchEmojis(ctx context.Context, domain string, dereferenceM
 		}
 
 		dataFunc := func(ctx context.Context) (reader io.ReadCloser, fileSize int64, err error) {
-			return dereferenceMedia(ctx, emojiImageIRI)
+			reader, fileSize, _, err = dereferenceMedia(ctx, emojiImageIRI)
+			return
 		}
 
 		processingEmoji, err := m.PreProcessEmoji(ctx, dataFunc, emoji.Shortcode, emoji.ID, emoji.URI, &AdditionalEmojiInfo{
@@ -0,0 +1,319 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os/exec"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// ErrInfected is returned when a media
+// scanner finds media to be infected.
+var ErrInfected = errors.New("media infected")
+
+// scans counts media scans by result,
+// one of "clean", "infected" or "error".
+var scans, _ = otel.Meter(
+	"github.com/superseriousbusiness/gotosocial/internal/media",
+).Int64Counter(
+	"gotosocial.media.scans",
+	metric.WithDescription("Number of media scans by result"),
+)
+
+// Scanner scans media for viruses and other malware.
+type Scanner interface {
+	// Scan scans the media data read from r, returning
+	// the name of what was found if media is infected,
+	// or an empty string if the media is clean.
+	Scan(ctx context.Context, r io.Reader) (string, error)
+}
+
+// NewScanner returns a new Scanner as configured
+// by media-scan-clamav-address or media-scan-command,
+// else nil if neither is set (ie., scanning disabled).
+func NewScanner() (Scanner, error) {
+	if addr := config.GetMediaScanClamAVAddress(); addr != "" {
+		u, err := url.Parse(addr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid clamav address %s: %w", addr, err)
+		}
+
+		switch u.Scheme {
+		case "tcp":
+			return &clamAVScanner{network: "tcp", address: u.Host}, nil
+		case "unix":
+			return &clamAVScanner{network: "unix", address: u.Path}, nil
+		default:
+			return nil, fmt.Errorf("invalid clamav address scheme %s, must be tcp or unix", u.Scheme)
+		}
+	}
+
+	if cmd := strings.Fields(config.GetMediaScanCommand()); len(cmd) > 0 {
+		return &commandScanner{command: cmd}, nil
+	}
+
+	return nil, nil
+}
+
+// errScanner implements Scanner by failing every
+// scan with the given error, used to fail closed
+// when the configured scanner is invalid.
+type errScanner struct {
+	err error
+}
+
+func (s *errScanner) Scan(context.Context, io.Reader) (string, error) {
+	return "", s.err
+}
+
+// clamAVScanner implements Scanner by streaming
+// media to a clamd daemon using INSTREAM command.
+type clamAVScanner struct {
+	network string
+	address string
+}
+
+// clamAVChunkSize is the size of chunks
+// media is streamed to clamd in; clamd
+// defaults StreamMaxLength to 25MiB so
+// we keep each chunk well below that.
+const clamAVChunkSize = 64 * 1024
+
+func (s *clamAVScanner) Scan(ctx context.Context, r io.Reader) (string, error) {
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return "", fmt.Errorf("error dialing clamd: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	// Start a null-terminated INSTREAM session.
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return "", fmt.Errorf("error writing to clamd: %w", err)
+	}
+
+	// Stream media in chunks, each
+	// prefixed by its length as a
+	// 4 byte big-endian integer.
+	buf := make([]byte, 4+clamAVChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := conn.Write(buf[:4+n]); err != nil {
+				return "", fmt.Errorf("error writing to clamd: %w", err)
+			}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		} else if err != nil {
+			return "", fmt.Errorf("error reading media: %w", err)
+		}
+	}
+
+	// Terminate stream with zero-length chunk.
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return "", fmt.Errorf("error writing to clamd: %w", err)
+	}
+
+	// Read the null-terminated reply, eg:
+	//
+	//	stream: OK
+	//	stream: Eicar-Test-Signature FOUND
+	//	INSTREAM size limit exceeded. ERROR
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("error reading from clamd: %w", err)
+	}
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+
+	switch {
+	case strings.HasSuffix(reply, " OK"):
+		return "", nil
+	case strings.HasSuffix(reply, " FOUND"):
+		found := strings.TrimSuffix(reply, " FOUND")
+		found = strings.TrimPrefix(found, "stream: ")
+		return found, nil
+	default:
+		return "", fmt.Errorf("unexpected clamd reply: %s", reply)
+	}
+}
+
+// commandScanner implements Scanner by running
+// an external command with media on stdin.
+type commandScanner struct {
+	command []string
+}
+
+func (s *commandScanner) Scan(ctx context.Context, r io.Reader) (string, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, s.command[0], s.command[1:]...)
+	cmd.Stdin = r
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err == nil {
+		// Exit code 0 == clean.
+		return "", nil
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		// Exit code 1 == infected.
+		found := strings.TrimSpace(stdout.String())
+		if found == "" {
+			found = "unknown"
+		}
+		return found, nil
+	}
+
+	return "", fmt.Errorf("error running scan command: %w (stderr: %s)", err, strings.TrimSpace(stderr.String()))
+}
+
+// scan scans the stored original file of the processing media, if a
+// scanner is configured, returning a wrapped ErrInfected if infected.
+// Infected media is removed from storage, and a report is opened
+// against the owning account to notify instance moderators.
+func (p *ProcessingMedia) scan(ctx context.Context) error {
+	scanner := p.mgr.scanner
+	if scanner == nil {
+		// Scanning disabled.
+		return nil
+	}
+
+	rc, err := p.mgr.state.Storage.GetStream(ctx, p.media.File.Path)
+	if err != nil {
+		return gtserror.Newf("error loading file from storage: %w", err)
+	}
+	defer rc.Close()
+
+	scanCtx, cancel := context.WithTimeout(ctx, config.GetMediaScanTimeout())
+	defer cancel()
+
+	found, err := scanner.Scan(scanCtx, rc)
+	switch {
+	case err != nil:
+		scans.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "error")))
+
+		// Fail closed: media which can't
+		// be scanned shouldn't be stored.
+		p.removeInfected(ctx)
+		return gtserror.Newf("error scanning media: %w", err)
+
+	case found != "":
+		scans.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "infected")))
+		log.Warnf(ctx, "media %s (remote url %s) infected: %s", p.media.ID, p.media.RemoteURL, found)
+
+		p.removeInfected(ctx)
+		if err := p.mgr.reportInfected(ctx, p.media, found); err != nil {
+			log.Errorf(ctx, "error reporting infected media %s: %v", p.media.ID, err)
+		}
+		return fmt.Errorf("%w: %s", ErrInfected, found)
+
+	default:
+		scans.Add(ctx, 1, metric.WithAttributes(attribute.String("result", "clean")))
+		return nil
+	}
+}
+
+// removeInfected removes the stored original file of
+// processing media, marking the attachment as uncached.
+func (p *ProcessingMedia) removeInfected(ctx context.Context) {
+	if err := p.mgr.state.Storage.Delete(ctx, p.media.File.Path); err != nil {
+		log.Errorf(ctx, "error removing media %s from storage: %v", p.media.File.Path, err)
+	}
+	p.media.Cached = util.Ptr(false)
+}
+
+// reportInfected opens a report from the instance account against
+// the account owning given infected attachment, so that instance
+// moderators are notified. The report is never forwarded.
+func (m *Manager) reportInfected(ctx context.Context, attachment *gtsmodel.MediaAttachment, found string) error {
+	instanceAcct, err := m.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("error getting instance account: %w", err)
+	}
+
+	targetAcct, err := m.state.DB.GetAccountByID(ctx, attachment.AccountID)
+	if err != nil {
+		return gtserror.Newf("error getting account %s: %w", attachment.AccountID, err)
+	}
+
+	comment := "Media scanner found " + found + " in "
+	if attachment.RemoteURL != "" {
+		comment += "remote media " + attachment.RemoteURL + ", which was not cached."
+	} else {
+		comment += "uploaded media, which was rejected."
+	}
+
+	reportID := id.NewULID()
+	report := &gtsmodel.Report{
+		ID:              reportID,
+		URI:             uris.GenerateURIForReport(reportID),
+		AccountID:       instanceAcct.ID,
+		Account:         instanceAcct,
+		TargetAccountID: targetAcct.ID,
+		TargetAccount:   targetAcct,
+		Comment:         comment,
+		Forwarded:       util.Ptr(false),
+	}
+
+	if err := m.state.DB.PutReport(ctx, report); err != nil {
+		return gtserror.Newf("error putting report: %w", err)
+	}
+
+	m.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityFlag,
+		GTSModel:       report,
+		OriginAccount:  instanceAcct,
+		TargetAccount:  targetAcct,
+	})
+
+	return nil
+}
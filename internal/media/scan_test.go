@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// eicar is the standard antivirus test file.
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd serves a single clamd INSTREAM session on
+// a local TCP listener, replying FOUND if the streamed
+// data contains the EICAR test string, else OK.
+func fakeClamd(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		cmd := make([]byte, len("zINSTREAM\x00"))
+		if _, err := io.ReadFull(conn, cmd); err != nil {
+			return
+		}
+
+		var data bytes.Buffer
+		for {
+			var size uint32
+			if err := binary.Read(conn, binary.BigEndian, &size); err != nil {
+				return
+			}
+			if size == 0 {
+				break
+			}
+			if _, err := io.CopyN(&data, conn, int64(size)); err != nil {
+				return
+			}
+		}
+
+		if bytes.Contains(data.Bytes(), []byte(eicar)) {
+			_, _ = conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+		} else {
+			_, _ = conn.Write([]byte("stream: OK\x00"))
+		}
+	}()
+
+	return "tcp://" + l.Addr().String()
+}
+
+func newScanner(t *testing.T, clamAVAddress string, command string) media.Scanner {
+	testrig.InitTestConfig()
+	config.SetMediaScanClamAVAddress(clamAVAddress)
+	config.SetMediaScanCommand(command)
+
+	scanner, err := media.NewScanner()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return scanner
+}
+
+func TestScanDisabled(t *testing.T) {
+	if scanner := newScanner(t, "", ""); scanner != nil {
+		t.Fatalf("expected nil scanner, got %T", scanner)
+	}
+}
+
+func TestScanClamAVClean(t *testing.T) {
+	scanner := newScanner(t, fakeClamd(t), "")
+
+	found, err := scanner.Scan(context.Background(), bytes.NewReader(bytes.Repeat([]byte("a"), 100000)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != "" {
+		t.Fatalf("expected clean, found %s", found)
+	}
+}
+
+func TestScanClamAVInfected(t *testing.T) {
+	scanner := newScanner(t, fakeClamd(t), "")
+
+	found, err := scanner.Scan(context.Background(), bytes.NewReader([]byte(eicar)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != "Eicar-Test-Signature" {
+		t.Fatalf("expected Eicar-Test-Signature, found %q", found)
+	}
+}
+
+func TestScanClamAVInvalidAddress(t *testing.T) {
+	testrig.InitTestConfig()
+	config.SetMediaScanClamAVAddress("http://127.0.0.1:3310")
+
+	if _, err := media.NewScanner(); err == nil {
+		t.Fatal("expected error for invalid clamav address scheme")
+	}
+}
+
+func TestScanCommand(t *testing.T) {
+	found, err := newScanner(t, "", "true").Scan(context.Background(), bytes.NewReader([]byte(eicar)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found != "" {
+		t.Fatalf("expected clean, found %s", found)
+	}
+
+	found, err = newScanner(t, "", "false").Scan(context.Background(), bytes.NewReader([]byte(eicar)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found == "" {
+		t.Fatal("expected infected")
+	}
+}
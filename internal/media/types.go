@@ -87,6 +87,8 @@ type AdditionalMediaInfo struct {
 	FocusX *float32
 	// Y focus coordinate for this media; defaults to 0.
 	FocusY *float32
+	// Mark this media individually as sensitive; defaults to false.
+	Sensitive *bool
 }
 
 // AdditionalEmojiInfo represents additional information
@@ -106,6 +108,10 @@ type AdditionalEmojiInfo struct {
 	VisibleInPicker *bool
 	// ID of the category this emoji should be placed in; defaults to "".
 	CategoryID *string
+	// License under which the emoji image may be used; defaults to "".
+	License *string
+	// Credit for the author(s) of the emoji image; defaults to "".
+	Attribution *string
 }
 
 // DataFunc represents a function used to retrieve the raw bytes of a piece of media.
@@ -0,0 +1,282 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package messages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// gtsModels contains constructors for the GTSModel types which
+// may be carried by messages, by type name, used to decode them.
+var gtsModels = map[string]func() any{
+	"Account":           func() any { return new(gtsmodel.Account) },
+	"Block":             func() any { return new(gtsmodel.Block) },
+	"DomainBlock":       func() any { return new(gtsmodel.DomainBlock) },
+	"FirstInteraction":  func() any { return new(gtsmodel.FirstInteraction) },
+	"Follow":            func() any { return new(gtsmodel.Follow) },
+	"FollowRequest":     func() any { return new(gtsmodel.FollowRequest) },
+	"QuarantinedStatus": func() any { return new(gtsmodel.QuarantinedStatus) },
+	"Report":            func() any { return new(gtsmodel.Report) },
+	"Status":            func() any { return new(gtsmodel.Status) },
+	"StatusFave":        func() any { return new(gtsmodel.StatusFave) },
+	"StatusReaction":    func() any { return new(gtsmodel.StatusReaction) },
+}
+
+// GetAccountFunc fetches the account with given ID,
+// used to fill in the accounts of decoded messages.
+type GetAccountFunc func(ctx context.Context, id string) (*gtsmodel.Account, error)
+
+// encodedModel is the JSON encoding of a GTSModel.
+type encodedModel struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// encodedClientAPI is the JSON encoding of FromClientAPI.
+type encodedClientAPI struct {
+	APObjectType    string        `json:"object_type"`
+	APActivityType  string        `json:"activity_type"`
+	GTSModel        *encodedModel `json:"model,omitempty"`
+	OriginAccountID string        `json:"origin_account_id,omitempty"`
+	TargetAccountID string        `json:"target_account_id,omitempty"`
+}
+
+// encodedFediAPI is the JSON encoding of FromFediAPI.
+type encodedFediAPI struct {
+	APObjectType       string          `json:"object_type"`
+	APActivityType     string          `json:"activity_type"`
+	APIri              string          `json:"iri,omitempty"`
+	APObjectModel      json.RawMessage `json:"object,omitempty"`
+	APObjectModelType  string          `json:"object_type_name,omitempty"`
+	GTSModel           *encodedModel   `json:"model,omitempty"`
+	ReceivingAccountID string          `json:"receiving_account_id,omitempty"`
+}
+
+// Encode encodes the message as JSON, e.g. for persisting in a queue.
+//
+// Accounts are encoded by ID only, to be fetched again when the message
+// is decoded, so that private keys of local accounts aren't persisted.
+func (msg *FromClientAPI) Encode() ([]byte, error) {
+	model, err := encodeModel(msg.GTSModel)
+	if err != nil {
+		return nil, err
+	}
+
+	enc := encodedClientAPI{
+		APObjectType:   msg.APObjectType,
+		APActivityType: msg.APActivityType,
+		GTSModel:       model,
+	}
+
+	if msg.OriginAccount != nil {
+		enc.OriginAccountID = msg.OriginAccount.ID
+	}
+
+	if msg.TargetAccount != nil {
+		enc.TargetAccountID = msg.TargetAccount.ID
+	}
+
+	return json.Marshal(enc)
+}
+
+// Decode decodes the message from JSON as encoded by Encode(),
+// fetching its accounts by ID using the given function.
+func (msg *FromClientAPI) Decode(ctx context.Context, b []byte, getAccount GetAccountFunc) error {
+	var enc encodedClientAPI
+	if err := json.Unmarshal(b, &enc); err != nil {
+		return err
+	}
+
+	model, err := decodeModel(enc.GTSModel)
+	if err != nil {
+		return err
+	}
+
+	*msg = FromClientAPI{
+		APObjectType:   enc.APObjectType,
+		APActivityType: enc.APActivityType,
+		GTSModel:       model,
+	}
+
+	if enc.OriginAccountID != "" {
+		msg.OriginAccount, err = getAccount(ctx, enc.OriginAccountID)
+		if err != nil {
+			return fmt.Errorf("error getting origin account: %w", err)
+		}
+	}
+
+	if enc.TargetAccountID != "" {
+		msg.TargetAccount, err = getAccount(ctx, enc.TargetAccountID)
+		if err != nil {
+			return fmt.Errorf("error getting target account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Encode encodes the message as JSON, e.g. for persisting in a queue.
+//
+// The receiving account is encoded by ID only, see FromClientAPI.Encode().
+func (msg *FromFediAPI) Encode() ([]byte, error) {
+	model, err := encodeModel(msg.GTSModel)
+	if err != nil {
+		return nil, err
+	}
+
+	enc := encodedFediAPI{
+		APObjectType:   msg.APObjectType,
+		APActivityType: msg.APActivityType,
+		GTSModel:       model,
+	}
+
+	if msg.APIri != nil {
+		enc.APIri = msg.APIri.String()
+	}
+
+	switch t := msg.APObjectModel.(type) {
+	case nil:
+	case ap.Accountable:
+		enc.APObjectModelType = "Accountable"
+		enc.APObjectModel, err = encodeAP(t)
+	case ap.Statusable:
+		enc.APObjectModelType = "Statusable"
+		enc.APObjectModel, err = encodeAP(t)
+	default:
+		err = fmt.Errorf("unsupported object model type %T", t)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.ReceivingAccount != nil {
+		enc.ReceivingAccountID = msg.ReceivingAccount.ID
+	}
+
+	return json.Marshal(enc)
+}
+
+// Decode decodes the message from JSON as encoded by Encode(),
+// fetching the receiving account by ID using the given function.
+func (msg *FromFediAPI) Decode(ctx context.Context, b []byte, getAccount GetAccountFunc) error {
+	var enc encodedFediAPI
+	if err := json.Unmarshal(b, &enc); err != nil {
+		return err
+	}
+
+	model, err := decodeModel(enc.GTSModel)
+	if err != nil {
+		return err
+	}
+
+	*msg = FromFediAPI{
+		APObjectType:   enc.APObjectType,
+		APActivityType: enc.APActivityType,
+		GTSModel:       model,
+	}
+
+	if enc.APIri != "" {
+		msg.APIri, err = url.Parse(enc.APIri)
+		if err != nil {
+			return fmt.Errorf("invalid iri: %w", err)
+		}
+	}
+
+	switch enc.APObjectModelType {
+	case "":
+	case "Accountable":
+		msg.APObjectModel, err = ap.ResolveAccountable(ctx, enc.APObjectModel)
+	case "Statusable":
+		msg.APObjectModel, err = ap.ResolveStatusable(ctx, enc.APObjectModel)
+	default:
+		err = fmt.Errorf("unsupported object model type %s", enc.APObjectModelType)
+	}
+	if err != nil {
+		return fmt.Errorf("error decoding object model: %w", err)
+	}
+
+	if enc.ReceivingAccountID != "" {
+		msg.ReceivingAccount, err = getAccount(ctx, enc.ReceivingAccountID)
+		if err != nil {
+			return fmt.Errorf("error getting receiving account: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// encodeModel encodes the given GTSModel with its
+// type name, so that it can be decoded again.
+func encodeModel(model any) (*encodedModel, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	t := reflect.TypeOf(model)
+	if t.Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("unsupported model type %T", model)
+	}
+
+	name := t.Elem().Name()
+	if _, ok := gtsModels[name]; !ok {
+		return nil, fmt.Errorf("unsupported model type %T", model)
+	}
+
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("error encoding model: %w", err)
+	}
+
+	return &encodedModel{Type: name, Value: b}, nil
+}
+
+// decodeModel decodes a GTSModel encoded by encodeModel.
+func decodeModel(enc *encodedModel) (any, error) {
+	if enc == nil {
+		return nil, nil
+	}
+
+	newModel, ok := gtsModels[enc.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported model type %s", enc.Type)
+	}
+
+	model := newModel()
+	if err := json.Unmarshal(enc.Value, model); err != nil {
+		return nil, fmt.Errorf("error decoding model: %w", err)
+	}
+
+	return model, nil
+}
+
+// encodeAP encodes the given ActivityStreams type as JSON.
+func encodeAP(t vocab.Type) (json.RawMessage, error) {
+	m, err := ap.Serialize(t)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing object model: %w", err)
+	}
+	return json.Marshal(m)
+}
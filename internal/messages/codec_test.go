@@ -0,0 +1,123 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package messages_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+func TestFromClientAPICodec(t *testing.T) {
+	account := &gtsmodel.Account{
+		ID:       "01F8MH1H7YV1Z7D2C8K2730QBF",
+		Username: "the_mighty_zork",
+	}
+	status := &gtsmodel.Status{
+		ID:        "01F8MHAMCHF6Y650WCRSCP4WMY",
+		Content:   "hello everyone!",
+		AccountID: account.ID,
+	}
+
+	msg := &messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       status,
+		OriginAccount:  account,
+	}
+
+	b, err := msg.Encode()
+	require.NoError(t, err)
+
+	var fetched []string
+	getAccount := func(_ context.Context, id string) (*gtsmodel.Account, error) {
+		fetched = append(fetched, id)
+		return account, nil
+	}
+
+	var decoded messages.FromClientAPI
+	require.NoError(t, decoded.Decode(context.Background(), b, getAccount))
+
+	assert.Equal(t, ap.ObjectNote, decoded.APObjectType)
+	assert.Equal(t, ap.ActivityCreate, decoded.APActivityType)
+	assert.Equal(t, []string{account.ID}, fetched)
+	assert.Same(t, account, decoded.OriginAccount)
+	assert.Nil(t, decoded.TargetAccount)
+
+	decodedStatus, ok := decoded.GTSModel.(*gtsmodel.Status)
+	require.True(t, ok)
+	assert.Equal(t, status.ID, decodedStatus.ID)
+	assert.Equal(t, status.Content, decodedStatus.Content)
+}
+
+func TestFromClientAPIDecodeAccountError(t *testing.T) {
+	msg := &messages.FromClientAPI{
+		APObjectType:   ap.ActorPerson,
+		APActivityType: ap.ActivityUpdate,
+		TargetAccount:  &gtsmodel.Account{ID: "01F8MH17FWEB39HZJ76B6VXSKF"},
+	}
+
+	b, err := msg.Encode()
+	require.NoError(t, err)
+
+	errGone := errors.New("gone")
+	getAccount := func(context.Context, string) (*gtsmodel.Account, error) {
+		return nil, errGone
+	}
+
+	var decoded messages.FromClientAPI
+	err = decoded.Decode(context.Background(), b, getAccount)
+	assert.ErrorIs(t, err, errGone)
+}
+
+func TestFromFediAPICodec(t *testing.T) {
+	receiver := &gtsmodel.Account{ID: "01F8MH1H7YV1Z7D2C8K2730QBF"}
+	block := &gtsmodel.Block{
+		ID:              "01FEXXET6XXMF7G2V3ASZP3YQW",
+		AccountID:       "01F8MH5ZK5VRH73AKHQM6Y9VNX",
+		TargetAccountID: receiver.ID,
+	}
+
+	msg := &messages.FromFediAPI{
+		APObjectType:     ap.ActivityBlock,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         block,
+		ReceivingAccount: receiver,
+	}
+
+	b, err := msg.Encode()
+	require.NoError(t, err)
+
+	getAccount := func(_ context.Context, id string) (*gtsmodel.Account, error) {
+		assert.Equal(t, receiver.ID, id)
+		return receiver, nil
+	}
+
+	var decoded messages.FromFediAPI
+	require.NoError(t, decoded.Decode(context.Background(), b, getAccount))
+
+	assert.Same(t, receiver, decoded.ReceivingAccount)
+	assert.Nil(t, decoded.APObjectModel)
+	assert.Equal(t, block, decoded.GTSModel)
+}
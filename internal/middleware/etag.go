@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ETag returns a new gin middleware which sets an ETag, computed from
+// a hash of the body, on successful responses to GET requests. If the
+// request's If-None-Match header matches the ETag, 304 Not Modified is
+// served instead of the body. This saves bandwidth for clients polling
+// relatively static resources, though the handler still does its work.
+//
+// Since the client API otherwise serves responses as no-store, this
+// also allows clients to store responses, provided they revalidate
+// them first, and keeps them out of shared caches.
+func ETag() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		// Buffer the body written
+		// by subsequent handlers.
+		w := &bufferedWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+		c.Next()
+		c.Writer = w.ResponseWriter
+
+		if c.Writer.Status() != http.StatusOK {
+			// Only tag successful responses.
+			_, _ = c.Writer.Write(w.body.Bytes())
+			return
+		}
+
+		// Weak, since the body may
+		// get compressed after this.
+		sum := sha256.Sum256(w.body.Bytes())
+		eTag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+
+		c.Header("ETag", eTag)
+		c.Header("Cache-Control", "private, no-cache")
+		c.Writer.Header().Add("Vary", "Authorization")
+
+		if eTagMatches(c.GetHeader("If-None-Match"), eTag) {
+			c.Writer.WriteHeader(http.StatusNotModified)
+			c.Writer.WriteHeaderNow()
+			return
+		}
+
+		_, _ = c.Writer.Write(w.body.Bytes())
+	}
+}
+
+// eTagMatches returns true if the given If-None-Match
+// header value matches the given ETag, using weak
+// comparison as is appropriate for If-None-Match.
+//
+// See: https://www.rfc-editor.org/rfc/rfc9110#section-13.1.2
+func eTagMatches(ifNoneMatch string, eTag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+
+	eTag = strings.TrimPrefix(eTag, "W/")
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == eTag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bufferedWriter wraps a gin.ResponseWriter,
+// buffering the body instead of writing it.
+type bufferedWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) WriteString(s string) (int, error) {
+	return w.body.WriteString(s)
+}
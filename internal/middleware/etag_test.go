@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+)
+
+type ETagTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ETagTestSuite) serve(engine *gin.Engine, path string, ifNoneMatch string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodGet, path, nil)
+	if ifNoneMatch != "" {
+		request.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	engine.ServeHTTP(recorder, request)
+	return recorder
+}
+
+func (suite *ETagTestSuite) TestETag() {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	body := gin.H{"title": "GoToSocial"}
+
+	engine := gin.New()
+	engine.GET("/api/v1/instance", middleware.ETag(), func(c *gin.Context) {
+		c.JSON(http.StatusOK, body)
+	})
+	engine.GET("/api/v1/accounts/lookup", middleware.ETag(), func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Not Found"})
+	})
+
+	// First request gets the body and an ETag.
+	recorder := suite.serve(engine, "/api/v1/instance", "")
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`{"title":"GoToSocial"}`, recorder.Body.String())
+	suite.Equal("private, no-cache", recorder.Header().Get("Cache-Control"))
+	eTag := recorder.Header().Get("ETag")
+	suite.Regexp(`^W/"[0-9a-f]{32}"$`, eTag)
+
+	// Revalidating gets nothing new.
+	recorder = suite.serve(engine, "/api/v1/instance", eTag)
+	suite.Equal(http.StatusNotModified, recorder.Code)
+	suite.Empty(recorder.Body.String())
+	suite.Equal(eTag, recorder.Header().Get("ETag"))
+
+	// Strong comparison of a weak tag
+	// among others still matches.
+	recorder = suite.serve(engine, "/api/v1/instance", `"nope", `+eTag[2:])
+	suite.Equal(http.StatusNotModified, recorder.Code)
+
+	// Once the resource changes, so does the ETag.
+	body["title"] = "GoToSocial!"
+	recorder = suite.serve(engine, "/api/v1/instance", eTag)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(`{"title":"GoToSocial!"}`, recorder.Body.String())
+	suite.NotEqual(eTag, recorder.Header().Get("ETag"))
+
+	// Errors aren't tagged.
+	recorder = suite.serve(engine, "/api/v1/accounts/lookup", "*")
+	suite.Equal(http.StatusNotFound, recorder.Code)
+	suite.Equal(`{"error":"Not Found"}`, recorder.Body.String())
+	suite.Empty(recorder.Header().Get("ETag"))
+}
+
+func TestETagTestSuite(t *testing.T) {
+	suite.Run(t, new(ETagTestSuite))
+}
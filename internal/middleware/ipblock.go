@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// IPBlock returns a gin middleware which rejects requests from
+// client IPs that are covered by an ip block with severity
+// no_access, as reported by match. Sign-up blocks are enforced
+// by the sign-up processor instead, since they don't apply to
+// any other kind of request.
+//
+// Rejected requests receive HTTP response code 403: Forbidden,
+// with instanceGet used to render the error page.
+//
+// If match returns an error, the request is allowed through
+// rather than locking everyone out of the instance.
+func IPBlock(
+	match func(context.Context, netip.Addr) (*gtsmodel.IPBlock, error),
+	instanceGet func(context.Context) (*apimodel.InstanceV1, gtserror.WithCode),
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		addr, err := netip.ParseAddr(c.ClientIP())
+		if err != nil {
+			// Nothing to check against.
+			return
+		}
+
+		block, err := match(c.Request.Context(), addr)
+		if err != nil {
+			log.Errorf(c.Request.Context(), "error matching ip blocks: %v", err)
+			return
+		}
+
+		if block == nil || block.Severity != gtsmodel.IPBlockNoAccess {
+			return
+		}
+
+		const text = "access from your IP address is not allowed"
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(errors.New(text), text), instanceGet)
+		c.Abort()
+	}
+}
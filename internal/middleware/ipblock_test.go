@@ -0,0 +1,79 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+)
+
+type IPBlockTestSuite struct {
+	suite.Suite
+}
+
+func (suite *IPBlockTestSuite) TestIPBlock() {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	blocks := map[string]*gtsmodel.IPBlock{
+		"192.0.2.1":   {IP: "192.0.2.0/24", Severity: gtsmodel.IPBlockSignUpBlock},
+		"192.0.2.200": {IP: "192.0.2.128/25", Severity: gtsmodel.IPBlockNoAccess},
+	}
+
+	match := func(_ context.Context, addr netip.Addr) (*gtsmodel.IPBlock, error) {
+		return blocks[addr.String()], nil
+	}
+
+	for _, test := range []struct {
+		remoteAddr string
+		expectCode int
+	}{
+		{"198.51.100.1:1234", http.StatusOK},
+		{"192.0.2.1:1234", http.StatusOK}, // sign-up block only
+		{"192.0.2.200:1234", http.StatusForbidden},
+	} {
+		engine := gin.New()
+		engine.Use(middleware.IPBlock(match, instanceGet))
+		engine.GET("/api/v1/timelines/home", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/api/v1/timelines/home", nil)
+		request.RemoteAddr = test.remoteAddr
+		engine.ServeHTTP(recorder, request)
+
+		suite.Equal(test.expectCode, recorder.Code, test.remoteAddr)
+
+		if test.expectCode == http.StatusForbidden {
+			suite.JSONEq(`{"error":"Forbidden: access from your IP address is not allowed"}`, recorder.Body.String())
+		}
+	}
+}
+
+func TestIPBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(IPBlockTestSuite))
+}
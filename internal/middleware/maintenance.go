@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// defaultMaintenanceRetryAfter is the Retry-After value sent
+// to rejected requests when maintenance has no scheduled end.
+const defaultMaintenanceRetryAfter = 5 * time.Minute
+
+// Maintenance returns a gin middleware which rejects requests that would
+// modify data (ie., anything other than GET, HEAD, or OPTIONS) while the
+// instance is in read-only maintenance mode, as reported by maintenance.
+//
+// Rejected requests receive HTTP response code 503: Service Unavailable,
+// with a Retry-After header set to the time remaining until maintenance
+// ends (or a default value if maintenance has no scheduled end).
+//
+// Requests with a path beginning with one of the exempt prefixes are
+// always allowed through, so that eg., admins can end maintenance early.
+//
+// instanceGet is used to render the error page for rejected requests.
+func Maintenance(
+	maintenance func() (bool, time.Time),
+	instanceGet func(context.Context) (*apimodel.InstanceV1, gtserror.WithCode),
+	exemptPrefixes ...string,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		switch c.Request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			// Read-only request.
+			return
+		}
+
+		for _, prefix := range exemptPrefixes {
+			if strings.HasPrefix(c.Request.URL.Path, prefix) {
+				return
+			}
+		}
+
+		active, until := maintenance()
+		if !active {
+			return
+		}
+
+		retryAfter := defaultMaintenanceRetryAfter
+		if !until.IsZero() {
+			retryAfter = time.Until(until)
+		}
+
+		c.Header("Retry-After", strconv.FormatInt(int64(retryAfter/time.Second)+1, 10))
+		const text = "instance is in read-only maintenance mode"
+		apiutil.ErrorHandler(c, gtserror.NewErrorServiceUnavailable(errors.New(text), text), instanceGet)
+		c.Abort()
+	}
+}
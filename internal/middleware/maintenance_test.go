@@ -0,0 +1,119 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+)
+
+// instanceGet is a stand-in for the instance
+// processor, used when rendering error pages.
+func instanceGet(context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+	return &apimodel.InstanceV1{}, nil
+}
+
+type MaintenanceTestSuite struct {
+	suite.Suite
+}
+
+func (suite *MaintenanceTestSuite) TestMaintenance() {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	type maintenanceTest struct {
+		active     bool
+		until      time.Time
+		method     string
+		path       string
+		expectCode int
+	}
+
+	for _, test := range []maintenanceTest{
+		{
+			// Not in maintenance, writes allowed.
+			active:     false,
+			method:     http.MethodPost,
+			path:       "/api/v1/statuses",
+			expectCode: http.StatusOK,
+		},
+		{
+			// In maintenance, reads allowed.
+			active:     true,
+			method:     http.MethodGet,
+			path:       "/api/v1/statuses",
+			expectCode: http.StatusOK,
+		},
+		{
+			// In maintenance, writes rejected.
+			active:     true,
+			until:      time.Now().Add(time.Hour),
+			method:     http.MethodPost,
+			path:       "/api/v1/statuses",
+			expectCode: http.StatusServiceUnavailable,
+		},
+		{
+			// In maintenance with no end, writes rejected.
+			active:     true,
+			method:     http.MethodDelete,
+			path:       "/api/v1/statuses",
+			expectCode: http.StatusServiceUnavailable,
+		},
+		{
+			// In maintenance, exempt writes allowed.
+			active:     true,
+			method:     http.MethodDelete,
+			path:       "/api/v1/admin/announcements",
+			expectCode: http.StatusOK,
+		},
+	} {
+		maintenance := func() (bool, time.Time) {
+			return test.active, test.until
+		}
+
+		engine := gin.New()
+		engine.Use(middleware.Maintenance(maintenance, instanceGet, "/api/v1/admin"))
+		engine.Handle(test.method, test.path, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(test.method, test.path, nil)
+		engine.ServeHTTP(recorder, request)
+
+		suite.Equal(test.expectCode, recorder.Code)
+
+		if test.expectCode == http.StatusServiceUnavailable {
+			suite.NotEmpty(recorder.Header().Get("Retry-After"))
+			suite.JSONEq(`{"error":"Service Unavailable: instance is in read-only maintenance mode"}`, recorder.Body.String())
+		}
+	}
+}
+
+func TestMaintenanceTestSuite(t *testing.T) {
+	suite.Run(t, new(MaintenanceTestSuite))
+}
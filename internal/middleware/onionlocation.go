@@ -0,0 +1,45 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// OnionLocation returns a new gin middleware which advertises
+// the instance's onion service address to Tor Browser users, by
+// setting the Onion-Location header on responses to GET requests
+// made to the clearnet host. Browsers only act on this header for
+// HTML pages, so it's harmless to set it on other responses too.
+//
+// See: https://community.torproject.org/onion-services/advanced/onion-location/
+func OnionLocation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet ||
+			uris.IsOnionHost(c.Request.Host) {
+			return
+		}
+
+		if location := uris.OnionLocation(c.Request.URL.RequestURI()); location != "" {
+			c.Header("Onion-Location", location)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+)
+
+type OnionLocationTestSuite struct {
+	suite.Suite
+}
+
+func (suite *OnionLocationTestSuite) TestOnionLocation() {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	const onion = "abcdefghijklmnop.onion"
+
+	type onionLocationTest struct {
+		onionHost      string
+		method         string
+		host           string
+		target         string
+		expectLocation string
+	}
+
+	for _, test := range []onionLocationTest{
+		{
+			// Clearnet page, onion advertised.
+			onionHost:      onion,
+			method:         http.MethodGet,
+			host:           "example.org",
+			target:         "/@the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY?page=2",
+			expectLocation: "http://" + onion + "/@the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY?page=2",
+		},
+		{
+			// Already on the onion service.
+			onionHost: onion,
+			method:    http.MethodGet,
+			host:      onion,
+			target:    "/about",
+		},
+		{
+			// Not a page load.
+			onionHost: onion,
+			method:    http.MethodPost,
+			host:      "example.org",
+			target:    "/about",
+		},
+		{
+			// No onion service configured.
+			method: http.MethodGet,
+			host:   "example.org",
+			target: "/about",
+		},
+	} {
+		config.SetOnionHost(test.onionHost)
+
+		engine := gin.New()
+		engine.Use(middleware.OnionLocation())
+		engine.Handle(test.method, "/*path", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		recorder := httptest.NewRecorder()
+		request := httptest.NewRequest(test.method, test.target, nil)
+		request.Host = test.host
+		engine.ServeHTTP(recorder, request)
+
+		suite.Equal(http.StatusOK, recorder.Code)
+		suite.Equal(test.expectLocation, recorder.Header().Get("Onion-Location"))
+	}
+
+	config.SetOnionHost("")
+}
+
+func TestOnionLocationTestSuite(t *testing.T) {
+	suite.Run(t, new(OnionLocationTestSuite))
+}
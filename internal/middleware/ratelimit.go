@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"net/netip"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -153,3 +154,42 @@ func RateLimit(limit int, exceptions []string) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// ReloadableRateLimit wraps a RateLimit middleware so that
+// its limit and exceptions can be changed at runtime, eg.,
+// after a config reload. Reloading replaces the underlying
+// limiter, so counts for all callers start again from zero.
+type ReloadableRateLimit struct {
+	handler atomic.Pointer[gin.HandlerFunc]
+}
+
+// NewReloadableRateLimit returns a new ReloadableRateLimit,
+// initially using the given limit and exceptions.
+func NewReloadableRateLimit(limit int, exceptions []string) *ReloadableRateLimit {
+	r := new(ReloadableRateLimit)
+	handler := RateLimit(limit, exceptions)
+	r.handler.Store(&handler)
+	return r
+}
+
+// Reload replaces the underlying limiter with one
+// using the given limit and exceptions. If any of the
+// exceptions can't be parsed, the current limiter is kept.
+func (r *ReloadableRateLimit) Reload(limit int, exceptions []string) error {
+	for _, str := range exceptions {
+		if _, err := netip.ParsePrefix(str); err != nil {
+			return err
+		}
+	}
+	handler := RateLimit(limit, exceptions)
+	r.handler.Store(&handler)
+	return nil
+}
+
+// Handler returns a gin middleware which
+// delegates to the current rate limiter.
+func (r *ReloadableRateLimit) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		(*r.handler.Load())(c)
+	}
+}
@@ -66,8 +66,10 @@ func NewRequestID() string {
 func AddRequestID(header string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		id := c.GetHeader(header)
-		// Have we found anything?
-		if id == "" {
+		// Have we found anything usable? As the ID
+		// is logged and returned to the caller in
+		// error responses, don't accept just anything.
+		if !validRequestID(id) {
 			// Generate new ID.
 			id = NewRequestID()
 
@@ -84,3 +86,24 @@ func AddRequestID(header string) gin.HandlerFunc {
 		c.Writer.Header().Set(header, id)
 	}
 }
+
+// validRequestID returns whether the given request ID, as
+// provided by a reverse proxy or the caller, is non-empty,
+// reasonably short, and contains only characters which are
+// safe to include in logs and error responses.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > 64 {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		switch c := id[i]; {
+		case c >= 'a' && c <= 'z',
+			c >= 'A' && c <= 'Z',
+			c >= '0' && c <= '9',
+			c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
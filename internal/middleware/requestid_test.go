@@ -0,0 +1,105 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package middleware_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/middleware"
+)
+
+type RequestIDTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RequestIDTestSuite) TestRequestID() {
+	// Suppress warnings about debug mode.
+	gin.SetMode(gin.ReleaseMode)
+
+	const header = "X-Request-Id"
+
+	type requestIDTest struct {
+		incoming string
+		expectID string // empty if a new ID should be generated
+	}
+
+	for _, test := range []requestIDTest{
+		{
+			// No incoming ID.
+			incoming: "",
+		},
+		{
+			// Usable incoming ID is kept.
+			incoming: "01HQ4Z6WJ7-proxy_1.2:3",
+			expectID: "01HQ4Z6WJ7-proxy_1.2:3",
+		},
+		{
+			// Incoming ID with unsafe characters is replaced.
+			incoming: `"><script>alert(1)</script>`,
+		},
+		{
+			// Overlong incoming ID is replaced.
+			incoming: strings.Repeat("a", 65),
+		},
+	} {
+		engine := gin.New()
+		engine.Use(middleware.AddRequestID(header))
+		engine.GET("/api/v1/statuses", func(c *gin.Context) {
+			err := errors.New("oh no")
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), nil)
+		})
+
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/statuses", nil)
+		r.Header.Set("Accept", "application/json")
+		if test.incoming != "" {
+			r.Header.Set(header, test.incoming)
+		}
+
+		rec := httptest.NewRecorder()
+		engine.ServeHTTP(rec, r)
+
+		id := rec.Header().Get(header)
+		if test.expectID != "" {
+			suite.Equal(test.expectID, id)
+		} else {
+			suite.NotEmpty(id)
+			suite.NotEqual(test.incoming, id)
+		}
+
+		// Error response body should include the same ID.
+		var body map[string]string
+		if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+			suite.FailNow(err.Error())
+		}
+		suite.Equal("Bad Request: oh no", body["error"])
+		suite.Equal(id, body["request_id"])
+	}
+}
+
+func TestRequestIDTestSuite(t *testing.T) {
+	suite.Run(t, &RequestIDTestSuite{})
+}
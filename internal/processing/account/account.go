@@ -18,6 +18,9 @@
 package account
 
 import (
+	"path/filepath"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
@@ -44,6 +47,7 @@ type Processor struct {
 	formatter    *text.Formatter
 	federator    *federation.Federator
 	parseMention gtsmodel.ParseMentionFunc
+	themes       *themes
 }
 
 // New returns a new account processor.
@@ -67,5 +71,6 @@ func New(
 		formatter:    text.NewFormatter(state.DB),
 		federator:    federator,
 		parseMention: parseMention,
+		themes:       loadThemes(filepath.Join(config.GetWebAssetBaseDir(), themesDir)),
 	}
 }
@@ -0,0 +1,185 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+)
+
+// maxAliases is the maximum number of
+// alsoKnownAs URIs one account may have.
+const maxAliases = 5
+
+// AliasesGet returns the aliases (alsoKnownAs URIs)
+// of the requesting account, with the aliased
+// account for each, if it's known to this instance.
+func (p *Processor) AliasesGet(ctx context.Context, requestingAccount *gtsmodel.Account) ([]*apimodel.AccountAlias, gtserror.WithCode) {
+	return p.apiAliases(ctx, requestingAccount), nil
+}
+
+// AliasAdd adds the account with the given URI as an alias of the
+// requesting account, after checking that the aliased account has
+// itself listed the requesting account in its alsoKnownAs property.
+func (p *Processor) AliasAdd(ctx context.Context, requestingAccount *gtsmodel.Account, uriStr string) ([]*apimodel.AccountAlias, gtserror.WithCode) {
+	uri, err := url.Parse(uriStr)
+	if err != nil || !uri.IsAbs() || (uri.Scheme != "http" && uri.Scheme != "https") {
+		err := fmt.Errorf("%s is not a valid account URI", uriStr)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	uriStr = uri.String()
+
+	if uriStr == requestingAccount.URI {
+		const text = "an account cannot be an alias of itself"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	for _, alias := range requestingAccount.AlsoKnownAsURIs {
+		if alias == uriStr {
+			// Already an alias,
+			// nothing to do.
+			return p.apiAliases(ctx, requestingAccount), nil
+		}
+	}
+
+	if len(requestingAccount.AlsoKnownAsURIs) >= maxAliases {
+		err := fmt.Errorf("accounts may have at most %d aliases", maxAliases)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Make sure the target account exists, and
+	// that it's aliased back to this account.
+	target, apubAcc, err := p.federator.GetAccountByURI(ctx, requestingAccount.Username, uri)
+	if err != nil {
+		err := fmt.Errorf("could not resolve account %s: %w", uriStr, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if !target.IsLocal() && !aliasesAccount(target, requestingAccount) && apubAcc == nil {
+		// We may have only had an old copy of this account
+		// cached, so force a refresh to check for changes.
+		target, _, err = p.federator.RefreshAccount(ctx, requestingAccount.Username, target, nil, true)
+		if err != nil {
+			err := fmt.Errorf("could not refresh account %s: %w", uriStr, err)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+	}
+
+	if !aliasesAccount(target, requestingAccount) {
+		err := fmt.Errorf(
+			"account %s does not list %s in its aliases (alsoKnownAs); add the alias there first",
+			uriStr, requestingAccount.URI,
+		)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	requestingAccount.AlsoKnownAsURIs = append(requestingAccount.AlsoKnownAsURIs, uriStr)
+	if errWithCode := p.updateAliases(ctx, requestingAccount); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAliases(ctx, requestingAccount), nil
+}
+
+// AliasRemove removes the given URI from the
+// aliases (alsoKnownAs URIs) of the requesting account.
+func (p *Processor) AliasRemove(ctx context.Context, requestingAccount *gtsmodel.Account, uriStr string) ([]*apimodel.AccountAlias, gtserror.WithCode) {
+	aliases := make([]string, 0, len(requestingAccount.AlsoKnownAsURIs))
+	for _, alias := range requestingAccount.AlsoKnownAsURIs {
+		if alias != uriStr {
+			aliases = append(aliases, alias)
+		}
+	}
+
+	if len(aliases) == len(requestingAccount.AlsoKnownAsURIs) {
+		err := fmt.Errorf("%s is not an alias of this account", uriStr)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	requestingAccount.AlsoKnownAsURIs = aliases
+	if errWithCode := p.updateAliases(ctx, requestingAccount); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiAliases(ctx, requestingAccount), nil
+}
+
+// updateAliases stores the updated aliases of the given
+// account, and federates the change out to other instances.
+func (p *Processor) updateAliases(ctx context.Context, account *gtsmodel.Account) gtserror.WithCode {
+	if err := p.state.DB.UpdateAccount(ctx, account, "also_known_as_uris"); err != nil {
+		err = gtserror.Newf("db error updating account aliases: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       account,
+		OriginAccount:  account,
+	})
+
+	return nil
+}
+
+// apiAliases converts the aliases of the given account
+// to their frontend representation, resolving each aliased
+// account from the database where possible.
+func (p *Processor) apiAliases(ctx context.Context, account *gtsmodel.Account) []*apimodel.AccountAlias {
+	apiAliases := make([]*apimodel.AccountAlias, 0, len(account.AlsoKnownAsURIs))
+	for _, uri := range account.AlsoKnownAsURIs {
+		apiAlias := &apimodel.AccountAlias{URI: uri}
+		apiAliases = append(apiAliases, apiAlias)
+
+		aliased, err := p.state.DB.GetAccountByURI(ctx, uri)
+		if err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				log.Errorf(ctx, "db error getting aliased account %s: %v", uri, err)
+			}
+			continue
+		}
+
+		apiAlias.Account, err = p.converter.AccountToAPIAccountPublic(ctx, aliased)
+		if err != nil {
+			log.Errorf(ctx, "error converting aliased account %s: %v", uri, err)
+		}
+	}
+
+	return apiAliases
+}
+
+// aliasesAccount returns whether account
+// lists target in its alsoKnownAs URIs.
+func aliasesAccount(account *gtsmodel.Account, target *gtsmodel.Account) bool {
+	for _, alias := range account.AlsoKnownAsURIs {
+		if alias == target.URI {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AliasTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AliasTestSuite) TestAliasAddRemove() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		targetAccount     = suite.testAccounts["local_account_2"]
+	)
+
+	// Turtle aliases zork first.
+	targetAccount.AlsoKnownAsURIs = []string{requestingAccount.URI}
+	if err := suite.db.UpdateAccount(ctx, targetAccount, "also_known_as_uris"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	aliases, errWithCode := suite.accountProcessor.AliasAdd(ctx, requestingAccount, targetAccount.URI)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !suite.Len(aliases, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal(targetAccount.URI, aliases[0].URI)
+	suite.Equal("1happyturtle", aliases[0].Account.Acct)
+
+	dbAccount, err := suite.db.GetAccountByID(ctx, requestingAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{targetAccount.URI}, dbAccount.AlsoKnownAsURIs)
+
+	// Now remove it again.
+	aliases, errWithCode = suite.accountProcessor.AliasRemove(ctx, requestingAccount, targetAccount.URI)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(aliases)
+
+	dbAccount, err = suite.db.GetAccountByID(ctx, requestingAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(dbAccount.AlsoKnownAsURIs)
+}
+
+func (suite *AliasTestSuite) TestAliasAddNoBackReference() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		targetAccount     = suite.testAccounts["admin_account"]
+	)
+
+	// Admin doesn't alias zork, so this should fail.
+	_, errWithCode := suite.accountProcessor.AliasAdd(ctx, requestingAccount, targetAccount.URI)
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Empty(requestingAccount.AlsoKnownAsURIs)
+}
+
+func (suite *AliasTestSuite) TestAliasAddSelf() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	_, errWithCode := suite.accountProcessor.AliasAdd(ctx, requestingAccount, requestingAccount.URI)
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *AliasTestSuite) TestAliasRemoveNotAlias() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	_, errWithCode := suite.accountProcessor.AliasRemove(ctx, requestingAccount, "https://example.org/users/nobody")
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestAliasTestSuite(t *testing.T) {
+	suite.Run(t, new(AliasTestSuite))
+}
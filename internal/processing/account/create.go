@@ -20,6 +20,8 @@ package account
 import (
 	"context"
 	"fmt"
+	"net/netip"
+	"strings"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -41,6 +43,10 @@ func (p *Processor) Create(
 	app *gtsmodel.Application,
 	form *apimodel.AccountCreateRequest,
 ) (*apimodel.Token, gtserror.WithCode) {
+	if errWithCode := p.checkSignUpAllowed(ctx, form); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	emailAvailable, err := p.state.DB.IsEmailAvailable(ctx, form.Email)
 	if err != nil {
 		err := fmt.Errorf("db error checking email availability: %w", err)
@@ -106,3 +112,46 @@ func (p *Processor) Create(
 		CreatedAt:   accessToken.GetAccessCreateAt().Unix(),
 	}, nil
 }
+
+// checkSignUpAllowed checks the given sign-up form against
+// ip blocks, email domain blocks, and the sign-up challenge,
+// returning an error if the sign-up should be rejected.
+func (p *Processor) checkSignUpAllowed(ctx context.Context, form *apimodel.AccountCreateRequest) gtserror.WithCode {
+	addr, ok := netip.AddrFromSlice(form.IP)
+	if !ok {
+		err := fmt.Errorf("ip %s could not be parsed", form.IP)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	addr = addr.Unmap()
+
+	// Any kind of block on the
+	// ip also blocks sign-ups.
+	block, err := p.state.DB.MatchIPBlock(ctx, addr)
+	if err != nil {
+		err := gtserror.Newf("db error matching ip blocks: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if block != nil {
+		const text = "sign-ups from your IP address are not allowed"
+		err := fmt.Errorf("ip %s matched ip block %s", addr, block.ID)
+		return gtserror.NewErrorForbidden(err, text)
+	}
+
+	// Email is already validated
+	// so it's safe to split it.
+	_, domain, _ := strings.Cut(form.Email, "@")
+
+	blocked, err := p.state.DB.IsEmailDomainBlocked(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("db error checking email domain block: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		err := fmt.Errorf("sign-ups using email addresses at %s are not allowed", domain)
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return p.c.VerifyChallenge(ctx, form.ChallengeResponse, addr)
+}
@@ -0,0 +1,152 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type CreateTestSuite struct {
+	AccountStandardTestSuite
+}
+
+// fakeChallenge passes responses equal to "passed".
+type fakeChallenge struct{}
+
+func (fakeChallenge) Provider() string { return "fake" }
+
+func (fakeChallenge) SiteKey() string { return "fake-site-key" }
+
+func (fakeChallenge) Verify(_ context.Context, response string, _ netip.Addr) (bool, error) {
+	return response == "passed", nil
+}
+
+func (suite *CreateTestSuite) create(form *apimodel.AccountCreateRequest) (*apimodel.Token, int) {
+	token, errWithCode := suite.accountProcessor.Create(
+		context.Background(),
+		oauth.DBTokenToToken(suite.testTokens["local_account_1_client_application_token"]),
+		suite.testApplications["application_1"],
+		form,
+	)
+	if errWithCode != nil {
+		return nil, errWithCode.Code()
+	}
+	return token, http.StatusOK
+}
+
+func (suite *CreateTestSuite) newForm() *apimodel.AccountCreateRequest {
+	return &apimodel.AccountCreateRequest{
+		Username:  "new_person",
+		Email:     "new_person@example.org",
+		Password:  "a very strong password indeed",
+		Agreement: true,
+		Locale:    "en",
+		IP:        net.ParseIP("192.0.2.1"),
+	}
+}
+
+func (suite *CreateTestSuite) TestCreateIPBlocked() {
+	for _, severity := range []gtsmodel.IPBlockSeverity{
+		gtsmodel.IPBlockSignUpBlock,
+		gtsmodel.IPBlockNoAccess,
+	} {
+		block := &gtsmodel.IPBlock{
+			ID:                 "01HF2A3ZFKNMK3Q5VMB4MSBP1W",
+			IP:                 "192.0.2.0/24",
+			Severity:           severity,
+			CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		}
+		if err := suite.db.PutIPBlock(context.Background(), block); err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		_, code := suite.create(suite.newForm())
+		suite.Equal(http.StatusForbidden, code, severity)
+
+		if err := suite.db.DeleteIPBlockByID(context.Background(), block.ID); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+}
+
+func (suite *CreateTestSuite) TestCreateIPBlockExpired() {
+	if err := suite.db.PutIPBlock(context.Background(), &gtsmodel.IPBlock{
+		ID:                 "01HF2A3ZFKNMK3Q5VMB4MSBP1W",
+		IP:                 "192.0.2.0/24",
+		Severity:           gtsmodel.IPBlockSignUpBlock,
+		ExpiresAt:          time.Now().Add(-time.Minute),
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	token, code := suite.create(suite.newForm())
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(token.AccessToken)
+}
+
+func (suite *CreateTestSuite) TestCreateEmailDomainBlocked() {
+	if err := suite.db.PutEmailDomainBlock(context.Background(), &gtsmodel.EmailDomainBlock{
+		ID:                 "01HF2A3ZFKNMK3Q5VMB4MSBP1W",
+		Domain:             "example.org",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form := suite.newForm()
+	form.Email = "new_person@mail.example.org"
+
+	_, code := suite.create(form)
+	suite.Equal(http.StatusUnprocessableEntity, code)
+}
+
+func (suite *CreateTestSuite) TestCreateChallenge() {
+	suite.state.Challenge = fakeChallenge{}
+	defer func() { suite.state.Challenge = nil }()
+
+	// No response given.
+	_, code := suite.create(suite.newForm())
+	suite.Equal(http.StatusBadRequest, code)
+
+	// Challenge failed.
+	form := suite.newForm()
+	form.ChallengeResponse = "failed"
+	_, code = suite.create(form)
+	suite.Equal(http.StatusForbidden, code)
+
+	// Challenge passed.
+	form.ChallengeResponse = "passed"
+	token, code := suite.create(form)
+	suite.Equal(http.StatusOK, code)
+	suite.NotEmpty(token.AccessToken)
+}
+
+func TestCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateTestSuite))
+}
@@ -423,6 +423,54 @@ func (p *Processor) deleteAccountPeripheral(ctx context.Context, account *gtsmod
 		return err
 	}
 
+	// Delete all reactions targeting given account.
+	if err := p.state.DB.DeleteStatusReactions(ctx, account.ID, ""); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete all reactions owned by given account.
+	if err := p.state.DB.DeleteStatusReactions(ctx, "", account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete all drafts owned by given account.
+	if err := p.state.DB.DeleteDraftsForAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete all saved searches owned by given account.
+	if err := p.state.DB.DeleteSavedSearchesForAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete all tags featured by given account.
+	if err := p.state.DB.DeleteFeaturedTagsForAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete all first interactions with or from given account.
+	if err := p.state.DB.DeleteAccountFirstInteractions(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete highlights made for given account.
+	if err := p.state.DB.DeleteHighlightsByAccountID(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	// Delete all mutes owned by or targeting given account.
+	if err := p.state.DB.DeleteAccountMutes(ctx, account.ID); // nocollapse
+	err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
 	// TODO: add status mutes here when they're implemented.
 
 	return nil
@@ -456,12 +504,14 @@ func stubbifyAccount(account *gtsmodel.Account, origin string) []string {
 	account.Note = ""
 	account.NoteRaw = ""
 	account.Memorial = util.Ptr(false)
-	account.AlsoKnownAs = ""
+	account.AlsoKnownAsURIs = nil
 	account.MovedToAccountID = ""
 	account.Reason = ""
 	account.Discoverable = util.Ptr(false)
+	account.Indexable = util.Ptr(false)
 	account.StatusContentType = ""
 	account.CustomCSS = ""
+	account.Theme = ""
 	account.SuspendedAt = now
 	account.SuspensionOrigin = origin
 	account.HideCollections = util.Ptr(true)
@@ -479,12 +529,14 @@ func stubbifyAccount(account *gtsmodel.Account, origin string) []string {
 		"note",
 		"note_raw",
 		"memorial",
-		"also_known_as",
+		"also_known_as_uris",
 		"moved_to_account_id",
 		"reason",
 		"discoverable",
+		"indexable",
 		"status_content_type",
 		"custom_css",
+		"theme",
 		"suspended_at",
 		"suspension_origin",
 		"hide_collections",
@@ -0,0 +1,147 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// maxFeaturedTags is the maximum number of tags that
+// one account may feature. Keep in sync with the
+// max_featured_tags served in the instance configuration.
+const maxFeaturedTags = 10
+
+// FeaturedTagsGet returns the tags featured by the target account,
+// in the order they were featured, if it's visible to the requesting
+// account. Requesting account may be nil, for public web views.
+//
+// Only local accounts can have featured tags; remote accounts'
+// featured tags are not dereferenced, so none are returned.
+func (p *Processor) FeaturedTagsGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]*apimodel.FeaturedTag, gtserror.WithCode) {
+	targetAccount, errWithCode := p.c.GetVisibleTargetAccount(ctx, requestingAccount, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	featuredTags, err := p.state.DB.GetFeaturedTagsForAccountID(ctx, targetAccount.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return []*apimodel.FeaturedTag{}, nil
+		}
+		err = gtserror.Newf("db error getting featured tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFeaturedTags := make([]*apimodel.FeaturedTag, 0, len(featuredTags))
+	for _, featuredTag := range featuredTags {
+		apiFeaturedTag, err := p.converter.FeaturedTagToAPIFeaturedTag(ctx, featuredTag)
+		if err != nil {
+			err = gtserror.Newf("error converting featured tag %s: %w", featuredTag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiFeaturedTags = append(apiFeaturedTags, apiFeaturedTag)
+	}
+
+	return apiFeaturedTags, nil
+}
+
+// FeaturedTagCreate features the tag with the given
+// name on the profile of the requesting account.
+func (p *Processor) FeaturedTagCreate(ctx context.Context, requestingAccount *gtsmodel.Account, tagName string) (*apimodel.FeaturedTag, gtserror.WithCode) {
+	tag, errWithCode := p.c.GetOrCreateTag(ctx, tagName)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !*tag.Useable {
+		err := fmt.Errorf("tag %s cannot be used on this instance", tag.Name)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	featuredTags, err := p.state.DB.GetFeaturedTagsForAccountID(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting featured tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, featuredTag := range featuredTags {
+		if featuredTag.TagID == tag.ID {
+			err := fmt.Errorf("tag %s is already featured", tag.Name)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+	}
+
+	if len(featuredTags) >= maxFeaturedTags {
+		err := fmt.Errorf("featured tag limit reached, you can feature at most %d tags", maxFeaturedTags)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	featuredTag := &gtsmodel.FeaturedTag{
+		ID:        id.NewULID(),
+		AccountID: requestingAccount.ID,
+		Account:   requestingAccount,
+		TagID:     tag.ID,
+		Tag:       tag,
+	}
+
+	if err := p.state.DB.PutFeaturedTag(ctx, featuredTag); err != nil {
+		err = gtserror.Newf("db error putting featured tag: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFeaturedTag, err := p.converter.FeaturedTagToAPIFeaturedTag(ctx, featuredTag)
+	if err != nil {
+		err = gtserror.Newf("error converting featured tag %s: %w", featuredTag.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiFeaturedTag, nil
+}
+
+// FeaturedTagDelete stops featuring one tag
+// on the profile of the requesting account.
+func (p *Processor) FeaturedTagDelete(ctx context.Context, requestingAccount *gtsmodel.Account, featuredTagID string) gtserror.WithCode {
+	featuredTag, err := p.state.DB.GetFeaturedTagByID(ctx, featuredTagID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Featured tag doesn't seem to exist.
+			return gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if featuredTag.AccountID != requestingAccount.ID {
+		err = fmt.Errorf("featured tag with id %s does not belong to account %s", featuredTag.ID, requestingAccount.ID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.DeleteFeaturedTagByID(ctx, featuredTag.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
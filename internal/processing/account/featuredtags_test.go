@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FeaturedTagsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *FeaturedTagsTestSuite) TestFeaturedTagCreateGetDelete() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["admin_account"]
+		otherAccount      = suite.testAccounts["local_account_1"]
+	)
+
+	// Feature a tag the account has used.
+	welcome, errWithCode := suite.accountProcessor.FeaturedTagCreate(ctx, requestingAccount, "#Welcome")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("welcome", welcome.Name)
+	suite.Equal("http://localhost:8080/tags/welcome", welcome.URL)
+	suite.Equal(1, welcome.StatusesCount)
+	suite.Equal("2021-10-20T11:36:45.000Z", *welcome.LastStatusAt)
+
+	// Featuring it again should fail.
+	_, errWithCode = suite.accountProcessor.FeaturedTagCreate(ctx, requestingAccount, "welcome")
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// Feature a tag that doesn't exist yet.
+	sloths, errWithCode := suite.accountProcessor.FeaturedTagCreate(ctx, requestingAccount, "sloths")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("sloths", sloths.Name)
+	suite.Zero(sloths.StatusesCount)
+	suite.Nil(sloths.LastStatusAt)
+
+	// Other accounts should see them too, in the order they were featured.
+	featuredTags, errWithCode := suite.accountProcessor.FeaturedTagsGet(ctx, otherAccount, requestingAccount.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(featuredTags, 2)
+	suite.Equal(welcome.ID, featuredTags[0].ID)
+	suite.Equal(sloths.ID, featuredTags[1].ID)
+
+	// Other accounts can't delete them.
+	errWithCode = suite.accountProcessor.FeaturedTagDelete(ctx, otherAccount, welcome.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// The featuring account can.
+	errWithCode = suite.accountProcessor.FeaturedTagDelete(ctx, requestingAccount, welcome.ID)
+	suite.Nil(errWithCode)
+
+	featuredTags, errWithCode = suite.accountProcessor.FeaturedTagsGet(ctx, requestingAccount, requestingAccount.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(featuredTags, 1)
+	suite.Equal(sloths.ID, featuredTags[0].ID)
+}
+
+func (suite *FeaturedTagsTestSuite) TestFeaturedTagCreateInvalid() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	_, errWithCode := suite.accountProcessor.FeaturedTagCreate(ctx, requestingAccount, "not a tag")
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *FeaturedTagsTestSuite) TestFeaturedTagCreateLimit() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	for i := 0; i < 10; i++ {
+		_, errWithCode := suite.accountProcessor.FeaturedTagCreate(ctx, requestingAccount, fmt.Sprintf("tag%d", i))
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+	}
+
+	_, errWithCode := suite.accountProcessor.FeaturedTagCreate(ctx, requestingAccount, "onetoomany")
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: featured tag limit reached, you can feature at most 10 tags", errWithCode.Safe())
+}
+
+func TestFeaturedTagsTestSuite(t *testing.T) {
+	suite.Run(t, new(FeaturedTagsTestSuite))
+}
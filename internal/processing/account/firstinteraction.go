@@ -0,0 +1,180 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// FirstInteractionsGet returns the first interactions with
+// requestingAccount that are pending review, newest first.
+func (p *Processor) FirstInteractionsGet(ctx context.Context, requestingAccount *gtsmodel.Account) ([]*apimodel.FirstInteraction, gtserror.WithCode) {
+	firstInteractions, err := p.state.DB.GetPendingFirstInteractions(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting first interactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiFirstInteractions := make([]*apimodel.FirstInteraction, 0, len(firstInteractions))
+	for _, firstInteraction := range firstInteractions {
+		apiFirstInteraction, err := p.converter.FirstInteractionToAPIFirstInteraction(ctx, firstInteraction)
+		if err != nil {
+			err := gtserror.Newf("error converting first interaction %s: %w", firstInteraction.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiFirstInteractions = append(apiFirstInteractions, apiFirstInteraction)
+	}
+
+	return apiFirstInteractions, nil
+}
+
+// FirstInteractionApprove approves the pending first interaction with the
+// given ID, notifying requestingAccount of the held statuses, and letting
+// future statuses from the same remote account through without review.
+func (p *Processor) FirstInteractionApprove(ctx context.Context, requestingAccount *gtsmodel.Account, firstInteractionID string) (*apimodel.FirstInteraction, gtserror.WithCode) {
+	firstInteraction, errWithCode := p.getPendingFirstInteraction(ctx, requestingAccount, firstInteractionID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Convert before clearing held
+	// statuses, so the caller can
+	// see what was just approved.
+	apiFirstInteraction, err := p.converter.FirstInteractionToAPIFirstInteraction(ctx, firstInteraction)
+	if err != nil {
+		err := gtserror.Newf("error converting first interaction %s: %w", firstInteraction.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Copy the first interaction
+	// with its held statuses, for
+	// side effects to notify them.
+	held := *firstInteraction
+
+	firstInteraction.ApprovedAt = time.Now()
+	firstInteraction.StatusIDs = nil
+	firstInteraction.Statuses = nil
+	if err := p.state.DB.UpdateFirstInteraction(ctx, firstInteraction, "approved_at", "statuses"); err != nil {
+		err := gtserror.Newf("db error updating first interaction %s: %w", firstInteraction.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityAccept,
+		GTSModel:       &held,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  held.OriginAccount,
+	})
+
+	return apiFirstInteraction, nil
+}
+
+// FirstInteractionReport reports the remote account of the pending
+// first interaction with the given ID to the moderators of this instance,
+// attaching the held statuses, and removes the first interaction, so
+// that further statuses from the account are held for review again.
+func (p *Processor) FirstInteractionReport(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	firstInteractionID string,
+	form *apimodel.FirstInteractionReportRequest,
+) (*apimodel.Report, gtserror.WithCode) {
+	firstInteraction, errWithCode := p.getPendingFirstInteraction(ctx, requestingAccount, firstInteractionID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only attach held statuses
+	// which still exist.
+	statusIDs := make([]string, 0, len(firstInteraction.Statuses))
+	for _, status := range firstInteraction.Statuses {
+		statusIDs = append(statusIDs, status.ID)
+	}
+
+	reportID := id.NewULID()
+	report := &gtsmodel.Report{
+		ID:              reportID,
+		URI:             uris.GenerateURIForReport(reportID),
+		AccountID:       requestingAccount.ID,
+		Account:         requestingAccount,
+		TargetAccountID: firstInteraction.OriginAccountID,
+		TargetAccount:   firstInteraction.OriginAccount,
+		Comment:         form.Comment,
+		StatusIDs:       statusIDs,
+		Statuses:        firstInteraction.Statuses,
+		Forwarded:       &form.Forward,
+	}
+
+	if err := p.state.DB.PutReport(ctx, report); err != nil {
+		err := gtserror.Newf("db error putting report: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteFirstInteractionByID(ctx, firstInteraction.ID); err != nil {
+		err := gtserror.Newf("db error deleting first interaction %s: %w", firstInteraction.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityFlag,
+		GTSModel:       report,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  firstInteraction.OriginAccount,
+	})
+
+	apiReport, err := p.converter.ReportToAPIReport(ctx, report)
+	if err != nil {
+		err := gtserror.Newf("error converting report to frontend representation: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiReport, nil
+}
+
+// getPendingFirstInteraction gets the pending first interaction
+// with the given ID, checking that it's with requestingAccount.
+func (p *Processor) getPendingFirstInteraction(ctx context.Context, requestingAccount *gtsmodel.Account, firstInteractionID string) (*gtsmodel.FirstInteraction, gtserror.WithCode) {
+	firstInteraction, err := p.state.DB.GetFirstInteractionByID(ctx, firstInteractionID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting first interaction %s: %w", firstInteractionID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if firstInteraction == nil ||
+		firstInteraction.AccountID != requestingAccount.ID ||
+		!firstInteraction.Pending() {
+		// Don't reveal first interactions with others.
+		err := gtserror.Newf("pending first interaction %s not found", firstInteractionID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return firstInteraction, nil
+}
@@ -0,0 +1,163 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type FirstInteractionTestSuite struct {
+	AccountStandardTestSuite
+}
+
+// putFirstInteraction puts a pending first interaction of
+// foss_satan with zork, holding foss_satan's first status.
+func (suite *FirstInteractionTestSuite) putFirstInteraction() *gtsmodel.FirstInteraction {
+	firstInteraction := &gtsmodel.FirstInteraction{
+		ID:              id.NewULID(),
+		AccountID:       suite.testAccounts["local_account_1"].ID,
+		OriginAccountID: suite.testAccounts["remote_account_1"].ID,
+		StatusIDs:       []string{suite.testStatuses["remote_account_1_status_1"].ID},
+	}
+
+	if err := suite.db.PutFirstInteraction(context.Background(), firstInteraction); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return firstInteraction
+}
+
+func (suite *FirstInteractionTestSuite) TestFirstInteractionsGet() {
+	ctx := context.Background()
+	firstInteraction := suite.putFirstInteraction()
+
+	apiFirstInteractions, errWithCode := suite.accountProcessor.FirstInteractionsGet(ctx, suite.testAccounts["local_account_1"])
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !suite.Len(apiFirstInteractions, 1) {
+		suite.FailNow("")
+	}
+	suite.Equal(firstInteraction.ID, apiFirstInteractions[0].ID)
+	suite.Equal("foss_satan@fossbros-anonymous.io", apiFirstInteractions[0].Account.Acct)
+	if suite.Len(apiFirstInteractions[0].Statuses, 1) {
+		suite.Equal(suite.testStatuses["remote_account_1_status_1"].ID, apiFirstInteractions[0].Statuses[0].ID)
+	}
+
+	// Someone else shouldn't see it.
+	apiFirstInteractions, errWithCode = suite.accountProcessor.FirstInteractionsGet(ctx, suite.testAccounts["local_account_2"])
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiFirstInteractions)
+}
+
+func (suite *FirstInteractionTestSuite) TestFirstInteractionApprove() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		firstInteraction  = suite.putFirstInteraction()
+	)
+
+	apiFirstInteraction, errWithCode := suite.accountProcessor.FirstInteractionApprove(ctx, requestingAccount, firstInteraction.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Len(apiFirstInteraction.Statuses, 1)
+
+	// The first interaction should be
+	// approved, and held statuses cleared.
+	dbFirstInteraction, err := suite.db.GetFirstInteractionByID(ctx, firstInteraction.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(dbFirstInteraction.Pending())
+	suite.Empty(dbFirstInteraction.StatusIDs)
+
+	// Held statuses should be sent
+	// on for notifying, as they were.
+	msg := <-suite.fromClientAPIChan
+	suite.Equal(ap.ActivityAccept, msg.APActivityType)
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	held, ok := msg.GTSModel.(*gtsmodel.FirstInteraction)
+	if !ok {
+		suite.FailNow("")
+	}
+	suite.Equal(firstInteraction.StatusIDs, held.StatusIDs)
+
+	// Can't approve twice.
+	_, errWithCode = suite.accountProcessor.FirstInteractionApprove(ctx, requestingAccount, firstInteraction.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *FirstInteractionTestSuite) TestFirstInteractionApproveNotOwn() {
+	firstInteraction := suite.putFirstInteraction()
+
+	_, errWithCode := suite.accountProcessor.FirstInteractionApprove(
+		context.Background(),
+		suite.testAccounts["local_account_2"],
+		firstInteraction.ID,
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *FirstInteractionTestSuite) TestFirstInteractionReport() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		firstInteraction  = suite.putFirstInteraction()
+	)
+
+	apiReport, errWithCode := suite.accountProcessor.FirstInteractionReport(
+		ctx,
+		requestingAccount,
+		firstInteraction.ID,
+		&apimodel.FirstInteractionReportRequest{
+			Comment: "unsolicited spam",
+		},
+	)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Equal("unsolicited spam", apiReport.Comment)
+	suite.Equal(suite.testAccounts["remote_account_1"].ID, apiReport.TargetAccount.ID)
+	suite.Equal([]string{suite.testStatuses["remote_account_1_status_1"].ID}, apiReport.StatusIDs)
+
+	// The first interaction should be gone.
+	_, err := suite.db.GetFirstInteractionByID(ctx, firstInteraction.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	msg := <-suite.fromClientAPIChan
+	suite.Equal(ap.ActivityFlag, msg.APActivityType)
+	suite.Equal(ap.ObjectProfile, msg.APObjectType)
+}
+
+func TestFirstInteractionTestSuite(t *testing.T) {
+	suite.Run(t, new(FirstInteractionTestSuite))
+}
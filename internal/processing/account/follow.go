@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -79,6 +80,18 @@ func (p *Processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 		)
 	}
 
+	// Guard against bursts of follows, which
+	// suggest that the account is compromised.
+	if errWithCode := p.c.CheckActivityLimit(ctx, requestingAccount, common.LimitedActivityFollow); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Guard against clients toggling
+	// the follow on and off repeatedly.
+	if errWithCode := p.c.CheckInteractionCooldown(requestingAccount, common.ToggledInteractionFollow, targetAccount.ID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Neither follows nor follow requests, so
 	// create and store a new follow request.
 	followID, err := id.NewRandomULID()
@@ -102,6 +115,7 @@ func (p *Processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 		err = gtserror.Newf("error creating follow request in db: %s", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
+	p.c.MarkInteractionToggled(requestingAccount, common.ToggledInteractionFollow, targetAccount.ID)
 
 	if targetAccount.IsLocal() && !*targetAccount.Locked {
 		// If the target account is local and not locked,
@@ -136,12 +150,23 @@ func (p *Processor) FollowRemove(ctx context.Context, requestingAccount *gtsmode
 		return nil, errWithCode
 	}
 
+	// Guard against clients toggling the follow on and
+	// off repeatedly. Unfollowing an account that isn't
+	// followed is a no-op, so only check if it is.
+	if errWithCode := p.checkUnfollowCooldown(ctx, requestingAccount, targetAccount); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Unfollow and deal with side effects.
 	msgs, err := p.unfollow(ctx, requestingAccount, targetAccount)
 	if err != nil {
 		return nil, gtserror.NewErrorNotFound(gtserror.Newf("account %s not found in the db: %s", targetAccountID, err))
 	}
 
+	if len(msgs) != 0 {
+		p.c.MarkInteractionToggled(requestingAccount, common.ToggledInteractionFollow, targetAccount.ID)
+	}
+
 	// Batch queue accreted client api messages.
 	p.state.Workers.EnqueueClientAPI(ctx, msgs...)
 
@@ -218,6 +243,32 @@ func (p *Processor) getFollowTarget(ctx context.Context, requester *gtsmodel.Acc
 	return p.c.GetVisibleTargetAccount(ctx, requester, targetID)
 }
 
+// checkUnfollowCooldown checks the interaction cooldown for
+// unfollowing the target account, if the requesting account
+// currently follows or has requested to follow it.
+func (p *Processor) checkUnfollowCooldown(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) gtserror.WithCode {
+	following, err := p.state.DB.IsFollowing(ctx, requestingAccount.ID, targetAccount.ID)
+	if err != nil {
+		err = gtserror.Newf("db error checking follow: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if !following {
+		requested, err := p.state.DB.IsFollowRequested(ctx, requestingAccount.ID, targetAccount.ID)
+		if err != nil {
+			err = gtserror.Newf("db error checking follow request: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if !requested {
+			// Nothing to unfollow.
+			return nil
+		}
+	}
+
+	return p.c.CheckInteractionCooldown(requestingAccount, common.ToggledInteractionFollow, targetAccount.ID)
+}
+
 // unfollow is a convenience function for having requesting account
 // unfollow (and un follow request) target account, if follows and/or
 // follow requests exist.
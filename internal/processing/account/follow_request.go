@@ -20,16 +20,24 @@ package account
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+// maxBulkFollowRequests is the max number of follow
+// requests that can be authorized / rejected at once.
+const maxBulkFollowRequests = 200
+
 // FollowRequestAccept handles the accepting of a follow request from the sourceAccountID to the requestingAccount (the currently authorized account).
 func (p *Processor) FollowRequestAccept(ctx context.Context, requestingAccount *gtsmodel.Account, sourceAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
 	follow, err := p.state.DB.AcceptFollowRequest(ctx, sourceAccountID, requestingAccount.ID)
@@ -117,3 +125,135 @@ func (p *Processor) FollowRequestsGet(ctx context.Context, requestingAccount *gt
 		Prev:  page.Prev(lo, hi),
 	}), nil
 }
+
+// FollowRequestsGetExtended fetches a page of follow requests targeting the given
+// requestingAccount, including the requesting accounts and mutual follower counts.
+func (p *Processor) FollowRequestsGetExtended(ctx context.Context, requestingAccount *gtsmodel.Account, page *paging.Page) (*apimodel.PageableResponse, gtserror.WithCode) {
+	// Fetch follow requests targeting the given requesting account model.
+	followRequests, err := p.state.DB.GetAccountFollowRequests(ctx, requestingAccount.ID, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(followRequests)
+	if count == 0 {
+		return paging.EmptyResponse(), nil
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := followRequests[count-1].ID
+	hi := followRequests[0].ID
+
+	items := make([]interface{}, 0, count)
+	for _, followRequest := range followRequests {
+		if followRequest.Account == nil {
+			// Account not stored.
+			continue
+		}
+
+		// Check whether this account is visible to requesting account.
+		visible, err := p.filter.AccountVisible(ctx, requestingAccount, followRequest.Account)
+		if err != nil {
+			log.Errorf(ctx, "error checking account visibility: %v", err)
+			continue
+		}
+
+		if !visible {
+			// Not visible to requester.
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, followRequest.Account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account: %v", err)
+			continue
+		}
+
+		mutuals, err := p.state.DB.CountMutualFollowers(ctx, requestingAccount.ID, followRequest.AccountID)
+		if err != nil {
+			err := gtserror.Newf("db error counting mutual followers: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		items = append(items, &apimodel.FollowRequest{
+			ID:                   followRequest.ID,
+			CreatedAt:            util.FormatISO8601(followRequest.CreatedAt),
+			Account:              apiAccount,
+			MutualFollowersCount: mutuals,
+		})
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/follow_requests/extended",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// FollowRequestsAcceptBulk handles the accepting of multiple follow requests targeting
+// the requestingAccount, either from the given account IDs or, if all is set, the oldest
+// pending follow requests, up to maxBulkFollowRequests. Account IDs with no pending follow
+// request are skipped. Returns relationships with each account whose request was accepted.
+func (p *Processor) FollowRequestsAcceptBulk(ctx context.Context, requestingAccount *gtsmodel.Account, accountIDs []string, all bool) ([]*apimodel.Relationship, gtserror.WithCode) {
+	return p.followRequestsBulk(ctx, requestingAccount, accountIDs, all, p.FollowRequestAccept)
+}
+
+// FollowRequestsRejectBulk is like FollowRequestsAcceptBulk, but rejects the follow requests.
+func (p *Processor) FollowRequestsRejectBulk(ctx context.Context, requestingAccount *gtsmodel.Account, accountIDs []string, all bool) ([]*apimodel.Relationship, gtserror.WithCode) {
+	return p.followRequestsBulk(ctx, requestingAccount, accountIDs, all, p.FollowRequestReject)
+}
+
+func (p *Processor) followRequestsBulk(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	accountIDs []string,
+	all bool,
+	handle func(context.Context, *gtsmodel.Account, string) (*apimodel.Relationship, gtserror.WithCode),
+) ([]*apimodel.Relationship, gtserror.WithCode) {
+	switch {
+	case all && len(accountIDs) != 0:
+		const text = "provide either account_ids or all, not both"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+
+	case all:
+		// Fetch oldest pending follow requests first,
+		// so floods are worked through in order.
+		followRequests, err := p.state.DB.GetAccountFollowRequests(ctx, requestingAccount.ID, nil)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("db error getting follow requests: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		for i := len(followRequests) - 1; i >= 0 && len(accountIDs) < maxBulkFollowRequests; i-- {
+			accountIDs = append(accountIDs, followRequests[i].AccountID)
+		}
+
+	case len(accountIDs) == 0:
+		const text = "no account_ids provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+
+	case len(accountIDs) > maxBulkFollowRequests:
+		text := fmt.Sprintf("too many account_ids provided, max is %d", maxBulkFollowRequests)
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	relationships := make([]*apimodel.Relationship, 0, len(accountIDs))
+	for _, accountID := range util.UniqueStrings(accountIDs) {
+		relationship, errWithCode := handle(ctx, requestingAccount, accountID)
+		if errWithCode != nil {
+			if errWithCode.Code() == http.StatusNotFound {
+				// No pending follow
+				// request, skip it.
+				continue
+			}
+			return nil, errWithCode
+		}
+
+		relationships = append(relationships, relationship)
+	}
+
+	return relationships, nil
+}
@@ -19,10 +19,13 @@ package account_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -130,6 +133,96 @@ func (suite *FollowTestSuite) TestUpdateExistingFollowSetNothing() {
 	suite.False(relationship.Notifying)
 }
 
+func (suite *FollowTestSuite) TestFollowCreateActivityLimit() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_2"]
+	instanceAccount := suite.testAccounts["instance_account"]
+
+	// Only allow one follow per window.
+	config.SetAccountsActivityLimitUserFollows(1)
+
+	// First follow is fine.
+	_, errWithCode := suite.accountProcessor.FollowCreate(ctx, requestingAccount, &apimodel.AccountFollowRequest{
+		ID: suite.testAccounts["admin_account"].ID,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Second follow goes over the limit.
+	_, errWithCode = suite.accountProcessor.FollowCreate(ctx, requestingAccount, &apimodel.AccountFollowRequest{
+		ID: suite.testAccounts["remote_account_2"].ID,
+	})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	// User should now be limited.
+	user, err := suite.db.GetUserByAccountID(ctx, requestingAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(user.LimitedUntil.After(time.Now()))
+
+	// Moderators should have been alerted with a
+	// report on the account from the instance account.
+	reports, err := suite.db.GetReports(ctx, nil, instanceAccount.ID, requestingAccount.ID, "", "", "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(reports, 1)
+	suite.Contains(reports[0].Comment, "after sending 1 follows in 1h0m0s")
+
+	// Further follows are refused while limited,
+	// even though they're within the limit again.
+	config.SetAccountsActivityLimitUserFollows(100)
+	_, errWithCode = suite.accountProcessor.FollowCreate(ctx, requestingAccount, &apimodel.AccountFollowRequest{
+		ID: suite.testAccounts["remote_account_2"].ID,
+	})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+}
+
+func (suite *FollowTestSuite) TestFollowToggleCooldown() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_2"]
+	targetAccount := suite.testAccounts["remote_account_2"]
+
+	config.SetAccountsInteractionCooldown(time.Minute)
+
+	// Follow is fine.
+	_, errWithCode := suite.accountProcessor.FollowCreate(ctx, requestingAccount, &apimodel.AccountFollowRequest{
+		ID: targetAccount.ID,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Following again is a no-op, not a toggle.
+	_, errWithCode = suite.accountProcessor.FollowCreate(ctx, requestingAccount, &apimodel.AccountFollowRequest{
+		ID: targetAccount.ID,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Unfollowing straight away is too quick.
+	_, errWithCode = suite.accountProcessor.FollowRemove(ctx, requestingAccount, targetAccount.ID)
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+	suite.Contains(errWithCode.Safe(), "you're toggling this follow too quickly")
+
+	// Follow request should still be there.
+	requested, err := suite.db.IsFollowRequested(ctx, requestingAccount.ID, targetAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(requested)
+
+	// Unfollowing an account that isn't
+	// followed is a no-op, so it's fine.
+	_, errWithCode = suite.accountProcessor.FollowRemove(ctx, requestingAccount, suite.testAccounts["remote_account_3"].ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+}
+
 func TestFollowTestS(t *testing.T) {
 	suite.Run(t, new(FollowTestSuite))
 }
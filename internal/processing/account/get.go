@@ -57,19 +57,6 @@ func (p *Processor) GetLocalByUsername(ctx context.Context, requestingAccount *g
 	return p.getFor(ctx, requestingAccount, targetAccount)
 }
 
-// GetCustomCSSForUsername returns custom css for the given local username.
-func (p *Processor) GetCustomCSSForUsername(ctx context.Context, username string) (string, gtserror.WithCode) {
-	customCSS, err := p.state.DB.GetAccountCustomCSSByUsername(ctx, username)
-	if err != nil {
-		if errors.Is(err, db.ErrNoEntries) {
-			return "", gtserror.NewErrorNotFound(errors.New("account not found"))
-		}
-		return "", gtserror.NewErrorInternalError(fmt.Errorf("db error: %w", err))
-	}
-
-	return customCSS, nil
-}
-
 func (p *Processor) getFor(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (*apimodel.Account, gtserror.WithCode) {
 	var err error
 
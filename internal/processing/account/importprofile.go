@@ -0,0 +1,211 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// ImportProfileGet returns the remote account with the given URI,
+// from which the requesting account may import its profile, so
+// that the user can preview what would be copied over.
+func (p *Processor) ImportProfileGet(ctx context.Context, requestingAccount *gtsmodel.Account, uri string) (*apimodel.Account, gtserror.WithCode) {
+	source, errWithCode := p.importProfileSource(ctx, requestingAccount, uri)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, source)
+	if err != nil {
+		err = gtserror.Newf("error converting account %s: %w", uri, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// ImportProfile copies the selected parts of the profile of the
+// remote account in the given form onto the requesting account,
+// re-uploading avatar and header locally. This is intended to
+// smooth migrations from another instance, so the remote account
+// must already be one of the requesting account's aliases.
+func (p *Processor) ImportProfile(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.ImportProfileRequest) (*apimodel.Account, gtserror.WithCode) {
+	if !form.DisplayName && !form.Note && !form.Fields && !form.Avatar && !form.Header {
+		const text = "select at least one of display_name, note, fields, avatar or header to import"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	source, errWithCode := p.importProfileSource(ctx, requestingAccount, form.URI)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Reuse the usual account update
+	// logic for validating, formatting,
+	// storing and federating the changes.
+	update := &apimodel.UpdateCredentialsRequest{}
+
+	if form.DisplayName {
+		displayName := text.SanitizeToPlaintext(source.DisplayName)
+		update.DisplayName = &displayName
+	}
+
+	if form.Note {
+		// The remote note is HTML, so convert it back
+		// to something close to what the user typed.
+		note := text.HTMLToPlaintext(source.Note)
+		update.Note = &note
+	}
+
+	if form.Fields {
+		fields := make([]apimodel.UpdateField, 0, len(source.Fields))
+		for _, field := range source.Fields {
+			var (
+				name  = text.SanitizeToPlaintext(field.Name)
+				value = text.SanitizeToPlaintext(field.Value)
+			)
+			fields = append(fields, apimodel.UpdateField{
+				Name:  &name,
+				Value: &value,
+			})
+		}
+		update.FieldsAttributes = &fields
+	}
+
+	if form.Avatar && source.AvatarRemoteURL != "" {
+		var description *string
+		if source.AvatarMediaAttachment != nil && source.AvatarMediaAttachment.Description != "" {
+			description = &source.AvatarMediaAttachment.Description
+		}
+
+		avatar, err := p.importProfileMedia(ctx, requestingAccount, source.AvatarRemoteURL, &media.AdditionalMediaInfo{
+			Avatar:      func() *bool { v := true; return &v }(),
+			Description: description,
+		})
+		if err != nil {
+			err := fmt.Errorf("could not import avatar: %w", err)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		requestingAccount.AvatarMediaAttachmentID = avatar.ID
+		requestingAccount.AvatarMediaAttachment = avatar
+	}
+
+	if form.Header && source.HeaderRemoteURL != "" {
+		header, err := p.importProfileMedia(ctx, requestingAccount, source.HeaderRemoteURL, &media.AdditionalMediaInfo{
+			Header: func() *bool { v := true; return &v }(),
+		})
+		if err != nil {
+			err := fmt.Errorf("could not import header: %w", err)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		requestingAccount.HeaderMediaAttachmentID = header.ID
+		requestingAccount.HeaderMediaAttachment = header
+	}
+
+	return p.Update(ctx, requestingAccount, update)
+}
+
+// importProfileSource resolves the remote account with the given
+// URI, checking that it's an alias of the requesting account.
+func (p *Processor) importProfileSource(ctx context.Context, requestingAccount *gtsmodel.Account, uriStr string) (*gtsmodel.Account, gtserror.WithCode) {
+	var aliased bool
+	for _, alias := range requestingAccount.AlsoKnownAsURIs {
+		if alias == uriStr {
+			aliased = true
+			break
+		}
+	}
+
+	if !aliased {
+		err := fmt.Errorf("%s is not an alias of this account; add it as an alias first", uriStr)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	uri, err := url.Parse(uriStr)
+	if err != nil {
+		err := fmt.Errorf("%s is not a valid account URI", uriStr)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	source, _, err := p.federator.GetAccountByURI(ctx, requestingAccount.Username, uri)
+	if err != nil {
+		err := fmt.Errorf("could not resolve account %s: %w", uriStr, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if source.IsLocal() {
+		const text = "profiles can only be imported from accounts on other instances"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return source, nil
+}
+
+// importProfileMedia dereferences the remote image at the given
+// URL on behalf of the requesting account, and stores it as a
+// new local media attachment owned by that account.
+func (p *Processor) importProfileMedia(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	urlStr string,
+	info *media.AdditionalMediaInfo,
+) (*gtsmodel.MediaAttachment, error) {
+	iri, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, gtserror.Newf("invalid media url %s: %w", urlStr, err)
+	}
+
+	tsport, err := p.federator.TransportController().NewTransportForUsername(ctx, requestingAccount.Username)
+	if err != nil {
+		return nil, gtserror.Newf("error getting transport for %s: %w", requestingAccount.Username, err)
+	}
+
+	dataFunc := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
+		rc, sz, _, err := tsport.DereferenceMedia(innerCtx, iri)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if maxImageSize := config.GetMediaImageMaxSize(); sz > int64(maxImageSize) {
+			rc.Close()
+			return nil, 0, fmt.Errorf("image with size %d exceeded max image size of %d bytes", sz, maxImageSize)
+		}
+
+		return rc, sz, nil
+	}
+
+	processingMedia, err := p.mediaManager.PreProcessMedia(ctx, dataFunc, requestingAccount.ID, info)
+	if err != nil {
+		return nil, gtserror.Newf("error processing media: %w", err)
+	}
+
+	return processingMedia.LoadAttachment(ctx)
+}
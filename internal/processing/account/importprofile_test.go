@@ -0,0 +1,90 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type ImportProfileTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *ImportProfileTestSuite) TestImportProfileNotAlias() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		sourceAccount     = suite.testAccounts["remote_account_1"]
+	)
+
+	_, errWithCode := suite.accountProcessor.ImportProfile(ctx, requestingAccount, &apimodel.ImportProfileRequest{
+		URI:         sourceAccount.URI,
+		DisplayName: true,
+		Note:        true,
+	})
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Contains(errWithCode.Safe(), "add it as an alias first")
+}
+
+func (suite *ImportProfileTestSuite) TestImportProfileNothingSelected() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		sourceAccount     = suite.testAccounts["remote_account_1"]
+	)
+
+	requestingAccount.AlsoKnownAsURIs = []string{sourceAccount.URI}
+	defer func() { requestingAccount.AlsoKnownAsURIs = nil }()
+
+	_, errWithCode := suite.accountProcessor.ImportProfile(ctx, requestingAccount, &apimodel.ImportProfileRequest{
+		URI: sourceAccount.URI,
+	})
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *ImportProfileTestSuite) TestImportProfileGetLocal() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		sourceAccount     = suite.testAccounts["local_account_2"]
+	)
+
+	requestingAccount.AlsoKnownAsURIs = []string{sourceAccount.URI}
+	defer func() { requestingAccount.AlsoKnownAsURIs = nil }()
+
+	_, errWithCode := suite.accountProcessor.ImportProfileGet(ctx, requestingAccount, sourceAccount.URI)
+	if !suite.NotNil(errWithCode) {
+		suite.FailNow("")
+	}
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestImportProfileTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportProfileTestSuite))
+}
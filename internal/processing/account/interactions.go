@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// InteractionCircleGet returns up to limit accounts which most interacted
+// with statuses of requestingAccount (by faving, boosting, or replying)
+// over the past given number of days, sorted by total interactions.
+//
+// Only the requester's own interactions are ever exposed by this function;
+// accounts which are suspended, or which block or are blocked by the
+// requester, are skipped.
+//
+// The interaction circle is opt-in for the instance; if it's
+// not enabled, a 404 error is returned.
+func (p *Processor) InteractionCircleGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	days int,
+	limit int,
+) ([]*apimodel.InteractionCircleEntry, gtserror.WithCode) {
+	if !config.GetInstanceInteractionCircle() {
+		err := gtserror.New("interaction circle not enabled")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	since := time.Now().AddDate(0, 0, -days)
+
+	counts, err := p.state.DB.GetAccountInteractionCounts(ctx, requestingAccount.ID, since)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting interaction counts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Sort by most interactions first, falling back
+	// to account ID to keep the ordering determinate.
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Total() != counts[j].Total() {
+			return counts[i].Total() > counts[j].Total()
+		}
+		return counts[i].AccountID < counts[j].AccountID
+	})
+
+	entries := make([]*apimodel.InteractionCircleEntry, 0, limit)
+	for _, count := range counts {
+		if len(entries) == limit {
+			break
+		}
+
+		account, err := p.state.DB.GetAccountByID(ctx, count.AccountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting account %s: %v", count.AccountID, err)
+			continue
+		}
+
+		if !account.SuspendedAt.IsZero() {
+			continue
+		}
+
+		blocked, err := p.state.DB.IsEitherBlocked(ctx, requestingAccount.ID, account.ID)
+		if err != nil {
+			err = gtserror.Newf("db error checking blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if blocked {
+			continue
+		}
+
+		apiAccount, err := p.converter.AccountToAPIAccountPublic(ctx, account)
+		if err != nil {
+			log.Errorf(ctx, "error converting account %s: %v", account.ID, err)
+			continue
+		}
+
+		entries = append(entries, &apimodel.InteractionCircleEntry{
+			Account:    apiAccount,
+			Favourites: count.Favourites,
+			Reblogs:    count.Reblogs,
+			Replies:    count.Replies,
+			Total:      count.Total(),
+		})
+	}
+
+	return entries, nil
+}
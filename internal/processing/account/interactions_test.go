@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type InteractionCircleTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *InteractionCircleTestSuite) TestInteractionCircleGet() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Testrig interactions are old, so look back a long way.
+	entries, errWithCode := suite.accountProcessor.InteractionCircleGet(ctx, requestingAccount, 36500, 20)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !suite.Len(entries, 2) {
+		suite.FailNow("")
+	}
+
+	// Admin faved, boosted, and replied to zork.
+	suite.Equal("admin", entries[0].Account.Acct)
+	suite.Equal(1, entries[0].Favourites)
+	suite.Equal(1, entries[0].Reblogs)
+	suite.Equal(1, entries[0].Replies)
+	suite.Equal(3, entries[0].Total)
+
+	// Turtle only replied.
+	suite.Equal("1happyturtle", entries[1].Account.Acct)
+	suite.Equal(1, entries[1].Replies)
+	suite.Equal(1, entries[1].Total)
+}
+
+func (suite *InteractionCircleTestSuite) TestInteractionCircleGetBlocked() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+	)
+
+	// Zork blocks admin; admin should be left out.
+	if err := suite.db.PutBlock(ctx, &gtsmodel.Block{
+		ID:              id.NewULID(),
+		URI:             "http://localhost:8080/some_block_uri",
+		AccountID:       requestingAccount.ID,
+		TargetAccountID: suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	entries, errWithCode := suite.accountProcessor.InteractionCircleGet(ctx, requestingAccount, 36500, 20)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if suite.Len(entries, 1) {
+		suite.Equal("1happyturtle", entries[0].Account.Acct)
+	}
+}
+
+func (suite *InteractionCircleTestSuite) TestInteractionCircleGetNothingRecent() {
+	entries, errWithCode := suite.accountProcessor.InteractionCircleGet(context.Background(), suite.testAccounts["local_account_1"], 30, 20)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Empty(entries)
+}
+
+func (suite *InteractionCircleTestSuite) TestInteractionCircleGetNotEnabled() {
+	config.SetInstanceInteractionCircle(false)
+	defer config.SetInstanceInteractionCircle(true)
+
+	_, errWithCode := suite.accountProcessor.InteractionCircleGet(context.Background(), suite.testAccounts["local_account_1"], 30, 20)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestInteractionCircleTestSuite(t *testing.T) {
+	suite.Run(t, new(InteractionCircleTestSuite))
+}
@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+const (
+	jsonFeedLength = 20
+)
+
+type GetJSONFeed func() (string, gtserror.WithCode)
+
+// GetJSONFeedForUsername returns a function to return one page of the JSON Feed
+// of a local account with the given username, starting from the status before
+// maxID (or from the newest status if maxID is empty), and the last-modified
+// time (time that the account last posted a status eligible to be included
+// in the feed). The JSON Feed contains the same statuses as the RSS feed, and
+// is only available if the account has enabled its RSS feed.
+//
+// To save db calls, callers to this function should only call the returned GetJSONFeed
+// func if the last-modified time is newer than the last-modified time they have cached.
+//
+// If the account has not yet posted an eligible status, the returned last-modified
+// time will be zero, and the GetJSONFeed func will return a valid feed with no items.
+func (p *Processor) GetJSONFeedForUsername(ctx context.Context, username string, maxID string) (GetJSONFeed, time.Time, gtserror.WithCode) {
+	var (
+		never = time.Time{}
+	)
+
+	account, err := p.state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Simply no account with this username.
+			err = gtserror.New("account not found")
+			return nil, never, gtserror.NewErrorNotFound(err)
+		}
+
+		// Real db error.
+		err = gtserror.Newf("db error getting account %s: %w", username, err)
+		return nil, never, gtserror.NewErrorInternalError(err)
+	}
+
+	// Ensure account has rss feed enabled;
+	// the JSON Feed is an alternative to it.
+	if !*account.EnableRSS {
+		err = gtserror.New("account RSS feed not enabled")
+		return nil, never, gtserror.NewErrorNotFound(err)
+	}
+
+	// LastModified time is needed by callers to check freshness for cacheing.
+	// This might be a zero time.Time if account has never posted a status that's
+	// eligible to appear in the feed; that's fine.
+	lastPostAt, err := p.state.DB.GetAccountLastPosted(ctx, account.ID, true)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting account %s last posted: %w", username, err)
+		return nil, never, gtserror.NewErrorInternalError(err)
+	}
+
+	return func() (string, gtserror.WithCode) {
+		// Assemble author namestring once only.
+		author := "@" + account.Username + "@" + config.GetAccountDomain()
+
+		// Derive image for this account (may be nil).
+		// This also populates the account's avatar.
+		image, errWithCode := p.rssImageForAccount(ctx, account, author)
+		if errWithCode != nil {
+			return "", errWithCode
+		}
+
+		feedURL := account.URL + "/feed.json"
+		feed := &apimodel.JSONFeed{
+			Version:     typeutils.JSONFeedVersion,
+			Title:       "Posts from " + author,
+			HomePageURL: account.URL,
+			FeedURL:     feedURL,
+			Description: "Posts from " + author,
+			Authors:     []apimodel.JSONFeedAuthor{p.converter.AccountToJSONFeedAuthor(account)},
+			Language:    account.Language,
+			Items:       []*apimodel.JSONFeedItem{},
+		}
+
+		if image != nil {
+			feed.Icon = image.Url
+			feed.Favicon = image.Url
+		}
+
+		if maxID != "" {
+			feed.FeedURL += "?max_id=" + url.QueryEscape(maxID)
+		}
+
+		// We can return early rather than wasting a db call
+		// if we already know there's no eligible statuses.
+		if lastPostAt.IsZero() {
+			return stringifyJSONFeed(feed)
+		}
+
+		// Retrieve statuses as they'd be shown on the web view of the account profile.
+		statuses, err := p.state.DB.GetAccountWebStatuses(ctx, account.ID, jsonFeedLength, maxID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("db error getting account web statuses: %w", err)
+			return "", gtserror.NewErrorInternalError(err)
+		}
+
+		// Add each status to the feed.
+		for _, status := range statuses {
+			item, err := p.converter.StatusToJSONFeedItem(ctx, status)
+			if err != nil {
+				err = gtserror.Newf("error converting status to feed item: %w", err)
+				return "", gtserror.NewErrorInternalError(err)
+			}
+
+			feed.Items = append(feed.Items, item)
+		}
+
+		// If this page is full there may be older
+		// statuses, so link to the next page of them.
+		if len(statuses) == jsonFeedLength {
+			nextMaxID := statuses[len(statuses)-1].ID
+			feed.NextURL = feedURL + "?max_id=" + url.QueryEscape(nextMaxID)
+		}
+
+		return stringifyJSONFeed(feed)
+	}, lastPostAt, nil
+}
+
+func stringifyJSONFeed(feed *apimodel.JSONFeed) (string, gtserror.WithCode) {
+	b, err := json.Marshal(feed)
+	if err != nil {
+		err := gtserror.Newf("error converting feed to json string: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	return string(b), nil
+}
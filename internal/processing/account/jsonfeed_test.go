@@ -0,0 +1,116 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type GetJSONFeedTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *GetJSONFeedTestSuite) getFeed(username string, maxID string) *apimodel.JSONFeed {
+	getFeed, _, errWithCode := suite.accountProcessor.GetJSONFeedForUsername(context.Background(), username, maxID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	raw, errWithCode := getFeed()
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	feed := &apimodel.JSONFeed{}
+	if err := json.Unmarshal([]byte(raw), feed); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return feed
+}
+
+func (suite *GetJSONFeedTestSuite) TestGetAccountJSONFeedAdmin() {
+	_, lastModified, errWithCode := suite.accountProcessor.GetJSONFeedForUsername(context.Background(), "admin", "")
+	suite.NoError(errWithCode)
+	suite.EqualValues(1634733405, lastModified.Unix())
+
+	feed := suite.getFeed("admin", "")
+	suite.Equal("https://jsonfeed.org/version/1.1", feed.Version)
+	suite.Equal("Posts from @admin@localhost:8080", feed.Title)
+	suite.Equal("http://localhost:8080/@admin", feed.HomePageURL)
+	suite.Equal("http://localhost:8080/@admin/feed.json", feed.FeedURL)
+	suite.Empty(feed.NextURL)
+	suite.Len(feed.Items, 2)
+
+	// Newest first.
+	suite.Equal("http://localhost:8080/@admin/statuses/01F8MHAAY43M6RJ473VQFCVH37", feed.Items[0].ID)
+	suite.Equal("open to see some puppies", feed.Items[0].Summary)
+	suite.Equal("🐕🐕🐕🐕🐕", feed.Items[0].ContentHTML)
+
+	item := feed.Items[1]
+	suite.Equal("http://localhost:8080/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R", item.ID)
+	suite.Equal("http://localhost:8080/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R", item.URL)
+	suite.Equal("hello world! #welcome ! first post on the instance <img src=\"http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png\" title=\":rainbow:\" alt=\":rainbow:\" class=\"emoji\"/> !", item.ContentHTML)
+	suite.Equal("2021-10-20T11:36:45.000Z", item.DatePublished)
+	suite.Equal([]string{"welcome"}, item.Tags)
+	if suite.Len(item.Authors, 1) {
+		suite.Equal("@admin@localhost:8080", item.Authors[0].Name)
+		suite.Equal("http://localhost:8080/@admin", item.Authors[0].URL)
+	}
+	if suite.Len(item.Attachments, 1) {
+		suite.Equal("http://localhost:8080/fileserver/01F8MH17FWEB39HZJ76B6VXSKF/attachment/original/01F8MH6NEM8D7527KZAECTCR76.jpg", item.Attachments[0].URL)
+		suite.Equal("image/jpeg", item.Attachments[0].MIMEType)
+		suite.Equal(62529, item.Attachments[0].SizeInBytes)
+	}
+}
+
+func (suite *GetJSONFeedTestSuite) TestGetAccountJSONFeedPaged() {
+	// Only the older status is before the newer one.
+	feed := suite.getFeed("admin", "01F8MHAAY43M6RJ473VQFCVH37")
+	suite.Equal("http://localhost:8080/@admin/feed.json?max_id=01F8MHAAY43M6RJ473VQFCVH37", feed.FeedURL)
+	if suite.Len(feed.Items, 1) {
+		suite.Equal("http://localhost:8080/@admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R", feed.Items[0].ID)
+	}
+
+	// Nothing before the oldest status.
+	feed = suite.getFeed("admin", "01F8MH75CBF9JFX4ZAD54N0W0R")
+	suite.Empty(feed.Items)
+}
+
+func (suite *GetJSONFeedTestSuite) TestGetAccountJSONFeedZork() {
+	feed := suite.getFeed("the_mighty_zork", "")
+	suite.Equal("http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/avatar/small/01F8MH58A357CV5K7R7TJMSH6S.jpg", feed.Icon)
+	if suite.Len(feed.Authors, 1) {
+		suite.Equal(feed.Icon, feed.Authors[0].Avatar)
+	}
+	suite.Len(feed.Items, 1)
+}
+
+func (suite *GetJSONFeedTestSuite) TestGetAccountJSONFeedNotEnabled() {
+	_, _, errWithCode := suite.accountProcessor.GetJSONFeedForUsername(context.Background(), "1happyturtle", "")
+	suite.EqualError(errWithCode, "GetJSONFeedForUsername: account RSS feed not enabled")
+}
+
+func TestGetJSONFeedTestSuite(t *testing.T) {
+	suite.Run(t, new(GetJSONFeedTestSuite))
+}
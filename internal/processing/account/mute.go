@@ -0,0 +1,242 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// MuteCreate handles the creation or updating of a mute from requestingAccount to targetAccountID.
+//
+// By default everything from the target account is muted, but the
+// form can narrow the mute to only boosts, or only notifications.
+func (p *Processor) MuteCreate(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetAccountID string,
+	form *apimodel.AccountMuteRequest,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	existingMute, errWithCode := p.getMuteTarget(ctx, requestingAccount, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Everything is muted unless told otherwise.
+	statuses, boosts, notifications := true, true, true
+	if form.Statuses != nil {
+		statuses = *form.Statuses
+	}
+	if form.Boosts != nil {
+		boosts = *form.Boosts
+	}
+	if form.Notifications != nil {
+		notifications = *form.Notifications
+	}
+
+	if !statuses && !boosts && !notifications {
+		const text = "at least one of statuses, boosts or notifications must be muted"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if existingMute != nil {
+		if *existingMute.Statuses == statuses &&
+			*existingMute.Boosts == boosts &&
+			*existingMute.Notifications == notifications {
+			// Mute already exists as requested, nothing to do.
+			return p.RelationshipGet(ctx, requestingAccount, targetAccountID)
+		}
+
+		// Update the existing mute to the requested options.
+		existingMute.Statuses = &statuses
+		existingMute.Boosts = &boosts
+		existingMute.Notifications = &notifications
+
+		if err := p.state.DB.UpdateMute(ctx,
+			existingMute,
+			"statuses",
+			"boosts",
+			"notifications",
+		); err != nil {
+			err := gtserror.Newf("error updating mute in db: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	} else {
+		// Create and store a new mute.
+		mute := &gtsmodel.UserMute{
+			ID:              id.NewULID(),
+			AccountID:       requestingAccount.ID,
+			TargetAccountID: targetAccountID,
+			Statuses:        &statuses,
+			Boosts:          &boosts,
+			Notifications:   &notifications,
+		}
+
+		if err := p.state.DB.PutMute(ctx, mute); err != nil {
+			err := gtserror.Newf("error creating mute in db: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	// Timelined statuses may no longer be visible.
+	p.removeMuteTimelines(ctx, requestingAccount)
+
+	return p.RelationshipGet(ctx, requestingAccount, targetAccountID)
+}
+
+// MuteRemove handles the removal of a mute from requestingAccount to targetAccountID.
+func (p *Processor) MuteRemove(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetAccountID string,
+) (*apimodel.Relationship, gtserror.WithCode) {
+	existingMute, errWithCode := p.getMuteTarget(ctx, requestingAccount, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if existingMute == nil {
+		// Already not muted, nothing to do.
+		return p.RelationshipGet(ctx, requestingAccount, targetAccountID)
+	}
+
+	// We got a mute, remove it from the db.
+	if err := p.state.DB.DeleteMuteByID(ctx, existingMute.ID); err != nil {
+		err := gtserror.Newf("error removing mute from db: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Previously muted statuses may now be visible.
+	p.removeMuteTimelines(ctx, requestingAccount)
+
+	return p.RelationshipGet(ctx, requestingAccount, targetAccountID)
+}
+
+// MutesGet returns a pageable response of accounts muted by requestingAccount.
+func (p *Processor) MutesGet(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	mutes, err := p.state.DB.GetAccountMutes(ctx,
+		requestingAccount.ID,
+		page,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(mutes)
+	if len(mutes) == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+
+	for _, mute := range mutes {
+		// Convert target account to frontend API model. (target will never be nil)
+		account, err := p.converter.AccountToAPIAccountPublic(ctx, mute.TargetAccount)
+		if err != nil {
+			log.Errorf(ctx, "error converting account to public api account: %v", err)
+			continue
+		}
+
+		// Append target to return items.
+		items = append(items, account)
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := mutes[count-1].ID
+	hi := mutes[0].ID
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/mutes",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+func (p *Processor) getMuteTarget(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*gtsmodel.UserMute, gtserror.WithCode) {
+	// Account should not mute or unmute itself.
+	if requestingAccount.ID == targetAccountID {
+		err := gtserror.Newf("account %s cannot mute or unmute itself", requestingAccount.ID)
+		return nil, gtserror.NewErrorNotAcceptable(err, err.Error())
+	}
+
+	// Ensure target account retrievable.
+	if _, err := p.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		targetAccountID,
+	); err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// Real db error.
+			err = gtserror.Newf("db error looking for target account %s: %w", targetAccountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		// Account not found.
+		err = gtserror.Newf("target account %s not found in the db", targetAccountID)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	// Check if currently muted.
+	mute, err := p.state.DB.GetMute(
+		gtscontext.SetBarebones(ctx),
+		requestingAccount.ID,
+		targetAccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error checking existing mute: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return mute, nil
+}
+
+// removeMuteTimelines drops the home and list timelines
+// of the given account, so that they are freshly rebuilt
+// with the account's current mutes on next access.
+func (p *Processor) removeMuteTimelines(ctx context.Context, account *gtsmodel.Account) {
+	if err := p.state.Timelines.Home.RemoveTimeline(ctx, account.ID); err != nil {
+		log.Errorf(ctx, "error removing home timeline: %v", err)
+	}
+
+	lists, err := p.state.DB.GetListsForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "error getting lists: %v", err)
+		return
+	}
+
+	for _, list := range lists {
+		if err := p.state.Timelines.List.RemoveTimeline(ctx, list.ID); err != nil {
+			log.Errorf(ctx, "error removing list timeline: %v", err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type MuteTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *MuteTestSuite) TestMuteCreateDefault() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["admin_account"]
+
+	// Mute everything by default.
+	relationship, errWithCode := suite.accountProcessor.MuteCreate(ctx, requestingAccount, targetAccount.ID, &apimodel.AccountMuteRequest{})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.True(relationship.Muting)
+	suite.True(relationship.MutingNotifications)
+
+	// Muted account should be listed.
+	resp, errWithCode := suite.accountProcessor.MutesGet(ctx, requestingAccount, &paging.Page{Limit: 10})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.Len(resp.Items, 1)
+	suite.Equal(targetAccount.ID, resp.Items[0].(*apimodel.Account).ID)
+}
+
+func (suite *MuteTestSuite) TestMuteCreateNotificationsOnlyThenRemove() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["admin_account"]
+
+	// Mute only notifications.
+	relationship, errWithCode := suite.accountProcessor.MuteCreate(ctx, requestingAccount, targetAccount.ID, &apimodel.AccountMuteRequest{
+		Statuses: util.Ptr(false),
+		Boosts:   util.Ptr(false),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.False(relationship.Muting)
+	suite.True(relationship.MutingNotifications)
+
+	// Update the existing mute to boosts only.
+	relationship, errWithCode = suite.accountProcessor.MuteCreate(ctx, requestingAccount, targetAccount.ID, &apimodel.AccountMuteRequest{
+		Statuses:      util.Ptr(false),
+		Notifications: util.Ptr(false),
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.True(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+
+	// Remove the mute again.
+	relationship, errWithCode = suite.accountProcessor.MuteRemove(ctx, requestingAccount, targetAccount.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	suite.False(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+}
+
+func (suite *MuteTestSuite) TestMuteCreateNothing() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["admin_account"]
+
+	_, errWithCode := suite.accountProcessor.MuteCreate(ctx, requestingAccount, targetAccount.ID, &apimodel.AccountMuteRequest{
+		Statuses:      util.Ptr(false),
+		Boosts:        util.Ptr(false),
+		Notifications: util.Ptr(false),
+	})
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(MuteTestSuite))
+}
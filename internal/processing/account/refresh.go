@@ -0,0 +1,116 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Refresh forces a re-dereference of the given remote target
+// account on behalf of the requesting account, updating its
+// profile fields, avatar, header and emojis without waiting
+// for the configured freshness window to pass.
+//
+// Refreshes are rate-limited per requesting account by the
+// configured refresh cooldown, since every refresh results
+// in requests being made to the target account's instance.
+func (p *Processor) Refresh(
+	ctx context.Context,
+	requester *gtsmodel.Account,
+	targetID string,
+) (*apimodel.Account, gtserror.WithCode) {
+	target, errWithCode := p.c.GetVisibleTargetAccount(ctx, requester, targetID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if target.IsLocal() {
+		err := errors.New("local accounts are always up to date")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if errWithCode := p.checkRefreshCooldown(requester); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Start the cooldown before dereferencing, so
+	// that failed refreshes are rate-limited too.
+	p.markRefreshed(requester)
+
+	latest, _, err := p.federator.RefreshAccount(ctx,
+		requester.Username,
+		target,
+		nil,
+		true, // force
+	)
+	if err != nil {
+		err := gtserror.Newf("error refreshing account %s: %w", target.URI, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.c.GetAPIAccount(ctx, requester, latest)
+}
+
+// checkRefreshCooldown returns a 429 error if the
+// requesting account forced an account refresh
+// more recently than the configured cooldown.
+func (p *Processor) checkRefreshCooldown(requester *gtsmodel.Account) gtserror.WithCode {
+	cooldown := config.GetAccountsRefreshCooldown()
+	if cooldown <= 0 {
+		// Cooldown disabled.
+		return nil
+	}
+
+	last, ok := p.state.Caches.GTS.AccountRefreshes().Get(requester.ID)
+	if !ok {
+		// Not refreshed recently.
+		return nil
+	}
+
+	wait := time.Until(last.Add(cooldown))
+	if wait <= 0 {
+		// Cooldown has passed.
+		return nil
+	}
+
+	err := fmt.Errorf(
+		"you're refreshing accounts too quickly: try again in %s",
+		wait.Round(time.Second),
+	)
+	return gtserror.NewErrorTooManyRequests(err, err.Error())
+}
+
+// markRefreshed records that the requesting account
+// has just forced an account refresh, starting the
+// cooldown checked by checkRefreshCooldown.
+func (p *Processor) markRefreshed(requester *gtsmodel.Account) {
+	if config.GetAccountsRefreshCooldown() <= 0 {
+		// Cooldown disabled.
+		return
+	}
+
+	p.state.Caches.GTS.AccountRefreshes().Set(requester.ID, time.Now())
+}
@@ -0,0 +1,70 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type RefreshTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *RefreshTestSuite) TestRefreshRemoteAccount() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		targetAccount     = suite.testAccounts["remote_account_1"]
+	)
+
+	apiAccount, errWithCode := suite.accountProcessor.Refresh(ctx, requestingAccount, targetAccount.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(targetAccount.ID, apiAccount.ID)
+
+	// Refreshing again straight away
+	// should be rate-limited.
+	_, errWithCode = suite.accountProcessor.Refresh(ctx, requestingAccount, targetAccount.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+
+	// The cooldown applies per requesting account.
+	_, errWithCode = suite.accountProcessor.Refresh(ctx, suite.testAccounts["admin_account"], targetAccount.ID)
+	suite.Nil(errWithCode)
+}
+
+func (suite *RefreshTestSuite) TestRefreshLocalAccount() {
+	var (
+		ctx               = context.Background()
+		requestingAccount = suite.testAccounts["local_account_1"]
+		targetAccount     = suite.testAccounts["admin_account"]
+	)
+
+	_, errWithCode := suite.accountProcessor.Refresh(ctx, requestingAccount, targetAccount.ID)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func TestRefreshTestSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTestSuite))
+}
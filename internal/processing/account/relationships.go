@@ -23,6 +23,7 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
@@ -135,5 +136,16 @@ func (p *Processor) RelationshipGet(ctx context.Context, requestingAccount *gtsm
 		return nil, gtserror.NewErrorInternalError(gtserror.Newf("error converting relationship: %s", err))
 	}
 
+	targetAccount, err := p.state.DB.GetAccountByID(gtscontext.SetBarebones(ctx), targetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(gtserror.Newf("error getting target account: %s", err))
+	}
+
+	if targetAccount != nil && targetAccount.IsGroup() {
+		// Following a group is
+		// equivalent to joining it.
+		r.Member = &r.Following
+	}
+
 	return r, nil
 }
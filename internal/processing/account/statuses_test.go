@@ -0,0 +1,69 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type StatusesTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *StatusesTestSuite) TestStatusesGetQuarantined() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["remote_account_1"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	// getIDs returns the IDs of target account's statuses.
+	getIDs := func() []string {
+		resp, errWithCode := suite.accountProcessor.StatusesGet(ctx, requestingAccount, targetAccount.ID, 20, false, false, "", "", false, false, false)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+
+		ids := make([]string, 0, len(resp.Items))
+		for _, item := range resp.Items {
+			ids = append(ids, item.(*apimodel.Status).ID)
+		}
+		return ids
+	}
+
+	suite.Contains(getIDs(), targetStatus.ID)
+
+	if err := suite.db.PutQuarantinedStatus(ctx, &gtsmodel.QuarantinedStatus{
+		ID:        id.NewULID(),
+		StatusID:  targetStatus.ID,
+		AccountID: targetStatus.AccountID,
+		Score:     60,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotContains(getIDs(), targetStatus.ID)
+}
+
+func TestStatusesTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusesTestSuite))
+}
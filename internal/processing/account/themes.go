@@ -0,0 +1,157 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/exp/slices"
+)
+
+// themesDir is the directory within the web
+// assets dir where admins can put theme CSS.
+const themesDir = "themes"
+
+var (
+	themeTitle       = regexp.MustCompile(`(?m)^\s*theme-title:\s*(.+?)\s*$`)
+	themeDescription = regexp.MustCompile(`(?m)^\s*theme-description:\s*(.+?)\s*$`)
+)
+
+// theme is one theme from the server-side theme gallery.
+type theme struct {
+	apimodel.Theme
+	css string
+}
+
+// themes is the server-side theme gallery,
+// ordered by title, keyed by file name.
+type themes struct {
+	list   []*theme
+	byName map[string]*theme
+}
+
+// get returns the theme with the given file name, if any.
+func (t *themes) get(fileName string) (*theme, bool) {
+	theme, ok := t.byName[fileName]
+	return theme, ok
+}
+
+// loadThemes loads all *.css files in dir as themes.
+// Each file can optionally have "theme-title: ..." and
+// "theme-description: ..." lines in a leading comment.
+// A missing dir just results in no themes.
+func loadThemes(dir string) *themes {
+	t := &themes{byName: make(map[string]*theme)}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Warnf(nil, "error reading themes dir %s: %v", dir, err)
+		}
+		return t
+	}
+
+	for _, entry := range entries {
+		fileName := entry.Name()
+		if entry.IsDir() || filepath.Ext(fileName) != ".css" {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(dir, fileName))
+		if err != nil {
+			log.Warnf(nil, "error reading theme %s: %v", fileName, err)
+			continue
+		}
+		css := string(b)
+
+		theme := &theme{
+			Theme: apimodel.Theme{
+				Title:    strings.TrimSuffix(fileName, ".css"),
+				FileName: fileName,
+			},
+			css: css,
+		}
+
+		// Look for metadata in the
+		// leading comment, if any.
+		if strings.HasPrefix(strings.TrimSpace(css), "/*") {
+			header, _, _ := strings.Cut(css, "*/")
+			if m := themeTitle.FindStringSubmatch(header); m != nil {
+				theme.Title = m[1]
+			}
+			if m := themeDescription.FindStringSubmatch(header); m != nil {
+				theme.Description = m[1]
+			}
+		}
+
+		t.list = append(t.list, theme)
+		t.byName[fileName] = theme
+	}
+
+	slices.SortFunc(t.list, func(a, b *theme) bool {
+		return a.Title < b.Title
+	})
+
+	return t
+}
+
+// ThemesGet returns the themes available
+// in the server-side theme gallery.
+func (p *Processor) ThemesGet() []apimodel.Theme {
+	themes := make([]apimodel.Theme, 0, len(p.themes.list))
+	for _, theme := range p.themes.list {
+		themes = append(themes, theme.Theme)
+	}
+	return themes
+}
+
+// GetCustomCSSForUsername returns the CSS for the given
+// local username: the css of their chosen theme (if any)
+// followed by their own custom css (if allowed).
+func (p *Processor) GetCustomCSSForUsername(ctx context.Context, username string) (string, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByUsernameDomain(ctx, username, "")
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return "", gtserror.NewErrorNotFound(errors.New("account not found"))
+		}
+		return "", gtserror.NewErrorInternalError(gtserror.Newf("db error: %w", err))
+	}
+
+	var css strings.Builder
+
+	if theme, ok := p.themes.get(account.Theme); ok {
+		css.WriteString(theme.css)
+		css.WriteString("\n")
+	}
+
+	if config.GetAccountsAllowCustomCSS() {
+		css.WriteString(account.CustomCSS)
+	}
+
+	return css.String(), nil
+}
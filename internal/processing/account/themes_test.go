@@ -0,0 +1,66 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package account
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadThemes(t *testing.T) {
+	dir := t.TempDir()
+
+	for name, css := range map[string]string{
+		"zebra.css": "/*\n\ttheme-title: Aardvark\n\ttheme-description: Stripes, sort of.\n*/\n\nbody { color: black; }\n",
+		"plain.css": "body { color: red; }\n/* theme-title: Not A Header */\n",
+		"notes.txt": "not a theme",
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(css), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	themes := loadThemes(dir)
+	if l := len(themes.list); l != 2 {
+		t.Fatalf("expected 2 themes, got %d", l)
+	}
+
+	// Sorted by title.
+	first, second := themes.list[0], themes.list[1]
+	if first.Title != "Aardvark" || first.Description != "Stripes, sort of." || first.FileName != "zebra.css" {
+		t.Errorf("unexpected first theme: %+v", first.Theme)
+	}
+	if second.Title != "plain" || second.Description != "" || second.FileName != "plain.css" {
+		t.Errorf("unexpected second theme: %+v", second.Theme)
+	}
+
+	if _, ok := themes.get("plain.css"); !ok {
+		t.Error("expected to get plain.css")
+	}
+	if _, ok := themes.get("notes.txt"); ok {
+		t.Error("did not expect to get notes.txt")
+	}
+}
+
+func TestLoadThemesNoDir(t *testing.T) {
+	themes := loadThemes(filepath.Join(t.TempDir(), "nope"))
+	if l := len(themes.list); l != 0 {
+		t.Fatalf("expected 0 themes, got %d", l)
+	}
+}
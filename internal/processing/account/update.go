@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"mime/multipart"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -50,6 +51,14 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		account.Discoverable = form.Discoverable
 	}
 
+	if form.Indexable != nil {
+		account.Indexable = form.Indexable
+	}
+
+	if form.NoIndex != nil {
+		account.NoIndex = form.NoIndex
+	}
+
 	if form.Bot != nil {
 		account.Bot = form.Bot
 	}
@@ -112,6 +121,7 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 			fieldRaw := &gtsmodel.Field{
 				Name:  text.SanitizeToPlaintext(name),
 				Value: text.SanitizeToPlaintext(value),
+				RelMe: updateField.RelMe != nil && *updateField.RelMe,
 			}
 			fieldsRaw = append(fieldsRaw, fieldRaw)
 		}
@@ -177,6 +187,13 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 			fieldFormatValueResult := p.formatter.FromPlainNoParagraph(ctx, p.parseMention, account.ID, "", fieldRaw.Value)
 			field.Value = fieldFormatValueResult.HTML
 
+			// Mark links as rel="me" if
+			// the owner asked us to.
+			if fieldRaw.RelMe {
+				field.RelMe = true
+				field.Value = text.AddLinkRelMe(field.Value)
+			}
+
 			// Retrieve field emojis.
 			for _, emoji := range fieldFormatValueResult.Emojis {
 				emojis[emoji.ID] = emoji
@@ -248,6 +265,16 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 
 			account.StatusContentType = *form.Source.StatusContentType
 		}
+
+		if form.Source.Locale != nil ||
+			form.Source.EmailNotifications != nil ||
+			form.Source.ReviewFirstInteractions != nil ||
+			form.Source.TimeZone != nil ||
+			form.Source.HighlightsDigest != nil {
+			if errWithCode := p.updateUserSource(ctx, account, form.Source); errWithCode != nil {
+				return nil, errWithCode
+			}
+		}
 	}
 
 	if form.CustomCSS != nil {
@@ -258,6 +285,15 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		account.CustomCSS = text.SanitizeToPlaintext(customCSS)
 	}
 
+	if form.Theme != nil {
+		theme := *form.Theme
+		if _, ok := p.themes.get(theme); theme != "" && !ok {
+			err := fmt.Errorf("theme %s not available on this instance", theme)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		account.Theme = theme
+	}
+
 	if form.EnableRSS != nil {
 		account.EnableRSS = form.EnableRSS
 	}
@@ -335,3 +371,84 @@ func (p *Processor) UpdateHeader(ctx context.Context, header *multipart.FileHead
 
 	return processingMedia.LoadAttachment(ctx)
 }
+
+// updateUserSource updates the source settings which are stored on
+// the user of the given account, rather than on the account itself:
+// the preferred locale (cleared if empty), email notifications, whether
+// to review first interactions, time zone (cleared if empty), and
+// highlights.
+func (p *Processor) updateUserSource(ctx context.Context, account *gtsmodel.Account, source *apimodel.UpdateSource) gtserror.WithCode {
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", account.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	var columns []string
+
+	if source.Locale != nil {
+		locale := *source.Locale
+		if locale != "" {
+			locale, err = validate.Language(locale)
+			if err != nil {
+				return gtserror.NewErrorBadRequest(err, err.Error())
+			}
+		}
+
+		user.Locale = locale
+		columns = append(columns, "locale")
+	}
+
+	if source.EmailNotifications != nil {
+		if err := validate.EmailNotifications(*source.EmailNotifications); err != nil {
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		emailNotifications := typeutils.APIEmailNotificationsToEmailNotifications(*source.EmailNotifications)
+		if emailNotifications == gtsmodel.EmailNotificationsDaily &&
+			user.EmailNotifications != gtsmodel.EmailNotificationsDaily {
+			// Digests just turned on, so the
+			// first one should cover notifications
+			// from now on, not from any earlier time
+			// they were on.
+			user.DigestSentAt = time.Now()
+			columns = append(columns, "digest_sent_at")
+		}
+
+		user.EmailNotifications = emailNotifications
+		columns = append(columns, "email_notifications")
+	}
+
+	if source.ReviewFirstInteractions != nil {
+		user.ReviewFirstInteractions = source.ReviewFirstInteractions
+		columns = append(columns, "review_first_interactions")
+	}
+
+	if source.TimeZone != nil {
+		timeZone := *source.TimeZone
+		if timeZone != "" {
+			if err := validate.TimeZone(timeZone); err != nil {
+				return gtserror.NewErrorBadRequest(err, err.Error())
+			}
+		}
+
+		user.TimeZone = timeZone
+		columns = append(columns, "time_zone")
+	}
+
+	if source.HighlightsDigest != nil {
+		if err := validate.HighlightsDigest(*source.HighlightsDigest); err != nil {
+			return gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		user.HighlightsDigest = typeutils.APIHighlightsDigestToHighlightsDigest(*source.HighlightsDigest)
+		columns = append(columns, "highlights_digest")
+	}
+
+	if err := p.state.DB.UpdateUser(ctx, user, columns...); err != nil {
+		err := gtserror.Newf("db error updating user %s: %w", user.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
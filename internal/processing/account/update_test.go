@@ -19,7 +19,9 @@ package account_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -288,6 +290,208 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateNoteNotFields() {
 	suite.Equal(fieldsBefore, len(dbAccount.Fields))
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateUnknownTheme() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	theme := "does-not-exist.css"
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Theme: &theme,
+	})
+	suite.Nil(apiAccount)
+	suite.EqualError(errWithCode, "theme does-not-exist.css not available on this instance")
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateWithRelMeField() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	relMe := true
+	updateFields := []apimodel.UpdateField{
+		{
+			Name:  func() *string { s := "my website"; return &s }(),
+			Value: func() *string { s := "https://example.org"; return &s }(),
+			RelMe: &relMe,
+		},
+		{
+			Name:  func() *string { s := "my other website"; return &s }(),
+			Value: func() *string { s := "https://example.com"; return &s }(),
+		},
+	}
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		FieldsAttributes: &updateFields,
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Only the first field should be rel="me".
+	suite.True(apiAccount.Source.Fields[0].RelMe)
+	suite.True(apiAccount.Fields[0].RelMe)
+	suite.Equal("<a href=\"https://example.org\" rel=\"me nofollow noreferrer noopener\" target=\"_blank\">https://example.org</a>", apiAccount.Fields[0].Value)
+	suite.False(apiAccount.Source.Fields[1].RelMe)
+	suite.False(apiAccount.Fields[1].RelMe)
+	suite.Equal("<a href=\"https://example.com\" rel=\"nofollow noreferrer noopener\" target=\"_blank\">https://example.com</a>", apiAccount.Fields[1].Value)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateLocale() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	locale := "pt-br"
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			Locale: &locale,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("pt-BR", apiAccount.Source.Locale)
+
+	dbUser, err := suite.db.GetUserByAccountID(context.Background(), testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("pt-BR", dbUser.Locale)
+
+	// Clear the preference again.
+	locale = ""
+	apiAccount, errWithCode = suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			Locale: &locale,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiAccount.Source.Locale)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateInvalidLocale() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	locale := "not a locale!"
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			Locale: &locale,
+		},
+	})
+	suite.Nil(apiAccount)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateEmailNotifications() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	emailNotifications := "daily"
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			EmailNotifications: &emailNotifications,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("daily", apiAccount.Source.EmailNotifications)
+
+	dbUser, err := suite.db.GetUserByAccountID(context.Background(), testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.EmailNotificationsDaily, dbUser.EmailNotifications)
+
+	// Turning on digests should start
+	// counting towards the first one.
+	suite.WithinDuration(time.Now(), dbUser.DigestSentAt, time.Minute)
+
+	// Turn email notifications off again.
+	emailNotifications = "none"
+	apiAccount, errWithCode = suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			EmailNotifications: &emailNotifications,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("none", apiAccount.Source.EmailNotifications)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateInvalidEmailNotifications() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	emailNotifications := "hourly"
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			EmailNotifications: &emailNotifications,
+		},
+	})
+	suite.Nil(apiAccount)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateTimeZoneAndHighlights() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	var (
+		timeZone         = "Europe/Amsterdam"
+		highlightsDigest = "email"
+	)
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			TimeZone:         &timeZone,
+			HighlightsDigest: &highlightsDigest,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal("Europe/Amsterdam", apiAccount.Source.TimeZone)
+	suite.Equal("email", apiAccount.Source.HighlightsDigest)
+
+	dbUser, err := suite.db.GetUserByAccountID(context.Background(), testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("Europe/Amsterdam", dbUser.TimeZone)
+	suite.Equal(gtsmodel.HighlightsDigestEmail, dbUser.HighlightsDigest)
+
+	// Clear the time zone and turn highlights off again.
+	timeZone = ""
+	highlightsDigest = "none"
+	apiAccount, errWithCode = suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			TimeZone:         &timeZone,
+			HighlightsDigest: &highlightsDigest,
+		},
+	})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(apiAccount.Source.TimeZone)
+	suite.Equal("none", apiAccount.Source.HighlightsDigest)
+}
+
+func (suite *AccountUpdateTestSuite) TestAccountUpdateInvalidTimeZone() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	timeZone := "Middle/Earth"
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, &apimodel.UpdateCredentialsRequest{
+		Source: &apimodel.UpdateSource{
+			TimeZone: &timeZone,
+		},
+	})
+	suite.Nil(apiAccount)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
 func TestAccountUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountUpdateTestSuite))
 }
@@ -19,16 +19,119 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 )
 
+// AccountGet returns the admin view of the account with the
+// given ID, including its media storage usage and quota if
+// it's a local account.
+func (p *Processor) AccountGet(ctx context.Context, accountID string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiAccountWithMediaQuota(ctx, account)
+}
+
+// AccountMediaQuotaSet sets the media quota of the local account
+// with the given ID to the given number of bytes (0 meaning no
+// limit), overriding the instance default. A nil quota resets
+// the media quota of the account to the instance default.
+func (p *Processor) AccountMediaQuotaSet(ctx context.Context, accountID string, quota *int64) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting account %s: %w", accountID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !account.IsLocal() || account.IsInstance() {
+		err := fmt.Errorf("account %s is not a local user account", account.ID)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if quota != nil && *quota < 0 {
+		err := errors.New("quota must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user.MediaQuota = quota
+	if err := p.state.DB.UpdateUser(ctx, user, "media_quota"); err != nil {
+		err := gtserror.Newf("db error updating user %s: %w", user.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiAccountWithMediaQuota(ctx, account)
+}
+
+// apiAccountWithMediaQuota converts the given account to its
+// admin API representation, adding media storage usage and
+// quota if it's a local account.
+func (p *Processor) apiAccountWithMediaQuota(ctx context.Context, account *gtsmodel.Account) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	apiAccount, err := p.converter.AccountToAdminAPIAccount(ctx, account)
+	if err != nil {
+		err := gtserror.Newf("error converting account %s: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !account.IsLocal() || account.IsInstance() {
+		// Only local users
+		// can store media.
+		return apiAccount, nil
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	used, err := p.state.DB.GetAccountAttachmentsSize(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting attachments size for account %s: %w", account.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	limit := int64(config.GetMediaLocalQuota())
+	mediaQuota := &apimodel.AdminAccountMediaQuota{Used: used}
+
+	if user.MediaQuota != nil {
+		limit = *user.MediaQuota
+		mediaQuota.Override = true
+	}
+
+	if limit > 0 {
+		mediaQuota.Limit = &limit
+	}
+
+	apiAccount.MediaQuota = mediaQuota
+	return apiAccount, nil
+}
+
 func (p *Processor) AccountAction(
 	ctx context.Context,
 	adminAcct *gtsmodel.Account,
@@ -44,11 +147,15 @@ func (p *Processor) AccountAction(
 	case gtsmodel.AdminActionSuspend:
 		return p.accountActionSuspend(ctx, adminAcct, targetAcct, request.Text)
 
+	case gtsmodel.AdminActionUnlimit:
+		return p.accountActionUnlimit(ctx, adminAcct, targetAcct, request.Text)
+
 	default:
 		// TODO: add more types to this slice when adding
 		//       more types to the switch statement above.
 		supportedTypes := []string{
 			gtsmodel.AdminActionSuspend.String(),
+			gtsmodel.AdminActionUnlimit.String(),
 		}
 
 		err := fmt.Errorf(
@@ -100,3 +207,53 @@ func (p *Processor) accountActionSuspend(
 
 	return actionID, errWithCode
 }
+
+func (p *Processor) accountActionUnlimit(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	targetAcct *gtsmodel.Account,
+	text string,
+) (string, gtserror.WithCode) {
+	if !targetAcct.IsLocal() {
+		err := fmt.Errorf("account %s is not a local account", targetAcct.ID)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.state.DB.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", targetAcct.ID, err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	if !time.Now().Before(user.LimitedUntil) {
+		err := fmt.Errorf("account %s is not limited", targetAcct.ID)
+		return "", gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	actionID := id.NewULID()
+
+	errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryAccount,
+			TargetID:       targetAcct.ID,
+			Target:         targetAcct,
+			Type:           gtsmodel.AdminActionUnlimit,
+			AccountID:      adminAcct.ID,
+			Text:           text,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			user.LimitedUntil = time.Time{}
+			if err := p.state.DB.UpdateUser(ctx, user, "limited_until"); err != nil {
+				errs := gtserror.NewMultiError(1)
+				errs.Appendf("db error lifting limit on user %s: %w", user.ID, err)
+				return errs
+			}
+
+			return nil
+		},
+	)
+
+	return actionID, errWithCode
+}
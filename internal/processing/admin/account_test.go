@@ -20,10 +20,14 @@ package admin_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"codeberg.org/gruf/go-bytesize"
 	"github.com/stretchr/testify/suite"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -77,6 +81,48 @@ func (suite *AccountTestSuite) TestAccountActionSuspend() {
 	suite.NotZero(targetAcct.SuspendedAt)
 }
 
+func (suite *AccountTestSuite) TestAccountActionUnlimit() {
+	var (
+		ctx       = context.Background()
+		adminAcct = suite.testAccounts["admin_account"]
+		request   = &apimodel.AdminActionRequest{
+			Category: gtsmodel.AdminActionCategoryAccount.String(),
+			Type:     gtsmodel.AdminActionUnlimit.String(),
+			TargetID: suite.testAccounts["local_account_1"].ID,
+		}
+	)
+
+	// Account isn't limited yet.
+	_, errWithCode := suite.adminProcessor.AccountAction(ctx, adminAcct, request)
+	suite.EqualError(errWithCode, "account "+request.TargetID+" is not limited")
+
+	// Limit the account.
+	user := suite.testUsers["local_account_1"]
+	user.LimitedUntil = time.Now().Add(time.Hour)
+	if err := suite.db.UpdateUser(ctx, user, "limited_until"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	actionID, errWithCode := suite.adminProcessor.AccountAction(ctx, adminAcct, request)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(actionID)
+
+	// Wait for action to finish.
+	if !testrig.WaitFor(func() bool {
+		return suite.adminProcessor.Actions().TotalRunning() == 0
+	}) {
+		suite.FailNow("timed out waiting for admin action(s) to finish")
+	}
+
+	// Ensure limit lifted.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Zero(dbUser.LimitedUntil)
+}
+
 func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 	var (
 		ctx       = context.Background()
@@ -94,10 +140,77 @@ func (suite *AccountTestSuite) TestAccountActionUnsupported() {
 		adminAcct,
 		request,
 	)
-	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\"]")
+	suite.EqualError(errWithCode, "admin action type pee pee poo poo is not supported for this endpoint, currently supported types are: [\"suspend\" \"unlimit\"]")
 	suite.Empty(actionID)
 }
 
+func (suite *AccountTestSuite) TestAccountMediaQuota() {
+	var (
+		ctx         = context.Background()
+		targetAcct  = suite.testAccounts["local_account_1"]
+		defaultSize = bytesize.Size(1073741824)
+	)
+
+	config.SetMediaLocalQuota(defaultSize)
+	defer config.SetMediaLocalQuota(0)
+
+	used, err := suite.db.GetAccountAttachmentsSize(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Instance default applies to begin with.
+	apiAccount, errWithCode := suite.adminProcessor.AccountGet(ctx, targetAcct.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	if !suite.NotNil(apiAccount.MediaQuota) {
+		suite.FailNow("")
+	}
+	suite.Equal(used, apiAccount.MediaQuota.Used)
+	suite.EqualValues(defaultSize, *apiAccount.MediaQuota.Limit)
+	suite.False(apiAccount.MediaQuota.Override)
+
+	// Override it with no limit.
+	apiAccount, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(ctx, targetAcct.ID, util.Ptr(int64(0)))
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Nil(apiAccount.MediaQuota.Limit)
+	suite.True(apiAccount.MediaQuota.Override)
+
+	dbUser, err := suite.db.GetUserByAccountID(ctx, targetAcct.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.EqualValues(0, *dbUser.MediaQuota)
+
+	// Reset it to the instance default.
+	apiAccount, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(ctx, targetAcct.ID, nil)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.EqualValues(defaultSize, *apiAccount.MediaQuota.Limit)
+	suite.False(apiAccount.MediaQuota.Override)
+}
+
+func (suite *AccountTestSuite) TestAccountMediaQuotaRemote() {
+	var (
+		ctx        = context.Background()
+		targetAcct = suite.testAccounts["remote_account_1"]
+	)
+
+	apiAccount, errWithCode := suite.adminProcessor.AccountGet(ctx, targetAcct.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Nil(apiAccount.MediaQuota)
+
+	_, errWithCode = suite.adminProcessor.AccountMediaQuotaSet(ctx, targetAcct.ID, util.Ptr(int64(1024)))
+	suite.EqualError(errWithCode, "account "+targetAcct.ID+" is not a local user account")
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
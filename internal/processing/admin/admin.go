@@ -20,20 +20,32 @@ package admin
 import (
 	"github.com/superseriousbusiness/gotosocial/internal/cleaner"
 	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/federation"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 type Processor struct {
+	// common processor logic
+	c *common.Processor
+
 	state               *state.State
 	cleaner             *cleaner.Cleaner
 	converter           *typeutils.Converter
 	mediaManager        *media.Manager
 	transportController transport.Controller
 	emailSender         email.Sender
+	stream              *stream.Processor
+	federator           *federation.Federator
+
+	// announcements with
+	// maintenance scheduled
+	maintenance *maintenance
 
 	// admin Actions currently
 	// undergoing processing
@@ -45,14 +57,22 @@ func (p *Processor) Actions() *Actions {
 }
 
 // New returns a new admin processor.
-func New(state *state.State, converter *typeutils.Converter, mediaManager *media.Manager, transportController transport.Controller, emailSender email.Sender) Processor {
+func New(common *common.Processor, state *state.State, converter *typeutils.Converter, mediaManager *media.Manager, federator *federation.Federator, emailSender email.Sender, stream *stream.Processor) Processor {
 	return Processor{
+		c:                   common,
 		state:               state,
 		cleaner:             cleaner.New(state),
 		converter:           converter,
 		mediaManager:        mediaManager,
-		transportController: transportController,
+		transportController: federator.TransportController(),
 		emailSender:         emailSender,
+		stream:              stream,
+		federator:           federator,
+
+		maintenance: &maintenance{
+			announcements: make(map[string]*gtsmodel.Announcement),
+			cancels:       make(map[string][]func()),
+		},
 
 		actions: &Actions{
 			r:     make(map[string]*gtsmodel.AdminAction),
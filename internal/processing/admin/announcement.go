@@ -0,0 +1,177 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// AnnouncementsGet returns all announcements stored on this instance,
+// including unpublished and expired ones.
+func (p *Processor) AnnouncementsGet(ctx context.Context) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetAnnouncements(ctx)
+	if err != nil {
+		err := gtserror.Newf("db error getting announcements: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncements := make([]*apimodel.Announcement, len(announcements))
+	for i, a := range announcements {
+		apiAnnouncements[i] = p.converter.AnnouncementToAPIAnnouncement(a)
+	}
+
+	return apiAnnouncements, nil
+}
+
+// AnnouncementCreate creates a new announcement, streaming it to
+// clients once it becomes active. If the form specifies a maintenance
+// time, the instance will enter read-only maintenance mode at that
+// time, with countdown events streamed to clients leading up to it.
+func (p *Processor) AnnouncementCreate(ctx context.Context, form *apimodel.AnnouncementCreateRequest) (*apimodel.Announcement, gtserror.WithCode) {
+	if form.Text == "" {
+		const text = "announcement text cannot be empty"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	startsAt, errWithCode := parseAnnouncementTime("starts_at", form.StartsAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	endsAt, errWithCode := parseAnnouncementTime("ends_at", form.EndsAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	maintenanceAt, errWithCode := parseAnnouncementTime("maintenance_at", form.MaintenanceAt)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if !endsAt.IsZero() {
+		if !startsAt.IsZero() && !endsAt.After(startsAt) {
+			const text = "ends_at must be after starts_at"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if !maintenanceAt.IsZero() && !endsAt.After(maintenanceAt) {
+			const text = "ends_at must be after maintenance_at"
+			return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+	}
+
+	if !maintenanceAt.IsZero() && !form.Published {
+		const text = "maintenance can only be scheduled by a published announcement"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	announcementID := id.NewULID()
+
+	// Parse emojis only; announcements
+	// shouldn't mention anyone or use tags.
+	formatted := text.NewFormatter(p.state.DB).FromPlainEmojiOnly(ctx, nil, "", announcementID, form.Text)
+
+	announcement := &gtsmodel.Announcement{
+		ID:            announcementID,
+		Content:       "<p>" + formatted.HTML + "</p>",
+		Text:          form.Text,
+		StartsAt:      startsAt,
+		EndsAt:        endsAt,
+		AllDay:        &form.AllDay,
+		Published:     &form.Published,
+		MaintenanceAt: maintenanceAt,
+	}
+
+	if err := p.state.DB.PutAnnouncement(ctx, announcement); err != nil {
+		err := gtserror.Newf("db error putting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiAnnouncement := p.converter.AnnouncementToAPIAnnouncement(announcement)
+
+	if announcement.Active(time.Now()) {
+		// Announcement is already active,
+		// stream it to clients immediately.
+		if err := p.stream.Announcement(apiAnnouncement); err != nil {
+			log.Errorf(ctx, "error streaming announcement %s: %v", announcement.ID, err)
+		}
+	}
+
+	// Schedule any future events.
+	p.scheduleAnnouncement(announcement)
+
+	return apiAnnouncement, nil
+}
+
+// AnnouncementDelete deletes the announcement with the given ID,
+// cancelling any maintenance window scheduled by the announcement.
+func (p *Processor) AnnouncementDelete(ctx context.Context, id string) (*apimodel.Announcement, gtserror.WithCode) {
+	announcement, err := p.state.DB.GetAnnouncementByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = gtserror.Newf("no announcement with id %s found in the db", id)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteAnnouncementByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting announcement: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if p.unscheduleAnnouncement(id) {
+		// Deleting the announcement
+		// ended maintenance early.
+		p.streamMaintenance(announcement, 0, false)
+	}
+
+	if err := p.stream.AnnouncementDelete(id); err != nil {
+		log.Errorf(ctx, "error streaming announcement delete %s: %v", id, err)
+	}
+
+	return p.converter.AnnouncementToAPIAnnouncement(announcement), nil
+}
+
+// parseAnnouncementTime parses the given (optional)
+// RFC3339 / ISO 8601 time value of an announcement
+// form field, returning a zero time if it's not set.
+func parseAnnouncementTime(field string, value string) (time.Time, gtserror.WithCode) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		text := field + " could not be parsed as an ISO 8601 datetime"
+		return time.Time{}, gtserror.NewErrorBadRequest(err, text)
+	}
+
+	return t, nil
+}
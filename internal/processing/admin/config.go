@@ -0,0 +1,59 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// ConfigReload re-reads the configuration file, applying changes
+// to settings which can be changed at runtime, and returning
+// which settings were changed and which require a restart.
+func (p *Processor) ConfigReload(ctx context.Context) (*apimodel.AdminConfigReload, gtserror.WithCode) {
+	if config.GetConfigPath() == "" {
+		const text = "instance was not started with a configuration file"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	res, err := config.ReloadFile()
+	if err != nil {
+		err := gtserror.Newf("error reloading configuration: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	reload := &apimodel.AdminConfigReload{
+		Changed:         res.Changed,
+		RequiresRestart: res.RequiresRestart,
+	}
+
+	// Ensure we serialize empty
+	// arrays rather than null.
+	if reload.Changed == nil {
+		reload.Changed = []string{}
+	}
+	if reload.RequiresRestart == nil {
+		reload.RequiresRestart = []string{}
+	}
+
+	return reload, nil
+}
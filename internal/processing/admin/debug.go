@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// DebugCachesGet returns statistics for all of the instance's caches.
+func (p *Processor) DebugCachesGet() []*apimodel.AdminCacheStats {
+	stats := p.state.Caches.Stats()
+
+	apiStats := make([]*apimodel.AdminCacheStats, 0, len(stats))
+	for _, s := range stats {
+		apiStats = append(apiStats, apiCacheStats(s))
+	}
+
+	return apiStats
+}
+
+// DebugCacheInvalidate invalidates entries with the given keys
+// under lookup from the named cache, or clears the whole cache
+// if no keys are given, returning updated stats for the cache.
+func (p *Processor) DebugCacheInvalidate(name string, lookup string, keys []string) (*apimodel.AdminCacheStats, gtserror.WithCode) {
+	if name == "" {
+		const text = "no cache specified"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if err := p.state.Caches.Invalidate(name, lookup, keys); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	for _, s := range p.state.Caches.Stats() {
+		if s.Name == name {
+			return apiCacheStats(s), nil
+		}
+	}
+
+	err := gtserror.Newf("no stats for cache %s", name)
+	return nil, gtserror.NewErrorInternalError(err)
+}
+
+// DebugDereferencesGet returns a snapshot of the
+// remote dereferences which are currently in progress.
+func (p *Processor) DebugDereferencesGet() *apimodel.AdminDereferencesInFlight {
+	inflight := p.federator.InFlight()
+	return &apimodel.AdminDereferencesInFlight{
+		Handshakes: inflight.Handshakes,
+		Avatars:    inflight.Avatars,
+		Headers:    inflight.Headers,
+		Emojis:     inflight.Emojis,
+	}
+}
+
+func apiCacheStats(s cache.CacheStats) *apimodel.AdminCacheStats {
+	apiStats := &apimodel.AdminCacheStats{
+		Name:    s.Name,
+		Lookups: s.Lookups,
+	}
+
+	if s.Len >= 0 {
+		apiStats.Size = &s.Len
+	}
+
+	if s.Cap >= 0 {
+		apiStats.Capacity = &s.Cap
+	}
+
+	if s.Counted {
+		apiStats.Hits = &s.Hits
+		apiStats.Misses = &s.Misses
+	}
+
+	if apiStats.Lookups == nil {
+		apiStats.Lookups = []string{}
+	}
+
+	if s.Breakdown != nil {
+		apiStats.Breakdown = make(map[string]apimodel.AdminCacheCounts, len(s.Breakdown))
+		for kind, counts := range s.Breakdown {
+			apiStats.Breakdown[kind] = apimodel.AdminCacheCounts{
+				Hits:   counts.Hits,
+				Misses: counts.Misses,
+			}
+		}
+	}
+
+	return apiStats
+}
@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DebugTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DebugTestSuite) TestDebugCacheInvalidate() {
+	ctx := context.Background()
+	account := suite.testAccounts["remote_account_1"]
+
+	// Load account twice: one miss, one hit.
+	for i := 0; i < 2; i++ {
+		if _, err := suite.db.GetAccountByURI(ctx, account.URI); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	var before uint64
+	for _, stats := range suite.adminProcessor.DebugCachesGet() {
+		if stats.Name == "Account" {
+			suite.NotNil(stats.Hits)
+			suite.NotNil(stats.Misses)
+			suite.NotNil(stats.Capacity)
+			suite.Nil(stats.Size)
+			suite.Contains(stats.Lookups, "URI")
+			before = *stats.Misses
+		}
+	}
+	suite.NotZero(before)
+
+	// Invalidate the account by URI.
+	stats, errWithCode := suite.adminProcessor.DebugCacheInvalidate("Account", "URI", []string{account.URI})
+	suite.NoError(errWithCode)
+	suite.Equal("Account", stats.Name)
+
+	// Loading it again should now be a miss.
+	if _, err := suite.db.GetAccountByURI(ctx, account.URI); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	for _, stats := range suite.adminProcessor.DebugCachesGet() {
+		if stats.Name == "Account" {
+			suite.Equal(before+1, *stats.Misses)
+		}
+	}
+}
+
+func (suite *DebugTestSuite) TestDebugCacheInvalidateInvalid() {
+	for _, test := range []struct {
+		cache  string
+		lookup string
+		keys   []string
+	}{
+		{cache: "", lookup: "ID", keys: []string{"01F8MH1H7YV1Z7D2C8K2730QBF"}},
+		{cache: "NotACache", lookup: "ID", keys: []string{"01F8MH1H7YV1Z7D2C8K2730QBF"}},
+		{cache: "Account", lookup: "NotALookup", keys: []string{"01F8MH1H7YV1Z7D2C8K2730QBF"}},
+		{cache: "Account", lookup: "Username.Domain", keys: []string{"someone"}},
+		{cache: "DomainBlock", lookup: "Domain", keys: []string{"example.org"}},
+	} {
+		_, errWithCode := suite.adminProcessor.DebugCacheInvalidate(test.cache, test.lookup, test.keys)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+
+	// Clearing a whole cache is always fine.
+	_, errWithCode := suite.adminProcessor.DebugCacheInvalidate("DomainBlock", "", nil)
+	suite.NoError(errWithCode)
+}
+
+func (suite *DebugTestSuite) TestDebugDereferencesGet() {
+	inflight := suite.adminProcessor.DebugDereferencesGet()
+	suite.Empty(inflight.Handshakes)
+	suite.Empty(inflight.Avatars)
+	suite.Empty(inflight.Headers)
+	suite.Empty(inflight.Emojis)
+}
+
+func TestDebugTestSuite(t *testing.T) {
+	suite.Run(t, new(DebugTestSuite))
+}
@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// DomainInteropsGet returns the interop profiles of
+// all remote domains with learned federation quirks.
+func (p *Processor) DomainInteropsGet(ctx context.Context) ([]*apimodel.DomainInterop, gtserror.WithCode) {
+	interops, err := p.state.DB.GetDomainInterops(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain interops: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiInterops := make([]*apimodel.DomainInterop, 0, len(interops))
+	for _, interop := range interops {
+		apiInterop, errWithCode := p.apiDomainInterop(ctx, interop)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiInterops = append(apiInterops, apiInterop)
+	}
+
+	return apiInterops, nil
+}
+
+// DomainInteropGet returns the interop profile of the given domain.
+func (p *Processor) DomainInteropGet(ctx context.Context, domain string) (*apimodel.DomainInterop, gtserror.WithCode) {
+	interop, errWithCode := p.getDomainInterop(ctx, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainInterop(ctx, interop)
+}
+
+// DomainInteropDelete forgets the interop profile of the given
+// domain, such that its quirks will be learned again from scratch.
+// This is useful after the domain has updated its software.
+// The deleted profile is returned.
+func (p *Processor) DomainInteropDelete(ctx context.Context, domain string) (*apimodel.DomainInterop, gtserror.WithCode) {
+	interop, errWithCode := p.getDomainInterop(ctx, domain)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiInterop, errWithCode := p.apiDomainInterop(ctx, interop)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteDomainInterop(ctx, interop.Domain); err != nil {
+		err := gtserror.Newf("db error deleting domain interop %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiInterop, nil
+}
+
+func (p *Processor) getDomainInterop(ctx context.Context, domain string) (*gtsmodel.DomainInterop, gtserror.WithCode) {
+	interop, err := p.state.DB.GetDomainInterop(ctx, domain)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("no interop quirks have been learned for domain %s", domain)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err = gtserror.Newf("db error getting domain interop %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return interop, nil
+}
+
+func (p *Processor) apiDomainInterop(ctx context.Context, interop *gtsmodel.DomainInterop) (*apimodel.DomainInterop, gtserror.WithCode) {
+	apiInterop, err := p.converter.DomainInteropToAPIDomainInterop(ctx, interop)
+	if err != nil {
+		err := gtserror.Newf("error converting domain interop %s: %w", interop.Domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiInterop, nil
+}
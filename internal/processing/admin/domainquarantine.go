@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DomainQuarantinesGet returns a page of new domains
+// whose statuses are held until they're approved.
+func (p *Processor) DomainQuarantinesGet(
+	ctx context.Context,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	quarantines, err := p.state.DB.GetPendingDomainQuarantines(ctx, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain quarantines: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(quarantines)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, q := range quarantines {
+		items = append(items, p.converter.DomainQuarantineToAdminAPIDomainQuarantine(q))
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := quarantines[count-1].ID
+	hi := quarantines[0].ID
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/domain_quarantines",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// DomainQuarantineApprove approves the quarantined domain with the
+// given ID, releasing any statuses from it that are being held
+// into timelines. Later statuses from the domain are not held.
+func (p *Processor) DomainQuarantineApprove(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AdminDomainQuarantine, gtserror.WithCode) {
+	quarantine, err := p.state.DB.GetDomainQuarantineByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain quarantine %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if quarantine == nil {
+		err := fmt.Errorf("domain quarantine %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if quarantine.Approved() {
+		// Already approved,
+		// nothing to do.
+		return p.converter.DomainQuarantineToAdminAPIDomainQuarantine(quarantine), nil
+	}
+
+	quarantine.ApprovedAt = time.Now()
+	quarantine.ApprovedByAccountID = adminAcct.ID
+	if err := p.state.DB.UpdateDomainQuarantine(ctx, quarantine, "approved_at", "approved_by_account_id"); err != nil {
+		err := gtserror.Newf("db error updating domain quarantine %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	held, err := p.state.DB.GetQuarantinedStatusesByDomain(ctx, quarantine.Domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting statuses held for %s: %w", quarantine.Domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Hand each held status over to the
+	// worker to be accepted into timelines.
+	for _, quarantined := range held {
+		if err := p.state.DB.DeleteQuarantinedStatusByID(ctx, quarantined.ID); err != nil {
+			log.Errorf(ctx, "db error deleting quarantined status %s: %v", quarantined.ID, err)
+			continue
+		}
+
+		p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityAccept,
+			GTSModel:       quarantined,
+			OriginAccount:  adminAcct,
+			TargetAccount:  quarantined.Account,
+		})
+	}
+
+	return p.converter.DomainQuarantineToAdminAPIDomainQuarantine(quarantine), nil
+}
@@ -0,0 +1,128 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type DomainQuarantineTestSuite struct {
+	AdminStandardTestSuite
+}
+
+// quarantineDomain puts a pending domain quarantine for
+// the domain of the given status in the database, and
+// holds the status in the queue as if it was from there.
+func (suite *DomainQuarantineTestSuite) quarantineDomain(status *gtsmodel.Status) (*gtsmodel.DomainQuarantine, *gtsmodel.QuarantinedStatus) {
+	ctx := context.Background()
+	domain := suite.testAccounts["remote_account_1"].Domain
+
+	quarantine := &gtsmodel.DomainQuarantine{
+		ID:         id.NewULID(),
+		Domain:     domain,
+		CleanCount: 1,
+	}
+
+	if err := suite.db.PutDomainQuarantine(ctx, quarantine); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	quarantined := &gtsmodel.QuarantinedStatus{
+		ID:        id.NewULID(),
+		StatusID:  status.ID,
+		AccountID: status.AccountID,
+		Reasons:   []string{"first statuses from new domain " + domain},
+		Domain:    domain,
+	}
+
+	if err := suite.db.PutQuarantinedStatus(ctx, quarantined); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return quarantine, quarantined
+}
+
+func (suite *DomainQuarantineTestSuite) TestDomainQuarantinesGet() {
+	ctx := context.Background()
+	quarantine, _ := suite.quarantineDomain(suite.testStatuses["remote_account_1_status_1"])
+
+	resp, errWithCode := suite.adminProcessor.DomainQuarantinesGet(ctx, &paging.Page{Limit: 20})
+	suite.NoError(errWithCode)
+	suite.Len(resp.Items, 1)
+
+	apiQuarantine := resp.Items[0].(*apimodel.AdminDomainQuarantine)
+	suite.Equal(quarantine.ID, apiQuarantine.ID)
+	suite.Equal("fossbros-anonymous.io", apiQuarantine.Domain)
+	suite.Equal(1, apiQuarantine.CleanCount)
+	suite.Empty(apiQuarantine.ApprovedAt)
+}
+
+func (suite *DomainQuarantineTestSuite) TestDomainQuarantineApprove() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+	status := suite.testStatuses["remote_account_1_status_1"]
+	quarantine, quarantined := suite.quarantineDomain(status)
+
+	apiQuarantine, errWithCode := suite.adminProcessor.DomainQuarantineApprove(ctx, adminAcct, quarantine.ID)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(apiQuarantine.ApprovedAt)
+
+	// Domain should be approved by this admin.
+	dbQuarantine, err := suite.db.GetDomainQuarantineByID(ctx, quarantine.ID)
+	suite.NoError(err)
+	suite.True(dbQuarantine.Approved())
+	suite.Equal(adminAcct.ID, dbQuarantine.ApprovedByAccountID)
+
+	// Held status should be released, but stay.
+	_, err = suite.db.GetQuarantinedStatusByID(ctx, quarantined.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+
+	// Approved domain should no longer be pending.
+	resp, errWithCode := suite.adminProcessor.DomainQuarantinesGet(ctx, &paging.Page{Limit: 20})
+	suite.NoError(errWithCode)
+	suite.Empty(resp.Items)
+
+	// Approving again is a no-op.
+	_, errWithCode = suite.adminProcessor.DomainQuarantineApprove(ctx, adminAcct, quarantine.ID)
+	suite.NoError(errWithCode)
+}
+
+func (suite *DomainQuarantineTestSuite) TestDomainQuarantineApproveNotFound() {
+	_, errWithCode := suite.adminProcessor.DomainQuarantineApprove(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		id.NewULID(),
+	)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestDomainQuarantineTestSuite(t *testing.T) {
+	suite.Run(t, new(DomainQuarantineTestSuite))
+}
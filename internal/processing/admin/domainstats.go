@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// DomainStatsGet returns a page of federation stats of remote
+// domains. If failing is true, only stats of domains whose
+// most recent contact failed are returned.
+func (p *Processor) DomainStatsGet(
+	ctx context.Context,
+	page *paging.Page,
+	failing bool,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	statss, err := p.state.DB.GetAllDomainStats(ctx, page, failing)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting domain stats: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(statss)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, stats := range statss {
+		apiStats, errWithCode := p.apiDomainStats(ctx, stats)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		items = append(items, apiStats)
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := statss[count-1].ID
+	hi := statss[0].ID
+
+	// Keep the failing filter
+	// when paging through stats.
+	var query url.Values
+	if failing {
+		query = url.Values{"failing": []string{strconv.FormatBool(failing)}}
+	}
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/domain_stats",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+		Query: query,
+	}), nil
+}
+
+// DomainStatGet returns the federation stats of the given domain.
+func (p *Processor) DomainStatGet(ctx context.Context, domain string) (*apimodel.DomainStats, gtserror.WithCode) {
+	stats, err := p.state.DB.GetDomainStats(ctx, domain)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("domain %s has not been contacted", domain)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+
+		err = gtserror.Newf("db error getting domain stats %s: %w", domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDomainStats(ctx, stats)
+}
+
+func (p *Processor) apiDomainStats(ctx context.Context, stats *gtsmodel.DomainStats) (*apimodel.DomainStats, gtserror.WithCode) {
+	apiStats, err := p.converter.DomainStatsToAPIDomainStats(ctx, stats)
+	if err != nil {
+		err := gtserror.Newf("error converting domain stats %s: %w", stats.Domain, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiStats, nil
+}
@@ -0,0 +1,143 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// EmailDomainBlocksGet returns all email domain blocks stored on this instance.
+func (p *Processor) EmailDomainBlocksGet(ctx context.Context) ([]*apimodel.AdminEmailDomainBlock, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetEmailDomainBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting email domain blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiBlocks := make([]*apimodel.AdminEmailDomainBlock, len(blocks))
+	for i, block := range blocks {
+		apiBlocks[i] = p.converter.EmailDomainBlockToAdminAPIEmailDomainBlock(block)
+	}
+
+	return apiBlocks, nil
+}
+
+// EmailDomainBlockGet returns one email domain block, with the given ID.
+func (p *Processor) EmailDomainBlockGet(ctx context.Context, id string) (*apimodel.AdminEmailDomainBlock, gtserror.WithCode) {
+	block, errWithCode := p.getEmailDomainBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.EmailDomainBlockToAdminAPIEmailDomainBlock(block), nil
+}
+
+// EmailDomainBlockCreate blocks new sign-ups using email
+// addresses at the given domain, or any of its subdomains.
+func (p *Processor) EmailDomainBlockCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.AdminEmailDomainBlockRequest,
+) (*apimodel.AdminEmailDomainBlock, gtserror.WithCode) {
+	// Allow admins to paste in "@example.org" too.
+	domain := strings.TrimPrefix(strings.TrimSpace(form.Domain), "@")
+	if domain == "" || strings.ContainsAny(domain, "@/: ") {
+		err := fmt.Errorf("domain %q is not a valid email domain", form.Domain)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	domain, err := util.Punify(domain)
+	if err != nil {
+		err := fmt.Errorf("domain %q is not a valid email domain: %w", form.Domain, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	blocked, err := p.state.DB.IsEmailDomainBlocked(ctx, domain)
+	if err != nil {
+		err := gtserror.Newf("db error checking email domain block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if blocked {
+		// Either this domain or a parent
+		// domain is already blocked.
+		text := "email domain " + domain + " is already blocked"
+		return nil, gtserror.NewErrorConflict(errors.New(text), text)
+	}
+
+	now := time.Now()
+	block := &gtsmodel.EmailDomainBlock{
+		ID:                 id.NewULID(),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		Domain:             domain,
+		Comment:            form.Comment,
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+	}
+
+	if err := p.state.DB.PutEmailDomainBlock(ctx, block); err != nil {
+		err := gtserror.Newf("db error putting email domain block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.EmailDomainBlockToAdminAPIEmailDomainBlock(block), nil
+}
+
+// EmailDomainBlockDelete deletes an existing email domain block.
+func (p *Processor) EmailDomainBlockDelete(ctx context.Context, id string) (*apimodel.AdminEmailDomainBlock, gtserror.WithCode) {
+	block, errWithCode := p.getEmailDomainBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteEmailDomainBlockByID(ctx, block.ID); err != nil {
+		err := gtserror.Newf("db error deleting email domain block %s: %w", block.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.EmailDomainBlockToAdminAPIEmailDomainBlock(block), nil
+}
+
+// getEmailDomainBlock returns the email domain block with
+// the given ID, or a not found error if there's no such block.
+func (p *Processor) getEmailDomainBlock(ctx context.Context, id string) (*gtsmodel.EmailDomainBlock, gtserror.WithCode) {
+	block, err := p.state.DB.GetEmailDomainBlockByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting email domain block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if block == nil {
+		err := fmt.Errorf("email domain block %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return block, nil
+}
@@ -0,0 +1,83 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type EmailDomainBlockTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *EmailDomainBlockTestSuite) TestEmailDomainBlockCreateGetDelete() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	apiBlock, errWithCode := suite.adminProcessor.EmailDomainBlockCreate(ctx, adminAcct, &apimodel.AdminEmailDomainBlockRequest{
+		Domain:  "@Example.org",
+		Comment: "throwaway addresses",
+	})
+	suite.NoError(errWithCode)
+	suite.Equal("example.org", apiBlock.Domain)
+	suite.Equal("throwaway addresses", apiBlock.Comment)
+	suite.Equal(adminAcct.ID, apiBlock.CreatedBy)
+
+	// Subdomains are already covered by the block.
+	_, errWithCode = suite.adminProcessor.EmailDomainBlockCreate(ctx, adminAcct, &apimodel.AdminEmailDomainBlockRequest{
+		Domain: "mail.example.org",
+	})
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	apiBlocks, errWithCode := suite.adminProcessor.EmailDomainBlocksGet(ctx)
+	suite.NoError(errWithCode)
+	suite.Len(apiBlocks, 1)
+	suite.Equal(apiBlock.ID, apiBlocks[0].ID)
+
+	_, errWithCode = suite.adminProcessor.EmailDomainBlockDelete(ctx, apiBlock.ID)
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.EmailDomainBlockGet(ctx, apiBlock.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *EmailDomainBlockTestSuite) TestEmailDomainBlockCreateInvalid() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	for _, domain := range []string{
+		"",
+		"someone@example.org",
+		"https://example.org",
+		"example .org",
+	} {
+		_, errWithCode := suite.adminProcessor.EmailDomainBlockCreate(ctx, adminAcct, &apimodel.AdminEmailDomainBlockRequest{
+			Domain: domain,
+		})
+		suite.Equal(http.StatusBadRequest, errWithCode.Code(), domain)
+	}
+}
+
+func TestEmailDomainBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(EmailDomainBlockTestSuite))
+}
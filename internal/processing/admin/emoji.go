@@ -62,16 +62,18 @@ func (p *Processor) EmojiCreate(ctx context.Context, account *gtsmodel.Account,
 		return f, form.Image.Size, err
 	}
 
-	var ai *media.AdditionalEmojiInfo
+	ai := &media.AdditionalEmojiInfo{
+		License:     &form.License,
+		Attribution: &form.Attribution,
+	}
+
 	if form.CategoryName != "" {
 		category, err := p.getOrCreateEmojiCategory(ctx, form.CategoryName)
 		if err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting id in category: %s", err), "error putting id in category")
 		}
 
-		ai = &media.AdditionalEmojiInfo{
-			CategoryID: &category.ID,
-		}
+		ai.CategoryID = &category.ID
 	}
 
 	processingEmoji, err := p.mediaManager.PreProcessEmoji(ctx, data, form.Shortcode, emojiID, emojiURI, ai, false)
@@ -243,7 +245,7 @@ func (p *Processor) EmojiUpdate(ctx context.Context, id string, form *apimodel.E
 	case apimodel.EmojiUpdateDisable:
 		return p.emojiUpdateDisable(ctx, emoji)
 	case apimodel.EmojiUpdateModify:
-		return p.emojiUpdateModify(ctx, emoji, form.Image, form.CategoryName)
+		return p.emojiUpdateModify(ctx, emoji, form.Image, form.CategoryName, form.License, form.Attribution)
 	default:
 		err := errors.New("unrecognized emoji action type")
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
@@ -401,7 +403,14 @@ func (p *Processor) emojiUpdateDisable(ctx context.Context, emoji *gtsmodel.Emoj
 }
 
 // modify a local emoji
-func (p *Processor) emojiUpdateModify(ctx context.Context, emoji *gtsmodel.Emoji, image *multipart.FileHeader, categoryName *string) (*apimodel.AdminEmoji, gtserror.WithCode) {
+func (p *Processor) emojiUpdateModify(
+	ctx context.Context,
+	emoji *gtsmodel.Emoji,
+	image *multipart.FileHeader,
+	categoryName *string,
+	license *string,
+	attribution *string,
+) (*apimodel.AdminEmoji, gtserror.WithCode) {
 	if emoji.Domain != "" {
 		err := fmt.Errorf("emojiUpdateModify: emoji %s is not a local emoji, cannot do a modify action on it", emoji.ID)
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
@@ -439,6 +448,16 @@ func (p *Processor) emojiUpdateModify(ctx context.Context, emoji *gtsmodel.Emoji
 			columns = append(columns, "category_id")
 		}
 
+		if license != nil {
+			emoji.License = *license
+			columns = append(columns, "license")
+		}
+
+		if attribution != nil {
+			emoji.Attribution = *attribution
+			columns = append(columns, "attribution")
+		}
+
 		var err error
 		err = p.state.DB.UpdateEmoji(ctx, emoji, columns...)
 		if err != nil {
@@ -452,11 +471,13 @@ func (p *Processor) emojiUpdateModify(ctx context.Context, emoji *gtsmodel.Emoji
 			return i, image.Size, err
 		}
 
-		var ai *media.AdditionalEmojiInfo
+		ai := &media.AdditionalEmojiInfo{
+			License:     license,
+			Attribution: attribution,
+		}
+
 		if updateCategoryID {
-			ai = &media.AdditionalEmojiInfo{
-				CategoryID: &updatedCategoryID,
-			}
+			ai.CategoryID = &updatedCategoryID
 		}
 
 		processingEmoji, err := p.mediaManager.PreProcessEmoji(ctx, data, emoji.Shortcode, emoji.ID, emoji.URI, ai, true)
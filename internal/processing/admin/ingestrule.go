@@ -0,0 +1,194 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ingest"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// IngestRulesGet returns all ingest rules stored on this instance.
+func (p *Processor) IngestRulesGet(ctx context.Context) ([]*apimodel.AdminIngestRule, gtserror.WithCode) {
+	rules, err := p.state.DB.GetIngestRules(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting ingest rules: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRules := make([]*apimodel.AdminIngestRule, len(rules))
+	for i, rule := range rules {
+		apiRules[i] = p.converter.IngestRuleToAdminAPIIngestRule(rule)
+	}
+
+	return apiRules, nil
+}
+
+// IngestRuleGet returns one ingest rule, with the given ID.
+func (p *Processor) IngestRuleGet(ctx context.Context, id string) (*apimodel.AdminIngestRule, gtserror.WithCode) {
+	rule, errWithCode := p.getIngestRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.IngestRuleToAdminAPIIngestRule(rule), nil
+}
+
+// IngestRuleCreate adds a new ingest rule to this instance,
+// which applies to statuses coming in from then on.
+func (p *Processor) IngestRuleCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.AdminIngestRuleRequest,
+) (*apimodel.AdminIngestRule, gtserror.WithCode) {
+	rule, errWithCode := newIngestRule(form.Pattern, form.Regex)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	switch action := gtsmodel.IngestRuleAction(form.Action); action {
+	case gtsmodel.IngestRuleActionDrop, gtsmodel.IngestRuleActionUnlist:
+		rule.Action = action
+	default:
+		err := fmt.Errorf("action must be one of %s or %s", gtsmodel.IngestRuleActionDrop, gtsmodel.IngestRuleActionUnlist)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	now := time.Now()
+	rule.ID = id.NewULID()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+	rule.Comment = form.Comment
+	rule.CreatedByAccountID = adminAcct.ID
+	rule.CreatedByAccount = adminAcct
+
+	if err := p.state.DB.PutIngestRule(ctx, rule); err != nil {
+		err := gtserror.Newf("db error putting ingest rule: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.IngestRuleToAdminAPIIngestRule(rule), nil
+}
+
+// IngestRuleDelete deletes an existing ingest rule.
+// Statuses that were unlisted by the rule stay unlisted.
+func (p *Processor) IngestRuleDelete(ctx context.Context, id string) (*apimodel.AdminIngestRule, gtserror.WithCode) {
+	rule, errWithCode := p.getIngestRule(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteIngestRuleByID(ctx, rule.ID); err != nil {
+		err := gtserror.Newf("db error deleting ingest rule %s: %w", rule.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.IngestRuleToAdminAPIIngestRule(rule), nil
+}
+
+// IngestRuleTest checks the given text against ingest rules,
+// without counting any hits. If a pattern is given in the form,
+// the text is checked against only that pattern, so that admins
+// can try out a rule before creating it; otherwise, the text
+// is checked against all the rules stored on this instance.
+func (p *Processor) IngestRuleTest(ctx context.Context, form *apimodel.AdminIngestRuleTestRequest) (*apimodel.AdminIngestRuleTestResult, gtserror.WithCode) {
+	var rules []*gtsmodel.IngestRule
+
+	if form.Pattern != "" {
+		rule, errWithCode := newIngestRule(form.Pattern, form.Regex)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		// Pattern is already known to compile.
+		r, _ := ingest.Compile(rule)
+		if r.MatchString(form.Text) {
+			rules = append(rules, rule)
+		}
+	} else {
+		var err error
+		rules, err = p.state.DB.MatchIngestRules(ctx, form.Text)
+		if err != nil {
+			err := gtserror.Newf("error matching ingest rules: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	result := &apimodel.AdminIngestRuleTestResult{
+		Matched: len(rules) != 0,
+		Rules:   make([]*apimodel.AdminIngestRule, len(rules)),
+	}
+
+	for i, rule := range rules {
+		result.Rules[i] = p.converter.IngestRuleToAdminAPIIngestRule(rule)
+
+		// Dropping takes precedence over unlisting.
+		if result.Action != string(gtsmodel.IngestRuleActionDrop) {
+			result.Action = string(rule.Action)
+		}
+	}
+
+	return result, nil
+}
+
+// getIngestRule returns the ingest rule with the given ID,
+// or a not found error if there's no such rule.
+func (p *Processor) getIngestRule(ctx context.Context, id string) (*gtsmodel.IngestRule, gtserror.WithCode) {
+	rule, err := p.state.DB.GetIngestRuleByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting ingest rule %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if rule == nil {
+		err := fmt.Errorf("ingest rule %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return rule, nil
+}
+
+// newIngestRule returns a new, unstored ingest rule with the
+// given pattern, checking that the pattern is valid first.
+func newIngestRule(pattern string, regex bool) (*gtsmodel.IngestRule, gtserror.WithCode) {
+	if pattern == "" {
+		err := errors.New("pattern must be set")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	rule := &gtsmodel.IngestRule{
+		Pattern: pattern,
+		Regex:   util.Ptr(regex),
+	}
+
+	if _, err := ingest.Compile(rule); err != nil {
+		err := fmt.Errorf("pattern is not a valid regular expression: %w", err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	return rule, nil
+}
@@ -0,0 +1,138 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type IngestRuleTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *IngestRuleTestSuite) TestIngestRuleCreateGetDelete() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	apiRule, errWithCode := suite.adminProcessor.IngestRuleCreate(ctx, adminAcct, &apimodel.AdminIngestRuleRequest{
+		Pattern: "cheap followers",
+		Action:  "drop",
+		Comment: "spam wave",
+	})
+	suite.NoError(errWithCode)
+	suite.Equal("cheap followers", apiRule.Pattern)
+	suite.False(apiRule.Regex)
+	suite.Equal("drop", apiRule.Action)
+	suite.Equal("spam wave", apiRule.Comment)
+	suite.Equal(adminAcct.ID, apiRule.CreatedBy)
+	suite.Zero(apiRule.Hits)
+	suite.Nil(apiRule.LastHitAt)
+
+	apiRules, errWithCode := suite.adminProcessor.IngestRulesGet(ctx)
+	suite.NoError(errWithCode)
+	suite.Len(apiRules, 1)
+	suite.Equal(apiRule.ID, apiRules[0].ID)
+
+	_, errWithCode = suite.adminProcessor.IngestRuleDelete(ctx, apiRule.ID)
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.IngestRuleGet(ctx, apiRule.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *IngestRuleTestSuite) TestIngestRuleCreateInvalid() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	for _, form := range []*apimodel.AdminIngestRuleRequest{
+		{Pattern: "", Action: "drop"},
+		{Pattern: "spam", Action: "delete"},
+		{Pattern: "spam(", Regex: true, Action: "drop"},
+	} {
+		_, errWithCode := suite.adminProcessor.IngestRuleCreate(ctx, adminAcct, form)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code())
+	}
+}
+
+func (suite *IngestRuleTestSuite) TestIngestRuleTest() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	for _, form := range []*apimodel.AdminIngestRuleRequest{
+		{Pattern: "cheap followers", Action: string(gtsmodel.IngestRuleActionUnlist)},
+		{Pattern: `https?://spam\.example\.com`, Regex: true, Action: string(gtsmodel.IngestRuleActionDrop)},
+	} {
+		if _, errWithCode := suite.adminProcessor.IngestRuleCreate(ctx, adminAcct, form); errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+	}
+
+	// Check against all stored rules.
+	result, errWithCode := suite.adminProcessor.IngestRuleTest(ctx, &apimodel.AdminIngestRuleTestRequest{
+		Text: "Cheap followers at https://spam.example.com",
+	})
+	suite.NoError(errWithCode)
+	suite.True(result.Matched)
+	suite.Equal("drop", result.Action)
+	suite.Len(result.Rules, 2)
+
+	result, errWithCode = suite.adminProcessor.IngestRuleTest(ctx, &apimodel.AdminIngestRuleTestRequest{
+		Text: "cheap followers here",
+	})
+	suite.NoError(errWithCode)
+	suite.True(result.Matched)
+	suite.Equal("unlist", result.Action)
+	suite.Len(result.Rules, 1)
+
+	// Check against a pattern that isn't stored.
+	result, errWithCode = suite.adminProcessor.IngestRuleTest(ctx, &apimodel.AdminIngestRuleTestRequest{
+		Text:    "cheap followers here",
+		Pattern: "(?i)^CHEAP",
+		Regex:   true,
+	})
+	suite.NoError(errWithCode)
+	suite.True(result.Matched)
+	suite.Empty(result.Action)
+	suite.Len(result.Rules, 1)
+	suite.Empty(result.Rules[0].ID)
+
+	result, errWithCode = suite.adminProcessor.IngestRuleTest(ctx, &apimodel.AdminIngestRuleTestRequest{
+		Text:    "nothing to see here",
+		Pattern: "cheap",
+	})
+	suite.NoError(errWithCode)
+	suite.False(result.Matched)
+	suite.Empty(result.Rules)
+
+	// Testing doesn't count hits.
+	apiRules, errWithCode := suite.adminProcessor.IngestRulesGet(ctx)
+	suite.NoError(errWithCode)
+	for _, apiRule := range apiRules {
+		suite.Zero(apiRule.Hits)
+	}
+}
+
+func TestIngestRuleTestSuite(t *testing.T) {
+	suite.Run(t, &IngestRuleTestSuite{})
+}
@@ -0,0 +1,145 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache/ipblock"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// IPBlocksGet returns all ip blocks stored on this instance.
+func (p *Processor) IPBlocksGet(ctx context.Context) ([]*apimodel.AdminIPBlock, gtserror.WithCode) {
+	blocks, err := p.state.DB.GetIPBlocks(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting ip blocks: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiBlocks := make([]*apimodel.AdminIPBlock, len(blocks))
+	for i, block := range blocks {
+		apiBlocks[i] = p.converter.IPBlockToAdminAPIIPBlock(block)
+	}
+
+	return apiBlocks, nil
+}
+
+// IPBlockGet returns one ip block, with the given ID.
+func (p *Processor) IPBlockGet(ctx context.Context, id string) (*apimodel.AdminIPBlock, gtserror.WithCode) {
+	block, errWithCode := p.getIPBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.IPBlockToAdminAPIIPBlock(block), nil
+}
+
+// IPBlockCreate adds a new block on an ip address or
+// range, which applies to requests from then on.
+func (p *Processor) IPBlockCreate(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	form *apimodel.AdminIPBlockRequest,
+) (*apimodel.AdminIPBlock, gtserror.WithCode) {
+	prefix, err := ipblock.Parse(form.IP)
+	if err != nil {
+		err := fmt.Errorf("ip %s is not a valid ip address or cidr range: %w", form.IP, err)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	severity := gtsmodel.IPBlockSeverity(form.Severity)
+	switch severity {
+	case gtsmodel.IPBlockSignUpBlock, gtsmodel.IPBlockNoAccess:
+		// Fine.
+	default:
+		err := fmt.Errorf("severity must be one of %s or %s", gtsmodel.IPBlockSignUpBlock, gtsmodel.IPBlockNoAccess)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.ExpiresIn < 0 {
+		err := errors.New("expires_in must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	now := time.Now()
+	block := &gtsmodel.IPBlock{
+		ID:                 id.NewULID(),
+		CreatedAt:          now,
+		UpdatedAt:          now,
+		IP:                 prefix.String(),
+		Severity:           severity,
+		Comment:            form.Comment,
+		CreatedByAccountID: adminAcct.ID,
+		CreatedByAccount:   adminAcct,
+	}
+
+	if form.ExpiresIn > 0 {
+		block.ExpiresAt = now.Add(time.Duration(form.ExpiresIn) * time.Second)
+	}
+
+	if err := p.state.DB.PutIPBlock(ctx, block); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			text := "a block on " + block.IP + " already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+		err := gtserror.Newf("db error putting ip block: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.IPBlockToAdminAPIIPBlock(block), nil
+}
+
+// IPBlockDelete deletes an existing ip block.
+func (p *Processor) IPBlockDelete(ctx context.Context, id string) (*apimodel.AdminIPBlock, gtserror.WithCode) {
+	block, errWithCode := p.getIPBlock(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteIPBlockByID(ctx, block.ID); err != nil {
+		err := gtserror.Newf("db error deleting ip block %s: %w", block.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.IPBlockToAdminAPIIPBlock(block), nil
+}
+
+// getIPBlock returns the ip block with the given ID,
+// or a not found error if there's no such block.
+func (p *Processor) getIPBlock(ctx context.Context, id string) (*gtsmodel.IPBlock, gtserror.WithCode) {
+	block, err := p.state.DB.GetIPBlockByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting ip block %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if block == nil {
+		err := fmt.Errorf("ip block %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	return block, nil
+}
@@ -0,0 +1,98 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type IPBlockTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *IPBlockTestSuite) TestIPBlockCreateGetDelete() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	apiBlock, errWithCode := suite.adminProcessor.IPBlockCreate(ctx, adminAcct, &apimodel.AdminIPBlockRequest{
+		IP:        "192.0.2.7/24",
+		Severity:  "sign_up_block",
+		Comment:   "sign-up spam",
+		ExpiresIn: 3600,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal("192.0.2.0/24", apiBlock.IP)
+	suite.Equal("sign_up_block", apiBlock.Severity)
+	suite.Equal("sign-up spam", apiBlock.Comment)
+	suite.Equal(adminAcct.ID, apiBlock.CreatedBy)
+	suite.NotNil(apiBlock.ExpiresAt)
+
+	// The same range can't be blocked twice.
+	_, errWithCode = suite.adminProcessor.IPBlockCreate(ctx, adminAcct, &apimodel.AdminIPBlockRequest{
+		IP:       "192.0.2.0/24",
+		Severity: "no_access",
+	})
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	apiBlocks, errWithCode := suite.adminProcessor.IPBlocksGet(ctx)
+	suite.NoError(errWithCode)
+	suite.Len(apiBlocks, 1)
+	suite.Equal(apiBlock.ID, apiBlocks[0].ID)
+
+	_, errWithCode = suite.adminProcessor.IPBlockDelete(ctx, apiBlock.ID)
+	suite.NoError(errWithCode)
+
+	_, errWithCode = suite.adminProcessor.IPBlockGet(ctx, apiBlock.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *IPBlockTestSuite) TestIPBlockCreateSingleAddress() {
+	apiBlock, errWithCode := suite.adminProcessor.IPBlockCreate(
+		context.Background(),
+		suite.testAccounts["admin_account"],
+		&apimodel.AdminIPBlockRequest{IP: "2001:db8::1", Severity: "no_access"},
+	)
+	suite.NoError(errWithCode)
+	suite.Equal("2001:db8::1/128", apiBlock.IP)
+	suite.Nil(apiBlock.ExpiresAt)
+}
+
+func (suite *IPBlockTestSuite) TestIPBlockCreateInvalid() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	for _, form := range []*apimodel.AdminIPBlockRequest{
+		{IP: "", Severity: "no_access"},
+		{IP: "192.0.2.300", Severity: "no_access"},
+		{IP: "192.0.2.0/33", Severity: "no_access"},
+		{IP: "192.0.2.0/24", Severity: "suspend"},
+		{IP: "192.0.2.0/24", Severity: "no_access", ExpiresIn: -1},
+	} {
+		_, errWithCode := suite.adminProcessor.IPBlockCreate(ctx, adminAcct, form)
+		suite.Equal(http.StatusBadRequest, errWithCode.Code(), form.IP)
+	}
+}
+
+func TestIPBlockTestSuite(t *testing.T) {
+	suite.Run(t, new(IPBlockTestSuite))
+}
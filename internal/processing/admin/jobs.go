@@ -0,0 +1,109 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// JobsGet returns all of the instance's
+// scheduled background jobs, sorted by name.
+func (p *Processor) JobsGet(ctx context.Context) ([]*apimodel.AdminJob, gtserror.WithCode) {
+	infos, err := p.state.Jobs.List(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiJobs := make([]*apimodel.AdminJob, 0, len(infos))
+	for _, info := range infos {
+		apiJobs = append(apiJobs, apiJob(info))
+	}
+
+	return apiJobs, nil
+}
+
+// JobGet returns the scheduled background job with the given name.
+func (p *Processor) JobGet(ctx context.Context, name string) (*apimodel.AdminJob, gtserror.WithCode) {
+	info, err := p.state.Jobs.Get(ctx, name)
+	if err != nil {
+		return nil, jobErrWithCode(err)
+	}
+
+	return apiJob(info), nil
+}
+
+// JobRun triggers a run of the job with the given name in the
+// background, regardless of its schedule or whether it's paused.
+func (p *Processor) JobRun(ctx context.Context, name string) (*apimodel.AdminJob, gtserror.WithCode) {
+	if err := p.state.Jobs.Trigger(name); err != nil {
+		return nil, jobErrWithCode(err)
+	}
+
+	return p.JobGet(ctx, name)
+}
+
+// JobPause pauses or unpauses the job with the given name.
+func (p *Processor) JobPause(ctx context.Context, name string, paused bool) (*apimodel.AdminJob, gtserror.WithCode) {
+	if err := p.state.Jobs.SetPaused(ctx, name, paused); err != nil {
+		return nil, jobErrWithCode(err)
+	}
+
+	return p.JobGet(ctx, name)
+}
+
+// jobErrWithCode wraps an error returned
+// by jobs.Jobs with a suitable status code.
+func jobErrWithCode(err error) gtserror.WithCode {
+	switch {
+	case errors.Is(err, jobs.ErrUnknownJob):
+		return gtserror.NewErrorNotFound(err, err.Error())
+	case errors.Is(err, jobs.ErrJobRunning):
+		return gtserror.NewErrorConflict(err, err.Error())
+	default:
+		return gtserror.NewErrorInternalError(err)
+	}
+}
+
+func apiJob(info jobs.Info) *apimodel.AdminJob {
+	apiJob := &apimodel.AdminJob{
+		Name:            info.Name,
+		Schedule:        info.Schedule,
+		Paused:          *info.Job.Paused,
+		Running:         info.Running,
+		LastRunStatus:   string(info.Job.LastRunStatus),
+		LastRunError:    info.Job.LastRunError,
+		LastRunDuration: info.Job.LastRunDuration,
+		LastRunAttempts: info.Job.LastRunAttempts,
+	}
+
+	if !info.Next.IsZero() {
+		apiJob.NextRunAt = util.FormatISO8601(info.Next)
+	}
+
+	if !info.Job.LastRunAt.IsZero() {
+		apiJob.LastRunAt = util.FormatISO8601(info.Job.LastRunAt)
+	}
+
+	return apiJob
+}
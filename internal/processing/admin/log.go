@@ -0,0 +1,74 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"slices"
+
+	"codeberg.org/gruf/go-logger/v2/level"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// LogLevelsGet returns the default log level
+// and subsystem overrides currently in effect.
+func (p *Processor) LogLevelsGet() *apimodel.AdminLogLevels {
+	subsystems := []string{}
+	for subsystem, lvl := range log.SubsystemLevels() {
+		subsystems = append(subsystems, subsystem+"="+log.LevelString(lvl))
+	}
+	slices.Sort(subsystems)
+
+	return &apimodel.AdminLogLevels{
+		Level:      log.LevelString(log.DefaultLevel()),
+		Subsystems: subsystems,
+	}
+}
+
+// LogLevelsSet changes the default log level and / or subsystem
+// overrides at runtime. Changes are reflected in the instance
+// configuration, but not persisted to the config file, so they
+// are reverted on restart or when the config file is reloaded.
+func (p *Processor) LogLevelsSet(form *apimodel.AdminLogLevelsRequest) (*apimodel.AdminLogLevels, gtserror.WithCode) {
+	var lvl level.LEVEL
+
+	if form.Level != nil {
+		// Check level is valid before changing anything.
+		var err error
+		lvl, err = log.LevelFromString(*form.Level)
+		if err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	if form.Subsystems != nil {
+		if err := log.ParseSubsystemLevels(*form.Subsystems); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		config.SetLogLevels(*form.Subsystems)
+	}
+
+	if form.Level != nil {
+		log.SetLevel(lvl)
+		config.SetLogLevel(log.LevelString(lvl))
+	}
+
+	return p.LogLevelsGet(), nil
+}
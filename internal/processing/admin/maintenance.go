@@ -0,0 +1,216 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"codeberg.org/gruf/go-sched"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// maintenanceCountdown contains the offsets before the start of
+// a maintenance window at which countdown events will be streamed.
+var maintenanceCountdown = []time.Duration{
+	time.Hour,
+	30 * time.Minute,
+	10 * time.Minute,
+	5 * time.Minute,
+	time.Minute,
+}
+
+// maintenance tracks announcements which have a maintenance
+// window scheduled, along with the cancel functions for jobs
+// scheduled on their behalf.
+type maintenance struct {
+	announcements map[string]*gtsmodel.Announcement
+	cancels       map[string][]func()
+	mu            sync.Mutex
+}
+
+// MaintenanceActive returns whether the instance is currently in
+// read-only maintenance mode, and if so, when maintenance will end.
+// The returned end time will be zero if there is no scheduled end.
+func (p *Processor) MaintenanceActive() (bool, time.Time) {
+	p.maintenance.mu.Lock()
+	defer p.maintenance.mu.Unlock()
+
+	var (
+		now       = time.Now()
+		active    bool
+		openEnded bool
+		until     time.Time
+	)
+
+	for _, a := range p.maintenance.announcements {
+		if !a.InMaintenance(now) {
+			continue
+		}
+
+		active = true
+
+		// Maintenance lasts until the
+		// latest-ending window is over.
+		if a.EndsAt.IsZero() {
+			openEnded = true
+		} else if a.EndsAt.After(until) {
+			until = a.EndsAt
+		}
+	}
+
+	if openEnded {
+		until = time.Time{}
+	}
+
+	return active, until
+}
+
+// MaintenanceScheduleAll loads all announcements with upcoming or
+// ongoing maintenance windows from the database, and schedules their
+// announcement and maintenance countdown events. It should be called
+// once on startup, after the worker scheduler has been started.
+func (p *Processor) MaintenanceScheduleAll(ctx context.Context) error {
+	announcements, err := p.state.DB.GetMaintenanceAnnouncements(ctx)
+	if err != nil {
+		return gtserror.Newf("db error getting maintenance announcements: %w", err)
+	}
+
+	for _, a := range announcements {
+		p.scheduleAnnouncement(a)
+	}
+
+	return nil
+}
+
+// scheduleAnnouncement starts tracking the given announcement,
+// and schedules any of its streaming events which are still
+// in the future.
+func (p *Processor) scheduleAnnouncement(a *gtsmodel.Announcement) {
+	if !*a.Published {
+		// Nothing to stream yet.
+		return
+	}
+
+	p.maintenance.mu.Lock()
+	defer p.maintenance.mu.Unlock()
+
+	now := time.Now()
+	cancels := []func(){}
+
+	schedule := func(at time.Time, fn func()) {
+		if !at.After(now) {
+			// Already happened.
+			return
+		}
+
+		if !p.state.Workers.Scheduler.Running() {
+			log.Warnf(nil, "scheduler not running, skipping event for announcement %s", a.ID)
+			return
+		}
+
+		cancel := p.state.Workers.Scheduler.Schedule(sched.NewJob(func(time.Time) { fn() }).At(at))
+		cancels = append(cancels, cancel)
+	}
+
+	if !a.StartsAt.IsZero() {
+		// Stream the announcement
+		// once it becomes active.
+		schedule(a.StartsAt, func() {
+			apiAnnouncement := p.converter.AnnouncementToAPIAnnouncement(a)
+			if err := p.stream.Announcement(apiAnnouncement); err != nil {
+				log.Errorf(nil, "error streaming announcement %s: %v", a.ID, err)
+			}
+		})
+	}
+
+	if !a.MaintenanceAt.IsZero() {
+		// Stream countdown events leading up to maintenance.
+		for _, offset := range maintenanceCountdown {
+			offset := offset
+			schedule(a.MaintenanceAt.Add(-offset), func() {
+				p.streamMaintenance(a, int64(offset/time.Second), false)
+			})
+		}
+
+		// Stream the start of maintenance.
+		schedule(a.MaintenanceAt, func() {
+			log.Infof(nil, "entering maintenance mode for announcement %s", a.ID)
+			p.streamMaintenance(a, 0, true)
+		})
+
+		// Stream the end of maintenance (if any).
+		if !a.EndsAt.IsZero() {
+			schedule(a.EndsAt, func() {
+				log.Infof(nil, "leaving maintenance mode for announcement %s", a.ID)
+				p.streamMaintenance(a, 0, false)
+			})
+		}
+
+		p.maintenance.announcements[a.ID] = a
+	}
+
+	p.maintenance.cancels[a.ID] = cancels
+}
+
+// unscheduleAnnouncement stops tracking the announcement with the
+// given ID, cancelling any of its streaming events still pending.
+// It returns whether the announcement had put the instance into
+// maintenance mode.
+func (p *Processor) unscheduleAnnouncement(id string) bool {
+	p.maintenance.mu.Lock()
+	defer p.maintenance.mu.Unlock()
+
+	for _, cancel := range p.maintenance.cancels[id] {
+		cancel()
+	}
+
+	var inMaintenance bool
+	if a, ok := p.maintenance.announcements[id]; ok {
+		inMaintenance = a.InMaintenance(time.Now())
+	}
+
+	delete(p.maintenance.cancels, id)
+	delete(p.maintenance.announcements, id)
+
+	return inMaintenance
+}
+
+// streamMaintenance streams the maintenance
+// state of the given announcement to clients.
+func (p *Processor) streamMaintenance(a *gtsmodel.Announcement, secondsRemaining int64, active bool) {
+	m := &apimodel.Maintenance{
+		AnnouncementID:   a.ID,
+		StartsAt:         util.FormatISO8601(a.MaintenanceAt),
+		SecondsRemaining: secondsRemaining,
+		Active:           active,
+	}
+
+	if !a.EndsAt.IsZero() {
+		m.EndsAt = util.FormatISO8601(a.EndsAt)
+	}
+
+	if err := p.stream.Maintenance(m); err != nil {
+		log.Errorf(nil, "error streaming maintenance for announcement %s: %v", a.ID, err)
+	}
+}
@@ -0,0 +1,199 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// PagesGet returns all static pages stored on this instance.
+func (p *Processor) PagesGet(ctx context.Context) ([]*apimodel.InstancePage, gtserror.WithCode) {
+	pages, err := p.state.DB.GetInstancePages(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting instance pages: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiPages := make([]*apimodel.InstancePage, len(pages))
+	for i, page := range pages {
+		apiPages[i] = p.converter.InstancePageToAPIInstancePage(page)
+	}
+
+	return apiPages, nil
+}
+
+// PageGet returns one static page, with the given ID.
+func (p *Processor) PageGet(ctx context.Context, id string) (*apimodel.InstancePage, gtserror.WithCode) {
+	page, errWithCode := p.getPage(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.converter.InstancePageToAPIInstancePage(page), nil
+}
+
+// PageCreate adds a new static page to the instance.
+func (p *Processor) PageCreate(ctx context.Context, form *apimodel.InstancePageCreateRequest) (*apimodel.InstancePage, gtserror.WithCode) {
+	if err := validate.InstancePageSlug(form.Slug); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := validate.InstancePageTitle(form.Title); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := validate.InstancePageText(form.Text); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.ShowInNav == nil {
+		// Pages are shown in
+		// navigation by default.
+		form.ShowInNav = util.Ptr(true)
+	}
+
+	pageID := id.NewULID()
+	page := &gtsmodel.InstancePage{
+		ID:        pageID,
+		Slug:      form.Slug,
+		Title:     form.Title,
+		Text:      form.Text,
+		Content:   p.formatPage(ctx, pageID, form.Text),
+		NavOrder:  form.NavOrder,
+		ShowInNav: form.ShowInNav,
+	}
+
+	if err := p.state.DB.PutInstancePage(ctx, page); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			text := "a page with slug " + form.Slug + " already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+		err := gtserror.Newf("db error putting instance page: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.InstancePageToAPIInstancePage(page), nil
+}
+
+// PageUpdate updates an existing static page. Only
+// fields set on the given form will be updated.
+func (p *Processor) PageUpdate(ctx context.Context, id string, form *apimodel.InstancePageUpdateRequest) (*apimodel.InstancePage, gtserror.WithCode) {
+	page, errWithCode := p.getPage(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := make([]string, 0, 5)
+
+	if form.Slug != nil {
+		if err := validate.InstancePageSlug(*form.Slug); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		page.Slug = *form.Slug
+		columns = append(columns, "slug")
+	}
+
+	if form.Title != nil {
+		if err := validate.InstancePageTitle(*form.Title); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		page.Title = *form.Title
+		columns = append(columns, "title")
+	}
+
+	if form.Text != nil {
+		if err := validate.InstancePageText(*form.Text); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		page.Text = *form.Text
+		page.Content = p.formatPage(ctx, page.ID, page.Text)
+		columns = append(columns, "text", "content")
+	}
+
+	if form.NavOrder != nil {
+		page.NavOrder = *form.NavOrder
+		columns = append(columns, "nav_order")
+	}
+
+	if form.ShowInNav != nil {
+		page.ShowInNav = form.ShowInNav
+		columns = append(columns, "show_in_nav")
+	}
+
+	if len(columns) == 0 {
+		const text = "no page fields provided to update"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if err := p.state.DB.UpdateInstancePage(ctx, page, columns...); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			text := "a page with slug " + page.Slug + " already exists"
+			return nil, gtserror.NewErrorConflict(errors.New(text), text)
+		}
+		err := gtserror.Newf("db error updating instance page: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.InstancePageToAPIInstancePage(page), nil
+}
+
+// PageDelete deletes an existing static page.
+func (p *Processor) PageDelete(ctx context.Context, id string) (*apimodel.InstancePage, gtserror.WithCode) {
+	page, errWithCode := p.getPage(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.DeleteInstancePageByID(ctx, id); err != nil {
+		err := gtserror.Newf("db error deleting instance page: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.InstancePageToAPIInstancePage(page), nil
+}
+
+func (p *Processor) getPage(ctx context.Context, id string) (*gtsmodel.InstancePage, gtserror.WithCode) {
+	page, err := p.state.DB.GetInstancePageByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = gtserror.Newf("no instance page with id %s found in the db", id)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err := gtserror.Newf("db error getting instance page: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return page, nil
+}
+
+// formatPage renders the given markdown text of a
+// page to html. Only emojis are parsed; pages
+// shouldn't mention anyone or use tags.
+func (p *Processor) formatPage(ctx context.Context, pageID string, input string) string {
+	return text.NewFormatter(p.state.DB).FromMarkdownEmojiOnly(ctx, nil, "", pageID, input).HTML
+}
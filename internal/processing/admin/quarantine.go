@@ -0,0 +1,138 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// QuarantineGet returns a page of statuses held
+// by the spam filter that are awaiting review.
+func (p *Processor) QuarantineGet(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	page *paging.Page,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	quarantined, err := p.state.DB.GetQuarantinedStatuses(ctx, page)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting quarantined statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Check for empty response.
+	count := len(quarantined)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, q := range quarantined {
+		apiQuarantined, err := p.converter.QuarantinedStatusToAdminAPIQuarantinedStatus(ctx, q, adminAcct)
+		if err != nil {
+			log.Errorf(ctx, "error converting quarantined status to api: %v", err)
+			continue
+		}
+		items = append(items, apiQuarantined)
+	}
+
+	// Get the lowest and highest
+	// ID values, used for paging.
+	lo := quarantined[count-1].ID
+	hi := quarantined[0].ID
+
+	return paging.PackageResponse(paging.ResponseParams{
+		Items: items,
+		Path:  "/api/v1/admin/quarantine",
+		Next:  page.Next(lo, hi),
+		Prev:  page.Prev(lo, hi),
+	}), nil
+}
+
+// QuarantineApprove releases a status held by the spam filter,
+// putting it in timelines and sending any notifications for it.
+func (p *Processor) QuarantineApprove(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AdminQuarantinedStatus, gtserror.WithCode) {
+	return p.quarantineResolve(ctx, adminAcct, id, ap.ActivityAccept)
+}
+
+// QuarantineReject deletes a status held by the spam filter.
+func (p *Processor) QuarantineReject(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+) (*apimodel.AdminQuarantinedStatus, gtserror.WithCode) {
+	return p.quarantineResolve(ctx, adminAcct, id, ap.ActivityReject)
+}
+
+// quarantineResolve removes the quarantine entry with the given
+// ID, and hands its status over to the worker to either accept
+// into timelines or reject and delete, depending on activityType.
+func (p *Processor) quarantineResolve(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	id string,
+	activityType string,
+) (*apimodel.AdminQuarantinedStatus, gtserror.WithCode) {
+	quarantined, err := p.state.DB.GetQuarantinedStatusByID(ctx, id)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting quarantined status %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if quarantined == nil {
+		err := fmt.Errorf("quarantined status %s not found", id)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	// Convert before resolving, as
+	// the status may soon be gone.
+	apiQuarantined, err := p.converter.QuarantinedStatusToAdminAPIQuarantinedStatus(ctx, quarantined, adminAcct)
+	if err != nil {
+		err := gtserror.Newf("error converting quarantined status to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.state.DB.DeleteQuarantinedStatusByID(ctx, quarantined.ID); err != nil {
+		err := gtserror.Newf("db error deleting quarantined status %s: %w", id, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: activityType,
+		GTSModel:       quarantined,
+		OriginAccount:  adminAcct,
+		TargetAccount:  quarantined.Account,
+	})
+
+	return apiQuarantined, nil
+}
@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/paging"
+)
+
+type QuarantineTestSuite struct {
+	AdminStandardTestSuite
+}
+
+// quarantine puts a quarantine entry
+// for the given status in the database.
+func (suite *QuarantineTestSuite) quarantine(status *gtsmodel.Status) *gtsmodel.QuarantinedStatus {
+	quarantined := &gtsmodel.QuarantinedStatus{
+		ID:        id.NewULID(),
+		StatusID:  status.ID,
+		AccountID: status.AccountID,
+		Score:     60,
+		Reasons:   []string{"3 links", "high link density"},
+	}
+
+	if err := suite.db.PutQuarantinedStatus(context.Background(), quarantined); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return quarantined
+}
+
+func (suite *QuarantineTestSuite) TestQuarantineGet() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+	status := suite.testStatuses["remote_account_1_status_1"]
+	quarantined := suite.quarantine(status)
+
+	resp, errWithCode := suite.adminProcessor.QuarantineGet(ctx, adminAcct, &paging.Page{Limit: 20})
+	suite.NoError(errWithCode)
+	suite.Len(resp.Items, 1)
+
+	apiQuarantined := resp.Items[0].(*apimodel.AdminQuarantinedStatus)
+	suite.Equal(quarantined.ID, apiQuarantined.ID)
+	suite.Equal(60, apiQuarantined.Score)
+	suite.Equal([]string{"3 links", "high link density"}, apiQuarantined.Reasons)
+	suite.Equal(status.ID, apiQuarantined.Status.ID)
+}
+
+func (suite *QuarantineTestSuite) TestQuarantineApprove() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+	status := suite.testStatuses["remote_account_1_status_1"]
+	quarantined := suite.quarantine(status)
+
+	apiQuarantined, errWithCode := suite.adminProcessor.QuarantineApprove(ctx, adminAcct, quarantined.ID)
+	suite.NoError(errWithCode)
+	suite.Equal(quarantined.ID, apiQuarantined.ID)
+
+	// Entry should be gone, but the status should stay.
+	_, err := suite.db.GetQuarantinedStatusByID(ctx, quarantined.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	_, err = suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+
+	// Entry can't be approved twice.
+	_, errWithCode = suite.adminProcessor.QuarantineApprove(ctx, adminAcct, quarantined.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *QuarantineTestSuite) TestQuarantineReject() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+	status := suite.testStatuses["remote_account_1_status_1"]
+	quarantined := suite.quarantine(status)
+
+	// The testrig stubs out the worker queues,
+	// so process any enqueued messages directly.
+	suite.state.Workers.EnqueueClientAPI = func(ctx context.Context, msgs ...messages.FromClientAPI) {
+		for _, msg := range msgs {
+			suite.NoError(suite.state.Workers.ProcessFromClientAPI(ctx, msg))
+		}
+	}
+
+	_, errWithCode := suite.adminProcessor.QuarantineReject(ctx, adminAcct, quarantined.ID)
+	suite.NoError(errWithCode)
+
+	_, err := suite.db.GetQuarantinedStatusByID(ctx, quarantined.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// Status should be deleted by the worker.
+	_, err = suite.db.GetStatusByID(ctx, status.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestQuarantineTestSuite(t *testing.T) {
+	suite.Run(t, new(QuarantineTestSuite))
+}
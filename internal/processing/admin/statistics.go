@@ -0,0 +1,112 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+)
+
+const (
+	// statisticsInterval is how often
+	// instance statistics are recorded.
+	statisticsInterval = 24 * time.Hour
+
+	// statisticsSchedule is the default schedule for
+	// recording statistics for the previous day; shortly
+	// after midnight UTC, to let any stragglers come in.
+	statisticsSchedule = "10 0 * * *"
+)
+
+// StatisticsRegisterJob registers the job recording instance
+// statistics for the previous day, by default every night
+// shortly after midnight UTC. It should be called once on
+// startup, before jobs are started.
+func (p *Processor) StatisticsRegisterJob() {
+	p.state.Jobs.Register(jobs.InstanceStatistics, statisticsSchedule, p.StatisticsRecord)
+}
+
+// StatisticsRecord calculates and stores instance statistics
+// for the UTC day before the given time, replacing any
+// statistics already recorded for that day.
+func (p *Processor) StatisticsRecord(ctx context.Context, now time.Time) error {
+	day := now.UTC().Truncate(statisticsInterval).Add(-statisticsInterval)
+
+	stat, err := p.state.DB.CalculateInstanceStatistic(ctx, day)
+	if err != nil {
+		return gtserror.Newf("db error calculating statistics for %s: %w", day.Format(time.DateOnly), err)
+	}
+
+	existing, err := p.state.DB.GetInstanceStatisticByDay(ctx, day)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting statistics for %s: %w", day.Format(time.DateOnly), err)
+	}
+
+	if existing == nil {
+		stat.ID = id.NewULID()
+		if err := p.state.DB.PutInstanceStatistic(ctx, stat); err != nil {
+			return gtserror.Newf("db error putting statistics for %s: %w", day.Format(time.DateOnly), err)
+		}
+		return nil
+	}
+
+	// Already recorded, eg., because the job was run
+	// manually or twice; overwrite with fresh numbers.
+	stat.ID = existing.ID
+	stat.CreatedAt = existing.CreatedAt
+	if err := p.state.DB.UpdateInstanceStatistic(ctx, stat,
+		"active_users",
+		"new_registrations",
+		"statuses_created",
+		"statuses_received",
+		"accounts_discovered",
+		"known_instances",
+		"media_storage_bytes",
+	); err != nil {
+		return gtserror.Newf("db error updating statistics for %s: %w", day.Format(time.DateOnly), err)
+	}
+
+	return nil
+}
+
+// StatisticsGet returns the instance statistics recorded
+// over the past number of days, oldest day first.
+func (p *Processor) StatisticsGet(ctx context.Context, days int) ([]*apimodel.AdminStatistic, gtserror.WithCode) {
+	today := time.Now().UTC().Truncate(statisticsInterval)
+	since := today.Add(-time.Duration(days) * statisticsInterval)
+
+	stats, err := p.state.DB.GetInstanceStatistics(ctx, since)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting statistics: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiStats := make([]*apimodel.AdminStatistic, 0, len(stats))
+	for _, stat := range stats {
+		apiStats = append(apiStats, p.converter.InstanceStatisticToAdminAPIStatistic(stat))
+	}
+
+	return apiStats, nil
+}
@@ -0,0 +1,66 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StatisticsTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *StatisticsTestSuite) TestStatisticsRecord() {
+	var (
+		ctx       = context.Background()
+		now       = time.Now()
+		yesterday = now.UTC().Truncate(24 * time.Hour).Add(-24 * time.Hour)
+	)
+
+	// Nothing recorded yet.
+	stats, errWithCode := suite.adminProcessor.StatisticsGet(ctx, 30)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Empty(stats)
+
+	// Record twice; the second
+	// run should overwrite the first.
+	for i := 0; i < 2; i++ {
+		if err := suite.adminProcessor.StatisticsRecord(ctx, now); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	stats, errWithCode = suite.adminProcessor.StatisticsGet(ctx, 30)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	if suite.Len(stats, 1) {
+		suite.Equal(yesterday.Format(time.DateOnly), stats[0].Date)
+		suite.NotZero(stats[0].MediaStorageBytes)
+	}
+}
+
+func TestStatisticsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatisticsTestSuite))
+}
@@ -0,0 +1,217 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"codeberg.org/gruf/go-kv"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// tagUnlistPageSize is the number of statuses
+// to unlist in each page when bulk-unlisting
+// the existing usages of a banned tag.
+const tagUnlistPageSize = 100
+
+// TagBansGet returns all hashtags that
+// have been banned on this instance.
+func (p *Processor) TagBansGet(ctx context.Context) ([]*apimodel.AdminTag, gtserror.WithCode) {
+	tags, err := p.state.DB.GetBannedTags(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting banned tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTags := make([]*apimodel.AdminTag, 0, len(tags))
+	for _, tag := range tags {
+		apiTag, err := p.converter.TagToAdminAPITag(ctx, tag)
+		if err != nil {
+			err := gtserror.Newf("error converting tag %s: %w", tag.Name, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiTags = append(apiTags, apiTag)
+	}
+
+	return apiTags, nil
+}
+
+// TagBan bans the hashtag with the given name, creating
+// it first if it hasn't been seen on this instance yet.
+//
+// New local posts using a banned tag are rejected, and
+// posts using it are not listed on its tag timeline.
+//
+// If unlistExisting is true, existing public posts that
+// use the tag are changed to unlisted asynchronously, and
+// the ID of this admin action is set on the returned tag.
+func (p *Processor) TagBan(
+	ctx context.Context,
+	adminAcct *gtsmodel.Account,
+	tagName string,
+	unlistExisting bool,
+) (*apimodel.AdminTag, gtserror.WithCode) {
+	tag, errWithCode := p.c.GetOrCreateTag(ctx, tagName)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if *tag.Useable || *tag.Listable {
+		tag.Useable = util.Ptr(false)
+		tag.Listable = util.Ptr(false)
+		if err := p.state.DB.UpdateTag(ctx, tag, "useable", "listable"); err != nil {
+			err := gtserror.Newf("db error updating tag %s: %w", tag.Name, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	apiTag, err := p.converter.TagToAdminAPITag(ctx, tag)
+	if err != nil {
+		err := gtserror.Newf("error converting tag %s: %w", tag.Name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !unlistExisting {
+		return apiTag, nil
+	}
+
+	actionID := id.NewULID()
+
+	// Unlist existing usages asynchronously.
+	if errWithCode := p.actions.Run(
+		ctx,
+		&gtsmodel.AdminAction{
+			ID:             actionID,
+			TargetCategory: gtsmodel.AdminActionCategoryTag,
+			TargetID:       tag.ID,
+			Type:           gtsmodel.AdminActionUnlist,
+			AccountID:      adminAcct.ID,
+		},
+		func(ctx context.Context) gtserror.MultiError {
+			// Log start + finish.
+			l := log.WithFields(kv.Fields{
+				{"tag", tag.Name},
+				{"actionID", actionID},
+			}...).WithContext(ctx)
+
+			l.Info("unlisting existing usages of banned tag")
+			defer func() { l.Info("finished unlisting existing usages of banned tag") }()
+
+			return p.tagUnlistSideEffects(ctx, tag)
+		},
+	); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiTag.UnlistActionID = actionID
+	return apiTag, nil
+}
+
+// TagUnban lifts the ban on the hashtag with the given name.
+// Posts that were unlisted when the tag was banned stay unlisted.
+func (p *Processor) TagUnban(ctx context.Context, tagName string) (*apimodel.AdminTag, gtserror.WithCode) {
+	name, ok := text.NormalizeHashtag(tagName)
+	if !ok {
+		err := fmt.Errorf("%s is not a valid tag name", tagName)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error getting tag %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag == nil || (*tag.Useable && *tag.Listable) {
+		err := fmt.Errorf("tag %s is not banned", name)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	tag.Useable = util.Ptr(true)
+	tag.Listable = util.Ptr(true)
+	if err := p.state.DB.UpdateTag(ctx, tag, "useable", "listable"); err != nil {
+		err := gtserror.Newf("db error updating tag %s: %w", tag.Name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiTag, err := p.converter.TagToAdminAPITag(ctx, tag)
+	if err != nil {
+		err := gtserror.Newf("error converting tag %s: %w", tag.Name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiTag, nil
+}
+
+// tagUnlistSideEffects changes every public status
+// using the given tag to unlisted, page by page.
+//
+// It should be called asynchronously, since it can take
+// a while when there are many statuses using the tag.
+func (p *Processor) tagUnlistSideEffects(ctx context.Context, tag *gtsmodel.Tag) gtserror.MultiError {
+	var (
+		maxID string
+		errs  gtserror.MultiError
+	)
+
+	for {
+		// Only public statuses are on the tag
+		// timeline, so this gets exactly the
+		// statuses that we need to unlist.
+		statuses, err := p.state.DB.GetTagTimeline(ctx, tag.ID, maxID, "", "", tagUnlistPageSize)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			errs.Appendf("db error getting statuses for tag %s: %w", tag.Name, err)
+			return errs
+		}
+
+		if len(statuses) == 0 {
+			// All done.
+			return errs
+		}
+
+		// Page down through statuses.
+		maxID = statuses[len(statuses)-1].ID
+
+		for _, status := range statuses {
+			status.Visibility = gtsmodel.VisibilityUnlocked
+			if err := p.state.DB.UpdateStatus(ctx, status, "visibility"); err != nil {
+				errs.Appendf("db error updating status %s: %w", status.ID, err)
+				continue
+			}
+
+			// Make sure timelines show the updated
+			// visibility next time they're fetched.
+			if err := p.state.Timelines.Home.UnprepareItemFromAllTimelines(ctx, status.ID); err != nil {
+				errs.Appendf("error unpreparing status %s from home timelines: %w", status.ID, err)
+			}
+
+			if err := p.state.Timelines.List.UnprepareItemFromAllTimelines(ctx, status.ID); err != nil {
+				errs.Appendf("error unpreparing status %s from list timelines: %w", status.ID, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package admin_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type TagTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *TagTestSuite) TestTagBanAndUnban() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+
+	// Ban a tag that we haven't seen yet.
+	apiTag, errWithCode := suite.adminProcessor.TagBan(ctx, adminAcct, "#SomeNewTag", false)
+	suite.NoError(errWithCode)
+	suite.Equal("somenewtag", apiTag.Name)
+	suite.False(apiTag.Useable)
+	suite.False(apiTag.Listable)
+	suite.Empty(apiTag.UnlistActionID)
+
+	apiTags, errWithCode := suite.adminProcessor.TagBansGet(ctx)
+	suite.NoError(errWithCode)
+	suite.Len(apiTags, 1)
+	suite.Equal(apiTag.ID, apiTags[0].ID)
+
+	// Lift the ban again.
+	apiTag, errWithCode = suite.adminProcessor.TagUnban(ctx, "somenewtag")
+	suite.NoError(errWithCode)
+	suite.True(apiTag.Useable)
+	suite.True(apiTag.Listable)
+
+	apiTags, errWithCode = suite.adminProcessor.TagBansGet(ctx)
+	suite.NoError(errWithCode)
+	suite.Empty(apiTags)
+
+	// Unbanning a tag that isn't banned is not found.
+	_, errWithCode = suite.adminProcessor.TagUnban(ctx, "somenewtag")
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *TagTestSuite) TestTagBanUnlistExisting() {
+	ctx := context.Background()
+	adminAcct := suite.testAccounts["admin_account"]
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	suite.Equal(gtsmodel.VisibilityPublic, testStatus.Visibility)
+
+	apiTag, errWithCode := suite.adminProcessor.TagBan(ctx, adminAcct, "welcome", true)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(apiTag.UnlistActionID)
+
+	// Existing public status using the
+	// tag should be unlisted eventually.
+	if !suite.Eventually(func() bool {
+		dbStatus, err := suite.db.GetStatusByID(ctx, testStatus.ID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+		return dbStatus.Visibility == gtsmodel.VisibilityUnlocked
+	}, 10*time.Second, 100*time.Millisecond) {
+		suite.FailNow("timed out waiting for status to be unlisted")
+	}
+}
+
+func TestTagTestSuite(t *testing.T) {
+	suite.Run(t, &TagTestSuite{})
+}
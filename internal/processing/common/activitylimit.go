@@ -0,0 +1,203 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// LimitedActivity is a kind of activity by a local
+// account which is counted towards its activity limits.
+type LimitedActivity int
+
+const (
+	LimitedActivityFollow        LimitedActivity = iota // Following, or requesting to follow, an account.
+	LimitedActivityDirectMessage                        // Sending a direct message.
+)
+
+func (a LimitedActivity) String() string {
+	switch a {
+	case LimitedActivityFollow:
+		return "follows"
+	case LimitedActivityDirectMessage:
+		return "direct messages"
+	default:
+		return "unknown"
+	}
+}
+
+// CheckActivityLimit checks whether the given local account
+// may perform the given activity, returning an error if not.
+//
+// The account may not perform the activity if it's currently
+// limited, or if doing so would take it over the limit configured
+// for its role within the configured window of time. In the latter
+// case, the account is limited for the configured duration, and a
+// report on it is opened from the instance account, to alert
+// moderators that the account may have been compromised.
+func (p *Processor) CheckActivityLimit(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	activity LimitedActivity,
+) gtserror.WithCode {
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("db error getting user for account %s: %w", account.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	now := time.Now()
+	if now.Before(user.LimitedUntil) {
+		return limitedError(user)
+	}
+
+	limit := activityLimit(user, activity)
+	if limit <= 0 {
+		// No limit for this role.
+		return nil
+	}
+
+	window := config.GetAccountsActivityLimitWindow()
+	since := now.Add(-window)
+
+	var count int
+	switch activity {
+	case LimitedActivityFollow:
+		count, err = p.state.DB.CountAccountFollowsSince(ctx, account.ID, since)
+	case LimitedActivityDirectMessage:
+		count, err = p.state.DB.CountAccountStatusesSince(ctx, account.ID, gtsmodel.VisibilityDirect, since)
+	}
+	if err != nil {
+		err := gtserror.Newf("db error counting %s by account %s: %w", activity, account.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if count < limit {
+		// Within limit.
+		return nil
+	}
+
+	user.LimitedUntil = now.Add(config.GetAccountsActivityLimitDuration())
+	if err := p.state.DB.UpdateUser(ctx, user, "limited_until"); err != nil {
+		err := gtserror.Newf("db error limiting user %s: %w", user.ID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	log.Warnf(ctx,
+		"account %s limited until %s after sending %d %s in %s",
+		account.ID, user.LimitedUntil.Format(time.RFC3339), count, activity, window,
+	)
+
+	// Alert moderators. The account is limited
+	// either way, so only log any error here.
+	comment := fmt.Sprintf(
+		"Automatically limited until %s after sending %d %s in %s. The account may have been compromised.",
+		user.LimitedUntil.Format(time.RFC3339), count, activity, window,
+	)
+	if err := p.reportFromInstance(ctx, account, comment); err != nil {
+		log.Errorf(ctx, "error reporting limited account %s: %v", account.ID, err)
+	}
+
+	return limitedError(user)
+}
+
+// activityLimit returns the number of times the given user
+// may perform the given activity within the activity limit
+// window, according to their role. 0 means no limit.
+func activityLimit(user *gtsmodel.User, activity LimitedActivity) int {
+	switch {
+	case *user.Admin:
+		if activity == LimitedActivityFollow {
+			return config.GetAccountsActivityLimitAdminFollows()
+		}
+		return config.GetAccountsActivityLimitAdminDirectMessages()
+
+	case *user.Moderator:
+		if activity == LimitedActivityFollow {
+			return config.GetAccountsActivityLimitModeratorFollows()
+		}
+		return config.GetAccountsActivityLimitModeratorDirectMessages()
+
+	default:
+		if activity == LimitedActivityFollow {
+			return config.GetAccountsActivityLimitUserFollows()
+		}
+		return config.GetAccountsActivityLimitUserDirectMessages()
+	}
+}
+
+// limitedError returns an error telling
+// the given user that they're limited.
+func limitedError(user *gtsmodel.User) gtserror.WithCode {
+	err := fmt.Errorf(
+		"your account has been temporarily limited because of a burst of activity: "+
+			"you can't follow accounts or send direct messages until %s, "+
+			"unless a moderator lifts the limit sooner",
+		user.LimitedUntil.Format(time.RFC3339),
+	)
+	return gtserror.NewErrorForbidden(err, err.Error())
+}
+
+// reportFromInstance opens a report on the given local
+// account from the instance account, with the given comment.
+func (p *Processor) reportFromInstance(ctx context.Context, account *gtsmodel.Account, comment string) error {
+	instanceAcct, err := p.state.DB.GetInstanceAccount(ctx, "")
+	if err != nil {
+		return gtserror.Newf("db error getting instance account: %w", err)
+	}
+
+	reportID := id.NewULID()
+	report := &gtsmodel.Report{
+		ID:              reportID,
+		URI:             uris.GenerateURIForReport(reportID),
+		AccountID:       instanceAcct.ID,
+		Account:         instanceAcct,
+		TargetAccountID: account.ID,
+		TargetAccount:   account,
+		Comment:         comment,
+		Forwarded:       util.Ptr(false),
+	}
+
+	if err := p.state.DB.PutReport(ctx, report); err != nil {
+		return gtserror.Newf("db error putting report: %w", err)
+	}
+
+	// Process side effects, such
+	// as emailing moderators.
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityFlag,
+		GTSModel:       report,
+		OriginAccount:  instanceAcct,
+		TargetAccount:  account,
+	})
+
+	return nil
+}
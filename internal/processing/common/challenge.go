@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// VerifyChallenge checks the given response token from
+// the sign-up challenge widget, submitted from the given
+// remote IP address, returning an error if the challenge
+// was not passed. If no challenge is configured, then
+// there's nothing to pass, and nil is always returned.
+func (p *Processor) VerifyChallenge(
+	ctx context.Context,
+	response string,
+	remoteIP netip.Addr,
+) gtserror.WithCode {
+	if p.state.Challenge == nil {
+		// No challenge
+		// to pass.
+		return nil
+	}
+
+	if response == "" {
+		const text = "challenge_response must be set"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	passed, err := p.state.Challenge.Verify(ctx, response, remoteIP)
+	if err != nil {
+		err := gtserror.Newf("error verifying %s challenge: %w", p.state.Challenge.Provider(), err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if !passed {
+		const text = "sign-up challenge was not passed, please try again"
+		return gtserror.NewErrorForbidden(errors.New(text), text)
+	}
+
+	return nil
+}
@@ -0,0 +1,195 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hash"
+	"io"
+	"mime/multipart"
+	"reflect"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+)
+
+// Idempotent calls create to make something for the given account from
+// the given request form, unless the account recently made the same kind
+// of request with the same Idempotency-Key, in which case get is called
+// with the ID of what was created then. This way, clients retrying a
+// request over a flaky connection don't make duplicates.
+//
+// If key is empty, create is always called. If the key was used for a
+// different request, or the request made with it is still in progress,
+// an error is returned.
+//
+// It's a function rather than a Processor method as it's generic,
+// and is used by processors that don't have the common processor.
+func Idempotent[T any](
+	state *state.State,
+	account *gtsmodel.Account,
+	kind string,
+	key string,
+	form any,
+	create func() (T, string, gtserror.WithCode),
+	get func(id string) (T, gtserror.WithCode),
+) (T, gtserror.WithCode) {
+	var zero T
+
+	if key == "" {
+		t, _, errWithCode := create()
+		return t, errWithCode
+	}
+
+	fingerprint, err := fingerprintForm(form)
+	if err != nil {
+		return zero, gtserror.NewErrorInternalError(err)
+	}
+
+	requests := state.Caches.GTS.IdempotentRequests()
+	cacheKey := kind + ":" + account.ID + ":" + key
+
+	// Mark the request as in progress, unless
+	// there's already a request with this key.
+	if !requests.Add(cacheKey, cache.IdempotentRequest{Fingerprint: fingerprint}) {
+		prev, ok := requests.Get(cacheKey)
+		switch {
+		case !ok:
+			// Expired in the meantime,
+			// treat as a new request.
+			requests.Set(cacheKey, cache.IdempotentRequest{Fingerprint: fingerprint})
+
+		case prev.Fingerprint != fingerprint:
+			const text = "Idempotency-Key was already used for a different request"
+			return zero, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+
+		case prev.ID == "":
+			const text = "request with this Idempotency-Key is still being processed"
+			return zero, gtserror.NewErrorConflict(errors.New(text), text)
+
+		default:
+			// Same request again,
+			// return the first result.
+			return get(prev.ID)
+		}
+	}
+
+	t, id, errWithCode := create()
+	if errWithCode != nil {
+		// Let the client retry.
+		requests.Invalidate(cacheKey)
+		return zero, errWithCode
+	}
+
+	requests.Set(cacheKey, cache.IdempotentRequest{
+		Fingerprint: fingerprint,
+		ID:          id,
+	})
+
+	return t, nil
+}
+
+// fingerprintForm returns a hash of the given request form. As
+// json.Marshal only includes the name, headers and size of files
+// uploaded with the form, their contents are hashed in as well,
+// so that retrying with a different file of the same name and
+// size isn't mistaken for the same request.
+func fingerprintForm(form any) (string, error) {
+	b, err := json.Marshal(form)
+	if err != nil {
+		return "", gtserror.Newf("error marshaling form: %w", err)
+	}
+
+	h := sha256.New()
+	h.Write(b)
+
+	if err := hashFormFiles(h, reflect.ValueOf(form)); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+var fileHeaderType = reflect.TypeOf((*multipart.FileHeader)(nil))
+
+// hashFormFiles writes the contents of any uploaded
+// files found in the fields of v, including embedded
+// structs and slices of files, to the given hash.
+func hashFormFiles(h hash.Hash, v reflect.Value) error {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+
+		if v.Type() == fileHeaderType {
+			return hashFile(h, v.Interface().(*multipart.FileHeader))
+		}
+
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+
+			if err := hashFormFiles(h, v.Field(i)); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice:
+		switch v.Type().Elem().Kind() {
+		case reflect.Pointer, reflect.Interface,
+			reflect.Struct, reflect.Slice:
+		default:
+			// Can't contain files.
+			return nil
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			if err := hashFormFiles(h, v.Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// hashFile writes the contents of the given uploaded file to h.
+func hashFile(h hash.Hash, fh *multipart.FileHeader) error {
+	f, err := fh.Open()
+	if err != nil {
+		return gtserror.Newf("error opening uploaded file %s: %w", fh.Filename, err)
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return gtserror.Newf("error reading uploaded file %s: %w", fh.Filename, err)
+	}
+
+	return nil
+}
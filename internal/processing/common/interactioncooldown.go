@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// ToggledInteraction is a kind of interaction by a local
+// account which can be toggled on and off again, and is
+// subject to the configured interaction cooldown.
+type ToggledInteraction int
+
+const (
+	ToggledInteractionBoost  ToggledInteraction = iota // Boosting or unboosting a status.
+	ToggledInteractionFollow                           // Following or unfollowing an account.
+)
+
+func (i ToggledInteraction) String() string {
+	switch i {
+	case ToggledInteractionBoost:
+		return "boost"
+	case ToggledInteractionFollow:
+		return "follow"
+	default:
+		return "unknown"
+	}
+}
+
+// CheckInteractionCooldown checks whether the given local
+// account may toggle the given interaction with the given
+// target (a status ID for boosts, an account ID for follows),
+// returning a 429 error if it was last toggled too recently.
+//
+// This guards against misbehaving clients that flip boosts
+// or follows back and forth, since every toggle federates
+// out to other instances.
+func (p *Processor) CheckInteractionCooldown(
+	account *gtsmodel.Account,
+	interaction ToggledInteraction,
+	targetID string,
+) gtserror.WithCode {
+	cooldown := config.GetAccountsInteractionCooldown()
+	if cooldown <= 0 {
+		// Cooldown disabled.
+		return nil
+	}
+
+	key := interactionToggleKey(account, interaction, targetID)
+	last, ok := p.state.Caches.GTS.InteractionToggles().Get(key)
+	if !ok {
+		// Not toggled recently.
+		return nil
+	}
+
+	wait := time.Until(last.Add(cooldown))
+	if wait <= 0 {
+		// Cooldown has passed.
+		return nil
+	}
+
+	err := fmt.Errorf(
+		"you're toggling this %s too quickly: try again in %s",
+		interaction, wait.Round(time.Second),
+	)
+	return gtserror.NewErrorTooManyRequests(err, err.Error())
+}
+
+// MarkInteractionToggled records that the given local account
+// has just toggled the given interaction with the given target,
+// starting the cooldown checked by CheckInteractionCooldown.
+func (p *Processor) MarkInteractionToggled(
+	account *gtsmodel.Account,
+	interaction ToggledInteraction,
+	targetID string,
+) {
+	if config.GetAccountsInteractionCooldown() <= 0 {
+		// Cooldown disabled.
+		return
+	}
+
+	key := interactionToggleKey(account, interaction, targetID)
+	p.state.Caches.GTS.InteractionToggles().Set(key, time.Now())
+}
+
+// interactionToggleKey returns the interaction
+// toggles cache key for the given parameters.
+func interactionToggleKey(
+	account *gtsmodel.Account,
+	interaction ToggledInteraction,
+	targetID string,
+) string {
+	return interaction.String() + ":" + account.ID + ":" + targetID
+}
@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// GetOrCreateTag returns the tag with the given name, creating
+// it first if necessary, so that tags can be featured, banned
+// or used in list rules before they've been seen on this instance.
+func (p *Processor) GetOrCreateTag(ctx context.Context, tagName string) (*gtsmodel.Tag, gtserror.WithCode) {
+	name, ok := text.NormalizeHashtag(tagName)
+	if !ok {
+		err := fmt.Errorf("%s is not a valid tag name", tagName)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.state.DB.GetTagByName(ctx, name)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting tag %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if tag != nil {
+		return tag, nil
+	}
+
+	tag = &gtsmodel.Tag{
+		ID: id.NewULID(),
+		// Tag names are stored lowercase.
+		Name: strings.ToLower(name),
+	}
+
+	if err := p.state.DB.PutTag(ctx, tag); err != nil {
+		err = gtserror.Newf("db error putting new tag %s: %w", name, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return tag, nil
+}
@@ -0,0 +1,61 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package draft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// Create stores a new draft for the given account, using the provided form.
+// The form should have already been validated by the time it reaches this function.
+func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.DraftRequest) (*apimodel.Draft, gtserror.WithCode) {
+	count, err := p.state.DB.CountDraftsForAccountID(ctx, account.ID)
+	if err != nil {
+		err = gtserror.Newf("db error counting drafts: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if count >= maxDrafts {
+		text := fmt.Sprintf("draft limit reached, you can store at most %d drafts", maxDrafts)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	draft := &gtsmodel.Draft{
+		ID:        id.NewULID(),
+		AccountID: account.ID,
+		Account:   account,
+	}
+
+	if errWithCode := p.fillDraft(ctx, account.ID, form, draft); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.state.DB.PutDraft(ctx, draft); err != nil {
+		err = gtserror.Newf("db error putting draft: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.apiDraft(ctx, draft)
+}
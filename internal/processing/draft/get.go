@@ -0,0 +1,62 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package draft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Get returns the api model of one draft with the given ID.
+func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Draft, gtserror.WithCode) {
+	draft, errWithCode := p.getDraft(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDraft(ctx, draft)
+}
+
+// GetAll returns api models of all drafts for the given account, newest first.
+func (p *Processor) GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Draft, gtserror.WithCode) {
+	drafts, err := p.state.DB.GetDraftsForAccountID(ctx, account.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return []*apimodel.Draft{}, nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiDrafts := make([]*apimodel.Draft, 0, len(drafts))
+	for _, draft := range drafts {
+		apiDraft, err := p.converter.DraftToAPIDraft(ctx, draft)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting draft to api: %w", err))
+		}
+
+		apiDrafts = append(apiDrafts, apiDraft)
+	}
+
+	return apiDrafts, nil
+}
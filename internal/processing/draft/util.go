@@ -0,0 +1,121 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package draft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// getDraft is a shortcut to get one draft from the database and
+// check that it's owned by the given accountID. Will return
+// appropriate errors so caller doesn't need to bother.
+func (p *Processor) getDraft(ctx context.Context, accountID string, draftID string) (*gtsmodel.Draft, gtserror.WithCode) {
+	draft, err := p.state.DB.GetDraftByID(ctx, draftID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Draft doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if draft.AccountID != accountID {
+		err = fmt.Errorf("draft with id %s does not belong to account %s", draft.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return draft, nil
+}
+
+// apiDraft is a shortcut to return the API version of the given
+// draft, or return an appropriate error if conversion fails.
+func (p *Processor) apiDraft(ctx context.Context, draft *gtsmodel.Draft) (*apimodel.Draft, gtserror.WithCode) {
+	apiDraft, err := p.converter.DraftToAPIDraft(ctx, draft)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting draft to api: %w", err))
+	}
+
+	return apiDraft, nil
+}
+
+// fillDraft sets the fields of the given draft from the given form,
+// which should have already been validated by the time it reaches
+// this function. Attachments are checked for ownership here.
+func (p *Processor) fillDraft(ctx context.Context, accountID string, form *apimodel.DraftRequest, draft *gtsmodel.Draft) gtserror.WithCode {
+	attachments := make([]*gtsmodel.MediaAttachment, 0, len(form.MediaIDs))
+	attachmentIDs := make([]string, 0, len(form.MediaIDs))
+	for _, mediaID := range form.MediaIDs {
+		attachment, err := p.state.DB.GetAttachmentByID(ctx, mediaID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err := gtserror.Newf("error fetching media from db: %w", err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if attachment == nil {
+			text := fmt.Sprintf("media %s not found", mediaID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if attachment.AccountID != accountID {
+			text := fmt.Sprintf("media %s does not belong to account", mediaID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
+			text := fmt.Sprintf("media %s already attached to status", mediaID)
+			return gtserror.NewErrorBadRequest(errors.New(text), text)
+		}
+
+		attachments = append(attachments, attachment)
+		attachmentIDs = append(attachmentIDs, attachment.ID)
+	}
+
+	draft.Text = form.Status
+	draft.ContentWarning = form.SpoilerText
+	draft.Attachments = attachments
+	draft.AttachmentIDs = attachmentIDs
+	draft.InReplyToID = form.InReplyToID
+	draft.Visibility = typeutils.APIVisToVis(form.Visibility)
+	draft.Sensitive = &form.Sensitive
+	draft.Language = form.Language
+	draft.ContentType = string(form.ContentType)
+
+	if form.Poll != nil {
+		draft.PollOptions = form.Poll.Options
+		draft.PollExpiresIn = form.Poll.ExpiresIn
+		draft.PollMultiple = &form.Poll.Multiple
+		draft.PollHideTotals = &form.Poll.HideTotals
+	} else {
+		draft.PollOptions = nil
+		draft.PollExpiresIn = 0
+		draft.PollMultiple = util.Ptr(false)
+		draft.PollHideTotals = util.Ptr(false)
+	}
+
+	return nil
+}
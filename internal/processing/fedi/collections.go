@@ -23,12 +23,14 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/paging"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 // InboxPost handles POST requests to a user's inbox for new activitypub messages.
@@ -118,11 +120,12 @@ func (p *Processor) FollowersGet(ctx context.Context, requestedUsername string,
 	}
 
 	// Calculate total number of followers available for account.
-	total, err := p.state.DB.CountAccountFollowers(ctx, requestedAccount.ID)
+	stats, err := p.state.DB.GetAccountStats(ctx, requestedAccount.ID)
 	if err != nil {
-		err := gtserror.Newf("error counting followers: %w", err)
+		err := gtserror.Newf("error getting account stats: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
+	total := stats.FollowersCount
 
 	var obj vocab.Type
 
@@ -194,6 +197,78 @@ func (p *Processor) FollowersGet(ctx context.Context, requestedUsername string,
 	return data, nil
 }
 
+// FollowersSyncGet handles the getting of the partial followers collection of a user/account used
+// for FEP-8fcf followers synchronization: that is, only those followers on the requester's host.
+func (p *Processor) FollowersSyncGet(ctx context.Context, requestedUsername string) (interface{}, gtserror.WithCode) {
+	requestedAccount, requestingAccount, errWithCode := p.authenticate(ctx, requestedUsername)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Parse the requesting account's URI to
+	// get the host to filter followers with.
+	requestingURI, err := url.Parse(requestingAccount.URI)
+	if err != nil {
+		err := gtserror.Newf("error parsing account uri %s: %w", requestingAccount.URI, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	collectionID, err := url.Parse(uris.GenerateURIForFollowersSync(requestedAccount.Username))
+	if err != nil {
+		err := gtserror.Newf("error parsing followers sync uri: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Get all followers of the requested account.
+	followers, err := p.state.DB.GetAccountFollowers(ctx, requestedAccount.ID, nil)
+	if err != nil {
+		err := gtserror.Newf("error getting followers: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Build up the items of the partial collection
+	// from followers on the requesting host only.
+	itemsProp := streams.NewActivityStreamsOrderedItemsProperty()
+	for _, follow := range followers {
+		if follow.Account == nil {
+			continue
+		}
+
+		iri, err := url.Parse(follow.Account.URI)
+		if err != nil {
+			log.Errorf(ctx, "error parsing account uri %s: %v", follow.Account.URI, err)
+			continue
+		}
+
+		if iri.Host != requestingURI.Host {
+			continue
+		}
+
+		itemsProp.AppendIRI(iri)
+	}
+
+	collection := streams.NewActivityStreamsOrderedCollection()
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(collectionID)
+	collection.SetJSONLDId(idProp)
+
+	totalItems := streams.NewActivityStreamsTotalItemsProperty()
+	totalItems.Set(itemsProp.Len())
+	collection.SetActivityStreamsTotalItems(totalItems)
+
+	collection.SetActivityStreamsOrderedItems(itemsProp)
+
+	// Serialize the prepared object.
+	data, err := ap.Serialize(collection)
+	if err != nil {
+		err := gtserror.Newf("error serializing: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return data, nil
+}
+
 // FollowingGet handles the getting of a fedi/activitypub representation of a user/account's following, performing appropriate
 // authentication before returning a JSON serializable interface to the caller.
 func (p *Processor) FollowingGet(ctx context.Context, requestedUsername string, page *paging.Page) (interface{}, gtserror.WithCode) {
@@ -210,11 +285,12 @@ func (p *Processor) FollowingGet(ctx context.Context, requestedUsername string,
 	}
 
 	// Calculate total number of following available for account.
-	total, err := p.state.DB.CountAccountFollows(ctx, requestedAccount.ID)
+	stats, err := p.state.DB.GetAccountStats(ctx, requestedAccount.ID)
 	if err != nil {
-		err := gtserror.Newf("error counting follows: %w", err)
+		err := gtserror.Newf("error getting account stats: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
+	total := stats.FollowingCount
 
 	var obj vocab.Type
 
@@ -313,3 +389,33 @@ func (p *Processor) FeaturedCollectionGet(ctx context.Context, requestedUsername
 
 	return data, nil
 }
+
+// FeaturedTagsCollectionGet returns a collection of the hashtags featured by
+// the requested username. The returned collection has an `items` property
+// which contains a list of Hashtag objects.
+func (p *Processor) FeaturedTagsCollectionGet(ctx context.Context, requestedUsername string) (interface{}, gtserror.WithCode) {
+	requestedAccount, _, errWithCode := p.authenticate(ctx, requestedUsername)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	featuredTags, err := p.state.DB.GetFeaturedTagsForAccountID(ctx, requestedAccount.ID)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	collectionID := uris.GenerateURIForFeaturedTags(requestedAccount.Username)
+	collection, err := p.converter.FeaturedTagsToASCollection(ctx, collectionID, featuredTags)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	data, err := ap.Serialize(collection)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return data, nil
+}
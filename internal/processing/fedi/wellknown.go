@@ -24,6 +24,7 @@ import (
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 const (
@@ -49,9 +50,9 @@ var (
 )
 
 // NodeInfoRelGet returns a well known response giving the path to node info.
-func (p *Processor) NodeInfoRelGet(ctx context.Context) (*apimodel.WellKnownResponse, gtserror.WithCode) {
-	protocol := config.GetProtocol()
-	host := config.GetHost()
+// Requests made to our onion service address are pointed back at it.
+func (p *Processor) NodeInfoRelGet(ctx context.Context, requestHost string) (*apimodel.WellKnownResponse, gtserror.WithCode) {
+	protocol, host := uris.ServedOn(requestHost)
 
 	return &apimodel.WellKnownResponse{
 		Links: []apimodel.Link{
@@ -100,9 +101,9 @@ func (p *Processor) NodeInfoGet(ctx context.Context) (*apimodel.Nodeinfo, gtserr
 }
 
 // HostMetaGet returns a host-meta struct in response to a host-meta request.
-func (p *Processor) HostMetaGet() *apimodel.HostMeta {
-	protocol := config.GetProtocol()
-	host := config.GetHost()
+// Requests made to our onion service address are pointed back at it.
+func (p *Processor) HostMetaGet(requestHost string) *apimodel.HostMeta {
+	protocol, host := uris.ServedOn(requestHost)
 	return &apimodel.HostMeta{
 		XMLNS: hostMetaXMLNS,
 		Link: []apimodel.Link{
@@ -19,8 +19,10 @@ package processing
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -70,6 +72,22 @@ func (p *Processor) InstanceGetV2(ctx context.Context) (*apimodel.InstanceV2, gt
 	return ai, nil
 }
 
+// InstanceGetChooser returns metadata about this instance
+// for apps that help people choose an instance to sign up on.
+func (p *Processor) InstanceGetChooser(ctx context.Context) (*apimodel.InstanceChooser, gtserror.WithCode) {
+	i, err := p.getThisInstance(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error fetching instance: %s", err))
+	}
+
+	ai, err := p.converter.InstanceToAPIInstanceChooser(ctx, i)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting instance to api representation: %s", err))
+	}
+
+	return ai, nil
+}
+
 func (p *Processor) InstancePeersGet(ctx context.Context, includeSuspended bool, includeOpen bool, flat bool) (interface{}, gtserror.WithCode) {
 	domains := []*apimodel.Domain{}
 
@@ -145,6 +163,96 @@ func (p *Processor) InstanceGetRules(ctx context.Context) ([]apimodel.InstanceRu
 	return p.converter.InstanceRulesToAPIRules(i.Rules), nil
 }
 
+// InstanceGetTranslationLanguages returns the languages that statuses
+// can be translated from, mapped to the languages that each of them
+// can be translated into. The map is empty if translation is disabled.
+func (p *Processor) InstanceGetTranslationLanguages(ctx context.Context) (map[string][]string, gtserror.WithCode) {
+	if p.state.Translator == nil {
+		return map[string][]string{}, nil
+	}
+
+	langs, err := p.state.Translator.Languages(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error fetching translation languages: %w", err))
+	}
+
+	return langs, nil
+}
+
+// InstanceGetAnnouncements returns all currently
+// active announcements published on this instance.
+func (p *Processor) InstanceGetAnnouncements(ctx context.Context) ([]*apimodel.Announcement, gtserror.WithCode) {
+	announcements, err := p.state.DB.GetAnnouncements(ctx)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error fetching announcements: %s", err))
+	}
+
+	var (
+		now              = time.Now()
+		apiAnnouncements = make([]*apimodel.Announcement, 0, len(announcements))
+	)
+
+	for _, a := range announcements {
+		if !a.Active(now) {
+			continue
+		}
+
+		apiAnnouncements = append(apiAnnouncements, p.converter.AnnouncementToAPIAnnouncement(a))
+	}
+
+	return apiAnnouncements, nil
+}
+
+// InstanceGetPage returns the static page
+// of this instance with the given slug.
+func (p *Processor) InstanceGetPage(ctx context.Context, slug string) (*apimodel.InstancePage, gtserror.WithCode) {
+	page, err := p.state.DB.GetInstancePageBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err := fmt.Errorf("no instance page with slug %s", slug)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error fetching instance page: %w", err))
+	}
+
+	return p.converter.InstancePageToAPIInstancePage(page), nil
+}
+
+// InstanceGetNavPages returns the static pages of
+// this instance that should be shown in navigation
+// entries, in navigation order.
+func (p *Processor) InstanceGetNavPages(ctx context.Context) ([]*apimodel.InstancePage, gtserror.WithCode) {
+	pages, err := p.state.DB.GetInstancePages(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error fetching instance pages: %w", err))
+	}
+
+	apiPages := make([]*apimodel.InstancePage, 0, len(pages))
+	for _, page := range pages {
+		if !*page.ShowInNav {
+			continue
+		}
+
+		apiPages = append(apiPages, p.converter.InstancePageToAPIInstancePage(page))
+	}
+
+	return apiPages, nil
+}
+
+// InstanceGetPrivacyPolicy returns the privacy policy of
+// this instance, ie., the static page with slug "privacy".
+func (p *Processor) InstanceGetPrivacyPolicy(ctx context.Context) (*apimodel.PrivacyPolicy, gtserror.WithCode) {
+	page, errWithCode := p.InstanceGetPage(ctx, gtsmodel.InstancePagePrivacy)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return &apimodel.PrivacyPolicy{
+		UpdatedAt: page.UpdatedAt,
+		Content:   page.Content,
+	}, nil
+}
+
 func (p *Processor) InstancePatch(ctx context.Context, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.InstanceV1, gtserror.WithCode) {
 	// fetch the instance entry from the db for processing
 	host := config.GetHost()
@@ -18,17 +18,22 @@
 package list
 
 import (
+	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 type Processor struct {
+	// common processor logic
+	c *common.Processor
+
 	state     *state.State
 	converter *typeutils.Converter
 }
 
-func New(state *state.State, converter *typeutils.Converter) Processor {
+func New(common *common.Processor, state *state.State, converter *typeutils.Converter) Processor {
 	return Processor{
+		c:         common,
 		state:     state,
 		converter: converter,
 	}
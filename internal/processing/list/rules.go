@@ -0,0 +1,167 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// maxListRules is the maximum number
+// of rules that may be attached to one list.
+const maxListRules = 50
+
+// GetRules returns all rules of the given list, if the list is owned by account.
+func (p *Processor) GetRules(ctx context.Context, account *gtsmodel.Account, listID string) ([]*apimodel.ListRule, gtserror.WithCode) {
+	// Ensure list exists + is owned by requesting account.
+	if _, errWithCode := p.getList(gtscontext.SetBarebones(ctx), account.ID, listID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	rules, err := p.state.DB.GetListRules(ctx, listID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting rules for list %s: %w", listID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRules := make([]*apimodel.ListRule, 0, len(rules))
+	for _, rule := range rules {
+		apiRule, err := p.converter.ListRuleToAPIListRule(ctx, rule)
+		if err != nil {
+			log.Errorf(ctx, "error converting list rule %s: %v", rule.ID, err)
+			continue
+		}
+		apiRules = append(apiRules, apiRule)
+	}
+
+	return apiRules, nil
+}
+
+// CreateRule creates one new rule for the given list, matching
+// either the given tag name or the given target account ID.
+func (p *Processor) CreateRule(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	listID string,
+	tagName string,
+	targetAccountID string,
+) (*apimodel.ListRule, gtserror.WithCode) {
+	if (tagName == "") == (targetAccountID == "") {
+		const text = "exactly one of tag or account_id must be provided"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	// Ensure list exists + is owned by requesting account.
+	if _, errWithCode := p.getList(gtscontext.SetBarebones(ctx), account.ID, listID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	rules, err := p.state.DB.GetListRules(gtscontext.SetBarebones(ctx), listID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting rules for list %s: %w", listID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(rules) >= maxListRules {
+		err := fmt.Errorf("list %s already has the maximum of %d rules", listID, maxListRules)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	rule := &gtsmodel.ListRule{
+		ID:     id.NewULID(),
+		ListID: listID,
+	}
+
+	if tagName != "" {
+		tag, errWithCode := p.c.GetOrCreateTag(ctx, tagName)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		rule.TagID = tag.ID
+		rule.Tag = tag
+	} else {
+		targetAccount, err := p.state.DB.GetAccountByID(ctx, targetAccountID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				err = fmt.Errorf("account %s not found", targetAccountID)
+				return nil, gtserror.NewErrorNotFound(err, err.Error())
+			}
+			err = gtserror.Newf("db error getting account %s: %w", targetAccountID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		rule.TargetAccountID = targetAccount.ID
+		rule.TargetAccount = targetAccount
+	}
+
+	// Ensure an identical rule isn't already on the list.
+	for _, existing := range rules {
+		if existing.TagID == rule.TagID &&
+			existing.TargetAccountID == rule.TargetAccountID {
+			err := fmt.Errorf("list %s already has rule %s with this target", listID, existing.ID)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+	}
+
+	if err := p.state.DB.PutListRule(ctx, rule); err != nil {
+		err = gtserror.Newf("db error putting list rule: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiRule, err := p.converter.ListRuleToAPIListRule(ctx, rule)
+	if err != nil {
+		err = gtserror.Newf("error converting list rule: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiRule, nil
+}
+
+// DeleteRule deletes one rule from the given list, if the list is owned by account.
+func (p *Processor) DeleteRule(ctx context.Context, account *gtsmodel.Account, listID string, ruleID string) gtserror.WithCode {
+	// Ensure list exists + is owned by requesting account.
+	if _, errWithCode := p.getList(gtscontext.SetBarebones(ctx), account.ID, listID); errWithCode != nil {
+		return errWithCode
+	}
+
+	rule, err := p.state.DB.GetListRuleByID(gtscontext.SetBarebones(ctx), ruleID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting list rule %s: %w", ruleID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if rule == nil || rule.ListID != listID {
+		err := fmt.Errorf("rule %s not found in list %s", ruleID, listID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.DeleteListRule(ctx, ruleID); err != nil {
+		err = gtserror.Newf("db error deleting list rule %s: %w", ruleID, err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -50,5 +50,9 @@ func (p *Processor) Get(ctx context.Context, account *gtsmodel.Account, names []
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting marker to api: %w", err))
 	}
 
+	if err := p.setUnreadCount(ctx, markers, apiMarker); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	return apiMarker, nil
 }
@@ -18,10 +18,20 @@
 package markers
 
 import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
+// unreadCountLimit is the maximum number of
+// unread notifications counted for the
+// notifications marker.
+const unreadCountLimit = 1000
+
 type Processor struct {
 	state     *state.State
 	converter *typeutils.Converter
@@ -33,3 +43,34 @@ func New(state *state.State, converter *typeutils.Converter) Processor {
 		converter: converter,
 	}
 }
+
+// setUnreadCount sets the number of unread notifications on the notifications
+// marker of apiMarker, counting those newer than the matching marker in markers.
+func (p *Processor) setUnreadCount(ctx context.Context, markers []*gtsmodel.Marker, apiMarker *apimodel.Marker) error {
+	if apiMarker.Notifications == nil {
+		// No notifications marker.
+		return nil
+	}
+
+	for _, marker := range markers {
+		if marker.Name != gtsmodel.MarkerNameNotifications {
+			continue
+		}
+
+		count, err := p.state.DB.CountAccountNotifications(
+			ctx,
+			marker.AccountID,
+			marker.LastReadID,
+			unreadCountLimit,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return gtserror.Newf("error counting unread notifications: %w", err)
+		}
+
+		apiMarker.Notifications.UnreadCount = &count
+	}
+
+	return nil
+}
@@ -44,5 +44,9 @@ func (p *Processor) Update(ctx context.Context, markers []*gtsmodel.Marker) (*ap
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting marker to api: %w", err))
 	}
 
+	if err := p.setUnreadCount(ctx, markers, apiMarker); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
 	return apiMarker, nil
 }
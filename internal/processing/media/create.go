@@ -19,6 +19,7 @@ package media
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 
@@ -26,10 +27,34 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 )
 
 // Create creates a new media attachment belonging to the given account, using the request form.
-func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.AttachmentRequest) (*apimodel.Attachment, gtserror.WithCode) {
+//
+// If idempotencyKey is set, and the account recently uploaded the same file with the same key,
+// that attachment is returned instead of creating a duplicate.
+func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.AttachmentRequest, idempotencyKey string) (*apimodel.Attachment, gtserror.WithCode) {
+	return common.Idempotent(
+		p.state,
+		account,
+		"media",
+		idempotencyKey,
+		form,
+		func() (*apimodel.Attachment, string, gtserror.WithCode) {
+			apiAttachment, errWithCode := p.create(ctx, account, form)
+			if errWithCode != nil {
+				return nil, "", errWithCode
+			}
+			return apiAttachment, apiAttachment.ID, nil
+		},
+		func(attachmentID string) (*apimodel.Attachment, gtserror.WithCode) {
+			return p.Get(ctx, account, attachmentID)
+		},
+	)
+}
+
+func (p *Processor) create(ctx context.Context, account *gtsmodel.Account, form *apimodel.AttachmentRequest) (*apimodel.Attachment, gtserror.WithCode) {
 	data := func(innerCtx context.Context) (io.ReadCloser, int64, error) {
 		f, err := form.File.Open()
 		return f, form.File.Size, err
@@ -41,11 +66,23 @@ func (p *Processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
+	// make sure the upload fits within the account's
+	// media quota before we go to the trouble of processing it
+	limit, used, err := p.quotaUsage(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if reason := uploadRejectReason(form.File.Size, "", limit, used); reason != "" {
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(reason), reason)
+	}
+
 	// process the media attachment and load it immediately
 	media, err := p.mediaManager.PreProcessMedia(ctx, data, account.ID, &media.AdditionalMediaInfo{
 		Description: &form.Description,
 		FocusX:      &focusX,
 		FocusY:      &focusY,
+		Sensitive:   &form.Sensitive,
 	})
 	if err != nil {
 		return nil, gtserror.NewErrorUnprocessableEntity(err)
@@ -0,0 +1,102 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media_test
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type CreateTestSuite struct {
+	MediaStandardTestSuite
+}
+
+// fileHeader returns a file header for the given
+// bytes, as if they'd been uploaded as a form file.
+func (suite *CreateTestSuite) fileHeader(filename string, data []byte) *multipart.FileHeader {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	fw, err := w.CreateFormFile("file", filename)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if _, err := fw.Write(data); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := w.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form, err := multipart.NewReader(body, w.Boundary()).ReadForm(int64(len(data)) * 2)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return form.File["file"][0]
+}
+
+func (suite *CreateTestSuite) TestIdempotentCreate() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	b, err := os.ReadFile("../../../testrig/media/test-jpeg.jpg")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	form := &apimodel.AttachmentRequest{
+		File:        suite.fileHeader("test.jpg", b),
+		Description: "a test image",
+	}
+
+	attachment, errWithCode := suite.mediaProcessor.Create(ctx, testAccount, form, "some-key")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Retrying returns the same attachment.
+	retried, errWithCode := suite.mediaProcessor.Create(ctx, testAccount, form, "some-key")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(attachment.ID, retried.ID)
+
+	// A different file with the same name and size
+	// is a different request, so reusing the key fails.
+	changed := bytes.Clone(b)
+	changed[len(changed)-3]++
+	form.File = suite.fileHeader("test.jpg", changed)
+
+	_, errWithCode = suite.mediaProcessor.Create(ctx, testAccount, form, "some-key")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.EqualError(errWithCode, "Idempotency-Key was already used for a different request")
+}
+
+func TestCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateTestSuite))
+}
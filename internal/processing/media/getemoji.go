@@ -19,6 +19,7 @@ package media
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
@@ -49,3 +50,27 @@ func (p *Processor) GetCustomEmojis(ctx context.Context) ([]*apimodel.Emoji, gts
 
 	return apiEmojis, nil
 }
+
+// GetCustomEmoji returns the local, enabled custom
+// emoji with the given shortcode, including its
+// license and attribution details, if any.
+func (p *Processor) GetCustomEmoji(ctx context.Context, shortcode string) (*apimodel.Emoji, gtserror.WithCode) {
+	emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, shortcode, "")
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err := gtserror.Newf("db error retrieving custom emoji %s: %w", shortcode, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if emoji == nil || *emoji.Disabled {
+		err := gtserror.Newf("no custom emoji with shortcode %s", shortcode)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	apiEmoji, err := p.converter.EmojiToAPIEmoji(ctx, emoji)
+	if err != nil {
+		err := gtserror.Newf("error converting emoji with id %s: %w", emoji.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apiEmoji, nil
+}
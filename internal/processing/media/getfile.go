@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
@@ -151,12 +152,18 @@ func (p *Processor) getAttachmentContent(ctx context.Context, requestingAccount
 		//   recache operation -> holding open a media worker.
 		// ]
 
+		// Set if media was found to
+		// have permanently moved URI.
+		var movedTo *url.URL
+
 		dataFn := func(ctx context.Context) (io.ReadCloser, int64, error) {
 			t, err := p.transportController.NewTransportForUsername(ctx, requestingUsername)
 			if err != nil {
 				return nil, 0, err
 			}
-			return t.DereferenceMedia(gtscontext.SetFastFail(ctx), remoteMediaIRI)
+			rc, sz, moved, err := t.DereferenceMedia(gtscontext.SetFastFail(ctx), remoteMediaIRI)
+			movedTo = moved
+			return rc, sz, err
 		}
 
 		// Start recaching this media with the prepared data function.
@@ -170,6 +177,15 @@ func (p *Processor) getAttachmentContent(ctx context.Context, requestingAccount
 		if err != nil {
 			return nil, gtserror.NewErrorNotFound(fmt.Errorf("error loading recached attachment: %w", err))
 		}
+
+		if movedTo != nil {
+			// Media has permanently moved, update
+			// so future recaches use the new URL.
+			a.RemoteURL = movedTo.String()
+			if err := p.state.DB.UpdateAttachment(ctx, a, "remote_url"); err != nil {
+				log.Errorf(ctx, "error updating attachment %s remote url: %v", a.ID, err)
+			}
+		}
 	}
 
 	var (
@@ -231,12 +247,18 @@ func (p *Processor) getEmojiContent(ctx context.Context, fileName string, owning
 			return nil, gtserror.NewErrorNotFound(fmt.Errorf("error parsing remote emoji iri %s: %w", e.ImageRemoteURL, err))
 		}
 
+		// Set if emoji was found to
+		// have permanently moved URI.
+		var movedTo *url.URL
+
 		dataFn := func(ctx context.Context) (io.ReadCloser, int64, error) {
 			t, err := p.transportController.NewTransportForUsername(ctx, "")
 			if err != nil {
 				return nil, 0, err
 			}
-			return t.DereferenceMedia(gtscontext.SetFastFail(ctx), remoteURL)
+			rc, sz, moved, err := t.DereferenceMedia(gtscontext.SetFastFail(ctx), remoteURL)
+			movedTo = moved
+			return rc, sz, err
 		}
 
 		// Start recaching this emoji with the prepared data function.
@@ -250,6 +272,15 @@ func (p *Processor) getEmojiContent(ctx context.Context, fileName string, owning
 		if err != nil {
 			return nil, gtserror.NewErrorNotFound(fmt.Errorf("error loading recached emoji: %w", err))
 		}
+
+		if movedTo != nil {
+			// Emoji image has permanently moved, update
+			// so future recaches use the new URL.
+			e.ImageRemoteURL = movedTo.String()
+			if err := p.state.DB.UpdateEmoji(ctx, e, "image_remote_url"); err != nil {
+				log.Errorf(ctx, "error updating emoji %s remote url: %v", e.ID, err)
+			}
+		}
 	}
 
 	switch emojiSize {
@@ -21,11 +21,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"image"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 )
 
@@ -46,6 +49,16 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, media
 
 	var updatingColumns []string
 
+	if form.Rotate != nil || form.Crop != nil {
+		// Edit the image itself, before any other
+		// changes, as this reprocesses the attachment.
+		columns, errWithCode := p.editImage(ctx, attachment, form)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		updatingColumns = append(updatingColumns, columns...)
+	}
+
 	if form.Description != nil {
 		attachment.Description = text.SanitizeToPlaintext(*form.Description)
 		updatingColumns = append(updatingColumns, "description")
@@ -61,8 +74,15 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, media
 		updatingColumns = append(updatingColumns, "focus_x", "focus_y")
 	}
 
-	if err := p.state.DB.UpdateAttachment(ctx, attachment, updatingColumns...); err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("database error updating media: %s", err))
+	if form.Sensitive != nil {
+		attachment.Sensitive = form.Sensitive
+		updatingColumns = append(updatingColumns, "sensitive")
+	}
+
+	if len(updatingColumns) != 0 {
+		if err := p.state.DB.UpdateAttachment(ctx, attachment, updatingColumns...); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("database error updating media: %s", err))
+		}
 	}
 
 	a, err := p.converter.AttachmentToAPIAttachment(ctx, attachment)
@@ -72,3 +92,87 @@ func (p *Processor) Update(ctx context.Context, account *gtsmodel.Account, media
 
 	return &a, nil
 }
+
+// editImage rotates and / or crops the given image attachment as
+// requested in form, reprocessing it from its stored original. The
+// attachment is updated in place, and any columns that still need
+// to be updated in the database by the caller are returned.
+func (p *Processor) editImage(ctx context.Context, attachment *gtsmodel.MediaAttachment, form *apimodel.AttachmentUpdateRequest) ([]string, gtserror.WithCode) {
+	if attachment.StatusID != "" {
+		const text = "attachment is already attached to a status and can no longer be edited"
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	var edit media.ImageEdit
+
+	if form.Rotate != nil {
+		edit.Rotate = *form.Rotate
+	}
+
+	// Dimensions of the image after rotation,
+	// which any crop must be within the bounds of.
+	width := attachment.FileMeta.Original.Width
+	height := attachment.FileMeta.Original.Height
+	if edit.Rotate == 90 || edit.Rotate == 270 {
+		width, height = height, width
+	}
+
+	if form.Crop != nil {
+		crop, err := parseCrop(*form.Crop)
+		if err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if !crop.In(image.Rect(0, 0, width, height)) {
+			err := fmt.Errorf("crop %s is out of bounds for %dx%d image", *form.Crop, width, height)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		edit.Crop = &crop
+	}
+
+	oldPath := attachment.File.Path
+
+	processing, err := p.mediaManager.PreProcessMediaEdit(ctx, attachment, edit)
+	if err != nil {
+		if errors.Is(err, media.ErrUneditable) {
+			const text = "only jpeg, png and webp images can be rotated or cropped"
+			return nil, gtserror.NewErrorUnprocessableEntity(err, text)
+		}
+		err := gtserror.Newf("error editing media: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	edited, err := processing.LoadAttachment(ctx)
+	if err != nil {
+		err := gtserror.Newf("error processing edited media: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if edited.File.Path != oldPath {
+		// Image was re-encoded with a different
+		// extension, so clean up the old original.
+		if err := p.state.Storage.Delete(ctx, oldPath); err != nil {
+			log.Errorf(ctx, "error removing old original %s: %v", oldPath, err)
+		}
+	}
+
+	*attachment = *edited
+
+	// Keep the focus point on the same part of
+	// the image; or reset it if the image was
+	// cropped, since it may now be anywhere.
+	focus := &attachment.FileMeta.Focus
+	switch {
+	case edit.Crop != nil:
+		focus.X, focus.Y = 0, 0
+	case edit.Rotate == 90:
+		focus.X, focus.Y = focus.Y, -focus.X
+	case edit.Rotate == 180:
+		focus.X, focus.Y = -focus.X, -focus.Y
+	case edit.Rotate == 270:
+		focus.X, focus.Y = -focus.Y, focus.X
+	}
+
+	return []string{"focus_x", "focus_y"}, nil
+}
@@ -0,0 +1,162 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package media
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"golang.org/x/exp/slices"
+)
+
+// UploadCheck returns media quota and upload limits for the
+// given account, so that clients can find out whether an upload
+// would be accepted before sending it. If form.Size is set, the
+// response will also indicate whether an upload of that size
+// (and type, if form.Type is set) would be accepted.
+func (p *Processor) UploadCheck(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	form *apimodel.MediaUploadCheckRequest,
+) (*apimodel.MediaUploadCheck, gtserror.WithCode) {
+	if form.Size < 0 {
+		const text = "size must not be negative"
+		return nil, gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	limit, used, err := p.quotaUsage(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	check := &apimodel.MediaUploadCheck{
+		QuotaUsed:          used,
+		SupportedMimeTypes: media.SupportedMIMETypes,
+		ImageSizeLimit:     int(config.GetMediaImageMaxSize()),
+		ImageMatrixLimit:   media.ImageMatrixLimit(),
+		VideoSizeLimit:     int(config.GetMediaVideoMaxSize()),
+		VideoMatrixLimit:   media.VideoMatrixLimit,
+		DescriptionLimit:   config.GetMediaDescriptionMaxChars(),
+		DescriptionMinimum: config.GetMediaDescriptionMinChars(),
+		AsyncProcessing:    false,
+	}
+
+	if limit > 0 {
+		remaining := limit - used
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		check.QuotaLimit = util.Ptr(limit)
+		check.QuotaRemaining = util.Ptr(remaining)
+	}
+
+	if form.Size == 0 {
+		// No upload to
+		// check, we're done.
+		return check, nil
+	}
+
+	reason := uploadRejectReason(form.Size, form.Type, limit, used)
+	check.Accepted = util.Ptr(reason == "")
+	check.Reason = reason
+
+	return check, nil
+}
+
+// quotaUsage returns the media quota limit in bytes for
+// the given account (0 meaning no limit), and the number
+// of bytes of media the account is currently storing.
+//
+// The limit is the instance default, unless an admin has
+// overridden it for the user of the account.
+func (p *Processor) quotaUsage(ctx context.Context, account *gtsmodel.Account) (int64, int64, error) {
+	user, err := p.state.DB.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("error getting user for account %s: %w", account.ID, err)
+		return 0, 0, err
+	}
+
+	used, err := p.state.DB.GetAccountAttachmentsSize(ctx, account.ID)
+	if err != nil {
+		err := gtserror.Newf("error getting attachments size for account %s: %w", account.ID, err)
+		return 0, 0, err
+	}
+
+	limit := int64(config.GetMediaLocalQuota())
+	if user.MediaQuota != nil {
+		limit = *user.MediaQuota
+	}
+
+	return limit, used, nil
+}
+
+// uploadRejectReason returns the reason why an upload of
+// the given size and mime type would be rejected, given
+// the account quota limit and current usage. If mimeType
+// is empty, only the most lenient size limit is applied.
+// Returns an empty string if the upload would be accepted.
+func uploadRejectReason(size int64, mimeType string, limit int64, used int64) string {
+	var (
+		maxImageSize = int64(config.GetMediaImageMaxSize())
+		maxVideoSize = int64(config.GetMediaVideoMaxSize())
+		maxSize      int64
+	)
+
+	switch {
+	case mimeType == "":
+		// We don't know which type it is
+		// yet, so take the most lenient limit.
+		maxSize = maxVideoSize
+		if maxImageSize > maxSize {
+			maxSize = maxImageSize
+		}
+
+	case !slices.Contains(media.SupportedMIMETypes, mimeType):
+		return fmt.Sprintf("mime type %s is not supported", mimeType)
+
+	case strings.HasPrefix(mimeType, "video/"):
+		maxSize = maxVideoSize
+
+	default:
+		maxSize = maxImageSize
+	}
+
+	if size > maxSize {
+		return fmt.Sprintf("file size limit exceeded: limit is %d bytes but upload would be %d bytes", maxSize, size)
+	}
+
+	if limit > 0 && used+size > limit {
+		remaining := limit - used
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		return fmt.Sprintf("upload of %d bytes would exceed remaining media quota of %d bytes", size, remaining)
+	}
+
+	return ""
+}
@@ -19,6 +19,7 @@ package media
 
 import (
 	"fmt"
+	"image"
 	"strconv"
 	"strings"
 )
@@ -60,3 +61,29 @@ func parseFocus(focus string) (focusx, focusy float32, err error) {
 	focusy = float32(fy)
 	return
 }
+
+// parseCrop parses a crop rectangle given in the form
+// of four comma-separated integers "x,y,width,height",
+// all in pixels, where x and y are the top-left corner.
+func parseCrop(crop string) (image.Rectangle, error) {
+	spl := strings.Split(crop, ",")
+	if len(spl) != 4 {
+		return image.Rectangle{}, fmt.Errorf("improperly formatted crop %s", crop)
+	}
+
+	var vals [4]int
+	for i, str := range spl {
+		v, err := strconv.Atoi(strings.TrimSpace(str))
+		if err != nil {
+			return image.Rectangle{}, fmt.Errorf("improperly formatted crop %s: %s", crop, err)
+		}
+		vals[i] = v
+	}
+
+	x, y, width, height := vals[0], vals[1], vals[2], vals[3]
+	if x < 0 || y < 0 || width <= 0 || height <= 0 {
+		return image.Rectangle{}, fmt.Errorf("improperly formatted crop %s", crop)
+	}
+
+	return image.Rect(x, y, x+width, y+height), nil
+}
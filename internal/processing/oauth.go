@@ -18,9 +18,12 @@
 package processing
 
 import (
+	"context"
 	"net/http"
+	"net/netip"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
@@ -34,6 +37,22 @@ func (p *Processor) OAuthHandleTokenRequest(r *http.Request) (map[string]interfa
 	return p.oauthServer.HandleTokenRequest(r)
 }
 
+// OAuthVerifyChallenge checks the sign-up challenge response sent
+// with a token request from the given remote IP address, returning
+// an oauth spec compliant error if the challenge was not passed.
+func (p *Processor) OAuthVerifyChallenge(ctx context.Context, response string, remoteIP netip.Addr) gtserror.WithCode {
+	errWithCode := p.common.VerifyChallenge(ctx, response, remoteIP)
+	if errWithCode == nil || errWithCode.Code() == http.StatusInternalServerError {
+		return errWithCode
+	}
+
+	// Client errors from VerifyChallenge
+	// wrap their own help text, so it's
+	// safe to pass that on to the client.
+	help := errWithCode.Unwrap().Error()
+	return gtserror.NewErrorBadRequest(oauth.InvalidRequest(), help)
+}
+
 func (p *Processor) OAuthValidateBearerToken(r *http.Request) (oauth2.TokenInfo, error) {
 	// todo: some kind of metrics stuff here
 	return p.oauthServer.ValidationBearerToken(r)
@@ -0,0 +1,183 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing
+
+import (
+	"context"
+	"html"
+	"net/url"
+	"strconv"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+const (
+	oEmbedVersion       = "1.0"
+	oEmbedCacheAge      = 86400 // 1 day
+	oEmbedDefaultWidth  = 400
+	oEmbedDefaultHeight = 300
+)
+
+// OEmbedGet returns an oEmbed representation of the status or
+// profile at the given web URL on this instance, so that other
+// sites can embed it or unfurl links to it. Only content that's
+// visible to unauthenticated visitors of the web view is returned.
+//
+// maxWidth and maxHeight constrain the dimensions of the embed;
+// a value of 0 means no constraint.
+func (p *Processor) OEmbedGet(ctx context.Context, targetURL string, maxWidth int, maxHeight int) (*apimodel.OEmbed, gtserror.WithCode) {
+	target, err := url.Parse(targetURL)
+	if err != nil {
+		err := gtserror.Newf("error parsing url %s: %w", targetURL, err)
+		return nil, gtserror.NewErrorBadRequest(err, "url could not be parsed")
+	}
+
+	if target.Host != config.GetHost() {
+		err := gtserror.Newf("url %s does not belong to this instance", targetURL)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	instance, errWithCode := p.InstanceGetV1(ctx)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	oEmbed := &apimodel.OEmbed{
+		Version:      oEmbedVersion,
+		ProviderName: instance.Title,
+		ProviderURL:  instance.URI,
+		CacheAge:     oEmbedCacheAge,
+	}
+
+	if username, statusID, err := uris.ParseWebStatusPath(target); err == nil {
+		return p.oEmbedStatus(ctx, oEmbed, username, statusID, maxWidth, maxHeight)
+	}
+
+	if username, err := uris.ParseWebProfilePath(target); err == nil {
+		return p.oEmbedAccount(ctx, oEmbed, username)
+	}
+
+	err = gtserror.Newf("url %s is not a status or profile url", targetURL)
+	return nil, gtserror.NewErrorNotFound(err)
+}
+
+func (p *Processor) oEmbedStatus(
+	ctx context.Context,
+	oEmbed *apimodel.OEmbed,
+	username string,
+	statusID string,
+	maxWidth int,
+	maxHeight int,
+) (*apimodel.OEmbed, gtserror.WithCode) {
+	account, errWithCode := p.oEmbedGetAccount(ctx, username)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	status, errWithCode := p.status.Get(ctx, nil, statusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if status.Account == nil || status.Account.ID != account.ID {
+		err := gtserror.Newf("account %s does not own status %s", username, statusID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	width := clampOEmbedDimension(oEmbedDefaultWidth, maxWidth)
+	height := clampOEmbedDimension(oEmbedDefaultHeight, maxHeight)
+
+	oEmbed.Type = "rich"
+	oEmbed.Title = "Post by @" + account.Acct
+	oEmbed.AuthorName = oEmbedAuthorName(account)
+	oEmbed.AuthorURL = account.URL
+	oEmbed.Width = width
+	oEmbed.Height = height
+	oEmbed.HTML = `<iframe src="` + html.EscapeString(status.URL+"/embed") + `"` +
+		` class="gotosocial-embed" style="max-width: 100%; border: 0"` +
+		` width="` + strconv.Itoa(width) + `" height="` + strconv.Itoa(height) + `"` +
+		` sandbox="allow-scripts allow-same-origin allow-popups allow-popups-to-escape-sandbox"` +
+		` allowfullscreen="allowfullscreen"></iframe>`
+
+	// Use the first attachment as a thumbnail if
+	// we can, falling back to the author's avatar.
+	if !status.Sensitive && len(status.MediaAttachments) > 0 && status.MediaAttachments[0].PreviewURL != "" {
+		a := status.MediaAttachments[0]
+		oEmbed.ThumbnailURL = a.PreviewURL
+		oEmbed.ThumbnailWidth = a.Meta.Small.Width
+		oEmbed.ThumbnailHeight = a.Meta.Small.Height
+	} else {
+		oEmbed.ThumbnailURL = account.Avatar
+	}
+
+	return oEmbed, nil
+}
+
+func (p *Processor) oEmbedAccount(
+	ctx context.Context,
+	oEmbed *apimodel.OEmbed,
+	username string,
+) (*apimodel.OEmbed, gtserror.WithCode) {
+	account, errWithCode := p.oEmbedGetAccount(ctx, username)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	oEmbed.Type = "link"
+	oEmbed.Title = oEmbedAuthorName(account) + " (@" + account.Acct + ")"
+	oEmbed.AuthorName = oEmbedAuthorName(account)
+	oEmbed.AuthorURL = account.URL
+	oEmbed.ThumbnailURL = account.Avatar
+
+	return oEmbed, nil
+}
+
+// oEmbedGetAccount gets the local account with the given
+// username, returning not found if it's been suspended.
+func (p *Processor) oEmbedGetAccount(ctx context.Context, username string) (*apimodel.Account, gtserror.WithCode) {
+	account, errWithCode := p.account.GetLocalByUsername(ctx, nil, username)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if account.Suspended {
+		err := gtserror.Newf("account %s is suspended", username)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return account, nil
+}
+
+func oEmbedAuthorName(account *apimodel.Account) string {
+	if account.DisplayName != "" {
+		return account.DisplayName
+	}
+	return account.Username
+}
+
+// clampOEmbedDimension returns def, or max
+// if max is set and smaller than def.
+func clampOEmbedDimension(def int, max int) int {
+	if max > 0 && max < def {
+		return max
+	}
+	return def
+}
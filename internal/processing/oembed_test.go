@@ -0,0 +1,92 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package processing_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OEmbedTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *OEmbedTestSuite) TestOEmbedGetStatus() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+
+	oEmbed, errWithCode := suite.processor.OEmbedGet(ctx, testStatus.URL, 0, 0)
+	suite.NoError(errWithCode)
+
+	suite.Equal("1.0", oEmbed.Version)
+	suite.Equal("rich", oEmbed.Type)
+	suite.Equal("Post by @the_mighty_zork", oEmbed.Title)
+	suite.Equal("original zork (he/they)", oEmbed.AuthorName)
+	suite.Equal("http://localhost:8080/@the_mighty_zork", oEmbed.AuthorURL)
+	suite.Equal("http://localhost:8080", oEmbed.ProviderURL)
+	suite.Equal(400, oEmbed.Width)
+	suite.Equal(300, oEmbed.Height)
+	suite.Equal(`<iframe src="http://localhost:8080/@the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY/embed" class="gotosocial-embed" style="max-width: 100%; border: 0" width="400" height="300" sandbox="allow-scripts allow-same-origin allow-popups allow-popups-to-escape-sandbox" allowfullscreen="allowfullscreen"></iframe>`, oEmbed.HTML)
+}
+
+func (suite *OEmbedTestSuite) TestOEmbedGetStatusMaxSize() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_1"]
+
+	oEmbed, errWithCode := suite.processor.OEmbedGet(ctx, testStatus.URL, 320, 1000)
+	suite.NoError(errWithCode)
+	suite.Equal(320, oEmbed.Width)
+	suite.Equal(300, oEmbed.Height)
+}
+
+func (suite *OEmbedTestSuite) TestOEmbedGetStatusNotPublic() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_1_status_2"]
+
+	oEmbed, errWithCode := suite.processor.OEmbedGet(ctx, testStatus.URL, 0, 0)
+	suite.Nil(oEmbed)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *OEmbedTestSuite) TestOEmbedGetProfile() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	oEmbed, errWithCode := suite.processor.OEmbedGet(ctx, testAccount.URL, 0, 0)
+	suite.NoError(errWithCode)
+
+	suite.Equal("link", oEmbed.Type)
+	suite.Equal("original zork (he/they) (@the_mighty_zork)", oEmbed.Title)
+	suite.Equal("http://localhost:8080/@the_mighty_zork", oEmbed.AuthorURL)
+	suite.Empty(oEmbed.HTML)
+}
+
+func (suite *OEmbedTestSuite) TestOEmbedGetOtherHost() {
+	ctx := context.Background()
+
+	oEmbed, errWithCode := suite.processor.OEmbedGet(ctx, "https://example.org/@the_mighty_zork", 0, 0)
+	suite.Nil(oEmbed)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestOEmbedTestSuite(t *testing.T) {
+	suite.Run(t, &OEmbedTestSuite{})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/draft"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/fedi"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/list"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/markers"
@@ -52,6 +53,7 @@ type Processor struct {
 	converter   *typeutils.Converter
 	oauthServer oauth.Server
 	state       *state.State
+	common      *common.Processor
 
 	/*
 		SUB-PROCESSORS
@@ -59,6 +61,7 @@ type Processor struct {
 
 	account  account.Processor
 	admin    admin.Processor
+	draft    draft.Processor
 	fedi     fedi.Processor
 	list     list.Processor
 	markers  markers.Processor
@@ -80,6 +83,10 @@ func (p *Processor) Admin() *admin.Processor {
 	return &p.admin
 }
 
+func (p *Processor) Draft() *draft.Processor {
+	return &p.draft
+}
+
 func (p *Processor) Fedi() *fedi.Processor {
 	return &p.fedi
 }
@@ -151,20 +158,22 @@ func NewProcessor(
 	commonProcessor := common.New(state, converter, federator, filter)
 	accountProcessor := account.New(&commonProcessor, state, converter, mediaManager, oauthServer, federator, filter, parseMentionFunc)
 	mediaProcessor := media.New(state, converter, mediaManager, federator.TransportController())
-	streamProcessor := stream.New(state, oauthServer)
+	streamProcessor := stream.New(state, oauthServer, filter)
 
 	// Instantiate the rest of the sub
 	// processors + pin them to this struct.
+	processor.common = &commonProcessor
 	processor.account = accountProcessor
-	processor.admin = admin.New(state, converter, mediaManager, federator.TransportController(), emailSender)
+	processor.admin = admin.New(&commonProcessor, state, converter, mediaManager, federator, emailSender, &streamProcessor)
+	processor.draft = draft.New(state, converter)
 	processor.fedi = fedi.New(state, converter, federator, filter)
-	processor.list = list.New(state, converter)
+	processor.list = list.New(&commonProcessor, state, converter)
 	processor.markers = markers.New(state, converter)
 	processor.media = mediaProcessor
 	processor.report = report.New(state, converter)
 	processor.timeline = timeline.New(state, converter, filter)
-	processor.search = search.New(state, federator, converter, filter)
-	processor.status = status.New(state, federator, converter, filter, parseMentionFunc)
+	processor.search = search.New(state, federator, converter, filter, &streamProcessor)
+	processor.status = status.New(&commonProcessor, state, federator, converter, filter, parseMentionFunc)
 	processor.stream = streamProcessor
 	processor.user = user.New(state, emailSender)
 
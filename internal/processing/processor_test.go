@@ -144,7 +144,7 @@ func (suite *ProcessingStandardTestSuite) openStreams(ctx context.Context, accou
 		stream.TimelinePublic,
 		stream.TimelineNotifications,
 	} {
-		stream, err := suite.processor.Stream().Open(ctx, account, streamType)
+		stream, err := suite.processor.Stream().Open(ctx, account, streamType, "")
 		if err != nil {
 			suite.FailNow(err.Error())
 		}
@@ -155,7 +155,7 @@ func (suite *ProcessingStandardTestSuite) openStreams(ctx context.Context, accou
 	for _, listID := range listIDs {
 		streamType := stream.TimelineList + ":" + listID
 
-		stream, err := suite.processor.Stream().Open(ctx, account, streamType)
+		stream, err := suite.processor.Stream().Open(ctx, account, streamType, "")
 		if err != nil {
 			suite.FailNow(err.Error())
 		}
@@ -38,6 +38,11 @@ import (
 // it will only return one match at most. For namestrings
 // that exclude domain, multiple matches may be returned.
 //
+// If interacted is true, only accounts that requestingAccount
+// has recently interacted with (or follows, if following is
+// also true) are returned, and remote lookups are never made,
+// so that clients can cheaply autocomplete mentions.
+//
 // This behavior aligns more or less with Mastodon's API.
 // See https://docs.joinmastodon.org/methods/accounts/#search.
 func (p *Processor) Accounts(
@@ -48,6 +53,7 @@ func (p *Processor) Accounts(
 	offset int,
 	resolve bool,
 	following bool,
+	interacted bool,
 ) ([]*apimodel.Account, gtserror.WithCode) {
 	// Don't include instance accounts in this search.
 	//
@@ -68,6 +74,12 @@ func (p *Processor) Accounts(
 		return nil, gtserror.NewErrorBadRequest(err, err.Error())
 	}
 
+	// Autocompleting should be quick, so
+	// don't try to resolve remote accounts.
+	if interacted {
+		resolve = false
+	}
+
 	// Be nice and normalize query by prepending '@'.
 	// This will make it easier for accountsByNamestring
 	// to pick this up as a valid namestring.
@@ -83,6 +95,7 @@ func (p *Processor) Accounts(
 			{"query", query},
 			{"resolve", resolve},
 			{"following", following},
+			{"interacted", interacted},
 		}...).
 		Debugf("beginning search")
 
@@ -111,6 +124,7 @@ func (p *Processor) Accounts(
 		query,
 		resolve,
 		following,
+		interacted,
 		appendAccount,
 	); err != nil && !errors.Is(err, db.ErrNoEntries) {
 		err = gtserror.Newf("error searching by namestring: %w", err)
@@ -135,6 +135,55 @@ func (p *Processor) Get(
 		err           error
 	)
 
+	// If query contains status search operators (from:,
+	// before: etc) then it can only be a status search,
+	// so skip the other search methods and go straight
+	// to searching statuses in the database.
+	text, operators, hasOperators, err := parseStatusOperators(query)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if hasOperators {
+		if !includeStatuses(queryType) {
+			err := fmt.Errorf("search operators can only be used with search query type '%s' or '%s'", queryTypeAny, queryTypeStatuses)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		dbOperators, ok, err := p.statusOperatorsToDB(ctx, account, operators)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if ok {
+			if err := p.statusesByText(ctx,
+				account.ID,
+				maxID,
+				minID,
+				limit,
+				offset,
+				text,
+				dbOperators,
+				appendStatus,
+			); err != nil {
+				err = gtserror.Newf("error searching statuses with operators: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+		}
+
+		// Return whatever we got
+		// (could be nothing).
+		return p.packageSearchResult(
+			ctx,
+			account,
+			foundAccounts,
+			foundStatuses,
+			foundTags,
+			req.APIv1,
+			includeInstanceAccounts,
+		)
+	}
+
 	// Only try to search by namestring if search type includes
 	// accounts, since this is all namestring search can return.
 	if includeAccounts(queryType) {
@@ -163,6 +212,7 @@ func (p *Processor) Get(
 			queryC,
 			resolve,
 			following,
+			false, // interacted
 			appendAccount,
 		)
 		if err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -310,6 +360,7 @@ func (p *Processor) accountsByNamestring(
 	query string,
 	resolve bool,
 	following bool,
+	interacted bool,
 	appendAccount func(*gtsmodel.Account),
 ) (bool, error) {
 	// See if we have something that looks like a namestring.
@@ -337,6 +388,7 @@ func (p *Processor) accountsByNamestring(
 			// it instead of query to omit leading '@'.
 			username,
 			following,
+			interacted,
 			appendAccount,
 		)
 	}
@@ -744,6 +796,7 @@ func (p *Processor) byText(
 			offset,
 			query,
 			following,
+			false, // interacted
 			appendAccount,
 		); err != nil {
 			return err
@@ -759,6 +812,7 @@ func (p *Processor) byText(
 			limit,
 			offset,
 			query,
+			db.StatusSearchOperators{},
 			appendStatus,
 		); err != nil {
 			return err
@@ -779,12 +833,13 @@ func (p *Processor) accountsByText(
 	offset int,
 	query string,
 	following bool,
+	interacted bool,
 	appendAccount func(*gtsmodel.Account),
 ) error {
 	accounts, err := p.state.DB.SearchForAccounts(
 		ctx,
 		requestingAccountID,
-		query, maxID, minID, limit, following, offset)
+		query, maxID, minID, limit, following, interacted, offset)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return gtserror.Newf("error checking database for accounts using text %s: %w", query, err)
 	}
@@ -797,7 +852,8 @@ func (p *Processor) accountsByText(
 }
 
 // statusesByText searches in the database for limit
-// number of statuses using the given query text.
+// number of statuses using the given query text and
+// operators.
 func (p *Processor) statusesByText(
 	ctx context.Context,
 	requestingAccountID string,
@@ -806,12 +862,13 @@ func (p *Processor) statusesByText(
 	limit int,
 	offset int,
 	query string,
+	operators db.StatusSearchOperators,
 	appendStatus func(*gtsmodel.Status),
 ) error {
 	statuses, err := p.state.DB.SearchForStatuses(
 		ctx,
 		requestingAccountID,
-		query, maxID, minID, limit, offset)
+		query, operators, maxID, minID, limit, offset)
 	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return gtserror.Newf("error checking database for statuses using text %s: %w", query, err)
 	}
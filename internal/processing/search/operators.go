@@ -0,0 +1,172 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	operatorFrom   = "from:"
+	operatorBefore = "before:"
+	operatorAfter  = "after:"
+	operatorHas    = "has:"
+
+	operatorFromMe   = "me"
+	operatorHasMedia = "media"
+
+	// operatorDateLayout is the layout
+	// of before: and after: dates.
+	operatorDateLayout = "2006-01-02"
+)
+
+// statusOperators contains status search
+// operators as parsed from a query string.
+type statusOperators struct {
+	from     string    // "@username[@domain]" or "me"
+	before   time.Time // start of the given day
+	after    time.Time // end of the given day
+	hasMedia bool
+}
+
+// parseStatusOperators pulls any status search operators
+// out of the given query, returning the remaining query
+// text, parsed operators, and whether any were found.
+//
+// Supported operators are:
+//
+//   - from:me, from:@username, from:@username@domain
+//   - before:YYYY-MM-DD, after:YYYY-MM-DD (UTC, exclusive)
+//   - has:media
+func parseStatusOperators(query string) (string, statusOperators, bool, error) {
+	var (
+		ops   statusOperators
+		found bool
+		words = strings.Fields(query)
+		text  = make([]string, 0, len(words))
+	)
+
+	for _, word := range words {
+		lower := strings.ToLower(word)
+
+		switch {
+		case strings.HasPrefix(lower, operatorFrom):
+			ops.from = word[len(operatorFrom):]
+			if strings.EqualFold(ops.from, operatorFromMe) {
+				break
+			}
+
+			// Be generous with
+			// missing leading '@'.
+			if !strings.HasPrefix(ops.from, "@") {
+				ops.from = "@" + ops.from
+			}
+
+			if _, _, err := util.ExtractNamestringParts(ops.from); err != nil {
+				err := errors.New("from: operator requires an account, eg., from:me or from:@username@example.org")
+				return "", ops, false, err
+			}
+
+		case strings.HasPrefix(lower, operatorBefore):
+			t, err := time.Parse(operatorDateLayout, word[len(operatorBefore):])
+			if err != nil {
+				return "", ops, false, fmt.Errorf("before: operator requires a date in the format YYYY-MM-DD: %w", err)
+			}
+			ops.before = t
+
+		case strings.HasPrefix(lower, operatorAfter):
+			t, err := time.Parse(operatorDateLayout, word[len(operatorAfter):])
+			if err != nil {
+				return "", ops, false, fmt.Errorf("after: operator requires a date in the format YYYY-MM-DD: %w", err)
+			}
+			// Exclude the whole given day.
+			ops.after = t.Add(24*time.Hour - time.Millisecond)
+
+		case strings.HasPrefix(lower, operatorHas):
+			if has := lower[len(operatorHas):]; has != operatorHasMedia {
+				return "", ops, false, fmt.Errorf("has: operator value %s not recognized, valid options are ['%s']", has, operatorHasMedia)
+			}
+			ops.hasMedia = true
+
+		default:
+			// Not an operator, just text.
+			text = append(text, word)
+			continue
+		}
+
+		found = true
+	}
+
+	return strings.Join(text, " "), ops, found, nil
+}
+
+// statusOperatorsToDB converts parsed operators into db search operators,
+// resolving the from: account, if set. If the from: account
+// can't be found on this instance, false will be returned,
+// meaning the search can't possibly return results.
+func (p *Processor) statusOperatorsToDB(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	ops statusOperators,
+) (db.StatusSearchOperators, bool, error) {
+	dbOps := db.StatusSearchOperators{
+		Before:   ops.before,
+		After:    ops.after,
+		HasMedia: ops.hasMedia,
+	}
+
+	if ops.from == "" {
+		return dbOps, true, nil
+	}
+
+	if strings.EqualFold(ops.from, operatorFromMe) {
+		dbOps.FromAccountID = requestingAccount.ID
+		return dbOps, true, nil
+	}
+
+	// Already validated when parsing.
+	username, domain, _ := util.ExtractNamestringParts(ops.from)
+
+	if domain == config.GetHost() || domain == config.GetAccountDomain() {
+		// Local account.
+		domain = ""
+	}
+
+	account, err := p.state.DB.GetAccountByUsernameDomain(ctx, username, domain)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Account not known to us,
+			// so no statuses either.
+			return dbOps, false, nil
+		}
+		return dbOps, false, gtserror.Newf("db error getting from: account %s: %w", ops.from, err)
+	}
+
+	dbOps.FromAccountID = account.ID
+	return dbOps, true, nil
+}
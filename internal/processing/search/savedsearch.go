@@ -0,0 +1,122 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// maxSavedSearches is the maximum number
+// of searches that one account may save.
+const maxSavedSearches = 20
+
+// SavedSearchesGet returns all saved searches
+// of the given account, newest first.
+func (p *Processor) SavedSearchesGet(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.SavedSearch, gtserror.WithCode) {
+	savedSearches, err := p.state.DB.GetSavedSearchesForAccountID(ctx, account.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return []*apimodel.SavedSearch{}, nil
+		}
+		err = gtserror.Newf("db error getting saved searches: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiSavedSearches := make([]*apimodel.SavedSearch, 0, len(savedSearches))
+	for _, savedSearch := range savedSearches {
+		apiSavedSearches = append(apiSavedSearches, p.converter.SavedSearchToAPISavedSearch(ctx, savedSearch))
+	}
+
+	return apiSavedSearches, nil
+}
+
+// SavedSearchCreate saves a new search for the given account,
+// using the provided form. The form should have already been
+// validated by the time it reaches this function, apart from
+// the search operators in the query, which are checked here.
+func (p *Processor) SavedSearchCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.SavedSearchCreateRequest) (*apimodel.SavedSearch, gtserror.WithCode) {
+	if _, _, _, err := parseStatusOperators(form.Query); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	count, err := p.state.DB.CountSavedSearchesForAccountID(ctx, account.ID)
+	if err != nil {
+		err = gtserror.Newf("db error counting saved searches: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if count >= maxSavedSearches {
+		text := fmt.Sprintf("saved search limit reached, you can save at most %d searches", maxSavedSearches)
+		return nil, gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	// Only statuses created after the search
+	// was saved should cause notifications,
+	// so start checking from the search's ID.
+	savedSearchID := id.NewULID()
+
+	savedSearch := &gtsmodel.SavedSearch{
+		ID:            savedSearchID,
+		AccountID:     account.ID,
+		Account:       account,
+		Title:         form.Title,
+		Query:         form.Query,
+		Notify:        util.Ptr(form.Notify),
+		LastCheckedID: savedSearchID,
+	}
+
+	if err := p.state.DB.PutSavedSearch(ctx, savedSearch); err != nil {
+		err = gtserror.Newf("db error putting saved search: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return p.converter.SavedSearchToAPISavedSearch(ctx, savedSearch), nil
+}
+
+// SavedSearchDelete deletes one saved search of the given account.
+func (p *Processor) SavedSearchDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	savedSearch, err := p.state.DB.GetSavedSearchByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Saved search doesn't seem to exist.
+			return gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	if savedSearch.AccountID != account.ID {
+		err = fmt.Errorf("saved search with id %s does not belong to account %s", savedSearch.ID, account.ID)
+		return gtserror.NewErrorNotFound(err)
+	}
+
+	if err := p.state.DB.DeleteSavedSearchByID(ctx, savedSearch.ID); err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package search
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// savedSearchSchedule is the default schedule on
+	// which saved searches are checked for new matches.
+	savedSearchSchedule = "*/15 * * * *"
+
+	// savedSearchCheckLimit is the maximum number of
+	// new matches to notify about per saved search in
+	// one check; any more will be picked up next time.
+	savedSearchCheckLimit = 20
+)
+
+// SavedSearchRegisterJob registers the job checking saved
+// searches for new matches, by default every 15 minutes. It
+// should be called once on startup, before jobs are started.
+func (p *Processor) SavedSearchRegisterJob() {
+	p.state.Jobs.Register(jobs.SavedSearches, savedSearchSchedule, func(ctx context.Context, _ time.Time) error {
+		return p.SavedSearchesCheck(ctx)
+	})
+}
+
+// SavedSearchesCheck checks all saved searches which have
+// notify set for statuses matching them since they were last
+// checked, and notifies their owners of any new matches.
+func (p *Processor) SavedSearchesCheck(ctx context.Context) error {
+	savedSearches, err := p.state.DB.GetSavedSearchesToNotify(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Nothing to do.
+			return nil
+		}
+		return gtserror.Newf("db error getting saved searches: %w", err)
+	}
+
+	for _, savedSearch := range savedSearches {
+		if err := p.checkSavedSearch(ctx, savedSearch); err != nil {
+			log.Errorf(ctx, "error checking saved search %s: %v", savedSearch.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// checkSavedSearch searches for statuses matching the given saved
+// search which are newer than the last checked status, notifies
+// its owner of them, and updates the last checked status ID.
+func (p *Processor) checkSavedSearch(ctx context.Context, savedSearch *gtsmodel.SavedSearch) error {
+	text, operators, _, err := parseStatusOperators(savedSearch.Query)
+	if err != nil {
+		// Query was valid when saved,
+		// so this shouldn't happen.
+		return gtserror.Newf("error parsing query: %w", err)
+	}
+
+	dbOperators, ok, err := p.statusOperatorsToDB(ctx, savedSearch.Account, operators)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		// Can't return
+		// any results.
+		return nil
+	}
+
+	minID := savedSearch.LastCheckedID
+	if minID == "" {
+		minID = id.Lowest
+	}
+
+	// Search the owner's searchable statuses for new
+	// matches, using minID to get the oldest first.
+	statuses, err := p.state.DB.SearchForStatuses(
+		ctx,
+		savedSearch.AccountID,
+		text, dbOperators,
+		"", minID, savedSearchCheckLimit, 0)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error searching statuses: %w", err)
+	}
+
+	if len(statuses) == 0 {
+		// No new matches.
+		return nil
+	}
+
+	for _, status := range statuses {
+		if status.AccountID == savedSearch.AccountID {
+			// Don't notify about own statuses.
+			continue
+		}
+
+		visible, err := p.filter.StatusVisible(ctx, savedSearch.Account, status)
+		if err != nil {
+			log.Errorf(ctx, "error checking visibility of status %s: %v", status.ID, err)
+			continue
+		}
+
+		if !visible {
+			continue
+		}
+
+		if err := p.notifySavedSearch(ctx, savedSearch.Account, status); err != nil {
+			log.Errorf(ctx, "error notifying saved search match %s: %v", status.ID, err)
+		}
+	}
+
+	// Statuses are returned newest first.
+	savedSearch.LastCheckedID = statuses[0].ID
+	if err := p.state.DB.UpdateSavedSearch(ctx, savedSearch, "last_checked_id"); err != nil {
+		return gtserror.Newf("db error updating saved search: %w", err)
+	}
+
+	return nil
+}
+
+// notifySavedSearch notifies the given account that the
+// given status matches one of their saved searches, unless
+// they were already notified about this status.
+func (p *Processor) notifySavedSearch(ctx context.Context, account *gtsmodel.Account, status *gtsmodel.Status) error {
+	// Make sure a notification doesn't
+	// already exist with these params.
+	if _, err := p.state.DB.GetNotification(
+		gtscontext.SetBarebones(ctx),
+		gtsmodel.NotificationSavedSearch,
+		account.ID,
+		status.AccountID,
+		status.ID,
+	); err == nil {
+		// Notification exists;
+		// nothing to do.
+		return nil
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		// Real error.
+		return gtserror.Newf("error checking existence of notification: %w", err)
+	}
+
+	notif := &gtsmodel.Notification{
+		ID:               id.NewULID(),
+		NotificationType: gtsmodel.NotificationSavedSearch,
+		TargetAccountID:  account.ID,
+		OriginAccountID:  status.AccountID,
+		StatusID:         status.ID,
+	}
+
+	if err := p.state.DB.PutNotification(ctx, notif); err != nil {
+		return gtserror.Newf("error putting notification in database: %w", err)
+	}
+
+	// Stream notification to the user.
+	apiNotif, err := p.converter.NotificationToAPINotification(ctx, notif)
+	if err != nil {
+		return gtserror.Newf("error converting notification to api representation: %w", err)
+	}
+
+	if err := p.stream.Notify(apiNotif, account); err != nil {
+		return gtserror.Newf("error streaming notification to account: %w", err)
+	}
+
+	return nil
+}
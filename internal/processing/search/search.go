@@ -19,6 +19,7 @@ package search
 
 import (
 	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
@@ -29,14 +30,16 @@ type Processor struct {
 	federator *federation.Federator
 	converter *typeutils.Converter
 	filter    *visibility.Filter
+	stream    *stream.Processor
 }
 
 // New returns a new status processor.
-func New(state *state.State, federator *federation.Federator, converter *typeutils.Converter, filter *visibility.Filter) Processor {
+func New(state *state.State, federator *federation.Federator, converter *typeutils.Converter, filter *visibility.Filter, stream *stream.Processor) Processor {
 	return Processor{
 		state:     state,
 		federator: federator,
 		converter: converter,
 		filter:    filter,
+		stream:    stream,
 	}
 }
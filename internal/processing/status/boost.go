@@ -28,10 +28,21 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 )
 
 // BoostCreate processes the boost/reblog of a given status, returning the newly-created boost if all is well.
-func (p *Processor) BoostCreate(ctx context.Context, requestingAccount *gtsmodel.Account, application *gtsmodel.Application, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
+//
+// If visibility is set, the boost will be created with that visibility, provided it's not wider than that of
+// the target status. If not set, the boost will take the visibility of the target status.
+func (p *Processor) BoostCreate(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	application *gtsmodel.Application,
+	targetStatusID string,
+	visibility apimodel.Visibility,
+) (*apimodel.Status, gtserror.WithCode) {
 	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
 	if err != nil {
 		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error fetching status %s: %s", targetStatusID, err))
@@ -62,12 +73,36 @@ func (p *Processor) BoostCreate(ctx context.Context, requestingAccount *gtsmodel
 		return nil, gtserror.NewErrorNotFound(errors.New("status is not boostable"))
 	}
 
+	boostVisibility, errWithCode := boostVisibility(targetStatus, visibility)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Check if the status is already boosted by
+	// this account; if so, just return the existing
+	// boost rather than federating out a duplicate.
+	existing, err := p.state.DB.GetStatusBoost(ctx, targetStatus.ID, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error checking existing boost of %s: %w", targetStatus.ID, err)
+		return nil, gtserror.NewErrorInternalError(err)
+	} else if existing != nil {
+		return p.apiStatus(ctx, existing, requestingAccount)
+	}
+
+	// Guard against clients toggling
+	// the boost on and off repeatedly.
+	if errWithCode := p.c.CheckInteractionCooldown(requestingAccount, common.ToggledInteractionBoost, targetStatus.ID); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// it's visible! it's boostable! so let's boost the FUCK out of it
 	boostWrapperStatus, err := p.converter.StatusToBoost(ctx, targetStatus, requestingAccount)
 	if err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	boostWrapperStatus.Visibility = boostVisibility
+
 	boostWrapperStatus.CreatedWithApplicationID = application.ID
 	boostWrapperStatus.BoostOfAccount = targetStatus.Account
 
@@ -75,6 +110,7 @@ func (p *Processor) BoostCreate(ctx context.Context, requestingAccount *gtsmodel
 	if err := p.state.DB.PutStatus(ctx, boostWrapperStatus); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
+	p.c.MarkInteractionToggled(requestingAccount, common.ToggledInteractionBoost, targetStatus.ID)
 
 	// send it back to the processor for async processing
 	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
@@ -88,6 +124,52 @@ func (p *Processor) BoostCreate(ctx context.Context, requestingAccount *gtsmodel
 	return p.apiStatus(ctx, boostWrapperStatus, requestingAccount)
 }
 
+// visibilityRanks ranks visibility levels from
+// widest to narrowest, for comparing a requested
+// boost visibility against that of the boosted status.
+var visibilityRanks = map[gtsmodel.Visibility]int{
+	gtsmodel.VisibilityPublic:        0,
+	gtsmodel.VisibilityUnlocked:      1,
+	gtsmodel.VisibilityFollowersOnly: 2,
+	gtsmodel.VisibilityMutualsOnly:   3,
+	gtsmodel.VisibilityDirect:        4,
+}
+
+// boostVisibility returns the visibility that a boost of the
+// target status should be created with, given the visibility
+// requested by the client (if any). Boosts can only be public,
+// unlisted, or followers-only, and may not widen the audience
+// of the boosted status.
+func boostVisibility(
+	targetStatus *gtsmodel.Status,
+	requested apimodel.Visibility,
+) (gtsmodel.Visibility, gtserror.WithCode) {
+	if requested == "" {
+		// Nothing requested,
+		// use status visibility.
+		return targetStatus.Visibility, nil
+	}
+
+	vis := typeutils.APIVisToVis(requested)
+	switch vis {
+	case gtsmodel.VisibilityPublic,
+		gtsmodel.VisibilityUnlocked,
+		gtsmodel.VisibilityFollowersOnly:
+		// Fine.
+
+	default:
+		text := fmt.Sprintf("boost visibility %s not allowed, must be one of public, unlisted, private", requested)
+		return "", gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	if visibilityRanks[vis] < visibilityRanks[targetStatus.Visibility] {
+		text := fmt.Sprintf("boost visibility %s is wider than visibility of boosted status", requested)
+		return "", gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+	}
+
+	return vis, nil
+}
+
 // BoostRemove processes the unboost/unreblog of a given status, returning the status if all is well.
 func (p *Processor) BoostRemove(ctx context.Context, requestingAccount *gtsmodel.Account, application *gtsmodel.Application, targetStatusID string) (*apimodel.Status, gtserror.WithCode) {
 	targetStatus, err := p.state.DB.GetStatusByID(ctx, targetStatusID)
@@ -108,11 +190,18 @@ func (p *Processor) BoostRemove(ctx context.Context, requestingAccount *gtsmodel
 
 	// Check whether the requesting account has boosted the given status ID.
 	boost, err := p.state.DB.GetStatusBoost(ctx, targetStatusID, requestingAccount.ID)
-	if err != nil {
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
 		return nil, gtserror.NewErrorNotFound(fmt.Errorf("error checking status boost %s: %w", targetStatusID, err))
 	}
 
 	if boost != nil {
+		// Guard against clients toggling
+		// the boost on and off repeatedly.
+		if errWithCode := p.c.CheckInteractionCooldown(requestingAccount, common.ToggledInteractionBoost, targetStatusID); errWithCode != nil {
+			return nil, errWithCode
+		}
+		p.c.MarkInteractionToggled(requestingAccount, common.ToggledInteractionBoost, targetStatusID)
+
 		// pin some stuff onto the boost while we have it out of the db
 		boost.Account = requestingAccount
 		boost.BoostOf = targetStatus
@@ -19,9 +19,13 @@ package status_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 )
 
 type StatusBoostTestSuite struct {
@@ -36,7 +40,7 @@ func (suite *StatusBoostTestSuite) TestBoostOfBoost() {
 	application1 := suite.testApplications["application_1"]
 	targetStatus1 := suite.testStatuses["admin_account_status_1"]
 
-	boost1, err := suite.status.BoostCreate(ctx, boostingAccount1, application1, targetStatus1.ID)
+	boost1, err := suite.status.BoostCreate(ctx, boostingAccount1, application1, targetStatus1.ID, "")
 	suite.NoError(err)
 	suite.NotNil(boost1)
 	suite.Equal(targetStatus1.ID, boost1.Reblog.ID)
@@ -46,7 +50,7 @@ func (suite *StatusBoostTestSuite) TestBoostOfBoost() {
 	application2 := suite.testApplications["application_2"]
 	targetStatus2ID := boost1.ID
 
-	boost2, err := suite.status.BoostCreate(ctx, boostingAccount2, application2, targetStatus2ID)
+	boost2, err := suite.status.BoostCreate(ctx, boostingAccount2, application2, targetStatus2ID, "")
 	suite.NoError(err)
 	suite.NotNil(boost2)
 	// the boosted status should not be the boost,
@@ -54,6 +58,89 @@ func (suite *StatusBoostTestSuite) TestBoostOfBoost() {
 	suite.Equal(targetStatus1.ID, boost2.Reblog.ID)
 }
 
+func (suite *StatusBoostTestSuite) TestBoostTwice() {
+	ctx := context.Background()
+
+	boostingAccount := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	boost1, errWithCode := suite.status.BoostCreate(ctx, boostingAccount, application, targetStatus.ID, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Boosting again should just return the existing boost.
+	boost2, errWithCode := suite.status.BoostCreate(ctx, boostingAccount, application, targetStatus.ID, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(boost1.ID, boost2.ID)
+}
+
+func (suite *StatusBoostTestSuite) TestBoostToggleCooldown() {
+	ctx := context.Background()
+
+	boostingAccount := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	config.SetAccountsInteractionCooldown(time.Minute)
+
+	_, errWithCode := suite.status.BoostCreate(ctx, boostingAccount, application, targetStatus.ID, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Unboosting straight away is too quick.
+	_, errWithCode = suite.status.BoostRemove(ctx, boostingAccount, application, targetStatus.ID)
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+	suite.Contains(errWithCode.Safe(), "you're toggling this boost too quickly")
+
+	// Once the cooldown's over, it's fine.
+	config.SetAccountsInteractionCooldown(0)
+	_, errWithCode = suite.status.BoostRemove(ctx, boostingAccount, application, targetStatus.ID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Unboosting again is a no-op.
+	_, errWithCode = suite.status.BoostRemove(ctx, boostingAccount, application, targetStatus.ID)
+	suite.NoError(errWithCode)
+}
+
+func (suite *StatusBoostTestSuite) TestBoostUnlisted() {
+	ctx := context.Background()
+
+	boostingAccount := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	boost, errWithCode := suite.status.BoostCreate(ctx, boostingAccount, application, targetStatus.ID, apimodel.VisibilityUnlisted)
+	suite.NoError(errWithCode)
+	suite.Equal(apimodel.VisibilityUnlisted, boost.Visibility)
+	suite.Equal(targetStatus.ID, boost.Reblog.ID)
+}
+
+func (suite *StatusBoostTestSuite) TestBoostWiderVisibility() {
+	ctx := context.Background()
+
+	// Self boost of a followers-only status.
+	boostingAccount := suite.testAccounts["local_account_1"]
+	application := suite.testApplications["application_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_5"]
+
+	boost, errWithCode := suite.status.BoostCreate(ctx, boostingAccount, application, targetStatus.ID, apimodel.VisibilityPublic)
+	suite.Nil(boost)
+	suite.EqualError(errWithCode, "boost visibility public is wider than visibility of boosted status")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+
+	// Boosting with followers-only visibility is fine.
+	boost, errWithCode = suite.status.BoostCreate(ctx, boostingAccount, application, targetStatus.ID, apimodel.VisibilityPrivate)
+	suite.NoError(errWithCode)
+	suite.Equal(apimodel.VisibilityPrivate, boost.Visibility)
+}
+
 func TestStatusBoostTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusBoostTestSuite))
 }
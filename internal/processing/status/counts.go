@@ -0,0 +1,86 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// countsSchedule is the default schedule on which
+	// faves / boosts counts of remote statuses are synced.
+	countsSchedule = "@every 15m"
+
+	// countsInterval is how long after last fetching
+	// a remote status' counts they're fetched again.
+	countsInterval = 6 * time.Hour
+
+	// countsPeriod is how long after a remote status
+	// was created we keep syncing its counts; past
+	// this, counts seldom change much anymore.
+	countsPeriod = 7 * 24 * time.Hour
+
+	// countsBatch is the maximum number of statuses
+	// which have their counts synced in one run.
+	countsBatch = 50
+)
+
+// CountsRegisterJob registers the job syncing the faves and
+// boosts counts of recent remote statuses faved or boosted by
+// local accounts, by default every 15 minutes. It should be
+// called once on startup, before jobs are started.
+func (p *Processor) CountsRegisterJob() {
+	p.state.Jobs.Register(jobs.StatusCounts, countsSchedule, p.CountsSync)
+}
+
+// CountsSync refreshes the faves and boosts counts of a batch
+// of recent remote statuses that local accounts have faved or
+// boosted, and which haven't had them fetched in countsInterval,
+// so that these counts aren't stuck as they were on first fetch.
+func (p *Processor) CountsSync(ctx context.Context, now time.Time) error {
+	statuses, err := p.state.DB.GetStatusesForCountsRefresh(ctx,
+		now.Add(-countsInterval),
+		now.Add(-countsPeriod),
+		countsBatch,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting statuses: %w", err)
+	}
+
+	for _, status := range statuses {
+		if err := p.federator.RefreshStatusCounts(ctx, "", status); err != nil {
+			log.Warnf(ctx, "error refreshing counts of status %s: %v", status.URI, err)
+
+			// Mark as fetched anyway, so we
+			// don't retry before they're due.
+			status.CountsFetchedAt = now
+			if err := p.state.DB.UpdateStatus(ctx, status, "counts_fetched_at"); err != nil {
+				log.Errorf(ctx, "db error updating status %s: %v", status.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
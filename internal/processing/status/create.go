@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/common"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
@@ -39,8 +40,31 @@ import (
 
 // Create processes the given form to create a new status, returning the api model representation of that status if it's OK.
 //
+// If idempotencyKey is set, and the account recently created a status from the same form with the same key,
+// that status is returned instead of creating a duplicate.
+//
 // Precondition: the form's fields should have already been validated and normalized by the caller.
-func (p *Processor) Create(ctx context.Context, requestingAccount *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode) {
+func (p *Processor) Create(ctx context.Context, requestingAccount *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm, idempotencyKey string) (*apimodel.Status, gtserror.WithCode) {
+	return common.Idempotent(
+		p.state,
+		requestingAccount,
+		"status",
+		idempotencyKey,
+		form,
+		func() (*apimodel.Status, string, gtserror.WithCode) {
+			apiStatus, errWithCode := p.create(ctx, requestingAccount, application, form)
+			if errWithCode != nil {
+				return nil, "", errWithCode
+			}
+			return apiStatus, apiStatus.ID, nil
+		},
+		func(statusID string) (*apimodel.Status, gtserror.WithCode) {
+			return p.Get(ctx, requestingAccount, statusID)
+		},
+	)
+}
+
+func (p *Processor) create(ctx context.Context, requestingAccount *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode) {
 	// Generate new ID for status.
 	statusID := id.NewULID()
 
@@ -64,6 +88,7 @@ func (p *Processor) Create(ctx context.Context, requestingAccount *gtsmodel.Acco
 		Sensitive:                &form.Sensitive,
 		CreatedWithApplicationID: application.ID,
 		Text:                     form.Status,
+		Location:                 text.SanitizeToPlaintext(form.Location),
 	}
 
 	if errWithCode := p.processReplyToID(ctx, form, requestingAccount.ID, status); errWithCode != nil {
@@ -78,6 +103,14 @@ func (p *Processor) Create(ctx context.Context, requestingAccount *gtsmodel.Acco
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if status.Visibility == gtsmodel.VisibilityDirect {
+		// Guard against bursts of direct messages,
+		// which suggest that the account is compromised.
+		if errWithCode := p.c.CheckActivityLimit(ctx, requestingAccount, common.LimitedActivityDirectMessage); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
 	if err := processLanguage(form, requestingAccount.Language, status); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -86,6 +119,10 @@ func (p *Processor) Create(ctx context.Context, requestingAccount *gtsmodel.Acco
 		return nil, gtserror.NewErrorInternalError(err)
 	}
 
+	if errWithCode := checkTagsUseable(status); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	// Insert this new status in the database.
 	if err := p.state.DB.PutStatus(ctx, status); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
@@ -278,6 +315,19 @@ func processLanguage(form *apimodel.AdvancedStatusCreateForm, accountDefaultLang
 	return nil
 }
 
+// checkTagsUseable returns an error if the
+// status uses any tags that have been banned
+// by an admin of this instance.
+func checkTagsUseable(status *gtsmodel.Status) gtserror.WithCode {
+	for _, tag := range status.Tags {
+		if tag.Useable != nil && !*tag.Useable {
+			text := fmt.Sprintf("hashtag #%s is not allowed on this instance", tag.Name)
+			return gtserror.NewErrorUnprocessableEntity(errors.New(text), text)
+		}
+	}
+	return nil
+}
+
 func (p *Processor) processContent(ctx context.Context, parseMention gtsmodel.ParseMentionFunc, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) error {
 	if form.ContentType == "" {
 		// If content type wasn't specified, use the author's preferred content-type.
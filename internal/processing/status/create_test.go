@@ -19,6 +19,7 @@ package status_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -26,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 type StatusCreateTestSuite struct {
@@ -59,7 +61,7 @@ func (suite *StatusCreateTestSuite) TestProcessContentWarningWithQuotationMarks(
 		},
 	}
 
-	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
 	suite.NoError(err)
 	suite.NotNil(apiStatus)
 
@@ -93,7 +95,7 @@ func (suite *StatusCreateTestSuite) TestProcessContentWarningWithHTMLEscapedQuot
 		},
 	}
 
-	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
 	suite.NoError(err)
 	suite.NotNil(apiStatus)
 
@@ -131,7 +133,7 @@ func (suite *StatusCreateTestSuite) TestProcessStatusMarkdownWithUnderscoreEmoji
 		},
 	}
 
-	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
 	suite.NoError(err)
 	suite.NotNil(apiStatus)
 
@@ -165,7 +167,7 @@ func (suite *StatusCreateTestSuite) TestProcessStatusMarkdownWithSpoilerTextEmoj
 		},
 	}
 
-	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
 	suite.NoError(err)
 	suite.NotNil(apiStatus)
 
@@ -203,7 +205,7 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 		},
 	}
 
-	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
 	suite.EqualError(err, "media 01F8MH8RMYQ6MSNY3JM2XT1CQ5 description too short, at least 100 required")
 	suite.Nil(apiStatus)
 }
@@ -235,13 +237,126 @@ func (suite *StatusCreateTestSuite) TestProcessLanguageWithScriptPart() {
 		},
 	}
 
-	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
 	suite.NoError(err)
 	suite.NotNil(apiStatus)
 
 	suite.Equal("zh-Hans", *apiStatus.Language)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessStatusWithBannedTag() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Ban the #welcome tag.
+	tag := new(gtsmodel.Tag)
+	*tag = *suite.testTags["welcome"]
+	tag.Useable = util.Ptr(false)
+	tag.Listable = util.Ptr(false)
+	if err := suite.db.UpdateTag(ctx, tag, "useable", "listable"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "hello #Welcome",
+			Visibility:  apimodel.VisibilityPublic,
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: hashtag #welcome is not allowed on this instance", errWithCode.Safe())
+}
+
+func (suite *StatusCreateTestSuite) TestProcessDirectMessageActivityLimit() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	// Only allow one direct message per window.
+	config.SetAccountsActivityLimitUserDirectMessages(1)
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "hey @the_mighty_zork",
+			Visibility:  apimodel.VisibilityDirect,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	// First direct message is fine.
+	_, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Public statuses aren't limited.
+	statusCreateForm.Visibility = apimodel.VisibilityPublic
+	_, errWithCode = suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Second direct message goes over the limit.
+	statusCreateForm.Visibility = apimodel.VisibilityDirect
+	_, errWithCode = suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	user, err := suite.db.GetUserByAccountID(ctx, creatingAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.NotZero(user.LimitedUntil)
+}
+
+func (suite *StatusCreateTestSuite) TestIdempotentCreate() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "posting over a flaky connection",
+			Visibility:  apimodel.VisibilityPublic,
+			Language:    "en",
+			ContentType: apimodel.StatusContentTypePlain,
+		},
+	}
+
+	apiStatus, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "some-key")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	// Retrying returns the same status.
+	retried, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "some-key")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.Equal(apiStatus.ID, retried.ID)
+
+	// Without the key, it's a new status.
+	another, errWithCode := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "")
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	suite.NotEqual(apiStatus.ID, another.ID)
+
+	// Reusing the key for something else fails.
+	statusCreateForm.Status = "something else entirely"
+	_, errWithCode = suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm, "some-key")
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.EqualError(errWithCode, "Idempotency-Key was already used for a different request")
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
@@ -22,6 +22,7 @@ import (
 	"sort"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
@@ -43,42 +44,139 @@ func (p *Processor) ContextGet(ctx context.Context, requestingAccount *gtsmodel.
 		return nil, errWithCode
 	}
 
-	context := &apimodel.Context{
-		Ancestors:   []apimodel.Status{},
-		Descendants: []apimodel.Status{},
+	parents, children, errWithCode := p.contextStatuses(ctx, requestingAccount, targetStatus)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
-	parents, err := p.state.DB.GetStatusParents(ctx, targetStatus, false)
-	if err != nil {
-		return nil, gtserror.NewErrorInternalError(err)
+	return &apimodel.Context{
+		Ancestors:   p.apiContextStatuses(ctx, requestingAccount, parents),
+		Descendants: p.apiContextStatuses(ctx, requestingAccount, children),
+	}, nil
+}
+
+// WebContextGet returns one page of the context of the given status ID, for
+// serving in the web view of a thread. Only the statuses on the requested page
+// are converted, so that links into very long threads remain fast to render.
+//
+// Replies are split into pages of web-thread-page-size statuses. If there are
+// more earlier posts than that, only the first post of the thread and the
+// latest earlier posts are returned, and the rest are counted as hidden.
+func (p *Processor) WebContextGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, page int) (*apimodel.WebThreadContext, gtserror.WithCode) {
+	targetStatus, errWithCode := p.getVisibleStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
 	}
 
-	for _, status := range parents {
-		if v, err := p.filter.StatusVisible(ctx, requestingAccount, status); err == nil && v {
-			apiStatus, err := p.converter.StatusToAPIStatus(ctx, status, requestingAccount)
-			if err == nil {
-				context.Ancestors = append(context.Ancestors, *apiStatus)
-			}
+	parents, children, errWithCode := p.contextStatuses(ctx, requestingAccount, targetStatus)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Pagination is disabled
+	// if page size is not set.
+	pageSize := config.GetWebThreadPageSize()
+	if pageSize <= 0 {
+		page = 1
+	}
+
+	webContext := &apimodel.WebThreadContext{
+		Page:             page,
+		TotalDescendants: len(children),
+	}
+
+	if pageSize > 0 && len(parents) > pageSize {
+		// Collapse earlier posts, keeping the start of
+		// the thread + the posts closest to the target.
+		webContext.HiddenAncestors = len(parents) - pageSize
+		parents = append(parents[:1], parents[len(parents)-pageSize+1:]...)
+	}
+
+	if pageSize > 0 {
+		start := (page - 1) * pageSize
+		if start >= len(children) && page > 1 {
+			err := gtserror.Newf("page %d is beyond the last page of replies", page)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+
+		end := start + pageSize
+		if end < len(children) {
+			webContext.NextPage = page + 1
+		} else {
+			end = len(children)
 		}
+
+		children = children[start:end]
+	}
+
+	if page > 1 {
+		webContext.PrevPage = page - 1
+	}
+
+	webContext.Ancestors = p.apiContextStatuses(ctx, requestingAccount, parents)
+	webContext.Descendants = p.apiContextStatuses(ctx, requestingAccount, children)
+
+	return webContext, nil
+}
+
+// contextStatuses returns the parents and children of the given
+// status which are visible to the requesting account, with parents
+// sorted from the start of the thread to the status closest to target.
+func (p *Processor) contextStatuses(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatus *gtsmodel.Status,
+) ([]*gtsmodel.Status, []*gtsmodel.Status, gtserror.WithCode) {
+	parents, err := p.state.DB.GetStatusParents(ctx, targetStatus, false)
+	if err != nil {
+		return nil, nil, gtserror.NewErrorInternalError(err)
 	}
 
-	sort.Slice(context.Ancestors, func(i int, j int) bool {
-		return context.Ancestors[i].ID < context.Ancestors[j].ID
+	parents = p.visibleStatuses(ctx, requestingAccount, parents)
+
+	sort.Slice(parents, func(i int, j int) bool {
+		return parents[i].ID < parents[j].ID
 	})
 
 	children, err := p.state.DB.GetStatusChildren(ctx, targetStatus, false, "")
 	if err != nil {
-		return nil, gtserror.NewErrorInternalError(err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
 	}
 
-	for _, status := range children {
+	children = p.visibleStatuses(ctx, requestingAccount, children)
+
+	return parents, children, nil
+}
+
+// visibleStatuses returns the given
+// statuses visible to requester.
+func (p *Processor) visibleStatuses(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	statuses []*gtsmodel.Status,
+) []*gtsmodel.Status {
+	visible := make([]*gtsmodel.Status, 0, len(statuses))
+	for _, status := range statuses {
 		if v, err := p.filter.StatusVisible(ctx, requestingAccount, status); err == nil && v {
-			apiStatus, err := p.converter.StatusToAPIStatus(ctx, status, requestingAccount)
-			if err == nil {
-				context.Descendants = append(context.Descendants, *apiStatus)
-			}
+			visible = append(visible, status)
 		}
 	}
+	return visible
+}
 
-	return context, nil
+// apiContextStatuses converts the given statuses to
+// api statuses, skipping any that fail to convert.
+func (p *Processor) apiContextStatuses(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	statuses []*gtsmodel.Status,
+) []apimodel.Status {
+	apiStatuses := make([]apimodel.Status, 0, len(statuses))
+	for _, status := range statuses {
+		apiStatus, err := p.converter.StatusToAPIStatus(ctx, status, requestingAccount)
+		if err == nil {
+			apiStatuses = append(apiStatuses, *apiStatus)
+		}
+	}
+	return apiStatuses
 }
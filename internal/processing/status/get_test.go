@@ -0,0 +1,407 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type StatusGetTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusGetTestSuite) TestWebContextGetPaged() {
+	ctx := context.Background()
+	config.SetWebThreadPageSize(1)
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// Get whole context to compare with.
+	apiContext, errWithCode := suite.status.ContextGet(ctx, requestingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Len(apiContext.Descendants, 2)
+
+	webContext, errWithCode := suite.status.WebContextGet(ctx, requestingAccount, targetStatus.ID, 1)
+	suite.NoError(errWithCode)
+	suite.Equal(2, webContext.TotalDescendants)
+	suite.Len(webContext.Descendants, 1)
+	suite.Equal(apiContext.Descendants[0].ID, webContext.Descendants[0].ID)
+	suite.Zero(webContext.PrevPage)
+	suite.Equal(2, webContext.NextPage)
+
+	webContext, errWithCode = suite.status.WebContextGet(ctx, requestingAccount, targetStatus.ID, 2)
+	suite.NoError(errWithCode)
+	suite.Len(webContext.Descendants, 1)
+	suite.Equal(apiContext.Descendants[1].ID, webContext.Descendants[0].ID)
+	suite.Equal(1, webContext.PrevPage)
+	suite.Zero(webContext.NextPage)
+
+	// No replies left for this page.
+	_, errWithCode = suite.status.WebContextGet(ctx, requestingAccount, targetStatus.ID, 3)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusGetTestSuite) TestWebContextGetCollapsedAncestors() {
+	ctx := context.Background()
+	config.SetWebThreadPageSize(2)
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+	rootStatus := suite.testStatuses["local_account_1_status_1"]
+	parentStatus := suite.testStatuses["admin_account_status_3"]
+
+	// Extend the thread with a chain of replies, so that
+	// target status has 4 ancestors: root, parent + 2 new.
+	for i := 0; i < 3; i++ {
+		// Ancestors are sorted by ID, so ensure
+		// each reply gets a later ID than parent.
+		statusID, err := id.NewULIDFromTime(time.Now().Add(time.Duration(i) * time.Second))
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+
+		reply := &gtsmodel.Status{
+			ID:                  statusID,
+			URI:                 "http://localhost:8080/users/the_mighty_zork/statuses/" + statusID,
+			URL:                 "http://localhost:8080/@the_mighty_zork/statuses/" + statusID,
+			Content:             "reply " + statusID,
+			Local:               util.Ptr(true),
+			AccountURI:          requestingAccount.URI,
+			AccountID:           requestingAccount.ID,
+			InReplyToID:         parentStatus.ID,
+			InReplyToURI:        parentStatus.URI,
+			InReplyToAccountID:  parentStatus.AccountID,
+			Visibility:          gtsmodel.VisibilityPublic,
+			Sensitive:           util.Ptr(false),
+			Federated:           util.Ptr(true),
+			Boostable:           util.Ptr(true),
+			Replyable:           util.Ptr(true),
+			Likeable:            util.Ptr(true),
+			ActivityStreamsType: "Note",
+		}
+		if err := suite.db.PutStatus(ctx, reply); err != nil {
+			suite.FailNow(err.Error())
+		}
+		parentStatus = reply
+	}
+
+	webContext, errWithCode := suite.status.WebContextGet(ctx, requestingAccount, parentStatus.ID, 1)
+	suite.NoError(errWithCode)
+	suite.Equal(2, webContext.HiddenAncestors)
+	suite.Len(webContext.Ancestors, 2)
+	suite.Equal(rootStatus.ID, webContext.Ancestors[0].ID)
+	suite.Equal(parentStatus.InReplyToID, webContext.Ancestors[1].ID)
+}
+
+// putReply puts a new reply from the given local account to the
+// given status, with the given visibility, returning the reply.
+func (suite *StatusGetTestSuite) putReply(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	parentStatus *gtsmodel.Status,
+	visibility gtsmodel.Visibility,
+) *gtsmodel.Status {
+	statusID := id.NewULID()
+
+	reply := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 account.URI + "/statuses/" + statusID,
+		URL:                 account.URL + "/statuses/" + statusID,
+		Content:             "reply " + statusID,
+		Local:               util.Ptr(true),
+		AccountURI:          account.URI,
+		AccountID:           account.ID,
+		InReplyToID:         parentStatus.ID,
+		InReplyToURI:        parentStatus.URI,
+		InReplyToAccountID:  parentStatus.AccountID,
+		Visibility:          visibility,
+		Sensitive:           util.Ptr(false),
+		Federated:           util.Ptr(true),
+		Boostable:           util.Ptr(true),
+		Replyable:           util.Ptr(true),
+		Likeable:            util.Ptr(true),
+		ActivityStreamsType: "Note",
+	}
+	if err := suite.db.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return reply
+}
+
+// webContextDescendantIDs pages through the whole web context of
+// the given status, checking the page links along the way, and
+// returns the IDs of the descendants from all pages in order.
+func (suite *StatusGetTestSuite) webContextDescendantIDs(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+	pageSize int,
+) []string {
+	var (
+		ids  []string
+		page = 1
+	)
+
+	for {
+		webContext, errWithCode := suite.status.WebContextGet(ctx, requestingAccount, targetStatusID, page)
+		if errWithCode != nil {
+			suite.FailNow(errWithCode.Error())
+		}
+
+		suite.Equal(page, webContext.Page)
+		suite.Equal(page-1, webContext.PrevPage)
+		suite.LessOrEqual(len(webContext.Descendants), pageSize)
+
+		for _, descendant := range webContext.Descendants {
+			ids = append(ids, descendant.ID)
+		}
+
+		if webContext.NextPage == 0 {
+			// Last page should account
+			// for all the descendants.
+			suite.Equal(webContext.TotalDescendants, len(ids))
+			break
+		}
+
+		// Only the last page may be short.
+		suite.Len(webContext.Descendants, pageSize)
+		suite.Equal(page+1, webContext.NextPage)
+		page = webContext.NextPage
+	}
+
+	// One past the last page is gone.
+	_, errWithCode := suite.status.WebContextGet(ctx, requestingAccount, targetStatusID, page+1)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	return ids
+}
+
+// contextDescendantIDs returns the IDs of all
+// descendants of the given status, unpaged.
+func (suite *StatusGetTestSuite) contextDescendantIDs(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+) []string {
+	apiContext, errWithCode := suite.status.ContextGet(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+
+	ids := make([]string, 0, len(apiContext.Descendants))
+	for _, descendant := range apiContext.Descendants {
+		ids = append(ids, descendant.ID)
+	}
+	return ids
+}
+
+func (suite *StatusGetTestSuite) TestWebContextGetPageBoundaries() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// Bring the thread up to 7 replies.
+	for i := 0; i < 5; i++ {
+		suite.putReply(ctx, suite.testAccounts["local_account_2"], targetStatus, gtsmodel.VisibilityPublic)
+	}
+
+	allIDs := suite.contextDescendantIDs(ctx, requestingAccount, targetStatus.ID)
+	suite.Len(allIDs, 7)
+
+	// Short last page, full last page, single
+	// full page, and a page bigger than the thread.
+	for _, pageSize := range []int{3, 1, 7, 20} {
+		config.SetWebThreadPageSize(pageSize)
+		suite.Equal(allIDs, suite.webContextDescendantIDs(ctx, requestingAccount, targetStatus.ID, pageSize), pageSize)
+	}
+}
+
+func (suite *StatusGetTestSuite) TestWebContextGetNoReplies() {
+	ctx := context.Background()
+	config.SetWebThreadPageSize(2)
+
+	// Status without any replies.
+	targetStatus := suite.testStatuses["local_account_2_status_1"]
+
+	webContext, errWithCode := suite.status.WebContextGet(ctx, nil, targetStatus.ID, 1)
+	suite.NoError(errWithCode)
+	suite.Empty(webContext.Descendants)
+	suite.Zero(webContext.TotalDescendants)
+	suite.Zero(webContext.PrevPage)
+	suite.Zero(webContext.NextPage)
+
+	// There's no page 2 of nothing.
+	_, errWithCode = suite.status.WebContextGet(ctx, nil, targetStatus.ID, 2)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusGetTestSuite) TestWebContextGetPagingDisabled() {
+	ctx := context.Background()
+	config.SetWebThreadPageSize(0)
+
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// Any requested page just gives the whole thread.
+	webContext, errWithCode := suite.status.WebContextGet(ctx, requestingAccount, targetStatus.ID, 5)
+	suite.NoError(errWithCode)
+	suite.Equal(1, webContext.Page)
+	suite.Zero(webContext.PrevPage)
+	suite.Zero(webContext.NextPage)
+	suite.Len(webContext.Descendants, 2)
+	suite.Equal(2, webContext.TotalDescendants)
+}
+
+func (suite *StatusGetTestSuite) TestWebContextGetHiddenReplies() {
+	ctx := context.Background()
+	config.SetWebThreadPageSize(2)
+
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+	turtle := suite.testAccounts["local_account_2"]
+
+	// Mix public replies with replies only
+	// visible to followers of the turtle.
+	var hidden []string
+	for i := 0; i < 3; i++ {
+		suite.putReply(ctx, turtle, targetStatus, gtsmodel.VisibilityPublic)
+		reply := suite.putReply(ctx, turtle, targetStatus, gtsmodel.VisibilityFollowersOnly)
+		hidden = append(hidden, reply.ID)
+	}
+
+	// Logged out, the followers-only
+	// replies should be paged over.
+	allIDs := suite.contextDescendantIDs(ctx, nil, targetStatus.ID)
+	pagedIDs := suite.webContextDescendantIDs(ctx, nil, targetStatus.ID, 2)
+	suite.Equal(allIDs, pagedIDs)
+	for _, id := range hidden {
+		suite.NotContains(pagedIDs, id)
+	}
+
+	// The turtle sees their own replies.
+	allIDs = suite.contextDescendantIDs(ctx, turtle, targetStatus.ID)
+	pagedIDs = suite.webContextDescendantIDs(ctx, turtle, targetStatus.ID, 2)
+	suite.Equal(allIDs, pagedIDs)
+	for _, id := range hidden {
+		suite.Contains(pagedIDs, id)
+	}
+}
+
+func (suite *StatusGetTestSuite) TestWebContextGetHiddenTarget() {
+	ctx := context.Background()
+	config.SetWebThreadPageSize(2)
+
+	// Logged out visitors can't see this
+	// followers-only reply, nor its thread.
+	targetStatus := suite.putReply(
+		ctx,
+		suite.testAccounts["local_account_2"],
+		suite.testStatuses["local_account_1_status_1"],
+		gtsmodel.VisibilityFollowersOnly,
+	)
+
+	_, errWithCode := suite.status.WebContextGet(ctx, nil, targetStatus.ID, 1)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+// putQuarantinedReply puts a new reply from a remote account to
+// the given status, held by the spam filter, returning the reply.
+func (suite *StatusGetTestSuite) putQuarantinedReply(ctx context.Context, parentStatus *gtsmodel.Status) *gtsmodel.Status {
+	replyingAccount := suite.testAccounts["remote_account_1"]
+	statusID := id.NewULID()
+
+	reply := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 "http://fossbros-anonymous.io/users/foss_satan/statuses/" + statusID,
+		URL:                 "http://fossbros-anonymous.io/@foss_satan/statuses/" + statusID,
+		Content:             "buy cheap followers",
+		Local:               util.Ptr(false),
+		AccountURI:          replyingAccount.URI,
+		AccountID:           replyingAccount.ID,
+		InReplyToID:         parentStatus.ID,
+		InReplyToURI:        parentStatus.URI,
+		InReplyToAccountID:  parentStatus.AccountID,
+		Visibility:          gtsmodel.VisibilityPublic,
+		Sensitive:           util.Ptr(false),
+		Federated:           util.Ptr(true),
+		Boostable:           util.Ptr(true),
+		Replyable:           util.Ptr(true),
+		Likeable:            util.Ptr(true),
+		ActivityStreamsType: "Note",
+	}
+	if err := suite.db.PutStatus(ctx, reply); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutQuarantinedStatus(ctx, &gtsmodel.QuarantinedStatus{
+		ID:        id.NewULID(),
+		StatusID:  reply.ID,
+		AccountID: reply.AccountID,
+		Score:     60,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return reply
+}
+
+func (suite *StatusGetTestSuite) TestGetQuarantined() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	reply := suite.putQuarantinedReply(ctx, suite.testStatuses["local_account_1_status_1"])
+
+	_, errWithCode := suite.status.Get(ctx, requestingAccount, reply.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	_, errWithCode = suite.status.ContextGet(ctx, requestingAccount, reply.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *StatusGetTestSuite) TestContextGetQuarantinedReply() {
+	ctx := context.Background()
+	requestingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+	reply := suite.putQuarantinedReply(ctx, targetStatus)
+
+	apiContext, errWithCode := suite.status.ContextGet(ctx, requestingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Len(apiContext.Descendants, 2)
+	for _, descendant := range apiContext.Descendants {
+		suite.NotEqual(reply.ID, descendant.ID)
+	}
+
+	webContext, errWithCode := suite.status.WebContextGet(ctx, nil, targetStatus.ID, 1)
+	suite.NoError(errWithCode)
+	suite.Equal(2, webContext.TotalDescendants)
+	for _, descendant := range webContext.Descendants {
+		suite.NotEqual(reply.ID, descendant.ID)
+	}
+}
+
+func TestStatusGetTestSuite(t *testing.T) {
+	suite.Run(t, &StatusGetTestSuite{})
+}
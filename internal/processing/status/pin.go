@@ -24,12 +24,12 @@ import (
 	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
-const allowedPinnedCount = 10
-
 // getPinnableStatus fetches targetStatusID status and ensures that requestingAccountID
 // can pin or unpin it.
 //
@@ -88,13 +88,17 @@ func (p *Processor) PinCreate(ctx context.Context, requestingAccount *gtsmodel.A
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error checking number of pinned statuses: %w", err))
 	}
 
+	allowedPinnedCount := config.GetStatusesMaxPinned()
 	if pinnedCount >= allowedPinnedCount {
 		err = fmt.Errorf("status pin limit exceeded, you've already pinned %d status(es) out of %d", pinnedCount, allowedPinnedCount)
 		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
 	}
 
+	// New pins go to the top, above
+	// any pins that have been ordered.
 	targetStatus.PinnedAt = time.Now()
-	if err := p.state.DB.UpdateStatus(ctx, targetStatus, "pinned_at"); err != nil {
+	targetStatus.PinOrder = 0
+	if err := p.state.DB.UpdateStatus(ctx, targetStatus, "pinned_at", "pin_order"); err != nil {
 		err = gtserror.Newf("db error pinning status: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -129,7 +133,8 @@ func (p *Processor) PinRemove(ctx context.Context, requestingAccount *gtsmodel.A
 	}
 
 	targetStatus.PinnedAt = time.Time{}
-	if err := p.state.DB.UpdateStatus(ctx, targetStatus, "pinned_at"); err != nil {
+	targetStatus.PinOrder = 0
+	if err := p.state.DB.UpdateStatus(ctx, targetStatus, "pinned_at", "pin_order"); err != nil {
 		err = gtserror.Newf("db error unpinning status: %w", err)
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -141,3 +146,62 @@ func (p *Processor) PinRemove(ctx context.Context, requestingAccount *gtsmodel.A
 
 	return p.apiStatus(ctx, targetStatus, requestingAccount)
 }
+
+// PinsOrder reorders the pinned statuses of requestingAccount, as shown
+// on their profile and served in their Featured ActivityPub collection.
+//
+// statusIDs must list each of the account's currently pinned statuses
+// exactly once, in the desired order. Statuses pinned afterwards are
+// shown above the ordered ones until the pins are ordered again.
+//
+// The pinned statuses are returned in their new order.
+func (p *Processor) PinsOrder(ctx context.Context, requestingAccount *gtsmodel.Account, statusIDs []string) ([]*apimodel.Status, gtserror.WithCode) {
+	pinned, err := p.state.DB.GetAccountPinnedStatuses(ctx, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting pinned statuses: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if len(statusIDs) != len(pinned) {
+		err := fmt.Errorf("you have %d pinned status(es), but gave %d status id(s) to order", len(pinned), len(statusIDs))
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	pinnedByID := make(map[string]*gtsmodel.Status, len(pinned))
+	for _, status := range pinned {
+		pinnedByID[status.ID] = status
+	}
+
+	ordered := make([]*gtsmodel.Status, 0, len(statusIDs))
+	for _, statusID := range statusIDs {
+		status, ok := pinnedByID[statusID]
+		if !ok {
+			err := fmt.Errorf("status %s is not one of your pinned statuses, or is listed more than once", statusID)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		// Ensure each is only listed once.
+		delete(pinnedByID, statusID)
+		ordered = append(ordered, status)
+	}
+
+	apiStatuses := make([]*apimodel.Status, 0, len(ordered))
+	for i, status := range ordered {
+		if pinOrder := i + 1; status.PinOrder != pinOrder {
+			status.PinOrder = pinOrder
+			if err := p.state.DB.UpdateStatus(ctx, status, "pin_order"); err != nil {
+				err = gtserror.Newf("db error ordering pinned status: %w", err)
+				return nil, gtserror.NewErrorInternalError(err)
+			}
+		}
+
+		apiStatus, errWithCode := p.apiStatus(ctx, status, requestingAccount)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
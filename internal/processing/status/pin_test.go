@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type StatusPinTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusPinTestSuite) TestPinLimit() {
+	ctx := context.Background()
+
+	// admin_account already has 2 pinned statuses.
+	config.SetStatusesMaxPinned(2)
+	defer config.SetStatusesMaxPinned(10)
+
+	requestingAccount := suite.testAccounts["admin_account"]
+	targetStatus := suite.testStatuses["admin_account_status_3"]
+
+	apiStatus, errWithCode := suite.status.PinCreate(ctx, requestingAccount, targetStatus.ID)
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: status pin limit exceeded, you've already pinned 2 status(es) out of 2", errWithCode.Safe())
+}
+
+func (suite *StatusPinTestSuite) TestPinsOrder() {
+	ctx := context.Background()
+
+	requestingAccount := suite.testAccounts["admin_account"]
+	status1 := suite.testStatuses["admin_account_status_1"]
+	status2 := suite.testStatuses["admin_account_status_2"]
+
+	// Unordered pins are shown newest pin first.
+	pinned, err := suite.db.GetAccountPinnedStatuses(ctx, requestingAccount.ID)
+	suite.NoError(err)
+	suite.Len(pinned, 2)
+	suite.Equal(status2.ID, pinned[0].ID)
+	suite.Equal(status1.ID, pinned[1].ID)
+
+	apiStatuses, errWithCode := suite.status.PinsOrder(ctx, requestingAccount, []string{status1.ID, status2.ID})
+	suite.NoError(errWithCode)
+	suite.Len(apiStatuses, 2)
+	suite.Equal(status1.ID, apiStatuses[0].ID)
+	suite.Equal(status2.ID, apiStatuses[1].ID)
+
+	// The new order should be stored.
+	pinned, err = suite.db.GetAccountPinnedStatuses(ctx, requestingAccount.ID)
+	suite.NoError(err)
+	suite.Len(pinned, 2)
+	suite.Equal(status1.ID, pinned[0].ID)
+	suite.Equal(1, pinned[0].PinOrder)
+	suite.Equal(status2.ID, pinned[1].ID)
+	suite.Equal(2, pinned[1].PinOrder)
+
+	// A newly pinned status goes above the ordered ones.
+	status3 := suite.testStatuses["admin_account_status_3"]
+	_, errWithCode = suite.status.PinCreate(ctx, requestingAccount, status3.ID)
+	suite.NoError(errWithCode)
+
+	pinned, err = suite.db.GetAccountPinnedStatuses(ctx, requestingAccount.ID)
+	suite.NoError(err)
+	suite.Len(pinned, 3)
+	suite.Equal(status3.ID, pinned[0].ID)
+	suite.Equal(status1.ID, pinned[1].ID)
+	suite.Equal(status2.ID, pinned[2].ID)
+}
+
+func (suite *StatusPinTestSuite) TestPinsOrderInvalid() {
+	ctx := context.Background()
+
+	requestingAccount := suite.testAccounts["admin_account"]
+	status1 := suite.testStatuses["admin_account_status_1"]
+	status2 := suite.testStatuses["admin_account_status_2"]
+	status3 := suite.testStatuses["admin_account_status_3"]
+
+	for _, statusIDs := range [][]string{
+		{status1.ID},                         // missing a pin
+		{status1.ID, status1.ID},             // duplicate
+		{status1.ID, status3.ID},             // not pinned
+		{status1.ID, status2.ID, status3.ID}, // too many
+	} {
+		apiStatuses, errWithCode := suite.status.PinsOrder(ctx, requestingAccount, statusIDs)
+		suite.Nil(apiStatuses)
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+}
+
+func TestStatusPinTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusPinTestSuite))
+}
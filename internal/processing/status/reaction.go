@@ -0,0 +1,202 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// ReactionCreate adds an emoji reaction for the requestingAccount, targeting the given
+// status (no-op if the requesting account already reacted to the status with this emoji).
+func (p *Processor) ReactionCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, content string) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, existingReaction, errWithCode := p.getReactionTarget(ctx, requestingAccount, targetStatusID, content)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if existingReaction != nil {
+		// Status is already reacted to with this emoji.
+		return p.apiStatus(ctx, targetStatus, requestingAccount)
+	}
+
+	// Create a new reaction.
+	reactionID := id.NewULID()
+	reaction := &gtsmodel.StatusReaction{
+		ID:              reactionID,
+		AccountID:       requestingAccount.ID,
+		Account:         requestingAccount,
+		TargetAccountID: targetStatus.AccountID,
+		TargetAccount:   targetStatus.Account,
+		StatusID:        targetStatus.ID,
+		Status:          targetStatus,
+		Content:         content,
+		URI:             uris.GenerateURIForLike(requestingAccount.Username, reactionID),
+	}
+
+	if shortcode := strings.Trim(content, ":"); shortcode != content {
+		// Custom emoji reaction, ensure
+		// it's one of our local emojis.
+		emoji, err := p.state.DB.GetEmojiByShortcodeDomain(ctx, shortcode, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			err = gtserror.Newf("db error getting emoji %s: %w", shortcode, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if emoji == nil || *emoji.Disabled {
+			err := fmt.Errorf("custom emoji %s not found", content)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		reaction.EmojiID = emoji.ID
+		reaction.Emoji = emoji
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		err = gtserror.Newf("error putting reaction in database: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process new status reaction side effects.
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       reaction,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  targetStatus.Account,
+	})
+
+	return p.apiStatus(ctx, targetStatus, requestingAccount)
+}
+
+// ReactionRemove removes an emoji reaction for the requesting account, targeting the given
+// status (no-op if the requesting account did not react to the status with this emoji).
+func (p *Processor) ReactionRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, content string) (*apimodel.Status, gtserror.WithCode) {
+	targetStatus, existingReaction, errWithCode := p.getReactionTarget(ctx, requestingAccount, targetStatusID, content)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if existingReaction == nil {
+		// Status isn't reacted to with this emoji.
+		return p.apiStatus(ctx, targetStatus, requestingAccount)
+	}
+
+	// We have a reaction to remove.
+	if err := p.state.DB.DeleteStatusReactionByID(ctx, existingReaction.ID); err != nil {
+		err = gtserror.Newf("error removing status reaction: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Process remove status reaction side effects.
+	p.state.Workers.EnqueueClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityEmojiReact,
+		APActivityType: ap.ActivityUndo,
+		GTSModel:       existingReaction,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  targetStatus.Account,
+	})
+
+	return p.apiStatus(ctx, targetStatus, requestingAccount)
+}
+
+// ReactionsGet returns a summary of emoji reactions to the given status, including the
+// accounts that reacted with each emoji, filtered according to privacy settings.
+func (p *Processor) ReactionsGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string) ([]apimodel.StatusReaction, gtserror.WithCode) {
+	if !config.GetInstanceEmojiReactions() {
+		err := errors.New("emoji reactions are not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	targetStatus, errWithCode := p.getVisibleStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	reactions, err := p.state.DB.GetStatusReactions(ctx, targetStatus.ID)
+	if err != nil {
+		err = gtserror.Newf("db error getting status reactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Only show the requester reactions from
+	// accounts they don't block, and which
+	// don't block them.
+	visible := make([]*gtsmodel.StatusReaction, 0, len(reactions))
+	for _, reaction := range reactions {
+		blocked, err := p.state.DB.IsEitherBlocked(ctx, requestingAccount.ID, reaction.AccountID)
+		if err != nil {
+			err = gtserror.Newf("error checking blocks: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if !blocked {
+			visible = append(visible, reaction)
+		}
+	}
+
+	apiReactions, err := p.converter.StatusReactionsToAPIStatusReactions(ctx, visible, requestingAccount, true)
+	if err != nil {
+		err = gtserror.Newf("error converting status reactions: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiReactions, nil
+}
+
+func (p *Processor) getReactionTarget(ctx context.Context, requestingAccount *gtsmodel.Account, targetStatusID string, content string) (*gtsmodel.Status, *gtsmodel.StatusReaction, gtserror.WithCode) {
+	if !config.GetInstanceEmojiReactions() {
+		err := errors.New("emoji reactions are not enabled on this instance")
+		return nil, nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	if err := validate.StatusReaction(content); err != nil {
+		return nil, nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	targetStatus, errWithCode := p.getVisibleStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+
+	if !*targetStatus.Likeable {
+		err := errors.New("status is not reactable")
+		return nil, nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	reaction, err := p.state.DB.GetStatusReaction(ctx, requestingAccount.ID, targetStatus.ID, content)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("error checking existing reaction: %w", err)
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return targetStatus, reaction, nil
+}
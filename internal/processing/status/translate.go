@@ -0,0 +1,187 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// Translate translates the target status into the given language
+// (ISO 639), or into the main language of the instance if lang is
+// empty, using the translation backend set up for the instance.
+//
+// Only public and unlisted statuses can be translated, since their
+// text is sent to a third party. Translations are cached, so that
+// everyone asking for the same one doesn't need a new request to
+// the translation backend.
+func (p *Processor) Translate(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	targetStatusID string,
+	lang string,
+) (*apimodel.Translation, gtserror.WithCode) {
+	translator := p.state.Translator
+	if translator == nil {
+		err := errors.New("translation is not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	targetStatus, errWithCode := p.getVisibleStatus(ctx, requestingAccount, targetStatusID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if targetStatus.BoostOfID != "" {
+		err := errors.New("cannot translate boosts, translate the boosted status instead")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if targetStatus.Visibility != gtsmodel.VisibilityPublic &&
+		targetStatus.Visibility != gtsmodel.VisibilityUnlocked {
+		err := errors.New("only public or unlisted statuses can be translated")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	if targetStatus.Content == "" && targetStatus.ContentWarning == "" {
+		err := errors.New("status has no text to translate")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if lang == "" {
+		lang = defaultTranslationLanguage()
+	}
+	lang = strings.ToLower(lang)
+
+	source := strings.ToLower(targetStatus.Language)
+	if source != "" && baseLanguage(source) == baseLanguage(lang) {
+		err := fmt.Errorf("status is already in %s", lang)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	langs, err := translator.Languages(ctx)
+	if err != nil {
+		err := gtserror.Newf("error getting translation languages: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if _, ok := langs[source]; !ok {
+		// Fall back to the language without its region,
+		// or if that's not supported either, let the
+		// translation backend detect the language.
+		source = baseLanguage(source)
+		if _, ok := langs[source]; !ok {
+			source = ""
+		}
+	}
+
+	if !canTranslate(langs, source, lang) {
+		err := fmt.Errorf("status cannot be translated into %s", lang)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	// Key on update time as well, so
+	// that edited statuses get translated
+	// again instead of showing the old text.
+	key := targetStatus.ID +
+		"." + strconv.FormatInt(targetStatus.UpdatedAt.Unix(), 10) +
+		"." + lang
+
+	translation, ok := p.state.Caches.GTS.Translations().Get(key)
+	if !ok {
+		texts := []string{targetStatus.Content}
+		if targetStatus.ContentWarning != "" {
+			texts = append(texts, targetStatus.ContentWarning)
+		}
+
+		translated, detected, err := translator.Translate(ctx, texts, source, lang)
+		if err != nil {
+			err := gtserror.Newf("error translating status %s: %w", targetStatus.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		// Don't trust HTML coming back
+		// from the translation backend.
+		translation = cache.Translation{
+			Content:        text.SanitizeToHTML(translated[0]),
+			SourceLanguage: detected,
+			Provider:       translator.Provider(),
+		}
+		if len(translated) > 1 {
+			translation.ContentWarning = text.SanitizeToPlaintext(translated[1])
+		}
+
+		p.state.Caches.GTS.Translations().Set(key, translation)
+	}
+
+	return &apimodel.Translation{
+		Content:                translation.Content,
+		SpoilerText:            translation.ContentWarning,
+		DetectedSourceLanguage: translation.SourceLanguage,
+		Language:               lang,
+		Provider:               translation.Provider,
+	}, nil
+}
+
+// defaultTranslationLanguage returns the language
+// that statuses are translated into when none is
+// given: the main language of the instance.
+func defaultTranslationLanguage() string {
+	if langs := config.GetInstanceLanguages(); len(langs) > 0 {
+		return langs[0]
+	}
+	return "en"
+}
+
+// baseLanguage returns the given language
+// without its region, eg., "en" for "en-gb".
+func baseLanguage(lang string) string {
+	base, _, _ := strings.Cut(lang, "-")
+	return base
+}
+
+// canTranslate returns whether the given source
+// language can be translated into target, according
+// to the languages supported by a translator. An empty
+// source means any language the translator supports.
+// Targets with a region match target without one.
+func canTranslate(langs map[string][]string, source string, target string) bool {
+	for s, targets := range langs {
+		if source != "" && s != source {
+			continue
+		}
+
+		for _, t := range targets {
+			if t == target || baseLanguage(t) == target {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+)
+
+// Announcement streams the given published announcement to *ALL* open user streams.
+func (p *Processor) Announcement(a *apimodel.Announcement) error {
+	bytes, err := json.Marshal(a)
+	if err != nil {
+		return fmt.Errorf("error marshalling announcement to json: %s", err)
+	}
+
+	return p.toAllAccounts(&stream.Event{
+		ID:          id.NewMonotonicULID(),
+		StreamTypes: []string{stream.TimelineHome},
+		Event:       stream.EventTypeAnnouncement,
+		Payload:     string(bytes),
+	})
+}
+
+// AnnouncementDelete streams the delete of the given announcementID to *ALL* open user streams.
+func (p *Processor) AnnouncementDelete(announcementID string) error {
+	return p.toAllAccounts(&stream.Event{
+		ID:          id.NewMonotonicULID(),
+		StreamTypes: []string{stream.TimelineHome},
+		Event:       stream.EventTypeAnnouncementDelete,
+		Payload:     announcementID,
+	})
+}
+
+// Maintenance streams the given maintenance state to *ALL* open user streams.
+func (p *Processor) Maintenance(m *apimodel.Maintenance) error {
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("error marshalling maintenance to json: %s", err)
+	}
+
+	return p.toAllAccounts(&stream.Event{
+		ID:          id.NewMonotonicULID(),
+		StreamTypes: []string{stream.TimelineHome},
+		Event:       stream.EventTypeMaintenance,
+		Payload:     string(bytes),
+	})
+}
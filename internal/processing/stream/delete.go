@@ -19,36 +19,20 @@ package stream
 
 import (
 	"fmt"
-	"strings"
 
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
 
 // Delete streams the delete of the given statusID to *ALL* open streams.
 func (p *Processor) Delete(statusID string) error {
-	errs := []string{}
-
-	// get all account IDs with open streams
-	accountIDs := []string{}
-	p.streamMap.Range(func(k interface{}, _ interface{}) bool {
-		key, ok := k.(string)
-		if !ok {
-			panic("streamMap key was not a string (account id)")
-		}
-
-		accountIDs = append(accountIDs, key)
-		return true
-	})
-
-	// stream the delete to every account
-	for _, accountID := range accountIDs {
-		if err := p.toAccount(statusID, stream.EventTypeDelete, stream.AllStatusTimelines, accountID); err != nil {
-			errs = append(errs, err.Error())
-		}
-	}
-
-	if len(errs) != 0 {
-		return fmt.Errorf("one or more errors streaming status delete: %s", strings.Join(errs, ";"))
+	if err := p.toAllAccounts(&stream.Event{
+		ID:          id.NewMonotonicULID(),
+		StreamTypes: stream.AllStatusTimelines,
+		Event:       stream.EventTypeDelete,
+		Payload:     statusID,
+	}); err != nil {
+		return fmt.Errorf("one or more errors streaming status delete: %w", err)
 	}
 
 	return nil
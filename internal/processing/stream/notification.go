@@ -23,6 +23,7 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
 
@@ -33,5 +34,11 @@ func (p *Processor) Notify(n *apimodel.Notification, account *gtsmodel.Account)
 		return fmt.Errorf("error marshalling notification to json: %s", err)
 	}
 
-	return p.toAccount(string(bytes), stream.EventTypeNotification, []string{stream.TimelineNotifications, stream.TimelineHome}, account.ID)
+	return p.toAccount(&stream.Event{
+		ID:             id.NewMonotonicULID(),
+		StreamTypes:    []string{stream.TimelineNotifications, stream.TimelineHome},
+		Event:          stream.EventTypeNotification,
+		Payload:        string(bytes),
+		NotificationID: n.ID,
+	}, account.ID)
 }
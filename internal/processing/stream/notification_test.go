@@ -35,7 +35,7 @@ type NotificationTestSuite struct {
 func (suite *NotificationTestSuite) TestStreamNotification() {
 	account := suite.testAccounts["local_account_1"]
 
-	openStream, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user")
+	openStream, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user", "")
 	suite.NoError(errWithCode)
 
 	followAccount := suite.testAccounts["remote_account_1"]
@@ -67,7 +67,10 @@ func (suite *NotificationTestSuite) TestStreamNotification() {
     "display_name": "big gerald",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2021-09-26T10:52:36.000Z",
     "note": "i post about like, i dunno, stuff, or whatever!!!!",
     "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
@@ -31,10 +32,15 @@ import (
 )
 
 // Open returns a new Stream for the given account, which will contain a channel for passing messages back to the caller.
-func (p *Processor) Open(ctx context.Context, account *gtsmodel.Account, streamType string) (*stream.Stream, gtserror.WithCode) {
+//
+// If sinceID is set, events of the given streamType that were streamed to the account after sinceID, within
+// the last HistoryWindow, are replayed into the new stream first, provided they should still be shown to the
+// account; the account may have muted or blocked someone, or dismissed a notification, since they were streamed.
+func (p *Processor) Open(ctx context.Context, account *gtsmodel.Account, streamType string, sinceID string) (*stream.Stream, gtserror.WithCode) {
 	l := log.WithContext(ctx).WithFields(kv.Fields{
 		{"account", account.ID},
 		{"streamType", streamType},
+		{"sinceID", sinceID},
 	}...)
 	l.Debug("received open stream request")
 
@@ -60,36 +66,111 @@ func (p *Processor) Open(ctx context.Context, account *gtsmodel.Account, streamT
 	newStream := &stream.Stream{
 		ID:          streamID,
 		StreamTypes: streamTypes,
-		Messages:    make(chan *stream.Message, 100),
+		Messages:    make(chan *stream.Message, 100+stream.HistorySize),
 		Hangup:      make(chan interface{}, 1),
 		Connected:   true,
 	}
+	// Load the entry in the streamMap for this
+	// account, or store a new one if there's none.
+	v, _ := p.streamMap.LoadOrStore(account.ID, &stream.StreamsForAccount{})
+	streamsForAccount, ok := v.(*stream.StreamsForAccount)
+	if !ok {
+		return nil, gtserror.NewErrorInternalError(errors.New("stream map error"))
+	}
+
+	// Lock the entry while replaying, so that no
+	// event can be streamed in between replaying
+	// history and adding the new stream to it.
+	streamsForAccount.Lock()
+	defer streamsForAccount.Unlock()
+
+	if len(streamsForAccount.Streams) >= stream.MaxStreamsPerAccount {
+		const text = "too many open streams for this account"
+		return nil, gtserror.NewErrorTooManyRequests(errors.New(text), text)
+	}
+
+	if sinceID != "" {
+		p.replay(ctx, account, streamsForAccount, newStream, sinceID)
+	}
+
+	// Append new stream to entry.
+	streamsForAccount.Streams = append(streamsForAccount.Streams, newStream)
+	streamsForAccount.ClosedAt = time.Time{}
 	go p.waitToCloseStream(account, newStream)
 
-	v, ok := p.streamMap.Load(account.ID)
-	if ok {
-		// There is an entry in the streamMap
-		// for this account. Parse it out.
-		streamsForAccount, ok := v.(*stream.StreamsForAccount)
-		if !ok {
-			return nil, gtserror.NewErrorInternalError(errors.New("stream map error"))
+	return newStream, nil
+}
+
+// replay puts events recorded in the history of the given account after
+// sinceID into the given stream, skipping events that should no longer be
+// shown to the account. Caller must hold the lock of streamsForAccount.
+func (p *Processor) replay(
+	ctx context.Context,
+	account *gtsmodel.Account,
+	streamsForAccount *stream.StreamsForAccount,
+	s *stream.Stream,
+	sinceID string,
+) {
+	// Don't replay events older than the window, which may
+	// linger in history if no events were streamed since.
+	oldestID, err := id.NewULIDFromTime(time.Now().Add(-stream.HistoryWindow))
+	if err != nil {
+		log.Errorf(ctx, "error generating oldest replay id: %v", err)
+		return
+	}
+
+	if sinceID < oldestID {
+		sinceID = oldestID
+	}
+
+	for _, e := range streamsForAccount.History.Since(sinceID) {
+		if !p.replayable(ctx, account, e) {
+			continue
 		}
 
-		// Append new stream to existing entry.
-		streamsForAccount.Lock()
-		streamsForAccount.Streams = append(streamsForAccount.Streams, newStream)
-		streamsForAccount.Unlock()
-	} else {
-		// There is no entry in the streamMap for
-		// this account yet. Create one and store it.
-		p.streamMap.Store(account.ID, &stream.StreamsForAccount{
-			Streams: []*stream.Stream{
-				newStream,
-			},
-		})
+		deliver(s, e)
 	}
+}
 
-	return newStream, nil
+// replayable returns true if the given event recorded in the history of
+// the given account should still be shown to it. Since the event was first
+// streamed, the account may have muted or blocked someone, a status may have
+// been deleted, or a notification dismissed.
+func (p *Processor) replayable(ctx context.Context, account *gtsmodel.Account, e *stream.Event) bool {
+	switch e.Event {
+	case stream.EventTypeUpdate:
+		status, err := p.state.DB.GetStatusByID(ctx, e.StatusID)
+		if err != nil {
+			// Most likely deleted.
+			return false
+		}
+
+		timelineable, err := p.filter.StatusHomeTimelineable(ctx, account, status)
+		if err != nil {
+			log.Errorf(ctx, "error checking status %s hometimelineability: %v", status.ID, err)
+			return false
+		}
+
+		return timelineable
+
+	case stream.EventTypeNotification:
+		notif, err := p.state.DB.GetNotificationByID(ctx, e.NotificationID)
+		if err != nil {
+			// Most likely dismissed.
+			return false
+		}
+
+		muted, err := p.filter.NotificationsMuted(ctx, account, notif.OriginAccountID)
+		if err != nil {
+			log.Errorf(ctx, "error checking notification %s mute: %v", notif.ID, err)
+			return false
+		}
+
+		return !muted
+
+	default:
+		return true
+	}
 }
 
 // waitToCloseStream waits until the hangup channel is closed for the given stream.
@@ -128,6 +209,12 @@ func (p *Processor) waitToCloseStream(account *gtsmodel.Account, thisStream *str
 	}
 	streamsForAccount.Streams = modifiedStreams
 
+	if len(modifiedStreams) == 0 {
+		// Keep recording history for a while,
+		// in case the account reconnects.
+		streamsForAccount.ClosedAt = time.Now()
+	}
+
 	// finally close the messages channel so no more messages can be read from it
 	close(thisStream.Messages)
 }
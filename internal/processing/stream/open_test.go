@@ -19,9 +19,13 @@ package stream_test
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
 
 type OpenStreamTestSuite struct {
@@ -31,10 +35,83 @@ type OpenStreamTestSuite struct {
 func (suite *OpenStreamTestSuite) TestOpenStream() {
 	account := suite.testAccounts["local_account_1"]
 
-	_, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user")
+	_, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user", "")
 	suite.NoError(errWithCode)
 }
 
+func (suite *OpenStreamTestSuite) TestOpenStreamReplay() {
+	account := suite.testAccounts["local_account_1"]
+
+	openStream, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user", "")
+	suite.NoError(errWithCode)
+
+	// Notification that doesn't exist
+	// in the db, ie., was dismissed.
+	dismissed := &apimodel.Notification{ID: "01HFKJBV3Q3CYAZ2N9RRRQV7SS", Type: "follow"}
+
+	// Notification that does.
+	existing := &apimodel.Notification{ID: "01F8Q0ANPTWW10DAKTX7BRPBJP", Type: "favourite"}
+
+	for _, n := range []*apimodel.Notification{dismissed, existing, dismissed} {
+		suite.NoError(suite.streamProcessor.Notify(n, account))
+	}
+
+	var msgs []*stream.Message
+	for i := 0; i < 3; i++ {
+		msgs = append(msgs, suite.receive(openStream))
+	}
+
+	// Drop the connection, and wait
+	// for the stream to be closed.
+	close(openStream.Hangup)
+	for suite.receive(openStream) != nil {
+	}
+
+	// Missed while reconnecting.
+	suite.NoError(suite.streamProcessor.Notify(existing, account))
+
+	// Reconnect since the first message; only the
+	// existing notification should be replayed, both
+	// the one received before and the one missed.
+	reopenedStream, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user", msgs[0].ID)
+	suite.NoError(errWithCode)
+	suite.Len(reopenedStream.Messages, 2)
+
+	replayed := suite.receive(reopenedStream)
+	suite.Equal(msgs[1].ID, replayed.ID)
+	suite.Equal(stream.EventTypeNotification, replayed.Event)
+	suite.Contains(replayed.Payload, existing.ID)
+
+	replayed = suite.receive(reopenedStream)
+	suite.Greater(replayed.ID, msgs[2].ID)
+	suite.Contains(replayed.Payload, existing.ID)
+}
+
+func (suite *OpenStreamTestSuite) TestOpenStreamTooMany() {
+	account := suite.testAccounts["local_account_1"]
+
+	for i := 0; i < stream.MaxStreamsPerAccount; i++ {
+		_, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user", "")
+		suite.NoError(errWithCode)
+	}
+
+	_, errWithCode := suite.streamProcessor.Open(context.Background(), account, "user", "")
+	suite.EqualError(errWithCode, "too many open streams for this account")
+	suite.Equal(http.StatusTooManyRequests, errWithCode.Code())
+}
+
+// receive returns the next message from the given stream, or
+// nil if it was closed, failing the test if none arrives in time.
+func (suite *OpenStreamTestSuite) receive(s *stream.Stream) *stream.Message {
+	select {
+	case msg := <-s.Messages:
+		return msg
+	case <-time.After(5 * time.Second):
+		suite.FailNow("timed out waiting for stream message")
+		return nil
+	}
+}
+
 func TestOpenStreamTestSuite(t *testing.T) {
 	suite.Run(t, &OpenStreamTestSuite{})
 }
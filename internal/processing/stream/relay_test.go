@@ -0,0 +1,104 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package stream_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/stream"
+)
+
+// recordingRelay is a stream.Relay
+// which records relayed events.
+type recordingRelay struct {
+	accountIDs []string
+	events     []*stream.Event
+	mu         sync.Mutex
+}
+
+func (r *recordingRelay) Relay(accountID string, e *stream.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.accountIDs = append(r.accountIDs, accountID)
+	r.events = append(r.events, e)
+}
+
+type RelayTestSuite struct {
+	StreamTestSuite
+}
+
+func (suite *RelayTestSuite) TestRelayNotification() {
+	account := suite.testAccounts["local_account_1"]
+
+	relay := &recordingRelay{}
+	suite.streamProcessor.SetRelay(relay)
+
+	openStream, errWithCode := suite.streamProcessor.Open(context.Background(), account, stream.TimelineNotifications, "")
+	suite.NoError(errWithCode)
+
+	err := suite.streamProcessor.Notify(&apimodel.Notification{
+		ID:   "01FH57SJCMDWQGEAJ0X08CE3WV",
+		Type: "follow",
+	}, account)
+	suite.NoError(err)
+
+	// Streamed locally...
+	msg := <-openStream.Messages
+	suite.Equal(stream.EventTypeNotification, msg.Event)
+
+	// ...and relayed to other processes.
+	if suite.Len(relay.events, 1) {
+		suite.Equal(account.ID, relay.accountIDs[0])
+		suite.Equal(stream.EventTypeNotification, relay.events[0].Event)
+		suite.Equal(msg.Payload, relay.events[0].Payload)
+	}
+}
+
+func (suite *RelayTestSuite) TestDeliverNotRelayed() {
+	account := suite.testAccounts["local_account_1"]
+
+	relay := &recordingRelay{}
+	suite.streamProcessor.SetRelay(relay)
+
+	openStream, errWithCode := suite.streamProcessor.Open(context.Background(), account, stream.TimelineHome, "")
+	suite.NoError(errWithCode)
+
+	// Event relayed from another process
+	// to all accounts is streamed locally.
+	suite.streamProcessor.Deliver("", &stream.Event{
+		ID:          "01HG2FKZ1Y2CRNBYBT6ZQ4K1MB",
+		StreamTypes: []string{stream.TimelineHome},
+		Event:       stream.EventTypeDelete,
+		Payload:     "01FVW7JHQFSFK166WWKR8CBA6M",
+	})
+
+	msg := <-openStream.Messages
+	suite.Equal(stream.EventTypeDelete, msg.Event)
+	suite.Equal("01FVW7JHQFSFK166WWKR8CBA6M", msg.Payload)
+
+	// But not relayed back again.
+	suite.Empty(relay.events)
+}
+
+func TestRelayTestSuite(t *testing.T) {
+	suite.Run(t, &RelayTestSuite{})
+}
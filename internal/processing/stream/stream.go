@@ -18,29 +18,90 @@
 package stream
 
 import (
+	"errors"
 	"sync"
+	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 )
 
 type Processor struct {
 	state       *state.State
 	oauthServer oauth.Server
+	filter      *visibility.Filter
 	streamMap   *sync.Map
+	relay       *relay
 }
 
-func New(state *state.State, oauthServer oauth.Server) Processor {
+// relay wraps the stream.Relay to pass events
+// on to, safe to set after streaming started.
+type relay struct {
+	r  stream.Relay
+	mu sync.RWMutex
+}
+
+func New(state *state.State, oauthServer oauth.Server, filter *visibility.Filter) Processor {
 	return Processor{
 		state:       state,
 		oauthServer: oauthServer,
+		filter:      filter,
 		streamMap:   &sync.Map{},
+		relay:       &relay{},
+	}
+}
+
+// SetRelay sets the stream.Relay to pass events on to, so they reach
+// streams open on other processes. Passing nil disables relaying.
+func (p *Processor) SetRelay(r stream.Relay) {
+	p.relay.mu.Lock()
+	p.relay.r = r
+	p.relay.mu.Unlock()
+}
+
+// Deliver streams an event relayed from another process to the given
+// account ID, or to all accounts if the ID is empty, but only to the
+// streams open on this process; the event is not relayed any further.
+func (p *Processor) Deliver(accountID string, e *stream.Event) {
+	var err error
+	if accountID == "" {
+		err = p.deliverToAllAccounts(e)
+	} else {
+		err = p.deliverToAccount(e, accountID)
+	}
+	if err != nil {
+		log.Errorf(nil, "error delivering relayed event: %v", err)
+	}
+}
+
+// toRelay passes the event streamed to given account ID
+// (empty for all accounts) on to the relay, if any is set.
+func (p *Processor) toRelay(e *stream.Event, accountID string) {
+	p.relay.mu.RLock()
+	r := p.relay.r
+	p.relay.mu.RUnlock()
+
+	if r != nil {
+		r.Relay(accountID, e)
 	}
 }
 
-// toAccount streams the given payload with the given event type to any streams currently open for the given account ID.
-func (p *Processor) toAccount(payload string, event string, streamTypes []string, accountID string) error {
+// toAccount streams the given event to any streams currently open for the given account ID,
+// on this process and, if a relay is set, on other processes too.
+func (p *Processor) toAccount(e *stream.Event, accountID string) error {
+	p.toRelay(e, accountID)
+	return p.deliverToAccount(e, accountID)
+}
+
+// deliverToAccount streams the given event to any streams open on this process for the given account ID.
+//
+// Replayable events are also recorded in the history of the account, so that they can be
+// replayed to streams that reconnect after missing them, until HistoryWindow has passed
+// since the account's last stream was closed.
+func (p *Processor) deliverToAccount(e *stream.Event, accountID string) error {
 	// Load all streams open for this account.
 	v, ok := p.streamMap.Load(accountID)
 	if !ok {
@@ -51,30 +112,87 @@ func (p *Processor) toAccount(payload string, event string, streamTypes []string
 	streamsForAccount.Lock()
 	defer streamsForAccount.Unlock()
 
+	if stream.Replayable(e.Event) {
+		if len(streamsForAccount.Streams) == 0 &&
+			time.Since(streamsForAccount.ClosedAt) > stream.HistoryWindow {
+			// No stream reconnected in time
+			// to replay history; drop it.
+			streamsForAccount.History.Clear()
+		} else {
+			// Record event in case a
+			// stream needs it replayed.
+			streamsForAccount.History.Add(e)
+		}
+	}
+
 	for _, s := range streamsForAccount.Streams {
-		s.Lock()
-		defer s.Unlock()
+		deliver(s, e)
+	}
 
-		if !s.Connected {
-			continue
+	return nil
+}
+
+// toAllAccounts streams the given event to *ALL* open streams of the event's stream types,
+// on this process and, if a relay is set, on other processes too.
+func (p *Processor) toAllAccounts(e *stream.Event) error {
+	p.toRelay(e, "")
+	return p.deliverToAllAccounts(e)
+}
+
+// deliverToAllAccounts streams the given event to *ALL* streams open on this process of the event's stream types.
+func (p *Processor) deliverToAllAccounts(e *stream.Event) error {
+	// get all account IDs with open streams
+	accountIDs := []string{}
+	p.streamMap.Range(func(k interface{}, _ interface{}) bool {
+		key, ok := k.(string)
+		if !ok {
+			panic("streamMap key was not a string (account id)")
 		}
 
-	typeLoop:
-		for _, streamType := range streamTypes {
-			if _, found := s.StreamTypes[streamType]; found {
-				s.Messages <- &stream.Message{
-					Stream:  []string{streamType},
-					Event:   string(event),
-					Payload: payload,
-				}
-
-				// Break out to the outer loop,
-				// to avoid sending duplicates of
-				// the same event to the same stream.
-				break typeLoop
-			}
+		accountIDs = append(accountIDs, key)
+		return true
+	})
+
+	// stream the event to every account
+	var errs []error
+	for _, accountID := range accountIDs {
+		if err := p.deliverToAccount(e, accountID); err != nil {
+			errs = append(errs, err)
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
+}
+
+// deliver puts the given event into the messages of the given
+// stream, if it's connected and subscribed to one of the stream
+// types of the event.
+func deliver(s *stream.Stream, e *stream.Event) {
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.Connected {
+		return
+	}
+
+	for _, streamType := range e.StreamTypes {
+		if _, found := s.StreamTypes[streamType]; found {
+			msg := &stream.Message{
+				Stream:  []string{streamType},
+				Event:   e.Event,
+				Payload: e.Payload,
+			}
+
+			if stream.Replayable(e.Event) {
+				msg.ID = e.ID
+			}
+
+			s.Messages <- msg
+
+			// Return to avoid sending
+			// duplicates of the same
+			// event to the same stream.
+			return
+		}
+	}
 }
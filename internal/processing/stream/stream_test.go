@@ -24,6 +24,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -49,7 +50,7 @@ func (suite *StreamTestSuite) SetupTest() {
 	suite.db = testrig.NewTestDB(&suite.state)
 	suite.state.DB = suite.db
 	suite.oauthServer = testrig.NewTestOauthServer(suite.db)
-	suite.streamProcessor = stream.New(&suite.state, suite.oauthServer)
+	suite.streamProcessor = stream.New(&suite.state, suite.oauthServer, visibility.NewFilter(&suite.state))
 
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
 }
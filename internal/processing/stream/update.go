@@ -23,6 +23,7 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
 
@@ -33,5 +34,11 @@ func (p *Processor) Update(s *apimodel.Status, account *gtsmodel.Account, stream
 		return fmt.Errorf("error marshalling status to json: %s", err)
 	}
 
-	return p.toAccount(string(bytes), stream.EventTypeUpdate, streamTypes, account.ID)
+	return p.toAccount(&stream.Event{
+		ID:          id.NewMonotonicULID(),
+		StreamTypes: streamTypes,
+		Event:       stream.EventTypeUpdate,
+		Payload:     string(bytes),
+		StatusID:    s.ID,
+	}, account.ID)
 }
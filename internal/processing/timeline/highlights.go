@@ -0,0 +1,76 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// HighlightsGet returns the most recent daily highlights
+// made for the requesting account, which are empty if
+// none have been made yet.
+func (p *Processor) HighlightsGet(ctx context.Context, authed *oauth.Auth) (*apimodel.Highlights, gtserror.WithCode) {
+	apiHighlights := &apimodel.Highlights{
+		Statuses: make([]*apimodel.Status, 0),
+	}
+
+	highlights, err := p.state.DB.GetHighlightsByAccountID(ctx, authed.Account.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// None made yet.
+			return apiHighlights, nil
+		}
+
+		err = gtserror.Newf("db error getting highlights: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiHighlights.CreatedAt = util.FormatISO8601(highlights.CreatedAt)
+
+	for _, s := range highlights.Statuses {
+		// Visibility may have changed
+		// since the highlights were made.
+		visible, err := p.filter.StatusVisible(ctx, authed.Account, s)
+		if err != nil {
+			log.Errorf(ctx, "error checking status visibility: %v", err)
+			continue
+		}
+
+		if !visible {
+			continue
+		}
+
+		apiStatus, err := p.converter.StatusToAPIStatus(ctx, s, authed.Account)
+		if err != nil {
+			log.Errorf(ctx, "error converting to api status: %v", err)
+			continue
+		}
+
+		apiHighlights.Statuses = append(apiHighlights.Statuses, apiStatus)
+	}
+
+	return apiHighlights, nil
+}
@@ -85,6 +85,18 @@ func ListTimelineFilter(state *state.State, filter *visibility.Filter) timeline.
 			return false, err
 		}
 
+		if timelineable {
+			return true, nil
+		}
+
+		// Status may still be included if it
+		// was pulled in by one of the list rules.
+		timelineable, err = filter.StatusListRuleTimelineable(ctx, requestingAccount, list, status)
+		if err != nil {
+			err = gtserror.Newf("error checking list rule timelineability of status %s for list %s: %w", status.ID, listID, err)
+			return false, err
+		}
+
 		return timelineable, nil
 	}
 }
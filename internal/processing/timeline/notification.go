@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -72,6 +73,17 @@ func (p *Processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ma
 			if !visible {
 				continue
 			}
+
+			// Skip notifications from accounts requester has since muted.
+			muted, err := p.filter.NotificationsMuted(ctx, authed.Account, n.OriginAccountID)
+			if err != nil {
+				log.Debugf(ctx, "skipping notification %s because of an error checking notification mute: %s", n.ID, err)
+				continue
+			}
+
+			if muted {
+				continue
+			}
 		}
 
 		if n.Status != nil {
@@ -134,6 +146,31 @@ func (p *Processor) NotificationGet(ctx context.Context, account *gtsmodel.Accou
 	return apiNotif, nil
 }
 
+// NotificationsUnreadCount counts notifications targeting the authorized
+// account that are newer than its notifications marker, up to limit.
+// If no notifications marker is set, all notifications are unread.
+func (p *Processor) NotificationsUnreadCount(ctx context.Context, authed *oauth.Auth, limit int, types []string, excludeTypes []string) (*apimodel.NotificationsUnreadCount, gtserror.WithCode) {
+	var sinceID string
+
+	marker, err := p.state.DB.GetMarker(ctx, authed.Account.ID, gtsmodel.MarkerNameNotifications)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting notifications marker: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if marker != nil {
+		sinceID = marker.LastReadID
+	}
+
+	count, err := p.state.DB.CountAccountNotifications(ctx, authed.Account.ID, sinceID, limit, types, excludeTypes)
+	if err != nil {
+		err = gtserror.Newf("db error counting notifications: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return &apimodel.NotificationsUnreadCount{Count: count}, nil
+}
+
 func (p *Processor) NotificationsClear(ctx context.Context, authed *oauth.Auth) gtserror.WithCode {
 	// Delete all notifications of all types that target the authorized account.
 	if err := p.state.DB.DeleteNotifications(ctx, nil, authed.Account.ID, ""); err != nil && !errors.Is(err, db.ErrNoEntries) {
@@ -142,3 +179,29 @@ func (p *Processor) NotificationsClear(ctx context.Context, authed *oauth.Auth)
 
 	return nil
 }
+
+// NotificationsDismiss dismisses all notifications targeting the authorized
+// account that match the filters of the given form, in one database call.
+func (p *Processor) NotificationsDismiss(ctx context.Context, authed *oauth.Auth, form *apimodel.NotificationsDismissRequest) gtserror.WithCode {
+	if len(form.Types) == 0 && form.AccountID == "" && form.OlderThan == "" {
+		const text = "at least one of types, account_id or older_than must be provided; use /api/v1/notifications/clear to dismiss all notifications"
+		return gtserror.NewErrorBadRequest(errors.New(text), text)
+	}
+
+	var olderThan time.Time
+	if form.OlderThan != "" {
+		var err error
+		olderThan, err = util.ParseISO8601(form.OlderThan)
+		if err != nil {
+			const text = "older_than could not be parsed as an ISO 8601 datetime"
+			return gtserror.NewErrorBadRequest(err, text)
+		}
+	}
+
+	if _, err := p.state.DB.DismissNotifications(ctx, authed.Account.ID, form.Types, form.AccountID, olderThan); err != nil {
+		err = gtserror.Newf("db error dismissing notifications: %w", err)
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,259 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// notificationGroupSamples is the maximum number
+// of sample accounts given per notification group.
+const notificationGroupSamples = 8
+
+// groupableNotificationTypes are the types of notifications that
+// may be grouped together, by the status they pertain to (if any).
+var groupableNotificationTypes = []string{
+	string(gtsmodel.NotificationFave),
+	string(gtsmodel.NotificationReblog),
+	string(gtsmodel.NotificationFollow),
+}
+
+// NotificationGroupsGet returns a page of notifications targeting the authorized
+// account, with those of one of the given groupedTypes collapsed into groups per
+// type and status. Accounts and statuses that the groups refer to are returned
+// once alongside them, rather than once per notification.
+//
+// If groupedTypes is nil, all types that can be grouped are.
+func (p *Processor) NotificationGroupsGet(
+	ctx context.Context,
+	authed *oauth.Auth,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+	excludeTypes []string,
+	groupedTypes []string,
+) (*apimodel.GroupedNotificationsResults, gtserror.WithCode) {
+	if groupedTypes == nil {
+		// Group all by default.
+		groupedTypes = groupableNotificationTypes
+	} else {
+		// Only group types that can be grouped.
+		groupedTypes = slices.DeleteFunc(slices.Clone(groupedTypes), func(t string) bool {
+			return !slices.Contains(groupableNotificationTypes, t)
+		})
+	}
+
+	groups, err := p.state.DB.GetAccountNotificationGroups(
+		ctx,
+		authed.Account.ID,
+		maxID,
+		sinceID,
+		minID,
+		limit,
+		excludeTypes,
+		groupedTypes,
+		notificationGroupSamples,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting notification groups: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(groups)
+	results := &apimodel.GroupedNotificationsResults{
+		Accounts:           make([]*apimodel.Account, 0, count),
+		Statuses:           make([]*apimodel.Status, 0, count),
+		NotificationGroups: make([]*apimodel.NotificationGroup, 0, count),
+	}
+
+	if count == 0 {
+		return results, nil
+	}
+
+	var (
+		// IDs of accounts and statuses already
+		// added to results, so none is repeated.
+		accountIDs = make(map[string]struct{}, count)
+		statusIDs  = make(map[string]struct{}, count)
+
+		nextMaxIDValue string
+		prevMinIDValue string
+	)
+
+	for i, g := range groups {
+		// Set next + prev values before filtering and API
+		// converting, so caller can still page properly.
+		if i == count-1 {
+			nextMaxIDValue = g.MostRecentNotificationID
+		}
+
+		if i == 0 {
+			prevMinIDValue = g.MostRecentNotificationID
+		}
+
+		// Ensure this group should be shown to requester,
+		// and convert the status it pertains to, if any.
+		if g.StatusID != "" {
+			if _, seen := statusIDs[g.StatusID]; !seen {
+				apiStatus, err := p.notificationGroupStatus(ctx, authed.Account, g.StatusID)
+				if err != nil {
+					log.Debugf(ctx, "skipping notification group %s: %v", g.MostRecentNotificationID, err)
+					continue
+				}
+
+				results.Statuses = append(results.Statuses, apiStatus)
+				statusIDs[g.StatusID] = struct{}{}
+			}
+		}
+
+		// Sample only accounts that should be shown
+		// to requester, converting any not yet seen.
+		sampleAccountIDs := make([]string, 0, len(g.SampleAccountIDs))
+		for _, accountID := range g.SampleAccountIDs {
+			if _, seen := accountIDs[accountID]; !seen {
+				apiAccount, err := p.notificationGroupAccount(ctx, authed.Account, accountID)
+				if err != nil {
+					log.Debugf(ctx, "skipping account %s of notification group %s: %v", accountID, g.MostRecentNotificationID, err)
+					continue
+				}
+
+				results.Accounts = append(results.Accounts, apiAccount)
+				accountIDs[accountID] = struct{}{}
+			}
+
+			sampleAccountIDs = append(sampleAccountIDs, accountID)
+		}
+
+		if len(sampleAccountIDs) == 0 {
+			// Nothing to show.
+			continue
+		}
+
+		results.NotificationGroups = append(results.NotificationGroups, &apimodel.NotificationGroup{
+			GroupKey:                 notificationGroupKey(g, groupedTypes),
+			NotificationsCount:       g.NotificationsCount,
+			Type:                     string(g.NotificationType),
+			MostRecentNotificationID: g.MostRecentNotificationID,
+			PageMinID:                g.OldestNotificationID,
+			PageMaxID:                g.MostRecentNotificationID,
+			LatestPageNotificationAt: util.FormatISO8601(g.MostRecentNotification.CreatedAt),
+			SampleAccountIDs:         sampleAccountIDs,
+			StatusID:                 g.StatusID,
+		})
+	}
+
+	resp, errWithCode := util.PackagePageableResponse(util.PageableResponseParams{
+		Path:           "api/v2/notifications",
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	results.LinkHeader = resp.LinkHeader
+	return results, nil
+}
+
+// notificationGroupStatus returns the API representation of the
+// status with the given ID, if it's visible to requestingAccount.
+func (p *Processor) notificationGroupStatus(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	statusID string,
+) (*apimodel.Status, error) {
+	status, err := p.state.DB.GetStatusByID(ctx, statusID)
+	if err != nil {
+		return nil, gtserror.Newf("error getting status: %w", err)
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, requestingAccount, status)
+	if err != nil {
+		return nil, gtserror.Newf("error checking status visibility: %w", err)
+	}
+
+	if !visible {
+		return nil, gtserror.New("status not visible")
+	}
+
+	return p.converter.StatusToAPIStatus(ctx, status, requestingAccount)
+}
+
+// notificationGroupAccount returns the API representation of the account
+// with the given ID, if it's visible to requestingAccount, and requestingAccount
+// hasn't muted its notifications.
+func (p *Processor) notificationGroupAccount(
+	ctx context.Context,
+	requestingAccount *gtsmodel.Account,
+	accountID string,
+) (*apimodel.Account, error) {
+	account, err := p.state.DB.GetAccountByID(ctx, accountID)
+	if err != nil {
+		return nil, gtserror.Newf("error getting account: %w", err)
+	}
+
+	visible, err := p.filter.AccountVisible(ctx, requestingAccount, account)
+	if err != nil {
+		return nil, gtserror.Newf("error checking account visibility: %w", err)
+	}
+
+	if !visible {
+		return nil, gtserror.New("account not visible")
+	}
+
+	muted, err := p.filter.NotificationsMuted(ctx, requestingAccount, account.ID)
+	if err != nil {
+		return nil, gtserror.Newf("error checking notification mute: %w", err)
+	}
+
+	if muted {
+		return nil, gtserror.New("account notifications muted")
+	}
+
+	return p.converter.AccountToAPIAccountPublic(ctx, account)
+}
+
+// notificationGroupKey returns a key identifying the given notification
+// group, which is the same for the group on every page of results.
+func notificationGroupKey(g *gtsmodel.NotificationGroup, groupedTypes []string) string {
+	switch {
+	case !slices.Contains(groupedTypes, string(g.NotificationType)):
+		// One notification per group.
+		return "ungrouped-" + g.MostRecentNotificationID
+
+	case g.StatusID == "":
+		// Grouped by type only.
+		return string(g.NotificationType)
+
+	default:
+		// Grouped by type and status.
+		return string(g.NotificationType) + "-" + g.StatusID
+	}
+}
@@ -0,0 +1,258 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gorilla/feeds"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	rssFeedLength = 20
+
+	// rssFeedMaxPages is the max number of timeline
+	// pages to look through when filling up a feed,
+	// to avoid scanning the whole timeline when most
+	// statuses are ineligible (eg., remote statuses).
+	rssFeedMaxPages = 5
+)
+
+// GetFeed returns the stringified RSS or Atom feed for a timeline.
+type GetFeed func() (string, gtserror.WithCode)
+
+// FeedFormat is the syndication format
+// that a timeline feed is serialized as.
+type FeedFormat int
+
+const (
+	FeedFormatRSS FeedFormat = iota
+	FeedFormatAtom
+)
+
+// LocalTimelineFeedGet returns a function to return the RSS or Atom feed of the
+// local public timeline, and the last-modified time (time of the most recent public
+// post by a local account).
+//
+// To save db calls, callers to this function should only call the returned GetFeed
+// func if the last-modified time is newer than the last-modified time they have cached.
+//
+// If no local account has posted yet, the returned last-modified time will be zero,
+// and the GetFeed func will return a valid feed with no items.
+func (p *Processor) LocalTimelineFeedGet(ctx context.Context, format FeedFormat) (GetFeed, time.Time, gtserror.WithCode) {
+	if !config.GetInstanceExposeLocalTimelineRSS() {
+		err := gtserror.New("local timeline RSS feed not enabled")
+		return nil, time.Time{}, gtserror.NewErrorNotFound(err)
+	}
+
+	getStatuses := func(maxID string, limit int) ([]*gtsmodel.Status, error) {
+		return p.state.DB.GetPublicTimeline(ctx, maxID, "", "", limit, true)
+	}
+
+	host := config.GetHost()
+	feed := &feeds.Feed{
+		Title:       "Public posts from " + host,
+		Description: "Public posts from local accounts on " + host,
+		Link:        &feeds.Link{Href: config.GetProtocol() + "://" + host},
+	}
+
+	return p.timelineFeed(ctx, feed, format, getStatuses, p.filter.StatusPublicTimelineable)
+}
+
+// TagTimelineFeedGet returns a function to return the RSS or Atom feed of public
+// posts using the given tag, and the last-modified time (time of the most recent
+// public post using the tag).
+//
+// See LocalTimelineFeedGet for the caching semantics of the returned values.
+func (p *Processor) TagTimelineFeedGet(ctx context.Context, tagName string, format FeedFormat) (GetFeed, time.Time, gtserror.WithCode) {
+	if !config.GetInstanceExposeTagRSS() {
+		err := gtserror.New("tag RSS feeds not enabled")
+		return nil, time.Time{}, gtserror.NewErrorNotFound(err)
+	}
+
+	tag, errWithCode := p.getTag(ctx, tagName)
+	if errWithCode != nil {
+		return nil, time.Time{}, errWithCode
+	}
+
+	if tag == nil || !*tag.Useable || !*tag.Listable {
+		err := gtserror.New("tag was not found, or not useable/listable on this instance")
+		return nil, time.Time{}, gtserror.NewErrorNotFound(err)
+	}
+
+	getStatuses := func(maxID string, limit int) ([]*gtsmodel.Status, error) {
+		return p.state.DB.GetTagTimeline(ctx, tag.ID, maxID, "", "", limit)
+	}
+
+	host := config.GetHost()
+	feed := &feeds.Feed{
+		Title:       "#" + tag.Name + " on " + host,
+		Description: "Public posts tagged #" + tag.Name + " on " + host,
+		Link:        &feeds.Link{Href: config.GetProtocol() + "://" + host + "/tags/" + tag.Name},
+	}
+
+	return p.timelineFeed(ctx, feed, format, getStatuses, p.filter.StatusTagTimelineable)
+}
+
+// timelineFeed wraps the given timeline status getter and
+// visibility func into a GetFeed func, filling the given
+// feed and serializing it in the given format.
+//
+// Only statuses created by local accounts with RSS enabled are
+// included, so that accounts which have opted out of RSS are not
+// republished via a shared feed, and remote content isn't syndicated.
+func (p *Processor) timelineFeed(
+	ctx context.Context,
+	feed *feeds.Feed,
+	format FeedFormat,
+	getStatuses func(maxID string, limit int) ([]*gtsmodel.Status, error),
+	timelineable func(context.Context, *gtsmodel.Account, *gtsmodel.Status) (bool, error),
+) (GetFeed, time.Time, gtserror.WithCode) {
+	// LastModified time is needed by callers to check freshness for cacheing.
+	// This will be a zero time.Time if nothing eligible has been posted yet.
+	latest, err := getStatuses("", 1)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = gtserror.Newf("db error getting latest timeline status: %w", err)
+		return nil, time.Time{}, gtserror.NewErrorInternalError(err)
+	}
+
+	var lastPostAt time.Time
+	if len(latest) != 0 {
+		lastPostAt = latest[0].CreatedAt
+	}
+
+	return func() (string, gtserror.WithCode) {
+		// If nothing has been posted yet, use instance
+		// creation time as Updated value for the feed;
+		// we want something determinate so cacheing
+		// isn't messed up.
+		if lastPostAt.IsZero() {
+			instance, err := p.state.DB.GetInstance(ctx, config.GetHost())
+			if err != nil {
+				err = gtserror.Newf("db error getting instance: %w", err)
+				return "", gtserror.NewErrorInternalError(err)
+			}
+
+			feed.Updated = instance.CreatedAt
+			return stringifyFeed(feed, format)
+		}
+
+		feed.Updated = lastPostAt
+
+		// Page down through the timeline until we've
+		// either filled up the feed or run out of posts.
+		var maxID string
+		for page := 0; page < rssFeedMaxPages && len(feed.Items) < rssFeedLength; page++ {
+			statuses, err := getStatuses(maxID, rssFeedLength)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				err = gtserror.Newf("db error getting timeline statuses: %w", err)
+				return "", gtserror.NewErrorInternalError(err)
+			}
+
+			if len(statuses) == 0 {
+				break
+			}
+
+			maxID = statuses[len(statuses)-1].ID
+
+			for _, status := range statuses {
+				if !p.rssEligible(ctx, status, timelineable) {
+					continue
+				}
+
+				item, err := p.converter.StatusToRSSItem(ctx, status)
+				if err != nil {
+					err = gtserror.Newf("error converting status to feed item: %w", err)
+					return "", gtserror.NewErrorInternalError(err)
+				}
+
+				feed.Add(item)
+				if len(feed.Items) == rssFeedLength {
+					break
+				}
+			}
+		}
+
+		return stringifyFeed(feed, format)
+	}, lastPostAt, nil
+}
+
+// rssEligible returns whether the given timeline
+// status may be included in a shared RSS feed.
+func (p *Processor) rssEligible(
+	ctx context.Context,
+	status *gtsmodel.Status,
+	timelineable func(context.Context, *gtsmodel.Account, *gtsmodel.Status) (bool, error),
+) bool {
+	if !*status.Local {
+		return false
+	}
+
+	if status.Account == nil {
+		var err error
+		status.Account, err = p.state.DB.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			log.Errorf(ctx, "error getting status author: %v", err)
+			return false
+		}
+	}
+
+	if status.Account.EnableRSS == nil || !*status.Account.EnableRSS {
+		return false
+	}
+
+	// Check visibility with no requester,
+	// as RSS feeds are always unauthenticated.
+	visible, err := timelineable(ctx, nil, status)
+	if err != nil {
+		log.Errorf(ctx, "error checking status visibility: %v", err)
+		return false
+	}
+
+	return visible
+}
+
+func stringifyFeed(feed *feeds.Feed, format FeedFormat) (string, gtserror.WithCode) {
+	var (
+		str string
+		err error
+	)
+
+	// Stringify the feed. Even with no statuses,
+	// this will still produce valid rss / atom xml.
+	switch format {
+	case FeedFormatAtom:
+		str, err = feed.ToAtom()
+	default:
+		str, err = feed.ToRss()
+	}
+
+	if err != nil {
+		err := gtserror.Newf("error stringifying feed: %w", err)
+		return "", gtserror.NewErrorInternalError(err)
+	}
+
+	return str, nil
+}
@@ -0,0 +1,215 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package timeline_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type RSSTestSuite struct {
+	TimelineStandardTestSuite
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedDisabled() {
+	config.SetInstanceExposeLocalTimelineRSS(false)
+
+	getFeed, _, errWithCode := suite.timeline.LocalTimelineFeedGet(context.Background(), timeline.FeedFormatRSS)
+	suite.Nil(getFeed)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedRSS() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+
+	getFeed, lastPostAt, errWithCode := suite.timeline.LocalTimelineFeedGet(context.Background(), timeline.FeedFormatRSS)
+	suite.NoError(errWithCode)
+	suite.False(lastPostAt.IsZero())
+
+	feed, errWithCode := getFeed()
+	suite.NoError(errWithCode)
+	suite.Contains(feed, `<rss version="2.0"`)
+	suite.Contains(feed, "<title>Public posts from localhost:8080</title>")
+
+	// Zork has RSS enabled, so their public posts should be there.
+	suite.Contains(feed, suite.testStatuses["local_account_1_status_1"].URL)
+
+	// Turtle has not enabled RSS, so their posts should be left out.
+	suite.NotContains(feed, suite.testStatuses["local_account_2_status_1"].URL)
+
+	// Remote posts are never included.
+	suite.NotContains(feed, suite.testStatuses["remote_account_1_status_1"].URL)
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedAtom() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+
+	getFeed, _, errWithCode := suite.timeline.LocalTimelineFeedGet(context.Background(), timeline.FeedFormatAtom)
+	suite.NoError(errWithCode)
+
+	feed, errWithCode := getFeed()
+	suite.NoError(errWithCode)
+	suite.Contains(feed, `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	suite.Contains(feed, "<title>Public posts from localhost:8080</title>")
+	suite.Contains(feed, suite.testStatuses["local_account_1_status_1"].URL)
+	suite.NotContains(feed, suite.testStatuses["local_account_2_status_1"].URL)
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedAccountOptOut() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+	ctx := context.Background()
+
+	// Zork opts out of RSS.
+	account := new(gtsmodel.Account)
+	*account = *suite.testAccounts["local_account_1"]
+	account.EnableRSS = util.Ptr(false)
+	if err := suite.db.UpdateAccount(ctx, account, "enable_rss"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	getFeed, _, errWithCode := suite.timeline.LocalTimelineFeedGet(ctx, timeline.FeedFormatRSS)
+	suite.NoError(errWithCode)
+
+	feed, errWithCode := getFeed()
+	suite.NoError(errWithCode)
+	suite.NotContains(feed, suite.testStatuses["local_account_1_status_1"].URL)
+
+	// Admin still has RSS enabled.
+	suite.Contains(feed, suite.testStatuses["admin_account_status_1"].URL)
+}
+
+func (suite *RSSTestSuite) TestTagTimelineFeedDisabled() {
+	config.SetInstanceExposeTagRSS(false)
+
+	getFeed, _, errWithCode := suite.timeline.TagTimelineFeedGet(context.Background(), "welcome", timeline.FeedFormatRSS)
+	suite.Nil(getFeed)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *RSSTestSuite) TestTagTimelineFeedUnknownTag() {
+	config.SetInstanceExposeTagRSS(true)
+
+	getFeed, _, errWithCode := suite.timeline.TagTimelineFeedGet(context.Background(), "thistagdoesnotexist", timeline.FeedFormatRSS)
+	suite.Nil(getFeed)
+	suite.NotNil(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *RSSTestSuite) TestTagTimelineFeed() {
+	config.SetInstanceExposeTagRSS(true)
+
+	for _, format := range []timeline.FeedFormat{timeline.FeedFormatRSS, timeline.FeedFormatAtom} {
+		getFeed, lastPostAt, errWithCode := suite.timeline.TagTimelineFeedGet(context.Background(), "welcome", format)
+		suite.NoError(errWithCode)
+		suite.False(lastPostAt.IsZero())
+
+		feed, errWithCode := getFeed()
+		suite.NoError(errWithCode)
+		suite.Contains(feed, "#welcome on localhost:8080")
+		suite.Contains(feed, suite.testStatuses["admin_account_status_1"].URL)
+		suite.NotContains(feed, suite.testStatuses["local_account_1_status_1"].URL)
+	}
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedMaxLength() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+	ctx := context.Background()
+
+	// Zork posts more than fits in one feed.
+	suite.putStatusCopies("local_account_1_status_1", 25)
+
+	getFeed, _, errWithCode := suite.timeline.LocalTimelineFeedGet(ctx, timeline.FeedFormatRSS)
+	suite.NoError(errWithCode)
+
+	feed, errWithCode := getFeed()
+	suite.NoError(errWithCode)
+	suite.Equal(20, strings.Count(feed, "<item>"))
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedPagesPastIneligible() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+	ctx := context.Background()
+
+	// Zork posts, then turtle (RSS disabled) posts a whole
+	// page's worth, burying zork's post on the next page.
+	zorkStatuses := suite.putStatusCopies("local_account_1_status_1", 1)
+	suite.putStatusCopies("local_account_2_status_1", 30)
+
+	getFeed, _, errWithCode := suite.timeline.LocalTimelineFeedGet(ctx, timeline.FeedFormatRSS)
+	suite.NoError(errWithCode)
+
+	feed, errWithCode := getFeed()
+	suite.NoError(errWithCode)
+	suite.Contains(feed, zorkStatuses[0].URL)
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedMaxPages() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+	ctx := context.Background()
+
+	// Zork posts, then turtle (RSS disabled) posts more
+	// than we're willing to page through to fill the feed.
+	zorkStatuses := suite.putStatusCopies("local_account_1_status_1", 1)
+	suite.putStatusCopies("local_account_2_status_1", 110)
+
+	getFeed, _, errWithCode := suite.timeline.LocalTimelineFeedGet(ctx, timeline.FeedFormatRSS)
+	suite.NoError(errWithCode)
+
+	feed, errWithCode := getFeed()
+	suite.NoError(errWithCode)
+	suite.NotContains(feed, zorkStatuses[0].URL)
+	suite.Zero(strings.Count(feed, "<item>"))
+}
+
+// putStatusCopies puts n copies of the given test status
+// in the database, newest last, with unique IDs and URIs.
+func (suite *RSSTestSuite) putStatusCopies(name string, n int) []*gtsmodel.Status {
+	statuses := make([]*gtsmodel.Status, n)
+	for i := range statuses {
+		status := new(gtsmodel.Status)
+		*status = *suite.testStatuses[name]
+		status.ID = id.NewULID()
+		status.URI += "/" + status.ID
+		status.URL += "/" + status.ID
+		status.CreatedAt = time.Now()
+		status.UpdatedAt = status.CreatedAt
+
+		if err := suite.db.PutStatus(context.Background(), status); err != nil {
+			suite.FailNow(err.Error())
+		}
+		statuses[i] = status
+	}
+
+	return statuses
+}
+
+func TestRSSTestSuite(t *testing.T) {
+	suite.Run(t, new(RSSTestSuite))
+}
@@ -0,0 +1,180 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// emailDigestSchedule is the default schedule
+	// on which we check for digests that are due.
+	emailDigestSchedule = "@hourly"
+
+	// emailDigestPeriod is how long after
+	// sending a user their last digest
+	// their next digest is due.
+	emailDigestPeriod = 24 * time.Hour
+
+	// emailDigestLimit is the maximum number
+	// of notifications included in one digest.
+	emailDigestLimit = 100
+)
+
+// emailDigestExcludeTypes are the types of notification
+// to leave out when fetching notifications for a digest.
+var emailDigestExcludeTypes = []string{
+	string(gtsmodel.NotificationReblog),
+	string(gtsmodel.NotificationFave),
+	string(gtsmodel.NotificationPoll),
+	string(gtsmodel.NotificationStatus),
+	string(gtsmodel.NotificationSavedSearch),
+}
+
+// EmailDigestRegisterJob registers the job sending digests of
+// notifications to users who've chosen daily email notifications,
+// by default checking every hour for digests which are due. It
+// should be called once on startup, before jobs are started.
+func (p *Processor) EmailDigestRegisterJob() {
+	p.surface.state.Jobs.Register(jobs.EmailDigests, emailDigestSchedule, p.EmailDigests)
+}
+
+// EmailDigests sends a digest of their notifications to each
+// user with daily email notifications whose last digest was
+// sent at least emailDigestPeriod before now.
+func (p *Processor) EmailDigests(ctx context.Context, now time.Time) error {
+	users, err := p.surface.state.DB.GetUsersByEmailNotifications(ctx, gtsmodel.EmailNotificationsDaily)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting users: %w", err)
+	}
+
+	if len(users) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	instance, err := p.surface.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	for _, user := range users {
+		if err := p.surface.emailDigest(ctx, instance, user, now); err != nil {
+			log.Errorf(ctx, "error sending email digest to user %s: %v", user.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// emailDigest sends the given user a digest of the follow and mention
+// notifications they've received since their last digest, if one is due.
+func (s *surface) emailDigest(
+	ctx context.Context,
+	instance *gtsmodel.Instance,
+	user *gtsmodel.User,
+	now time.Time,
+) error {
+	if user.DigestSentAt.IsZero() {
+		// User only just turned on digests,
+		// start counting from now; the first
+		// one will be sent in a day's time.
+		return s.updateDigestSentAt(ctx, user, now)
+	}
+
+	if now.Sub(user.DigestSentAt) < emailDigestPeriod {
+		// Not due yet.
+		return nil
+	}
+
+	if !emailable(user) {
+		// Don't send anything, but don't
+		// save up notifications either.
+		return s.updateDigestSentAt(ctx, user, now)
+	}
+
+	sinceID, err := id.NewULIDFromTime(user.DigestSentAt)
+	if err != nil {
+		return gtserror.Newf("error creating since id: %w", err)
+	}
+
+	notifs, err := s.state.DB.GetAccountNotifications(
+		ctx,
+		user.AccountID,
+		"", sinceID, "",
+		emailDigestLimit,
+		emailDigestExcludeTypes,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting notifications: %w", err)
+	}
+
+	data := notificationEmailData(instance, user)
+	for _, notif := range notifs {
+		if _, ok := emailNotificationTypes[notif.NotificationType]; !ok {
+			// Not emailed.
+			continue
+		}
+
+		emailNotif, err := s.emailNotificationFor(ctx, notif)
+		if err != nil {
+			log.Errorf(ctx, "error converting notification %s for email: %v", notif.ID, err)
+			continue
+		}
+
+		data.Notifications = append(data.Notifications, emailNotif)
+	}
+
+	if len(data.Notifications) == 0 {
+		// Nothing happened, so don't
+		// bother the user with an email.
+		return s.updateDigestSentAt(ctx, user, now)
+	}
+
+	if err := s.emailSender.SendDigestEmail(user.Email, data); err != nil {
+		return gtserror.Newf("error emailing digest: %w", err)
+	}
+
+	user.LastEmailedAt = now
+	user.DigestSentAt = now
+	if err := s.state.DB.UpdateUser(ctx, user, "last_emailed_at", "digest_sent_at"); err != nil {
+		return gtserror.Newf("db error updating user: %w", err)
+	}
+
+	return nil
+}
+
+// updateDigestSentAt sets when the
+// user's last digest was sent to now.
+func (s *surface) updateDigestSentAt(ctx context.Context, user *gtsmodel.User, now time.Time) error {
+	user.DigestSentAt = now
+	if err := s.state.DB.UpdateUser(ctx, user, "digest_sent_at"); err != nil {
+		return gtserror.Newf("db error updating user: %w", err)
+	}
+	return nil
+}
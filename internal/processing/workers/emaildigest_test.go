@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+type EmailDigestTestSuite struct {
+	WorkersTestSuite
+}
+
+// setDigestSentAt turns on daily email digests for
+// local_account_1, with the last one sent at the given time.
+func (suite *EmailDigestTestSuite) setDigestSentAt(ctx context.Context, sentAt time.Time) *gtsmodel.User {
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+	user.EmailNotifications = gtsmodel.EmailNotificationsDaily
+	user.DigestSentAt = sentAt
+
+	if err := suite.db.UpdateUser(ctx, user, "email_notifications", "digest_sent_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return user
+}
+
+// putFollowNotification notifies local_account_1
+// that admin_account followed them at the given time.
+func (suite *EmailDigestTestSuite) putFollowNotification(ctx context.Context, at time.Time) {
+	notifID, err := id.NewULIDFromTime(at)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutNotification(ctx, &gtsmodel.Notification{
+		ID:               notifID,
+		NotificationType: gtsmodel.NotificationFollow,
+		TargetAccountID:  suite.testAccounts["local_account_1"].ID,
+		OriginAccountID:  suite.testAccounts["admin_account"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *EmailDigestTestSuite) TestEmailDigest() {
+	var (
+		ctx  = context.Background()
+		now  = time.Now()
+		user = suite.setDigestSentAt(ctx, now.Add(-25*time.Hour))
+	)
+
+	suite.putFollowNotification(ctx, now.Add(-time.Hour))
+
+	if err := suite.processor.Workers().EmailDigests(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Only the follow should be in the digest;
+	// the test fave notification is too old, and
+	// isn't a type that's emailed anyway.
+	suite.Len(suite.sentEmails, 1)
+	msg := suite.sentEmails[user.Email]
+	suite.Contains(msg, "Subject: GoToSocial Notifications Digest")
+	suite.Contains(msg, "You have 1 new notification on")
+	suite.Contains(msg, "- @admin followed you.")
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(now, dbUser.DigestSentAt, time.Second)
+	suite.WithinDuration(now, dbUser.LastEmailedAt, time.Second)
+}
+
+func (suite *EmailDigestTestSuite) TestEmailDigestNotDue() {
+	var (
+		ctx    = context.Background()
+		now    = time.Now()
+		sentAt = now.Add(-time.Hour)
+		user   = suite.setDigestSentAt(ctx, sentAt)
+	)
+
+	suite.putFollowNotification(ctx, now.Add(-time.Minute))
+
+	if err := suite.processor.Workers().EmailDigests(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(suite.sentEmails)
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(sentAt, dbUser.DigestSentAt, time.Second)
+}
+
+func (suite *EmailDigestTestSuite) TestEmailDigestNothingNew() {
+	var (
+		ctx  = context.Background()
+		now  = time.Now()
+		user = suite.setDigestSentAt(ctx, now.Add(-25*time.Hour))
+	)
+
+	if err := suite.processor.Workers().EmailDigests(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(suite.sentEmails)
+
+	// Next digest should be counted from now.
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(now, dbUser.DigestSentAt, time.Second)
+}
+
+func TestEmailDigestTestSuite(t *testing.T) {
+	suite.Run(t, &EmailDigestTestSuite{})
+}
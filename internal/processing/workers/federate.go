@@ -435,6 +435,65 @@ func (f *federate) UndoLike(ctx context.Context, fave *gtsmodel.StatusFave) erro
 	return nil
 }
 
+func (f *federate) UndoReaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	// Populate model.
+	if err := f.state.DB.PopulateStatusReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// Do nothing if both accounts are local.
+	if reaction.Account.IsLocal() &&
+		reaction.TargetAccount.IsLocal() {
+		return nil
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(reaction.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	targetAccountIRI, err := parseURI(reaction.TargetAccount.URI)
+	if err != nil {
+		return err
+	}
+
+	// Recreate the ActivityStreams Like.
+	like, err := f.converter.StatusReactionToAS(ctx, reaction)
+	if err != nil {
+		return gtserror.Newf("error converting reaction to AS: %w", err)
+	}
+
+	// Create a new Undo.
+	undo := streams.NewActivityStreamsUndo()
+
+	// Set the Actor for the Undo:
+	// same as the actor for the Like.
+	undo.SetActivityStreamsActor(like.GetActivityStreamsActor())
+
+	// Set recreated Like as the 'object' property.
+	undoObject := streams.NewActivityStreamsObjectProperty()
+	undoObject.AppendActivityStreamsLike(like)
+	undo.SetActivityStreamsObject(undoObject)
+
+	// Address the Undo To the target account.
+	undoTo := streams.NewActivityStreamsToProperty()
+	undoTo.AppendIRI(targetAccountIRI)
+	undo.SetActivityStreamsTo(undoTo)
+
+	// Send the Undo via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, undo,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			undo, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
 func (f *federate) UndoAnnounce(ctx context.Context, boost *gtsmodel.Status) error {
 	// Populate model.
 	if err := f.state.DB.PopulateStatus(ctx, boost); err != nil {
@@ -693,6 +752,43 @@ func (f *federate) Like(ctx context.Context, fave *gtsmodel.StatusFave) error {
 	return nil
 }
 
+func (f *federate) Reaction(ctx context.Context, reaction *gtsmodel.StatusReaction) error {
+	// Populate model.
+	if err := f.state.DB.PopulateStatusReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// Do nothing if both accounts are local.
+	if reaction.Account.IsLocal() &&
+		reaction.TargetAccount.IsLocal() {
+		return nil
+	}
+
+	// Parse relevant URI(s).
+	outboxIRI, err := parseURI(reaction.Account.OutboxURI)
+	if err != nil {
+		return err
+	}
+
+	// Create the ActivityStreams Like.
+	like, err := f.converter.StatusReactionToAS(ctx, reaction)
+	if err != nil {
+		return gtserror.Newf("error converting reaction to AS Like: %w", err)
+	}
+
+	// Send the Like via the Actor's outbox.
+	if _, err := f.FederatingActor().Send(
+		ctx, outboxIRI, like,
+	); err != nil {
+		return gtserror.Newf(
+			"error sending activity %T via outbox %s: %w",
+			like, outboxIRI, err,
+		)
+	}
+
+	return nil
+}
+
 func (f *federate) Announce(ctx context.Context, boost *gtsmodel.Status) error {
 	// Populate model.
 	if err := f.state.DB.PopulateStatus(ctx, boost); err != nil {
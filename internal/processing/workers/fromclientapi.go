@@ -48,6 +48,13 @@ type clientAPI struct {
 }
 
 func (p *Processor) EnqueueClientAPI(cctx context.Context, msgs ...messages.FromClientAPI) {
+	if q := p.workers.ClientAPIQueue; q != nil {
+		// Persistent queue is configured, try there first.
+		if pushQueue(cctx, q, toPtrs(msgs)) {
+			return
+		}
+	}
+
 	_ = p.workers.ClientAPI.MustEnqueueCtx(cctx, func(wctx context.Context) {
 		// Copy caller ctx values to worker's.
 		wctx = gtscontext.WithValues(wctx, cctx)
@@ -101,6 +108,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg messages.From
 		case ap.ActivityLike:
 			return p.clientAPI.CreateLike(ctx, cMsg)
 
+		// CREATE EMOJIREACT/REACTION
+		case ap.ActivityEmojiReact:
+			return p.clientAPI.CreateReaction(ctx, cMsg)
+
 		// CREATE ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.clientAPI.CreateAnnounce(ctx, cMsg)
@@ -129,20 +140,31 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg messages.From
 
 	// ACCEPT SOMETHING
 	case ap.ActivityAccept:
-		switch cMsg.APObjectType { //nolint:gocritic
+		switch cMsg.APObjectType {
 
 		// ACCEPT FOLLOW (request)
 		case ap.ActivityFollow:
 			return p.clientAPI.AcceptFollow(ctx, cMsg)
+
+		// ACCEPT NOTE (held by first interaction or spam filter)
+		case ap.ObjectNote:
+			if _, ok := cMsg.GTSModel.(*gtsmodel.QuarantinedStatus); ok {
+				return p.clientAPI.AcceptQuarantinedStatus(ctx, cMsg)
+			}
+			return p.clientAPI.AcceptFirstInteraction(ctx, cMsg)
 		}
 
 	// REJECT SOMETHING
 	case ap.ActivityReject:
-		switch cMsg.APObjectType { //nolint:gocritic
+		switch cMsg.APObjectType {
 
 		// REJECT FOLLOW (request)
 		case ap.ActivityFollow:
 			return p.clientAPI.RejectFollowRequest(ctx, cMsg)
+
+		// REJECT NOTE (held by spam filter)
+		case ap.ObjectNote:
+			return p.clientAPI.RejectQuarantinedStatus(ctx, cMsg)
 		}
 
 	// UNDO SOMETHING
@@ -161,6 +183,10 @@ func (p *Processor) ProcessFromClientAPI(ctx context.Context, cMsg messages.From
 		case ap.ActivityLike:
 			return p.clientAPI.UndoFave(ctx, cMsg)
 
+		// UNDO EMOJIREACT/REACTION
+		case ap.ActivityEmojiReact:
+			return p.clientAPI.UndoReaction(ctx, cMsg)
+
 		// UNDO ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.clientAPI.UndoAnnounce(ctx, cMsg)
@@ -275,6 +301,23 @@ func (p *clientAPI) CreateLike(ctx context.Context, cMsg messages.FromClientAPI)
 	return nil
 }
 
+func (p *clientAPI) CreateReaction(ctx context.Context, cMsg messages.FromClientAPI) error {
+	reaction, ok := cMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", cMsg.GTSModel)
+	}
+
+	// Interaction counts changed on the reacted-to
+	// status; uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	if err := p.federate.Reaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error federating reaction: %w", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) CreateAnnounce(ctx context.Context, cMsg messages.FromClientAPI) error {
 	boost, ok := cMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -376,6 +419,12 @@ func (p *clientAPI) UpdateReport(ctx context.Context, cMsg messages.FromClientAP
 		return nil
 	}
 
+	if report.Account.IsInstance() {
+		// Report was opened automatically by
+		// this instance, there's no one to email.
+		return nil
+	}
+
 	if err := p.surface.emailReportClosed(ctx, report); err != nil {
 		return gtserror.Newf("error sending report closed email: %w", err)
 	}
@@ -400,6 +449,62 @@ func (p *clientAPI) AcceptFollow(ctx context.Context, cMsg messages.FromClientAP
 	return nil
 }
 
+func (p *clientAPI) AcceptFirstInteraction(ctx context.Context, cMsg messages.FromClientAPI) error {
+	firstInteraction, ok := cMsg.GTSModel.(*gtsmodel.FirstInteraction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.FirstInteraction", cMsg.GTSModel)
+	}
+
+	if err := p.surface.notifyFirstInteraction(ctx, firstInteraction); err != nil {
+		return gtserror.Newf("error notifying first interaction: %w", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) AcceptQuarantinedStatus(ctx context.Context, cMsg messages.FromClientAPI) error {
+	quarantined, ok := cMsg.GTSModel.(*gtsmodel.QuarantinedStatus)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.QuarantinedStatus", cMsg.GTSModel)
+	}
+
+	// Get the fully populated status, as
+	// the one on the entry is barebones.
+	status, err := p.state.DB.GetStatusByID(ctx, quarantined.StatusID)
+	if err != nil {
+		return gtserror.Newf("error getting quarantined status: %w", err)
+	}
+
+	if err := p.surface.timelineAndNotifyStatus(ctx, status); err != nil {
+		return gtserror.Newf("error timelining quarantined status: %w", err)
+	}
+
+	return nil
+}
+
+func (p *clientAPI) RejectQuarantinedStatus(ctx context.Context, cMsg messages.FromClientAPI) error {
+	quarantined, ok := cMsg.GTSModel.(*gtsmodel.QuarantinedStatus)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.QuarantinedStatus", cMsg.GTSModel)
+	}
+
+	status, err := p.state.DB.GetStatusByID(ctx, quarantined.StatusID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Already gone,
+			// nothing to do.
+			return nil
+		}
+		return gtserror.Newf("error getting quarantined status: %w", err)
+	}
+
+	if err := p.wipeStatus(ctx, status, true); err != nil {
+		return gtserror.Newf("error wiping quarantined status: %w", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) RejectFollowRequest(ctx context.Context, cMsg messages.FromClientAPI) error {
 	followReq, ok := cMsg.GTSModel.(*gtsmodel.FollowRequest)
 	if !ok {
@@ -459,6 +564,23 @@ func (p *clientAPI) UndoFave(ctx context.Context, cMsg messages.FromClientAPI) e
 	return nil
 }
 
+func (p *clientAPI) UndoReaction(ctx context.Context, cMsg messages.FromClientAPI) error {
+	reaction, ok := cMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", cMsg.GTSModel)
+	}
+
+	// Interaction counts changed on the reacted-to
+	// status; uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	if err := p.federate.UndoReaction(ctx, reaction); err != nil {
+		return gtserror.Newf("error federating undo reaction: %w", err)
+	}
+
+	return nil
+}
+
 func (p *clientAPI) UndoAnnounce(ctx context.Context, cMsg messages.FromClientAPI) error {
 	status, ok := cMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
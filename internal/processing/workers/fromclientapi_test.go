@@ -265,6 +265,62 @@ func (suite *FromClientAPITestSuite) TestProcessCreateStatusWithNotification() {
 	)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusSelfReplyWithNotification() {
+	var (
+		ctx              = context.Background()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_1"]
+
+		// Admin account replies to its own status,
+		// ie., it continues a thread. This should
+		// notify followers who asked for notifs.
+		status = suite.newStatus(
+			ctx,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			suite.testStatuses["admin_account_status_1"],
+			nil,
+		)
+	)
+
+	// Update the follow from receiving account -> posting account so
+	// that receiving account wants notifs when posting account posts.
+	follow := new(gtsmodel.Follow)
+	*follow = *suite.testFollows["local_account_1_admin_account"]
+
+	follow.Notify = util.Ptr(true)
+	if err := suite.db.UpdateFollow(ctx, follow); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Process the new status.
+	if err := suite.processor.Workers().ProcessFromClientAPI(
+		ctx,
+		messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			OriginAccount:  postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Wait for a notification to appear for the status.
+	if !testrig.WaitFor(func() bool {
+		_, err := suite.db.GetNotification(
+			ctx,
+			gtsmodel.NotificationStatus,
+			receivingAccount.ID,
+			postingAccount.ID,
+			status.ID,
+		)
+		return err == nil
+	}) {
+		suite.FailNow("timed out waiting for new status notification")
+	}
+}
+
 func (suite *FromClientAPITestSuite) TestProcessCreateStatusReply() {
 	var (
 		ctx              = context.Background()
@@ -530,6 +586,85 @@ func (suite *FromClientAPITestSuite) TestProcessCreateStatusReplyListRepliesPoli
 	)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessCreateStatusListRuleTag() {
+	var (
+		ctx              = context.Background()
+		postingAccount   = suite.testAccounts["admin_account"]
+		receivingAccount = suite.testAccounts["local_account_2"]
+		tag              = suite.testTags["welcome"]
+	)
+
+	// Turtle doesn't follow admin, but has a list
+	// with a rule to show statuses tagged #welcome.
+	testList := &gtsmodel.List{
+		ID:            id.NewULID(),
+		Title:         "Welcomes",
+		AccountID:     receivingAccount.ID,
+		RepliesPolicy: gtsmodel.RepliesPolicyFollowed,
+	}
+	if err := suite.db.PutList(ctx, testList); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.PutListRule(ctx, &gtsmodel.ListRule{
+		ID:     id.NewULID(),
+		ListID: testList.ID,
+		TagID:  tag.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	var (
+		streams    = suite.openStreams(ctx, receivingAccount, []string{testList.ID})
+		homeStream = streams[stream.TimelineHome]
+		listStream = streams[stream.TimelineList+":"+testList.ID]
+		status     = suite.newStatus(
+			ctx,
+			postingAccount,
+			gtsmodel.VisibilityPublic,
+			nil,
+			nil,
+		)
+	)
+
+	// Tag the status.
+	status.TagIDs = []string{tag.ID}
+	if err := suite.db.UpdateStatus(ctx, status, "tags"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	statusJSON := suite.statusJSON(ctx, status, receivingAccount)
+
+	// Process the new status.
+	if err := suite.processor.Workers().ProcessFromClientAPI(
+		ctx,
+		messages.FromClientAPI{
+			APObjectType:   ap.ObjectNote,
+			APActivityType: ap.ActivityCreate,
+			GTSModel:       status,
+			OriginAccount:  postingAccount,
+		},
+	); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Check message in list stream.
+	suite.checkStreamed(
+		listStream,
+		true,
+		statusJSON,
+		stream.EventTypeUpdate,
+	)
+
+	// Turtle doesn't follow admin,
+	// so nothing in home stream.
+	suite.checkStreamed(
+		homeStream,
+		false,
+		"",
+		"",
+	)
+}
+
 func (suite *FromClientAPITestSuite) TestProcessCreateStatusBoost() {
 	var (
 		ctx              = context.Background()
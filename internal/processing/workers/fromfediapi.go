@@ -19,11 +19,16 @@ package workers
 
 import (
 	"context"
+	"errors"
 	"net/url"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/spam"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -46,6 +51,13 @@ type fediAPI struct {
 }
 
 func (p *Processor) EnqueueFediAPI(cctx context.Context, msgs ...messages.FromFediAPI) {
+	if q := p.workers.FediAPIQueue; q != nil {
+		// Persistent queue is configured, try there first.
+		if pushQueue(cctx, q, toPtrs(msgs)) {
+			return
+		}
+	}
+
 	_ = p.workers.Federator.MustEnqueueCtx(cctx, func(wctx context.Context) {
 		// Copy caller ctx values to worker's.
 		wctx = gtscontext.WithValues(wctx, cctx)
@@ -102,6 +114,10 @@ func (p *Processor) ProcessFromFediAPI(ctx context.Context, fMsg messages.FromFe
 		case ap.ActivityLike:
 			return p.fediAPI.CreateLike(ctx, fMsg)
 
+		// CREATE EMOJIREACT/REACTION
+		case ap.ActivityEmojiReact:
+			return p.fediAPI.CreateReaction(ctx, fMsg)
+
 		// CREATE ANNOUNCE/BOOST
 		case ap.ActivityAnnounce:
 			return p.fediAPI.CreateAnnounce(ctx, fMsg)
@@ -165,6 +181,13 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg messages.FromFediAPI) e
 		status, err = p.statusFromGTSModel(ctx, fMsg)
 	}
 
+	if gtserror.Dropped(err) {
+		// Status was dropped by an
+		// ingest rule, nothing to do.
+		log.Debug(ctx, err)
+		return nil
+	}
+
 	if err != nil {
 		return gtserror.Newf("error extracting status from federatorMsg: %w", err)
 	}
@@ -198,6 +221,32 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg messages.FromFediAPI) e
 		return err
 	}
 
+	// Check whether the status looks like spam
+	// before it reaches anyone's timelines.
+	held, err := p.checkSpam(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	if held {
+		// Status was dropped or
+		// quarantined, we're done.
+		return nil
+	}
+
+	// Check whether the status comes from a
+	// new domain still awaiting approval.
+	held, err = p.checkNewDomain(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	if held {
+		// Status was quarantined
+		// with its domain, done.
+		return nil
+	}
+
 	if status.InReplyToID != "" {
 		// Interaction counts changed on the replied status;
 		// uncache the prepared version from all timelines.
@@ -211,6 +260,177 @@ func (p *fediAPI) CreateStatus(ctx context.Context, fMsg messages.FromFediAPI) e
 	return nil
 }
 
+// checkSpam scores the given incoming status with the spam
+// filter, and either wipes it or holds it for review by a
+// moderator if the score is high enough. Returns true if
+// the status was wiped or held, and should go no further.
+func (p *fediAPI) checkSpam(ctx context.Context, status *gtsmodel.Status) (bool, error) {
+	result, err := spam.Check(ctx, p.state, status)
+	if err != nil {
+		// Don't hold up the status
+		// just because checking failed.
+		log.Errorf(ctx, "error checking status %s for spam: %v", status.URI, err)
+		return false, nil
+	}
+
+	switch {
+	case result.Drop():
+		log.Infof(ctx, "dropping status %s with spam score %d", status.URI, result.Score)
+		if err := p.wipeStatus(ctx, status, true); err != nil {
+			return true, gtserror.Newf("error wiping spam status: %w", err)
+		}
+		return true, nil
+
+	case result.Quarantine():
+		log.Infof(ctx, "quarantining status %s with spam score %d", status.URI, result.Score)
+		if err := p.state.DB.PutQuarantinedStatus(ctx, &gtsmodel.QuarantinedStatus{
+			ID:        id.NewULID(),
+			StatusID:  status.ID,
+			Status:    status,
+			AccountID: status.AccountID,
+			Account:   status.Account,
+			Score:     result.Score,
+			Reasons:   result.Reasons,
+		}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+			return true, gtserror.Newf("error quarantining spam status: %w", err)
+		}
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// checkNewDomain holds the given incoming status in the
+// quarantine queue if new domain quarantine is enabled,
+// and its domain has not yet been approved. A domain
+// delivering for the first time is quarantined, unless
+// we already have statuses from it. Returns true if the
+// status was held, and should go no further.
+func (p *fediAPI) checkNewDomain(ctx context.Context, status *gtsmodel.Status) (bool, error) {
+	if !config.GetInstanceNewDomainQuarantine() {
+		// Not enabled.
+		return false, nil
+	}
+
+	if status.Account == nil || status.Account.IsLocal() {
+		// Nothing to check.
+		return false, nil
+	}
+	domain := status.Account.Domain
+
+	quarantine, err := p.state.DB.GetDomainQuarantine(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("db error getting domain quarantine for %s: %w", domain, err)
+	}
+
+	if quarantine == nil {
+		// Domain delivering for the first time
+		// since quarantine was enabled. If we
+		// already have statuses from it (other
+		// than this one), consider it known.
+		known, err := p.state.DB.DomainHasStatuses(ctx, domain, status.ID)
+		if err != nil {
+			return false, gtserror.Newf("db error checking statuses for %s: %w", domain, err)
+		}
+
+		quarantine = &gtsmodel.DomainQuarantine{
+			ID:     id.NewULID(),
+			Domain: domain,
+		}
+
+		if known {
+			quarantine.ApprovedAt = time.Now()
+		} else {
+			log.Infof(ctx, "quarantining new domain %s", domain)
+		}
+
+		if err := p.state.DB.PutDomainQuarantine(ctx, quarantine); err != nil {
+			if !errors.Is(err, db.ErrAlreadyExists) {
+				return false, gtserror.Newf("db error putting domain quarantine for %s: %w", domain, err)
+			}
+
+			// Raced with another status
+			// from the same domain, refetch.
+			quarantine, err = p.state.DB.GetDomainQuarantine(ctx, domain)
+			if err != nil {
+				return false, gtserror.Newf("db error getting domain quarantine for %s: %w", domain, err)
+			}
+		}
+	}
+
+	if quarantine.Approved() {
+		// Domain is fine.
+		return false, nil
+	}
+
+	log.Infof(ctx, "quarantining status %s from new domain %s", status.URI, domain)
+	if err := p.state.DB.PutQuarantinedStatus(ctx, &gtsmodel.QuarantinedStatus{
+		ID:        id.NewULID(),
+		StatusID:  status.ID,
+		Status:    status,
+		AccountID: status.AccountID,
+		Account:   status.Account,
+		Reasons:   []string{"first statuses from new domain " + domain},
+		Domain:    domain,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return true, gtserror.Newf("error quarantining status from new domain: %w", err)
+	}
+
+	// This status got past the spam
+	// filter, so count it as clean.
+	quarantine.CleanCount++
+	columns := []string{"clean_count"}
+
+	autoApprove := config.GetInstanceNewDomainAutoApprove()
+	if autoApprove > 0 && quarantine.CleanCount >= autoApprove {
+		log.Infof(ctx, "auto-approving new domain %s after %d clean statuses", domain, quarantine.CleanCount)
+		quarantine.ApprovedAt = time.Now()
+		columns = append(columns, "approved_at")
+	}
+
+	if err := p.state.DB.UpdateDomainQuarantine(ctx, quarantine, columns...); err != nil {
+		return true, gtserror.Newf("db error updating domain quarantine for %s: %w", domain, err)
+	}
+
+	if quarantine.Approved() {
+		// Domain just got approved,
+		// release all of its statuses.
+		p.releaseDomain(ctx, domain)
+	}
+
+	return true, nil
+}
+
+// releaseDomain releases all statuses held because their
+// domain was awaiting approval, putting them in timelines
+// and sending any notifications for them.
+func (p *fediAPI) releaseDomain(ctx context.Context, domain string) {
+	held, err := p.state.DB.GetQuarantinedStatusesByDomain(ctx, domain)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf(ctx, "db error getting statuses held for %s: %v", domain, err)
+		return
+	}
+
+	for _, quarantined := range held {
+		if err := p.state.DB.DeleteQuarantinedStatusByID(ctx, quarantined.ID); err != nil {
+			log.Errorf(ctx, "db error deleting quarantined status %s: %v", quarantined.ID, err)
+			continue
+		}
+
+		// Get the fully populated status, as
+		// the one on the entry is barebones.
+		status, err := p.state.DB.GetStatusByID(ctx, quarantined.StatusID)
+		if err != nil {
+			log.Errorf(ctx, "db error getting quarantined status %s: %v", quarantined.StatusID, err)
+			continue
+		}
+
+		if err := p.surface.timelineAndNotifyStatus(ctx, status); err != nil {
+			log.Errorf(ctx, "error timelining released status %s: %v", status.ID, err)
+		}
+	}
+}
+
 func (p *fediAPI) statusFromGTSModel(ctx context.Context, fMsg messages.FromFediAPI) (*gtsmodel.Status, error) {
 	// There should be a status pinned to the message:
 	// we've already checked to ensure this is not nil.
@@ -326,6 +546,38 @@ func (p *fediAPI) CreateLike(ctx context.Context, fMsg messages.FromFediAPI) err
 	return nil
 }
 
+func (p *fediAPI) CreateReaction(ctx context.Context, fMsg messages.FromFediAPI) error {
+	reaction, ok := fMsg.GTSModel.(*gtsmodel.StatusReaction)
+	if !ok {
+		return gtserror.Newf("%T not parseable as *gtsmodel.StatusReaction", fMsg.GTSModel)
+	}
+
+	// Dereference custom emoji used for
+	// the reaction (if any) before storing.
+	if err := p.federate.DereferenceReactionEmoji(
+		ctx,
+		reaction,
+		fMsg.ReceivingAccount.Username,
+	); err != nil {
+		return gtserror.Newf("error dereferencing reaction emoji: %w", err)
+	}
+
+	if err := p.state.DB.PutStatusReaction(ctx, reaction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// The reaction already exists in the database,
+			// which means we've already handled side effects.
+			return nil
+		}
+		return gtserror.Newf("db error inserting reaction: %w", err)
+	}
+
+	// Interaction counts changed on the reacted-to
+	// status; uncache the prepared version from all timelines.
+	p.surface.invalidateStatusFromTimelines(ctx, reaction.StatusID)
+
+	return nil
+}
+
 func (p *fediAPI) CreateAnnounce(ctx context.Context, fMsg messages.FromFediAPI) error {
 	status, ok := fMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
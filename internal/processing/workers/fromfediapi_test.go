@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -117,7 +118,7 @@ func (suite *FromFediAPITestSuite) TestProcessReplyMention() {
 		Likeable:            util.Ptr(false),
 	}
 
-	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), repliedAccount, stream.TimelineHome)
+	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), repliedAccount, stream.TimelineHome, "")
 	suite.NoError(errWithCode)
 
 	// id the status based on the time it was created
@@ -173,12 +174,110 @@ func (suite *FromFediAPITestSuite) TestProcessReplyMention() {
 	suite.Equal(replyingAccount.ID, notifStreamed.Account.ID)
 }
 
+func (suite *FromFediAPITestSuite) TestProcessMentionFirstInteraction() {
+	var (
+		ctx               = context.Background()
+		mentionedAccount  = suite.testAccounts["local_account_1"]
+		mentionedUser     = suite.testUsers["local_account_1"]
+		mentioningAccount = suite.testAccounts["remote_account_1"]
+	)
+
+	// Zork reviews first interactions, and
+	// doesn't know foss_satan either way round.
+	mentionedUser.ReviewFirstInteractions = util.Ptr(true)
+	if err := suite.db.UpdateUser(ctx, mentionedUser, "review_first_interactions"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	statusID := id.NewULID()
+	mention := &gtsmodel.Mention{
+		ID:               id.NewULID(),
+		StatusID:         statusID,
+		OriginAccountID:  mentioningAccount.ID,
+		OriginAccountURI: mentioningAccount.URI,
+		TargetAccountID:  mentionedAccount.ID,
+		TargetAccountURI: mentionedAccount.URI,
+		NameString:       "@the_mighty_zork@localhost:8080",
+	}
+
+	if err := suite.db.PutMention(ctx, mention); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	mentioningStatus := &gtsmodel.Status{
+		ID:                  statusID,
+		CreatedAt:           time.Now(),
+		UpdatedAt:           time.Now(),
+		URI:                 "http://fossbros-anonymous.io/users/foss_satan/statuses/106221634728637553",
+		URL:                 "http://fossbros-anonymous.io/@foss_satan/106221634728637553",
+		FetchedAt:           time.Now(),
+		Content:             `<p><span class="h-card"><a href="http://localhost:8080/@the_mighty_zork" class="u-url mention">@<span>the_mighty_zork</span></a></span> hello friend, click my link</p>`,
+		MentionIDs:          []string{mention.ID},
+		AccountID:           mentioningAccount.ID,
+		AccountURI:          mentioningAccount.URI,
+		Visibility:          gtsmodel.VisibilityDirect,
+		ActivityStreamsType: ap.ObjectNote,
+		Federated:           util.Ptr(true),
+		Boostable:           util.Ptr(false),
+		Replyable:           util.Ptr(true),
+		Likeable:            util.Ptr(true),
+	}
+
+	if err := suite.db.PutStatus(ctx, mentioningStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.processor.Workers().ProcessFromFediAPI(ctx, messages.FromFediAPI{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         mentioningStatus,
+		ReceivingAccount: mentionedAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// The mention should be held, not notified.
+	var notif gtsmodel.Notification
+	err := suite.db.GetWhere(ctx, []db.Where{
+		{Key: "status_id", Value: mentioningStatus.ID},
+	}, &notif)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	firstInteraction, err := suite.db.GetFirstInteraction(ctx, mentionedAccount.ID, mentioningAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(firstInteraction.Pending())
+	suite.Equal([]string{mentioningStatus.ID}, firstInteraction.StatusIDs)
+
+	// Approving the first interaction
+	// should notify the held mention.
+	if err := suite.processor.Workers().ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityAccept,
+		GTSModel:       firstInteraction,
+		OriginAccount:  mentionedAccount,
+		TargetAccount:  mentioningAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.GetWhere(ctx, []db.Where{
+		{Key: "status_id", Value: mentioningStatus.ID},
+	}, &notif); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.NotificationMention, notif.NotificationType)
+	suite.Equal(mentionedAccount.ID, notif.TargetAccountID)
+	suite.Equal(mentioningAccount.ID, notif.OriginAccountID)
+}
+
 func (suite *FromFediAPITestSuite) TestProcessFave() {
 	favedAccount := suite.testAccounts["local_account_1"]
 	favedStatus := suite.testStatuses["local_account_1_status_1"]
 	favingAccount := suite.testAccounts["remote_account_1"]
 
-	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), favedAccount, stream.TimelineNotifications)
+	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), favedAccount, stream.TimelineNotifications, "")
 	suite.NoError(errWithCode)
 
 	fave := &gtsmodel.StatusFave{
@@ -251,7 +350,7 @@ func (suite *FromFediAPITestSuite) TestProcessFaveWithDifferentReceivingAccount(
 	favedStatus := suite.testStatuses["local_account_1_status_1"]
 	favingAccount := suite.testAccounts["remote_account_1"]
 
-	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), receivingAccount, stream.TimelineHome)
+	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), receivingAccount, stream.TimelineHome, "")
 	suite.NoError(errWithCode)
 
 	fave := &gtsmodel.StatusFave{
@@ -394,7 +493,7 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestLocked() {
 	// target is a locked account
 	targetAccount := suite.testAccounts["local_account_2"]
 
-	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), targetAccount, stream.TimelineHome)
+	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), targetAccount, stream.TimelineHome, "")
 	suite.NoError(errWithCode)
 
 	// put the follow request in the database as though it had passed through the federating db already
@@ -443,6 +542,53 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestLocked() {
 	suite.Empty(suite.httpClient.SentMessages)
 }
 
+func (suite *FromFediAPITestSuite) TestProcessFollowRequestLockedEmail() {
+	ctx := context.Background()
+
+	originAccount := suite.testAccounts["remote_account_1"]
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	// target wants notifications emailed straight away
+	targetUser := new(gtsmodel.User)
+	*targetUser = *suite.testUsers["local_account_2"]
+	targetUser.EmailNotifications = gtsmodel.EmailNotificationsImmediate
+	if err := suite.db.UpdateUser(ctx, targetUser, "email_notifications"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	satanFollowRequestTurtle := &gtsmodel.FollowRequest{
+		ID:              "01FGRYAVAWWPP926J175QGM0WV",
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+		AccountID:       originAccount.ID,
+		Account:         originAccount,
+		TargetAccountID: targetAccount.ID,
+		TargetAccount:   targetAccount,
+		ShowReblogs:     util.Ptr(true),
+		URI:             fmt.Sprintf("%s/follows/01FGRYAVAWWPP926J175QGM0WV", originAccount.URI),
+		Notify:          util.Ptr(false),
+	}
+
+	if err := suite.db.Put(ctx, satanFollowRequestTurtle); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.processor.Workers().ProcessFromFediAPI(ctx, messages.FromFediAPI{
+		APObjectType:     ap.ActivityFollow,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         satanFollowRequestTurtle,
+		ReceivingAccount: targetAccount,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// the follow request should have been emailed
+	suite.Len(suite.sentEmails, 1)
+	msg := suite.sentEmails[targetUser.Email]
+	suite.Contains(msg, "Subject: GoToSocial New Follow Request")
+	suite.Contains(msg, "@foss_satan@fossbros-anonymous.io requested to follow you on")
+}
+
 func (suite *FromFediAPITestSuite) TestProcessFollowRequestUnlocked() {
 	ctx := context.Background()
 
@@ -451,7 +597,7 @@ func (suite *FromFediAPITestSuite) TestProcessFollowRequestUnlocked() {
 	// target is an unlocked account
 	targetAccount := suite.testAccounts["local_account_1"]
 
-	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), targetAccount, stream.TimelineHome)
+	wssStream, errWithCode := suite.processor.Stream().Open(context.Background(), targetAccount, stream.TimelineHome, "")
 	suite.NoError(errWithCode)
 
 	// put the follow request in the database as though it had passed through the federating db already
@@ -560,6 +706,119 @@ func (suite *FromFediAPITestSuite) TestCreateStatusFromIRI() {
 	suite.Equal(statusCreator.URI, s.AccountURI)
 }
 
+// newDomainStatus puts and processes a new public status from the given remote account.
+func (suite *FromFediAPITestSuite) newDomainStatus(ctx context.Context, account *gtsmodel.Account) *gtsmodel.Status {
+	statusID := id.NewULID()
+	status := &gtsmodel.Status{
+		ID:                  statusID,
+		URI:                 account.URI + "/statuses/" + statusID,
+		URL:                 account.URL + "/" + statusID,
+		Content:             "<p>hello fediverse</p>",
+		AccountID:           account.ID,
+		AccountURI:          account.URI,
+		Visibility:          gtsmodel.VisibilityPublic,
+		ActivityStreamsType: ap.ObjectNote,
+		Local:               util.Ptr(false),
+		Federated:           util.Ptr(true),
+		Boostable:           util.Ptr(true),
+		Replyable:           util.Ptr(true),
+		Likeable:            util.Ptr(true),
+
+		// Mark as just fetched, so the status isn't
+		// dereferenced again from its (fake) URI.
+		FetchedAt: time.Now(),
+	}
+
+	if err := suite.db.PutStatus(ctx, status); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.processor.Workers().ProcessFromFediAPI(ctx, messages.FromFediAPI{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityCreate,
+		GTSModel:         status,
+		ReceivingAccount: suite.testAccounts["local_account_1"],
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return status
+}
+
+func (suite *FromFediAPITestSuite) TestCreateStatusNewDomainQuarantine() {
+	ctx := context.Background()
+	config.SetInstanceNewDomainQuarantine(true)
+	config.SetInstanceNewDomainAutoApprove(2)
+
+	// remote_account_3 is on a domain we have no statuses from yet.
+	account := suite.testAccounts["remote_account_3"]
+
+	// First status should be held with its domain.
+	status1 := suite.newDomainStatus(ctx, account)
+
+	quarantined, err := suite.db.IsStatusQuarantined(ctx, status1.ID)
+	suite.NoError(err)
+	suite.True(quarantined)
+
+	quarantine, err := suite.db.GetDomainQuarantine(ctx, account.Domain)
+	suite.NoError(err)
+	suite.False(quarantine.Approved())
+	suite.Equal(1, quarantine.CleanCount)
+
+	// Second clean status should auto-approve
+	// the domain, releasing both statuses.
+	status2 := suite.newDomainStatus(ctx, account)
+
+	quarantine, err = suite.db.GetDomainQuarantine(ctx, account.Domain)
+	suite.NoError(err)
+	suite.True(quarantine.Approved())
+	suite.Equal(2, quarantine.CleanCount)
+
+	for _, status := range []*gtsmodel.Status{status1, status2} {
+		quarantined, err := suite.db.IsStatusQuarantined(ctx, status.ID)
+		suite.NoError(err)
+		suite.False(quarantined)
+	}
+
+	// Later statuses aren't held.
+	status3 := suite.newDomainStatus(ctx, account)
+
+	quarantined, err = suite.db.IsStatusQuarantined(ctx, status3.ID)
+	suite.NoError(err)
+	suite.False(quarantined)
+}
+
+func (suite *FromFediAPITestSuite) TestCreateStatusNewDomainQuarantineKnownDomain() {
+	ctx := context.Background()
+	config.SetInstanceNewDomainQuarantine(true)
+
+	// We already have statuses from remote_account_1's domain.
+	account := suite.testAccounts["remote_account_1"]
+	status := suite.newDomainStatus(ctx, account)
+
+	quarantined, err := suite.db.IsStatusQuarantined(ctx, status.ID)
+	suite.NoError(err)
+	suite.False(quarantined)
+
+	quarantine, err := suite.db.GetDomainQuarantine(ctx, account.Domain)
+	suite.NoError(err)
+	suite.True(quarantine.Approved())
+}
+
+func (suite *FromFediAPITestSuite) TestCreateStatusNewDomainQuarantineDisabled() {
+	ctx := context.Background()
+
+	account := suite.testAccounts["remote_account_3"]
+	status := suite.newDomainStatus(ctx, account)
+
+	quarantined, err := suite.db.IsStatusQuarantined(ctx, status.ID)
+	suite.NoError(err)
+	suite.False(quarantined)
+
+	_, err = suite.db.GetDomainQuarantine(ctx, account.Domain)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestFromFederatorTestSuite(t *testing.T) {
 	suite.Run(t, &FromFediAPITestSuite{})
 }
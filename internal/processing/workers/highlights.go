@@ -0,0 +1,374 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	// highlightsSchedule is the default schedule on
+	// which we check for highlights that are due.
+	highlightsSchedule = "@hourly"
+
+	// highlightsHour is the hour of the day, in
+	// the user's own time zone, from which their
+	// highlights for that day are made.
+	highlightsHour = 8
+
+	// highlightsPeriod is how far back
+	// we look for statuses to highlight.
+	highlightsPeriod = 24 * time.Hour
+
+	// highlightsCandidates is the maximum number of
+	// statuses from the home timeline considered for
+	// highlights, newest first.
+	highlightsCandidates = 400
+
+	// highlightsLimit is the maximum number
+	// of statuses included in one highlights.
+	highlightsLimit = 10
+)
+
+// HighlightsRegisterJob registers the job making highlights of
+// the statuses from followed accounts that got the most interactions
+// for users who've turned on highlights, by default checking every
+// hour for highlights which are due. It should be called once on
+// startup, before jobs are started.
+func (p *Processor) HighlightsRegisterJob() {
+	p.surface.state.Jobs.Register(jobs.Highlights, highlightsSchedule, p.Highlights)
+}
+
+// Highlights makes highlights for each user who's turned them
+// on, and hasn't had any made yet on the current day in their
+// time zone, once it's past highlightsHour there. Highlights
+// are emailed to users who've chosen to get them by email.
+func (p *Processor) Highlights(ctx context.Context, now time.Time) error {
+	users, err := p.surface.state.DB.GetUsersWithHighlightsDigest(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("db error getting users: %w", err)
+	}
+
+	if len(users) == 0 {
+		// Nothing to do.
+		return nil
+	}
+
+	instance, err := p.surface.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	for _, user := range users {
+		if err := p.surface.highlights(ctx, instance, user, now); err != nil {
+			log.Errorf(ctx, "error making highlights for user %s: %v", user.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// highlightsDue returns whether it's time
+// to make the given user's highlights.
+func highlightsDue(user *gtsmodel.User, now time.Time) bool {
+	var (
+		loc   = user.Location()
+		local = now.In(loc)
+	)
+
+	if local.Hour() < highlightsHour {
+		// Too early in the day.
+		return false
+	}
+
+	if user.HighlightsDigestAt.IsZero() {
+		// Never made any.
+		return true
+	}
+
+	// Due if the last ones were
+	// made on an earlier day.
+	year, month, day := local.Date()
+	lastYear, lastMonth, lastDay := user.HighlightsDigestAt.In(loc).Date()
+	return year != lastYear || month != lastMonth || day != lastDay
+}
+
+// highlights makes the given user's highlights
+// if they're due, and emails them to the user if
+// they've chosen to get highlights by email.
+func (s *surface) highlights(
+	ctx context.Context,
+	instance *gtsmodel.Instance,
+	user *gtsmodel.User,
+	now time.Time,
+) error {
+	if !highlightsDue(user, now) {
+		return nil
+	}
+
+	statuses, interactions, err := s.highlightedStatuses(ctx, user, now)
+	if err != nil {
+		return err
+	}
+
+	// Store highlights even if there
+	// aren't any statuses in them, so
+	// that yesterday's highlights
+	// aren't shown again as today's.
+	highlights := &gtsmodel.Highlights{
+		ID:        id.NewULID(),
+		AccountID: user.AccountID,
+		StatusIDs: make([]string, len(statuses)),
+		Statuses:  statuses,
+	}
+	for i, status := range statuses {
+		highlights.StatusIDs[i] = status.ID
+	}
+
+	if err := s.state.DB.PutHighlights(ctx, highlights); err != nil {
+		return gtserror.Newf("db error putting highlights: %w", err)
+	}
+
+	columns := []string{"highlights_digest_at"}
+	user.HighlightsDigestAt = now
+
+	if user.HighlightsDigest == gtsmodel.HighlightsDigestEmail &&
+		len(statuses) != 0 && emailable(user) {
+		data := highlightsEmailData(instance, user)
+		for i, status := range statuses {
+			data.Statuses = append(data.Statuses, s.highlightedStatusFor(status, interactions[i]))
+		}
+
+		if err := s.emailSender.SendHighlightsEmail(user.Email, data); err != nil {
+			// Don't return, so that highlights
+			// aren't made again on the next run.
+			log.Errorf(ctx, "error emailing highlights: %v", err)
+		} else {
+			user.LastEmailedAt = now
+			columns = append(columns, "last_emailed_at")
+		}
+	}
+
+	if err := s.state.DB.UpdateUser(ctx, user, columns...); err != nil {
+		return gtserror.Newf("db error updating user: %w", err)
+	}
+
+	return nil
+}
+
+// highlightedStatuses returns the statuses from accounts
+// that the given user follows, posted in the highlightsPeriod
+// before now, that got the most interactions, along with
+// the number of interactions each got. Boosts, replies, and
+// statuses the user already faved or boosted are left out.
+func (s *surface) highlightedStatuses(
+	ctx context.Context,
+	user *gtsmodel.User,
+	now time.Time,
+) ([]*gtsmodel.Status, []int, error) {
+	account := user.Account
+	if account == nil {
+		var err error
+		account, err = s.state.DB.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			return nil, nil, gtserror.Newf("db error getting account: %w", err)
+		}
+	}
+
+	sinceID, err := id.NewULIDFromTime(now.Add(-highlightsPeriod))
+	if err != nil {
+		return nil, nil, gtserror.Newf("error creating since id: %w", err)
+	}
+
+	candidates, err := s.state.DB.GetHomeTimeline(
+		ctx,
+		account.ID,
+		"", sinceID, "",
+		highlightsCandidates,
+		false,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, nil, gtserror.Newf("db error getting home timeline: %w", err)
+	}
+
+	type scored struct {
+		status       *gtsmodel.Status
+		interactions int
+	}
+
+	highlighted := make([]scored, 0, len(candidates))
+	for _, status := range candidates {
+		if status.AccountID == account.ID ||
+			status.BoostOfID != "" ||
+			status.InReplyToID != "" {
+			// Own status, boost, or reply.
+			continue
+		}
+
+		timelineable, err := s.filter.StatusHomeTimelineable(ctx, account, status)
+		if err != nil {
+			log.Errorf(ctx, "error checking status %s visibility: %v", status.ID, err)
+			continue
+		}
+
+		if !timelineable {
+			continue
+		}
+
+		seen, err := s.interactedWith(ctx, account, status)
+		if err != nil {
+			log.Errorf(ctx, "error checking interactions with status %s: %v", status.ID, err)
+			continue
+		}
+
+		if seen {
+			// Not missed.
+			continue
+		}
+
+		interactions, err := s.countInteractions(ctx, status)
+		if err != nil {
+			log.Errorf(ctx, "error counting interactions with status %s: %v", status.ID, err)
+			continue
+		}
+
+		if interactions == 0 {
+			continue
+		}
+
+		highlighted = append(highlighted, scored{status, interactions})
+	}
+
+	// Most interacted first. Candidates are
+	// newest first, so ties stay that way.
+	sort.SliceStable(highlighted, func(i, j int) bool {
+		return highlighted[i].interactions > highlighted[j].interactions
+	})
+
+	if len(highlighted) > highlightsLimit {
+		highlighted = highlighted[:highlightsLimit]
+	}
+
+	var (
+		statuses     = make([]*gtsmodel.Status, len(highlighted))
+		interactions = make([]int, len(highlighted))
+	)
+	for i, h := range highlighted {
+		statuses[i] = h.status
+		interactions[i] = h.interactions
+	}
+
+	return statuses, interactions, nil
+}
+
+// interactedWith returns whether the given
+// account has faved or boosted the status.
+func (s *surface) interactedWith(ctx context.Context, account *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	faved, err := s.state.DB.IsStatusFavedBy(ctx, status.ID, account.ID)
+	if err != nil || faved {
+		return faved, err
+	}
+
+	return s.state.DB.IsStatusBoostedBy(ctx, status.ID, account.ID)
+}
+
+// countInteractions returns the total number of
+// faves, boosts, and replies the status has got.
+func (s *surface) countInteractions(ctx context.Context, status *gtsmodel.Status) (int, error) {
+	faves, err := s.state.DB.CountStatusFaves(ctx, status.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	boosts, err := s.state.DB.CountStatusBoosts(ctx, status.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	replies, err := s.state.DB.CountStatusReplies(ctx, status.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	return faves + boosts + replies, nil
+}
+
+// highlightedStatusFor converts the given
+// status to the form used in highlights emails.
+func (s *surface) highlightedStatusFor(status *gtsmodel.Status, interactions int) email.HighlightedStatus {
+	highlighted := email.HighlightedStatus{
+		StatusURL:    status.URL,
+		Interactions: interactions,
+	}
+
+	if highlighted.StatusURL == "" {
+		highlighted.StatusURL = status.URI
+	}
+
+	if status.Account != nil {
+		highlighted.Account = "@" + status.Account.Username
+		if status.Account.Domain != "" {
+			highlighted.Account += "@" + status.Account.Domain
+		}
+	}
+
+	// Don't put what's behind a content
+	// warning in the email, just the warning.
+	if status.ContentWarning != "" {
+		highlighted.StatusText = "[" + text.SanitizeToPlaintext(status.ContentWarning) + "]"
+	} else {
+		highlighted.StatusText = text.SanitizeToPlaintext(status.Content)
+	}
+
+	if r := []rune(highlighted.StatusText); len(r) > emailStatusTextLength {
+		highlighted.StatusText = string(r[:emailStatusTextLength]) + "…"
+	}
+
+	return highlighted
+}
+
+// highlightsEmailData returns highlights email
+// data addressed to the given user, without any
+// statuses set on it yet.
+func highlightsEmailData(instance *gtsmodel.Instance, user *gtsmodel.User) email.HighlightsData {
+	var username string
+	if user.Account != nil {
+		username = user.Account.Username
+	}
+
+	return email.HighlightsData{
+		Username:     username,
+		InstanceURL:  instance.URI,
+		InstanceName: instance.Title,
+		SettingsURL:  instance.URI + "/settings/user/settings",
+		Locale:       user.Locale,
+	}
+}
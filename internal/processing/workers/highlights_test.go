@@ -0,0 +1,129 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type HighlightsTestSuite struct {
+	WorkersTestSuite
+}
+
+// setHighlights turns on highlights for local_account_1
+// in the Europe/Amsterdam time zone, with the last ones
+// made at the given time.
+func (suite *HighlightsTestSuite) setHighlights(ctx context.Context, madeAt time.Time) *gtsmodel.User {
+	user := new(gtsmodel.User)
+	*user = *suite.testUsers["local_account_1"]
+	user.TimeZone = "Europe/Amsterdam"
+	user.HighlightsDigest = gtsmodel.HighlightsDigestDaily
+	user.HighlightsDigestAt = madeAt
+
+	if err := suite.db.UpdateUser(ctx, user, "time_zone", "highlights_digest", "highlights_digest_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return user
+}
+
+// amsterdam returns the given time of day
+// on 1 June 2023 in Europe/Amsterdam.
+func (suite *HighlightsTestSuite) amsterdam(hour int) time.Time {
+	loc, err := time.LoadLocation("Europe/Amsterdam")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return time.Date(2023, time.June, 1, hour, 0, 0, 0, loc)
+}
+
+func (suite *HighlightsTestSuite) TestHighlights() {
+	var (
+		ctx  = context.Background()
+		now  = suite.amsterdam(9)
+		user = suite.setHighlights(ctx, now.Add(-24*time.Hour))
+	)
+
+	if err := suite.processor.Workers().Highlights(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	highlights, err := suite.db.GetHighlightsByAccountID(ctx, user.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(user.AccountID, highlights.AccountID)
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(now, dbUser.HighlightsDigestAt, time.Second)
+
+	// Highlights weren't chosen to be emailed.
+	suite.Empty(suite.sentEmails)
+}
+
+func (suite *HighlightsTestSuite) TestHighlightsTooEarly() {
+	var (
+		ctx  = context.Background()
+		now  = suite.amsterdam(7)
+		user = suite.setHighlights(ctx, now.Add(-24*time.Hour))
+	)
+
+	if err := suite.processor.Workers().Highlights(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// It's before 08:00 in Amsterdam,
+	// even though it's later in UTC.
+	_, err := suite.db.GetHighlightsByAccountID(ctx, user.AccountID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *HighlightsTestSuite) TestHighlightsAlreadyMadeToday() {
+	var (
+		ctx    = context.Background()
+		now    = suite.amsterdam(20)
+		madeAt = suite.amsterdam(8)
+		user   = suite.setHighlights(ctx, madeAt)
+	)
+
+	if err := suite.processor.Workers().Highlights(ctx, now); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetHighlightsByAccountID(ctx, user.AccountID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	dbUser, err := suite.db.GetUserByID(ctx, user.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.WithinDuration(madeAt, dbUser.HighlightsDigestAt, time.Second)
+}
+
+func TestHighlightsTestSuite(t *testing.T) {
+	suite.Run(t, &HighlightsTestSuite{})
+}
@@ -0,0 +1,141 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package workers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/workers"
+)
+
+// queued is a batch of encoded messages persisted
+// in a workers.Queue, enqueued together and so
+// processed in order, along with the ID of the
+// request which enqueued them, for logging.
+type queued struct {
+	RequestID string            `json:"request_id,omitempty"`
+	Msgs      []json.RawMessage `json:"msgs"`
+}
+
+// pushQueue encodes and pushes the batch of messages to the
+// queue, returning false if this failed for any reason, in
+// which case the caller should queue them in memory instead.
+func pushQueue[T interface{ Encode() ([]byte, error) }](
+	ctx context.Context,
+	queue workers.Queue,
+	msgs []T,
+) bool {
+	batch := queued{
+		RequestID: gtscontext.RequestID(ctx),
+		Msgs:      make([]json.RawMessage, 0, len(msgs)),
+	}
+
+	for _, msg := range msgs {
+		b, err := msg.Encode()
+		if err != nil {
+			log.Warnf(ctx, "couldn't encode message for persistent queue, queueing in memory: %v", err)
+			return false
+		}
+		batch.Msgs = append(batch.Msgs, b)
+	}
+
+	b, err := json.Marshal(batch)
+	if err != nil {
+		log.Warnf(ctx, "couldn't encode messages for persistent queue, queueing in memory: %v", err)
+		return false
+	}
+
+	if err := queue.Push(ctx, b); err != nil {
+		log.Errorf(ctx, "couldn't push messages to persistent queue, queueing in memory: %v", err)
+		return false
+	}
+
+	return true
+}
+
+// toPtrs returns pointers to each of the given values, as the
+// message Encode() methods are defined on the pointer types.
+func toPtrs[T any](values []T) []*T {
+	ptrs := make([]*T, len(values))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	return ptrs
+}
+
+// popQueue decodes a batch of messages pushed to a queue by pushQueue,
+// returning a context carrying the ID of the request which enqueued it.
+func popQueue(ctx context.Context, data []byte) (context.Context, []json.RawMessage, bool) {
+	var batch queued
+	if err := json.Unmarshal(data, &batch); err != nil {
+		log.Errorf(ctx, "invalid message batch in persistent queue: %v", err)
+		return ctx, nil, false
+	}
+
+	if batch.RequestID != "" {
+		ctx = gtscontext.SetRequestID(ctx, batch.RequestID)
+	}
+
+	return ctx, batch.Msgs, true
+}
+
+// ProcessQueuedClientAPI processes a batch of client API
+// messages pushed to the persistent ClientAPIQueue.
+func (p *Processor) ProcessQueuedClientAPI(ctx context.Context, data []byte) {
+	ctx, msgs, ok := popQueue(ctx, data)
+	if !ok {
+		return
+	}
+
+	for _, b := range msgs {
+		var msg messages.FromClientAPI
+		if err := msg.Decode(ctx, b, p.clientAPI.state.DB.GetAccountByID); err != nil {
+			log.Errorf(ctx, "error decoding queued client API message: %v", err)
+			continue
+		}
+
+		if err := p.ProcessFromClientAPI(ctx, msg); err != nil {
+			log.Errorf(ctx, "error processing client API message: %v", err)
+		}
+	}
+}
+
+// ProcessQueuedFediAPI processes a batch of fedi API
+// messages pushed to the persistent FediAPIQueue.
+func (p *Processor) ProcessQueuedFediAPI(ctx context.Context, data []byte) {
+	ctx, msgs, ok := popQueue(ctx, data)
+	if !ok {
+		return
+	}
+
+	for _, b := range msgs {
+		var msg messages.FromFediAPI
+		if err := msg.Decode(ctx, b, p.fediAPI.state.DB.GetAccountByID); err != nil {
+			log.Errorf(ctx, "error decoding queued fedi API message: %v", err)
+			continue
+		}
+
+		if err := p.ProcessFromFediAPI(ctx, msg); err != nil {
+			log.Errorf(ctx, "error processing fedi API message: %v", err)
+		}
+	}
+}
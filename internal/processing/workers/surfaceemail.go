@@ -28,6 +28,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/email"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -71,15 +72,8 @@ func (s *surface) emailReportClosed(ctx context.Context, report *gtsmodel.Report
 		return gtserror.Newf("db error getting user: %w", err)
 	}
 
-	if user.ConfirmedAt.IsZero() ||
-		!*user.Approved ||
-		*user.Disabled ||
-		user.Email == "" {
-		// Only email users who:
-		// - are confirmed
-		// - are approved
-		// - are not disabled
-		// - have an email address
+	if !emailable(user) {
+		// Nothing to do.
 		return nil
 	}
 
@@ -99,6 +93,7 @@ func (s *surface) emailReportClosed(ctx context.Context, report *gtsmodel.Report
 		ReportTargetUsername: report.TargetAccount.Username,
 		ReportTargetDomain:   report.TargetAccount.Domain,
 		ActionTakenComment:   report.ActionTaken,
+		Locale:               user.Locale,
 	}
 
 	return s.emailSender.SendReportClosedEmail(user.Email, reportClosedData)
@@ -134,6 +129,7 @@ func (s *surface) emailPleaseConfirm(ctx context.Context, user *gtsmodel.User, u
 			InstanceURL:  instance.URI,
 			InstanceName: instance.Title,
 			ConfirmLink:  confirmLink,
+			Locale:       user.Locale,
 		},
 	); err != nil {
 		return err
@@ -158,3 +154,146 @@ func (s *surface) emailPleaseConfirm(ctx context.Context, user *gtsmodel.User, u
 
 	return nil
 }
+
+// emailNotification emails the target of the given
+// notification about it, if they've chosen to get
+// emails for notifications as they happen, and it's
+// a notification type that can be emailed.
+func (s *surface) emailNotification(ctx context.Context, notif *gtsmodel.Notification) error {
+	if _, ok := emailNotificationTypes[notif.NotificationType]; !ok {
+		// Not emailed.
+		return nil
+	}
+
+	user, err := s.state.DB.GetUserByAccountID(ctx, notif.TargetAccountID)
+	if err != nil {
+		return gtserror.Newf("db error getting user: %w", err)
+	}
+
+	if user.EmailNotifications != gtsmodel.EmailNotificationsImmediate ||
+		!emailable(user) {
+		// Nothing to do.
+		return nil
+	}
+
+	instance, err := s.state.DB.GetInstance(ctx, config.GetHost())
+	if err != nil {
+		return gtserror.Newf("db error getting instance: %w", err)
+	}
+
+	emailNotif, err := s.emailNotificationFor(ctx, notif)
+	if err != nil {
+		return err
+	}
+
+	data := notificationEmailData(instance, user)
+	data.Notifications = []email.Notification{emailNotif}
+
+	if err := s.emailSender.SendNotificationEmail(user.Email, data); err != nil {
+		return gtserror.Newf("error emailing notification: %w", err)
+	}
+
+	user.LastEmailedAt = time.Now()
+	if err := s.state.DB.UpdateUser(ctx, user, "last_emailed_at"); err != nil {
+		return gtserror.Newf("db error updating user: %w", err)
+	}
+
+	return nil
+}
+
+// emailNotificationTypes are the types of
+// notification that may be emailed to users.
+var emailNotificationTypes = map[gtsmodel.NotificationType]string{
+	gtsmodel.NotificationFollow:        email.NotificationFollow,
+	gtsmodel.NotificationFollowRequest: email.NotificationFollowRequest,
+	gtsmodel.NotificationMention:       email.NotificationMention,
+}
+
+// emailNotificationFor converts the given notification
+// to the form used in notification and digest emails.
+func (s *surface) emailNotificationFor(ctx context.Context, notif *gtsmodel.Notification) (email.Notification, error) {
+	account, err := s.state.DB.GetAccountByID(ctx, notif.OriginAccountID)
+	if err != nil {
+		return email.Notification{}, gtserror.Newf("db error getting origin account %s: %w", notif.OriginAccountID, err)
+	}
+
+	emailNotif := email.Notification{
+		Type:       emailNotificationTypes[notif.NotificationType],
+		Account:    "@" + account.Username,
+		AccountURL: account.URL,
+	}
+
+	if account.Domain != "" {
+		emailNotif.Account += "@" + account.Domain
+	}
+
+	if emailNotif.AccountURL == "" {
+		emailNotif.AccountURL = account.URI
+	}
+
+	if notif.StatusID == "" {
+		// Not a mention.
+		return emailNotif, nil
+	}
+
+	status, err := s.state.DB.GetStatusByID(ctx, notif.StatusID)
+	if err != nil {
+		return email.Notification{}, gtserror.Newf("db error getting status %s: %w", notif.StatusID, err)
+	}
+
+	emailNotif.StatusURL = status.URL
+	if emailNotif.StatusURL == "" {
+		emailNotif.StatusURL = status.URI
+	}
+
+	// Don't put what's behind a content
+	// warning in the email, just the warning.
+	if status.ContentWarning != "" {
+		emailNotif.StatusText = "[" + text.SanitizeToPlaintext(status.ContentWarning) + "]"
+	} else {
+		emailNotif.StatusText = text.SanitizeToPlaintext(status.Content)
+	}
+
+	if r := []rune(emailNotif.StatusText); len(r) > emailStatusTextLength {
+		emailNotif.StatusText = string(r[:emailStatusTextLength]) + "…"
+	}
+
+	return emailNotif, nil
+}
+
+// emailStatusTextLength is the maximum number of
+// characters of a mentioning status to include
+// in notification and digest emails.
+const emailStatusTextLength = 500
+
+// notificationEmailData returns notification email
+// data addressed to the given user, without any
+// notifications set on it yet.
+func notificationEmailData(instance *gtsmodel.Instance, user *gtsmodel.User) email.NotificationData {
+	var username string
+	if user.Account != nil {
+		username = user.Account.Username
+	}
+
+	return email.NotificationData{
+		Username:     username,
+		InstanceURL:  instance.URI,
+		InstanceName: instance.Title,
+		SettingsURL:  instance.URI + "/settings/user/settings",
+		Locale:       user.Locale,
+	}
+}
+
+// emailable returns whether we
+// should send emails to the user.
+func emailable(user *gtsmodel.User) bool {
+	// Only email users who:
+	// - are confirmed
+	// - are approved
+	// - are not disabled
+	// - have an email address
+	return !user.ConfirmedAt.IsZero() &&
+		*user.Approved &&
+		!*user.Disabled &&
+		user.Email != ""
+}
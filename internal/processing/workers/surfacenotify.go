@@ -20,6 +20,7 @@ package workers
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
@@ -37,6 +38,19 @@ func (s *surface) notifyMentions(
 	errs := gtserror.NewMultiError(len(mentions))
 
 	for _, mention := range mentions {
+		// Hold the mention for review
+		// instead, if it's (part of) a
+		// first interaction, see below.
+		held, err := s.holdFirstInteraction(ctx, mention)
+		if err != nil {
+			errs.Append(err)
+			continue
+		}
+
+		if held {
+			continue
+		}
+
 		if err := s.notify(
 			ctx,
 			gtsmodel.NotificationMention,
@@ -51,6 +65,152 @@ func (s *surface) notifyMentions(
 	return errs.Combine()
 }
 
+// holdFirstInteraction checks whether the given mention is (part of) the
+// first interaction of a remote account with a local account that wants
+// to review first interactions: that is, the accounts don't follow each
+// other either way, and the local account hasn't approved the remote one.
+//
+// If so, the mentioning status is added to the pending first interaction,
+// which is created if necessary, and true is returned to indicate that
+// the local account shouldn't be notified of the mention for now.
+func (s *surface) holdFirstInteraction(
+	ctx context.Context,
+	mention *gtsmodel.Mention,
+) (bool, error) {
+	originAccount, err := s.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		mention.OriginAccountID,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error getting origin account %s: %w", mention.OriginAccountID, err)
+	}
+
+	targetAccount, err := s.state.DB.GetAccountByID(
+		gtscontext.SetBarebones(ctx),
+		mention.TargetAccountID,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error getting target account %s: %w", mention.TargetAccountID, err)
+	}
+
+	if originAccount.IsLocal() || !targetAccount.IsLocal() {
+		// Only mentions of local accounts
+		// by remote accounts are held.
+		return false, nil
+	}
+
+	user, err := s.state.DB.GetUserByAccountID(ctx, targetAccount.ID)
+	if err != nil {
+		return false, gtserror.Newf("error getting user of account %s: %w", targetAccount.ID, err)
+	}
+
+	if !*user.ReviewFirstInteractions {
+		// User doesn't want to review.
+		return false, nil
+	}
+
+	firstInteraction, err := s.state.DB.GetFirstInteraction(
+		gtscontext.SetBarebones(ctx),
+		targetAccount.ID,
+		originAccount.ID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return false, gtserror.Newf("error getting first interaction: %w", err)
+	}
+
+	if firstInteraction != nil {
+		if !firstInteraction.Pending() {
+			// Origin account was
+			// approved already.
+			return false, nil
+		}
+
+		if !slices.Contains(firstInteraction.StatusIDs, mention.StatusID) {
+			// Hold this status too, along
+			// with any others held already.
+			firstInteraction.StatusIDs = append(firstInteraction.StatusIDs, mention.StatusID)
+			if err := s.state.DB.UpdateFirstInteraction(ctx, firstInteraction, "statuses"); err != nil {
+				return false, gtserror.Newf("error updating first interaction: %w", err)
+			}
+		}
+
+		return true, nil
+	}
+
+	// Accounts that follow each other, either
+	// way round, have interacted before.
+	for _, ids := range [][2]string{
+		{targetAccount.ID, originAccount.ID},
+		{originAccount.ID, targetAccount.ID},
+	} {
+		following, err := s.state.DB.IsFollowing(ctx, ids[0], ids[1])
+		if err != nil {
+			return false, gtserror.Newf("error checking follow: %w", err)
+		}
+
+		if following {
+			return false, nil
+		}
+	}
+
+	// This is a first interaction; hold
+	// the status until it's reviewed.
+	firstInteraction = &gtsmodel.FirstInteraction{
+		ID:              id.NewULID(),
+		AccountID:       targetAccount.ID,
+		OriginAccountID: originAccount.ID,
+		StatusIDs:       []string{mention.StatusID},
+	}
+
+	if err := s.state.DB.PutFirstInteraction(ctx, firstInteraction); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Another mention from the same account
+			// raced us here; hold this one alongside.
+			return s.holdFirstInteraction(ctx, mention)
+		}
+		return false, gtserror.Newf("error putting first interaction: %w", err)
+	}
+
+	return true, nil
+}
+
+// notifyFirstInteraction notifies the account of the given
+// approved first interaction of the mentions that were held
+// for review, as if they'd only just been received. The
+// first interaction's StatusIDs should be those that were held.
+func (s *surface) notifyFirstInteraction(
+	ctx context.Context,
+	firstInteraction *gtsmodel.FirstInteraction,
+) error {
+	errs := gtserror.NewMultiError(len(firstInteraction.StatusIDs))
+
+	for _, statusID := range firstInteraction.StatusIDs {
+		// Make sure the held status still exists;
+		// its author might have deleted it since.
+		if _, err := s.state.DB.GetStatusByID(
+			gtscontext.SetBarebones(ctx),
+			statusID,
+		); err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				errs.Appendf("error getting status %s: %w", statusID, err)
+			}
+			continue
+		}
+
+		if err := s.notify(
+			ctx,
+			gtsmodel.NotificationMention,
+			firstInteraction.AccountID,
+			firstInteraction.OriginAccountID,
+			statusID,
+		); err != nil {
+			errs.Append(err)
+		}
+	}
+
+	return errs.Combine()
+}
+
 // notifyFollowRequest notifies the target of the given
 // follow request that they have a new follow request.
 func (s *surface) notifyFollowRequest(
@@ -148,6 +308,51 @@ func (s *surface) notifyAnnounce(
 	)
 }
 
+// notifyStatus notifies the owner of the given follow
+// that the followed account has posted the given status,
+// if the follower subscribed to posts from this account
+// by setting notify on the follow.
+//
+// In line with Mastodon, boosts and replies to other
+// accounts don't trigger a notification, but replies
+// to the author's own statuses (ie., threads) do.
+func (s *surface) notifyStatus(
+	ctx context.Context,
+	follow *gtsmodel.Follow,
+	status *gtsmodel.Status,
+) error {
+	if follow.Notify == nil || !*follow.Notify {
+		// This follower doesn't have notifs
+		// set for this account's new posts.
+		return nil
+	}
+
+	if follow.AccountID == status.AccountID {
+		// Own status, nothing to do.
+		return nil
+	}
+
+	if status.BoostOfID != "" {
+		// Don't notify for boosts.
+		return nil
+	}
+
+	if status.InReplyToURI != "" &&
+		status.InReplyToAccountID != status.AccountID {
+		// Don't notify for replies
+		// to other accounts.
+		return nil
+	}
+
+	return s.notify(
+		ctx,
+		gtsmodel.NotificationStatus,
+		follow.AccountID,
+		status.AccountID,
+		status.ID,
+	)
+}
+
 // notify creates, inserts, and streams a new
 // notification to the target account if it
 // doesn't yet exist with the given parameters.
@@ -176,6 +381,18 @@ func (s *surface) notify(
 		return nil
 	}
 
+	// Make sure target hasn't muted
+	// notifications from origin account.
+	muted, err := s.filter.NotificationsMuted(ctx, targetAccount, originAccountID)
+	if err != nil {
+		return gtserror.Newf("error checking notification mute: %w", err)
+	}
+
+	if muted {
+		// Nothing to do.
+		return nil
+	}
+
 	// Make sure a notification doesn't
 	// already exist with these params.
 	if _, err := s.state.DB.GetNotification(
@@ -217,5 +434,10 @@ func (s *surface) notify(
 		return gtserror.Newf("error streaming notification to account: %w", err)
 	}
 
+	// Email notification to the user, if they want that.
+	if err := s.emailNotification(ctx, notif); err != nil {
+		return gtserror.Newf("error emailing notification to account: %w", err)
+	}
+
 	return nil
 }
@@ -66,6 +66,14 @@ func (s *surface) timelineAndNotifyStatus(ctx context.Context, status *gtsmodel.
 		return gtserror.Newf("error timelining status %s for followers: %w", status.ID, err)
 	}
 
+	// Add the status to any lists which have a
+	// rule matching its author or one of its tags.
+	//
+	// Errors here shouldn't prevent mention notifs.
+	if err := s.listTimelineStatusForRules(ctx, status); err != nil {
+		log.Errorf(ctx, "error timelining status %s for list rules: %v", status.ID, err)
+	}
+
 	// Notify each local account that's mentioned by this status.
 	if err := s.notifyMentions(ctx, status.Mentions); err != nil {
 		return gtserror.Newf("error notifying status mentions for status %s: %w", status.ID, err)
@@ -84,11 +92,7 @@ func (s *surface) timelineAndNotifyStatusForFollowers(
 	status *gtsmodel.Status,
 	follows []*gtsmodel.Follow,
 ) error {
-	var (
-		errs  = new(gtserror.MultiError)
-		boost = status.BoostOfID != ""
-		reply = status.InReplyToURI != ""
-	)
+	errs := new(gtserror.MultiError)
 
 	for _, follow := range follows {
 		// Check to see if the status is timelineable for this follower,
@@ -98,6 +102,9 @@ func (s *surface) timelineAndNotifyStatusForFollowers(
 		// If it's not timelineable, we can just stop early, since lists
 		// are prettymuch subsets of the home timeline, so if it shouldn't
 		// appear there, it shouldn't appear in lists either.
+		//
+		// Likewise, a status that's filtered from the home timeline
+		// of this follower shouldn't generate a notification for them.
 		timelineable, err := s.filter.StatusHomeTimelineable(
 			ctx, follow.Account, status,
 		)
@@ -122,51 +129,25 @@ func (s *surface) timelineAndNotifyStatusForFollowers(
 
 		// Add status to home timeline for owner
 		// of this follow, if applicable.
-		homeTimelined, err := s.timelineStatus(
+		if _, err := s.timelineStatus(
 			ctx,
 			s.state.Timelines.Home.IngestOne,
 			follow.AccountID, // home timelines are keyed by account ID
 			follow.Account,
 			status,
 			stream.TimelineHome,
-		)
-		if err != nil {
+		); err != nil {
 			errs.Appendf("error home timelining status: %w", err)
-			continue
-		}
-
-		if !homeTimelined {
-			// If status wasn't added to home
-			// timeline, we shouldn't notify it.
-			continue
-		}
-
-		if !*follow.Notify {
-			// This follower doesn't have notifs
-			// set for this account's new posts.
-			continue
-		}
-
-		if boost || reply {
-			// Don't notify for boosts or replies.
-			continue
 		}
 
-		// If we reach here, we know:
+		// Notify the owner of this follow of
+		// the new status, if they asked for it.
 		//
-		//   - This status is hometimelineable.
-		//   - This status was added to the home timeline for this follower.
-		//   - This follower wants to be notified when this account posts.
-		//   - This is a top-level post (not a reply or boost).
-		//
-		// That means we can officially notify this one.
-		if err := s.notify(
-			ctx,
-			gtsmodel.NotificationStatus,
-			follow.AccountID,
-			status.AccountID,
-			status.ID,
-		); err != nil {
+		// This doesn't depend on the status being
+		// freshly inserted into the home timeline,
+		// since it may already have been indexed
+		// there (eg., via a list or another path).
+		if err := s.notifyStatus(ctx, follow, status); err != nil {
 			errs.Appendf("error notifying account %s about new status: %w", follow.AccountID, err)
 		}
 	}
@@ -230,6 +211,84 @@ func (s *surface) listTimelineStatusForFollow(
 	}
 }
 
+// listTimelineStatusForRules puts the given status
+// in any eligible lists which have a rule matching
+// either the status author, or one of its tags.
+func (s *surface) listTimelineStatusForRules(
+	ctx context.Context,
+	status *gtsmodel.Status,
+) error {
+	// Tag rules don't apply to boosts, only to
+	// the original status, and not to tags that
+	// have been banned on this instance.
+	var tagIDs []string
+	if status.BoostOfID == "" {
+		tagIDs = s.listableTagIDs(ctx, status)
+	}
+
+	// Get every list rule that matches this status, from any list.
+	// Both columns are indexed, so this is one cheap lookup no
+	// matter how many lists + rules exist on the instance.
+	listRules, err := s.state.DB.GetListRulesForTargets(
+		gtscontext.SetBarebones(ctx),
+		status.AccountID,
+		tagIDs,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.Newf("error getting list rules: %w", err)
+	}
+
+	var (
+		errs    = new(gtserror.MultiError)
+		listIDs = make(map[string]struct{}, len(listRules))
+	)
+
+	for _, listRule := range listRules {
+		if _, done := listIDs[listRule.ListID]; done {
+			// Several rules of the same list
+			// matched, only timeline it once.
+			continue
+		}
+		listIDs[listRule.ListID] = struct{}{}
+
+		list, err := s.state.DB.GetListByID(ctx, listRule.ListID)
+		if err != nil {
+			errs.Appendf("error getting list %s: %w", listRule.ListID, err)
+			continue
+		}
+
+		timelineable, err := s.filter.StatusListRuleTimelineable(
+			ctx, list.Account, list, status,
+		)
+		if err != nil {
+			errs.Appendf("error checking list rule eligibility: %w", err)
+			continue
+		}
+
+		if !timelineable {
+			// Don't add this.
+			continue
+		}
+
+		// If the status was already added to this list
+		// through one of its follow entries, ingest will
+		// just do nothing, so it won't be streamed twice.
+		if _, err := s.timelineStatus(
+			ctx,
+			s.state.Timelines.List.IngestOne,
+			list.ID, // list timelines are keyed by list ID
+			list.Account,
+			status,
+			stream.TimelineList+":"+list.ID, // key streamType to this specific list
+		); err != nil {
+			errs.Appendf("error adding status to timeline for list %s: %w", list.ID, err)
+			// implicit continue
+		}
+	}
+
+	return errs.Combine()
+}
+
 // listEligible checks if the given status is eligible
 // for inclusion in the list that that the given listEntry
 // belongs to, based on the replies policy of the list.
@@ -372,6 +431,31 @@ func (s *surface) deleteStatusFromTimelines(ctx context.Context, statusID string
 	return s.stream.Delete(statusID)
 }
 
+// listableTagIDs returns the IDs of those tags of
+// the given status which may be listed on this
+// instance, ie., which haven't been banned.
+func (s *surface) listableTagIDs(ctx context.Context, status *gtsmodel.Status) []string {
+	tags := status.Tags
+	if len(tags) != len(status.TagIDs) {
+		var err error
+		tags, err = s.state.DB.GetTags(ctx, status.TagIDs)
+		if err != nil {
+			log.Errorf(ctx, "error getting tags for status %s: %v", status.ID, err)
+			return nil
+		}
+	}
+
+	tagIDs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if tag.Listable != nil && !*tag.Listable {
+			continue
+		}
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	return tagIDs
+}
+
 // invalidateStatusFromTimelines does cache invalidation on the given status by
 // unpreparing it from all timelines, forcing it to be prepared again (with updated
 // stats, boost counts, etc) next time it's fetched by the timeline owner. This goes
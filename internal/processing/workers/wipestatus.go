@@ -85,6 +85,16 @@ func wipeStatusF(state *state.State, media *media.Processor, surface *surface) w
 			errs.Appendf("error deleting status faves: %w", err)
 		}
 
+		// delete all reactions to this status
+		if err := state.DB.DeleteStatusReactionsForStatus(ctx, statusToDelete.ID); err != nil {
+			errs.Appendf("error deleting status reactions: %w", err)
+		}
+
+		// delete any spam filter quarantine entry for this status
+		if err := state.DB.DeleteQuarantinedStatusByStatusID(ctx, statusToDelete.ID); err != nil {
+			errs.Appendf("error deleting status quarantine entry: %w", err)
+		}
+
 		// delete all boosts for this status + remove them from timelines
 		boosts, err := state.DB.GetStatusBoosts(
 			// we MUST set a barebones context here,
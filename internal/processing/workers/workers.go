@@ -33,6 +33,7 @@ type Processor struct {
 	workers   *workers.Workers
 	clientAPI *clientAPI
 	fediAPI   *fediAPI
+	surface   *surface
 }
 
 func New(
@@ -73,6 +74,7 @@ func New(
 
 	return Processor{
 		workers: &state.Workers,
+		surface: surface,
 		clientAPI: &clientAPI{
 			state:      state,
 			converter:  converter,
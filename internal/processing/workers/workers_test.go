@@ -50,6 +50,7 @@ type WorkersTestSuite struct {
 	federator           *federation.Federator
 	oauthServer         oauth.Server
 	emailSender         email.Sender
+	sentEmails          map[string]string
 
 	// standard suite models
 	testTokens       map[string]*gtsmodel.Token
@@ -122,7 +123,8 @@ func (suite *WorkersTestSuite) SetupTest() {
 	suite.mediaManager = testrig.NewTestMediaManager(&suite.state)
 	suite.federator = testrig.NewTestFederator(&suite.state, suite.transportController, suite.mediaManager)
 	suite.oauthServer = testrig.NewTestOauthServer(suite.db)
-	suite.emailSender = testrig.NewEmailSender("../../../web/template/", nil)
+	suite.sentEmails = make(map[string]string)
+	suite.emailSender = testrig.NewEmailSender("../../../web/template/", suite.sentEmails)
 
 	suite.processor = processing.NewProcessor(suite.typeconverter, suite.federator, suite.oauthServer, suite.mediaManager, &suite.state, suite.emailSender)
 	suite.state.Workers.EnqueueClientAPI = suite.processor.Workers().EnqueueClientAPI
@@ -146,7 +148,7 @@ func (suite *WorkersTestSuite) openStreams(ctx context.Context, account *gtsmode
 		stream.TimelinePublic,
 		stream.TimelineNotifications,
 	} {
-		stream, err := suite.processor.Stream().Open(ctx, account, streamType)
+		stream, err := suite.processor.Stream().Open(ctx, account, streamType, "")
 		if err != nil {
 			suite.FailNow(err.Error())
 		}
@@ -157,7 +159,7 @@ func (suite *WorkersTestSuite) openStreams(ctx context.Context, account *gtsmode
 	for _, listID := range listIDs {
 		streamType := stream.TimelineList + ":" + listID
 
-		stream, err := suite.processor.Stream().Open(ctx, account, streamType)
+		stream, err := suite.processor.Stream().Open(ctx, account, streamType, "")
 		if err != nil {
 			suite.FailNow(err.Error())
 		}
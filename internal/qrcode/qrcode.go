@@ -0,0 +1,570 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package qrcode
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrTooLong is returned when data is too long
+// to fit in the largest supported QR code version.
+var ErrTooLong = errors.New("qrcode: data too long")
+
+// Code is an encoded QR code symbol.
+type Code struct {
+	// Size is the width and height of
+	// the symbol in modules (without any
+	// quiet zone around it).
+	Size int
+
+	modules    [][]bool // dark modules, indexed [y][x]
+	isFunction [][]bool // function pattern modules, indexed [y][x]
+}
+
+// Dark returns whether the module at x, y is dark.
+// Coordinates outside of the symbol are always light.
+func (c *Code) Dark(x, y int) bool {
+	if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+		return false
+	}
+	return c.modules[y][x]
+}
+
+// version contains the block layout for
+// one QR code version, at error correction
+// level M, which is all we encode at.
+type version struct {
+	ecPerBlock int   // EC codewords per block
+	blocks     []int // data codewords per block
+	alignment  []int // alignment pattern center positions
+}
+
+// versions 1 to 10 at error correction level M, which
+// allows up to 213 bytes; plenty for a profile URL.
+var versions = []version{
+	{10, []int{16}, nil},
+	{16, []int{28}, []int{6, 18}},
+	{26, []int{44}, []int{6, 22}},
+	{18, []int{32, 32}, []int{6, 26}},
+	{24, []int{43, 43}, []int{6, 30}},
+	{16, []int{27, 27, 27, 27}, []int{6, 34}},
+	{18, []int{31, 31, 31, 31}, []int{6, 22, 38}},
+	{22, []int{38, 38, 39, 39}, []int{6, 24, 42}},
+	{22, []int{36, 36, 36, 37, 37}, []int{6, 26, 46}},
+	{26, []int{43, 43, 43, 43, 44}, []int{6, 28, 50}},
+}
+
+// dataCodewords returns the total number of data codewords.
+func (v *version) dataCodewords() int {
+	n := 0
+	for _, b := range v.blocks {
+		n += b
+	}
+	return n
+}
+
+// Encode encodes the given data as a QR code, in byte
+// mode at error correction level M, using the smallest
+// version that fits, and the mask with lowest penalty.
+func Encode(data []byte) (*Code, error) {
+	// Find smallest version that fits the data.
+	ver := -1
+	for i := range versions {
+		if 4+countBits(i+1)+8*len(data) <= 8*versions[i].dataCodewords() {
+			ver = i + 1
+			break
+		}
+	}
+	if ver == -1 {
+		return nil, fmt.Errorf("%w: %d bytes", ErrTooLong, len(data))
+	}
+	v := &versions[ver-1]
+
+	// Encode the data as codewords,
+	// then append error correction.
+	codewords := interleave(v, encodeData(v, ver, data))
+
+	c := newCode(ver)
+	c.drawFunctionPatterns(v, ver)
+	c.drawCodewords(codewords)
+
+	// Try each mask, keep the best one.
+	best, bestPenalty := 0, -1
+	for mask := 0; mask < 8; mask++ {
+		c.applyMask(mask)
+		c.drawFormatBits(mask)
+		if p := c.penalty(); bestPenalty == -1 || p < bestPenalty {
+			best, bestPenalty = mask, p
+		}
+		c.applyMask(mask) // masking is its own inverse
+	}
+	c.applyMask(best)
+	c.drawFormatBits(best)
+
+	return c, nil
+}
+
+// countBits returns the length of the byte
+// mode character count indicator for version.
+func countBits(ver int) int {
+	if ver < 10 {
+		return 8
+	}
+	return 16
+}
+
+// encodeData returns the data codewords for data:
+// mode indicator, count, data, terminator, padding.
+func encodeData(v *version, ver int, data []byte) []byte {
+	var bb bitBuffer
+	bb.append(0b0100, 4) // byte mode
+	bb.append(uint32(len(data)), countBits(ver))
+	for _, b := range data {
+		bb.append(uint32(b), 8)
+	}
+
+	capacity := 8 * v.dataCodewords()
+
+	// Terminator of up to four zero bits,
+	// then zero pad up to a byte boundary.
+	bb.append(0, min(4, capacity-bb.len))
+	bb.append(0, (8-bb.len%8)%8)
+
+	// Alternating pad bytes fill the rest.
+	for pad := uint32(0xEC); bb.len < capacity; pad ^= 0xEC ^ 0x11 {
+		bb.append(pad, 8)
+	}
+
+	return bb.bytes
+}
+
+// interleave splits the data into blocks, computes the error
+// correction for each, and interleaves them as the final sequence.
+func interleave(v *version, data []byte) []byte {
+	var (
+		divisor = rsDivisor(v.ecPerBlock)
+		blocks  = make([][]byte, len(v.blocks))
+		ecs     = make([][]byte, len(v.blocks))
+		maxLen  = 0
+	)
+
+	for i, n := range v.blocks {
+		blocks[i], data = data[:n], data[n:]
+		ecs[i] = rsRemainder(blocks[i], divisor)
+		maxLen = max(maxLen, n)
+	}
+
+	var out []byte
+	for i := 0; i < maxLen; i++ {
+		for _, block := range blocks {
+			if i < len(block) {
+				out = append(out, block[i])
+			}
+		}
+	}
+	for i := 0; i < v.ecPerBlock; i++ {
+		for _, ec := range ecs {
+			out = append(out, ec[i])
+		}
+	}
+
+	return out
+}
+
+// bitBuffer is a big-endian sequence of bits.
+type bitBuffer struct {
+	bytes []byte
+	len   int
+}
+
+// append appends the low n bits of val.
+func (bb *bitBuffer) append(val uint32, n int) {
+	for i := n - 1; i >= 0; i-- {
+		if bb.len%8 == 0 {
+			bb.bytes = append(bb.bytes, 0)
+		}
+		if val>>i&1 != 0 {
+			bb.bytes[bb.len/8] |= 0x80 >> (bb.len % 8)
+		}
+		bb.len++
+	}
+}
+
+// rsMultiply multiplies x and y in GF(2^8)
+// modulo the QR code polynomial 0x11D.
+func rsMultiply(x, y byte) byte {
+	var z int
+	for i := 7; i >= 0; i-- {
+		z = (z << 1) ^ ((z >> 7) * 0x11D)
+		z ^= int(y>>i&1) * int(x)
+	}
+	return byte(z)
+}
+
+// rsDivisor returns the Reed-Solomon generator polynomial
+// of the given degree, highest coefficient (always 1) omitted.
+func rsDivisor(degree int) []byte {
+	result := make([]byte, degree)
+	result[degree-1] = 1
+
+	root := byte(1)
+	for i := 0; i < degree; i++ {
+		for j := range result {
+			result[j] = rsMultiply(result[j], root)
+			if j+1 < len(result) {
+				result[j] ^= result[j+1]
+			}
+		}
+		root = rsMultiply(root, 0x02)
+	}
+
+	return result
+}
+
+// rsRemainder returns the Reed-Solomon error
+// correction codewords for data, using divisor.
+func rsRemainder(data []byte, divisor []byte) []byte {
+	result := make([]byte, len(divisor))
+	for _, b := range data {
+		factor := b ^ result[0]
+		copy(result, result[1:])
+		result[len(result)-1] = 0
+		for i, d := range divisor {
+			result[i] ^= rsMultiply(d, factor)
+		}
+	}
+	return result
+}
+
+func newCode(ver int) *Code {
+	size := ver*4 + 17
+	c := &Code{
+		Size:       size,
+		modules:    make([][]bool, size),
+		isFunction: make([][]bool, size),
+	}
+	for i := 0; i < size; i++ {
+		c.modules[i] = make([]bool, size)
+		c.isFunction[i] = make([]bool, size)
+	}
+	return c
+}
+
+func (c *Code) setFunction(x, y int, dark bool) {
+	c.modules[y][x] = dark
+	c.isFunction[y][x] = true
+}
+
+// drawFunctionPatterns draws finder, timing, and alignment
+// patterns, and reserves space for format and version info.
+func (c *Code) drawFunctionPatterns(v *version, ver int) {
+	// Timing patterns.
+	for i := 0; i < c.Size; i++ {
+		c.setFunction(6, i, i%2 == 0)
+		c.setFunction(i, 6, i%2 == 0)
+	}
+
+	// Finder patterns in three corners,
+	// including their separators.
+	for _, pos := range [][2]int{
+		{3, 3},
+		{c.Size - 4, 3},
+		{3, c.Size - 4},
+	} {
+		for dy := -4; dy <= 4; dy++ {
+			for dx := -4; dx <= 4; dx++ {
+				x, y := pos[0]+dx, pos[1]+dy
+				if x < 0 || y < 0 || x >= c.Size || y >= c.Size {
+					continue
+				}
+				dist := max(abs(dx), abs(dy))
+				c.setFunction(x, y, dist != 2 && dist != 4)
+			}
+		}
+	}
+
+	// Alignment patterns, except where
+	// they'd overlap the finder patterns.
+	last := len(v.alignment) - 1
+	for i, x := range v.alignment {
+		for j, y := range v.alignment {
+			if (i == 0 && j == 0) || (i == 0 && j == last) || (i == last && j == 0) {
+				continue
+			}
+			for dy := -2; dy <= 2; dy++ {
+				for dx := -2; dx <= 2; dx++ {
+					c.setFunction(x+dx, y+dy, max(abs(dx), abs(dy)) != 1)
+				}
+			}
+		}
+	}
+
+	// Reserve format info area with
+	// dummy bits, drawn after masking.
+	c.drawFormatBits(0)
+
+	// Version info, versions 7 and up.
+	if ver >= 7 {
+		bits := versionBits(ver)
+		for i := 0; i < 18; i++ {
+			dark := bits>>i&1 != 0
+			a, b := c.Size-11+i%3, i/3
+			c.setFunction(a, b, dark)
+			c.setFunction(b, a, dark)
+		}
+	}
+}
+
+// versionBits returns the 18 version info bits for version.
+func versionBits(ver int) int {
+	rem := ver
+	for i := 0; i < 12; i++ {
+		rem = (rem << 1) ^ ((rem >> 11) * 0x1F25)
+	}
+	return ver<<12 | rem
+}
+
+// formatBits returns the 15 format info
+// bits for error correction level M and mask.
+func formatBits(mask int) int {
+	data := 0b00<<3 | mask // 0b00 is level M
+	rem := data
+	for i := 0; i < 10; i++ {
+		rem = (rem << 1) ^ ((rem >> 9) * 0x537)
+	}
+	return (data<<10 | rem) ^ 0x5412
+}
+
+// drawFormatBits draws both copies of the format info for mask.
+func (c *Code) drawFormatBits(mask int) {
+	bits := formatBits(mask)
+	bit := func(i int) bool { return bits>>i&1 != 0 }
+
+	// First copy, around the top left finder.
+	for i := 0; i <= 5; i++ {
+		c.setFunction(8, i, bit(i))
+	}
+	c.setFunction(8, 7, bit(6))
+	c.setFunction(8, 8, bit(7))
+	c.setFunction(7, 8, bit(8))
+	for i := 9; i < 15; i++ {
+		c.setFunction(14-i, 8, bit(i))
+	}
+
+	// Second copy, split between the
+	// top right and bottom left finders.
+	for i := 0; i < 8; i++ {
+		c.setFunction(c.Size-1-i, 8, bit(i))
+	}
+	for i := 8; i < 15; i++ {
+		c.setFunction(8, c.Size-15+i, bit(i))
+	}
+
+	// Always dark.
+	c.setFunction(8, c.Size-8, true)
+}
+
+// drawCodewords draws the codewords into non-function
+// modules in the standard zig-zag order. Any leftover
+// (remainder) modules stay light.
+func (c *Code) drawCodewords(codewords []byte) {
+	i := 0
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			// Skip the vertical timing pattern.
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < c.Size; vert++ {
+			y := vert
+			if upward {
+				y = c.Size - 1 - vert
+			}
+			for j := 0; j < 2; j++ {
+				x := right - j
+				if c.isFunction[y][x] || i >= len(codewords)*8 {
+					continue
+				}
+				c.modules[y][x] = codewords[i/8]>>(7-i%8)&1 != 0
+				i++
+			}
+		}
+	}
+}
+
+// applyMask XORs all non-function modules with mask.
+func (c *Code) applyMask(mask int) {
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			var invert bool
+			switch mask {
+			case 0:
+				invert = (x+y)%2 == 0
+			case 1:
+				invert = y%2 == 0
+			case 2:
+				invert = x%3 == 0
+			case 3:
+				invert = (x+y)%3 == 0
+			case 4:
+				invert = (x/3+y/2)%2 == 0
+			case 5:
+				invert = x*y%2+x*y%3 == 0
+			case 6:
+				invert = (x*y%2+x*y%3)%2 == 0
+			case 7:
+				invert = ((x+y)%2+x*y%3)%2 == 0
+			}
+			if invert && !c.isFunction[y][x] {
+				c.modules[y][x] = !c.modules[y][x]
+			}
+		}
+	}
+}
+
+// penalty scores the symbol according to the
+// mask evaluation rules of the QR code spec.
+func (c *Code) penalty() int {
+	var (
+		score int
+		dark  int
+	)
+
+	// Finder-like pattern, with four light
+	// modules on one side or the other.
+	finderLike := [][]bool{
+		{true, false, true, true, true, false, true, false, false, false, false},
+		{false, false, false, false, true, false, true, true, true, false, true},
+	}
+
+	for i := 0; i < c.Size; i++ {
+		// Rule 1: runs of five or more
+		// same color modules in a line.
+		runRow, runCol := 1, 1
+		for j := 0; j < c.Size; j++ {
+			if c.modules[i][j] {
+				dark++
+			}
+			if j == 0 {
+				continue
+			}
+			if c.modules[i][j] == c.modules[i][j-1] {
+				runRow++
+			} else {
+				runRow = 1
+			}
+			if runRow == 5 {
+				score += 3
+			} else if runRow > 5 {
+				score++
+			}
+			if c.modules[j][i] == c.modules[j-1][i] {
+				runCol++
+			} else {
+				runCol = 1
+			}
+			if runCol == 5 {
+				score += 3
+			} else if runCol > 5 {
+				score++
+			}
+		}
+
+		// Rule 3: finder-like patterns.
+		for j := 0; j+11 <= c.Size; j++ {
+			for _, pattern := range finderLike {
+				row, col := true, true
+				for k, dark := range pattern {
+					row = row && c.modules[i][j+k] == dark
+					col = col && c.modules[j+k][i] == dark
+				}
+				if row {
+					score += 40
+				}
+				if col {
+					score += 40
+				}
+			}
+		}
+	}
+
+	// Rule 2: 2x2 blocks of same color.
+	for y := 0; y < c.Size-1; y++ {
+		for x := 0; x < c.Size-1; x++ {
+			m := c.modules[y][x]
+			if m == c.modules[y][x+1] && m == c.modules[y+1][x] && m == c.modules[y+1][x+1] {
+				score += 3
+			}
+		}
+	}
+
+	// Rule 4: balance of dark and light modules,
+	// 10 points per 5% deviation from 50% dark.
+	total := c.Size * c.Size
+	deviation := abs(dark*20 - total*10)
+	score += (deviation + total - 1) / total * 10
+	score -= 10
+
+	return score
+}
+
+// WriteSVG writes the symbol as an SVG image, with a
+// quiet zone of four modules around it. Each module
+// is one user unit, so the image scales cleanly.
+func (c *Code) WriteSVG(w io.Writer) error {
+	const quiet = 4
+	size := c.Size + 2*quiet
+
+	if _, err := fmt.Fprintf(w,
+		`<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`+
+			`<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`,
+		size, size, size, size,
+	); err != nil {
+		return err
+	}
+
+	// Draw each horizontal run of
+	// dark modules as one rectangle.
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if !c.modules[y][x] {
+				continue
+			}
+			run := 1
+			for x+run < c.Size && c.modules[y][x+run] {
+				run++
+			}
+			if _, err := fmt.Fprintf(w, "M%d %dh%dv1h-%dz", x+quiet, y+quiet, run, run); err != nil {
+				return err
+			}
+			x += run
+		}
+	}
+
+	_, err := io.WriteString(w, `"/></svg>`)
+	return err
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,140 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package qrcode
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRSRemainder(t *testing.T) {
+	// Version 1-M "HELLO WORLD" example from the
+	// thonky.com QR code tutorial.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	expect := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, expect) {
+		t.Fatalf("expected %v, got %v", expect, got)
+	}
+}
+
+func TestFormatBits(t *testing.T) {
+	// Level M format strings for masks 0 to 7, from the spec.
+	for mask, expect := range []int{
+		0b101010000010010,
+		0b101000100100101,
+		0b101111001111100,
+		0b101101101001011,
+		0b100010111111001,
+		0b100000011001110,
+		0b100111110010111,
+		0b100101010100000,
+	} {
+		if got := formatBits(mask); got != expect {
+			t.Errorf("mask %d: expected %015b, got %015b", mask, expect, got)
+		}
+	}
+}
+
+func TestVersionBits(t *testing.T) {
+	// Version info strings from the spec.
+	for ver, expect := range map[int]int{
+		7:  0b000111110010010100,
+		8:  0b001000010110111100,
+		10: 0b001010010011010011,
+	} {
+		if got := versionBits(ver); got != expect {
+			t.Errorf("version %d: expected %018b, got %018b", ver, expect, got)
+		}
+	}
+}
+
+func TestEncodeData(t *testing.T) {
+	v := &versions[0]
+	got := encodeData(v, 1, []byte("hi"))
+	expect := []byte{
+		0x40, 0x26, 0x86, 0x90, // mode, count, 'h', 'i', terminator
+		0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11,
+	}
+	if !bytes.Equal(got, expect) {
+		t.Fatalf("expected %x, got %x", expect, got)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	for _, test := range []struct {
+		data string
+		size int
+	}{
+		{"https://gts.ex", 21},
+		{"https://example.org", 25},
+		{"https://example.org/@someone", 29},
+		{"https://example.org/@" + strings.Repeat("a", 150), 53},
+	} {
+		c, err := Encode([]byte(test.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if c.Size != test.size {
+			t.Errorf("%q: expected size %d, got %d", test.data, test.size, c.Size)
+		}
+
+		// Check the finder pattern centers
+		// and the timing pattern are in place.
+		for _, pos := range [][2]int{{3, 3}, {c.Size - 4, 3}, {3, c.Size - 4}} {
+			if !c.Dark(pos[0], pos[1]) || c.Dark(pos[0]+2, pos[1]) || !c.Dark(pos[0]+3, pos[1]) {
+				t.Errorf("%q: bad finder pattern at %v", test.data, pos)
+			}
+		}
+		for i := 8; i < c.Size-8; i++ {
+			if c.Dark(i, 6) != (i%2 == 0) || c.Dark(6, i) != (i%2 == 0) {
+				t.Errorf("%q: bad timing pattern at %d", test.data, i)
+			}
+		}
+	}
+}
+
+func TestEncodeTooLong(t *testing.T) {
+	if _, err := Encode(make([]byte, 214)); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestWriteSVG(t *testing.T) {
+	c, err := Encode([]byte("https://example.org"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := c.WriteSVG(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	svg := buf.String()
+	if !strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 33 33"`) {
+		t.Fatalf("unexpected svg: %s", svg)
+	}
+
+	// Top row of the top left finder pattern.
+	if !strings.Contains(svg, "M4 4h7v1h-7z") {
+		t.Fatalf("unexpected svg: %s", svg)
+	}
+}
@@ -0,0 +1,263 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+const (
+	// cacheNotifyChannel is the redis pub/sub channel
+	// on which cache invalidations are sent / received.
+	cacheNotifyChannel = "gts:cache:invalidate"
+
+	// cacheNotifyMaxPending is the max number of invalidate events
+	// we'll queue while unable to send, after which the queue is
+	// dropped and other processes are told to clear all caches.
+	cacheNotifyMaxPending = 100_000
+)
+
+// cacheNotifyPayload is the JSON payload
+// published on the cache notify channel.
+type cacheNotifyPayload struct {
+	// Node is the unique ID of the sending process,
+	// used to ignore our own notifications.
+	Node string `json:"n"`
+
+	// Events contains the batched invalidate events.
+	Events []cache.InvalidateEvent `json:"e,omitempty"`
+
+	// Overflow indicates the sender dropped some events,
+	// and so receivers should clear all their caches.
+	Overflow bool `json:"o,omitempty"`
+}
+
+// CacheNotifier implements cache.Notifier by propagating invalidations to
+// other GoToSocial processes using redis pub/sub, and handles invalidations
+// received from other processes in turn. It is the redis equivalent of
+// the postgres LISTEN / NOTIFY based notifier of the bundb package.
+type CacheNotifier struct {
+	client *Client
+	caches *cache.Caches
+	node   string
+	cancel context.CancelFunc
+
+	pending  []cache.InvalidateEvent
+	overflow bool
+	clearing bool
+	signal   chan struct{}
+	mu       sync.Mutex
+}
+
+// StartCacheNotifier starts subscribing to, and publishing, cache
+// invalidations using client, registering itself with caches.
+func StartCacheNotifier(client *Client, caches *cache.Caches) *CacheNotifier {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	n := &CacheNotifier{
+		client: client,
+		caches: caches,
+		node:   id.NewULID(),
+		cancel: cancel,
+		signal: make(chan struct{}, 1),
+	}
+
+	go n.listen(ctx)
+	go n.send(ctx)
+
+	caches.SetNotifier(n)
+	log.Infof(ctx, "propagating cache invalidations on redis channel %s", cacheNotifyChannel)
+	return n
+}
+
+// Stop will stop the cache notifier, unregistering it from caches.
+func (n *CacheNotifier) Stop() {
+	n.caches.SetNotifier(nil)
+	n.cancel()
+}
+
+// Notify implements cache.Notifier{}, queueing
+// the event to be sent on the next send loop.
+func (n *CacheNotifier) Notify(event cache.InvalidateEvent) {
+	n.mu.Lock()
+	if n.clearing {
+		// Invalidations caused by clearing
+		// our own caches are not propagated.
+		n.mu.Unlock()
+		return
+	} else if len(n.pending) >= cacheNotifyMaxPending {
+		// Too many queued, drop them and just
+		// tell other processes to clear caches.
+		n.pending = nil
+		n.overflow = true
+	} else if !n.overflow {
+		n.pending = append(n.pending, event)
+	}
+	n.mu.Unlock()
+
+	select {
+	case n.signal <- struct{}{}:
+	default:
+	}
+}
+
+// send loops, publishing pending invalidate
+// events until the context is cancelled.
+func (n *CacheNotifier) send(ctx context.Context) {
+	var backoff time.Duration
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-n.signal:
+		}
+
+		for {
+			// Take the currently pending events.
+			n.mu.Lock()
+			events, overflow := n.pending, n.overflow
+			n.pending, n.overflow = nil, false
+			n.mu.Unlock()
+
+			if len(events) == 0 && !overflow {
+				break
+			}
+
+			payload := cacheNotifyPayload{
+				Node:     n.node,
+				Events:   events,
+				Overflow: overflow,
+			}
+			if overflow {
+				// Other processes will clear
+				// everything, no need for events.
+				payload.Events = nil
+			}
+
+			err := n.publish(ctx, payload)
+			if err == nil {
+				backoff = 0
+				continue
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			backoff = util.NextBackoff(backoff, time.Minute)
+			log.Errorf(ctx, "error publishing cache invalidations, retrying in %s: %v", backoff, err)
+
+			// Requeue what we failed to send as an
+			// overflow, as we don't know what got sent.
+			n.mu.Lock()
+			n.pending, n.overflow = nil, true
+			n.mu.Unlock()
+
+			if !util.SleepCtx(ctx, backoff) {
+				return
+			}
+		}
+	}
+}
+
+// publish encodes and publishes the payload on the cache notify channel.
+func (n *CacheNotifier) publish(ctx context.Context, payload cacheNotifyPayload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	_, err = n.client.Do(ctx, "PUBLISH", cacheNotifyChannel, string(b))
+	return err
+}
+
+// listen loops, handling invalidations received
+// from other processes until context is cancelled.
+func (n *CacheNotifier) listen(ctx context.Context) {
+	var backoff time.Duration
+
+	for {
+		err := n.client.Subscribe(ctx, cacheNotifyChannel, func(msg string) {
+			backoff = 0
+			n.handle(ctx, msg)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+
+		backoff = util.NextBackoff(backoff, time.Minute)
+		log.Errorf(ctx, "error subscribing to cache invalidations, retrying in %s: %v", backoff, err)
+
+		// We may have missed invalidations while
+		// disconnected, so clear all our caches.
+		n.clearAll()
+
+		if !util.SleepCtx(ctx, backoff) {
+			return
+		}
+	}
+}
+
+// handle handles a message received on the cache notify channel.
+func (n *CacheNotifier) handle(ctx context.Context, msg string) {
+	var payload cacheNotifyPayload
+	if err := json.Unmarshal([]byte(msg), &payload); err != nil {
+		log.Warnf(ctx, "invalid cache invalidation payload: %v", err)
+		return
+	}
+
+	if payload.Node == n.node {
+		// Sent by us.
+		return
+	}
+
+	if payload.Overflow {
+		n.clearAll()
+		return
+	}
+
+	for _, event := range payload.Events {
+		if !n.caches.HandleInvalidate(event) {
+			log.Warnf(ctx, "unknown cache in invalidation: %s", event.Cache)
+		}
+	}
+}
+
+// clearAll clears all local caches, used when we may have missed
+// events. Invalidations during the clear are not propagated.
+func (n *CacheNotifier) clearAll() {
+	n.mu.Lock()
+	n.clearing = true
+	n.mu.Unlock()
+
+	defer func() {
+		n.mu.Lock()
+		n.clearing = false
+		n.mu.Unlock()
+	}()
+
+	n.caches.Clear()
+}
@@ -0,0 +1,364 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package redis
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// dialTimeout is the timeout for
+	// establishing new connections.
+	dialTimeout = 10 * time.Second
+
+	// maxIdle is the max number of idle
+	// connections kept in the pool.
+	maxIdle = 128
+)
+
+// Error is an error reply returned by the redis server.
+type Error string
+
+func (e Error) Error() string { return "redis: " + string(e) }
+
+// Nil is returned for nil replies,
+// e.g. a BLMOVE that timed out.
+var Nil = errors.New("redis: nil")
+
+// Client is a minimal redis client, supporting just
+// what GoToSocial needs: commands with string arguments,
+// and subscribing to pub/sub channels. It is safe for
+// concurrent use, maintaining a pool of connections.
+type Client struct {
+	addr     string
+	username string
+	password string
+	db       int
+	tls      *tls.Config
+
+	idle []*conn
+	mu   sync.Mutex
+}
+
+// conn wraps a single connection to redis.
+type conn struct {
+	net.Conn
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+// New returns a new Client for the redis server at the given URL, in the form
+// "redis://[[username]:password@]host[:port][/db]", or "rediss://..." for TLS.
+func New(rawURL string) (*Client, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid redis url: %w", err)
+	}
+
+	c := &Client{addr: u.Host}
+
+	switch u.Scheme {
+	case "redis":
+	case "rediss":
+		c.tls = &tls.Config{
+			ServerName: u.Hostname(),
+			MinVersion: tls.VersionTLS12,
+		}
+	default:
+		return nil, fmt.Errorf("invalid redis url scheme: %q", u.Scheme)
+	}
+
+	if u.Port() == "" {
+		c.addr = net.JoinHostPort(u.Hostname(), "6379")
+	}
+
+	if u.User != nil {
+		c.username = u.User.Username()
+		c.password, _ = u.User.Password()
+	}
+
+	if path := strings.TrimPrefix(u.Path, "/"); path != "" {
+		c.db, err = strconv.Atoi(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redis url db: %q", path)
+		}
+	}
+
+	return c, nil
+}
+
+// Do sends the command with given arguments and returns the reply, which
+// is one of string, int64, or []any (of the same), or error Nil for nil.
+func (c *Client) Do(ctx context.Context, args ...string) (any, error) {
+	cn, err := c.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := cn.do(ctx, args...)
+
+	var rerr Error
+	if err != nil && !errors.Is(err, Nil) && !errors.As(err, &rerr) {
+		// Connection is in unknown state.
+		_ = cn.Close()
+		return nil, err
+	}
+
+	c.put(cn)
+	return reply, err
+}
+
+// Subscribe subscribes to the given channel on a dedicated connection, passing
+// each message received to fn until the context is cancelled or an error occurs.
+func (c *Client) Subscribe(ctx context.Context, channel string, fn func(msg string)) error {
+	cn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer cn.Close()
+
+	// Unblock reads on cancel.
+	stop := context.AfterFunc(ctx, func() {
+		_ = cn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if err := cn.write("SUBSCRIBE", channel); err != nil {
+		return err
+	}
+
+	for {
+		reply, err := cn.read()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		// Messages are of the form
+		// ["message", channel, payload].
+		parts, ok := reply.([]any)
+		if !ok || len(parts) != 3 {
+			continue
+		}
+
+		if kind, _ := parts[0].(string); kind != "message" {
+			// e.g. subscribe confirmation.
+			continue
+		}
+
+		if msg, ok := parts[2].(string); ok {
+			fn(msg)
+		}
+	}
+}
+
+// Close closes all idle connections.
+func (c *Client) Close() {
+	c.mu.Lock()
+	idle := c.idle
+	c.idle = nil
+	c.mu.Unlock()
+
+	for _, cn := range idle {
+		_ = cn.Close()
+	}
+}
+
+// get returns an idle connection from
+// the pool, or dials a new connection.
+func (c *Client) get(ctx context.Context) (*conn, error) {
+	c.mu.Lock()
+	if n := len(c.idle); n > 0 {
+		cn := c.idle[n-1]
+		c.idle = c.idle[:n-1]
+		c.mu.Unlock()
+		return cn, nil
+	}
+	c.mu.Unlock()
+	return c.dial(ctx)
+}
+
+// put returns a connection to the pool,
+// closing it if the pool is already full.
+func (c *Client) put(cn *conn) {
+	c.mu.Lock()
+	if len(c.idle) < maxIdle {
+		c.idle = append(c.idle, cn)
+		cn = nil
+	}
+	c.mu.Unlock()
+
+	if cn != nil {
+		_ = cn.Close()
+	}
+}
+
+// dial opens a new connection, authenticating
+// and selecting the configured db as needed.
+func (c *Client) dial(ctx context.Context) (*conn, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+
+	var nc net.Conn
+	var err error
+
+	if c.tls != nil {
+		td := &tls.Dialer{NetDialer: dialer, Config: c.tls}
+		nc, err = td.DialContext(ctx, "tcp", c.addr)
+	} else {
+		nc, err = dialer.DialContext(ctx, "tcp", c.addr)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("redis: error connecting: %w", err)
+	}
+
+	cn := &conn{
+		Conn: nc,
+		r:    bufio.NewReader(nc),
+		w:    bufio.NewWriter(nc),
+	}
+
+	if c.password != "" {
+		args := []string{"AUTH", c.password}
+		if c.username != "" {
+			args = []string{"AUTH", c.username, c.password}
+		}
+		if _, err := cn.do(ctx, args...); err != nil {
+			_ = cn.Close()
+			return nil, err
+		}
+	}
+
+	if c.db != 0 {
+		if _, err := cn.do(ctx, "SELECT", strconv.Itoa(c.db)); err != nil {
+			_ = cn.Close()
+			return nil, err
+		}
+	}
+
+	return cn, nil
+}
+
+// do writes the command and reads its reply,
+// respecting any deadline / cancel of ctx.
+func (cn *conn) do(ctx context.Context, args ...string) (any, error) {
+	deadline, _ := ctx.Deadline()
+	if err := cn.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	stop := context.AfterFunc(ctx, func() {
+		_ = cn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if err := cn.write(args...); err != nil {
+		return nil, err
+	}
+
+	reply, err := cn.read()
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return reply, err
+}
+
+// write writes the command with
+// given args as a RESP array.
+func (cn *conn) write(args ...string) error {
+	cn.w.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		cn.w.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n")
+		cn.w.WriteString(arg)
+		cn.w.WriteString("\r\n")
+	}
+	return cn.w.Flush()
+}
+
+// read reads a single RESP reply.
+func (cn *conn) read() (any, error) {
+	line, err := cn.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("redis: invalid reply line %q", line)
+	}
+	kind, line := line[0], line[1:len(line)-2]
+
+	switch kind {
+	case '+':
+		return line, nil
+
+	case '-':
+		return nil, Error(line)
+
+	case ':':
+		return strconv.ParseInt(line, 10, 64)
+
+	case '$':
+		n, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid bulk length %q", line)
+		}
+		if n < 0 {
+			return nil, Nil
+		}
+
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(cn.r, b); err != nil {
+			return nil, err
+		}
+		return string(b[:n]), nil
+
+	case '*':
+		n, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fmt.Errorf("redis: invalid array length %q", line)
+		}
+		if n < 0 {
+			return nil, Nil
+		}
+
+		arr := make([]any, n)
+		for i := range arr {
+			arr[i], err = cn.read()
+			if err != nil && !errors.Is(err, Nil) {
+				return nil, err
+			}
+		}
+		return arr, nil
+
+	default:
+		return nil, fmt.Errorf("redis: unknown reply type %q", kind)
+	}
+}
@@ -0,0 +1,207 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package redis_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/superseriousbusiness/gotosocial/internal/redis"
+)
+
+// fakeServer is a tiny RESP server on loopback,
+// replying to a handful of commands, and recording
+// the commands it receives.
+type fakeServer struct {
+	ln   net.Listener
+	cmds [][]string
+	mu   sync.Mutex
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	s := &fakeServer{ln: ln}
+	go func() {
+		for {
+			nc, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(nc)
+		}
+	}()
+	return s
+}
+
+func (s *fakeServer) url(userinfo string, db string) string {
+	return "redis://" + userinfo + s.ln.Addr().String() + db
+}
+
+func (s *fakeServer) serve(nc net.Conn) {
+	defer nc.Close()
+	r := bufio.NewReader(nc)
+
+	for {
+		cmd, err := readCommand(r)
+		if err != nil {
+			return
+		}
+
+		s.mu.Lock()
+		s.cmds = append(s.cmds, cmd)
+		s.mu.Unlock()
+
+		var reply string
+		switch strings.ToUpper(cmd[0]) {
+		case "AUTH", "SELECT":
+			reply = "+OK\r\n"
+		case "PING":
+			reply = "+PONG\r\n"
+		case "GET":
+			reply = "$-1\r\n"
+		case "LPUSH":
+			reply = ":1\r\n"
+		case "LRANGE":
+			reply = "*2\r\n$3\r\nfoo\r\n$0\r\n\r\n"
+		default:
+			reply = "-ERR unknown command\r\n"
+		}
+
+		if _, err := io.WriteString(nc, reply); err != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeServer) commands() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([][]string(nil), s.cmds...)
+}
+
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := make([]string, n)
+	for i := range cmd {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		l, err := strconv.Atoi(strings.TrimSpace(line[1:]))
+		if err != nil {
+			return nil, err
+		}
+
+		b := make([]byte, l+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		cmd[i] = string(b[:l])
+	}
+
+	return cmd, nil
+}
+
+func TestClientReplies(t *testing.T) {
+	s := newFakeServer(t)
+	ctx := context.Background()
+
+	c, err := redis.New(s.url("", ""))
+	require.NoError(t, err)
+	defer c.Close()
+
+	reply, err := c.Do(ctx, "PING")
+	require.NoError(t, err)
+	assert.Equal(t, "PONG", reply)
+
+	reply, err = c.Do(ctx, "LPUSH", "key", "value with\r\nnewline")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), reply)
+
+	reply, err = c.Do(ctx, "LRANGE", "key", "0", "-1")
+	require.NoError(t, err)
+	assert.Equal(t, []any{"foo", ""}, reply)
+
+	_, err = c.Do(ctx, "GET", "missing")
+	assert.ErrorIs(t, err, redis.Nil)
+
+	_, err = c.Do(ctx, "NOPE")
+	var rerr redis.Error
+	require.True(t, errors.As(err, &rerr))
+	assert.Equal(t, "ERR unknown command", string(rerr))
+
+	// Error replies shouldn't
+	// spoil the connection.
+	reply, err = c.Do(ctx, "PING")
+	require.NoError(t, err)
+	assert.Equal(t, "PONG", reply)
+
+	cmds := s.commands()
+	require.Len(t, cmds, 6)
+	assert.Equal(t, []string{"LPUSH", "key", "value with\r\nnewline"}, cmds[1])
+}
+
+func TestClientAuthSelect(t *testing.T) {
+	s := newFakeServer(t)
+
+	c, err := redis.New(s.url("gts:hunter2@", "/3"))
+	require.NoError(t, err)
+	defer c.Close()
+
+	_, err = c.Do(context.Background(), "PING")
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]string{
+		{"AUTH", "gts", "hunter2"},
+		{"SELECT", "3"},
+		{"PING"},
+	}, s.commands())
+}
+
+func TestNewInvalidURL(t *testing.T) {
+	for _, u := range []string{
+		"http://localhost",
+		"redis://localhost/notadb",
+		"://",
+	} {
+		_, err := redis.New(u)
+		assert.Error(t, err, u)
+	}
+}
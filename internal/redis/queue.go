@@ -0,0 +1,151 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package redis
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// popTimeout is the max seconds a worker blocks waiting
+// for a message, before checking if it should stop.
+const popTimeout = "5"
+
+// Queue is a persistent FIFO queue of messages held in a redis
+// list, so that queued messages survive restarts, and can be shared
+// by multiple processes. Messages being handled are moved to a second
+// list kept per host, and requeued on next start if the process exited
+// before finishing them, so that they are not lost on a crash.
+type Queue struct {
+	client     *Client
+	key        string
+	processing string
+	cancel     context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// NewQueue returns a new Queue with given name using client.
+func NewQueue(client *Client, name string) (*Queue, error) {
+	host, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	key := "gts:queue:" + name
+	return &Queue{
+		client:     client,
+		key:        key,
+		processing: key + ":processing:" + host,
+	}, nil
+}
+
+// Push pushes the given message onto the queue.
+func (q *Queue) Push(ctx context.Context, data []byte) error {
+	_, err := q.client.Do(ctx, "LPUSH", q.key, string(data))
+	return err
+}
+
+// Len returns the number of messages waiting in the queue.
+func (q *Queue) Len(ctx context.Context) (int64, error) {
+	reply, err := q.client.Do(ctx, "LLEN", q.key)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := reply.(int64)
+	return n, nil
+}
+
+// Start requeues any messages left unfinished by a previous run,
+// then starts given number of workers passing messages to handle.
+func (q *Queue) Start(workers int, handle func(context.Context, []byte)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	q.cancel = cancel
+
+	// Requeue unfinished messages.
+	for {
+		_, err := q.client.Do(ctx, "LMOVE", q.processing, q.key, "RIGHT", "RIGHT")
+		if errors.Is(err, Nil) {
+			break
+		} else if err != nil {
+			log.Errorf(ctx, "error requeueing unfinished messages in %s: %v", q.key, err)
+			break
+		}
+	}
+
+	for i := 0; i < workers; i++ {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			q.work(ctx, handle)
+		}()
+	}
+}
+
+// Stop stops the workers, waiting for any
+// messages currently being handled to finish.
+func (q *Queue) Stop() {
+	if q.cancel != nil {
+		q.cancel()
+	}
+	q.wg.Wait()
+}
+
+// work loops, taking messages from the queue and passing
+// them to handle, until the context is cancelled.
+func (q *Queue) work(ctx context.Context, handle func(context.Context, []byte)) {
+	var backoff time.Duration
+
+	for {
+		reply, err := q.client.Do(ctx, "BLMOVE", q.key, q.processing, "RIGHT", "LEFT", popTimeout)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if errors.Is(err, Nil) {
+			// Timed out, nothing queued.
+			continue
+		}
+
+		if err != nil {
+			backoff = util.NextBackoff(backoff, time.Minute)
+			log.Errorf(ctx, "error taking message from %s, retrying in %s: %v", q.key, backoff, err)
+			if !util.SleepCtx(ctx, backoff) {
+				return
+			}
+			continue
+		}
+
+		backoff = 0
+
+		data, _ := reply.(string)
+
+		// Messages being handled are seen through
+		// even when stopping, so use a fresh context.
+		handle(context.Background(), []byte(data))
+
+		if _, err := q.client.Do(context.Background(), "LREM", q.processing, "1", data); err != nil {
+			log.Errorf(ctx, "error removing finished message from %s: %v", q.processing, err)
+		}
+	}
+}
@@ -49,6 +49,7 @@ const (
 	emojiFinder              = `(?:\b)?:(` + emojiShortcode + `):(?:\b)?`                // Extract all emoji shortcodes from a text.
 	usernameStrict           = `^[a-z0-9_]{1,64}$`                                       // Pattern for usernames on THIS instance. maximumUsernameLength = 64
 	usernameRelaxed          = `[a-z0-9_\.]{1,}`                                         // Relaxed version of username that can match instance accounts too.
+	instancePageSlug         = `^[a-z0-9]+(?:-[a-z0-9]+)*$`                              // Pattern for instance page slugs, eg., "privacy" or "code-of-conduct".
 	misskeyReportNotesFinder = `(?m)(?:^Note: ((?:http|https):\/\/.*)$)`                 // Extract reported Note URIs from the text of a Misskey report/flag.
 	ulid                     = `[0123456789ABCDEFGHJKMNPQRSTVWXYZ]{26}`                  // Pattern for ULID.
 	ulidValidate             = `^` + ulid + `$`                                          // Validate one ULID.
@@ -70,6 +71,8 @@ const (
 	statusesPath   = userPathPrefix + `/` + statuses + `/(` + ulid + `)$`
 	blockPath      = userPathPrefix + `/` + blocks + `/(` + ulid + `)$`
 	reportPath     = `^/?` + reports + `/(` + ulid + `)$`
+	webProfilePath = `^/?@(` + usernameRelaxed + `)/?$`
+	webStatusPath  = `^/?@(` + usernameRelaxed + `)/` + statuses + `/(` + ulid + `)/?$`
 	filePath       = `^/?(` + ulid + `)/([a-z]+)/([a-z]+)/(` + ulid + `)\.([a-z0-9]+)$`
 )
 
@@ -103,6 +106,9 @@ var (
 	// Username can be used to validate usernames of new signups on this instance.
 	Username = regexp.MustCompile(usernameStrict)
 
+	// InstancePageSlug can be used to validate slugs of instance pages.
+	InstancePageSlug = regexp.MustCompile(instancePageSlug)
+
 	// MisskeyReportNotes captures a list of Note URIs from report content created by Misskey.
 	// See: https://regex101.com/r/EnTOBV/1
 	MisskeyReportNotes = regexp.MustCompile(misskeyReportNotesFinder)
@@ -152,6 +158,14 @@ var (
 	// from eg /reports/01GP3AWY4CRDVRNZKW0TEAMB5R
 	ReportPath = regexp.MustCompile(reportPath)
 
+	// WebProfilePath parses a path that validates and captures the username part
+	// from the web view of a profile, eg /@example_username
+	WebProfilePath = regexp.MustCompile(webProfilePath)
+
+	// WebStatusPath parses a path that validates and captures the username part and
+	// the ulid part from the web view of a status, eg /@example_username/statuses/01F7XT5JZW1WMVSW1KADS8PVDH
+	WebStatusPath = regexp.MustCompile(webStatusPath)
+
 	// FilePath parses a file storage path of the form [ACCOUNT_ID]/[MEDIA_TYPE]/[MEDIA_SIZE]/[FILE_NAME]
 	// eg 01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01F8MH8RMYQ6MSNY3JM2XT1CQ5.jpeg
 	// It captures the account id, media type, media size, file name, and file extension, eg
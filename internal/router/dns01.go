@@ -0,0 +1,419 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package router
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+const (
+	// cache key names, matching those
+	// used by autocert so that switching
+	// challenge type keeps account + certs.
+	acmeAccountKeyName = "acme_account+key"
+
+	dns01RenewBefore   = 30 * 24 * time.Hour
+	dns01CheckInterval = 12 * time.Hour
+	dns01RetryInterval = time.Hour
+	dns01ObtainTimeout = 10 * time.Minute
+)
+
+// DNSProvider creates and removes the DNS TXT
+// records used to answer ACME dns-01 challenges.
+//
+// Present should only return once the record can be
+// resolved, as the ACME server is told to check for it
+// straight afterwards. Both fqdn and value are exactly
+// as they should appear in the record, with fqdn
+// including a trailing dot.
+type DNSProvider interface {
+	Present(ctx context.Context, fqdn string, value string) error
+	CleanUp(ctx context.Context, fqdn string, value string) error
+}
+
+// CommandDNSProvider is a DNSProvider which
+// delegates to an external executable, called as:
+//
+//	<command> present|cleanup <fqdn> <value>
+//
+// This is compatible with the "exec" DNS provider of
+// lego and similar, so existing hooks can be reused.
+type CommandDNSProvider struct {
+	Command string
+}
+
+// Present implements DNSProvider.
+func (p *CommandDNSProvider) Present(ctx context.Context, fqdn string, value string) error {
+	return p.run(ctx, "present", fqdn, value)
+}
+
+// CleanUp implements DNSProvider.
+func (p *CommandDNSProvider) CleanUp(ctx context.Context, fqdn string, value string) error {
+	return p.run(ctx, "cleanup", fqdn, value)
+}
+
+func (p *CommandDNSProvider) run(ctx context.Context, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, p.Command, args...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running %s %s: %w: %s",
+			p.Command, args[0], err,
+			strings.TrimSpace(stderr.String()),
+		)
+	}
+	return nil
+}
+
+// dns01Manager obtains and renews a certificate for
+// a single host using ACME dns-01 challenges, which
+// autocert doesn't support. Obtained certificates are
+// stored in the same cache, and in the same format, as
+// those obtained via autocert.
+type dns01Manager struct {
+	client   *acme.Client
+	cache    autocert.Cache
+	provider DNSProvider
+	host     string
+	email    string
+	cert     atomic.Pointer[tls.Certificate]
+}
+
+// GetCertificate implements tls.Config{}.GetCertificate.
+func (m *dns01Manager) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cert := m.cert.Load()
+	if cert == nil {
+		return nil, errors.New("letsencrypt: no certificate obtained yet")
+	}
+	return cert, nil
+}
+
+// TLSConfig returns a TLS config
+// which serves managed certificate.
+func (m *dns01Manager) TLSConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		NextProtos:     []string{"h2", "http/1.1"},
+		GetCertificate: m.GetCertificate,
+	}
+}
+
+// Run loads any cached certificate, then keeps it
+// renewed until the given context is cancelled.
+func (m *dns01Manager) Run(ctx context.Context) {
+	if cert, err := m.cacheGet(ctx); err == nil {
+		m.cert.Store(cert)
+	} else if !errors.Is(err, autocert.ErrCacheMiss) {
+		log.Errorf(ctx, "letsencrypt: error loading cached certificate: %v", err)
+	}
+
+	for {
+		next := dns01CheckInterval
+		if err := m.renew(ctx); err != nil {
+			log.Errorf(ctx, "letsencrypt: error obtaining certificate for %s: %v", m.host, err)
+			next = dns01RetryInterval
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(next):
+		}
+	}
+}
+
+// renew obtains a new certificate if there
+// is none yet, or the current one is due to
+// expire within dns01RenewBefore.
+func (m *dns01Manager) renew(ctx context.Context) error {
+	if cert := m.cert.Load(); cert != nil &&
+		time.Until(cert.Leaf.NotAfter) > dns01RenewBefore {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dns01ObtainTimeout)
+	defer cancel()
+
+	cert, err := m.obtain(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := m.cachePut(ctx, cert); err != nil {
+		// Not fatal, we'll just end up
+		// requesting another on restart.
+		log.Errorf(ctx, "letsencrypt: error caching certificate: %v", err)
+	}
+
+	log.Infof(ctx, "letsencrypt: obtained certificate for %s valid until %s", m.host, cert.Leaf.NotAfter)
+	m.cert.Store(cert)
+	return nil
+}
+
+// obtain requests a new certificate from
+// the ACME server, answering its challenges
+// with TXT records set via the DNS provider.
+func (m *dns01Manager) obtain(ctx context.Context) (*tls.Certificate, error) {
+	if err := m.register(ctx); err != nil {
+		return nil, err
+	}
+
+	order, err := m.client.AuthorizeOrder(ctx, acme.DomainIDs(m.host))
+	if err != nil {
+		return nil, fmt.Errorf("error creating order: %w", err)
+	}
+
+	for _, url := range order.AuthzURLs {
+		if err := m.authorize(ctx, url); err != nil {
+			return nil, err
+		}
+	}
+
+	order, err = m.client.WaitOrder(ctx, order.URI)
+	if err != nil {
+		return nil, fmt.Errorf("error waiting for order: %w", err)
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: m.host},
+		DNSNames: []string{m.host},
+	}, key)
+	if err != nil {
+		return nil, err
+	}
+
+	der, _, err := m.client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("error finalizing order: %w", err)
+	}
+
+	leaf, err := x509.ParseCertificate(der[0])
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Certificate{
+		Certificate: der,
+		PrivateKey:  key,
+		Leaf:        leaf,
+	}, nil
+}
+
+// authorize answers the dns-01 challenge
+// of the authorization at the given url.
+func (m *dns01Manager) authorize(ctx context.Context, url string) error {
+	authz, err := m.client.GetAuthorization(ctx, url)
+	if err != nil {
+		return fmt.Errorf("error getting authorization: %w", err)
+	}
+
+	if authz.Status == acme.StatusValid {
+		// Already authorized
+		// by a previous order.
+		return nil
+	}
+
+	var chal *acme.Challenge
+	for _, c := range authz.Challenges {
+		if c.Type == "dns-01" {
+			chal = c
+			break
+		}
+	}
+
+	if chal == nil {
+		return fmt.Errorf("no dns-01 challenge offered for %s", authz.Identifier.Value)
+	}
+
+	value, err := m.client.DNS01ChallengeRecord(chal.Token)
+	if err != nil {
+		return err
+	}
+
+	fqdn := "_acme-challenge." + authz.Identifier.Value + "."
+	if err := m.provider.Present(ctx, fqdn, value); err != nil {
+		return fmt.Errorf("error presenting dns record: %w", err)
+	}
+
+	defer func() {
+		// Use a fresh context so records are
+		// removed even if the order timed out.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if err := m.provider.CleanUp(ctx, fqdn, value); err != nil {
+			log.Errorf(ctx, "letsencrypt: error cleaning up dns record: %v", err)
+		}
+	}()
+
+	if _, err := m.client.Accept(ctx, chal); err != nil {
+		return fmt.Errorf("error accepting challenge: %w", err)
+	}
+
+	if _, err := m.client.WaitAuthorization(ctx, authz.URI); err != nil {
+		return fmt.Errorf("error waiting for authorization: %w", err)
+	}
+
+	return nil
+}
+
+// register ensures the client has an account key,
+// and that the account is registered with the server.
+func (m *dns01Manager) register(ctx context.Context) error {
+	if m.client.Key != nil {
+		return nil
+	}
+
+	key, err := m.accountKey(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting account key: %w", err)
+	}
+	m.client.Key = key
+
+	var contact []string
+	if m.email != "" {
+		contact = []string{"mailto:" + m.email}
+	}
+
+	_, err = m.client.Register(ctx, &acme.Account{Contact: contact}, acme.AcceptTOS)
+	if err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		m.client.Key = nil
+		return fmt.Errorf("error registering account: %w", err)
+	}
+
+	return nil
+}
+
+// accountKey loads the ACME account key from
+// the cache, generating and storing a new one
+// if none exists yet.
+func (m *dns01Manager) accountKey(ctx context.Context) (crypto.Signer, error) {
+	data, err := m.cache.Get(ctx, acmeAccountKeyName)
+	if errors.Is(err, autocert.ErrCacheMiss) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+
+		data := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: b})
+		if err := m.cache.Put(ctx, acmeAccountKeyName, data); err != nil {
+			return nil, err
+		}
+
+		return key, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil || !strings.Contains(block.Type, "PRIVATE") {
+		return nil, errors.New("invalid account key found in cache")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// cacheGet loads the certificate for the managed
+// host from the cache, in the format used by autocert:
+// the PEM private key followed by the PEM cert chain.
+func (m *dns01Manager) cacheGet(ctx context.Context) (*tls.Certificate, error) {
+	data, err := m.cache.Get(ctx, m.host)
+	if err != nil {
+		return nil, err
+	}
+
+	priv, pub := pem.Decode(data)
+	if priv == nil || !strings.Contains(priv.Type, "PRIVATE") {
+		return nil, autocert.ErrCacheMiss
+	}
+
+	cert, err := tls.X509KeyPair(pub, pem.EncodeToMemory(priv))
+	if err != nil {
+		return nil, err
+	}
+
+	if cert.Leaf == nil {
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if time.Now().After(cert.Leaf.NotAfter) {
+		// Expired, treat as missing.
+		return nil, autocert.ErrCacheMiss
+	}
+
+	return &cert, nil
+}
+
+// cachePut stores the given certificate for the managed
+// host in the cache, in the format used by autocert.
+func (m *dns01Manager) cachePut(ctx context.Context, cert *tls.Certificate) error {
+	b, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	_ = pem.Encode(&buf, &pem.Block{Type: "EC PRIVATE KEY", Bytes: b})
+	for _, der := range cert.Certificate {
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: der})
+	}
+
+	return m.cache.Put(ctx, m.host, buf.Bytes())
+}
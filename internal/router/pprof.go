@@ -0,0 +1,91 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package router
+
+import (
+	"context"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// ServePprof serves the net/http/pprof profile with given
+// name, where an empty name serves the index of profiles.
+//
+// Profiling (eg., a 30s CPU profile) can easily take longer
+// than the http.Server{} write timeout, so this is cleared.
+func ServePprof(w http.ResponseWriter, r *http.Request, name string) {
+	clearWriteDeadline(w)
+
+	switch name {
+	case "":
+		pprof.Index(w, r)
+	case "cmdline":
+		pprof.Cmdline(w, r)
+	case "profile":
+		pprof.Profile(w, r)
+	case "symbol":
+		pprof.Symbol(w, r)
+	case "trace":
+		pprof.Trace(w, r)
+	default:
+		pprof.Handler(name).ServeHTTP(w, r)
+	}
+}
+
+// ServeExpvar serves the expvar runtime
+// stats (memstats, cmdline etc) as JSON.
+func ServeExpvar(w http.ResponseWriter, r *http.Request) {
+	expvar.Handler().ServeHTTP(w, r)
+}
+
+// clearWriteDeadline removes any write deadline
+// set on the underlying connection of w, logging
+// if this isn't supported by the response writer.
+func clearWriteDeadline(w http.ResponseWriter) {
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Warnf(nil, "error clearing write deadline: %v", err)
+	}
+}
+
+// newPprofServer returns an http.Server{} serving pprof profiles
+// and expvar runtime stats, without any authentication, at the
+// standard "/debug/pprof/" and "/debug/vars" URLs on given address.
+func newPprofServer(ctx context.Context, addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", func(w http.ResponseWriter, r *http.Request) {
+		name := strings.TrimPrefix(r.URL.Path, "/debug/pprof/")
+		ServePprof(w, r, name)
+	})
+	mux.HandleFunc("/debug/vars", ServeExpvar)
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+		BaseContext: func(net.Listener) context.Context {
+			return ctx
+		},
+	}
+}
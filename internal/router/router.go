@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"codeberg.org/gruf/go-bytesize"
@@ -30,6 +31,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
 )
 
@@ -62,6 +64,10 @@ type Router interface {
 	Start()
 	// Stop the router
 	Stop(ctx context.Context) error
+	// ReloadTLS reloads the TLS certificate chain and key
+	// from disk, if TLS is configured using files on disk.
+	// Connections already open keep using the old certificate.
+	ReloadTLS() error
 }
 
 // router fulfils the Router interface using gin and logrus
@@ -69,6 +75,10 @@ type router struct {
 	engine      *gin.Engine
 	srv         *http.Server
 	certManager *autocert.Manager
+	dnsManager  *dns01Manager
+	pprofSrv    *http.Server
+	tlsCert     atomic.Pointer[tls.Certificate]
+	cancel      context.CancelFunc
 }
 
 // Start starts the router nicely. It will serve two handlers if letsencrypt is enabled, and only the web/API handler if letsencrypt is not enabled.
@@ -81,24 +91,32 @@ func (r *router) Start() {
 	// During config validation we already checked that both Chain and Key are set
 	// so we can forego checking for both here
 	if chain := config.GetTLSCertificateChain(); chain != "" {
-		pkey := config.GetTLSCertificateKey()
-		cer, err := tls.LoadX509KeyPair(chain, pkey)
-		if err != nil {
-			log.Fatalf(
-				nil,
-				"tls: failed to load keypair from %s and %s, ensure they are PEM-encoded and can be read by this process: %s",
-				chain, pkey, err,
-			)
+		if err := r.ReloadTLS(); err != nil {
+			log.Fatal(nil, err)
 		}
 		r.srv.TLSConfig = &tls.Config{
-			MinVersion:   tls.VersionTLS12,
-			Certificates: []tls.Certificate{cer},
+			MinVersion: tls.VersionTLS12,
+			GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+				// Always serve the most recently
+				// loaded cert, see ReloadTLS().
+				return r.tlsCert.Load(), nil
+			},
 		}
 		// TLS is enabled, update the listen function
 		listen = func() error { return r.srv.ListenAndServeTLS("", "") }
 	}
 
-	if config.GetLetsEncryptEnabled() {
+	if r.dnsManager != nil {
+		// LetsEncrypt support is enabled using dns-01
+		// challenges, so no challenge listener is needed,
+		// just keep our certificate obtained + renewed.
+		ctx, cancel := context.WithCancel(context.Background())
+		r.cancel = cancel
+		go r.dnsManager.Run(ctx)
+
+		// TLS is enabled, update the listen function
+		listen = func() error { return r.srv.ListenAndServeTLS("", "") }
+	} else if config.GetLetsEncryptEnabled() {
 		// LetsEncrypt support is enabled
 
 		// Prepare an HTTPS-redirect handler for LetsEncrypt fallback
@@ -144,6 +162,17 @@ func (r *router) Start() {
 		r.srv.WriteTimeout = 0
 	}
 
+	if r.pprofSrv != nil {
+		// Start the separate, unauthenticated pprof listener.
+		go func() {
+			log.Infof(nil, "pprof listening on %s", r.pprofSrv.Addr)
+			if err := r.pprofSrv.ListenAndServe(); err != nil &&
+				err != http.ErrServerClosed {
+				log.Fatalf(nil, "pprof: listen: %s", err)
+			}
+		}()
+	}
+
 	// Start the main listener.
 	go func() {
 		log.Infof(nil, "listening on %s", r.srv.Addr)
@@ -153,8 +182,35 @@ func (r *router) Start() {
 	}()
 }
 
+// ReloadTLS implements Router{}.ReloadTLS().
+func (r *router) ReloadTLS() error {
+	chain := config.GetTLSCertificateChain()
+	if chain == "" {
+		// Not configured, or
+		// managed by letsencrypt.
+		return nil
+	}
+
+	pkey := config.GetTLSCertificateKey()
+	cer, err := tls.LoadX509KeyPair(chain, pkey)
+	if err != nil {
+		return fmt.Errorf(
+			"tls: failed to load keypair from %s and %s, ensure they are PEM-encoded and can be read by this process: %w",
+			chain, pkey, err,
+		)
+	}
+
+	r.tlsCert.Store(&cer)
+	return nil
+}
+
 // Stop shuts down the router nicely
 func (r *router) Stop(ctx context.Context) error {
+	if r.cancel != nil {
+		// Stop letsencrypt renewals.
+		r.cancel()
+	}
+
 	log.Infof(nil, "shutting down http router with %s grace period", shutdownTimeout)
 	timeout, cancel := context.WithTimeout(ctx, shutdownTimeout)
 	defer cancel()
@@ -163,6 +219,12 @@ func (r *router) Stop(ctx context.Context) error {
 		return fmt.Errorf("error shutting down http router: %s", err)
 	}
 
+	if r.pprofSrv != nil {
+		if err := r.pprofSrv.Shutdown(timeout); err != nil {
+			return fmt.Errorf("error shutting down pprof listener: %s", err)
+		}
+	}
+
 	log.Info(nil, "http router closed connections and shut down gracefully")
 	return nil
 }
@@ -221,8 +283,23 @@ func New(ctx context.Context) (Router, error) {
 	// In either case, the gin engine will still be used for routing requests.
 	leEnabled := config.GetLetsEncryptEnabled()
 
-	var m *autocert.Manager
-	if leEnabled {
+	var (
+		m   *autocert.Manager
+		dnm *dns01Manager
+	)
+
+	if leEnabled && config.GetLetsEncryptChallenge() == config.LetsEncryptChallengeDNS01 {
+		// le IS enabled using dns-01 challenges, which autocert
+		// doesn't support, so roll up our own manager instead
+		dnm = &dns01Manager{
+			client:   &acme.Client{DirectoryURL: autocert.DefaultACMEDirectory},
+			cache:    autocert.DirCache(config.GetLetsEncryptCertDir()),
+			provider: &CommandDNSProvider{Command: config.GetLetsEncryptDNSCommand()},
+			host:     config.GetHost(),
+			email:    config.GetLetsEncryptEmailAddress(),
+		}
+		s.TLSConfig = dnm.TLSConfig()
+	} else if leEnabled {
 		// le IS enabled, so roll up an autocert manager for handling letsencrypt requests
 		host := config.GetHost()
 		leCertDir := config.GetLetsEncryptCertDir()
@@ -236,9 +313,18 @@ func New(ctx context.Context) (Router, error) {
 		s.TLSConfig = m.TLSConfig()
 	}
 
+	var pprofSrv *http.Server
+	if addr := config.GetPprofBindAddress(); config.GetPprofEnabled() && addr != "" {
+		// pprof is enabled on a separate listener, so set that up;
+		// this is only meant to be reachable by local operators.
+		pprofSrv = newPprofServer(ctx, addr)
+	}
+
 	return &router{
 		engine:      engine,
 		srv:         s,
 		certManager: m,
+		dnsManager:  dnm,
+		pprofSrv:    pprofSrv,
 	}, nil
 }
@@ -27,9 +27,12 @@ import (
 
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/i18n"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -58,7 +61,21 @@ func LoadTemplates(engine *gin.Engine) error {
 		return fmt.Errorf("%s doesn't seem to contain the templates; index.tmpl is missing: %w", templateBaseDir, err)
 	}
 
-	engine.LoadHTMLGlob(filepath.Join(templateBaseDir, "*"))
+	files, err := filepath.Glob(filepath.Join(templateBaseDir, "*"))
+	if err != nil {
+		return fmt.Errorf("error listing templates in %s: %w", templateBaseDir, err)
+	}
+
+	// Email templates are text templates,
+	// loaded separately by the email sender.
+	htmlFiles := make([]string, 0, len(files))
+	for _, file := range files {
+		if !strings.HasPrefix(filepath.Base(file), "email_") {
+			htmlFiles = append(htmlFiles, file)
+		}
+	}
+
+	engine.LoadHTMLFiles(htmlFiles...)
 	return nil
 }
 
@@ -159,6 +176,17 @@ func emojify(emojis []apimodel.Emoji, inputText template.HTML) template.HTML {
 	return template.HTML(out)
 }
 
+// outboundLinks rewrites external links in the given
+// html to go through the external link interstitial, if enabled.
+// Input is a template.HTML to affirm that it's already escaped.
+func outboundLinks(inputHTML template.HTML) template.HTML {
+	out := text.InterstitialLinks(string(inputHTML), "/"+uris.LeavingPath, apiutil.WebLinkURLKey)
+
+	/* #nosec G203 */
+	// (this is escaped above)
+	return template.HTML(out)
+}
+
 func acctInstance(acct string) string {
 	parts := strings.Split(acct, "@")
 	if len(parts) > 1 {
@@ -168,6 +196,22 @@ func acctInstance(acct string) string {
 	return ""
 }
 
+// localizedPartial pairs the data passed into a partial
+// template, eg., status.tmpl, with the localizer of the
+// page which includes it, since the partial can't reach
+// the data of the including page otherwise.
+type localizedPartial struct {
+	I18n *i18n.Localizer
+	Data any
+}
+
+func localize(localizer *i18n.Localizer, data any) localizedPartial {
+	return localizedPartial{
+		I18n: localizer,
+		Data: data,
+	}
+}
+
 func LoadTemplateFunctions(engine *gin.Engine) {
 	engine.SetFuncMap(template.FuncMap{
 		"escape":           escape,
@@ -180,5 +224,8 @@ func LoadTemplateFunctions(engine *gin.Engine) {
 		"timestampPrecise": timestampPrecise,
 		"emojify":          emojify,
 		"acctInstance":     acctInstance,
+		"themeColor":       config.GetWebThemeColor,
+		"outboundLinks":    outboundLinks,
+		"localize":         localize,
 	})
 }
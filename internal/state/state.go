@@ -19,9 +19,12 @@ package state
 
 import (
 	"github.com/superseriousbusiness/gotosocial/internal/cache"
+	"github.com/superseriousbusiness/gotosocial/internal/challenge"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/jobs"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/translate"
 	"github.com/superseriousbusiness/gotosocial/internal/workers"
 )
 
@@ -47,6 +50,19 @@ type State struct {
 	// Workers provides access to this state's collection of worker pools.
 	Workers workers.Workers
 
+	// Jobs provides access to this state's
+	// registry of scheduled background jobs.
+	Jobs jobs.Jobs
+
+	// Challenge provides the challenge that new sign-ups
+	// must pass, if one is configured. May be nil.
+	Challenge challenge.Challenge
+
+	// Translator translates statuses into other languages
+	// when users ask for it, if translation is enabled.
+	// May be nil.
+	Translator translate.Translator
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -17,7 +17,10 @@
 
 package stream
 
-import "sync"
+import (
+	"sync"
+	"time"
+)
 
 const (
 	// EventTypeNotification -- a user should be shown a notification
@@ -26,6 +29,12 @@ const (
 	EventTypeUpdate string = "update"
 	// EventTypeDelete -- something should be deleted from a user
 	EventTypeDelete string = "delete"
+	// EventTypeAnnouncement -- an announcement has been published
+	EventTypeAnnouncement string = "announcement"
+	// EventTypeAnnouncementDelete -- an announcement should be removed
+	EventTypeAnnouncementDelete string = "announcement.delete"
+	// EventTypeMaintenance -- scheduled maintenance is approaching, has started, or has ended
+	EventTypeMaintenance string = "maintenance"
 )
 
 const (
@@ -52,15 +61,123 @@ var AllStatusTimelines = []string{
 	TimelineList,
 }
 
-// StreamsForAccount is a wrapper for the multiple streams that one account can have running at the same time.
-// TODO: put a limit on this
+const (
+	// HistorySize is the maximum number of recent
+	// events kept in the history of an account.
+	HistorySize = 100
+	// HistoryWindow is how long events are kept in the
+	// history of an account, and how long after its last
+	// stream is closed events are still recorded for it.
+	HistoryWindow = 10 * time.Minute
+	// MaxStreamsPerAccount is the maximum number of streams,
+	// websockets or server-sent events alike, that one
+	// account can have open at the same time.
+	MaxStreamsPerAccount = 16
+)
+
+// replayableEvents contains event types that are
+// recorded in history, to be replayed if missed.
+var replayableEvents = map[string]struct{}{
+	EventTypeNotification: {},
+	EventTypeUpdate:       {},
+	EventTypeDelete:       {},
+}
+
+// Replayable returns true if events of the given type are
+// recorded in history, to be replayed to streams that missed
+// them. Events that only reflect a current state, like
+// maintenance, aren't.
+func Replayable(event string) bool {
+	_, ok := replayableEvents[event]
+	return ok
+}
+
+// StreamsForAccount is a wrapper for the multiple streams that one account can have running at the same time,
+// up to MaxStreamsPerAccount.
 type StreamsForAccount struct {
 	// The currently held streams for this account
 	Streams []*Stream
-	// Mutex to lock/unlock when modifying the slice of streams.
+	// Recent events streamed to this account, to replay
+	// to streams that reconnect after missing them.
+	History History
+	// When the last stream of this account was
+	// closed. Zero while any streams are open.
+	ClosedAt time.Time
+	// Mutex to lock/unlock when modifying the slice of streams, or the history.
 	sync.Mutex
 }
 
+// Event is a message recorded in the history of an account.
+type Event struct {
+	// ID of the event, generated when it was streamed.
+	// Being a ULID, events are sorted by ID in time.
+	ID string
+	// All the stream types this event was delivered to.
+	StreamTypes []string
+	// The event type (update/delete/notification etc).
+	Event string
+	// The payload of the event.
+	Payload string
+	// ID of the status of an update event, used to
+	// check it still should be shown when replayed.
+	StatusID string
+	// ID of the notification of a notification event, used
+	// to check it still should be shown when replayed.
+	NotificationID string
+}
+
+// History is a ring buffer of the most recent events
+// streamed to an account. It isn't safe for concurrent
+// use; callers must hold the lock of StreamsForAccount.
+type History struct {
+	events []*Event
+	next   int
+}
+
+// Add adds the given event to the history,
+// replacing the oldest event if it's full.
+func (h *History) Add(e *Event) {
+	if h.events == nil {
+		h.events = make([]*Event, HistorySize)
+	}
+
+	h.events[h.next] = e
+	h.next = (h.next + 1) % len(h.events)
+}
+
+// Since returns events in the history with an ID
+// greater than the given ID, oldest first.
+func (h *History) Since(id string) []*Event {
+	var events []*Event
+
+	for i := range h.events {
+		// Start at the oldest event.
+		e := h.events[(h.next+i)%len(h.events)]
+		if e != nil && e.ID > id {
+			events = append(events, e)
+		}
+	}
+
+	return events
+}
+
+// Clear removes all events from the history.
+func (h *History) Clear() {
+	h.events = nil
+	h.next = 0
+}
+
+// Relay is implemented by types able to pass streamed events on to
+// other GoToSocial processes serving the same instance, e.g. via
+// postgres LISTEN / NOTIFY, so that events reach open streams no
+// matter which process they are connected to.
+type Relay interface {
+	// Relay passes on the event streamed to given account ID,
+	// or to all accounts if the ID is empty. Relay must not
+	// block, as it is called while streaming the event.
+	Relay(accountID string, e *Event)
+}
+
 // Stream represents one open stream for a client.
 type Stream struct {
 	// ID of this stream, generated during creation.
@@ -80,6 +197,10 @@ type Stream struct {
 
 // Message represents one streamed message.
 type Message struct {
+	// ID of the event, which can be passed as since_id
+	// when reconnecting, to replay missed events. Empty
+	// for events which can't be replayed.
+	ID string `json:"id,omitempty"`
 	// All the stream types this message should be delivered to.
 	Stream []string `json:"stream"`
 	// The event type of the message (update/delete/notification etc)
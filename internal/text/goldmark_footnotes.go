@@ -0,0 +1,113 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"strconv"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	mdutil "github.com/yuin/goldmark/util"
+)
+
+// footnotes fulfils the following goldmark interfaces:
+//
+//   - renderer.NodeRenderer
+//   - goldmark.Extender.
+//
+// It parses footnotes in the same way as goldmark's own
+// Footnote extension, but renders them without the ids and
+// fragment links which that uses to tie references and notes
+// together: statuses are shown next to each other in timelines,
+// where ids would clash, and relative links don't survive
+// sanitizing anyway. Instead, references are rendered as
+// superscript numbers, and the notes as a numbered list at
+// the end of the status.
+type footnotes struct{}
+
+func (f *footnotes) Extend(markdown goldmark.Markdown) {
+	// Same priorities as goldmark's
+	// own Footnote extension.
+	markdown.Parser().AddOptions(
+		parser.WithBlockParsers(
+			mdutil.Prioritized(extension.NewFootnoteBlockParser(), 999),
+		),
+		parser.WithInlineParsers(
+			mdutil.Prioritized(extension.NewFootnoteParser(), 101),
+		),
+		parser.WithASTTransformers(
+			mdutil.Prioritized(extension.NewFootnoteASTTransformer(), 999),
+		),
+	)
+	markdown.Renderer().AddOptions(
+		renderer.WithNodeRenderers(
+			mdutil.Prioritized(f, 500),
+		),
+	)
+}
+
+func (f *footnotes) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(east.KindFootnoteLink, f.renderFootnoteLink)
+	reg.Register(east.KindFootnoteBacklink, f.renderFootnoteBacklink)
+	reg.Register(east.KindFootnote, f.renderFootnote)
+	reg.Register(east.KindFootnoteList, f.renderFootnoteList)
+}
+
+// renderFootnoteLink renders a reference
+// to a footnote as its superscript number.
+func (f *footnotes) renderFootnoteLink(w mdutil.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		n := node.(*east.FootnoteLink)
+		_, _ = w.WriteString("<sup>")
+		_, _ = w.WriteString(strconv.Itoa(n.Index))
+		_, _ = w.WriteString("</sup>")
+	}
+	return ast.WalkContinue, nil
+}
+
+// renderFootnoteBacklink renders nothing, as there's
+// nothing for the link back to a reference to link to.
+func (f *footnotes) renderFootnoteBacklink(w mdutil.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	return ast.WalkContinue, nil
+}
+
+// renderFootnote renders a footnote as an item of
+// the list; the list numbers it for us, in order.
+func (f *footnotes) renderFootnote(w mdutil.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString("<li>")
+	} else {
+		_, _ = w.WriteString("</li>")
+	}
+	return ast.WalkContinue, nil
+}
+
+// renderFootnoteList renders the list of footnotes
+// at the end of the status, set apart by a rule.
+func (f *footnotes) renderFootnoteList(w mdutil.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		_, _ = w.WriteString(`<section class="footnotes"><hr><ol>`)
+	} else {
+		_, _ = w.WriteString("</ol></section>")
+	}
+	return ast.WalkContinue, nil
+}
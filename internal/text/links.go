@@ -0,0 +1,238 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"errors"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/idna"
+)
+
+// LinkHostTrusted returns whether links to the given host
+// can be followed directly from the web view, without going
+// through the external link interstitial. This is the case
+// for links to this instance itself, and for links to
+// (subdomains of) configured trusted domains.
+func LinkHostTrusted(host string) bool {
+	host, err := idna.ToASCII(strings.ToLower(host))
+	if err != nil {
+		// Not a valid domain
+		// name, don't trust it.
+		return false
+	}
+
+	if host == config.GetHost() || host == config.GetAccountDomain() {
+		return true
+	}
+
+	for _, domain := range config.GetWebLinkInterstitialTrustedDomains() {
+		domain = strings.ToLower(domain)
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// defaultLinkRel are the rel values set by the sanitizer
+// on fully qualified links, in the order it sets them.
+var defaultLinkRel = []string{"nofollow", "noreferrer", "noopener"}
+
+// keptLinkRel are rel values which say what a link is,
+// rather than how it should be treated, so they're kept
+// regardless of the configured link rel values.
+var keptLinkRel = map[string]struct{}{
+	"tag": {},
+}
+
+// applyLinkRel sets the configured rel values on fully
+// qualified links in the given sanitized html, in place
+// of the ones set by the sanitizer.
+func applyLinkRel(in string) string {
+	rel := config.GetAdvancedSanitizerLinkRel()
+	if slices.Equal(rel, defaultLinkRel) {
+		// Nothing to do.
+		return in
+	}
+
+	return rewriteAnchors(in, func(tok *html.Token) bool {
+		if !fullyQualified(anchorAttr(tok, "href")) {
+			return false
+		}
+
+		values := make([]string, 0, len(rel)+1)
+		for _, v := range strings.Fields(anchorAttr(tok, "rel")) {
+			if _, keep := keptLinkRel[v]; keep {
+				values = append(values, v)
+			}
+		}
+		values = append(values, rel...)
+
+		return setAnchorAttr(tok, "rel", strings.Join(values, " "))
+	})
+}
+
+// AddLinkRelMe adds rel="me" to fully qualified links in the
+// given html. This is used for profile fields which their owner
+// marked as rel="me", so that the sites they link to can verify
+// that the profile links back to them.
+func AddLinkRelMe(in string) string {
+	return rewriteAnchors(in, func(tok *html.Token) bool {
+		if !fullyQualified(anchorAttr(tok, "href")) {
+			return false
+		}
+
+		values := strings.Fields(anchorAttr(tok, "rel"))
+		if slices.Contains(values, "me") {
+			return false
+		}
+
+		return setAnchorAttr(tok, "rel", strings.Join(append([]string{"me"}, values...), " "))
+	})
+}
+
+// InterstitialLinks rewrites links in the given html which
+// point to untrusted external hosts, so that they lead through
+// the external link interstitial at interstitialPath instead.
+// The original link is passed to the interstitial in the query
+// string, under the given key. Links marked rel="me" are left
+// alone, as rewriting them would break verification of them.
+//
+// If the interstitial isn't enabled, or the given html can't
+// be parsed, the input is returned unchanged.
+func InterstitialLinks(in string, interstitialPath string, key string) string {
+	if !config.GetWebLinkInterstitial() {
+		return in
+	}
+
+	return rewriteAnchors(in, func(tok *html.Token) bool {
+		if slices.Contains(strings.Fields(anchorAttr(tok, "rel")), "me") {
+			return false
+		}
+
+		href := anchorAttr(tok, "href")
+		u, err := url.Parse(href)
+		if err != nil ||
+			!fullyQualified(href) ||
+			LinkHostTrusted(u.Hostname()) {
+			return false
+		}
+
+		return setAnchorAttr(tok, "href", interstitialPath+"?"+key+"="+url.QueryEscape(href))
+	})
+}
+
+// rewriteAnchors calls fn for the start tag of each anchor
+// in the given html. If fn changes the token and returns true,
+// the changed token replaces the original in the output.
+//
+// If the given html can't be parsed, it's returned unchanged.
+func rewriteAnchors(in string, fn func(tok *html.Token) bool) string {
+	var (
+		z = html.NewTokenizer(strings.NewReader(in))
+		b strings.Builder
+	)
+
+	b.Grow(len(in))
+
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			if !errors.Is(z.Err(), io.EOF) {
+				// Something went wrong,
+				// leave input be.
+				return in
+			}
+			return b.String()
+		}
+
+		// Copy the raw token now, since
+		// parsing it changes the slice.
+		raw := string(z.Raw())
+
+		if tt != html.StartTagToken {
+			b.WriteString(raw)
+			continue
+		}
+
+		tok := z.Token()
+		if tok.DataAtom != atom.A || !fn(&tok) {
+			b.WriteString(raw)
+			continue
+		}
+
+		b.WriteString(tok.String())
+	}
+}
+
+// anchorAttr returns the value of the given
+// attribute of an anchor token, or "" if unset.
+func anchorAttr(tok *html.Token, key string) string {
+	for _, attr := range tok.Attr {
+		if attr.Namespace == "" && attr.Key == key {
+			return attr.Val
+		}
+	}
+	return ""
+}
+
+// setAnchorAttr sets the given attribute of an anchor token,
+// removing it if val is empty. Returns whether it changed.
+func setAnchorAttr(tok *html.Token, key string, val string) bool {
+	for i, attr := range tok.Attr {
+		if attr.Namespace != "" || attr.Key != key {
+			continue
+		}
+
+		if attr.Val == val {
+			return false
+		}
+
+		if val == "" {
+			tok.Attr = append(tok.Attr[:i], tok.Attr[i+1:]...)
+		} else {
+			tok.Attr[i].Val = val
+		}
+		return true
+	}
+
+	if val == "" {
+		return false
+	}
+
+	tok.Attr = append(tok.Attr, html.Attribute{Key: key, Val: val})
+	return true
+}
+
+// fullyQualified returns whether the
+// given href is an absolute web link.
+func fullyQualified(href string) bool {
+	u, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
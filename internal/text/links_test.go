@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type LinksTestSuite struct {
+	suite.Suite
+}
+
+func (suite *LinksTestSuite) SetupTest() {
+	config.SetHost("example.org")
+	config.SetAccountDomain("")
+	config.SetWebLinkInterstitial(true)
+	config.SetWebLinkInterstitialTrustedDomains([]string{"wikipedia.org"})
+}
+
+func (suite *LinksTestSuite) TearDownTest() {
+	config.SetWebLinkInterstitial(false)
+	config.SetWebLinkInterstitialTrustedDomains(nil)
+}
+
+func (suite *LinksTestSuite) TestLinkHostTrusted() {
+	for host, trusted := range map[string]bool{
+		"example.org":          true,
+		"EXAMPLE.org":          true,
+		"wikipedia.org":        true,
+		"en.wikipedia.org":     true,
+		"notwikipedia.org":     false,
+		"wikipedia.org.evil":   false,
+		"example.com":          false,
+		"exаmple.org":          false, // cyrillic 'а'
+		"sub.example.org.test": false,
+	} {
+		suite.Equal(trusted, text.LinkHostTrusted(host), host)
+	}
+}
+
+func (suite *LinksTestSuite) TestInterstitialLinks() {
+	in := `<p>check out <a href="https://your-bank.example.com/login?a=1&amp;b=2" rel="nofollow noreferrer noopener" target="_blank">https://your-bank.example.org</a>, ` +
+		`<a href="https://example.org/@someone" class="u-url mention">@someone</a> and ` +
+		`<a href="https://en.wikipedia.org/wiki/Phishing">phishing</a><br/>thanks!</p>`
+
+	out := text.InterstitialLinks(in, "/leaving", "url")
+	suite.Equal(`<p>check out <a href="/leaving?url=https%3A%2F%2Fyour-bank.example.com%2Flogin%3Fa%3D1%26b%3D2" rel="nofollow noreferrer noopener" target="_blank">https://your-bank.example.org</a>, `+
+		`<a href="https://example.org/@someone" class="u-url mention">@someone</a> and `+
+		`<a href="https://en.wikipedia.org/wiki/Phishing">phishing</a><br/>thanks!</p>`, out)
+}
+
+func (suite *LinksTestSuite) TestInterstitialLinksDisabled() {
+	config.SetWebLinkInterstitial(false)
+
+	in := `<p><a href="https://example.com">https://example.org</a></p>`
+	suite.Equal(in, text.InterstitialLinks(in, "/leaving", "url"))
+}
+
+func (suite *LinksTestSuite) TestInterstitialLinksRelMe() {
+	in := `<a href="https://example.com" rel="me nofollow noreferrer noopener" target="_blank">https://example.com</a>`
+	suite.Equal(in, text.InterstitialLinks(in, "/leaving", "url"))
+}
+
+func (suite *LinksTestSuite) TestSanitizeLinkRel() {
+	config.SetAdvancedSanitizerLinkRel([]string{"ugc", "noopener"})
+	defer config.SetAdvancedSanitizerLinkRel([]string{"nofollow", "noreferrer", "noopener"})
+
+	in := `<p><a href="https://example.com" rel="me nofollow">https://example.com</a> ` +
+		`<a href="https://example.org/tags/test" class="mention hashtag" rel="tag">#test</a></p>`
+	suite.Equal(`<p><a href="https://example.com" rel="ugc noopener" target="_blank">https://example.com</a> `+
+		`<a href="https://example.org/tags/test" class="mention hashtag" rel="tag ugc noopener" target="_blank">#test</a></p>`, text.SanitizeToHTML(in))
+}
+
+func (suite *LinksTestSuite) TestAddLinkRelMe() {
+	in := `<a href="https://example.com" rel="nofollow noreferrer noopener" target="_blank">https://example.com</a>`
+	suite.Equal(`<a href="https://example.com" rel="me nofollow noreferrer noopener" target="_blank">https://example.com</a>`, text.AddLinkRelMe(in))
+}
+
+func TestLinksTestSuite(t *testing.T) {
+	suite.Run(t, new(LinksTestSuite))
+}
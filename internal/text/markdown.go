@@ -37,6 +37,35 @@ func (f *Formatter) FromMarkdown(
 	authorID string,
 	statusID string,
 	input string,
+) *FormatResult {
+	return f.fromMarkdown(ctx, false, parseMention, authorID, statusID, input)
+}
+
+// FromMarkdownEmojiOnly fulfils FormatFunc by parsing
+// the given markdown input into a FormatResult.
+//
+// Unlike FromMarkdown, it will only parse emojis with
+// the custom renderer, leaving aside mentions and tags.
+func (f *Formatter) FromMarkdownEmojiOnly(
+	ctx context.Context,
+	parseMention gtsmodel.ParseMentionFunc,
+	authorID string,
+	statusID string,
+	input string,
+) *FormatResult {
+	return f.fromMarkdown(ctx, true, parseMention, authorID, statusID, input)
+}
+
+// fromMarkdown parses the given markdown
+// input into a FormatResult, optionally
+// only parsing emojis with custom renderer.
+func (f *Formatter) fromMarkdown(
+	ctx context.Context,
+	emojiOnly bool,
+	parseMention gtsmodel.ParseMentionFunc,
+	authorID string,
+	statusID string,
+	input string,
 ) *FormatResult {
 	result := new(FormatResult)
 
@@ -58,11 +87,17 @@ func (f *Formatter) FromMarkdown(
 				parseMention,
 				authorID,
 				statusID,
-				false, // emojiOnly = false.
+				emojiOnly,
 				result,
 			},
 			extension.Linkify, // Turns URLs into links.
 			extension.Strikethrough,
+			extension.NewTable(
+				// Align cells with an attribute rather
+				// than a style, as styles are sanitized.
+				extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute),
+			),
+			&footnotes{},
 		),
 	)
 
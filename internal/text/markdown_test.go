@@ -59,7 +59,13 @@ const (
 	mdCodeBlockWithNewlines         = "some code coming up\n\n```\n\n\n\n```\nthat was some code"
 	mdCodeBlockWithNewlinesExpected = "<p>some code coming up</p><pre><code>\n\n\n</code></pre><p>that was some code</p>"
 	mdWithFootnote                  = "fox mulder,fbi.[^1]\n\n[^1]: federated bureau of investigation"
-	mdWithFootnoteExpected          = "<p>fox mulder,fbi.[^1]</p><p>[^1]: federated bureau of investigation</p>"
+	mdWithFootnoteExpected          = "<p>fox mulder,fbi.<sup>1</sup></p><section class=\"footnotes\"><hr><ol><li><p>federated bureau of investigation</p></li></ol></section>"
+	mdWithFootnotes                 = "two notes[^a] here[^b] and again[^a].\n\n[^a]: first\n[^b]: second *em*"
+	mdWithFootnotesExpected         = "<p>two notes<sup>1</sup> here<sup>2</sup> and again<sup>1</sup>.</p><section class=\"footnotes\"><hr><ol><li><p>first</p></li><li><p>second <em>em</em></p></li></ol></section>"
+	mdWithTable                     = "a table:\n\n| a | b | c |\n|:--|--:|---|\n| 1 | 2 | 3 |\n\ndone"
+	mdWithTableExpected             = "<p>a table:</p><table><thead><tr><th align=\"left\">a</th><th align=\"right\">b</th><th>c</th></tr></thead><tbody><tr><td align=\"left\">1</td><td align=\"right\">2</td><td>3</td></tr></tbody></table><p>done</p>"
+	mdWithCodeBlockLanguage         = "c++:\n\n```c++\nint main() {}\n```\n"
+	mdWithCodeBlockLanguageExpected = "<p>c++:</p><pre><code class=\"language-c++\">int main() {}\n</code></pre>"
 	mdWithBlockQuote                = "get ready, there's a block quote coming:\n\n>line1\n>line2\n>\n>line3\n\n"
 	mdWithBlockQuoteExpected        = "<p>get ready, there's a block quote coming:</p><blockquote><p>line1<br>line2</p><p>line3</p></blockquote>"
 	mdHashtagAndCodeBlock           = "#Hashtag\n\n```\n#Hashtag\n```"
@@ -146,6 +152,21 @@ func (suite *MarkdownTestSuite) TestParseWithFootnote() {
 	suite.Equal(mdWithFootnoteExpected, formatted.HTML)
 }
 
+func (suite *MarkdownTestSuite) TestParseWithFootnotes() {
+	formatted := suite.FromMarkdown(mdWithFootnotes)
+	suite.Equal(mdWithFootnotesExpected, formatted.HTML)
+}
+
+func (suite *MarkdownTestSuite) TestParseWithTable() {
+	formatted := suite.FromMarkdown(mdWithTable)
+	suite.Equal(mdWithTableExpected, formatted.HTML)
+}
+
+func (suite *MarkdownTestSuite) TestParseWithCodeBlockLanguage() {
+	formatted := suite.FromMarkdown(mdWithCodeBlockLanguage)
+	suite.Equal(mdWithCodeBlockLanguageExpected, formatted.HTML)
+}
+
 func (suite *MarkdownTestSuite) TestParseWithBlockquote() {
 	formatted := suite.FromMarkdown(mdWithBlockQuote)
 	suite.Equal(mdWithBlockQuoteExpected, formatted.HTML)
@@ -0,0 +1,154 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text
+
+import (
+	"strings"
+)
+
+// mfmFrame is a partially parsed MFM
+// function, ie., `$[name.args content]`.
+type mfmFrame struct {
+	open    string          // opening text, used if unclosed
+	name    string          // function name without args
+	content strings.Builder // content parsed so far
+}
+
+// ConvertMFM converts basic Misskey Flavored Markdown
+// (MFM) function markup in the given content to plain
+// HTML, so that it doesn't render as garbage. Most
+// functions (eg., `$[x2 big text]` or `$[spin.speed=2s
+// ...]`) are purely decorative, so they are stripped
+// and only their contents are kept. `$[ruby base text]`
+// is converted to a ruby annotation, since its meaning
+// would otherwise be lost.
+//
+// Unterminated or malformed functions are left as-is.
+// Output is NOT sanitized and must be sanitized after.
+//
+// See: https://misskey-hub.net/en/docs/for-users/features/mfm/
+func ConvertMFM(content string) string {
+	if !strings.Contains(content, "$[") {
+		// Nothing to do.
+		return content
+	}
+
+	var (
+		root  strings.Builder
+		stack []*mfmFrame
+	)
+
+	// current returns the builder
+	// currently being written to.
+	current := func() *strings.Builder {
+		if len(stack) == 0 {
+			return &root
+		}
+		return &stack[len(stack)-1].content
+	}
+
+	for i := 0; i < len(content); {
+		switch {
+		case strings.HasPrefix(content[i:], "$["):
+			// Possible function opening; parse
+			// its name up to the first space.
+			name, n := parseMFMFunc(content[i+2:])
+			if n == 0 {
+				current().WriteString("$[")
+				i += 2
+				continue
+			}
+
+			open := content[i : i+2+n+1]
+			stack = append(stack, &mfmFrame{
+				open: open,
+				name: name,
+			})
+			i += len(open)
+
+		case content[i] == ']' && len(stack) != 0:
+			// Function closing; render
+			// its content to the parent.
+			frame := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			current().WriteString(renderMFMFunc(
+				frame.name,
+				frame.content.String(),
+			))
+			i++
+
+		default:
+			current().WriteByte(content[i])
+			i++
+		}
+	}
+
+	// Write any unterminated
+	// functions back out as-is.
+	for len(stack) != 0 {
+		frame := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		current().WriteString(frame.open)
+		current().WriteString(frame.content.String())
+	}
+
+	return root.String()
+}
+
+// parseMFMFunc parses the name (and any args) of an
+// MFM function from the start of s, returning the name
+// without args, and the length of the name with args.
+// The name must be followed by a space, else 0 is returned.
+func parseMFMFunc(s string) (string, int) {
+	for i, r := range s {
+		switch {
+		case r == ' ':
+			if i == 0 {
+				return "", 0
+			}
+			name, _, _ := strings.Cut(s[:i], ".")
+			return name, i
+
+		case r >= 'a' && r <= 'z',
+			r >= 'A' && r <= 'Z',
+			r >= '0' && r <= '9',
+			r == '.', r == ',', r == '=',
+			r == '-', r == '_':
+			continue
+
+		default:
+			return "", 0
+		}
+	}
+	return "", 0
+}
+
+// renderMFMFunc renders the given MFM function
+// and its already converted content to HTML.
+func renderMFMFunc(name string, content string) string {
+	if name == "ruby" {
+		base, text, ok := strings.Cut(content, " ")
+		if ok && base != "" && text != "" {
+			return "<ruby>" + base + "<rp>(</rp><rt>" + text + "</rt><rp>)</rp></ruby>"
+		}
+	}
+
+	// Decorative function,
+	// keep only the content.
+	return content
+}
@@ -0,0 +1,67 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package text_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+type MFMTestSuite struct {
+	suite.Suite
+}
+
+func (suite *MFMTestSuite) TestConvertMFM() {
+	for _, test := range []struct {
+		in  string
+		out string
+	}{
+		{
+			in:  "<p>no mfm here [at all]</p>",
+			out: "<p>no mfm here [at all]</p>",
+		},
+		{
+			in:  "<p>$[x2 big text] and normal text</p>",
+			out: "<p>big text and normal text</p>",
+		},
+		{
+			in:  "<p>$[spin.speed=2s,alternate $[tada nested] text]</p>",
+			out: "<p>nested text</p>",
+		},
+		{
+			in:  "<p>$[ruby 漢字 かんじ]</p>",
+			out: "<p><ruby>漢字<rp>(</rp><rt>かんじ</rt><rp>)</rp></ruby></p>",
+		},
+		{
+			in:  "<p>$[flip unterminated</p>",
+			out: "<p>$[flip unterminated</p>",
+		},
+		{
+			in:  "<p>costs $[5] or $[] or $[<b>x</b>]</p>",
+			out: "<p>costs $[5] or $[] or $[<b>x</b>]</p>",
+		},
+	} {
+		suite.Equal(test.out, text.ConvertMFM(test.in))
+	}
+}
+
+func TestMFMTestSuite(t *testing.T) {
+	suite.Run(t, new(MFMTestSuite))
+}
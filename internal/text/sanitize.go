@@ -21,14 +21,20 @@ import (
 	"html"
 	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/microcosm-cc/bluemonday"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
 // Regular HTML policy is an adapted version of the default
 // bluemonday UGC policy, with some tweaks of our own.
 // See: https://github.com/microcosm-cc/bluemonday#usage
-var regular *bluemonday.Policy = func() *bluemonday.Policy {
+var regular *bluemonday.Policy = newRegularPolicy()
+
+// newRegularPolicy returns a new instance of the regular HTML policy.
+func newRegularPolicy() *bluemonday.Policy {
 	p := bluemonday.NewPolicy()
 
 	// AllowStandardAttributes will enable "id", "title" and
@@ -49,9 +55,11 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 	// See: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/details
 	p.AllowAttrs("open").Matching(regexp.MustCompile(`(?i)^(|open)$`)).OnElements("details")
 
-	// "section" is permitted and takes no attributes.
+	// "section" is permitted, including the "class" attribute
+	// "footnotes" with which markdown footnotes are wrapped.
 	// See: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/section
 	p.AllowElements("section")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^footnotes$`)).OnElements("section")
 
 	// "summary" is permitted and takes no attributes.
 	// See: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/summary
@@ -97,6 +105,19 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 	// `<span class="h-card"><a href="https://example.org/users/targetAccount" class="u-url mention">@<span>someusername</span></a></span>`
 	p.AllowAttrs("class").OnElements("span")
 
+	/*
+		TABLES
+	*/
+
+	// "table" "thead" "tbody" "tr" are permitted and take no attributes.
+	// See: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/table
+	p.AllowElements("table", "thead", "tbody", "tr")
+
+	// "th" "td" are permitted, including the "align" attribute
+	// which markdown tables use to align the cells of a column.
+	p.AllowElements("th", "td")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+
 	/*
 		LANGUAGE FORMATTING
 	*/
@@ -115,8 +136,9 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 		CODE BLOCKS
 	*/
 
-	// Permit language tags for code elements.
-	p.AllowAttrs("class").Matching(regexp.MustCompile("^language-[a-zA-Z0-9]+$")).OnElements("code")
+	// Permit language tags for code elements, including
+	// those of languages like "c++", "c#", or "objective-c".
+	p.AllowAttrs("class").Matching(regexp.MustCompile("^language-[a-zA-Z0-9_+#-]+$")).OnElements("code")
 
 	// Don't sanitize HTML inside code blocks.
 	p.SkipElementsContent("code", "pre")
@@ -125,6 +147,34 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 		LINKS AND LINK SAFETY.
 	*/
 
+	allowLinks(p)
+
+	return p
+}
+
+// newMinimalPolicy returns a new HTML policy which only
+// permits paragraphs, line breaks, links, mentions, and
+// basic emphasis. Everything else is stripped.
+func newMinimalPolicy() *bluemonday.Policy {
+	p := bluemonday.NewPolicy()
+
+	// "br" "p" "span" are permitted and take no attributes.
+	p.AllowElements("br", "p", "span")
+
+	// Basic emphasis only.
+	p.AllowElements("b", "del", "em", "i", "s", "strong")
+
+	// Class needed on span for mentions, see above.
+	p.AllowAttrs("class").OnElements("span")
+
+	allowLinks(p)
+
+	return p
+}
+
+// allowLinks permits hyperlinks on the given policy,
+// enforcing our link safety rules on all of them.
+func allowLinks(p *bluemonday.Policy) {
 	// Permit hyperlinks.
 	p.AllowAttrs("class", "href", "rel").OnElements("a")
 
@@ -149,9 +199,7 @@ var regular *bluemonday.Policy = func() *bluemonday.Policy {
 	// Force target="_blank".
 	// See: https://developer.mozilla.org/en-US/docs/Web/HTML/Element/a#target
 	p.AddTargetBlankToFullyQualifiedLinks(true)
-
-	return p
-}()
+}
 
 // '[C]an be thought of as equivalent to stripping all HTML
 // elements and their attributes as it has nothing on its allowlist.
@@ -172,7 +220,24 @@ func removeHTML(in string) string {
 // SanitizeToHTML sanitizes only risky html elements
 // from the given string, allowing safe ones through.
 func SanitizeToHTML(in string) string {
-	return regular.Sanitize(in)
+	return applyLinkRel(regular.Sanitize(in))
+}
+
+// SanitizeRemoteHTML sanitizes HTML received from remote
+// instances, according to the configured sanitizer policy.
+func SanitizeRemoteHTML(in string) string {
+	return applyLinkRel(remotePolicy().Sanitize(in))
+}
+
+// ResanitizeRemoteHTML applies the configured sanitizer policy
+// to remote HTML which was already sanitized when received. This
+// is a no-op with the default policy, since any remote HTML will
+// have been sanitized at least that strictly already.
+func ResanitizeRemoteHTML(in string) string {
+	if isDefaultRemotePolicy() {
+		return in
+	}
+	return SanitizeRemoteHTML(in)
 }
 
 // SanitizeToPlaintext runs text through basic sanitization.
@@ -189,3 +254,125 @@ func SanitizeToPlaintext(in string) string {
 	content = html.UnescapeString(content)
 	return strings.TrimSpace(content)
 }
+
+var (
+	paragraphBreak = regexp.MustCompile(`(?i)</p>\s*<p[^>]*>`)
+	lineBreak      = regexp.MustCompile(`(?i)<br\s*/?>`)
+)
+
+// HTMLToPlaintext is like SanitizeToPlaintext, but keeps
+// paragraph and line breaks from the given HTML as newlines,
+// so that the result can be reformatted as a local note.
+func HTMLToPlaintext(in string) string {
+	content := paragraphBreak.ReplaceAllString(in, "\n\n")
+	content = lineBreak.ReplaceAllString(content, "\n")
+	return SanitizeToPlaintext(content)
+}
+
+// Elements and attributes which are never permitted
+// in remote content, even when configured by admins,
+// as they could run code or leak information about
+// viewers without any interaction on their part.
+var (
+	forbiddenTags = map[string]struct{}{
+		"applet": {}, "audio": {}, "base": {}, "button": {},
+		"embed": {}, "form": {}, "frame": {}, "frameset": {},
+		"iframe": {}, "img": {}, "input": {}, "link": {},
+		"math": {}, "meta": {}, "noscript": {}, "object": {},
+		"picture": {}, "script": {}, "select": {}, "source": {},
+		"style": {}, "svg": {}, "template": {}, "textarea": {},
+		"track": {}, "video": {},
+	}
+	forbiddenAttrs = map[string]struct{}{
+		"action": {}, "background": {}, "formaction": {},
+		"poster": {}, "src": {}, "srcset": {}, "style": {},
+	}
+	htmlName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+)
+
+// remote caches the current remote content policy,
+// alongside the configuration it was built from.
+var remote struct {
+	policy *bluemonday.Policy
+	key    string
+	mu     sync.Mutex
+}
+
+// isDefaultRemotePolicy returns whether the configured
+// remote content policy is just the standard policy.
+func isDefaultRemotePolicy() bool {
+	switch config.GetAdvancedSanitizerPolicy() {
+	case "", "standard":
+		return len(config.GetAdvancedSanitizerAllowTags()) == 0 &&
+			len(config.GetAdvancedSanitizerAllowAttrs()) == 0
+	default:
+		return false
+	}
+}
+
+// remotePolicy returns the HTML policy for remote content,
+// (re)building it if the sanitizer configuration changed.
+func remotePolicy() *bluemonday.Policy {
+	var (
+		base  = config.GetAdvancedSanitizerPolicy()
+		tags  = config.GetAdvancedSanitizerAllowTags()
+		attrs = config.GetAdvancedSanitizerAllowAttrs()
+		key   = base + "|" + strings.Join(tags, ",") + "|" + strings.Join(attrs, ",")
+	)
+
+	remote.mu.Lock()
+	defer remote.mu.Unlock()
+
+	if remote.policy == nil || remote.key != key {
+		remote.policy = newRemotePolicy(base, tags, attrs)
+		remote.key = key
+	}
+
+	return remote.policy
+}
+
+// newRemotePolicy builds an HTML policy for remote content
+// from the given base policy name, and extra permitted tags
+// and "element.attribute" pairs. Forbidden or malformed
+// extras are logged and skipped.
+func newRemotePolicy(base string, tags []string, attrs []string) *bluemonday.Policy {
+	var p *bluemonday.Policy
+	switch base {
+	case "minimal":
+		p = newMinimalPolicy()
+	case "", "standard":
+		p = newRegularPolicy()
+	default:
+		log.Warnf(nil, "unknown sanitizer policy %q, falling back to standard", base)
+		p = newRegularPolicy()
+	}
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if _, forbidden := forbiddenTags[tag]; forbidden || !htmlName.MatchString(tag) {
+			log.Warnf(nil, "not permitting html element %q in remote content", tag)
+			continue
+		}
+
+		p.AllowElements(tag)
+	}
+
+	for _, attr := range attrs {
+		tag, name, ok := strings.Cut(strings.ToLower(strings.TrimSpace(attr)), ".")
+		if !ok || !htmlName.MatchString(tag) || !htmlName.MatchString(name) {
+			log.Warnf(nil, "malformed html attribute %q, expected 'element.attribute'", attr)
+			continue
+		}
+
+		_, forbiddenTag := forbiddenTags[tag]
+		_, forbiddenAttr := forbiddenAttrs[name]
+		if forbiddenTag || forbiddenAttr || strings.HasPrefix(name, "on") {
+			log.Warnf(nil, "not permitting html attribute %q in remote content", attr)
+			continue
+		}
+
+		p.AllowAttrs(name).OnElements(tag)
+	}
+
+	return p
+}
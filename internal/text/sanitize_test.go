@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 )
 
@@ -128,6 +129,54 @@ func (suite *SanitizeTestSuite) TestSanitizeInlineImg() {
 	suite.Equal(`<p>Here&#39;s an inline image: </p>`, sanitized)
 }
 
+func (suite *SanitizeTestSuite) TestSanitizeTablesAndFootnotes() {
+	dodgy := `<table><tr><th align="center">a</th><td align="javascript:alert(1)" style="color: red">b</td></tr></table><section class="footnotes"><ol><li>note</li></ol></section><section class="footnotes evil">x</section>`
+	sanitized := text.SanitizeToHTML(dodgy)
+	suite.Equal(`<table><tr><th align="center">a</th><td>b</td></tr></table><section class="footnotes"><ol><li>note</li></ol></section><section>x</section>`, sanitized)
+}
+
+func (suite *SanitizeTestSuite) TestSanitizeRemoteStandard() {
+	config.SetAdvancedSanitizerPolicy("standard")
+	config.SetAdvancedSanitizerAllowTags(nil)
+	config.SetAdvancedSanitizerAllowAttrs(nil)
+
+	remote := `<h1>hi</h1><p><ruby>漢<rt>kan</rt></ruby> <a href="https://example.org">link</a></p><table><tr><td>cell</td></tr></table>`
+	sanitized := text.SanitizeRemoteHTML(remote)
+	suite.Equal(`<h1>hi</h1><p><ruby>漢<rt>kan</rt></ruby> <a href="https://example.org" rel="nofollow noreferrer noopener" target="_blank">link</a></p><table><tr><td>cell</td></tr></table>`, sanitized)
+}
+
+func (suite *SanitizeTestSuite) TestSanitizeRemoteMinimal() {
+	config.SetAdvancedSanitizerPolicy("minimal")
+	config.SetAdvancedSanitizerAllowTags(nil)
+	config.SetAdvancedSanitizerAllowAttrs(nil)
+	defer config.SetAdvancedSanitizerPolicy("standard")
+
+	remote := `<h1>hi</h1><p><ruby>漢<rt>kan</rt></ruby> <strong>bold</strong> <span class="h-card"><a href="https://example.org/@someone" class="u-url mention">@<span>someone</span></a></span></p>`
+	sanitized := text.SanitizeRemoteHTML(remote)
+	suite.Equal(`hi<p>漢kan <strong>bold</strong> <span class="h-card"><a href="https://example.org/@someone" class="u-url mention" rel="nofollow noreferrer noopener" target="_blank">@<span>someone</span></a></span></p>`, sanitized)
+}
+
+func (suite *SanitizeTestSuite) TestSanitizeRemoteExtras() {
+	config.SetAdvancedSanitizerPolicy("minimal")
+	config.SetAdvancedSanitizerAllowTags([]string{"ol", "LI", "img", "iframe"})
+	config.SetAdvancedSanitizerAllowAttrs([]string{"ol.start", "li.onclick", "p.style", "nonsense"})
+	defer func() {
+		config.SetAdvancedSanitizerPolicy("standard")
+		config.SetAdvancedSanitizerAllowTags(nil)
+		config.SetAdvancedSanitizerAllowAttrs(nil)
+	}()
+
+	remote := `<ol start="3"><li onclick="alert(1)">three</li></ol><p style="color: red">hi <img src="https://example.org/track.png"></p><iframe src="https://example.org"></iframe>`
+	sanitized := text.SanitizeRemoteHTML(remote)
+	suite.Equal(`<ol start="3"><li>three</li></ol><p>hi </p>`, sanitized)
+}
+
+func (suite *SanitizeTestSuite) TestHTMLToPlaintext() {
+	note := `<p>hello, i&#39;m <a href="https://example.org/@someone">@someone</a></p><p>i post about:<br/>cats<br>dogs</p>`
+	plain := text.HTMLToPlaintext(note)
+	suite.Equal("hello, i'm @someone\n\ni post about:\ncats\ndogs", plain)
+}
+
 func TestSanitizeTestSuite(t *testing.T) {
 	suite.Run(t, new(SanitizeTestSuite))
 }
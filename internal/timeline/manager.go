@@ -86,7 +86,11 @@ type Manager interface {
 	// Prune manually triggers a prune operation for the given timelineID.
 	Prune(ctx context.Context, timelineID string, desiredPreparedItemsLength int, desiredIndexedItemsLength int) (int, error)
 
-	// Start starts hourly cleanup jobs for this timeline manager.
+	// PruneStale prunes old entries from each timeline which
+	// hasn't been fetched in the hour before the given time.
+	PruneStale(now time.Time)
+
+	// Start starts the timeline manager (currently a stub, doesn't do anything).
 	Start() error
 
 	// Stop stops the timeline manager (currently a stub, doesn't do anything).
@@ -113,46 +117,34 @@ type manager struct {
 }
 
 func (m *manager) Start() error {
-	// Start a background goroutine which iterates
-	// through all stored timelines once per hour,
-	// and cleans up old entries if that timeline
-	// hasn't been accessed in the last hour.
-	go func() {
-		for now := range time.NewTicker(1 * time.Hour).C {
-			now := now // rescope
-			// Define the range function inside here,
-			// so that we can use the 'now' returned
-			// by the ticker, instead of having to call
-			// time.Now() multiple times.
-			//
-			// Unless it panics, this function always
-			// returns 'true', to continue the Range
-			// call through the sync.Map.
-			f := func(_ any, v any) bool {
-				timeline, ok := v.(Timeline)
-				if !ok {
-					log.Panic(nil, "couldn't parse timeline manager sync map value as Timeline, this should never happen so panic")
-				}
-
-				if now.Sub(timeline.LastGot()) < 1*time.Hour {
-					// Timeline has been fetched in the
-					// last hour, move on to the next one.
-					return true
-				}
-
-				if amountPruned := timeline.Prune(pruneLengthPrepared, pruneLengthIndexed); amountPruned > 0 {
-					log.WithField("accountID", timeline.TimelineID()).Infof("pruned %d indexed and prepared items from timeline", amountPruned)
-				}
-
-				return true
-			}
-
-			// Execute the function for each timeline.
-			m.timelines.Range(f)
+	return nil
+}
+
+func (m *manager) PruneStale(now time.Time) {
+	// Unless it panics, this function always
+	// returns 'true', to continue the Range
+	// call through the sync.Map.
+	f := func(_ any, v any) bool {
+		timeline, ok := v.(Timeline)
+		if !ok {
+			log.Panic(nil, "couldn't parse timeline manager sync map value as Timeline, this should never happen so panic")
 		}
-	}()
 
-	return nil
+		if now.Sub(timeline.LastGot()) < 1*time.Hour {
+			// Timeline has been fetched in the
+			// last hour, move on to the next one.
+			return true
+		}
+
+		if amountPruned := timeline.Prune(pruneLengthPrepared, pruneLengthIndexed); amountPruned > 0 {
+			log.WithField("accountID", timeline.TimelineID()).Infof("pruned %d indexed and prepared items from timeline", amountPruned)
+		}
+
+		return true
+	}
+
+	// Execute the function for each timeline.
+	m.timelines.Range(f)
 }
 
 func (m *manager) Stop() error {
@@ -39,6 +39,7 @@ type Account struct {
 	Reason                string          `json:"reason,omitempty" bun:",nullzero"`
 	Locked                *bool           `json:"locked"`
 	Discoverable          *bool           `json:"discoverable"`
+	Indexable             *bool           `json:"indexable,omitempty"`
 	Privacy               string          `json:"privacy,omitempty" bun:",nullzero"`
 	Sensitive             *bool           `json:"sensitive"`
 	Language              string          `json:"language,omitempty" bun:",nullzero"`
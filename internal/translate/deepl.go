@@ -0,0 +1,193 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	deepLFreeURL = "https://api-free.deepl.com"
+	deepLProURL  = "https://api.deepl.com"
+)
+
+// deepL is a Translator
+// backed by the DeepL API.
+type deepL struct {
+	url    string
+	apiKey string
+	langs  languageCache
+	client *http.Client
+}
+
+// deepLTranslateRequest is the JSON
+// body POSTed to /v2/translate.
+type deepLTranslateRequest struct {
+	Text        []string `json:"text"`
+	SourceLang  string   `json:"source_lang,omitempty"`
+	TargetLang  string   `json:"target_lang"`
+	TagHandling string   `json:"tag_handling"`
+}
+
+// deepLTranslateResponse is the JSON
+// body returned by /v2/translate.
+type deepLTranslateResponse struct {
+	Translations []struct {
+		DetectedSourceLanguage string `json:"detected_source_language"`
+		Text                   string `json:"text"`
+	} `json:"translations"`
+}
+
+// deepLLanguage is an entry in the
+// JSON array returned by /v2/languages.
+type deepLLanguage struct {
+	Language string `json:"language"`
+}
+
+// NewDeepL returns a Translator which uses the DeepL
+// API at the given base URL. If url is empty, DeepL API
+// Free is used for Free API keys (which end in ":fx"),
+// and DeepL API Pro otherwise.
+func NewDeepL(url string, apiKey string) Translator {
+	if url == "" {
+		if strings.HasSuffix(apiKey, ":fx") {
+			url = deepLFreeURL
+		} else {
+			url = deepLProURL
+		}
+	}
+
+	return &deepL{
+		url:    strings.TrimSuffix(url, "/"),
+		apiKey: apiKey,
+		client: newClient(),
+	}
+}
+
+func (d *deepL) Provider() string {
+	return "DeepL.com"
+}
+
+func (d *deepL) header() http.Header {
+	return http.Header{
+		"Authorization": []string{"DeepL-Auth-Key " + d.apiKey},
+	}
+}
+
+func (d *deepL) Translate(ctx context.Context, texts []string, source string, target string) ([]string, string, error) {
+	// DeepL only accepts source languages
+	// without a region, eg., "EN", not "EN-GB".
+	source, _, _ = strings.Cut(source, "-")
+
+	var result deepLTranslateResponse
+	if err := doJSON(ctx,
+		d.client,
+		d.Provider(),
+		http.MethodPost,
+		d.url+"/v2/translate",
+		d.header(),
+		deepLTranslateRequest{
+			Text:        texts,
+			SourceLang:  strings.ToUpper(source),
+			TargetLang:  deepLTarget(target),
+			TagHandling: "html",
+		},
+		&result,
+	); err != nil {
+		return nil, "", err
+	}
+
+	if len(result.Translations) != len(texts) {
+		return nil, "", fmt.Errorf("%s returned %d translations for %d texts", d.Provider(), len(result.Translations), len(texts))
+	}
+
+	translated := make([]string, len(texts))
+	for i, t := range result.Translations {
+		translated[i] = t.Text
+	}
+
+	if source == "" {
+		source = strings.ToLower(result.Translations[0].DetectedSourceLanguage)
+	}
+
+	return translated, source, nil
+}
+
+// deepLTarget converts the given target language
+// to one accepted by DeepL, which no longer accepts
+// "EN" or "PT" without a region as targets.
+func deepLTarget(target string) string {
+	switch target = strings.ToUpper(target); target {
+	case "EN":
+		return "EN-US"
+	case "PT":
+		return "PT-PT"
+	default:
+		return target
+	}
+}
+
+func (d *deepL) Languages(ctx context.Context) (map[string][]string, error) {
+	return d.langs.get(ctx, d.fetchLanguages)
+}
+
+func (d *deepL) fetchLanguages(ctx context.Context) (map[string][]string, error) {
+	var sources, targets []deepLLanguage
+
+	for _, l := range []struct {
+		typ  string
+		dest *[]deepLLanguage
+	}{
+		{"source", &sources},
+		{"target", &targets},
+	} {
+		if err := doJSON(ctx,
+			d.client,
+			d.Provider(),
+			http.MethodGet,
+			d.url+"/v2/languages?type="+l.typ,
+			d.header(),
+			nil,
+			l.dest,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	// DeepL can translate from any
+	// source into any other target.
+	langs := make(map[string][]string, len(sources))
+	for _, s := range sources {
+		source := strings.ToLower(s.Language)
+
+		langTargets := make([]string, 0, len(targets))
+		for _, t := range targets {
+			target := strings.ToLower(t.Language)
+			if base, _, _ := strings.Cut(target, "-"); base != source {
+				langTargets = append(langTargets, target)
+			}
+		}
+
+		langs[source] = langTargets
+	}
+
+	return langs, nil
+}
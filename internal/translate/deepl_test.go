@@ -0,0 +1,131 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DeepLTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+
+	// last request posted to the server.
+	request deepLTranslateRequest
+}
+
+func (suite *DeepLTestSuite) SetupTest() {
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "DeepL-Auth-Key api-key:fx" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/v2/languages":
+			if r.URL.Query().Get("type") == "target" {
+				_, _ = w.Write([]byte(`[{"language":"DE","name":"German"},{"language":"EN-GB","name":"English (British)"},{"language":"EN-US","name":"English (American)"}]`))
+			} else {
+				_, _ = w.Write([]byte(`[{"language":"DE","name":"German"},{"language":"EN","name":"English"}]`))
+			}
+
+		case "/v2/translate":
+			suite.request = deepLTranslateRequest{}
+			if err := json.NewDecoder(r.Body).Decode(&suite.request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			rsp := deepLTranslateResponse{}
+			for _, text := range suite.request.Text {
+				rsp.Translations = append(rsp.Translations, struct {
+					DetectedSourceLanguage string `json:"detected_source_language"`
+					Text                   string `json:"text"`
+				}{"DE", "[" + suite.request.TargetLang + "] " + text})
+			}
+			_ = json.NewEncoder(w).Encode(rsp)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func (suite *DeepLTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *DeepLTestSuite) TestDefaultURL() {
+	suite.Equal(deepLFreeURL, NewDeepL("", "api-key:fx").(*deepL).url)
+	suite.Equal(deepLProURL, NewDeepL("", "api-key").(*deepL).url)
+}
+
+func (suite *DeepLTestSuite) TestTranslate() {
+	d := NewDeepL(suite.server.URL, "api-key:fx")
+
+	texts, source, err := d.Translate(context.Background(), []string{"<p>hallo</p>"}, "de-AT", "en")
+	suite.NoError(err)
+	suite.Equal([]string{"[EN-US] <p>hallo</p>"}, texts)
+	suite.Equal("de", source)
+	suite.Equal(deepLTranslateRequest{
+		Text:        []string{"<p>hallo</p>"},
+		SourceLang:  "DE",
+		TargetLang:  "EN-US",
+		TagHandling: "html",
+	}, suite.request)
+}
+
+func (suite *DeepLTestSuite) TestTranslateDetectSource() {
+	d := NewDeepL(suite.server.URL, "api-key:fx")
+
+	_, source, err := d.Translate(context.Background(), []string{"hallo"}, "", "en-gb")
+	suite.NoError(err)
+	suite.Equal("de", source)
+	suite.Empty(suite.request.SourceLang)
+	suite.Equal("EN-GB", suite.request.TargetLang)
+}
+
+func (suite *DeepLTestSuite) TestTranslateBadAPIKey() {
+	d := NewDeepL(suite.server.URL, "wrong-key")
+
+	_, _, err := d.Translate(context.Background(), []string{"hello"}, "en", "de")
+	suite.EqualError(err, "DeepL.com responded 403 Forbidden")
+}
+
+func (suite *DeepLTestSuite) TestLanguages() {
+	d := NewDeepL(suite.server.URL, "api-key:fx")
+
+	langs, err := d.Languages(context.Background())
+	suite.NoError(err)
+	suite.Equal(map[string][]string{
+		"de": {"en-gb", "en-us"},
+		"en": {"de"},
+	}, langs)
+}
+
+func TestDeepLTestSuite(t *testing.T) {
+	suite.Run(t, new(DeepLTestSuite))
+}
@@ -0,0 +1,152 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// libreTranslate is a Translator
+// backed by a LibreTranslate server.
+type libreTranslate struct {
+	url    string
+	apiKey string
+	langs  languageCache
+	client *http.Client
+}
+
+// libreTranslateRequest is the JSON
+// body POSTed to /translate.
+type libreTranslateRequest struct {
+	Q      []string `json:"q"`
+	Source string   `json:"source"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+	APIKey string   `json:"api_key,omitempty"`
+}
+
+// libreTranslateResponse is the JSON body
+// returned by /translate. DetectedLanguage
+// is only set when source was "auto".
+type libreTranslateResponse struct {
+	TranslatedText   []string `json:"translatedText"`
+	DetectedLanguage []struct {
+		Language string `json:"language"`
+	} `json:"detectedLanguage"`
+}
+
+// libreTranslateLanguage is an entry
+// in the JSON array returned by /languages.
+type libreTranslateLanguage struct {
+	Code    string   `json:"code"`
+	Targets []string `json:"targets"`
+}
+
+// NewLibreTranslate returns a Translator which
+// uses the LibreTranslate server at the given
+// base URL. apiKey may be empty if the server
+// doesn't require one.
+func NewLibreTranslate(url string, apiKey string) Translator {
+	return &libreTranslate{
+		url:    strings.TrimSuffix(url, "/"),
+		apiKey: apiKey,
+		client: newClient(),
+	}
+}
+
+func (l *libreTranslate) Provider() string {
+	return "LibreTranslate"
+}
+
+func (l *libreTranslate) Translate(ctx context.Context, texts []string, source string, target string) ([]string, string, error) {
+	if source == "" {
+		source = "auto"
+	}
+
+	var result libreTranslateResponse
+	if err := doJSON(ctx,
+		l.client,
+		l.Provider(),
+		http.MethodPost,
+		l.url+"/translate",
+		nil,
+		libreTranslateRequest{
+			Q:      texts,
+			Source: source,
+			Target: target,
+			Format: "html",
+			APIKey: l.apiKey,
+		},
+		&result,
+	); err != nil {
+		return nil, "", err
+	}
+
+	if len(result.TranslatedText) != len(texts) {
+		return nil, "", fmt.Errorf("%s returned %d translations for %d texts", l.Provider(), len(result.TranslatedText), len(texts))
+	}
+
+	if source == "auto" {
+		if len(result.DetectedLanguage) == 0 {
+			return nil, "", errors.New(l.Provider() + " did not return detected language")
+		}
+		source = result.DetectedLanguage[0].Language
+	}
+
+	return result.TranslatedText, source, nil
+}
+
+func (l *libreTranslate) Languages(ctx context.Context) (map[string][]string, error) {
+	return l.langs.get(ctx, l.fetchLanguages)
+}
+
+func (l *libreTranslate) fetchLanguages(ctx context.Context) (map[string][]string, error) {
+	var result []libreTranslateLanguage
+	if err := doJSON(ctx,
+		l.client,
+		l.Provider(),
+		http.MethodGet,
+		l.url+"/languages",
+		nil,
+		nil,
+		&result,
+	); err != nil {
+		return nil, err
+	}
+
+	langs := make(map[string][]string, len(result))
+	for _, lang := range result {
+		source := strings.ToLower(lang.Code)
+
+		targets := make([]string, 0, len(lang.Targets))
+		for _, target := range lang.Targets {
+			target = strings.ToLower(target)
+			if target != source {
+				targets = append(targets, target)
+			}
+		}
+
+		langs[source] = targets
+	}
+
+	return langs, nil
+}
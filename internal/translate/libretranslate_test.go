@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package translate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LibreTranslateTestSuite struct {
+	suite.Suite
+	server *httptest.Server
+
+	// last request posted to the server.
+	request libreTranslateRequest
+
+	// number of requests for languages.
+	languageRequests int
+}
+
+func (suite *LibreTranslateTestSuite) SetupTest() {
+	suite.languageRequests = 0
+	suite.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/languages":
+			suite.languageRequests++
+			_, _ = w.Write([]byte(`[{"code":"en","name":"English","targets":["de","en","fr"]},{"code":"de","name":"German","targets":["de","en"]}]`))
+
+		case "/translate":
+			suite.request = libreTranslateRequest{}
+			if err := json.NewDecoder(r.Body).Decode(&suite.request); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if suite.request.APIKey != "api-key" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"error":"Invalid API key"}`))
+				return
+			}
+
+			rsp := libreTranslateResponse{}
+			for _, q := range suite.request.Q {
+				rsp.TranslatedText = append(rsp.TranslatedText, "["+suite.request.Target+"] "+q)
+				if suite.request.Source == "auto" {
+					rsp.DetectedLanguage = append(rsp.DetectedLanguage, struct {
+						Language string `json:"language"`
+					}{"de"})
+				}
+			}
+			_ = json.NewEncoder(w).Encode(rsp)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func (suite *LibreTranslateTestSuite) TearDownTest() {
+	suite.server.Close()
+}
+
+func (suite *LibreTranslateTestSuite) TestTranslate() {
+	l := NewLibreTranslate(suite.server.URL+"/", "api-key")
+
+	texts, source, err := l.Translate(context.Background(), []string{"<p>hello</p>", "cw"}, "en", "fr")
+	suite.NoError(err)
+	suite.Equal([]string{"[fr] <p>hello</p>", "[fr] cw"}, texts)
+	suite.Equal("en", source)
+	suite.Equal(libreTranslateRequest{
+		Q:      []string{"<p>hello</p>", "cw"},
+		Source: "en",
+		Target: "fr",
+		Format: "html",
+		APIKey: "api-key",
+	}, suite.request)
+}
+
+func (suite *LibreTranslateTestSuite) TestTranslateDetectSource() {
+	l := NewLibreTranslate(suite.server.URL, "api-key")
+
+	texts, source, err := l.Translate(context.Background(), []string{"<p>hallo</p>"}, "", "en")
+	suite.NoError(err)
+	suite.Equal([]string{"[en] <p>hallo</p>"}, texts)
+	suite.Equal("de", source)
+	suite.Equal("auto", suite.request.Source)
+}
+
+func (suite *LibreTranslateTestSuite) TestTranslateBadAPIKey() {
+	l := NewLibreTranslate(suite.server.URL, "wrong-key")
+
+	_, _, err := l.Translate(context.Background(), []string{"hello"}, "en", "de")
+	suite.EqualError(err, `LibreTranslate responded 403 Forbidden: {"error":"Invalid API key"}`)
+}
+
+func (suite *LibreTranslateTestSuite) TestLanguages() {
+	l := NewLibreTranslate(suite.server.URL, "")
+
+	for i := 0; i < 2; i++ {
+		langs, err := l.Languages(context.Background())
+		suite.NoError(err)
+		suite.Equal(map[string][]string{
+			"en": {"de", "fr"},
+			"de": {"en"},
+		}, langs)
+	}
+
+	// Second call should have been cached.
+	suite.Equal(1, suite.languageRequests)
+}
+
+func TestLibreTranslateTestSuite(t *testing.T) {
+	suite.Run(t, new(LibreTranslateTestSuite))
+}
@@ -0,0 +1,183 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+// Package translate provides the hook through which
+// statuses can be translated by an external service.
+package translate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+// languagesTTL is how long the languages supported
+// by a translation backend are remembered for, as
+// they're needed for every translation but rarely
+// change.
+const languagesTTL = 24 * time.Hour
+
+// Translator is a translation backend, which
+// statuses are sent to when users ask for them
+// to be translated into another language.
+type Translator interface {
+	// Provider returns the name of the translation
+	// backend, eg., "DeepL.com", so that clients can
+	// credit it alongside translations.
+	Provider() string
+
+	// Translate translates each of the given HTML texts
+	// from the source language into the target language,
+	// returning the translated texts in the same order.
+	// If source is empty, the backend detects it. The
+	// source language is returned alongside the texts.
+	Translate(ctx context.Context, texts []string, source string, target string) ([]string, string, error)
+
+	// Languages returns the languages that the backend
+	// can translate from, mapped to the languages that
+	// each of them can be translated into. Languages are
+	// given as lowercase ISO 639 / BCP 47 codes.
+	Languages(ctx context.Context) (map[string][]string, error)
+}
+
+// New returns the Translator set up in the instance
+// configuration, or nil if translation is disabled.
+func New() (Translator, error) {
+	var (
+		url    = config.GetStatusesTranslationURL()
+		apiKey = config.GetStatusesTranslationAPIKey()
+	)
+
+	switch backend := config.GetStatusesTranslationBackend(); backend {
+	case "":
+		return nil, nil
+	case config.StatusesTranslationBackendLibreTranslate:
+		return NewLibreTranslate(url, apiKey), nil
+	case config.StatusesTranslationBackendDeepL:
+		return NewDeepL(url, apiKey), nil
+	default:
+		return nil, fmt.Errorf("translation backend %s not recognized", backend)
+	}
+}
+
+// languageCache remembers the
+// languages a backend supports.
+type languageCache struct {
+	mu      sync.Mutex
+	langs   map[string][]string
+	fetched time.Time
+}
+
+// get returns cached languages, calling
+// fetch for them if they're missing or
+// have expired. Errors aren't cached.
+func (l *languageCache) get(
+	ctx context.Context,
+	fetch func(context.Context) (map[string][]string, error),
+) (map[string][]string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.langs != nil && time.Since(l.fetched) < languagesTTL {
+		return l.langs, nil
+	}
+
+	langs, err := fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l.langs = langs
+	l.fetched = time.Now()
+	return langs, nil
+}
+
+// doJSON performs an HTTP request against a translation
+// backend, sending body (if any) JSON-encoded and decoding
+// the JSON response into out.
+func doJSON(
+	ctx context.Context,
+	client *http.Client,
+	provider string,
+	method string,
+	url string,
+	header http.Header,
+	body any,
+	out any,
+) error {
+	var r io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, r)
+	if err != nil {
+		return err
+	}
+
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	rsp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+
+	// Translations of long statuses can be
+	// a fair bit bigger than the original.
+	lr := io.LimitReader(rsp.Body, 1024*1024)
+
+	if rsp.StatusCode != http.StatusOK {
+		// Include the start of the body, as backends
+		// explain what went wrong in their responses.
+		b, _ := io.ReadAll(io.LimitReader(lr, 256))
+		if msg := strings.TrimSpace(string(b)); msg != "" {
+			return fmt.Errorf("%s responded %s: %s", provider, rsp.Status, msg)
+		}
+		return fmt.Errorf("%s responded %s", provider, rsp.Status)
+	}
+
+	if err := json.NewDecoder(lr).Decode(out); err != nil {
+		return fmt.Errorf("error decoding %s response: %w", provider, err)
+	}
+
+	return nil
+}
+
+// newClient returns an HTTP client
+// for talking to a translation backend.
+func newClient() *http.Client {
+	return &http.Client{Timeout: 30 * time.Second}
+}
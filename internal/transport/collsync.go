@@ -0,0 +1,118 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/federation/collsync"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+)
+
+// followersSync checks whether the given serialized activity is
+// addressed to the followers collection of the account owning this
+// transport. If so, it returns a function generating the FEP-8fcf
+// Collection-Synchronization header value for a recipient host,
+// containing a digest of the followers on that host. Otherwise nil.
+func (t *transport) followersSync(ctx context.Context, b []byte) func(host string) string {
+	account, err := t.controller.state.DB.GetAccountByPubkeyID(
+		gtscontext.SetBarebones(ctx),
+		t.pubKeyID,
+	)
+	if err != nil {
+		log.Debugf(ctx, "error getting transport account %s: %v", t.pubKeyID, err)
+		return nil
+	}
+
+	if !account.IsLocal() || !addressedTo(b, account.FollowersURI) {
+		// Only synchronize followers for
+		// local followers-addressed activities.
+		return nil
+	}
+
+	follows, err := t.controller.state.DB.GetAccountFollowers(ctx, account.ID, nil)
+	if err != nil {
+		log.Errorf(ctx, "error getting followers of %s: %v", account.URI, err)
+		return nil
+	}
+
+	// Group follower URIs by their host.
+	byHost := make(map[string][]string)
+	for _, follow := range follows {
+		if follow.Account == nil {
+			continue
+		}
+
+		uri, err := url.Parse(follow.Account.URI)
+		if err != nil {
+			continue
+		}
+
+		byHost[uri.Host] = append(byHost[uri.Host], follow.Account.URI)
+	}
+
+	syncURL := uris.GenerateURIForFollowersSync(account.Username)
+	return func(host string) string {
+		return collsync.Header{
+			CollectionID: account.FollowersURI,
+			URL:          syncURL,
+			Digest:       collsync.Digest(byHost[host]),
+		}.String()
+	}
+}
+
+// addressedTo returns whether the given serialized activity
+// contains the given IRI in either of its 'to' or 'cc' fields.
+func addressedTo(b []byte, iri string) bool {
+	var activity struct {
+		To json.RawMessage `json:"to"`
+		Cc json.RawMessage `json:"cc"`
+	}
+
+	if err := json.Unmarshal(b, &activity); err != nil {
+		return false
+	}
+
+	for _, raw := range []json.RawMessage{activity.To, activity.Cc} {
+		if len(raw) == 0 {
+			continue
+		}
+
+		// Addressing may be a single IRI or an array of them.
+		var iris []string
+		if err := json.Unmarshal(raw, &iris); err != nil {
+			var single string
+			if err := json.Unmarshal(raw, &single); err != nil {
+				continue
+			}
+			iris = []string{single}
+		}
+
+		for _, i := range iris {
+			if i == iri {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/collsync"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type CollSyncTestSuite struct {
+	TransportTestSuite
+}
+
+func (suite *CollSyncTestSuite) deliver(b []byte) string {
+	var header string
+	client := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		header = req.Header.Get(collsync.HeaderName)
+		return response(req, http.StatusAccepted, "text/plain"), nil
+	}, "../../testrig/media")
+
+	tc := testrig.NewTestTransportController(&suite.state, client)
+	ts, err := tc.NewTransportForUsername(context.Background(), "the_mighty_zork")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	to := testrig.URLMustParse("https://fossbros-anonymous.io/inbox")
+	if err := ts.Deliver(context.Background(), b, to); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return header
+}
+
+func (suite *CollSyncTestSuite) TestDeliverFollowersSync() {
+	var (
+		ctx      = context.Background()
+		account  = suite.testAccounts["local_account_1"]
+		follower = suite.testAccounts["remote_account_1"]
+	)
+
+	// Add a follow from a remote account on the recipient host.
+	if err := suite.state.DB.PutFollow(ctx, &gtsmodel.Follow{
+		ID:              "01HDQ0JN5ZBW4V3TDSQB4S7QDB",
+		URI:             "http://fossbros-anonymous.io/users/foss_satan/follow/01HDQ0JN5ZBW4V3TDSQB4S7QDB",
+		AccountID:       follower.ID,
+		TargetAccountID: account.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	header := suite.deliver([]byte(`{
+		"type": "Create",
+		"to": "https://www.w3.org/ns/activitystreams#Public",
+		"cc": ["` + account.FollowersURI + `"]
+	}`))
+
+	hdr, err := collsync.ParseHeader(header)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(account.FollowersURI, hdr.CollectionID)
+	suite.Equal("http://localhost:8080/users/the_mighty_zork/followers_synchronization", hdr.URL)
+	suite.Equal(collsync.Digest([]string{follower.URI}), hdr.Digest)
+}
+
+func (suite *CollSyncTestSuite) TestDeliverNoFollowersSync() {
+	header := suite.deliver([]byte(`{
+		"type": "Create",
+		"to": ["https://fossbros-anonymous.io/users/foss_satan"]
+	}`))
+	suite.Empty(header)
+}
+
+func TestCollSyncTestSuite(t *testing.T) {
+	suite.Run(t, &CollSyncTestSuite{})
+}
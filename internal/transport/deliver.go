@@ -26,7 +26,10 @@ import (
 	"codeberg.org/gruf/go-byteutil"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/collsync"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/interop"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*url.URL) error {
@@ -46,6 +49,10 @@ func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*ur
 		// Get current instance host info.
 		domain = config.GetAccountDomain()
 		host   = config.GetHost()
+
+		// Get followers synchronization
+		// header generator, if applicable.
+		sync = t.followersSync(ctx, b)
 	)
 
 	// Block on expect no. senders.
@@ -80,7 +87,7 @@ func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*ur
 				}
 
 				// Attempt to deliver data to recipient.
-				if err := t.deliver(ctx, b, to); err != nil {
+				if err := t.deliver(ctx, b, to, sync); err != nil {
 					mutex.Lock() // safely append err to accumulator.
 					errs.Appendf("error delivering to %s: %v", to, err)
 					mutex.Unlock()
@@ -103,29 +110,61 @@ func (t *transport) Deliver(ctx context.Context, b []byte, to *url.URL) error {
 	}
 
 	// Deliver data to recipient.
-	return t.deliver(ctx, b, to)
+	return t.deliver(ctx, b, to, t.followersSync(ctx, b))
 }
 
-func (t *transport) deliver(ctx context.Context, b []byte, to *url.URL) error {
-	url := to.String()
+func (t *transport) deliver(ctx context.Context, b []byte, to *url.URL, sync func(string) string) error {
+	// Don't waste time delivering
+	// to domains that seem dead.
+	if t.deliveriesSuspended(ctx, to.Host) {
+		return gtserror.Newf("deliveries to %s are suspended", to.Host)
+	}
 
-	// Use rewindable bytes reader for body.
-	var body byteutil.ReadNopCloser
-	body.Reset(b)
+	err := t.doDeliver(ctx, b, to, sync)
+	t.recordContact(ctx, to.Host, gtsmodel.DomainContactDelivery, err)
+	return err
+}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", url, &body)
-	if err != nil {
-		return err
+// doDeliver performs the actual delivery
+// of data to recipient, for deliver.
+func (t *transport) doDeliver(ctx context.Context, b []byte, to *url.URL, sync func(string) string) error {
+	// Check for learned quirks of the remote domain.
+	profile := interop.Get(ctx, t.controller.state, to.Host)
+
+	contentType := apiutil.AppActivityLDJSON
+	if interop.DeliverActivityJSON.In(profile) {
+		contentType = apiutil.AppActivityJSON
 	}
 
-	req.Header.Add("Content-Type", string(apiutil.AppActivityLDJSON))
-	req.Header.Add("Accept-Charset", "utf-8")
-	req.Header.Set("Host", to.Host)
+	// Generate followers synchronization
+	// header for recipient host, if set.
+	var syncHdr string
+	if sync != nil {
+		syncHdr = sync(to.Host)
+	}
 
-	rsp, err := t.POST(req, b)
+	rsp, err := t.post(ctx, b, to, contentType, syncHdr)
 	if err != nil {
 		return err
 	}
+
+	if rsp.StatusCode == http.StatusUnsupportedMediaType &&
+		contentType != apiutil.AppActivityJSON {
+		// Remote may not understand our usual Content-Type,
+		// retry delivery with only the most common type.
+		_ = rsp.Body.Close()
+
+		rsp, err = t.post(ctx, b, to, apiutil.AppActivityJSON, syncHdr)
+		if err != nil {
+			return err
+		}
+
+		if code := rsp.StatusCode; code == http.StatusOK ||
+			code == http.StatusCreated || code == http.StatusAccepted {
+			interop.LearnQuirk(ctx, t.controller.state, to.Host, interop.DeliverActivityJSON,
+				"delivery to "+to.String()+" rejected Content-Type: "+string(contentType))
+		}
+	}
 	defer rsp.Body.Close()
 
 	if code := rsp.StatusCode; code != http.StatusOK &&
@@ -135,3 +174,27 @@ func (t *transport) deliver(ctx context.Context, b []byte, to *url.URL) error {
 
 	return nil
 }
+
+// post performs a delivery of data to recipient,
+// with the request body of given content-type,
+// and FEP-8fcf synchronization header if non-empty.
+func (t *transport) post(ctx context.Context, b []byte, to *url.URL, contentType apiutil.MIME, syncHdr string) (*http.Response, error) {
+	// Use rewindable bytes reader for body.
+	var body byteutil.ReadNopCloser
+	body.Reset(b)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", to.String(), &body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", string(contentType))
+	req.Header.Add("Accept-Charset", "utf-8")
+	req.Header.Set("Host", to.Host)
+
+	if syncHdr != "" {
+		req.Header.Set(collsync.HeaderName, syncHdr)
+	}
+
+	return t.POST(req, b)
+}
@@ -20,53 +20,148 @@ package transport
 import (
 	"context"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/interop"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 func (t *transport) Dereference(ctx context.Context, iri *url.URL) ([]byte, error) {
+	b, _, err := t.DereferenceMoved(ctx, iri)
+	return b, err
+}
+
+func (t *transport) DereferenceMoved(ctx context.Context, iri *url.URL) ([]byte, *url.URL, error) {
 	// if the request is to us, we can shortcut for certain URIs rather than going through
 	// the normal request flow, thereby saving time and energy
 	if iri.Host == config.GetHost() {
 		if uris.IsFollowersPath(iri) {
 			// the request is for followers of one of our accounts, which we can shortcut
-			return t.controller.dereferenceLocalFollowers(ctx, iri)
+			b, err := t.controller.dereferenceLocalFollowers(ctx, iri)
+			return b, nil, err
 		}
 
 		if uris.IsUserPath(iri) {
 			// the request is for one of our accounts, which we can shortcut
-			return t.controller.dereferenceLocalUser(ctx, iri)
+			b, err := t.controller.dereferenceLocalUser(ctx, iri)
+			return b, nil, err
 		}
 	}
 
+	b, moved, err := t.dereferenceRemote(ctx, iri)
+	t.recordContact(ctx, iri.Host, gtsmodel.DomainContactDereference, err)
+	return b, moved, err
+}
+
+// dereferenceRemote performs the actual dereference
+// of the given IRI on a remote domain, for DereferenceMoved.
+func (t *transport) dereferenceRemote(ctx context.Context, iri *url.URL) ([]byte, *url.URL, error) {
 	// Build IRI just once
 	iriStr := iri.String()
 
 	// Prepare new HTTP request to endpoint
 	req, err := http.NewRequestWithContext(ctx, "GET", iriStr, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	req.Header.Add("Accept", string(apiutil.AppActivityLDJSON)+","+string(apiutil.AppActivityJSON))
+	// Check for learned quirks of the remote domain.
+	profile := interop.Get(ctx, t.controller.state, iri.Host)
+
+	accept := string(apiutil.AppActivityLDJSON) + "," + string(apiutil.AppActivityJSON)
+	if interop.AcceptActivityJSON.In(profile) {
+		accept = string(apiutil.AppActivityJSON)
+	}
+
+	req.Header.Add("Accept", accept)
 	req.Header.Add("Accept-Charset", "utf-8")
 	req.Header.Set("Host", iri.Host)
 
 	// Perform the HTTP request
 	rsp, err := t.GET(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	if rsp.StatusCode == http.StatusNotAcceptable &&
+		accept != string(apiutil.AppActivityJSON) {
+		// Remote may not understand our usual Accept
+		// header, retry with only the most common type.
+		_ = rsp.Body.Close()
+
+		req = req.Clone(ctx)
+		req.Header.Set("Accept", string(apiutil.AppActivityJSON))
+
+		rsp, err = t.GET(req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if rsp.StatusCode == http.StatusOK {
+			interop.LearnQuirk(ctx, t.controller.state, iri.Host, interop.AcceptActivityJSON,
+				"dereference of "+iriStr+" rejected Accept: "+accept)
+		}
 	}
 	defer rsp.Body.Close()
 
 	if rsp.StatusCode != http.StatusOK {
-		return nil, gtserror.NewFromResponse(rsp)
+		return nil, nil, gtserror.NewFromResponse(rsp)
+	}
+
+	if ct := rsp.Header.Get("Content-Type"); !isActivityContentType(ct) {
+		// Served with unexpected content-type, which we
+		// accept anyway; note this for debugging interop.
+		interop.LearnQuirk(ctx, t.controller.state, iri.Host, interop.LaxContentType,
+			"dereference of "+iriStr+" served with Content-Type: "+ct)
+	}
+
+	b, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return b, permanentRedirect(rsp), nil
+}
+
+// isActivityContentType returns whether given Content-Type
+// header value is one of the ActivityPub specified types.
+func isActivityContentType(ct string) bool {
+	mediaType, _, err := mime.ParseMediaType(ct)
+	if err != nil {
+		return false
+	}
+	return mediaType == string(apiutil.AppActivityJSON) ||
+		mediaType == "application/ld+json"
+}
+
+// permanentRedirect returns the final request URL of the response
+// if it was reached via permanent redirects (HTTP 301 / 308) only.
+// If any redirects were temporary, or there were none, returns nil.
+func permanentRedirect(rsp *http.Response) *url.URL {
+	if rsp.Request == nil || rsp.Request.Response == nil {
+		// No redirects.
+		return nil
+	}
+
+	// Walk back through the chain of redirect responses.
+	for req := rsp.Request; req != nil && req.Response != nil; req = req.Response.Request {
+		switch req.Response.StatusCode {
+		case http.StatusMovedPermanently,
+			http.StatusPermanentRedirect:
+			// Permanent.
+
+		default:
+			// Temporary redirect in chain,
+			// so don't treat as moved.
+			return nil
+		}
 	}
 
-	return io.ReadAll(rsp.Body)
+	return rsp.Request.URL
 }
@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DereferenceTestSuite struct {
+	TransportTestSuite
+}
+
+// redirectingClient returns a mock http client which simulates the
+// response chain produced by net/http following redirects from the
+// requested URL, with given status codes, to the final URL given.
+func (suite *DereferenceTestSuite) redirectingClient(final string, codes ...int) *testrig.MockHTTPClient {
+	return testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		finalURL, _ := url.Parse(final)
+
+		// Build the redirected request chain,
+		// each linked to the response before it.
+		last := req
+		for _, code := range codes {
+			last = &http.Request{
+				Method: http.MethodGet,
+				URL:    finalURL,
+				Header: http.Header{},
+				Response: &http.Response{
+					StatusCode: code,
+					Request:    last,
+				},
+			}
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader([]byte("{}"))),
+			Request:    last,
+		}, nil
+	}, "../../testrig/media")
+}
+
+func (suite *DereferenceTestSuite) dereferenceMoved(client *testrig.MockHTTPClient) *url.URL {
+	tc := testrig.NewTestTransportController(&suite.state, client)
+	ts, err := tc.NewTransportForUsername(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	iri := testrig.URLMustParse("https://example.org/users/someone")
+	_, moved, err := ts.DereferenceMoved(context.Background(), iri)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return moved
+}
+
+func (suite *DereferenceTestSuite) TestDereferenceNotMoved() {
+	moved := suite.dereferenceMoved(suite.redirectingClient("https://example.org/users/someone"))
+	suite.Nil(moved)
+}
+
+func (suite *DereferenceTestSuite) TestDereferencePermanentlyMoved() {
+	moved := suite.dereferenceMoved(suite.redirectingClient(
+		"https://example.com/users/someone",
+		http.StatusMovedPermanently,
+		http.StatusPermanentRedirect,
+	))
+	suite.NotNil(moved)
+	suite.Equal("https://example.com/users/someone", moved.String())
+}
+
+func (suite *DereferenceTestSuite) TestDereferenceTemporarilyMoved() {
+	moved := suite.dereferenceMoved(suite.redirectingClient(
+		"https://example.com/users/someone",
+		http.StatusMovedPermanently,
+		http.StatusFound,
+	))
+	suite.Nil(moved)
+}
+
+func TestDereferenceTestSuite(t *testing.T) {
+	suite.Run(t, &DereferenceTestSuite{})
+}
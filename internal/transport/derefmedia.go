@@ -19,6 +19,7 @@ package transport
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"net/url"
@@ -26,28 +27,70 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 )
 
-func (t *transport) DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, error) {
+// ErrNotModified is returned by DereferenceMediaConditional
+// when the remote media has not been modified since it was
+// last fetched, according to the given cache validators.
+var ErrNotModified = errors.New("media not modified")
+
+// MediaValidators are the HTTP cache validators returned
+// by a remote server with a piece of media, which can be
+// used to make a conditional request for it later on.
+type MediaValidators struct {
+	ETag         string // value of ETag header, if any
+	LastModified string // value of Last-Modified header, if any
+}
+
+// IsZero returns whether no validators are set.
+func (v *MediaValidators) IsZero() bool {
+	return v.ETag == "" && v.LastModified == ""
+}
+
+func (t *transport) DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, *url.URL, error) {
+	// Without validators, the request is unconditional.
+	return t.DereferenceMediaConditional(ctx, iri, &MediaValidators{})
+}
+
+func (t *transport) DereferenceMediaConditional(ctx context.Context, iri *url.URL, validators *MediaValidators) (io.ReadCloser, int64, *url.URL, error) {
 	// Build IRI just once
 	iriStr := iri.String()
 
 	// Prepare HTTP request to this media's IRI
 	req, err := http.NewRequestWithContext(ctx, "GET", iriStr, nil)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
 	req.Header.Add("Accept", "*/*") // we don't know what kind of media we're going to get here
 	req.Header.Set("Host", iri.Host)
 
+	// Make the request conditional
+	// on any validators we have.
+	if validators.ETag != "" {
+		req.Header.Set("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		req.Header.Set("If-Modified-Since", validators.LastModified)
+	}
+
 	// Perform the HTTP request
 	rsp, err := t.GET(req)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
 
-	// Check for an expected status code
-	if rsp.StatusCode != http.StatusOK {
-		return nil, 0, gtserror.NewFromResponse(rsp)
+	switch rsp.StatusCode {
+	case http.StatusOK:
+		// Media (possibly) changed,
+		// store latest validators.
+		validators.ETag = rsp.Header.Get("ETag")
+		validators.LastModified = rsp.Header.Get("Last-Modified")
+
+	case http.StatusNotModified:
+		_ = rsp.Body.Close()
+		return nil, 0, nil, ErrNotModified
+
+	default:
+		return nil, 0, nil, gtserror.NewFromResponse(rsp)
 	}
 
-	return rsp.Body, rsp.ContentLength, nil
+	return rsp.Body, rsp.ContentLength, permanentRedirect(rsp), nil
 }
@@ -0,0 +1,119 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DerefMediaTestSuite struct {
+	TransportTestSuite
+}
+
+// etagClient returns a mock http client which serves
+// media with the given ETag, responding with 304 Not
+// Modified to requests which already have that ETag.
+func (suite *DerefMediaTestSuite) etagClient(etag string) *testrig.MockHTTPClient {
+	return testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("If-None-Match") == etag {
+			return &http.Response{
+				StatusCode: http.StatusNotModified,
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+				Request:    req,
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header: http.Header{
+				"Etag":          []string{etag},
+				"Last-Modified": []string{"Mon, 30 Oct 2023 10:00:00 GMT"},
+			},
+			Body:    io.NopCloser(bytes.NewReader([]byte("some media"))),
+			Request: req,
+		}, nil
+	}, "../../testrig/media")
+}
+
+func (suite *DerefMediaTestSuite) transport(client *testrig.MockHTTPClient) transport.Transport {
+	tc := testrig.NewTestTransportController(&suite.state, client)
+	ts, err := tc.NewTransportForUsername(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return ts
+}
+
+func (suite *DerefMediaTestSuite) TestDereferenceMediaConditional() {
+	var (
+		ctx        = context.Background()
+		ts         = suite.transport(suite.etagClient(`"abc123"`))
+		iri        = testrig.URLMustParse("https://example.org/media/avatar.png")
+		validators = transport.MediaValidators{}
+	)
+
+	// No validators, so media should be fetched.
+	rc, _, _, err := ts.DereferenceMediaConditional(ctx, iri, &validators)
+	suite.NoError(err)
+	b, _ := io.ReadAll(rc)
+	rc.Close()
+	suite.Equal("some media", string(b))
+
+	// Validators should have been set from the response.
+	suite.Equal(`"abc123"`, validators.ETag)
+	suite.Equal("Mon, 30 Oct 2023 10:00:00 GMT", validators.LastModified)
+
+	// Media hasn't changed, so second request with
+	// validators should indicate it's not modified.
+	rc, _, _, err = ts.DereferenceMediaConditional(ctx, iri, &validators)
+	suite.Nil(rc)
+	suite.True(errors.Is(err, transport.ErrNotModified))
+
+	// Validators should be untouched.
+	suite.Equal(`"abc123"`, validators.ETag)
+}
+
+func (suite *DerefMediaTestSuite) TestDereferenceMediaConditionalChanged() {
+	var (
+		ctx        = context.Background()
+		ts         = suite.transport(suite.etagClient(`"def456"`))
+		iri        = testrig.URLMustParse("https://example.org/media/avatar.png")
+		validators = transport.MediaValidators{ETag: `"abc123"`}
+	)
+
+	// Media has changed, so should be fetched again.
+	rc, _, _, err := ts.DereferenceMediaConditional(ctx, iri, &validators)
+	suite.NoError(err)
+	rc.Close()
+
+	// Validators should have been updated.
+	suite.Equal(`"def456"`, validators.ETag)
+}
+
+func TestDerefMediaTestSuite(t *testing.T) {
+	suite.Run(t, &DerefMediaTestSuite{})
+}
@@ -0,0 +1,149 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type InteropTestSuite struct {
+	TransportTestSuite
+}
+
+// newTransport returns a new transport using
+// a mock http client with the given do func.
+func (suite *InteropTestSuite) newTransport(do func(req *http.Request) (*http.Response, error)) transport.Transport {
+	client := testrig.NewMockHTTPClient(do, "../../testrig/media")
+	tc := testrig.NewTestTransportController(&suite.state, client)
+	ts, err := tc.NewTransportForUsername(context.Background(), "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	return ts
+}
+
+func response(req *http.Request, code int, contentType string) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       io.NopCloser(bytes.NewReader([]byte("{}"))),
+		Request:    req,
+	}
+}
+
+func (suite *InteropTestSuite) TestLearnAcceptActivityJSON() {
+	var accepts []string
+	ts := suite.newTransport(func(req *http.Request) (*http.Response, error) {
+		accept := req.Header.Get("Accept")
+		accepts = append(accepts, accept)
+		if accept != "application/activity+json" {
+			return response(req, http.StatusNotAcceptable, "text/plain"), nil
+		}
+		return response(req, http.StatusOK, "application/activity+json"), nil
+	})
+
+	iri := testrig.URLMustParse("https://picky.example.org/users/someone")
+
+	// First dereference should fail, then
+	// retry and learn the Accept quirk.
+	if _, err := ts.Dereference(context.Background(), iri); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(accepts, 2)
+
+	interop, err := suite.state.DB.GetDomainInterop(context.Background(), "picky.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*interop.AcceptActivityJSON)
+	suite.False(*interop.LaxContentType)
+	suite.NotEmpty(interop.LastQuirk)
+
+	// Second dereference should use the
+	// workaround straight away, no retry.
+	if _, err := ts.Dereference(context.Background(), iri); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(accepts, 3)
+	suite.Equal("application/activity+json", accepts[2])
+}
+
+func (suite *InteropTestSuite) TestLearnLaxContentType() {
+	ts := suite.newTransport(func(req *http.Request) (*http.Response, error) {
+		return response(req, http.StatusOK, "application/json; charset=utf-8"), nil
+	})
+
+	iri := testrig.URLMustParse("https://lax.example.org/users/someone")
+	if _, err := ts.Dereference(context.Background(), iri); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	interop, err := suite.state.DB.GetDomainInterop(context.Background(), "lax.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*interop.LaxContentType)
+	suite.False(*interop.AcceptActivityJSON)
+}
+
+func (suite *InteropTestSuite) TestLearnDeliverActivityJSON() {
+	var contentTypes []string
+	ts := suite.newTransport(func(req *http.Request) (*http.Response, error) {
+		contentType := req.Header.Get("Content-Type")
+		contentTypes = append(contentTypes, contentType)
+		if contentType != "application/activity+json" {
+			return response(req, http.StatusUnsupportedMediaType, "text/plain"), nil
+		}
+		return response(req, http.StatusAccepted, "text/plain"), nil
+	})
+
+	to := testrig.URLMustParse("https://picky.example.org/users/someone/inbox")
+
+	// First delivery should be retried
+	// after learning Content-Type quirk.
+	if err := ts.Deliver(context.Background(), []byte("{}"), to); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(contentTypes, 2)
+
+	interop, err := suite.state.DB.GetDomainInterop(context.Background(), "picky.example.org")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*interop.DeliverActivityJSON)
+
+	// Second delivery should use the
+	// workaround straight away, no retry.
+	if err := ts.Deliver(context.Background(), []byte("{}"), to); err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(contentTypes, 3)
+	suite.Equal("application/activity+json", contentTypes[2])
+}
+
+func TestInteropTestSuite(t *testing.T) {
+	suite.Run(t, &InteropTestSuite{})
+}
@@ -0,0 +1,114 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package transport
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// maxFailureLen is the maximum length
+// of a failure stored in domain stats.
+const maxFailureLen = 256
+
+// recordContact records the outcome of an outgoing contact with the
+// given domain in its federation stats, with err being the error returned
+// by the contact, if any. Errors recording are logged rather than returned.
+func (t *transport) recordContact(ctx context.Context, domain string, contact gtsmodel.DomainContact, err error) {
+	if ctx.Err() != nil {
+		// We gave up on the contact, eg.
+		// during shutdown; not their fault.
+		return
+	}
+
+	var failure string
+	if err != nil {
+		failure = err.Error()
+	}
+
+	if contact == gtsmodel.DomainContactDereference {
+		// For dereferences, any response below 5xx means the
+		// remote is alive and well, even if it didn't have what
+		// we asked for (eg. a deleted status 404ing or 410ing).
+		if code := gtserror.StatusCode(err); code != 0 && code < http.StatusInternalServerError {
+			failure = ""
+		}
+	}
+
+	if r := []rune(failure); len(r) > maxFailureLen {
+		failure = string(r[:maxFailureLen])
+	}
+
+	if err := t.controller.state.DB.RecordDomainContact(ctx, domain, contact, failure); err != nil {
+		log.Errorf(ctx, "error recording %s stats for %s: %v", contact, domain, err)
+		return
+	}
+
+	if contact == gtsmodel.DomainContactDelivery && failure != "" {
+		t.checkDead(ctx, domain)
+	}
+}
+
+// checkDead suspends deliveries to the given domain if it has failed
+// enough consecutive deliveries over a long enough time to seem dead.
+// Deliveries are resumed once the domain successfully contacts us.
+func (t *transport) checkDead(ctx context.Context, domain string) {
+	maxFailures := config.GetInstanceDeadDeliveryFailures()
+	if maxFailures <= 0 {
+		// Suspension disabled.
+		return
+	}
+
+	stats, err := t.controller.state.DB.GetDomainStats(ctx, domain)
+	if err != nil {
+		log.Errorf(ctx, "error getting stats for %s: %v", domain, err)
+		return
+	}
+
+	if stats.DeliveriesSuspended() ||
+		stats.ConsecutiveDeliveryFailures < int64(maxFailures) ||
+		time.Since(stats.DeliveryFailingSince) < config.GetInstanceDeadDeliveryWindow() {
+		// Not (newly) dead.
+		return
+	}
+
+	if err := t.controller.state.DB.SuspendDomainDeliveries(ctx, domain); err != nil {
+		log.Errorf(ctx, "error suspending deliveries to %s: %v", domain, err)
+		return
+	}
+
+	log.Warnf(ctx, "suspended deliveries to %s after %d consecutive failures since %s",
+		domain, stats.ConsecutiveDeliveryFailures, stats.DeliveryFailingSince.Format(time.RFC3339))
+}
+
+// deliveriesSuspended returns whether deliveries to the given domain are
+// suspended. Errors checking are logged, and deliveries are then attempted.
+func (t *transport) deliveriesSuspended(ctx context.Context, domain string) bool {
+	suspended, err := t.controller.state.DB.AreDomainDeliveriesSuspended(ctx, domain)
+	if err != nil {
+		log.Errorf(ctx, "error checking delivery suspension of %s: %v", domain, err)
+		return false
+	}
+	return suspended
+}
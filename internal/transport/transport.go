@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/go-fed/httpsig"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/interop"
 	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
@@ -59,8 +60,18 @@ type Transport interface {
 	// Dereference fetches the ActivityStreams object located at this IRI with a GET request.
 	Dereference(ctx context.Context, iri *url.URL) ([]byte, error)
 
+	// DereferenceMoved is like Dereference, but if the object was found to have permanently
+	// moved (ie., HTTP 301 / 308 redirect), its new location is also returned, else nil.
+	DereferenceMoved(ctx context.Context, iri *url.URL) ([]byte, *url.URL, error)
+
 	// DereferenceMedia fetches the given media attachment IRI, returning the reader and filesize.
-	DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, error)
+	// If the media was found to have permanently moved, its new location is also returned, else nil.
+	DereferenceMedia(ctx context.Context, iri *url.URL) (io.ReadCloser, int64, *url.URL, error)
+
+	// DereferenceMediaConditional is like DereferenceMedia, but the request is made conditional on
+	// the given cache validators (if set), returning ErrNotModified if the media is unchanged. If the
+	// media is fetched, the given validators are updated with those returned by the remote server.
+	DereferenceMediaConditional(ctx context.Context, iri *url.URL, validators *MediaValidators) (io.ReadCloser, int64, *url.URL, error)
 
 	// DereferenceInstance dereferences remote instance information, first by checking /api/v1/instance, and then by checking /.well-known/nodeinfo.
 	DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error)
@@ -90,7 +101,34 @@ func (t *transport) GET(r *http.Request) (*http.Response, error) {
 	ctx = gtscontext.SetOutgoingPublicKeyID(ctx, t.pubKeyID)
 	r = r.WithContext(ctx) // replace request ctx.
 	r.Header.Set("User-Agent", t.controller.userAgent)
-	return t.controller.client.DoSigned(r, t.signGET())
+
+	if r.URL.RawQuery == "" {
+		// Simple case, no query to worry about.
+		return t.controller.client.DoSigned(r, t.signGET())
+	}
+
+	// Check for learned quirks of the remote domain.
+	profile := interop.Get(ctx, t.controller.state, r.URL.Host)
+	if interop.SignGETWithoutQuery.In(profile) {
+		return t.controller.client.DoSigned(r, t.signGETWithoutQuery())
+	}
+
+	rsp, err := t.controller.client.DoSigned(r.Clone(ctx), t.signGET())
+	if err != nil || rsp.StatusCode != http.StatusUnauthorized {
+		return rsp, err
+	}
+
+	// Remote may verify signatures over the
+	// request path only, excluding the query.
+	_ = rsp.Body.Close()
+
+	rsp, err = t.controller.client.DoSigned(r, t.signGETWithoutQuery())
+	if err == nil && rsp.StatusCode == http.StatusOK {
+		interop.LearnQuirk(ctx, t.controller.state, r.URL.Host, interop.SignGETWithoutQuery,
+			"signed GET of "+r.URL.String()+" rejected as unauthorized")
+	}
+
+	return rsp, err
 }
 
 // POST will perform given http request using transport client, retrying on certain preset errors.
@@ -115,6 +153,18 @@ func (t *transport) signGET() httpclient.SignFunc {
 	}
 }
 
+// signGETWithoutQuery will safely sign an HTTP GET request,
+// excluding the URL query from the signed request-target.
+func (t *transport) signGETWithoutQuery() httpclient.SignFunc {
+	sign := t.signGET()
+	return func(r *http.Request) error {
+		rawQuery := r.URL.RawQuery
+		r.URL.RawQuery = ""
+		defer func() { r.URL.RawQuery = rawQuery }()
+		return sign(r)
+	}
+}
+
 // signPOST will safely sign an HTTP POST request for given body.
 func (t *transport) signPOST(body []byte) httpclient.SignFunc {
 	return func(r *http.Request) (err error) {
@@ -21,15 +21,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"html"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -143,6 +148,13 @@ func (c *Converter) ASRepresentationToAccount(ctx context.Context, accountable a
 		acct.Discoverable = &d
 	}
 
+	// indexable
+	// leave nil if not set, since older
+	// software doesn't know about it
+	if i, ok := ap.ExtractIndexable(accountable); ok {
+		acct.Indexable = &i
+	}
+
 	// assume not rss feed
 	enableRSS := false
 	acct.EnableRSS = &enableRSS
@@ -198,7 +210,13 @@ func (c *Converter) ASRepresentationToAccount(ctx context.Context, accountable a
 
 	// TODO: FeaturedTagsURI
 
-	// TODO: alsoKnownAs
+	// alsoKnownAs
+	if alsoKnownAs := ap.ExtractAlsoKnownAsURIs(accountable); len(alsoKnownAs) != 0 {
+		acct.AlsoKnownAsURIs = make([]string, len(alsoKnownAs))
+		for i, uri := range alsoKnownAs {
+			acct.AlsoKnownAsURIs[i] = uri.String()
+		}
+	}
 
 	// publicKey
 	pkey, pkeyURL, pkeyOwnerID, err := ap.ExtractPublicKey(accountable)
@@ -248,6 +266,32 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	// The (html-formatted) content of this status.
 	status.Content = ap.ExtractContent(statusable)
 
+	switch statusable.GetTypeName() {
+	case ap.ObjectArticle, ap.ObjectPage, ap.ObjectVideo, ap.ObjectEvent:
+		// Long-form and media types use `name` as a title,
+		// which is not shown for Notes. We have no separate
+		// title field, so prepend it to content as a title line.
+		if name := ap.ExtractName(statusable); name != "" {
+			status.Content = "<p><strong>" + html.EscapeString(name) + "</strong></p>" + status.Content
+		}
+	}
+
+	// status.EventStartAt
+	// status.EventEndAt
+	//
+	// Start and end times of an Event, if applicable.
+	if eventable, ok := ap.ToEventable(statusable); ok {
+		status.EventStartAt = ap.ExtractStartTime(eventable)
+		status.EventEndAt = ap.ExtractEndTime(eventable)
+	}
+
+	// status.Location
+	//
+	// Name of the place this status was posted
+	// from, if set. Coordinates are deliberately
+	// not stored, only the human-readable name.
+	status.Location = text.SanitizeToPlaintext(ap.ExtractLocation(statusable))
+
 	// status.Attachments
 	//
 	// Media attachments for later dereferencing.
@@ -367,6 +411,41 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		}
 	}
 
+	// status.QuoteOfURI
+	// status.QuoteOfID
+	//
+	// Status that this status quotes, if applicable (eg.,
+	// Misskey / Fedibird quote posts). We don't dereference
+	// quoted statuses, just set the ID if we already have it.
+	if uri := ap.ExtractQuoteURI(statusable); uri != nil {
+		quoteOfURI := uri.String()
+		status.QuoteOfURI = quoteOfURI
+
+		// Check if we already have the quoted status.
+		quoteOf, err := c.state.DB.GetStatusByURI(
+			gtscontext.SetBarebones(ctx),
+			quoteOfURI,
+		)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			// Real database error.
+			err = gtserror.Newf("db error getting quoted status %s: %w", quoteOfURI, err)
+			return nil, err
+		}
+
+		if quoteOf != nil {
+			status.QuoteOfID = quoteOf.ID
+		}
+
+		// Clients that don't understand quotes would otherwise
+		// show no indication of the quote, so append a link to
+		// the quoted status if content doesn't already have one.
+		if !strings.Contains(status.Content, quoteOfURI) {
+			status.Content += `<span class="quote-inline"><br><br>RE: <a href="` +
+				html.EscapeString(quoteOfURI) + `">` +
+				html.EscapeString(quoteOfURI) + `</a></span>`
+		}
+	}
+
 	// status.Visibility
 	visibility, err := ap.ExtractVisibility(
 		statusable,
@@ -397,6 +476,26 @@ func (c *Converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	// language
 	// TODO: we might be able to extract this from the contentMap field
 
+	// status.RemoteFavesCount
+	// status.RemoteBoostsCount
+	//
+	// Totals of the status' likes / shares collections,
+	// if embedded, as we only see some faves / boosts.
+	var countsOK bool
+	if withLikes, ok := statusable.(ap.WithLikes); ok {
+		var ok bool
+		status.RemoteFavesCount, _, ok = ap.ExtractLikesTotal(withLikes)
+		countsOK = countsOK || ok
+	}
+	if withShares, ok := statusable.(ap.WithShares); ok {
+		var ok bool
+		status.RemoteBoostsCount, _, ok = ap.ExtractSharesTotal(withShares)
+		countsOK = countsOK || ok
+	}
+	if countsOK {
+		status.CountsFetchedAt = time.Now()
+	}
+
 	// ActivityStreamsType
 	status.ActivityStreamsType = statusable.GetTypeName()
 
@@ -522,6 +621,58 @@ func (c *Converter) ASLikeToFave(ctx context.Context, likeable ap.Likeable) (*gt
 	}, nil
 }
 
+// ASLikeToStatusReaction converts a remote activitystreams 'like' representation
+// carrying an emoji reaction in its content into a gts model status reaction.
+//
+// If the reaction is a custom emoji shortcode, and a matching emoji is found in
+// the tags of the like, then the returned reaction will have its Emoji field set
+// to a barebones emoji model, which should be dereferenced by the caller.
+func (c *Converter) ASLikeToStatusReaction(ctx context.Context, reactable ap.Reactable) (*gtsmodel.StatusReaction, error) {
+	content := ap.ExtractContent(reactable)
+	if content == "" {
+		return nil, errors.New("no content set on like, cannot convert to reaction")
+	}
+
+	// Reuse fave conversion logic to get the
+	// uri, origin + target account, and status.
+	fave, err := c.ASLikeToFave(ctx, reactable)
+	if err != nil {
+		return nil, err
+	}
+
+	reaction := &gtsmodel.StatusReaction{
+		AccountID:       fave.AccountID,
+		Account:         fave.Account,
+		TargetAccountID: fave.TargetAccountID,
+		TargetAccount:   fave.TargetAccount,
+		StatusID:        fave.StatusID,
+		Status:          fave.Status,
+		Content:         content,
+		URI:             fave.URI,
+	}
+
+	shortcode := strings.Trim(content, ":")
+	if shortcode == content {
+		// Unicode emoji,
+		// nothing to do.
+		return reaction, nil
+	}
+
+	emojis, err := ap.ExtractEmojis(reactable)
+	if err != nil {
+		return nil, gtserror.Newf("error extracting emojis: %w", err)
+	}
+
+	for _, emoji := range emojis {
+		if emoji.Shortcode == shortcode {
+			reaction.Emoji = emoji
+			break
+		}
+	}
+
+	return reaction, nil
+}
+
 // ASBlockToBlock converts a remote activity streams 'block' representation into a gts model block.
 func (c *Converter) ASBlockToBlock(ctx context.Context, blockable ap.Blockable) (*gtsmodel.Block, error) {
 	idProp := blockable.GetJSONLDId()
@@ -617,7 +768,7 @@ func (c *Converter) ASAnnounceToStatus(ctx context.Context, announceable ap.Anno
 	status.URI = statusURIStr
 
 	// Get the URI of the boosted status.
-	boostOfURI, err := ap.ExtractObjectURI(announceable)
+	boostOfURI, err := ap.ExtractAnnounceObjectURI(announceable)
 	if err != nil {
 		err = gtserror.Newf("error extracting Object: %w", err)
 		return nil, isNew, err
@@ -630,11 +781,12 @@ func (c *Converter) ASAnnounceToStatus(ctx context.Context, announceable ap.Anno
 	}
 	status.BoostOf = boostOf
 
-	// Extract published time for the boost.
+	// Extract published time for the boost. Groups
+	// (eg., Lemmy communities) don't always set this
+	// on Announces, so fall back to the current time.
 	published, err := ap.ExtractPublished(announceable)
 	if err != nil {
-		err = gtserror.Newf("error extracting published: %w", err)
-		return nil, isNew, err
+		published = time.Now()
 	}
 	status.CreatedAt = published
 	status.UpdatedAt = published
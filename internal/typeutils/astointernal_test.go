@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/activity/streams"
@@ -175,6 +176,120 @@ func (suite *ASToInternalTestSuite) TestParseReplyWithMention() {
 	suite.Equal(gtsmodel.VisibilityUnlocked, status.Visibility)
 }
 
+func (suite *ASToInternalTestSuite) TestParseMisskeyQuote() {
+	t := suite.jsonToType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/users/foss_satan/statuses/9k2xk1t2c9",
+  "type": "Note",
+  "attributedTo": "http://fossbros-anonymous.io/users/foss_satan",
+  "content": "<p>look at this lol</p>",
+  "published": "2023-10-29T10:00:00Z",
+  "to": [
+    "https://www.w3.org/ns/activitystreams#Public"
+  ],
+  "_misskey_quote": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY",
+  "quoteUri": "http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY"
+}`)
+	rep, ok := t.(ap.Statusable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	status, err := suite.typeconverter.ASStatusToStatus(context.Background(), rep)
+	suite.NoError(err)
+
+	quoted := suite.testStatuses["local_account_1_status_1"]
+	suite.Equal(quoted.URI, status.QuoteOfURI)
+	suite.Equal(quoted.ID, status.QuoteOfID)
+	suite.Equal(`<p>look at this lol</p><span class="quote-inline"><br><br>RE: <a href="http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY">http://localhost:8080/users/the_mighty_zork/statuses/01F8MHAMCHF6Y650WCRSCP4WMY</a></span>`, status.Content)
+}
+
+func (suite *ASToInternalTestSuite) TestParseArticle() {
+	t := suite.jsonToType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/users/foss_satan/articles/why-foss-is-great",
+  "type": "Article",
+  "attributedTo": "http://fossbros-anonymous.io/users/foss_satan",
+  "name": "Why FOSS is great & other <truths>",
+  "content": "<p>It just is.</p>",
+  "published": "2023-10-30T10:00:00Z",
+  "to": [
+    "https://www.w3.org/ns/activitystreams#Public"
+  ]
+}`)
+	rep, ok := t.(ap.Statusable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	status, err := suite.typeconverter.ASStatusToStatus(context.Background(), rep)
+	suite.NoError(err)
+
+	suite.Equal(ap.ObjectArticle, status.ActivityStreamsType)
+	suite.Equal(`<p><strong>Why FOSS is great &amp; other &lt;truths&gt;</strong></p><p>It just is.</p>`, status.Content)
+	suite.Zero(status.EventStartAt)
+	suite.Zero(status.EventEndAt)
+}
+
+func (suite *ASToInternalTestSuite) TestParseEvent() {
+	t := suite.jsonToType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/users/foss_satan/events/foss-meetup",
+  "type": "Event",
+  "attributedTo": "http://fossbros-anonymous.io/users/foss_satan",
+  "name": "FOSS meetup",
+  "content": "<p>Come along!</p>",
+  "startTime": "2023-11-05T18:00:00Z",
+  "endTime": "2023-11-05T21:30:00Z",
+  "published": "2023-10-30T10:00:00Z",
+  "to": [
+    "https://www.w3.org/ns/activitystreams#Public"
+  ]
+}`)
+	rep, ok := t.(ap.Statusable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	status, err := suite.typeconverter.ASStatusToStatus(context.Background(), rep)
+	suite.NoError(err)
+
+	suite.Equal(ap.ObjectEvent, status.ActivityStreamsType)
+	suite.Equal(`<p><strong>FOSS meetup</strong></p><p>Come along!</p>`, status.Content)
+	suite.Equal("2023-11-05T18:00:00Z", status.EventStartAt.UTC().Format(time.RFC3339))
+	suite.Equal("2023-11-05T21:30:00Z", status.EventEndAt.UTC().Format(time.RFC3339))
+}
+
+func (suite *ASToInternalTestSuite) TestParseStatusWithLocation() {
+	t := suite.jsonToType(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "http://fossbros-anonymous.io/users/foss_satan/statuses/01HE7XJ1QCSDYHXK2A1Z9V6J0T",
+  "type": "Note",
+  "attributedTo": "http://fossbros-anonymous.io/users/foss_satan",
+  "content": "<p>at the station</p>",
+  "location": {
+    "type": "Place",
+    "name": "<em>Amsterdam</em> Centraal",
+    "latitude": 52.3791,
+    "longitude": 4.9003
+  },
+  "published": "2023-10-30T10:00:00Z",
+  "to": [
+    "https://www.w3.org/ns/activitystreams#Public"
+  ]
+}`)
+	rep, ok := t.(ap.Statusable)
+	if !ok {
+		suite.FailNow("type not coercible")
+	}
+
+	status, err := suite.typeconverter.ASStatusToStatus(context.Background(), rep)
+	suite.NoError(err)
+
+	// Only the sanitized place name should be kept.
+	suite.Equal("Amsterdam Centraal", status.Location)
+}
+
 func (suite *ASToInternalTestSuite) TestParseOwncastService() {
 	t := suite.jsonToType(owncastService)
 	rep, ok := t.(ap.Accountable)
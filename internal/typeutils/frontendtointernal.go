@@ -47,3 +47,23 @@ func APIMarkerNameToMarkerName(m apimodel.MarkerName) gtsmodel.MarkerName {
 	}
 	return ""
 }
+
+func APIEmailNotificationsToEmailNotifications(m string) gtsmodel.EmailNotifications {
+	switch m {
+	case "immediate":
+		return gtsmodel.EmailNotificationsImmediate
+	case "daily":
+		return gtsmodel.EmailNotificationsDaily
+	}
+	return gtsmodel.EmailNotificationsNone
+}
+
+func APIHighlightsDigestToHighlightsDigest(m string) gtsmodel.HighlightsDigest {
+	switch m {
+	case "daily":
+		return gtsmodel.HighlightsDigestDaily
+	case "email":
+		return gtsmodel.HighlightsDigestEmail
+	}
+	return gtsmodel.HighlightsDigestNone
+}
@@ -118,7 +118,15 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	person.SetTootFeatured(featuredProp)
 
 	// featuredTags
-	// NOT IMPLEMENTED
+	// Hashtags featured on the profile.
+	// Not part of the go-fed vocab, so set as raw property.
+	if a.IsLocal() {
+		featuredTagsURI, err := url.Parse(uris.GenerateURIForFeaturedTags(a.Username))
+		if err != nil {
+			return nil, err
+		}
+		ap.SetFeaturedTagsURI(person, featuredTagsURI)
+	}
 
 	// preferredUsername
 	// Used for Webfinger lookup. Must be unique on the domain, and must correspond to a Webfinger acct: URI.
@@ -166,12 +174,18 @@ func (c *Converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	discoverableProp.Set(*a.Discoverable)
 	person.SetTootDiscoverable(discoverableProp)
 
+	// indexable
+	// Whether statuses may be found by search.
+	// Not part of the go-fed vocab, so set as raw property.
+	ap.SetIndexable(person, a.Indexable != nil && *a.Indexable)
+
 	// devices
 	// NOT IMPLEMENTED, probably won't implement
 
 	// alsoKnownAs
 	// Required for Move activity.
-	// TODO: NOT IMPLEMENTED **YET** -- this needs to be added as an activitypub extension to https://github.com/go-fed/activity, see https://github.com/go-fed/activity/tree/master/astool
+	// Not part of the go-fed vocab, so set as raw property.
+	ap.SetAlsoKnownAsURIs(person, a.AlsoKnownAsURIs)
 
 	// publicKey
 	// Required for signatures.
@@ -609,7 +623,13 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 			attachments = append(attachments, attachment)
 		}
 	}
+	sensitive := *s.Sensitive
 	for _, a := range attachments {
+		// There's no way to federate sensitivity
+		// per attachment, so the closest thing is
+		// to mark the whole status as sensitive.
+		sensitive = sensitive || a.IsSensitive()
+
 		doc, err := c.AttachmentToAS(ctx, a)
 		if err != nil {
 			return nil, gtserror.Newf("error converting attachment: %w", err)
@@ -630,9 +650,21 @@ func (c *Converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Stat
 
 	// sensitive
 	sensitiveProp := streams.NewActivityStreamsSensitiveProperty()
-	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive)
+	sensitiveProp.AppendXMLSchemaBoolean(sensitive)
 	status.SetActivityStreamsSensitive(sensitiveProp)
 
+	// location
+	if s.Location != "" {
+		place := streams.NewActivityStreamsPlace()
+		placeNameProp := streams.NewActivityStreamsNameProperty()
+		placeNameProp.AppendXMLSchemaString(s.Location)
+		place.SetActivityStreamsName(placeNameProp)
+
+		locationProp := streams.NewActivityStreamsLocationProperty()
+		locationProp.AppendActivityStreamsPlace(place)
+		status.SetActivityStreamsLocation(locationProp)
+	}
+
 	return status, nil
 }
 
@@ -1064,6 +1096,50 @@ func (c *Converter) FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab
 	return like, nil
 }
 
+// StatusReactionToAS converts a gts model status reaction into an activityStreams LIKE,
+// with the reaction set as content, and any custom emoji used for the reaction set as a tag.
+// This is the format understood by Misskey, Pleroma, and Akkoma, while other implementations
+// (eg., Mastodon) will simply treat the reaction as a fave.
+func (c *Converter) StatusReactionToAS(ctx context.Context, r *gtsmodel.StatusReaction) (vocab.ActivityStreamsLike, error) {
+	if err := c.state.DB.PopulateStatusReaction(ctx, r); err != nil {
+		return nil, gtserror.Newf("error populating reaction: %w", err)
+	}
+
+	// A reaction is just a fave with extra
+	// steps, so reuse the fave conversion.
+	like, err := c.FaveToAS(ctx, &gtsmodel.StatusFave{
+		AccountID:       r.AccountID,
+		Account:         r.Account,
+		TargetAccountID: r.TargetAccountID,
+		TargetAccount:   r.TargetAccount,
+		StatusID:        r.StatusID,
+		Status:          r.Status,
+		URI:             r.URI,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Set the reaction as content.
+	contentProp := streams.NewActivityStreamsContentProperty()
+	contentProp.AppendXMLSchemaString(r.Content)
+	like.SetActivityStreamsContent(contentProp)
+
+	if r.Emoji != nil {
+		// Set custom emoji as tag.
+		asEmoji, err := c.EmojiToAS(ctx, r.Emoji)
+		if err != nil {
+			return nil, gtserror.Newf("error converting emoji %s: %w", r.Emoji.ID, err)
+		}
+
+		tagProp := streams.NewActivityStreamsTagProperty()
+		tagProp.AppendTootEmoji(asEmoji)
+		like.SetActivityStreamsTag(tagProp)
+	}
+
+	return like, nil
+}
+
 // BoostToAS converts a gts model boost into an activityStreams ANNOUNCE, suitable for federation
 func (c *Converter) BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) (vocab.ActivityStreamsAnnounce, error) {
 	// the boosted status is probably pinned to the boostWrapperStatus but double check to make sure
@@ -1110,33 +1186,50 @@ func (c *Converter) BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.
 	publishedProp.Set(boostWrapperStatus.CreatedAt)
 	announce.SetActivityStreamsPublished(publishedProp)
 
-	// set the to
+	// set the to and cc based on the visibility of the
+	// boost itself, which may be narrower than that of
+	// the boosted status (eg., an unlisted boost of a
+	// public status, or a followers-only self boost).
 	followersURI, err := url.Parse(boostingAccount.FollowersURI)
 	if err != nil {
 		return nil, fmt.Errorf("BoostToAS: error parsing uri %s: %s", boostingAccount.FollowersURI, err)
 	}
-	toProp := streams.NewActivityStreamsToProperty()
-	toProp.AppendIRI(followersURI)
-	announce.SetActivityStreamsTo(toProp)
 
-	// set the cc
-	ccProp := streams.NewActivityStreamsCcProperty()
 	boostedAccountURI, err := url.Parse(boostedAccount.URI)
 	if err != nil {
 		return nil, fmt.Errorf("BoostToAS: error parsing uri %s: %s", boostedAccount.URI, err)
 	}
-	ccProp.AppendIRI(boostedAccountURI)
 
-	// maybe CC it to public depending on the boosted status visibility
-	switch boostWrapperStatus.BoostOf.Visibility {
-	case gtsmodel.VisibilityPublic, gtsmodel.VisibilityUnlocked:
-		publicURI, err := url.Parse(pub.PublicActivityPubIRI)
-		if err != nil {
-			return nil, fmt.Errorf("BoostToAS: error parsing uri %s: %s", pub.PublicActivityPubIRI, err)
-		}
+	publicURI, err := url.Parse(pub.PublicActivityPubIRI)
+	if err != nil {
+		return nil, fmt.Errorf("BoostToAS: error parsing uri %s: %s", pub.PublicActivityPubIRI, err)
+	}
+
+	toProp := streams.NewActivityStreamsToProperty()
+	ccProp := streams.NewActivityStreamsCcProperty()
+
+	switch boostWrapperStatus.Visibility {
+	case gtsmodel.VisibilityPublic:
+		// Public boost: address public in 'to',
+		// so that remotes see it as public and
+		// not merely unlisted.
+		toProp.AppendIRI(publicURI)
+		ccProp.AppendIRI(followersURI)
+		ccProp.AppendIRI(boostedAccountURI)
+
+	case gtsmodel.VisibilityUnlocked:
+		// Unlisted boost: address public in 'cc'.
+		toProp.AppendIRI(followersURI)
+		ccProp.AppendIRI(boostedAccountURI)
 		ccProp.AppendIRI(publicURI)
+
+	default:
+		// Followers-only boost: no public at all.
+		toProp.AppendIRI(followersURI)
+		ccProp.AppendIRI(boostedAccountURI)
 	}
 
+	announce.SetActivityStreamsTo(toProp)
 	announce.SetActivityStreamsCc(ccProp)
 
 	return announce, nil
@@ -1519,6 +1612,43 @@ func (c *Converter) StatusesToASFeaturedCollection(ctx context.Context, featured
 	return collection, nil
 }
 
+// FeaturedTagsToASCollection converts a slice of featured tags into a collection
+// of hashtags, suitable for serving at /users/:username/collections/tags
+func (c *Converter) FeaturedTagsToASCollection(ctx context.Context, collectionID string, featuredTags []*gtsmodel.FeaturedTag) (vocab.ActivityStreamsCollection, error) {
+	collection := streams.NewActivityStreamsCollection()
+
+	collectionIDProp := streams.NewJSONLDIdProperty()
+	collectionIDURI, err := url.Parse(collectionID)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing url %s", collectionID)
+	}
+	collectionIDProp.SetIRI(collectionIDURI)
+	collection.SetJSONLDId(collectionIDProp)
+
+	itemsProp := streams.NewActivityStreamsItemsProperty()
+	for _, f := range featuredTags {
+		if f.Tag == nil {
+			f.Tag, err = c.state.DB.GetTag(ctx, f.TagID)
+			if err != nil {
+				return nil, gtserror.Newf("error getting tag %s: %w", f.TagID, err)
+			}
+		}
+
+		tag, err := c.TagToAS(ctx, f.Tag)
+		if err != nil {
+			return nil, gtserror.Newf("error converting tag %s: %w", f.Tag.Name, err)
+		}
+		itemsProp.AppendTootHashtag(tag)
+	}
+	collection.SetActivityStreamsItems(itemsProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(len(featuredTags))
+	collection.SetActivityStreamsTotalItems(totalItemsProp)
+
+	return collection, nil
+}
+
 // ReportToASFlag converts a gts model report into an activitystreams FLAG, suitable for federation.
 func (c *Converter) ReportToASFlag(ctx context.Context, r *gtsmodel.Report) (vocab.ActivityStreamsFlag, error) {
 	flag := streams.NewActivityStreamsFlag()
@@ -29,6 +29,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -55,6 +56,7 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
 
 	suite.Equal(`: true,
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -69,6 +71,7 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
     "url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/header/original/01PFPMWK2FF0D9WMHEJHR07C3Q.jpg"
   },
   "inbox": "http://localhost:8080/users/the_mighty_zork/inbox",
+  "indexable": false,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
@@ -85,6 +88,32 @@ func (suite *InternalToASTestSuite) TestAccountToAS() {
 }`, trimmed)
 }
 
+func (suite *InternalToASTestSuite) TestAccountToASWithAliases() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+	testAccount.AlsoKnownAsURIs = []string{"http://fossbros-anonymous.io/users/foss_satan"}
+
+	asPerson, err := suite.typeconverter.AccountToAS(context.Background(), testAccount)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asPerson)
+	suite.NoError(err)
+
+	// alsoKnownAs should always be an array.
+	suite.Equal([]interface{}{"http://fossbros-anonymous.io/users/foss_satan"}, ser["alsoKnownAs"])
+
+	bytes, err := json.Marshal(ser)
+	suite.NoError(err)
+
+	// Aliases should survive a round trip.
+	accountable, err := ap.ResolveAccountable(context.Background(), bytes)
+	suite.NoError(err)
+
+	account, err := suite.typeconverter.ASRepresentationToAccount(context.Background(), accountable, "")
+	suite.NoError(err)
+	suite.Equal(testAccount.AlsoKnownAsURIs, account.AlsoKnownAsURIs)
+}
+
 func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
 	testAccount := &gtsmodel.Account{}
 	*testAccount = *suite.testAccounts["local_account_2"]
@@ -118,10 +147,12 @@ func (suite *InternalToASTestSuite) TestAccountToASWithFields() {
   ],
   "discoverable": false,
   "featured": "http://localhost:8080/users/1happyturtle/collections/featured",
+  "featuredTags": "http://localhost:8080/users/1happyturtle/collections/tags",
   "followers": "http://localhost:8080/users/1happyturtle/followers",
   "following": "http://localhost:8080/users/1happyturtle/following",
   "id": "http://localhost:8080/users/1happyturtle",
   "inbox": "http://localhost:8080/users/1happyturtle/inbox",
+  "indexable": false,
   "manuallyApprovesFollowers": true,
   "name": "happy little turtle :3",
   "outbox": "http://localhost:8080/users/1happyturtle/outbox",
@@ -166,10 +197,12 @@ func (suite *InternalToASTestSuite) TestAccountToASWithOneField() {
   ],
   "discoverable": false,
   "featured": "http://localhost:8080/users/1happyturtle/collections/featured",
+  "featuredTags": "http://localhost:8080/users/1happyturtle/collections/tags",
   "followers": "http://localhost:8080/users/1happyturtle/followers",
   "following": "http://localhost:8080/users/1happyturtle/following",
   "id": "http://localhost:8080/users/1happyturtle",
   "inbox": "http://localhost:8080/users/1happyturtle/inbox",
+  "indexable": false,
   "manuallyApprovesFollowers": true,
   "name": "happy little turtle :3",
   "outbox": "http://localhost:8080/users/1happyturtle/outbox",
@@ -206,6 +239,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithEmoji() {
 
 	suite.Equal(`: true,
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -220,6 +254,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithEmoji() {
     "url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/header/original/01PFPMWK2FF0D9WMHEJHR07C3Q.jpg"
   },
   "inbox": "http://localhost:8080/users/the_mighty_zork/inbox",
+  "indexable": false,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
@@ -270,6 +305,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
     "sharedInbox": "http://localhost:8080/sharedInbox"
   },
   "featured": "http://localhost:8080/users/the_mighty_zork/collections/featured",
+  "featuredTags": "http://localhost:8080/users/the_mighty_zork/collections/tags",
   "followers": "http://localhost:8080/users/the_mighty_zork/followers",
   "following": "http://localhost:8080/users/the_mighty_zork/following",
   "icon": {
@@ -284,6 +320,7 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
     "url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/header/original/01PFPMWK2FF0D9WMHEJHR07C3Q.jpg"
   },
   "inbox": "http://localhost:8080/users/the_mighty_zork/inbox",
+  "indexable": false,
   "manuallyApprovesFollowers": false,
   "name": "original zork (he/they)",
   "outbox": "http://localhost:8080/users/the_mighty_zork/outbox",
@@ -361,6 +398,29 @@ func (suite *InternalToASTestSuite) TestStatusToAS() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestStatusToASWithLocation() {
+	testStatus := &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["local_account_1_status_1"]
+	testStatus.Location = "Amsterdam Centraal"
+	ctx := context.Background()
+
+	asStatus, err := suite.typeconverter.StatusToAS(ctx, testStatus)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asStatus)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	// Location should be serialized as a
+	// Place with just a name, no coordinates.
+	suite.Contains(string(bytes), `"location": {
+    "name": "Amsterdam Centraal",
+    "type": "Place"
+  },`)
+}
+
 func (suite *InternalToASTestSuite) TestStatusWithTagsToASWithIDs() {
 	// use the status with just IDs of attachments and emojis pinned on it
 	testStatus := suite.testStatuses["admin_account_status_1"]
@@ -737,6 +797,46 @@ func (suite *InternalToASTestSuite) TestSelfBoostFollowersOnlyToAS() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestUnlistedBoostToAS() {
+	ctx := context.Background()
+
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	boostedAccount := suite.testAccounts["admin_account"]
+
+	boostWrapperStatus, err := suite.typeconverter.StatusToBoost(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.NotNil(boostWrapperStatus)
+
+	boostWrapperStatus.ID = "01G74JJ1KS331G2JXHRMZCE0ER"
+	boostWrapperStatus.URI = "http://localhost:8080/users/the_mighty_zork/statuses/01G74JJ1KS331G2JXHRMZCE0ER"
+	boostWrapperStatus.CreatedAt = testrig.TimeMustParse("2022-06-09T13:12:00Z")
+	boostWrapperStatus.Visibility = gtsmodel.VisibilityUnlocked
+
+	asBoost, err := suite.typeconverter.BoostToAS(ctx, boostWrapperStatus, testAccount, boostedAccount)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asBoost)
+	suite.NoError(err)
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "http://localhost:8080/users/the_mighty_zork",
+  "cc": [
+    "http://localhost:8080/users/admin",
+    "https://www.w3.org/ns/activitystreams#Public"
+  ],
+  "id": "http://localhost:8080/users/the_mighty_zork/statuses/01G74JJ1KS331G2JXHRMZCE0ER",
+  "object": "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+  "published": "2022-06-09T13:12:00Z",
+  "to": "http://localhost:8080/users/the_mighty_zork/followers",
+  "type": "Announce"
+}`, string(bytes))
+}
+
 func (suite *InternalToASTestSuite) TestReportToAS() {
 	ctx := context.Background()
 
@@ -863,6 +963,115 @@ func (suite *InternalToASTestSuite) TestPinnedStatusesToASOneItem() {
 }`, string(bytes))
 }
 
+func (suite *InternalToASTestSuite) TestFeaturedTagsToAS() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["admin_account"]
+	testTag, err := suite.db.GetTagByName(ctx, "welcome")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	featuredTags := []*gtsmodel.FeaturedTag{
+		{
+			ID:        "01HG2QAJ3SPVDQT7A1XDDJF5KX",
+			AccountID: testAccount.ID,
+			TagID:     testTag.ID,
+		},
+	}
+
+	collection, err := suite.typeconverter.FeaturedTagsToASCollection(ctx, "http://localhost:8080/users/admin/collections/tags", featuredTags)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ser, err := ap.Serialize(collection)
+	suite.NoError(err)
+
+	suite.Equal("Collection", ser["type"])
+	suite.Equal("http://localhost:8080/users/admin/collections/tags", ser["id"])
+	suite.EqualValues(1, ser["totalItems"])
+	suite.Equal(map[string]interface{}{
+		"href": "http://localhost:8080/tags/welcome",
+		"name": "#welcome",
+		"type": "Hashtag",
+	}, ser["items"])
+}
+
+func (suite *InternalToASTestSuite) TestStatusReactionToAS() {
+	ctx := context.Background()
+
+	testAccount := suite.testAccounts["local_account_1"]
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	testEmoji := suite.testEmojis["rainbow"]
+
+	reaction := &gtsmodel.StatusReaction{
+		ID:              "01HDJ5Z7XK8W2Y6QK3T0M4N9VB",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		StatusID:        testStatus.ID,
+		Content:         ":rainbow:",
+		EmojiID:         testEmoji.ID,
+		URI:             "http://localhost:8080/users/the_mighty_zork/liked/01HDJ5Z7XK8W2Y6QK3T0M4N9VB",
+	}
+
+	asLike, err := suite.typeconverter.StatusReactionToAS(ctx, reaction)
+	suite.NoError(err)
+
+	ser, err := ap.Serialize(asLike)
+	suite.NoError(err)
+
+	// Ordering of the @context entries isn't
+	// deterministic with the emoji tag set.
+	delete(ser, "@context")
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "_misskey_reaction": ":rainbow:",
+  "actor": "http://localhost:8080/users/the_mighty_zork",
+  "content": ":rainbow:",
+  "id": "http://localhost:8080/users/the_mighty_zork/liked/01HDJ5Z7XK8W2Y6QK3T0M4N9VB",
+  "object": "http://localhost:8080/users/admin/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+  "tag": {
+    "icon": {
+      "mediaType": "image/png",
+      "type": "Image",
+      "url": "http://localhost:8080/fileserver/01AY6P665V14JJR0AFVRT7311Y/emoji/original/01F8MH9H8E4VG3KDYJR9EGPXCQ.png"
+    },
+    "id": "http://localhost:8080/emoji/01F8MH9H8E4VG3KDYJR9EGPXCQ",
+    "name": ":rainbow:",
+    "type": "Emoji",
+    "updated": "2021-09-20T10:40:37Z"
+  },
+  "to": "http://localhost:8080/users/admin",
+  "type": "Like"
+}`, string(bytes))
+}
+
+func (suite *InternalToASTestSuite) TestStatusWithSensitiveAttachmentToAS() {
+	ctx := context.Background()
+
+	// Copy the status so we don't
+	// modify the test model in place.
+	testStatus := &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["admin_account_status_1"]
+	suite.False(*testStatus.Sensitive)
+
+	// Mark the one attachment as individually sensitive.
+	attachment := &gtsmodel.MediaAttachment{}
+	*attachment = *suite.testAttachments["admin_account_status_1_attachment_1"]
+	attachment.Sensitive = util.Ptr(true)
+	testStatus.Attachments = []*gtsmodel.MediaAttachment{attachment}
+
+	asStatus, err := suite.typeconverter.StatusToAS(ctx, testStatus)
+	suite.NoError(err)
+
+	// The whole status should be federated as sensitive.
+	suite.True(ap.ExtractSensitive(asStatus))
+}
+
 func TestInternalToASTestSuite(t *testing.T) {
 	suite.Run(t, new(InternalToASTestSuite))
 }
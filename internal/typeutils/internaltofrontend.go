@@ -24,6 +24,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -32,14 +33,13 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
 const (
 	instanceStatusesCharactersReservedPerURL    = 25
-	instanceMediaAttachmentsImageMatrixLimit    = 16777216 // width * height
-	instanceMediaAttachmentsVideoMatrixLimit    = 16777216 // width * height
 	instanceMediaAttachmentsVideoFrameRateLimit = 60
 	instancePollsMinExpiration                  = 300     // seconds
 	instancePollsMaxExpiration                  = 2629746 // seconds
@@ -81,14 +81,45 @@ func (c *Converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		statusContentType = a.StatusContentType
 	}
 
+	// The preferred locale, email notifications, first
+	// interaction review, time zone and highlights are
+	// set on the user of the account, if it has one.
+	var (
+		locale                  string
+		emailNotifications      = "none"
+		reviewFirstInteractions bool
+		timeZone                string
+		highlightsDigest        = "none"
+	)
+	user, err := c.state.DB.GetUserByAccountID(ctx, a.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("error getting user: %w", err)
+	}
+	if user != nil {
+		locale = user.Locale
+		if user.EmailNotifications != gtsmodel.EmailNotificationsNone {
+			emailNotifications = string(user.EmailNotifications)
+		}
+		reviewFirstInteractions = *user.ReviewFirstInteractions
+		timeZone = user.TimeZone
+		if user.HighlightsDigest != gtsmodel.HighlightsDigestNone {
+			highlightsDigest = string(user.HighlightsDigest)
+		}
+	}
+
 	apiAccount.Source = &apimodel.Source{
-		Privacy:             c.VisToAPIVis(ctx, a.Privacy),
-		Sensitive:           *a.Sensitive,
-		Language:            a.Language,
-		StatusContentType:   statusContentType,
-		Note:                a.NoteRaw,
-		Fields:              c.fieldsToAPIFields(a.FieldsRaw),
-		FollowRequestsCount: frc,
+		Privacy:                 c.VisToAPIVis(ctx, a.Privacy),
+		Sensitive:               *a.Sensitive,
+		Language:                a.Language,
+		StatusContentType:       statusContentType,
+		Locale:                  locale,
+		EmailNotifications:      emailNotifications,
+		ReviewFirstInteractions: reviewFirstInteractions,
+		TimeZone:                timeZone,
+		HighlightsDigest:        highlightsDigest,
+		Note:                    a.NoteRaw,
+		Fields:                  c.fieldsToAPIFields(a.FieldsRaw),
+		FollowRequestsCount:     frc,
 	}
 
 	return apiAccount, nil
@@ -108,19 +139,9 @@ func (c *Converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 	//   - Statuses count
 	//   - Last status time
 
-	followersCount, err := c.state.DB.CountAccountFollowers(ctx, a.ID)
-	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		return nil, fmt.Errorf("AccountToAPIAccountPublic: error counting followers: %w", err)
-	}
-
-	followingCount, err := c.state.DB.CountAccountFollows(ctx, a.ID)
-	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		return nil, fmt.Errorf("AccountToAPIAccountPublic: error counting following: %w", err)
-	}
-
-	statusesCount, err := c.state.DB.CountAccountStatuses(ctx, a.ID)
-	if err != nil && !errors.Is(err, db.ErrNoEntries) {
-		return nil, fmt.Errorf("AccountToAPIAccountPublic: error counting statuses: %w", err)
+	stats, err := c.state.DB.GetAccountStats(ctx, a.ID)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToAPIAccountPublic: error getting account stats: %w", err)
 	}
 
 	var lastStatusAt *string
@@ -159,6 +180,17 @@ func (c *Converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 	// convert account gts model fields to front api model fields
 	fields := c.fieldsToAPIFields(a.Fields)
 
+	// Remote HTML was sanitized when it was received,
+	// but the sanitizer policy may have been made more
+	// restrictive since, so apply the current one again.
+	note := a.Note
+	if a.IsRemote() {
+		note = text.ResanitizeRemoteHTML(note)
+		for i := range fields {
+			fields[i].Value = text.ResanitizeRemoteHTML(fields[i].Value)
+		}
+	}
+
 	// GTS model emojis -> frontend.
 	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, a.Emojis, a.EmojiIDs)
 	if err != nil {
@@ -168,10 +200,12 @@ func (c *Converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 	// Bits that vary between remote + local accounts:
 	//   - Account (acct) string.
 	//   - Role.
+	//   - NoIndex.
 
 	var (
-		acct string
-		role *apimodel.AccountRole
+		acct    string
+		role    *apimodel.AccountRole
+		noIndex bool
 	)
 
 	if a.IsRemote() {
@@ -204,6 +238,14 @@ func (c *Converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		}
 
 		acct = a.Username // omit domain
+
+		// Use the account's own choice of
+		// noindex, else the instance default.
+		if a.NoIndex != nil {
+			noIndex = *a.NoIndex
+		} else {
+			noIndex = config.GetInstanceNoIndexDefault()
+		}
 	}
 
 	// Remaining properties are simple and
@@ -216,22 +258,26 @@ func (c *Converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		DisplayName:    a.DisplayName,
 		Locked:         *a.Locked,
 		Discoverable:   *a.Discoverable,
+		Indexable:      a.Indexable != nil && *a.Indexable,
+		NoIndex:        noIndex,
 		Bot:            *a.Bot,
+		Group:          a.IsGroup(),
 		CreatedAt:      util.FormatISO8601(a.CreatedAt),
-		Note:           a.Note,
+		Note:           note,
 		URL:            a.URL,
 		Avatar:         aviURL,
 		AvatarStatic:   aviURLStatic,
 		Header:         headerURL,
 		HeaderStatic:   headerURLStatic,
-		FollowersCount: followersCount,
-		FollowingCount: followingCount,
-		StatusesCount:  statusesCount,
+		FollowersCount: stats.FollowersCount,
+		FollowingCount: stats.FollowingCount,
+		StatusesCount:  stats.StatusesCount,
 		LastStatusAt:   lastStatusAt,
 		Emojis:         apiEmojis,
 		Fields:         fields,
 		Suspended:      !a.SuspendedAt.IsZero(),
 		CustomCSS:      a.CustomCSS,
+		Theme:          a.Theme,
 		EnableRSS:      *a.EnableRSS,
 		Role:           role,
 	}
@@ -251,6 +297,7 @@ func (c *Converter) fieldsToAPIFields(f []*gtsmodel.Field) []apimodel.Field {
 		mField := apimodel.Field{
 			Name:  field.Name,
 			Value: field.Value,
+			RelMe: field.RelMe,
 		}
 
 		if !field.VerifiedAt.IsZero() {
@@ -310,6 +357,7 @@ func (c *Converter) AccountToAPIAccountBlocked(ctx context.Context, a *gtsmodel.
 		Acct:        acct,
 		DisplayName: a.DisplayName,
 		Bot:         *a.Bot,
+		Group:       a.IsGroup(),
 		CreatedAt:   util.FormatISO8601(a.CreatedAt),
 		URL:         a.URL,
 		Suspended:   !a.SuspendedAt.IsZero(),
@@ -329,6 +377,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		disabled               bool
 		role                   = apimodel.AccountRole{Name: apimodel.AccountRoleUser} // assume user by default
 		createdByApplicationID string
+		limitedUntil           string
 	)
 
 	if a.IsRemote() {
@@ -373,6 +422,10 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		approved = *user.Approved
 		disabled = *user.Disabled
 		createdByApplicationID = user.CreatedByApplicationID
+
+		if time.Now().Before(user.LimitedUntil) {
+			limitedUntil = util.FormatISO8601(user.LimitedUntil)
+		}
 	}
 
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -396,6 +449,7 @@ func (c *Converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Disabled:               disabled,
 		Silenced:               !a.SilencedAt.IsZero(),
 		Suspended:              !a.SuspendedAt.IsZero(),
+		LimitedUntil:           limitedUntil,
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
 		InvitedByAccountID:     "", // not implemented (yet)
@@ -442,7 +496,8 @@ func (c *Converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.M
 				Aspect: float32(a.FileMeta.Small.Aspect),
 			},
 		},
-		Blurhash: a.Blurhash,
+		Sensitive: a.IsSensitive(),
+		Blurhash:  a.Blurhash,
 	}
 
 	// nullable fields
@@ -545,6 +600,8 @@ func (c *Converter) EmojiToAPIEmoji(ctx context.Context, e *gtsmodel.Emoji) (api
 		StaticURL:       e.ImageStaticURL,
 		VisibleInPicker: *e.VisibleInPicker,
 		Category:        category,
+		License:         e.License,
+		Attribution:     e.Attribution,
 	}, nil
 }
 
@@ -603,6 +660,141 @@ func (c *Converter) TagToAPITag(ctx context.Context, t *gtsmodel.Tag, stubHistor
 	}, nil
 }
 
+// TagToAdminAPITag converts a gts model tag into its admin api
+// representation, including its moderation status on this instance.
+func (c *Converter) TagToAdminAPITag(ctx context.Context, t *gtsmodel.Tag) (*apimodel.AdminTag, error) {
+	tag, err := c.TagToAPITag(ctx, t, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apimodel.AdminTag{
+		Tag:       tag,
+		ID:        t.ID,
+		Useable:   *t.Useable,
+		Listable:  *t.Listable,
+		UpdatedAt: util.FormatISO8601(t.UpdatedAt),
+	}, nil
+}
+
+// IngestRuleToAdminAPIIngestRule converts a gts model
+// ingest rule into its admin api (frontend) representation.
+func (c *Converter) IngestRuleToAdminAPIIngestRule(r *gtsmodel.IngestRule) *apimodel.AdminIngestRule {
+	var lastHitAt *string
+	if !r.LastHitAt.IsZero() {
+		lastHitAt = util.Ptr(util.FormatISO8601(r.LastHitAt))
+	}
+
+	return &apimodel.AdminIngestRule{
+		ID:        r.ID,
+		Pattern:   r.Pattern,
+		Regex:     r.Regex != nil && *r.Regex,
+		Action:    string(r.Action),
+		Comment:   r.Comment,
+		CreatedBy: r.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(r.CreatedAt),
+		Hits:      r.Hits,
+		LastHitAt: lastHitAt,
+	}
+}
+
+// IPBlockToAdminAPIIPBlock converts a gts model ip
+// block into its admin api (frontend) representation.
+func (c *Converter) IPBlockToAdminAPIIPBlock(b *gtsmodel.IPBlock) *apimodel.AdminIPBlock {
+	var expiresAt *string
+	if !b.ExpiresAt.IsZero() {
+		expiresAt = util.Ptr(util.FormatISO8601(b.ExpiresAt))
+	}
+
+	return &apimodel.AdminIPBlock{
+		ID:        b.ID,
+		IP:        b.IP,
+		Severity:  string(b.Severity),
+		Comment:   b.Comment,
+		CreatedBy: b.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(b.CreatedAt),
+		ExpiresAt: expiresAt,
+	}
+}
+
+// EmailDomainBlockToAdminAPIEmailDomainBlock converts a gts model
+// email domain block into its admin api (frontend) representation.
+func (c *Converter) EmailDomainBlockToAdminAPIEmailDomainBlock(b *gtsmodel.EmailDomainBlock) *apimodel.AdminEmailDomainBlock {
+	return &apimodel.AdminEmailDomainBlock{
+		ID:        b.ID,
+		Domain:    b.Domain,
+		Comment:   b.Comment,
+		CreatedBy: b.CreatedByAccountID,
+		CreatedAt: util.FormatISO8601(b.CreatedAt),
+	}
+}
+
+// QuarantinedStatusToAdminAPIQuarantinedStatus converts a gts model
+// quarantined status into its admin api (frontend) representation.
+func (c *Converter) QuarantinedStatusToAdminAPIQuarantinedStatus(
+	ctx context.Context,
+	q *gtsmodel.QuarantinedStatus,
+	requestingAccount *gtsmodel.Account,
+) (*apimodel.AdminQuarantinedStatus, error) {
+	if q.Status == nil {
+		var err error
+		q.Status, err = c.state.DB.GetStatusByID(ctx, q.StatusID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting status %s: %w", q.StatusID, err)
+		}
+	}
+
+	status, err := c.StatusToAPIStatus(ctx, q.Status, requestingAccount)
+	if err != nil {
+		return nil, gtserror.Newf("error converting status %s: %w", q.StatusID, err)
+	}
+
+	reasons := q.Reasons
+	if reasons == nil {
+		reasons = make([]string, 0)
+	}
+
+	return &apimodel.AdminQuarantinedStatus{
+		ID:        q.ID,
+		CreatedAt: util.FormatISO8601(q.CreatedAt),
+		Score:     q.Score,
+		Reasons:   reasons,
+		Status:    status,
+	}, nil
+}
+
+// DomainQuarantineToAdminAPIDomainQuarantine converts a gts model
+// domain quarantine into its admin api (frontend) representation.
+func (c *Converter) DomainQuarantineToAdminAPIDomainQuarantine(d *gtsmodel.DomainQuarantine) *apimodel.AdminDomainQuarantine {
+	var approvedAt string
+	if d.Approved() {
+		approvedAt = util.FormatISO8601(d.ApprovedAt)
+	}
+
+	return &apimodel.AdminDomainQuarantine{
+		ID:         d.ID,
+		Domain:     d.Domain,
+		CreatedAt:  util.FormatISO8601(d.CreatedAt),
+		ApprovedAt: approvedAt,
+		CleanCount: d.CleanCount,
+	}
+}
+
+// InstanceStatisticToAdminAPIStatistic converts a gts model instance
+// statistic into its admin api (frontend) representation.
+func (c *Converter) InstanceStatisticToAdminAPIStatistic(s *gtsmodel.InstanceStatistic) *apimodel.AdminStatistic {
+	return &apimodel.AdminStatistic{
+		Date:               s.Day.UTC().Format(time.DateOnly),
+		ActiveUsers:        s.ActiveUsers,
+		NewRegistrations:   s.NewRegistrations,
+		StatusesCreated:    s.StatusesCreated,
+		StatusesReceived:   s.StatusesReceived,
+		AccountsDiscovered: s.AccountsDiscovered,
+		KnownInstances:     s.KnownInstances,
+		MediaStorageBytes:  s.MediaStorageBytes,
+	}
+}
+
 // StatusToAPIStatus converts a gts model status into its api (frontend) representation for serialization on the API.
 //
 // Requesting account can be nil.
@@ -637,6 +829,12 @@ func (c *Converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		return nil, fmt.Errorf("error counting faves: %w", err)
 	}
 
+	// We only see some of the boosts / faves of
+	// remote statuses; prefer their remote totals
+	// when fetched, which include any of ours.
+	reblogsCount = max(reblogsCount, s.RemoteBoostsCount)
+	favesCount = max(favesCount, s.RemoteFavesCount)
+
 	interacts, err := c.interactionsWithStatusForAccount(ctx, s, requestingAccount)
 	if err != nil {
 		log.Errorf(ctx, "error getting interactions for status %s for account %s: %v", s.ID, requestingAccount.ID, err)
@@ -665,13 +863,22 @@ func (c *Converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		log.Errorf(ctx, "error converting status emojis: %v", err)
 	}
 
+	// Remote HTML was sanitized when it was received,
+	// but the sanitizer policy may have been made more
+	// restrictive since, so apply the current one again.
+	content, spoilerText := s.Content, s.ContentWarning
+	if !*s.Local {
+		content = text.ResanitizeRemoteHTML(content)
+		spoilerText = text.ResanitizeRemoteHTML(spoilerText)
+	}
+
 	apiStatus := &apimodel.Status{
 		ID:                 s.ID,
 		CreatedAt:          util.FormatISO8601(s.CreatedAt),
 		InReplyToID:        nil,
 		InReplyToAccountID: nil,
 		Sensitive:          *s.Sensitive,
-		SpoilerText:        s.ContentWarning,
+		SpoilerText:        spoilerText,
 		Visibility:         c.VisToAPIVis(ctx, s.Visibility),
 		Language:           nil,
 		URI:                s.URI,
@@ -684,7 +891,7 @@ func (c *Converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		Muted:              interacts.Muted,
 		Reblogged:          interacts.Reblogged,
 		Pinned:             interacts.Pinned,
-		Content:            s.Content,
+		Content:            content,
 		Reblog:             nil,
 		Application:        nil,
 		Account:            apiAuthorAccount,
@@ -695,6 +902,20 @@ func (c *Converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		Card:               nil, // TODO: implement cards
 		Poll:               nil, // TODO: implement polls
 		Text:               s.Text,
+		QuoteURL:           s.QuoteOfURI,
+		Location:           s.Location,
+	}
+
+	if config.GetInstanceEmojiReactions() {
+		reactions, err := c.state.DB.GetStatusReactions(ctx, s.ID)
+		if err != nil {
+			log.Errorf(ctx, "error getting status reactions: %v", err)
+		}
+
+		apiStatus.EmojiReactions, err = c.StatusReactionsToAPIStatusReactions(ctx, reactions, requestingAccount, false)
+		if err != nil {
+			log.Errorf(ctx, "error converting status reactions: %v", err)
+		}
 	}
 
 	// Nullable fields.
@@ -711,6 +932,21 @@ func (c *Converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		apiStatus.Language = func() *string { i := s.Language; return &i }()
 	}
 
+	if s.QuoteOfID != "" {
+		apiStatus.QuoteID = func() *string { i := s.QuoteOfID; return &i }()
+	}
+
+	if !s.EventStartAt.IsZero() {
+		apiStatus.Event = &apimodel.StatusEvent{
+			StartTime: util.FormatISO8601(s.EventStartAt),
+		}
+
+		if !s.EventEndAt.IsZero() {
+			endTime := util.FormatISO8601(s.EventEndAt)
+			apiStatus.Event.EndTime = &endTime
+		}
+	}
+
 	if s.BoostOf != nil {
 		apiBoostOf, err := c.StatusToAPIStatus(ctx, s.BoostOf, requestingAccount)
 		if err != nil {
@@ -745,6 +981,62 @@ func (c *Converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 	return apiStatus, nil
 }
 
+// StatusReactionsToAPIStatusReactions converts the given slice of gts model status
+// reactions to a single status into a summary of reactions grouped by emoji, ordered
+// by when each emoji was first used to react. If withAccounts is true, then the
+// accounts that reacted with each emoji will be included in the summary.
+func (c *Converter) StatusReactionsToAPIStatusReactions(
+	ctx context.Context,
+	reactions []*gtsmodel.StatusReaction,
+	requestingAccount *gtsmodel.Account,
+	withAccounts bool,
+) ([]apimodel.StatusReaction, error) {
+	var (
+		apiReactions = make([]apimodel.StatusReaction, 0, len(reactions))
+		indices      = make(map[string]int, len(reactions))
+	)
+
+	for _, reaction := range reactions {
+		i, ok := indices[reaction.Content]
+		if !ok {
+			// First time seeing this emoji,
+			// add a new entry to the summary.
+			apiReaction := apimodel.StatusReaction{
+				Name: reaction.Content,
+			}
+
+			if reaction.Emoji != nil {
+				apiReaction.URL = reaction.Emoji.ImageURL
+				apiReaction.StaticURL = reaction.Emoji.ImageStaticURL
+			}
+
+			i = len(apiReactions)
+			indices[reaction.Content] = i
+			apiReactions = append(apiReactions, apiReaction)
+		}
+
+		apiReactions[i].Count++
+
+		if requestingAccount != nil &&
+			reaction.AccountID == requestingAccount.ID {
+			apiReactions[i].Me = true
+		}
+
+		if !withAccounts || reaction.Account == nil {
+			continue
+		}
+
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, reaction.Account)
+		if err != nil {
+			return nil, gtserror.Newf("error converting account %s: %w", reaction.AccountID, err)
+		}
+
+		apiReactions[i].Accounts = append(apiReactions[i].Accounts, apiAccount)
+	}
+
+	return apiReactions, nil
+}
+
 // VisToAPIVis converts a gts visibility into its api equivalent
 func (c *Converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apimodel.Visibility {
 	switch m {
@@ -789,6 +1081,37 @@ func (c *Converter) InstanceRuleToAdminAPIRule(r *gtsmodel.Rule) *apimodel.Admin
 	}
 }
 
+// AnnouncementToAPIAnnouncement converts a gts announcement into its api equivalent.
+func (c *Converter) AnnouncementToAPIAnnouncement(a *gtsmodel.Announcement) *apimodel.Announcement {
+	apiAnnouncement := &apimodel.Announcement{
+		ID:          a.ID,
+		Content:     a.Content,
+		AllDay:      *a.AllDay,
+		PublishedAt: util.FormatISO8601(a.CreatedAt),
+		UpdatedAt:   util.FormatISO8601(a.UpdatedAt),
+		Published:   *a.Published,
+		Mentions:    []apimodel.Mention{},
+		Statuses:    []apimodel.Status{},
+		Tags:        []apimodel.Tag{},
+		Emojis:      []apimodel.Emoji{},
+		Reactions:   []apimodel.AnnouncementReaction{},
+	}
+
+	if !a.StartsAt.IsZero() {
+		apiAnnouncement.StartsAt = util.FormatISO8601(a.StartsAt)
+	}
+
+	if !a.EndsAt.IsZero() {
+		apiAnnouncement.EndsAt = util.FormatISO8601(a.EndsAt)
+	}
+
+	if !a.MaintenanceAt.IsZero() {
+		apiAnnouncement.MaintenanceAt = util.FormatISO8601(a.MaintenanceAt)
+	}
+
+	return apiAnnouncement
+}
+
 // InstanceToAPIV1Instance converts a gts instance into its api equivalent for serving at /api/v1/instance
 func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceV1, error) {
 	instance := &apimodel.InstanceV1{
@@ -799,7 +1122,7 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 		ShortDescription: i.ShortDescription,
 		Email:            i.ContactEmail,
 		Version:          config.GetSoftwareVersion(),
-		Languages:        []string{}, // todo: not supported yet
+		Languages:        append([]string{}, config.GetInstanceLanguages()...),
 		Registrations:    config.GetAccountsRegistrationOpen(),
 		ApprovalRequired: config.GetAccountsApprovalRequired(),
 		InvitesEnabled:   false, // todo: not supported yet
@@ -816,13 +1139,15 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Statuses.MaxCharacters = config.GetStatusesMaxChars()
 	instance.Configuration.Statuses.MaxMediaAttachments = config.GetStatusesMediaMaxFiles()
 	instance.Configuration.Statuses.CharactersReservedPerURL = instanceStatusesCharactersReservedPerURL
+	instance.Configuration.Statuses.MaxContentWarningCharacters = config.GetStatusesCWMaxChars()
 	instance.Configuration.Statuses.SupportedMimeTypes = instanceStatusesSupportedMimeTypes
 	instance.Configuration.MediaAttachments.SupportedMimeTypes = media.SupportedMIMETypes
 	instance.Configuration.MediaAttachments.ImageSizeLimit = int(config.GetMediaImageMaxSize())
-	instance.Configuration.MediaAttachments.ImageMatrixLimit = instanceMediaAttachmentsImageMatrixLimit
+	instance.Configuration.MediaAttachments.ImageMatrixLimit = media.ImageMatrixLimit()
 	instance.Configuration.MediaAttachments.VideoSizeLimit = int(config.GetMediaVideoMaxSize())
 	instance.Configuration.MediaAttachments.VideoFrameRateLimit = instanceMediaAttachmentsVideoFrameRateLimit
-	instance.Configuration.MediaAttachments.VideoMatrixLimit = instanceMediaAttachmentsVideoMatrixLimit
+	instance.Configuration.MediaAttachments.VideoMatrixLimit = media.VideoMatrixLimit
+	instance.Configuration.MediaAttachments.DescriptionLimit = config.GetMediaDescriptionMaxChars()
 	instance.Configuration.Polls.MaxOptions = config.GetStatusesPollMaxOptions()
 	instance.Configuration.Polls.MaxCharactersPerOption = config.GetStatusesPollOptionMaxChars()
 	instance.Configuration.Polls.MinExpiration = instancePollsMinExpiration
@@ -830,6 +1155,7 @@ func (c *Converter) InstanceToAPIV1Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.AllowCustomCSS = config.GetAccountsAllowCustomCSS()
 	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
 	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
+	instance.Configuration.Accounts.MaxPinnedStatuses = config.GetStatusesMaxPinned()
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize())
 
 	// URLs
@@ -907,8 +1233,7 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 		Version:       config.GetSoftwareVersion(),
 		SourceURL:     instanceSourceURL,
 		Description:   i.Description,
-		Usage:         apimodel.InstanceV2Usage{}, // todo: not implemented
-		Languages:     []string{},                 // todo: not implemented
+		Languages:     append([]string{}, config.GetInstanceLanguages()...),
 		Rules:         c.InstanceRulesToAPIRules(i.Rules),
 		Terms:         i.Terms,
 	}
@@ -917,6 +1242,13 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 		instance.Version = toMastodonVersion(instance.Version)
 	}
 
+	// usage
+	activeUsers, err := c.state.DB.CountInstanceActiveUsers(ctx, i.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("InstanceToAPIV2Instance: db error counting instance active users: %w", err)
+	}
+	instance.Usage.Users.ActiveMonth = activeUsers
+
 	// thumbnail
 	thumbnail := apimodel.InstanceV2Thumbnail{}
 
@@ -949,13 +1281,15 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Statuses.MaxCharacters = config.GetStatusesMaxChars()
 	instance.Configuration.Statuses.MaxMediaAttachments = config.GetStatusesMediaMaxFiles()
 	instance.Configuration.Statuses.CharactersReservedPerURL = instanceStatusesCharactersReservedPerURL
+	instance.Configuration.Statuses.MaxContentWarningCharacters = config.GetStatusesCWMaxChars()
 	instance.Configuration.Statuses.SupportedMimeTypes = instanceStatusesSupportedMimeTypes
 	instance.Configuration.MediaAttachments.SupportedMimeTypes = media.SupportedMIMETypes
 	instance.Configuration.MediaAttachments.ImageSizeLimit = int(config.GetMediaImageMaxSize())
-	instance.Configuration.MediaAttachments.ImageMatrixLimit = instanceMediaAttachmentsImageMatrixLimit
+	instance.Configuration.MediaAttachments.ImageMatrixLimit = media.ImageMatrixLimit()
 	instance.Configuration.MediaAttachments.VideoSizeLimit = int(config.GetMediaVideoMaxSize())
 	instance.Configuration.MediaAttachments.VideoFrameRateLimit = instanceMediaAttachmentsVideoFrameRateLimit
-	instance.Configuration.MediaAttachments.VideoMatrixLimit = instanceMediaAttachmentsVideoMatrixLimit
+	instance.Configuration.MediaAttachments.VideoMatrixLimit = media.VideoMatrixLimit
+	instance.Configuration.MediaAttachments.DescriptionLimit = config.GetMediaDescriptionMaxChars()
 	instance.Configuration.Polls.MaxOptions = config.GetStatusesPollMaxOptions()
 	instance.Configuration.Polls.MaxCharactersPerOption = config.GetStatusesPollOptionMaxChars()
 	instance.Configuration.Polls.MinExpiration = instancePollsMinExpiration
@@ -963,12 +1297,20 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	instance.Configuration.Accounts.AllowCustomCSS = config.GetAccountsAllowCustomCSS()
 	instance.Configuration.Accounts.MaxFeaturedTags = instanceAccountsMaxFeaturedTags
 	instance.Configuration.Accounts.MaxProfileFields = instanceAccountsMaxProfileFields
+	instance.Configuration.Accounts.MaxPinnedStatuses = config.GetStatusesMaxPinned()
 	instance.Configuration.Emojis.EmojiSizeLimit = int(config.GetMediaEmojiLocalMaxSize())
+	instance.Configuration.Translation.Enabled = c.state.Translator != nil
 
 	// registrations
 	instance.Registrations.Enabled = config.GetAccountsRegistrationOpen()
 	instance.Registrations.ApprovalRequired = config.GetAccountsApprovalRequired()
 	instance.Registrations.Message = nil // todo: not implemented
+	if c.state.Challenge != nil {
+		instance.Registrations.Challenge = &apimodel.InstanceV2RegistrationsChallenge{
+			Provider: c.state.Challenge.Provider(),
+			SiteKey:  c.state.Challenge.SiteKey(),
+		}
+	}
 
 	// contact
 	instance.Contact.Email = i.ContactEmail
@@ -991,6 +1333,43 @@ func (c *Converter) InstanceToAPIV2Instance(ctx context.Context, i *gtsmodel.Ins
 	return instance, nil
 }
 
+// InstanceToAPIInstanceChooser converts a gts instance into the metadata
+// served to instance chooser apps at /api/v1/instance/chooser.
+func (c *Converter) InstanceToAPIInstanceChooser(ctx context.Context, i *gtsmodel.Instance) (*apimodel.InstanceChooser, error) {
+	// Reuse the v2 representation for
+	// thumbnail and usage, so they match.
+	v2, err := c.InstanceToAPIV2Instance(ctx, i)
+	if err != nil {
+		return nil, fmt.Errorf("InstanceToAPIInstanceChooser: error converting instance: %w", err)
+	}
+
+	userCount, err := c.state.DB.CountInstanceUsers(ctx, i.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("InstanceToAPIInstanceChooser: db error counting instance users: %w", err)
+	}
+
+	return &apimodel.InstanceChooser{
+		Domain:           i.Domain,
+		Title:            i.Title,
+		ShortDescription: i.ShortDescription,
+		Thumbnail:        v2.Thumbnail.URL,
+		Version:          config.GetSoftwareVersion(),
+		Languages:        v2.Languages,
+		Categories:       append([]string{}, config.GetInstanceCategories()...),
+		Registrations: apimodel.InstanceChooserRegistrations{
+			Open:             config.GetAccountsRegistrationOpen(),
+			ApprovalRequired: config.GetAccountsApprovalRequired(),
+			ReasonRequired:   config.GetAccountsReasonRequired(),
+			InviteRequired:   !config.GetAccountsRegistrationOpen(),
+		},
+		Rules: v2.Rules,
+		Usage: apimodel.InstanceChooserUsage{
+			Users:       userCount,
+			ActiveMonth: v2.Usage.Users.ActiveMonth,
+		},
+	}, nil
+}
+
 // RelationshipToAPIRelationship converts a gts relationship into its api equivalent for serving in various places
 func (c *Converter) RelationshipToAPIRelationship(ctx context.Context, r *gtsmodel.Relationship) (*apimodel.Relationship, error) {
 	return &apimodel.Relationship{
@@ -1108,7 +1487,113 @@ func (c *Converter) DomainPermToAPIDomainPerm(
 	return domainPerm, nil
 }
 
+// DomainInteropToAPIDomainInterop converts a gts model domain interop profile into its api representation.
+func (c *Converter) DomainInteropToAPIDomainInterop(ctx context.Context, i *gtsmodel.DomainInterop) (*apimodel.DomainInterop, error) {
+	// Domain may be in Punycode,
+	// de-punify it just in case.
+	domain, err := util.DePunify(i.Domain)
+	if err != nil {
+		return nil, gtserror.Newf("error de-punifying domain %s: %w", i.Domain, err)
+	}
+
+	apiInterop := &apimodel.DomainInterop{
+		Domain:              domain,
+		SignGETWithoutQuery: *i.SignGETWithoutQuery,
+		AcceptActivityJSON:  *i.AcceptActivityJSON,
+		DeliverActivityJSON: *i.DeliverActivityJSON,
+		LaxContentType:      *i.LaxContentType,
+		SignatureAlgorithm:  i.SignatureAlgorithm,
+		LastQuirk:           i.LastQuirk,
+		CreatedAt:           util.FormatISO8601(i.CreatedAt),
+	}
+
+	if !i.LastQuirkAt.IsZero() {
+		apiInterop.LastQuirkAt = util.FormatISO8601(i.LastQuirkAt)
+	}
+
+	return apiInterop, nil
+}
+
+// DomainStatsToAPIDomainStats converts gts model domain
+// stats into their api (frontend) representation, for
+// serialization on the admin API.
+func (c *Converter) DomainStatsToAPIDomainStats(ctx context.Context, s *gtsmodel.DomainStats) (*apimodel.DomainStats, error) {
+	// Domain may be in Punycode,
+	// de-punify it just in case.
+	domain, err := util.DePunify(s.Domain)
+	if err != nil {
+		return nil, gtserror.Newf("error de-punifying domain %s: %w", s.Domain, err)
+	}
+
+	apiStats := &apimodel.DomainStats{
+		Domain:                      domain,
+		DeliverySuccesses:           s.DeliverySuccesses,
+		DeliveryFailures:            s.DeliveryFailures,
+		DeliveryFailureRate:         failureRate(s.DeliverySuccesses, s.DeliveryFailures),
+		DereferenceSuccesses:        s.DereferenceSuccesses,
+		DereferenceFailures:         s.DereferenceFailures,
+		DereferenceFailureRate:      failureRate(s.DereferenceSuccesses, s.DereferenceFailures),
+		LastFailure:                 s.LastFailure,
+		ConsecutiveDeliveryFailures: s.ConsecutiveDeliveryFailures,
+		CreatedAt:                   util.FormatISO8601(s.CreatedAt),
+	}
+
+	if !s.LastSuccessAt.IsZero() {
+		apiStats.LastSuccessAt = util.FormatISO8601(s.LastSuccessAt)
+	}
+
+	if !s.LastFailureAt.IsZero() {
+		apiStats.LastFailureAt = util.FormatISO8601(s.LastFailureAt)
+	}
+
+	if s.DeliveriesSuspended() {
+		apiStats.DeliveriesSuspendedAt = util.FormatISO8601(s.DeliveriesSuspendedAt)
+	}
+
+	return apiStats, nil
+}
+
+// failureRate returns the fraction of attempts which
+// failed, or 0 if nothing has been attempted at all.
+func failureRate(successes int64, failures int64) float64 {
+	total := successes + failures
+	if total == 0 {
+		return 0
+	}
+	return float64(failures) / float64(total)
+}
+
 // ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
+// FirstInteractionToAPIFirstInteraction converts a gts model first interaction
+// into its api (frontend) representation, for serialization on the API.
+// Held statuses are converted as seen by the account of the first interaction.
+func (c *Converter) FirstInteractionToAPIFirstInteraction(ctx context.Context, f *gtsmodel.FirstInteraction) (*apimodel.FirstInteraction, error) {
+	if err := c.state.DB.PopulateFirstInteraction(ctx, f); err != nil {
+		return nil, gtserror.Newf("error populating first interaction: %w", err)
+	}
+
+	account, err := c.AccountToAPIAccountPublic(ctx, f.OriginAccount)
+	if err != nil {
+		return nil, gtserror.Newf("error converting origin account: %w", err)
+	}
+
+	statuses := make([]*apimodel.Status, 0, len(f.Statuses))
+	for _, s := range f.Statuses {
+		status, err := c.StatusToAPIStatus(ctx, s, f.Account)
+		if err != nil {
+			return nil, gtserror.Newf("error converting status %s: %w", s.ID, err)
+		}
+		statuses = append(statuses, status)
+	}
+
+	return &apimodel.FirstInteraction{
+		ID:        f.ID,
+		CreatedAt: util.FormatISO8601(f.CreatedAt),
+		Account:   account,
+		Statuses:  statuses,
+	}, nil
+}
+
 func (c *Converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
 		ID:          r.ID,
@@ -1258,6 +1743,133 @@ func (c *Converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimo
 	}, nil
 }
 
+// ListRuleToAPIListRule converts one gts model list rule into an api model list rule, for serving at /api/v1/lists/{id}/rules
+func (c *Converter) ListRuleToAPIListRule(ctx context.Context, r *gtsmodel.ListRule) (*apimodel.ListRule, error) {
+	if err := c.state.DB.PopulateListRule(ctx, r); err != nil {
+		return nil, gtserror.Newf("error populating list rule: %w", err)
+	}
+
+	apiRule := &apimodel.ListRule{
+		ID: r.ID,
+	}
+
+	if r.Tag != nil {
+		apiTag, err := c.TagToAPITag(ctx, r.Tag, true)
+		if err != nil {
+			return nil, gtserror.Newf("error converting list rule tag: %w", err)
+		}
+		apiRule.Tag = &apiTag
+	}
+
+	if r.TargetAccount != nil {
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, r.TargetAccount)
+		if err != nil {
+			return nil, gtserror.Newf("error converting list rule account: %w", err)
+		}
+		apiRule.Account = apiAccount
+	}
+
+	return apiRule, nil
+}
+
+// InstancePageToAPIInstancePage converts one gts model instance page into an api model instance page.
+func (c *Converter) InstancePageToAPIInstancePage(p *gtsmodel.InstancePage) *apimodel.InstancePage {
+	return &apimodel.InstancePage{
+		ID:        p.ID,
+		CreatedAt: util.FormatISO8601(p.CreatedAt),
+		UpdatedAt: util.FormatISO8601(p.UpdatedAt),
+		Slug:      p.Slug,
+		Title:     p.Title,
+		Text:      p.Text,
+		Content:   p.Content,
+		NavOrder:  p.NavOrder,
+		ShowInNav: *p.ShowInNav,
+	}
+}
+
+// SavedSearchToAPISavedSearch converts one gts model saved search into an api model saved search, for serving at /api/v1/saved_searches
+func (c *Converter) SavedSearchToAPISavedSearch(ctx context.Context, s *gtsmodel.SavedSearch) *apimodel.SavedSearch {
+	return &apimodel.SavedSearch{
+		ID:        s.ID,
+		CreatedAt: util.FormatISO8601(s.CreatedAt),
+		Title:     s.Title,
+		Query:     s.Query,
+		Notify:    *s.Notify,
+	}
+}
+
+// FeaturedTagToAPIFeaturedTag converts one gts model featured tag into an api model featured tag, for serving at /api/v1/featured_tags
+func (c *Converter) FeaturedTagToAPIFeaturedTag(ctx context.Context, f *gtsmodel.FeaturedTag) (*apimodel.FeaturedTag, error) {
+	if f.Tag == nil {
+		var err error
+		f.Tag, err = c.state.DB.GetTag(ctx, f.TagID)
+		if err != nil {
+			return nil, gtserror.Newf("error getting tag %s: %w", f.TagID, err)
+		}
+	}
+
+	count, lastStatusAt, err := c.state.DB.GetFeaturedTagUsage(ctx, f)
+	if err != nil {
+		return nil, gtserror.Newf("error getting usage of featured tag %s: %w", f.ID, err)
+	}
+
+	apiFeaturedTag := &apimodel.FeaturedTag{
+		ID:            f.ID,
+		Name:          strings.ToLower(f.Tag.Name),
+		URL:           uris.GenerateURIForTag(f.Tag.Name),
+		StatusesCount: count,
+	}
+
+	if !lastStatusAt.IsZero() {
+		apiFeaturedTag.LastStatusAt = util.Ptr(util.FormatISO8601(lastStatusAt))
+	}
+
+	return apiFeaturedTag, nil
+}
+
+// DraftToAPIDraft converts one gts model draft into an api model draft, for serving at /api/v1/drafts/{id}
+func (c *Converter) DraftToAPIDraft(ctx context.Context, d *gtsmodel.Draft) (*apimodel.Draft, error) {
+	apiDraft := &apimodel.Draft{
+		ID:               d.ID,
+		CreatedAt:        util.FormatISO8601(d.CreatedAt),
+		UpdatedAt:        util.FormatISO8601(d.UpdatedAt),
+		Status:           d.Text,
+		SpoilerText:      d.ContentWarning,
+		MediaAttachments: make([]*apimodel.Attachment, 0, len(d.Attachments)),
+		Sensitive:        *d.Sensitive,
+		Language:         d.Language,
+		ContentType:      apimodel.StatusContentType(d.ContentType),
+	}
+
+	if d.Visibility != "" {
+		apiDraft.Visibility = c.VisToAPIVis(ctx, d.Visibility)
+	}
+
+	if d.InReplyToID != "" {
+		apiDraft.InReplyToID = &d.InReplyToID
+	}
+
+	for _, a := range d.Attachments {
+		apiAttachment, err := c.AttachmentToAPIAttachment(ctx, a)
+		if err != nil {
+			log.Errorf(ctx, "error converting attachment with id %s: %v", a.ID, err)
+			continue
+		}
+		apiDraft.MediaAttachments = append(apiDraft.MediaAttachments, &apiAttachment)
+	}
+
+	if d.HasPoll() {
+		apiDraft.Poll = &apimodel.DraftPoll{
+			Options:    d.PollOptions,
+			ExpiresIn:  d.PollExpiresIn,
+			Multiple:   *d.PollMultiple,
+			HideTotals: *d.PollHideTotals,
+		}
+	}
+
+	return apiDraft, nil
+}
+
 // MarkersToAPIMarker converts several gts model markers into an api marker, for serving at /api/v1/markers
 func (c *Converter) MarkersToAPIMarker(ctx context.Context, markers []*gtsmodel.Marker) (*apimodel.Marker, error) {
 	apiMarker := &apimodel.Marker{}
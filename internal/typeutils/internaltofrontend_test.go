@@ -21,8 +21,10 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -48,7 +50,10 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontend() {
   "display_name": "original zork (he/they)",
   "locked": false,
   "discoverable": true,
+  "indexable": false,
+  "noindex": false,
   "bot": false,
+  "group": false,
   "created_at": "2022-05-20T11:09:18.000Z",
   "note": "\u003cp\u003ehey yo this is my profile!\u003c/p\u003e",
   "url": "http://localhost:8080/@the_mighty_zork",
@@ -90,7 +95,10 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendWithEmojiStruct()
   "display_name": "original zork (he/they)",
   "locked": false,
   "discoverable": true,
+  "indexable": false,
+  "noindex": false,
   "bot": false,
+  "group": false,
   "created_at": "2022-05-20T11:09:18.000Z",
   "note": "\u003cp\u003ehey yo this is my profile!\u003c/p\u003e",
   "url": "http://localhost:8080/@the_mighty_zork",
@@ -138,7 +146,10 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendWithEmojiIDs() {
   "display_name": "original zork (he/they)",
   "locked": false,
   "discoverable": true,
+  "indexable": false,
+  "noindex": false,
   "bot": false,
+  "group": false,
   "created_at": "2022-05-20T11:09:18.000Z",
   "note": "\u003cp\u003ehey yo this is my profile!\u003c/p\u003e",
   "url": "http://localhost:8080/@the_mighty_zork",
@@ -182,7 +193,10 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendSensitive() {
   "display_name": "original zork (he/they)",
   "locked": false,
   "discoverable": true,
+  "indexable": false,
+  "noindex": false,
   "bot": false,
+  "group": false,
   "created_at": "2022-05-20T11:09:18.000Z",
   "note": "\u003cp\u003ehey yo this is my profile!\u003c/p\u003e",
   "url": "http://localhost:8080/@the_mighty_zork",
@@ -201,6 +215,11 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendSensitive() {
     "sensitive": false,
     "language": "en",
     "status_content_type": "text/plain",
+    "locale": "en",
+    "email_notifications": "none",
+    "review_first_interactions": false,
+    "time_zone": "",
+    "highlights_digest": "none",
     "note": "hey yo this is my profile!",
     "fields": [],
     "follow_requests_count": 0
@@ -231,7 +250,10 @@ func (suite *InternalToFrontendTestSuite) TestAccountToFrontendPublicPunycode()
   "display_name": "",
   "locked": false,
   "discoverable": false,
+  "indexable": false,
+  "noindex": false,
   "bot": false,
+  "group": false,
   "created_at": "2020-08-10T12:13:28.000Z",
   "note": "",
   "url": "https://xn--xample-ova.org/users/@%C3%BCser",
@@ -269,7 +291,10 @@ func (suite *InternalToFrontendTestSuite) TestLocalInstanceAccountToFrontendPubl
   "display_name": "",
   "locked": false,
   "discoverable": true,
+  "indexable": false,
+  "noindex": false,
   "bot": false,
+  "group": false,
   "created_at": "2020-05-17T13:10:59.000Z",
   "note": "",
   "url": "http://localhost:8080/@localhost:8080",
@@ -307,7 +332,10 @@ func (suite *InternalToFrontendTestSuite) TestLocalInstanceAccountToFrontendBloc
   "display_name": "",
   "locked": false,
   "discoverable": false,
+  "indexable": false,
+  "noindex": false,
   "bot": false,
+  "group": false,
   "created_at": "2020-05-17T13:10:59.000Z",
   "note": "",
   "url": "http://localhost:8080/@localhost:8080",
@@ -365,7 +393,10 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -412,6 +443,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
         }
       },
       "description": "Black and white image of some 50's style text saying: Welcome On Board",
+      "sensitive": false,
       "blurhash": "LNJRdVM{00Rj%Mayt7j[4nWBofRj"
     }
   ],
@@ -437,6 +469,69 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestGroupAccountToFrontend() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["remote_account_1"]
+	testAccount.ActorType = ap.ActorGroup
+
+	apiAccount, err := suite.typeconverter.AccountToAPIAccountPublic(context.Background(), testAccount)
+	suite.NoError(err)
+	suite.True(apiAccount.Group)
+	suite.False(apiAccount.Bot)
+}
+
+func (suite *InternalToFrontendTestSuite) TestEventStatusToFrontend() {
+	testStatus := &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["admin_account_status_1"]
+	testStatus.ActivityStreamsType = ap.ObjectEvent
+	testStatus.EventStartAt = time.Date(2023, 11, 5, 18, 0, 0, 0, time.UTC)
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(context.Background(), testStatus, requestingAccount)
+	suite.NoError(err)
+
+	// Event with no end time.
+	if suite.NotNil(apiStatus.Event) {
+		suite.Equal("2023-11-05T18:00:00.000Z", apiStatus.Event.StartTime)
+		suite.Nil(apiStatus.Event.EndTime)
+	}
+
+	testStatus.EventEndAt = time.Date(2023, 11, 5, 21, 30, 0, 0, time.UTC)
+
+	apiStatus, err = suite.typeconverter.StatusToAPIStatus(context.Background(), testStatus, requestingAccount)
+	suite.NoError(err)
+
+	// Event with an end time.
+	if suite.NotNil(apiStatus.Event) && suite.NotNil(apiStatus.Event.EndTime) {
+		suite.Equal("2023-11-05T18:00:00.000Z", apiStatus.Event.StartTime)
+		suite.Equal("2023-11-05T21:30:00.000Z", *apiStatus.Event.EndTime)
+	}
+}
+
+func (suite *InternalToFrontendTestSuite) TestStatusToFrontendRemoteSanitized() {
+	config.SetAdvancedSanitizerPolicy("minimal")
+	defer config.SetAdvancedSanitizerPolicy("standard")
+
+	testStatus := &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["remote_account_1_status_1"]
+	testStatus.Content = `<h2>big news</h2><p><strong>it's</strong> <code>true</code></p>`
+	requestingAccount := suite.testAccounts["local_account_1"]
+	apiStatus, err := suite.typeconverter.StatusToAPIStatus(context.Background(), testStatus, requestingAccount)
+	suite.NoError(err)
+
+	// Elements not allowed by the minimal
+	// policy should have been stripped.
+	suite.Equal(`big news<p><strong>it&#39;s</strong> true</p>`, apiStatus.Content)
+
+	// Local statuses shouldn't be touched.
+	testStatus = &gtsmodel.Status{}
+	*testStatus = *suite.testStatuses["local_account_1_status_1"]
+	testStatus.Content = `<h2>big news</h2>`
+	apiStatus, err = suite.typeconverter.StatusToAPIStatus(context.Background(), testStatus, requestingAccount)
+	suite.NoError(err)
+	suite.Equal(`<h2>big news</h2>`, apiStatus.Content)
+}
+
 func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage() {
 	testStatus := &gtsmodel.Status{}
 	*testStatus = *suite.testStatuses["admin_account_status_1"]
@@ -480,7 +575,10 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -527,6 +625,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
         }
       },
       "description": "Black and white image of some 50's style text saying: Welcome On Board",
+      "sensitive": false,
       "blurhash": "LNJRdVM{00Rj%Mayt7j[4nWBofRj"
     }
   ],
@@ -583,7 +682,8 @@ func (suite *InternalToFrontendTestSuite) TestVideoAttachmentToFrontend() {
       "aspect": 1.7821782
     }
   },
-  "description": "A cow adorably licking another cow!"
+  "description": "A cow adorably licking another cow!",
+  "sensitive": false
 }`, string(b))
 }
 
@@ -621,6 +721,7 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -638,7 +739,8 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -649,7 +751,8 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "emojis": {
       "emoji_size_limit": 51200
@@ -671,7 +774,10 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV1ToFrontend() {
     "display_name": "",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-05-17T13:10:59.000Z",
     "note": "",
     "url": "http://localhost:8080/@admin",
@@ -734,12 +840,14 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
     "accounts": {
       "allow_custom_css": true,
       "max_featured_tags": 10,
-      "max_profile_fields": 6
+      "max_profile_fields": 6,
+      "max_pinned_statuses": 10
     },
     "statuses": {
       "max_characters": 5000,
       "max_media_attachments": 6,
       "characters_reserved_per_url": 25,
+      "max_content_warning_characters": 100,
       "supported_mime_types": [
         "text/plain",
         "text/markdown"
@@ -757,7 +865,8 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
       "image_matrix_limit": 16777216,
       "video_size_limit": 41943040,
       "video_frame_rate_limit": 60,
-      "video_matrix_limit": 16777216
+      "video_matrix_limit": 16777216,
+      "description_limit": 500
     },
     "polls": {
       "max_options": 6,
@@ -775,7 +884,8 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
   "registrations": {
     "enabled": true,
     "approval_required": true,
-    "message": null
+    "message": null,
+    "challenge": null
   },
   "contact": {
     "email": "admin@example.org",
@@ -786,7 +896,10 @@ func (suite *InternalToFrontendTestSuite) TestInstanceV2ToFrontend() {
       "display_name": "",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-05-17T13:10:59.000Z",
       "note": "",
       "url": "http://localhost:8080/@admin",
@@ -900,7 +1013,10 @@ func (suite *InternalToFrontendTestSuite) TestReportToFrontend1() {
     "display_name": "big gerald",
     "locked": false,
     "discoverable": true,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2021-09-26T10:52:36.000Z",
     "note": "i post about like, i dunno, stuff, or whatever!!!!",
     "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -943,7 +1059,10 @@ func (suite *InternalToFrontendTestSuite) TestReportToFrontend2() {
     "display_name": "happy little turtle :3",
     "locked": true,
     "discoverable": false,
+    "indexable": false,
+    "noindex": false,
     "bot": false,
+    "group": false,
     "created_at": "2022-06-04T13:12:00.000Z",
     "note": "\u003cp\u003ei post about things that concern me\u003c/p\u003e",
     "url": "http://localhost:8080/@1happyturtle",
@@ -1017,7 +1136,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "display_name": "big gerald",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2021-09-26T10:52:36.000Z",
       "note": "i post about like, i dunno, stuff, or whatever!!!!",
       "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -1058,7 +1180,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "display_name": "happy little turtle :3",
       "locked": true,
       "discoverable": false,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-06-04T13:12:00.000Z",
       "note": "\u003cp\u003ei post about things that concern me\u003c/p\u003e",
       "url": "http://localhost:8080/@1happyturtle",
@@ -1114,7 +1239,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "display_name": "",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-05-17T13:10:59.000Z",
       "note": "",
       "url": "http://localhost:8080/@admin",
@@ -1160,7 +1288,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
       "display_name": "",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-05-17T13:10:59.000Z",
       "note": "",
       "url": "http://localhost:8080/@admin",
@@ -1229,7 +1360,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "display_name": "happy little turtle :3",
       "locked": true,
       "discoverable": false,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-06-04T13:12:00.000Z",
       "note": "\u003cp\u003ei post about things that concern me\u003c/p\u003e",
       "url": "http://localhost:8080/@1happyturtle",
@@ -1285,7 +1419,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "display_name": "big gerald",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2021-09-26T10:52:36.000Z",
       "note": "i post about like, i dunno, stuff, or whatever!!!!",
       "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -1332,7 +1469,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
         "display_name": "big gerald",
         "locked": false,
         "discoverable": true,
+        "indexable": false,
+        "noindex": false,
         "bot": false,
+        "group": false,
         "created_at": "2021-09-26T10:52:36.000Z",
         "note": "i post about like, i dunno, stuff, or whatever!!!!",
         "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -1375,6 +1515,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
             }
           },
           "description": "tweet from thoughts of dog: i drank. all the water. in my bowl. earlier. but just now. i returned. to the same bowl. and it was. full again.. the bowl. is haunted",
+          "sensitive": false,
           "blurhash": "LARysgM_IU_3~pD%M_Rj_39FIAt6"
         }
       ],
@@ -1463,7 +1604,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "display_name": "big gerald",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2021-09-26T10:52:36.000Z",
       "note": "i post about like, i dunno, stuff, or whatever!!!!",
       "url": "http://fossbros-anonymous.io/@foss_satan",
@@ -1504,7 +1648,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "display_name": "",
       "locked": true,
       "discoverable": false,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-06-04T13:12:00.000Z",
       "note": "",
       "url": "http://localhost:8080/@1happyturtle",
@@ -1549,7 +1696,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "display_name": "",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-05-17T13:10:59.000Z",
       "note": "",
       "url": "http://localhost:8080/@admin",
@@ -1595,7 +1745,10 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontendSuspendedLoca
       "display_name": "",
       "locked": false,
       "discoverable": true,
+      "indexable": false,
+      "noindex": false,
       "bot": false,
+      "group": false,
       "created_at": "2022-05-17T13:10:59.000Z",
       "note": "",
       "url": "http://localhost:8080/@admin",
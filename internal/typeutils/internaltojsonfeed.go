@@ -0,0 +1,99 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package typeutils
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// JSONFeedVersion is the URL of the version of
+// JSON Feed that GoToSocial serves feeds in.
+const JSONFeedVersion = "https://jsonfeed.org/version/1.1"
+
+// AccountToJSONFeedAuthor converts a gts model account
+// into an author for use in a JSON Feed. The account's
+// avatar should already be populated, if it has one.
+func (c *Converter) AccountToJSONFeedAuthor(a *gtsmodel.Account) apimodel.JSONFeedAuthor {
+	author := apimodel.JSONFeedAuthor{
+		Name: "@" + a.Username + "@" + config.GetAccountDomain(),
+		URL:  a.URL,
+	}
+
+	if a.AvatarMediaAttachment != nil {
+		author.Avatar = a.AvatarMediaAttachment.Thumbnail.URL
+	}
+
+	return author
+}
+
+// StatusToJSONFeedItem converts a gts model status
+// into an item for use in a JSON Feed, with all of
+// the status' media attachments as enclosures.
+func (c *Converter) StatusToJSONFeedItem(ctx context.Context, s *gtsmodel.Status) (*apimodel.JSONFeedItem, error) {
+	if err := c.state.DB.PopulateStatus(ctx, s); err != nil {
+		// Ensure author account present + correct;
+		// can't really go further without this!
+		if s.Account == nil {
+			return nil, fmt.Errorf("error(s) populating status, cannot continue: %w", err)
+		}
+
+		log.Errorf(ctx, "error(s) populating status, will continue: %v", err)
+	}
+
+	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, s.Emojis, s.EmojiIDs)
+	if err != nil {
+		log.Errorf(ctx, "error converting status emojis: %v", err)
+	}
+
+	item := &apimodel.JSONFeedItem{
+		ID:            s.URL,
+		URL:           s.URL,
+		ContentHTML:   text.Emojify(apiEmojis, s.Content),
+		Summary:       s.ContentWarning,
+		DatePublished: util.FormatISO8601(s.CreatedAt),
+		Authors:       []apimodel.JSONFeedAuthor{c.AccountToJSONFeedAuthor(s.Account)},
+		Language:      s.Language,
+	}
+
+	if s.UpdatedAt.After(s.CreatedAt) {
+		item.DateModified = util.FormatISO8601(s.UpdatedAt)
+	}
+
+	for _, tag := range s.Tags {
+		item.Tags = append(item.Tags, tag.Name)
+	}
+
+	for _, attachment := range s.Attachments {
+		item.Attachments = append(item.Attachments, apimodel.JSONFeedAttachment{
+			URL:         attachment.URL,
+			MIMEType:    attachment.File.ContentType,
+			Title:       attachment.Description,
+			SizeInBytes: attachment.File.FileSize,
+		})
+	}
+
+	return item, nil
+}
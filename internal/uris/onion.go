@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package uris
+
+import (
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+// onionProtocol is the protocol that
+// the onion service is assumed to be
+// served on; tor already provides an
+// encrypted + authenticated transport.
+const onionProtocol = "http"
+
+// IsOnionHost returns true if the given host is the
+// onion service address this instance is also served on.
+func IsOnionHost(host string) bool {
+	onion := config.GetOnionHost()
+	return onion != "" && host == onion
+}
+
+// IsLocalHost returns true if the given host is
+// either the configured host of this instance, or
+// the onion service address it is also served on.
+func IsLocalHost(host string) bool {
+	return host == config.GetHost() || IsOnionHost(host)
+}
+
+// Canonicalize returns the given URI with the onion service
+// address swapped for the configured protocol and host, as
+// local URIs are always stored using the latter. URIs on any
+// other host are returned unchanged.
+func Canonicalize(u *url.URL) *url.URL {
+	if !IsOnionHost(u.Host) {
+		return u
+	}
+	c := new(url.URL)
+	*c = *u
+	c.Scheme = config.GetProtocol()
+	c.Host = config.GetHost()
+	return c
+}
+
+// ServedOn returns the protocol and host to use when
+// building links in a response to a request made to
+// the given host, so that requests reaching us via the
+// onion service are pointed back at the onion service.
+func ServedOn(requestHost string) (protocol string, host string) {
+	if IsOnionHost(requestHost) {
+		return onionProtocol, requestHost
+	}
+	return config.GetProtocol(), config.GetHost()
+}
+
+// OnionLocation returns the URL of the given request
+// path on the onion service, or an empty string if no
+// onion service address is configured.
+func OnionLocation(requestURI string) string {
+	onion := config.GetOnionHost()
+	if onion == "" {
+		return ""
+	}
+	return onionProtocol + "://" + onion + requestURI
+}
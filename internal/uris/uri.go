@@ -27,24 +27,26 @@ import (
 )
 
 const (
-	UsersPath        = "users"         // UsersPath is for serving users info
-	StatusesPath     = "statuses"      // StatusesPath is for serving statuses
-	InboxPath        = "inbox"         // InboxPath represents the activitypub inbox location
-	OutboxPath       = "outbox"        // OutboxPath represents the activitypub outbox location
-	FollowersPath    = "followers"     // FollowersPath represents the activitypub followers location
-	FollowingPath    = "following"     // FollowingPath represents the activitypub following location
-	LikedPath        = "liked"         // LikedPath represents the activitypub liked location
-	CollectionsPath  = "collections"   // CollectionsPath represents the activitypub collections location
-	FeaturedPath     = "featured"      // FeaturedPath represents the activitypub featured location
-	PublicKeyPath    = "main-key"      // PublicKeyPath is for serving an account's public key
-	FollowPath       = "follow"        // FollowPath used to generate the URI for an individual follow or follow request
-	UpdatePath       = "updates"       // UpdatePath is used to generate the URI for an account update
-	BlocksPath       = "blocks"        // BlocksPath is used to generate the URI for a block
-	ReportsPath      = "reports"       // ReportsPath is used to generate the URI for a report/flag
-	ConfirmEmailPath = "confirm_email" // ConfirmEmailPath is used to generate the URI for an email confirmation link
-	FileserverPath   = "fileserver"    // FileserverPath is a path component for serving attachments + media
-	EmojiPath        = "emoji"         // EmojiPath represents the activitypub emoji location
-	TagsPath         = "tags"          // TagsPath represents the activitypub tags location
+	UsersPath         = "users"                     // UsersPath is for serving users info
+	StatusesPath      = "statuses"                  // StatusesPath is for serving statuses
+	InboxPath         = "inbox"                     // InboxPath represents the activitypub inbox location
+	OutboxPath        = "outbox"                    // OutboxPath represents the activitypub outbox location
+	FollowersPath     = "followers"                 // FollowersPath represents the activitypub followers location
+	FollowingPath     = "following"                 // FollowingPath represents the activitypub following location
+	FollowersSyncPath = "followers_synchronization" // FollowersSyncPath represents the location of partial followers collections for FEP-8fcf synchronization
+	LikedPath         = "liked"                     // LikedPath represents the activitypub liked location
+	CollectionsPath   = "collections"               // CollectionsPath represents the activitypub collections location
+	FeaturedPath      = "featured"                  // FeaturedPath represents the activitypub featured location
+	PublicKeyPath     = "main-key"                  // PublicKeyPath is for serving an account's public key
+	FollowPath        = "follow"                    // FollowPath used to generate the URI for an individual follow or follow request
+	UpdatePath        = "updates"                   // UpdatePath is used to generate the URI for an account update
+	BlocksPath        = "blocks"                    // BlocksPath is used to generate the URI for a block
+	ReportsPath       = "reports"                   // ReportsPath is used to generate the URI for a report/flag
+	ConfirmEmailPath  = "confirm_email"             // ConfirmEmailPath is used to generate the URI for an email confirmation link
+	FileserverPath    = "fileserver"                // FileserverPath is a path component for serving attachments + media
+	EmojiPath         = "emoji"                     // EmojiPath represents the activitypub emoji location
+	TagsPath          = "tags"                      // TagsPath represents the activitypub tags location
+	LeavingPath       = "leaving"                   // LeavingPath is used to generate the URI for the interstitial page shown for external links
 )
 
 // UserURIs contains a bunch of UserURIs and URLs for a user, host, account, etc.
@@ -84,6 +86,24 @@ func GenerateURIForFollow(username string, thisFollowID string) string {
 	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, username, FollowPath, thisFollowID)
 }
 
+// GenerateURIForFollowersSync returns the URI of the partial followers
+// collection used for FEP-8fcf synchronization -- something like:
+// https://example.org/users/whatever_user/followers_synchronization
+func GenerateURIForFollowersSync(username string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s/%s", protocol, host, UsersPath, username, FollowersSyncPath)
+}
+
+// GenerateURIForFeaturedTags returns the URI of the collection
+// of hashtags featured by a user -- something like:
+// https://example.org/users/whatever_user/collections/tags
+func GenerateURIForFeaturedTags(username string) string {
+	protocol := config.GetProtocol()
+	host := config.GetHost()
+	return fmt.Sprintf("%s://%s/%s/%s/%s/%s", protocol, host, UsersPath, username, CollectionsPath, TagsPath)
+}
+
 // GenerateURIForLike returns the AP URI for a new like/fave -- something like:
 // https://example.org/users/whatever_user/liked/01F7XTH1QGBAPMGF49WJZ91XGC
 func GenerateURIForLike(username string, thisFavedID string) string {
@@ -348,3 +368,26 @@ func ParseReportPath(id *url.URL) (ulid string, err error) {
 	ulid = matches[1]
 	return
 }
+
+// ParseWebProfilePath returns the username from a web profile path such as /@example_username
+func ParseWebProfilePath(id *url.URL) (username string, err error) {
+	matches := regexes.WebProfilePath.FindStringSubmatch(id.Path)
+	if len(matches) != 2 {
+		err = fmt.Errorf("expected 2 matches but matches length was %d", len(matches))
+		return
+	}
+	username = matches[1]
+	return
+}
+
+// ParseWebStatusPath returns the username and ulid from a web status path such as /@example_username/statuses/SOME_ULID_OF_A_STATUS
+func ParseWebStatusPath(id *url.URL) (username string, ulid string, err error) {
+	matches := regexes.WebStatusPath.FindStringSubmatch(id.Path)
+	if len(matches) != 3 {
+		err = fmt.Errorf("expected 3 matches but matches length was %d", len(matches))
+		return
+	}
+	username = matches[1]
+	ulid = matches[2]
+	return
+}
@@ -0,0 +1,57 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package util_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type BackoffSuite struct {
+	suite.Suite
+}
+
+func (suite *BackoffSuite) TestNextBackoff() {
+	var d time.Duration
+	for _, expect := range []time.Duration{
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		5 * time.Second,
+		5 * time.Second,
+	} {
+		d = util.NextBackoff(d, 5*time.Second)
+		suite.Equal(expect, d)
+	}
+}
+
+func (suite *BackoffSuite) TestSleepCtxCancelled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	suite.False(util.SleepCtx(ctx, time.Minute))
+	suite.True(util.SleepCtx(context.Background(), time.Millisecond))
+}
+
+func TestBackoffSuite(t *testing.T) {
+	suite.Run(t, &BackoffSuite{})
+}
@@ -21,6 +21,10 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -41,9 +45,15 @@ const (
 	maximumSiteTermsLength        = 5000
 	maximumUsernameLength         = 64
 	maximumEmojiCategoryLength    = 64
+	maximumEmojiLicenseLength     = 100
+	maximumEmojiAttributionLength = 500
 	maximumProfileFieldLength     = 255
 	maximumProfileFields          = 6
 	maximumListTitleLength        = 200
+	maximumPageSlugLength         = 64
+	maximumPageTitleLength        = 200
+	maximumPageTextLength         = 50000
+	maximumReactionRunes          = 16 // Enough for flags, skin tones, and most ZWJ sequences.
 )
 
 // Password returns a helpful error if the given password
@@ -174,6 +184,36 @@ func StatusContentType(statusContentType string) error {
 	return fmt.Errorf("status content type '%s' was not recognized, valid options are 'text/plain', 'text/markdown'", statusContentType)
 }
 
+// EmailNotifications checks that the desired email notifications setting is valid.
+func EmailNotifications(emailNotifications string) error {
+	switch emailNotifications {
+	case "none", "immediate", "daily":
+		return nil
+	}
+	return fmt.Errorf("email notifications '%s' was not recognized, valid options are 'none', 'immediate', 'daily'", emailNotifications)
+}
+
+// TimeZone checks that the desired time zone is a known IANA time zone name.
+func TimeZone(timeZone string) error {
+	if timeZone == "" || timeZone == "Local" {
+		return errors.New("no time zone given")
+	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return fmt.Errorf("time zone '%s' was not recognized, use an IANA time zone name like 'Europe/Amsterdam'", timeZone)
+	}
+	return nil
+}
+
+// HighlightsDigest checks that the desired highlights setting is valid.
+func HighlightsDigest(highlightsDigest string) error {
+	switch highlightsDigest {
+	case "none", "daily", "email":
+		return nil
+	}
+	return fmt.Errorf("highlights digest '%s' was not recognized, valid options are 'none', 'daily', 'email'", highlightsDigest)
+}
+
 func CustomCSS(customCSS string) error {
 	if !config.GetAccountsAllowCustomCSS() {
 		return errors.New("accounts-allow-custom-css is not enabled for this instance")
@@ -205,6 +245,22 @@ func EmojiCategory(category string) error {
 	return nil
 }
 
+// EmojiLicense validates the license of an emoji.
+func EmojiLicense(license string) error {
+	if length := len([]rune(license)); length > maximumEmojiLicenseLength {
+		return fmt.Errorf("emoji license should be no more than %d chars but given license was %d", maximumEmojiLicenseLength, length)
+	}
+	return nil
+}
+
+// EmojiAttribution validates the attribution of an emoji.
+func EmojiAttribution(attribution string) error {
+	if length := len([]rune(attribution)); length > maximumEmojiAttributionLength {
+		return fmt.Errorf("emoji attribution should be no more than %d chars but given attribution was %d", maximumEmojiAttributionLength, length)
+	}
+	return nil
+}
+
 // SiteTitle ensures that the given site title is within spec.
 func SiteTitle(siteTitle string) error {
 	if length := len([]rune(siteTitle)); length > maximumSiteTitleLength {
@@ -241,6 +297,42 @@ func SiteTerms(t string) error {
 	return nil
 }
 
+// InstancePageSlug ensures that the given instance page slug
+// contains only lowercase letters, numbers, and single hyphens.
+func InstancePageSlug(slug string) error {
+	if length := len(slug); length == 0 || length > maximumPageSlugLength {
+		return fmt.Errorf("page slug must be provided, and must be no more than %d chars", maximumPageSlugLength)
+	}
+
+	if !regexes.InstancePageSlug.MatchString(slug) {
+		return fmt.Errorf("page slug %s was invalid: must contain only lowercase letters, numbers, and hyphens", slug)
+	}
+
+	return nil
+}
+
+// InstancePageTitle ensures that the given instance page title is within spec.
+func InstancePageTitle(title string) error {
+	if title == "" {
+		return fmt.Errorf("page title must be provided, and must be no more than %d chars", maximumPageTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumPageTitleLength {
+		return fmt.Errorf("page title should be no more than %d chars but given title was %d", maximumPageTitleLength, length)
+	}
+
+	return nil
+}
+
+// InstancePageText ensures that the given instance page text is within spec.
+func InstancePageText(text string) error {
+	if length := len([]rune(text)); length > maximumPageTextLength {
+		return fmt.Errorf("page text should be no more than %d chars but given text was %d", maximumPageTextLength, length)
+	}
+
+	return nil
+}
+
 // ULID returns true if the passed string is a valid ULID.
 func ULID(i string) bool {
 	return regexes.ULID.MatchString(i)
@@ -306,3 +398,35 @@ func MarkerName(name string) error {
 	}
 	return fmt.Errorf("marker timeline name '%s' was not recognized, valid options are '%s', '%s'", name, apimodel.MarkerNameHome, apimodel.MarkerNameNotifications)
 }
+
+// StatusReaction checks that the given emoji reaction content is either
+// a custom emoji shortcode wrapped in colons (eg., ':blobcat:'), or a
+// short sequence of unicode emoji runes with no letters, digits, or spaces.
+func StatusReaction(content string) error {
+	if content == "" {
+		return errors.New("empty string for emoji reaction not allowed")
+	}
+
+	if strings.HasPrefix(content, ":") {
+		shortcode := strings.TrimSuffix(strings.TrimPrefix(content, ":"), ":")
+		if shortcode == "" || len(shortcode) != len(content)-2 ||
+			regexes.EmojiShortcode.FindString(shortcode) != shortcode {
+			return fmt.Errorf("emoji reaction %s did not pass validation, custom emoji must be a valid shortcode wrapped in colons", content)
+		}
+		return nil
+	}
+
+	if length := utf8.RuneCountInString(content); length > maximumReactionRunes {
+		return fmt.Errorf("emoji reaction should be no more than %d runes but given reaction was %d", maximumReactionRunes, length)
+	}
+
+	for _, r := range content {
+		if r < utf8.RuneSelf || r == utf8.RuneError ||
+			unicode.IsLetter(r) || unicode.IsDigit(r) ||
+			unicode.IsSpace(r) || unicode.IsControl(r) {
+			return fmt.Errorf("emoji reaction %s did not pass validation, must be a unicode emoji or custom emoji shortcode", content)
+		}
+	}
+
+	return nil
+}
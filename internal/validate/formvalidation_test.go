@@ -321,6 +321,33 @@ func (suite *ValidationTestSuite) TestValidateCustomCSSTooLongUnicode() {
 	suite.EqualError(err, "custom_css must be less than 5 characters, but submitted custom_css was 10 characters")
 }
 
+func (suite *ValidationTestSuite) TestValidateStatusReaction() {
+	for _, content := range []string{
+		"👍",
+		"🐢",
+		"🏳️‍🌈",
+		"👋🏽",
+		":blobcat:",
+		":blob_cat_2:",
+	} {
+		suite.NoError(validate.StatusReaction(content), content)
+	}
+
+	for _, content := range []string{
+		"",
+		"::",
+		":a:",
+		":blob cat:",
+		":blobcat",
+		"blobcat",
+		"hi 👋",
+		"1",
+		"👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍👍",
+	} {
+		suite.Error(validate.StatusReaction(content), content)
+	}
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
@@ -54,6 +54,7 @@ func (f *Filter) StatusHomeTimelineable(ctx context.Context, owner *gtsmodel.Acc
 		return &cache.CachedVisibility{
 			ItemID:      status.ID,
 			RequesterID: requesterID,
+			BoostOfID:   status.BoostOfID,
 			Type:        vtype,
 			Value:       visible,
 		}, nil
@@ -94,6 +95,17 @@ func (f *Filter) isStatusHomeTimelineable(ctx context.Context, owner *gtsmodel.A
 		return true, nil
 	}
 
+	// Check whether owner has muted this status.
+	muted, err := f.isStatusMuted(ctx, owner, status)
+	if err != nil {
+		return false, err
+	}
+
+	if muted {
+		log.Trace(ctx, "ignoring muted status")
+		return false, nil
+	}
+
 	if status.MentionsAccount(owner.ID) {
 		// Can always see when you are mentioned.
 		return true, nil
@@ -87,6 +87,96 @@ func (suite *StatusStatusHomeTimelineableTestSuite) TestFollowingBoostedStatusHo
 	suite.False(timelineable)
 }
 
+func (suite *StatusStatusHomeTimelineableTestSuite) TestFollowingStatusHomeTimelineableMuted() {
+	ctx := context.Background()
+
+	testStatus := suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Mute everything from local_account_2.
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01HF8ZKXKTQ3FW5KDMAM0NDZ2X",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		Statuses:        util.Ptr(true),
+		Boosts:          util.Ptr(true),
+		Notifications:   util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err := suite.filter.StatusHomeTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+
+	suite.False(timelineable)
+}
+
+func (suite *StatusStatusHomeTimelineableTestSuite) TestFollowingStatusHomeTimelineableMutedNotificationsOnly() {
+	ctx := context.Background()
+
+	testStatus := suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Mute only notifications from local_account_2.
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01HF8ZKXKTQ3FW5KDMAM0NDZ2X",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		Statuses:        util.Ptr(false),
+		Boosts:          util.Ptr(false),
+		Notifications:   util.Ptr(true),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err := suite.filter.StatusHomeTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+
+	suite.True(timelineable)
+
+	muted, err := suite.filter.NotificationsMuted(ctx, testAccount, testStatus.AccountID)
+	suite.NoError(err)
+
+	suite.True(muted)
+}
+
+func (suite *StatusStatusHomeTimelineableTestSuite) TestFollowingBoostedStatusHomeTimelineableMutedBoostsOnly() {
+	ctx := context.Background()
+
+	testBoost := suite.testStatuses["admin_account_status_4"]
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Mute only boosts by admin_account.
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01HF8ZKXKTQ3FW5KDMAM0NDZ2X",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testBoost.AccountID,
+		Statuses:        util.Ptr(false),
+		Boosts:          util.Ptr(true),
+		Notifications:   util.Ptr(false),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Boost should now be hidden...
+	timelineable, err := suite.filter.StatusHomeTimelineable(ctx, testAccount, testBoost)
+	suite.NoError(err)
+
+	suite.False(timelineable)
+
+	// ...but statuses by the booster still shown.
+	timelineable, err = suite.filter.StatusHomeTimelineable(ctx, testAccount, testStatus)
+	suite.NoError(err)
+
+	suite.True(timelineable)
+
+	muted, err := suite.filter.NotificationsMuted(ctx, testAccount, testBoost.AccountID)
+	suite.NoError(err)
+
+	suite.False(muted)
+}
+
 func (suite *StatusStatusHomeTimelineableTestSuite) TestNotFollowingStatusHomeTimelineable() {
 	testStatus := suite.testStatuses["remote_account_1_status_1"]
 	testAccount := suite.testAccounts["local_account_1"]
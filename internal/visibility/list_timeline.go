@@ -0,0 +1,136 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// StatusListRuleTimelineable checks if given status should be included on the given list's timeline
+// by virtue of matching one of the list's rules (ie., by tag or author), rather than one of its follow
+// entries. This relies on status visibility to list owner, and the replies policy of the list.
+//
+// Unlike the other timelineable checks, results are not cached, since list rules may change at any time.
+func (f *Filter) StatusListRuleTimelineable(ctx context.Context, owner *gtsmodel.Account, list *gtsmodel.List, status *gtsmodel.Status) (bool, error) {
+	if status.CreatedAt.After(time.Now().Add(24 * time.Hour)) {
+		// Statuses made over 1 day in the future we don't show...
+		log.Warnf(ctx, "status >24hrs in the future: %+v", status)
+		return false, nil
+	}
+
+	// Fetch the rules of this list.
+	rules, err := f.state.DB.GetListRules(
+		gtscontext.SetBarebones(ctx),
+		list.ID,
+	)
+	if err != nil {
+		return false, gtserror.Newf("error getting rules for list %s: %w", list.ID, err)
+	}
+
+	if !listRulesMatch(rules, status) {
+		// Not relevant
+		// to this list.
+		return false, nil
+	}
+
+	// Check whether status is visible to list owner.
+	visible, err := f.StatusVisible(ctx, owner, status)
+	if err != nil {
+		return false, err
+	}
+
+	if !visible {
+		log.Trace(ctx, "status not visible to list owner")
+		return false, nil
+	}
+
+	if status.InReplyToURI == "" {
+		// Not a reply,
+		// nothing else
+		// to check.
+		return true, nil
+	}
+
+	if status.InReplyToID == "" {
+		// Status is a reply but we don't
+		// have the replied-to account!
+		return false, nil
+	}
+
+	if status.InReplyToAccountID == status.AccountID {
+		// Self-replies (threads) are
+		// fine under all reply policies
+		// except "none", handled below.
+		return list.RepliesPolicy != gtsmodel.RepliesPolicyNone, nil
+	}
+
+	switch list.RepliesPolicy {
+	case gtsmodel.RepliesPolicyNone:
+		// No replies at all.
+		return false, nil
+
+	case gtsmodel.RepliesPolicyList:
+		// Only replies to accounts in
+		// the list, either as follow
+		// entries or as author rules.
+		for _, rule := range rules {
+			if rule.TargetAccountID == status.InReplyToAccountID {
+				return true, nil
+			}
+		}
+
+		return f.state.DB.ListIncludesAccount(ctx, list.ID, status.InReplyToAccountID)
+
+	default:
+		// Only replies to accounts
+		// followed by list owner.
+		return f.state.DB.IsFollowing(ctx, owner.ID, status.InReplyToAccountID)
+	}
+}
+
+// listRulesMatch returns whether the given status matches
+// any of the given list rules, either by author or by tag.
+//
+// Boosts are matched only by the account that did
+// the boosting, not by the tags of the boosted status.
+func listRulesMatch(rules []*gtsmodel.ListRule, status *gtsmodel.Status) bool {
+	for _, rule := range rules {
+		if rule.TargetAccountID != "" &&
+			rule.TargetAccountID == status.AccountID {
+			return true
+		}
+
+		if rule.TagID == "" || status.BoostOfID != "" {
+			continue
+		}
+
+		for _, tagID := range status.TagIDs {
+			if tagID == rule.TagID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
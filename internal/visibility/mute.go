@@ -0,0 +1,97 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package visibility
+
+import (
+	"context"
+	"errors"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtscontext"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// NotificationsMuted checks whether owner has muted notifications
+// originating from account with given ID, in which case they should
+// neither be created for, nor shown to, the owner.
+func (f *Filter) NotificationsMuted(ctx context.Context, owner *gtsmodel.Account, originAccountID string) (bool, error) {
+	if originAccountID == "" || originAccountID == owner.ID {
+		// Can't mute yourself.
+		return false, nil
+	}
+
+	mute, err := f.getMute(ctx, owner.ID, originAccountID)
+	if err != nil {
+		return false, err
+	}
+
+	return (mute != nil && *mute.Notifications), nil
+}
+
+// isStatusMuted checks whether the given status should be hidden from
+// requester's timelines due to a mute, i.e. the requester has muted the
+// statuses of its author (or of the boosted author, if it is a boost),
+// or the status is a boost and requester has muted the booster's boosts.
+func (f *Filter) isStatusMuted(ctx context.Context, requester *gtsmodel.Account, status *gtsmodel.Status) (bool, error) {
+	// Check for a mute on the author of this status.
+	mute, err := f.getMute(ctx, requester.ID, status.AccountID)
+	if err != nil {
+		return false, err
+	}
+
+	if mute != nil {
+		if status.BoostOfID == "" && *mute.Statuses {
+			// Requester has muted statuses by author.
+			return true, nil
+		}
+
+		if status.BoostOfID != "" && *mute.Boosts {
+			// Requester has muted boosts by author.
+			return true, nil
+		}
+	}
+
+	if status.BoostOfAccountID == "" ||
+		status.BoostOfAccountID == requester.ID {
+		// Not a boost, or a
+		// boost of requester.
+		return false, nil
+	}
+
+	// Check for a mute on the author of the boosted status.
+	mute, err = f.getMute(ctx, requester.ID, status.BoostOfAccountID)
+	if err != nil {
+		return false, err
+	}
+
+	return (mute != nil && *mute.Statuses), nil
+}
+
+// getMute fetches the mute from account with ID on target account with ID, if any.
+func (f *Filter) getMute(ctx context.Context, accountID string, targetAccountID string) (*gtsmodel.UserMute, error) {
+	mute, err := f.state.DB.GetMute(
+		gtscontext.SetBarebones(ctx),
+		accountID,
+		targetAccountID,
+	)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.Newf("error getting mute %s->%s: %w", accountID, targetAccountID, err)
+	}
+	return mute, nil
+}
@@ -51,6 +51,7 @@ func (f *Filter) StatusPublicTimelineable(ctx context.Context, requester *gtsmod
 		return &cache.CachedVisibility{
 			ItemID:      status.ID,
 			RequesterID: requesterID,
+			BoostOfID:   status.BoostOfID,
 			Type:        vtype,
 			Value:       visible,
 		}, nil
@@ -91,6 +92,19 @@ func (f *Filter) isStatusPublicTimelineable(ctx context.Context, requester *gtsm
 		return false, nil
 	}
 
+	if requester != nil && status.AccountID != requester.ID {
+		// Check whether requester has muted this status.
+		muted, err := f.isStatusMuted(ctx, requester, status)
+		if err != nil {
+			return false, err
+		}
+
+		if muted {
+			log.Trace(ctx, "ignoring muted status")
+			return false, nil
+		}
+	}
+
 	for parent := status; parent.InReplyToURI != ""; {
 		// Fetch next parent to lookup.
 		parentID := parent.InReplyToID
@@ -70,6 +70,7 @@ func (f *Filter) StatusVisible(ctx context.Context, requester *gtsmodel.Account,
 		return &cache.CachedVisibility{
 			ItemID:      status.ID,
 			RequesterID: requesterID,
+			BoostOfID:   status.BoostOfID,
 			Type:        vtype,
 			Value:       visible,
 		}, nil
@@ -96,6 +97,18 @@ func (f *Filter) isStatusVisible(ctx context.Context, requester *gtsmodel.Accoun
 		return false, nil
 	}
 
+	if status.Account.IsRemote() {
+		// Remote statuses held by the spam filter aren't
+		// visible to anyone until a moderator approves them.
+		quarantined, err := f.state.DB.IsStatusQuarantined(ctx, status.ID)
+		if err != nil {
+			return false, fmt.Errorf("isStatusVisible: error checking status %s quarantine: %w", status.ID, err)
+		} else if quarantined {
+			log.Trace(ctx, "status held by spam filter")
+			return false, nil
+		}
+	}
+
 	if status.Visibility == gtsmodel.VisibilityPublic {
 		// This status will be visible to all.
 		return true, nil
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -156,6 +157,79 @@ func (suite *StatusVisibleTestSuite) TestStatusNotVisibleIfNotFollowingCached()
 	suite.False(visible)
 }
 
+func (suite *StatusVisibleTestSuite) TestQuarantinedStatusNotVisible() {
+	ctx := context.Background()
+
+	testStatus := suite.testStatuses["remote_account_1_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+
+	quarantined := &gtsmodel.QuarantinedStatus{
+		ID:        "01HF1DQ7A8V3S5X0E6H9R5KJ3Z",
+		StatusID:  testStatus.ID,
+		AccountID: testStatus.AccountID,
+		Score:     60,
+	}
+	if err := suite.db.PutQuarantinedStatus(ctx, quarantined); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Held status is visible to no-one.
+	for _, requester := range []*gtsmodel.Account{testAccount, nil} {
+		visible, err := suite.filter.StatusVisible(ctx, requester, testStatus)
+		suite.NoError(err)
+		suite.False(visible)
+	}
+
+	// Once released, the status should be visible again.
+	if err := suite.db.DeleteQuarantinedStatusByID(ctx, quarantined.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	visible, err := suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(visible)
+}
+
+func (suite *StatusVisibleTestSuite) TestVisibleInvalidatedByDomainBlock() {
+	ctx := context.Background()
+
+	testStatus := suite.testStatuses["remote_account_1_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+
+	// Status should be visible, and
+	// the result now cached for later.
+	visible, err := suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(visible)
+
+	// Second lookup should be served from cache.
+	visible, err = suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.True(visible)
+
+	for _, stats := range suite.state.Caches.Stats() {
+		if stats.Name != "Visibility" {
+			continue
+		}
+		suite.Equal(uint64(1), stats.Breakdown["status"].Hits)
+		suite.NotZero(stats.Breakdown["status"].Misses)
+	}
+
+	// Block the status author's domain, which
+	// should clear the cached visibility result.
+	err = suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01HFZ6FM6ERJ0W6H2ZD9VQ5E4A",
+		Domain:             "fossbros-anonymous.io",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.NoError(err)
+	suite.False(suite.state.Caches.Visibility.Has("Type.RequesterID.ItemID", cache.VisibilityTypeStatus, testAccount.ID, testStatus.ID))
+
+	visible, err = suite.filter.StatusVisible(ctx, testAccount, testStatus)
+	suite.NoError(err)
+	suite.False(visible)
+}
+
 func TestStatusVisibleTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusVisibleTestSuite))
 }
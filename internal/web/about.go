@@ -21,9 +21,11 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 const (
@@ -37,9 +39,43 @@ func (m *Module) aboutGETHandler(c *gin.Context) {
 		return
 	}
 
+	// If admins have written an about
+	// page, show it in place of the
+	// instance description.
+	aboutPage, errWithCode := m.processor.InstanceGetPage(c.Request.Context(), gtsmodel.InstancePageAbout)
+	if errWithCode != nil && errWithCode.Code() != http.StatusNotFound {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	pageNav, errWithCode := m.processor.InstanceGetNavPages(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Credit the authors of custom emojis
+	// that have licensing details set.
+	emojis, errWithCode := m.processor.Media().GetCustomEmojis(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	emojiCredits := make([]*apimodel.Emoji, 0, len(emojis))
+	for _, emoji := range emojis {
+		if emoji.License != "" || emoji.Attribution != "" {
+			emojiCredits = append(emojiCredits, emoji)
+		}
+	}
+
 	c.HTML(http.StatusOK, "about.tmpl", gin.H{
+		"i18n":             apiutil.TemplateLocalizer(c),
 		"instance":         instance,
 		"ogMeta":           ogBase(instance),
+		"aboutPage":        aboutPage,
+		"pageNav":          pageNav,
+		"emojiCredits":     emojiCredits,
 		"blocklistExposed": config.GetInstanceExposeSuspendedWeb(),
 		"stylesheets": []string{
 			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
@@ -40,9 +40,19 @@ func (m *Module) baseHandler(c *gin.Context) {
 		return
 	}
 
+	// Only generate feed links if local timeline feed is enabled.
+	var rssFeed, atomFeed string
+	if config.GetInstanceExposeLocalTimelineRSS() {
+		rssFeed = localRSSFeedPath
+		atomFeed = localAtomFeedPath
+	}
+
 	c.HTML(http.StatusOK, "index.tmpl", gin.H{
+		"i18n":     apiutil.TemplateLocalizer(c),
 		"instance": instance,
 		"ogMeta":   ogBase(instance),
+		"rssFeed":  rssFeed,
+		"atomFeed": atomFeed,
 		"stylesheets": []string{
 			distPathPrefix + "/index.css",
 		},
@@ -0,0 +1,81 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"net/http"
+	"net/url"
+	"path/filepath"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+const (
+	clientSharePath         = clientPathPrefix + "/share"
+	clientServiceWorkerPath = "/client-sw.js"
+)
+
+// ClientHandler serves the logged-in web client, a minimal
+// first-party frontend built on top of the client API.
+func (m *Module) ClientHandler(c *gin.Context) {
+	instance, err := m.processor.InstanceGetV1(c.Request.Context())
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.HTML(http.StatusOK, "frontend.tmpl", gin.H{
+		"i18n":     apiutil.TemplateLocalizer(c),
+		"instance": instance,
+		"stylesheets": []string{
+			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
+			distPathPrefix + "/_colors.css",
+			distPathPrefix + "/base.css",
+			distPathPrefix + "/settings-style.css",
+			distPathPrefix + "/client-style.css",
+		},
+		"javascript": []string{distPathPrefix + "/client.js"},
+	})
+}
+
+// clientServiceWorkerGETHandler serves the service worker of the
+// web client. It's served from the root rather than from /assets,
+// since a service worker can only control pages beneath its own path.
+func (m *Module) clientServiceWorkerGETHandler(c *gin.Context) {
+	c.Header(cacheControlHeader, cacheControlNoCache)
+	c.Header("Content-Type", "text/javascript")
+	c.File(filepath.Join(config.GetWebAssetBaseDir(), "dist", "client-sw.js"))
+}
+
+// clientSharePOSTHandler handles content shared to the installed web
+// client when its service worker isn't (yet) running to intercept the
+// share. Shared text fields are passed on to the compose box in the
+// query string; shared files can't be passed on this way, so are dropped.
+func (m *Module) clientSharePOSTHandler(c *gin.Context) {
+	query := url.Values{}
+	for _, key := range []string{"title", "text", "url"} {
+		if v := c.PostForm(key); v != "" {
+			query.Set(key, v)
+		}
+	}
+
+	c.Redirect(http.StatusSeeOther, clientPathPrefix+"/home?"+query.Encode())
+}
@@ -0,0 +1,117 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+)
+
+type ClientTestSuite struct {
+	WebStandardTestSuite
+}
+
+func (suite *ClientTestSuite) TestClientHandler() {
+	for _, view := range []string{"", "/home", "/notifications"} {
+		ctx, recorder := suite.newContext(clientPathPrefix+view, string(apiutil.TextHTML))
+		if view != "" {
+			ctx.Params = gin.Params{{Key: "view", Value: view}}
+		}
+
+		suite.webModule.ClientHandler(ctx)
+
+		body := recorder.Body.String()
+		suite.Equal(http.StatusOK, recorder.Code, view)
+		suite.Contains(body, `<div id="root">`, view)
+		suite.Contains(body, distPathPrefix+"/client.js", view)
+		suite.Contains(body, distPathPrefix+"/client-style.css", view)
+	}
+}
+
+func (suite *ClientTestSuite) TestSharePOST() {
+	// Browsers send shares as multipart/form-data, per the
+	// manifest, with the shared files alongside the text.
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	for k, v := range map[string]string{
+		"title": "A cool page",
+		"text":  "look at this & that",
+		"url":   "https://example.org/some/page?a=b",
+	} {
+		if err := w.WriteField(k, v); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+	if _, err := w.CreateFormFile("media", "test.jpeg"); err != nil {
+		suite.FailNow(err.Error())
+	}
+	if err := w.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx, recorder := suite.newContext(clientSharePath, "")
+	ctx.Request = httptest.NewRequest(http.MethodPost, "http://localhost:8080"+clientSharePath, body)
+	ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+
+	suite.webModule.clientSharePOSTHandler(ctx)
+
+	// Redirects have no body, so flush
+	// the header through to the recorder.
+	ctx.Writer.WriteHeaderNow()
+	suite.Equal(http.StatusSeeOther, recorder.Code)
+
+	location, err := url.Parse(recorder.Header().Get("Location"))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("/client/home", location.Path)
+	suite.Equal(url.Values{
+		"title": {"A cool page"},
+		"text":  {"look at this & that"},
+		"url":   {"https://example.org/some/page?a=b"},
+	}, location.Query())
+}
+
+func (suite *ClientTestSuite) TestSharePOSTOnlyURL() {
+	form := url.Values{"url": {"https://example.org/"}}
+
+	ctx, recorder := suite.newContext(clientSharePath, "")
+	ctx.Request = httptest.NewRequest(http.MethodPost, "http://localhost:8080"+clientSharePath, bytes.NewBufferString(form.Encode()))
+	ctx.Request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	suite.webModule.clientSharePOSTHandler(ctx)
+
+	// Redirects have no body, so flush
+	// the header through to the recorder.
+	ctx.Writer.WriteHeaderNow()
+	suite.Equal(http.StatusSeeOther, recorder.Code)
+	suite.Equal("/client/home?url=https%3A%2F%2Fexample.org%2F", recorder.Header().Get("Location"))
+}
+
+func TestClientTestSuite(t *testing.T) {
+	suite.Run(t, new(ClientTestSuite))
+}
@@ -49,6 +49,7 @@ func (m *Module) confirmEmailGETHandler(c *gin.Context) {
 	}
 
 	c.HTML(http.StatusOK, "confirmed.tmpl", gin.H{
+		"i18n":     apiutil.TemplateLocalizer(c),
 		"instance": instance,
 		"email":    user.Email,
 		"username": user.Account.Username,
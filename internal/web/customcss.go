@@ -31,12 +31,6 @@ import (
 const textCSSUTF8 = string(apiutil.TextCSS + "; charset=utf-8")
 
 func (m *Module) customCSSGETHandler(c *gin.Context) {
-	if !config.GetAccountsAllowCustomCSS() {
-		err := errors.New("accounts-allow-custom-css is not enabled on this instance")
-		apiutil.WebErrorHandler(c, gtserror.NewErrorNotFound(err), m.processor.InstanceGetV1)
-		return
-	}
-
 	if _, err := apiutil.NegotiateAccept(c, apiutil.TextCSS); err != nil {
 		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
 		return
@@ -56,6 +50,28 @@ func (m *Module) customCSSGETHandler(c *gin.Context) {
 		return
 	}
 
+	if customCSS == "" && !config.GetAccountsAllowCustomCSS() {
+		// No theme chosen, and custom css not enabled.
+		err := errors.New("accounts-allow-custom-css is not enabled on this instance")
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotFound(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	eTag, err := generateEtag(strings.NewReader(customCSS))
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Theme css changes rarely, so let callers
+	// revalidate using the ETag of the combined css.
 	c.Header(cacheControlHeader, cacheControlNoCache)
+	c.Header(eTagHeader, eTag)
+
+	if c.Request.Header.Get(ifNoneMatchHeader) == eTag {
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
 	c.Data(http.StatusOK, textCSSUTF8, []byte(customCSS))
 }
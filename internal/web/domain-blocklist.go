@@ -58,6 +58,7 @@ func (m *Module) domainBlockListGETHandler(c *gin.Context) {
 	}
 
 	c.HTML(http.StatusOK, "domain-blocklist.tmpl", gin.H{
+		"i18n":      apiutil.TemplateLocalizer(c),
 		"instance":  instance,
 		"ogMeta":    ogBase(instance),
 		"blocklist": domainBlocks,
@@ -0,0 +1,106 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+// statusEmbedGETHandler serves a minimal view of one status,
+// suitable for embedding in an iframe on other sites, as
+// referenced by the oEmbed and twitter:player metadata.
+//
+// Embeds are always rendered without auth, so that only
+// public statuses can be embedded.
+func (m *Module) statusEmbedGETHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	instance, errWithCode := m.processor.InstanceGetV1(ctx)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Return instance we already got from the db,
+	// don't try to fetch it again when erroring.
+	instanceGet := func(ctx context.Context) (*apimodel.InstanceV1, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	// Parse account targetUsername and status ID from the URL.
+	targetUsername, errWithCode := apiutil.ParseWebUsername(c.Param(apiutil.WebUsernameKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	targetStatusID, errWithCode := apiutil.ParseWebStatusID(c.Param(apiutil.WebStatusIDKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Normalize requested username + status ID,
+	// see threadGETHandler for more details.
+	targetUsername = strings.ToLower(targetUsername)
+	targetStatusID = strings.ToUpper(targetStatusID)
+
+	targetAccount, errWithCode := m.processor.Account().GetLocalByUsername(ctx, nil, targetUsername)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	if targetAccount.Suspended {
+		err := fmt.Errorf("target account %s is suspended", targetUsername)
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotFound(err), instanceGet)
+		return
+	}
+
+	status, errWithCode := m.processor.Status().Get(ctx, nil, targetStatusID)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	// Ensure status actually belongs to target account.
+	if status.GetAccountID() != targetAccount.ID {
+		err := fmt.Errorf("target account %s does not own status %s", targetUsername, targetStatusID)
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotFound(err), instanceGet)
+		return
+	}
+
+	c.HTML(http.StatusOK, "embed.tmpl", gin.H{
+		"i18n":     apiutil.TemplateLocalizer(c),
+		"instance": instance,
+		"status":   status,
+		"stylesheets": []string{
+			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
+			distPathPrefix + "/status.css",
+		},
+		"javascript": []string{distPathPrefix + "/frontend.js"},
+	})
+}
@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+const appFeedJSONUTF8 = string(apiutil.AppFeedJSON) + "; charset=utf-8"
+
+func (m *Module) jsonFeedGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.AppFeedJSON, apiutil.AppJSON); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Fetch + normalize username from URL.
+	username, errWithCode := apiutil.ParseWebUsername(c.Param(apiutil.WebUsernameKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Usernames on our instance will always be lowercase.
+	//
+	// todo: https://github.com/superseriousbusiness/gotosocial/issues/1813
+	username = strings.ToLower(username)
+
+	// Older pages of the feed are
+	// requested by max status ID.
+	maxID := apiutil.ParseMaxID(c.Query(apiutil.MaxIDKey), "")
+	if maxID != "" && !validate.ULID(maxID) {
+		err := errors.New("max_id was not a valid status id")
+		apiutil.WebErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Retrieve the getJSONFeed function from the processor.
+	// We'll only call the function if we need to, to save db calls.
+	// lastPostAt may be a zero time if account has never posted.
+	getJSONFeed, lastPostAt, errWithCode := m.processor.Account().GetJSONFeedForUsername(c.Request.Context(), username, maxID)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	// Each page of the feed is cached separately.
+	cacheKey := c.Request.URL.Path
+	if maxID != "" {
+		cacheKey += "?" + apiutil.MaxIDKey + "=" + maxID
+	}
+
+	m.serveFeed(c, cacheKey, appFeedJSONUTF8, getJSONFeed, lastPostAt)
+}
@@ -0,0 +1,108 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"golang.org/x/net/idna"
+)
+
+const (
+	leavingPath = "/" + uris.LeavingPath
+)
+
+// leavingGETHandler serves the external link interstitial,
+// which shows the real destination domain of a link before
+// the visitor continues to it. Links to trusted domains are
+// redirected to straight away.
+//
+// The interstitial is served even when it's not enabled, rather
+// than redirecting, so that it can't be used as an open redirect.
+func (m *Module) leavingGETHandler(c *gin.Context) {
+	dest, err := parseLeavingURL(c.Query(apiutil.WebLinkURLKey))
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	if text.LinkHostTrusted(dest.Hostname()) {
+		c.Redirect(http.StatusSeeOther, dest.String())
+		return
+	}
+
+	instance, err := m.processor.InstanceGetV1(c.Request.Context())
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	// Show the punycode form of the domain, since
+	// that's what can't be confused with other domains;
+	// if it's an internationalized domain name, show
+	// the unicode form too, for readability.
+	domain := strings.ToLower(dest.Hostname())
+	asciiDomain, err := idna.ToASCII(domain)
+	if err != nil {
+		asciiDomain = domain
+	}
+
+	unicodeDomain, err := idna.ToUnicode(asciiDomain)
+	if err != nil || unicodeDomain == asciiDomain {
+		unicodeDomain = ""
+	}
+
+	c.HTML(http.StatusOK, "leaving.tmpl", gin.H{
+		"i18n":          apiutil.TemplateLocalizer(c),
+		"instance":      instance,
+		"destination":   dest.String(),
+		"domain":        asciiDomain,
+		"unicodeDomain": unicodeDomain,
+		"stylesheets": []string{
+			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
+		},
+	})
+}
+
+// parseLeavingURL parses the destination of the
+// external link interstitial, which must be an
+// absolute http or https url.
+func parseLeavingURL(raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, errors.New("no url provided")
+	}
+
+	dest, err := url.Parse(raw)
+	if err != nil {
+		return nil, errors.New("url could not be parsed")
+	}
+
+	if (dest.Scheme != "http" && dest.Scheme != "https") || dest.Host == "" {
+		return nil, errors.New("url must be an absolute http or https url")
+	}
+
+	return dest, nil
+}
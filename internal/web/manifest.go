@@ -0,0 +1,142 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	manifestPath        = "/manifest.webmanifest"
+	manifestContentType = "application/manifest+json"
+)
+
+// webManifest models a web app manifest, which allows
+// browsers to install the web client as an app.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/Manifest
+type webManifest struct {
+	Name            string              `json:"name"`
+	ShortName       string              `json:"short_name"`
+	Description     string              `json:"description,omitempty"`
+	StartURL        string              `json:"start_url"`
+	Scope           string              `json:"scope"`
+	Display         string              `json:"display"`
+	ThemeColor      string              `json:"theme_color,omitempty"`
+	BackgroundColor string              `json:"background_color,omitempty"`
+	Icons           []webManifestIcon   `json:"icons"`
+	ShareTarget     *webManifestShareTo `json:"share_target,omitempty"`
+}
+
+type webManifestIcon struct {
+	Src     string `json:"src"`
+	Type    string `json:"type,omitempty"`
+	Sizes   string `json:"sizes,omitempty"`
+	Purpose string `json:"purpose,omitempty"`
+}
+
+// webManifestShareTo models the share_target member of a
+// web app manifest, which lets an installed web client
+// receive links and images shared from other apps.
+//
+// See: https://developer.mozilla.org/en-US/docs/Web/Manifest/share_target
+type webManifestShareTo struct {
+	Action  string                   `json:"action"`
+	Method  string                   `json:"method"`
+	EncType string                   `json:"enctype"`
+	Params  webManifestShareToParams `json:"params"`
+}
+
+type webManifestShareToParams struct {
+	Title string                   `json:"title"`
+	Text  string                   `json:"text"`
+	URL   string                   `json:"url"`
+	Files []webManifestShareToFile `json:"files"`
+}
+
+type webManifestShareToFile struct {
+	Name   string   `json:"name"`
+	Accept []string `json:"accept"`
+}
+
+func (m *Module) manifestGETHandler(c *gin.Context) {
+	instance, err := m.processor.InstanceGetV1(c.Request.Context())
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	name := instance.Title
+	if name == "" {
+		name = config.GetHost()
+	}
+
+	icons := []webManifestIcon{
+		{
+			Src:  instance.Thumbnail,
+			Type: instance.ThumbnailType,
+		},
+	}
+
+	if instance.ThumbnailType == "" {
+		// No custom thumbnail set, so the thumbnail
+		// is the default GoToSocial logo; offer the
+		// scalable version too, so it looks crisp.
+		icons = append(icons, webManifestIcon{
+			Src:     assetsPathPrefix + "/logo.svg",
+			Type:    "image/svg+xml",
+			Sizes:   "any",
+			Purpose: "any",
+		})
+	}
+
+	c.Header("Content-Type", manifestContentType)
+	c.JSON(http.StatusOK, webManifest{
+		Name:            name,
+		ShortName:       name,
+		Description:     text.SanitizeToPlaintext(instance.ShortDescription),
+		StartURL:        clientPathPrefix + "/home",
+		Scope:           clientPathPrefix + "/",
+		Display:         "standalone",
+		ThemeColor:      config.GetWebThemeColor(),
+		BackgroundColor: config.GetWebBackgroundColor(),
+		Icons:           icons,
+		ShareTarget: &webManifestShareTo{
+			Action:  clientSharePath,
+			Method:  http.MethodPost,
+			EncType: "multipart/form-data",
+			Params: webManifestShareToParams{
+				Title: "title",
+				Text:  "text",
+				URL:   "url",
+				Files: []webManifestShareToFile{
+					{
+						Name:   "media",
+						Accept: []string{"image/*", "video/*", "audio/*"},
+					},
+				},
+			},
+		},
+	})
+}
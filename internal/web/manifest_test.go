@@ -0,0 +1,93 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type ManifestTestSuite struct {
+	WebStandardTestSuite
+}
+
+func (suite *ManifestTestSuite) TestManifestGET() {
+	ctx, recorder := suite.newContext(manifestPath, "")
+	suite.webModule.manifestGETHandler(ctx)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(manifestContentType, recorder.Header().Get("Content-Type"))
+
+	manifest := webManifest{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &manifest); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("GoToSocial Testrig Instance", manifest.Name)
+	suite.Equal("GoToSocial Testrig Instance", manifest.ShortName)
+	suite.Contains(manifest.Description, "This is the GoToSocial testrig.")
+	suite.NotContains(manifest.Description, "<p>")
+	suite.Equal("/client/home", manifest.StartURL)
+	suite.Equal("/client/", manifest.Scope)
+	suite.Equal("standalone", manifest.Display)
+	suite.Equal("#fd6a00", manifest.ThemeColor)
+	suite.Equal("#2a2b2f", manifest.BackgroundColor)
+
+	// No custom thumbnail in the testrig,
+	// so the svg logo should be offered too.
+	suite.Len(manifest.Icons, 2)
+	suite.Equal("/assets/logo.svg", manifest.Icons[1].Src)
+	suite.Equal("image/svg+xml", manifest.Icons[1].Type)
+
+	// Share target should point at the share
+	// handler, with param names it understands.
+	suite.NotNil(manifest.ShareTarget)
+	suite.Equal("/client/share", manifest.ShareTarget.Action)
+	suite.Equal(http.MethodPost, manifest.ShareTarget.Method)
+	suite.Equal("multipart/form-data", manifest.ShareTarget.EncType)
+	suite.Equal("title", manifest.ShareTarget.Params.Title)
+	suite.Equal("text", manifest.ShareTarget.Params.Text)
+	suite.Equal("url", manifest.ShareTarget.Params.URL)
+	suite.Len(manifest.ShareTarget.Params.Files, 1)
+	suite.Equal("media", manifest.ShareTarget.Params.Files[0].Name)
+}
+
+func (suite *ManifestTestSuite) TestManifestGETUnsetColors() {
+	config.SetWebThemeColor("")
+	config.SetWebBackgroundColor("")
+
+	ctx, recorder := suite.newContext(manifestPath, "")
+	suite.webModule.manifestGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	manifest := make(map[string]any)
+	if err := json.Unmarshal(recorder.Body.Bytes(), &manifest); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotContains(manifest, "theme_color")
+	suite.NotContains(manifest, "background_color")
+}
+
+func TestManifestTestSuite(t *testing.T) {
+	suite.Run(t, new(ManifestTestSuite))
+}
@@ -19,6 +19,9 @@ package web
 
 import (
 	"html"
+	"mime"
+	"net/url"
+	"path"
 	"strconv"
 	"strings"
 
@@ -28,6 +31,21 @@ import (
 
 const maxOGDescriptionLength = 300
 
+// mediaTypes maps extensions of media we store
+// to their mime types, since not all of these
+// are known to the mime package on every system.
+var mediaTypes = map[string]string{
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".gif":  "image/gif",
+	".webp": "image/webp",
+	".mp4":  "video/mp4",
+	".webm": "video/webm",
+	".mp3":  "audio/mpeg",
+	".ogg":  "audio/ogg",
+}
+
 // ogMeta represents supported OpenGraph Meta tags
 //
 // see eg https://ogp.me/
@@ -42,10 +60,21 @@ type ogMeta struct {
 
 	// image tags
 	Image       string // og:image
+	ImageType   string // og:image:type
 	ImageWidth  string // og:image:width
 	ImageHeight string // og:image:height
 	ImageAlt    string // og:image:alt
 
+	// video tags
+	Video       string // og:video
+	VideoType   string // og:video:type
+	VideoWidth  string // og:video:width
+	VideoHeight string // og:video:height
+
+	// audio tags
+	Audio     string // og:audio
+	AudioType string // og:audio:type
+
 	// article tags
 	ArticlePublisher     string // article:publisher
 	ArticleAuthor        string // article:author
@@ -54,6 +83,18 @@ type ogMeta struct {
 
 	// profile tags
 	ProfileUsername string // profile:username
+
+	// twitter card tags; title,
+	// description and image are
+	// taken from the og tags
+	TwitterCard         string // twitter:card
+	TwitterPlayer       string // twitter:player
+	TwitterPlayerWidth  string // twitter:player:width
+	TwitterPlayerHeight string // twitter:player:height
+
+	// OEmbed is the url for
+	// oEmbed discovery, if any.
+	OEmbed string
 }
 
 // ogBase returns an *ogMeta suitable for serving at
@@ -76,6 +117,8 @@ func ogBase(instance *apimodel.InstanceV1) *ogMeta {
 
 		Image:    instance.Thumbnail,
 		ImageAlt: instance.ThumbnailDescription,
+
+		TwitterCard: "summary",
 	}
 
 	return og
@@ -85,6 +128,7 @@ func ogBase(instance *apimodel.InstanceV1) *ogMeta {
 // struct specific to that account. It's suitable for serving
 // at account profile pages.
 func (og *ogMeta) withAccount(account *apimodel.Account) *ogMeta {
+	og.OEmbed = oEmbedURL(og.URL, account.URL)
 	og.Title = parseTitle(account, og.SiteName)
 	og.Type = "profile"
 	og.URL = account.URL
@@ -106,6 +150,7 @@ func (og *ogMeta) withAccount(account *apimodel.Account) *ogMeta {
 // struct specific to that status. It's suitable for serving
 // at status pages.
 func (og *ogMeta) withStatus(status *apimodel.Status) *ogMeta {
+	og.OEmbed = oEmbedURL(og.URL, status.URL)
 	og.Title = "Post by " + parseTitle(status.Account, og.SiteName)
 	og.Type = "article"
 	if status.Language != nil {
@@ -121,17 +166,10 @@ func (og *ogMeta) withStatus(status *apimodel.Status) *ogMeta {
 		og.Description = og.Title
 	}
 
+	og.Image = status.Account.Avatar
+	og.ImageAlt = "Avatar for " + status.Account.Username
 	if !status.Sensitive && len(status.MediaAttachments) > 0 {
-		a := status.MediaAttachments[0]
-		og.Image = a.PreviewURL
-		og.ImageWidth = strconv.Itoa(a.Meta.Small.Width)
-		og.ImageHeight = strconv.Itoa(a.Meta.Small.Height)
-		if a.Description != nil {
-			og.ImageAlt = *a.Description
-		}
-	} else {
-		og.Image = status.Account.Avatar
-		og.ImageAlt = "Avatar for " + status.Account.Username
+		og.withAttachment(&status.MediaAttachments[0], status.URL)
 	}
 
 	og.ArticlePublisher = status.Account.URL
@@ -142,6 +180,94 @@ func (og *ogMeta) withStatus(status *apimodel.Status) *ogMeta {
 	return og
 }
 
+// withAttachment adds image, video or audio tags for the
+// given attachment of the status at statusURL, using the
+// original dimensions of the media where we have them.
+func (og *ogMeta) withAttachment(a *apimodel.Attachment, statusURL string) {
+	var alt string
+	if a.Description != nil {
+		alt = *a.Description
+	}
+
+	var mediaURL string
+	if a.URL != nil {
+		mediaURL = *a.URL
+	}
+
+	switch a.Type {
+	case "image":
+		// Show the full size image
+		// in a large summary card.
+		og.Image = mediaURL
+		og.ImageType = mediaType(mediaURL)
+		og.ImageAlt = alt
+		og.setImageSize(a.Meta.Original)
+		og.TwitterCard = "summary_large_image"
+
+	case "video", "gifv":
+		// Use the preview as the image, and
+		// offer the video itself + a player.
+		og.Image = a.PreviewURL
+		og.ImageType = mediaType(a.PreviewURL)
+		og.ImageAlt = alt
+		og.setImageSize(a.Meta.Small)
+
+		og.Video = mediaURL
+		og.VideoType = mediaType(mediaURL)
+		if a.Meta.Original.Width > 0 && a.Meta.Original.Height > 0 {
+			og.VideoWidth = strconv.Itoa(a.Meta.Original.Width)
+			og.VideoHeight = strconv.Itoa(a.Meta.Original.Height)
+		}
+
+		og.TwitterCard = "player"
+		og.TwitterPlayer = statusURL + "/embed"
+		og.TwitterPlayerWidth = og.VideoWidth
+		og.TwitterPlayerHeight = og.VideoHeight
+
+	case "audio":
+		// Keep the avatar as image.
+		og.Audio = mediaURL
+		og.AudioType = mediaType(mediaURL)
+	}
+}
+
+// setImageSize sets image width + height from
+// the given dimensions, if they're known.
+func (og *ogMeta) setImageSize(dims apimodel.MediaDimensions) {
+	if dims.Width <= 0 || dims.Height <= 0 {
+		return
+	}
+	og.ImageWidth = strconv.Itoa(dims.Width)
+	og.ImageHeight = strconv.Itoa(dims.Height)
+}
+
+// mediaType guesses the mime type of
+// media at the given url from its extension.
+func mediaType(mediaURL string) string {
+	u, err := url.Parse(mediaURL)
+	if err != nil {
+		return ""
+	}
+
+	ext := strings.ToLower(path.Ext(u.Path))
+	if mt, ok := mediaTypes[ext]; ok {
+		return mt
+	}
+
+	// Fall back to system mime types.
+	mt, _, err := mime.ParseMediaType(mime.TypeByExtension(ext))
+	if err != nil {
+		return ""
+	}
+	return mt
+}
+
+// oEmbedURL returns the url of the oEmbed
+// endpoint at instanceURI for the given target.
+func oEmbedURL(instanceURI string, target string) string {
+	return instanceURI + "/api/oembed?url=" + url.QueryEscape(target)
+}
+
 // parseTitle parses a page title from account and accountDomain
 func parseTitle(account *apimodel.Account, accountDomain string) string {
 	user := "@" + account.Acct + "@" + accountDomain
@@ -47,6 +47,7 @@ func (suite *OpenGraphTestSuite) TestParseDescription() {
 
 func (suite *OpenGraphTestSuite) TestWithAccountWithNote() {
 	baseMeta := ogBase(&apimodel.InstanceV1{
+		URI:           "https://example.org",
 		AccountDomain: "example.org",
 		Languages:     []string{"en"},
 	})
@@ -75,11 +76,14 @@ func (suite *OpenGraphTestSuite) TestWithAccountWithNote() {
 		ArticleModifiedTime:  "",
 		ArticlePublishedTime: "",
 		ProfileUsername:      "example_account",
+		TwitterCard:          "summary",
+		OEmbed:               "https://example.org/api/oembed?url=https%3A%2F%2Fexample.org%2F%40example_account",
 	}, *accountMeta)
 }
 
 func (suite *OpenGraphTestSuite) TestWithAccountNoNote() {
 	baseMeta := ogBase(&apimodel.InstanceV1{
+		URI:           "https://example.org",
 		AccountDomain: "example.org",
 		Languages:     []string{"en"},
 	})
@@ -108,9 +112,70 @@ func (suite *OpenGraphTestSuite) TestWithAccountNoNote() {
 		ArticleModifiedTime:  "",
 		ArticlePublishedTime: "",
 		ProfileUsername:      "example_account",
+		TwitterCard:          "summary",
+		OEmbed:               "https://example.org/api/oembed?url=https%3A%2F%2Fexample.org%2F%40example_account",
 	}, *accountMeta)
 }
 
+func (suite *OpenGraphTestSuite) TestWithStatusVideo() {
+	baseMeta := ogBase(&apimodel.InstanceV1{
+		URI:           "https://example.org",
+		AccountDomain: "example.org",
+		Languages:     []string{"en"},
+	})
+
+	videoURL := "https://example.org/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01CDR64G398ADCHXK08WWTHEZ5.mp4"
+	description := "a cool video"
+	statusMeta := baseMeta.withStatus(&apimodel.Status{
+		CreatedAt: "2023-11-06T12:00:00.000Z",
+		URL:       "https://example.org/@example_account/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+		Text:      "check out this video",
+		Account: &apimodel.Account{
+			Acct:     "example_account",
+			URL:      "https://example.org/@example_account",
+			Username: "example_account",
+			Avatar:   "https://example.org/avatar.png",
+		},
+		MediaAttachments: []apimodel.Attachment{{
+			Type:        "video",
+			URL:         &videoURL,
+			PreviewURL:  "https://example.org/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01CDR64G398ADCHXK08WWTHEZ5.jpg",
+			Description: &description,
+			Meta: apimodel.MediaMeta{
+				Original: apimodel.MediaDimensions{Width: 1280, Height: 720},
+				Small:    apimodel.MediaDimensions{Width: 512, Height: 288},
+			},
+		}},
+	})
+
+	suite.EqualValues(ogMeta{
+		Title:                "Post by @example_account@example.org",
+		Type:                 "article",
+		Locale:               "en",
+		URL:                  "https://example.org/@example_account/statuses/01F8MH75CBF9JFX4ZAD54N0W0R",
+		SiteName:             "example.org",
+		Description:          "content=\"check out this video\"",
+		Image:                "https://example.org/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01CDR64G398ADCHXK08WWTHEZ5.jpg",
+		ImageType:            "image/jpeg",
+		ImageWidth:           "512",
+		ImageHeight:          "288",
+		ImageAlt:             "a cool video",
+		Video:                videoURL,
+		VideoType:            "video/mp4",
+		VideoWidth:           "1280",
+		VideoHeight:          "720",
+		ArticlePublisher:     "https://example.org/@example_account",
+		ArticleAuthor:        "https://example.org/@example_account",
+		ArticleModifiedTime:  "2023-11-06T12:00:00.000Z",
+		ArticlePublishedTime: "2023-11-06T12:00:00.000Z",
+		TwitterCard:          "player",
+		TwitterPlayer:        "https://example.org/@example_account/statuses/01F8MH75CBF9JFX4ZAD54N0W0R/embed",
+		TwitterPlayerWidth:   "1280",
+		TwitterPlayerHeight:  "720",
+		OEmbed:               "https://example.org/api/oembed?url=https%3A%2F%2Fexample.org%2F%40example_account%2Fstatuses%2F01F8MH75CBF9JFX4ZAD54N0W0R",
+	}, *statusMeta)
+}
+
 func TestOpenGraphTestSuite(t *testing.T) {
 	suite.Run(t, &OpenGraphTestSuite{})
 }
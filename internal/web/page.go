@@ -0,0 +1,75 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+const (
+	pageSlugKey       = "slug"
+	pagesPathPrefix   = "/pages"
+	pagePath          = pagesPathPrefix + "/:" + pageSlugKey
+	privacyPolicyPath = "/privacy-policy"
+)
+
+func (m *Module) pageGETHandler(c *gin.Context) {
+	instance, err := m.processor.InstanceGetV1(c.Request.Context())
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	page, errWithCode := m.processor.InstanceGetPage(c.Request.Context(), c.Param(pageSlugKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	pageNav, errWithCode := m.processor.InstanceGetNavPages(c.Request.Context())
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	ogMeta := ogBase(instance)
+	ogMeta.Title = text.SanitizeToPlaintext(page.Title) + " - " + ogMeta.Title
+	ogMeta.URL = instance.URI + pagesPathPrefix + "/" + page.Slug
+
+	c.HTML(http.StatusOK, "page.tmpl", gin.H{
+		"i18n":     apiutil.TemplateLocalizer(c),
+		"instance": instance,
+		"ogMeta":   ogMeta,
+		"page":     page,
+		"pageNav":  pageNav,
+		"stylesheets": []string{
+			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
+		},
+		"javascript": []string{distPathPrefix + "/frontend.js"},
+	})
+}
+
+func (m *Module) privacyPolicyGETHandler(c *gin.Context) {
+	c.Redirect(http.StatusFound, pagesPathPrefix+"/"+gtsmodel.InstancePagePrivacy)
+}
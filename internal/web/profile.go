@@ -103,18 +103,16 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		return
 	}
 
-	// Only generate RSS link if account has RSS enabled.
-	var rssFeed string
+	// Only generate feed links if account has RSS enabled.
+	var rssFeed, jsonFeed string
 	if targetAccount.EnableRSS {
 		rssFeed = "/@" + targetAccount.Username + "/feed.rss"
+		jsonFeed = "/@" + targetAccount.Username + "/feed.json"
 	}
 
-	// Only allow search engines / robots to
-	// index if account is discoverable.
-	var robotsMeta string
-	if targetAccount.Discoverable {
-		robotsMeta = robotsMetaAllowSome
-	}
+	// Only allow search engines / robots to index if
+	// account is discoverable and doesn't want noindex.
+	robotsMeta := setRobots(c, targetAccount, true)
 
 	// We need to change our response slightly if the
 	// profile visitor is paging through statuses.
@@ -122,6 +120,7 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		maxStatusID    = apiutil.ParseMaxID(c.Query(apiutil.MaxIDKey), "")
 		paging         = maxStatusID != ""
 		pinnedStatuses *apimodel.PageableResponse
+		featuredTags   []*apimodel.FeaturedTag
 	)
 
 	if !paging {
@@ -132,6 +131,13 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 			apiutil.WebErrorHandler(c, errWithCode, instanceGet)
 			return
 		}
+
+		// And featured tags.
+		featuredTags, errWithCode = m.processor.Account().FeaturedTagsGet(ctx, authed.Account, targetAccount.ID)
+		if errWithCode != nil {
+			apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+			return
+		}
 	} else {
 		// Don't load pinned statuses at
 		// the top of profile while paging.
@@ -150,19 +156,22 @@ func (m *Module) profileGETHandler(c *gin.Context) {
 		distPathPrefix + "/status.css",
 		distPathPrefix + "/profile.css",
 	}
-	if config.GetAccountsAllowCustomCSS() {
+	if config.GetAccountsAllowCustomCSS() || targetAccount.Theme != "" {
 		stylesheets = append(stylesheets, "/@"+targetAccount.Username+"/custom.css")
 	}
 
 	c.HTML(http.StatusOK, "profile.tmpl", gin.H{
+		"i18n":             apiutil.TemplateLocalizer(c),
 		"instance":         instance,
 		"account":          targetAccount,
 		"ogMeta":           ogBase(instance).withAccount(targetAccount),
 		"rssFeed":          rssFeed,
+		"jsonFeed":         jsonFeed,
 		"robotsMeta":       robotsMeta,
 		"statuses":         statusResp.Items,
 		"statuses_next":    statusResp.NextLink,
 		"pinned_statuses":  pinnedStatuses.Items,
+		"featured_tags":    featuredTags,
 		"show_back_to_top": paging,
 		"stylesheets":      stylesheets,
 		"javascript":       []string{distPathPrefix + "/frontend.js"},
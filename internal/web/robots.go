@@ -21,11 +21,14 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 )
 
 const (
 	robotsPath          = "/robots.txt"
 	robotsMetaAllowSome = "nofollow, noarchive, nositelinkssearchbox, max-image-preview:standard" // https://developers.google.com/search/docs/crawling-indexing/robots-meta-tag#robotsmeta
+	robotsMetaNoIndex   = "noindex, nofollow"
+	robotsHeader        = "X-Robots-Tag"
 	robotsTxt           = `# GoToSocial robots.txt -- to edit, see internal/web/robots.go
 # More info @ https://developers.google.com/search/docs/crawling-indexing/robots/intro
 
@@ -94,7 +97,10 @@ Disallow: /user
 Disallow: /settings/
 
 # Domain blocklist.
-Disallow: /about/suspended`
+Disallow: /about/suspended
+
+# External link interstitial.
+Disallow: /leaving`
 )
 
 // robotsGETHandler returns a decent robots.txt that prevents crawling
@@ -105,3 +111,19 @@ Disallow: /about/suspended`
 func (m *Module) robotsGETHandler(c *gin.Context) {
 	c.String(http.StatusOK, robotsTxt)
 }
+
+// setRobots sets the X-Robots-Tag header for a web page of
+// the given local account, returning the same policy for
+// use in the page's robots meta tag. Search engines may only
+// index the page if allowIndex is true, the account is
+// discoverable, and it hasn't opted out with noindex (or
+// been opted out by the instance default).
+func setRobots(c *gin.Context, account *apimodel.Account, allowIndex bool) string {
+	robots := robotsMetaNoIndex
+	if allowIndex && account.Discoverable && !account.NoIndex {
+		robots = robotsMetaAllowSome
+	}
+
+	c.Header(robotsHeader, robots)
+	return robots
+}
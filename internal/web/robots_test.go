@@ -0,0 +1,110 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+type RobotsTestSuite struct {
+	WebStandardTestSuite
+}
+
+// getRobots returns the X-Robots-Tag header and
+// body of the web view of zork's profile, and of
+// zork's first (public) status.
+func (suite *RobotsTestSuite) getRobots() (profile string, thread string) {
+	ctx, recorder := suite.newContext("/@the_mighty_zork", string(apiutil.TextHTML))
+	ctx.Params = gin.Params{
+		{Key: apiutil.WebUsernameKey, Value: "the_mighty_zork"},
+	}
+	suite.webModule.profileGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	profile = recorder.Header().Get(robotsHeader)
+	suite.Contains(recorder.Body.String(), `<meta name="robots" content="`+profile+`">`)
+
+	status := suite.testStatuses["local_account_1_status_1"]
+	ctx, recorder = suite.newContext("/@the_mighty_zork/statuses/"+status.ID, string(apiutil.TextHTML))
+	ctx.Params = gin.Params{
+		{Key: apiutil.WebUsernameKey, Value: "the_mighty_zork"},
+		{Key: apiutil.WebStatusIDKey, Value: status.ID},
+	}
+	suite.webModule.threadGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+	thread = recorder.Header().Get(robotsHeader)
+	suite.Contains(recorder.Body.String(), `<meta name="robots" content="`+thread+`">`)
+
+	return profile, thread
+}
+
+// setNoIndex sets zork's own noindex choice.
+func (suite *RobotsTestSuite) setNoIndex(noIndex *bool) {
+	account := new(gtsmodel.Account)
+	*account = *suite.testAccounts["local_account_1"]
+	account.NoIndex = noIndex
+
+	if err := suite.db.UpdateAccount(context.Background(), account, "no_index"); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *RobotsTestSuite) TestRobotsDiscoverable() {
+	// Zork is discoverable and hasn't
+	// chosen, so may be indexed.
+	profile, thread := suite.getRobots()
+	suite.Equal(robotsMetaAllowSome, profile)
+	suite.Equal(robotsMetaAllowSome, thread)
+}
+
+func (suite *RobotsTestSuite) TestRobotsNoIndex() {
+	suite.setNoIndex(util.Ptr(true))
+
+	profile, thread := suite.getRobots()
+	suite.Equal(robotsMetaNoIndex, profile)
+	suite.Equal(robotsMetaNoIndex, thread)
+}
+
+func (suite *RobotsTestSuite) TestRobotsInstanceDefault() {
+	config.SetInstanceNoIndexDefault(true)
+
+	// Instance default applies when
+	// zork hasn't chosen themselves...
+	profile, thread := suite.getRobots()
+	suite.Equal(robotsMetaNoIndex, profile)
+	suite.Equal(robotsMetaNoIndex, thread)
+
+	// ...but not once they have.
+	suite.setNoIndex(util.Ptr(false))
+
+	profile, thread = suite.getRobots()
+	suite.Equal(robotsMetaAllowSome, profile)
+	suite.Equal(robotsMetaAllowSome, thread)
+}
+
+func TestRobotsTestSuite(t *testing.T) {
+	suite.Run(t, new(RobotsTestSuite))
+}
@@ -27,9 +27,13 @@ import (
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/timeline"
 )
 
-const appRSSUTF8 = string(apiutil.AppRSSXML) + "; charset=utf-8"
+const (
+	appRSSUTF8  = string(apiutil.AppRSSXML) + "; charset=utf-8"
+	appAtomUTF8 = string(apiutil.AppAtomXML) + "; charset=utf-8"
+)
 
 func (m *Module) rssFeedGETHandler(c *gin.Context) {
 	if _, err := apiutil.NegotiateAccept(c, apiutil.AppRSSXML); err != nil {
@@ -58,35 +62,116 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 		return
 	}
 
+	m.serveFeed(c, c.Request.URL.Path, appRSSUTF8, getRSSFeed, lastPostAt)
+}
+
+func (m *Module) localTimelineRSSFeedGETHandler(c *gin.Context) {
+	m.localTimelineFeedGETHandler(c, timeline.FeedFormatRSS, apiutil.AppRSSXML, appRSSUTF8)
+}
+
+func (m *Module) localTimelineAtomFeedGETHandler(c *gin.Context) {
+	m.localTimelineFeedGETHandler(c, timeline.FeedFormatAtom, apiutil.AppAtomXML, appAtomUTF8)
+}
+
+func (m *Module) localTimelineFeedGETHandler(
+	c *gin.Context,
+	format timeline.FeedFormat,
+	mime apiutil.MIME,
+	contentType string,
+) {
+	if _, err := apiutil.NegotiateAccept(c, mime); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	getFeed, lastPostAt, errWithCode := m.processor.Timeline().LocalTimelineFeedGet(c.Request.Context(), format)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	m.serveFeed(c, c.Request.URL.Path, contentType, getFeed, lastPostAt)
+}
+
+func (m *Module) tagRSSFeedGETHandler(c *gin.Context) {
+	m.tagFeedGETHandler(c, timeline.FeedFormatRSS, apiutil.AppRSSXML, appRSSUTF8)
+}
+
+func (m *Module) tagAtomFeedGETHandler(c *gin.Context) {
+	m.tagFeedGETHandler(c, timeline.FeedFormatAtom, apiutil.AppAtomXML, appAtomUTF8)
+}
+
+func (m *Module) tagFeedGETHandler(
+	c *gin.Context,
+	format timeline.FeedFormat,
+	mime apiutil.MIME,
+	contentType string,
+) {
+	if _, err := apiutil.NegotiateAccept(c, mime); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	tagName, errWithCode := apiutil.ParseTagName(c.Param(apiutil.TagNameKey))
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	getFeed, lastPostAt, errWithCode := m.processor.Timeline().TagTimelineFeedGet(c.Request.Context(), tagName, format)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	m.serveFeed(c, c.Request.URL.Path, contentType, getFeed, lastPostAt)
+}
+
+// serveFeed serves the feed returned by getFeed with the
+// given content type, using lastPostAt (time of the most
+// recent post eligible to appear in the feed) to handle
+// cache control headers. The ETag of the feed is cached
+// under cacheKey, which must be unique to the feed (and
+// page of the feed, if it's paged).
+//
+// getFeed will only be called if the caller doesn't
+// already have the latest version of the feed cached.
+func (m *Module) serveFeed(
+	c *gin.Context,
+	cacheKey string,
+	contentType string,
+	getFeed func() (string, gtserror.WithCode),
+	lastPostAt time.Time,
+) {
 	var (
-		rssFeed string // Stringified rss feed.
+		feed        string // Stringified feed.
+		errWithCode gtserror.WithCode
 
-		cacheKey              = c.Request.URL.Path
 		cacheEntry, wasCached = m.eTagCache.Get(cacheKey)
 	)
 
 	if !wasCached || unixAfter(lastPostAt, cacheEntry.lastModified) {
-		// We either have no ETag cache entry for this account's feed,
-		// or we have an expired cache entry (account has posted since
-		// the cache entry was last generated).
+		// We either have no ETag cache entry for this feed, or we
+		// have an expired cache entry (something eligible has been
+		// posted since the cache entry was last generated).
 		//
 		// As such, we need to generate a new ETag, and for that we need
-		// the string representation of the RSS feed.
-		rssFeed, errWithCode = getRSSFeed()
+		// the string representation of the feed.
+		feed, errWithCode = getFeed()
 		if errWithCode != nil {
 			apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 			return
 		}
 
-		eTag, err := generateEtag(bytes.NewBufferString(rssFeed))
+		eTag, err := generateEtag(bytes.NewBufferString(feed))
 		if err != nil {
 			apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
 			return
 		}
 
-		// We never want lastModified to be zero, so if account
-		// has never actually posted anything, just use Now as
-		// the lastModified time instead for cache control.
+		// We never want lastModified to be zero, so if nothing
+		// has actually been posted yet, just use Now as the
+		// lastModified time instead for cache control.
 		var lastModified time.Time
 		if lastPostAt.IsZero() {
 			lastModified = time.Now()
@@ -141,23 +226,23 @@ func (m *Module) rssFeedGETHandler(c *gin.Context) {
 	}
 
 	// At this point we know that the client wants the newest
-	// representation of the RSS feed, either because they didn't
+	// representation of the feed, either because they didn't
 	// submit any 'If-None-Match' / 'If-Modified-Since' cache headers,
-	// or because they did but the account has posted more recently
+	// or because they did but something has been posted more recently
 	// than the values of the submitted headers would suggest.
 	//
 	// If we had a cache hit earlier, we may not have called the
-	// getRSSFeed function yet; if that's the case then do call it
+	// getFeed function yet; if that's the case then do call it
 	// now because we definitely need it.
-	if rssFeed == "" {
-		rssFeed, errWithCode = getRSSFeed()
+	if feed == "" {
+		feed, errWithCode = getFeed()
 		if errWithCode != nil {
 			apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
 			return
 		}
 	}
 
-	c.Data(http.StatusOK, appRSSUTF8, []byte(rssFeed))
+	c.Data(http.StatusOK, contentType, []byte(feed))
 }
 
 // unixAfter returns true if the unix value of t1
@@ -0,0 +1,170 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+type RSSTestSuite struct {
+	WebStandardTestSuite
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedDisabled() {
+	config.SetInstanceExposeLocalTimelineRSS(false)
+
+	ctx, recorder := suite.newContext(localRSSFeedPath, string(apiutil.AppRSSXML))
+	suite.webModule.localTimelineRSSFeedGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+
+	ctx, recorder = suite.newContext(localAtomFeedPath, string(apiutil.AppAtomXML))
+	suite.webModule.localTimelineAtomFeedGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedRSS() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+
+	ctx, recorder := suite.newContext(localRSSFeedPath, string(apiutil.AppRSSXML))
+	suite.webModule.localTimelineRSSFeedGETHandler(ctx)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(appRSSUTF8, recorder.Header().Get("Content-Type"))
+	suite.NotEmpty(recorder.Header().Get(eTagHeader))
+	suite.Contains(recorder.Body.String(), `<rss version="2.0"`)
+	suite.Contains(recorder.Body.String(), suite.testStatuses["local_account_1_status_1"].URL)
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedAtom() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+
+	ctx, recorder := suite.newContext(localAtomFeedPath, string(apiutil.AppAtomXML))
+	suite.webModule.localTimelineAtomFeedGETHandler(ctx)
+
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(appAtomUTF8, recorder.Header().Get("Content-Type"))
+	suite.Contains(recorder.Body.String(), `<feed xmlns="http://www.w3.org/2005/Atom">`)
+	suite.Contains(recorder.Body.String(), suite.testStatuses["local_account_1_status_1"].URL)
+}
+
+func (suite *RSSTestSuite) TestLocalTimelineFeedNotAcceptable() {
+	config.SetInstanceExposeLocalTimelineRSS(true)
+
+	ctx, recorder := suite.newContext(localAtomFeedPath, string(apiutil.AppRSSXML))
+	suite.webModule.localTimelineAtomFeedGETHandler(ctx)
+	suite.Equal(http.StatusNotAcceptable, recorder.Code)
+}
+
+func (suite *RSSTestSuite) TestTagFeed() {
+	config.SetInstanceExposeTagRSS(true)
+
+	for _, test := range []struct {
+		handler     func(*gin.Context)
+		accept      apiutil.MIME
+		contentType string
+	}{
+		{suite.webModule.tagRSSFeedGETHandler, apiutil.AppRSSXML, appRSSUTF8},
+		{suite.webModule.tagAtomFeedGETHandler, apiutil.AppAtomXML, appAtomUTF8},
+	} {
+		ctx, recorder := suite.newContext("/tags/welcome/feed", string(test.accept))
+		ctx.Params = gin.Params{{Key: apiutil.TagNameKey, Value: "welcome"}}
+		test.handler(ctx)
+
+		suite.Equal(http.StatusOK, recorder.Code)
+		suite.Equal(test.contentType, recorder.Header().Get("Content-Type"))
+		suite.Contains(recorder.Body.String(), suite.testStatuses["admin_account_status_1"].URL)
+	}
+}
+
+func (suite *RSSTestSuite) TestTagFeedDisabled() {
+	config.SetInstanceExposeTagRSS(false)
+
+	ctx, recorder := suite.newContext("/tags/welcome/feed.rss", string(apiutil.AppRSSXML))
+	ctx.Params = gin.Params{{Key: apiutil.TagNameKey, Value: "welcome"}}
+	suite.webModule.tagRSSFeedGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func (suite *RSSTestSuite) TestServeFeedCaching() {
+	var (
+		calls      int
+		lastPostAt = time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+		getFeed    = func() (string, gtserror.WithCode) {
+			calls++
+			return "some feed", nil
+		}
+	)
+
+	// First request generates the feed + ETag.
+	ctx, recorder := suite.newContext("/feed", "")
+	suite.webModule.serveFeed(ctx, "/feed", appRSSUTF8, getFeed, lastPostAt)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("some feed", recorder.Body.String())
+	suite.Equal(1, calls)
+
+	eTag := recorder.Header().Get(eTagHeader)
+	suite.NotEmpty(eTag)
+	suite.Equal(lastPostAt.Format(http.TimeFormat), recorder.Header().Get(lastModifiedHeader))
+	suite.Equal(cacheControlNoCache, recorder.Header().Get(cacheControlHeader))
+
+	// Matching ETag means nothing new to serve.
+	ctx, recorder = suite.newContext("/feed", "")
+	ctx.Request.Header.Set(ifNoneMatchHeader, eTag)
+	suite.webModule.serveFeed(ctx, "/feed", appRSSUTF8, getFeed, lastPostAt)
+	suite.Equal(http.StatusNotModified, recorder.Code)
+	suite.Equal(1, calls)
+
+	// Same for an If-Modified-Since no older than the last post.
+	ctx, recorder = suite.newContext("/feed", "")
+	ctx.Request.Header.Set(ifModifiedSinceHeader, lastPostAt.Format(http.TimeFormat))
+	suite.webModule.serveFeed(ctx, "/feed", appRSSUTF8, getFeed, lastPostAt)
+	suite.Equal(http.StatusNotModified, recorder.Code)
+	suite.Equal(1, calls)
+
+	// Cached ETag without cache headers still serves the feed.
+	ctx, recorder = suite.newContext("/feed", "")
+	suite.webModule.serveFeed(ctx, "/feed", appRSSUTF8, getFeed, lastPostAt)
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal(eTag, recorder.Header().Get(eTagHeader))
+	suite.Equal(2, calls)
+
+	// Something new was posted, so the old ETag is stale.
+	newFeed := func() (string, gtserror.WithCode) {
+		calls++
+		return "some newer feed", nil
+	}
+	ctx, recorder = suite.newContext("/feed", "")
+	ctx.Request.Header.Set(ifNoneMatchHeader, eTag)
+	suite.webModule.serveFeed(ctx, "/feed", appRSSUTF8, newFeed, lastPostAt.Add(time.Minute))
+	suite.Equal(http.StatusOK, recorder.Code)
+	suite.Equal("some newer feed", recorder.Body.String())
+	suite.NotEqual(eTag, recorder.Header().Get(eTagHeader))
+	suite.Equal(3, calls)
+}
+
+func TestRSSTestSuite(t *testing.T) {
+	suite.Run(t, new(RSSTestSuite))
+}
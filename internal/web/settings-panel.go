@@ -33,6 +33,7 @@ func (m *Module) SettingsPanelHandler(c *gin.Context) {
 	}
 
 	c.HTML(http.StatusOK, "frontend.tmpl", gin.H{
+		"i18n":     apiutil.TemplateLocalizer(c),
 		"instance": instance,
 		"stylesheets": []string{
 			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
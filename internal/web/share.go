@@ -0,0 +1,180 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/qrcode"
+)
+
+const (
+	imageSVG      = string(apiutil.ImageSVG)
+	textVCardUTF8 = string(apiutil.TextVCard) + "; charset=utf-8"
+)
+
+func (m *Module) profileQRGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.ImageSVG); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	account, errWithCode := m.getShareAccount(c)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	code, err := qrcode.Encode([]byte(account.URL))
+	if err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := code.WriteSVG(&buf); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header(cacheControlHeader, cacheControlNoCache)
+	c.Data(http.StatusOK, imageSVG, buf.Bytes())
+}
+
+func (m *Module) profileVCardGETHandler(c *gin.Context) {
+	if _, err := apiutil.NegotiateAccept(c, apiutil.TextVCard); err != nil {
+		apiutil.WebErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGetV1)
+		return
+	}
+
+	account, errWithCode := m.getShareAccount(c)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, m.processor.InstanceGetV1)
+		return
+	}
+
+	c.Header(cacheControlHeader, cacheControlNoCache)
+	c.Header("Content-Disposition", `attachment; filename="`+account.Username+`.vcf"`)
+	c.Data(http.StatusOK, textVCardUTF8, []byte(vCard(account, config.GetAccountDomain())))
+}
+
+// getShareAccount gets the local account for the
+// username in the request path, for sharing.
+func (m *Module) getShareAccount(c *gin.Context) (*apimodel.Account, gtserror.WithCode) {
+	username, errWithCode := apiutil.ParseWebUsername(c.Param(apiutil.WebUsernameKey))
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Usernames on our instance will always be lowercase.
+	//
+	// todo: https://github.com/superseriousbusiness/gotosocial/issues/1813
+	username = strings.ToLower(username)
+
+	account, errWithCode := m.processor.Account().GetLocalByUsername(c.Request.Context(), nil, username)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if account.Suspended {
+		err := fmt.Errorf("account %s is suspended", username)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return account, nil
+}
+
+// vCard renders a vCard 4.0 contact card for account,
+// containing its name, handle, profile URL and avatar.
+//
+// See: https://www.rfc-editor.org/rfc/rfc6350
+func vCard(account *apimodel.Account, accountDomain string) string {
+	name := account.DisplayName
+	if name == "" {
+		name = account.Username
+	}
+
+	var b strings.Builder
+	line := func(l string) {
+		b.WriteString(foldVCardLine(l))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCARD")
+	line("VERSION:4.0")
+	if account.Bot {
+		line("KIND:application")
+	} else if account.Group {
+		line("KIND:group")
+	} else {
+		line("KIND:individual")
+	}
+	line("FN:" + escapeVCardText(name))
+	line("NICKNAME:" + escapeVCardText("@"+account.Username+"@"+accountDomain))
+	line("URL:" + account.URL)
+	line("SOCIALPROFILE;SERVICE-TYPE=Fediverse;USERNAME=" + account.Username + ":" + account.URL)
+	if account.Avatar != "" {
+		line("PHOTO:" + account.Avatar)
+	}
+	line("END:VCARD")
+
+	return b.String()
+}
+
+// escapeVCardText escapes special
+// characters in a vCard text value.
+func escapeVCardText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		`,`, `\,`,
+		`;`, `\;`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// foldVCardLine folds a vCard content line longer than
+// 75 octets, without splitting multi-byte characters.
+func foldVCardLine(l string) string {
+	const maxOctets = 75
+
+	var b strings.Builder
+	for n := maxOctets; len(l) > n; n = maxOctets - 1 {
+		// Back up to the start of a character.
+		i := n
+		for i > 0 && !utf8.RuneStart(l[i]) {
+			i--
+		}
+		b.WriteString(l[:i])
+		b.WriteString("\r\n ")
+		l = l[i:]
+	}
+	b.WriteString(l)
+
+	return b.String()
+}
@@ -0,0 +1,65 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type ShareTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ShareTestSuite) TestVCard() {
+	card := vCard(&apimodel.Account{
+		Username:    "example_account",
+		DisplayName: "example person; the best, \\really\\",
+		URL:         "https://example.org/@example_account",
+		Avatar:      "https://example.org/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/avatar/original/01F8MH58A357CV5K7R7TJMSH6S.jpg",
+	}, "example.org")
+
+	suite.Equal("BEGIN:VCARD\r\n"+
+		"VERSION:4.0\r\n"+
+		"KIND:individual\r\n"+
+		`FN:example person\; the best\, \\really\\`+"\r\n"+
+		"NICKNAME:@example_account@example.org\r\n"+
+		"URL:https://example.org/@example_account\r\n"+
+		"SOCIALPROFILE;SERVICE-TYPE=Fediverse;USERNAME=example_account:https://examp\r\n"+
+		" le.org/@example_account\r\n"+
+		"PHOTO:https://example.org/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/avatar/orig\r\n"+
+		" inal/01F8MH58A357CV5K7R7TJMSH6S.jpg\r\n"+
+		"END:VCARD\r\n", card)
+}
+
+func (suite *ShareTestSuite) TestFoldVCardLineMultiByte() {
+	line := "FN:" + strings.Repeat("🐢", 30)
+	folded := foldVCardLine(line)
+
+	for _, l := range strings.Split(folded, "\r\n") {
+		suite.LessOrEqual(len(l), 75)
+	}
+	suite.Equal(line, strings.ReplaceAll(folded, "\r\n ", ""))
+}
+
+func TestShareTestSuite(t *testing.T) {
+	suite.Run(t, new(ShareTestSuite))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/gin-gonic/gin"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 )
 
@@ -62,10 +63,20 @@ func (m *Module) tagGETHandler(c *gin.Context) {
 		distPathPrefix + "/tag.css",
 	}
 
+	// Only generate feed links if tag feeds are enabled.
+	var rssFeed, atomFeed string
+	if config.GetInstanceExposeTagRSS() {
+		rssFeed = "/tags/" + tagName + "/feed.rss"
+		atomFeed = "/tags/" + tagName + "/feed.atom"
+	}
+
 	c.HTML(http.StatusOK, "tag.tmpl", gin.H{
+		"i18n":        apiutil.TemplateLocalizer(c),
 		"instance":    instance,
 		"ogMeta":      ogBase(instance),
 		"tagName":     tagName,
+		"rssFeed":     rssFeed,
+		"atomFeed":    atomFeed,
 		"stylesheets": stylesheets,
 	})
 }
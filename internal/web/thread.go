@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"strings"
 
@@ -124,26 +125,39 @@ func (m *Module) threadGETHandler(c *gin.Context) {
 		return
 	}
 
+	// Parse which page of replies to show, if any.
+	page, errWithCode := apiutil.ParseWebThreadPage(c.Query(apiutil.WebThreadPageKey), 1, math.MaxInt32, 1)
+	if errWithCode != nil {
+		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
 	// Fill in the rest of the thread context.
-	context, errWithCode := m.processor.Status().ContextGet(ctx, authed.Account, targetStatusID)
+	context, errWithCode := m.processor.Status().WebContextGet(ctx, authed.Account, targetStatusID, page)
 	if errWithCode != nil {
 		apiutil.WebErrorHandler(c, errWithCode, instanceGet)
 		return
 	}
 
+	// Only allow search engines / robots to index public
+	// statuses, of accounts which allow their profile to be.
+	robotsMeta := setRobots(c, targetAccount, status.Visibility == apimodel.VisibilityPublic)
+
 	stylesheets := []string{
 		assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
 		distPathPrefix + "/status.css",
 	}
-	if config.GetAccountsAllowCustomCSS() {
+	if config.GetAccountsAllowCustomCSS() || targetAccount.Theme != "" {
 		stylesheets = append(stylesheets, "/@"+targetUsername+"/custom.css")
 	}
 
 	c.HTML(http.StatusOK, "thread.tmpl", gin.H{
+		"i18n":        apiutil.TemplateLocalizer(c),
 		"instance":    instance,
 		"status":      status,
 		"context":     context,
 		"ogMeta":      ogBase(instance).withStatus(status),
+		"robotsMeta":  robotsMeta,
 		"stylesheets": stylesheets,
 		"javascript":  []string{distPathPrefix + "/frontend.js"},
 	})
@@ -0,0 +1,100 @@
+// GoToSocial
+// Copyright (C) GoToSocial Authors admin@gotosocial.org
+// SPDX-License-Identifier: AGPL-3.0-or-later
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package web
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+)
+
+type ThreadTestSuite struct {
+	WebStandardTestSuite
+}
+
+// getThread gets the web view of the
+// given page of zork's first status.
+func (suite *ThreadTestSuite) getThread(page string) (int, string) {
+	status := suite.testStatuses["local_account_1_status_1"]
+
+	path := "/@the_mighty_zork/statuses/" + status.ID
+	if page != "" {
+		path += "?" + apiutil.WebThreadPageKey + "=" + page
+	}
+
+	ctx, recorder := suite.newContext(path, string(apiutil.TextHTML))
+	ctx.Params = gin.Params{
+		{Key: apiutil.WebUsernameKey, Value: "the_mighty_zork"},
+		{Key: apiutil.WebStatusIDKey, Value: status.ID},
+	}
+
+	suite.webModule.threadGETHandler(ctx)
+	return recorder.Code, recorder.Body.String()
+}
+
+func (suite *ThreadTestSuite) TestThreadPages() {
+	config.SetWebThreadPageSize(1)
+
+	// First page: no way back, a way forward.
+	code, body := suite.getThread("")
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, `<div id="replies"></div>`)
+	suite.NotContains(body, "Show previous replies")
+	suite.Contains(body, `<a href="?page=2#replies">Load more replies</a>`)
+
+	// Last page: the other way around.
+	code, body = suite.getThread("2")
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, `<a href="?page=1#replies">Show previous replies</a>`)
+	suite.NotContains(body, "Load more replies")
+
+	// Past the last page.
+	code, _ = suite.getThread("3")
+	suite.Equal(http.StatusNotFound, code)
+}
+
+func (suite *ThreadTestSuite) TestThreadSinglePage() {
+	config.SetWebThreadPageSize(20)
+
+	code, body := suite.getThread("1")
+	suite.Equal(http.StatusOK, code)
+	suite.NotContains(body, "Show previous replies")
+	suite.NotContains(body, "Load more replies")
+}
+
+func (suite *ThreadTestSuite) TestThreadBadPage() {
+	config.SetWebThreadPageSize(1)
+
+	code, _ := suite.getThread("nope")
+	suite.Equal(http.StatusBadRequest, code)
+
+	// Pages before the first are clamped to the first.
+	for _, page := range []string{"0", "-1"} {
+		code, body := suite.getThread(page)
+		suite.Equal(http.StatusOK, code, page)
+		suite.Contains(body, `<a href="?page=2#replies">Load more replies</a>`, page)
+	}
+}
+
+func TestThreadTestSuite(t *testing.T) {
+	suite.Run(t, new(ThreadTestSuite))
+}
@@ -39,15 +39,25 @@ const (
 	confirmEmailPath   = "/" + uris.ConfirmEmailPath
 	profileGroupPath   = "/@:" + usernameKey
 	statusPath         = "/statuses/:" + apiutil.WebStatusIDKey // leave out the '/@:username' prefix as this will be served within the profile group
+	statusEmbedPath    = statusPath + "/embed"
 	tagsPath           = "/tags/:" + apiutil.TagNameKey
 	customCSSPath      = profileGroupPath + "/custom.css"
+	profileQRPath      = profileGroupPath + "/qr.svg"
+	profileVCardPath   = profileGroupPath + "/contact.vcf"
 	rssFeedPath        = profileGroupPath + "/feed.rss"
+	jsonFeedPath       = profileGroupPath + "/feed.json"
+	tagRSSFeedPath     = tagsPath + "/feed.rss"
+	tagAtomFeedPath    = tagsPath + "/feed.atom"
+	localRSSFeedPath   = "/timelines/local/feed.rss"
+	localAtomFeedPath  = "/timelines/local/feed.atom"
 	assetsPathPrefix   = "/assets"
 	distPathPrefix     = assetsPathPrefix + "/dist"
 	settingsPathPrefix = "/settings"
 	settingsPanelGlob  = settingsPathPrefix + "/*panel"
 	userPanelPath      = settingsPathPrefix + "/user"
 	adminPanelPath     = settingsPathPrefix + "/admin"
+	clientPathPrefix   = "/client"
+	clientGlob         = clientPathPrefix + "/*view"
 
 	tokenParam  = "token"
 	usernameKey = "username"
@@ -97,17 +107,33 @@ func (m *Module) Route(r router.Router, mi ...gin.HandlerFunc) {
 	}))
 	profileGroup.Handle(http.MethodGet, "", m.profileGETHandler) // use empty path here since it's the base of the group
 	profileGroup.Handle(http.MethodGet, statusPath, m.threadGETHandler)
+	profileGroup.Handle(http.MethodGet, statusEmbedPath, m.statusEmbedGETHandler)
 
 	// Attach individual web handlers which require no specific middlewares
 	r.AttachHandler(http.MethodGet, "/", m.baseHandler) // front-page
 	r.AttachHandler(http.MethodGet, settingsPathPrefix, m.SettingsPanelHandler)
 	r.AttachHandler(http.MethodGet, settingsPanelGlob, m.SettingsPanelHandler)
+	r.AttachHandler(http.MethodGet, clientPathPrefix, m.ClientHandler)
+	r.AttachHandler(http.MethodGet, clientGlob, m.ClientHandler)
+	r.AttachHandler(http.MethodPost, clientSharePath, m.clientSharePOSTHandler)
+	r.AttachHandler(http.MethodGet, clientServiceWorkerPath, m.clientServiceWorkerGETHandler)
+	r.AttachHandler(http.MethodGet, manifestPath, m.manifestGETHandler)
+	r.AttachHandler(http.MethodGet, leavingPath, m.leavingGETHandler)
 	r.AttachHandler(http.MethodGet, customCSSPath, m.customCSSGETHandler)
+	r.AttachHandler(http.MethodGet, profileQRPath, m.profileQRGETHandler)
+	r.AttachHandler(http.MethodGet, profileVCardPath, m.profileVCardGETHandler)
 	r.AttachHandler(http.MethodGet, rssFeedPath, m.rssFeedGETHandler)
+	r.AttachHandler(http.MethodGet, jsonFeedPath, m.jsonFeedGETHandler)
+	r.AttachHandler(http.MethodGet, tagRSSFeedPath, m.tagRSSFeedGETHandler)
+	r.AttachHandler(http.MethodGet, tagAtomFeedPath, m.tagAtomFeedGETHandler)
+	r.AttachHandler(http.MethodGet, localRSSFeedPath, m.localTimelineRSSFeedGETHandler)
+	r.AttachHandler(http.MethodGet, localAtomFeedPath, m.localTimelineAtomFeedGETHandler)
 	r.AttachHandler(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
 	r.AttachHandler(http.MethodGet, robotsPath, m.robotsGETHandler)
 	r.AttachHandler(http.MethodGet, aboutPath, m.aboutGETHandler)
 	r.AttachHandler(http.MethodGet, domainBlockListPath, m.domainBlockListGETHandler)
+	r.AttachHandler(http.MethodGet, pagePath, m.pageGETHandler)
+	r.AttachHandler(http.MethodGet, privacyPolicyPath, m.privacyPolicyGETHandler)
 	r.AttachHandler(http.MethodGet, tagsPath, m.tagGETHandler)
 
 	// Attach redirects from old endpoints to current ones for backwards compatibility
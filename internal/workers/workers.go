@@ -61,6 +61,13 @@ type Workers struct {
 	// Media manager worker pools.
 	Media runners.WorkerPool
 
+	// Optional persistent queues for client API / federator
+	// messages. If set, messages are queued in these instead of
+	// the ClientAPI / Federator worker pools, so that they survive
+	// restarts, falling back to the worker pools on failure.
+	ClientAPIQueue Queue
+	FediAPIQueue   Queue
+
 	// prevent pass-by-value.
 	_ nocopy
 }
@@ -95,6 +102,21 @@ func (w *Workers) Stop() {
 	tryUntil("stopping media workerpool", 5, w.Media.Stop)
 }
 
+// Queue is a persistent queue of encoded messages,
+// held outside of this process, e.g. in redis.
+type Queue interface {
+	// Push pushes the message onto the queue.
+	Push(ctx context.Context, data []byte) error
+
+	// Start starts given number of workers taking
+	// messages from the queue, passing them to handle.
+	Start(workers int, handle func(context.Context, []byte))
+
+	// Stop stops the workers, waiting for any
+	// messages being handled to finish.
+	Stop()
+}
+
 // nocopy when embedded will signal linter to
 // error on pass-by-value of parent struct.
 type nocopy struct{}
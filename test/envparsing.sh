@@ -5,10 +5,24 @@ set -eu
 EXPECT=$(cat << "EOF"
 {
     "account-domain": "peepee",
+    "accounts-activity-limit-admin-direct-messages": 0,
+    "accounts-activity-limit-admin-follows": 0,
+    "accounts-activity-limit-duration": 86400000000000,
+    "accounts-activity-limit-moderator-direct-messages": 100,
+    "accounts-activity-limit-moderator-follows": 200,
+    "accounts-activity-limit-user-direct-messages": 30,
+    "accounts-activity-limit-user-follows": 50,
+    "accounts-activity-limit-window": 3600000000000,
     "accounts-allow-custom-css": true,
     "accounts-approval-required": false,
+    "accounts-challenge-app-tokens": true,
+    "accounts-challenge-provider": "turnstile",
+    "accounts-challenge-secret-key": "0x4AAAAAAAsecret",
+    "accounts-challenge-site-key": "0x4AAAAAAAsitekey",
     "accounts-custom-css-length": 5000,
+    "accounts-interaction-cooldown": 30000000000,
     "accounts-reason-required": false,
+    "accounts-refresh-cooldown": 300000000000,
     "accounts-registration-open": true,
     "advanced-cookies-samesite": "strict",
     "advanced-csp-extra-uris": [],
@@ -17,6 +31,19 @@ EXPECT=$(cat << "EOF"
         "127.0.0.1/32"
     ],
     "advanced-rate-limit-requests": 6969,
+    "advanced-sanitizer-allow-attrs": [
+        "ol.start"
+    ],
+    "advanced-sanitizer-allow-tags": [
+        "table",
+        "tr",
+        "td"
+    ],
+    "advanced-sanitizer-link-rel": [
+        "nofollow",
+        "ugc"
+    ],
+    "advanced-sanitizer-policy": "minimal",
     "advanced-sender-multiplier": -1,
     "advanced-throttling-multiplier": -1,
     "advanced-throttling-retry-after": 10000000000,
@@ -26,8 +53,10 @@ EXPECT=$(cat << "EOF"
         "account-mem-ratio": 5,
         "account-note-mem-ratio": 1,
         "application-mem-ratio": 0.1,
+        "block-filter-mem-ratio": 0.5,
         "block-mem-ratio": 3,
         "boost-of-ids-mem-ratio": 3,
+        "domain-interop-mem-ratio": 0.5,
         "emoji-category-mem-ratio": 0.1,
         "emoji-mem-ratio": 3,
         "follow-ids-mem-ratio": 4,
@@ -42,16 +71,24 @@ EXPECT=$(cat << "EOF"
         "media-mem-ratio": 4,
         "memory-target": 104857600,
         "mention-mem-ratio": 2,
+        "mute-filter-mem-ratio": 0.5,
         "notification-mem-ratio": 2,
+        "prime-local": false,
         "report-mem-ratio": 1,
         "status-fave-ids-mem-ratio": 3,
         "status-fave-mem-ratio": 2,
         "status-mem-ratio": 5,
+        "status-reaction-ids-mem-ratio": 1,
+        "status-reaction-mem-ratio": 1,
         "tag-mem-ratio": 2,
         "tombstone-mem-ratio": 0.5,
+        "translation-ttl": 86400000000000,
         "user-mem-ratio": 0.25,
+        "user-mute-mem-ratio": 1,
         "visibility-mem-ratio": 2,
-        "webfinger-mem-ratio": 0.1
+        "webfinger-mem-ratio": 0.1,
+        "webfinger-negative-ttl": 600000000000,
+        "webfinger-ttl": 3600000000000
     },
     "config-path": "internal/config/testdata/test.yaml",
     "db-address": ":memory:",
@@ -59,6 +96,9 @@ EXPECT=$(cat << "EOF"
     "db-max-open-conns-multiplier": 3,
     "db-password": "hunter2",
     "db-port": 6969,
+    "db-postgres-advisory-locks": true,
+    "db-postgres-cache-notify": true,
+    "db-postgres-stream-notify": true,
     "db-sqlite-busy-timeout": 1000000000,
     "db-sqlite-cache-size": 0,
     "db-sqlite-journal-mode": "DELETE",
@@ -67,38 +107,85 @@ EXPECT=$(cat << "EOF"
     "db-tls-mode": "disable",
     "db-type": "sqlite",
     "db-user": "sex-haver",
+    "disabled": false,
+    "domain": "",
     "dry-run": true,
     "email": "",
     "host": "example.com",
     "http-client": {
         "allow-ips": [],
         "block-ips": [],
+        "disable-http2": false,
+        "idle-conn-timeout": 90000000000,
+        "max-idle-conns-per-host": 0,
+        "max-open-conns-per-host": 0,
+        "onion-proxy": "",
+        "proxy": "",
         "timeout": 10000000000,
         "tls-insecure-skip-verify": false
     },
+    "instance-categories": [
+        "tech",
+        "music"
+    ],
+    "instance-dead-delivery-failures": 10,
+    "instance-dead-delivery-window": 259200000000000,
     "instance-deliver-to-shared-inboxes": false,
+    "instance-emoji-reactions": true,
+    "instance-expose-local-timeline-rss": true,
     "instance-expose-peers": true,
     "instance-expose-public-timeline": true,
     "instance-expose-suspended": true,
     "instance-expose-suspended-web": true,
+    "instance-expose-tag-rss": true,
     "instance-federation-mode": "allowlist",
     "instance-inject-mastodon-version": true,
+    "instance-interaction-circle": true,
+    "instance-languages": [
+        "nl",
+        "en-GB"
+    ],
+    "instance-new-domain-auto-approve": 5,
+    "instance-new-domain-quarantine": true,
+    "instance-noindex-default": true,
+    "instance-optimistic-ingestion": true,
+    "instance-remote-account-freshness": 43200000000000,
+    "instance-remote-refresh-per-domain": 3,
+    "instance-remote-status-freshness": 14400000000000,
+    "jobs-max-retries": 5,
+    "jobs-schedules": [
+        "media-clean=0 3 * * *",
+        "timeline-prune=@every 30m"
+    ],
     "landing-page-user": "admin",
     "letsencrypt-cert-dir": "/gotosocial/storage/certs",
+    "letsencrypt-challenge": "http-01",
+    "letsencrypt-dns-command": "",
     "letsencrypt-email-address": "",
     "letsencrypt-enabled": true,
     "letsencrypt-port": 80,
     "local-only": false,
     "log-client-ip": false,
     "log-db-queries": true,
+    "log-format": "json",
     "log-level": "info",
+    "log-levels": [
+        "federation/dereferencing=debug",
+        "media=warn"
+    ],
     "log-timestamp-format": "banana",
     "media-description-max-chars": 5000,
     "media-description-min-chars": 69,
     "media-emoji-local-max-size": 420,
     "media-emoji-remote-max-size": 420,
+    "media-image-max-dimension": 2048,
+    "media-image-max-pixels": 16777216,
     "media-image-max-size": 420,
+    "media-local-quota": 420,
     "media-remote-cache-days": 30,
+    "media-scan-clamav-address": "tcp://127.0.0.1:3310",
+    "media-scan-command": "",
+    "media-scan-timeout": 30000000000,
     "media-video-max-size": 420,
     "oidc-admin-groups": [
         "steamy"
@@ -114,24 +201,49 @@ EXPECT=$(cat << "EOF"
         "write"
     ],
     "oidc-skip-verification": true,
+    "onion-host": "",
     "password": "",
     "path": "",
+    "pending": false,
     "port": 6969,
+    "pprof-bind-address": "localhost:6060",
+    "pprof-enabled": true,
     "protocol": "http",
+    "redis-cache-notify": true,
+    "redis-queues": [
+        "client-api",
+        "fedi-api"
+    ],
+    "redis-url": "redis://localhost:6379/0",
     "remote-only": false,
+    "repair": false,
     "request-id-header": "X-Trace-Id",
+    "role": "",
     "smtp-disclose-recipients": true,
+    "smtp-dkim-domain": "",
+    "smtp-dkim-private-key-path": "",
+    "smtp-dkim-selector": "gotosocial",
     "smtp-from": "queen.rip.in.piss@terfisland.org",
     "smtp-host": "example.com",
+    "smtp-http-token": "",
+    "smtp-http-url": "",
     "smtp-password": "hunter2",
     "smtp-port": 4269,
+    "smtp-transport": "smtp",
     "smtp-username": "sex-haver",
     "software-version": "",
     "statuses-cw-max-chars": 420,
     "statuses-max-chars": 69,
+    "statuses-max-pinned": 5,
     "statuses-media-max-files": 1,
     "statuses-poll-max-options": 1,
     "statuses-poll-option-max-chars": 50,
+    "statuses-spam-filter-drop-score": 80,
+    "statuses-spam-filter-enabled": true,
+    "statuses-spam-filter-quarantine-score": 40,
+    "statuses-translation-api-key": "some-deepl-key:fx",
+    "statuses-translation-backend": "deepl",
+    "statuses-translation-url": "",
     "storage-backend": "local",
     "storage-local-base-path": "/root/store",
     "storage-s3-access-key": "minio",
@@ -155,7 +267,15 @@ EXPECT=$(cat << "EOF"
     ],
     "username": "",
     "web-asset-base-dir": "/root",
-    "web-template-base-dir": "/root"
+    "web-background-color": "#000000",
+    "web-link-interstitial": true,
+    "web-link-interstitial-trusted-domains": [
+        "example.org",
+        "example.com"
+    ],
+    "web-template-base-dir": "/root",
+    "web-theme-color": "#ffffff",
+    "web-thread-page-size": 25
 }
 EOF
 )
@@ -163,6 +283,8 @@ EOF
 # Set all the environment variables to 
 # ensure that these are parsed without panic
 OUTPUT=$(GTS_LOG_LEVEL='info' \
+GTS_LOG_LEVELS='federation/dereferencing=debug,media=warn' \
+GTS_LOG_FORMAT='json' \
 GTS_LOG_TIMESTAMP_FORMAT="banana" \
 GTS_LOG_DB_QUERIES=true \
 GTS_LOG_CLIENT_IP=false \
@@ -185,29 +307,63 @@ GTS_DB_SQLITE_JOURNAL_MODE='DELETE' \
 GTS_DB_SQLITE_SYNCHRONOUS='FULL' \
 GTS_DB_SQLITE_CACHE_SIZE=0 \
 GTS_DB_SQLITE_BUSY_TIMEOUT='1s' \
+GTS_DB_POSTGRES_CACHE_NOTIFY=true \
+GTS_DB_POSTGRES_STREAM_NOTIFY=true \
+GTS_DB_POSTGRES_ADVISORY_LOCKS=true \
 GTS_TLS_MODE='' \
 GTS_DB_TLS_CA_CERT='' \
 GTS_WEB_TEMPLATE_BASE_DIR='/root' \
 GTS_WEB_ASSET_BASE_DIR='/root' \
+GTS_WEB_THREAD_PAGE_SIZE=25 \
+GTS_WEB_THEME_COLOR='#ffffff' \
+GTS_WEB_BACKGROUND_COLOR='#000000' \
+GTS_WEB_LINK_INTERSTITIAL=true \
+GTS_WEB_LINK_INTERSTITIAL_TRUSTED_DOMAINS='example.org,example.com' \
 GTS_INSTANCE_EXPOSE_PEERS=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED=true \
 GTS_INSTANCE_EXPOSE_SUSPENDED_WEB=true \
 GTS_INSTANCE_EXPOSE_PUBLIC_TIMELINE=true \
+GTS_INSTANCE_EXPOSE_LOCAL_TIMELINE_RSS=true \
+GTS_INSTANCE_EXPOSE_TAG_RSS=true \
+GTS_INSTANCE_NOINDEX_DEFAULT=true \
+GTS_INSTANCE_EMOJI_REACTIONS=true \
+GTS_INSTANCE_INTERACTION_CIRCLE=true \
 GTS_INSTANCE_FEDERATION_MODE='allowlist' \
 GTS_INSTANCE_DELIVER_TO_SHARED_INBOXES=false \
 GTS_INSTANCE_INJECT_MASTODON_VERSION=true \
+GTS_INSTANCE_LANGUAGES='nl,en-GB' \
+GTS_INSTANCE_CATEGORIES='tech,music' \
+GTS_INSTANCE_NEW_DOMAIN_QUARANTINE=true \
+GTS_INSTANCE_NEW_DOMAIN_AUTO_APPROVE=5 \
+GTS_INSTANCE_DEAD_DELIVERY_FAILURES=10 \
+GTS_INSTANCE_DEAD_DELIVERY_WINDOW='72h' \
+GTS_INSTANCE_OPTIMISTIC_INGESTION=true \
+GTS_INSTANCE_REMOTE_ACCOUNT_FRESHNESS='12h' \
+GTS_INSTANCE_REMOTE_STATUS_FRESHNESS='4h' \
+GTS_INSTANCE_REMOTE_REFRESH_PER_DOMAIN=3 \
+GTS_ACCOUNTS_ACTIVITY_LIMIT_USER_FOLLOWS=50 \
 GTS_ACCOUNTS_ALLOW_CUSTOM_CSS=true \
 GTS_ACCOUNTS_CUSTOM_CSS_LENGTH=5000 \
+GTS_ACCOUNTS_INTERACTION_COOLDOWN=30s \
+GTS_ACCOUNTS_REFRESH_COOLDOWN=5m \
+GTS_ACCOUNTS_CHALLENGE_PROVIDER='turnstile' \
+GTS_ACCOUNTS_CHALLENGE_SITE_KEY='0x4AAAAAAAsitekey' \
+GTS_ACCOUNTS_CHALLENGE_SECRET_KEY='0x4AAAAAAAsecret' \
+GTS_ACCOUNTS_CHALLENGE_APP_TOKENS=true \
 GTS_ACCOUNTS_REGISTRATION_OPEN=true \
 GTS_ACCOUNTS_APPROVAL_REQUIRED=false \
 GTS_ACCOUNTS_REASON_REQUIRED=false \
 GTS_MEDIA_IMAGE_MAX_SIZE=420 \
 GTS_MEDIA_VIDEO_MAX_SIZE=420 \
+GTS_MEDIA_IMAGE_MAX_DIMENSION=2048 \
+GTS_MEDIA_IMAGE_MAX_PIXELS=16777216 \
 GTS_MEDIA_DESCRIPTION_MIN_CHARS=69 \
 GTS_MEDIA_DESCRIPTION_MAX_CHARS=5000 \
 GTS_MEDIA_REMOTE_CACHE_DAYS=30 \
 GTS_MEDIA_EMOJI_LOCAL_MAX_SIZE=420 \
 GTS_MEDIA_EMOJI_REMOTE_MAX_SIZE=420 \
+GTS_MEDIA_LOCAL_QUOTA=420 \
+GTS_MEDIA_SCAN_CLAMAV_ADDRESS='tcp://127.0.0.1:3310' \
 GTS_STORAGE_BACKEND='local' \
 GTS_STORAGE_LOCAL_BASE_PATH='/root/store' \
 GTS_STORAGE_S3_ACCESS_KEY='minio' \
@@ -221,6 +377,12 @@ GTS_STATUSES_CW_MAX_CHARS=420 \
 GTS_STATUSES_POLL_MAX_OPTIONS=1 \
 GTS_STATUSES_POLL_OPTIONS_MAX_CHARS=69 \
 GTS_STATUSES_MEDIA_MAX_FILES=1 \
+GTS_STATUSES_MAX_PINNED=5 \
+GTS_STATUSES_SPAM_FILTER_ENABLED=true \
+GTS_STATUSES_SPAM_FILTER_QUARANTINE_SCORE=40 \
+GTS_STATUSES_SPAM_FILTER_DROP_SCORE=80 \
+GTS_STATUSES_TRANSLATION_BACKEND='deepl' \
+GTS_STATUSES_TRANSLATION_API_KEY='some-deepl-key:fx' \
 GTS_LETS_ENCRYPT_ENABLED=false \
 GTS_LETS_ENCRYPT_PORT=8080 \
 GTS_LETS_ENCRYPT_CERT_DIR='/root/certs' \
@@ -240,14 +402,26 @@ GTS_SMTP_USERNAME='sex-haver' \
 GTS_SMTP_PASSWORD='hunter2' \
 GTS_SMTP_FROM='queen.rip.in.piss@terfisland.org' \
 GTS_SMTP_DISCLOSE_RECIPIENTS=true \
+GTS_SMTP_DKIM_SELECTOR='gotosocial' \
 GTS_SYSLOG_ENABLED=true \
 GTS_SYSLOG_PROTOCOL='udp' \
 GTS_SYSLOG_ADDRESS='127.0.0.1:6969' \
+GTS_REDIS_URL='redis://localhost:6379/0' \
+GTS_REDIS_CACHE_NOTIFY=true \
+GTS_REDIS_QUEUES='client-api,fedi-api' \
+GTS_JOBS_SCHEDULES='media-clean=0 3 * * *,timeline-prune=@every 30m' \
+GTS_JOBS_MAX_RETRIES=5 \
 GTS_TRACING_ENDPOINT='localhost:4317' \
 GTS_TRACING_INSECURE_TRANSPORT=true \
+GTS_PPROF_ENABLED=true \
+GTS_PPROF_BIND_ADDRESS='localhost:6060' \
 GTS_ADVANCED_COOKIES_SAMESITE='strict' \
 GTS_ADVANCED_RATE_LIMIT_EXCEPTIONS="192.0.2.0/24,127.0.0.1/32" \
 GTS_ADVANCED_RATE_LIMIT_REQUESTS=6969 \
+GTS_ADVANCED_SANITIZER_POLICY='minimal' \
+GTS_ADVANCED_SANITIZER_ALLOW_TAGS='table,tr,td' \
+GTS_ADVANCED_SANITIZER_ALLOW_ATTRS='ol.start' \
+GTS_ADVANCED_SANITIZER_LINK_REL='nofollow,ugc' \
 GTS_ADVANCED_SENDER_MULTIPLIER=-1 \
 GTS_ADVANCED_THROTTLING_MULTIPLIER=-1 \
 GTS_ADVANCED_THROTTLING_RETRY_AFTER='10s' \
@@ -34,6 +34,8 @@ func InitTestConfig() {
 
 var testDefaults = config.Configuration{
 	LogLevel:           "info",
+	LogLevels:          []string{},
+	LogFormat:          "logfmt",
 	LogTimestampFormat: "02/01/2006 15:04:05.000",
 	LogDbQueries:       true,
 	ApplicationName:    "gotosocial",
@@ -62,12 +64,28 @@ var testDefaults = config.Configuration{
 
 	WebTemplateBaseDir: "./web/template/",
 	WebAssetBaseDir:    "./web/assets/",
+	WebThreadPageSize:  40,
+	WebThemeColor:      "#fd6a00",
+	WebBackgroundColor: "#2a2b2f",
+
+	WebLinkInterstitial:               false,
+	WebLinkInterstitialTrustedDomains: []string{},
 
 	InstanceFederationMode:         config.InstanceFederationModeDefault,
 	InstanceExposePeers:            true,
 	InstanceExposeSuspended:        true,
 	InstanceExposeSuspendedWeb:     true,
 	InstanceDeliverToSharedInboxes: true,
+	InstanceEmojiReactions:         true,
+	InstanceInteractionCircle:      true,
+	InstanceLanguages:              []string{},
+	InstanceCategories:             []string{},
+	InstanceNewDomainQuarantine:    false,
+	InstanceNewDomainAutoApprove:   0,
+	InstanceDeadDeliveryFailures:   20,
+	InstanceDeadDeliveryWindow:     7 * 24 * time.Hour,
+	InstanceRemoteAccountFreshness: 6 * time.Hour,
+	InstanceRemoteStatusFreshness:  2 * time.Hour,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
@@ -75,13 +93,34 @@ var testDefaults = config.Configuration{
 	AccountsAllowCustomCSS:   true,
 	AccountsCustomCSSLength:  10000,
 
+	AccountsActivityLimitWindow:                  time.Hour,
+	AccountsActivityLimitDuration:                24 * time.Hour,
+	AccountsActivityLimitUserFollows:             100,
+	AccountsActivityLimitUserDirectMessages:      30,
+	AccountsActivityLimitModeratorFollows:        200,
+	AccountsActivityLimitModeratorDirectMessages: 100,
+	AccountsActivityLimitAdminFollows:            0,
+	AccountsActivityLimitAdminDirectMessages:     0,
+	AccountsInteractionCooldown:                  0,
+	AccountsRefreshCooldown:                      time.Minute,
+	AccountsChallengeProvider:                    "",
+	AccountsChallengeSiteKey:                     "",
+	AccountsChallengeSecretKey:                   "",
+	AccountsChallengeAppTokens:                   false,
+
 	MediaImageMaxSize:        10485760, // 10mb
 	MediaVideoMaxSize:        41943040, // 40mb
+	MediaImageMaxDimension:   4096,
+	MediaImageMaxPixels:      67108864, // 8192x8192
 	MediaDescriptionMinChars: 0,
 	MediaDescriptionMaxChars: 500,
 	MediaRemoteCacheDays:     7,
 	MediaEmojiLocalMaxSize:   51200,  // 50kb
 	MediaEmojiRemoteMaxSize:  102400, // 100kb
+	MediaLocalQuota:          0,
+	MediaScanClamAVAddress:   "",
+	MediaScanCommand:         "",
+	MediaScanTimeout:         30 * time.Second,
 
 	// the testrig only uses in-memory storage, so we can
 	// safely set this value to 'test' to avoid running storage
@@ -94,11 +133,22 @@ var testDefaults = config.Configuration{
 	StatusesPollMaxOptions:     6,
 	StatusesPollOptionMaxChars: 50,
 	StatusesMediaMaxFiles:      6,
+	StatusesMaxPinned:          10,
+
+	StatusesSpamFilterEnabled:         false,
+	StatusesSpamFilterQuarantineScore: 50,
+	StatusesSpamFilterDropScore:       100,
+
+	StatusesTranslationBackend: "",
+	StatusesTranslationURL:     "",
+	StatusesTranslationAPIKey:  "",
 
 	LetsEncryptEnabled:      false,
 	LetsEncryptPort:         0,
 	LetsEncryptCertDir:      "",
 	LetsEncryptEmailAddress: "",
+	LetsEncryptChallenge:    "",
+	LetsEncryptDNSCommand:   "",
 
 	OIDCEnabled:          false,
 	OIDCIdpName:          "",
@@ -115,6 +165,12 @@ var testDefaults = config.Configuration{
 	SMTPPassword:           "",
 	SMTPFrom:               "GoToSocial",
 	SMTPDiscloseRecipients: false,
+	SMTPTransport:          config.SMTPTransportSMTP,
+	SMTPHTTPURL:            "",
+	SMTPHTTPToken:          "",
+	SMTPDKIMDomain:         "",
+	SMTPDKIMSelector:       "",
+	SMTPDKIMPrivateKeyPath: "",
 
 	TracingEnabled:           false,
 	TracingEndpoint:          "localhost:4317",
@@ -129,6 +185,7 @@ var testDefaults = config.Configuration{
 	AdvancedRateLimitRequests:    0, // disabled
 	AdvancedThrottlingMultiplier: 0, // disabled
 	AdvancedSenderMultiplier:     0, // 1 sender only, regardless of CPU
+	AdvancedSanitizerLinkRel:     []string{"nofollow", "noreferrer", "noopener"},
 
 	SoftwareVersion: "0.0.0-testrig",
 
@@ -32,15 +32,21 @@ import (
 
 var testModels = []interface{}{
 	&gtsmodel.Account{},
+	&gtsmodel.AccountStats{},
 	&gtsmodel.AccountToEmoji{},
+	&gtsmodel.Announcement{},
 	&gtsmodel.Application{},
+	&gtsmodel.Backfill{},
 	&gtsmodel.Block{},
 	&gtsmodel.DomainBlock{},
+	&gtsmodel.DomainInterop{},
+	&gtsmodel.Draft{},
 	&gtsmodel.EmailDomainBlock{},
 	&gtsmodel.Follow{},
 	&gtsmodel.FollowRequest{},
 	&gtsmodel.List{},
 	&gtsmodel.ListEntry{},
+	&gtsmodel.ListRule{},
 	&gtsmodel.Marker{},
 	&gtsmodel.MediaAttachment{},
 	&gtsmodel.Mention{},
@@ -48,12 +54,14 @@ var testModels = []interface{}{
 	&gtsmodel.StatusToEmoji{},
 	&gtsmodel.StatusToTag{},
 	&gtsmodel.StatusFave{},
+	&gtsmodel.StatusReaction{},
 	&gtsmodel.StatusBookmark{},
 	&gtsmodel.StatusMute{},
 	&gtsmodel.Tag{},
 	&gtsmodel.User{},
 	&gtsmodel.Emoji{},
 	&gtsmodel.Instance{},
+	&gtsmodel.InstancePage{},
 	&gtsmodel.Notification{},
 	&gtsmodel.RouterSession{},
 	&gtsmodel.Token{},
@@ -62,7 +70,19 @@ var testModels = []interface{}{
 	&gtsmodel.Tombstone{},
 	&gtsmodel.Report{},
 	&gtsmodel.Rule{},
+	&gtsmodel.SavedSearch{},
+	&gtsmodel.IngestRule{},
+	&gtsmodel.FirstInteraction{},
+	&gtsmodel.FeaturedTag{},
+	&gtsmodel.InstanceStatistic{},
+	&gtsmodel.IPBlock{},
+	&gtsmodel.Job{},
+	&gtsmodel.Highlights{},
 	&gtsmodel.AccountNote{},
+	&gtsmodel.UserMute{},
+	&gtsmodel.QuarantinedStatus{},
+	&gtsmodel.DomainQuarantine{},
+	&gtsmodel.DomainStats{},
 }
 
 // NewTestDB returns a new initialized, empty database for testing.
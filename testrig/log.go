@@ -32,6 +32,16 @@ func InitTestLog() {
 		log.Panicf(nil, "error parsing log level: %v", err)
 	}
 
+	// Set any per-subsystem log level overrides
+	if err := log.ParseSubsystemLevels(config.GetLogLevels()); err != nil {
+		log.Panicf(nil, "error parsing log levels: %v", err)
+	}
+
+	// Set the log output format
+	if err := log.ParseFormat(config.GetLogFormat()); err != nil {
+		log.Panicf(nil, "error parsing log format: %v", err)
+	}
+
 	if config.GetSyslogEnabled() {
 		// Enable logging to syslog
 		if err := log.EnableSyslog(
@@ -22,6 +22,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -60,5 +61,19 @@ func NewTestRouter(db db.DB) router.Router {
 // ConfigureTemplatesWithGin will panic on any errors related to template loading during tests
 func ConfigureTemplatesWithGin(engine *gin.Engine, templatePath string) {
 	router.LoadTemplateFunctions(engine)
-	engine.LoadHTMLGlob(filepath.Join(templatePath, "*"))
+
+	files, err := filepath.Glob(filepath.Join(templatePath, "*"))
+	if err != nil {
+		panic(err)
+	}
+
+	// Leave out email templates, same as router.LoadTemplates.
+	htmlFiles := make([]string, 0, len(files))
+	for _, file := range files {
+		if !strings.HasPrefix(filepath.Base(file), "email_") {
+			htmlFiles = append(htmlFiles, file)
+		}
+	}
+
+	engine.LoadHTMLFiles(htmlFiles...)
 }
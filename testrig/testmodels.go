@@ -160,124 +160,128 @@ func NewTestApplications() map[string]*gtsmodel.Application {
 func NewTestUsers() map[string]*gtsmodel.User {
 	users := map[string]*gtsmodel.User{
 		"unconfirmed_account": {
-			ID:                     "01F8MGYG9E893WRHW0TAEXR8GJ",
-			Email:                  "",
-			AccountID:              "01F8MH0BBE4FHXPH513MBVFHB0",
-			EncryptedPassword:      "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
-			CreatedAt:              TimeMustParse("2022-06-04T13:12:00Z"),
-			SignUpIP:               net.ParseIP("199.222.111.89"),
-			UpdatedAt:              time.Time{},
-			CurrentSignInAt:        time.Time{},
-			CurrentSignInIP:        nil,
-			LastSignInAt:           time.Time{},
-			LastSignInIP:           nil,
-			SignInCount:            0,
-			InviteID:               "",
-			ChosenLanguages:        []string{},
-			FilteredLanguages:      []string{},
-			Locale:                 "en",
-			CreatedByApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
-			LastEmailedAt:          time.Time{},
-			ConfirmationToken:      "a5a280bd-34be-44a3-8330-a57eaf61b8dd",
-			ConfirmedAt:            time.Time{},
-			ConfirmationSentAt:     TimeMustParse("2022-06-04T13:12:00Z"),
-			UnconfirmedEmail:       "weed_lord420@example.org",
-			Moderator:              util.Ptr(false),
-			Admin:                  util.Ptr(false),
-			Disabled:               util.Ptr(false),
-			Approved:               util.Ptr(false),
-			ResetPasswordToken:     "",
-			ResetPasswordSentAt:    time.Time{},
+			ID:                      "01F8MGYG9E893WRHW0TAEXR8GJ",
+			Email:                   "",
+			AccountID:               "01F8MH0BBE4FHXPH513MBVFHB0",
+			EncryptedPassword:       "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
+			CreatedAt:               TimeMustParse("2022-06-04T13:12:00Z"),
+			SignUpIP:                net.ParseIP("199.222.111.89"),
+			UpdatedAt:               time.Time{},
+			CurrentSignInAt:         time.Time{},
+			CurrentSignInIP:         nil,
+			LastSignInAt:            time.Time{},
+			LastSignInIP:            nil,
+			SignInCount:             0,
+			InviteID:                "",
+			ChosenLanguages:         []string{},
+			FilteredLanguages:       []string{},
+			Locale:                  "en",
+			CreatedByApplicationID:  "01F8MGY43H3N2C8EWPR2FPYEXG",
+			LastEmailedAt:           time.Time{},
+			ConfirmationToken:       "a5a280bd-34be-44a3-8330-a57eaf61b8dd",
+			ConfirmedAt:             time.Time{},
+			ConfirmationSentAt:      TimeMustParse("2022-06-04T13:12:00Z"),
+			UnconfirmedEmail:        "weed_lord420@example.org",
+			Moderator:               util.Ptr(false),
+			Admin:                   util.Ptr(false),
+			Disabled:                util.Ptr(false),
+			Approved:                util.Ptr(false),
+			ReviewFirstInteractions: util.Ptr(false),
+			ResetPasswordToken:      "",
+			ResetPasswordSentAt:     time.Time{},
 		},
 		"admin_account": {
-			ID:                     "01F8MGWYWKVKS3VS8DV1AMYPGE",
-			Email:                  "admin@example.org",
-			AccountID:              "01F8MH17FWEB39HZJ76B6VXSKF",
-			EncryptedPassword:      "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
-			CreatedAt:              TimeMustParse("2022-06-01T13:12:00Z"),
-			SignUpIP:               net.ParseIP("89.22.189.19"),
-			UpdatedAt:              TimeMustParse("2022-06-01T13:12:00Z"),
-			CurrentSignInAt:        TimeMustParse("2022-06-04T13:12:00Z"),
-			CurrentSignInIP:        net.ParseIP("89.122.255.1"),
-			LastSignInAt:           TimeMustParse("2022-06-03T13:12:00Z"),
-			LastSignInIP:           net.ParseIP("89.122.255.1"),
-			SignInCount:            78,
-			InviteID:               "",
-			ChosenLanguages:        []string{"en"},
-			FilteredLanguages:      []string{},
-			Locale:                 "en",
-			CreatedByApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
-			LastEmailedAt:          TimeMustParse("2022-06-03T13:12:00Z"),
-			ConfirmationToken:      "",
-			ConfirmedAt:            TimeMustParse("2022-06-02T13:12:00Z"),
-			ConfirmationSentAt:     time.Time{},
-			UnconfirmedEmail:       "",
-			Moderator:              util.Ptr(true),
-			Admin:                  util.Ptr(true),
-			Disabled:               util.Ptr(false),
-			Approved:               util.Ptr(true),
-			ResetPasswordToken:     "",
-			ResetPasswordSentAt:    time.Time{},
+			ID:                      "01F8MGWYWKVKS3VS8DV1AMYPGE",
+			Email:                   "admin@example.org",
+			AccountID:               "01F8MH17FWEB39HZJ76B6VXSKF",
+			EncryptedPassword:       "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
+			CreatedAt:               TimeMustParse("2022-06-01T13:12:00Z"),
+			SignUpIP:                net.ParseIP("89.22.189.19"),
+			UpdatedAt:               TimeMustParse("2022-06-01T13:12:00Z"),
+			CurrentSignInAt:         TimeMustParse("2022-06-04T13:12:00Z"),
+			CurrentSignInIP:         net.ParseIP("89.122.255.1"),
+			LastSignInAt:            TimeMustParse("2022-06-03T13:12:00Z"),
+			LastSignInIP:            net.ParseIP("89.122.255.1"),
+			SignInCount:             78,
+			InviteID:                "",
+			ChosenLanguages:         []string{"en"},
+			FilteredLanguages:       []string{},
+			Locale:                  "en",
+			CreatedByApplicationID:  "01F8MGXQRHYF5QPMTMXP78QC2F",
+			LastEmailedAt:           TimeMustParse("2022-06-03T13:12:00Z"),
+			ConfirmationToken:       "",
+			ConfirmedAt:             TimeMustParse("2022-06-02T13:12:00Z"),
+			ConfirmationSentAt:      time.Time{},
+			UnconfirmedEmail:        "",
+			Moderator:               util.Ptr(true),
+			Admin:                   util.Ptr(true),
+			Disabled:                util.Ptr(false),
+			Approved:                util.Ptr(true),
+			ReviewFirstInteractions: util.Ptr(false),
+			ResetPasswordToken:      "",
+			ResetPasswordSentAt:     time.Time{},
 		},
 		"local_account_1": {
-			ID:                     "01F8MGVGPHQ2D3P3X0454H54Z5",
-			Email:                  "zork@example.org",
-			AccountID:              "01F8MH1H7YV1Z7D2C8K2730QBF",
-			EncryptedPassword:      "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
-			CreatedAt:              TimeMustParse("2022-06-01T13:12:00Z"),
-			SignUpIP:               net.ParseIP("59.99.19.172"),
-			UpdatedAt:              TimeMustParse("2022-06-01T13:12:00Z"),
-			CurrentSignInAt:        TimeMustParse("2022-06-04T13:12:00Z"),
-			CurrentSignInIP:        net.ParseIP("88.234.118.16"),
-			LastSignInAt:           TimeMustParse("2022-06-03T13:12:00Z"),
-			LastSignInIP:           net.ParseIP("147.111.231.154"),
-			SignInCount:            9,
-			InviteID:               "",
-			ChosenLanguages:        []string{"en"},
-			FilteredLanguages:      []string{},
-			Locale:                 "en",
-			CreatedByApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
-			LastEmailedAt:          TimeMustParse("2022-06-02T13:12:00Z"),
-			ConfirmationToken:      "",
-			ConfirmedAt:            TimeMustParse("2022-06-02T13:12:00Z"),
-			ConfirmationSentAt:     TimeMustParse("2022-06-02T13:12:00Z"),
-			UnconfirmedEmail:       "",
-			Moderator:              util.Ptr(false),
-			Admin:                  util.Ptr(false),
-			Disabled:               util.Ptr(false),
-			Approved:               util.Ptr(true),
-			ResetPasswordToken:     "",
-			ResetPasswordSentAt:    time.Time{},
+			ID:                      "01F8MGVGPHQ2D3P3X0454H54Z5",
+			Email:                   "zork@example.org",
+			AccountID:               "01F8MH1H7YV1Z7D2C8K2730QBF",
+			EncryptedPassword:       "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
+			CreatedAt:               TimeMustParse("2022-06-01T13:12:00Z"),
+			SignUpIP:                net.ParseIP("59.99.19.172"),
+			UpdatedAt:               TimeMustParse("2022-06-01T13:12:00Z"),
+			CurrentSignInAt:         TimeMustParse("2022-06-04T13:12:00Z"),
+			CurrentSignInIP:         net.ParseIP("88.234.118.16"),
+			LastSignInAt:            TimeMustParse("2022-06-03T13:12:00Z"),
+			LastSignInIP:            net.ParseIP("147.111.231.154"),
+			SignInCount:             9,
+			InviteID:                "",
+			ChosenLanguages:         []string{"en"},
+			FilteredLanguages:       []string{},
+			Locale:                  "en",
+			CreatedByApplicationID:  "01F8MGY43H3N2C8EWPR2FPYEXG",
+			LastEmailedAt:           TimeMustParse("2022-06-02T13:12:00Z"),
+			ConfirmationToken:       "",
+			ConfirmedAt:             TimeMustParse("2022-06-02T13:12:00Z"),
+			ConfirmationSentAt:      TimeMustParse("2022-06-02T13:12:00Z"),
+			UnconfirmedEmail:        "",
+			Moderator:               util.Ptr(false),
+			Admin:                   util.Ptr(false),
+			Disabled:                util.Ptr(false),
+			Approved:                util.Ptr(true),
+			ReviewFirstInteractions: util.Ptr(false),
+			ResetPasswordToken:      "",
+			ResetPasswordSentAt:     time.Time{},
 		},
 		"local_account_2": {
-			ID:                     "01F8MH1VYJAE00TVVGMM5JNJ8X",
-			Email:                  "tortle.dude@example.org",
-			AccountID:              "01F8MH5NBDF2MV7CTC4Q5128HF",
-			EncryptedPassword:      "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
-			CreatedAt:              TimeMustParse("2022-05-23T13:12:00Z"),
-			SignUpIP:               net.ParseIP("59.99.19.172"),
-			UpdatedAt:              TimeMustParse("2022-05-23T13:12:00Z"),
-			CurrentSignInAt:        TimeMustParse("2022-06-05T13:12:00Z"),
-			CurrentSignInIP:        net.ParseIP("118.44.18.196"),
-			LastSignInAt:           TimeMustParse("2022-06-06T13:12:00Z"),
-			LastSignInIP:           net.ParseIP("198.98.21.15"),
-			SignInCount:            9,
-			InviteID:               "",
-			ChosenLanguages:        []string{"en"},
-			FilteredLanguages:      []string{},
-			Locale:                 "en",
-			CreatedByApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
-			LastEmailedAt:          TimeMustParse("2022-06-06T13:12:00Z"),
-			ConfirmationToken:      "",
-			ConfirmedAt:            TimeMustParse("2022-05-24T13:12:00Z"),
-			ConfirmationSentAt:     TimeMustParse("2022-05-23T13:12:00Z"),
-			UnconfirmedEmail:       "",
-			Moderator:              util.Ptr(false),
-			Admin:                  util.Ptr(false),
-			Disabled:               util.Ptr(false),
-			Approved:               util.Ptr(true),
-			ResetPasswordToken:     "",
-			ResetPasswordSentAt:    time.Time{},
+			ID:                      "01F8MH1VYJAE00TVVGMM5JNJ8X",
+			Email:                   "tortle.dude@example.org",
+			AccountID:               "01F8MH5NBDF2MV7CTC4Q5128HF",
+			EncryptedPassword:       "$2y$10$ggWz5QWwnx6kzb9g0tnIJurFtE0dhr5Zfeaqs9iFuUIXzafQlJVZS", // 'password'
+			CreatedAt:               TimeMustParse("2022-05-23T13:12:00Z"),
+			SignUpIP:                net.ParseIP("59.99.19.172"),
+			UpdatedAt:               TimeMustParse("2022-05-23T13:12:00Z"),
+			CurrentSignInAt:         TimeMustParse("2022-06-05T13:12:00Z"),
+			CurrentSignInIP:         net.ParseIP("118.44.18.196"),
+			LastSignInAt:            TimeMustParse("2022-06-06T13:12:00Z"),
+			LastSignInIP:            net.ParseIP("198.98.21.15"),
+			SignInCount:             9,
+			InviteID:                "",
+			ChosenLanguages:         []string{"en"},
+			FilteredLanguages:       []string{},
+			Locale:                  "en",
+			CreatedByApplicationID:  "01F8MGY43H3N2C8EWPR2FPYEXG",
+			LastEmailedAt:           TimeMustParse("2022-06-06T13:12:00Z"),
+			ConfirmationToken:       "",
+			ConfirmedAt:             TimeMustParse("2022-05-24T13:12:00Z"),
+			ConfirmationSentAt:      TimeMustParse("2022-05-23T13:12:00Z"),
+			UnconfirmedEmail:        "",
+			Moderator:               util.Ptr(false),
+			Admin:                   util.Ptr(false),
+			Disabled:                util.Ptr(false),
+			Approved:                util.Ptr(true),
+			ReviewFirstInteractions: util.Ptr(false),
+			ResetPasswordToken:      "",
+			ResetPasswordSentAt:     time.Time{},
 		},
 	}
 
@@ -317,7 +321,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/localhost:8080/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/localhost:8080/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -355,7 +358,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/weed_lord420/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/weed_lord420/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/weed_lord420#main-key",
@@ -396,7 +398,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/admin/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/admin/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -435,7 +436,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/the_mighty_zork/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/the_mighty_zork/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/the_mighty_zork/main-key",
@@ -494,7 +494,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://localhost:8080/users/1happyturtle/following",
 			FeaturedCollectionURI: "http://localhost:8080/users/1happyturtle/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://localhost:8080/users/1happyturtle#main-key",
@@ -531,7 +530,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://fossbros-anonymous.io/users/foss_satan/following",
 			FeaturedCollectionURI: "http://fossbros-anonymous.io/users/foss_satan/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://fossbros-anonymous.io/users/foss_satan/main-key",
@@ -568,7 +566,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://example.org/users/Some_User/following",
 			FeaturedCollectionURI: "http://example.org/users/Some_User/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://example.org/users/Some_User#main-key",
@@ -605,7 +602,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj/following",
 			FeaturedCollectionURI:   "http://thequeenisstillalive.technology/users/her_fuckin_maj/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj#main-key",
@@ -642,7 +638,6 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "https://xn--xample-ova.org/users/%C3%BCser/following",
 			FeaturedCollectionURI:   "https://xn--xample-ova.org/users/%C3%BCser/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "https://xn--xample-ova.org/users/%C3%BCser#main-key",
@@ -2540,6 +2535,35 @@ func NewTestFediStatuses() map[string]vocab.ActivityStreamsNote {
 			[]vocab.TootHashtag{},
 			nil,
 		),
+		"https://unknown-instance.com/users/brand_new_person/statuses/01HF0AXW0S6NZTJV1SJ0KC3RBE": NewAPNote(
+			URLMustParse("https://unknown-instance.com/users/brand_new_person/statuses/01HF0AXW0S6NZTJV1SJ0KC3RBE"),
+			URLMustParse("https://unknown-instance.com/users/@brand_new_person/01HF0AXW0S6NZTJV1SJ0KC3RBE"),
+			TimeMustParse("2023-11-12T12:13:12+02:00"),
+			"Hey @the_mighty_zork@localhost:8080 @admin@localhost:8080 @nobody_here@unknown-instance.com, party at mine!",
+			"",
+			URLMustParse("https://unknown-instance.com/users/brand_new_person"),
+			[]*url.URL{
+				URLMustParse(pub.PublicActivityPubIRI),
+			},
+			[]*url.URL{},
+			false,
+			[]vocab.ActivityStreamsMention{
+				newAPMention(
+					URLMustParse("http://localhost:8080/users/the_mighty_zork"),
+					"@the_mighty_zork@localhost:8080",
+				),
+				newAPMention(
+					URLMustParse("http://localhost:8080/users/admin"),
+					"@admin@localhost:8080",
+				),
+				newAPMention(
+					URLMustParse("https://unknown-instance.com/users/nobody_here"),
+					"@nobody_here@unknown-instance.com",
+				),
+			},
+			[]vocab.TootHashtag{},
+			nil,
+		),
 		"https://unknown-instance.com/users/brand_new_person/statuses/01H641QSRS3TCXSVC10X4GPKW7": NewAPNote(
 			URLMustParse("https://unknown-instance.com/users/brand_new_person/statuses/01H641QSRS3TCXSVC10X4GPKW7"),
 			URLMustParse("https://unknown-instance.com/users/@brand_new_person/01H641QSRS3TCXSVC10X4GPKW7"),
@@ -324,6 +324,17 @@ func WebfingerResponse(req *http.Request) (responseCode int, responseBytes []byt
 				},
 			},
 		}
+	case "https://example.org/.well-known/webfinger?resource=acct%3Argh%40example.org":
+		wfr = &apimodel.WellKnownResponse{
+			Subject: "acct:rgh@example.org",
+			Links: []apimodel.Link{
+				{
+					Rel:  "self",
+					Type: applicationActivityJSON,
+					Href: "https://owncast.example.org/federation/user/rgh",
+				},
+			},
+		}
 	case "https://unknown-instance.com/.well-known/webfinger?resource=acct%3Abrand_new_person%40unknown-instance.com":
 		wfr = &apimodel.WellKnownResponse{
 			Subject: "acct:brand_new_person@unknown-instance.com",
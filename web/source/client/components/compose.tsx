@@ -0,0 +1,144 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React, { useEffect, useState } from "react";
+
+import { useTextInput } from "../../settings/lib/form";
+import useFormSubmit from "../../settings/lib/form/submit";
+import { TextArea, TextInput, Select } from "../../settings/components/form/inputs";
+import MutationButton from "../../settings/components/form/mutation-button";
+import { usePostStatusMutation } from "../lib/query";
+import { takeSharedContent } from "../lib/share";
+
+function Attachments({ files, setFiles }: { files: File[], setFiles: (_files: File[]) => void }) {
+	function onChange(e: React.ChangeEvent<HTMLInputElement>) {
+		const added = Array.from(e.target.files ?? []);
+		setFiles([...files, ...added]);
+		e.target.value = "";
+	}
+
+	return (
+		<div className="attachments">
+			<ul>
+				{files.map((file, i) => (
+					<li key={`${i}-${file.name}`}>
+						<span className="text-cutoff">{file.name}</span>
+						<button
+							type="button"
+							className="danger"
+							aria-label={`Remove ${file.name}`}
+							title="Remove"
+							onClick={() => setFiles(files.filter((_f, j) => j != i))}
+						>
+							<i className="fa fa-fw fa-close" aria-hidden="true" />
+						</button>
+					</li>
+				))}
+			</ul>
+			<label className="button with-icon">
+				<i className="fa fa-fw fa-paperclip" aria-hidden="true" />
+				Attach media
+				<input
+					type="file"
+					className="hidden"
+					accept="image/*,video/*,audio/*"
+					multiple
+					onChange={onChange}
+				/>
+			</label>
+		</div>
+	);
+}
+
+/**
+ * Compose box for posting a new status.
+ */
+export default function Compose() {
+	const form = {
+		status: useTextInput("status"),
+		spoiler_text: useTextInput("spoiler_text"),
+		visibility: useTextInput("visibility", { initialValue: "public", dontReset: true }),
+	};
+
+	const [files, setFiles] = useState<File[]>([]);
+
+	// Prefill the compose box with content
+	// shared from another app, if any.
+	useEffect(() => {
+		takeSharedContent().then((shared) => {
+			if (shared != undefined) {
+				form.status.setter(shared.text);
+				setFiles(shared.files);
+			}
+		});
+	}, []);
+
+	const [submitForm, result] = useFormSubmit({
+		...form,
+		// Attachments aren't a regular form field,
+		// so pass them along as a plain value.
+		media: { name: "media", value: files, hasChanged: () => true },
+	} as any, usePostStatusMutation(), {
+		changedOnly: false,
+		onFinish: (res) => {
+			if (res.error == undefined) {
+				form.status.reset();
+				form.spoiler_text.reset();
+				setFiles([]);
+			}
+		},
+	});
+
+	return (
+		<form className="compose" onSubmit={submitForm}>
+			<TextInput
+				field={form.spoiler_text}
+				label="Content warning (optional)"
+				placeholder="Write your warning here"
+			/>
+			<TextArea
+				field={form.status}
+				label="Post"
+				placeholder="What's on your mind?"
+				rows={4}
+			/>
+			<Attachments files={files} setFiles={setFiles} />
+			<div className="compose-actions">
+				<Select
+					field={form.visibility}
+					label="Visibility"
+					options={
+						<>
+							<option value="public">Public</option>
+							<option value="unlisted">Unlisted</option>
+							<option value="private">Followers only</option>
+							<option value="mutuals_only">Mutuals only</option>
+							<option value="direct">Direct</option>
+						</>
+					}
+				/>
+				<MutationButton
+					label="Post"
+					result={result}
+					disabled={form.status.value?.trim().length == 0 && files.length == 0}
+				/>
+			</div>
+		</form>
+	);
+}
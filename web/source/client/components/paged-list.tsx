@@ -0,0 +1,98 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React, { useState } from "react";
+
+import Loading from "../../settings/components/loading";
+import { Error } from "../../settings/components/error";
+import { PAGE_SIZE } from "../lib/query";
+
+import type { PageParams } from "../lib/types";
+
+interface PagedListProps<T extends { id: string }> {
+	useQuery: (_params: PageParams) => { data?: T[], isLoading: boolean, error?: any };
+	renderItem: (_item: T) => React.JSX.Element;
+	emptyMessage: string;
+}
+
+interface PageProps<T extends { id: string }> extends PagedListProps<T> {
+	maxID?: string;
+	isFirst: boolean;
+	isLast: boolean;
+	onMore: (_maxID: string) => void;
+}
+
+function Page<T extends { id: string }>({
+	maxID,
+	isFirst,
+	isLast,
+	onMore,
+	useQuery,
+	renderItem,
+	emptyMessage,
+}: PageProps<T>) {
+	const { data, isLoading, error } = useQuery({ max_id: maxID });
+
+	if (isLoading) {
+		return <Loading />;
+	} else if (error) {
+		return <Error error={error} />;
+	} else if (data == undefined || data.length == 0) {
+		return isFirst ? <p>{emptyMessage}</p> : null;
+	}
+
+	return (
+		<>
+			{data.map((item) => (
+				<React.Fragment key={item.id}>
+					{renderItem(item)}
+				</React.Fragment>
+			))}
+			{(isLast && data.length >= PAGE_SIZE) &&
+				<button className="load-more" onClick={() => onMore(data[data.length - 1].id)}>
+					Load more
+				</button>
+			}
+		</>
+	);
+}
+
+/**
+ * PagedList renders consecutive pages from a paged
+ * API endpoint, newest first, with a button at the
+ * bottom to fetch the next (older) page.
+ */
+export default function PagedList<T extends { id: string }>(props: PagedListProps<T>) {
+	const [pages, setPages] = useState<(string | undefined)[]>([undefined]);
+
+	return (
+		<div className="paged-list">
+			{pages.map((maxID, i) => (
+				<Page
+					key={maxID ?? "first"}
+					maxID={maxID}
+					isFirst={i == 0}
+					isLast={i == pages.length - 1}
+					onMore={(next) => setPages([...pages, next])}
+					{...props}
+				/>
+			))}
+		</div>
+	);
+}
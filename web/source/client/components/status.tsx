@@ -0,0 +1,126 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React, { useState } from "react";
+
+import type { Account, MediaAttachment, Status } from "../lib/types";
+
+function displayName(account: Account): string {
+	return account.display_name.length > 0
+		? account.display_name
+		: account.username;
+}
+
+export function AccountLink({ account }: { account: Account }) {
+	return (
+		<a className="account-link" href={account.url} title={`@${account.acct}`}>
+			<img className="avatar" src={account.avatar} alt="" />
+			<span className="display-name text-cutoff">{displayName(account)}</span>
+			<span className="acct text-cutoff">@{account.acct}</span>
+		</a>
+	);
+}
+
+/**
+ * Render a single media attachment. Sensitive media
+ * (either the attachment itself, or the whole status
+ * it's attached to) is hidden until revealed.
+ */
+function MediaItem({ media, sensitive }: { media: MediaAttachment, sensitive: boolean }) {
+	const [revealed, setRevealed] = useState(false);
+
+	if ((sensitive || media.sensitive) && !revealed) {
+		return (
+			<button
+				className="sensitive"
+				onClick={() => setRevealed(true)}
+				title={media.description}
+			>
+				<i className="fa fa-fw fa-eye-slash" aria-hidden="true" /> Show sensitive media
+			</button>
+		);
+	}
+
+	return (
+		<a href={media.url} target="_blank" rel="noreferrer">
+			<img src={media.preview_url} alt={media.description ?? ""} title={media.description} />
+		</a>
+	);
+}
+
+function Media({ media, sensitive }: { media: MediaAttachment[], sensitive: boolean }) {
+	if (media.length == 0) {
+		return null;
+	}
+
+	return (
+		<div className="media">
+			{media.map((m) => (
+				<MediaItem key={m.id} media={m} sensitive={sensitive} />
+			))}
+		</div>
+	);
+}
+
+/**
+ * Render a single status. Status content is
+ * HTML that has already been sanitized by GtS.
+ */
+export function StatusView({ status }: { status: Status }) {
+	let boostedBy: Account | undefined;
+	if (status.reblog) {
+		boostedBy = status.account;
+		status = status.reblog;
+	}
+
+	const body = (
+		<>
+			<div
+				className="content"
+				dangerouslySetInnerHTML={{ __html: status.content }}
+			/>
+			<Media media={status.media_attachments} sensitive={status.sensitive} />
+		</>
+	);
+
+	return (
+		<article className="client-status">
+			{boostedBy &&
+				<div className="boosted-by">
+					<i className="fa fa-fw fa-retweet" aria-hidden="true" /> {displayName(boostedBy)} boosted
+				</div>
+			}
+			<header>
+				<AccountLink account={status.account} />
+				<a className="timestamp" href={status.url}>
+					<time dateTime={status.created_at}>
+						{new Date(status.created_at).toLocaleString()}
+					</time>
+				</a>
+			</header>
+			{status.spoiler_text.length > 0
+				? <details>
+					<summary>{status.spoiler_text}</summary>
+					{body}
+				</details>
+				: body
+			}
+		</article>
+	);
+}
@@ -0,0 +1,100 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React from "react";
+import ReactDom from "react-dom/client";
+import { Provider } from "react-redux";
+import { PersistGate } from "redux-persist/integration/react";
+import { Router, Switch, Route, Redirect, Link, useRoute } from "wouter";
+
+import { store, persistor } from "../settings/redux/store";
+import { Authorization } from "../settings/components/authorization";
+import Loading from "../settings/components/loading";
+import UserLogoutCard from "../settings/components/user-logout-card";
+
+import Home from "./views/home";
+import Notifications from "./views/notifications";
+
+require("./style.css");
+
+if ("serviceWorker" in navigator) {
+	navigator.serviceWorker.register("/client-sw.js", { scope: "/client/" }).catch((e) => {
+		// eslint-disable-next-line no-console
+		console.error("error registering service worker:", e);
+	});
+}
+
+function NavItem({ href, icon, name }) {
+	const [isActive] = useRoute(href);
+
+	return (
+		<li>
+			<Link href={href} className={isActive ? "active" : ""}>
+				<i className={`fa fa-fw ${icon}`} aria-hidden="true" />
+				{name}
+			</Link>
+		</li>
+	);
+}
+
+function App() {
+	return (
+		<Router base="/client">
+			<div className="sidebar">
+				<UserLogoutCard />
+				<nav className="client-nav">
+					<ul>
+						<NavItem href="/home" icon="fa-home" name="Home" />
+						<NavItem href="/notifications" icon="fa-bell" name="Notifications" />
+						<li>
+							<a href="/settings">
+								<i className="fa fa-fw fa-cogs" aria-hidden="true" />
+								Settings
+							</a>
+						</li>
+					</ul>
+				</nav>
+			</div>
+			<section className="with-sidebar">
+				<Switch>
+					<Route path="/home"><Home /></Route>
+					<Route path="/notifications"><Notifications /></Route>
+					<Route><Redirect to="/home" /></Route>
+				</Switch>
+			</section>
+		</Router>
+	);
+}
+
+function Main() {
+	return (
+		<Provider store={store}>
+			<PersistGate loading={<section><Loading /></section>} persistor={persistor}>
+				<Authorization
+					App={App}
+					title="GoToSocial"
+					scopes="read write"
+				/>
+			</PersistGate>
+		</Provider>
+	);
+}
+
+const root = ReactDom.createRoot(document.getElementById("root") as HTMLElement);
+root.render(<React.StrictMode><Main /></React.StrictMode>);
@@ -0,0 +1,104 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import type { FetchBaseQueryError } from "@reduxjs/toolkit/query";
+
+import { gtsApi } from "../../settings/lib/query/gts-api";
+
+import type {
+	MediaAttachment,
+	Notification,
+	PageParams,
+	PostStatusRequest,
+	Status,
+} from "./types";
+
+const pageSize = 20;
+
+const extended = gtsApi.injectEndpoints({
+	endpoints: (build) => ({
+		homeTimeline: build.query<Status[], PageParams>({
+			query: ({ max_id }) => ({
+				url: `/api/v1/timelines/home`,
+				params: { limit: pageSize, max_id },
+			}),
+			providesTags: ["Timeline"],
+		}),
+		notifications: build.query<Notification[], PageParams>({
+			query: ({ max_id }) => ({
+				url: `/api/v1/notifications`,
+				params: { limit: pageSize, max_id },
+			}),
+			providesTags: ["Notifications"],
+		}),
+		postStatus: build.mutation<Status, PostStatusRequest>({
+			async queryFn({ status, spoiler_text, visibility, media }, _api, _extraOpts, fetchWithBQ) {
+				// Upload any attachments first,
+				// so they can be attached by ID.
+				const mediaIDs: string[] = [];
+				for (const file of media ?? []) {
+					const mediaRes = await fetchWithBQ({
+						method: "POST",
+						url: `/api/v2/media`,
+						asForm: true,
+						body: { file },
+					});
+					if (mediaRes.error) {
+						return { error: mediaRes.error as FetchBaseQueryError };
+					}
+					mediaIDs.push((mediaRes.data as MediaAttachment).id);
+				}
+
+				const statusRes = await fetchWithBQ({
+					method: "POST",
+					url: `/api/v1/statuses`,
+					body: { status, spoiler_text, visibility, media_ids: mediaIDs },
+				});
+				if (statusRes.error) {
+					return { error: statusRes.error as FetchBaseQueryError };
+				}
+				return { data: statusRes.data as Status };
+			},
+			invalidatesTags: ["Timeline"],
+		}),
+	}),
+});
+
+export const PAGE_SIZE = pageSize;
+
+/**
+ * Fetch one page of the authorized account's home timeline.
+ */
+const useHomeTimelineQuery = extended.useHomeTimelineQuery;
+
+/**
+ * Fetch one page of the authorized account's notifications.
+ */
+const useNotificationsQuery = extended.useNotificationsQuery;
+
+/**
+ * Create a new status as the authorized account.
+ */
+const usePostStatusMutation = extended.usePostStatusMutation;
+
+export {
+	useHomeTimelineQuery,
+	useNotificationsQuery,
+	usePostStatusMutation,
+};
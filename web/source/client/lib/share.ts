@@ -0,0 +1,94 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import { SHARE_CACHE, SHARE_DATA_KEY, shareFileKey } from "./share-keys";
+
+/**
+ * Content shared to the web client from another app.
+ */
+export interface SharedContent {
+	text: string;
+	files: File[];
+}
+
+function joinShared(...parts: (string | null | undefined)[]): string {
+	return parts.filter((p) => p != undefined && p.length > 0).join("\n\n");
+}
+
+async function fromCache(): Promise<SharedContent | undefined> {
+	if (!("caches" in window)) {
+		return undefined;
+	}
+
+	const cache = await caches.open(SHARE_CACHE);
+	const dataRes = await cache.match(SHARE_DATA_KEY);
+	if (dataRes == undefined) {
+		return undefined;
+	}
+
+	const data = await dataRes.json();
+	const files: File[] = [];
+	for (let i = 0; i < data.files; i++) {
+		const fileRes = await cache.match(shareFileKey(i));
+		if (fileRes == undefined) {
+			continue;
+		}
+
+		const name = decodeURIComponent(fileRes.headers.get("X-Filename") ?? `shared-${i}`);
+		const blob = await fileRes.blob();
+		files.push(new File([blob], name, { type: blob.type }));
+	}
+
+	// Shared content is picked up
+	// once, then it's gone.
+	await caches.delete(SHARE_CACHE);
+
+	return {
+		text: joinShared(data.title, data.text, data.url),
+		files: files,
+	};
+}
+
+/**
+ * Retrieve content shared to the web client, either
+ * stashed by the service worker, or passed by the server
+ * in the query string if the service worker wasn't running.
+ * 
+ * Clears the share from the url once it's been read, so
+ * reloading the page doesn't share the same content again.
+ */
+export async function takeSharedContent(): Promise<SharedContent | undefined> {
+	const params = new URLSearchParams(window.location.search);
+
+	let shared: SharedContent | undefined;
+	if (params.get("share") == "cache") {
+		shared = await fromCache();
+	} else if (params.has("title") || params.has("text") || params.has("url")) {
+		shared = {
+			text: joinShared(params.get("title"), params.get("text"), params.get("url")),
+			files: [],
+		};
+	}
+
+	if (shared != undefined) {
+		window.history.replaceState({}, document.title, window.location.pathname);
+	}
+
+	return shared;
+}
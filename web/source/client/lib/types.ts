@@ -0,0 +1,94 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+/**
+ * Subset of the Mastodon API account entity
+ * used by the web client.
+ */
+export interface Account {
+	id: string;
+	username: string;
+	acct: string;
+	display_name: string;
+	avatar: string;
+	url: string;
+}
+
+export interface MediaAttachment {
+	id: string;
+	type: "image" | "gifv" | "video" | "audio" | "unknown";
+	url: string;
+	preview_url: string;
+	description?: string;
+	/**
+	 * Attachment has individually been marked as sensitive,
+	 * and should be hidden behind a warning even if the
+	 * status it's attached to is not marked as sensitive.
+	 */
+	sensitive: boolean;
+}
+
+/**
+ * Subset of the Mastodon API status entity
+ * used by the web client.
+ */
+export interface Status {
+	id: string;
+	created_at: string;
+	url: string;
+	content: string;
+	spoiler_text: string;
+	sensitive: boolean;
+	visibility: Visibility;
+	account: Account;
+	reblog?: Status;
+	media_attachments: MediaAttachment[];
+}
+
+export type Visibility = "public" | "unlisted" | "private" | "mutuals_only" | "direct";
+
+export interface Notification {
+	id: string;
+	type: "follow" | "follow_request" | "mention" | "reblog" | "favourite" | "poll" | "status";
+	created_at: string;
+	account: Account;
+	status?: Status;
+}
+
+/**
+ * Parameters for paging through timelines
+ * and notifications, newest first.
+ */
+export interface PageParams {
+	max_id?: string;
+}
+
+/**
+ * Body of a POST to /api/v1/statuses.
+ */
+export interface PostStatusRequest {
+	status: string;
+	spoiler_text?: string;
+	visibility?: Visibility;
+	/**
+	 * Files to upload and attach to
+	 * the status before posting it.
+	 */
+	media?: File[];
+}
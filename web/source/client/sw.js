@@ -0,0 +1,69 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+/*
+	Service worker for the web client. The only thing it
+	does for now is receive content shared to the installed
+	web client (see share_target in the web app manifest),
+	and stash it so the compose box can pick it up.
+*/
+
+const { SHARE_CACHE, SHARE_DATA_KEY, shareFileKey } = require("./lib/share-keys");
+
+self.addEventListener("install", () => {
+	self.skipWaiting();
+});
+
+self.addEventListener("activate", (event) => {
+	event.waitUntil(self.clients.claim());
+});
+
+self.addEventListener("fetch", (event) => {
+	const url = new URL(event.request.url);
+	if (event.request.method == "POST" && url.pathname.endsWith("/client/share")) {
+		event.respondWith(receiveShare(event.request, url));
+	}
+});
+
+async function receiveShare(request, url) {
+	const formData = await request.formData();
+	const cache = await caches.open(SHARE_CACHE);
+
+	const files = formData.getAll("media").filter((f) => f instanceof File);
+	await Promise.all(files.map((file, i) => {
+		return cache.put(shareFileKey(i), new Response(file, {
+			headers: {
+				"Content-Type": file.type,
+				"X-Filename": encodeURIComponent(file.name),
+			}
+		}));
+	}));
+
+	await cache.put(SHARE_DATA_KEY, new Response(JSON.stringify({
+		title: formData.get("title") ?? "",
+		text: formData.get("text") ?? "",
+		url: formData.get("url") ?? "",
+		files: files.length,
+	})));
+
+	const home = new URL(url);
+	home.pathname = home.pathname.replace(/\/share$/, "/home");
+	home.search = "?share=cache";
+	return Response.redirect(home.toString(), 303);
+}
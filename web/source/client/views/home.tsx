@@ -0,0 +1,41 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React from "react";
+
+import Compose from "../components/compose";
+import PagedList from "../components/paged-list";
+import { StatusView } from "../components/status";
+import { useHomeTimelineQuery } from "../lib/query";
+
+import type { Status } from "../lib/types";
+
+export default function Home() {
+	return (
+		<>
+			<h1>Home</h1>
+			<Compose />
+			<PagedList<Status>
+				useQuery={useHomeTimelineQuery}
+				renderItem={(status) => <StatusView status={status} />}
+				emptyMessage="Your home timeline is empty. Follow some accounts to see their posts here!"
+			/>
+		</>
+	);
+}
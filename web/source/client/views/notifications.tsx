@@ -0,0 +1,63 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+import React from "react";
+
+import PagedList from "../components/paged-list";
+import { AccountLink, StatusView } from "../components/status";
+import { useNotificationsQuery } from "../lib/query";
+
+import type { Notification } from "../lib/types";
+
+const descriptions: Record<Notification["type"], string> = {
+	follow: "followed you",
+	follow_request: "requested to follow you",
+	mention: "mentioned you",
+	reblog: "boosted your post",
+	favourite: "faved your post",
+	poll: "poll has ended",
+	status: "posted",
+};
+
+function NotificationView({ notification }: { notification: Notification }) {
+	return (
+		<div className={`notification ${notification.type}`}>
+			<div className="notification-header">
+				<AccountLink account={notification.account} />
+				<span>{descriptions[notification.type] ?? notification.type}</span>
+			</div>
+			{notification.status &&
+				<StatusView status={notification.status} />
+			}
+		</div>
+	);
+}
+
+export default function Notifications() {
+	return (
+		<>
+			<h1>Notifications</h1>
+			<PagedList<Notification>
+				useQuery={useNotificationsQuery}
+				renderItem={(notification) => <NotificationView notification={notification} />}
+				emptyMessage="You don't have any notifications yet."
+			/>
+		</>
+	);
+}
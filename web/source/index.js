@@ -88,6 +88,32 @@ skulk({
 				}]
 			]
 		},
+		client: {
+			entryFile: "client/index.tsx",
+			outputFile: "client.js",
+			prodCfg: prodCfg,
+			plugin: [
+				["tsify"]
+			],
+			transform: [
+				["babelify", {
+					global: true,
+					ignore: [/node_modules\/(?!nanoid)/],
+				}]
+			],
+			presets: [
+				"react",
+				["postcss", {
+					output: "client-style.css"
+				}]
+			]
+		},
+		clientServiceWorker: {
+			entryFile: "client/sw.js",
+			outputFile: "client-sw.js",
+			preset: ["js"],
+			prodCfg: prodCfg,
+		},
 		css: {
 			entryFiles: cssEntryFiles,
 			outputFile: "_discard",
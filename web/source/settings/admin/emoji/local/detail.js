@@ -20,7 +20,7 @@
 const React = require("react");
 const { useRoute, Link, Redirect } = require("wouter");
 
-const { useComboBoxInput, useFileInput, useValue } = require("../../../lib/form");
+const { useComboBoxInput, useFileInput, useTextInput, useValue } = require("../../../lib/form");
 const { CategorySelect } = require("../category-select");
 
 const useFormSubmit = require("../../../lib/form/submit").default;
@@ -29,7 +29,7 @@ const { useBaseUrl } = require("../../../lib/navigation/util");
 const FakeToot = require("../../../components/fake-toot");
 const FormWithData = require("../../../lib/form/form-with-data").default;
 const Loading = require("../../../components/loading");
-const { FileInput } = require("../../../components/form/inputs");
+const { FileInput, TextInput } = require("../../../components/form/inputs");
 const MutationButton = require("../../../components/form/mutation-button");
 const { Error } = require("../../../components/error");
 
@@ -62,7 +62,9 @@ function EmojiDetailForm({ data: emoji }) {
 		image: useFileInput("image", {
 			withPreview: true,
 			maxSize: 50 * 1024 // TODO: get from instance api
-		})
+		}),
+		license: useTextInput("license", { source: emoji }),
+		attribution: useTextInput("attribution", { source: emoji })
 	};
 
 	const [modifyEmoji, result] = useFormSubmit(form, useEditEmojiMutation());
@@ -144,6 +146,27 @@ function EmojiDetailForm({ data: emoji }) {
 					{result.error && <Error error={result.error} />}
 					{deleteResult.error && <Error error={deleteResult.error} />}
 				</div>
+
+				<div className="update-credits">
+					<TextInput
+						field={form.license}
+						label="License (eg., CC BY 4.0)"
+						maxLength={100}
+					/>
+
+					<TextInput
+						field={form.attribution}
+						label="Attribution, shown on the about page"
+						maxLength={500}
+					/>
+
+					<MutationButton
+						name="credits"
+						label="Save credits"
+						showError={false}
+						result={result}
+					/>
+				</div>
 			</form>
 		</>
 	);
@@ -0,0 +1,103 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+const React = require("react");
+
+const query = require("../../lib/query");
+const FormWithData = require("../../lib/form/form-with-data").default;
+
+module.exports = function StatisticsData() {
+	return (
+		<FormWithData
+			dataQuery={query.useInstanceStatisticsQuery}
+			DataForm={Statistics}
+		/>
+	);
+};
+
+const columns = [
+	["active_users", "Active users"],
+	["new_registrations", "New sign-ups"],
+	["statuses_created", "Posts created"],
+	["statuses_received", "Posts received"],
+	["accounts_discovered", "Remote accounts discovered"],
+	["known_instances", "Known instances"],
+	["media_storage_bytes", "Media storage"],
+];
+
+function Statistics({ data: stats }) {
+	return (
+		<>
+			<h1>Statistics</h1>
+			<p>
+				Daily usage statistics for the past 30 days. Statistics for each day are
+				recorded shortly after midnight UTC, so today is not included yet.
+			</p>
+			{stats.length == 0
+				? <b>No statistics have been recorded yet.</b>
+				: <StatisticsTable stats={stats} />
+			}
+		</>
+	);
+}
+
+function StatisticsTable({ stats }) {
+	// Newest day first.
+	const days = [...stats].reverse();
+
+	return (
+		<div className="statistics-table-wrapper">
+			<table className="statistics-table">
+				<thead>
+					<tr>
+						<th>Date</th>
+						{columns.map(([key, label]) => <th key={key}>{label}</th>)}
+					</tr>
+				</thead>
+				<tbody>
+					{days.map((day) => (
+						<tr key={day.date}>
+							<td><b>{day.date}</b></td>
+							{columns.map(([key]) => (
+								<td key={key}>{format(key, day[key])}</td>
+							))}
+						</tr>
+					))}
+				</tbody>
+			</table>
+		</div>
+	);
+}
+
+function format(key, value) {
+	if (key == "media_storage_bytes") {
+		return formatBytes(value);
+	}
+	return value.toLocaleString();
+}
+
+function formatBytes(bytes) {
+	const units = ["B", "KiB", "MiB", "GiB", "TiB"];
+	let i = 0;
+	while (bytes >= 1024 && i < units.length - 1) {
+		bytes /= 1024;
+		i++;
+	}
+	return `${bytes.toFixed(i == 0 ? 0 : 1)} ${units[i]}`;
+}
@@ -27,7 +27,7 @@ import Loading from "../loading";
 import { Error } from "../error";
 import { NoArg } from "../../lib/types/query";
 
-export function Authorization({ App }) {
+export function Authorization({ App, title = "GoToSocial Settings", scopes = "user admin" }) {
 	const { loginState, expectingRedirect } = store.getState().oauth;
 	const skip = (loginState == "none" || loginState == "logout" || expectingRedirect);
 
@@ -70,9 +70,9 @@ export function Authorization({ App }) {
 	} else {
 		return (
 			<section className="oauth">
-				<h1>GoToSocial Settings</h1>
+				<h1>{title}</h1>
 				{content}
-				{showLogin && <Login />}
+				{showLogin && <Login scopes={scopes} />}
 			</section>
 		);
 	}
@@ -26,12 +26,12 @@ import MutationButton from "../form/mutation-button";
 import Loading from "../loading";
 import { TextInput } from "../form/inputs";
 
-export default function Login({ }) {
+export default function Login({ scopes }) {
 	const form = {
 		instance: useTextInput("instance", {
 			defaultValue: window.location.origin
 		}),
-		scopes: useValue("scopes", "user admin"),
+		scopes: useValue("scopes", scopes),
 	};
 
 	const [formSubmit, result] = useFormSubmit(form, useAuthorizeFlowMutation(), { 
@@ -57,6 +57,7 @@ const { Sidebar, ViewRouter } = createNavigation("/settings", [
 		defaultUrl: "/settings/admin/settings",
 		permissions: ["admin"]
 	}, [
+		Item("Statistics", { icon: "fa-line-chart" }, require("./admin/statistics")),
 		Menu("Actions", { icon: "fa-bolt" }, [
 			Item("Media", { icon: "fa-photo" }, require("./admin/actions/media")),
 			Item("Keys", { icon: "fa-key-modern" }, require("./admin/actions/keys")),
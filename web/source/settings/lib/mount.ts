@@ -0,0 +1,82 @@
+/*
+	GoToSocial
+	Copyright (C) GoToSocial Authors admin@gotosocial.org
+	SPDX-License-Identifier: AGPL-3.0-or-later
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+/**
+ * AppMount describes one of the first-party frontends
+ * which share the OAuth + query logic in this directory.
+ */
+export interface AppMount {
+	/**
+	 * Path at which the app is served, eg "/settings".
+	 */
+	path: string;
+	/**
+	 * Name used when registering an OAuth application.
+	 */
+	clientName: string;
+	/**
+	 * Key under which redux-persist stores login state.
+	 * Each app registers its own OAuth application
+	 * with its own redirect URI, so state isn't shared.
+	 */
+	storageKey: string;
+}
+
+const mounts: AppMount[] = [
+	{
+		path: "/settings",
+		clientName: "GoToSocial Settings",
+		storageKey: "gotosocial-settings",
+	},
+	{
+		path: "/client",
+		clientName: "GoToSocial Web Client",
+		storageKey: "gotosocial-client",
+	},
+];
+
+/**
+ * Return the app mount for the current page,
+ * falling back to the settings panel.
+ */
+function getAppMount(): AppMount {
+	const pathname = window.location.pathname;
+	const found = mounts.find((m) => {
+		return pathname.endsWith(m.path) || pathname.includes(`${m.path}/`);
+	});
+	return found ?? mounts[0];
+}
+
+/**
+ * Return the full URL of the current app.
+ * 
+ * Needed in case the app isn't hosted at root, but some
+ * subpath like /gotosocial/settings. Other parts of the
+ * code don't take this into account yet so mostly future-proofing.
+ * 
+ * Also drops anything past the app path, because
+ * authorization urls that are too long get rejected by GTS.
+ */
+function getAppURL(mount: AppMount): string {
+	let [pre, _past] = window.location.pathname.split(mount.path);
+	return `${window.location.origin}${pre}${mount.path}`;
+}
+
+export const APP_MOUNT = getAppMount();
+export const APP_URL = getAppURL(APP_MOUNT);
@@ -83,6 +83,15 @@ const extended = gtsApi.injectEndpoints({
 			}
 		}),
 
+		instanceStatistics: build.query({
+			query: () => ({
+				url: `/api/v1/admin/statistics`,
+				params: {
+					days: 30
+				}
+			})
+		}),
+
 		instanceRules: build.query({
 			query: () => ({
 				url: `/api/v1/admin/instance/rules`
@@ -141,6 +150,7 @@ export const {
 	useGetAccountQuery,
 	useActionAccountMutation,
 	useSearchAccountMutation,
+	useInstanceStatisticsQuery,
 	useInstanceRulesQuery,
 	useAddInstanceRuleMutation,
 	useUpdateInstanceRuleMutation,
@@ -137,6 +137,8 @@ export const gtsApi = createApi({
 		"Reports",
 		"Account",
 		"InstanceRules",
+		"Timeline",
+		"Notifications",
 	],
 	endpoints: (build) => ({
 		instanceV1: build.query<InstanceV1, void>({
@@ -26,6 +26,7 @@ import {
 	authorize as oauthAuthorize,
 } from "../../../redux/oauth";
 import { RootState } from '../../../redux/store';
+import { APP_MOUNT, APP_URL } from '../../mount';
 
 export interface OauthTokenRequestBody {
 	client_id: string;
@@ -35,21 +36,6 @@ export interface OauthTokenRequestBody {
 	code: string;
 }
 
-function getSettingsURL() {
-	/*
-		needed in case the settings interface isn't hosted at /settings but
-		some subpath like /gotosocial/settings. Other parts of the code don't
-		take this into account yet so mostly future-proofing.
-
-		 Also drops anything past /settings/, because authorization urls that are too long
-		 get rejected by GTS.
-	*/
-	let [pre, _past] = window.location.pathname.split("/settings");
-	return `${window.location.origin}${pre}/settings`;
-}
-
-const SETTINGS_URL = (getSettingsURL());
-
 // Couple auth functions here require multiple requests as
 // part of an OAuth token 'flow'. To keep things simple for
 // callers of these query functions, the multiple requests
@@ -106,7 +92,7 @@ const extended = gtsApi.injectEndpoints({
 				const tokenReqBody: OauthTokenRequestBody = {
 					client_id: app.client_id,
 					client_secret: app.client_secret,
-					redirect_uri: SETTINGS_URL,
+					redirect_uri: APP_URL,
 					grant_type: "authorization_code",
 					code: code
 				};
@@ -155,10 +141,10 @@ const extended = gtsApi.injectEndpoints({
 					baseUrl: instanceUrl,
 					url: "/api/v1/apps",
 					body: {
-						client_name: "GoToSocial Settings",
+						client_name: APP_MOUNT.clientName,
 						scopes: formData.scopes,
-						redirect_uris: SETTINGS_URL,
-						website: SETTINGS_URL
+						redirect_uris: APP_URL,
+						website: APP_URL
 					}
 				});
 				if (appResult.error) {
@@ -178,7 +164,7 @@ const extended = gtsApi.injectEndpoints({
 				let url = new URL(instanceUrl);
 				url.pathname = "/oauth/authorize";
 				url.searchParams.set("client_id", app.client_id);
-				url.searchParams.set("redirect_uri", SETTINGS_URL);
+				url.searchParams.set("redirect_uri", APP_URL);
 				url.searchParams.set("response_type", "code");
 				url.searchParams.set("scope", app.scopes);
 				
@@ -32,6 +32,11 @@ const extended = gtsApi.injectEndpoints({
 			}),
 			...replaceCacheOnMutation("verifyCredentials")
 		}),
+		accountThemes: build.query({
+			query: () => ({
+				url: `/api/v1/accounts/themes`
+			})
+		}),
 		passwordChange: build.mutation({
 			query: (data) => ({
 				method: "POST",
@@ -44,5 +49,6 @@ const extended = gtsApi.injectEndpoints({
 
 export const {
 	useUpdateCredentialsMutation,
+	useAccountThemesQuery,
 	usePasswordChangeMutation,
 } = extended;
@@ -21,6 +21,8 @@ export interface CustomEmoji {
 	id?: string;
 	shortcode: string;
 	category?: string;
+	license?: string;
+	attribution?: string;
 }
 
 /**
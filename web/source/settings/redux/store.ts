@@ -32,6 +32,7 @@ import {
 
 import { oauthSlice } from "./oauth";
 import { gtsApi } from "../lib/query/gts-api";
+import { APP_MOUNT } from "../lib/mount";
 
 const combinedReducers = combineReducers({
 	[gtsApi.reducerPath]: gtsApi.reducer,
@@ -39,7 +40,7 @@ const combinedReducers = combineReducers({
 });
 
 const persistedReducer = persistReducer({
-	key: "gotosocial-settings",
+	key: APP_MOUNT.storageKey,
 	storage: require("redux-persist/lib/storage").default,
 	stateReconciler: require("redux-persist/lib/stateReconciler/autoMergeLevel1").default,
 	whitelist: ["oauth"],
@@ -33,7 +33,8 @@ const {
 	TextInput,
 	TextArea,
 	FileInput,
-	Checkbox
+	Checkbox,
+	Select
 } = require("../components/form/inputs");
 
 const FormWithData = require("../lib/form/form-with-data").default;
@@ -41,7 +42,7 @@ const FakeProfile = require("../components/fake-profile");
 const MutationButton = require("../components/form/mutation-button");
 
 const { useInstanceV1Query } = require("../lib/query");
-const { useUpdateCredentialsMutation } = require("../lib/query/user");
+const { useUpdateCredentialsMutation, useAccountThemesQuery } = require("../lib/query/user");
 const { useVerifyCredentialsQuery } = require("../lib/query/oauth");
 
 module.exports = function UserProfile() {
@@ -63,6 +64,7 @@ function UserProfileForm({ data: profile }) {
 		- file avatar
 		- file header
 		- bool enable_rss
+		- string theme
 		- string custom_css (if enabled)
 	*/
 
@@ -74,6 +76,17 @@ function UserProfileForm({ data: profile }) {
 		};
 	}, [instance]);
 
+	const { data: themes = [] } = useAccountThemesQuery();
+	const themeOptions = React.useMemo(() => {
+		return themes.map((theme) => {
+			return (
+				<option key={theme.file_name} value={theme.file_name} title={theme.description}>
+					{theme.title}
+				</option>
+			);
+		});
+	}, [themes]);
+
 	const form = {
 		avatar: useFileInput("avatar", { withPreview: true }),
 		header: useFileInput("header", { withPreview: true }),
@@ -83,7 +96,10 @@ function UserProfileForm({ data: profile }) {
 		bot: useBoolInput("bot", { source: profile }),
 		locked: useBoolInput("locked", { source: profile }),
 		discoverable: useBoolInput("discoverable", { source: profile}),
+		indexable: useBoolInput("indexable", { source: profile }),
+		noindex: useBoolInput("noindex", { source: profile }),
 		enableRSS: useBoolInput("enable_rss", { source: profile }),
+		theme: useTextInput("theme", { source: profile }),
 		fields: useFieldArrayInput("fields_attributes", {
 			defaultValue: profile?.source?.fields,
 			length: instanceConfig.maxPinnedFields
@@ -172,6 +188,14 @@ function UserProfileForm({ data: profile }) {
 				field={form.discoverable}
 				label="Mark account as discoverable by search engines and directories"
 			/>
+			<Checkbox
+				field={form.indexable}
+				label="Allow your posts and profile to be found by search on other instances"
+			/>
+			<Checkbox
+				field={form.noindex}
+				label="Ask search engines not to index your profile and post web pages"
+			/>
 			<Checkbox
 				field={form.enableRSS}
 				label="Enable RSS feed of Public posts"
@@ -188,6 +212,16 @@ function UserProfileForm({ data: profile }) {
 					Learn more about these settings (opens in a new tab)
 				</a>
 			</div>
+			<Select
+				field={form.theme}
+				label="Theme"
+				options={
+					<>
+						<option value="">Default</option>
+						{themeOptions}
+					</>
+				}
+			/>
 			<TextArea
 				field={form.customCSS}
 				label={`Custom CSS` + (!instanceConfig.allowCustomCSS ? ` (not enabled on this instance)` : ``)}
@@ -219,7 +253,8 @@ function ProfileFields({ field: formField }) {
 function Field({ index, data }) {
 	const form = useWithFormContext(index, {
 		name: useTextInput("name", { defaultValue: data.name }),
-		value: useTextInput("value", { defaultValue: data.value })
+		value: useTextInput("value", { defaultValue: data.value }),
+		relMe: useBoolInput("rel_me", { defaultValue: data.rel_me })
 	});
 
 	return (
@@ -232,6 +267,11 @@ function Field({ index, data }) {
 				field={form.value}
 				placeholder="Value"
 			/>
+			<Checkbox
+				field={form.relMe}
+				label="rel=me"
+				title="Mark links in this field as rel=me, so the sites they link to can verify that your profile links back to them"
+			/>
 		</div>
 	);
 }
\ No newline at end of file
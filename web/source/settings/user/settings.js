@@ -53,6 +53,11 @@ function UserSettingsForm({ data }) {
 		- bool source[sensitive]
 		- string source[language]
 		- string source[status_content_type]
+		- string source[locale]
+		- string source[email_notifications]
+		- bool source[review_first_interactions]
+		- string source[time_zone]
+		- string source[highlights_digest]
 	 */
 
 	const form = {
@@ -60,6 +65,11 @@ function UserSettingsForm({ data }) {
 		isSensitive: useBoolInput("source[sensitive]", { source: data }),
 		language: useTextInput("source[language]", { source: data, valueSelector: (s) => s.source.language?.toUpperCase() ?? "EN" }),
 		statusContentType: useTextInput("source[status_content_type]", { source: data, defaultValue: "text/plain" }),
+		locale: useTextInput("source[locale]", { source: data, valueSelector: (s) => s.source.locale?.toUpperCase() ?? "" }),
+		emailNotifications: useTextInput("source[email_notifications]", { source: data, defaultValue: "none" }),
+		reviewFirstInteractions: useBoolInput("source[review_first_interactions]", { source: data }),
+		timeZone: useTextInput("source[time_zone]", { source: data }),
+		highlightsDigest: useTextInput("source[highlights_digest]", { source: data, defaultValue: "none" }),
 	};
 
 	const [submitForm, result] = useFormSubmit(form, query.useUpdateCredentialsMutation());
@@ -93,6 +103,41 @@ function UserSettingsForm({ data }) {
 					field={form.isSensitive}
 					label="Mark my posts as sensitive by default"
 				/>
+				<Select field={form.locale} label="Language of pages and emails from this instance" options={
+					<>
+						<option value="">Same as my browser</option>
+						<Languages />
+					</>
+				}>
+					<a href="https://docs.gotosocial.org/en/latest/user_guide/settings/#language" target="_blank" className="docslink" rel="noreferrer">Learn more about this setting (opens in a new tab)</a>
+				</Select>
+				<Select field={form.emailNotifications} label="Email me about new followers and mentions" options={
+					<>
+						<option value="none">Never</option>
+						<option value="immediate">Straight away</option>
+						<option value="daily">In a daily digest</option>
+					</>
+				}>
+					<a href="https://docs.gotosocial.org/en/latest/user_guide/settings/#email-notifications" target="_blank" className="docslink" rel="noreferrer">Learn more about this setting (opens in a new tab)</a>
+				</Select>
+				<Checkbox
+					field={form.reviewFirstInteractions}
+					label="Hold mentions from remote accounts I've never interacted with for review"
+				/>
+				<TextInput
+					field={form.timeZone}
+					label="Time zone (eg., Europe/Amsterdam; leave empty for UTC)"
+					placeholder="UTC"
+				/>
+				<Select field={form.highlightsDigest} label="Make daily highlights of popular posts from accounts I follow" options={
+					<>
+						<option value="none">Never</option>
+						<option value="daily">Yes, for my client to show</option>
+						<option value="email">Yes, and email them to me</option>
+					</>
+				}>
+					<a href="https://docs.gotosocial.org/en/latest/user_guide/settings/#highlights" target="_blank" className="docslink" rel="noreferrer">Learn more about this setting (opens in a new tab)</a>
+				</Select>
 
 				<MutationButton label="Save settings" result={result} />
 			</form>